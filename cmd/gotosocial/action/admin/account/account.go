@@ -21,6 +21,7 @@
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"text/tabwriter"
 	"time"
 
@@ -29,6 +30,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	userprocessor "github.com/superseriousbusiness/gotosocial/internal/processing/user"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
@@ -385,3 +387,84 @@ func stopState(state *state.State) error {
 		"encrypted_password",
 	)
 }
+
+// DisableTwoFactor removes two factor authentication
+// details from a user, so they can sign in with
+// just their password again.
+var DisableTwoFactor action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	// Reset two factor details the same way as the
+	// user disabling it themselves would. The reset
+	// only touches the db, so nothing else is needed.
+	processor := userprocessor.New(state, nil, nil, nil)
+	return processor.TwoFactorReset(ctx, user)
+}
+
+// Domain sets the extra account domain that a local
+// account is hosted on, or resets it to the instance
+// account domain if no domain is given.
+var Domain action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	domain := config.GetAdminAccountDomain()
+	if domain != "" && !slices.Contains(config.GetExtraAccountDomains(), domain) {
+		return fmt.Errorf("domain %s is not one of the configured %s", domain, config.ExtraAccountDomainsFlag())
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	settings, err := state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	settings.AccountDomain = domain
+	return state.DB.UpdateAccountSettings(
+		ctx, settings,
+		"account_domain",
+	)
+}
@@ -0,0 +1,231 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/media/ffmpeg"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
+)
+
+type regenerate struct {
+	dbService db.DB
+	state     *state.State
+	manager   *media.Manager
+	accountID string
+	since     time.Time
+	until     time.Time
+}
+
+// include returns whether given attachment
+// matches the regenerate command filters.
+func (r *regenerate) include(attachment *gtsmodel.MediaAttachment) bool {
+	switch {
+	case attachment.IsRemote():
+		// Only local media.
+		return false
+
+	case r.accountID != "" &&
+		attachment.AccountID != r.accountID:
+		return false
+
+	case !r.since.IsZero() &&
+		attachment.CreatedAt.Before(r.since):
+		return false
+
+	case !r.until.IsZero() &&
+		!attachment.CreatedAt.Before(r.until):
+		return false
+
+	default:
+		return true
+	}
+}
+
+// parseDate parses given date flag value, either in
+// YYYY-MM-DD or RFC3339 format. Empty returns zero time.
+func parseDate(flag string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s date %s, must be YYYY-MM-DD or RFC3339", flag, value)
+	}
+
+	return t, nil
+}
+
+func setupRegenerate(ctx context.Context) (*regenerate, error) {
+	var state state.State
+
+	since, err := parseDate(
+		config.AdminMediaRegenerateSinceFlag(),
+		config.GetAdminMediaRegenerateSince(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	until, err := parseDate(
+		config.AdminMediaRegenerateUntilFlag(),
+		config.GetAdminMediaRegenerateUntil(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Media processing is done sequentially,
+	// so only a single instance is needed.
+	if err := ffmpeg.InitFfprobe(ctx, 1); err != nil {
+		return nil, fmt.Errorf("error compiling ffprobe: %w", err)
+	}
+
+	if err := ffmpeg.InitFfmpeg(ctx, 1); err != nil {
+		return nil, fmt.Errorf("error compiling ffmpeg: %w", err)
+	}
+
+	state.Caches.Init()
+	state.Caches.Start()
+
+	dbService, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dbservice: %w", err)
+	}
+	state.DB = dbService
+
+	//nolint:contextcheck
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error creating storage backend: %w", err)
+	}
+	state.Storage = storage
+
+	var accountID string
+
+	if username := config.GetAdminMediaRegenerateAccount(); username != "" {
+		// Look up the local account to filter by.
+		account, err := dbService.GetAccountByUsernameDomain(ctx, username, "")
+		if err != nil {
+			return nil, fmt.Errorf("error getting account %s: %w", username, err)
+		}
+		accountID = account.ID
+	}
+
+	return &regenerate{
+		dbService: dbService,
+		state:     &state,
+		manager:   media.NewManager(&state),
+		accountID: accountID,
+		since:     since,
+		until:     until,
+	}, nil
+}
+
+func (r *regenerate) shutdown() error {
+	err := r.dbService.Close()
+	r.state.Caches.Stop()
+	return err
+}
+
+// Regenerate recomputes thumbnails, blurhashes and metadata
+// of local attachments, optionally filtered by account or date.
+var Regenerate action.GTSAction = func(ctx context.Context) error {
+	regen, err := setupRegenerate(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure regenerator gets shutdown on exit.
+		if err := regen.shutdown(); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	var (
+		page        = paging.Page{Limit: 200}
+		total       int
+		failed      int
+		regenerated int
+	)
+
+	for {
+		// Get the next page of media attachments up to max ID.
+		attachments, err := regen.dbService.GetAttachments(ctx, &page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("failed to retrieve media metadata from database: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no attachments or the same group is returned, we reached the end.
+		if len(attachments) == 0 || maxID == attachments[len(attachments)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID' value.
+		maxID = attachments[len(attachments)-1].ID
+		page.Max = paging.MaxID(maxID)
+
+		for _, attachment := range attachments {
+			if !regen.include(attachment) {
+				continue
+			}
+
+			total++
+
+			if attachment.File.Path == "" ||
+				attachment.Type == gtsmodel.FileTypeUnknown {
+				// Nothing to
+				// regenerate.
+				continue
+			}
+
+			if err := regen.manager.RegenerateMedia(ctx, attachment); err != nil {
+				log.Errorf(ctx, "error regenerating media %s: %v", attachment.ID, err)
+				failed++
+				continue
+			}
+
+			regenerated++
+		}
+	}
+
+	log.Infof(ctx, "regenerated %d of %d matching local attachments (%d failed)", regenerated, total, failed)
+	return nil
+}
@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/search"
+	searchbackend "github.com/superseriousbusiness/gotosocial/internal/search"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+func initState(ctx context.Context) (*state.State, error) {
+	var state state.State
+	state.Caches.Init()
+	state.Caches.Start()
+
+	// Set the state DB connection
+	dbConn, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dbConn: %w", err)
+	}
+	state.DB = dbConn
+
+	// Set the configured search backend.
+	state.Search, err = searchbackend.NewBackend()
+	if err != nil {
+		return nil, fmt.Errorf("error creating search backend: %w", err)
+	}
+
+	return &state, nil
+}
+
+func stopState(state *state.State) error {
+	err := state.DB.Close()
+	state.Caches.Stop()
+	return err
+}
+
+// Reindex rebuilds the status search index from
+// scratch, in the configured search backend.
+var Reindex action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	// Only the backend + db are needed for reindexing.
+	processor := search.New(state, nil, nil, nil)
+	if err := processor.Reindex(ctx); err != nil {
+		return fmt.Errorf("error reindexing statuses: %w", err)
+	}
+
+	log.Info(ctx, "reindexing complete")
+	return nil
+}
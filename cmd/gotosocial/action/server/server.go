@@ -34,6 +34,7 @@
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/redis"
 	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/interaction"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/spam"
@@ -44,6 +45,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	tlprocessor "github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/pubsub"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"go.uber.org/automaxprocs/maxprocs"
@@ -54,17 +56,20 @@
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/ldap"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"github.com/superseriousbusiness/gotosocial/internal/router"
+	"github.com/superseriousbusiness/gotosocial/internal/search"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
+	"github.com/superseriousbusiness/gotosocial/internal/webhook"
 )
 
 // Start creates and starts a gotosocial server
@@ -118,6 +123,9 @@
 		}
 
 		if process != nil {
+			// Stop fanning-out streamed messages.
+			process.Stream().Stop()
+
 			const timeout = time.Minute
 
 			// Use a new timeout context to ensure
@@ -164,6 +172,24 @@
 	// Set DB on state.
 	state.DB = dbService
 
+	// Set external search backend on
+	// state, if configured, so that the
+	// DB keeps it up to date with statuses.
+	state.Search, err = search.NewBackend()
+	if err != nil {
+		return fmt.Errorf("error creating search backend: %w", err)
+	}
+
+	// Share cache invalidations with
+	// other processes, if configured.
+	bus, err := newBus("cache_invalidation")
+	if err != nil {
+		return fmt.Errorf("error creating cache invalidation bus: %w", err)
+	}
+	if bus != nil {
+		state.Caches.SetInvalidationBus(bus)
+	}
+
 	// Ensure necessary database instance prerequisites exist.
 	if err := dbService.CreateInstanceAccount(ctx); err != nil {
 		return fmt.Errorf("error creating instance account: %s", err)
@@ -193,6 +219,26 @@
 		BlockRanges:           config.MustParseIPPrefixes(config.GetHTTPClientBlockIPs()),
 		Timeout:               config.GetHTTPClientTimeout(),
 		TLSInsecureSkipVerify: config.GetHTTPClientTLSInsecureSkipVerify(),
+		MaxRetries:            config.GetDeliveryMaxRetries(),
+		Backoff:               config.GetDeliveryBackoff(),
+		UnreachableThreshold:  config.GetDeliveryUnreachableThreshold(),
+		UnreachableDuration:   config.GetDeliveryUnreachableDuration(),
+		SOCKSProxy:            config.GetHTTPClientSOCKSProxy(),
+		SOCKSProxyOnionOnly:   config.GetHTTPClientSOCKSProxyOnionOnly(),
+		Proxy:                 config.GetHTTPClientProxy(),
+		ProxyIncludeHosts:     config.GetHTTPClientProxyIncludeHosts(),
+		ProxyExcludeHosts:     config.GetHTTPClientProxyExcludeHosts(),
+		RateLimit: func(ctx context.Context, host string, delivery bool) int {
+			deliveryLimit, dereferenceLimit, err := state.DB.GetDomainRateLimits(ctx, host)
+			if err != nil {
+				log.Errorf(ctx, "error getting rate limits for %s: %v", host, err)
+				return 0
+			}
+			if delivery {
+				return deliveryLimit
+			}
+			return dereferenceLimit
+		},
 	})
 
 	// Compile WASM modules ahead of first use
@@ -262,6 +308,38 @@
 		return fmt.Errorf("error starting list timeline: %s", err)
 	}
 
+	if config.GetClusterEnabled() {
+		// Share changes to timelines with other nodes.
+		homeBus, err := newBus("timelines_home")
+		if err != nil {
+			return fmt.Errorf("error creating home timeline bus: %w", err)
+		}
+		state.Timelines.Home = timeline.Broadcast(state.Timelines.Home, homeBus)
+
+		listBus, err := newBus("timelines_list")
+		if err != nil {
+			return fmt.Errorf("error creating list timeline bus: %w", err)
+		}
+		state.Timelines.List = timeline.Broadcast(state.Timelines.List, listBus)
+
+		// Ensure exclusive scheduled tasks
+		// only run on one node at a time.
+		nodeID, err := clusterNodeID()
+		if err != nil {
+			return err
+		}
+		state.Workers.Scheduler.Lease = func(ctx context.Context, id string, ttl time.Duration) bool {
+			ok, err := state.DB.AcquireLease(ctx, id, nodeID, ttl)
+			if err != nil {
+				log.Errorf(ctx, "error acquiring lease %s: %v", id, err)
+				return false
+			}
+			return ok
+		}
+
+		log.Infof(ctx, "running as cluster node %s", nodeID)
+	}
+
 	// Start the job scheduler
 	// (this is required for cleaner).
 	state.Workers.StartScheduler()
@@ -302,12 +380,62 @@ func(context.Context, time.Time) {
 		intFilter,
 	)
 
+	// Prepare external search backend index, if configured.
+	if err := process.Search().InitBackend(ctx); err != nil {
+		return fmt.Errorf("error initializing search backend: %w", err)
+	}
+
+	// Schedule periodic trends updates, if enabled.
+	if config.GetTrendsEnabled() {
+		process.Trends().ScheduleUpdates()
+	}
+
+	// Schedule periodic deletion of statuses
+	// by accounts that have status expiry set.
+	process.Status().ScheduleExpiry()
+
+	// Schedule periodic email digests to accounts
+	// that have them enabled, if we can send email.
+	if config.GetSMTPHost() != "" {
+		process.User().ScheduleDigests()
+	}
+
+	// Schedule periodic deletion of accounts
+	// whose deletion grace period has passed.
+	process.User().ScheduleDeletions()
+
+	// Schedule periodic re-verification
+	// of local accounts' profile fields.
+	process.Account().ScheduleFieldVerification()
+
+	// Schedule periodic pruning of dead letters, if enabled.
+	process.Admin().ScheduleDeadLetterPrune()
+
+	// Schedule starting + lifting of scheduled and expiring admin actions.
+	process.Admin().ScheduleAdminActions()
+
+	if config.GetClusterEnabled() {
+		// Fan-out streamed messages to
+		// clients connected to other nodes.
+		streamBus, err := newBus("streaming")
+		if err != nil {
+			return fmt.Errorf("error creating streaming bus: %w", err)
+		}
+		process.Stream().SetBus(streamBus)
+
+		// Share queued deliveries with other nodes.
+		process.Admin().ScheduleWorkerQueueSharing()
+	}
+
 	// Initialize the specialized workers pools.
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
 	state.Workers.Delivery.Init(client)
+	state.Workers.Webhook.Init(client)
 	state.Workers.Client.Process = process.Workers().ProcessFromClientAPI
 	state.Workers.Federator.Process = process.Workers().ProcessFromFediAPI
+	state.Workers.Delivery.DeadLetter = process.Admin().DeadLetterPut
+	state.Workers.Webhook.DeadLetter = webhook.LogFailed
 
 	// Now start workers!
 	state.Workers.Start()
@@ -356,8 +484,10 @@ func(context.Context, time.Time) {
 		// note: hooks adding ctx fields must be ABOVE
 		// the logger, otherwise won't be accessible.
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.IPBlock(state),
 		middleware.HeaderFilter(state),
 		middleware.UserAgent(),
+		middleware.AIScraper(state),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
 	}...)
@@ -402,6 +532,19 @@ func(context.Context, time.Time) {
 		}
 	}
 
+	var ldapAuth ldap.Authenticator
+	if config.GetLDAPEnabled() {
+		if config.GetOIDCEnabled() {
+			// OIDC sign in bypasses the sign in form entirely.
+			return errors.New("oidc and ldap cannot both be enabled")
+		}
+
+		ldapAuth, err = ldap.NewAuthenticator()
+		if err != nil {
+			return fmt.Errorf("error creating ldap authenticator: %w", err)
+		}
+	}
+
 	routerSession, err := dbService.GetSession(ctx)
 	if err != nil {
 		return fmt.Errorf("error retrieving router session for session middleware: %w", err)
@@ -413,22 +556,45 @@ func(context.Context, time.Time) {
 	}
 
 	var (
-		authModule        = api.NewAuth(dbService, process, idp, routerSession, sessionName) // auth/oauth paths
-		clientModule      = api.NewClient(state, process)                                    // api client endpoints
-		metricsModule     = api.NewMetrics()                                                 // Metrics endpoints
-		healthModule      = api.NewHealth(dbService.Ready)                                   // Health check endpoints
-		fileserverModule  = api.NewFileserver(process)                                       // fileserver endpoints
-		wellKnownModule   = api.NewWellKnown(process)                                        // .well-known endpoints
-		nodeInfoModule    = api.NewNodeInfo(process)                                         // nodeinfo endpoint
-		activityPubModule = api.NewActivityPub(dbService, process)                           // ActivityPub endpoints
-		webModule         = web.New(dbService, process)                                      // web pages + user profiles + settings panels etc
+		authModule        = api.NewAuth(dbService, process, idp, ldapAuth, routerSession, sessionName) // auth/oauth paths
+		clientModule      = api.NewClient(state, process)                                              // api client endpoints
+		metricsModule     = api.NewMetrics()                                                           // Metrics endpoints
+		debugModule       = api.NewDebug(state)                                                        // Debug/profiling endpoints
+		healthModule      = api.NewHealth(dbService.Ready)                                             // Health check endpoints
+		fileserverModule  = api.NewFileserver(process)                                                 // fileserver endpoints
+		wellKnownModule   = api.NewWellKnown(process)                                                  // .well-known endpoints
+		nodeInfoModule    = api.NewNodeInfo(process)                                                   // nodeinfo endpoint
+		activityPubModule = api.NewActivityPub(dbService, process)                                     // ActivityPub endpoints
+		webModule         = web.New(dbService, process)                                                // web pages + user profiles + settings panels etc
 	)
 
 	// create required middleware
 	// rate limiting
 	rlLimit := config.GetAdvancedRateLimitRequests()
 	rlExceptions := config.GetAdvancedRateLimitExceptions()
-	clLimit := middleware.RateLimit(rlLimit, rlExceptions)        // client api
+	clScopes := []middleware.RateLimitScope{
+		{
+			// Searches are expensive,
+			// so limit them separately.
+			Prefixes: []string{
+				"/api/v1/search",
+				"/api/v2/search",
+				"/api/v1/accounts/search",
+			},
+			Limit: config.GetAdvancedRateLimitSearchRequests(),
+		},
+		{
+			// Streaming clients reconnect
+			// often, so limit separately.
+			Prefixes: []string{"/api/v1/streaming"},
+			Limit:    config.GetAdvancedRateLimitStreamingRequests(),
+		},
+	}
+	clLimit := middleware.RateLimitWithConfig(middleware.RateLimitConfig{ // client api
+		Limit:      rlLimit,
+		Exceptions: rlExceptions,
+		Scopes:     clScopes,
+	})
 	s2sLimit := middleware.RateLimit(rlLimit, rlExceptions)       // server-to-server (AP)
 	fsMainLimit := middleware.RateLimit(rlLimit, rlExceptions)    // fileserver / web templates
 	fsEmojiLimit := middleware.RateLimit(rlLimit*2, rlExceptions) // fileserver (emojis only, use high limit)
@@ -449,11 +615,38 @@ func(context.Context, time.Time) {
 	// is rather annoying to neatly override).
 	gzip := middleware.Gzip()
 
+	// By default the client api is limited by IP address
+	// like everything else. When limiting by token or
+	// account instead, that limiter runs after the token
+	// check, so that callers sharing an IP get a limit
+	// each. A looser IP limit stays in front of the token
+	// check as a backstop, so that there's always *some*
+	// limit on requests that make us look up a token.
+	clientMiddleware := []gin.HandlerFunc{clLimit, clThrottle, gzip}
+	if rlKey := config.GetAdvancedRateLimitKey(); rlKey != config.RateLimitKeyIP {
+		clBackstopLimit := middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limit:      rlLimit * rlBackstopMultiplier,
+			Exceptions: rlExceptions,
+			Scopes:     scaleRateLimitScopes(clScopes, rlBackstopMultiplier),
+		})
+		clientModule.UseAuthed(
+			middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+				Limit:      rlLimit,
+				Exceptions: rlExceptions,
+				Key:        rlKey,
+				Scopes:     clScopes,
+			}),
+			clThrottle,
+		)
+		clientMiddleware = []gin.HandlerFunc{clBackstopLimit, gzip}
+	}
+
 	// these should be routed in order;
 	// apply throttling *after* rate limiting
 	authModule.Route(route, clLimit, clThrottle, gzip)
-	clientModule.Route(route, clLimit, clThrottle, gzip)
+	clientModule.Route(route, clientMiddleware...)
 	metricsModule.Route(route, clLimit, clThrottle)
+	debugModule.Route(route, clLimit)
 	healthModule.Route(route, clLimit, clThrottle)
 	fileserverModule.Route(route, fsMainLimit, fsThrottle)
 	fileserverModule.RouteEmojis(route, instanceAccount.ID, fsEmojiLimit, fsThrottle)
@@ -482,6 +675,24 @@ func(context.Context, time.Time) {
 	return nil
 }
 
+// rlBackstopMultiplier is how much looser the IP keyed
+// rate limit in front of the client api token check is
+// than the main limit, when limiting by token or account.
+const rlBackstopMultiplier = 10
+
+// scaleRateLimitScopes returns a copy of the given
+// rate limit scopes with their limits multiplied.
+func scaleRateLimitScopes(scopes []middleware.RateLimitScope, multiplier int) []middleware.RateLimitScope {
+	scaled := make([]middleware.RateLimitScope, len(scopes))
+	for i, scope := range scopes {
+		scaled[i] = middleware.RateLimitScope{
+			Prefixes: scope.Prefixes,
+			Limit:    scope.Limit * multiplier,
+		}
+	}
+	return scaled
+}
+
 func setLimits(ctx context.Context) {
 	if _, err := maxprocs.Set(maxprocs.Logger(nil)); err != nil {
 		log.Warnf(ctx, "could not set CPU limits from cgroup: %s", err)
@@ -512,3 +723,37 @@ func compileWASM(ctx context.Context) error {
 
 	return nil
 }
+
+// newBus returns a new pubsub.Bus{} over the named channel,
+// of the type set by cache-invalidation-bus (nil if unset).
+func newBus(channel string) (pubsub.Bus, error) {
+	switch config.GetCacheInvalidationBus() {
+	case config.CacheInvalidationBusPostgres:
+		return bundb.NewPostgresBus(channel)
+	case config.CacheInvalidationBusRedis:
+		return redis.NewBus(redis.Config{
+			Address:   config.GetCacheRedisAddress(),
+			Username:  config.GetCacheRedisUsername(),
+			Password:  config.GetCacheRedisPassword(),
+			DB:        config.GetCacheRedisDB(),
+			KeyPrefix: config.GetCacheRedisKeyPrefix(),
+		}, channel)
+	default:
+		return nil, nil
+	}
+}
+
+// clusterNodeID returns the configured cluster
+// node ID, falling back to the hostname if unset.
+func clusterNodeID() (string, error) {
+	if nodeID := config.GetClusterNodeID(); nodeID != "" {
+		return nodeID, nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("error getting hostname for cluster node id: %w", err)
+	}
+
+	return hostname, nil
+}
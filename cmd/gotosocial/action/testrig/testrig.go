@@ -39,6 +39,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/language"
+	"github.com/superseriousbusiness/gotosocial/internal/ldap"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
@@ -230,8 +231,10 @@
 
 	middlewares = append(middlewares, []gin.HandlerFunc{
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.IPBlock(state),
 		middleware.HeaderFilter(state),
 		middleware.UserAgent(),
+		middleware.AIScraper(state),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
 	}...)
@@ -276,6 +279,19 @@
 		}
 	}
 
+	var ldapAuth ldap.Authenticator
+	if config.GetLDAPEnabled() {
+		if config.GetOIDCEnabled() {
+			// OIDC sign in bypasses the sign in form entirely.
+			return errors.New("oidc and ldap cannot both be enabled")
+		}
+
+		ldapAuth, err = ldap.NewAuthenticator()
+		if err != nil {
+			return fmt.Errorf("error creating ldap authenticator: %w", err)
+		}
+	}
+
 	routerSession, err := state.DB.GetSession(ctx)
 	if err != nil {
 		return fmt.Errorf("error retrieving router session for session middleware: %w", err)
@@ -287,15 +303,15 @@
 	}
 
 	var (
-		authModule        = api.NewAuth(state.DB, processor, idp, routerSession, sessionName) // auth/oauth paths
-		clientModule      = api.NewClient(state, processor)                                   // api client endpoints
-		metricsModule     = api.NewMetrics()                                                  // Metrics endpoints
-		healthModule      = api.NewHealth(state.DB.Ready)                                     // Health check endpoints
-		fileserverModule  = api.NewFileserver(processor)                                      // fileserver endpoints
-		wellKnownModule   = api.NewWellKnown(processor)                                       // .well-known endpoints
-		nodeInfoModule    = api.NewNodeInfo(processor)                                        // nodeinfo endpoint
-		activityPubModule = api.NewActivityPub(state.DB, processor)                           // ActivityPub endpoints
-		webModule         = web.New(state.DB, processor)                                      // web pages + user profiles + settings panels etc
+		authModule        = api.NewAuth(state.DB, processor, idp, ldapAuth, routerSession, sessionName) // auth/oauth paths
+		clientModule      = api.NewClient(state, processor)                                             // api client endpoints
+		metricsModule     = api.NewMetrics()                                                            // Metrics endpoints
+		healthModule      = api.NewHealth(state.DB.Ready)                                               // Health check endpoints
+		fileserverModule  = api.NewFileserver(processor)                                                // fileserver endpoints
+		wellKnownModule   = api.NewWellKnown(processor)                                                 // .well-known endpoints
+		nodeInfoModule    = api.NewNodeInfo(processor)                                                  // nodeinfo endpoint
+		activityPubModule = api.NewActivityPub(state.DB, processor)                                     // ActivityPub endpoints
+		webModule         = web.New(state.DB, processor)                                                // web pages + user profiles + settings panels etc
 	)
 
 	// these should be routed in order
@@ -319,6 +335,23 @@
 		return fmt.Errorf("error scheduling cleaner jobs: %w", err)
 	}
 
+	// Schedule periodic trends updates, if enabled.
+	if config.GetTrendsEnabled() {
+		processor.Trends().ScheduleUpdates()
+	}
+
+	// Schedule periodic deletion of statuses
+	// by accounts that have status expiry set.
+	processor.Status().ScheduleExpiry()
+
+	// Schedule periodic email digests
+	// to accounts that have them enabled.
+	processor.User().ScheduleDigests()
+
+	// Schedule periodic deletion of accounts
+	// whose deletion grace period has passed.
+	processor.User().ScheduleDeletions()
+
 	// Finally start the main http server!
 	if err := route.Start(); err != nil {
 		return fmt.Errorf("error starting router: %w", err)
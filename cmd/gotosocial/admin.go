@@ -22,6 +22,7 @@
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media/prune"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/search"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
@@ -146,6 +147,32 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountDisable2FACmd := &cobra.Command{
+		Use:   "disable-2fa",
+		Short: "disable two factor authentication for a local account, eg., if the user has lost their authenticator app and backup codes",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.DisableTwoFactor)
+		},
+	}
+	config.AddAdminAccount(adminAccountDisable2FACmd)
+	adminAccountCmd.AddCommand(adminAccountDisable2FACmd)
+
+	adminAccountDomainCmd := &cobra.Command{
+		Use:   "domain",
+		Short: "set the extra account domain that a local account is hosted on, or leave out --domain to move it back to the instance account domain",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Domain)
+		},
+	}
+	config.AddAdminAccountDomain(adminAccountDomainCmd)
+	adminAccountCmd.AddCommand(adminAccountDomainCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -217,6 +244,23 @@ func adminCommands() *cobra.Command {
 	config.AddAdminMediaList(adminMediaListEmojisLocalCmd)
 	adminMediaCmd.AddCommand(adminMediaListEmojisLocalCmd)
 
+	/*
+		ADMIN MEDIA REGENERATE COMMANDS
+	*/
+
+	adminMediaRegenerateCmd := &cobra.Command{
+		Use:   "regenerate",
+		Short: "regenerate thumbnails, blurhashes and metadata of local attachments, optionally filtered by account or date",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), media.Regenerate)
+		},
+	}
+	config.AddAdminMediaRegenerate(adminMediaRegenerateCmd)
+	adminMediaCmd.AddCommand(adminMediaRegenerateCmd)
+
 	/*
 		ADMIN MEDIA PRUNE COMMANDS
 	*/
@@ -268,5 +312,28 @@ func adminCommands() *cobra.Command {
 
 	adminCmd.AddCommand(adminMediaCmd)
 
+	/*
+		ADMIN SEARCH COMMANDS
+	*/
+
+	adminSearchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "admin commands related to status search",
+	}
+
+	adminSearchReindexCmd := &cobra.Command{
+		Use:   "reindex",
+		Short: "rebuild the status search index from scratch, in the configured search backend",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), search.Reindex)
+		},
+	}
+	adminSearchCmd.AddCommand(adminSearchReindexCmd)
+
+	adminCmd.AddCommand(adminSearchCmd)
+
 	return adminCmd
 }
@@ -69,6 +69,23 @@ func run(ctx context.Context, action action.GTSAction) error {
 		return fmt.Errorf("error parsing log level: %w", err)
 	}
 
+	// Set the global log format from configuration
+	if err := log.ParseFormat(config.GetLogFormat()); err != nil {
+		return fmt.Errorf("error parsing log format: %w", err)
+	}
+
+	if path := config.GetLogFile(); path != "" {
+		// Enable logging to file
+		if err := log.EnableFile(
+			path,
+			int64(config.GetLogFileMaxSize()), // #nosec G115 -- won't overflow
+			config.GetLogFileMaxAge(),
+			config.GetLogFileMaxBackups(),
+		); err != nil {
+			return fmt.Errorf("error enabling logging to file: %w", err)
+		}
+	}
+
 	if config.GetSyslogEnabled() {
 		// Enable logging to syslog
 		if err := log.EnableSyslog(
@@ -81,8 +81,44 @@
 	// Not in the AS spec, just used internally to indicate
 	// that we don't *yet* know what type of Object something is.
 	ObjectUnknown = "Unknown"
+
+	// Not in the AS spec, and never federated, just used
+	// internally to indicate an appeal against an admin action.
+	ObjectAppeal = "Appeal"
+
+	// Not in the AS spec, used by Pleroma (and others) to federate
+	// emoji reactions. We never resolve this type directly, it's
+	// rewritten to a Like with content on the way in.
+	//
+	// See NormalizeIncomingReaction.
+	ActivityEmojiReact = "EmojiReact"
 )
 
+// Link media types and rels used to indicate that a Link tag points
+// to another ActivityStreams object, eg., a quoted status.
+//
+// See https://codeberg.org/fediverse/fep/src/branch/main/fep/e232/fep-e232.md
+const (
+	// LinkMediaTypeObject is the media type recommended by FEP-e232 for object links.
+	LinkMediaTypeObject = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+
+	// LinkMediaTypeActivityJSON is an alternative media type for object links.
+	LinkMediaTypeActivityJSON = "application/activity+json"
+
+	// LinkRelQuote is the rel used by Misskey (and others) to mark an object link as a quote.
+	LinkRelQuote = "https://misskey-hub.net/ns#_misskey_quote"
+)
+
+// FlagCategoryKey is the key of the (non-standard)
+// property used to give the category of a Flag,
+// one of "spam", "violation" or "other".
+const FlagCategoryKey = "category"
+
+// MisskeyReactionKey is the key of the (non-standard)
+// property used by Misskey to give the emoji of a Like
+// that's actually an emoji reaction.
+const MisskeyReactionKey = "_misskey_reaction"
+
 // isActivity returns whether AS type name is of an Activity (NOT IntransitiveActivity).
 func isActivity(typeName string) bool {
 	switch typeName {
@@ -324,6 +324,70 @@ func ExtractAttributedToURI(i WithAttributedTo) (*url.URL, error) {
 	return nil, gtserror.New("couldn't find iri for attributed to")
 }
 
+// ExtractAnnounceObjectURI returns the URI of the object
+// being Announced by the given Announceable.
+//
+// Group actors (Lemmy / Mbin communities, a p groups, etc)
+// implementing FEP-1b12 wrap the activity they're forwarding
+// to their followers in the Announce, like so:
+//
+//	"type": "Announce",
+//	"object": {
+//	  "type": "Create",
+//	  "object": {
+//	    "type": "Page",
+//	    ...
+//	  }
+//	}
+//
+// In the case of a wrapped Create, the URI of the created
+// object will be returned. Any other wrapped activity type
+// (Like, Update, Delete, etc) will return an error flagged
+// as NotRelevant, as these can't be converted to a boost.
+func ExtractAnnounceObjectURI(i WithObject) (*url.URL, error) {
+	objectProp := i.GetActivityStreamsObject()
+	if objectProp == nil || objectProp.Len() == 0 {
+		return nil, gtserror.New("objectProp was nil or empty")
+	}
+
+	object := objectProp.At(0)
+
+	t := object.GetType()
+	if t == nil {
+		// Just a plain IRI.
+		if object.IsIRI() {
+			if iri := object.GetIRI(); iri != nil {
+				return iri, nil
+			}
+		}
+		return nil, gtserror.New("couldn't find iri for object")
+	}
+
+	activity, ok := ToActivityable(t)
+	if !ok {
+		// An embedded object
+		// of some other type.
+		if id := GetJSONLDId(t); id != nil {
+			return id, nil
+		}
+		return nil, gtserror.New("couldn't find iri for object")
+	}
+
+	if name := activity.GetTypeName(); name != ActivityCreate {
+		err := gtserror.Newf("wrapped %s activity can't be announced", name)
+		return nil, gtserror.SetNotRelevant(err)
+	}
+
+	// Unwrap the Create to
+	// get the created object.
+	objectIRIs := GetObjectIRIs(activity)
+	if len(objectIRIs) == 0 {
+		return nil, gtserror.New("couldn't find iri for wrapped create object")
+	}
+
+	return objectIRIs[0], nil
+}
+
 // ExtractIconURI extracts the first URI it can find from
 // the given WithIcon which links to a supported image file.
 // Input will look something like this:
@@ -634,6 +698,14 @@ func ExtractContent(i WithContent) gtsmodel.Content {
 	return content
 }
 
+// ExtractReaction returns the emoji reaction carried by the
+// given Like, ie., its trimmed plaintext content, or an empty
+// string if it's just a regular Like. Note that this relies
+// on the Like having been passed through NormalizeIncomingReaction.
+func ExtractReaction(i WithContent) string {
+	return strings.TrimSpace(ExtractContent(i).Content)
+}
+
 // ExtractAttachments attempts to extract barebones MediaAttachment objects from given AS interface type.
 func ExtractAttachments(i WithAttachment) ([]*gtsmodel.MediaAttachment, error) {
 	attachmentProp := i.GetActivityStreamsAttachment()
@@ -773,6 +845,87 @@ func ExtractHashtags(i WithTag) ([]*gtsmodel.Tag, error) {
 	return tags, nil
 }
 
+// ExtractQuoteURI extracts the URI of the status quoted by
+// the given item, by looking for an FEP-e232 object Link in
+// its tags. Links explicitly marked with the Misskey quote rel
+// are preferred over other object links.
+//
+// Returns nil if no quote link could be found.
+func ExtractQuoteURI(i WithTag) *url.URL {
+	tagsProp := i.GetActivityStreamsTag()
+	if tagsProp == nil {
+		return nil
+	}
+
+	var quoteURI *url.URL
+
+	for iter := tagsProp.Begin(); iter != tagsProp.End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsLink() {
+			continue
+		}
+
+		link := iter.GetActivityStreamsLink()
+		if !isObjectLink(link) {
+			continue
+		}
+
+		hrefProp := link.GetActivityStreamsHref()
+		if hrefProp == nil || hrefProp.Get() == nil {
+			continue
+		}
+
+		if linkHasRel(link, LinkRelQuote) {
+			// Explicitly marked as
+			// quote, this is the one.
+			return hrefProp.Get()
+		}
+
+		if quoteURI == nil {
+			// Keep first object link
+			// as fallback in case no
+			// rel'd link is found.
+			quoteURI = hrefProp.Get()
+		}
+	}
+
+	return quoteURI
+}
+
+// isObjectLink returns whether the given Link has
+// a media type indicating that its href points to
+// an ActivityStreams object, as per FEP-e232.
+func isObjectLink(link vocab.ActivityStreamsLink) bool {
+	mediaTypeProp := link.GetActivityStreamsMediaType()
+	if mediaTypeProp == nil {
+		return false
+	}
+
+	// Strip whitespace for comparison, as
+	// the profile parameter may be spaced.
+	mediaType := strings.ReplaceAll(mediaTypeProp.Get(), " ", "")
+	return mediaType == strings.ReplaceAll(LinkMediaTypeObject, " ", "") ||
+		mediaType == LinkMediaTypeActivityJSON
+}
+
+// linkHasRel returns whether the given Link has the given rel.
+func linkHasRel(link vocab.ActivityStreamsLink, rel string) bool {
+	relProp := link.GetActivityStreamsRel()
+	if relProp == nil {
+		return false
+	}
+
+	for iter := relProp.Begin(); iter != relProp.End(); iter = iter.Next() {
+		switch {
+		case iter.IsIRI() && iter.GetIRI().String() == rel:
+			return true
+		case iter.IsRFCRfc5988() && iter.Get() == rel:
+			return true
+		}
+	}
+
+	return false
+}
+
 // extractHashtag extracts a minimal gtsmodel.Tag from the given
 // Hashtaggable, without yet doing any normalization on it.
 func extractHashtag(i Hashtaggable) (*gtsmodel.Tag, error) {
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type ExtractQuoteTestSuite struct {
+	APTestSuite
+}
+
+func (suite *ExtractQuoteTestSuite) resolve(rawJSON string) ap.Statusable {
+	statusable, err := ap.ResolveStatusable(
+		context.Background(),
+		io.NopCloser(bytes.NewBufferString(rawJSON)),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return statusable
+}
+
+func (suite *ExtractQuoteTestSuite) TestExtractQuoteLinkTag() {
+	statusable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/someone",
+  "content": "<p>look at this!</p>",
+  "tag": [
+    {
+      "type": "Link",
+      "mediaType": "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"",
+      "href": "https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7"
+    }
+  ]
+}`)
+
+	quoteURI := ap.ExtractQuoteURI(statusable)
+	suite.NotNil(quoteURI)
+	suite.Equal("https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7", quoteURI.String())
+}
+
+func (suite *ExtractQuoteTestSuite) TestExtractQuotePreferRel() {
+	statusable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/someone",
+  "content": "<p>look at these!</p>",
+  "tag": [
+    {
+      "type": "Link",
+      "mediaType": "application/activity+json",
+      "href": "https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7"
+    },
+    {
+      "type": "Link",
+      "mediaType": "application/activity+json",
+      "rel": "https://misskey-hub.net/ns#_misskey_quote",
+      "href": "https://example.org/users/someone_else/statuses/01HVR8EG0MGD0N5JCHNW6QBH4N"
+    }
+  ]
+}`)
+
+	quoteURI := ap.ExtractQuoteURI(statusable)
+	suite.NotNil(quoteURI)
+	suite.Equal("https://example.org/users/someone_else/statuses/01HVR8EG0MGD0N5JCHNW6QBH4N", quoteURI.String())
+}
+
+func (suite *ExtractQuoteTestSuite) TestExtractQuoteIgnoreNonObjectLink() {
+	statusable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/someone",
+  "content": "<p>look at this!</p>",
+  "tag": [
+    {
+      "type": "Link",
+      "mediaType": "text/html",
+      "href": "https://example.org/some/page"
+    }
+  ]
+}`)
+
+	suite.Nil(ap.ExtractQuoteURI(statusable))
+}
+
+func (suite *ExtractQuoteTestSuite) TestExtractQuoteURL() {
+	statusable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/someone",
+  "content": "<p>look at this!</p>",
+  "quoteUrl": "https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7"
+}`)
+
+	quoteURI := ap.ExtractQuoteURI(statusable)
+	suite.NotNil(quoteURI)
+	suite.Equal("https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7", quoteURI.String())
+
+	// Serializing should now include
+	// both the link tag and quoteUrl.
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "attributedTo": "https://example.org/users/someone",
+  "content": "\u003cp\u003elook at this!\u003c/p\u003e",
+  "id": "https://example.org/users/someone/statuses/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "quoteUrl": "https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7",
+  "tag": {
+    "href": "https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7",
+    "mediaType": "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"",
+    "name": "RE: https://example.org/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7",
+    "rel": "https://misskey-hub.net/ns#_misskey_quote",
+    "type": "Link"
+  },
+  "type": "Note"
+}`, suite.typeToJson(statusable))
+}
+
+func (suite *ExtractQuoteTestSuite) TestExtractQuoteMisskeyProps() {
+	for _, key := range []string{
+		"quoteUri",
+		"_misskey_quote",
+	} {
+		statusable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/notes/9ukzvbdk5d",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/9ukzun0hg2",
+  "content": "<p>look at this!</p>",
+  "` + key + `": "https://example.org/notes/9ukzvf1y7x"
+}`)
+
+		quoteURI := ap.ExtractQuoteURI(statusable)
+		if suite.NotNil(quoteURI, key) {
+			suite.Equal("https://example.org/notes/9ukzvf1y7x", quoteURI.String(), key)
+		}
+	}
+}
+
+func (suite *ExtractQuoteTestSuite) TestExtractQuotePreferQuoteURL() {
+	statusable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/notes/9ukzvbdk5d",
+  "type": "Note",
+  "attributedTo": "https://example.org/users/9ukzun0hg2",
+  "content": "<p>look at this!</p>",
+  "_misskey_quote": "not a url",
+  "quoteUri": "https://example.org/notes/9ukzwk6rzr",
+  "quoteUrl": "https://example.org/notes/9ukzvf1y7x"
+}`)
+
+	quoteURI := ap.ExtractQuoteURI(statusable)
+	suite.NotNil(quoteURI)
+	suite.Equal("https://example.org/notes/9ukzvf1y7x", quoteURI.String())
+}
+
+func TestExtractQuoteTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractQuoteTestSuite{})
+}
@@ -0,0 +1,134 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type ExtractReactionTestSuite struct {
+	APTestSuite
+}
+
+func (suite *ExtractReactionTestSuite) resolve(rawJSON string) ap.Activityable {
+	activityable, err := ap.ResolveActivityable(
+		context.Background(),
+		io.NopCloser(bytes.NewBufferString(rawJSON)),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return activityable
+}
+
+func (suite *ExtractReactionTestSuite) TestExtractReactionEmojiReact() {
+	activityable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/activities/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "type": "EmojiReact",
+  "actor": "https://example.org/users/someone",
+  "object": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+  "content": "🐢"
+}`)
+
+	like, ok := activityable.(vocab.ActivityStreamsLike)
+	if !ok {
+		suite.FailNow("", "expected Like, got %T", activityable)
+	}
+
+	suite.Equal("🐢", ap.ExtractReaction(like))
+}
+
+func (suite *ExtractReactionTestSuite) TestExtractReactionMisskey() {
+	activityable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/likes/9qkx4l6ipn",
+  "type": "Like",
+  "actor": "https://example.org/users/someone",
+  "object": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+  "_misskey_reaction": ":blobcat:"
+}`)
+
+	like, ok := activityable.(vocab.ActivityStreamsLike)
+	if !ok {
+		suite.FailNow("", "expected Like, got %T", activityable)
+	}
+
+	suite.Equal(":blobcat:", ap.ExtractReaction(like))
+}
+
+func (suite *ExtractReactionTestSuite) TestExtractReactionPlainLike() {
+	activityable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/likes/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+  "type": "Like",
+  "actor": "https://example.org/users/someone",
+  "object": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY"
+}`)
+
+	like, ok := activityable.(vocab.ActivityStreamsLike)
+	if !ok {
+		suite.FailNow("", "expected Like, got %T", activityable)
+	}
+
+	suite.Empty(ap.ExtractReaction(like))
+}
+
+func (suite *ExtractReactionTestSuite) TestExtractReactionUndoEmojiReact() {
+	activityable := suite.resolve(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/activities/01HVR8DQ4RJ0Y0MFNB5CJGB1W7",
+  "type": "Undo",
+  "actor": "https://example.org/users/someone",
+  "object": {
+    "id": "https://example.org/activities/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+    "type": "EmojiReact",
+    "actor": "https://example.org/users/someone",
+    "object": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+    "content": "🐢"
+  }
+}`)
+
+	undo, ok := activityable.(vocab.ActivityStreamsUndo)
+	if !ok {
+		suite.FailNow("", "expected Undo, got %T", activityable)
+	}
+
+	objects := ap.ExtractObjects(undo)
+	if len(objects) != 1 {
+		suite.FailNow("", "expected 1 object, got %d", len(objects))
+	}
+
+	like, ok := objects[0].GetType().(vocab.ActivityStreamsLike)
+	if !ok {
+		suite.FailNow("", "expected Like, got %T", objects[0].GetType())
+	}
+
+	suite.Equal("🐢", ap.ExtractReaction(like))
+}
+
+func TestExtractReactionTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractReactionTestSuite{})
+}
@@ -0,0 +1,416 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const (
+	// DataIntegrityContext is the JSON-LD context
+	// for documents secured with a DataIntegrityProof.
+	DataIntegrityContext = "https://w3id.org/security/data-integrity/v1"
+
+	// MultikeyContext is the JSON-LD context for
+	// actors publishing Multikey verification methods.
+	MultikeyContext = "https://w3id.org/security/multikey/v1"
+
+	// ProofTypeDataIntegrity is the only proof type we support.
+	ProofTypeDataIntegrity = "DataIntegrityProof"
+
+	// CryptosuiteEddsaJcs2022 is the only cryptosuite we
+	// support: Ed25519 signatures over JCS canonicalized JSON.
+	CryptosuiteEddsaJcs2022 = "eddsa-jcs-2022"
+
+	// ProofPurposeAssertionMethod is the purpose
+	// of proofs on activities and objects.
+	ProofPurposeAssertionMethod = "assertionMethod"
+
+	// KeyTypeMultikey is the type of a
+	// verification method encoded as Multikey.
+	KeyTypeMultikey = "Multikey"
+)
+
+// multicodec prefix for Ed25519 public keys.
+var ed25519MulticodecPrefix = []byte{0xed, 0x01}
+
+// AddIntegrityProof returns a copy of the given serialized document with
+// an eddsa-jcs-2022 DataIntegrityProof attached, as per FEP-8b32, signed
+// with key and referencing keyID as its verificationMethod. Any existing
+// proof on the document is replaced. The given document is not modified.
+func AddIntegrityProof(
+	doc map[string]interface{},
+	key ed25519.PrivateKey,
+	keyID string,
+	created time.Time,
+) (map[string]interface{}, error) {
+	// Shallow copy the document
+	// sans any existing proof.
+	secured := make(map[string]interface{}, len(doc)+1)
+	for k, v := range doc {
+		if k != "proof" {
+			secured[k] = v
+		}
+	}
+
+	// Ensure data integrity context is
+	// present, as it defines the proof.
+	secured["@context"] = appendContext(
+		secured["@context"],
+		DataIntegrityContext,
+	)
+
+	proof := map[string]interface{}{
+		"@context":           secured["@context"],
+		"type":               ProofTypeDataIntegrity,
+		"cryptosuite":        CryptosuiteEddsaJcs2022,
+		"verificationMethod": keyID,
+		"proofPurpose":       ProofPurposeAssertionMethod,
+		"created":            created.UTC().Format(time.RFC3339),
+	}
+
+	hash, err := integrityHash(proof, secured)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign hashed data and encode as multibase base58btc.
+	proof["proofValue"] = "z" + base58Encode(ed25519.Sign(key, hash))
+	secured["proof"] = proof
+
+	return secured, nil
+}
+
+// ExtractIntegrityProofKeyID returns the verificationMethod
+// of the integrity proof attached to the given document,
+// and whether a proof was attached to the document at all.
+func ExtractIntegrityProofKeyID(doc map[string]interface{}) (string, bool, error) {
+	if _, ok := doc["proof"]; !ok {
+		return "", false, nil
+	}
+
+	proof, err := extractProof(doc)
+	if err != nil {
+		return "", true, err
+	}
+
+	keyID, _ := proof["verificationMethod"].(string)
+	if keyID == "" {
+		return "", true, errors.New("proof verificationMethod not set")
+	}
+
+	return keyID, true, nil
+}
+
+// VerifyIntegrityProof verifies the eddsa-jcs-2022 DataIntegrityProof
+// attached to the given serialized document using the given public key,
+// returning an error if the proof is unsupported, malformed or invalid.
+func VerifyIntegrityProof(doc map[string]interface{}, key ed25519.PublicKey) error {
+	proof, err := extractProof(doc)
+	if err != nil {
+		return err
+	}
+
+	if t, _ := proof["type"].(string); t != ProofTypeDataIntegrity {
+		return fmt.Errorf("unsupported proof type %q", t)
+	}
+
+	if cs, _ := proof["cryptosuite"].(string); cs != CryptosuiteEddsaJcs2022 {
+		return fmt.Errorf("unsupported proof cryptosuite %q", cs)
+	}
+
+	if pp, _ := proof["proofPurpose"].(string); pp != ProofPurposeAssertionMethod {
+		return fmt.Errorf("unexpected proof purpose %q", pp)
+	}
+
+	value, _ := proof["proofValue"].(string)
+	if len(value) < 2 || value[0] != 'z' {
+		return errors.New("proofValue not multibase base58btc encoded")
+	}
+
+	sig, err := base58Decode(value[1:])
+	if err != nil {
+		return fmt.Errorf("error decoding proofValue: %w", err)
+	}
+
+	// Rebuild proof options
+	// sans the proof value.
+	options := make(map[string]interface{}, len(proof))
+	for k, v := range proof {
+		if k != "proofValue" {
+			options[k] = v
+		}
+	}
+
+	// Rebuild unsecured
+	// document sans proof.
+	unsecured := make(map[string]interface{}, len(doc))
+	for k, v := range doc {
+		if k != "proof" {
+			unsecured[k] = v
+		}
+	}
+
+	if c, ok := options["@context"]; ok {
+		// Document is to be verified
+		// using the context of proof.
+		unsecured["@context"] = c
+	}
+
+	hash, err := integrityHash(options, unsecured)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(key, hash, sig) {
+		return errors.New("proof signature invalid")
+	}
+
+	return nil
+}
+
+// EncodeMultikey encodes the given Ed25519
+// public key as a multibase base58btc Multikey
+// value, suitable for publicKeyMultibase.
+func EncodeMultikey(key ed25519.PublicKey) string {
+	b := make([]byte, 0, len(ed25519MulticodecPrefix)+len(key))
+	b = append(b, ed25519MulticodecPrefix...)
+	b = append(b, key...)
+	return "z" + base58Encode(b)
+}
+
+// DecodeMultikey decodes the given multibase
+// base58btc Multikey value as Ed25519 public key.
+func DecodeMultikey(value string) (ed25519.PublicKey, error) {
+	if len(value) < 2 || value[0] != 'z' {
+		return nil, errors.New("value not multibase base58btc encoded")
+	}
+
+	b, err := base58Decode(value[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(b, ed25519MulticodecPrefix) {
+		return nil, errors.New("value not an ed25519 public key")
+	}
+
+	b = b[len(ed25519MulticodecPrefix):]
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length %d", len(b))
+	}
+
+	return ed25519.PublicKey(b), nil
+}
+
+// AppendAssertionMethod adds the given Ed25519 public key to
+// the serialized actor as a Multikey within assertionMethod,
+// as per FEP-521a, so that remotes can verify integrity proofs.
+func AppendAssertionMethod(
+	actor map[string]interface{},
+	keyID string,
+	key ed25519.PublicKey,
+) {
+	actor["@context"] = appendContext(
+		actor["@context"],
+		MultikeyContext,
+	)
+
+	actor["assertionMethod"] = []interface{}{
+		map[string]interface{}{
+			"id":                 keyID,
+			"type":               KeyTypeMultikey,
+			"controller":         actor["id"],
+			"publicKeyMultibase": EncodeMultikey(key),
+		},
+	}
+}
+
+// ExtractAssertionMethod looks for the Multikey with given
+// keyID within the given serialized document, which may
+// either be an actor with assertionMethod, or a bare Multikey.
+// It returns the key itself, and the URI of its controller.
+func ExtractAssertionMethod(
+	doc map[string]interface{},
+	keyID string,
+) (ed25519.PublicKey, string, error) {
+	var method map[string]interface{}
+
+	if id, _ := doc["id"].(string); id == keyID {
+		// Bare key.
+		method = doc
+	} else {
+		// Search through actor methods.
+		var methods []interface{}
+		switch am := doc["assertionMethod"].(type) {
+		case []interface{}:
+			methods = am
+		case map[string]interface{}:
+			methods = []interface{}{am}
+		}
+
+		for _, m := range methods {
+			m, _ := m.(map[string]interface{})
+			if id, _ := m["id"].(string); id == keyID {
+				method = m
+				break
+			}
+		}
+	}
+
+	if method == nil {
+		return nil, "", fmt.Errorf("no assertion method found with id %s", keyID)
+	}
+
+	if t, _ := method["type"].(string); t != KeyTypeMultikey {
+		return nil, "", fmt.Errorf("unsupported assertion method type %q", t)
+	}
+
+	controller, _ := method["controller"].(string)
+	if controller == "" {
+		return nil, "", errors.New("assertion method controller not set")
+	}
+
+	multibase, _ := method["publicKeyMultibase"].(string)
+	key, err := DecodeMultikey(multibase)
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding publicKeyMultibase: %w", err)
+	}
+
+	return key, controller, nil
+}
+
+// extractProof returns the single proof object attached to document.
+func extractProof(doc map[string]interface{}) (map[string]interface{}, error) {
+	switch p := doc["proof"].(type) {
+	case map[string]interface{}:
+		return p, nil
+	case []interface{}:
+		if len(p) == 1 {
+			if proof, ok := p[0].(map[string]interface{}); ok {
+				return proof, nil
+			}
+		}
+		return nil, errors.New("multiple or malformed proofs not supported")
+	default:
+		return nil, errors.New("proof not set or malformed")
+	}
+}
+
+// integrityHash returns the data to be signed or verified for the
+// eddsa-jcs-2022 cryptosuite: hash of canonicalized proof options,
+// followed by hash of the canonicalized (unsecured) document.
+func integrityHash(options, doc map[string]interface{}) ([]byte, error) {
+	o, err := canonicalize(options)
+	if err != nil {
+		return nil, fmt.Errorf("error canonicalizing proof options: %w", err)
+	}
+
+	d, err := canonicalize(doc)
+	if err != nil {
+		return nil, fmt.Errorf("error canonicalizing document: %w", err)
+	}
+
+	oHash := sha256.Sum256(o)
+	dHash := sha256.Sum256(d)
+	return append(oHash[:], dHash[:]...), nil
+}
+
+// appendContext returns the given JSON-LD @context
+// value with the context IRI appended, if not present.
+func appendContext(context interface{}, iri string) interface{} {
+	var contexts []interface{}
+
+	switch c := context.(type) {
+	case nil:
+		return iri
+	case []interface{}:
+		contexts = make([]interface{}, 0, len(c)+1)
+		contexts = append(contexts, c...)
+	default:
+		contexts = []interface{}{c}
+	}
+
+	for _, c := range contexts {
+		if c == iri {
+			return context
+		}
+	}
+
+	return append(contexts, iri)
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var bigRadix = big.NewInt(58)
+
+// base58Encode encodes b using the bitcoin base58 alphabet.
+func base58Encode(b []byte) string {
+	x := new(big.Int).SetBytes(b)
+	mod := new(big.Int)
+
+	var out []byte
+	for x.Sign() > 0 {
+		x.DivMod(x, bigRadix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// Leading zero bytes are
+	// encoded as leading '1's.
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+
+	// Reverse into big-endian order.
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+// base58Decode decodes s using the bitcoin base58 alphabet.
+func base58Decode(s string) ([]byte, error) {
+	x := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		idx := bytes.IndexByte([]byte(base58Alphabet), s[i])
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", s[i])
+		}
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(idx)))
+	}
+
+	// Restore leading zero
+	// bytes from leading '1's.
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), x.Bytes()...), nil
+}
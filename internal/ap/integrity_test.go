@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type IntegrityTestSuite struct {
+	suite.Suite
+}
+
+func (suite *IntegrityTestSuite) newDoc() map[string]interface{} {
+	return map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       "https://example.org/users/1/statuses/1/activity",
+		"type":     "Create",
+		"actor":    "https://example.org/users/1",
+		"object": map[string]interface{}{
+			"id":      "https://example.org/users/1/statuses/1",
+			"type":    "Note",
+			"content": "<p>hello & welcome, élève</p>",
+			"to":      []interface{}{"https://www.w3.org/ns/activitystreams#Public"},
+		},
+	}
+}
+
+func (suite *IntegrityTestSuite) TestProofRoundTrip() {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	const keyID = "https://example.org/users/1#ed25519-key"
+
+	doc := suite.newDoc()
+	secured, err := ap.AddIntegrityProof(doc, priv, keyID, time.Now())
+	suite.NoError(err)
+
+	// Original should be untouched.
+	suite.NotContains(doc, "proof")
+	suite.Equal("https://www.w3.org/ns/activitystreams", doc["@context"])
+
+	// Context should have been extended.
+	suite.Equal([]interface{}{
+		"https://www.w3.org/ns/activitystreams",
+		ap.DataIntegrityContext,
+	}, secured["@context"])
+
+	gotKeyID, ok, err := ap.ExtractIntegrityProofKeyID(secured)
+	suite.NoError(err)
+	suite.True(ok)
+	suite.Equal(keyID, gotKeyID)
+
+	// Verify secured doc after a trip through JSON,
+	// as it would arrive at the receiving instance.
+	secured = suite.jsonRoundTrip(secured)
+	suite.NoError(ap.VerifyIntegrityProof(secured, pub))
+
+	// Tampering with the document should invalidate proof.
+	secured["object"].(map[string]interface{})["content"] = "<p>goodbye</p>"
+	suite.EqualError(ap.VerifyIntegrityProof(secured, pub), "proof signature invalid")
+
+	// As should verifying with a different key.
+	secured, err = ap.AddIntegrityProof(doc, priv, keyID, time.Now())
+	suite.NoError(err)
+	secured = suite.jsonRoundTrip(secured)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+	suite.EqualError(ap.VerifyIntegrityProof(secured, otherPub), "proof signature invalid")
+}
+
+func (suite *IntegrityTestSuite) TestNoProof() {
+	_, ok, err := ap.ExtractIntegrityProofKeyID(suite.newDoc())
+	suite.NoError(err)
+	suite.False(ok)
+}
+
+func (suite *IntegrityTestSuite) TestMultikeyKnownKey() {
+	// Test vector taken from the W3C
+	// Data Integrity EdDSA Cryptosuites spec.
+	seed, err := hex.DecodeString("c96ef9ea10c5e414c471723aff9de72c35fa5b70fae97e8832ecac7d2e2b8ed6")
+	suite.NoError(err)
+
+	pub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+	suite.Equal("z6MkrJVnaZkeFzdQyMZu1cgjg7k1pZZ6pvBQ7XJPt4swbTQ2", ap.EncodeMultikey(pub))
+}
+
+func (suite *IntegrityTestSuite) TestMultikeyRoundTrip() {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	// Ed25519 multikeys always have this prefix.
+	multikey := ap.EncodeMultikey(pub)
+	suite.Equal("z6Mk", multikey[:4])
+
+	decoded, err := ap.DecodeMultikey(multikey)
+	suite.NoError(err)
+	suite.Equal(pub, decoded)
+}
+
+func (suite *IntegrityTestSuite) TestAssertionMethodRoundTrip() {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	const keyID = "https://example.org/users/1#ed25519-key"
+
+	actor := map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       "https://example.org/users/1",
+		"type":     "Person",
+	}
+	ap.AppendAssertionMethod(actor, keyID, pub)
+	actor = suite.jsonRoundTrip(actor)
+
+	key, controller, err := ap.ExtractAssertionMethod(actor, keyID)
+	suite.NoError(err)
+	suite.Equal(pub, key)
+	suite.Equal("https://example.org/users/1", controller)
+
+	_, _, err = ap.ExtractAssertionMethod(actor, "https://example.org/users/1#main-key")
+	suite.Error(err)
+}
+
+func (suite *IntegrityTestSuite) jsonRoundTrip(m map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(m)
+	suite.NoError(err)
+
+	out := make(map[string]interface{})
+	suite.NoError(json.Unmarshal(b, &out))
+	return out
+}
+
+func TestIntegrityTestSuite(t *testing.T) {
+	suite.Run(t, &IntegrityTestSuite{})
+}
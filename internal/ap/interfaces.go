@@ -294,6 +294,16 @@ type Likeable interface {
 	WithObject
 }
 
+// Reactable represents the minimum interface for an activitystreams
+// 'like' activity that carries an emoji reaction as its content.
+type Reactable interface {
+	Likeable
+
+	WithContent
+	WithTag
+	WithUnknownProperties
+}
+
 // Blockable represents the minimum interface for an activitystreams 'block' activity.
 type Blockable interface {
 	WithJSONLDId
@@ -367,6 +377,7 @@ type Flaggable interface {
 	WithActor
 	WithContent
 	WithObject
+	WithUnknownProperties
 }
 
 // TypeOrIRI represents the minimum interface for something that may be a vocab.Type OR IRI.
@@ -578,6 +589,18 @@ type WithReplies interface {
 	SetActivityStreamsReplies(vocab.ActivityStreamsRepliesProperty)
 }
 
+// WithLikes represents an object with ActivityStreamsLikesProperty
+type WithLikes interface {
+	GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty
+	SetActivityStreamsLikes(vocab.ActivityStreamsLikesProperty)
+}
+
+// WithShares represents an object with ActivityStreamsSharesProperty
+type WithShares interface {
+	GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty
+	SetActivityStreamsShares(vocab.ActivityStreamsSharesProperty)
+}
+
 // WithMediaType represents an activity with ActivityStreamsMediaTypeProperty
 type WithMediaType interface {
 	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
@@ -701,3 +724,9 @@ type WithApprovedBy interface {
 	GetGoToSocialApprovedBy() vocab.GoToSocialApprovedByProperty
 	SetGoToSocialApprovedBy(vocab.GoToSocialApprovedByProperty)
 }
+
+// WithUnknownProperties represents an activity with properties
+// not (yet) covered by the generated ActivityStreams vocabulary.
+type WithUnknownProperties interface {
+	GetUnknownProperties() map[string]interface{}
+}
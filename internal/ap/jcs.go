@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"unicode/utf16"
+)
+
+// canonicalize serializes the given value as JSON as per
+// the JSON Canonicalization Scheme (RFC 8785): object keys
+// sorted by UTF-16 code units, numbers in ES6 form, and
+// strings with only the minimal required escaping.
+func canonicalize(v interface{}) ([]byte, error) {
+	// Round-trip through the stdlib encoder
+	// so that we're only dealing with generic
+	// JSON values, keeping numbers as-is.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	dec := json.NewDecoder(&buf)
+	dec.UseNumber()
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, buf.Cap())
+	return appendCanonical(out, generic)
+}
+
+// appendCanonical appends the JCS serialization
+// of generic JSON value v to b, returning result.
+func appendCanonical(b []byte, v interface{}) ([]byte, error) {
+	var err error
+
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...), nil
+
+	case bool:
+		return strconv.AppendBool(b, v), nil
+
+	case json.Number:
+		return appendCanonicalNumber(b, v)
+
+	case string:
+		return appendCanonicalString(b, v), nil
+
+	case []interface{}:
+		b = append(b, '[')
+		for i, elem := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b, err = appendCanonical(b, elem)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+
+	case map[string]interface{}:
+		// Keys are sorted by
+		// their UTF-16 code units.
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.SortFunc(keys, func(a, b string) int {
+			return slices.Compare(
+				utf16.Encode([]rune(a)),
+				utf16.Encode([]rune(b)),
+			)
+		})
+
+		b = append(b, '{')
+		for i, key := range keys {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendCanonicalString(b, key)
+			b = append(b, ':')
+			b, err = appendCanonical(b, v[key])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return append(b, '}'), nil
+
+	default:
+		return nil, fmt.Errorf("unexpected json type %T", v)
+	}
+}
+
+// appendCanonicalNumber appends number n to b
+// serialized as an IEEE 754 double in ES6 form.
+func appendCanonicalNumber(b []byte, n json.Number) ([]byte, error) {
+	f, err := strconv.ParseFloat(n.String(), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %s: %w", n, err)
+	}
+
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("invalid number %s", n)
+	}
+
+	if f == 0 {
+		// Normalize -0.
+		return append(b, '0'), nil
+	}
+
+	// Same as ES6 Number.toString(): shortest
+	// round-tripping digits, in exponent form
+	// outside of the range [1e-6, 1e21).
+	format := byte('f')
+	if abs := math.Abs(f); abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+
+	return b, nil
+}
+
+// appendCanonicalString appends string s to b, quoted and
+// escaped as per JCS: only '"', '\\' and control characters
+// are escaped, using short forms where JSON defines them.
+func appendCanonicalString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\\':
+			b = append(b, '\\', c)
+		case '\b':
+			b = append(b, '\\', 'b')
+		case '\t':
+			b = append(b, '\\', 't')
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\f':
+			b = append(b, '\\', 'f')
+		case '\r':
+			b = append(b, '\\', 'r')
+		default:
+			if c < 0x20 {
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				b = append(b, c)
+			}
+		}
+	}
+	return append(b, '"')
+}
@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type JCSTestSuite struct {
+	suite.Suite
+}
+
+func (suite *JCSTestSuite) canonicalizeJSON(in string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(in), &v); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, err := canonicalize(v)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return string(b)
+}
+
+func (suite *JCSTestSuite) TestCanonicalizeRFC8785Sample() {
+	// RFC 8785 section 3.2.2.
+	in := `{
+  "numbers": [333333333.33333329, 1E30, 4.50,
+              2e-3, 0.000000000000000000000000001],
+  "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+  "literals": [null, true, false]
+}`
+	expect := `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`
+	suite.Equal(expect, suite.canonicalizeJSON(in))
+}
+
+func (suite *JCSTestSuite) TestCanonicalizeRFC8785Sorting() {
+	// RFC 8785 section 3.2.3.
+	in := `{
+  "\u20ac": "Euro Sign",
+  "\r": "Carriage Return",
+  "\ufb33": "Hebrew Letter Dalet With Dagesh",
+  "1": "One",
+  "\ud83d\ude00": "Emoji: Grinning Face",
+  "\u0080": "Control",
+  "\u00f6": "Latin Small Letter O With Diaeresis"
+}`
+	expect := "{" +
+		`"\r":"Carriage Return",` +
+		`"1":"One",` +
+		"\"\u0080\":\"Control\"," +
+		"\"\u00f6\":\"Latin Small Letter O With Diaeresis\"," +
+		"\"\u20ac\":\"Euro Sign\"," +
+		"\"\U0001f600\":\"Emoji: Grinning Face\"," +
+		"\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"" +
+		"}"
+	suite.Equal(expect, suite.canonicalizeJSON(in))
+}
+
+func (suite *JCSTestSuite) TestCanonicalizeRFC8785Numbers() {
+	// RFC 8785 appendix B.
+	for _, test := range []struct {
+		bits   uint64
+		expect string
+	}{
+		{0x0000000000000000, "0"},
+		{0x8000000000000000, "0"},
+		{0x0000000000000001, "5e-324"},
+		{0x8000000000000001, "-5e-324"},
+		{0x7fefffffffffffff, "1.7976931348623157e+308"},
+		{0xffefffffffffffff, "-1.7976931348623157e+308"},
+		{0x4340000000000000, "9007199254740992"},
+		{0xc340000000000000, "-9007199254740992"},
+		{0x4430000000000000, "295147905179352830000"},
+		{0x44b52d02c7e14af5, "9.999999999999997e+22"},
+		{0x44b52d02c7e14af6, "1e+23"},
+		{0x44b52d02c7e14af7, "1.0000000000000001e+23"},
+		{0x444b1ae4d6e2ef4e, "999999999999999700000"},
+		{0x444b1ae4d6e2ef4f, "999999999999999900000"},
+		{0x444b1ae4d6e2ef50, "1e+21"},
+		{0x3eb0c6f7a0b5ed8c, "9.999999999999997e-7"},
+		{0x3eb0c6f7a0b5ed8d, "0.000001"},
+		{0x41b3de4355555553, "333333333.3333332"},
+		{0x41b3de4355555554, "333333333.33333325"},
+		{0x41b3de4355555555, "333333333.3333333"},
+		{0x41b3de4355555556, "333333333.3333334"},
+		{0x41b3de4355555557, "333333333.33333343"},
+		{0xbecbf647612f3696, "-0.0000033333333333333333"},
+		{0x43143ff3c1cb0959, "1424953923781206.2"},
+	} {
+		f := math.Float64frombits(test.bits)
+		b, err := canonicalize(f)
+		suite.NoError(err)
+		suite.Equal(test.expect, string(b), "bits %016x", test.bits)
+	}
+}
+
+func (suite *JCSTestSuite) TestCanonicalizeStringEscaping() {
+	in := map[string]interface{}{
+		"s": "<a href=\"x\">&</a>  \x7f\x01\b\t\n\f\r\\",
+	}
+	expect := `{"s":"<a href=\"x\">&</a>` + "  \x7f" + `\u0001\b\t\n\f\r\\"}`
+
+	b, err := canonicalize(in)
+	suite.NoError(err)
+	suite.Equal(expect, string(b))
+}
+
+func TestJCSTestSuite(t *testing.T) {
+	suite.Run(t, new(JCSTestSuite))
+}
@@ -18,6 +18,8 @@
 package ap
 
 import (
+	"net/url"
+
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -74,6 +76,7 @@ func NormalizeIncomingActivity(activity pub.Activity, rawJSON map[string]interfa
 			NormalizeIncomingAttachments(statusable, rawData)
 			NormalizeIncomingSummary(statusable, rawData)
 			NormalizeIncomingName(statusable, rawData)
+			NormalizeIncomingQuote(statusable, rawData)
 			continue
 		}
 
@@ -435,6 +438,96 @@ func NormalizeIncomingPollOptions(item WithOneOf, rawJSON map[string]interface{}
 	}
 }
 
+// quoteURIKeys are the raw json keys used by Misskey,
+// its forks (eg., Firefish), and Akkoma to indicate the
+// URI of a quoted status, in order of preference.
+var quoteURIKeys = []string{
+	"quoteUrl",
+	"quoteUri",
+	"_misskey_quote",
+}
+
+// NormalizeIncomingQuote appends an FEP-e232 quote Link
+// to the tags of the given item, derived from the raw
+// 'quoteUrl', 'quoteUri' or '_misskey_quote' value in
+// the raw json object map, so that quotes can be
+// extracted consistently via ExtractQuoteURI.
+//
+// noop if there was no usable quote URI in the json object
+// map, or if the item already contains an object link tag.
+func NormalizeIncomingQuote(item WithTag, rawJSON map[string]interface{}) {
+	if ExtractQuoteURI(item) != nil {
+		// Already has a quote
+		// link, nothing to do.
+		return
+	}
+
+	for _, key := range quoteURIKeys {
+		rawQuoteURI, ok := rawJSON[key].(string)
+		if !ok || rawQuoteURI == "" {
+			// Not set or not
+			// a plain string.
+			continue
+		}
+
+		quoteURI, err := url.Parse(rawQuoteURI)
+		if err != nil || quoteURI.Scheme == "" || quoteURI.Host == "" {
+			// Not a usable
+			// absolute URI.
+			continue
+		}
+
+		AppendQuoteLink(item, quoteURI)
+		return
+	}
+}
+
+// NormalizeIncomingReaction rewrites a Pleroma-style EmojiReact
+// in the given raw json object map into a Like carrying the reaction
+// emoji as its content, since EmojiReact isn't a type we can resolve.
+// A Misskey-style Like with '_misskey_reaction' set but no 'content'
+// has the reaction copied into 'content', so that both can be handled
+// the same way via ExtractReaction. The same is done for an activity
+// embedded as the 'object' of the given one, ie., in an Undo.
+//
+// Unlike the other incoming normalization functions, this must be
+// called on the raw json object map *before* resolving it as a type.
+func NormalizeIncomingReaction(rawJSON map[string]interface{}) {
+	normalizeReaction(rawJSON)
+
+	if rawObject, ok := rawJSON["object"].(map[string]interface{}); ok {
+		normalizeReaction(rawObject)
+	}
+}
+
+// normalizeReaction does the work of
+// NormalizeIncomingReaction for one object.
+func normalizeReaction(rawJSON map[string]interface{}) {
+	switch rawJSON["type"] {
+	case ActivityEmojiReact:
+		// Resolve as a Like instead.
+		rawJSON["type"] = ActivityLike
+
+	case ActivityLike:
+		// Might be a Misskey reaction.
+
+	default:
+		// Not a reaction.
+		return
+	}
+
+	if _, ok := rawJSON["content"]; ok {
+		// Content already set,
+		// nothing more to do.
+		return
+	}
+
+	reaction, ok := rawJSON[MisskeyReactionKey].(string)
+	if ok && reaction != "" {
+		rawJSON["content"] = reaction
+	}
+}
+
 /*
 	OUTGOING NORMALIZATION
 	The below functions should be called to normalize the content
@@ -676,6 +769,21 @@ func NormalizeOutgoingInteractionPolicyProp(item WithInteractionPolicy, rawJSON
 	}
 }
 
+// NormalizeOutgoingQuoteProp sets the 'quoteUrl' property in the rawJSON
+// of the given item to the href of its FEP-e232 quote link (if any), for
+// compatibility with implementations that don't parse quote link tags.
+//
+// Noop for items without a quote link.
+func NormalizeOutgoingQuoteProp(item WithTag, rawJSON map[string]interface{}) {
+	quoteURI := ExtractQuoteURI(item)
+	if quoteURI == nil {
+		// Nothing to do.
+		return
+	}
+
+	rawJSON["quoteUrl"] = quoteURI.String()
+}
+
 // NormalizeOutgoingObjectProp normalizes each Object entry in the rawJSON of the given
 // item by calling custom serialization / normalization functions on them in turn.
 //
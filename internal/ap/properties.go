@@ -541,6 +541,66 @@ func SetApprovedBy(with WithApprovedBy, approvedBy *url.URL) {
 	abProp.Set(approvedBy)
 }
 
+// AppendQuoteLink appends an FEP-e232 object Link pointing
+// to the given quoted status URI to the Tag property of 'with'.
+// The link is additionally marked with the Misskey quote rel,
+// and named with a plaintext "RE: " fallback for the quote.
+func AppendQuoteLink(with WithTag, quoteURI *url.URL) {
+	tagProp := with.GetActivityStreamsTag()
+	if tagProp == nil {
+		tagProp = streams.NewActivityStreamsTagProperty()
+		with.SetActivityStreamsTag(tagProp)
+	}
+
+	link := streams.NewActivityStreamsLink()
+
+	hrefProp := streams.NewActivityStreamsHrefProperty()
+	hrefProp.Set(quoteURI)
+	link.SetActivityStreamsHref(hrefProp)
+
+	mediaTypeProp := streams.NewActivityStreamsMediaTypeProperty()
+	mediaTypeProp.Set(LinkMediaTypeObject)
+	link.SetActivityStreamsMediaType(mediaTypeProp)
+
+	relProp := streams.NewActivityStreamsRelProperty()
+	relProp.AppendRFCRfc5988(LinkRelQuote)
+	link.SetActivityStreamsRel(relProp)
+
+	nameProp := streams.NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString("RE: " + quoteURI.String())
+	link.SetActivityStreamsName(nameProp)
+
+	tagProp.AppendActivityStreamsLink(link)
+}
+
+// GetFlagCategory returns the string value of
+// the non-standard category property of 'with',
+// if set, else an empty string.
+func GetFlagCategory(with WithUnknownProperties) string {
+	category, _ := with.GetUnknownProperties()[FlagCategoryKey].(string)
+	return category
+}
+
+// SetFlagCategory sets the given string on the
+// non-standard category property of 'with'. As the
+// category isn't part of the generated vocabulary,
+// it's stored with the unknown properties of 'with',
+// which are included as-is on serialization.
+func SetFlagCategory(with WithUnknownProperties, category string) {
+	with.GetUnknownProperties()[FlagCategoryKey] = category
+}
+
+// SetReaction sets the given emoji reaction as the content
+// of 'with', as well as on the non-standard Misskey reaction
+// property, for compatibility with implementations that only
+// look at the latter.
+func SetReaction(with Reactable, reaction string) {
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(reaction)
+	with.SetActivityStreamsContent(contentProp)
+	with.GetUnknownProperties()[MisskeyReactionKey] = reaction
+}
+
 // extractIRIs extracts just the AP IRIs from an iterable
 // property that may contain types (with IRIs) or just IRIs.
 //
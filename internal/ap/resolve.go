@@ -25,12 +25,17 @@
 	"io"
 	"net/http"
 
+	"codeberg.org/gruf/go-bytesize"
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
 
+// MaxIncomingBodySize is the maximum size in bytes of
+// an incoming ActivityPub request body we'll read.
+const MaxIncomingBodySize = int64(1 * bytesize.MiB)
+
 // ResolveActivity is a util function for pulling a pub.Activity type out of an incoming request body,
 // returning the resolved activity type, error and whether to accept activity (false = transient i.e. ignore).
 func ResolveIncomingActivity(r *http.Request) (pub.Activity, bool, gtserror.WithCode) {
@@ -52,6 +57,12 @@ func ResolveIncomingActivity(r *http.Request) (pub.Activity, bool, gtserror.With
 		// error is mainly for our logging. tl;dr there's not a
 		// huge need to differentiate between those error types.
 
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			const text = "body exceeds maximum size"
+			return nil, false, gtserror.NewErrorRequestEntityTooLarge(err, text)
+		}
+
 		if !streams.IsUnmatchedErr(err) {
 			err := gtserror.Newf("error matching json to type: %w", err)
 			return nil, false, gtserror.NewErrorInternalError(err)
@@ -84,6 +95,50 @@ func ResolveIncomingActivity(r *http.Request) (pub.Activity, bool, gtserror.With
 	return activity, true, nil
 }
 
+// ResolveOutboxActivity is a util function for pulling an ActivityStreams
+// type out of a request body POSTed to an actor's outbox by a client, as
+// per the ActivityPub client-to-server protocol. Unlike incoming federated
+// activities, the result may be either an Activity or a bare Object (which
+// the caller should treat as wrapped in a Create), and it is not expected
+// to have an ID yet, as that is to be assigned by us.
+func ResolveOutboxActivity(r *http.Request) (vocab.Type, gtserror.WithCode) {
+	// Get "raw" map
+	// destination.
+	raw := getMap()
+	// Release.
+	defer putMap(raw)
+
+	// Decode data as JSON into 'raw' map
+	// and get the resolved AS vocab.Type.
+	// (this handles close of request body).
+	t, err := decodeType(r.Context(), r.Body, raw)
+	if err != nil {
+		if !streams.IsUnmatchedErr(err) {
+			err := gtserror.Newf("error matching json to type: %w", err)
+			return nil, gtserror.NewErrorBadRequest(err, "body json not valid")
+		}
+
+		const text = "body json not resolvable as ActivityStreams type"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if activity, ok := t.(pub.Activity); ok {
+		// Normalize any Statusable fields of the object(s).
+		NormalizeIncomingActivity(activity, raw)
+		return activity, nil
+	}
+
+	if statusable, ok := ToStatusable(t); ok {
+		// Normalize the bare Statusable.
+		NormalizeIncomingContent(statusable, raw)
+		NormalizeIncomingSummary(statusable, raw)
+		return statusable, nil
+	}
+
+	text := fmt.Sprintf("cannot resolve vocab type %T as activity or object", t)
+	return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+}
+
 // ResolveStatusable tries to resolve the response data as an ActivityPub
 // Statusable representation. It will then perform normalization on the Statusable.
 //
@@ -110,6 +165,45 @@ func ResolveStatusable(ctx context.Context, body io.ReadCloser) (Statusable, err
 		return nil, gtserror.SetWrongType(err)
 	}
 
+	return normalizeStatusable(statusable, raw), nil
+}
+
+// ResolveStatusableOrActivityable tries to resolve the response data as
+// either an ActivityPub Statusable representation, normalized as in
+// ResolveStatusable(), or an Activityable, eg. a Create wrapping a status.
+// Exactly one of the returned values will be set on success.
+func ResolveStatusableOrActivityable(ctx context.Context, body io.ReadCloser) (Statusable, Activityable, error) {
+	// Get "raw" map
+	// destination.
+	raw := getMap()
+	// Release.
+	defer putMap(raw)
+
+	// Decode data as JSON into 'raw' map
+	// and get the resolved AS vocab.Type.
+	// (this handles close of given body).
+	t, err := decodeType(ctx, body, raw)
+	if err != nil {
+		return nil, nil, gtserror.SetWrongType(err)
+	}
+
+	// Attempt to cast as Statusable.
+	if statusable, ok := ToStatusable(t); ok {
+		return normalizeStatusable(statusable, raw), nil, nil
+	}
+
+	// Else attempt to cast as activityable.
+	if activityable, ok := ToActivityable(t); ok {
+		return nil, activityable, nil
+	}
+
+	err = gtserror.Newf("cannot resolve vocab type %T as statusable or activityable", t)
+	return nil, nil, gtserror.SetWrongType(err)
+}
+
+// normalizeStatusable performs normalization on the
+// given Statusable, resolved from the given raw data.
+func normalizeStatusable(statusable Statusable, raw map[string]any) Statusable {
 	if pollable, ok := ToPollable(statusable); ok {
 		// Question requires extra normalization, and
 		// fortunately directly implements Statusable.
@@ -121,8 +215,9 @@ func ResolveStatusable(ctx context.Context, body io.ReadCloser) (Statusable, err
 	NormalizeIncomingAttachments(statusable, raw)
 	NormalizeIncomingSummary(statusable, raw)
 	NormalizeIncomingName(statusable, raw)
+	NormalizeIncomingQuote(statusable, raw)
 
-	return statusable, nil
+	return statusable
 }
 
 // ResolveAccountable tries to resolve the given reader into an ActivityPub
@@ -228,6 +323,36 @@ func ResolveAcceptable(
 	return acceptable, nil
 }
 
+// ResolveActivityable tries to resolve the given reader
+// into an ActivityStreams Activityable representation.
+func ResolveActivityable(
+	ctx context.Context,
+	body io.ReadCloser,
+) (Activityable, error) {
+	// Get "raw" map
+	// destination.
+	raw := getMap()
+	// Release.
+	defer putMap(raw)
+
+	// Decode data as JSON into 'raw' map
+	// and get the resolved AS vocab.Type.
+	// (this handles close of given body).
+	t, err := decodeType(ctx, body, raw)
+	if err != nil {
+		return nil, gtserror.SetWrongType(err)
+	}
+
+	// Attempt to cast as activityable.
+	activityable, ok := ToActivityable(t)
+	if !ok {
+		err := gtserror.Newf("cannot resolve vocab type %T as activityable", t)
+		return nil, gtserror.SetWrongType(err)
+	}
+
+	return activityable, nil
+}
+
 // emptydest is an empty JSON decode
 // destination useful for "noop" decodes
 // to check underlying reader is empty.
@@ -276,6 +401,10 @@ func decodeType(
 	// Done with body.
 	_ = body.Close()
 
+	// Rewrite emoji reactions to something
+	// we can resolve into an AS vocab.Type.
+	NormalizeIncomingReaction(raw)
+
 	// Resolve an ActivityStreams type.
 	t, err := streams.ToType(ctx, raw)
 	if err != nil {
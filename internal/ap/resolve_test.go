@@ -80,6 +80,50 @@ func (suite *ResolveTestSuite) TestResolveNonAPJSONAsAccountable() {
 	suite.Nil(accountable)
 }
 
+func (suite *ResolveTestSuite) TestResolveDocumentAsStatusableOrActivityable() {
+	b := []byte(suite.typeToJson(suite.document1))
+
+	statusable, activityable, err := ap.ResolveStatusableOrActivityable(
+		context.Background(), io.NopCloser(bytes.NewReader(b)),
+	)
+	suite.NoError(err)
+	suite.NotNil(statusable)
+	suite.Nil(activityable)
+}
+
+func (suite *ResolveTestSuite) TestResolveCreateAsStatusableOrActivityable() {
+	b := []byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/1/activity",
+  "type": "Create",
+  "actor": "https://example.org/users/someone",
+  "object": "https://example.org/users/someone/statuses/1"
+}`)
+
+	statusable, activityable, err := ap.ResolveStatusableOrActivityable(
+		context.Background(), io.NopCloser(bytes.NewReader(b)),
+	)
+	suite.NoError(err)
+	suite.Nil(statusable)
+	suite.Equal(ap.ActivityCreate, activityable.GetTypeName())
+}
+
+func (suite *ResolveTestSuite) TestResolvePersonAsStatusableOrActivityable() {
+	b := []byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone",
+  "type": "Person"
+}`)
+
+	statusable, activityable, err := ap.ResolveStatusableOrActivityable(
+		context.Background(), io.NopCloser(bytes.NewReader(b)),
+	)
+	suite.True(gtserror.IsWrongType(err))
+	suite.EqualError(err, "ResolveStatusableOrActivityable: cannot resolve vocab type *typeperson.ActivityStreamsPerson as statusable or activityable")
+	suite.Nil(statusable)
+	suite.Nil(activityable)
+}
+
 func TestResolveTestSuite(t *testing.T) {
 	suite.Run(t, &ResolveTestSuite{})
 }
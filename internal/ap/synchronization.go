@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// CollectionSynchronizationHeader is the http header used
+// to advertise the state of an actor's followers collection
+// alongside deliveries, as described in FEP-8fcf:
+// https://codeberg.org/fediverse/fep/src/branch/main/fep/8fcf/fep-8fcf.md
+const CollectionSynchronizationHeader = "Collection-Synchronization"
+
+// CollectionSynchronization models the
+// value of a Collection-Synchronization header.
+type CollectionSynchronization struct {
+	// ID of the synchronized (followers) collection.
+	CollectionID string
+
+	// URL of the partial followers collection, containing
+	// only followers on the receiving instance's domain.
+	URL string
+
+	// Digest of the partial followers collection.
+	Digest string
+}
+
+// String formats the CollectionSynchronization
+// for use as a Collection-Synchronization header value.
+func (s CollectionSynchronization) String() string {
+	return "collectionId=" + strconv.Quote(s.CollectionID) +
+		", url=" + strconv.Quote(s.URL) +
+		", digest=" + strconv.Quote(s.Digest)
+}
+
+// ParseCollectionSynchronization parses the
+// value of a Collection-Synchronization header.
+func ParseCollectionSynchronization(value string) (CollectionSynchronization, error) {
+	var sync CollectionSynchronization
+
+	for _, param := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return sync, errors.New("malformed collection synchronization parameter")
+		}
+
+		// Values are always quoted.
+		val, err := strconv.Unquote(val)
+		if err != nil {
+			return sync, errors.New("unquoted collection synchronization value")
+		}
+
+		switch key {
+		case "collectionId":
+			sync.CollectionID = val
+		case "url":
+			sync.URL = val
+		case "digest":
+			sync.Digest = val
+		}
+	}
+
+	if sync.CollectionID == "" || sync.URL == "" || sync.Digest == "" {
+		return sync, errors.New("missing collection synchronization parameter")
+	}
+
+	return sync, nil
+}
+
+// FollowersDigest returns the digest of a partial followers
+// collection containing the given account URIs: the hex
+// encoding of the XOR of the SHA256 sums of each URI.
+func FollowersDigest(uris []string) string {
+	var digest [sha256.Size]byte
+	for _, uri := range uris {
+		sum := sha256.Sum256([]byte(uri))
+		for i := range digest {
+			digest[i] ^= sum[i]
+		}
+	}
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type SynchronizationTestSuite struct {
+	suite.Suite
+}
+
+func (suite *SynchronizationTestSuite) TestFollowersDigest() {
+	// Digest of nothing is all zeroes.
+	suite.Equal(
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		ap.FollowersDigest(nil),
+	)
+
+	// Digest is independent of order.
+	a := ap.FollowersDigest([]string{
+		"https://example.org/users/1",
+		"https://example.org/users/2",
+	})
+	b := ap.FollowersDigest([]string{
+		"https://example.org/users/2",
+		"https://example.org/users/1",
+	})
+	suite.Equal(a, b)
+
+	// Digest of a single URI is its SHA256 sum.
+	suite.Equal(
+		"55189e5df599541cd135e17a22c18cdc953aafabaa28876d892a33fc4c82267e",
+		ap.FollowersDigest([]string{"https://example.org/users/1"}),
+	)
+}
+
+func (suite *SynchronizationTestSuite) TestCollectionSynchronizationRoundTrip() {
+	sync := ap.CollectionSynchronization{
+		CollectionID: "https://example.org/users/1/followers",
+		URL:          "https://example.org/users/1/followers_synchronization",
+		Digest:       "55189e5df599541cd135e17a22c18cdc953aafabaa28876d892a33fc4c82267e",
+	}
+
+	value := sync.String()
+	suite.Equal(
+		`collectionId="https://example.org/users/1/followers", `+
+			`url="https://example.org/users/1/followers_synchronization", `+
+			`digest="55189e5df599541cd135e17a22c18cdc953aafabaa28876d892a33fc4c82267e"`,
+		value,
+	)
+
+	parsed, err := ap.ParseCollectionSynchronization(value)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(sync, parsed)
+}
+
+func (suite *SynchronizationTestSuite) TestParseCollectionSynchronizationMissing() {
+	_, err := ap.ParseCollectionSynchronization(`collectionId="https://example.org/users/1/followers"`)
+	suite.Error(err)
+
+	_, err = ap.ParseCollectionSynchronization(`nonsense`)
+	suite.Error(err)
+}
+
+func TestSynchronizationTestSuite(t *testing.T) {
+	suite.Run(t, new(SynchronizationTestSuite))
+}
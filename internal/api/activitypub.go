@@ -33,6 +33,7 @@ type ActivityPub struct {
 	users                    *users.Module
 	publicKey                *publickey.Module
 	signatureCheckMiddleware gin.HandlerFunc
+	tokenCheckMiddleware     gin.HandlerFunc
 }
 
 func (a *ActivityPub) Route(r *router.Router, m ...gin.HandlerFunc) {
@@ -49,6 +50,10 @@ func (a *ActivityPub) Route(r *router.Router, m ...gin.HandlerFunc) {
 	emojiGroup.Use(a.signatureCheckMiddleware, ccMiddleware)
 	usersGroup.Use(a.signatureCheckMiddleware, ccMiddleware)
 
+	// Clients posting to outboxes via the
+	// C2S API authenticate with OAuth tokens.
+	usersGroup.Use(a.tokenCheckMiddleware)
+
 	a.emoji.Route(emojiGroup.Handle)
 	a.users.Route(usersGroup.Handle)
 }
@@ -71,9 +76,13 @@ func (a *ActivityPub) RoutePublicKey(r *router.Router, m ...gin.HandlerFunc) {
 
 func NewActivityPub(db db.DB, p *processing.Processor) *ActivityPub {
 	return &ActivityPub{
-		emoji:                    emoji.New(p),
-		users:                    users.New(p),
-		publicKey:                publickey.New(p),
-		signatureCheckMiddleware: middleware.SignatureCheck(db.IsURIBlocked),
+		emoji:     emoji.New(p),
+		users:     users.New(p),
+		publicKey: publickey.New(p),
+		signatureCheckMiddleware: middleware.SignatureCheck(
+			db.IsURIBlocked,
+			middleware.AuthorizedFetch(db.GetAuthorizedFetchDomains, db.IsSignatureRequired),
+		),
+		tokenCheckMiddleware: middleware.TokenCheck(db, p.OAuthValidateBearerToken),
 	}
 }
@@ -24,6 +24,7 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
@@ -32,6 +33,10 @@
 //
 // Get the public outbox collection for an actor.
 //
+// The outbox contains Create activities for all public and unlisted statuses of the actor,
+// including replies, and Announce activities for their boosts, newest first. Pages can be
+// followed via `next` links to crawl the actor's complete history.
+//
 // Note that the response will be a Collection with a page as `first`, as shown below, if `page` is `false`.
 //
 // If `page` is `true`, then the response will be a single `CollectionPage` without the wrapping `Collection`.
@@ -68,6 +73,11 @@
 //		type: string
 //		description: Maximum ID of the next status, used for paging.
 //		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of items to return per page. Capped to the instance's configured outbox page size.
+//		in: query
 //
 //	responses:
 //		'200':
@@ -105,9 +115,9 @@ func (m *Module) OutboxGETHandler(c *gin.Context) {
 	}
 
 	page, errWithCode := paging.ParseIDPage(c,
-		1,  // min limit
-		80, // max limit
-		0,  // default = disabled
+		1,                                  // min limit
+		config.GetInstanceOutboxPageSize(), // max limit
+		0,                                  // default = disabled
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
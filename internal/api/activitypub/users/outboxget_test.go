@@ -140,8 +140,15 @@ func (suite *OutboxGetTestSuite) TestGetOutboxFirstPage() {
 	suite.Equal(`{
   "@context": "https://www.w3.org/ns/activitystreams",
   "id": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40",
-  "next": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40\u0026max_id=01F8MHAMCHF6Y650WCRSCP4WMY",
   "orderedItems": [
+    {
+      "actor": "http://localhost:8080/users/the_mighty_zork",
+      "cc": "http://localhost:8080/users/the_mighty_zork/followers",
+      "id": "http://localhost:8080/users/the_mighty_zork/statuses/01J2M1HPFSS54S60Y0KYV23KJE/activity#Create",
+      "object": "http://localhost:8080/users/the_mighty_zork/statuses/01J2M1HPFSS54S60Y0KYV23KJE",
+      "to": "https://www.w3.org/ns/activitystreams#Public",
+      "type": "Create"
+    },
     {
       "actor": "http://localhost:8080/users/the_mighty_zork",
       "cc": "http://localhost:8080/users/the_mighty_zork/followers",
@@ -160,7 +167,7 @@ func (suite *OutboxGetTestSuite) TestGetOutboxFirstPage() {
     }
   ],
   "partOf": "http://localhost:8080/users/the_mighty_zork/outbox",
-  "prev": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40\u0026min_id=01HH9KYNQPA416TNJ53NSATP40",
+  "prev": "http://localhost:8080/users/the_mighty_zork/outbox?limit=40\u0026min_id=01J2M1HPFSS54S60Y0KYV23KJE",
   "totalItems": 8,
   "type": "OrderedCollectionPage"
 }`, dst.String())
@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// OutboxPOSTHandler swagger:operation POST /users/{username}/outbox c2sOutboxPost
+//
+// Post an activity to the outbox of the authorized account, as per the ActivityPub client-to-server protocol.
+//
+// Supported are `Create` of a `Note`, `Follow` of an account, and `Like` of a status.
+// A bare `Note` will be treated as though it were wrapped in a `Create`.
+//
+// Activities are processed in the same way as their client API equivalents,
+// so eg., a created Note will be formatted, stored, and federated just as a
+// status created via `POST /api/v1/statuses` would be. Content is treated as
+// plain text, and attachments are not supported.
+//
+// The URI of the resulting activity is returned in the `Location` header.
+//
+//	---
+//	tags:
+//	- c2s
+//
+//	consumes:
+//	- application/activity+json
+//	- application/ld+json
+//
+//	parameters:
+//	-
+//		name: username
+//		type: string
+//		description: Username of the account. Must be the authorized account.
+//		in: path
+//		required: true
+//	-
+//		name: activity
+//		description: The activity or object to post.
+//		in: body
+//		required: true
+//		schema:
+//			type: object
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:statuses
+//		- write:follows
+//		- write:favourites
+//
+//	responses:
+//		'201':
+//			description: activity created
+//			headers:
+//				Location:
+//					type: string
+//					description: URI of the created activity.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'500':
+//			description: internal server error
+func (m *Module) OutboxPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// usernames on our instance are always lowercase
+	requestedUsername := strings.ToLower(c.Param(UsernameKey))
+	if requestedUsername != authed.Account.Username {
+		const text = "you may only post to your own outbox"
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if ct := c.GetHeader("Content-Type"); !apiutil.ASContentType(ct) {
+		err := errors.New("content type " + ct + " is not an ActivityStreams content type")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	t, errWithCode := ap.ResolveOutboxActivity(c.Request)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	location, errWithCode := m.processor.OutboxPost(
+		c.Request.Context(),
+		authed.Account,
+		authed.Application,
+		t,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header("Location", location)
+	c.Status(http.StatusCreated)
+	c.Writer.WriteHeaderNow()
+}
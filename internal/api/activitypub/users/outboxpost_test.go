@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/activitypub/users"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type OutboxPostTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *OutboxPostTestSuite) postOutbox(
+	requester string,
+	username string,
+	body string,
+) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[requester]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[requester])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[requester])
+
+	target := "http://localhost:8080/users/" + username + "/outbox"
+	ctx.Request = httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	ctx.Request.Header.Set("Content-Type", "application/activity+json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: username,
+		},
+	}
+
+	suite.userModule.OutboxPOSTHandler(ctx)
+	return recorder
+}
+
+func (suite *OutboxPostTestSuite) TestPostNote() {
+	recorder := suite.postOutbox("local_account_1", "the_mighty_zork", `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "cc": ["http://localhost:8080/users/the_mighty_zork/followers"],
+  "summary": "hello",
+  "content": "<p>posting from a generic client!</p><p>second paragraph</p>"
+}`)
+	suite.Equal(http.StatusCreated, recorder.Code)
+
+	location := recorder.Header().Get("Location")
+	statusURI, ok := strings.CutSuffix(location, "/activity#Create")
+	suite.True(ok)
+
+	status, err := suite.db.GetStatusByURI(context.Background(), statusURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(suite.testAccounts["local_account_1"].ID, status.AccountID)
+	suite.Equal(gtsmodel.VisibilityPublic, status.Visibility)
+	suite.Equal("hello", status.ContentWarning)
+	suite.Equal("posting from a generic client!\n\nsecond paragraph", status.Text)
+}
+
+func (suite *OutboxPostTestSuite) TestPostLike() {
+	targetStatus := suite.testStatuses["admin_account_status_3"]
+
+	recorder := suite.postOutbox("local_account_1", "the_mighty_zork", `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Like",
+  "object": "`+targetStatus.URI+`"
+}`)
+	suite.Equal(http.StatusCreated, recorder.Code)
+
+	fave, err := suite.db.GetStatusFave(
+		context.Background(),
+		suite.testAccounts["local_account_1"].ID,
+		targetStatus.ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(fave.URI, recorder.Header().Get("Location"))
+}
+
+func (suite *OutboxPostTestSuite) TestPostWrongOutbox() {
+	recorder := suite.postOutbox("local_account_1", "admin", `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "content": "this shouldn't work"
+}`)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *OutboxPostTestSuite) TestPostActorMismatch() {
+	recorder := suite.postOutbox("local_account_1", "the_mighty_zork", `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Like",
+  "actor": "http://localhost:8080/users/admin",
+  "object": "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R"
+}`)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func TestOutboxPostTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxPostTestSuite))
+}
@@ -44,10 +44,12 @@
 	BasePath = "/:" + UsernameKey
 	// InboxPath is for serving POST requests to a user's inbox with the given username key.
 	InboxPath = BasePath + "/" + uris.InboxPath
-	// OutboxPath is for serving GET requests to a user's outbox with the given username key.
+	// OutboxPath is for serving GET and POST requests to a user's outbox with the given username key.
 	OutboxPath = BasePath + "/" + uris.OutboxPath
 	// FollowersPath is for serving GET request's to a user's followers list, with the given username key.
 	FollowersPath = BasePath + "/" + uris.FollowersPath
+	// FollowersSyncPath is for serving GET requests to a user's partial followers list, used for follower synchronization.
+	FollowersSyncPath = BasePath + "/" + uris.FollowersSyncPath
 	// FollowingPath is for serving GET request's to a user's following list, with the given username key.
 	FollowingPath = BasePath + "/" + uris.FollowingPath
 	// FeaturedCollectionPath is for serving GET requests to a user's list of featured (pinned) statuses.
@@ -74,10 +76,12 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.UsersGETHandler)
 	attachHandler(http.MethodPost, InboxPath, m.InboxPOSTHandler)
 	attachHandler(http.MethodGet, FollowersPath, m.FollowersGETHandler)
+	attachHandler(http.MethodGet, FollowersSyncPath, m.FollowersSyncGETHandler)
 	attachHandler(http.MethodGet, FollowingPath, m.FollowingGETHandler)
 	attachHandler(http.MethodGet, FeaturedCollectionPath, m.FeaturedCollectionGETHandler)
 	attachHandler(http.MethodGet, StatusPath, m.StatusGETHandler)
 	attachHandler(http.MethodGet, StatusRepliesPath, m.StatusRepliesGETHandler)
 	attachHandler(http.MethodGet, OutboxPath, m.OutboxGETHandler)
+	attachHandler(http.MethodPost, OutboxPath, m.OutboxPOSTHandler)
 	attachHandler(http.MethodGet, AcceptPath, m.AcceptGETHandler)
 }
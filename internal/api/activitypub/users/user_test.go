@@ -105,7 +105,10 @@ func (suite *UserStandardTestSuite) SetupTest() {
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
 	testrig.StandardStorageSetup(suite.storage, "../../../../testrig/media")
 
-	suite.signatureCheck = middleware.SignatureCheck(suite.db.IsURIBlocked)
+	suite.signatureCheck = middleware.SignatureCheck(
+		suite.db.IsURIBlocked,
+		middleware.AuthorizedFetch(suite.db.GetAuthorizedFetchDomains, suite.db.IsSignatureRequired),
+	)
 }
 
 func (suite *UserStandardTestSuite) TearDownTest() {
@@ -24,12 +24,15 @@
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/api/activitypub/users"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -159,6 +162,95 @@ func (suite *UserGetTestSuite) TestGetUserPublicKeyDeleted() {
 	suite.EqualValues(targetAccount.Username, a.Username)
 }
 
+// TestGetUserUnsignedSpoofedUserAgent checks that an unsigned request
+// claiming in its user-agent to come from a domain with authorized fetch
+// set to optional can't get past the instance authorized fetch mode, as
+// it doesn't come from an address that the domain resolves to.
+func (suite *UserGetTestSuite) TestGetUserUnsignedSpoofedUserAgent() {
+	ctx := context.Background()
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	if err := suite.db.CreateDomainAllow(ctx, &gtsmodel.DomainAllow{
+		ID:                  "01JKFV0QW3SJ9XJ1AHZ6Q5N4KD",
+		Domain:              "trusted.example.org",
+		CreatedByAccountID:  suite.testAccounts["admin_account"].ID,
+		AuthorizedFetchMode: config.InstanceAuthorizedFetchModeOptional,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer config.SetInstanceAuthorizedFetchMode(config.InstanceAuthorizedFetchModeRequire)
+
+	for _, test := range []struct {
+		instanceMode string
+		expectCode   int
+	}{
+		{config.InstanceAuthorizedFetchModeRequire, http.StatusUnauthorized},
+		{config.InstanceAuthorizedFetchModeOptional, http.StatusOK},
+	} {
+		config.SetInstanceAuthorizedFetchMode(test.instanceMode)
+
+		// setup unsigned request, pretending
+		// to come from the trusted domain
+		recorder := httptest.NewRecorder()
+		ginCtx, _ := testrig.CreateGinTestContext(recorder, nil)
+		ginCtx.Request = httptest.NewRequest(http.MethodGet, targetAccount.URI, nil)
+		ginCtx.Request.Header.Set("accept", "application/activity+json")
+		ginCtx.Request.Header.Set("User-Agent", "GoToSocial/0.17.0 (+https://trusted.example.org)")
+
+		suite.signatureCheck(ginCtx)
+		ginCtx.Params = gin.Params{
+			gin.Param{
+				Key:   users.UsernameKey,
+				Value: targetAccount.Username,
+			},
+		}
+		suite.userModule.UsersGETHandler(ginCtx)
+
+		suite.Equal(test.expectCode, recorder.Code, test.instanceMode)
+	}
+}
+
+func (suite *UserGetTestSuite) TestGetUserPendingDeletion() {
+	// the dereference we're gonna use
+	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
+	signedRequest := derefRequests["foss_satan_dereference_zork"]
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// mark the target user as having asked for their account to be deleted
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+	user.DeletionRequestedAt = time.Now()
+	if err := suite.db.UpdateUser(context.Background(), user, "deletion_requested_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// setup request
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetAccount.URI, nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", signedRequest.SignatureHeader)
+	ctx.Request.Header.Set("Date", signedRequest.DateHeader)
+
+	// we need to pass the context through signature check first to set appropriate values on it
+	suite.signatureCheck(ctx)
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	// trigger the function being tested
+	suite.userModule.UsersGETHandler(ctx)
+
+	// account should be hidden
+	suite.EqualValues(http.StatusNotFound, recorder.Code)
+}
+
 func TestUserGetTestSuite(t *testing.T) {
 	suite.Run(t, new(UserGetTestSuite))
 }
@@ -22,6 +22,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/api/auth"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/ldap"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
@@ -58,10 +59,10 @@ func (a *Auth) Route(r *router.Router, m ...gin.HandlerFunc) {
 	a.auth.RouteOauth(oauthGroup.Handle)
 }
 
-func NewAuth(db db.DB, p *processing.Processor, idp oidc.IDP, routerSession *gtsmodel.RouterSession, sessionName string) *Auth {
+func NewAuth(db db.DB, p *processing.Processor, idp oidc.IDP, ldapAuth ldap.Authenticator, routerSession *gtsmodel.RouterSession, sessionName string) *Auth {
 	return &Auth{
 		routerSession: routerSession,
 		sessionName:   sessionName,
-		auth:          auth.New(db, p, idp),
+		auth:          auth.New(db, p, idp, ldapAuth),
 	}
 }
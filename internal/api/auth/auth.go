@@ -23,6 +23,7 @@
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/ldap"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
@@ -34,6 +35,10 @@
 
 	// AuthSignInPath is the API path for users to sign in through
 	AuthSignInPath = "/sign_in"
+	// AuthTwoFactorPath is the API path for users with two factor auth enabled to enter a code after signing in
+	AuthTwoFactorPath = "/2fa"
+	// AuthTermsOfServicePath is the API path for signed in users to accept new terms of service, if required
+	AuthTermsOfServicePath = "/terms_of_service"
 	// AuthCheckYourEmailPath users land here after registering a new account, instructs them to confirm their email
 	AuthCheckYourEmailPath = "/check_your_email"
 	// AuthWaitForApprovalPath users land here after confirming their email
@@ -61,34 +66,41 @@
 		params / session keys
 	*/
 
-	callbackStateParam   = "state"
-	callbackCodeParam    = "code"
-	sessionUserID        = "userid"
-	sessionClientID      = "client_id"
-	sessionRedirectURI   = "redirect_uri"
-	sessionForceLogin    = "force_login"
-	sessionResponseType  = "response_type"
-	sessionScope         = "scope"
-	sessionInternalState = "internal_state"
-	sessionClientState   = "client_state"
-	sessionClaims        = "claims"
-	sessionAppID         = "app_id"
+	callbackStateParam         = "state"
+	callbackCodeParam          = "code"
+	sessionUserID              = "userid"
+	session2FAUserID           = "2fa_userid"
+	session2FAFailures         = "2fa_failures"
+	sessionClientID            = "client_id"
+	sessionRedirectURI         = "redirect_uri"
+	sessionForceLogin          = "force_login"
+	sessionResponseType        = "response_type"
+	sessionScope               = "scope"
+	sessionInternalState       = "internal_state"
+	sessionClientState         = "client_state"
+	sessionCodeChallenge       = "code_challenge"
+	sessionCodeChallengeMethod = "code_challenge_method"
+	sessionClaims              = "claims"
+	sessionAppID               = "app_id"
 )
 
 type Module struct {
 	db        db.DB
 	processor *processing.Processor
 	idp       oidc.IDP
+	ldap      ldap.Authenticator
 }
 
 // New returns an Auth module which provides both 'oauth' and 'auth' endpoints.
 //
-// It is safe to pass a nil idp if oidc is disabled.
-func New(db db.DB, processor *processing.Processor, idp oidc.IDP) *Module {
+// It is safe to pass a nil idp if oidc is disabled,
+// and a nil ldapAuth if ldap is disabled.
+func New(db db.DB, processor *processing.Processor, idp oidc.IDP, ldapAuth ldap.Authenticator) *Module {
 	return &Module{
 		db:        db,
 		processor: processor,
 		idp:       idp,
+		ldap:      ldapAuth,
 	}
 }
 
@@ -96,6 +108,10 @@ func New(db db.DB, processor *processing.Processor, idp oidc.IDP) *Module {
 func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, AuthSignInPath, m.SignInGETHandler)
 	attachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
+	attachHandler(http.MethodGet, AuthTwoFactorPath, m.TwoFactorGETHandler)
+	attachHandler(http.MethodPost, AuthTwoFactorPath, m.TwoFactorPOSTHandler)
+	attachHandler(http.MethodGet, AuthTermsOfServicePath, m.TermsOfServiceGETHandler)
+	attachHandler(http.MethodPost, AuthTermsOfServicePath, m.TermsOfServicePOSTHandler)
 	attachHandler(http.MethodGet, AuthCallbackPath, m.CallbackGETHandler)
 }
 
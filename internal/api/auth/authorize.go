@@ -22,6 +22,7 @@
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -32,6 +33,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4"
 )
 
 // AuthorizeGETHandler should be served as GET at https://example.org/oauth/authorize
@@ -121,6 +123,10 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 		return
 	}
 
+	if m.ensureTermsAcceptedOrRedirect(c, user) {
+		return
+	}
+
 	// Finally we should also get the redirect and scope of this particular request, as stored in the session.
 	redirect, ok := s.Get(sessionRedirectURI).(string)
 	if !ok || redirect == "" {
@@ -205,6 +211,17 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		clientState = s
 	}
 
+	// PKCE values are optional, but if a code challenge
+	// was provided then it must be passed to the oauth
+	// server so it can be checked against the verifier.
+	var codeChallenge, codeChallengeMethod string
+	if s, ok := s.Get(sessionCodeChallenge).(string); ok {
+		codeChallenge = s
+	}
+	if s, ok := s.Get(sessionCodeChallengeMethod).(string); ok {
+		codeChallengeMethod = s
+	}
+
 	userID, ok := s.Get(sessionUserID).(string)
 	if !ok {
 		errs = append(errs, fmt.Sprintf("key %s was not found in session", sessionUserID))
@@ -248,6 +265,10 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if m.ensureTermsAcceptedOrRedirect(c, user) {
+		return
+	}
+
 	if redirectURI != oauth.OOBURI {
 		// we're done with the session now, so just clear it out
 		m.clearSession(s)
@@ -268,6 +289,11 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		c.Request.Form.Set("state", clientState)
 	}
 
+	if codeChallenge != "" {
+		c.Request.Form.Set(sessionCodeChallenge, codeChallenge)
+		c.Request.Form.Set(sessionCodeChallengeMethod, codeChallengeMethod)
+	}
+
 	if errWithCode := m.processor.OAuthHandleAuthorizeRequest(c.Writer, c.Request); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 	}
@@ -301,6 +327,21 @@ func saveAuthFormToSession(s sessions.Session, form *apimodel.OAuthAuthorize) gt
 		form.Scope = "read"
 	}
 
+	// check pkce values, if set
+	if form.CodeChallengeMethod != "" {
+		if form.CodeChallenge == "" {
+			err := errors.New("field code_challenge_method was set on OAuthAuthorize form, but code_challenge was not")
+			return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+		}
+
+		if !slices.ContainsFunc(oauth.CodeChallengeMethods, func(m oauth2.CodeChallengeMethod) bool {
+			return m.String() == form.CodeChallengeMethod
+		}) {
+			err := fmt.Errorf("code_challenge_method %s is not supported, use S256 or plain", form.CodeChallengeMethod)
+			return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+		}
+	}
+
 	// save these values from the form so we can use them elsewhere in the session
 	s.Set(sessionForceLogin, form.ForceLogin)
 	s.Set(sessionResponseType, form.ResponseType)
@@ -309,6 +350,8 @@ func saveAuthFormToSession(s sessions.Session, form *apimodel.OAuthAuthorize) gt
 	s.Set(sessionScope, form.Scope)
 	s.Set(sessionInternalState, uuid.NewString())
 	s.Set(sessionClientState, form.State)
+	s.Set(sessionCodeChallenge, form.CodeChallenge)
+	s.Set(sessionCodeChallengeMethod, form.CodeChallengeMethod)
 
 	if err := s.Save(); err != nil {
 		err := fmt.Errorf("error saving form values onto session: %s", err)
@@ -23,8 +23,6 @@
 	"fmt"
 	"net"
 	"net/http"
-	"slices"
-	"strings"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -34,6 +32,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
@@ -165,13 +164,20 @@ func (m *Module) CallbackGETHandler(c *gin.Context) {
 		return
 	}
 
-	s.Set(sessionUserID, user.ID)
-	if err := s.Save(); err != nil {
-		m.clearSession(s)
-		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
-		return
+	// Promote or demote user
+	// according to their groups.
+	if config.GetOIDCSyncRoles() {
+		if errWithCode := m.syncUserRole(c.Request.Context(), user, claims.Groups); errWithCode != nil {
+			m.clearSession(s)
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
 	}
-	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
+
+	// Go through the same two factor check as
+	// password sign in, in case the user enabled
+	// it before the instance switched to OIDC.
+	m.completeSignIn(c, s, user)
 }
 
 // FinalizePOSTHandler registers the user after additional data has been provided
@@ -342,9 +348,9 @@ func (m *Module) createUserFromOIDC(ctx context.Context, claims *oidc.Claims, ex
 	)
 
 	// If one of the claimed groups corresponds to one of
-	// the configured admin OIDC groups, create this user
-	// as an admin.
-	admin := adminGroup(claims.Groups)
+	// the configured admin or moderator OIDC groups, create
+	// this user as an admin or moderator respectively.
+	role := oidc.GroupsRole(claims.Groups)
 
 	// Create the user! This will also create an account and
 	// store it in the database, so we don't need to do that.
@@ -357,7 +363,8 @@ func (m *Module) createUserFromOIDC(ctx context.Context, claims *oidc.Claims, ex
 		ExternalID:    claims.Sub,
 		PreApproved:   preApproved,
 		EmailVerified: emailVerified,
-		Admin:         admin,
+		Admin:         role == oidc.RoleAdmin,
+		Moderator:     role == oidc.RoleModerator,
 	})
 	if err != nil {
 		err := gtserror.Newf("db error doing new signup: %w", err)
@@ -367,21 +374,40 @@ func (m *Module) createUserFromOIDC(ctx context.Context, claims *oidc.Claims, ex
 	return user, nil
 }
 
+// syncUserRole updates the admin and moderator
+// rights of the given user to match the role that
+// the given OIDC groups map to, if necessary.
+func (m *Module) syncUserRole(ctx context.Context, user *gtsmodel.User, groups []string) gtserror.WithCode {
+	var (
+		role      = oidc.GroupsRole(groups)
+		admin     = role == oidc.RoleAdmin
+		moderator = role == oidc.RoleAdmin || role == oidc.RoleModerator
+	)
+
+	if *user.Admin == admin && *user.Moderator == moderator {
+		// Nothing to do.
+		return nil
+	}
+
+	log.Infof(ctx,
+		"updating rights of user %s to match oidc role %s",
+		user.ID, role,
+	)
+
+	user.Admin = &admin
+	user.Moderator = &moderator
+	if err := m.db.UpdateUser(ctx, user, "admin", "moderator"); err != nil {
+		err := gtserror.Newf("db error updating user %s: %w", user.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
 // adminGroup returns true if one of the given OIDC
 // groups is equal to at least one admin OIDC group.
 func adminGroup(groups []string) bool {
-	adminGroups := config.GetOIDCAdminGroups()
-	for _, claimedGroup := range groups {
-		if slices.ContainsFunc(adminGroups, func(allowedGroup string) bool {
-			return strings.EqualFold(claimedGroup, allowedGroup)
-		}) {
-			return true
-		}
-	}
-
-	// User is in no admin groups,
-	// ∴ user is not an admin.
-	return false
+	return oidc.InGroups(groups, config.GetOIDCAdminGroups())
 }
 
 // allowedGroup returns true if one of the given OIDC
@@ -392,15 +418,5 @@ func allowedGroup(groups []string) bool {
 		// If no groups are configured, allow access (for backwards compatibility)
 		return true
 	}
-	for _, claimedGroup := range groups {
-		if slices.ContainsFunc(allowedGroups, func(allowedGroup string) bool {
-			return strings.EqualFold(claimedGroup, allowedGroup)
-		}) {
-			return true
-		}
-	}
-
-	// User is in no allowed groups,
-	// ∴ user is not allowed to log in
-	return false
+	return oidc.InGroups(groups, allowedGroups)
 }
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/ldap"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// ldapUsernameDisallowed matches characters
+// that may appear in directory usernames,
+// but which aren't allowed in GtS usernames.
+var ldapUsernameDisallowed = regexp.MustCompile(`[^a-z0-9_]`)
+
+// ValidateLDAP takes a login (username or email address,
+// depending on configuration) and a password, and checks
+// them against the configured LDAP directory. If OK, it
+// returns the ID of the user corresponding to the directory
+// entry, creating that user first if auto-provisioning
+// is enabled and they don't have an account yet.
+//
+// If the login doesn't correspond to any directory entry,
+// found will be false, and the caller should fall back to
+// checking the password stored in the database instead.
+func (m *Module) ValidateLDAP(
+	ctx context.Context,
+	login string,
+	password string,
+	ip net.IP,
+	clientID string,
+) (userID string, found bool, errWithCode gtserror.WithCode) {
+	entry, err := m.ldap.Authenticate(ctx, login, password)
+	switch {
+	case errors.Is(err, ldap.ErrUserNotFound):
+		// Not a directory
+		// user, fall back.
+		return "", false, nil
+
+	case errors.Is(err, ldap.ErrInvalidCredentials):
+		err := fmt.Errorf("ldap credentials for %s were incorrect: %w", login, err)
+		_, errWithCode := incorrectPassword(err)
+		return "", true, errWithCode
+
+	case err != nil:
+		err := gtserror.Newf("error authenticating %s against ldap: %w", login, err)
+		return "", true, gtserror.NewErrorInternalError(err)
+	}
+
+	if entry.Email == "" {
+		err := gtserror.Newf(
+			"directory entry %s has no %s attribute",
+			entry.DN, config.GetLDAPEmailAttribute(),
+		)
+		return "", true, gtserror.NewErrorInternalError(err)
+	}
+
+	user, err := m.db.GetUserByEmailAddress(ctx, entry.Email)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting user with email %s: %w", entry.Email, err)
+		return "", true, gtserror.NewErrorInternalError(err)
+	}
+
+	if user != nil {
+		// Existing user,
+		// all good.
+		return user.ID, true, nil
+	}
+
+	if !config.GetLDAPAutoProvision() {
+		const help = "You don't have an account on this instance yet, and the server administrator has not enabled automatic account creation"
+		err := fmt.Errorf("no user exists for directory entry %s", entry.DN)
+		return "", true, gtserror.NewErrorForbidden(err, help)
+	}
+
+	user, errWithCode = m.createUserFromLDAP(ctx, entry, ip, clientID)
+	if errWithCode != nil {
+		return "", true, errWithCode
+	}
+
+	return user.ID, true, nil
+}
+
+func (m *Module) createUserFromLDAP(
+	ctx context.Context,
+	entry *ldap.Entry,
+	ip net.IP,
+	clientID string,
+) (*gtsmodel.User, gtserror.WithCode) {
+	// Directory usernames are often
+	// eg., "Jane.Doe", so map to the
+	// closest valid GtS username.
+	username := ldapUsernameDisallowed.ReplaceAllString(
+		strings.ToLower(entry.Username), "_",
+	)
+
+	if err := validate.Username(username); err != nil {
+		const help = "Your directory username could not be used as a username on this instance, please contact the server administrator"
+		err := gtserror.Newf("username %s for directory entry %s invalid: %w", username, entry.DN, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, help)
+	}
+
+	usernameAvailable, err := m.db.IsUsernameAvailable(ctx, username)
+	if err != nil {
+		err := gtserror.Newf("db error checking username availability: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !usernameAvailable {
+		const help = "Your directory username is already taken on this instance, please contact the server administrator"
+		err := gtserror.Newf("username %s for directory entry %s is not available", username, entry.DN)
+		return nil, gtserror.NewErrorConflict(err, help)
+	}
+
+	// Get the app for the client that
+	// started this sign in, so that we
+	// can mark the user as created by it.
+	app, err := m.db.GetApplicationByClientID(ctx, clientID)
+	if err != nil {
+		err := gtserror.Newf("error getting application for client %s: %w", clientID, err)
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	log.Infof(ctx,
+		"creating user %s for directory entry %s",
+		username, entry.DN,
+	)
+
+	// As with OIDC, the password stored in the database
+	// won't ever be used, since the user signs in using
+	// their directory password, but it must be set to
+	// something; see createUserFromOIDC for details.
+	password := uuid.NewString() + uuid.NewString()
+
+	// Since the directory vouches for this user,
+	// consider them approved and their email
+	// verified, so they can use their account
+	// straight away.
+	user, err := m.db.NewSignup(ctx, gtsmodel.NewSignup{
+		Username:      username,
+		Email:         entry.Email,
+		Password:      password,
+		SignUpIP:      ip,
+		AppID:         app.ID,
+		PreApproved:   true,
+		EmailVerified: true,
+	})
+	if err != nil {
+		err := gtserror.Newf("db error doing new signup: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/auth"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/ldap"
+)
+
+// fakeLDAP is an ldap.Authenticator backed
+// by a map of logins to directory entries,
+// which all share the same password.
+type fakeLDAP struct {
+	entries  map[string]*ldap.Entry
+	password string
+}
+
+func (f *fakeLDAP) Authenticate(_ context.Context, login string, password string) (*ldap.Entry, error) {
+	entry, ok := f.entries[login]
+	if !ok {
+		return nil, ldap.ErrUserNotFound
+	}
+
+	if password != f.password {
+		return nil, ldap.ErrInvalidCredentials
+	}
+
+	return entry, nil
+}
+
+type LDAPTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *LDAPTestSuite) SetupTest() {
+	suite.AuthStandardTestSuite.SetupTest()
+	suite.authModule = auth.New(suite.db, suite.processor, suite.idp, &fakeLDAP{
+		entries: map[string]*ldap.Entry{
+			"zork": {
+				DN:       "uid=zork,ou=people,dc=example,dc=org",
+				Username: "zork",
+				Email:    suite.testUsers["local_account_1"].Email,
+			},
+			"Jane.Doe": {
+				DN:       "uid=Jane.Doe,ou=people,dc=example,dc=org",
+				Username: "Jane.Doe",
+				Email:    "jane@example.org",
+			},
+			"the_mighty_zork": {
+				DN:       "uid=the_mighty_zork,ou=people,dc=example,dc=org",
+				Username: "the_mighty_zork",
+				Email:    "imposter@example.org",
+			},
+		},
+		password: "correct horse battery staple",
+	})
+}
+
+func (suite *LDAPTestSuite) validate(login string, password string) (string, bool, int) {
+	userID, found, errWithCode := suite.authModule.ValidateLDAP(
+		context.Background(),
+		login,
+		password,
+		net.ParseIP("127.0.0.1"),
+		suite.testApplications["application_1"].ClientID,
+	)
+
+	code := http.StatusOK
+	if errWithCode != nil {
+		code = errWithCode.Code()
+	}
+
+	return userID, found, code
+}
+
+func (suite *LDAPTestSuite) TestValidateLDAPExistingUser() {
+	userID, found, code := suite.validate("zork", "correct horse battery staple")
+	suite.True(found)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(suite.testUsers["local_account_1"].ID, userID)
+}
+
+func (suite *LDAPTestSuite) TestValidateLDAPWrongPassword() {
+	userID, found, code := suite.validate("zork", "incorrect horse battery staple")
+	suite.True(found)
+	suite.Equal(http.StatusUnauthorized, code)
+	suite.Empty(userID)
+}
+
+func (suite *LDAPTestSuite) TestValidateLDAPNotFound() {
+	// Should indicate the caller
+	// falls back to local passwords.
+	userID, found, code := suite.validate(suite.testUsers["local_account_1"].Email, "password")
+	suite.False(found)
+	suite.Equal(http.StatusOK, code)
+	suite.Empty(userID)
+}
+
+func (suite *LDAPTestSuite) TestValidateLDAPNoAutoProvision() {
+	userID, found, code := suite.validate("Jane.Doe", "correct horse battery staple")
+	suite.True(found)
+	suite.Equal(http.StatusForbidden, code)
+	suite.Empty(userID)
+}
+
+func (suite *LDAPTestSuite) TestValidateLDAPAutoProvision() {
+	config.SetLDAPAutoProvision(true)
+
+	userID, found, code := suite.validate("Jane.Doe", "correct horse battery staple")
+	suite.True(found)
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(userID)
+
+	user, err := suite.db.GetUserByID(context.Background(), userID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("jane@example.org", user.Email)
+	suite.True(*user.Approved)
+	suite.False(user.ConfirmedAt.IsZero())
+	suite.Equal(suite.testApplications["application_1"].ID, user.CreatedByApplicationID)
+	suite.Equal("jane_doe", user.Account.Username)
+
+	// Signing in again should
+	// now find the same user.
+	userID2, found, code := suite.validate("Jane.Doe", "correct horse battery staple")
+	suite.True(found)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(userID, userID2)
+}
+
+func (suite *LDAPTestSuite) TestValidateLDAPAutoProvisionUsernameTaken() {
+	config.SetLDAPAutoProvision(true)
+
+	userID, found, code := suite.validate("the_mighty_zork", "correct horse battery staple")
+	suite.True(found)
+	suite.Equal(http.StatusConflict, code)
+	suite.Empty(userID)
+}
+
+func TestLDAPTestSuite(t *testing.T) {
+	suite.Run(t, &LDAPTestSuite{})
+}
@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 
 	"github.com/gin-contrib/sessions"
@@ -28,6 +29,7 @@
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -58,6 +60,9 @@ func (m *Module) SignInGETHandler(c *gin.Context) {
 		page := apiutil.WebPage{
 			Template: "sign-in.tmpl",
 			Instance: instance,
+			Extra: map[string]any{
+				"ldap": m.ldap != nil,
+			},
 		}
 
 		apiutil.TemplateWebPage(c, page)
@@ -92,7 +97,30 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	userid, errWithCode := m.ValidatePassword(c.Request.Context(), form.Email, form.Password)
+	var (
+		userid      string
+		found       bool
+		errWithCode gtserror.WithCode
+	)
+
+	if m.ldap != nil {
+		// Check directory first; if the
+		// login isn't a directory user then
+		// fall back to stored password.
+		clientID, _ := s.Get(sessionClientID).(string)
+		userid, found, errWithCode = m.ValidateLDAP(
+			c.Request.Context(),
+			form.Email,
+			form.Password,
+			net.ParseIP(c.ClientIP()),
+			clientID,
+		)
+	}
+
+	if !found {
+		userid, errWithCode = m.ValidatePassword(c.Request.Context(), form.Email, form.Password)
+	}
+
 	if errWithCode != nil {
 		// don't clear session here, so the user can just press back and try again
 		// if they accidentally gave the wrong password or something
@@ -100,10 +128,43 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	s.Set(sessionUserID, userid)
+	user, err := m.db.GetUserByID(c.Request.Context(), userid)
+	if err != nil {
+		err := fmt.Errorf("error getting user %s: %w", userid, err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	m.completeSignIn(c, s, user)
+}
+
+// completeSignIn stores the given authenticated user on the
+// session and sends them on to authorize, or, if they have
+// two factor auth enabled, to the 2fa page to enter a code.
+// All sign in paths should finish with this, so that none
+// of them skip the two factor check.
+func (m *Module) completeSignIn(c *gin.Context, s sessions.Session, user *gtsmodel.User) {
+	if user.TwoFactorEnabled() {
+		// User still needs to provide a two factor code
+		// before they're properly signed in, so store
+		// them separately and send them to the 2fa page.
+		s.Set(session2FAUserID, user.ID)
+		s.Delete(session2FAFailures)
+		if err := s.Save(); err != nil {
+			err := fmt.Errorf("error saving 2fa user id onto session: %s", err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+			return
+		}
+
+		c.Redirect(http.StatusFound, "/auth"+AuthTwoFactorPath)
+		return
+	}
+
+	s.Set(sessionUserID, user.ID)
 	if err := s.Save(); err != nil {
 		err := fmt.Errorf("error saving user id onto session: %s", err)
 		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
 	}
 
 	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// termsOfService wraps the ID of
+// a form-submitted terms of service.
+type termsOfService struct {
+	ID string `form:"terms_of_service_id"`
+}
+
+// TermsOfServiceGETHandler should be served at https://example.org/auth/terms_of_service.
+// It presents a page where a signed in user can read and accept new terms of service,
+// if acceptance of the terms is required before they can continue to sign in.
+// The form will then POST to the same path, handled by TermsOfServicePOSTHandler.
+func (m *Module) TermsOfServiceGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	user, errWithCode := m.sessionUser(c)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if user == nil {
+		// Not signed in
+		// yet, start over.
+		c.Redirect(http.StatusSeeOther, "/auth"+AuthSignInPath)
+		return
+	}
+
+	tos, errWithCode := m.processor.User().TermsOfServicePending(c.Request.Context(), user)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if tos == nil {
+		// Nothing to accept,
+		// carry on signing in.
+		c.Redirect(http.StatusSeeOther, "/oauth"+OauthAuthorizePath)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	page := apiutil.WebPage{
+		Template: "sign-in-terms.tmpl",
+		Instance: instance,
+		Extra: map[string]any{
+			"termsID":       tos.ID,
+			"termsContent":  tos.Content,
+			"effectiveDate": util.FormatISO8601Date(tos.CreatedAt),
+		},
+	}
+
+	apiutil.TemplateWebPage(c, page)
+}
+
+// TermsOfServicePOSTHandler should be served at https://example.org/auth/terms_of_service.
+// It records that the signed in user accepted the submitted terms of service version,
+// and redirects to /oauth/authorize, which checks again whether further terms need to
+// be accepted, in case a newer version was published in the meantime.
+func (m *Module) TermsOfServicePOSTHandler(c *gin.Context) {
+	user, errWithCode := m.sessionUser(c)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if user == nil {
+		err := fmt.Errorf("key %s was not found in session", sessionUserID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &termsOfService{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.User().TermsOfServiceAccept(c.Request.Context(), user, form.ID); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
+}
+
+// sessionUser returns the user stored on the session
+// by the sign in handlers, or nil if nobody is signed in.
+func (m *Module) sessionUser(c *gin.Context) (*gtsmodel.User, gtserror.WithCode) {
+	s := sessions.Default(c)
+
+	userID, ok := s.Get(sessionUserID).(string)
+	if !ok || userID == "" {
+		return nil, nil
+	}
+
+	user, err := m.db.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		m.clearSession(s)
+		err := fmt.Errorf("error getting user %s: %w", userID, err)
+		return nil, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice)
+	}
+
+	return user, nil
+}
+
+// ensureTermsAcceptedOrRedirect redirects to the terms of service
+// page if the given user has to accept new terms before signing in.
+func (m *Module) ensureTermsAcceptedOrRedirect(c *gin.Context, user *gtsmodel.User) (redirected bool) {
+	tos, errWithCode := m.processor.User().TermsOfServicePending(c.Request.Context(), user)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		redirected = true
+		return
+	}
+
+	if tos != nil {
+		c.Redirect(http.StatusSeeOther, "/auth"+AuthTermsOfServicePath)
+		redirected = true
+		return
+	}
+
+	return
+}
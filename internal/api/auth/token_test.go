@@ -19,6 +19,8 @@
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -209,6 +211,95 @@ func (suite *TokenTestSuite) TestRetrieveAuthorizationCodeWrongGrantType() {
 	suite.Equal(`{"error":"invalid_request","error_description":"Bad Request: a code was provided in the token request form, but grant_type was not set to authorization_code"}`, string(b))
 }
 
+func (suite *TokenTestSuite) retrieveAuthorizationCodePKCE(codeVerifier *string) *http.Response {
+	testClient := suite.testClients["local_account_1"]
+	testUserAuthorizationToken := suite.testTokens["local_account_1_user_authorization_token"]
+
+	// Set a code challenge on the
+	// authorization token, as though
+	// it was requested using PKCE.
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := sha256.Sum256([]byte(verifier))
+	testUserAuthorizationToken.CodeChallenge = base64.RawURLEncoding.EncodeToString(challenge[:])
+	testUserAuthorizationToken.CodeChallengeMethod = "S256"
+	if err := suite.db.UpdateByID(
+		context.Background(),
+		testUserAuthorizationToken,
+		testUserAuthorizationToken.ID,
+		"code_challenge",
+		"code_challenge_method",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := map[string][]string{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {testClient.ID},
+		"client_secret": {testClient.Secret},
+		"redirect_uri":  {"http://localhost:8080"},
+		"code":          {testUserAuthorizationToken.Code},
+	}
+	if codeVerifier != nil {
+		form["code_verifier"] = []string{*codeVerifier}
+	}
+
+	requestBody, w, err := testrig.CreateMultipartFormData(nil, form)
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/token", bodyBytes, w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.authModule.TokenPOSTHandler(ctx)
+
+	return recorder.Result()
+}
+
+func (suite *TokenTestSuite) TestRetrieveAuthorizationCodePKCEOK() {
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+	result := suite.retrieveAuthorizationCodePKCE(&verifier)
+	defer result.Body.Close()
+
+	suite.Equal(http.StatusOK, result.StatusCode)
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	t := &apimodel.Token{}
+	err = json.Unmarshal(b, t)
+	suite.NoError(err)
+
+	suite.Equal("Bearer", t.TokenType)
+	suite.NotEmpty(t.AccessToken)
+}
+
+func (suite *TokenTestSuite) TestRetrieveAuthorizationCodePKCEWrongVerifier() {
+	verifier := "not-the-verifier-that-was-used-to-create-the-challenge"
+
+	result := suite.retrieveAuthorizationCodePKCE(&verifier)
+	defer result.Body.Close()
+
+	suite.Equal(http.StatusBadRequest, result.StatusCode)
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Contains(string(b), "invalid_grant")
+}
+
+func (suite *TokenTestSuite) TestRetrieveAuthorizationCodePKCENoVerifier() {
+	result := suite.retrieveAuthorizationCodePKCE(nil)
+	defer result.Body.Close()
+
+	suite.Equal(http.StatusBadRequest, result.StatusCode)
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Contains(string(b), "code verifier")
+}
+
 func TestTokenTestSuite(t *testing.T) {
 	suite.Run(t, &TokenTestSuite{})
 }
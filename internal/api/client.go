@@ -23,11 +23,15 @@
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/announcements"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/appeals"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/apps"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/blocks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/bookmarks"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/circles"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/customemojis"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/directory"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/exports"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/favourites"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
@@ -39,6 +43,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/instance"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/interactionpolicies"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/interactionrequests"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/invites"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/markers"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/media"
@@ -48,10 +53,13 @@
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/preferences"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/reports"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/severedrelationships"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/streaming"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/tags"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/timelines"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/tokens"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
@@ -63,39 +71,48 @@
 type Client struct {
 	processor *processing.Processor
 	db        db.DB
+	authed    []gin.HandlerFunc // middlewares to apply after the token check
 
-	accounts            *accounts.Module            // api/v1/accounts, api/v1/profile
-	admin               *admin.Module               // api/v1/admin
-	apps                *apps.Module                // api/v1/apps
-	blocks              *blocks.Module              // api/v1/blocks
-	bookmarks           *bookmarks.Module           // api/v1/bookmarks
-	conversations       *conversations.Module       // api/v1/conversations
-	customEmojis        *customemojis.Module        // api/v1/custom_emojis
-	exports             *exports.Module             // api/v1/exports
-	favourites          *favourites.Module          // api/v1/favourites
-	featuredTags        *featuredtags.Module        // api/v1/featured_tags
-	filtersV1           *filtersV1.Module           // api/v1/filters
-	filtersV2           *filtersV2.Module           // api/v2/filters
-	followRequests      *followrequests.Module      // api/v1/follow_requests
-	followedTags        *followedtags.Module        // api/v1/followed_tags
-	importData          *importdata.Module          // api/v1/import
-	instance            *instance.Module            // api/v1/instance
-	interactionPolicies *interactionpolicies.Module // api/v1/interaction_policies
-	interactionRequests *interactionrequests.Module // api/v1/interaction_requests
-	lists               *lists.Module               // api/v1/lists
-	markers             *markers.Module             // api/v1/markers
-	media               *media.Module               // api/v1/media, api/v2/media
-	mutes               *mutes.Module               // api/v1/mutes
-	notifications       *notifications.Module       // api/v1/notifications
-	polls               *polls.Module               // api/v1/polls
-	preferences         *preferences.Module         // api/v1/preferences
-	reports             *reports.Module             // api/v1/reports
-	search              *search.Module              // api/v1/search, api/v2/search
-	statuses            *statuses.Module            // api/v1/statuses
-	streaming           *streaming.Module           // api/v1/streaming
-	tags                *tags.Module                // api/v1/tags
-	timelines           *timelines.Module           // api/v1/timelines
-	user                *user.Module                // api/v1/user
+	accounts             *accounts.Module             // api/v1/accounts, api/v1/profile
+	admin                *admin.Module                // api/v1/admin
+	announcements        *announcements.Module        // api/v1/announcements
+	appeals              *appeals.Module              // api/v1/appeals, api/v1/strikes
+	apps                 *apps.Module                 // api/v1/apps
+	blocks               *blocks.Module               // api/v1/blocks
+	bookmarks            *bookmarks.Module            // api/v1/bookmarks
+	circles              *circles.Module              // api/v1/circles
+	conversations        *conversations.Module        // api/v1/conversations
+	customEmojis         *customemojis.Module         // api/v1/custom_emojis
+	directory            *directory.Module            // api/v1/directory
+	exports              *exports.Module              // api/v1/exports
+	favourites           *favourites.Module           // api/v1/favourites
+	featuredTags         *featuredtags.Module         // api/v1/featured_tags
+	filtersV1            *filtersV1.Module            // api/v1/filters
+	filtersV2            *filtersV2.Module            // api/v2/filters
+	followRequests       *followrequests.Module       // api/v1/follow_requests
+	followedTags         *followedtags.Module         // api/v1/followed_tags
+	importData           *importdata.Module           // api/v1/import
+	instance             *instance.Module             // api/v1/instance
+	interactionPolicies  *interactionpolicies.Module  // api/v1/interaction_policies
+	interactionRequests  *interactionrequests.Module  // api/v1/interaction_requests
+	invites              *invites.Module              // api/v1/invites
+	lists                *lists.Module                // api/v1/lists
+	markers              *markers.Module              // api/v1/markers
+	media                *media.Module                // api/v1/media, api/v2/media
+	mutes                *mutes.Module                // api/v1/mutes
+	notifications        *notifications.Module        // api/v1/notifications, api/v2/notifications
+	polls                *polls.Module                // api/v1/polls
+	preferences          *preferences.Module          // api/v1/preferences
+	reports              *reports.Module              // api/v1/reports
+	search               *search.Module               // api/v1/search, api/v2/search
+	severedRelationships *severedrelationships.Module // api/v1/severed_relationships
+	statuses             *statuses.Module             // api/v1/statuses
+	streaming            *streaming.Module            // api/v1/streaming
+	tags                 *tags.Module                 // api/v1/tags
+	timelines            *timelines.Module            // api/v1/timelines
+	tokens               *tokens.Module               // api/v1/tokens
+	trends               *trends.Module               // api/v1/trends
+	user                 *user.Module                 // api/v1/user
 }
 
 func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
@@ -104,8 +121,9 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 
 	// attach non-global middlewares appropriate to the client api
 	apiGroup.Use(m...)
+	apiGroup.Use(middleware.TokenCheck(c.db, c.processor.OAuthValidateBearerToken))
+	apiGroup.Use(c.authed...)
 	apiGroup.Use(
-		middleware.TokenCheck(c.db, c.processor.OAuthValidateBearerToken),
 		middleware.CacheControl(middleware.CacheControlConfig{
 			// Never cache client api responses.
 			Directives: []string{"no-store"},
@@ -117,11 +135,15 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	h := apiGroup.Handle
 	c.accounts.Route(h)
 	c.admin.Route(h)
+	c.announcements.Route(h)
+	c.appeals.Route(h)
 	c.apps.Route(h)
 	c.blocks.Route(h)
 	c.bookmarks.Route(h)
+	c.circles.Route(h)
 	c.conversations.Route(h)
 	c.customEmojis.Route(h)
+	c.directory.Route(h)
 	c.exports.Route(h)
 	c.favourites.Route(h)
 	c.featuredTags.Route(h)
@@ -133,6 +155,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.instance.Route(h)
 	c.interactionPolicies.Route(h)
 	c.interactionRequests.Route(h)
+	c.invites.Route(h)
 	c.lists.Route(h)
 	c.markers.Route(h)
 	c.media.Route(h)
@@ -142,49 +165,71 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.preferences.Route(h)
 	c.reports.Route(h)
 	c.search.Route(h)
+	c.severedRelationships.Route(h)
 	c.statuses.Route(h)
 	c.streaming.Route(h)
 	c.tags.Route(h)
 	c.timelines.Route(h)
+	c.tokens.Route(h)
+	c.trends.Route(h)
 	c.user.Route(h)
 }
 
+// UseAuthed sets middlewares to be applied to the
+// client api after the token check, such as rate
+// limiting by token or account. Unlike middlewares
+// passed to Route, these can make use of the token
+// and account the request was authenticated with.
+//
+// Must be called before Route.
+func (c *Client) UseAuthed(m ...gin.HandlerFunc) {
+	c.authed = append(c.authed, m...)
+}
+
 func NewClient(state *state.State, p *processing.Processor) *Client {
 	return &Client{
 		processor: p,
 		db:        state.DB,
 
-		accounts:            accounts.New(p),
-		admin:               admin.New(state, p),
-		apps:                apps.New(p),
-		blocks:              blocks.New(p),
-		bookmarks:           bookmarks.New(p),
-		conversations:       conversations.New(p),
-		customEmojis:        customemojis.New(p),
-		exports:             exports.New(p),
-		favourites:          favourites.New(p),
-		featuredTags:        featuredtags.New(p),
-		filtersV1:           filtersV1.New(p),
-		filtersV2:           filtersV2.New(p),
-		followRequests:      followrequests.New(p),
-		followedTags:        followedtags.New(p),
-		importData:          importdata.New(p),
-		instance:            instance.New(p),
-		interactionPolicies: interactionpolicies.New(p),
-		interactionRequests: interactionrequests.New(p),
-		lists:               lists.New(p),
-		markers:             markers.New(p),
-		media:               media.New(p),
-		mutes:               mutes.New(p),
-		notifications:       notifications.New(p),
-		polls:               polls.New(p),
-		preferences:         preferences.New(p),
-		reports:             reports.New(p),
-		search:              search.New(p),
-		statuses:            statuses.New(p),
-		streaming:           streaming.New(p, time.Second*30, 4096),
-		tags:                tags.New(p),
-		timelines:           timelines.New(p),
-		user:                user.New(p),
+		accounts:             accounts.New(p),
+		admin:                admin.New(state, p),
+		announcements:        announcements.New(p),
+		appeals:              appeals.New(p),
+		apps:                 apps.New(p),
+		blocks:               blocks.New(p),
+		bookmarks:            bookmarks.New(p),
+		circles:              circles.New(p),
+		conversations:        conversations.New(p),
+		customEmojis:         customemojis.New(p),
+		directory:            directory.New(p),
+		exports:              exports.New(p),
+		favourites:           favourites.New(p),
+		featuredTags:         featuredtags.New(p),
+		filtersV1:            filtersV1.New(p),
+		filtersV2:            filtersV2.New(p),
+		followRequests:       followrequests.New(p),
+		followedTags:         followedtags.New(p),
+		importData:           importdata.New(p),
+		instance:             instance.New(p),
+		interactionPolicies:  interactionpolicies.New(p),
+		interactionRequests:  interactionrequests.New(p),
+		invites:              invites.New(p),
+		lists:                lists.New(p),
+		markers:              markers.New(p),
+		media:                media.New(p),
+		mutes:                mutes.New(p),
+		notifications:        notifications.New(p),
+		polls:                polls.New(p),
+		preferences:          preferences.New(p),
+		reports:              reports.New(p),
+		search:               search.New(p),
+		severedRelationships: severedrelationships.New(p),
+		statuses:             statuses.New(p),
+		streaming:            streaming.New(p, time.Second*30, 4096),
+		tags:                 tags.New(p),
+		timelines:            timelines.New(p),
+		tokens:               tokens.New(p),
+		trends:               trends.New(p),
+		user:                 user.New(p),
 	}
 }
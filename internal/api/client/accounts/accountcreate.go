@@ -34,6 +34,10 @@
 //
 // Create a new account using an application token.
 //
+// If the instance has sign-up questions (see `registrations.questions` in the v2 instance response),
+// answers to all of them must be given as `answers[question_id]`, or as an `answers` object when using JSON,
+// unless signing up with an invite code.
+//
 // The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
 // The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
 //
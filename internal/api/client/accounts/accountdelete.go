@@ -33,6 +33,13 @@
 //
 // Delete your account.
 //
+// If the instance has an account deletion grace period configured,
+// your account will be deactivated, and only actually deleted once
+// the grace period has passed. Until then, you can still log in,
+// but only to check when your account will be deleted (see the
+// `deletion_scheduled_at` field of `/api/v1/user`), or to cancel
+// the deletion with `POST /api/v1/accounts/delete/cancel`.
+//
 //	---
 //	tags:
 //	- accounts
@@ -54,7 +61,7 @@
 //
 //	responses:
 //		'202':
-//			description: "The account deletion has been accepted and the account will be deleted."
+//			description: "The account deletion has been accepted and the account will be deleted, or is now pending deletion."
 //		'400':
 //			description: bad request
 //		'401':
@@ -40,6 +40,8 @@
 
 	BlockPath         = BasePathWithID + "/block"
 	DeletePath        = BasePath + "/delete"
+	DeleteCancelPath  = DeletePath + "/cancel"
+	FamiliarPath      = BasePath + "/familiar_followers"
 	FollowersPath     = BasePathWithID + "/followers"
 	FollowingPath     = BasePathWithID + "/following"
 	FollowPath        = BasePathWithID + "/follow"
@@ -55,6 +57,7 @@
 	UnmutePath        = BasePathWithID + "/unmute"
 	UpdatePath        = BasePath + "/update_credentials"
 	VerifyPath        = BasePath + "/verify_credentials"
+	VerifyFieldsPath  = BasePath + "/verify_fields"
 	MovePath          = BasePath + "/move"
 	AliasPath         = BasePath + "/alias"
 	ThemesPath        = BasePath + "/themes"
@@ -84,10 +87,14 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 
 	// delete account
 	attachHandler(http.MethodPost, DeletePath, m.AccountDeletePOSTHandler)
+	attachHandler(http.MethodPost, DeleteCancelPath, m.AccountDeleteCancelPOSTHandler)
 
 	// verify account
 	attachHandler(http.MethodGet, VerifyPath, m.AccountVerifyGETHandler)
 
+	// re-verify profile fields
+	attachHandler(http.MethodPost, VerifyFieldsPath, m.AccountVerifyFieldsPOSTHandler)
+
 	// modify account
 	attachHandler(http.MethodPatch, UpdatePath, m.AccountUpdateCredentialsPATCHHandler)
 
@@ -105,6 +112,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// get relationship with account
 	attachHandler(http.MethodGet, RelationshipsPath, m.AccountRelationshipsGETHandler)
 
+	// get familiar followers of accounts
+	attachHandler(http.MethodGet, FamiliarPath, m.AccountFamiliarFollowersGETHandler)
+
 	// follow or unfollow account
 	attachHandler(http.MethodPost, FollowPath, m.AccountFollowPOSTHandler)
 	attachHandler(http.MethodPost, UnfollowPath, m.AccountUnfollowPOSTHandler)
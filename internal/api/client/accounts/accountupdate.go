@@ -154,6 +154,51 @@
 //			"none": show no posts on the web, not even Public ones.
 //		type: string
 //	-
+//		name: status_expiry_days
+//		in: formData
+//		description: >-
+//			Automatically delete statuses by this account once they're older than this many days.
+//			Must be between 7 and 3650 (inclusive), or 0 to disable automatic deletion.
+//		type: integer
+//	-
+//		name: status_expiry_keep_pinned
+//		in: formData
+//		description: Don't automatically delete pinned statuses.
+//		type: boolean
+//	-
+//		name: status_expiry_keep_self_faved
+//		in: formData
+//		description: Don't automatically delete statuses faved by this account.
+//		type: boolean
+//	-
+//		name: email_digest_days
+//		in: formData
+//		description: >-
+//			Send an email digest of new followers, mentions, and pending follow requests
+//			to this account every this many days. Must be between 0 and 30 (inclusive),
+//			where 0 disables email digests.
+//		type: integer
+//	-
+//		name: email_digest_follows
+//		in: formData
+//		description: Include new followers in email digests.
+//		type: boolean
+//	-
+//		name: email_digest_mentions
+//		in: formData
+//		description: Include mentions in email digests.
+//		type: boolean
+//	-
+//		name: email_digest_follow_requests
+//		in: formData
+//		description: Include pending follow requests in email digests.
+//		type: boolean
+//	-
+//		name: require_media_descriptions
+//		in: formData
+//		description: Reject new statuses by this account that have media attachments without a description (alt text).
+//		type: boolean
+//	-
 //		name: fields_attributes[0][name]
 //		in: formData
 //		description: Name of 1st profile field to be added to this account's profile.
@@ -349,7 +394,15 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.CustomCSS == nil &&
 			form.EnableRSS == nil &&
 			form.HideCollections == nil &&
-			form.WebVisibility == nil) {
+			form.WebVisibility == nil &&
+			form.StatusExpiryDays == nil &&
+			form.StatusExpiryKeepPinned == nil &&
+			form.StatusExpiryKeepSelfFaved == nil &&
+			form.EmailDigestDays == nil &&
+			form.EmailDigestFollows == nil &&
+			form.EmailDigestMentions == nil &&
+			form.EmailDigestFollowRequests == nil &&
+			form.RequireMediaDescriptions == nil) {
 		return nil, errors.New("empty form submitted")
 	}
 
@@ -495,6 +495,97 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateCredentialsPATCHHandlerUpd
 	}
 }
 
+func (suite *AccountUpdateTestSuite) TestUpdateAccountStatusExpiryFormData() {
+	data := map[string][]string{
+		"status_expiry_days":            {"30"},
+		"status_expiry_keep_self_faved": {"false"},
+	}
+
+	apimodelAccount, err := suite.updateAccountFromFormData(data, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(30, apimodelAccount.Source.StatusExpiryDays)
+	suite.True(apimodelAccount.Source.StatusExpiryKeepPinned)
+	suite.False(apimodelAccount.Source.StatusExpiryKeepSelfFaved)
+
+	// Check the account in the database too.
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(30, dbAccount.Settings.StatusExpiryDays)
+	suite.False(*dbAccount.Settings.StatusExpiryKeepSelfFaved)
+}
+
+func (suite *AccountUpdateTestSuite) TestUpdateAccountStatusExpiryTooShort() {
+	data := map[string][]string{
+		"status_expiry_days": {"1"},
+	}
+
+	_, err := suite.updateAccountFromFormData(data, http.StatusBadRequest, `{"error":"Bad Request: status_expiry_days must be 0, or between 7 and 3650 (inclusive), but was 1"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *AccountUpdateTestSuite) TestUpdateAccountEmailDigestFormData() {
+	data := map[string][]string{
+		"email_digest_days":     {"7"},
+		"email_digest_mentions": {"false"},
+	}
+
+	apimodelAccount, err := suite.updateAccountFromFormData(data, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(7, apimodelAccount.Source.EmailDigestDays)
+	suite.True(apimodelAccount.Source.EmailDigestFollows)
+	suite.False(apimodelAccount.Source.EmailDigestMentions)
+	suite.True(apimodelAccount.Source.EmailDigestFollowRequests)
+
+	// Check the account in the database too.
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(7, dbAccount.Settings.EmailDigestDays)
+	suite.False(*dbAccount.Settings.EmailDigestMentions)
+}
+
+func (suite *AccountUpdateTestSuite) TestUpdateAccountEmailDigestTooLong() {
+	data := map[string][]string{
+		"email_digest_days": {"365"},
+	}
+
+	_, err := suite.updateAccountFromFormData(data, http.StatusBadRequest, `{"error":"Bad Request: email_digest_days must be between 0 and 30 (inclusive), but was 365"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *AccountUpdateTestSuite) TestUpdateAccountRequireMediaDescriptions() {
+	data := map[string][]string{
+		"require_media_descriptions": {"true"},
+	}
+
+	apimodelAccount, err := suite.updateAccountFromFormData(data, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(apimodelAccount.Source.RequireMediaDescriptions)
+
+	// Check the account in the database too.
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbAccount.Settings.RequireMediaDescriptions)
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUpdateTestSuite))
 }
@@ -38,6 +38,9 @@
 // If you already follow (request) the given account, then the follow (request) will be updated instead using the
 // `reblogs` and `notify` parameters.
 //
+// If the account has to approve the follow, the optional `note` is shown to it alongside the follow request.
+// The note is federated as the content of the Follow activity, so remote software may or may not show it.
+//
 //	---
 //	tags:
 //	- accounts
@@ -66,6 +69,13 @@
 //		default: false
 //		description: Notify when this account posts.
 //		in: formData
+//	-
+//		name: note
+//		type: string
+//		description: >-
+//			Optional plaintext note to show to the account alongside the follow request,
+//			to help it decide whether to approve the follow. Max 500 characters.
+//		in: formData
 //
 //	produces:
 //	- application/json
@@ -19,7 +19,6 @@
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -53,7 +52,9 @@
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: >-
+//			Type of action to be taken, one of `suspend`, `silence`, or `disable`.
+//			`freeze` is accepted as an alias for `disable`. Disabling only works on local accounts.
 //		type: string
 //		required: true
 //	-
@@ -61,6 +62,20 @@
 //		in: formData
 //		description: Optional text describing why this action was taken.
 //		type: string
+//	-
+//		name: scheduled_at
+//		in: formData
+//		description: >-
+//			Optional RFC3339 timestamp at which to carry out the action.
+//			Must be in the future. If not set, the action is carried out immediately.
+//		type: string
+//	-
+//		name: expires_at
+//		in: formData
+//		description: >-
+//			Optional RFC3339 timestamp after which the action will automatically be lifted.
+//			Must be after `scheduled_at`, if set. Not supported for `suspend`, as suspension cannot be undone.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -93,12 +108,6 @@ func (m *Module) AccountActionPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
-		return
-	}
-
 	if authed.Account.IsMoving() {
 		apiutil.ForbiddenAfterMove(c)
 		return
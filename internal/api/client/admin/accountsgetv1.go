@@ -193,12 +193,6 @@ func (m *Module) AccountsGETV1Handler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
-		return
-	}
-
 	if authed.Account.IsMoving() {
 		apiutil.ForbiddenAfterMove(c)
 		return
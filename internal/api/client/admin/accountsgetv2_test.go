@@ -132,7 +132,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "546037",
       "highlighted": true
     },
     "confirmed": true,
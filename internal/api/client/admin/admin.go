@@ -23,6 +23,7 @@
 	"codeberg.org/gruf/go-debug"
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 )
@@ -32,6 +33,8 @@
 	EmojiPath                          = BasePath + "/custom_emojis"
 	EmojiPathWithID                    = EmojiPath + "/:" + apiutil.IDKey
 	EmojiCategoriesPath                = EmojiPath + "/categories"
+	EmojiCategoriesPathWithID          = EmojiCategoriesPath + "/:" + apiutil.IDKey
+	EmojiCategoryEmojisPath            = EmojiCategoriesPathWithID + "/emojis"
 	DomainBlocksPath                   = BasePath + "/domain_blocks"
 	DomainBlocksPathWithID             = DomainBlocksPath + "/:" + apiutil.IDKey
 	DomainAllowsPath                   = BasePath + "/domain_allows"
@@ -43,6 +46,9 @@
 	DomainPermissionExcludesPath       = BasePath + "/domain_permission_excludes"
 	DomainPermissionExcludesPathWithID = DomainPermissionExcludesPath + "/:" + apiutil.IDKey
 	DomainKeysExpirePath               = BasePath + "/domain_keys_expire"
+	MediaRetentionPoliciesPath         = BasePath + "/media_retention_policies"
+	MediaRetentionPoliciesPathWithID   = MediaRetentionPoliciesPath + "/:" + apiutil.IDKey
+	AIScrapersPath                     = BasePath + "/ai_scrapers"
 	HeaderAllowsPath                   = BasePath + "/header_allows"
 	HeaderAllowsPathWithID             = HeaderAllowsPath + "/:" + apiutil.IDKey
 	HeaderBlocksPath                   = BasePath + "/header_blocks"
@@ -53,6 +59,9 @@
 	AccountsActionPath                 = AccountsPathWithID + "/action"
 	AccountsApprovePath                = AccountsPathWithID + "/approve"
 	AccountsRejectPath                 = AccountsPathWithID + "/reject"
+	AccountsBulkApprovePath            = AccountsV1Path + "/approve"
+	AccountsBulkRejectPath             = AccountsV1Path + "/reject"
+	AccountsRolePath                   = AccountsPathWithID + "/role"
 	MediaCleanupPath                   = BasePath + "/media_cleanup"
 	MediaRefetchPath                   = BasePath + "/media_refetch"
 	ReportsPath                        = BasePath + "/reports"
@@ -62,6 +71,47 @@
 	EmailTestPath                      = EmailPath + "/test"
 	InstanceRulesPath                  = BasePath + "/instance/rules"
 	InstanceRulesPathWithID            = InstanceRulesPath + "/:" + apiutil.IDKey
+	InteractionPoliciesDefaultsPath    = BasePath + "/interaction_policies/defaults"
+	TrendsPath                         = BasePath + "/trends/:" + TrendTypeKey
+	TrendsPathWithID                   = TrendsPath + "/:" + apiutil.IDKey
+	TrendsApprovePath                  = TrendsPathWithID + "/approve"
+	TrendsRejectPath                   = TrendsPathWithID + "/reject"
+	RelaysPath                         = BasePath + "/relays"
+	RelaysPathWithID                   = RelaysPath + "/:" + apiutil.IDKey
+	DeadLettersPath                    = BasePath + "/dead_letters"
+	DeadLettersPathWithID              = DeadLettersPath + "/:" + apiutil.IDKey
+	DeadLettersRedrivePath             = DeadLettersPathWithID + "/redrive"
+	ActionsPath                        = BasePath + "/actions"
+	ActionsPathWithID                  = ActionsPath + "/:" + apiutil.IDKey
+	AppealsPath                        = BasePath + "/appeals"
+	AppealsPathWithID                  = AppealsPath + "/:" + apiutil.IDKey
+	AppealsApprovePath                 = AppealsPathWithID + "/approve"
+	AppealsRejectPath                  = AppealsPathWithID + "/reject"
+	AutomodRulesPath                   = BasePath + "/automod_rules"
+	AutomodRulesPathWithID             = AutomodRulesPath + "/:" + apiutil.IDKey
+	IPBlocksPath                       = BasePath + "/ip_blocks"
+	IPBlocksPathWithID                 = IPBlocksPath + "/:" + apiutil.IDKey
+	CanonicalEmailBlocksPath           = BasePath + "/canonical_email_blocks"
+	CanonicalEmailBlocksPathWithID     = CanonicalEmailBlocksPath + "/:" + apiutil.IDKey
+	CanonicalEmailBlocksTestPath       = CanonicalEmailBlocksPath + "/test"
+	InvitesPath                        = BasePath + "/invites"
+	InvitesPathWithID                  = InvitesPath + "/:" + apiutil.IDKey
+	SignupQuestionsPath                = BasePath + "/signup_questions"
+	SignupQuestionsPathWithID          = SignupQuestionsPath + "/:" + apiutil.IDKey
+	SpamFlagsPath                      = BasePath + "/spam_flags"
+	SpamFlagsPathWithID                = SpamFlagsPath + "/:" + apiutil.IDKey
+	SpamFlagsFalsePositivePath         = SpamFlagsPathWithID + "/false_positive"
+	AuditLogPath                       = BasePath + "/audit_log"
+	RolesPath                          = BasePath + "/roles"
+	RolesPathWithID                    = RolesPath + "/:" + apiutil.IDKey
+	WebhooksPath                       = BasePath + "/webhooks"
+	WebhooksPathWithID                 = WebhooksPath + "/:" + apiutil.IDKey
+	TermsOfServicePath                 = BasePath + "/terms_of_service"
+	AnnouncementsPath                  = BasePath + "/announcements"
+	AnnouncementsPathWithID            = AnnouncementsPath + "/:" + apiutil.IDKey
+	MeasuresPath                       = BasePath + "/measures"
+	DimensionsPath                     = BasePath + "/dimensions"
+	RetentionPath                      = BasePath + "/retention"
 	DebugPath                          = BasePath + "/debug"
 	DebugAPUrlPath                     = DebugPath + "/apurl"
 	DebugClearCachesPath               = DebugPath + "/caches/clear"
@@ -70,6 +120,7 @@
 	MaxShortcodeDomainKey = "max_shortcode_domain"
 	MinShortcodeDomainKey = "min_shortcode_domain"
 	DomainQueryKey        = "domain"
+	TrendTypeKey          = "trend_type"
 )
 
 type Module struct {
@@ -85,6 +136,16 @@ func New(state *state.State, processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// Routes behind these middlewares may be used by
+	// non-admin users with a custom role granting the
+	// permission; all other routes are admin-only.
+	var (
+		viewAuditLog     = m.RequirePermission(gtsmodel.RolePermissionViewAuditLog)
+		manageReports    = m.RequirePermission(gtsmodel.RolePermissionManageReports)
+		manageFederation = m.RequirePermission(gtsmodel.RolePermissionManageFederation)
+		manageUsers      = m.RequirePermission(gtsmodel.RolePermissionManageUsers)
+	)
+
 	// emoji stuff
 	attachHandler(http.MethodPost, EmojiPath, m.EmojiCreatePOSTHandler)
 	attachHandler(http.MethodGet, EmojiPath, m.EmojisGETHandler)
@@ -92,61 +153,154 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
 	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
 	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	attachHandler(http.MethodPost, EmojiCategoriesPath, m.EmojiCategoryCreatePOSTHandler)
+	attachHandler(http.MethodPatch, EmojiCategoriesPathWithID, m.EmojiCategoryPATCHHandler)
+	attachHandler(http.MethodDelete, EmojiCategoriesPathWithID, m.EmojiCategoryDELETEHandler)
+	attachHandler(http.MethodPost, EmojiCategoryEmojisPath, m.EmojiCategoryEmojisPOSTHandler)
 
 	// domain block stuff
-	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
-	attachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
-	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
-	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
+	attachHandler(http.MethodPost, DomainBlocksPath, manageFederation, m.DomainBlocksPOSTHandler)
+	attachHandler(http.MethodGet, DomainBlocksPath, manageFederation, m.DomainBlocksGETHandler)
+	attachHandler(http.MethodGet, DomainBlocksPathWithID, manageFederation, m.DomainBlockGETHandler)
+	attachHandler(http.MethodDelete, DomainBlocksPathWithID, manageFederation, m.DomainBlockDELETEHandler)
 
 	// domain allow stuff
-	attachHandler(http.MethodPost, DomainAllowsPath, m.DomainAllowsPOSTHandler)
-	attachHandler(http.MethodGet, DomainAllowsPath, m.DomainAllowsGETHandler)
-	attachHandler(http.MethodGet, DomainAllowsPathWithID, m.DomainAllowGETHandler)
-	attachHandler(http.MethodDelete, DomainAllowsPathWithID, m.DomainAllowDELETEHandler)
+	attachHandler(http.MethodPost, DomainAllowsPath, manageFederation, m.DomainAllowsPOSTHandler)
+	attachHandler(http.MethodGet, DomainAllowsPath, manageFederation, m.DomainAllowsGETHandler)
+	attachHandler(http.MethodGet, DomainAllowsPathWithID, manageFederation, m.DomainAllowGETHandler)
+	attachHandler(http.MethodPatch, DomainAllowsPathWithID, manageFederation, m.DomainAllowPATCHHandler)
+	attachHandler(http.MethodDelete, DomainAllowsPathWithID, manageFederation, m.DomainAllowDELETEHandler)
 
 	// domain permission draft stuff
-	attachHandler(http.MethodPost, DomainPermissionDraftsPath, m.DomainPermissionDraftsPOSTHandler)
-	attachHandler(http.MethodGet, DomainPermissionDraftsPath, m.DomainPermissionDraftsGETHandler)
-	attachHandler(http.MethodGet, DomainPermissionDraftsPathWithID, m.DomainPermissionDraftGETHandler)
-	attachHandler(http.MethodPost, DomainPermissionDraftAcceptPath, m.DomainPermissionDraftAcceptPOSTHandler)
-	attachHandler(http.MethodPost, DomainPermissionDraftRemovePath, m.DomainPermissionDraftRemovePOSTHandler)
+	attachHandler(http.MethodPost, DomainPermissionDraftsPath, manageFederation, m.DomainPermissionDraftsPOSTHandler)
+	attachHandler(http.MethodGet, DomainPermissionDraftsPath, manageFederation, m.DomainPermissionDraftsGETHandler)
+	attachHandler(http.MethodGet, DomainPermissionDraftsPathWithID, manageFederation, m.DomainPermissionDraftGETHandler)
+	attachHandler(http.MethodPost, DomainPermissionDraftAcceptPath, manageFederation, m.DomainPermissionDraftAcceptPOSTHandler)
+	attachHandler(http.MethodPost, DomainPermissionDraftRemovePath, manageFederation, m.DomainPermissionDraftRemovePOSTHandler)
 
 	// domain permission excludes stuff
-	attachHandler(http.MethodPost, DomainPermissionExcludesPath, m.DomainPermissionExcludesPOSTHandler)
-	attachHandler(http.MethodGet, DomainPermissionExcludesPath, m.DomainPermissionExcludesGETHandler)
-	attachHandler(http.MethodGet, DomainPermissionExcludesPathWithID, m.DomainPermissionExcludeGETHandler)
-	attachHandler(http.MethodDelete, DomainPermissionExcludesPathWithID, m.DomainPermissionExcludeDELETEHandler)
+	attachHandler(http.MethodPost, DomainPermissionExcludesPath, manageFederation, m.DomainPermissionExcludesPOSTHandler)
+	attachHandler(http.MethodGet, DomainPermissionExcludesPath, manageFederation, m.DomainPermissionExcludesGETHandler)
+	attachHandler(http.MethodGet, DomainPermissionExcludesPathWithID, manageFederation, m.DomainPermissionExcludeGETHandler)
+	attachHandler(http.MethodDelete, DomainPermissionExcludesPathWithID, manageFederation, m.DomainPermissionExcludeDELETEHandler)
+
+	// media retention policy stuff
+	attachHandler(http.MethodPost, MediaRetentionPoliciesPath, m.MediaRetentionPoliciesPOSTHandler)
+	attachHandler(http.MethodGet, MediaRetentionPoliciesPath, m.MediaRetentionPoliciesGETHandler)
+	attachHandler(http.MethodGet, MediaRetentionPoliciesPathWithID, m.MediaRetentionPolicyGETHandler)
+	attachHandler(http.MethodPatch, MediaRetentionPoliciesPathWithID, m.MediaRetentionPolicyPATCHHandler)
+	attachHandler(http.MethodDelete, MediaRetentionPoliciesPathWithID, m.MediaRetentionPolicyDELETEHandler)
 
 	// header filtering administration routes
-	attachHandler(http.MethodGet, HeaderAllowsPathWithID, m.HeaderFilterAllowGET)
-	attachHandler(http.MethodGet, HeaderBlocksPathWithID, m.HeaderFilterBlockGET)
-	attachHandler(http.MethodGet, HeaderAllowsPath, m.HeaderFilterAllowsGET)
-	attachHandler(http.MethodGet, HeaderBlocksPath, m.HeaderFilterBlocksGET)
-	attachHandler(http.MethodPost, HeaderAllowsPath, m.HeaderFilterAllowPOST)
-	attachHandler(http.MethodPost, HeaderBlocksPath, m.HeaderFilterBlockPOST)
-	attachHandler(http.MethodDelete, HeaderAllowsPathWithID, m.HeaderFilterAllowDELETE)
-	attachHandler(http.MethodDelete, HeaderBlocksPathWithID, m.HeaderFilterBlockDELETE)
+	attachHandler(http.MethodGet, HeaderAllowsPathWithID, manageFederation, m.HeaderFilterAllowGET)
+	attachHandler(http.MethodGet, HeaderBlocksPathWithID, manageFederation, m.HeaderFilterBlockGET)
+	attachHandler(http.MethodGet, HeaderAllowsPath, manageFederation, m.HeaderFilterAllowsGET)
+	attachHandler(http.MethodGet, HeaderBlocksPath, manageFederation, m.HeaderFilterBlocksGET)
+	attachHandler(http.MethodPost, HeaderAllowsPath, manageFederation, m.HeaderFilterAllowPOST)
+	attachHandler(http.MethodPost, HeaderBlocksPath, manageFederation, m.HeaderFilterBlockPOST)
+	attachHandler(http.MethodDelete, HeaderAllowsPathWithID, manageFederation, m.HeaderFilterAllowDELETE)
+	attachHandler(http.MethodDelete, HeaderBlocksPathWithID, manageFederation, m.HeaderFilterBlockDELETE)
+
+	// ai scraper stuff
+	attachHandler(http.MethodGet, AIScrapersPath, m.AIScrapersGETHandler)
+	attachHandler(http.MethodPatch, AIScrapersPath, m.AIScrapersPATCHHandler)
 
 	// domain maintenance stuff
-	attachHandler(http.MethodPost, DomainKeysExpirePath, m.DomainKeysExpirePOSTHandler)
+	attachHandler(http.MethodPost, DomainKeysExpirePath, manageFederation, m.DomainKeysExpirePOSTHandler)
 
 	// accounts stuff
-	attachHandler(http.MethodGet, AccountsV1Path, m.AccountsGETV1Handler)
-	attachHandler(http.MethodGet, AccountsV2Path, m.AccountsGETV2Handler)
-	attachHandler(http.MethodGet, AccountsPathWithID, m.AccountGETHandler)
-	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
-	attachHandler(http.MethodPost, AccountsApprovePath, m.AccountApprovePOSTHandler)
-	attachHandler(http.MethodPost, AccountsRejectPath, m.AccountRejectPOSTHandler)
+	attachHandler(http.MethodGet, AccountsV1Path, manageUsers, m.AccountsGETV1Handler)
+	attachHandler(http.MethodGet, AccountsV2Path, manageUsers, m.AccountsGETV2Handler)
+	attachHandler(http.MethodGet, AccountsPathWithID, manageUsers, m.AccountGETHandler)
+	attachHandler(http.MethodPost, AccountsActionPath, manageUsers, m.AccountActionPOSTHandler)
+	attachHandler(http.MethodPost, AccountsApprovePath, manageUsers, m.AccountApprovePOSTHandler)
+	attachHandler(http.MethodPost, AccountsRejectPath, manageUsers, m.AccountRejectPOSTHandler)
+	attachHandler(http.MethodPost, AccountsBulkApprovePath, manageUsers, m.AccountsBulkApprovePOSTHandler)
+	attachHandler(http.MethodPost, AccountsBulkRejectPath, manageUsers, m.AccountsBulkRejectPOSTHandler)
+	attachHandler(http.MethodPut, AccountsRolePath, m.AccountRolePUTHandler)
+
+	// admin actions stuff
+	attachHandler(http.MethodGet, ActionsPath, manageUsers, m.ActionsGETHandler)
+	attachHandler(http.MethodGet, ActionsPathWithID, manageUsers, m.ActionGETHandler)
+
+	// appeals stuff
+	attachHandler(http.MethodGet, AppealsPath, manageReports, m.AppealsGETHandler)
+	attachHandler(http.MethodGet, AppealsPathWithID, manageReports, m.AppealGETHandler)
+	attachHandler(http.MethodPost, AppealsApprovePath, manageReports, m.AppealApprovePOSTHandler)
+	attachHandler(http.MethodPost, AppealsRejectPath, manageReports, m.AppealRejectPOSTHandler)
+
+	// automod stuff
+	attachHandler(http.MethodGet, AutomodRulesPath, m.AutomodRulesGETHandler)
+	attachHandler(http.MethodPost, AutomodRulesPath, m.AutomodRulePOSTHandler)
+	attachHandler(http.MethodGet, AutomodRulesPathWithID, m.AutomodRuleGETHandler)
+	attachHandler(http.MethodDelete, AutomodRulesPathWithID, m.AutomodRuleDELETEHandler)
+
+	// ip block stuff
+	attachHandler(http.MethodGet, IPBlocksPath, manageUsers, m.IPBlocksGETHandler)
+	attachHandler(http.MethodPost, IPBlocksPath, manageUsers, m.IPBlockPOSTHandler)
+	attachHandler(http.MethodGet, IPBlocksPathWithID, manageUsers, m.IPBlockGETHandler)
+	attachHandler(http.MethodPut, IPBlocksPathWithID, manageUsers, m.IPBlockPUTHandler)
+	attachHandler(http.MethodDelete, IPBlocksPathWithID, manageUsers, m.IPBlockDELETEHandler)
+
+	// canonical email block stuff
+	attachHandler(http.MethodGet, CanonicalEmailBlocksPath, manageUsers, m.CanonicalEmailBlocksGETHandler)
+	attachHandler(http.MethodPost, CanonicalEmailBlocksPath, manageUsers, m.CanonicalEmailBlockPOSTHandler)
+	attachHandler(http.MethodPost, CanonicalEmailBlocksTestPath, manageUsers, m.CanonicalEmailBlocksTestPOSTHandler)
+	attachHandler(http.MethodGet, CanonicalEmailBlocksPathWithID, manageUsers, m.CanonicalEmailBlockGETHandler)
+	attachHandler(http.MethodDelete, CanonicalEmailBlocksPathWithID, manageUsers, m.CanonicalEmailBlockDELETEHandler)
+
+	// invite stuff
+	attachHandler(http.MethodGet, InvitesPath, m.InvitesGETHandler)
+	attachHandler(http.MethodDelete, InvitesPathWithID, m.InviteDELETEHandler)
+
+	// sign-up question stuff
+	attachHandler(http.MethodGet, SignupQuestionsPath, m.SignupQuestionsGETHandler)
+	attachHandler(http.MethodPost, SignupQuestionsPath, m.SignupQuestionPOSTHandler)
+	attachHandler(http.MethodGet, SignupQuestionsPathWithID, m.SignupQuestionGETHandler)
+	attachHandler(http.MethodPatch, SignupQuestionsPathWithID, m.SignupQuestionPATCHHandler)
+	attachHandler(http.MethodDelete, SignupQuestionsPathWithID, m.SignupQuestionDELETEHandler)
+
+	// spam flags stuff
+	attachHandler(http.MethodGet, SpamFlagsPath, manageReports, m.SpamFlagsGETHandler)
+	attachHandler(http.MethodGet, SpamFlagsPathWithID, manageReports, m.SpamFlagGETHandler)
+	attachHandler(http.MethodPost, SpamFlagsFalsePositivePath, manageReports, m.SpamFlagFalsePositivePOSTHandler)
+
+	// audit log stuff
+	attachHandler(http.MethodGet, AuditLogPath, viewAuditLog, m.AuditLogGETHandler)
+
+	// roles stuff
+	attachHandler(http.MethodGet, RolesPath, m.RolesGETHandler)
+	attachHandler(http.MethodPost, RolesPath, m.RolePOSTHandler)
+	attachHandler(http.MethodGet, RolesPathWithID, m.RoleGETHandler)
+	attachHandler(http.MethodPatch, RolesPathWithID, m.RolePATCHHandler)
+	attachHandler(http.MethodDelete, RolesPathWithID, m.RoleDELETEHandler)
+
+	// webhooks stuff
+	attachHandler(http.MethodGet, WebhooksPath, m.WebhooksGETHandler)
+	attachHandler(http.MethodPost, WebhooksPath, m.WebhookPOSTHandler)
+	attachHandler(http.MethodGet, WebhooksPathWithID, m.WebhookGETHandler)
+	attachHandler(http.MethodPatch, WebhooksPathWithID, m.WebhookPATCHHandler)
+	attachHandler(http.MethodDelete, WebhooksPathWithID, m.WebhookDELETEHandler)
+
+	// terms of service stuff
+	attachHandler(http.MethodGet, TermsOfServicePath, m.TermsOfServiceGETHandler)
+	attachHandler(http.MethodPost, TermsOfServicePath, m.TermsOfServicePOSTHandler)
+
+	// announcements stuff
+	attachHandler(http.MethodGet, AnnouncementsPath, m.AnnouncementsGETHandler)
+	attachHandler(http.MethodPost, AnnouncementsPath, m.AnnouncementPOSTHandler)
+	attachHandler(http.MethodGet, AnnouncementsPathWithID, m.AnnouncementGETHandler)
+	attachHandler(http.MethodPatch, AnnouncementsPathWithID, m.AnnouncementPATCHHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, m.AnnouncementDELETEHandler)
 
 	// media stuff
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
 	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
 
 	// reports stuff
-	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
-	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
-	attachHandler(http.MethodPost, ReportsResolvePath, m.ReportResolvePOSTHandler)
+	attachHandler(http.MethodGet, ReportsPath, manageReports, m.ReportsGETHandler)
+	attachHandler(http.MethodGet, ReportsPathWithID, manageReports, m.ReportGETHandler)
+	attachHandler(http.MethodPost, ReportsResolvePath, manageReports, m.ReportResolvePOSTHandler)
 
 	// email stuff
 	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
@@ -158,6 +312,33 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPatch, InstanceRulesPathWithID, m.RulePATCHHandler)
 	attachHandler(http.MethodDelete, InstanceRulesPathWithID, m.RuleDELETEHandler)
 
+	// default interaction policies stuff
+	attachHandler(http.MethodGet, InteractionPoliciesDefaultsPath, m.InteractionPoliciesDefaultsGETHandler)
+	attachHandler(http.MethodPatch, InteractionPoliciesDefaultsPath, m.InteractionPoliciesDefaultsPATCHHandler)
+
+	// relay stuff
+	attachHandler(http.MethodPost, RelaysPath, manageFederation, m.RelaysPOSTHandler)
+	attachHandler(http.MethodGet, RelaysPath, manageFederation, m.RelaysGETHandler)
+	attachHandler(http.MethodGet, RelaysPathWithID, manageFederation, m.RelayGETHandler)
+	attachHandler(http.MethodPatch, RelaysPathWithID, manageFederation, m.RelayPATCHHandler)
+	attachHandler(http.MethodDelete, RelaysPathWithID, manageFederation, m.RelayDELETEHandler)
+
+	// dead letter stuff
+	attachHandler(http.MethodGet, DeadLettersPath, manageFederation, m.DeadLettersGETHandler)
+	attachHandler(http.MethodGet, DeadLettersPathWithID, manageFederation, m.DeadLetterGETHandler)
+	attachHandler(http.MethodPost, DeadLettersRedrivePath, manageFederation, m.DeadLetterRedrivePOSTHandler)
+	attachHandler(http.MethodDelete, DeadLettersPathWithID, manageFederation, m.DeadLetterDELETEHandler)
+
+	// trends stuff
+	attachHandler(http.MethodGet, TrendsPath, m.TrendsGETHandler)
+	attachHandler(http.MethodPost, TrendsApprovePath, m.TrendApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendsRejectPath, m.TrendRejectPOSTHandler)
+
+	// dashboard stuff
+	attachHandler(http.MethodPost, MeasuresPath, m.MeasuresPOSTHandler)
+	attachHandler(http.MethodPost, DimensionsPath, m.DimensionsPOSTHandler)
+	attachHandler(http.MethodPost, RetentionPath, m.RetentionPOSTHandler)
+
 	// debug stuff
 	if debug.DEBUG {
 		attachHandler(http.MethodGet, DebugAPUrlPath, m.DebugAPUrlHandler)
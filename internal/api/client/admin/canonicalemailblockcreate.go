@@ -0,0 +1,183 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// CanonicalEmailBlockPOSTHandler swagger:operation POST /api/v1/admin/canonical_email_blocks canonicalEmailBlockCreate
+//
+// Create one or more canonical email blocks, from a string or a file.
+//
+// Sign-ups are refused for any email address with a blocked canonical form, ie., the
+// address lowercased, with any dots or "+suffix" removed from the part before the @.
+//
+// You have two options when using this endpoint: either you can set `import` to `true` and
+// upload a file containing multiple canonical email blocks, JSON-formatted, or you can leave
+// import as `false`, and add one block by `email` or `canonical_email_hash`.
+//
+// The format of the json file should be as returned by GET /api/v1/admin/canonical_email_blocks,
+// something like: `[{"canonical_email_hash":"79a6123c2db3b110c92f2872d217545dfc5ff5147bbdd47e67e72f223747a538","comment":"ban evasion"}]`.
+// Hashes that are already blocked are skipped, and only newly created blocks are returned.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: import
+//		in: query
+//		description: >-
+//			Signal that a list of canonical email blocks is being imported as a file.
+//			If set to `true`, then `canonical_email_blocks` must be present as a JSON-formatted file.
+//		type: boolean
+//		default: false
+//	-
+//		name: canonical_email_blocks
+//		in: formData
+//		description: >-
+//			JSON-formatted list of canonical email blocks to import.
+//			This is only used if `import` is set to `true`.
+//		type: file
+//	-
+//		name: email
+//		in: formData
+//		description: >-
+//			Email address to block the canonical form of.
+//			Used only if `import` is not `true`.
+//		type: string
+//	-
+//		name: canonical_email_hash
+//		in: formData
+//		description: >-
+//			Hex-encoded SHA256 hash of a canonical email address to block, in place of `email`.
+//			Used only if `import` is not `true`.
+//		type: string
+//	-
+//		name: comment
+//		in: formData
+//		description: >-
+//			Admin comment on why this block exists.
+//			Used only if `import` is not `true`.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: >-
+//				The newly created canonical email block, if `import` != `true`.
+//				If a list has been imported, then an `array` of newly created blocks will be returned instead.
+//			schema:
+//				"$ref": "#/definitions/canonicalEmailBlock"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: >-
+//				Conflict: a block already exists for this canonical email hash.
+//		'500':
+//			description: internal server error
+func (m *Module) CanonicalEmailBlockPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	importing, errWithCode := apiutil.ParseCanonicalEmailBlockImport(c.Query(apiutil.CanonicalEmailBlockImportKey), false)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := new(apimodel.CanonicalEmailBlockRequest)
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !importing {
+		// Single canonical email block creation.
+		block, errWithCode := m.processor.Admin().CreateCanonicalEmailBlock(
+			c.Request.Context(),
+			authed.Account,
+			form.Email,
+			form.CanonicalEmailHash,
+			form.Comment,
+		)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		apiutil.JSON(c, http.StatusOK, block)
+		return
+	}
+
+	if form.CanonicalEmailBlocks == nil || form.CanonicalEmailBlocks.Size == 0 {
+		err := errors.New("import was specified but list of canonical email blocks is empty")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	blocks, errWithCode := m.processor.Admin().ImportCanonicalEmailBlocks(
+		c.Request.Context(),
+		authed.Account,
+		form.CanonicalEmailBlocks, // Pass the file through.
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, blocks)
+}
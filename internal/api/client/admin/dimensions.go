@@ -0,0 +1,165 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DimensionsPOSTHandler swagger:operation POST /api/v1/admin/dimensions adminDimensions
+//
+// Get qualitative data about the instance, biggest data items first.
+//
+// Dimensions that require extra params take them as eg., `tag_servers[id]` or
+// `instance_languages[domain]` (or as nested JSON objects, eg., `{"tag_servers": {"id": "..."}}`).
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: keys[]
+//		in: formData
+//		description: |-
+//			Keys of the dimensions to get. Unknown keys are ignored.
+//			Tag dimensions require `id` param, instance dimensions require `domain` param.
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- languages
+//				- servers
+//				- space_usage
+//				- software_versions
+//				- tag_servers
+//				- tag_languages
+//				- instance_accounts
+//				- instance_languages
+//		required: true
+//	-
+//		name: start_at
+//		in: formData
+//		description: First day of the period to get data for (ISO 8601 Date or Datetime). Defaults to 29 days before end_at.
+//		type: string
+//	-
+//		name: end_at
+//		in: formData
+//		description: Last day of the period to get data for (ISO 8601 Date or Datetime). Defaults to today.
+//		type: string
+//	-
+//		name: limit
+//		in: formData
+//		description: Maximum number of data items to return per dimension.
+//		type: integer
+//		default: 10
+//		minimum: 1
+//		maximum: 100
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminDimension"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DimensionsPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := new(apimodel.AdminDimensionsRequest)
+	form.Params, err = bindWithParams(c, form, &form.Keys)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	start, end, errWithCode := parseMeasurePeriod(form.StartAt, form.EndAt)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	var limitStr string
+	if form.Limit != 0 {
+		limitStr = strconv.Itoa(form.Limit)
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(limitStr, 10, 100, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	dimensions, errWithCode := m.processor.Admin().DimensionsGet(
+		c.Request.Context(),
+		form.Keys,
+		start,
+		end,
+		limit,
+		form.Params,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, dimensions)
+}
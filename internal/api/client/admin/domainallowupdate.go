@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DomainAllowPATCHHandler swagger:operation PATCH /api/v1/admin/domain_allows/{id} domainAllowUpdate
+//
+// Update the domain allow with the given ID.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: The id of the domain allow.
+//		type: string
+//	-
+//		name: authorized_fetch_mode
+//		in: formData
+//		description: >-
+//			Authorized fetch mode to use for requests from this domain,
+//			overriding the instance-authorized-fetch-mode setting. One of `require` or `optional`.
+//			Unsigned requests are attributed to this domain only if they come from an address
+//			that the domain resolves to. Set to an empty string to remove the override.
+//		type: string
+//	-
+//		name: delivery_rate_limit
+//		in: formData
+//		description: >-
+//			Maximum number of outgoing deliveries per minute to this domain (and its subdomains),
+//			overriding the delivery-rate-limit setting. Set to -1 for no limit, or 0 to remove the override.
+//		type: integer
+//	-
+//		name: dereference_rate_limit
+//		in: formData
+//		description: >-
+//			Maximum number of outgoing dereference requests per minute to this domain (and its subdomains),
+//			overriding the delivery-dereference-rate-limit setting. Set to -1 for no limit, or 0 to remove the override.
+//		type: integer
+//	-
+//		name: media_policy
+//		in: formData
+//		description: >-
+//			Policy to apply to media from this domain (and its subdomains). `reject` to never fetch
+//			media attachments, avatars or headers from the domain, or `sensitive` to mark all incoming
+//			statuses with media from the domain as sensitive. Set to an empty string to remove the policy.
+//		type: string
+//	-
+//		name: limited
+//		in: formData
+//		description: >-
+//			Limit this domain (and its subdomains). Statuses from limited domains are still
+//			federated with, but are kept off of the public and tag timelines.
+//		type: boolean
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: The updated domain allow.
+//			schema:
+//				"$ref": "#/definitions/domainPermission"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DomainAllowPATCHHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	id, errWithCode := apiutil.ParseID(c.Param(apiutil.IDKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := new(apimodel.DomainAllowUpdateRequest)
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	domainAllow, errWithCode := m.processor.Admin().DomainAllowUpdate(
+		c.Request.Context(),
+		authed.Account,
+		id,
+		form.AuthorizedFetchMode,
+		form.DeliveryRateLimit,
+		form.DereferenceRateLimit,
+		form.MediaPolicy,
+		form.Limited,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, domainAllow)
+}
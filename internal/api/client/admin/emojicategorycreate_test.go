@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type EmojiCategoryCreateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryCreateTestSuite) TestEmojiCategoryCreate() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.EmojiCategoriesPath
+	body := []byte(`{"name":" blobs "}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+
+	suite.adminModule.EmojiCategoryCreatePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	category := &apimodel.EmojiCategory{}
+	suite.NoError(json.Unmarshal(b, category))
+	suite.NotEmpty(category.ID)
+	suite.Equal("blobs", category.Name)
+
+	// Category should now be in the db.
+	dbCategory, err := suite.db.GetEmojiCategory(context.Background(), category.ID)
+	suite.NoError(err)
+	suite.Equal("blobs", dbCategory.Name)
+}
+
+func (suite *EmojiCategoryCreateTestSuite) TestEmojiCategoryCreateAlreadyExists() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.EmojiCategoriesPath
+	body := []byte(`{"name":"Reactions"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+
+	suite.adminModule.EmojiCategoryCreatePOSTHandler(ctx)
+	suite.Equal(http.StatusConflict, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Conflict: emoji category reactions already exists"}`, string(b))
+}
+
+func (suite *EmojiCategoryCreateTestSuite) TestEmojiCategoryCreateNoName() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.EmojiCategoriesPath
+	body := []byte(`{"name":""}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+
+	suite.adminModule.EmojiCategoryCreatePOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no category name provided"}`, string(b))
+}
+
+func TestEmojiCategoryCreateTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryCreateTestSuite{})
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type EmojiCategoryDeleteTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryDeleteTestSuite) TestEmojiCategoryDelete() {
+	recorder := httptest.NewRecorder()
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	path := admin.EmojiCategoriesPathWithID
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, path, "")
+	ctx.AddParam(apiutil.IDKey, testCategory.ID)
+
+	suite.adminModule.EmojiCategoryDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// Category should no longer be in the db.
+	dbCategory, err := suite.db.GetEmojiCategory(context.Background(), testCategory.ID)
+	suite.Nil(dbCategory)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Emoji that was in the category
+	// should still exist, uncategorized.
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), suite.testEmojis["rainbow"].ID)
+	suite.NoError(err)
+	suite.Empty(dbEmoji.CategoryID)
+	suite.Nil(dbEmoji.Category)
+}
+
+func (suite *EmojiCategoryDeleteTestSuite) TestEmojiCategoryDeleteNotFound() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.EmojiCategoriesPathWithID
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, path, "")
+	ctx.AddParam(apiutil.IDKey, "01JG00000000000000000000AA")
+
+	suite.adminModule.EmojiCategoryDELETEHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestEmojiCategoryDeleteTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryDeleteTestSuite{})
+}
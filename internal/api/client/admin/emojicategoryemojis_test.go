@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+type EmojiCategoryEmojisTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryEmojisTestSuite) TestEmojiCategoryEmojisMoveFromCategory() {
+	var (
+		recorder   = httptest.NewRecorder()
+		toCategory = suite.testEmojiCategories["cute stuff"]
+		from       = suite.testEmojiCategories["reactions"]
+		testEmoji  = suite.testEmojis["rainbow"]
+	)
+
+	path := admin.EmojiCategoryEmojisPath
+	body := []byte(`{"from_category_id":"` + from.ID + `"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+	ctx.AddParam(apiutil.IDKey, toCategory.ID)
+
+	suite.adminModule.EmojiCategoryEmojisPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	var emojis []*apimodel.AdminEmoji
+	suite.NoError(json.Unmarshal(b, &emojis))
+	suite.Len(emojis, 1)
+	suite.Equal(testEmoji.ID, emojis[0].ID)
+	suite.Equal("cute stuff", emojis[0].Category)
+
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal(toCategory.ID, dbEmoji.CategoryID)
+}
+
+func (suite *EmojiCategoryEmojisTestSuite) TestEmojiCategoryEmojisMoveRemote() {
+	var (
+		recorder   = httptest.NewRecorder()
+		toCategory = suite.testEmojiCategories["cute stuff"]
+		testEmoji  = suite.testEmojis["yell"]
+	)
+
+	path := admin.EmojiCategoryEmojisPath
+	body := []byte(`{"emoji_ids":["` + testEmoji.ID + `"]}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+	ctx.AddParam(apiutil.IDKey, toCategory.ID)
+
+	suite.adminModule.EmojiCategoryEmojisPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: emoji `+testEmoji.ID+` is not a local emoji"}`, string(b))
+}
+
+func TestEmojiCategoryEmojisTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryEmojisTestSuite{})
+}
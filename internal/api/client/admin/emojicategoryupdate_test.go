@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+type EmojiCategoryUpdateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) TestEmojiCategoryUpdate() {
+	recorder := httptest.NewRecorder()
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	path := admin.EmojiCategoriesPathWithID
+	body := []byte(`{"name":"reacts"}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, body, path, "application/json")
+	ctx.AddParam(apiutil.IDKey, testCategory.ID)
+
+	suite.adminModule.EmojiCategoryPATCHHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "id": "01GGQ8V4993XK67B2JB396YFB7",
+  "name": "reacts"
+}`, dst.String())
+
+	// Emoji in the category should
+	// now show the updated name.
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), suite.testEmojis["rainbow"].ID)
+	suite.NoError(err)
+	suite.Equal("reacts", dbEmoji.Category.Name)
+}
+
+func (suite *EmojiCategoryUpdateTestSuite) TestEmojiCategoryUpdateConflict() {
+	recorder := httptest.NewRecorder()
+	testCategory := suite.testEmojiCategories["reactions"]
+
+	path := admin.EmojiCategoriesPathWithID
+	body := []byte(`{"name":"cute stuff"}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, body, path, "application/json")
+	ctx.AddParam(apiutil.IDKey, testCategory.ID)
+
+	suite.adminModule.EmojiCategoryPATCHHandler(ctx)
+	suite.Equal(http.StatusConflict, recorder.Code)
+}
+
+func TestEmojiCategoryUpdateTestSuite(t *testing.T) {
+	suite.Run(t, &EmojiCategoryUpdateTestSuite{})
+}
@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InteractionPoliciesTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *InteractionPoliciesTestSuite) call(
+	handler func(*gin.Context),
+	method string,
+	body string,
+	expectedHTTPStatus int,
+) *apimodel.DefaultPolicies {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, method, []byte(body), admin.InteractionPoliciesDefaultsPath, "application/json")
+
+	handler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+	if recorder.Code != http.StatusOK {
+		return nil
+	}
+
+	policies := new(apimodel.DefaultPolicies)
+	if err := json.Unmarshal(b, policies); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return policies
+}
+
+func (suite *InteractionPoliciesTestSuite) TestDefaultInteractionPolicies() {
+	ctx := context.Background()
+
+	// Instance has no defaults set,
+	// so global defaults are returned.
+	policies := suite.call(
+		suite.adminModule.InteractionPoliciesDefaultsGETHandler,
+		http.MethodGet, "", http.StatusOK,
+	)
+	suite.Equal(
+		[]apimodel.PolicyValue{apimodel.PolicyValuePublic},
+		policies.Public.CanReply.Always,
+	)
+
+	// Restrict replies to public
+	// statuses to followers only.
+	policies = suite.call(
+		suite.adminModule.InteractionPoliciesDefaultsPATCHHandler,
+		http.MethodPatch,
+		`{"public":{"can_favourite":{"always":["public"]},"can_reply":{"always":["author","followers"]},"can_reblog":{"always":["public"]}}}`,
+		http.StatusOK,
+	)
+	suite.Equal(
+		[]apimodel.PolicyValue{apimodel.PolicyValueAuthor, apimodel.PolicyValueFollowers, apimodel.PolicyValueMentioned},
+		policies.Public.CanReply.Always,
+	)
+
+	// Policy should now be returned by GET.
+	policies = suite.call(
+		suite.adminModule.InteractionPoliciesDefaultsGETHandler,
+		http.MethodGet, "", http.StatusOK,
+	)
+	suite.Equal(
+		[]apimodel.PolicyValue{apimodel.PolicyValueAuthor, apimodel.PolicyValueFollowers, apimodel.PolicyValueMentioned},
+		policies.Public.CanReply.Always,
+	)
+
+	// New accounts should be seeded with the instance default.
+	user, err := suite.db.NewSignup(ctx, gtsmodel.NewSignup{
+		Username: "someone_new",
+		Email:    "someone_new@example.org",
+		Password: "a very long and very secure password",
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings, err := suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.NotNil(settings.InteractionPolicyPublic) {
+		suite.Equal(
+			gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor, gtsmodel.PolicyValueFollowers, gtsmodel.PolicyValueMentioned},
+			settings.InteractionPolicyPublic.CanReply.Always,
+		)
+	}
+	suite.Nil(settings.InteractionPolicyDirect)
+
+	// Policies that aren't feasible for
+	// the visibility should be rejected.
+	suite.call(
+		suite.adminModule.InteractionPoliciesDefaultsPATCHHandler,
+		http.MethodPatch,
+		`{"direct":{"can_reply":{"always":["public"]}}}`,
+		http.StatusUnprocessableEntity,
+	)
+
+	// Empty body resets to global defaults.
+	policies = suite.call(
+		suite.adminModule.InteractionPoliciesDefaultsPATCHHandler,
+		http.MethodPatch, `{}`, http.StatusOK,
+	)
+	suite.Equal(
+		[]apimodel.PolicyValue{apimodel.PolicyValuePublic},
+		policies.Public.CanReply.Always,
+	)
+}
+
+func TestInteractionPoliciesTestSuite(t *testing.T) {
+	suite.Run(t, &InteractionPoliciesTestSuite{})
+}
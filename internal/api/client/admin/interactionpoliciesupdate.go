@@ -0,0 +1,230 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// InteractionPoliciesDefaultsPATCHHandler swagger:operation PATCH /api/v1/admin/interaction_policies/defaults adminInteractionPoliciesDefaultsUpdate
+//
+// Update instance-wide default interaction policies per visibility level.
+//
+// These policies are copied into the settings of accounts created after the update.
+// Existing accounts keep their current default policies.
+//
+// Form data and JSON bodies use the same format as /api/v1/interaction_policies/defaults.
+//
+// Any visibility level left unspecified in the request body will be returned to the global default.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/x-www-form-urlencoded
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: public[can_favourite][always][0]
+//		in: formData
+//		description: Nth entry for public.can_favourite.always.
+//		type: string
+//	-
+//		name: public[can_favourite][with_approval][0]
+//		in: formData
+//		description: Nth entry for public.can_favourite.with_approval.
+//		type: string
+//	-
+//		name: public[can_reply][always][0]
+//		in: formData
+//		description: Nth entry for public.can_reply.always.
+//		type: string
+//	-
+//		name: public[can_reply][with_approval][0]
+//		in: formData
+//		description: Nth entry for public.can_reply.with_approval.
+//		type: string
+//	-
+//		name: public[can_reblog][always][0]
+//		in: formData
+//		description: Nth entry for public.can_reblog.always.
+//		type: string
+//	-
+//		name: public[can_reblog][with_approval][0]
+//		in: formData
+//		description: Nth entry for public.can_reblog.with_approval.
+//		type: string
+//
+//	-
+//		name: unlisted[can_favourite][always][0]
+//		in: formData
+//		description: Nth entry for unlisted.can_favourite.always.
+//		type: string
+//	-
+//		name: unlisted[can_favourite][with_approval][0]
+//		in: formData
+//		description: Nth entry for unlisted.can_favourite.with_approval.
+//		type: string
+//	-
+//		name: unlisted[can_reply][always][0]
+//		in: formData
+//		description: Nth entry for unlisted.can_reply.always.
+//		type: string
+//	-
+//		name: unlisted[can_reply][with_approval][0]
+//		in: formData
+//		description: Nth entry for unlisted.can_reply.with_approval.
+//		type: string
+//	-
+//		name: unlisted[can_reblog][always][0]
+//		in: formData
+//		description: Nth entry for unlisted.can_reblog.always.
+//		type: string
+//	-
+//		name: unlisted[can_reblog][with_approval][0]
+//		in: formData
+//		description: Nth entry for unlisted.can_reblog.with_approval.
+//		type: string
+//
+//	-
+//		name: private[can_favourite][always][0]
+//		in: formData
+//		description: Nth entry for private.can_favourite.always.
+//		type: string
+//	-
+//		name: private[can_favourite][with_approval][0]
+//		in: formData
+//		description: Nth entry for private.can_favourite.with_approval.
+//		type: string
+//	-
+//		name: private[can_reply][always][0]
+//		in: formData
+//		description: Nth entry for private.can_reply.always.
+//		type: string
+//	-
+//		name: private[can_reply][with_approval][0]
+//		in: formData
+//		description: Nth entry for private.can_reply.with_approval.
+//		type: string
+//	-
+//		name: private[can_reblog][always][0]
+//		in: formData
+//		description: Nth entry for private.can_reblog.always.
+//		type: string
+//	-
+//		name: private[can_reblog][with_approval][0]
+//		in: formData
+//		description: Nth entry for private.can_reblog.with_approval.
+//		type: string
+//
+//	-
+//		name: direct[can_favourite][always][0]
+//		in: formData
+//		description: Nth entry for direct.can_favourite.always.
+//		type: string
+//	-
+//		name: direct[can_favourite][with_approval][0]
+//		in: formData
+//		description: Nth entry for direct.can_favourite.with_approval.
+//		type: string
+//	-
+//		name: direct[can_reply][always][0]
+//		in: formData
+//		description: Nth entry for direct.can_reply.always.
+//		type: string
+//	-
+//		name: direct[can_reply][with_approval][0]
+//		in: formData
+//		description: Nth entry for direct.can_reply.with_approval.
+//		type: string
+//	-
+//		name: direct[can_reblog][always][0]
+//		in: formData
+//		description: Nth entry for direct.can_reblog.always.
+//		type: string
+//	-
+//		name: direct[can_reblog][with_approval][0]
+//		in: formData
+//		description: Nth entry for direct.can_reblog.with_approval.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: Updated default policies object containing a policy for each status visibility.
+//			schema:
+//				"$ref": "#/definitions/defaultPolicies"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: unprocessable
+//		'500':
+//			description: internal server error
+func (m *Module) InteractionPoliciesDefaultsPATCHHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form, err := apiutil.ParseUpdateInteractionPoliciesForm(c)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	resp, errWithCode := m.processor.Admin().DefaultInteractionPoliciesUpdate(c.Request.Context(), authed.Account, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp)
+}
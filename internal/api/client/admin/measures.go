@@ -0,0 +1,263 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// maxMeasurePeriod is the longest period
+// measures, dimensions and retention data
+// can be requested for in one go.
+const maxMeasurePeriod = 366 * 24 * time.Hour
+
+// MeasuresPOSTHandler swagger:operation POST /api/v1/admin/measures adminMeasures
+//
+// Get quantitative data about the instance, split into daily buckets.
+//
+// Measures that require extra params take them as eg., `tag_uses[id]` or
+// `instance_statuses[domain]` (or as nested JSON objects, eg., `{"tag_uses": {"id": "..."}}`).
+//
+// Active users are local users who logged in or posted on a given day.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: keys[]
+//		in: formData
+//		description: |-
+//			Keys of the measures to get. Unknown keys are ignored.
+//			Tag measures require `id` param, instance measures require `domain` param.
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- active_users
+//				- new_users
+//				- interactions
+//				- opened_reports
+//				- resolved_reports
+//				- tag_accounts
+//				- tag_uses
+//				- tag_servers
+//				- instance_accounts
+//				- instance_media_attachments
+//				- instance_reports
+//				- instance_statuses
+//				- instance_follows
+//				- instance_followers
+//		required: true
+//	-
+//		name: start_at
+//		in: formData
+//		description: First day of the period to get data for (ISO 8601 Date or Datetime). Defaults to 29 days before end_at.
+//		type: string
+//	-
+//		name: end_at
+//		in: formData
+//		description: Last day of the period to get data for (ISO 8601 Date or Datetime). Defaults to today.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminMeasure"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) MeasuresPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := new(apimodel.AdminMeasuresRequest)
+	form.Params, err = bindWithParams(c, form, &form.Keys)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	start, end, errWithCode := parseMeasurePeriod(form.StartAt, form.EndAt)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	measures, errWithCode := m.processor.Admin().MeasuresGet(
+		c.Request.Context(),
+		form.Keys,
+		start,
+		end,
+		form.Params,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, measures)
+}
+
+// bindWithParams binds the request to the given form, then
+// gathers params for each of the requested keys, given as
+// form fields like `key[param]`, or nested JSON objects.
+func bindWithParams(c *gin.Context, form any, keys *[]string) (map[string]map[string]string, error) {
+	params := make(map[string]map[string]string)
+
+	if c.ContentType() == binding.MIMEJSON {
+		// Bind JSON twice, once for the form and once for
+		// the raw objects, so the body needs to be cached.
+		if err := c.ShouldBindBodyWith(form, binding.JSON); err != nil {
+			return nil, err
+		}
+
+		raw := make(map[string]json.RawMessage)
+		if err := c.ShouldBindBodyWith(&raw, binding.JSON); err != nil {
+			return nil, err
+		}
+
+		for _, key := range *keys {
+			rawParams, ok := raw[key]
+			if !ok {
+				continue
+			}
+
+			keyParams := make(map[string]string)
+			if err := json.Unmarshal(rawParams, &keyParams); err != nil {
+				return nil, fmt.Errorf("error parsing %s params: %w", key, err)
+			}
+
+			params[key] = keyParams
+		}
+
+		return params, nil
+	}
+
+	if err := c.ShouldBind(form); err != nil {
+		return nil, err
+	}
+
+	for _, key := range *keys {
+		if keyParams := c.PostFormMap(key); len(keyParams) != 0 {
+			params[key] = keyParams
+		}
+	}
+
+	return params, nil
+}
+
+// parseMeasurePeriod parses the given start and end dates into
+// the period from midnight (UTC) on the start date until midnight
+// after the end date, defaulting to the 30 days up to today.
+func parseMeasurePeriod(startAt string, endAt string) (time.Time, time.Time, gtserror.WithCode) {
+	parse := func(name string, value string) (time.Time, gtserror.WithCode) {
+		for _, layout := range []string{time.DateOnly, time.RFC3339} {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.UTC().Truncate(24 * time.Hour), nil
+			}
+		}
+
+		text := fmt.Sprintf("%s must be an ISO 8601 date or datetime", name)
+		return time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	var (
+		end   = time.Now().UTC().Truncate(24 * time.Hour)
+		start time.Time
+
+		errWithCode gtserror.WithCode
+	)
+
+	if endAt != "" {
+		end, errWithCode = parse("end_at", endAt)
+		if errWithCode != nil {
+			return time.Time{}, time.Time{}, errWithCode
+		}
+	}
+
+	if startAt != "" {
+		start, errWithCode = parse("start_at", startAt)
+		if errWithCode != nil {
+			return time.Time{}, time.Time{}, errWithCode
+		}
+	} else {
+		start = end.AddDate(0, 0, -29)
+	}
+
+	// End date is inclusive.
+	end = end.AddDate(0, 0, 1)
+
+	if !start.Before(end) {
+		const text = "start_at must not be after end_at"
+		return time.Time{}, time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if end.Sub(start) > maxMeasurePeriod {
+		const text = "period between start_at and end_at must not be longer than 366 days"
+		return time.Time{}, time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,263 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MeasuresTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *MeasuresTestSuite) post(
+	handler func(*gin.Context),
+	path string,
+	body string,
+	expectedHTTPStatus int,
+	dst any,
+) string {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), path, "application/json")
+
+	handler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+
+	if dst != nil && recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(b, dst); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	return string(b)
+}
+
+func (suite *MeasuresTestSuite) TestMeasures() {
+	var measures []map[string]any
+	suite.post(suite.adminModule.MeasuresPOSTHandler, admin.MeasuresPath, `{
+  "keys": ["new_users", "instance_statuses", "unknown_key"],
+  "start_at": "2022-05-31",
+  "end_at": "2022-06-02",
+  "instance_statuses": {"domain": "fossbros-anonymous.io"}
+}`, http.StatusOK, &measures)
+
+	// Unknown key should be ignored.
+	suite.Len(measures, 2)
+
+	b, err := json.MarshalIndent(measures[0], "", "  ")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Admin and local_account_1 were
+	// created on 2022-06-01, nobody in
+	// the previous three days.
+	suite.Equal(`{
+  "data": [
+    {
+      "date": "2022-05-31T00:00:00.000Z",
+      "value": "0"
+    },
+    {
+      "date": "2022-06-01T00:00:00.000Z",
+      "value": "2"
+    },
+    {
+      "date": "2022-06-02T00:00:00.000Z",
+      "value": "0"
+    }
+  ],
+  "key": "new_users",
+  "previous_total": "0",
+  "total": "2",
+  "unit": null
+}`, string(b))
+
+	suite.Equal("instance_statuses", measures[1]["key"])
+}
+
+func (suite *MeasuresTestSuite) TestMeasuresAllKeys() {
+	tagID := testrig.NewTestTags()["welcome"].ID
+
+	var measures []map[string]any
+	suite.post(suite.adminModule.MeasuresPOSTHandler, admin.MeasuresPath, `{
+  "keys": [
+    "active_users",
+    "new_users",
+    "interactions",
+    "opened_reports",
+    "resolved_reports",
+    "tag_accounts",
+    "tag_uses",
+    "tag_servers",
+    "instance_accounts",
+    "instance_media_attachments",
+    "instance_reports",
+    "instance_statuses",
+    "instance_follows",
+    "instance_followers"
+  ],
+  "start_at": "2021-01-01",
+  "end_at": "2021-12-31",
+  "tag_accounts": {"id": "`+tagID+`"},
+  "tag_uses": {"id": "`+tagID+`"},
+  "tag_servers": {"id": "`+tagID+`"},
+  "instance_accounts": {"domain": "fossbros-anonymous.io"},
+  "instance_media_attachments": {"domain": "fossbros-anonymous.io"},
+  "instance_reports": {"domain": "fossbros-anonymous.io"},
+  "instance_statuses": {"domain": "fossbros-anonymous.io"},
+  "instance_follows": {"domain": "fossbros-anonymous.io"},
+  "instance_followers": {"domain": "fossbros-anonymous.io"}
+}`, http.StatusOK, &measures)
+
+	suite.Len(measures, 14)
+	for _, measure := range measures {
+		suite.Len(measure["data"], 365)
+	}
+
+	// Media measure has a unit.
+	suite.Equal("instance_media_attachments", measures[9]["key"])
+	suite.Equal("bytes", measures[9]["unit"])
+}
+
+func (suite *MeasuresTestSuite) TestMeasuresMissingParam() {
+	body := suite.post(suite.adminModule.MeasuresPOSTHandler, admin.MeasuresPath, `{
+  "keys": ["tag_uses"]
+}`, http.StatusBadRequest, nil)
+	suite.Equal(`{"error":"Bad Request: tag_uses[id] must be provided for tag_uses"}`, body)
+}
+
+func (suite *MeasuresTestSuite) TestMeasuresBadPeriod() {
+	body := suite.post(suite.adminModule.MeasuresPOSTHandler, admin.MeasuresPath, `{
+  "keys": ["new_users"],
+  "start_at": "2022-06-02",
+  "end_at": "2022-05-01"
+}`, http.StatusBadRequest, nil)
+	suite.Equal(`{"error":"Bad Request: start_at must not be after end_at"}`, body)
+}
+
+func (suite *MeasuresTestSuite) TestDimensions() {
+	var dimensions []map[string]any
+	suite.post(suite.adminModule.DimensionsPOSTHandler, admin.DimensionsPath, `{
+  "keys": ["software_versions", "space_usage"],
+  "limit": 2
+}`, http.StatusOK, &dimensions)
+
+	suite.Len(dimensions, 2)
+	suite.Equal("software_versions", dimensions[0]["key"])
+	suite.Len(dimensions[0]["data"], 2)
+	suite.Equal("space_usage", dimensions[1]["key"])
+	suite.Len(dimensions[1]["data"], 2)
+}
+
+func (suite *MeasuresTestSuite) TestDimensionsAllKeys() {
+	tagID := testrig.NewTestTags()["welcome"].ID
+
+	var dimensions []map[string]any
+	suite.post(suite.adminModule.DimensionsPOSTHandler, admin.DimensionsPath, `{
+  "keys": [
+    "languages",
+    "servers",
+    "space_usage",
+    "software_versions",
+    "tag_servers",
+    "tag_languages",
+    "instance_accounts",
+    "instance_languages"
+  ],
+  "start_at": "2021-01-01",
+  "end_at": "2021-12-31",
+  "tag_servers": {"id": "`+tagID+`"},
+  "tag_languages": {"id": "`+tagID+`"},
+  "instance_accounts": {"domain": "fossbros-anonymous.io"},
+  "instance_languages": {"domain": "fossbros-anonymous.io"}
+}`, http.StatusOK, &dimensions)
+
+	suite.Len(dimensions, 8)
+}
+
+func (suite *MeasuresTestSuite) TestRetention() {
+	var cohorts []map[string]any
+	suite.post(suite.adminModule.RetentionPOSTHandler, admin.RetentionPath, `{
+  "start_at": "2022-06-01",
+  "end_at": "2022-06-02",
+  "frequency": "day"
+}`, http.StatusOK, &cohorts)
+
+	b, err := json.MarshalIndent(cohorts, "", "  ")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Two users signed up on the first day.
+	suite.Equal(`[
+  {
+    "data": [
+      {
+        "date": "2022-06-01T00:00:00.000Z",
+        "rate": 1,
+        "value": "2"
+      },
+      {
+        "date": "2022-06-02T00:00:00.000Z",
+        "rate": 0,
+        "value": "0"
+      }
+    ],
+    "frequency": "day",
+    "period": "2022-06-01T00:00:00.000Z"
+  },
+  {
+    "data": [
+      {
+        "date": "2022-06-02T00:00:00.000Z",
+        "rate": 0,
+        "value": "0"
+      }
+    ],
+    "frequency": "day",
+    "period": "2022-06-02T00:00:00.000Z"
+  }
+]`, string(b))
+}
+
+func (suite *MeasuresTestSuite) TestRetentionBadFrequency() {
+	body := suite.post(suite.adminModule.RetentionPOSTHandler, admin.RetentionPath, `{
+  "frequency": "fortnight"
+}`, http.StatusBadRequest, nil)
+	suite.Equal(`{"error":"Bad Request: frequency must be one of day, month"}`, body)
+}
+
+func TestMeasuresTestSuite(t *testing.T) {
+	suite.Run(t, &MeasuresTestSuite{})
+}
@@ -0,0 +1,175 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+type MediaRetentionPolicyTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *MediaRetentionPolicyTestSuite) call(
+	handler func(*gin.Context),
+	path string,
+	id string,
+	body string,
+	expectedHTTPStatus int,
+	dst any,
+) string {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), path, "application/json")
+	if id != "" {
+		ctx.AddParam(apiutil.IDKey, id)
+	}
+
+	handler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+
+	if dst != nil && recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(b, dst); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	return string(b)
+}
+
+func (suite *MediaRetentionPolicyTestSuite) TestMediaRetentionPolicyLifecycle() {
+	var (
+		adminAcct = suite.testAccounts["admin_account"]
+		policy    apimodel.MediaRetentionPolicy
+		policies  []apimodel.MediaRetentionPolicy
+	)
+
+	// Create a policy.
+	suite.call(
+		suite.adminModule.MediaRetentionPoliciesPOSTHandler,
+		admin.MediaRetentionPoliciesPath, "",
+		`{"domain":"fossbros-anonymous.io","remote_cache_days":2,"private_comment":"big instance"}`,
+		http.StatusOK, &policy,
+	)
+	suite.NotEmpty(policy.ID)
+	suite.Equal("fossbros-anonymous.io", policy.Domain)
+	suite.Equal(2, policy.RemoteCacheDays)
+	suite.Equal("big instance", policy.PrivateComment)
+	suite.Equal(adminAcct.ID, policy.CreatedBy)
+
+	// Creating another for the same domain should conflict.
+	suite.call(
+		suite.adminModule.MediaRetentionPoliciesPOSTHandler,
+		admin.MediaRetentionPoliciesPath, "",
+		`{"domain":"fossbros-anonymous.io","remote_cache_days":5}`,
+		http.StatusConflict, nil,
+	)
+
+	// List policies.
+	suite.call(
+		suite.adminModule.MediaRetentionPoliciesGETHandler,
+		admin.MediaRetentionPoliciesPath, "", "",
+		http.StatusOK, &policies,
+	)
+	if suite.Len(policies, 1) {
+		suite.Equal(policy.ID, policies[0].ID)
+	}
+
+	// Update the policy to keep media indefinitely.
+	suite.call(
+		suite.adminModule.MediaRetentionPolicyPATCHHandler,
+		admin.MediaRetentionPoliciesPath, policy.ID,
+		`{"remote_cache_days":0}`,
+		http.StatusOK, &policy,
+	)
+	suite.Equal(0, policy.RemoteCacheDays)
+	suite.Equal("big instance", policy.PrivateComment)
+
+	// Get the updated policy.
+	suite.call(
+		suite.adminModule.MediaRetentionPolicyGETHandler,
+		admin.MediaRetentionPoliciesPath, policy.ID, "",
+		http.StatusOK, &policy,
+	)
+	suite.Equal(0, policy.RemoteCacheDays)
+
+	// Delete the policy.
+	suite.call(
+		suite.adminModule.MediaRetentionPolicyDELETEHandler,
+		admin.MediaRetentionPoliciesPath, policy.ID, "",
+		http.StatusOK, nil,
+	)
+
+	// It should now be gone.
+	suite.call(
+		suite.adminModule.MediaRetentionPolicyGETHandler,
+		admin.MediaRetentionPoliciesPath, policy.ID, "",
+		http.StatusNotFound, nil,
+	)
+}
+
+func (suite *MediaRetentionPolicyTestSuite) TestMediaRetentionPolicyCreateBad() {
+	for _, test := range []struct {
+		body     string
+		expected string
+	}{
+		{
+			body:     `{"remote_cache_days":2}`,
+			expected: `{"error":"Bad Request: domain must be set"}`,
+		},
+		{
+			body:     `{"domain":"fossbros-anonymous.io"}`,
+			expected: `{"error":"Bad Request: remote_cache_days must be set"}`,
+		},
+		{
+			body:     `{"domain":"fossbros-anonymous.io","remote_cache_days":-1}`,
+			expected: `{"error":"Bad Request: remote_cache_days must be 0 (keep indefinitely) or greater"}`,
+		},
+		{
+			body:     `{"domain":"localhost:8080","remote_cache_days":2}`,
+			expected: `{"error":"Bad Request: cannot create media retention policy for this instance's own domain"}`,
+		},
+	} {
+		resp := suite.call(
+			suite.adminModule.MediaRetentionPoliciesPOSTHandler,
+			admin.MediaRetentionPoliciesPath, "",
+			test.body,
+			http.StatusBadRequest, nil,
+		)
+		suite.Equal(test.expected, resp)
+	}
+}
+
+func TestMediaRetentionPolicyTestSuite(t *testing.T) {
+	suite.Run(t, &MediaRetentionPolicyTestSuite{})
+}
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// RequirePermission returns a middleware that aborts the request
+// with 403 Forbidden unless the authorized user is an admin, or
+// has a custom role that grants them the given permission(s).
+//
+// Handlers behind this middleware shouldn't do their own admin check.
+func (m *Module) RequirePermission(perm gtsmodel.RolePermissions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authed, err := oauth.Authed(c, true, true, true, true)
+		if err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+			c.Abort()
+			return
+		}
+
+		if !authed.User.HasPermission(perm) {
+			err := fmt.Errorf("user %s does not have permission %s", authed.User.ID, perm)
+			apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,143 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+type RelayTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *RelayTestSuite) call(
+	handler func(*gin.Context),
+	id string,
+	body string,
+	expectedHTTPStatus int,
+	dst any,
+) string {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), admin.RelaysPath, "application/json")
+	if id != "" {
+		ctx.AddParam(apiutil.IDKey, id)
+	}
+
+	handler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+
+	if dst != nil && recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(b, dst); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	return string(b)
+}
+
+func (suite *RelayTestSuite) TestRelayLifecycle() {
+	var (
+		adminAcct = suite.testAccounts["admin_account"]
+		relayAcct = suite.testAccounts["remote_account_1"]
+		relay     apimodel.Relay
+		relays    []apimodel.Relay
+	)
+
+	// Subscribe to a relay.
+	suite.call(
+		suite.adminModule.RelaysPOSTHandler, "",
+		`{"actor_uri":"`+relayAcct.URI+`"}`,
+		http.StatusOK, &relay,
+	)
+	suite.NotEmpty(relay.ID)
+	suite.Equal(relayAcct.URI, relay.ActorURI)
+	suite.Equal(*relayAcct.SharedInboxURI, relay.InboxURI)
+	suite.Equal("pending", relay.State)
+	suite.True(relay.Enabled)
+	suite.Equal(adminAcct.ID, relay.CreatedBy)
+
+	// Subscribing again should conflict.
+	suite.call(
+		suite.adminModule.RelaysPOSTHandler, "",
+		`{"actor_uri":"`+relayAcct.URI+`"}`,
+		http.StatusConflict, nil,
+	)
+
+	// Subscribing to a nonsense uri should fail.
+	suite.call(
+		suite.adminModule.RelaysPOSTHandler, "",
+		`{"actor_uri":"not a url"}`,
+		http.StatusBadRequest, nil,
+	)
+
+	// List relays.
+	suite.call(
+		suite.adminModule.RelaysGETHandler, "", "",
+		http.StatusOK, &relays,
+	)
+	if suite.Len(relays, 1) {
+		suite.Equal(relay.ID, relays[0].ID)
+	}
+
+	// Disable the relay.
+	suite.call(
+		suite.adminModule.RelayPATCHHandler, relay.ID,
+		`{"enabled":false}`,
+		http.StatusOK, &relay,
+	)
+	suite.False(relay.Enabled)
+
+	// Get the updated relay.
+	suite.call(
+		suite.adminModule.RelayGETHandler, relay.ID, "",
+		http.StatusOK, &relay,
+	)
+	suite.False(relay.Enabled)
+
+	// Unsubscribe from the relay.
+	suite.call(
+		suite.adminModule.RelayDELETEHandler, relay.ID, "",
+		http.StatusOK, nil,
+	)
+
+	// It should now be gone.
+	suite.call(
+		suite.adminModule.RelayGETHandler, relay.ID, "",
+		http.StatusNotFound, nil,
+	)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
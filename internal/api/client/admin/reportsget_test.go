@@ -90,8 +90,12 @@ func (suite *ReportsGetTestSuite) getReports(
 	ctx.Request = httptest.NewRequest(http.MethodGet, requestURI, nil)
 	ctx.Request.Header.Set("accept", "application/json")
 
-	// trigger the handler
-	suite.adminModule.ReportsGETHandler(ctx)
+	// trigger the handler, behind
+	// the same middleware as in Route
+	suite.adminModule.RequirePermission(gtsmodel.RolePermissionManageReports)(ctx)
+	if !ctx.IsAborted() {
+		suite.adminModule.ReportsGETHandler(ctx)
+	}
 
 	// read the response
 	result := recorder.Result()
@@ -265,7 +269,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "id": "admin",
         "name": "admin",
         "color": "",
-        "permissions": "546033",
+        "permissions": "546037",
         "highlighted": true
       },
       "confirmed": true,
@@ -320,7 +324,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "id": "admin",
         "name": "admin",
         "color": "",
-        "permissions": "546033",
+        "permissions": "546037",
         "highlighted": true
       },
       "confirmed": true,
@@ -363,13 +367,14 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
     },
     "statuses": [],
     "rules": [],
+    "rule_ids": [],
     "action_taken_comment": "user was warned not to be a turtle anymore"
   },
   {
     "id": "01GP3AWY4CRDVRNZKW0TEAMB5R",
     "action_taken": false,
     "action_taken_at": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "created_at": "2022-05-14T10:20:03.000Z",
@@ -597,6 +602,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "text": "Do crime"
       }
     ],
+    "rule_ids": [
+      "01GP3AWY4CRDVRNZKW0TEAMB51",
+      "01GP3DFY9XQ1TJMZT5BGAZPXX3"
+    ],
     "action_taken_comment": null
   }
 ]`, string(b))
@@ -622,7 +631,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
     "id": "01GP3AWY4CRDVRNZKW0TEAMB5R",
     "action_taken": false,
     "action_taken_at": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "created_at": "2022-05-14T10:20:03.000Z",
@@ -850,6 +859,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "text": "Do crime"
       }
     ],
+    "rule_ids": [
+      "01GP3AWY4CRDVRNZKW0TEAMB51",
+      "01GP3DFY9XQ1TJMZT5BGAZPXX3"
+    ],
     "action_taken_comment": null
   }
 ]`, string(b))
@@ -875,7 +888,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
     "id": "01GP3AWY4CRDVRNZKW0TEAMB5R",
     "action_taken": false,
     "action_taken_at": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "created_at": "2022-05-14T10:20:03.000Z",
@@ -1103,6 +1116,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "text": "Do crime"
       }
     ],
+    "rule_ids": [
+      "01GP3AWY4CRDVRNZKW0TEAMB51",
+      "01GP3DFY9XQ1TJMZT5BGAZPXX3"
+    ],
     "action_taken_comment": null
   }
 ]`, string(b))
@@ -1133,7 +1150,35 @@ func (suite *ReportsGetTestSuite) TestReportsGetNotAdmin() {
 	testToken := suite.testTokens["local_account_1"]
 	testUser := suite.testUsers["local_account_1"]
 
-	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 not an admin"}`, nil, "", "", "", "", "", 20)
+	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 does not have permission manage_reports"}`, nil, "", "", "", "", "", 20)
+	suite.NoError(err)
+	suite.Empty(reports)
+}
+
+func (suite *ReportsGetTestSuite) TestReportsGetWithRole() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	// Give the non-admin user a
+	// role that lets them see reports.
+	testUser := new(gtsmodel.User)
+	*testUser = *suite.testUsers["local_account_1"]
+	testUser.Role = &gtsmodel.Role{
+		ID:          "01JK8QF3XQ0W8HWM9NM2QZ6BK0",
+		Name:        "Report Wrangler",
+		Permissions: gtsmodel.RolePermissionManageReports,
+		Highlighted: util.Ptr(false),
+	}
+	testUser.RoleID = testUser.Role.ID
+
+	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusOK, "", nil, "", "", "", "", "", 20)
+	suite.NoError(err)
+	suite.NotEmpty(reports)
+
+	// Without the permission
+	// they're forbidden again.
+	testUser.Role.Permissions = gtsmodel.RolePermissionViewAuditLog
+	reports, _, err = suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 does not have permission manage_reports"}`, nil, "", "", "", "", "", 20)
 	suite.NoError(err)
 	suite.Empty(reports)
 }
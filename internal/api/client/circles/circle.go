@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circles
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	IDKey = "id"
+	// BasePath is the base path for serving the circles API, minus the 'api' prefix
+	BasePath       = "/v1/circles"
+	BasePathWithID = BasePath + "/:" + IDKey
+	AccountsPath   = BasePathWithID + "/accounts"
+	MaxIDKey       = "max_id"
+	LimitKey       = "limit"
+	SinceIDKey     = "since_id"
+	MinIDKey       = "min_id"
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / update / delete circles
+	attachHandler(http.MethodPost, BasePath, m.CircleCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePath, m.CirclesGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.CircleGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, m.CircleUpdatePUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.CircleDELETEHandler)
+
+	// get / add / remove circle accounts
+	attachHandler(http.MethodGet, AccountsPath, m.CircleAccountsGETHandler)
+	attachHandler(http.MethodPost, AccountsPath, m.CircleAccountsPOSTHandler)
+	attachHandler(http.MethodDelete, AccountsPath, m.CircleAccountsDELETEHandler)
+}
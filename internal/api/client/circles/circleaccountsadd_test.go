@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circles_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"codeberg.org/gruf/go-bytes"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/circles"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type CircleAccountsAddTestSuite struct {
+	CirclesStandardTestSuite
+}
+
+func (suite *CircleAccountsAddTestSuite) newCircle() *gtsmodel.Circle {
+	circle := &gtsmodel.Circle{
+		ID:        id.NewULID(),
+		Title:     "Close friends",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+	}
+
+	if err := suite.db.PutCircle(context.Background(), circle); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return circle
+}
+
+func (suite *CircleAccountsAddTestSuite) postCircleAccounts(
+	expectedHTTPStatus int,
+	circleID string,
+	accountIDs []string,
+) ([]byte, error) {
+	var (
+		recorder = httptest.NewRecorder()
+		ctx, _   = testrig.CreateGinTestContext(recorder, nil)
+	)
+
+	// Prepare test context.
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// Inject path parameters.
+	ctx.AddParam("id", circleID)
+
+	// Inject query parameters.
+	requestPath := config.GetProtocol() + "://" + config.GetHost() + "/api/" + circles.BasePath + "/" + circleID + "/accounts"
+
+	// Prepare test body.
+	buf, w, err := testrig.CreateMultipartFormData(nil, map[string][]string{
+		"account_ids[]": accountIDs,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Prepare test context request.
+	request := httptest.NewRequest(http.MethodPost, requestPath, bytes.NewReader(buf.Bytes()))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", w.FormDataContentType())
+	ctx.Request = request
+
+	// trigger the handler
+	suite.circlesModule.CircleAccountsPOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check status code.
+	if status := recorder.Code; expectedHTTPStatus != status {
+		err = fmt.Errorf("expected %d got %d", expectedHTTPStatus, status)
+	}
+
+	return b, err
+}
+
+func (suite *CircleAccountsAddTestSuite) TestPostCircleAccountNotFollower() {
+	circle := suite.newCircle()
+
+	// remote_account_1 doesn't
+	// follow local_account_1.
+	accountIDs := []string{
+		suite.testAccounts["remote_account_1"].ID,
+	}
+
+	resp, err := suite.postCircleAccounts(http.StatusNotFound, circle.ID, accountIDs)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Not Found: account 01F8MH5ZK5VRH73AKHQM6Y9VNX does not currently follow you"}`, string(resp))
+}
+
+func (suite *CircleAccountsAddTestSuite) TestPostCircleAccountOK() {
+	var (
+		ctx      = context.Background()
+		circle   = suite.newCircle()
+		turtleID = suite.testAccounts["local_account_2"].ID
+	)
+
+	resp, err := suite.postCircleAccounts(http.StatusOK, circle.ID, []string{turtleID})
+	suite.NoError(err)
+	suite.Equal(`{}`, string(resp))
+
+	in, err := suite.db.IsAccountInCircle(ctx, circle.ID, turtleID)
+	suite.NoError(err)
+	suite.True(in)
+
+	// Adding again should fail.
+	resp, err = suite.postCircleAccounts(http.StatusUnprocessableEntity, circle.ID, []string{turtleID})
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: account `+turtleID+` is already in circle `+circle.ID+`"}`, string(resp))
+}
+
+func TestCircleAccountsAddTestSuite(t *testing.T) {
+	suite.Run(t, new(CircleAccountsAddTestSuite))
+}
@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circles_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/circles"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type CircleCreateTestSuite struct {
+	CirclesStandardTestSuite
+}
+
+func (suite *CircleCreateTestSuite) postCircle(
+	expectedHTTPStatus int,
+	title string,
+) ([]byte, error) {
+	var (
+		recorder = httptest.NewRecorder()
+		ctx, _   = testrig.CreateGinTestContext(recorder, nil)
+	)
+
+	// Prepare test context.
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// Prepare test context request.
+	requestPath := config.GetProtocol() + "://" + config.GetHost() + "/api/" + circles.BasePath
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestPath, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{"title": {title}}
+
+	// trigger the handler
+	suite.circlesModule.CircleCreatePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check status code.
+	if status := recorder.Code; expectedHTTPStatus != status {
+		err = fmt.Errorf("expected %d got %d", expectedHTTPStatus, status)
+	}
+
+	return b, err
+}
+
+func (suite *CircleCreateTestSuite) TestPostCircle() {
+	b, err := suite.postCircle(http.StatusOK, "Close friends")
+	suite.NoError(err)
+
+	circle := &apimodel.Circle{}
+	if err := json.Unmarshal(b, circle); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(circle.ID)
+	suite.Equal("Close friends", circle.Title)
+}
+
+func (suite *CircleCreateTestSuite) TestPostCircleDuplicateTitle() {
+	_, err := suite.postCircle(http.StatusOK, "Close friends")
+	suite.NoError(err)
+
+	b, err := suite.postCircle(http.StatusConflict, "Close friends")
+	suite.NoError(err)
+	suite.Equal(`{"error":"Conflict: you already have a circle with this title"}`, string(b))
+}
+
+func (suite *CircleCreateTestSuite) TestPostCircleNoTitle() {
+	b, err := suite.postCircle(http.StatusBadRequest, "")
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: circle title must be provided, and must be no more than 200 chars"}`, string(b))
+}
+
+func TestCircleCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(CircleCreateTestSuite))
+}
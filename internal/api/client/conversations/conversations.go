@@ -32,6 +32,12 @@
 	BasePathWithID = BasePath + "/:" + apiutil.IDKey
 	// ReadPathWithID is the path for marking an existing conversation as read.
 	ReadPathWithID = BasePathWithID + "/read"
+	// UnreadPathWithID is the path for marking an existing conversation as unread.
+	UnreadPathWithID = BasePathWithID + "/unread"
+	// MutePathWithID is the path for muting an existing conversation.
+	MutePathWithID = BasePathWithID + "/mute"
+	// UnmutePathWithID is the path for unmuting an existing conversation.
+	UnmutePathWithID = BasePathWithID + "/unmute"
 )
 
 type Module struct {
@@ -48,4 +54,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.ConversationsGETHandler)
 	attachHandler(http.MethodDelete, BasePathWithID, m.ConversationDELETEHandler)
 	attachHandler(http.MethodPost, ReadPathWithID, m.ConversationReadPOSTHandler)
+	attachHandler(http.MethodPost, UnreadPathWithID, m.ConversationUnreadPOSTHandler)
+	attachHandler(http.MethodPost, MutePathWithID, m.ConversationMutePOSTHandler)
+	attachHandler(http.MethodPost, UnmutePathWithID, m.ConversationUnmutePOSTHandler)
 }
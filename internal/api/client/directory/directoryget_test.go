@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package directory_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/directory"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func (suite *DirectoryTestSuite) getDirectory(
+	accountFixtureName string,
+	query string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) ([]*apimodel.Account, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountFixtureName])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountFixtureName]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountFixtureName])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodGet, config.GetProtocol()+"://"+config.GetHost()+"/api/"+directory.BasePath+"?"+query, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// trigger the handler
+	suite.directoryModule.DirectoryGETHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.NewMultiError(2)
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs.Appendf("expected %d got %d", expectedHTTPStatus, resultCode)
+		if expectedBody == "" {
+			return nil, errs.Combine()
+		}
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs.Appendf("expected %s got %s", expectedBody, string(b))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := []*apimodel.Account{}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func accts(accounts []*apimodel.Account) []string {
+	accts := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		accts = append(accts, account.Acct)
+	}
+	return accts
+}
+
+func (suite *DirectoryTestSuite) TestGetLocal() {
+	accounts, err := suite.getDirectory("local_account_1", "local=true&order=new", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Non-discoverable accounts and
+	// the instance account are skipped.
+	suite.Equal([]string{
+		"the_mighty_zork",
+		"admin",
+	}, accts(accounts))
+}
+
+func (suite *DirectoryTestSuite) TestGetAll() {
+	accounts, err := suite.getDirectory("local_account_1", "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.ElementsMatch([]string{
+		"the_mighty_zork",
+		"admin",
+		"foss_satan@fossbros-anonymous.io",
+		"Some_User@example.org",
+		"her_fuckin_maj@thequeenisstillalive.technology",
+	}, accts(accounts))
+}
+
+func (suite *DirectoryTestSuite) TestGetRemoteExcluded() {
+	config.SetInstanceDirectoryIncludeRemote(false)
+	defer config.SetInstanceDirectoryIncludeRemote(true)
+
+	// Even without local=true, remote
+	// accounts should be left out.
+	accounts, err := suite.getDirectory("local_account_1", "order=new", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]string{
+		"the_mighty_zork",
+		"admin",
+	}, accts(accounts))
+}
+
+func (suite *DirectoryTestSuite) TestGetLimitOffset() {
+	all, err := suite.getDirectory("local_account_1", "order=new", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	page, err := suite.getDirectory("local_account_1", "order=new&limit=2&offset=1", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(accts(all)[1:3], accts(page))
+}
+
+func (suite *DirectoryTestSuite) TestGetBadOrder() {
+	_, err := suite.getDirectory(
+		"local_account_1",
+		"order=popular",
+		http.StatusBadRequest,
+		`{"error":"Bad Request: error parsing order: value must be one of active, new"}`,
+	)
+	suite.NoError(err)
+}
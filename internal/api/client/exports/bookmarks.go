@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package exports
+
+import (
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+// ExportBookmarksCSVGETHandler swagger:operation GET /api/v1/exports/bookmarks.csv exportBookmarksCSV
+//
+// Export a CSV file of the URIs of statuses that you have bookmarked.
+//
+// The file contains one status URI per row, with no header row.
+// The export is generated as it is downloaded, so the response
+// will not include a Content-Length.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- text/csv
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:bookmarks
+//
+//	responses:
+//		'200':
+//			description: CSV file of status URIs.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportBookmarksCSVGETHandler(c *gin.Context) {
+	m.exportStatusURIs(c,
+		m.processor.Account().ExportBookmarks,
+		apiutil.CSVHeaders,
+		"bookmarks.csv",
+	)
+}
+
+// ExportBookmarksJSONGETHandler swagger:operation GET /api/v1/exports/bookmarks.json exportBookmarksJSON
+//
+// Export a JSON file of the URIs of statuses that you have bookmarked.
+//
+// The file is an ActivityStreams OrderedCollection of status URIs,
+// as found in the bookmarks.json file of an account archive. The export is
+// generated as it is downloaded, so the response will not include
+// a Content-Length.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:bookmarks
+//
+//	responses:
+//		'200':
+//			description: JSON file of status URIs.
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) ExportBookmarksJSONGETHandler(c *gin.Context) {
+	m.exportStatusURIs(c,
+		m.processor.Account().ExportBookmarks,
+		apiutil.JSONAcceptHeaders,
+		"bookmarks.json",
+	)
+}
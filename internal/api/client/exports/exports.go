@@ -18,9 +18,16 @@
 package exports
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -32,6 +39,14 @@
 	ListsPath     = BasePath + "/lists.csv"
 	BlocksPath    = BasePath + "/blocks.csv"
 	MutesPath     = BasePath + "/mutes.csv"
+
+	BookmarksCSVPath   = BasePath + "/bookmarks.csv"
+	BookmarksJSONPath  = BasePath + "/bookmarks.json"
+	FavouritesCSVPath  = BasePath + "/favourites.csv"
+	FavouritesJSONPath = BasePath + "/favourites.json"
+
+	ArchivePath         = BasePath + "/archive"
+	ArchiveDownloadPath = ArchivePath + "/download"
 )
 
 type Module struct {
@@ -51,4 +66,54 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, ListsPath, m.ExportListsGETHandler)
 	attachHandler(http.MethodGet, BlocksPath, m.ExportBlocksGETHandler)
 	attachHandler(http.MethodGet, MutesPath, m.ExportMutesGETHandler)
+	attachHandler(http.MethodGet, BookmarksCSVPath, m.ExportBookmarksCSVGETHandler)
+	attachHandler(http.MethodGet, BookmarksJSONPath, m.ExportBookmarksJSONGETHandler)
+	attachHandler(http.MethodGet, FavouritesCSVPath, m.ExportFavouritesCSVGETHandler)
+	attachHandler(http.MethodGet, FavouritesJSONPath, m.ExportFavouritesJSONGETHandler)
+	attachHandler(http.MethodPost, ArchivePath, m.ExportArchivePOSTHandler)
+	attachHandler(http.MethodGet, ArchivePath, m.ExportArchiveGETHandler)
+	attachHandler(http.MethodGet, ArchiveDownloadPath, m.ExportArchiveDownloadGETHandler)
+}
+
+// exportStatusURIs serves an export of status URIs
+// as generated by the given processor function, in
+// whichever of the offered formats the caller accepts,
+// as an attachment with the given filename.
+func (m *Module) exportStatusURIs(
+	c *gin.Context,
+	export func(context.Context, *gtsmodel.Account, string) (*apimodel.Content, gtserror.WithCode),
+	offers []string,
+	filename string,
+) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	contentType, err := apiutil.NegotiateAccept(c, offers...)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	content, errWithCode := export(
+		c.Request.Context(),
+		authed.Account,
+		contentType,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	defer func() {
+		// Close content when we're done, catch errors.
+		if err := content.Content.Close(); err != nil {
+			log.Errorf(c.Request.Context(), "error closing %s content: %v", filename, err)
+		}
+	}()
+
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.DataFromReader(http.StatusOK, content.ContentLength, content.ContentType, content.Content, nil)
 }
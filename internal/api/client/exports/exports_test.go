@@ -215,6 +215,37 @@ type testCase struct {
 			account:     suite.testAccounts["local_account_2"],
 			expect: `foss_satan@fossbros-anonymous.io
 `,
+		},
+		// Export Bookmarks as CSV.
+		{
+			handler:     suite.exportsModule.ExportBookmarksCSVGETHandler,
+			path:        exports.BookmarksCSVPath,
+			contentType: apiutil.TextCSV,
+			application: suite.testApplications["application_1"],
+			token:       suite.testTokens["admin_account"],
+			user:        suite.testUsers["admin_account"],
+			account:     suite.testAccounts["admin_account"],
+			expect: `http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY
+`,
+		},
+		// Export Favourites as JSON.
+		{
+			handler:     suite.exportsModule.ExportFavouritesJSONGETHandler,
+			path:        exports.FavouritesJSONPath,
+			contentType: apiutil.AppJSON,
+			application: suite.testApplications["application_1"],
+			token:       suite.testTokens["admin_account"],
+			user:        suite.testUsers["admin_account"],
+			account:     suite.testAccounts["admin_account"],
+			expect: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "likes.json",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY"
+  ],
+  "totalItems": 1
+}`,
 		},
 		// Export Stats.
 		{
@@ -33,12 +33,17 @@
 )
 
 const (
-	BasePath = "/v1/import"
+	BasePath       = "/v1/import"
+	IDKey          = "id"
+	BasePathWithID = BasePath + "/:" + IDKey
 )
 
 var types = []string{
 	"following",
+	"lists",
 	"blocks",
+	"mutes",
+	"bookmarks",
 }
 
 var modes = []string{
@@ -58,6 +63,8 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, BasePath, m.ImportPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, m.ImportsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.ImportGETHandler)
 }
 
 // ImportPOSTHandler swagger:operation POST /api/v1/import importData
@@ -69,6 +76,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 // Uploaded data will be processed asynchronously, and not all entries may be processed depending
 // on domain blocks, user-level blocks, network availability of referenced accounts and statuses, etc.
 //
+// The returned import can be fetched again from /api/v1/import/{id} to check progress,
+// and to see which entries (if any) could not be imported, and why.
+//
 //	---
 //	tags:
 //	- import-export
@@ -93,7 +103,10 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //			Type of entries contained in the data file:
 //
 //			- `following` - accounts to follow.
+//			- `lists` - lists of accounts, as list title + account address.
 //			- `blocks` - accounts to block.
+//			- `mutes` - accounts to mute.
+//			- `bookmarks` - statuses to bookmark.
 //		type: string
 //		required: true
 //	-
@@ -114,6 +127,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //	responses:
 //		'202':
 //			description: Upload accepted.
+//			schema:
+//				"$ref": "#/definitions/accountImport"
 //		'400':
 //			description: bad request
 //		'401':
@@ -179,7 +194,7 @@ func (m *Module) ImportPOSTHandler(c *gin.Context) {
 	overwrite := form.Mode == "overwrite"
 
 	// Trigger the import.
-	errWithCode := m.processor.Account().ImportData(
+	imp, errWithCode := m.processor.Account().ImportData(
 		c.Request.Context(),
 		authed.Account,
 		form.Data,
@@ -191,5 +206,5 @@ func (m *Module) ImportPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiutil.JSON(c, http.StatusAccepted, gin.H{"status": "accepted"})
+	apiutil.JSON(c, http.StatusAccepted, imp)
 }
@@ -20,6 +20,7 @@
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -27,6 +28,7 @@
 
 	"github.com/stretchr/testify/suite"
 	importdata "github.com/superseriousbusiness/gotosocial/internal/api/client/import"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -46,6 +48,7 @@ type ImportTestSuite struct {
 	testApplications map[string]*gtsmodel.Application
 	testUsers        map[string]*gtsmodel.User
 	testAccounts     map[string]*gtsmodel.Account
+	testStatuses     map[string]*gtsmodel.Status
 
 	// module being tested
 	importModule *importdata.Module
@@ -57,6 +60,7 @@ func (suite *ImportTestSuite) SetupSuite() {
 	suite.testApplications = testrig.NewTestApplications()
 	suite.testUsers = testrig.NewTestUsers()
 	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
 }
 
 func (suite *ImportTestSuite) SetupTest() {
@@ -103,7 +107,7 @@ func (suite *ImportTestSuite) TriggerHandler(
 	importData string,
 	importType string,
 	importMode string,
-) {
+) *apimodel.AccountImport {
 	// Set up request.
 	recorder := httptest.NewRecorder()
 	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
@@ -142,6 +146,48 @@ func (suite *ImportTestSuite) TriggerHandler(
 		}
 		suite.FailNow("", "expected 202, got %d: %s", code, string(b))
 	}
+
+	imp := new(apimodel.AccountImport)
+	if err := json.NewDecoder(recorder.Body).Decode(imp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return imp
+}
+
+// WaitForImport waits for the import with the
+// given ID to be done, and returns it as fetched
+// from the import GET handler.
+func (suite *ImportTestSuite) WaitForImport(id string) *apimodel.AccountImport {
+	var imp *apimodel.AccountImport
+
+	if !testrig.WaitFor(func() bool {
+		recorder := httptest.NewRecorder()
+		ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+		ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+		ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+		ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+		ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080/api/v1/import/"+id, nil)
+		ctx.Request.Header.Set("Accept", "application/json")
+		ctx.AddParam(importdata.IDKey, id)
+
+		suite.importModule.ImportGETHandler(ctx)
+		if recorder.Code != http.StatusOK {
+			suite.FailNow("", "expected 200, got %d", recorder.Code)
+		}
+
+		imp = new(apimodel.AccountImport)
+		if err := json.NewDecoder(recorder.Body).Decode(imp); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		return imp.State == "done"
+	}) {
+		suite.FailNow("timed out waiting for import to be done")
+	}
+
+	return imp
 }
 
 func (suite *ImportTestSuite) TearDownTest() {
@@ -205,6 +251,130 @@ func (suite *ImportTestSuite) TestImportFollows() {
 	}
 }
 
+func (suite *ImportTestSuite) TestImportListsOverwrite() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		admin       = suite.testAccounts["admin_account"]
+		turtle      = suite.testAccounts["local_account_2"]
+	)
+
+	// Zork's existing list contains both admin
+	// and turtle. Keep only admin in it, and move
+	// turtle into a new list along with someone
+	// who doesn't exist.
+	data := `Cool Ass Posters From This Instance,admin@localhost:8080
+New List,1happyturtle@localhost:8080
+New List,nobody@localhost:8080
+`
+
+	// Trigger the import handler.
+	imp := suite.TriggerHandler(data, "lists", "overwrite")
+	suite.Equal("lists", imp.Type)
+	suite.Equal("overwrite", imp.Mode)
+	suite.Equal(3, imp.Total)
+
+	imp = suite.WaitForImport(imp.ID)
+	suite.Equal(3, imp.Processed)
+	suite.Equal([]apimodel.AccountImportFailure{
+		{
+			Entry: "New List,nobody@localhost:8080",
+			Error: "account could not be retrieved",
+		},
+	}, imp.Failures)
+
+	dbLists, err := suite.state.DB.GetListsByAccountID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	lists := make(map[string][]string, len(dbLists))
+	for _, list := range dbLists {
+		accountIDs, err := suite.state.DB.GetAccountIDsInList(ctx, list.ID, nil)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		lists[list.Title] = accountIDs
+	}
+
+	suite.Equal(map[string][]string{
+		"Cool Ass Posters From This Instance": {admin.ID},
+		"New List":                            {turtle.ID},
+	}, lists)
+}
+
+func (suite *ImportTestSuite) TestImportMutes() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		targetAcct  = suite.testAccounts["admin_account"]
+	)
+
+	// Have zork mute admin, and someone who doesn't exist.
+	data := `Account address,Hide notifications
+admin@localhost:8080,false
+nobody@localhost:8080,true
+`
+
+	// Trigger the import handler.
+	imp := suite.TriggerHandler(data, "mutes", "merge")
+	suite.Equal("mutes", imp.Type)
+	suite.Equal("merge", imp.Mode)
+	suite.Equal(2, imp.Total)
+
+	imp = suite.WaitForImport(imp.ID)
+	suite.Equal(2, imp.Processed)
+	suite.Equal([]apimodel.AccountImportFailure{
+		{
+			Entry: "nobody@localhost:8080",
+			Error: "account could not be retrieved",
+		},
+	}, imp.Failures)
+
+	mute, err := suite.state.DB.GetMute(ctx, testAccount.ID, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*mute.Notifications)
+}
+
+func (suite *ImportTestSuite) TestImportBookmarks() {
+	var (
+		ctx          = context.Background()
+		testAccount  = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["local_account_2_status_1"]
+	)
+
+	// Have zork bookmark one of turtle's
+	// statuses, and a status that doesn't exist.
+	data := targetStatus.URI + `
+http://localhost:8080/users/admin/statuses/01JG0000000000000000000000
+`
+
+	// Trigger the import handler.
+	imp := suite.TriggerHandler(data, "bookmarks", "merge")
+	suite.Equal(2, imp.Total)
+
+	imp = suite.WaitForImport(imp.ID)
+	suite.Equal(2, imp.Processed)
+	suite.Len(imp.Failures, 1)
+	suite.Equal("http://localhost:8080/users/admin/statuses/01JG0000000000000000000000", imp.Failures[0].Entry)
+
+	bookmarked, err := suite.state.DB.IsStatusBookmarkedBy(ctx, testAccount.ID, targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(bookmarked)
+
+	// Existing bookmark should be
+	// left alone when merging.
+	bookmarked, err = suite.state.DB.IsStatusBookmarkedBy(ctx, testAccount.ID, suite.testStatuses["admin_account_status_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(bookmarked)
+}
+
 func TestImportTestSuite(t *testing.T) {
 	suite.Run(t, new(ImportTestSuite))
 }
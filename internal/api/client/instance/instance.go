@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -29,6 +30,8 @@
 	InstanceInformationPathV2 = "/v2/instance"
 	InstancePeersPath         = InstanceInformationPathV1 + "/peers"
 	InstanceRulesPath         = InstanceInformationPathV1 + "/rules"
+	InstanceTermsPath         = InstanceInformationPathV1 + "/terms_of_service"
+	InstanceTermsPathWithID   = InstanceTermsPath + "/:" + apiutil.IDKey
 	PeersFilterKey            = "filter" // PeersFilterKey is used to provide filters to /api/v1/instance/peers
 )
 
@@ -50,4 +53,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, InstancePeersPath, m.InstancePeersGETHandler)
 
 	attachHandler(http.MethodGet, InstanceRulesPath, m.InstanceRulesGETHandler)
+
+	attachHandler(http.MethodGet, InstanceTermsPath, m.InstanceTermsOfServiceGETHandler)
+	attachHandler(http.MethodGet, InstanceTermsPathWithID, m.InstanceTermsOfServiceVersionGETHandler)
 }
@@ -160,7 +160,7 @@ func (m *Module) InstanceUpdatePATCHHandler(c *gin.Context) {
 		return
 	}
 
-	i, errWithCode := m.processor.InstancePatch(c.Request.Context(), form)
+	i, errWithCode := m.processor.InstancePatch(c.Request.Context(), authed.Account, form)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -133,7 +133,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -148,6 +149,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
@@ -274,7 +281,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -289,6 +297,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
@@ -415,7 +429,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -430,6 +445,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
@@ -607,7 +628,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -622,6 +644,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
@@ -770,7 +798,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -785,6 +814,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
@@ -952,7 +987,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -967,6 +1003,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
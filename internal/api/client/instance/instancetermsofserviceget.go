@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package instance
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// InstanceTermsOfServiceGETHandler swagger:operation GET /api/v1/instance/terms_of_service instanceTermsOfServiceGet
+//
+// View the terms of service currently in effect on this instance (public).
+//
+//	---
+//	tags:
+//	- instance
+//
+//	produces:
+//	- application/json
+//
+//	responses:
+//		'200':
+//			description: The terms of service currently in effect.
+//			schema:
+//				"$ref": "#/definitions/termsOfService"
+//		'404':
+//			description: not found (no terms of service have been published)
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) InstanceTermsOfServiceGETHandler(c *gin.Context) {
+	m.termsOfServiceGET(c, "")
+}
+
+// InstanceTermsOfServiceVersionGETHandler swagger:operation GET /api/v1/instance/terms_of_service/{id} instanceTermsOfServiceVersionGet
+//
+// View the terms of service version with the given ID (public).
+//
+// Previous versions can be viewed here, to see how the terms have changed over time.
+//
+//	---
+//	tags:
+//	- instance
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the terms of service version.
+//		in: path
+//		required: true
+//
+//	responses:
+//		'200':
+//			description: The requested terms of service version.
+//			schema:
+//				"$ref": "#/definitions/termsOfService"
+//		'400':
+//			description: bad request
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) InstanceTermsOfServiceVersionGETHandler(c *gin.Context) {
+	id, errWithCode := apiutil.ParseID(c.Param(apiutil.IDKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	m.termsOfServiceGET(c, id)
+}
+
+func (m *Module) termsOfServiceGET(c *gin.Context, id string) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	resp, errWithCode := m.processor.TermsOfServiceGet(c.Request.Context(), id)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp)
+}
@@ -18,13 +18,9 @@
 package interactionpolicies
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/form/v4"
-	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -222,7 +218,7 @@ func (m *Module) PoliciesDefaultsPATCHHandler(c *gin.Context) {
 		return
 	}
 
-	form, err := parseUpdatePoliciesForm(c)
+	form, err := apiutil.ParseUpdateInteractionPoliciesForm(c)
 	if err != nil {
 		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
 		return
@@ -240,95 +236,3 @@ func (m *Module) PoliciesDefaultsPATCHHandler(c *gin.Context) {
 
 	apiutil.JSON(c, http.StatusOK, resp)
 }
-
-// intPolicyFormBinding satisfies gin's binding.Binding interface.
-// Should only be used specifically for multipart/form-data MIME type.
-type intPolicyFormBinding struct {
-	visibility string
-}
-
-func (i intPolicyFormBinding) Name() string {
-	return i.visibility
-}
-
-func (intPolicyFormBinding) Bind(req *http.Request, obj any) error {
-	if err := req.ParseForm(); err != nil {
-		return err
-	}
-
-	// Change default namespace prefix and suffix to
-	// allow correct parsing of the field attributes.
-	decoder := form.NewDecoder()
-	decoder.SetNamespacePrefix("[")
-	decoder.SetNamespaceSuffix("]")
-
-	return decoder.Decode(obj, req.Form)
-}
-
-// customBind does custom form binding for
-// each visibility in the form data.
-func customBind(
-	c *gin.Context,
-	form *apimodel.UpdateInteractionPoliciesRequest,
-) error {
-	for _, vis := range []string{
-		"Direct",
-		"Private",
-		"Unlisted",
-		"Public",
-	} {
-		if err := c.ShouldBindWith(
-			form,
-			intPolicyFormBinding{
-				visibility: vis,
-			},
-		); err != nil {
-			return fmt.Errorf("custom form binding failed: %w", err)
-		}
-	}
-
-	return nil
-}
-
-func parseUpdatePoliciesForm(c *gin.Context) (*apimodel.UpdateInteractionPoliciesRequest, error) {
-	form := new(apimodel.UpdateInteractionPoliciesRequest)
-
-	switch ct := c.ContentType(); ct {
-	case binding.MIMEJSON:
-		// Just bind with default json binding.
-		if err := c.ShouldBindWith(form, binding.JSON); err != nil {
-			return nil, err
-		}
-
-	case binding.MIMEPOSTForm:
-		// Bind with default form binding first.
-		if err := c.ShouldBindWith(form, binding.FormPost); err != nil {
-			return nil, err
-		}
-
-		// Now do custom binding.
-		if err := customBind(c, form); err != nil {
-			return nil, err
-		}
-
-	case binding.MIMEMultipartPOSTForm:
-		// Bind with default form binding first.
-		if err := c.ShouldBindWith(form, binding.FormMultipart); err != nil {
-			return nil, err
-		}
-
-		// Now do custom binding.
-		if err := customBind(c, form); err != nil {
-			return nil, err
-		}
-
-	default:
-		err := fmt.Errorf(
-			"content-type %s not supported for this endpoint; supported content-types are %s, %s, %s",
-			ct, binding.MIMEJSON, binding.MIMEPOSTForm, binding.MIMEMultipartPOSTForm,
-		)
-		return nil, err
-	}
-
-	return form, nil
-}
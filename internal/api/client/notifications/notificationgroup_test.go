@@ -0,0 +1,228 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oklog/ulid"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/notifications"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// notificationGroupRequest calls the given handler as local_account_1,
+// with the given group key path param (if set), returning the
+// response code and body.
+func (suite *NotificationsTestSuite) notificationGroupRequest(
+	method string,
+	path string,
+	groupKey string,
+	handler func(*gin.Context),
+) (int, []byte) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if groupKey != "" {
+		ctx.AddParam(notifications.GroupKeyKey, groupKey)
+	}
+
+	handler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+// addGroupableFave adds another fave of the status in the fixture
+// "local_account_1_like" notification, within the same group window,
+// and returns the key of the group the two notifications should be in.
+func (suite *NotificationsTestSuite) addGroupableFave() (*gtsmodel.Notification, string) {
+	fixture := suite.testNotifications["local_account_1_like"]
+
+	// Same millisecond as the fixture,
+	// so definitely in the same window.
+	notif := &gtsmodel.Notification{
+		ID:               fixture.ID[:len(fixture.ID)-1] + "Q",
+		NotificationType: gtsmodel.NotificationFave,
+		CreatedAt:        fixture.CreatedAt,
+		TargetAccountID:  fixture.TargetAccountID,
+		OriginAccountID:  suite.testAccounts["local_account_2"].ID,
+		StatusID:         fixture.StatusID,
+		Read:             util.Ptr(false),
+	}
+	if err := suite.db.Put(context.Background(), notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	window := ulid.MustParse(fixture.ID).Time() / (24 * 60 * 60 * 1000)
+	return notif, "favourite-" + fixture.StatusID + "-" + strconv.FormatUint(window, 10)
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroups() {
+	fixture := suite.testNotifications["local_account_1_like"]
+	suite.addMoreNotifications(suite.testAccounts["local_account_1"])
+	notif, groupKey := suite.addGroupableFave()
+
+	code, b := suite.notificationGroupRequest(
+		http.MethodGet,
+		notifications.GroupsPath,
+		"",
+		suite.notificationsModule.NotificationGroupsGETHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+
+	resp := &apimodel.GroupedNotificationsResults{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Follow, follow request, and the two faves grouped together.
+	suite.Len(resp.NotificationGroups, 3)
+	suite.Len(resp.Accounts, 3)
+	suite.Len(resp.Statuses, 1)
+
+	var faveGroup *apimodel.NotificationGroup
+	for _, group := range resp.NotificationGroups {
+		if group.GroupKey == groupKey {
+			faveGroup = group
+		}
+	}
+	if faveGroup == nil {
+		suite.FailNow("fave group " + groupKey + " not found")
+	}
+
+	suite.Equal("favourite", faveGroup.Type)
+	suite.Equal(2, faveGroup.NotificationsCount)
+	suite.Equal(notif.ID, faveGroup.MostRecentNotificationID)
+	suite.Equal(notif.ID, faveGroup.PageMaxID)
+	suite.Equal(fixture.ID, faveGroup.PageMinID)
+	suite.Equal(fixture.StatusID, faveGroup.StatusID)
+	suite.Equal([]string{
+		notif.OriginAccountID,
+		fixture.OriginAccountID,
+	}, faveGroup.SampleAccountIDs)
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroupsLimit() {
+	suite.addMoreNotifications(suite.testAccounts["local_account_1"])
+	_, groupKey := suite.addGroupableFave()
+
+	code, b := suite.notificationGroupRequest(
+		http.MethodGet,
+		notifications.GroupsPath+"?limit=2",
+		"",
+		suite.notificationsModule.NotificationGroupsGETHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+
+	resp := &apimodel.GroupedNotificationsResults{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Limit applies to groups, so
+	// the faves won't be included.
+	suite.Len(resp.NotificationGroups, 2)
+	for _, group := range resp.NotificationGroups {
+		suite.NotEqual(groupKey, group.GroupKey)
+	}
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroupAccounts() {
+	notif, groupKey := suite.addGroupableFave()
+
+	code, b := suite.notificationGroupRequest(
+		http.MethodGet,
+		notifications.GroupsPath+"/"+groupKey+"/accounts",
+		groupKey,
+		suite.notificationsModule.NotificationGroupAccountsGETHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+
+	accounts := make([]*apimodel.Account, 0)
+	if err := json.Unmarshal(b, &accounts); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(accounts, 2) {
+		suite.Equal(notif.OriginAccountID, accounts[0].ID)
+		suite.Equal(suite.testNotifications["local_account_1_like"].OriginAccountID, accounts[1].ID)
+	}
+}
+
+func (suite *NotificationsTestSuite) TestDismissNotificationGroup() {
+	_, groupKey := suite.addGroupableFave()
+
+	code, b := suite.notificationGroupRequest(
+		http.MethodPost,
+		notifications.GroupsPath+"/"+groupKey+"/dismiss",
+		groupKey,
+		suite.notificationsModule.NotificationGroupDismissPOSTHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("{}", string(b))
+
+	// Group should be gone now.
+	code, _ = suite.notificationGroupRequest(
+		http.MethodGet,
+		notifications.GroupsPath+"/"+groupKey,
+		groupKey,
+		suite.notificationsModule.NotificationGroupGETHandler,
+	)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationGroupBadKey() {
+	for _, groupKey := range []string{
+		"ungrouped-01F8Q0ANPTWW10DAKTX7BRPBJQ",
+		"mention-01F8MHAMCHF6Y650WCRSCP4WMY-18000",
+		"favourite-18000",
+		"follow-not-a-number",
+	} {
+		code, _ := suite.notificationGroupRequest(
+			http.MethodGet,
+			notifications.GroupsPath+"/"+groupKey,
+			groupKey,
+			suite.notificationsModule.NotificationGroupGETHandler,
+		)
+		suite.Equal(http.StatusNotFound, code, groupKey)
+	}
+}
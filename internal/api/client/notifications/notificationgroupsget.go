@@ -0,0 +1,209 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notifications
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// NotificationGroupsGETHandler swagger:operation GET /api/v2/notifications notificationGroups
+//
+// Get grouped notifications for currently authorized user.
+//
+// Notifications of the types given in `grouped_types[]` are collapsed server-side into
+// notification groups (eg., "37 people boosted your post"). Favourites and boosts are
+// grouped per status, and follows are grouped together, within a window of one day.
+// Notifications of other types are returned as groups of one, with group key `ungrouped-{id}`.
+//
+// The groups will be returned in descending chronological order (newest first). The
+// `limit` parameter applies to the number of groups returned, and paging parameters
+// refer to the IDs of the underlying notifications.
+//
+// The next and previous queries can be parsed from the returned Link header.
+// Example:
+//
+// ```
+// <https://example.org/api/v2/notifications?limit=40&max_id=01FC0SKA48HNSVR6YKZCQGS2V8>; rel="next", <https://example.org/api/v2/notifications?limit=40&min_id=01FC0SKW5JK2Q4EVAV2B462YY0>; rel="prev"
+// ````
+//
+//	---
+//	tags:
+//	- notifications
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: max_id
+//		type: string
+//		description: >-
+//			Return only notifications *OLDER* than the given max notification ID.
+//			The notification with the specified ID will not be included in the response.
+//		in: query
+//		required: false
+//	-
+//		name: since_id
+//		type: string
+//		description: >-
+//			Return only notifications *newer* than the given since notification ID.
+//			The notification with the specified ID will not be included in the response.
+//		in: query
+//	-
+//		name: min_id
+//		type: string
+//		description: >-
+//			Return only notifications *immediately newer* than the given since notification ID.
+//			The notification with the specified ID will not be included in the response.
+//		in: query
+//		required: false
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of notification groups to return.
+//		default: 40
+//		in: query
+//		required: false
+//	-
+//		name: types[]
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- follow
+//				- follow_request
+//				- mention
+//				- reblog
+//				- favourite
+//				- poll
+//				- status
+//				- admin.sign_up
+//				- admin.appeal
+//				- severed_relationships
+//				- pleroma:emoji_reaction
+//		description: Types of notifications to include. If not provided, all notification types will be included.
+//		in: query
+//		required: false
+//	-
+//		name: exclude_types[]
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- follow
+//				- follow_request
+//				- mention
+//				- reblog
+//				- favourite
+//				- poll
+//				- status
+//				- admin.sign_up
+//				- admin.appeal
+//				- severed_relationships
+//				- pleroma:emoji_reaction
+//		description: Types of notifications to exclude.
+//		in: query
+//		required: false
+//	-
+//		name: grouped_types[]
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- follow
+//				- reblog
+//				- favourite
+//		description: >-
+//			Types of notifications to group.
+//			If not provided, favourite, follow and reblog notifications will be grouped.
+//			Other types cannot be grouped and will be ignored.
+//		in: query
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:notifications
+//
+//	responses:
+//		'200':
+//			headers:
+//				Link:
+//					type: string
+//					description: Links to the next and previous queries.
+//			name: notifications
+//			description: Grouped notifications, and the accounts and statuses they refer to.
+//			schema:
+//				"$ref": "#/definitions/groupedNotificationsResults"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) NotificationGroupsGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		80, // max limit
+		40, // no limit
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	ctx := c.Request.Context()
+	resp, errWithCode := m.processor.Timeline().NotificationGroupsGet(
+		ctx,
+		authed,
+		page,
+		parseNotificationTypes(ctx, c.QueryArray(TypesKey)),        // Include types.
+		parseNotificationTypes(ctx, c.QueryArray(ExcludeTypesKey)), // Exclude types.
+		parseNotificationTypes(ctx, c.QueryArray(GroupedTypesKey)), // Grouped types.
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if resp.LinkHeader != "" {
+		c.Header("Link", resp.LinkHeader)
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp)
+}
@@ -0,0 +1,213 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/notifications"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// notificationRequestRequest calls the given handler as local_account_1,
+// with the given notification request ID path param (if set),
+// returning the response code and body.
+func (suite *NotificationsTestSuite) notificationRequestRequest(
+	method string,
+	path string,
+	requestID string,
+	handler func(*gin.Context),
+) (int, []byte) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if requestID != "" {
+		ctx.AddParam(notifications.IDKey, requestID)
+	}
+
+	handler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+// addFilteredNotification adds a filtered follow notification
+// from remote_account_1 to local_account_1, along with the
+// notification request holding it.
+func (suite *NotificationsTestSuite) addFilteredNotification() (*gtsmodel.Notification, *gtsmodel.NotificationRequest) {
+	var (
+		ctx           = context.Background()
+		targetAccount = suite.testAccounts["local_account_1"]
+		originAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  targetAccount.ID,
+		OriginAccountID:  originAccount.ID,
+		Read:             util.Ptr(false),
+		Filtered:         util.Ptr(true),
+	}
+	if err := suite.db.PutNotification(ctx, notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	req := &gtsmodel.NotificationRequest{
+		ID:              id.NewULID(),
+		AccountID:       targetAccount.ID,
+		OriginAccountID: originAccount.ID,
+	}
+	if err := suite.db.PutNotificationRequest(ctx, req); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return notif, req
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationRequests() {
+	notif, req := suite.addFilteredNotification()
+
+	code, b := suite.notificationRequestRequest(
+		http.MethodGet,
+		notifications.RequestsPath,
+		"",
+		suite.notificationsModule.NotificationRequestsGETHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+
+	resp := []*apimodel.NotificationRequest{}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(resp, 1)
+	suite.Equal(req.ID, resp[0].ID)
+	suite.Equal("1", resp[0].NotificationsCount)
+	suite.Equal(notif.OriginAccountID, resp[0].Account.ID)
+	suite.Nil(resp[0].LastStatus)
+
+	// The filtered notification
+	// shouldn't show up normally.
+	notifs, err := suite.db.GetAccountNotifications(
+		context.Background(),
+		notif.TargetAccountID,
+		nil, nil, nil,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, n := range notifs {
+		suite.NotEqual(notif.ID, n.ID)
+	}
+}
+
+func (suite *NotificationsTestSuite) TestAcceptNotificationRequest() {
+	notif, req := suite.addFilteredNotification()
+
+	code, b := suite.notificationRequestRequest(
+		http.MethodPost,
+		notifications.RequestsPathWithAccept,
+		req.ID,
+		suite.notificationsModule.NotificationRequestAcceptPOSTHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("{}", string(b))
+
+	// Notification should no longer be filtered.
+	dbNotif, err := suite.db.GetNotificationByID(context.Background(), notif.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbNotif.Filtered)
+
+	// Request should now be accepted, and
+	// therefore no longer retrievable.
+	dbReq, err := suite.db.GetNotificationRequestByID(context.Background(), req.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbReq.IsAccepted())
+
+	code, _ = suite.notificationRequestRequest(
+		http.MethodGet,
+		notifications.RequestsPathWithID,
+		req.ID,
+		suite.notificationsModule.NotificationRequestGETHandler,
+	)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *NotificationsTestSuite) TestDismissNotificationRequest() {
+	notif, req := suite.addFilteredNotification()
+
+	code, b := suite.notificationRequestRequest(
+		http.MethodPost,
+		notifications.RequestsPathWithDismiss,
+		req.ID,
+		suite.notificationsModule.NotificationRequestDismissPOSTHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("{}", string(b))
+
+	// Notification and request should both be gone.
+	_, err := suite.db.GetNotificationByID(context.Background(), notif.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetNotificationRequestByID(context.Background(), req.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *NotificationsTestSuite) TestGetNotificationPolicy() {
+	suite.addFilteredNotification()
+
+	code, b := suite.notificationRequestRequest(
+		http.MethodGet,
+		notifications.PolicyPath,
+		"",
+		suite.notificationsModule.NotificationPolicyGETHandler,
+	)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{"for_not_following":"accept","for_not_followers":"accept","for_new_accounts":"accept","for_private_mentions":"accept","for_limited_accounts":"accept","summary":{"pending_requests_count":1,"pending_notifications_count":1}}`, string(b))
+}
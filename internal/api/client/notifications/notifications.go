@@ -34,10 +34,29 @@
 	BasePathWithID    = BasePath + "/:" + IDKey
 	BasePathWithClear = BasePath + "/clear"
 
+	// RequestsPath is the path for serving notification requests, minus the 'api' prefix.
+	RequestsPath            = BasePath + "/requests"
+	RequestsPathWithID      = RequestsPath + "/:" + IDKey
+	RequestsPathWithAccept  = RequestsPathWithID + "/accept"
+	RequestsPathWithDismiss = RequestsPathWithID + "/dismiss"
+
+	// GroupKeyKey is for notification group keys.
+	GroupKeyKey = "group_key"
+	// GroupsPath is the base path for serving grouped notifications, minus the 'api' prefix.
+	GroupsPath = "/v2/notifications"
+	// GroupsPathWithKey is the groups path with the group key in it.
+	GroupsPathWithKey      = GroupsPath + "/:" + GroupKeyKey
+	GroupsPathWithAccounts = GroupsPathWithKey + "/accounts"
+	GroupsPathWithDismiss  = GroupsPathWithKey + "/dismiss"
+	// PolicyPath is the path for serving the notification policy, minus the 'api' prefix.
+	PolicyPath = GroupsPath + "/policy"
+
 	// TypesKey names an array param specifying notification types to include.
 	TypesKey = "types[]"
 	// ExcludeTypesKey names an array param specifying notification types to exclude.
 	ExcludeTypesKey = "exclude_types[]"
+	// GroupedTypesKey names an array param specifying notification types to group.
+	GroupedTypesKey = "grouped_types[]"
 	MaxIDKey        = "max_id"
 	LimitKey        = "limit"
 	SinceIDKey      = "since_id"
@@ -58,4 +77,14 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
 	attachHandler(http.MethodGet, BasePathWithID, m.NotificationGETHandler)
 	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodGet, GroupsPath, m.NotificationGroupsGETHandler)
+	attachHandler(http.MethodGet, GroupsPathWithKey, m.NotificationGroupGETHandler)
+	attachHandler(http.MethodGet, GroupsPathWithAccounts, m.NotificationGroupAccountsGETHandler)
+	attachHandler(http.MethodPost, GroupsPathWithDismiss, m.NotificationGroupDismissPOSTHandler)
+	attachHandler(http.MethodGet, RequestsPath, m.NotificationRequestsGETHandler)
+	attachHandler(http.MethodGet, RequestsPathWithID, m.NotificationRequestGETHandler)
+	attachHandler(http.MethodPost, RequestsPathWithAccept, m.NotificationRequestAcceptPOSTHandler)
+	attachHandler(http.MethodPost, RequestsPathWithDismiss, m.NotificationRequestDismissPOSTHandler)
+	attachHandler(http.MethodGet, PolicyPath, m.NotificationPolicyGETHandler)
+	attachHandler(http.MethodPatch, PolicyPath, m.NotificationPolicyPATCHHandler)
 }
@@ -95,6 +95,9 @@
 //				- poll
 //				- status
 //				- admin.sign_up
+//				- admin.appeal
+//				- severed_relationships
+//				- pleroma:emoji_reaction
 //		description: Types of notifications to include. If not provided, all notification types will be included.
 //		in: query
 //		required: false
@@ -112,6 +115,9 @@
 //				- poll
 //				- status
 //				- admin.sign_up
+//				- admin.appeal
+//				- severed_relationships
+//				- pleroma:emoji_reaction
 //		description: Types of notifications to exclude.
 //		in: query
 //		required: false
@@ -190,6 +190,70 @@ func (suite *ReportCreateTestSuite) TestCreateReport7() {
 	suite.Nil(report)
 }
 
+func (suite *ReportCreateTestSuite) TestCreateReportRules() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+	rule := suite.testRules["rule1"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		StatusIDs: []string{},
+		RuleIDs:   []string{rule.ID},
+	}
+
+	report, err := suite.createReport(http.StatusOK, "", form)
+	suite.NoError(err)
+	suite.ReportOK(form, report)
+
+	// No category given, so it
+	// should default to violation.
+	suite.Equal("violation", report.Category)
+	suite.Equal([]string{rule.ID}, report.RuleIDs)
+}
+
+func (suite *ReportCreateTestSuite) TestCreateReportSpam() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		StatusIDs: []string{},
+		Category:  "spam",
+	}
+
+	report, err := suite.createReport(http.StatusOK, "", form)
+	suite.NoError(err)
+	suite.ReportOK(form, report)
+	suite.Equal("spam", report.Category)
+}
+
+func (suite *ReportCreateTestSuite) TestCreateReportRulesWrongCategory() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		Category:  "spam",
+		RuleIDs:   []string{suite.testRules["rule1"].ID},
+	}
+
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: rule_ids can only be set for category violation, not spam"}`, form)
+	suite.NoError(err)
+	suite.Nil(report)
+}
+
+func (suite *ReportCreateTestSuite) TestCreateReportDeletedRule() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+	rule := suite.testRules["deleted_rule"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		StatusIDs: []string{},
+		RuleIDs:   []string{rule.ID},
+	}
+
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: rule with ID `+rule.ID+` does not exist"}`, form)
+	suite.NoError(err)
+	suite.Nil(report)
+}
+
 func TestReportCreateTestSuite(t *testing.T) {
 	suite.Run(t, &ReportCreateTestSuite{})
 }
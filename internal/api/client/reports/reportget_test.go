@@ -102,7 +102,7 @@ func (suite *ReportGetTestSuite) TestGetReport1() {
   "action_taken": false,
   "action_taken_at": null,
   "action_taken_comment": null,
-  "category": "other",
+  "category": "violation",
   "comment": "dark souls sucks, please yeet this nerd",
   "forwarded": true,
   "status_ids": [
@@ -128,7 +128,7 @@ func (suite *ReportsGetTestSuite) TestGetReports() {
     "action_taken": false,
     "action_taken_at": null,
     "action_taken_comment": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "status_ids": [
@@ -219,7 +219,7 @@ func (suite *ReportsGetTestSuite) TestGetReports4() {
     "action_taken": false,
     "action_taken_at": null,
     "action_taken_comment": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "status_ids": [
@@ -294,7 +294,7 @@ func (suite *ReportsGetTestSuite) TestGetReports6() {
     "action_taken": false,
     "action_taken_at": null,
     "action_taken_comment": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "status_ids": [
@@ -353,7 +353,7 @@ func (suite *ReportsGetTestSuite) TestGetReports7() {
     "action_taken": false,
     "action_taken_at": null,
     "action_taken_comment": null,
-    "category": "other",
+    "category": "violation",
     "comment": "dark souls sucks, please yeet this nerd",
     "forwarded": true,
     "status_ids": [
@@ -27,6 +27,8 @@
 const (
 	// IDKey is for status UUIDs
 	IDKey = "id"
+	// EmojiKey is for reaction emojis
+	EmojiKey = "emoji"
 	// BasePath is the base path for serving the statuses API, minus the 'api' prefix
 	BasePath = "/v1/statuses"
 	// BasePathWithID is just the base path with the ID key in it.
@@ -40,6 +42,11 @@
 	// UnfavouritePath is for removing a fave from a status
 	UnfavouritePath = BasePathWithID + "/unfavourite"
 
+	// ReactionsPath is for seeing the emoji reactions to a given status
+	ReactionsPath = BasePathWithID + "/reactions"
+	// ReactionPath is for adding or removing an emoji reaction to a given status
+	ReactionPath = ReactionsPath + "/:" + EmojiKey
+
 	// RebloggedPath is for seeing who's boosted a given status
 	RebloggedPath = BasePathWithID + "/reblogged_by"
 	// ReblogPath is for boosting/reblogging a given status
@@ -61,6 +68,8 @@
 	PinPath = BasePathWithID + "/pin"
 	// UnpinPath is for undoing a pin and returning a status to the ever-swirling drain of time and entropy
 	UnpinPath = BasePathWithID + "/unpin"
+	// PinnedOrderPath is for setting the order in which pinned statuses are shown
+	PinnedOrderPath = BasePath + "/pinned_order"
 
 	// ContextPath is used for fetching context of posts
 	ContextPath = BasePathWithID + "/context"
@@ -70,6 +79,12 @@
 
 	// SourcePath is used for fetching source of a post.
 	SourcePath = BasePathWithID + "/source"
+
+	// TranslatePath is used for translating a post into another language.
+	TranslatePath = BasePathWithID + "/translate"
+
+	// RefreshPath is used for refreshing a remote post from its origin server.
+	RefreshPath = BasePathWithID + "/refresh"
 )
 
 type Module struct {
@@ -93,9 +108,15 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, UnfavouritePath, m.StatusUnfavePOSTHandler)
 	attachHandler(http.MethodGet, FavouritedPath, m.StatusFavedByGETHandler)
 
+	// reaction stuff
+	attachHandler(http.MethodGet, ReactionsPath, m.StatusReactionsGETHandler)
+	attachHandler(http.MethodPut, ReactionPath, m.StatusReactionPUTHandler)
+	attachHandler(http.MethodDelete, ReactionPath, m.StatusReactionDELETEHandler)
+
 	// pin stuff
 	attachHandler(http.MethodPost, PinPath, m.StatusPinPOSTHandler)
 	attachHandler(http.MethodPost, UnpinPath, m.StatusUnpinPOSTHandler)
+	attachHandler(http.MethodPost, PinnedOrderPath, m.StatusPinnedOrderPOSTHandler)
 
 	// mute stuff
 	attachHandler(http.MethodPost, MutePath, m.StatusMutePOSTHandler)
@@ -114,4 +135,10 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// history/edit stuff
 	attachHandler(http.MethodGet, HistoryPath, m.StatusHistoryGETHandler)
 	attachHandler(http.MethodGet, SourcePath, m.StatusSourceGETHandler)
+
+	// translation
+	attachHandler(http.MethodPost, TranslatePath, m.StatusTranslatePOSTHandler)
+
+	// refresh remote status
+	attachHandler(http.MethodPost, RefreshPath, m.StatusRefreshPOSTHandler)
 }
@@ -129,6 +129,14 @@
 //		type: string
 //		in: formData
 //	-
+//		name: quote_id
+//		x-go-name: QuoteID
+//		description: |-
+//			ID of the status being quoted, if status is a quote.
+//			The quoted status must be public or unlisted, and boostable by the requester.
+//		type: string
+//		in: formData
+//	-
 //		name: sensitive
 //		x-go-name: Sensitive
 //		description: Status and attached media should be marked as sensitive.
@@ -145,7 +153,10 @@
 //	-
 //		name: visibility
 //		x-go-name: Visibility
-//		description: Visibility of the posted status.
+//		description: >-
+//			Visibility of the posted status.
+//			"local" is accepted for compatibility with Pleroma / Akkoma clients,
+//			and is equivalent to "public" with local_only set to true.
 //		type: string
 //		enum:
 //			- public
@@ -153,6 +164,7 @@
 //			- private
 //			- mutuals_only
 //			- direct
+//			- local
 //		in: formData
 //	-
 //		name: local_only
@@ -173,6 +185,15 @@
 //		in: formData
 //		type: boolean
 //	-
+//		name: circle_id
+//		x-go-name: CircleID
+//		description: >-
+//			ID of a circle owned by you to address this status to.
+//			The status will be delivered to, and visible to, only the members of that circle (plus any mentioned accounts).
+//			Visibility must be private, and will default to private if not set.
+//		type: string
+//		in: formData
+//	-
 //		name: scheduled_at
 //		x-go-name: ScheduledAt
 //		description: |-
@@ -186,7 +207,10 @@
 //	-
 //		name: language
 //		x-go-name: Language
-//		description: ISO 639 language code for this status.
+//		description: >-
+//			ISO 639 language code for this status.
+//			If not set, the language will be detected from the status text,
+//			falling back to the posting language preference of the account.
 //		type: string
 //		in: formData
 //	-
@@ -432,6 +456,13 @@ func validateStatusCreateForm(form *apimodel.StatusCreateRequest) gtserror.WithC
 		form.Language = lang
 	}
 
+	// Pleroma / Akkoma clients use "local" visibility to create
+	// local-only statuses, which we model as public + local_only.
+	if form.Visibility == apimodel.VisibilityLocal {
+		form.Visibility = apimodel.VisibilityPublic
+		form.LocalOnly = util.Ptr(true)
+	}
+
 	// Check if the deprecated "federated" field was
 	// set in lieu of "local_only", and use it if so.
 	if form.LocalOnly == nil && form.Federated != nil { // nolint:staticcheck
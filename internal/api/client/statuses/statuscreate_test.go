@@ -384,6 +384,22 @@ func (suite *StatusCreateTestSuite) TestPostNewScheduledStatus() {
 }`, out)
 }
 
+func (suite *StatusCreateTestSuite) TestPostNewStatusLocalVisibility() {
+	out, recorder := suite.postStatus(map[string][]string{
+		"status":     {"this status should stay on this instance"},
+		"visibility": {string(apimodel.VisibilityLocal)},
+	}, "")
+
+	// We should have OK from
+	// our call to the function.
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// "local" visibility should be
+	// converted to public + local_only.
+	suite.Contains(out, `"local_only": true`)
+	suite.Contains(out, `"visibility": "public"`)
+}
+
 func (suite *StatusCreateTestSuite) TestPostNewStatusMarkdown() {
 	out, recorder := suite.postStatus(map[string][]string{
 		"status":       {statusMarkdown},
@@ -30,7 +30,8 @@
 //
 // View edit history of status with the given ID.
 //
-// UNIMPLEMENTED: Currently this endpoint will always return an array of length 1, containing only the latest/current version of the status.
+// Revisions are returned oldest first, ending with the latest/current version of the status.
+// Each revision after the first includes a `diff` describing what changed compared to the revision before it.
 //
 //	---
 //	tags:
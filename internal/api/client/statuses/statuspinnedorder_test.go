@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusPinnedOrderTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusPinnedOrderTestSuite) reorderPins(
+	expectedHTTPStatus int,
+	statusIDs []string,
+) []byte {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["admin_account"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["admin_account"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["admin_account"])
+
+	// create the request
+	form := url.Values{"status_ids[]": statusIDs}
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api"+statuses.PinnedOrderPath, strings.NewReader(form.Encode()))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+
+	// trigger the handler
+	suite.statusModule.StatusPinnedOrderPOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+	return b
+}
+
+func (suite *StatusPinnedOrderTestSuite) TestReorderPins() {
+	var (
+		ctx     = context.Background()
+		status1 = suite.testStatuses["admin_account_status_1"]
+		status2 = suite.testStatuses["admin_account_status_2"]
+	)
+
+	// status_2 was pinned most recently, so is
+	// shown first; swap so status_1 is first.
+	b := suite.reorderPins(http.StatusOK, []string{status1.ID, status2.ID})
+
+	resp := []*apimodel.Status{}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(resp, 2) {
+		suite.Equal(status1.ID, resp[0].ID)
+		suite.Equal(status2.ID, resp[1].ID)
+	}
+
+	// New order should be persisted.
+	pinned, err := suite.db.GetAccountPinnedStatuses(ctx, status1.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(pinned, 2) {
+		suite.Equal(status1.ID, pinned[0].ID)
+		suite.Equal(status2.ID, pinned[1].ID)
+	}
+}
+
+func (suite *StatusPinnedOrderTestSuite) TestReorderPinsMissingStatus() {
+	suite.reorderPins(
+		http.StatusUnprocessableEntity,
+		[]string{suite.testStatuses["admin_account_status_1"].ID},
+	)
+}
+
+func (suite *StatusPinnedOrderTestSuite) TestReorderPinsNotPinned() {
+	var (
+		status1 = suite.testStatuses["admin_account_status_1"]
+		status2 = suite.testStatuses["admin_account_status_2"]
+		other   *gtsmodel.Status
+	)
+
+	// Find a status by admin that isn't pinned.
+	for _, status := range suite.testStatuses {
+		if status.AccountID == status1.AccountID &&
+			status.PinnedAt.IsZero() {
+			other = status
+			break
+		}
+	}
+
+	if other == nil {
+		suite.FailNow("no unpinned status found")
+	}
+
+	suite.reorderPins(
+		http.StatusUnprocessableEntity,
+		[]string{status1.ID, status2.ID, other.ID},
+	)
+}
+
+func TestStatusPinnedOrderTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusPinnedOrderTestSuite))
+}
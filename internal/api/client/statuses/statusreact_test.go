@@ -0,0 +1,194 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusReactTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusReactTestSuite) putStatusReaction(
+	targetStatusID string,
+	emoji string,
+	app *gtsmodel.Application,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	account *gtsmodel.Account,
+) (*apimodel.Status, *httptest.ResponseRecorder) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, app)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+
+	const pathBase = "http://localhost:8080/api" + statuses.ReactionPath
+	path := strings.ReplaceAll(pathBase, ":"+apiutil.IDKey, targetStatusID)
+	path = strings.ReplaceAll(path, ":"+statuses.EmojiKey, url.PathEscape(emoji))
+	ctx.Request = httptest.NewRequest(http.MethodPut, path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// Populate target status ID and emoji.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   apiutil.IDKey,
+			Value: targetStatusID,
+		},
+		gin.Param{
+			Key:   statuses.EmojiKey,
+			Value: emoji,
+		},
+	}
+
+	// Trigger handler.
+	suite.statusModule.StatusReactionPUTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder
+	}
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus := new(apimodel.Status)
+	if err := json.Unmarshal(b, apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiStatus, recorder
+}
+
+// React to a status with a unicode emoji.
+func (suite *StatusReactTestSuite) TestPutReaction() {
+	var (
+		targetStatus = suite.testStatuses["admin_account_status_2"]
+		app          = suite.testApplications["application_1"]
+		token        = suite.testTokens["local_account_1"]
+		user         = suite.testUsers["local_account_1"]
+		account      = suite.testAccounts["local_account_1"]
+	)
+
+	apiStatus, recorder := suite.putStatusReaction(
+		targetStatus.ID,
+		"🐢",
+		app,
+		token,
+		user,
+		account,
+	)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Len(apiStatus.EmojiReactions, 1)
+
+	reaction := apiStatus.EmojiReactions[0]
+	suite.Equal("🐢", reaction.Name)
+	suite.Equal(1, reaction.Count)
+	suite.True(reaction.Me)
+	suite.Empty(reaction.URL)
+
+	// Reacting again with the
+	// same emoji is a no-op.
+	apiStatus, recorder = suite.putStatusReaction(
+		targetStatus.ID,
+		"🐢",
+		app,
+		token,
+		user,
+		account,
+	)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Len(apiStatus.EmojiReactions, 1)
+	suite.Equal(1, apiStatus.EmojiReactions[0].Count)
+}
+
+// React to a status with a local custom emoji.
+func (suite *StatusReactTestSuite) TestPutReactionCustomEmoji() {
+	var (
+		targetStatus = suite.testStatuses["admin_account_status_2"]
+		app          = suite.testApplications["application_1"]
+		token        = suite.testTokens["local_account_1"]
+		user         = suite.testUsers["local_account_1"]
+		account      = suite.testAccounts["local_account_1"]
+	)
+
+	apiStatus, recorder := suite.putStatusReaction(
+		targetStatus.ID,
+		"rainbow",
+		app,
+		token,
+		user,
+		account,
+	)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Len(apiStatus.EmojiReactions, 1)
+
+	reaction := apiStatus.EmojiReactions[0]
+	suite.Equal("rainbow", reaction.Name)
+	suite.Equal(1, reaction.Count)
+	suite.True(reaction.Me)
+	suite.NotEmpty(reaction.URL)
+	suite.NotEmpty(reaction.StaticURL)
+}
+
+// Try to react with something that isn't an emoji.
+func (suite *StatusReactTestSuite) TestPutReactionInvalid() {
+	var (
+		targetStatus = suite.testStatuses["admin_account_status_2"]
+		app          = suite.testApplications["application_1"]
+		token        = suite.testTokens["local_account_1"]
+		user         = suite.testUsers["local_account_1"]
+		account      = suite.testAccounts["local_account_1"]
+	)
+
+	_, recorder := suite.putStatusReaction(
+		targetStatus.ID,
+		"hello world",
+		app,
+		token,
+		user,
+		account,
+	)
+
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestStatusReactTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactTestSuite))
+}
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusReactionsTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusReactionsTestSuite) TestGetReactions() {
+	var (
+		ctx          = context.Background()
+		targetStatus = suite.testStatuses["admin_account_status_1"]
+		account1     = suite.testAccounts["local_account_1"]
+		account2     = suite.testAccounts["local_account_2"]
+	)
+
+	// Have both accounts react to the status.
+	for _, acct := range []*gtsmodel.Account{account1, account2} {
+		if _, errWithCode := suite.processor.Status().ReactionCreate(ctx, acct, targetStatus.ID, "🐢"); errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+	}
+
+	if _, errWithCode := suite.processor.Status().ReactionCreate(ctx, account2, targetStatus.ID, "rainbow"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ginCtx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ginCtx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ginCtx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ginCtx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ginCtx.Set(oauth.SessionAuthorizedAccount, account1)
+
+	const pathBase = "http://localhost:8080/api" + statuses.ReactionsPath
+	path := strings.ReplaceAll(pathBase, ":"+apiutil.IDKey, targetStatus.ID)
+	ginCtx.Request = httptest.NewRequest(http.MethodGet, path, nil)
+	ginCtx.Request.Header.Set("accept", "application/json")
+	ginCtx.Params = gin.Params{
+		gin.Param{
+			Key:   apiutil.IDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	suite.statusModule.StatusReactionsGETHandler(ginCtx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reactions := []apimodel.StatusReaction{}
+	if err := json.Unmarshal(b, &reactions); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reactions should be grouped by
+	// emoji, in order of first reaction.
+	suite.Len(reactions, 2)
+
+	suite.Equal("🐢", reactions[0].Name)
+	suite.Equal(2, reactions[0].Count)
+	suite.True(reactions[0].Me)
+	suite.Len(reactions[0].Accounts, 2)
+
+	suite.Equal("rainbow", reactions[1].Name)
+	suite.Equal(1, reactions[1].Count)
+	suite.False(reactions[1].Me)
+	suite.Len(reactions[1].Accounts, 1)
+	suite.Equal(account2.ID, reactions[1].Accounts[0].ID)
+}
+
+func TestStatusReactionsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactionsTestSuite))
+}
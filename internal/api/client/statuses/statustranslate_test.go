@@ -0,0 +1,171 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusTranslateTestSuite struct {
+	StatusStandardTestSuite
+}
+
+// enableTranslation points the translation config at
+// a fake LibreTranslate instance that "translates"
+// texts by upper-casing them, then rebuilds the
+// status module so the processor picks that up.
+func (suite *StatusTranslateTestSuite) enableTranslation() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Q      []string `json:"q"`
+			Target string   `json:"target"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		translated := make([]string, len(body.Q))
+		for i, q := range body.Q {
+			translated[i] = strings.ToUpper(q) + " (" + body.Target + ")"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"translatedText": translated,
+		})
+	}))
+	suite.T().Cleanup(srv.Close)
+
+	config.SetTranslationProvider(config.TranslationProviderLibreTranslate)
+	config.SetTranslationEndpoint(srv.URL)
+
+	suite.processor = testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, suite.mediaManager)
+	suite.statusModule = statuses.New(suite.processor)
+}
+
+func (suite *StatusTranslateTestSuite) translate(statusID string, lang string) (int, string) {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts["local_account_1"]
+		testUser        = suite.testUsers["local_account_1"]
+		testToken       = oauth.DBTokenToToken(suite.testTokens["local_account_1"])
+		target          = fmt.Sprintf("http://localhost:8080%s", strings.ReplaceAll(statuses.TranslatePath, ":id", statusID))
+		form            = url.Values{"lang": {lang}}
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx, _ := testrig.CreateGinTestContext(recorder, request)
+
+	// Set auth + path params.
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: statusID,
+		},
+	}
+
+	// Call the handler.
+	suite.statusModule.StatusTranslatePOSTHandler(ctx)
+
+	// Read body.
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Indent nicely.
+	dst := new(bytes.Buffer)
+	if err := json.Indent(dst, b, "", "  "); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, dst.String()
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslate() {
+	suite.enableTranslation()
+
+	code, body := suite.translate(suite.testStatuses["local_account_1_status_1"].ID, "de")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{
+  "content": "HELLO EVERYONE! (de)",
+  "spoiler_text": "INTRODUCTION POST (de)",
+  "media_attachments": [],
+  "detected_source_language": "en",
+  "language": "de",
+  "provider": "LibreTranslate"
+}`, body)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateSameLanguage() {
+	suite.enableTranslation()
+
+	code, body := suite.translate(suite.testStatuses["local_account_1_status_1"].ID, "en-GB")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+	suite.Equal(`{
+  "error": "Unprocessable Entity: status is already in the requested language"
+}`, body)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateFollowersOnly() {
+	suite.enableTranslation()
+
+	code, body := suite.translate(suite.testStatuses["local_account_1_status_5"].ID, "de")
+	suite.Equal(http.StatusForbidden, code)
+	suite.Equal(`{
+  "error": "Forbidden: only public or unlisted statuses can be translated"
+}`, body)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateNotEnabled() {
+	code, body := suite.translate(suite.testStatuses["local_account_1_status_1"].ID, "de")
+	suite.Equal(http.StatusNotImplemented, code)
+	suite.Equal(`{
+  "error": "Not Implemented: translations are not enabled on this instance"
+}`, body)
+}
+
+func TestStatusTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTranslateTestSuite))
+}
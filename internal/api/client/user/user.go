@@ -31,6 +31,14 @@
 	PasswordChangePath = BasePath + "/password_change"
 	// EmailChangePath is the path for POSTing an email address change request.
 	EmailChangePath = BasePath + "/email_change"
+	// TwoFactorPath is the base path for two factor auth management.
+	TwoFactorPath = BasePath + "/2fa"
+	// TwoFactorEnrollPath is the path for POSTing a two factor enrollment request.
+	TwoFactorEnrollPath = TwoFactorPath + "/enroll"
+	// TwoFactorEnablePath is the path for POSTing a code to complete two factor enrollment.
+	TwoFactorEnablePath = TwoFactorPath + "/enable"
+	// TwoFactorDisablePath is the path for POSTing a two factor disable request.
+	TwoFactorDisablePath = TwoFactorPath + "/disable"
 )
 
 type Module struct {
@@ -47,4 +55,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.UserGETHandler)
 	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
 	attachHandler(http.MethodPost, EmailChangePath, m.EmailChangePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorEnrollPath, m.TwoFactorEnrollPOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorEnablePath, m.TwoFactorEnablePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorDisablePath, m.TwoFactorDisablePOSTHandler)
 }
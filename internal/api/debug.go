@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/api/debug"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+type Debug struct {
+	debug *debug.Module
+}
+
+func (d *Debug) Route(r *router.Router, m ...gin.HandlerFunc) {
+	d.route(r.AttachGroup, m...)
+}
+
+// route attaches the debug endpoints, if enabled,
+// using the given function to create their group.
+func (d *Debug) route(
+	attachGroup func(string, ...gin.HandlerFunc) *gin.RouterGroup,
+	m ...gin.HandlerFunc,
+) {
+	if !config.GetDebugEndpointsEnabled() {
+		// Noop: debug endpoints
+		// not enabled.
+		return
+	}
+
+	// Create new group on top level "debug" prefix.
+	debugGroup := attachGroup("debug")
+	debugGroup.Use(m...)
+	debugGroup.Use(
+		middleware.CacheControl(middleware.CacheControlConfig{
+			// Never cache debug responses.
+			Directives: []string{"no-store"},
+		}),
+		debugTokenAuth(config.GetDebugEndpointsToken()),
+	)
+
+	d.debug.Route(debugGroup.Handle)
+}
+
+func NewDebug(state *state.State) *Debug {
+	return &Debug{
+		debug: debug.New(state),
+	}
+}
+
+// debugTokenAuth returns middleware that aborts any
+// request not bearing the given token in its header
+// as 'Authorization: Bearer <token>'.
+func debugTokenAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth := c.GetHeader("Authorization")
+		bearer, ok := strings.CutPrefix(auth, "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		c.Next()
+	}
+}
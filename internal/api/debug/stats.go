@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package debug
+
+import (
+	"net/http"
+	"runtime"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+// Stats contains a snapshot of
+// runtime and worker queue stats.
+type Stats struct {
+	Goroutines int        `json:"goroutines"`
+	GOMAXPROCS int        `json:"gomaxprocs"`
+	Heap       HeapStats  `json:"heap"`
+	GC         GCStats    `json:"gc"`
+	Queues     QueueStats `json:"queues"`
+}
+
+// HeapStats contains heap memory stats, in bytes unless noted.
+type HeapStats struct {
+	Alloc    uint64 `json:"alloc"`
+	InUse    uint64 `json:"in_use"`
+	Idle     uint64 `json:"idle"`
+	Released uint64 `json:"released"`
+	Objects  uint64 `json:"objects"`
+	Sys      uint64 `json:"sys"`
+}
+
+// GCStats contains garbage collector stats.
+type GCStats struct {
+	NumGC        uint32 `json:"num_gc"`
+	PauseTotalNs uint64 `json:"pause_total_ns"`
+	NextGC       uint64 `json:"next_gc"`
+}
+
+// QueueStats contains the number of
+// tasks queued in each worker pool.
+type QueueStats struct {
+	Client      int `json:"client"`
+	Federator   int `json:"federator"`
+	Delivery    int `json:"delivery"`
+	Dereference int `json:"dereference"`
+	Processing  int `json:"processing"`
+	Transcode   int `json:"transcode"`
+}
+
+// StatsGETHandler returns a JSON snapshot of runtime
+// stats (goroutines, heap, GC) and worker queue lengths.
+func (m *Module) StatsGETHandler(c *gin.Context) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	workers := &m.state.Workers
+	apiutil.JSON(c, http.StatusOK, Stats{
+		Goroutines: runtime.NumGoroutine(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Heap: HeapStats{
+			Alloc:    mem.HeapAlloc,
+			InUse:    mem.HeapInuse,
+			Idle:     mem.HeapIdle,
+			Released: mem.HeapReleased,
+			Objects:  mem.HeapObjects,
+			Sys:      mem.HeapSys,
+		},
+		GC: GCStats{
+			NumGC:        mem.NumGC,
+			PauseTotalNs: mem.PauseTotalNs,
+			NextGC:       mem.NextGC,
+		},
+		Queues: QueueStats{
+			Client:      workers.Client.Queue.Len(),
+			Federator:   workers.Federator.Queue.Len(),
+			Delivery:    workers.Delivery.Queue.Len(),
+			Dereference: workers.Dereference.Queue.Len(),
+			Processing:  workers.Processing.Queue.Len(),
+			Transcode:   workers.Transcode.Queue.Len(),
+		},
+	})
+}
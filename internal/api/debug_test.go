@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+func TestDebugRoute(t *testing.T) {
+	const token = "some-long-random-token"
+	gin.SetMode(gin.TestMode)
+
+	for _, test := range []struct {
+		name    string
+		enabled bool
+		auth    string
+		expect  int
+	}{
+		{
+			name:    "disabled",
+			enabled: false,
+			auth:    "Bearer " + token,
+			expect:  http.StatusNotFound,
+		},
+		{
+			name:    "missing token",
+			enabled: true,
+			auth:    "",
+			expect:  http.StatusUnauthorized,
+		},
+		{
+			name:    "not bearer",
+			enabled: true,
+			auth:    "Basic " + token,
+			expect:  http.StatusUnauthorized,
+		},
+		{
+			name:    "wrong token",
+			enabled: true,
+			auth:    "Bearer some-other-token",
+			expect:  http.StatusUnauthorized,
+		},
+		{
+			name:    "token prefix",
+			enabled: true,
+			auth:    "Bearer " + token[:len(token)-1],
+			expect:  http.StatusUnauthorized,
+		},
+		{
+			name:    "correct token",
+			enabled: true,
+			auth:    "Bearer " + token,
+			expect:  http.StatusOK,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			config.SetDebugEndpointsEnabled(test.enabled)
+			config.SetDebugEndpointsToken(token)
+			t.Cleanup(func() {
+				config.SetDebugEndpointsEnabled(false)
+				config.SetDebugEndpointsToken("")
+			})
+
+			engine := gin.New()
+			NewDebug(&state.State{}).route(engine.Group)
+
+			req := httptest.NewRequest(http.MethodGet, "/debug/pprof/cmdline", nil)
+			if test.auth != "" {
+				req.Header.Set("Authorization", test.auth)
+			}
+
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+
+			if rec.Code != test.expect {
+				t.Fatalf("expected status %d, got %d", test.expect, rec.Code)
+			}
+		})
+	}
+}
@@ -33,13 +33,13 @@ type Fileserver struct {
 
 // Attach cache middleware appropriate for file serving.
 func useFSCacheMiddleware(grp *gin.RouterGroup) {
-	// If we're using local storage or proxying s3 (ie., serving
+	// If we're using local storage or proxying object storage (ie., serving
 	// from here) we can set a long max-age + immutable on file
 	// requests to reflect that we never host different files at
 	// the same URL (since ULIDs are generated per piece of media),
 	// so we can prevent clients having to fetch files repeatedly.
 	//
-	// If we *are* using non-proxying s3, however (ie., not serving
+	// If we *are* using non-proxying object storage, however (ie., not serving
 	// from here) the max age must be set dynamically within the
 	// request handler, based on how long the signed URL has left
 	// to live before it expires. This ensures that clients won't
@@ -50,14 +50,27 @@ func useFSCacheMiddleware(grp *gin.RouterGroup) {
 	//
 	// - https://developer.mozilla.org/en-US/docs/Web/HTTP/Caching#avoiding_revalidation
 	// - https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control#immutable
-	servingFromHere := config.GetStorageBackend() == "local" || config.GetStorageS3Proxy()
+	var servingFromHere bool
+	switch config.GetStorageBackend() {
+	case "local":
+		servingFromHere = true
+	case "s3":
+		servingFromHere = config.GetStorageS3Proxy()
+	case "azure":
+		servingFromHere = config.GetStorageAzureProxy()
+	case "gcs":
+		// GCS without credentials is always proxied.
+		servingFromHere = config.GetStorageGCSProxy() ||
+			config.GetStorageGCSCredentialsFile() == ""
+	}
+
 	if !servingFromHere {
 		return
 	}
 
 	grp.Use(middleware.CacheControl(middleware.CacheControlConfig{
 		Directives: []string{"private", "max-age=604800", "immutable"},
-		Vary:       []string{"Range"}, // Cache partial ranges separately.
+		Vary:       []string{"Range", "Accept"}, // Cache partial ranges + thumbnail fallbacks separately.
 	}))
 }
 
@@ -84,6 +84,7 @@ func (m *Module) ServeFile(c *gin.Context) {
 		MediaType: mediaType,
 		MediaSize: mediaSize,
 		FileName:  fileName,
+		Accept:    apiutil.Accepted(c),
 	})
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -95,6 +96,7 @@ func (m *Module) ServeFile(c *gin.Context) {
 		// the max-age value from how long the link has left until it expires.
 		maxAge := int(time.Until(content.URL.Expiry).Seconds())
 		c.Header("Cache-Control", "private, max-age="+strconv.Itoa(maxAge)+", immutable")
+		c.Header("Vary", "Accept") // Thumbnails may redirect to a fallback.
 		c.Redirect(http.StatusFound, content.URL.String())
 		return
 	}
@@ -44,12 +44,23 @@ func (suite *ServeFileTestSuite) GetFile(
 	mediaType media.Type,
 	mediaSize media.Size,
 	filename string,
+) (code int, headers http.Header, body []byte) {
+	return suite.GetFileAccept("*/*", accountID, mediaType, mediaSize, filename)
+}
+
+// GetFileAccept is like GetFile, but with the given Accept header value.
+func (suite *ServeFileTestSuite) GetFileAccept(
+	accept string,
+	accountID string,
+	mediaType media.Type,
+	mediaSize media.Size,
+	filename string,
 ) (code int, headers http.Header, body []byte) {
 	recorder := httptest.NewRecorder()
 
 	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
 	ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080/whatever", nil)
-	ctx.Request.Header.Set("accept", "*/*")
+	ctx.Request.Header.Set("accept", accept)
 	ctx.AddParam(fileserver.AccountIDKey, accountID)
 	ctx.AddParam(fileserver.MediaTypeKey, string(mediaType))
 	ctx.AddParam(fileserver.MediaSizeKey, string(mediaSize))
@@ -130,6 +141,61 @@ func (suite *ServeFileTestSuite) TestServeSmallLocalFileOK() {
 	suite.Equal(fileInStorage, body)
 }
 
+func (suite *ServeFileTestSuite) TestServeSmallLocalFileFallback() {
+	ctx := context.Background()
+
+	targetAttachment := &gtsmodel.MediaAttachment{}
+	*targetAttachment = *suite.testAttachments["admin_account_status_1_attachment_1"]
+	thumbInStorage, err := suite.storage.Get(ctx, targetAttachment.Thumbnail.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Pretend the thumbnail is avif, and use
+	// the original jpeg as its fallback thumb.
+	fallbackInStorage, err := suite.storage.Get(ctx, targetAttachment.File.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	targetAttachment.Thumbnail.ContentType = "image/avif"
+	targetAttachment.Thumbnail.FallbackPath = targetAttachment.File.Path
+	targetAttachment.Thumbnail.FallbackFileSize = targetAttachment.File.FileSize
+	if err := suite.db.UpdateAttachment(ctx, targetAttachment,
+		"thumbnail_content_type",
+		"thumbnail_fallback_path",
+		"thumbnail_fallback_file_size",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Wildcard accept should get the fallback.
+	code, headers, body := suite.GetFileAccept(
+		"image/webp,*/*",
+		targetAttachment.AccountID,
+		media.TypeAttachment,
+		media.SizeSmall,
+		targetAttachment.ID+".avif",
+	)
+
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("image/jpeg", headers.Get("content-type"))
+	suite.Equal(fallbackInStorage, body)
+
+	// Explicitly accepting avif should get the thumb.
+	code, headers, body = suite.GetFileAccept(
+		"image/avif,image/webp,*/*",
+		targetAttachment.AccountID,
+		media.TypeAttachment,
+		media.SizeSmall,
+		targetAttachment.ID+".avif",
+	)
+
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("image/avif", headers.Get("content-type"))
+	suite.Equal(thumbInStorage, body)
+}
+
 func (suite *ServeFileTestSuite) TestServeOriginalRemoteFileOK() {
 	targetAttachment := &gtsmodel.MediaAttachment{}
 	*targetAttachment = *suite.testAttachments["remote_account_1_status_1_attachment_1"]
@@ -147,6 +147,10 @@ type WebAccount struct {
 	// Only set if this account had a header set
 	// (and not just the default "blank" image.)
 	HeaderAttachment *WebAttachment `json:"-"`
+
+	// Domain to show in the account's handle,
+	// ie., the (extra) account domain it's on.
+	AccountDomain string `json:"-"`
 }
 
 // MutedAccount extends Account with a field used only by the muted user list.
@@ -191,6 +195,17 @@ type AccountCreateRequest struct {
 	// example: en
 	// Required: true
 	Locale string `form:"locale" json:"locale" xml:"locale" binding:"required"`
+	// Response token from the captcha widget, if the instance requires a captcha on sign up.
+	// swagger:parameters
+	CaptchaResponse string `form:"captcha_response" json:"captcha_response" xml:"captcha_response"`
+	// Code of an invite link. Sign-ups with a valid invite
+	// code are allowed even when registrations are closed,
+	// and don't need to be approved by moderators.
+	InviteCode string `form:"invite_code" json:"invite_code" xml:"invite_code"`
+	// Answers to the instance's sign-up questions, keyed by question ID.
+	// Every question must be answered, unless signing up with an invite code.
+	// swagger:ignore
+	Answers map[string]string `form:"answers" json:"answers" xml:"-"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -238,6 +253,25 @@ type UpdateCredentialsRequest struct {
 	// Visibility of statuses to show via the web view.
 	// "none", "public" (default), or "unlisted" (which includes public as well).
 	WebVisibility *string `form:"web_visibility" json:"web_visibility"`
+	// Delete statuses by this account once they're older than
+	// this many days. 0 disables automatic deletion.
+	StatusExpiryDays *int `form:"status_expiry_days" json:"status_expiry_days"`
+	// Don't automatically delete pinned statuses.
+	StatusExpiryKeepPinned *bool `form:"status_expiry_keep_pinned" json:"status_expiry_keep_pinned"`
+	// Don't automatically delete statuses faved by this account.
+	StatusExpiryKeepSelfFaved *bool `form:"status_expiry_keep_self_faved" json:"status_expiry_keep_self_faved"`
+	// Send an email digest of activity to this account
+	// every this many days. 0 disables email digests.
+	EmailDigestDays *int `form:"email_digest_days" json:"email_digest_days"`
+	// Include new followers in email digests.
+	EmailDigestFollows *bool `form:"email_digest_follows" json:"email_digest_follows"`
+	// Include mentions in email digests.
+	EmailDigestMentions *bool `form:"email_digest_mentions" json:"email_digest_mentions"`
+	// Include pending follow requests in email digests.
+	EmailDigestFollowRequests *bool `form:"email_digest_follow_requests" json:"email_digest_follow_requests"`
+	// Reject new statuses by this account that have
+	// media attachments without a description.
+	RequireMediaDescriptions *bool `form:"require_media_descriptions" json:"require_media_descriptions"`
 }
 
 // UpdateSource is to be used specifically in an UpdateCredentialsRequest.
@@ -278,6 +312,9 @@ type AccountFollowRequest struct {
 	Reblogs *bool `form:"reblogs" json:"reblogs" xml:"reblogs"`
 	// Notify when this account posts.
 	Notify *bool `form:"notify" json:"notify" xml:"notify"`
+	// Optional note to show to the account
+	// when it has to approve the follow.
+	Note *string `form:"note" json:"note" xml:"note"`
 }
 
 // AccountDeleteRequest models a request to delete an account.
@@ -311,7 +348,8 @@ type AccountAliasRequest struct {
 // swagger:model accountDisplayRole
 type AccountDisplayRole struct {
 	// ID of the role.
-	// Not used by GotoSocial, but we set it to the role name, just in case a client expects a unique ID.
+	// For GotoSocial's built-in roles, this is set to the role name, just in case a client expects a unique ID.
+	// For custom roles, this is the database ID of the role.
 	ID string `json:"id"`
 
 	// Name of the role.
@@ -332,7 +370,8 @@ type AccountRole struct {
 	Permissions AccountRolePermissions `json:"permissions"`
 
 	// Highlighted indicates whether the role is publicly visible on the user profile.
-	// This is always true for GotoSocial's built-in admin and moderator roles, and false otherwise.
+	// This is always true for GotoSocial's built-in admin and moderator roles,
+	// false for the built-in user role, and configurable for custom roles.
 	Highlighted bool `json:"highlighted"`
 }
 
@@ -349,7 +388,8 @@ type AccountRole struct {
 )
 
 // AccountRolePermissions is a bitmap representing a set of user permissions.
-// It's used for Mastodon API compatibility: internally, GotoSocial only tracks admins and moderators.
+// It's used for Mastodon API compatibility: internally, GotoSocial tracks admins,
+// moderators, and custom roles with their own, smaller set of permissions.
 //
 // swagger:type string
 type AccountRolePermissions int
@@ -389,7 +429,7 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 	AccountRolePermissionsAdministrator AccountRolePermissions = 1 << (iota - 1)
 	// AccountRolePermissionsDevops is not used by GotoSocial.
 	AccountRolePermissionsDevops
-	// AccountRolePermissionsViewAuditLog is not used by GotoSocial.
+	// AccountRolePermissionsViewAuditLog indicates that the user can view the admin action audit log.
 	AccountRolePermissionsViewAuditLog
 	// AccountRolePermissionsViewDashboard is not used by GotoSocial.
 	AccountRolePermissionsViewDashboard
@@ -431,6 +471,7 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 
 	// AccountRolePermissionsForAdminRole includes all of the permissions assigned to GotoSocial's built-in administrator role.
 	AccountRolePermissionsForAdminRole = AccountRolePermissionsAdministrator |
+		AccountRolePermissionsViewAuditLog |
 		AccountRolePermissionsManageReports |
 		AccountRolePermissionsManageFederation |
 		AccountRolePermissionsManageSettings |
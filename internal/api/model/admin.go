@@ -39,6 +39,11 @@ type AdminAccountInfo struct {
 	// no known email address.
 	// example: someone@somewhere.com
 	Email string `json:"email"`
+	// New email address the user has requested to change to,
+	// which has not yet been confirmed. Omitted if there
+	// is no email change pending for the account.
+	// example: someone.else@somewhere.com
+	UnconfirmedEmail string `json:"unconfirmed_email,omitempty"`
 	// The IP address last used to login to this account.
 	// Null if not known.
 	// example: 192.0.2.1
@@ -72,6 +77,11 @@ type AdminAccountInfo struct {
 	CreatedByApplicationID string `json:"created_by_application_id,omitempty"`
 	// The ID of the account that invited this user
 	InvitedByAccountID string `json:"invited_by_account_id,omitempty"`
+	// The ID of the invite this user signed up with.
+	InviteID string `json:"invite_id,omitempty"`
+	// Answers given to sign-up questions when signing up.
+	// Key/value not present if no questions were answered.
+	SignupAnswers []SignupAnswer `json:"signup_answers,omitempty"`
 }
 
 // AdminReport models the admin view of a report.
@@ -89,6 +99,7 @@ type AdminReport struct {
 	// example: 2021-07-30T09:20:25+00:00
 	ActionTakenAt *string `json:"action_taken_at"`
 	// Under what category was this report created?
+	// One of spam, violation, or other.
 	// example: spam
 	Category string `json:"category"`
 	// Comment submitted when the report was created.
@@ -120,6 +131,10 @@ type AdminReport struct {
 	// Array of rules that were broken according to this report.
 	// Will be empty if no rule IDs were submitted with the report.
 	Rules []*InstanceRule `json:"rules"`
+	// Array of IDs of rules that were submitted along with this report.
+	// Will be empty if no rule IDs were submitted.
+	// example: ["01GPBN5YDY6JKBWE44H7YQBDCQ","01GPBN65PDWSBPWVDD0SQCFFY3"]
+	RuleIDs []string `json:"rule_ids"`
 	// If an action was taken, what comment was made by the admin on the taken action?
 	// Will be null if not set / no action yet taken.
 	// example: Account was suspended.
@@ -170,10 +185,14 @@ type AdminEmoji struct {
 type AdminActionRequest struct {
 	// Category of the target entity.
 	Category string `form:"-" json:"-" xml:"-"`
-	// Type of admin action to take. One of disable, silence, suspend.
+	// Type of admin action to take. One of disable (or freeze), silence, suspend.
 	Type string `form:"type" json:"type" xml:"type"`
 	// Text describing why an action was taken.
 	Text string `form:"text" json:"text" xml:"text"`
+	// Time at which to run the action (ISO 8601 Datetime), if not immediately.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
+	// Time at which to automatically lift the action (ISO 8601 Datetime), if ever.
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
 	// ID of the target entity.
 	TargetID string `form:"-" json:"-" xml:"-"`
 }
@@ -189,6 +208,54 @@ type AdminActionResponse struct {
 	ActionID string `json:"action_id"`
 }
 
+// AdminAction models an action taken
+// (or scheduled to be taken) by an admin.
+//
+// swagger:model adminAction
+type AdminAction struct {
+	// The ID of the action.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the action was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Category of the entity targeted by the action.
+	// example: account
+	TargetCategory string `json:"target_category"`
+	// ID of the entity targeted by the action.
+	// This is an account ID, or a domain.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	TargetID string `json:"target_id"`
+	// Type of the action.
+	// example: silence
+	Type string `json:"type"`
+	// ID of the admin account that took the action.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	AccountID string `json:"account_id"`
+	// Text describing why the action was taken.
+	Text string `json:"text,omitempty"`
+	// State of the action, one of:
+	// scheduled (waiting to be run), running,
+	// active (completed, and not lifted), lifted (expired, or reversed by an appeal).
+	// example: active
+	State string `json:"state"`
+	// Time at which the action is scheduled to run (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+	// Time at which the action completed (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CompletedAt string `json:"completed_at,omitempty"`
+	// Time at which the action expires and will be lifted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	ExpiresAt string `json:"expires_at,omitempty"`
+	// Time at which the action was lifted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LiftedAt string `json:"lifted_at,omitempty"`
+	// Any errors encountered while running the action.
+	Errors []string `json:"errors,omitempty"`
+}
+
 // MediaCleanupRequest models admin media cleanup parameters
 //
 // swagger:parameters mediaCleanup
@@ -280,3 +347,49 @@ type AdminAccountRejectRequest struct {
 	// them that their sign-up has been rejected.
 	SendEmail bool `form:"send_email" json:"send_email"`
 }
+
+// AdminSignupsBulkResult models the outcome of a bulk
+// approve or reject action for one pending sign-up.
+//
+// swagger:model adminSignupsBulkResult
+type AdminSignupsBulkResult struct {
+	// ID of the account of the sign-up.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	AccountID string `json:"account_id"`
+	// The account after the action was taken.
+	// Null if the action failed for this sign-up.
+	Account *AdminAccountInfo `json:"account"`
+	// Why the action failed for this sign-up.
+	// Omitted if the action succeeded.
+	// example: Forbidden: only admins can take actions on admin accounts
+	Error string `json:"error,omitempty"`
+}
+
+// AdminSignupsBulkRequest models a request to approve
+// or deny all pending sign-ups matching the given filters.
+// At least one filter must be set.
+//
+// swagger:ignore
+type AdminSignupsBulkRequest struct {
+	// Only sign-ups with an email address at this domain.
+	EmailDomain string `form:"email_domain" json:"email_domain"`
+	// Only sign-ups from this IP address or CIDR range.
+	IP string `form:"ip" json:"ip"`
+	// Only sign-ups created after this time (RFC3339).
+	CreatedAfter string `form:"created_after" json:"created_after"`
+	// Only sign-ups created before this time (RFC3339).
+	CreatedBefore string `form:"created_before" json:"created_before"`
+	// Comment to leave on why the accounts were denied.
+	// The comment will be visible to admins only.
+	// Only used when rejecting.
+	PrivateComment string `form:"private_comment" json:"private_comment"`
+	// Message to include in email to applicants. Any
+	// occurrence of "{username}" is replaced with the
+	// applicant's username. Will be included only if
+	// send_email is true. Only used when rejecting.
+	Message string `form:"message" json:"message"`
+	// Send an email to the applicants informing
+	// them that their sign-ups have been rejected.
+	// Only used when rejecting.
+	SendEmail bool `form:"send_email" json:"send_email"`
+}
@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AIScrapers represents the User-Agent patterns
+// used to recognize AI scrapers on this instance.
+//
+// swagger:model aiScrapers
+type AIScrapers struct {
+	// User-Agent patterns that identify AI scrapers.
+	// A request is considered to come from an AI scraper if its
+	// User-Agent header contains any of these, ignoring case.
+	UserAgents []string `json:"user_agents"`
+	// True if the default list of patterns is in use,
+	// ie., no patterns have been set by an admin.
+	Default bool `json:"default"`
+}
+
+// AIScrapersUpdateRequest represents a request to replace the
+// AI scraper User-Agent patterns, made through the admin API.
+//
+// swagger:ignore
+type AIScrapersUpdateRequest struct {
+	// User-Agent patterns to set.
+	// If empty, the default list is restored.
+	UserAgents []string `form:"user_agents[]" json:"user_agents"`
+}
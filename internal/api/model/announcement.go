@@ -19,7 +19,7 @@
 
 // Announcement models an admin announcement for the instance.
 //
-// TODO: swagger:model announcement once announcement API is supported
+// swagger:model announcement
 type Announcement struct {
 	// The ID of the announcement.
 	// example: 01FC30T7X4TNCZK0TH90QYF3M4
@@ -28,17 +28,19 @@ type Announcement struct {
 	// Should be HTML formatted.
 	// example: <p>This is an announcement. No malarky.</p>
 	Content string `json:"content"`
-	// When the announcement should begin to be displayed (ISO 8601 Datetime).
-	// If the announcement has no start time, this will be omitted or empty.
+	// When the event the announcement is about starts (ISO 8601 Datetime).
+	// If the announcement has no start time, this will be null.
 	// example: 2021-07-30T09:20:25+00:00
-	StartsAt string `json:"starts_at"`
-	// When the announcement should stop being displayed (ISO 8601 Datetime).
-	// If the announcement has no end time, this will be omitted or empty.
+	StartsAt *string `json:"starts_at"`
+	// When the event the announcement is about ends, and the announcement
+	// stops being displayed (ISO 8601 Datetime).
+	// If the announcement has no end time, this will be null.
 	// example: 2021-07-30T09:20:25+00:00
-	EndsAt string `json:"ends_at"`
+	EndsAt *string `json:"ends_at"`
 	// Announcement doesn't have begin time and end time, but begin day and end day.
 	AllDay bool `json:"all_day"`
 	// When the announcement was first published (ISO 8601 Datetime).
+	// Empty if the announcement has not been published yet.
 	// example: 2021-07-30T09:20:25+00:00
 	PublishedAt string `json:"published_at"`
 	// When the announcement was last updated (ISO 8601 Datetime).
@@ -56,7 +58,43 @@ type Announcement struct {
 	// Tags used in this announcement.
 	Tags []Tag `json:"tags"`
 	// Emojis used in this announcement.
-	Emojis []Emoji `json:"emoji"`
+	Emojis []Emoji `json:"emojis"`
 	// Reactions to this announcement.
 	Reactions []AnnouncementReaction `json:"reactions"`
 }
+
+// AdminAnnouncementCreateRequest models
+// a request to create an announcement.
+//
+// swagger:ignore
+type AdminAnnouncementCreateRequest struct {
+	// Text of the announcement, formatted as Markdown.
+	Text string `form:"text" json:"text"`
+	// Start of the event the announcement is about (ISO 8601 Datetime).
+	StartsAt string `form:"starts_at" json:"starts_at"`
+	// End of the event the announcement is about (ISO 8601 Datetime).
+	EndsAt string `form:"ends_at" json:"ends_at"`
+	// Only the dates of starts_at and ends_at are relevant.
+	AllDay bool `form:"all_day" json:"all_day"`
+	// Publish the announcement immediately. Defaults to true.
+	Published *bool `form:"published" json:"published"`
+}
+
+// AdminAnnouncementUpdateRequest models a request to update an
+// announcement. Fields left unset are not changed.
+//
+// swagger:ignore
+type AdminAnnouncementUpdateRequest struct {
+	// Text of the announcement, formatted as Markdown.
+	Text *string `form:"text" json:"text"`
+	// Start of the event the announcement is about (ISO 8601 Datetime).
+	// Empty string to remove the start time.
+	StartsAt *string `form:"starts_at" json:"starts_at"`
+	// End of the event the announcement is about (ISO 8601 Datetime).
+	// Empty string to remove the end time.
+	EndsAt *string `form:"ends_at" json:"ends_at"`
+	// Only the dates of starts_at and ends_at are relevant.
+	AllDay *bool `form:"all_day" json:"all_day"`
+	// Publish or unpublish the announcement.
+	Published *bool `form:"published" json:"published"`
+}
@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Strike models an admin action taken against
+// the requesting user's account, which they
+// may be able to appeal.
+//
+// swagger:model strike
+type Strike struct {
+	// The ID of the strike.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the action was taken (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Type of the action, eg., silence.
+	// example: silence
+	Type string `json:"type"`
+	// Text describing why the action was taken.
+	Text string `json:"text,omitempty"`
+	// Time at which the action expires and will be lifted (ISO 8601 Datetime), if ever.
+	// example: 2021-07-30T09:20:25+00:00
+	ExpiresAt string `json:"expires_at,omitempty"`
+	// Action is still in effect, ie., it has been
+	// neither lifted, nor reversed by an appeal.
+	Active bool `json:"active"`
+	// Appeal made against this strike, if any.
+	Appeal *Appeal `json:"appeal"`
+}
+
+// Appeal models an appeal by a user
+// against a strike on their account.
+//
+// swagger:model appeal
+type Appeal struct {
+	// The ID of the appeal.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the appeal was made (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// ID of the appealed strike.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	StrikeID string `json:"strike_id"`
+	// Text of the appeal.
+	Text string `json:"text"`
+	// State of the appeal, one of pending, approved, rejected.
+	// example: pending
+	State string `json:"state"`
+	// Time at which the appeal was approved or rejected (ISO 8601 Datetime), if at all.
+	// example: 2021-07-30T09:20:25+00:00
+	ReviewedAt string `json:"reviewed_at,omitempty"`
+	// Comment by the admin who approved or rejected the appeal, if any.
+	ReviewComment string `json:"review_comment,omitempty"`
+}
+
+// AdminAppeal models the admin view of an appeal.
+//
+// swagger:model adminAppeal
+type AdminAppeal struct {
+	// The ID of the appeal.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the appeal was made (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the appeal was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// The account that made the appeal.
+	Account *AdminAccountInfo `json:"account"`
+	// The appealed admin action.
+	Action *AdminAction `json:"action"`
+	// Text of the appeal.
+	Text string `json:"text"`
+	// State of the appeal, one of pending, approved, rejected.
+	// example: pending
+	State string `json:"state"`
+	// Time at which the appeal was approved or rejected (ISO 8601 Datetime), if at all.
+	// example: 2021-07-30T09:20:25+00:00
+	ReviewedAt string `json:"reviewed_at,omitempty"`
+	// The admin account that approved or rejected the appeal, if any.
+	ReviewedByAccount *AdminAccountInfo `json:"reviewed_by_account"`
+	// Comment by the admin who approved or rejected the appeal, if any.
+	// This is shown to the appealing user.
+	ReviewComment string `json:"review_comment,omitempty"`
+}
+
+// AppealCreateRequest models a user's appeal against a strike.
+//
+// swagger:ignore
+type AppealCreateRequest struct {
+	// ID of the strike to appeal.
+	StrikeID string `form:"strike_id" json:"strike_id" xml:"strike_id"`
+	// Text of the appeal.
+	Text string `form:"text" json:"text" xml:"text"`
+}
+
+// AdminAppealReviewRequest models an admin's
+// approval or rejection of an appeal.
+//
+// swagger:ignore
+type AdminAppealReviewRequest struct {
+	// Optional comment to show to the appealing user.
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+}
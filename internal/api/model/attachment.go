@@ -124,6 +124,12 @@ type MediaMeta struct {
 	Small MediaDimensions `json:"small,omitempty"`
 	// Focus data for the media.
 	Focus *MediaFocus `json:"focus,omitempty"`
+	// Downsampled waveform of audio media, for rendering
+	// in audio players. Each value is the peak amplitude of
+	// an equal slice of the audio, between 0 and 1, relative
+	// to the loudest peak. Only set for audio attachments.
+	// example: [0.12,0.5,1,0.73]
+	Waveform []float32 `json:"waveform,omitempty"`
 }
 
 // MediaFocus models the focal point of a piece of media.
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminAuditLogEntry models one moderation
+// or admin action taken by an admin.
+//
+// swagger:model adminAuditLogEntry
+type AdminAuditLogEntry struct {
+	// The ID of the audit log entry.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the action was taken (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The admin account that took the action.
+	Account *AdminAccountInfo `json:"account"`
+	// Kind of action that was taken, eg., create, update, delete, approve, reject, suspend.
+	// example: create
+	Action string `json:"action"`
+	// Kind of thing that the action was taken on, eg., account, domain_block, ip_block, report.
+	// example: domain_block
+	TargetType string `json:"target_type"`
+	// ID of the thing that the action was taken on, if any.
+	// Usually a database ID, but may also be a domain name.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	TargetID string `json:"target_id,omitempty"`
+	// Reason or comment given for the action, if any.
+	// example: Spam instance.
+	Text string `json:"text,omitempty"`
+}
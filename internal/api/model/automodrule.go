@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AutomodRule represents a rule that's checked against incoming
+// federated statuses, with an action to take on statuses that match.
+//
+// swagger:model automodRule
+type AutomodRule struct {
+	// The ID of the automod rule.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// How the pattern is matched against statuses, one of:
+	// keyword (whole word or phrase, case-insensitive), regex,
+	// link_domain (links to the domain, or any subdomain of it).
+	// example: keyword
+	MatchType string `json:"match_type"`
+
+	// The keyword, regular expression, or link domain to match.
+	// example: buy followers
+	Pattern string `json:"pattern"`
+
+	// The action taken on matching statuses, one of:
+	// sensitive (mark as sensitive), sin_bin (remove, and keep
+	// a copy in the sin bin for review), drop (remove).
+	// example: sin_bin
+	Action string `json:"action"`
+
+	// Admin comment on why this rule exists.
+	// example: spam wave from december
+	Comment string `json:"comment,omitempty"`
+
+	// The ID of the admin account that created this rule.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the rule was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+}
+
+// AutomodRuleRequest is the form submitted as a POST to create a new automod rule.
+//
+// swagger:parameters automodRuleCreate
+type AutomodRuleRequest struct {
+	// How the pattern is matched against statuses.
+	// enum:
+	//	- keyword
+	//	- regex
+	//	- link_domain
+	// required: true
+	// in: formData
+	MatchType string `form:"match_type" json:"match_type" xml:"match_type"`
+
+	// The keyword, regular expression, or link domain to match.
+	// required: true
+	// in: formData
+	Pattern string `form:"pattern" json:"pattern" xml:"pattern"`
+
+	// The action to take on matching statuses.
+	// enum:
+	//	- sensitive
+	//	- sin_bin
+	//	- drop
+	// required: true
+	// in: formData
+	Action string `form:"action" json:"action" xml:"action"`
+
+	// Optional admin comment on why this rule exists.
+	// in: formData
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+}
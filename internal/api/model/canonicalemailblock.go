@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import "mime/multipart"
+
+// CanonicalEmailBlock represents a block on sign-ups using any email
+// address with the given canonical email hash. The canonical form of
+// an address is lowercased, with any dots or "+suffix" removed from
+// the local part, and the hash is the hex-encoded SHA256 of that form.
+//
+// swagger:model canonicalEmailBlock
+type CanonicalEmailBlock struct {
+	// The ID of the canonical email block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id,omitempty"`
+
+	// Hex-encoded SHA256 hash of the blocked canonical email address.
+	// example: 79a6123c2db3b110c92f2872d217545dfc5ff5147bbdd47e67e72f223747a538
+	CanonicalEmailHash string `json:"canonical_email_hash"`
+
+	// Admin comment on why this block exists.
+	// example: ban evasion
+	Comment string `json:"comment,omitempty"`
+
+	// The ID of the suspended local account this block was created for, if any.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	ReferenceAccountID string `json:"reference_account_id,omitempty"`
+
+	// The ID of the admin account that created this block.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by,omitempty"`
+
+	// Time at which the block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// CanonicalEmailBlockRequest is the form submitted as a POST to create canonical email blocks.
+//
+// swagger:ignore
+type CanonicalEmailBlockRequest struct {
+	// A list of canonical email blocks to import, JSON-formatted.
+	// Only used if import=true is specified.
+	CanonicalEmailBlocks *multipart.FileHeader `form:"canonical_email_blocks" json:"canonical_email_blocks"`
+
+	// An email address to block the canonical form of.
+	// Only used if import=true is NOT specified.
+	Email string `form:"email" json:"email"`
+
+	// A canonical email hash to block, in place of email.
+	// Only used if import=true is NOT specified.
+	CanonicalEmailHash string `form:"canonical_email_hash" json:"canonical_email_hash"`
+
+	// Admin comment on why this block exists.
+	// Only used if import=true is NOT specified.
+	Comment string `form:"comment" json:"comment"`
+}
+
+// CanonicalEmailBlockTestRequest is the form submitted as a POST to
+// check an email address against existing canonical email blocks.
+//
+// swagger:ignore
+type CanonicalEmailBlockTestRequest struct {
+	// Email address to check.
+	Email string `form:"email" json:"email"`
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Circle represents a user-created subset of their
+// followers, which statuses can be addressed to.
+//
+// swagger:model circle
+type Circle struct {
+	// The ID of the circle.
+	ID string `json:"id"`
+	// The user-defined title of the circle.
+	Title string `json:"title"`
+}
+
+// CircleCreateRequest models circle creation parameters.
+//
+// swagger:parameters circleCreate
+type CircleCreateRequest struct {
+	// Title of this circle.
+	// Sample: Close friends
+	// in: formData
+	// required: true
+	Title string `form:"title" json:"title" xml:"title"`
+}
+
+// CircleUpdateRequest models circle update parameters.
+//
+// swagger:ignore
+type CircleUpdateRequest struct {
+	// Title of this circle.
+	// Sample: Close friends
+	// in: formData
+	Title *string `form:"title" json:"title" xml:"title"`
+}
+
+// CircleAccountsChangeRequest is a list of account IDs to add to or remove from a circle.
+//
+// swagger:ignore
+type CircleAccountsChangeRequest struct {
+	AccountIDs []string `form:"account_ids[]" json:"account_ids" xml:"account_ids"`
+}
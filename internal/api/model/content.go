@@ -48,4 +48,8 @@ type GetContentRequestForm struct {
 	MediaSize string
 	// Filename of the content
 	FileName string
+	// Accept contains the content types accepted by the
+	// caller, used to choose between alternate versions
+	// of content (e.g. a thumbnail's jpeg fallback).
+	Accept []string
 }
@@ -26,6 +26,14 @@ type Conversation struct {
 	ID string `json:"id"`
 	// Is the conversation currently marked as unread?
 	Unread bool `json:"unread"`
+	// Is the conversation muted by the requester?
+	// Muted conversations are not marked as unread when new statuses arrive,
+	// and don't generate conversation notifications.
+	Muted bool `json:"muted"`
+	// ID of the most recent status in the conversation that the requester has read.
+	// Statuses in the conversation with a newer ID than this have not been read yet.
+	// Omitted if the requester hasn't read any statuses in the conversation.
+	LastReadStatusID string `json:"last_read_status_id,omitempty"`
 	// Participants in the conversation.
 	//
 	// If this is a conversation between no accounts (ie., a self-directed DM),
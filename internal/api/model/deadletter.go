@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// DeadLetter represents an outgoing ActivityPub delivery
+// that failed permanently, kept for inspection by admins.
+//
+// swagger:model deadLetter
+type DeadLetter struct {
+	// The ID of the dead letter.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the delivery failed permanently (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Domain of the inbox to which delivery failed.
+	// example: example.org
+	Domain string `json:"domain"`
+	// URI of the inbox to which delivery failed.
+	// example: https://example.org/users/someone/inbox
+	InboxURI string `json:"inbox_uri"`
+	// ActivityPub ID of the actor of the activity, if any.
+	// example: https://gts.example.org/users/admin
+	ActorID string `json:"actor_id,omitempty"`
+	// ActivityPub ID of the object of the activity, if any.
+	// example: https://gts.example.org/users/admin/statuses/01FBW21XJA09XYX51KV5JVBW0F
+	ObjectID string `json:"object_id,omitempty"`
+	// ActivityPub ID of the target of the activity, if any.
+	// example: https://example.org/users/someone
+	TargetID string `json:"target_id,omitempty"`
+	// Error message of the last delivery attempt.
+	// example: http response: 503 Service Unavailable (max retries)
+	Error string `json:"error"`
+}
@@ -64,6 +64,28 @@ type DomainPermission struct {
 	// Permission type of this entry (block, allow).
 	// Only set for domain permission drafts.
 	PermissionType string `json:"permission_type,omitempty"`
+	// Authorized fetch mode override for this domain (require, optional).
+	// Only set for domain allows, and only if an override is in place.
+	// example: optional
+	AuthorizedFetchMode string `json:"authorized_fetch_mode,omitempty"`
+	// Maximum number of outgoing deliveries per minute to this domain, overriding
+	// the instance-wide limit. -1 means no limit. Only set for domain allows,
+	// and only if an override is in place.
+	// example: 60
+	DeliveryRateLimit int `json:"delivery_rate_limit,omitempty"`
+	// Maximum number of outgoing dereference requests per minute to this domain,
+	// overriding the instance-wide limit. -1 means no limit. Only set for domain
+	// allows, and only if an override is in place.
+	// example: 120
+	DereferenceRateLimit int `json:"dereference_rate_limit,omitempty"`
+	// Policy applied to media from this domain (reject, sensitive).
+	// Only set for domain allows, and only if a policy is in place.
+	// example: sensitive
+	MediaPolicy string `json:"media_policy,omitempty"`
+	// Domain is limited: federated with, but kept off of public timelines.
+	// Only set for domain allows.
+	// example: false
+	Limited bool `json:"limited,omitempty"`
 }
 
 // DomainPermissionRequest is the form submitted as a POST to create a new domain permission entry (allow/block).
@@ -99,3 +121,23 @@ type DomainKeysExpireRequest struct {
 	// hostname/domain to expire keys for.
 	Domain string `form:"domain" json:"domain"`
 }
+
+// DomainAllowUpdateRequest is the form submitted as a PATCH to update an existing domain allow.
+//
+// swagger:ignore
+type DomainAllowUpdateRequest struct {
+	// Authorized fetch mode override for this domain (require, optional).
+	// Empty string removes the override.
+	AuthorizedFetchMode *string `form:"authorized_fetch_mode" json:"authorized_fetch_mode"`
+	// Max outgoing deliveries per minute to this domain.
+	// -1 means no limit, 0 removes the override.
+	DeliveryRateLimit *int `form:"delivery_rate_limit" json:"delivery_rate_limit"`
+	// Max outgoing dereferences per minute to this domain.
+	// -1 means no limit, 0 removes the override.
+	DereferenceRateLimit *int `form:"dereference_rate_limit" json:"dereference_rate_limit"`
+	// Policy to apply to media from this domain (reject, sensitive).
+	// Empty string removes the policy.
+	MediaPolicy *string `form:"media_policy" json:"media_policy"`
+	// Limit this domain, keeping its statuses off of public timelines.
+	Limited *bool `form:"limited" json:"limited"`
+}
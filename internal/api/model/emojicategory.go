@@ -26,3 +26,26 @@ type EmojiCategory struct {
 	// The name of the custom emoji category.
 	Name string `json:"name"`
 }
+
+// EmojiCategoryCreateUpdateRequest represents a request to
+// create or rename a custom emoji category, made through
+// the admin API.
+//
+// swagger:ignore
+type EmojiCategoryCreateUpdateRequest struct {
+	// Name of the category.
+	// Should not exceed 64 characters.
+	Name string `form:"name" json:"name"`
+}
+
+// EmojiCategoryEmojisRequest represents a request to move
+// local custom emojis into a custom emoji category, made
+// through the admin API.
+//
+// swagger:ignore
+type EmojiCategoryEmojisRequest struct {
+	// IDs of local emojis to move into the category.
+	EmojiIDs []string `form:"emoji_ids[]" json:"emoji_ids"`
+	// ID of a category to move all emojis out of.
+	FromCategoryID string `form:"from_category_id" json:"from_category_id"`
+}
@@ -61,6 +61,120 @@ type AccountExportStats struct {
 	MutesCount int `json:"mutes_count"`
 }
 
+// AccountArchive models one full archive
+// export of an account's data, as requested
+// via the /api/v1/exports/archive endpoint.
+//
+// swagger:model accountArchive
+type AccountArchive struct {
+	// The ID of the archive.
+	//
+	// example: 01JFZ2TTV1T6GGDGF07DFS01T8
+	ID string `json:"id"`
+
+	// Time when the archive was requested (ISO 8601 Datetime).
+	//
+	// example: 2024-12-22T10:00:00.000Z
+	CreatedAt string `json:"created_at"`
+
+	// Time when the archive was last updated (ISO 8601 Datetime).
+	//
+	// example: 2024-12-22T10:05:00.000Z
+	UpdatedAt string `json:"updated_at"`
+
+	// State of the archive, one of
+	// `pending`, `processing`, `done`, `failed`.
+	//
+	// example: processing
+	State string `json:"state"`
+
+	// Progress of archive generation, in percent.
+	//
+	// example: 42
+	Progress int `json:"progress"`
+
+	// Size of the generated archive in bytes.
+	// Only set once state is `done`.
+	//
+	// example: 1048576
+	Size int64 `json:"size,omitempty"`
+
+	// Earliest time at which another archive
+	// may be requested (ISO 8601 Datetime).
+	//
+	// example: 2024-12-29T10:00:00.000Z
+	NextRequestAt string `json:"next_request_at"`
+}
+
+// AccountImport models one CSV data import, and
+// the results of processing it so far.
+//
+// swagger:model accountImport
+type AccountImport struct {
+	// The ID of the import.
+	//
+	// example: 01JFZ2TTV1T6GGDGF07DFS01T8
+	ID string `json:"id"`
+
+	// Time when the import was uploaded (ISO 8601 Datetime).
+	//
+	// example: 2024-12-23T10:00:00.000Z
+	CreatedAt string `json:"created_at"`
+
+	// Time when the import was last updated (ISO 8601 Datetime).
+	//
+	// example: 2024-12-23T10:05:00.000Z
+	UpdatedAt string `json:"updated_at"`
+
+	// Type of entries contained in the import,
+	// one of `following`, `blocks`, `mutes`, `bookmarks`.
+	//
+	// example: following
+	Type string `json:"type"`
+
+	// Mode used for the import, one of `merge`, `overwrite`.
+	//
+	// example: merge
+	Mode string `json:"mode"`
+
+	// State of the import, one of
+	// `processing`, `done`, `failed`.
+	//
+	// example: processing
+	State string `json:"state"`
+
+	// Total number of entries parsed from the uploaded file.
+	//
+	// example: 100
+	Total int `json:"total"`
+
+	// Number of entries processed so
+	// far, including those that failed.
+	//
+	// example: 42
+	Processed int `json:"processed"`
+
+	// Entries that could not be imported.
+	Failures []AccountImportFailure `json:"failures"`
+}
+
+// AccountImportFailure models one entry
+// of an import that could not be imported.
+//
+// swagger:model accountImportFailure
+type AccountImportFailure struct {
+	// The entry that could not be imported,
+	// eg., an account address or status URL.
+	//
+	// example: someone@example.org
+	Entry string `json:"entry"`
+
+	// Why the entry could not be imported.
+	//
+	// example: account could not be found
+	Error string `json:"error"`
+}
+
 // AttachmentRequest models media attachment creation parameters.
 //
 // swagger: ignore
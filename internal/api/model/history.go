@@ -17,7 +17,9 @@
 
 package model
 
-// History represents daily usage history of a hashtag.
+// History represents daily usage history of a hashtag or link.
+//
+// swagger:model history
 type History struct {
 	// UNIX timestamp on midnight of the given day (string cast from integer).
 	Day string `json:"day"`
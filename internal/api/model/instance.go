@@ -117,6 +117,10 @@ type InstanceConfigurationMediaAttachments struct {
 	//
 	// example: 16777216
 	VideoMatrixLimit int `json:"video_matrix_limit"`
+	// Whether new statuses with media attachments
+	// that don't have a description (alt text) will
+	// be rejected by this instance.
+	DescriptionRequired bool `json:"description_required"`
 }
 
 // InstanceConfigurationPolls models instance poll config parameters.
@@ -141,6 +145,15 @@ type InstanceConfigurationPolls struct {
 	MaxExpiration int `json:"max_expiration"`
 }
 
+// InstanceConfigurationOAuth models instance oauth config parameters.
+type InstanceConfigurationOAuth struct {
+	// PKCE code challenge methods (RFC 7636) supported
+	// when using the authorization code flow.
+	//
+	// example: ["plain","S256"]
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
+}
+
 // InstanceConfigurationEmojis models instance emoji config parameters.
 type InstanceConfigurationEmojis struct {
 	// Max allowed emoji image size in bytes.
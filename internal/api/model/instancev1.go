@@ -135,6 +135,8 @@ type InstanceV1Configuration struct {
 	Accounts InstanceConfigurationAccounts `json:"accounts"`
 	// Instance configuration pertaining to emojis.
 	Emojis InstanceConfigurationEmojis `json:"emojis"`
+	// Instance configuration pertaining to oauth.
+	OAuth InstanceConfigurationOAuth `json:"oauth"`
 	// True if instance is running with OIDC as auth/identity backend, else omitted.
 	OIDCEnabled bool `json:"oidc_enabled,omitempty"`
 }
@@ -150,7 +150,7 @@ type InstanceV2URLs struct {
 // swagger:model instanceV2ConfigurationTranslation
 type InstanceV2ConfigurationTranslation struct {
 	// Whether the Translations API is available on this instance.
-	// Not implemented so this value is always false.
+	// True if the instance admin has configured a translation provider.
 	Enabled bool `json:"enabled"`
 }
 
@@ -172,6 +172,8 @@ type InstanceV2Configuration struct {
 	Translation InstanceV2ConfigurationTranslation `json:"translation"`
 	// Instance configuration pertaining to emojis.
 	Emojis InstanceConfigurationEmojis `json:"emojis"`
+	// Instance configuration pertaining to oauth.
+	OAuth InstanceConfigurationOAuth `json:"oauth"`
 	// True if instance is running with OIDC as auth/identity backend, else omitted.
 	OIDCEnabled bool `json:"oidc_enabled,omitempty"`
 }
@@ -190,6 +192,27 @@ type InstanceV2Registrations struct {
 	// Value will be null if no message is set.
 	// example: <p>Registrations are currently closed on example.org because of spam bots!</p>
 	Message *string `json:"message"`
+	// Captcha that must be solved to register, if any.
+	// Key/value not present if no captcha is required.
+	Captcha *InstanceV2RegistrationsCaptcha `json:"captcha,omitempty"`
+	// Extra questions that must be answered to register, in order.
+	// Answers should be passed as answers[question_id] when creating an account.
+	// Key/value not present if there are no questions.
+	Questions []SignupQuestion `json:"questions,omitempty"`
+}
+
+// Captcha that must be solved when registering on this instance.
+// The response token from the provider's widget should be passed
+// as captcha_response when creating an account.
+//
+// swagger:model instanceV2RegistrationsCaptcha
+type InstanceV2RegistrationsCaptcha struct {
+	// Captcha provider, one of hcaptcha, turnstile, friendlycaptcha.
+	// example: turnstile
+	Provider string `json:"provider"`
+	// Public site key to render the provider's widget with.
+	// example: 0x4AAAAAAABkMYinukE8nzY
+	SiteKey string `json:"site_key"`
 }
 
 // Hints related to contacting a representative of the instance.
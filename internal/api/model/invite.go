@@ -0,0 +1,80 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Invite represents an invite link, which allows whoever
+// holds it to sign up without needing admin approval.
+//
+// swagger:model invite
+type Invite struct {
+	// The ID of the invite.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// Random code identifying the invite.
+	// example: 6e0d4cdd1c0aa35a4b0ab0a5b7b1f6be
+	// readonly: true
+	Code string `json:"code"`
+
+	// Link to share with the people you want to invite.
+	// example: https://example.org/signup?invite=6e0d4cdd1c0aa35a4b0ab0a5b7b1f6be
+	// readonly: true
+	URL string `json:"url"`
+
+	// Maximum number of sign-ups allowed with this invite, if limited.
+	// example: 5
+	MaxUses *int `json:"max_uses"`
+
+	// Number of sign-ups made with this invite so far.
+	// example: 2
+	// readonly: true
+	Uses int `json:"uses"`
+
+	// Whether this invite can still be used to sign up,
+	// ie., it hasn't expired or run out of uses.
+	// readonly: true
+	Usable bool `json:"usable"`
+
+	// The ID of the account that created this invite.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the invite was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+
+	// Time at which the invite expires (ISO 8601 Datetime), if it does.
+	// example: 2021-08-30T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// InviteCreateRequest is the form submitted as a POST to create a new invite.
+//
+// swagger:parameters inviteCreate
+type InviteCreateRequest struct {
+	// Maximum number of sign-ups allowed with the invite. Omit or 0 for unlimited.
+	// in: formData
+	MaxUses int `form:"max_uses" json:"max_uses" xml:"max_uses"`
+
+	// Number of seconds from now that the invite should expire. Omit for no expiry.
+	// in: formData
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// IPBlock represents a block on a range of IP addresses.
+//
+// swagger:model ipBlock
+type IPBlock struct {
+	// The ID of the IP block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// The blocked IP range, in CIDR notation.
+	// example: 192.0.2.0/24
+	IP string `json:"ip"`
+
+	// What is blocked for addresses in the range, one of:
+	// sign_up_block (reject sign-ups), no_access (reject all requests).
+	// example: no_access
+	Severity string `json:"severity"`
+
+	// Admin comment on why this block exists.
+	// example: spam signups
+	Comment string `json:"comment,omitempty"`
+
+	// The ID of the admin account that created this block.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+
+	// Time at which the block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	CreatedAt string `json:"created_at"`
+
+	// Time at which the block expires (ISO 8601 Datetime), if it does.
+	// example: 2021-08-30T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// IPBlockCreateRequest is the form submitted as a POST to create a new IP block.
+//
+// swagger:parameters ipBlockCreate
+type IPBlockCreateRequest struct {
+	// The IP address or range to block, in CIDR notation.
+	// example: 192.0.2.0/24
+	// required: true
+	// in: formData
+	IP string `form:"ip" json:"ip" xml:"ip"`
+
+	// What to block for addresses in the range.
+	// enum:
+	//	- sign_up_block
+	//	- no_access
+	// required: true
+	// in: formData
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+
+	// Optional admin comment on why this block exists.
+	// in: formData
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+
+	// Number of seconds from now that the block should expire. Omit for no expiry.
+	// in: formData
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// IPBlockUpdateRequest is the form submitted as a PUT to update an existing IP block.
+//
+// swagger:ignore
+type IPBlockUpdateRequest struct {
+	// The IP address or range to block, in CIDR notation.
+	IP *string `form:"ip" json:"ip" xml:"ip"`
+
+	// What to block for addresses in the range.
+	Severity *string `form:"severity" json:"severity" xml:"severity"`
+
+	// Admin comment on why this block exists.
+	Comment *string `form:"comment" json:"comment" xml:"comment"`
+
+	// Number of seconds from now that the block should expire. 0 removes any expiry.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
@@ -0,0 +1,172 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminMeasure represents quantitative data
+// about the instance over a period of time.
+//
+// swagger:model adminMeasure
+type AdminMeasure struct {
+	// The unique keystring for the requested measure.
+	// example: active_users
+	Key string `json:"key"`
+	// The units associated with this data item's value, if applicable.
+	// example: bytes
+	Unit *string `json:"unit"`
+	// The numeric total associated with the requested measure.
+	// example: 100
+	Total string `json:"total"`
+	// A human-readable formatted value for this data item, if it has a unit.
+	// example: 2.4 MiB
+	HumanValue *string `json:"human_value,omitempty"`
+	// The numeric total associated with the requested
+	// measure, in the previous period of the same length.
+	// example: 50
+	PreviousTotal *string `json:"previous_total,omitempty"`
+	// The data available for the requested measure, split into daily buckets.
+	Data []AdminMeasureData `json:"data"`
+}
+
+// AdminMeasureData is the value of a
+// measure for one daily bucket.
+//
+// swagger:model adminMeasureData
+type AdminMeasureData struct {
+	// Midnight on the requested day in the time period (ISO 8601 Datetime).
+	// example: 2021-07-30T00:00:00.000Z
+	Date string `json:"date"`
+	// The numeric value for the requested measure.
+	// example: 5
+	Value string `json:"value"`
+}
+
+// AdminDimension represents qualitative data
+// about the instance over a period of time.
+//
+// swagger:model adminDimension
+type AdminDimension struct {
+	// The unique keystring for the requested dimension.
+	// example: languages
+	Key string `json:"key"`
+	// The data available for the requested dimension.
+	Data []AdminDimensionData `json:"data"`
+}
+
+// AdminDimensionData is one item of
+// data for an admin dimension.
+//
+// swagger:model adminDimensionData
+type AdminDimensionData struct {
+	// The unique keystring for this data item.
+	// example: en
+	Key string `json:"key"`
+	// A human-readable key for this data item.
+	// example: English
+	HumanKey string `json:"human_key"`
+	// The value for this data item.
+	// example: 10
+	Value string `json:"value"`
+	// The units associated with this data item's value, if applicable.
+	// example: bytes
+	Unit *string `json:"unit,omitempty"`
+	// A human-readable formatted value for this data item, if it has a unit.
+	// example: 2.4 MiB
+	HumanValue *string `json:"human_value,omitempty"`
+}
+
+// AdminCohort represents a retention metric: how many
+// of the users who signed up in a given period were
+// still active in each of the periods that followed.
+//
+// swagger:model adminCohort
+type AdminCohort struct {
+	// The timestamp for the start of the period, at midnight (ISO 8601 Datetime).
+	// example: 2021-07-01T00:00:00.000Z
+	Period string `json:"period"`
+	// The size of the bucket for the returned data.
+	// enum:
+	// - day
+	// - month
+	// example: day
+	Frequency string `json:"frequency"`
+	// Retention data for users who signed up in this period.
+	Data []AdminCohortData `json:"data"`
+}
+
+// AdminCohortData is the retention of
+// a cohort of users in one period.
+//
+// swagger:model adminCohortData
+type AdminCohortData struct {
+	// The timestamp for the start of the bucket, at midnight (ISO 8601 Datetime).
+	// example: 2021-07-02T00:00:00.000Z
+	Date string `json:"date"`
+	// The percentage rate of users who signed
+	// up in the cohort period and were active
+	// in this bucket, expressed as a fraction.
+	// example: 0.5
+	Rate float64 `json:"rate"`
+	// How many users who signed up in the
+	// cohort period were active in this bucket.
+	// example: 3
+	Value string `json:"value"`
+}
+
+// AdminMeasuresRequest models a request for admin measures.
+//
+// swagger:ignore
+type AdminMeasuresRequest struct {
+	// Keys of the measures to request.
+	Keys []string `form:"keys[]" json:"keys"`
+	// Start of the period to request (ISO 8601 Datetime).
+	StartAt string `form:"start_at" json:"start_at"`
+	// End of the period to request (ISO 8601 Datetime).
+	EndAt string `form:"end_at" json:"end_at"`
+	// Params for individual measures, keyed by measure
+	// key, eg., {"tag_uses": {"id": "01FBW9XGEP7G6K88VY4S9MPE1R"}}.
+	Params map[string]map[string]string `form:"-" json:"-"`
+}
+
+// AdminDimensionsRequest models a request for admin dimensions.
+//
+// swagger:ignore
+type AdminDimensionsRequest struct {
+	// Keys of the dimensions to request.
+	Keys []string `form:"keys[]" json:"keys"`
+	// Start of the period to request (ISO 8601 Datetime).
+	StartAt string `form:"start_at" json:"start_at"`
+	// End of the period to request (ISO 8601 Datetime).
+	EndAt string `form:"end_at" json:"end_at"`
+	// Maximum number of data items to return per dimension.
+	Limit int `form:"limit" json:"limit"`
+	// Params for individual dimensions, keyed by dimension
+	// key, eg., {"instance_accounts": {"domain": "example.org"}}.
+	Params map[string]map[string]string `form:"-" json:"-"`
+}
+
+// AdminRetentionRequest models a request for admin retention data.
+//
+// swagger:ignore
+type AdminRetentionRequest struct {
+	// Start of the period to request (ISO 8601 Datetime).
+	StartAt string `form:"start_at" json:"start_at"`
+	// End of the period to request (ISO 8601 Datetime).
+	EndAt string `form:"end_at" json:"end_at"`
+	// Size of the cohort buckets, either day or month.
+	Frequency string `form:"frequency" json:"frequency"`
+}
@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// MediaRetentionPolicy overrides how long remote media from
+// one domain (and its subdomains) is kept in the media cache.
+//
+// swagger:model mediaRetentionPolicy
+type MediaRetentionPolicy struct {
+	// The ID of the media retention policy.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// The domain this policy applies to.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of days to keep remote media from this domain cached for,
+	// in place of the instance's configured media-remote-cache-days.
+	// 0 means media from this domain is kept cached indefinitely.
+	// example: 3
+	RemoteCacheDays int `json:"remote_cache_days"`
+	// Private comment for this policy, visible to this instance's admins only.
+	// example: huge instance, lots of media
+	PrivateComment string `json:"private_comment,omitempty"`
+	// ID of the account that created this policy.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which the policy was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the policy was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// MediaRetentionPolicyRequest is the form submitted to
+// create or update a media retention policy.
+//
+// swagger:ignore
+type MediaRetentionPolicyRequest struct {
+	// Domain to create the policy for.
+	// Ignored when updating a policy.
+	Domain string `form:"domain" json:"domain"`
+	// Number of days to keep remote media from the domain cached for.
+	RemoteCacheDays *int `form:"remote_cache_days" json:"remote_cache_days"`
+	// Private comment about the policy.
+	PrivateComment *string `form:"private_comment" json:"private_comment"`
+}
@@ -34,6 +34,9 @@ type Notification struct {
 	// 	poll = A poll you have voted in or created has ended. `status` will be set. `account` will be set.
 	// 	status = Someone you enabled notifications for has posted a status. `status` will be set. `account` will be set.
 	// 	admin.sign_up = Someone has signed up for a new account on the instance. `account` will be set.
+	// 	admin.appeal = Someone has appealed a moderation action taken against their account. `account` will be set.
+	// 	severed_relationships = Some of your follow relationships were removed by a moderation action. `event` will be set.
+	// 	pleroma:emoji_reaction = Someone reacted to one of your statuses with an emoji. `status` will be set. `account` will be set. `emoji` will be set.
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -44,6 +47,18 @@ type Notification struct {
 
 	// Status that was the object of the notification, e.g. in mentions, reblogs, favourites, or polls.
 	Status *Status `json:"status,omitempty"`
+
+	// Moderation event that removed some of your follow relationships, for severed_relationships notifications.
+	Event *RelationshipSeveranceEvent `json:"event,omitempty"`
+
+	// Emoji used to react to the status, for pleroma:emoji_reaction notifications.
+	// Either a unicode emoji, or the shortcode of a custom emoji.
+	// example: 🐢
+	Emoji string `json:"emoji,omitempty"`
+
+	// URL of the custom emoji used to react to the status, if applicable.
+	// example: https://example.org/fileserver/01BPSX2MKCRVMD4YN4D71G9CP5/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png
+	EmojiURL string `json:"emoji_url,omitempty"`
 }
 
 /*
@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// NotificationGroup represents a group of notifications
+// of the same type, collapsed together server-side
+// (eg., "37 people boosted your post").
+//
+// swagger:model notificationGroup
+type NotificationGroup struct {
+	// Key identifying this group of notifications.
+	// Notifications that cannot be grouped will have
+	// a group key in the form `ungrouped-{notification_id}`.
+	GroupKey string `json:"group_key"`
+	// Total number of notifications in this group
+	// on the returned page of results.
+	NotificationsCount int `json:"notifications_count"`
+	// The type of event that resulted in the notifications in this group.
+	Type string `json:"type"`
+	// ID of the most recent notification in this group.
+	MostRecentNotificationID string `json:"most_recent_notification_id"`
+	// ID of the oldest notification from this group
+	// represented within the current page of results.
+	PageMinID string `json:"page_min_id,omitempty"`
+	// ID of the newest notification from this group
+	// represented within the current page of results.
+	PageMaxID string `json:"page_max_id,omitempty"`
+	// Timestamp of the newest notification from this group
+	// represented within the current page of results (ISO 8601 Datetime).
+	LatestPageNotificationAt string `json:"latest_page_notification_at,omitempty"`
+	// IDs of some of the accounts who most recently triggered
+	// notifications in this group. Up to 8 accounts will be included.
+	SampleAccountIDs []string `json:"sample_account_ids"`
+	// ID of the status that was the object of the notifications
+	// in this group, if the group type relates to a status.
+	StatusID string `json:"status_id,omitempty"`
+}
+
+// GroupedNotificationsResults wraps a page of notification groups,
+// along with the (deduplicated) accounts and statuses they refer to.
+//
+// swagger:model groupedNotificationsResults
+type GroupedNotificationsResults struct {
+	// Accounts referenced by the notification groups.
+	Accounts []*Account `json:"accounts"`
+	// Statuses referenced by the notification groups.
+	Statuses []*Status `json:"statuses"`
+	// The notification groups.
+	NotificationGroups []*NotificationGroup `json:"notification_groups"`
+	// Link header for the previous and next queries,
+	// not serialized as part of the response body.
+	LinkHeader string `json:"-"`
+}
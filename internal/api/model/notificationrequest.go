@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// NotificationRequest represents a bundle of notifications
+// from one account, held back by the requesting account's
+// notification policy until accepted or dismissed.
+//
+// swagger:model notificationRequest
+type NotificationRequest struct {
+	// The ID of the notification request.
+	ID string `json:"id"`
+	// When the first filtered notification from the account was received (ISO 8601 Datetime).
+	CreatedAt string `json:"created_at"`
+	// When the notification request was last updated (ISO 8601 Datetime).
+	UpdatedAt string `json:"updated_at"`
+	// The account that performed the actions that caused the notifications.
+	Account *Account `json:"account"`
+	// Number of filtered notifications from this account.
+	// Serialized as a string for compatibility with Mastodon.
+	NotificationsCount string `json:"notifications_count"`
+	// Most recent status attached to a filtered notification
+	// from this account, if any, and if still visible.
+	LastStatus *Status `json:"last_status,omitempty"`
+}
+
+// NotificationPolicy represents how the requesting
+// account handles notifications from unfamiliar accounts.
+//
+// Each criterion may be set to one of:
+//
+//	accept = Show notifications as normal.
+//	filter = Hold notifications in a notification request.
+//	drop = Don't create notifications at all.
+//
+// swagger:model notificationPolicy
+type NotificationPolicy struct {
+	// What to do with notifications from accounts the requesting account doesn't follow.
+	ForNotFollowing string `json:"for_not_following"`
+	// What to do with notifications from accounts that don't follow the requesting account.
+	ForNotFollowers string `json:"for_not_followers"`
+	// What to do with notifications from accounts created in the past 30 days.
+	ForNewAccounts string `json:"for_new_accounts"`
+	// What to do with private mentions not in reply to the requesting
+	// account, from accounts the requesting account doesn't follow.
+	ForPrivateMentions string `json:"for_private_mentions"`
+	// What to do with notifications from accounts limited by
+	// moderators, that the requesting account doesn't follow.
+	ForLimitedAccounts string `json:"for_limited_accounts"`
+	// Summary of filtered notifications.
+	Summary NotificationPolicySummary `json:"summary"`
+}
+
+// NotificationPolicySummary summarizes
+// notifications held back by a notification policy.
+//
+// swagger:model notificationPolicySummary
+type NotificationPolicySummary struct {
+	// Number of pending notification requests.
+	PendingRequestsCount int `json:"pending_requests_count"`
+	// Number of notifications held in pending notification requests.
+	PendingNotificationsCount int `json:"pending_notifications_count"`
+}
+
+// NotificationPolicyUpdateRequest models an update
+// to the requesting account's notification policy.
+//
+// swagger:ignore
+type NotificationPolicyUpdateRequest struct {
+	ForNotFollowing    *string `form:"for_not_following" json:"for_not_following"`
+	ForNotFollowers    *string `form:"for_not_followers" json:"for_not_followers"`
+	ForNewAccounts     *string `form:"for_new_accounts" json:"for_new_accounts"`
+	ForPrivateMentions *string `form:"for_private_mentions" json:"for_private_mentions"`
+	ForLimitedAccounts *string `form:"for_limited_accounts" json:"for_limited_accounts"`
+}
@@ -36,4 +36,10 @@ type OAuthAuthorize struct {
 	// The authorization server must return the unmodified state value back to the application.
 	// See https://www.oauth.com/oauth2-servers/authorization/the-authorization-request/
 	State string `form:"state" json:"state"`
+	// PKCE code challenge derived from the code verifier, as per RFC 7636.
+	// If set, the code verifier must be provided when exchanging the code for a token.
+	CodeChallenge string `form:"code_challenge" json:"code_challenge"`
+	// Method used to derive the code challenge, either `S256` or `plain`.
+	// Defaults to `plain` if a code challenge is set but this isn't.
+	CodeChallengeMethod string `form:"code_challenge_method" json:"code_challenge_method"`
 }
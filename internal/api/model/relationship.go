@@ -44,6 +44,8 @@ type Relationship struct {
 	Requested bool `json:"requested"`
 	// This account has requested to follow you, and the request is pending.
 	RequestedBy bool `json:"requested_by"`
+	// Note attached by this account to its pending follow request, if any.
+	RequestedByNote string `json:"requested_by_note,omitempty"`
 	// You are blocking this account's domain.
 	DomainBlocking bool `json:"domain_blocking"`
 	// You are featuring this account on your profile.
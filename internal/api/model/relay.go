@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Relay represents a subscription of this
+// instance to an ActivityPub relay.
+//
+// swagger:model relay
+type Relay struct {
+	// The ID of the relay subscription.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// ActivityPub URI of the relay actor.
+	// example: https://relay.example.org/actor
+	ActorURI string `json:"actor_uri"`
+	// Inbox URI of the relay, to which local public posts are delivered.
+	// example: https://relay.example.org/inbox
+	InboxURI string `json:"inbox_uri"`
+	// State of the subscription, one of `pending`, `accepted` or `rejected`.
+	// example: accepted
+	State string `json:"state"`
+	// Whether content is currently accepted from and delivered to this relay.
+	// example: true
+	Enabled bool `json:"enabled"`
+	// ID of the account that subscribed to this relay.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which the subscription was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the subscription was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// RelayRequest is the form submitted to
+// create or update a relay subscription.
+//
+// swagger:ignore
+type RelayRequest struct {
+	// ActivityPub URI of the relay actor.
+	// Ignored when updating a relay.
+	ActorURI string `form:"actor_uri" json:"actor_uri"`
+	// Enable or disable the relay.
+	// Ignored when creating a relay.
+	Enabled *bool `form:"enabled" json:"enabled"`
+}
@@ -39,6 +39,7 @@ type Report struct {
 	// example: Account was suspended.
 	ActionTakenComment *string `json:"action_taken_comment"`
 	// Under what category was this report created?
+	// One of spam, violation, or other.
 	// example: spam
 	Category string `json:"category"`
 	// Comment submitted when the report was created.
@@ -83,12 +84,12 @@ type ReportCreateRequest struct {
 	// in: formData
 	Forward bool `form:"forward" json:"forward" xml:"forward"`
 	// Specify if the report is due to spam, violation of enumerated instance rules, or some other reason.
-	// Currently only 'other' is supported.
-	// Sample: other
-	// default: other
+	// One of spam, violation, or other. If not set, defaults to violation if rule_ids are given, else other.
+	// Sample: spam
 	// in: formData
 	Category string `form:"category" json:"category" xml:"category"`
 	// IDs of rules on this instance which have been broken according to the reporter.
+	// Can only be given with category violation.
 	// Sample: ["01GPBN5YDY6JKBWE44H7YQBDCQ","01GPBN65PDWSBPWVDD0SQCFFY3"]
 	// in: formData
 	RuleIDs []string `form:"rule_ids[]" json:"rule_ids" xml:"rule_ids"`
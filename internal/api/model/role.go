@@ -0,0 +1,80 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminRole models a custom role defined by the admins
+// of this instance, which grants users some moderation
+// and admin permissions without making them an admin.
+//
+// swagger:model adminRole
+type AdminRole struct {
+	// The ID of the role.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the role was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the role was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// Name of the role.
+	// example: Report Wrangler
+	Name string `json:"name"`
+	// Permissions granted by the role, any of
+	// view_audit_log, manage_reports, manage_federation, manage_users.
+	// example: ["view_audit_log","manage_reports"]
+	Permissions []string `json:"permissions"`
+	// Show the role publicly on the profiles of users with it.
+	Highlighted bool `json:"highlighted"`
+}
+
+// AdminRoleCreateRequest models a request to create a custom role.
+//
+// swagger:ignore
+type AdminRoleCreateRequest struct {
+	// Name of the role.
+	Name string `form:"name" json:"name"`
+	// Permissions granted by the role.
+	Permissions []string `form:"permissions[]" json:"permissions"`
+	// Show the role publicly on the profiles of users with it.
+	Highlighted bool `form:"highlighted" json:"highlighted"`
+}
+
+// AdminRoleUpdateRequest models a request to update a custom role.
+// Fields left unset are not changed.
+//
+// swagger:ignore
+type AdminRoleUpdateRequest struct {
+	// Name of the role.
+	Name *string `form:"name" json:"name"`
+	// Permissions granted by the role.
+	Permissions []string `form:"permissions[]" json:"permissions"`
+	// Show the role publicly on the profiles of users with it.
+	Highlighted *bool `form:"highlighted" json:"highlighted"`
+}
+
+// AdminAccountRoleRequest models a request to
+// give a custom role to a local account.
+//
+// swagger:ignore
+type AdminAccountRoleRequest struct {
+	// ID of the custom role to give the account.
+	// Leave empty to take away the account's role.
+	RoleID string `form:"role_id" json:"role_id"`
+}
@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// RelationshipSeveranceEvent represents a moderation event,
+// such as a domain block or account suspension, which caused
+// follow relationships of the requesting account to be removed.
+//
+// swagger:model relationshipSeveranceEvent
+type RelationshipSeveranceEvent struct {
+	// The ID of the event.
+	ID string `json:"id"`
+	// Type of moderation event.
+	// 	domain_block = An admin blocked a domain, removing follows with accounts on that domain.
+	// 	account_suspension = An admin suspended an account, removing follows with that account.
+	Type string `json:"type"`
+	// Whether the relationships have been permanently removed,
+	// rather than being suspended and possibly restored later.
+	// Always true on GoToSocial, as the accounts are deleted.
+	Purged bool `json:"purged"`
+	// Name of the target of the event, ie., the blocked
+	// domain, or the username of the suspended account.
+	TargetName string `json:"target_name"`
+	// Number of followers that the requesting account lost in this event.
+	FollowersCount int `json:"followers_count"`
+	// Number of followed accounts that the requesting account lost in this event.
+	FollowingCount int `json:"following_count"`
+	// When the event took place (ISO 8601 Datetime).
+	CreatedAt string `json:"created_at"`
+}
+
+// SeveredRelationship represents one follow relationship
+// of the requesting account removed by a moderation event.
+//
+// swagger:model severedRelationship
+type SeveredRelationship struct {
+	// The ID of the severed relationship.
+	ID string `json:"id"`
+	// Direction of the severed follow.
+	// 	following = The requesting account followed the other account.
+	// 	follower = The other account followed the requesting account.
+	Direction string `json:"direction"`
+	// The username + domain of the other account, for remote accounts,
+	// or just username for local accounts.
+	Acct string `json:"acct"`
+	// ActivityPub URI of the other account.
+	URI string `json:"uri"`
+}
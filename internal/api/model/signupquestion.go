@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// SignupQuestion represents an extra question
+// that applicants must answer when signing up.
+//
+// swagger:model signupQuestion
+type SignupQuestion struct {
+	// The ID of the question.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+
+	// Text of the question, plaintext.
+	// example: How did you hear about this instance?
+	Text string `json:"text"`
+}
+
+// SignupAnswer represents an applicant's
+// answer to one sign-up question.
+//
+// swagger:model signupAnswer
+type SignupAnswer struct {
+	// The ID of the question being answered.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	QuestionID string `json:"question_id"`
+
+	// Text of the question, at the time it was answered.
+	// example: How did you hear about this instance?
+	Question string `json:"question"`
+
+	// Text of the answer, plaintext.
+	// example: A friend of mine is on here already!
+	Answer string `json:"answer"`
+}
+
+// SignupQuestionCreateRequest represents a request to create
+// a new sign-up question, made through the admin API.
+//
+// swagger:parameters signupQuestionCreate
+type SignupQuestionCreateRequest struct {
+	// Text of the question, plaintext.
+	// required: true
+	// in: formData
+	Text string `form:"text" json:"text"`
+}
+
+// SignupQuestionUpdateRequest represents a request to update the
+// text of a sign-up question, made through the admin API.
+//
+// swagger:parameters signupQuestionUpdate
+type SignupQuestionUpdateRequest struct {
+	// The id of the question to update.
+	// required: true
+	// in: path
+	ID string `form:"id" json:"id"`
+	// Text of the question, plaintext.
+	// required: true
+	// in: formData
+	Text string `form:"text" json:"text"`
+}
@@ -48,4 +48,24 @@ type Source struct {
 	//
 	// Omitted from json if empty / not set.
 	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
+	// Statuses by this account are deleted once they're
+	// older than this many days. 0 = statuses are never
+	// automatically deleted.
+	StatusExpiryDays int `json:"status_expiry_days"`
+	// Pinned statuses are exempt from automatic deletion.
+	StatusExpiryKeepPinned bool `json:"status_expiry_keep_pinned"`
+	// Statuses faved by this account are exempt from automatic deletion.
+	StatusExpiryKeepSelfFaved bool `json:"status_expiry_keep_self_faved"`
+	// An email digest of activity is sent to this account
+	// every this many days. 0 = email digests are not sent.
+	EmailDigestDays int `json:"email_digest_days"`
+	// New followers are included in email digests.
+	EmailDigestFollows bool `json:"email_digest_follows"`
+	// Mentions are included in email digests.
+	EmailDigestMentions bool `json:"email_digest_mentions"`
+	// Pending follow requests are included in email digests.
+	EmailDigestFollowRequests bool `json:"email_digest_follow_requests"`
+	// New statuses by this account are rejected if they have
+	// media attachments without a description (alt text).
+	RequireMediaDescriptions bool `json:"require_media_descriptions"`
 }
@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminSpamFlag models an incoming status that was
+// removed because its spam score met the threshold.
+//
+// swagger:model adminSpamFlag
+type AdminSpamFlag struct {
+	// The ID of the spam flag.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the status was flagged (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the spam flag was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// ActivityPub URI of the flagged status.
+	// example: https://example.org/users/someone/statuses/01H9QG6TZ9W5P0402VFRVM17TH
+	StatusURI string `json:"status_uri"`
+	// The remote account that authored the flagged status.
+	Account *AdminAccountInfo `json:"account"`
+	// The local account that the flagged status was delivered to.
+	TargetAccount *AdminAccountInfo `json:"target_account"`
+	// Spam score given to the status.
+	// example: 5
+	Score int `json:"score"`
+	// Reasons contributing to the spam score.
+	Reasons []string `json:"reasons"`
+	// Action taken on the status, one of drop, sin_bin.
+	// example: sin_bin
+	Action string `json:"action"`
+	// Time at which an admin marked the flag as a false positive (ISO 8601 Datetime), if at all.
+	// example: 2021-07-30T09:20:25+00:00
+	FalsePositiveAt string `json:"false_positive_at,omitempty"`
+	// The admin account that marked the flag as a false positive, if any.
+	FalsePositiveByAccount *AdminAccountInfo `json:"false_positive_by_account"`
+}
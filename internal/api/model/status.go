@@ -48,6 +48,9 @@ type Status struct {
 	Visibility Visibility `json:"visibility"`
 	// Set to "true" if status is not federated, ie., a "local only" status; omitted from response otherwise.
 	LocalOnly bool `json:"local_only,omitempty"`
+	// ID of the circle this status is addressed to.
+	// Only shown to the author of the status; omitted from response otherwise.
+	CircleID *string `json:"circle_id,omitempty"`
 	// Primary language of this status (ISO 639 Part 1 two-letter language code).
 	// Will be null if language is not known.
 	// example: en
@@ -80,6 +83,12 @@ type Status struct {
 	// The status that this status reblogs/boosts.
 	// nullable: true
 	Reblog *StatusReblogged `json:"reblog"`
+	// ID of the status that this status quotes; omitted if this status is not a quote.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	QuoteID *string `json:"quote_id,omitempty"`
+	// The status that this status quotes; omitted if this status is not
+	// a quote, or the quoted status is not visible to the requesting account.
+	Quote *StatusQuoted `json:"quote,omitempty"`
 	// The application used to post this status, if visible.
 	Application *Application `json:"application,omitempty"`
 	// The account that authored this status.
@@ -106,6 +115,9 @@ type Status struct {
 	Filtered []FilterResult `json:"filtered,omitempty"`
 	// The interaction policy for this status, as set by the status author.
 	InteractionPolicy InteractionPolicy `json:"interaction_policy"`
+	// Emoji reactions to this status, grouped by emoji, in order of
+	// the first reaction with each emoji; omitted if there are none.
+	EmojiReactions []StatusReaction `json:"emoji_reactions,omitempty"`
 }
 
 // WebStatus is like *model.Status, but contains
@@ -189,6 +201,13 @@ type StatusReblogged struct {
 	*Status
 }
 
+// StatusQuoted represents a quoted status.
+//
+// swagger:model statusQuoted
+type StatusQuoted struct {
+	*Status
+}
+
 // StatusCreateRequest models status creation parameters.
 //
 // swagger:ignore
@@ -204,6 +223,8 @@ type StatusCreateRequest struct {
 	Poll *PollRequest `form:"poll" json:"poll"`
 	// ID of the status being replied to, if status is a reply.
 	InReplyToID string `form:"in_reply_to_id" json:"in_reply_to_id"`
+	// ID of the status being quoted, if status is a quote.
+	QuoteID string `form:"quote_id" json:"quote_id"`
 	// Status and attached media should be marked as sensitive.
 	Sensitive bool `form:"sensitive" json:"sensitive"`
 	// Text to be shown as a warning or subject before the actual content.
@@ -215,11 +236,15 @@ type StatusCreateRequest struct {
 	LocalOnly *bool `form:"local_only" json:"local_only"`
 	// Deprecated: Only used if LocalOnly is not set.
 	Federated *bool `form:"federated" json:"federated"`
+	// ID of a circle owned by the author to address this status to.
+	// If set, visibility must be "private" (or omitted).
+	CircleID string `form:"circle_id" json:"circle_id"`
 	// ISO 8601 Datetime at which to schedule a status.
 	// Providing this parameter will cause ScheduledStatus to be returned instead of Status.
 	// Must be at least 5 minutes in the future.
 	ScheduledAt string `form:"scheduled_at" json:"scheduled_at"`
 	// ISO 639 language code for this status.
+	// If not set, it's detected from the status text.
 	Language string `form:"language" json:"language"`
 	// Content type to use when parsing this status.
 	ContentType StatusContentType `form:"content_type" json:"content_type"`
@@ -255,6 +280,10 @@ type StatusInteractionPolicyForm struct {
 	VisibilityMutualsOnly Visibility = "mutuals_only"
 	// VisibilityDirect is visible only to accounts tagged in the status. It is equivalent to a direct message.
 	VisibilityDirect Visibility = "direct"
+	// VisibilityLocal is visible to everyone, but is never federated. This is only accepted
+	// when creating a status, for compatibility with Pleroma / Akkoma clients, and is
+	// equivalent to creating a public status with local_only set to true.
+	VisibilityLocal Visibility = "local"
 )
 
 // StatusContentType is the content type with which to parse the submitted status.
@@ -283,6 +312,10 @@ type StatusSource struct {
 	Text string `json:"text"`
 	// Plain-text version of spoiler text.
 	SpoilerText string `json:"spoiler_text"`
+	// Content type with which the source text was formatted.
+	// Omitted for statuses created before content types were stored.
+	// example: text/markdown
+	ContentType StatusContentType `json:"content_type,omitempty"`
 }
 
 // StatusEdit represents one historical revision of a status, containing
@@ -313,4 +346,84 @@ type StatusEdit struct {
 	MediaAttachments []*Attachment `json:"media_attachments"`
 	// Custom emoji to be used when rendering status content.
 	Emojis []Emoji `json:"emojis"`
+	// Changes made in this revision, compared to the revision before it.
+	// Not set on the first revision.
+	// nullable: true
+	Diff *StatusEditDiff `json:"diff,omitempty"`
+}
+
+// StatusEditDiff describes what changed between
+// one revision of a status and the one before it.
+//
+// swagger:model statusEditDiff
+type StatusEditDiff struct {
+	// Word-level changes to the plaintext content of the status.
+	Content []TextDiffChunk `json:"content"`
+	// Word-level changes to the spoiler text of the status.
+	SpoilerText []TextDiffChunk `json:"spoiler_text"`
+	// Whether the status was marked or unmarked as sensitive.
+	// example: false
+	SensitiveChanged bool `json:"sensitive_changed"`
+	// Changes to the media attached to the status.
+	MediaAttachments StatusEditMediaDiff `json:"media_attachments"`
+	// Changes to the poll attached to the status.
+	// Null if the status had no poll before or after.
+	// nullable: true
+	Poll *StatusEditPollDiff `json:"poll"`
+}
+
+// TextDiffChunk is one chunk of a diff between two texts.
+//
+// swagger:model textDiffChunk
+type TextDiffChunk struct {
+	// Whether this chunk is in both texts (equal),
+	// only in the newer text (insert), or only in
+	// the older text (delete).
+	// example: insert
+	Type string `json:"type"`
+	// Text of this chunk.
+	// example: hello world
+	Text string `json:"text"`
+}
+
+// StatusEditMediaDiff describes changes to media
+// attached to a status between two revisions.
+//
+// swagger:model statusEditMediaDiff
+type StatusEditMediaDiff struct {
+	// IDs of attachments added in this revision.
+	Added []string `json:"added"`
+	// IDs of attachments removed in this revision.
+	Removed []string `json:"removed"`
+	// IDs of attachments whose description changed in this revision.
+	DescriptionChanged []string `json:"description_changed"`
+	// Whether attachments kept from the
+	// previous revision were reordered.
+	// example: false
+	Reordered bool `json:"reordered"`
+}
+
+// StatusEditPollDiff describes changes to the poll
+// attached to a status between two revisions.
+//
+// swagger:model statusEditPollDiff
+type StatusEditPollDiff struct {
+	// Options added in this revision.
+	Added []string `json:"added"`
+	// Options removed in this revision.
+	Removed []string `json:"removed"`
+	// Whether the poll was changed to or from multiple choice.
+	// example: false
+	MultipleChanged bool `json:"multiple_changed"`
+}
+
+// StatusPinnedOrderRequest models a request
+// to reorder the requester's pinned statuses.
+//
+// swagger:ignore
+type StatusPinnedOrderRequest struct {
+	// IDs of all pinned statuses of the
+	// requester, in the order they should
+	// be shown, first being shown first.
+	StatusIDs []string `form:"status_ids[]" json:"status_ids"`
 }
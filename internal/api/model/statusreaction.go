@@ -0,0 +1,43 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusReaction represents all emoji reactions
+// to a status using one particular emoji.
+//
+// swagger:model statusReaction
+type StatusReaction struct {
+	// The emoji used to react. Either a unicode emoji, or the shortcode
+	// of a custom emoji, suffixed with @domain for remote custom emoji.
+	// example: blobcat
+	Name string `json:"name"`
+	// Number of accounts that reacted to the status with this emoji.
+	// example: 3
+	Count int `json:"count"`
+	// Whether the account viewing the status reacted with this emoji.
+	Me bool `json:"me"`
+	// URL of the custom emoji image, if applicable.
+	// example: https://example.org/fileserver/01BPSX2MKCRVMD4YN4D71G9CP5/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png
+	URL string `json:"url,omitempty"`
+	// URL of a static version of the custom emoji image, if applicable.
+	// example: https://example.org/fileserver/01BPSX2MKCRVMD4YN4D71G9CP5/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png
+	StaticURL string `json:"static_url,omitempty"`
+	// Accounts that reacted to the status with this emoji.
+	// Only included when listing the reactions to a status.
+	Accounts []*Account `json:"accounts,omitempty"`
+}
@@ -27,10 +27,10 @@ type Tag struct {
 	// Web link to the hashtag.
 	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
-	// History of this hashtag's usage.
-	// Currently just a stub, if provided will always be an empty array.
-	// example: []
-	History *[]any `json:"history,omitempty"`
+	// Daily history of this hashtag's usage, newest first.
+	// Only populated for trending hashtags, otherwise
+	// if provided will always be an empty array.
+	History *[]History `json:"history,omitempty"`
 	// Following is true if the user is following this tag, false if they're not,
 	// and not present if there is no currently authenticated user.
 	Following *bool `json:"following,omitempty"`
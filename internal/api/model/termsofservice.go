@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// TermsOfService models one published
+// version of this instance's terms of service.
+//
+// swagger:model termsOfService
+type TermsOfService struct {
+	// The ID of this version of the terms.
+	// example: 01JK5Q3G2ZNXM7D8JY4N1C0T6E
+	ID string `json:"id"`
+	// Date on which this version came into effect (ISO 8601 Date).
+	// example: 2025-02-04
+	EffectiveDate string `json:"effective_date"`
+	// Whether this version is the one currently in effect.
+	Effective bool `json:"effective"`
+	// HTML content of the terms.
+	Content string `json:"content"`
+	// Date on which the version replacing this one came
+	// into effect (ISO 8601 Date). Null for the current version.
+	// example: 2025-03-01
+	SucceededBy *string `json:"succeeded_by"`
+}
+
+// AdminTermsOfService models one published version of
+// this instance's terms of service, as seen by an admin.
+//
+// swagger:model adminTermsOfService
+type AdminTermsOfService struct {
+	// The ID of this version of the terms.
+	// example: 01JK5Q3G2ZNXM7D8JY4N1C0T6E
+	ID string `json:"id"`
+	// Time at which this version was published, and came into effect (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Whether this version is the one currently in effect.
+	Effective bool `json:"effective"`
+	// Raw Markdown text of the terms.
+	Text string `json:"text"`
+	// HTML content of the terms, parsed from text.
+	Content string `json:"content"`
+	// Whether users had to accept this version
+	// the next time they signed in after it was published.
+	RequireAcceptance bool `json:"require_acceptance"`
+}
+
+// AdminTermsOfServiceCreateRequest models
+// a request to publish a new terms of service version.
+//
+// swagger:ignore
+type AdminTermsOfServiceCreateRequest struct {
+	// Markdown text of the terms.
+	Text string `form:"text" json:"text"`
+	// Require users to accept the new
+	// terms the next time they sign in.
+	RequireAcceptance bool `form:"require_acceptance" json:"require_acceptance"`
+}
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents the translation
+// of a status into another language.
+//
+// swagger:model translation
+type Translation struct {
+	// HTML-encoded translated content of the status.
+	// example: <p>Hello world!</p>
+	Content string `json:"content"`
+	// Translated subject, summary, or content warning of the status.
+	// example: Greetings
+	SpoilerText string `json:"spoiler_text"`
+	// Translated poll options of the status, if it has a poll.
+	Poll *TranslationPoll `json:"poll,omitempty"`
+	// Translated descriptions of the media attachments of the status.
+	MediaAttachments []TranslationAttachment `json:"media_attachments"`
+	// ISO 639 language code that the status was translated from.
+	// example: de
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	// ISO 639 language code that the status was translated into.
+	// example: en
+	Language string `json:"language"`
+	// Name of the service that provided the translation.
+	// example: DeepL.com
+	Provider string `json:"provider"`
+}
+
+// TranslationPoll represents the translation
+// of the options of a poll.
+//
+// swagger:model translationPoll
+type TranslationPoll struct {
+	// ID of the poll.
+	ID string `json:"id"`
+	// Translated options of the poll.
+	Options []TranslationPollOption `json:"options"`
+}
+
+// TranslationPollOption represents
+// the translation of a poll option.
+//
+// swagger:model translationPollOption
+type TranslationPollOption struct {
+	// Translated title of the poll option.
+	// example: Yes
+	Title string `json:"title"`
+}
+
+// TranslationAttachment represents the translation
+// of the description of a media attachment.
+//
+// swagger:model translationAttachment
+type TranslationAttachment struct {
+	// ID of the media attachment.
+	ID string `json:"id"`
+	// Translated description of the media attachment.
+	// example: A cat sitting on a keyboard.
+	Description string `json:"description"`
+}
+
+// StatusTranslateRequest models status translation parameters.
+//
+// swagger:ignore
+type StatusTranslateRequest struct {
+	// ISO 639 language code to translate the status into.
+	// Defaults to the requesting account's preferred language.
+	Lang string `form:"lang" json:"lang" xml:"lang"`
+}
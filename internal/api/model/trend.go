@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// TrendsLink represents a link that's currently
+// being shared by many accounts, with its history.
+//
+// swagger:model trendsLink
+type TrendsLink struct {
+	Card
+	// Daily history of this link's usage, newest first.
+	History []History `json:"history"`
+}
+
+// AdminTrend represents a trending hashtag, status, or link, along
+// with the info an admin needs to approve or reject it.
+//
+// swagger:model adminTrend
+type AdminTrend struct {
+	// The ID of the trend.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// Type of the trending item.
+	// enum:
+	// - tag
+	// - status
+	// - link
+	// example: tag
+	Type string `json:"type"`
+	// How popular the item currently is, relative to other trending items of the same
+	// type. Items with a score of 0 are no longer trending, but are kept for their
+	// review state, so that eg., rejected items don't come back if they trend again.
+	// example: 4.5
+	Score float64 `json:"score"`
+	// Number of times this item was used
+	// (posted, or faved or boosted) recently.
+	// example: 20
+	Uses int `json:"uses"`
+	// Number of different accounts that used this item recently.
+	// example: 5
+	Accounts int `json:"accounts"`
+	// Moderation state of this item. Pending items are only shown
+	// to users if the instance doesn't require trends to be reviewed.
+	// enum:
+	// - pending
+	// - approved
+	// - rejected
+	// example: pending
+	ReviewState string `json:"review_state"`
+	// Time when this item was approved or rejected (ISO 8601 Datetime), if it was.
+	// example: 2021-07-30T09:20:25+00:00
+	ReviewedAt *string `json:"reviewed_at"`
+	// ID of the admin account that approved or rejected this item, if any.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ReviewedByAccountID *string `json:"reviewed_by_account_id"`
+	// The trending hashtag, if type is tag.
+	Tag *Tag `json:"tag,omitempty"`
+	// The trending status, if type is status.
+	Status *Status `json:"status,omitempty"`
+	// The trending link, if type is link.
+	Link *TrendsLink `json:"link,omitempty"`
+}
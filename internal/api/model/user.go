@@ -60,6 +60,13 @@ type User struct {
 	// Time when the last "please reset your password" email was sent, if at all. (ISO 8601 Datetime)
 	// example: 2021-07-30T09:20:25+00:00
 	ResetPasswordSentAt string `json:"reset_password_sent_at,omitempty"`
+	// Time at which two factor authentication was enabled for this user, if at all. (ISO 8601 Datetime)
+	// example: 2021-07-30T09:20:25+00:00
+	TwoFactorEnabledAt string `json:"two_factor_enabled_at,omitempty"`
+	// Time at which this user's account is due to be deleted, if the user has asked for it to be deleted. (ISO 8601 Datetime)
+	// Until then, the deletion can be cancelled with `POST /api/v1/accounts/delete/cancel`.
+	// example: 2021-08-06T09:20:25+00:00
+	DeletionScheduledAt string `json:"deletion_scheduled_at,omitempty"`
 }
 
 // PasswordChangeRequest models user password change parameters.
@@ -95,3 +102,60 @@ type EmailChangeRequest struct {
 	// required: true
 	NewEmail string `form:"new_email" json:"new_email" xml:"new_email" validation:"required"`
 }
+
+// TwoFactorEnrollment models a pending two factor
+// authentication secret, to be added to an
+// authenticator app by the user.
+//
+// swagger:model twoFactorEnrollment
+type TwoFactorEnrollment struct {
+	// Base32-encoded TOTP secret, for manual entry into an authenticator app.
+	// example: JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	Secret string `json:"secret"`
+	// otpauth:// key URI, suitable for rendering as a QR code.
+	// example: otpauth://totp/example.org:someone?algorithm=SHA1&digits=6&issuer=example.org&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	URI string `json:"uri"`
+}
+
+// TwoFactorBackupCodes models one-time backup codes
+// which can be used in place of a TOTP code when
+// signing in, eg., if an authenticator device is lost.
+//
+// swagger:model twoFactorBackupCodes
+type TwoFactorBackupCodes struct {
+	// One-time backup codes. These are only ever shown once.
+	BackupCodes []string `json:"backup_codes"`
+}
+
+// TwoFactorEnrollRequest models two factor enroll parameters.
+//
+// swagger:parameters userTwoFactorEnroll
+type TwoFactorEnrollRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+}
+
+// TwoFactorEnableRequest models two factor enable parameters.
+//
+// swagger:parameters userTwoFactorEnable
+type TwoFactorEnableRequest struct {
+	// Current TOTP code from the authenticator app, to confirm successful enrollment.
+	//
+	// in: formData
+	// required: true
+	Code string `form:"code" json:"code" xml:"code" validation:"required"`
+}
+
+// TwoFactorDisableRequest models two factor disable parameters.
+//
+// swagger:parameters userTwoFactorDisable
+type TwoFactorDisableRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+}
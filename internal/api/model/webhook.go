@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AdminWebhook models a URL configured by an admin, to
+// which signed JSON payloads are POSTed when moderation
+// events of interest occur on this instance.
+//
+// swagger:model adminWebhook
+type AdminWebhook struct {
+	// The ID of the webhook.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	// readonly: true
+	ID string `json:"id"`
+	// Time at which the webhook was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the webhook was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// URL that event payloads are POSTed to.
+	// example: https://chat.example.org/hooks/moderation
+	URL string `json:"url"`
+	// Events the webhook is subscribed to, any of
+	// account.created, domain_block.created, report.created, status.reported.
+	// example: ["report.created","status.reported"]
+	Events []string `json:"events"`
+	// Secret used to sign event payloads. The hex-encoded
+	// HMAC-SHA256 signature of each payload, using this secret
+	// as the key, is sent in the X-Hub-Signature header as 'sha256=<signature>'.
+	// example: 5c1f4d4b3cf3d8a6c7d4b3f1fce2b8e1b0f7f0f1d1b5e4e2c2c1c0b7b5a6a4a2
+	Secret string `json:"secret"`
+	// Whether events are currently delivered to the webhook.
+	Enabled bool `json:"enabled"`
+}
+
+// WebhookEvent models the JSON payload
+// that is POSTed to webhooks.
+//
+// swagger:model webhookEvent
+type WebhookEvent struct {
+	// Name of the event.
+	// example: report.created
+	Event string `json:"event"`
+	// Time at which the event occurred (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The object the event concerns. Depending on the
+	// event this is an adminAccountInfo (account.created),
+	// domainPermission (domain_block.created), adminReport
+	// (report.created), or status (status.reported).
+	Object any `json:"object"`
+}
+
+// AdminWebhookCreateRequest models a request to create a webhook.
+//
+// swagger:ignore
+type AdminWebhookCreateRequest struct {
+	// URL to POST event payloads to.
+	URL string `form:"url" json:"url"`
+	// Events to subscribe to.
+	Events []string `form:"events[]" json:"events"`
+}
+
+// AdminWebhookUpdateRequest models a request to update a webhook.
+// Fields left unset are not changed.
+//
+// swagger:ignore
+type AdminWebhookUpdateRequest struct {
+	// URL to POST event payloads to.
+	URL *string `form:"url" json:"url"`
+	// Events to subscribe to.
+	Events []string `form:"events[]" json:"events"`
+	// Whether to deliver events to the webhook.
+	Enabled *bool `form:"enabled" json:"enabled"`
+}
@@ -47,7 +47,7 @@ type Link struct {
 // swagger:model nodeinfo
 type Nodeinfo struct {
 	// The schema version
-	// example: 2.0
+	// example: 2.1
 	Version string `json:"version"`
 	// Metadata about server software in use.
 	Software NodeInfoSoftware `json:"software"`
@@ -70,6 +70,14 @@ type NodeInfoSoftware struct {
 	Name string `json:"name"`
 	// example: 0.1.2 1234567
 	Version string `json:"version"`
+	// URL of the source code repository of the server software.
+	// Only set for nodeinfo schema 2.1.
+	// example: https://github.com/superseriousbusiness/gotosocial
+	Repository string `json:"repository,omitempty"`
+	// URL of the homepage of the server software.
+	// Only set for nodeinfo schema 2.1.
+	// example: https://docs.gotosocial.org
+	Homepage string `json:"homepage,omitempty"`
 }
 
 // NodeInfoServices represents inbound and outbound services that this node offers connections to.
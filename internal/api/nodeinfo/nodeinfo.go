@@ -28,6 +28,10 @@
 	NodeInfo2Version     = "2.0"
 	NodeInfo2Path        = "/" + NodeInfo2Version
 	NodeInfo2ContentType = "application/json; profile=\"http://nodeinfo.diaspora.software/ns/schema/" + NodeInfo2Version + "#\""
+
+	NodeInfo21Version     = "2.1"
+	NodeInfo21Path        = "/" + NodeInfo21Version
+	NodeInfo21ContentType = "application/json; profile=\"http://nodeinfo.diaspora.software/ns/schema/" + NodeInfo21Version + "#\""
 )
 
 type Module struct {
@@ -42,4 +46,5 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, NodeInfo2Path, m.NodeInfo2GETHandler)
+	attachHandler(http.MethodGet, NodeInfo21Path, m.NodeInfo21GETHandler)
 }
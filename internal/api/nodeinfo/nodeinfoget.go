@@ -43,12 +43,40 @@
 //			schema:
 //				"$ref": "#/definitions/nodeinfo"
 func (m *Module) NodeInfo2GETHandler(c *gin.Context) {
+	m.getNodeInfo(c, NodeInfo2Version, NodeInfo2ContentType)
+}
+
+// NodeInfo21GETHandler swagger:operation GET /nodeinfo/2.1 nodeInfo21Get
+//
+// Returns a compliant nodeinfo 2.1 response to node info queries.
+//
+// This is the same as the 2.0 response, with the addition
+// of repository and homepage fields on software.
+//
+// See: https://nodeinfo.diaspora.software/schema.html
+//
+//	---
+//	tags:
+//	- nodeinfo
+//
+//	produces:
+//	- application/json; profile="http://nodeinfo.diaspora.software/ns/schema/2.1#"
+//
+//	responses:
+//		'200':
+//			schema:
+//				"$ref": "#/definitions/nodeinfo"
+func (m *Module) NodeInfo21GETHandler(c *gin.Context) {
+	m.getNodeInfo(c, NodeInfo21Version, NodeInfo21ContentType)
+}
+
+func (m *Module) getNodeInfo(c *gin.Context, version string, contentType string) {
 	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
 		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
 		return
 	}
 
-	nodeInfo, errWithCode := m.processor.Fedi().NodeInfoGet(c.Request.Context())
+	nodeInfo, errWithCode := m.processor.Fedi().NodeInfoGet(c.Request.Context(), version)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -59,7 +87,7 @@ func (m *Module) NodeInfo2GETHandler(c *gin.Context) {
 		c.Writer,
 		c.Request,
 		http.StatusOK,
-		NodeInfo2ContentType,
+		contentType,
 		nodeInfo,
 	)
 }
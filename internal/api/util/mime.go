@@ -31,6 +31,7 @@
 	AppActivityLDJSON = appActivityLDJSON + `; profile="https://www.w3.org/ns/activitystreams"`
 	AppJRDJSON        = `application/jrd+json` // https://www.rfc-editor.org/rfc/rfc7033#section-10.2
 	AppForm           = `application/x-www-form-urlencoded`
+	AppZip            = `application/zip`
 	MultipartForm     = `multipart/form-data`
 	TextXML           = `text/xml`
 	TextHTML          = `text/html`
@@ -95,7 +96,7 @@ func ASContentType(ct string) bool {
 	}
 }
 
-// NodeInfo2ContentType returns whether is nodeinfo schema 2.0 content-type.
+// NodeInfo2ContentType returns whether is nodeinfo schema 2.0 or 2.1 content-type.
 func NodeInfo2ContentType(ct string) bool {
 	p := splitContentType(ct)
 	p, ok := isUTF8ContentType(p)
@@ -106,9 +107,18 @@ func NodeInfo2ContentType(ct string) bool {
 	case 1:
 		return p[0] == AppJSON
 	case 2:
-		return p[0] == AppJSON &&
-			p[1] == "profile=\"http://nodeinfo.diaspora.software/ns/schema/2.0#\"" ||
-			p[1] == "profile=http://nodeinfo.diaspora.software/ns/schema/2.0#"
+		if p[0] != AppJSON {
+			return false
+		}
+		switch p[1] {
+		case "profile=\"http://nodeinfo.diaspora.software/ns/schema/2.0#\"",
+			"profile=http://nodeinfo.diaspora.software/ns/schema/2.0#",
+			"profile=\"http://nodeinfo.diaspora.software/ns/schema/2.1#\"",
+			"profile=http://nodeinfo.diaspora.software/ns/schema/2.1#":
+			return true
+		default:
+			return false
+		}
 	default:
 		return false
 	}
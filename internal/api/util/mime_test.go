@@ -73,3 +73,43 @@ func TestIsASContentType(t *testing.T) {
 		}
 	}
 }
+
+func TestIsNodeInfo2ContentType(t *testing.T) {
+	for _, test := range []struct {
+		Input  string
+		Expect bool
+	}{
+		{
+			Input:  "application/json",
+			Expect: true,
+		},
+		{
+			Input:  "application/json; charset=utf-8",
+			Expect: true,
+		},
+		{
+			Input:  "application/json; profile=\"http://nodeinfo.diaspora.software/ns/schema/2.0#\"",
+			Expect: true,
+		},
+		{
+			Input:  "application/json; profile=http://nodeinfo.diaspora.software/ns/schema/2.1#",
+			Expect: true,
+		},
+		{
+			Input:  "application/json; profile=\"http://nodeinfo.diaspora.software/ns/schema/2.1#\"",
+			Expect: true,
+		},
+		{
+			Input:  "text/html; profile=\"http://nodeinfo.diaspora.software/ns/schema/2.1#\"",
+			Expect: false,
+		},
+		{
+			Input:  "application/json; profile=\"http://nodeinfo.diaspora.software/ns/schema/1.0#\"",
+			Expect: false,
+		},
+	} {
+		if util.NodeInfo2ContentType(test.Input) != test.Expect {
+			t.Errorf("did not get expected result %v for input: %s", test.Expect, test.Input)
+		}
+	}
+}
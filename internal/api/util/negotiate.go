@@ -94,6 +94,12 @@
 	TextCSV,
 }
 
+// ZipHeaders just contains the application/zip
+// MIME type, used for account archive export.
+var ZipHeaders = []string{
+	AppZip,
+}
+
 // NegotiateAccept takes the *gin.Context from an incoming request, and a
 // slice of Offers, and performs content negotiation for the given request
 // with the given content-type offers. It will return a string representation
@@ -148,12 +154,7 @@ func NegotiateFormat(c *gin.Context, offered ...string) string {
 		panic("you must provide at least one offer")
 	}
 
-	if c.Accepted == nil {
-		for _, a := range c.Request.Header.Values("Accept") {
-			c.Accepted = append(c.Accepted, parseAccept(a)...)
-		}
-	}
-	if len(c.Accepted) == 0 {
+	if len(Accepted(c)) == 0 {
 		return offered[0]
 	}
 	for _, accepted := range c.Accepted {
@@ -177,6 +178,17 @@ func NegotiateFormat(c *gin.Context, offered ...string) string {
 	return ""
 }
 
+// Accepted returns the content types listed in
+// the request's Accept header(s), without weights.
+func Accepted(c *gin.Context) []string {
+	if c.Accepted == nil {
+		for _, a := range c.Request.Header.Values("Accept") {
+			c.Accepted = append(c.Accepted, parseAccept(a)...)
+		}
+	}
+	return c.Accepted
+}
+
 // https://github.com/gin-gonic/gin/blob/4787b8203b79012877ac98d7806422da3a678ba2/utils.go#L103
 func parseAccept(acceptHeader string) []string {
 	parts := strings.Split(acceptHeader, ",")
@@ -63,6 +63,15 @@
 
 	TagNameKey = "tag_name"
 
+	/* Trends keys */
+
+	TrendsOffsetKey = "offset"
+
+	/* Directory keys */
+
+	DirectoryOffsetKey = "offset"
+	DirectoryOrderKey  = "order"
+
 	/* Web endpoint keys */
 
 	WebStatusIDKey = "status"
@@ -75,6 +84,27 @@
 	DomainPermissionPermTypeKey       = "permission_type"
 	DomainPermissionDomainKey         = "domain"
 
+	/* Canonical email block keys */
+
+	CanonicalEmailBlockImportKey = "import"
+
+	/* Dead letter keys */
+
+	DeadLetterDomainKey = "domain"
+
+	/* Admin action keys */
+
+	AdminActionTargetIDKey = "target_id"
+
+	/* Audit log keys */
+
+	AuditLogTargetTypeKey = "target_type"
+	AuditLogTargetIDKey   = "target_id"
+
+	/* Appeal keys */
+
+	AdminAppealStateKey = "state"
+
 	/* Admin query keys */
 
 	AdminRemoteKey      = "remote"
@@ -101,6 +131,10 @@
 	InteractionFavouritesKey = "favourites"
 	InteractionRepliesKey    = "replies"
 	InteractionReblogsKey    = "reblogs"
+
+	/* Announcement keys */
+
+	AnnouncementWithDismissedKey = "with_dismissed"
 )
 
 /*
@@ -160,6 +194,28 @@ func ParseSearchOffset(value string, defaultValue int, max, min int) (int, gtser
 	return parseInt(value, defaultValue, max, min, SearchOffsetKey)
 }
 
+func ParseTrendsOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, TrendsOffsetKey)
+}
+
+func ParseDirectoryOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, DirectoryOffsetKey)
+}
+
+func ParseDirectoryOrder(value string, defaultValue string) (string, gtserror.WithCode) {
+	key := DirectoryOrderKey
+
+	switch value {
+	case "":
+		return defaultValue, nil
+	case "active", "new":
+		return value, nil
+	default:
+		err := fmt.Errorf("error parsing %s: value must be one of active, new", key)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+}
+
 func ParseSearchResolve(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, SearchResolveKey)
 }
@@ -172,6 +228,10 @@ func ParseDomainPermissionImport(value string, defaultValue bool) (bool, gtserro
 	return parseBool(value, defaultValue, DomainPermissionImportKey)
 }
 
+func ParseCanonicalEmailBlockImport(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, CanonicalEmailBlockImportKey)
+}
+
 func ParseOnlyOtherAccounts(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, OnlyOtherAccountsKey)
 }
@@ -216,6 +276,10 @@ func ParseInteractionReblogs(value string, defaultValue bool) (bool, gtserror.Wi
 	return parseBool(value, defaultValue, InteractionReblogsKey)
 }
 
+func ParseAnnouncementWithDismissed(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, AnnouncementWithDismissedKey)
+}
+
 /*
 	Parse functions for *REQUIRED* parameters.
 */
@@ -27,9 +27,9 @@
 
 // NodeInfoWellKnownGETHandler swagger:operation GET /.well-known/nodeinfo nodeInfoWellKnownGet
 //
-// Returns a well-known response which redirects callers to `/nodeinfo/2.0`.
+// Returns a well-known response which redirects callers to `/nodeinfo/2.1` or `/nodeinfo/2.0`.
 //
-// eg. `{"links":[{"rel":"http://nodeinfo.diaspora.software/ns/schema/2.0","href":"http://example.org/nodeinfo/2.0"}]}`
+// eg. `{"links":[{"rel":"http://nodeinfo.diaspora.software/ns/schema/2.1","href":"http://example.org/nodeinfo/2.1"},{"rel":"http://nodeinfo.diaspora.software/ns/schema/2.0","href":"http://example.org/nodeinfo/2.0"}]}`
 // See: https://nodeinfo.diaspora.software/protocol.html
 //
 //	---
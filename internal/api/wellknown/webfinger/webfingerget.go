@@ -21,6 +21,7 @@
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
@@ -41,6 +42,13 @@
 //
 // ```
 //
+// Lookups for the host or account domain of this instance will be resolved, as well
+// as lookups for any of the extra hosts set in `instance-webfinger-alias-hosts`.
+//
+// Lookups for any of the `extra-account-domains` will only be resolved for
+// accounts hosted on that domain, and the subject of the response will always
+// use the domain that the account is hosted on.
+//
 // See: https://webfinger.net/
 //
 //	---
@@ -74,13 +82,14 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 		return
 	}
 
-	if requestedHost != config.GetHost() && requestedHost != config.GetAccountDomain() {
+	if !config.IsLocalDomain(requestedHost) &&
+		!slices.Contains(config.GetInstanceWebfingerAliasHosts(), requestedHost) {
 		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
 		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
 		return
 	}
 
-	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUsername)
+	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUsername, requestedHost)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
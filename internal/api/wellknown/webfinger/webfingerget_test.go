@@ -255,6 +255,90 @@ func (suite *WebfingerGetTestSuite) TestFingerUserWithDifferentAccountDomainByAc
 }`, resp)
 }
 
+func (suite *WebfingerGetTestSuite) TestFingerUserByAliasHost() {
+	config.SetInstanceWebfingerAliasHosts([]string{"old.example.org"})
+	defer config.SetInstanceWebfingerAliasHosts([]string{})
+
+	targetAccount := suite.testAccounts["local_account_1"]
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@old.example.org", webfinger.WebfingerBasePath, targetAccount.Username)
+
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork",
+    "acct:the_mighty_zork@old.example.org"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+}
+
+func (suite *WebfingerGetTestSuite) TestFingerUserOnExtraAccountDomain() {
+	config.SetExtraAccountDomains([]string{"family.example.org"})
+	defer config.SetExtraAccountDomains([]string{})
+
+	// Move the target account to the extra account domain.
+	targetAccount := suite.testAccounts["local_account_1"]
+	settings, err := suite.db.GetAccountSettings(context.Background(), targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.AccountDomain = "family.example.org"
+	if err := suite.db.UpdateAccountSettings(context.Background(), settings, "account_domain"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Lookups at both the extra account domain and the
+	// host should return the handle on the extra domain.
+	for _, host := range []string{"family.example.org", config.GetHost()} {
+		requestPath := fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, targetAccount.Username, host)
+
+		resp := suite.finger(requestPath)
+		suite.Equal(`{
+  "subject": "acct:the_mighty_zork@family.example.org",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp, host)
+	}
+
+	// Accounts not hosted on the
+	// extra domain shouldn't resolve.
+	_, errWithCode := suite.processor.Fedi().WebfingerGet(context.Background(),
+		suite.testAccounts["local_account_2"].Username,
+		"family.example.org",
+	)
+	if errWithCode == nil {
+		suite.FailNow("expected error fingering account on other domain")
+	}
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
 func (suite *WebfingerGetTestSuite) TestFingerUserWithoutAcct() {
 	// Leave out the 'acct:' part in the request path;
 	// the handler should be generous + still work OK.
@@ -0,0 +1,216 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package automod
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/k3a/html2text"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Rules represents a set of compiled automod
+// rules, ready to be matched against statuses.
+type Rules []rule
+
+type rule struct {
+	// model is the rule
+	// this was compiled from.
+	model *gtsmodel.AutomodRule
+
+	// expr is set for keyword
+	// and regex match types.
+	expr *regexp.Regexp
+
+	// domain is set for the
+	// link domain match type.
+	domain string
+}
+
+// Append will compile and add the given automod rule.
+func (rs *Rules) Append(model *gtsmodel.AutomodRule) error {
+	r := rule{model: model}
+
+	switch model.MatchType {
+	case gtsmodel.AutomodMatchKeyword:
+		expr, err := CompileKeyword(model.Pattern)
+		if err != nil {
+			return err
+		}
+		r.expr = expr
+
+	case gtsmodel.AutomodMatchRegex:
+		expr, err := regexp.Compile(model.Pattern)
+		if err != nil {
+			return err
+		}
+		r.expr = expr
+
+	case gtsmodel.AutomodMatchLinkDomain:
+		domain, err := util.Punify(model.Pattern)
+		if err != nil {
+			return err
+		}
+		r.domain = domain
+
+	default:
+		return fmt.Errorf("unknown automod match type %d", model.MatchType)
+	}
+
+	(*rs) = append((*rs), r)
+	return nil
+}
+
+// Match returns the matching rule with the most severe
+// action for the given status, or nil if none match.
+func (rs Rules) Match(status *gtsmodel.Status) *gtsmodel.AutomodRule {
+	if len(rs) == 0 {
+		// Nothing
+		// to match.
+		return nil
+	}
+
+	var (
+		fields = textFields(status)
+		hosts  = linkHosts(status)
+		match  *gtsmodel.AutomodRule
+	)
+
+	for _, r := range rs {
+		if match != nil && match.Action >= r.model.Action {
+			// Already matched a rule at
+			// least as severe, skip.
+			continue
+		}
+
+		if r.matches(fields, hosts) {
+			match = r.model
+		}
+	}
+
+	return match
+}
+
+// matches returns whether this rule matches
+// on given status text fields or link hosts.
+func (r *rule) matches(fields []string, hosts []string) bool {
+	if r.expr != nil {
+		for _, field := range fields {
+			if r.expr.MatchString(field) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, host := range hosts {
+		if host == r.domain ||
+			strings.HasSuffix(host, "."+r.domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileKeyword compiles the given keyword or phrase to a
+// case-insensitive regular expression matching whole words.
+func CompileKeyword(keyword string) (*regexp.Regexp, error) {
+	const (
+		// Either word boundary or
+		// whitespace or start of line.
+		wordBreakStart = `(?:\b|\s|^)`
+
+		// Either word boundary or
+		// whitespace or end of line.
+		wordBreakEnd = `(?:\b|\s|$)`
+	)
+
+	quoted := regexp.QuoteMeta(keyword)
+	return regexp.Compile(`(?i)` + wordBreakStart + quoted + wordBreakEnd)
+}
+
+// textFields returns the text fields of
+// the status that rules are matched on.
+func textFields(status *gtsmodel.Status) []string {
+	fields := make([]string, 0, 2+len(status.Attachments))
+
+	if status.ContentWarning != "" {
+		fields = append(fields, status.ContentWarning)
+	}
+
+	if status.Content != "" {
+		// Match on plain text, so that
+		// html tags + attributes don't
+		// get caught by the rules.
+		text := html2text.HTML2TextWithOptions(
+			status.Content,
+			html2text.WithLinksInnerText(),
+			html2text.WithUnixLineBreaks(),
+		)
+		if text != "" {
+			fields = append(fields, text)
+		}
+	}
+
+	for _, attachment := range status.Attachments {
+		if attachment.Description != "" {
+			fields = append(fields, attachment.Description)
+		}
+	}
+
+	if status.Poll != nil {
+		for _, opt := range status.Poll.Options {
+			if opt != "" {
+				fields = append(fields, opt)
+			}
+		}
+	}
+
+	return fields
+}
+
+// linkHosts returns the lowercase punycode
+// hosts of all http/https links in the status.
+func linkHosts(status *gtsmodel.Status) []string {
+	concat := status.ContentWarning + " " + status.Content
+	rawLinks := regexes.LinkScheme.FindAllString(concat, -1)
+
+	hosts := make([]string, 0, len(rawLinks))
+	for _, rawLink := range rawLinks {
+		u, err := url.Parse(rawLink)
+		if err != nil {
+			// Ignore
+			// bad links.
+			continue
+		}
+
+		host, err := util.Punify(u.Hostname())
+		if err != nil {
+			continue
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	return hosts
+}
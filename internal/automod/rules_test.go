@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package automod_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/automod"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type RulesTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RulesTestSuite) rules(models ...*gtsmodel.AutomodRule) automod.Rules {
+	var rules automod.Rules
+	for _, model := range models {
+		if err := rules.Append(model); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+	return rules
+}
+
+func (suite *RulesTestSuite) TestMatch() {
+	var (
+		keyword = &gtsmodel.AutomodRule{
+			ID:        "keyword",
+			MatchType: gtsmodel.AutomodMatchKeyword,
+			Pattern:   "buy followers",
+			Action:    gtsmodel.AutomodActionSinBin,
+		}
+		regex = &gtsmodel.AutomodRule{
+			ID:        "regex",
+			MatchType: gtsmodel.AutomodMatchRegex,
+			Pattern:   `(?i)crypto\s*giveaway`,
+			Action:    gtsmodel.AutomodActionDrop,
+		}
+		domain = &gtsmodel.AutomodRule{
+			ID:        "domain",
+			MatchType: gtsmodel.AutomodMatchLinkDomain,
+			Pattern:   "spam.example",
+			Action:    gtsmodel.AutomodActionSensitive,
+		}
+		rules = suite.rules(domain, keyword, regex)
+	)
+
+	for i, testCase := range []struct {
+		status *gtsmodel.Status
+		expect *gtsmodel.AutomodRule
+	}{
+		{
+			status: &gtsmodel.Status{Content: "<p>hello world</p>"},
+			expect: nil,
+		},
+		{
+			status: &gtsmodel.Status{Content: "<p>BUY FOLLOWERS now</p>"},
+			expect: keyword,
+		},
+		{
+			// Keyword should only
+			// match on whole words.
+			status: &gtsmodel.Status{Content: "<p>buy followersss</p>"},
+			expect: nil,
+		},
+		{
+			status: &gtsmodel.Status{ContentWarning: "Crypto Giveaway!"},
+			expect: regex,
+		},
+		{
+			status: &gtsmodel.Status{
+				Attachments: []*gtsmodel.MediaAttachment{
+					{Description: "cryptogiveaway"},
+				},
+			},
+			expect: regex,
+		},
+		{
+			status: &gtsmodel.Status{Content: `<p><a href="https://www.spam.example/deals">deals</a></p>`},
+			expect: domain,
+		},
+		{
+			// Only exact domain or
+			// subdomains should match.
+			status: &gtsmodel.Status{Content: `<p><a href="https://notspam.example/deals">deals</a></p>`},
+			expect: nil,
+		},
+		{
+			// Most severe action wins.
+			status: &gtsmodel.Status{Content: `<p>buy followers: https://spam.example, crypto giveaway</p>`},
+			expect: regex,
+		},
+	} {
+		suite.Equal(testCase.expect, rules.Match(testCase.status), "test case "+strconv.Itoa(i))
+	}
+}
+
+func (suite *RulesTestSuite) TestAppendInvalid() {
+	var rules automod.Rules
+
+	err := rules.Append(&gtsmodel.AutomodRule{
+		MatchType: gtsmodel.AutomodMatchRegex,
+		Pattern:   "(unclosed",
+	})
+	suite.Error(err)
+
+	err = rules.Append(&gtsmodel.AutomodRule{
+		MatchType: gtsmodel.AutomodMatchUnknown,
+		Pattern:   "whatever",
+	})
+	suite.Error(err)
+
+	suite.Empty(rules)
+}
+
+func TestRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(RulesTestSuite))
+}
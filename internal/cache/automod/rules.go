@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package automod
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/superseriousbusiness/gotosocial/internal/automod"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Cache provides a means of caching automod.Rules in
+// memory to reduce load on an underlying storage mechanism.
+type Cache struct {
+	// current cached automod rules slice.
+	ptr atomic.Pointer[automod.Rules]
+}
+
+// Match performs .Match() on cached automod.Rules, loading using callback if necessary.
+func (c *Cache) Match(status *gtsmodel.Status, load func() ([]*gtsmodel.AutomodRule, error)) (*gtsmodel.AutomodRule, error) {
+	// Load ptr value.
+	ptr := c.ptr.Load()
+
+	if ptr == nil {
+		// Cache is not hydrated.
+		// Load rules from callback.
+		rules, err := loadRules(load)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store the new
+		// automod rules.
+		ptr = &rules
+		c.ptr.Store(ptr)
+	}
+
+	// Deref and perform match.
+	return ptr.Match(status), nil
+}
+
+// Clear will drop the currently loaded rules,
+// triggering a reload on next call to .Match().
+func (c *Cache) Clear() { c.ptr.Store(nil) }
+
+// loadRules will load rules from given load callback, compiling each rule.
+func loadRules(load func() ([]*gtsmodel.AutomodRule, error)) (automod.Rules, error) {
+	// Load rules from callback.
+	models, err := load()
+	if err != nil {
+		return nil, fmt.Errorf("error reloading cache: %w", err)
+	}
+
+	// Allocate new rules slice to store compiled rules.
+	rules := make(automod.Rules, 0, len(models))
+
+	// Compile and add all rules to slice.
+	for _, model := range models {
+		if err := rules.Append(model); err != nil {
+			return nil, fmt.Errorf("error compiling rule %s: %w", model.ID, err)
+		}
+	}
+
+	return rules, nil
+}
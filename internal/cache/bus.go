@@ -0,0 +1,276 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/pubsub"
+)
+
+// InvalidationBus is a pub/sub channel over which cache
+// invalidations are shared between GoToSocial processes,
+// so that any process updating an account or status can
+// tell the others to drop their cached copies of it.
+type InvalidationBus interface {
+	// Publish sends payload to all subscribed processes
+	// (possibly including the publishing process itself).
+	Publish(ctx context.Context, payload string) error
+
+	// Subscribe calls recv for each received payload,
+	// blocking until ctx is canceled or an error occurs.
+	Subscribe(ctx context.Context, recv func(payload string)) error
+
+	// Close will close any open
+	// connections to the bus.
+	Close() error
+}
+
+const (
+	// Names of the caches that
+	// invalidations are published
+	// for over the InvalidationBus.
+	busCacheAccount = "account"
+	busCacheStatus  = "status"
+
+	// busMaxKeys is the maximum number of keys
+	// to send in any one message, keeping within
+	// Postgres' NOTIFY payload limit of 8000 bytes.
+	busMaxKeys = 32
+)
+
+// busMessage is a single invalidation
+// message sent over the InvalidationBus.
+type busMessage struct {
+	// Origin is the ID of the publishing
+	// process, to ignore our own messages.
+	Origin string `json:"origin"`
+
+	// Cache is the name of the cache
+	// to invalidate the keys from.
+	Cache string `json:"cache"`
+
+	// Keys are the index keys to invalidate.
+	Keys []busKey `json:"keys"`
+}
+
+// busKey is a single index key in a busMessage.
+type busKey struct {
+	Index string   `json:"index"`
+	Parts []string `json:"parts"`
+}
+
+// invalidationBus wraps an InvalidationBus
+// with the state needed to subscribe to it.
+type invalidationBus struct {
+	bus    InvalidationBus
+	origin string
+	cncl   context.CancelFunc
+}
+
+// SetInvalidationBus sets the bus over which cache invalidations
+// are shared with other processes, and starts a background routine
+// handling those received from others. This should be called after
+// Init(), with the bus being closed on Stop().
+func (c *Caches) SetInvalidationBus(bus InvalidationBus) {
+	ctx, cncl := context.WithCancel(context.Background())
+	c.bus = &invalidationBus{
+		bus:    bus,
+		origin: id.NewULID(),
+		cncl:   cncl,
+	}
+	go c.subscribe(ctx, c.bus)
+}
+
+// PublishInvalidateAccount publishes invalidations for the given
+// accounts to other processes, by all of their unique index keys.
+// This should be called after any account insert, update or delete.
+func (c *Caches) PublishInvalidateAccount(ctx context.Context, accounts ...*gtsmodel.Account) {
+	publish(ctx, c.bus, busCacheAccount, &c.DB.Account, accounts)
+}
+
+// PublishInvalidateAccountIDs is as PublishInvalidateAccount(),
+// except only publishing invalidations by account IDs.
+func (c *Caches) PublishInvalidateAccountIDs(ctx context.Context, ids []string) {
+	publishIDs(ctx, c.bus, busCacheAccount, ids)
+}
+
+// PublishInvalidateStatus publishes invalidations for the given
+// statuses to other processes, by all of their unique index keys.
+// This should be called after any status insert, update or delete.
+func (c *Caches) PublishInvalidateStatus(ctx context.Context, statuses ...*gtsmodel.Status) {
+	publish(ctx, c.bus, busCacheStatus, &c.DB.Status, statuses)
+}
+
+// PublishInvalidateStatusIDs is as PublishInvalidateStatus(),
+// except only publishing invalidations by status IDs.
+func (c *Caches) PublishInvalidateStatusIDs(ctx context.Context, ids []string) {
+	publishIDs(ctx, c.bus, busCacheStatus, ids)
+}
+
+// onInvalidation handles an invalidation message payload received over the bus.
+func (c *Caches) onInvalidation(ctx context.Context, origin string, payload string) {
+	var msg busMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		log.Errorf(ctx, "error decoding invalidation: %v", err)
+		return
+	}
+
+	if msg.Origin == origin {
+		// Our own message, we
+		// already invalidated.
+		return
+	}
+
+	switch msg.Cache {
+	case busCacheAccount:
+		invalidateKeys(&c.DB.Account, msg.Keys)
+	case busCacheStatus:
+		invalidateKeys(&c.DB.Status, msg.Keys)
+	default:
+		log.Warnf(ctx, "received invalidation for unknown cache %s", msg.Cache)
+	}
+}
+
+// subscribe handles invalidations received over the bus
+// until ctx is canceled, resubscribing on any error.
+func (c *Caches) subscribe(ctx context.Context, bus *invalidationBus) {
+	pubsub.Subscribe(ctx, bus.bus, "invalidation",
+		func(payload string) {
+			c.onInvalidation(ctx, bus.origin, payload)
+		},
+		func() {
+			// Any invalidations sent while we were not subscribed are
+			// lost, so other processes' changes may be missed until
+			// cached values are evicted. Clear the affected caches
+			// to be safe, as this should only be rare.
+			c.DB.Account.Clear()
+			c.DB.Status.Clear()
+		},
+	)
+}
+
+// publish publishes invalidations for values in sc
+// by all of their unique index keys, if bus is set.
+func publish[T any](ctx context.Context, bus *invalidationBus, cache string, sc *StructCache[T], values []T) {
+	if bus == nil {
+		return
+	}
+
+	keys := make([]busKey, 0, len(values)*len(sc.unique))
+	for _, value := range values {
+		for index, unique := range sc.unique {
+			parts, ok := unique.parts(value)
+			if !ok {
+				continue
+			}
+
+			// Only string key parts can be
+			// safely sent via JSON. All of
+			// the published caches' unique
+			// indices are currently strings.
+			strs := make([]string, len(parts))
+			for i, part := range parts {
+				if strs[i], ok = part.(string); !ok {
+					break
+				}
+			}
+			if !ok {
+				continue
+			}
+
+			keys = append(keys, busKey{
+				Index: index,
+				Parts: strs,
+			})
+		}
+	}
+
+	publishKeys(ctx, bus, cache, keys)
+}
+
+// publishIDs publishes invalidations for the given IDs, if bus is set.
+func publishIDs(ctx context.Context, bus *invalidationBus, cache string, ids []string) {
+	if bus == nil {
+		return
+	}
+
+	keys := make([]busKey, len(ids))
+	for i, id := range ids {
+		keys[i] = busKey{
+			Index: "ID",
+			Parts: []string{id},
+		}
+	}
+
+	publishKeys(ctx, bus, cache, keys)
+}
+
+// publishKeys publishes the given keys, in batches of up to busMaxKeys.
+func publishKeys(ctx context.Context, bus *invalidationBus, cache string, keys []busKey) {
+	// The database change has already been made,
+	// so ensure publishing isn't interrupted by
+	// eg. the calling request being canceled.
+	ctx = context.WithoutCancel(ctx)
+
+	for len(keys) > 0 {
+		n := min(len(keys), busMaxKeys)
+
+		b, err := json.Marshal(busMessage{
+			Origin: bus.origin,
+			Cache:  cache,
+			Keys:   keys[:n],
+		})
+		if err != nil {
+			log.Errorf(ctx, "error encoding invalidation: %v", err)
+			return
+		}
+
+		// Publishing shouldn't fail the database operation that
+		// has already taken place, so just log on any error.
+		if err := bus.bus.Publish(ctx, string(b)); err != nil {
+			log.Errorf(ctx, "error publishing %s invalidation: %v", cache, err)
+		}
+
+		keys = keys[n:]
+	}
+}
+
+// invalidateKeys invalidates the given keys from sc.
+func invalidateKeys[T any](sc *StructCache[T], keys []busKey) {
+	for _, key := range keys {
+		unique, ok := sc.unique[key.Index]
+		if !ok || len(unique.fields) != len(key.Parts) {
+			// Ignore anything we can't handle, as
+			// structr panics on bad keys; possibly
+			// sent by a different version of GTS.
+			continue
+		}
+
+		parts := make([]any, len(key.Parts))
+		for i, part := range key.Parts {
+			parts[i] = part
+		}
+
+		sc.Invalidate(key.Index, parts...)
+	}
+}
@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// memBus is a simple in-memory cache.InvalidationBus{},
+// delivering published payloads to subscribers in-line.
+type memBus struct {
+	mu    sync.Mutex
+	subs  []func(string)
+	ready chan struct{}
+}
+
+func (b *memBus) Publish(_ context.Context, payload string) error {
+	b.mu.Lock()
+	subs := b.subs
+	b.mu.Unlock()
+	for _, recv := range subs {
+		recv(payload)
+	}
+	return nil
+}
+
+func (b *memBus) Subscribe(ctx context.Context, recv func(string)) error {
+	b.mu.Lock()
+	b.subs = append(b.subs, recv)
+	b.mu.Unlock()
+	b.ready <- struct{}{}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (b *memBus) Close() error { return nil }
+
+func TestInvalidationBus(t *testing.T) {
+	testrig.InitTestConfig()
+
+	bus := &memBus{ready: make(chan struct{})}
+
+	var c1, c2 cache.Caches
+	c1.Init()
+	c2.Init()
+	c1.Start()
+	c2.Start()
+	c1.SetInvalidationBus(bus)
+	c2.SetInvalidationBus(bus)
+	<-bus.ready
+	<-bus.ready
+	defer c1.Stop()
+	defer c2.Stop()
+
+	account := &gtsmodel.Account{
+		ID:       "01F8MH17FWEB39HZJ76B6VXSKF",
+		URI:      "http://localhost:8080/users/the_mighty_zork",
+		Username: "the_mighty_zork",
+	}
+
+	// Second process looks up account
+	// before it exists, caching the miss.
+	if _, err := c2.DB.Account.LoadOne("URI", func() (*gtsmodel.Account, error) {
+		return nil, db.ErrNoEntries
+	}, account.URI); err != db.ErrNoEntries {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// First process creates the account.
+	if err := c1.DB.Account.Store(account, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	c1.PublishInvalidateAccount(context.Background(), account)
+
+	// Second process should no longer have the miss cached.
+	got, err := c2.DB.Account.LoadOne("URI", func() (*gtsmodel.Account, error) {
+		return account, nil
+	}, account.URI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != account.ID {
+		t.Fatalf("unexpected account loaded: %s", got.ID)
+	}
+
+	// Now the first process updates the account,
+	// the second process should drop its copy.
+	c1.PublishInvalidateAccountIDs(context.Background(), []string{account.ID})
+	if _, ok := c2.DB.Account.GetOne("ID", account.ID); ok {
+		t.Fatal("account should have been invalidated")
+	}
+}
@@ -21,7 +21,10 @@
 	"time"
 
 	"codeberg.org/gruf/go-cache/v3/ttl"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/automod"
 	"github.com/superseriousbusiness/gotosocial/internal/cache/headerfilter"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ipblock"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/redis"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
@@ -40,18 +43,44 @@ type Caches struct {
 	// the block []headerfilter.Filter cache.
 	BlockHeaderFilters headerfilter.Cache
 
+	// AutomodRules provides access to
+	// the compiled automod.Rules cache.
+	AutomodRules automod.Cache
+
+	// IPBlocks provides access to
+	// the parsed IP blocks cache.
+	IPBlocks ipblock.Cache
+
 	// Visibility provides access to the item visibility
 	// cache. (used by the visibility filter).
 	Visibility VisibilityCache
 
 	// Webfinger provides access to the webfinger URL cache.
-	Webfinger *ttl.Cache[string, string] // TTL=24hr, sweep=5min
+	Webfinger WebfingerCache // TTL=24hr, sweep=5min
 
 	// TTL cache of statuses -> filterable text fields.
 	// To ensure up-to-date fields, cache is keyed as:
 	// `[status.ID][status.UpdatedAt.Unix()]`
 	StatusesFilterableFields *ttl.Cache[string, []string]
 
+	// TTL cache of user IDs -> count of recent
+	// failed two factor sign in attempts.
+	TwoFactorFailures *ttl.Cache[string, int]
+
+	// TTL cache of remote account IDs whose followers
+	// were recently synchronized (or are being), used
+	// to dedupe and rate limit follower resyncs.
+	FollowerSyncs *ttl.Cache[string, struct{}]
+
+	// backend is the optional shared
+	// cache tier behind hot caches.
+	backend Backend
+
+	// bus is the optional bus over
+	// which cache invalidations are
+	// shared with other processes.
+	bus *invalidationBus
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -61,6 +90,7 @@ type Caches struct {
 func (c *Caches) Init() {
 	log.Infof(nil, "init: %p", c)
 
+	c.initBackend()
 	c.initAccount()
 	c.initAccountNote()
 	c.initAccountSettings()
@@ -74,6 +104,7 @@ func (c *Caches) Init() {
 	c.initConversationLastStatusIDs()
 	c.initDomainAllow()
 	c.initDomainBlock()
+	c.initDomainLimited()
 	c.initDomainPermissionDraft()
 	c.initDomainPermissionExclude()
 	c.initEmoji()
@@ -101,6 +132,7 @@ func (c *Caches) Init() {
 	c.initPollVote()
 	c.initPollVoteIDs()
 	c.initReport()
+	c.initRole()
 	c.initSinBinStatus()
 	c.initStatus()
 	c.initStatusBookmark()
@@ -117,6 +149,8 @@ func (c *Caches) Init() {
 	c.initWebfinger()
 	c.initVisibility()
 	c.initStatusesFilterableFields()
+	c.initTwoFactorFailures()
+	c.initFollowerSyncs()
 }
 
 // Start will start any caches that require a background
@@ -131,6 +165,14 @@ func (c *Caches) Start() {
 	tryUntil("starting statusesFilterableFields cache", 5, func() bool {
 		return c.StatusesFilterableFields.Start(5 * time.Minute)
 	})
+
+	tryUntil("starting twoFactorFailures cache", 5, func() bool {
+		return c.TwoFactorFailures.Start(1 * time.Minute)
+	})
+
+	tryUntil("starting followerSyncs cache", 5, func() bool {
+		return c.FollowerSyncs.Start(5 * time.Minute)
+	})
 }
 
 // Stop will stop any caches that require a background
@@ -140,6 +182,21 @@ func (c *Caches) Stop() {
 
 	tryUntil("stopping webfinger cache", 5, c.Webfinger.Stop)
 	tryUntil("stopping statusesFilterableFields cache", 5, c.StatusesFilterableFields.Stop)
+	tryUntil("stopping twoFactorFailures cache", 5, c.TwoFactorFailures.Stop)
+	tryUntil("stopping followerSyncs cache", 5, c.FollowerSyncs.Stop)
+
+	if c.bus != nil {
+		c.bus.cncl()
+		if err := c.bus.bus.Close(); err != nil {
+			log.Errorf(nil, "error closing invalidation bus: %v", err)
+		}
+	}
+
+	if c.backend != nil {
+		if err := c.backend.Close(); err != nil {
+			log.Errorf(nil, "error closing cache backend: %v", err)
+		}
+	}
 }
 
 // Sweep will sweep all the available caches to ensure none
@@ -185,6 +242,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.PollVote.Trim(threshold)
 	c.DB.PollVoteIDs.Trim(threshold)
 	c.DB.Report.Trim(threshold)
+	c.DB.Role.Trim(threshold)
 	c.DB.SinBinStatus.Trim(threshold)
 	c.DB.Status.Trim(threshold)
 	c.DB.StatusBookmark.Trim(threshold)
@@ -201,6 +259,29 @@ func (c *Caches) Sweep(threshold float64) {
 	c.Visibility.Trim(threshold)
 }
 
+func (c *Caches) initBackend() {
+	c.backend = nil
+
+	switch config.GetCacheBackend() {
+	case config.CacheBackendRedis:
+		backend, err := redis.New(redis.Config{
+			Address:   config.GetCacheRedisAddress(),
+			Username:  config.GetCacheRedisUsername(),
+			Password:  config.GetCacheRedisPassword(),
+			DB:        config.GetCacheRedisDB(),
+			KeyPrefix: config.GetCacheRedisKeyPrefix(),
+			TTL:       config.GetCacheRedisTTL(),
+		})
+		if err != nil {
+			log.Errorf(nil, "error initializing redis cache backend, falling back to memory: %v", err)
+			return
+		}
+
+		log.Infof(nil, "using redis cache backend at %s", config.GetCacheRedisAddress())
+		c.backend = backend
+	}
+}
+
 func (c *Caches) initWebfinger() {
 	// Calculate maximum cache size.
 	cap := calculateCacheMax(
@@ -210,12 +291,13 @@ func (c *Caches) initWebfinger() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.Webfinger = new(ttl.Cache[string, string])
-	c.Webfinger.Init(
+	c.Webfinger.Cache = new(ttl.Cache[string, string])
+	c.Webfinger.Cache.Init(
 		0,
 		cap,
 		24*time.Hour,
 	)
+	c.Webfinger.remote = c.backend
 }
 
 func (c *Caches) initStatusesFilterableFields() {
@@ -226,3 +308,21 @@ func (c *Caches) initStatusesFilterableFields() {
 		1*time.Hour,
 	)
 }
+
+func (c *Caches) initFollowerSyncs() {
+	c.FollowerSyncs = new(ttl.Cache[string, struct{}])
+	c.FollowerSyncs.Init(
+		0,
+		1024,
+		6*time.Hour,
+	)
+}
+
+func (c *Caches) initTwoFactorFailures() {
+	c.TwoFactorFailures = new(ttl.Cache[string, int])
+	c.TwoFactorFailures.Init(
+		0,
+		512,
+		15*time.Minute,
+	)
+}
@@ -67,6 +67,9 @@ type DBCaches struct {
 	// DomainBlock provides access to the domain block database cache.
 	DomainBlock *domain.Cache
 
+	// DomainLimited provides access to the database cache of limited domain allows.
+	DomainLimited *domain.Cache
+
 	// DomainPermissionDraft provides access to the domain permission draft database cache.
 	DomainPermissionDraft StructCache[*gtsmodel.DomainPermissionDraft]
 
@@ -214,6 +217,9 @@ type DBCaches struct {
 	// Report provides access to the gtsmodel Report database cache.
 	Report StructCache[*gtsmodel.Report]
 
+	// Role provides access to the gtsmodel Role database cache.
+	Role StructCache[*gtsmodel.Role]
+
 	// SinBinStatus provides access to the gtsmodel SinBinStatus database cache.
 	SinBinStatus StructCache[*gtsmodel.SinBinStatus]
 
@@ -307,6 +313,10 @@ func (c *Caches) initAccount() {
 		Copy:       copyF,
 		Invalidate: c.OnInvalidateAccount,
 	})
+
+	if c.backend != nil {
+		c.DB.Account.SetBackend(c.backend, "account")
+	}
 }
 
 func (c *Caches) initAccountNote() {
@@ -554,6 +564,10 @@ func (c *Caches) initDomainBlock() {
 	c.DB.DomainBlock = new(domain.Cache)
 }
 
+func (c *Caches) initDomainLimited() {
+	c.DB.DomainLimited = new(domain.Cache)
+}
+
 func (c *Caches) initDomainPermissionDraft() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1264,6 +1278,31 @@ func (c *Caches) initReport() {
 	})
 }
 
+func (c *Caches) initRole() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofRole(), // model in-mem size.
+		config.GetCacheRoleMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(r1 *gtsmodel.Role) *gtsmodel.Role {
+		r2 := new(gtsmodel.Role)
+		*r2 = *r1
+		return r2
+	}
+
+	c.DB.Role.Init(structr.CacheConfig[*gtsmodel.Role]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initSinBinStatus() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1311,6 +1350,7 @@ func (c *Caches) initStatus() {
 		s2.InReplyToAccount = nil
 		s2.BoostOf = nil
 		s2.BoostOfAccount = nil
+		s2.Quote = nil
 		s2.Poll = nil
 		s2.Attachments = nil
 		s2.Tags = nil
@@ -1335,6 +1375,10 @@ func (c *Caches) initStatus() {
 		Copy:       copyF,
 		Invalidate: c.OnInvalidateStatus,
 	})
+
+	if c.backend != nil {
+		c.DB.Status.SetBackend(c.backend, "status")
+	}
 }
 
 func (c *Caches) initStatusBookmark() {
@@ -1558,6 +1602,7 @@ func (c *Caches) initUser() {
 		// will be populated separately.
 		// See internal/db/bundb/user.go.
 		u2.Account = nil
+		u2.Role = nil
 
 		return u2
 	}
@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ipblock
+
+import (
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Cache provides a means of caching parsed IP blocks
+// in memory to reduce load on an underlying storage mechanism.
+type Cache struct {
+	// current cached IP blocks slice.
+	ptr atomic.Pointer[[]block]
+}
+
+// block is an IP block
+// with its parsed prefix.
+type block struct {
+	prefix netip.Prefix
+	model  *gtsmodel.IPBlock
+}
+
+// Match returns the unexpired IP block with the most severe severity that
+// contains the given address, or nil if none match. Blocks are loaded using
+// callback if necessary.
+func (c *Cache) Match(addr netip.Addr, load func() ([]*gtsmodel.IPBlock, error)) (*gtsmodel.IPBlock, error) {
+	// Load ptr value.
+	ptr := c.ptr.Load()
+
+	if ptr == nil {
+		// Cache is not hydrated.
+		// Load blocks from callback.
+		blocks, err := loadBlocks(load)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store the new
+		// IP blocks.
+		ptr = &blocks
+		c.ptr.Store(ptr)
+	}
+
+	// Deref and perform match.
+	return match(*ptr, addr, time.Now()), nil
+}
+
+// Clear will drop the currently loaded blocks,
+// triggering a reload on next call to .Match().
+func (c *Cache) Clear() { c.ptr.Store(nil) }
+
+// match returns the matching unexpired block with
+// the most severe severity for addr, or nil if none.
+func match(blocks []block, addr netip.Addr, now time.Time) *gtsmodel.IPBlock {
+	// Ensure IPv4-mapped IPv6
+	// addresses match IPv4 ranges.
+	addr = addr.Unmap()
+
+	var match *gtsmodel.IPBlock
+	for _, b := range blocks {
+		if b.model.Expired(now) {
+			continue
+		}
+
+		if match != nil && b.model.Severity <= match.Severity {
+			// Can't be more severe
+			// than current match.
+			continue
+		}
+
+		if b.prefix.Contains(addr) {
+			match = b.model
+		}
+	}
+
+	return match
+}
+
+// loadBlocks will load blocks from given load callback, parsing each block's prefix.
+func loadBlocks(load func() ([]*gtsmodel.IPBlock, error)) ([]block, error) {
+	// Load blocks from callback.
+	models, err := load()
+	if err != nil {
+		return nil, fmt.Errorf("error reloading cache: %w", err)
+	}
+
+	// Allocate new slice to store parsed blocks.
+	blocks := make([]block, 0, len(models))
+
+	// Parse and add all blocks to slice.
+	for _, model := range models {
+		prefix, err := netip.ParsePrefix(model.IP)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing block %s: %w", model.ID, err)
+		}
+
+		blocks = append(blocks, block{
+			prefix: prefix,
+			model:  model,
+		})
+	}
+
+	return blocks, nil
+}
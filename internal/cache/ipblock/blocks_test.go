@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ipblock
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func TestMatch(t *testing.T) {
+	now := time.Now()
+
+	var c Cache
+	load := func() ([]*gtsmodel.IPBlock, error) {
+		return []*gtsmodel.IPBlock{
+			{ID: "signup_v4", IP: "192.0.2.0/24", Severity: gtsmodel.IPBlockSeveritySignUpBlock},
+			{ID: "access_v4", IP: "192.0.2.128/25", Severity: gtsmodel.IPBlockSeverityNoAccess},
+			{ID: "expired", IP: "198.51.100.0/24", Severity: gtsmodel.IPBlockSeverityNoAccess, ExpiresAt: now.Add(-time.Minute)},
+			{ID: "temporary", IP: "203.0.113.7/32", Severity: gtsmodel.IPBlockSeverityNoAccess, ExpiresAt: now.Add(time.Hour)},
+			{ID: "access_v6", IP: "2001:db8::/32", Severity: gtsmodel.IPBlockSeverityNoAccess},
+		}, nil
+	}
+
+	for _, test := range []struct {
+		addr   string
+		expect string // ID of matched block, "" for none
+	}{
+		{addr: "192.0.2.1", expect: "signup_v4"},
+		{addr: "192.0.2.200", expect: "access_v4"},
+		{addr: "::ffff:192.0.2.200", expect: "access_v4"},
+		{addr: "192.0.3.1", expect: ""},
+		{addr: "198.51.100.1", expect: ""},
+		{addr: "203.0.113.7", expect: "temporary"},
+		{addr: "203.0.113.8", expect: ""},
+		{addr: "2001:db8::1", expect: "access_v6"},
+		{addr: "2001:db9::1", expect: ""},
+	} {
+		block, err := c.Match(netip.MustParseAddr(test.addr), load)
+		if err != nil {
+			t.Fatalf("unexpected error matching %s: %v", test.addr, err)
+		}
+
+		var got string
+		if block != nil {
+			got = block.ID
+		}
+
+		if got != test.expect {
+			t.Errorf("unexpected match for %s: expected %q, got %q", test.addr, test.expect, got)
+		}
+	}
+}
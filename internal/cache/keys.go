@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"codeberg.org/gruf/go-structr"
+)
+
+// uniqueIndex contains reflect field information
+// for a unique (i.e. non-Multiple) StructCache{}
+// index, allowing key parts to be generated from
+// values outside of structr, e.g. to share them
+// with other processes for invalidation.
+type uniqueIndex struct {
+	fields    [][]int
+	allowZero bool
+}
+
+// uniqueIndices returns the uniqueIndex{} information for all
+// unique indices in config, keyed by index name. This expects
+// values of type T to be pointers to structs, as all StructCache{}
+// types currently are, returning nil for anything else.
+func uniqueIndices[T any](config structr.CacheConfig[T]) map[string]uniqueIndex {
+	var zero T
+	t := reflect.TypeOf(zero)
+	if t == nil || t.Kind() != reflect.Pointer ||
+		t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	t = t.Elem()
+
+	indices := make(map[string]uniqueIndex, len(config.Indices))
+	for _, cfg := range config.Indices {
+		if cfg.Multiple {
+			continue
+		}
+
+		var fields [][]int
+		for _, name := range strings.Split(cfg.Fields, ",") {
+			field, ok := t.FieldByName(name)
+			if !ok {
+				panic(fmt.Sprintf("no field %s on %s", name, t))
+			}
+			fields = append(fields, field.Index)
+		}
+
+		indices[cfg.Fields] = uniqueIndex{
+			fields:    fields,
+			allowZero: cfg.AllowZero,
+		}
+	}
+
+	return indices
+}
+
+// parts returns the key parts of value (a struct
+// pointer) for this index, or false if the key is
+// not indexable (i.e. zero when not allowed).
+func (i uniqueIndex) parts(value any) ([]any, bool) {
+	rvalue := reflect.ValueOf(value).Elem()
+	parts := make([]any, len(i.fields))
+	for x, field := range i.fields {
+		if fvalue := reflect.Indirect(rvalue.FieldByIndex(field)); fvalue.IsValid() {
+			parts[x] = fvalue.Interface()
+		}
+	}
+	return parts, i.indexable(parts)
+}
+
+// indexable returns whether key parts
+// are indexable, i.e. they aren't zero
+// where the index does not allow it.
+func (i uniqueIndex) indexable(parts []any) bool {
+	if i.allowZero {
+		return true
+	}
+	for _, part := range parts {
+		rpart := reflect.ValueOf(part)
+		if !rpart.IsValid() || rpart.IsZero() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package redis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Bus implements pubsub.Bus{}
+// on top of Redis PUBLISH / SUBSCRIBE.
+type Bus struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewBus returns a new Bus for given Config, publishing to
+// the channel named KeyPrefix+channel. As with New(), this
+// does not check connectivity to the Redis server.
+func NewBus(cfg Config, channel string) (*Bus, error) {
+	opts, err := options(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bus{
+		client:  redis.NewClient(opts),
+		channel: cfg.KeyPrefix + channel,
+	}, nil
+}
+
+// Publish implements pubsub.Bus{}.
+func (b *Bus) Publish(ctx context.Context, payload string) error {
+	ctx, cncl := context.WithTimeout(ctx, timeout)
+	defer cncl()
+	return b.client.Publish(ctx, b.channel, payload).Err()
+}
+
+// Subscribe implements pubsub.Bus{}.
+func (b *Bus) Subscribe(ctx context.Context, recv func(payload string)) error {
+	sub := b.client.Subscribe(ctx, b.channel)
+	defer sub.Close()
+
+	// Wait for subscription to be confirmed,
+	// so connection errors are returned early.
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	msgs := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case msg, ok := <-msgs:
+			if !ok {
+				return errors.New("subscription closed")
+			}
+			recv(msg.Payload)
+		}
+	}
+}
+
+// Close implements pubsub.Bus{}.
+func (b *Bus) Close() error {
+	return b.client.Close()
+}
@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// timeout is the maximum time any one operation
+// against Redis may take, after which callers will
+// give up and fall back to loading from the database.
+const timeout = time.Second
+
+// Config contains the connection
+// details for a Redis cache Backend.
+type Config struct {
+	// Address is either a host:port
+	// pair, or a redis:// (or rediss://
+	// for TLS) connection URL.
+	Address  string
+	Username string
+	Password string
+	DB       int
+
+	// KeyPrefix is prepended to all
+	// keys stored by this Backend.
+	KeyPrefix string
+
+	// TTL is the time after which
+	// stored values will expire.
+	TTL time.Duration
+}
+
+// Backend implements cache.Backend{}
+// on top of a Redis (compatible) server.
+type Backend struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// New returns a new Backend for given Config. Note that
+// this does not check connectivity to the Redis server,
+// as the connection pool is only dialed on first use.
+func New(cfg Config) (*Backend, error) {
+	if cfg.TTL <= 0 {
+		return nil, errors.New("ttl must be greater than 0")
+	}
+
+	opts, err := options(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Backend{
+		client: redis.NewClient(opts),
+		prefix: cfg.KeyPrefix,
+		ttl:    cfg.TTL,
+	}, nil
+}
+
+// Get implements cache.Backend{}.
+func (b *Backend) Get(keys ...string) ([][]byte, error) {
+	ctx, cncl := context.WithTimeout(context.Background(), timeout)
+	defer cncl()
+
+	results, err := b.client.MGet(ctx, b.keys(keys)...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(results))
+	for i, result := range results {
+		// Missing keys are returned as nil,
+		// everything else is returned as string.
+		if str, ok := result.(string); ok {
+			values[i] = []byte(str)
+		}
+	}
+
+	return values, nil
+}
+
+// Set implements cache.Backend{}.
+func (b *Backend) Set(entries map[string][]byte) error {
+	ctx, cncl := context.WithTimeout(context.Background(), timeout)
+	defer cncl()
+
+	_, err := b.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range entries {
+			pipe.Set(ctx, b.prefix+key, value, b.ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+// Delete implements cache.Backend{}.
+func (b *Backend) Delete(keys ...string) error {
+	ctx, cncl := context.WithTimeout(context.Background(), timeout)
+	defer cncl()
+	return b.client.Del(ctx, b.keys(keys)...).Err()
+}
+
+// Close implements cache.Backend{}.
+func (b *Backend) Close() error {
+	return b.client.Close()
+}
+
+// keys returns the given keys with prefix prepended.
+func (b *Backend) keys(keys []string) []string {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = b.prefix + key
+	}
+	return prefixed
+}
+
+// options returns the redis client options for given Config.
+func options(cfg Config) (*redis.Options, error) {
+	var opts *redis.Options
+
+	if strings.Contains(cfg.Address, "://") {
+		var err error
+
+		// Parse connection options from URL,
+		// taking any other given config as
+		// overrides to those in the URL.
+		opts, err = redis.ParseURL(cfg.Address)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.Username != "" {
+			opts.Username = cfg.Username
+		}
+		if cfg.Password != "" {
+			opts.Password = cfg.Password
+		}
+		if cfg.DB != 0 {
+			opts.DB = cfg.DB
+		}
+	} else {
+		opts = &redis.Options{
+			Addr:     cfg.Address,
+			Username: cfg.Username,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		}
+	}
+
+	// Don't hang around waiting on a slow
+	// Redis, falling back to the db is
+	// preferable in that situation.
+	opts.DialTimeout = timeout
+	opts.ReadTimeout = timeout
+	opts.WriteTimeout = timeout
+
+	return opts, nil
+}
@@ -0,0 +1,313 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"codeberg.org/gruf/go-structr"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Backend is a shared cache tier that can sit behind
+// the in-process memory caches, allowing cached values
+// to be shared between multiple GoToSocial processes.
+//
+// Implementations should take care of their own
+// timeouts, as none of the calling cache functions
+// accept a context. Errors are only logged, with
+// callers falling back to loading from the database.
+type Backend interface {
+	// Get fetches values stored under keys, with
+	// a nil entry returned for each missing key.
+	Get(keys ...string) ([][]byte, error)
+
+	// Set stores the given key-value entries.
+	Set(entries map[string][]byte) error
+
+	// Delete removes values stored under keys.
+	Delete(keys ...string) error
+
+	// Close will close any open
+	// connections to the backend.
+	Close() error
+}
+
+// remoteTier provides a shared Backend tier for
+// a StructCache{}. Values are stored JSON-encoded
+// under their primary "ID" index key, with keys for
+// other unique indices storing a pointer to that ID.
+//
+// Pointer keys are never trusted outright: values
+// loaded through one are checked to still match the
+// lookup, so that stale pointers left behind after
+// an invalidation only ever result in a cache miss.
+type remoteTier[T any] struct {
+	backend Backend
+	name    string
+	indices map[string]uniqueIndex
+	copy    func(T) T
+	elem    reflect.Type
+}
+
+// newRemoteTier returns a new remoteTier for values of type
+// T (which must be a struct pointer), storing them in backend
+// under name. Only unique indices in config are supported,
+// lookups by any other indices will never touch the backend.
+func newRemoteTier[T any](backend Backend, name string, config structr.CacheConfig[T]) *remoteTier[T] {
+	var zero T
+	elem := reflect.TypeOf(zero).Elem()
+
+	indices := uniqueIndices(config)
+	if _, ok := indices["ID"]; !ok {
+		panic(fmt.Sprintf("no unique ID index on %s", elem))
+	}
+
+	return &remoteTier[T]{
+		backend: backend,
+		name:    name,
+		indices: indices,
+		copy:    config.Copy,
+		elem:    elem,
+	}
+}
+
+// Has returns whether index is stored in the backend.
+func (r *remoteTier[T]) Has(index string) bool {
+	_, ok := r.indices[index]
+	return ok
+}
+
+// GetOne fetches the value stored under index with key parts.
+func (r *remoteTier[T]) GetOne(index string, parts ...any) (T, bool) {
+	var zero T
+
+	key := r.key(index, parts)
+	if key == "" {
+		return zero, false
+	}
+
+	if index != "ID" {
+		// Resolve key pointer to ID.
+		id, ok := r.getOne(key)
+		if !ok {
+			return zero, false
+		}
+		key = r.key("ID", []any{string(id)})
+	}
+
+	b, ok := r.getOne(key)
+	if !ok {
+		return zero, false
+	}
+
+	value, ok := r.decode(b)
+	if !ok {
+		return zero, false
+	}
+
+	// Ensure the pointed-to value
+	// does still match this lookup.
+	if r.valueKey(index, value) != r.key(index, parts) {
+		return zero, false
+	}
+
+	return value, true
+}
+
+// GetIDs fetches all values stored under the given IDs,
+// returning those found, and the IDs of those not found.
+func (r *remoteTier[T]) GetIDs(ids []string) ([]T, []string) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = r.key("ID", []any{id})
+	}
+
+	data, err := r.backend.Get(keys...)
+	if err != nil {
+		log.Warnf(nil, "error getting %s from cache backend: %v", r.name, err)
+		return nil, ids
+	}
+
+	values := make([]T, 0, len(ids))
+	missing := make([]string, 0, len(ids))
+	for i, b := range data {
+		value, ok := r.decode(b)
+		if !ok {
+			missing = append(missing, ids[i])
+			continue
+		}
+		values = append(values, value)
+	}
+
+	return values, missing
+}
+
+// Put stores the given values under all of their unique index keys.
+func (r *remoteTier[T]) Put(values ...T) {
+	entries := make(map[string][]byte, len(values)*len(r.indices))
+	for _, value := range values {
+		value = r.copy(value)
+
+		id := r.valueKey("ID", value)
+		if id == "" {
+			continue
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			log.Errorf(nil, "error encoding %s for cache backend: %v", r.name, err)
+			continue
+		}
+		entries[id] = b
+
+		// Store the ID itself under
+		// all other index keys.
+		idPart := r.idOf(value)
+		for index := range r.indices {
+			if index == "ID" {
+				continue
+			}
+			if key := r.valueKey(index, value); key != "" {
+				entries[key] = []byte(idPart)
+			}
+		}
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := r.backend.Set(entries); err != nil {
+		log.Warnf(nil, "error setting %s in cache backend: %v", r.name, err)
+	}
+}
+
+// Invalidate removes the value stored under index with key parts.
+func (r *remoteTier[T]) Invalidate(index string, parts ...any) {
+	key := r.key(index, parts)
+	if key == "" {
+		return
+	}
+
+	keys := []string{key}
+	if index != "ID" {
+		// Also drop the value this key points to, so
+		// it can't still be fetched by any other index.
+		if id, ok := r.getOne(key); ok {
+			keys = append(keys, r.key("ID", []any{string(id)}))
+		}
+	}
+
+	r.delete(keys...)
+}
+
+// InvalidateValue removes value stored under all its unique index keys.
+func (r *remoteTier[T]) InvalidateValue(value T) {
+	keys := make([]string, 0, len(r.indices))
+	for index := range r.indices {
+		if key := r.valueKey(index, value); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	r.delete(keys...)
+}
+
+// InvalidateIDs removes the values stored under the given IDs.
+func (r *remoteTier[T]) InvalidateIDs(ids []string) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if key := r.key("ID", []any{id}); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	r.delete(keys...)
+}
+
+// getOne fetches the single value stored under key.
+func (r *remoteTier[T]) getOne(key string) ([]byte, bool) {
+	data, err := r.backend.Get(key)
+	if err != nil {
+		log.Warnf(nil, "error getting %s from cache backend: %v", r.name, err)
+		return nil, false
+	}
+	if len(data) != 1 || data[0] == nil {
+		return nil, false
+	}
+	return data[0], true
+}
+
+// delete removes the given keys from the backend.
+func (r *remoteTier[T]) delete(keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := r.backend.Delete(keys...); err != nil {
+		log.Warnf(nil, "error deleting %s from cache backend: %v", r.name, err)
+	}
+}
+
+// decode decodes a JSON-encoded value of type T.
+func (r *remoteTier[T]) decode(b []byte) (T, bool) {
+	var zero T
+	if b == nil {
+		return zero, false
+	}
+
+	ptr := reflect.New(r.elem)
+	if err := json.Unmarshal(b, ptr.Interface()); err != nil {
+		log.Errorf(nil, "error decoding %s from cache backend: %v", r.name, err)
+		return zero, false
+	}
+
+	return ptr.Interface().(T), true
+}
+
+// idOf returns the ID field value of value.
+func (r *remoteTier[T]) idOf(value T) string {
+	parts, _ := r.indices["ID"].parts(value)
+	return fmt.Sprint(parts[0])
+}
+
+// valueKey returns the backend key for value under index.
+func (r *remoteTier[T]) valueKey(index string, value T) string {
+	parts, ok := r.indices[index].parts(value)
+	if !ok {
+		return ""
+	}
+	return r.key(index, parts)
+}
+
+// key returns the backend key for index with key parts,
+// or an empty string where the key is not cacheable (i.e.
+// it contains zero values not allowed by the index).
+func (r *remoteTier[T]) key(index string, parts []any) string {
+	if !r.indices[index].indexable(parts) {
+		return ""
+	}
+
+	strs := make([]string, len(parts))
+	for i, part := range parts {
+		strs[i] = fmt.Sprint(part)
+	}
+
+	return r.name + ":" + index + ":" + strings.Join(strs, "|")
+}
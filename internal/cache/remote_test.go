@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"codeberg.org/gruf/go-structr"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// memBackend is a simple in-memory cache.Backend{}.
+type memBackend struct {
+	mu sync.Mutex
+	m  map[string][]byte
+}
+
+func (b *memBackend) Get(keys ...string) ([][]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = b.m[key]
+	}
+	return values, nil
+}
+
+func (b *memBackend) Set(entries map[string][]byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key, value := range entries {
+		b.m[key] = value
+	}
+	return nil
+}
+
+func (b *memBackend) Delete(keys ...string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, key := range keys {
+		delete(b.m, key)
+	}
+	return nil
+}
+
+func (b *memBackend) Close() error { return nil }
+
+func newAccountCache(backend cache.Backend) *cache.StructCache[*gtsmodel.Account] {
+	c := new(cache.StructCache[*gtsmodel.Account])
+	c.Init(structr.CacheConfig[*gtsmodel.Account]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "URI"},
+			{Fields: "Username,Domain", AllowZero: true},
+		},
+		MaxSize: 100,
+		Copy: func(a1 *gtsmodel.Account) *gtsmodel.Account {
+			a2 := new(gtsmodel.Account)
+			*a2 = *a1
+			return a2
+		},
+	})
+	c.SetBackend(backend, "account")
+	return c
+}
+
+func TestStructCacheBackend(t *testing.T) {
+	backend := &memBackend{m: make(map[string][]byte)}
+
+	account := &gtsmodel.Account{
+		ID:       "01F8MH17FWEB39HZJ76B6VXSKF",
+		URI:      "http://localhost:8080/users/the_mighty_zork",
+		Username: "the_mighty_zork",
+	}
+
+	load := func() (*gtsmodel.Account, error) {
+		return account, nil
+	}
+
+	noLoad := func() (*gtsmodel.Account, error) {
+		t.Fatal("value should have been loaded from backend")
+		return nil, nil
+	}
+
+	// Load into the first process' cache, which
+	// should also populate the shared backend.
+	c1 := newAccountCache(backend)
+	if _, err := c1.LoadOne("URI", load, account.URI); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second process should now find the
+	// value in the backend by any unique index.
+	c2 := newAccountCache(backend)
+	got, err := c2.LoadOne("Username,Domain", noLoad, account.Username, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != account.ID {
+		t.Fatalf("unexpected account loaded: %s", got.ID)
+	}
+
+	c3 := newAccountCache(backend)
+	accounts, err := c3.LoadIDs("ID", []string{account.ID}, func([]string) ([]*gtsmodel.Account, error) {
+		return nil, errors.New("values should have been loaded from backend")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0].URI != account.URI {
+		t.Fatalf("unexpected accounts loaded: %+v", accounts)
+	}
+
+	// Invalidating by a secondary index in a
+	// process where the value isn't cached should
+	// still drop the value from the backend.
+	newAccountCache(backend).Invalidate("URI", account.URI)
+
+	var loaded bool
+	c4 := newAccountCache(backend)
+	if _, err := c4.LoadOne("ID", func() (*gtsmodel.Account, error) {
+		loaded = true
+		return account, nil
+	}, account.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded {
+		t.Fatal("value should have been invalidated from backend")
+	}
+
+	// Storing an updated value should drop the
+	// old value from the backend, and the stale
+	// key pointer left behind by the old URI
+	// should no longer resolve to the new value.
+	updated := new(gtsmodel.Account)
+	*updated = *account
+	updated.URI = "http://localhost:8080/users/zork"
+	if err := c4.Store(updated, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	c5 := newAccountCache(backend)
+	if _, err := c5.LoadOne("ID", func() (*gtsmodel.Account, error) {
+		return updated, nil
+	}, account.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded = false
+	c6 := newAccountCache(backend)
+	if _, err := c6.LoadOne("URI", func() (*gtsmodel.Account, error) {
+		loaded = true
+		return nil, errors.New("not found")
+	}, account.URI); err == nil {
+		t.Fatal("stale value should not have been loaded from backend")
+	}
+	if !loaded {
+		t.Fatal("stale value should not have been loaded from backend")
+	}
+}
@@ -206,6 +206,7 @@ func totalOfRatios() float64 {
 		config.GetCachePollVoteMemRatio() +
 		config.GetCachePollVoteIDsMemRatio() +
 		config.GetCacheReportMemRatio() +
+		config.GetCacheRoleMemRatio() +
 		config.GetCacheSinBinStatusMemRatio() +
 		config.GetCacheStatusMemRatio() +
 		config.GetCacheStatusBookmarkMemRatio() +
@@ -250,6 +251,9 @@ func sizeofAccount() uintptr {
 		PrivateKey:              &rsa.PrivateKey{},
 		PublicKey:               &rsa.PublicKey{},
 		PublicKeyURI:            exampleURI,
+		Ed25519PrivateKey:       make([]byte, 64),
+		Ed25519PublicKey:        make([]byte, 32),
+		Ed25519PublicKeyURI:     exampleURI,
 		SensitizedAt:            exampleTime,
 		SilencedAt:              exampleTime,
 		SuspendedAt:             exampleTime,
@@ -339,6 +343,8 @@ func sizeofConversation() uintptr {
 		ThreadID:         exampleID,
 		LastStatusID:     exampleID,
 		Read:             util.Ptr(true),
+		LastReadStatusID: exampleID,
+		Muted:            util.Ptr(false),
 	}))
 }
 
@@ -444,6 +450,7 @@ func sizeofFollowRequest() uintptr {
 		ShowReblogs:     func() *bool { ok := true; return &ok }(),
 		URI:             exampleURI,
 		Notify:          func() *bool { ok := false; return &ok }(),
+		Note:            exampleTextSmall,
 	}))
 }
 
@@ -597,6 +604,7 @@ func sizeofReport() uintptr {
 		AccountID:              exampleID,
 		TargetAccountID:        exampleID,
 		Comment:                exampleText,
+		Category:               gtsmodel.ReportCategorySpam,
 		StatusIDs:              []string{exampleID, exampleID, exampleID},
 		Forwarded:              func() *bool { ok := true; return &ok }(),
 		ActionTaken:            exampleText,
@@ -605,6 +613,17 @@ func sizeofReport() uintptr {
 	}))
 }
 
+func sizeofRole() uintptr {
+	return uintptr(size.Of(&gtsmodel.Role{
+		ID:          exampleID,
+		CreatedAt:   exampleTime,
+		UpdatedAt:   exampleTime,
+		Name:        exampleUsername,
+		Permissions: gtsmodel.RolePermissionsAll,
+		Highlighted: func() *bool { ok := true; return &ok }(),
+	}))
+}
+
 func sizeofSinBinStatus() uintptr {
 	return uintptr(size.Of(&gtsmodel.SinBinStatus{
 		ID:                  exampleID,
@@ -657,6 +676,9 @@ func sizeofStatus() uintptr {
 		CreatedWithApplicationID: exampleID,
 		Federated:                func() *bool { ok := true; return &ok }(),
 		ActivityStreamsType:      ap.ObjectNote,
+		RemoteRepliesCount:       util.Ptr(100),
+		RemoteReblogsCount:       util.Ptr(100),
+		RemoteFavesCount:         util.Ptr(100),
 	}))
 }
 
@@ -21,7 +21,9 @@
 	"slices"
 
 	"codeberg.org/gruf/go-cache/v3/simple"
+	"codeberg.org/gruf/go-cache/v3/ttl"
 	"codeberg.org/gruf/go-structr"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 // SliceCache wraps a simple.Cache to provide simple loader-callback
@@ -89,18 +91,48 @@ func (c *SliceCache[T]) Cap() int {
 // name under the main database caches struct which would reduce
 // time required to access cached values).
 type StructCache[StructType any] struct {
-	cache structr.Cache[StructType]
-	index map[string]*structr.Index
+	cache  structr.Cache[StructType]
+	index  map[string]*structr.Index
+	unique map[string]uniqueIndex
+	config structr.CacheConfig[StructType]
+
+	// remote is an optional shared cache
+	// tier sitting behind the memory cache.
+	remote *remoteTier[StructType]
 }
 
 // Init initializes the cache with given structr.CacheConfig{}.
 func (c *StructCache[T]) Init(config structr.CacheConfig[T]) {
+	c.config = config
+	c.remote = nil
+
+	// Wrap the invalidate hook so that any
+	// values written, or invalidated from
+	// memory, are also dropped from backend.
+	invalidate := config.Invalidate
+	config.Invalidate = func(value T) {
+		if c.remote != nil {
+			c.remote.InvalidateValue(value)
+		}
+		if invalidate != nil {
+			invalidate(value)
+		}
+	}
+
 	c.index = make(map[string]*structr.Index, len(config.Indices))
 	c.cache = structr.Cache[T]{}
 	c.cache.Init(config)
 	for _, cfg := range config.Indices {
 		c.index[cfg.Fields] = c.cache.Index(cfg.Fields)
 	}
+	c.unique = uniqueIndices(config)
+}
+
+// SetBackend sets a shared Backend tier behind the memory cache, storing
+// values under name. Only lookups by unique indices will use the backend.
+// This must be called after Init(), and before the cache is in use.
+func (c *StructCache[T]) SetBackend(backend Backend, name string) {
+	c.remote = newRemoteTier(backend, name, c.config)
 }
 
 // GetOne calls structr.Cache{}.GetOne(), using a cached structr.Index{} by 'index' name.
@@ -126,6 +158,25 @@ func (c *StructCache[T]) Put(values ...T) {
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) LoadOne(index string, load func() (T, error), key ...any) (T, error) {
 	i := c.index[index]
+
+	if c.remote != nil && c.remote.Has(index) {
+		dbload := load
+
+		// Wrap the loader to first check the
+		// shared backend tier, and populate it
+		// with any values then loaded from db.
+		load = func() (T, error) {
+			if value, ok := c.remote.GetOne(index, key...); ok {
+				return value, nil
+			}
+			value, err := dbload()
+			if err == nil {
+				c.remote.Put(value)
+			}
+			return value, err
+		}
+	}
+
 	return c.cache.LoadOne(i, i.Key(key...), load)
 }
 
@@ -148,6 +199,26 @@ func (c *StructCache[T]) LoadIDs(index string, ids []string, load func([]string)
 		keys[x] = i.Key(id)
 	}
 
+	if c.remote != nil && index == "ID" {
+		dbload := load
+
+		// Wrap the loader to first check the
+		// shared backend tier, and populate it
+		// with any values then loaded from db.
+		load = func(ids []string) ([]T, error) {
+			values, missing := c.remote.GetIDs(ids)
+			if len(missing) == 0 {
+				return values, nil
+			}
+			loaded, err := dbload(missing)
+			if err != nil {
+				return nil, err
+			}
+			c.remote.Put(loaded...)
+			return append(values, loaded...), nil
+		}
+	}
+
 	// Pass loader callback with wrapper onto main cache load function.
 	return c.cache.Load(i, keys, func(uncached []structr.Key) ([]T, error) {
 		uncachedIDs := make([]string, len(uncached))
@@ -196,6 +267,9 @@ func (c *StructCache[T]) Store(value T, store func() error) error {
 func (c *StructCache[T]) Invalidate(index string, key ...any) {
 	i := c.index[index]
 	c.cache.Invalidate(i, i.Key(key...))
+	if c.remote != nil && c.remote.Has(index) {
+		c.remote.Invalidate(index, key...)
+	}
 }
 
 // InvalidateIDs calls structr.Cache{}.Invalidate(), using a cached structr.Index{} by 'index' name. Note: this also
@@ -219,6 +293,10 @@ func (c *StructCache[T]) InvalidateIDs(index string, ids []string) {
 
 	// Pass to main invalidate func.
 	c.cache.Invalidate(i, keys...)
+
+	if c.remote != nil && index == "ID" {
+		c.remote.InvalidateIDs(ids)
+	}
 }
 
 // Trim: see structr.Cache{}.Trim().
@@ -240,3 +318,62 @@ func (c *StructCache[T]) Len() int {
 func (c *StructCache[T]) Cap() int {
 	return c.cache.Cap()
 }
+
+// WebfingerCache wraps a ttl.Cache{} of webfinger URLs
+// by domain, optionally backed by a shared Backend tier.
+type WebfingerCache struct {
+	*ttl.Cache[string, string]
+
+	// remote is an optional shared cache
+	// tier sitting behind the memory cache.
+	remote Backend
+}
+
+// GetURL returns the cached webfinger URL for domain. Note that unlike
+// ttl.Cache{}.Get(), this does not update the cached item's expiry.
+func (c *WebfingerCache) GetURL(domain string) (string, bool) {
+	// We're doing the manual locking/unlocking here to be able to
+	// safely call Cache.Get instead of Get, as the latter updates the
+	// item expiry which we don't want to do here
+	c.Lock()
+	item, ok := c.Cache.Cache.Get(domain)
+	c.Unlock()
+
+	if ok {
+		return item.Value, true
+	}
+
+	if c.remote == nil {
+		return "", false
+	}
+
+	data, err := c.remote.Get("webfinger:" + domain)
+	if err != nil {
+		log.Warnf(nil, "error getting webfinger from cache backend: %v", err)
+		return "", false
+	}
+
+	if len(data) != 1 || data[0] == nil {
+		return "", false
+	}
+
+	// Store in memory for next time.
+	url := string(data[0])
+	c.Cache.Set(domain, url)
+
+	return url, true
+}
+
+// Set stores the webfinger URL for domain, also in any backend.
+func (c *WebfingerCache) Set(domain string, url string) {
+	c.Cache.Set(domain, url)
+
+	if c.remote == nil {
+		return
+	}
+
+	err := c.remote.Set(map[string][]byte{"webfinger:" + domain: []byte(url)})
+	if err != nil {
+		log.Warnf(nil, "error setting webfinger in cache backend: %v", err)
+	}
+}
@@ -0,0 +1,243 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// ErrFailed is returned by Verify when the captcha
+// provider did not accept the given response, or
+// when no response was given in the first place.
+var ErrFailed = errors.New("captcha verification failed")
+
+// provider contains the details needed to
+// embed the widget of one captcha provider
+// in a page, and to verify its responses.
+type provider struct {
+	// URL of the script that renders the widget.
+	script string
+
+	// Class of the element that the
+	// script renders the widget into.
+	class string
+
+	// Name of the form field that the widget fills
+	// in with its response when the form is submitted.
+	field string
+
+	// Endpoint to verify widget responses against.
+	verifyURL string
+
+	// Extra Content-Security-Policy directives
+	// needed to load and run the widget.
+	csp []string
+
+	// Builds the verification request form.
+	form func(siteKey, secretKey, response, remoteIP string) url.Values
+}
+
+var providers = map[string]provider{
+	config.AccountsCaptchaProviderHCaptcha: {
+		script:    "https://js.hcaptcha.com/1/api.js",
+		class:     "h-captcha",
+		field:     "h-captcha-response",
+		verifyURL: "https://api.hcaptcha.com/siteverify",
+		csp: []string{
+			"script-src 'self' https://hcaptcha.com https://*.hcaptcha.com",
+			"frame-src https://hcaptcha.com https://*.hcaptcha.com",
+			"style-src 'self' https://hcaptcha.com https://*.hcaptcha.com",
+			"connect-src 'self' https://hcaptcha.com https://*.hcaptcha.com",
+		},
+		form: func(siteKey, secretKey, response, remoteIP string) url.Values {
+			return url.Values{
+				"sitekey":  {siteKey},
+				"secret":   {secretKey},
+				"response": {response},
+				"remoteip": {remoteIP},
+			}
+		},
+	},
+	config.AccountsCaptchaProviderTurnstile: {
+		script:    "https://challenges.cloudflare.com/turnstile/v0/api.js",
+		class:     "cf-turnstile",
+		field:     "cf-turnstile-response",
+		verifyURL: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+		csp: []string{
+			"script-src 'self' https://challenges.cloudflare.com",
+			"frame-src https://challenges.cloudflare.com",
+		},
+		form: func(_, secretKey, response, remoteIP string) url.Values {
+			return url.Values{
+				"secret":   {secretKey},
+				"response": {response},
+				"remoteip": {remoteIP},
+			}
+		},
+	},
+	config.AccountsCaptchaProviderFriendlyCaptcha: {
+		script:    "https://cdn.jsdelivr.net/npm/friendly-challenge@0.9.18/widget.min.js",
+		class:     "frc-captcha",
+		field:     "frc-captcha-solution",
+		verifyURL: "https://api.friendlycaptcha.com/api/v1/siteverify",
+		csp: []string{
+			"script-src 'self' https://cdn.jsdelivr.net",
+			"connect-src 'self' https://api.friendlycaptcha.com",
+			"worker-src 'self' blob:",
+		},
+		form: func(siteKey, secretKey, response, _ string) url.Values {
+			return url.Values{
+				"sitekey":  {siteKey},
+				"secret":   {secretKey},
+				"solution": {response},
+			}
+		},
+	},
+}
+
+// Captcha wraps logic for embedding a signup
+// captcha widget from the configured provider,
+// and verifying responses from that widget.
+type Captcha struct {
+	provider  provider
+	siteKey   string
+	secretKey string
+	client    *http.Client
+}
+
+// New returns a new Captcha using the
+// accounts-captcha-* config values, or
+// nil if no captcha provider is configured.
+func New() *Captcha {
+	p, ok := providers[config.GetAccountsCaptchaProvider()]
+	if !ok {
+		return nil
+	}
+
+	return &Captcha{
+		provider:  p,
+		siteKey:   config.GetAccountsCaptchaSiteKey(),
+		secretKey: config.GetAccountsCaptchaSecretKey(),
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Script returns the URL of the
+// script that renders the widget.
+func (c *Captcha) Script() string {
+	return c.provider.script
+}
+
+// Class returns the class of the element
+// that the script renders the widget into.
+func (c *Captcha) Class() string {
+	return c.provider.class
+}
+
+// SiteKey returns the public site key,
+// to be set on the widget element.
+func (c *Captcha) SiteKey() string {
+	return c.siteKey
+}
+
+// FormField returns the name of the form field
+// that the widget fills in with its response.
+func (c *Captcha) FormField() string {
+	return c.provider.field
+}
+
+// CSP returns the Content-Security-Policy
+// directives needed to load and run the widget,
+// to be appended to the standard policy.
+func (c *Captcha) CSP() string {
+	return strings.Join(c.provider.csp, "; ")
+}
+
+// Verify checks the given widget response, submitted
+// from the given IP, with the captcha provider.
+//
+// Returns ErrFailed if the response is empty or
+// wasn't accepted by the provider, or another error
+// if the provider couldn't be reached.
+func (c *Captcha) Verify(ctx context.Context, response string, remoteIP net.IP) error {
+	if response == "" {
+		return ErrFailed
+	}
+
+	form := c.provider.form(
+		c.siteKey,
+		c.secretKey,
+		response,
+		remoteIP.String(),
+	)
+
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		c.provider.verifyURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return gtserror.Newf("error verifying captcha: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	// Providers respond 200 OK to any well-formed
+	// request, and put the verdict in the body.
+	// Anything else means something's up with
+	// our config (eg., wrong secret key).
+	if rsp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(rsp.Body, 256))
+		return gtserror.Newf("captcha provider responded %s: %s", rsp.Status, b)
+	}
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"` // hCaptcha, Turnstile
+		Errors     []string `json:"errors"`      // FriendlyCaptcha
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(&result); err != nil {
+		return gtserror.Newf("error decoding captcha provider response: %w", err)
+	}
+
+	if !result.Success {
+		codes := append(result.ErrorCodes, result.Errors...)
+		return fmt.Errorf("%w: %s", ErrFailed, strings.Join(codes, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package captcha
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+func TestNew(t *testing.T) {
+	for _, test := range []struct {
+		provider string
+		field    string
+	}{
+		{provider: ""},
+		{provider: config.AccountsCaptchaProviderHCaptcha, field: "h-captcha-response"},
+		{provider: config.AccountsCaptchaProviderTurnstile, field: "cf-turnstile-response"},
+		{provider: config.AccountsCaptchaProviderFriendlyCaptcha, field: "frc-captcha-solution"},
+	} {
+		test := test // loopvar capture
+		t.Run(test.provider, func(t *testing.T) {
+			config.SetAccountsCaptchaProvider(test.provider)
+			config.SetAccountsCaptchaSiteKey("site-key")
+			config.SetAccountsCaptchaSecretKey("secret-key")
+
+			c := New()
+			switch {
+			case test.provider == "" && c != nil:
+				t.Fatal("expected nil captcha when no provider set")
+			case test.provider == "":
+				return
+			case c == nil:
+				t.Fatalf("expected captcha for provider %s, got nil", test.provider)
+			}
+
+			if c.FormField() != test.field {
+				t.Fatalf("got form field %s, wanted %s", c.FormField(), test.field)
+			}
+
+			if c.SiteKey() != "site-key" {
+				t.Fatalf("got site key %s, wanted site-key", c.SiteKey())
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		response    string
+		status      int
+		body        string
+		expectedErr string
+		failed      bool
+	}{
+		{name: "ok", response: "token", status: http.StatusOK, body: `{"success":true}`},
+		{name: "rejected", response: "token", status: http.StatusOK, body: `{"success":false,"error-codes":["invalid-input-response"]}`, expectedErr: "captcha verification failed: invalid-input-response", failed: true},
+		{name: "rejected friendlycaptcha", response: "token", status: http.StatusOK, body: `{"success":false,"errors":["solution_invalid"]}`, expectedErr: "captcha verification failed: solution_invalid", failed: true},
+		{name: "no response", response: "", expectedErr: "captcha verification failed", failed: true},
+		{name: "bad secret", response: "token", status: http.StatusUnauthorized, body: `{"success":false,"errors":["secret_invalid"]}`, expectedErr: `Verify: captcha provider responded 401 Unauthorized: {"success":false,"errors":["secret_invalid"]}`},
+	} {
+		test := test // loopvar capture
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("error parsing form: %v", err)
+				}
+
+				if got := r.PostForm.Get("secret"); got != "secret-key" {
+					t.Errorf("got secret %s, wanted secret-key", got)
+				}
+
+				if got := r.PostForm.Get("response"); got != test.response {
+					t.Errorf("got response %s, wanted %s", got, test.response)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer srv.Close()
+
+			p := providers[config.AccountsCaptchaProviderTurnstile]
+			p.verifyURL = srv.URL
+
+			c := &Captcha{
+				provider:  p,
+				siteKey:   "site-key",
+				secretKey: "secret-key",
+				client:    srv.Client(),
+			}
+
+			err := c.Verify(context.Background(), test.response, net.ParseIP("192.0.2.1"))
+			switch {
+			case test.expectedErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case test.expectedErr != "" && err == nil:
+				t.Fatalf("expected error %s, got nil", test.expectedErr)
+			case test.expectedErr != "" && err.Error() != test.expectedErr:
+				t.Fatalf("got error: %s, wanted: %s", err, test.expectedErr)
+			}
+
+			if errors.Is(err, ErrFailed) != test.failed {
+				t.Fatalf("got ErrFailed %t, wanted %t", errors.Is(err, ErrFailed), test.failed)
+			}
+		})
+	}
+}
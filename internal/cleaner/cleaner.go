@@ -172,7 +172,7 @@ func (c *Cleaner) ScheduleJobs() error {
 	)
 
 	// Schedule the cleaning to execute according to schedule.
-	if !c.state.Workers.Scheduler.AddRecurring(
+	if !c.state.Workers.Scheduler.AddRecurringExclusive(
 		"@mediacleanup",
 		firstCleanupAt,
 		cleanupEvery,
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -170,7 +171,8 @@ func (m *Media) PruneUnused(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-// UncacheRemote will uncache all remote media attachments older than given input time.
+// UncacheRemote will uncache all remote media attachments older than given input time,
+// unless a media retention policy for the domain of the media owner's account says otherwise.
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
 func (m *Media) UncacheRemote(ctx context.Context, olderThan time.Time) (int, error) {
 	var total int
@@ -179,8 +181,15 @@ func (m *Media) UncacheRemote(ctx context.Context, olderThan time.Time) (int, er
 	// (i.e. make it olderThan inclusive search).
 	olderThan = olderThan.Add(-time.Minute)
 
-	// Store recent time.
-	mostRecent := olderThan
+	// Load any per-domain overrides of olderThan.
+	policies, err := m.getRetentionPolicies(ctx, olderThan)
+	if err != nil {
+		return total, err
+	}
+
+	// Search from the most recent time
+	// that any media may be uncached.
+	olderThan = policies.mostRecent()
 
 	for {
 		// Fetch the next batch of cached attachments older than last-set time.
@@ -200,7 +209,7 @@ func (m *Media) UncacheRemote(ctx context.Context, olderThan time.Time) (int, er
 
 		for _, media := range attachments {
 			// Check / uncache each remote media attachment.
-			uncached, err := m.uncacheRemote(ctx, mostRecent, media)
+			uncached, err := m.uncacheRemote(ctx, policies, media)
 			if err != nil {
 				return total, err
 			}
@@ -326,6 +335,21 @@ func (m *Media) isOrphaned(ctx context.Context, path string) (bool, error) {
 			l.Debug("missing db entry for emoji")
 			return true, nil
 		}
+
+	case media.TypeArchive:
+		// Look for account archive in database stored by ID.
+		archive, err := m.state.DB.GetAccountArchiveByID(
+			gtscontext.SetBarebones(ctx),
+			mediaID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return false, gtserror.Newf("error fetching account archive by id %s: %w", mediaID, err)
+		}
+
+		if archive == nil {
+			l.Debug("missing db entry for account archive")
+			return true, nil
+		}
 	}
 
 	return false, nil
@@ -422,6 +446,7 @@ func (m *Media) fixCacheState(ctx context.Context, media *gtsmodel.MediaAttachme
 		l.Debug("cached=false exists=true => deleting")
 		_, err := m.removeFiles(ctx,
 			media.Thumbnail.Path,
+			media.Thumbnail.FallbackPath,
 			media.File.Path,
 		)
 		return true, err
@@ -431,7 +456,7 @@ func (m *Media) fixCacheState(ctx context.Context, media *gtsmodel.MediaAttachme
 	}
 }
 
-func (m *Media) uncacheRemote(ctx context.Context, after time.Time, media *gtsmodel.MediaAttachment) (bool, error) {
+func (m *Media) uncacheRemote(ctx context.Context, policies *retentionPolicies, media *gtsmodel.MediaAttachment) (bool, error) {
 	if !*media.Cached {
 		// Already uncached.
 		return false, nil
@@ -441,6 +466,29 @@ func (m *Media) uncacheRemote(ctx context.Context, after time.Time, media *gtsmo
 	l := log.WithContext(ctx).
 		WithField("media", media.ID)
 
+	// Check whether we have the account that owns the media.
+	account, missing, err := m.getOwningAccount(ctx, media)
+	if err != nil {
+		return false, err
+	} else if missing {
+		// PruneUnused will take care of this case.
+		l.Debug("skipping due to missing account")
+		return false, nil
+	}
+
+	var domain string
+	if account != nil {
+		domain = account.Domain
+	}
+
+	// Get the time before which media
+	// from this domain should be uncached.
+	after, ok := policies.olderThan(domain)
+	if !ok || media.CreatedAt.After(after) {
+		l.Debug("skipping due to domain media retention policy")
+		return false, nil
+	}
+
 	// There are two possibilities here:
 	//
 	//   1. Media is an avatar or header; we should uncache
@@ -448,16 +496,6 @@ func (m *Media) uncacheRemote(ctx context.Context, after time.Time, media *gtsmo
 	//   2. Media is attached to a status; we should uncache
 	//      it if we haven't seen the status recently.
 	if *media.Avatar || *media.Header {
-		// Check whether we have the account that owns the media.
-		account, missing, err := m.getOwningAccount(ctx, media)
-		if err != nil {
-			return false, err
-		} else if missing {
-			// PruneUnused will take care of this case.
-			l.Debug("skipping due to missing account")
-			return false, nil
-		}
-
 		if account != nil && account.FetchedAt.After(after) {
 			l.Debug("skipping due to recently fetched account")
 			return false, nil
@@ -496,6 +534,84 @@ func (m *Media) uncacheRemote(ctx context.Context, after time.Time, media *gtsmo
 	return true, m.uncache(ctx, media)
 }
 
+// retentionPolicies contains the times before
+// which remote media should be uncached, both
+// by default and as overridden for domains.
+type retentionPolicies struct {
+	// olderThan by default.
+	def time.Time
+
+	// olderThan by domain, where
+	// a zero time indicates media
+	// should be kept indefinitely.
+	domains map[string]time.Time
+}
+
+// olderThan returns the time before which media from
+// domain should be uncached, checking for a policy for
+// the domain and each of its parents in turn. Returns
+// false if media from domain should never be uncached.
+func (p *retentionPolicies) olderThan(domain string) (time.Time, bool) {
+	for domain != "" {
+		if t, ok := p.domains[domain]; ok {
+			return t, !t.IsZero()
+		}
+
+		// Move on to parent domain.
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			break
+		}
+		domain = domain[i+1:]
+	}
+
+	return p.def, true
+}
+
+// mostRecent returns the most recent
+// time before which any remote media
+// may be uncached, by any policy.
+func (p *retentionPolicies) mostRecent() time.Time {
+	mostRecent := p.def
+	for _, t := range p.domains {
+		if t.After(mostRecent) {
+			mostRecent = t
+		}
+	}
+	return mostRecent
+}
+
+// getRetentionPolicies loads all media retention policies
+// from the database, returning them relative to given
+// default time before which remote media is uncached.
+func (m *Media) getRetentionPolicies(ctx context.Context, olderThan time.Time) (*retentionPolicies, error) {
+	policies, err := m.state.DB.GetMediaRetentionPolicies(
+		gtscontext.SetBarebones(ctx),
+		"", nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting media retention policies: %w", err)
+	}
+
+	now := time.Now()
+	p := &retentionPolicies{
+		def:     olderThan,
+		domains: make(map[string]time.Time, len(policies)),
+	}
+
+	for _, policy := range policies {
+		var t time.Time
+		if policy.RemoteCacheDays > 0 {
+			// Drop by a minute, as for the default.
+			days := time.Duration(policy.RemoteCacheDays)
+			t = now.Add(-24 * time.Hour * days).Add(-time.Minute)
+		}
+		p.domains[policy.Domain] = t
+	}
+
+	return p, nil
+}
+
 func (m *Media) getOwningAccount(ctx context.Context, media *gtsmodel.MediaAttachment) (*gtsmodel.Account, bool, error) {
 	if media.AccountID == "" {
 		// no related account.
@@ -548,10 +664,11 @@ func (m *Media) uncache(ctx context.Context, media *gtsmodel.MediaAttachment) er
 		return nil
 	}
 
-	// Remove media and thumbnail.
+	// Remove media and thumbnail(s).
 	_, err := m.removeFiles(ctx,
 		media.File.Path,
 		media.Thumbnail.Path,
+		media.Thumbnail.FallbackPath,
 	)
 	if err != nil {
 		return gtserror.Newf("error removing media files: %w", err)
@@ -573,10 +690,11 @@ func (m *Media) delete(ctx context.Context, media *gtsmodel.MediaAttachment) err
 		return nil
 	}
 
-	// Remove media and thumbnail.
+	// Remove media and thumbnail(s).
 	_, err := m.removeFiles(ctx,
 		media.File.Path,
 		media.Thumbnail.Path,
+		media.Thumbnail.FallbackPath,
 	)
 	if err != nil {
 		return gtserror.Newf("error removing media files: %w", err)
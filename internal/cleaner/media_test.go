@@ -315,6 +315,73 @@ func (suite *MediaTestSuite) TestUncacheRemote() {
 	suite.False(*uncachedAttachment.Cached)
 }
 
+func (suite *MediaTestSuite) TestUncacheRemoteRetentionPolicyKeep() {
+	ctx := context.Background()
+
+	testStatusAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(*testStatusAttachment.Cached)
+
+	testHeader := suite.testAttachments["remote_account_3_header"]
+	suite.True(*testHeader.Cached)
+
+	// Keep media from remote_account_1's domain indefinitely.
+	if err := suite.db.PutMediaRetentionPolicy(ctx, &gtsmodel.MediaRetentionPolicy{
+		ID:                 "01JFDR3Y8N8XJ6DW0N1D2TZ2PM",
+		Domain:             suite.testAccounts["remote_account_1"].Domain,
+		RemoteCacheDays:    0,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	after := time.Now().Add(-24 * time.Hour)
+	totalUncached, err := suite.cleaner.Media().UncacheRemote(ctx, after)
+	suite.NoError(err)
+	suite.Equal(2, totalUncached)
+
+	cachedAttachment, err := suite.db.GetAttachmentByID(ctx, testStatusAttachment.ID)
+	suite.NoError(err)
+	suite.True(*cachedAttachment.Cached)
+
+	uncachedAttachment, err := suite.db.GetAttachmentByID(ctx, testHeader.ID)
+	suite.NoError(err)
+	suite.False(*uncachedAttachment.Cached)
+}
+
+func (suite *MediaTestSuite) TestUncacheRemoteRetentionPolicyPrune() {
+	ctx := context.Background()
+
+	testStatusAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(*testStatusAttachment.Cached)
+
+	testHeader := suite.testAttachments["remote_account_3_header"]
+	suite.True(*testHeader.Cached)
+
+	// Prune media from remote_account_3's domain after a day.
+	if err := suite.db.PutMediaRetentionPolicy(ctx, &gtsmodel.MediaRetentionPolicy{
+		ID:                 "01JFDR3Y8N8XJ6DW0N1D2TZ2PM",
+		Domain:             suite.testAccounts["remote_account_3"].Domain,
+		RemoteCacheDays:    1,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// By default, only uncache media older than a century.
+	after := time.Now().AddDate(-100, 0, 0)
+	totalUncached, err := suite.cleaner.Media().UncacheRemote(ctx, after)
+	suite.NoError(err)
+	suite.Equal(1, totalUncached)
+
+	cachedAttachment, err := suite.db.GetAttachmentByID(ctx, testStatusAttachment.ID)
+	suite.NoError(err)
+	suite.True(*cachedAttachment.Cached)
+
+	uncachedAttachment, err := suite.db.GetAttachmentByID(ctx, testHeader.ID)
+	suite.NoError(err)
+	suite.False(*uncachedAttachment.Cached)
+}
+
 func (suite *MediaTestSuite) TestUncacheRemoteDry() {
 	ctx := context.Background()
 
@@ -45,20 +45,22 @@ func fieldtag(field, tag string) string {
 // will need to regenerate the global Getter/Setter helpers by running:
 // `go run ./internal/config/gen/ -out ./internal/config/helpers.gen.go`
 type Configuration struct {
-	LogLevel           string   `name:"log-level" usage:"Log level to run at: [trace, debug, info, warn, fatal]"`
-	LogTimestampFormat string   `name:"log-timestamp-format" usage:"Format to use for the log timestamp, as supported by Go's time.Layout"`
-	LogDbQueries       bool     `name:"log-db-queries" usage:"Log database queries verbosely when log-level is trace or debug"`
-	LogClientIP        bool     `name:"log-client-ip" usage:"Include the client IP in logs"`
-	ApplicationName    string   `name:"application-name" usage:"Name of the application, used in various places internally"`
-	LandingPageUser    string   `name:"landing-page-user" usage:"the user that should be shown on the instance's landing page"`
-	ConfigPath         string   `name:"config-path" usage:"Path to a file containing gotosocial configuration. Values set in this file will be overwritten by values set as env vars or arguments"`
-	Host               string   `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
-	AccountDomain      string   `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
-	Protocol           string   `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging or behind a reverse proxy!)"`
-	BindAddress        string   `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
-	Port               int      `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
-	TrustedProxies     []string `name:"trusted-proxies" usage:"Proxies to trust when parsing x-forwarded headers into real IPs."`
-	SoftwareVersion    string   `name:"software-version" usage:""`
+	LogLevel            string   `name:"log-level" usage:"Log level to run at: [trace, debug, info, warn, fatal]"`
+	LogTimestampFormat  string   `name:"log-timestamp-format" usage:"Format to use for the log timestamp, as supported by Go's time.Layout"`
+	LogDbQueries        bool     `name:"log-db-queries" usage:"Log database queries verbosely when log-level is trace or debug"`
+	LogClientIP         bool     `name:"log-client-ip" usage:"Include the client IP in logs"`
+	LogFormat           string   `name:"log-format" usage:"Format of log entries: [logfmt, json]"`
+	ApplicationName     string   `name:"application-name" usage:"Name of the application, used in various places internally"`
+	LandingPageUser     string   `name:"landing-page-user" usage:"the user that should be shown on the instance's landing page"`
+	ConfigPath          string   `name:"config-path" usage:"Path to a file containing gotosocial configuration. Values set in this file will be overwritten by values set as env vars or arguments"`
+	Host                string   `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
+	AccountDomain       string   `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
+	ExtraAccountDomains []string `name:"extra-account-domains" usage:"Extra domains that local accounts can be hosted on instead of account-domain (eg., family.example.org). Each domain must serve (or redirect to) this instance's /.well-known/webfinger endpoint."`
+	Protocol            string   `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging or behind a reverse proxy!)"`
+	BindAddress         string   `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
+	Port                int      `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
+	TrustedProxies      []string `name:"trusted-proxies" usage:"Proxies to trust when parsing x-forwarded headers into real IPs."`
+	SoftwareVersion     string   `name:"software-version" usage:""`
 
 	DbType                     string        `name:"db-type" usage:"Database type: eg., postgres"`
 	DbAddress                  string        `name:"db-address" usage:"Database ipv4 address, hostname, or filename"`
@@ -74,53 +76,108 @@ type Configuration struct {
 	DbSqliteCacheSize          bytesize.Size `name:"db-sqlite-cache-size" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_cache_size"`
 	DbSqliteBusyTimeout        time.Duration `name:"db-sqlite-busy-timeout" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_busy_timeout"`
 	DbPostgresConnectionString string        `name:"db-postgres-connection-string" usage:"Full Database URL for connection to postgres"`
+	DbPostgresReplicas         []string      `name:"db-postgres-replicas" usage:"Postgres only: full Database URLs of read-only replicas of the database, to which read-only queries will be routed"`
+	DbPostgresReplicaMaxLag    time.Duration `name:"db-postgres-replica-max-lag" usage:"Postgres only: maximum replication lag of a replica before read-only queries are routed back to the primary database"`
 
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceFederationMode         string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
-	InstanceFederationSpamFilter   bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
-	InstanceExposePeers            bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeSuspended        bool               `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
-	InstanceExposeSuspendedWeb     bool               `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
-	InstanceExposePublicTimeline   bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceDeliverToSharedInboxes bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
-	InstanceInjectMastodonVersion  bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
-	InstanceLanguages              language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
-
-	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
-	AccountsReasonRequired   bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
-	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
-	AccountsCustomCSSLength  int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
-
-	MediaDescriptionMinChars int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
-	MediaDescriptionMaxChars int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
-	MediaRemoteCacheDays     int           `name:"media-remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
-	MediaEmojiLocalMaxSize   bytesize.Size `name:"media-emoji-local-max-size" usage:"Max size in bytes of emojis uploaded to this instance via the admin API."`
-	MediaEmojiRemoteMaxSize  bytesize.Size `name:"media-emoji-remote-max-size" usage:"Max size in bytes of emojis to download from other instances."`
-	MediaImageSizeHint       bytesize.Size `name:"media-image-size-hint" usage:"Size in bytes of max image size referred to on /api/v_/instance endpoints (else, local max size)"`
-	MediaVideoSizeHint       bytesize.Size `name:"media-video-size-hint" usage:"Size in bytes of max video size referred to on /api/v_/instance endpoints (else, local max size)"`
-	MediaLocalMaxSize        bytesize.Size `name:"media-local-max-size" usage:"Max size in bytes of media uploaded to this instance via API"`
-	MediaRemoteMaxSize       bytesize.Size `name:"media-remote-max-size" usage:"Max size in bytes of media to download from other instances"`
-	MediaCleanupFrom         string        `name:"media-cleanup-from" usage:"Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
-	MediaCleanupEvery        time.Duration `name:"media-cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
-	MediaFfmpegPoolSize      int           `name:"media-ffmpeg-pool-size" usage:"Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS."`
-
-	StorageBackend       string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
-	StorageLocalBasePath string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
-	StorageS3Endpoint    string `name:"storage-s3-endpoint" usage:"S3 Endpoint URL (e.g 'minio.example.org:9000')"`
-	StorageS3AccessKey   string `name:"storage-s3-access-key" usage:"S3 Access Key"`
-	StorageS3SecretKey   string `name:"storage-s3-secret-key" usage:"S3 Secret Key"`
-	StorageS3UseSSL      bool   `name:"storage-s3-use-ssl" usage:"Use SSL for S3 connections. Only set this to 'false' when testing locally"`
-	StorageS3BucketName  string `name:"storage-s3-bucket" usage:"Place blobs in this bucket"`
-	StorageS3Proxy       bool   `name:"storage-s3-proxy" usage:"Proxy S3 contents through GoToSocial instead of redirecting to a presigned URL"`
-	StorageS3RedirectURL string `name:"storage-s3-redirect-url" usage:"Custom URL to use for redirecting S3 media links. If set, this will be used instead of the S3 bucket URL."`
+	InstanceFederationMode                   string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
+	InstanceAuthorizedFetchMode              string             `name:"instance-authorized-fetch-mode" usage:"Set instance authorized fetch mode: whether incoming federation GET requests must be http-signed."`
+	InstanceFederationSpamFilter             bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
+	InstanceFederationSpamScoreThreshold     int                `name:"instance-federation-spam-score-threshold" usage:"Spam score at or above which incoming statuses from accounts the receiver doesn't follow are removed. 0 disables spam scoring."`
+	InstanceFederationSpamScoreAction        string             `name:"instance-federation-spam-score-action" usage:"Action to take on incoming statuses that meet the spam score threshold: drop, or sin_bin."`
+	InstanceFederationSpamScoreMaxMentions   int                `name:"instance-federation-spam-score-max-mentions" usage:"Number of mentions a status can have before each further mention adds to its spam score."`
+	InstanceFederationSpamScoreMaxHashtags   int                `name:"instance-federation-spam-score-max-hashtags" usage:"Number of hashtags a status can have before each further hashtag adds to its spam score."`
+	InstanceFederationSpamScoreNewAccountAge time.Duration      `name:"instance-federation-spam-score-new-account-age" usage:"Accounts younger than this add to the spam score of their statuses. 0 disables the account age check."`
+	InstanceIntegrityProofs                  bool               `name:"instance-integrity-proofs" usage:"Publish Ed25519 keys for local accounts, and attach Ed25519 integrity proofs to outgoing activities (FEP-8b32)."`
+	InstanceExposePeers                      bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeSuspended                  bool               `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
+	InstanceExposeSuspendedWeb               bool               `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
+	InstanceExposePublicTimeline             bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceExposeTagRSS                     bool               `name:"instance-expose-tag-rss" usage:"Serve RSS feeds of public posts by local accounts for each hashtag at /tags/:tag_name.rss"`
+	InstanceWebSubHubURL                     string             `name:"instance-websub-hub-url" usage:"URL of a WebSub hub to advertise in, and publish updates of, account RSS feeds. Leave empty to disable WebSub."`
+	InstanceDeliverToSharedInboxes           bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceInjectMastodonVersion            bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	InstanceLanguages                        language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
+	InstanceWebfingerAliasHosts              []string           `name:"instance-webfinger-alias-hosts" usage:"Extra hosts for which webfinger lookups should resolve to local accounts, eg., the previous host of an instance that has changed its host."`
+	InstanceOutboxPageSize                   int                `name:"instance-outbox-page-size" usage:"Number of items per page of the ActivityPub outbox collections of local accounts. Requests for larger pages are capped to this size."`
+	InstanceBackfillReplies                  bool               `name:"instance-backfill-replies" usage:"When a user opens a remote status thread, asynchronously dereference the status's replies collection to pull in missing replies."`
+	InstanceBackfillRepliesMaxDepth          int                `name:"instance-backfill-replies-max-depth" usage:"Maximum depth of nested replies to follow when backfilling replies."`
+	InstanceBackfillRepliesMaxStatuses       int                `name:"instance-backfill-replies-max-statuses" usage:"Maximum number of remote statuses to dereference in one replies backfill."`
+	InstanceBackfillFollowStatuses           int                `name:"instance-backfill-follow-statuses" usage:"Number of recent statuses to fetch from the outbox of a newly followed remote account, if none of its statuses are stored yet. 0 to disable."`
+	InstanceDirectoryIncludeRemote           bool               `name:"instance-directory-include-remote" usage:"Include known remote accounts that have opted in to discovery in the profile directory at /api/v1/directory."`
+	InstanceNodeInfoMetadata                 []string           `name:"instance-nodeinfo-metadata" usage:"Metadata fields to include in nodeinfo responses. Any of: node-name, node-description, maintainer, federation."`
+
+	AccountsRegistrationOpen bool   `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
+	AccountsReasonRequired   bool   `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
+	AccountsAllowCustomCSS   bool   `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
+	AccountsAllowUserInvites bool   `name:"accounts-allow-user-invites" usage:"Allow users who aren't admins or moderators to create invite links. Admins and moderators can always create invite links."`
+	AccountsCustomCSSLength  int    `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsArchiveInterval  int    `name:"accounts-archive-interval-days" usage:"Minimum number of days between full archive exports requested by one account."`
+	AccountsDeletionGrace    int    `name:"accounts-deletion-grace-days" usage:"Number of days a self-deleted account stays deactivated, during which its owner can log in and cancel the deletion, before it is actually deleted. 0 to delete straight away."`
+	AccountsCaptchaProvider  string `name:"accounts-captcha-provider" usage:"Captcha provider to verify account signups with: hcaptcha, turnstile, or friendlycaptcha. Leave empty to disable signup captchas."`
+	AccountsCaptchaSiteKey   string `name:"accounts-captcha-site-key" usage:"Public site key given by the captcha provider."`
+	AccountsCaptchaSecretKey string `name:"accounts-captcha-secret-key" usage:"Secret key given by the captcha provider, used to verify captcha responses."`
+
+	AccountsFieldVerificationInterval time.Duration `name:"accounts-field-verification-interval" usage:"How often to re-check rel=me links of local accounts' profile fields, to keep their verification up to date. 0 to only verify fields when they're saved."`
+
+	MediaDescriptionMinChars   int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
+	MediaDescriptionRequired   bool          `name:"media-description-required" usage:"Reject new statuses with media attachments that don't have a description (alt text)"`
+	MediaDescriptionMaxChars   int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
+	MediaRemoteCacheDays       int           `name:"media-remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
+	MediaEmojiLocalMaxSize     bytesize.Size `name:"media-emoji-local-max-size" usage:"Max size in bytes of emojis uploaded to this instance via the admin API."`
+	MediaEmojiRemoteMaxSize    bytesize.Size `name:"media-emoji-remote-max-size" usage:"Max size in bytes of emojis to download from other instances."`
+	MediaImageSizeHint         bytesize.Size `name:"media-image-size-hint" usage:"Size in bytes of max image size referred to on /api/v_/instance endpoints (else, local max size)"`
+	MediaVideoSizeHint         bytesize.Size `name:"media-video-size-hint" usage:"Size in bytes of max video size referred to on /api/v_/instance endpoints (else, local max size)"`
+	MediaLocalMaxSize          bytesize.Size `name:"media-local-max-size" usage:"Max size in bytes of media uploaded to this instance via API"`
+	MediaRemoteMaxSize         bytesize.Size `name:"media-remote-max-size" usage:"Max size in bytes of media to download from other instances"`
+	MediaCleanupFrom           string        `name:"media-cleanup-from" usage:"Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
+	MediaCleanupEvery          time.Duration `name:"media-cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
+	MediaFfmpegPoolSize        int           `name:"media-ffmpeg-pool-size" usage:"Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS."`
+	MediaThumbnailFormat       string        `name:"media-thumbnail-format" usage:"Image format to generate media attachment thumbnails in: 'jpeg', 'webp' or 'avif'. For 'webp' and 'avif', a jpeg fallback is also stored for clients that don't accept the format."`
+	MediaVideoTranscode        bool          `name:"media-video-transcode" usage:"Transcode uploaded and fetched videos that aren't already web-safe into H.264/AAC mp4 renditions. Transcoding happens in the background, serving the original until finished."`
+	MediaVideoMaxBitrate       int           `name:"media-video-max-bitrate" usage:"Max video bitrate in kilobits per second of transcoded renditions. Videos above this bitrate are transcoded even if otherwise web-safe."`
+	MediaVideoMaxHeight        int           `name:"media-video-max-height" usage:"Max size in pixels of the shorter side of transcoded renditions, eg., 1080 for 1080p. Videos above this are transcoded even if otherwise web-safe."`
+	MediaVideoTranscodeWorkers int           `name:"media-video-transcode-workers" usage:"Number of background workers to use for video transcoding. Each occupies one instance of the ffmpeg pool while running."`
+
+	StorageBackend            string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
+	StorageLocalBasePath      string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
+	StorageS3Endpoint         string `name:"storage-s3-endpoint" usage:"S3 Endpoint URL (e.g 'minio.example.org:9000')"`
+	StorageS3AccessKey        string `name:"storage-s3-access-key" usage:"S3 Access Key"`
+	StorageS3SecretKey        string `name:"storage-s3-secret-key" usage:"S3 Secret Key"`
+	StorageS3UseSSL           bool   `name:"storage-s3-use-ssl" usage:"Use SSL for S3 connections. Only set this to 'false' when testing locally"`
+	StorageS3BucketName       string `name:"storage-s3-bucket" usage:"Place blobs in this bucket"`
+	StorageS3Proxy            bool   `name:"storage-s3-proxy" usage:"Proxy S3 contents through GoToSocial instead of redirecting to a presigned URL"`
+	StorageS3RedirectURL      string `name:"storage-s3-redirect-url" usage:"Custom URL to use for redirecting S3 media links. If set, this will be used instead of the S3 bucket URL."`
+	StorageAzureAccountName   string `name:"storage-azure-account-name" usage:"Azure storage account name"`
+	StorageAzureAccountKey    string `name:"storage-azure-account-key" usage:"Azure storage account key (base64 encoded)"`
+	StorageAzureContainer     string `name:"storage-azure-container" usage:"Place blobs in this Azure Blob container"`
+	StorageAzureEndpoint      string `name:"storage-azure-endpoint" usage:"Azure Blob service endpoint URL, defaults to 'https://{account}.blob.core.windows.net'"`
+	StorageAzureProxy         bool   `name:"storage-azure-proxy" usage:"Proxy Azure Blob contents through GoToSocial instead of redirecting to a SAS URL"`
+	StorageGCSBucket          string `name:"storage-gcs-bucket" usage:"Place objects in this Google Cloud Storage bucket"`
+	StorageGCSCredentialsFile string `name:"storage-gcs-credentials-file" usage:"Path to a Google Cloud service account JSON key file"`
+	StorageGCSEndpoint        string `name:"storage-gcs-endpoint" usage:"Google Cloud Storage API endpoint URL, defaults to 'https://storage.googleapis.com'"`
+	StorageGCSProxy           bool   `name:"storage-gcs-proxy" usage:"Proxy Google Cloud Storage contents through GoToSocial instead of redirecting to a signed URL"`
+
+	SearchBackend           string `name:"search-backend" usage:"Backend to use for searching status text: 'db' to use the database, or 'meilisearch' to use an external Meilisearch instance"`
+	SearchMeilisearchURL    string `name:"search-meilisearch-url" usage:"URL of the Meilisearch instance to use when search-backend is 'meilisearch'. Eg., 'http://localhost:7700'"`
+	SearchMeilisearchAPIKey string `name:"search-meilisearch-api-key" usage:"API key to authenticate with Meilisearch. Must have permission to manage the configured index."`
+	SearchMeilisearchIndex  string `name:"search-meilisearch-index" usage:"Name of the Meilisearch index to store statuses in. Will be created if it doesn't exist."`
 
 	StatusesMaxChars           int `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
 	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
 	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
 	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
 
+	TrendsEnabled       bool `name:"trends-enabled" usage:"Compute trending hashtags, statuses and links from recent public activity, and show them via the client API"`
+	TrendsRequireReview bool `name:"trends-require-review" usage:"Only show trending hashtags, statuses and links after they've been approved by an admin"`
+	TrendsMinAccounts   int  `name:"trends-min-accounts" usage:"Minimum number of different accounts that must have used a hashtag, status or link in the last 48 hours for it to trend"`
+
+	TranslationProvider string `name:"translation-provider" usage:"Translation provider to translate statuses with via the client API: deepl or libretranslate. Leave empty to disable translations."`
+	TranslationEndpoint string `name:"translation-endpoint" usage:"Base URL of the translation provider API. Required for libretranslate; for deepl, leave empty to pick the free or pro API based on the API key."`
+	TranslationAPIKey   string `name:"translation-api-key" usage:"API key given by the translation provider."`
+
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
 	LetsEncryptCertDir      string `name:"letsencrypt-cert-dir" usage:"Directory to store acquired letsencrypt certificates."`
@@ -139,6 +196,20 @@ type Configuration struct {
 	OIDCLinkExisting     bool     `name:"oidc-link-existing" usage:"link existing user accounts to OIDC logins based on the stored email value"`
 	OIDCAllowedGroups    []string `name:"oidc-allowed-groups" usage:"Membership of one of the listed groups allows access to GtS. If this is empty, all groups are allowed."`
 	OIDCAdminGroups      []string `name:"oidc-admin-groups" usage:"Membership of one of the listed groups makes someone a GtS admin"`
+	OIDCModeratorGroups  []string `name:"oidc-moderator-groups" usage:"Membership of one of the listed groups makes someone a GtS moderator"`
+	OIDCGroupsClaim      string   `name:"oidc-groups-claim" usage:"Name of the ID token claim containing group memberships. Nested claims can be given with dots, eg., 'realm_access.roles'."`
+	OIDCSyncRoles        bool     `name:"oidc-sync-roles" usage:"Update the admin and moderator roles of users on each OIDC login based on their group memberships, promoting or demoting them as necessary."`
+
+	LDAPEnabled           bool   `name:"ldap-enabled" usage:"Enable LDAP authentication for this instance. If set to true, then the other LDAP flags must also be set."`
+	LDAPURL               string `name:"ldap-url" usage:"URL of the LDAP server, including protocol and port. Eg., 'ldaps://ldap.example.org:636'"`
+	LDAPStartTLS          bool   `name:"ldap-start-tls" usage:"Upgrade the connection to the LDAP server with StartTLS. Only applicable to ldap:// URLs."`
+	LDAPBindDN            string `name:"ldap-bind-dn" usage:"DN of the service account to bind as when searching for users. If empty, searches will be done anonymously."`
+	LDAPBindPassword      string `name:"ldap-bind-password" usage:"Password of the service account to bind as when searching for users."`
+	LDAPBaseDN            string `name:"ldap-base-dn" usage:"DN under which to search for users. Eg., 'ou=people,dc=example,dc=org'"`
+	LDAPUserFilter        string `name:"ldap-user-filter" usage:"Filter used to search for the user logging in. The placeholder {username} will be replaced with the (escaped) value entered in the sign in form."`
+	LDAPUsernameAttribute string `name:"ldap-username-attribute" usage:"Attribute of the user entry to use as the GtS username."`
+	LDAPEmailAttribute    string `name:"ldap-email-attribute" usage:"Attribute of the user entry to use as the GtS email address."`
+	LDAPAutoProvision     bool   `name:"ldap-auto-provision" usage:"Automatically create a GtS account for directory users logging in for the first time."`
 
 	TracingEnabled           bool   `name:"tracing-enabled" usage:"Enable OTLP Tracing"`
 	TracingTransport         string `name:"tracing-transport" usage:"grpc or http"`
@@ -150,25 +221,55 @@ type Configuration struct {
 	MetricsAuthUsername string `name:"metrics-auth-username" usage:"Username for Prometheus metrics endpoint"`
 	MetricsAuthPassword string `name:"metrics-auth-password" usage:"Password for Prometheus metrics endpoint"`
 
-	SMTPHost               string `name:"smtp-host" usage:"Host of the smtp server. Eg., 'smtp.eu.mailgun.org'"`
-	SMTPPort               int    `name:"smtp-port" usage:"Port of the smtp server. Eg., 587"`
-	SMTPUsername           string `name:"smtp-username" usage:"Username to authenticate with the smtp server as. Eg., 'postmaster@mail.example.org'"`
-	SMTPPassword           string `name:"smtp-password" usage:"Password to pass to the smtp server."`
-	SMTPFrom               string `name:"smtp-from" usage:"Address to use as the 'from' field of the email. Eg., 'gotosocial@example.org'"`
-	SMTPDiscloseRecipients bool   `name:"smtp-disclose-recipients" usage:"If true, email notifications sent to multiple recipients will be To'd to every recipient at once. If false, recipients will not be disclosed"`
+	DebugEndpointsEnabled bool   `name:"debug-endpoints-enabled" usage:"Enable the /debug/pprof and /debug/stats endpoints for profiling this instance"`
+	DebugEndpointsToken   string `name:"debug-endpoints-token" usage:"Bearer token required to access the debug endpoints"`
+
+	SMTPHost               string   `name:"smtp-host" usage:"Host of the smtp server. Eg., 'smtp.eu.mailgun.org'"`
+	SMTPPort               int      `name:"smtp-port" usage:"Port of the smtp server. Eg., 587"`
+	SMTPUsername           string   `name:"smtp-username" usage:"Username to authenticate with the smtp server as. Eg., 'postmaster@mail.example.org'"`
+	SMTPPassword           string   `name:"smtp-password" usage:"Password to pass to the smtp server."`
+	SMTPFrom               string   `name:"smtp-from" usage:"Address to use as the 'from' field of the email. Eg., 'gotosocial@example.org'"`
+	SMTPDiscloseRecipients bool     `name:"smtp-disclose-recipients" usage:"If true, email notifications sent to multiple recipients will be To'd to every recipient at once. If false, recipients will not be disclosed"`
+	SMTPAuthMethod         string   `name:"smtp-auth-method" usage:"Method to use to authenticate with the smtp server. Options: [plain, xoauth2]"`
+	SMTPOAuth2TokenURL     string   `name:"smtp-oauth2-token-url" usage:"OAuth2 token endpoint to use to get access tokens for xoauth2 smtp authentication. Eg., 'https://oauth2.googleapis.com/token'"`
+	SMTPOAuth2ClientID     string   `name:"smtp-oauth2-client-id" usage:"OAuth2 client ID to use for xoauth2 smtp authentication."`
+	SMTPOAuth2ClientSecret string   `name:"smtp-oauth2-client-secret" usage:"OAuth2 client secret to use for xoauth2 smtp authentication."`
+	SMTPOAuth2RefreshToken string   `name:"smtp-oauth2-refresh-token" usage:"OAuth2 refresh token to use to get access tokens for xoauth2 smtp authentication."`
+	SMTPOAuth2Scopes       []string `name:"smtp-oauth2-scopes" usage:"OAuth2 scopes to request access tokens with for xoauth2 smtp authentication. Eg., 'https://mail.google.com/'"`
 
 	SyslogEnabled  bool   `name:"syslog-enabled" usage:"Enable the syslog logging hook. Logs will be mirrored to the configured destination."`
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
-	AdvancedCookiesSamesite      string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
-	AdvancedRateLimitRequests    int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
-	AdvancedRateLimitExceptions  []string      `name:"advanced-rate-limit-exceptions" usage:"Slice of CIDRs to exclude from rate limit restrictions."`
-	AdvancedThrottlingMultiplier int           `name:"advanced-throttling-multiplier" usage:"Multiplier to use per cpu for http request throttling. 0 or less turns throttling off."`
-	AdvancedThrottlingRetryAfter time.Duration `name:"advanced-throttling-retry-after" usage:"Retry-After duration response to send for throttled requests."`
-	AdvancedSenderMultiplier     int           `name:"advanced-sender-multiplier" usage:"Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended)."`
-	AdvancedCSPExtraURIs         []string      `name:"advanced-csp-extra-uris" usage:"Additional URIs to allow when building content-security-policy for media + images."`
-	AdvancedHeaderFilterMode     string        `name:"advanced-header-filter-mode" usage:"Set incoming request header filtering mode."`
+	LogFile           string        `name:"log-file" usage:"Path of a file to also write logs to. Leave empty to disable logging to file."`
+	LogFileMaxSize    bytesize.Size `name:"log-file-max-size" usage:"Size in bytes after which the log file is rotated. 0 to disable size-based rotation."`
+	LogFileMaxAge     time.Duration `name:"log-file-max-age" usage:"Age after which the log file is rotated. 0 to disable age-based rotation."`
+	LogFileMaxBackups int           `name:"log-file-max-backups" usage:"Number of rotated log files to keep. 0 to keep all rotated log files."`
+
+	DeliveryMaxRetries           int           `name:"delivery-max-retries" usage:"Maximum number of times to retry a failed outgoing delivery (or other outgoing request) before giving up."`
+	DeliveryBackoff              time.Duration `name:"delivery-backoff" usage:"Base backoff duration between retries of a failed outgoing delivery, doubled with each further retry."`
+	DeliveryUnreachableThreshold int           `name:"delivery-unreachable-threshold" usage:"Number of consecutive failed deliveries to a domain, after retries, before the domain is marked as temporarily unreachable."`
+	DeliveryUnreachableDuration  time.Duration `name:"delivery-unreachable-duration" usage:"Duration for which a domain is marked as unreachable, during which no deliveries to it are attempted."`
+	DeliveryDeadLetterRetention  time.Duration `name:"delivery-dead-letter-retention" usage:"Duration for which failed deliveries are kept as dead letters, to be inspected and re-driven by admins. 0 disables keeping dead letters."`
+	DeliveryRateLimit            int           `name:"delivery-rate-limit" usage:"Maximum number of outgoing deliveries per minute to a single domain. Can be overridden per domain on domain allows. 0 disables the limit."`
+	DeliveryDereferenceRateLimit int           `name:"delivery-dereference-rate-limit" usage:"Maximum number of outgoing dereference (GET) requests per minute to a single domain. Can be overridden per domain on domain allows. 0 disables the limit."`
+
+	ClusterEnabled bool   `name:"cluster-enabled" usage:"Enable running multiple GoToSocial processes (nodes) against the same database and storage. Requires a postgres or mysql database, and cache-invalidation-bus to be set."`
+	ClusterNodeID  string `name:"cluster-node-id" usage:"Name of this node, unique within the cluster. Used to coordinate scheduled jobs between nodes. Defaults to the hostname if not set."`
+
+	AdvancedCookiesSamesite            string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
+	AdvancedRateLimitRequests          int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
+	AdvancedRateLimitExceptions        []string      `name:"advanced-rate-limit-exceptions" usage:"Slice of CIDRs to exclude from rate limit restrictions."`
+	AdvancedRateLimitKey               string        `name:"advanced-rate-limit-key" usage:"How to group authenticated client API requests for rate limiting: 'ip' (by IP address), 'token' (by OAuth token), or 'account' (by account). Requests not authenticated by a user are always limited by IP address."`
+	AdvancedRateLimitSearchRequests    int           `name:"advanced-rate-limit-search-requests" usage:"Amount of client API search requests to permit within a 5 minute window, limited separately from other requests. 0 or less counts search requests toward advanced-rate-limit-requests instead."`
+	AdvancedRateLimitStreamingRequests int           `name:"advanced-rate-limit-streaming-requests" usage:"Amount of client API streaming requests to permit within a 5 minute window, limited separately from other requests. 0 or less counts streaming requests toward advanced-rate-limit-requests instead."`
+	AdvancedThrottlingMultiplier       int           `name:"advanced-throttling-multiplier" usage:"Multiplier to use per cpu for http request throttling. 0 or less turns throttling off."`
+	AdvancedThrottlingRetryAfter       time.Duration `name:"advanced-throttling-retry-after" usage:"Retry-After duration response to send for throttled requests."`
+	AdvancedSenderMultiplier           int           `name:"advanced-sender-multiplier" usage:"Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended)."`
+	AdvancedCSPExtraURIs               []string      `name:"advanced-csp-extra-uris" usage:"Additional URIs to allow when building content-security-policy for media + images."`
+	AdvancedHeaderFilterMode           string        `name:"advanced-header-filter-mode" usage:"Set incoming request header filtering mode."`
+	AdvancedAIScraperMode              string        `name:"advanced-ai-scraper-mode" usage:"How to handle requests from known AI scraper user agents: '' (robots.txt and headers only), 'block' (respond 403 Forbidden), or 'tarpit' (hold the request open before responding 403 Forbidden)."`
+	AdvancedAIScraperTarpitDelay       time.Duration `name:"advanced-ai-scraper-tarpit-delay" usage:"Duration to hold requests from AI scrapers open for before responding, when advanced-ai-scraper-mode is 'tarpit'."`
 
 	// HTTPClient configuration vars.
 	HTTPClient HTTPClientConfiguration `name:"http-client"`
@@ -177,13 +278,17 @@ type Configuration struct {
 	Cache CacheConfiguration `name:"cache"`
 
 	// TODO: move these elsewhere, these are more ephemeral vs long-running flags like above
-	AdminAccountUsername     string `name:"username" usage:"the username to create/delete/etc"`
-	AdminAccountEmail        string `name:"email" usage:"the email address of this account"`
-	AdminAccountPassword     string `name:"password" usage:"the password to set for this account"`
-	AdminTransPath           string `name:"path" usage:"the path of the file to import from/export to"`
-	AdminMediaPruneDryRun    bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
-	AdminMediaListLocalOnly  bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true"`
-	AdminMediaListRemoteOnly bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true"`
+	AdminAccountUsername        string `name:"username" usage:"the username to create/delete/etc"`
+	AdminAccountEmail           string `name:"email" usage:"the email address of this account"`
+	AdminAccountPassword        string `name:"password" usage:"the password to set for this account"`
+	AdminAccountDomain          string `name:"domain" usage:"the extra account domain to host this account on; empty to use account-domain"`
+	AdminTransPath              string `name:"path" usage:"the path of the file to import from/export to"`
+	AdminMediaPruneDryRun       bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
+	AdminMediaListLocalOnly     bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true"`
+	AdminMediaListRemoteOnly    bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true"`
+	AdminMediaRegenerateAccount string `name:"account" usage:"only regenerate media belonging to the local account with this username"`
+	AdminMediaRegenerateSince   string `name:"since" usage:"only regenerate media created on or after this date, formatted as YYYY-MM-DD or RFC3339"`
+	AdminMediaRegenerateUntil   string `name:"until" usage:"only regenerate media created before this date, formatted as YYYY-MM-DD or RFC3339"`
 
 	RequestIDHeader string `name:"request-id-header" usage:"Header to extract the Request ID from. Eg.,'X-Request-Id'."`
 }
@@ -193,6 +298,11 @@ type HTTPClientConfiguration struct {
 	BlockIPs              []string      `name:"block-ips"`
 	Timeout               time.Duration `name:"timeout"`
 	TLSInsecureSkipVerify bool          `name:"tls-insecure-skip-verify"`
+	SOCKSProxy            string        `name:"socks-proxy"`
+	SOCKSProxyOnionOnly   bool          `name:"socks-proxy-onion-only"`
+	Proxy                 string        `name:"proxy"`
+	ProxyIncludeHosts     []string      `name:"proxy-include-hosts"`
+	ProxyExcludeHosts     []string      `name:"proxy-exclude-hosts"`
 }
 
 type CacheConfiguration struct {
@@ -234,6 +344,7 @@ type CacheConfiguration struct {
 	PollVoteMemRatio                  float64       `name:"poll-vote-mem-ratio"`
 	PollVoteIDsMemRatio               float64       `name:"poll-vote-ids-mem-ratio"`
 	ReportMemRatio                    float64       `name:"report-mem-ratio"`
+	RoleMemRatio                      float64       `name:"role-mem-ratio"`
 	SinBinStatusMemRatio              float64       `name:"sin-bin-status-mem-ratio"`
 	StatusMemRatio                    float64       `name:"status-mem-ratio"`
 	StatusBookmarkMemRatio            float64       `name:"status-bookmark-mem-ratio"`
@@ -249,6 +360,15 @@ type CacheConfiguration struct {
 	UserMuteIDsMemRatio               float64       `name:"user-mute-ids-mem-ratio"`
 	WebfingerMemRatio                 float64       `name:"webfinger-mem-ratio"`
 	VisibilityMemRatio                float64       `name:"visibility-mem-ratio"`
+
+	Backend         string        `name:"backend"`
+	InvalidationBus string        `name:"invalidation-bus"`
+	RedisAddress    string        `name:"redis-address"`
+	RedisUsername   string        `name:"redis-username"`
+	RedisPassword   string        `name:"redis-password"`
+	RedisDB         int           `name:"redis-db"`
+	RedisKeyPrefix  string        `name:"redis-key-prefix"`
+	RedisTTL        time.Duration `name:"redis-ttl"`
 }
 
 // MarshalMap will marshal current Configuration into a map structure (useful for JSON/TOML/YAML).
@@ -24,9 +24,79 @@
 	InstanceFederationModeAllowlist = "allowlist"
 	InstanceFederationModeDefault   = InstanceFederationModeBlocklist
 
+	// Instance authorized fetch mode determines whether
+	// incoming federation GET requests must be signed.
+	InstanceAuthorizedFetchModeRequire  = "require"
+	InstanceAuthorizedFetchModeOptional = "optional"
+	InstanceAuthorizedFetchModeDefault  = InstanceAuthorizedFetchModeRequire
+
+	// Accounts captcha provider determines which service
+	// (if any) is used to verify signups with a captcha.
+	AccountsCaptchaProviderHCaptcha        = "hcaptcha"
+	AccountsCaptchaProviderTurnstile       = "turnstile"
+	AccountsCaptchaProviderFriendlyCaptcha = "friendlycaptcha"
+
+	// Translation provider determines which service
+	// (if any) is used to translate statuses.
+	TranslationProviderDeepL          = "deepl"
+	TranslationProviderLibreTranslate = "libretranslate"
+
+	// Instance federation spam score action determines what
+	// happens to incoming statuses that meet the score threshold.
+	InstanceFederationSpamScoreActionDrop    = "drop"
+	InstanceFederationSpamScoreActionSinBin  = "sin_bin"
+	InstanceFederationSpamScoreActionDefault = InstanceFederationSpamScoreActionDrop
+
+	// Instance nodeinfo metadata fields that
+	// can be included in nodeinfo responses.
+	InstanceNodeInfoMetadataNodeName        = "node-name"
+	InstanceNodeInfoMetadataNodeDescription = "node-description"
+	InstanceNodeInfoMetadataMaintainer      = "maintainer"
+	InstanceNodeInfoMetadataFederation      = "federation"
+
 	// Request header filter mode determines how
 	// this instance will perform request filtering.
 	RequestHeaderFilterModeAllow    = "allow"
 	RequestHeaderFilterModeBlock    = "block"
 	RequestHeaderFilterModeDisabled = ""
+
+	// AI scraper mode determines what happens to
+	// requests from known AI scraper user agents.
+	AIScraperModeBlock    = "block"
+	AIScraperModeTarpit   = "tarpit"
+	AIScraperModeDisabled = ""
+
+	// SMTP auth method determines how this
+	// instance authenticates with the smtp server.
+	SMTPAuthMethodPlain   = "plain"
+	SMTPAuthMethodXOAuth2 = "xoauth2"
+
+	// Search backend determines where
+	// status text searches are performed.
+	SearchBackendDB          = "db"
+	SearchBackendMeilisearch = "meilisearch"
+
+	// Media thumbnail format determines the image
+	// format that attachment thumbnails are encoded as.
+	MediaThumbnailFormatJPEG = "jpeg"
+	MediaThumbnailFormatWebP = "webp"
+	MediaThumbnailFormatAVIF = "avif"
+
+	// Rate limit key determines how authenticated
+	// requests are grouped for rate limiting.
+	RateLimitKeyIP      = "ip"
+	RateLimitKeyToken   = "token"
+	RateLimitKeyAccount = "account"
+
+	// Cache backend determines where hot
+	// caches (accounts, statuses, webfinger)
+	// are additionally shared, if anywhere.
+	CacheBackendMemory = "memory"
+	CacheBackendRedis  = "redis"
+
+	// Cache invalidation bus determines how cache
+	// invalidations are shared between processes.
+	CacheInvalidationBusNone     = ""
+	CacheInvalidationBusPostgres = "postgres"
+	CacheInvalidationBusRedis    = "redis"
 )
@@ -28,18 +28,20 @@
 // Defaults contains a populated Configuration with reasonable defaults. Note that
 // if you use this, you will still need to set Host, and, if desired, ConfigPath.
 var Defaults = Configuration{
-	LogLevel:           "info",
-	LogTimestampFormat: "02/01/2006 15:04:05.000",
-	LogDbQueries:       false,
-	ApplicationName:    "gotosocial",
-	LandingPageUser:    "",
-	ConfigPath:         "",
-	Host:               "",
-	AccountDomain:      "",
-	Protocol:           "https",
-	BindAddress:        "0.0.0.0",
-	Port:               8080,
-	TrustedProxies:     []string{"127.0.0.1/32", "::1"}, // localhost
+	LogLevel:            "info",
+	LogTimestampFormat:  "02/01/2006 15:04:05.000",
+	LogDbQueries:        false,
+	LogFormat:           "logfmt",
+	ApplicationName:     "gotosocial",
+	LandingPageUser:     "",
+	ConfigPath:          "",
+	Host:                "",
+	AccountDomain:       "",
+	ExtraAccountDomains: []string{},
+	Protocol:            "https",
+	BindAddress:         "0.0.0.0",
+	Port:                8080,
+	TrustedProxies:      []string{"127.0.0.1/32", "::1"}, // localhost
 
 	DbType:                   "postgres",
 	DbAddress:                "",
@@ -54,45 +56,94 @@
 	DbSqliteSynchronous:      "NORMAL",
 	DbSqliteCacheSize:        8 * bytesize.MiB,
 	DbSqliteBusyTimeout:      time.Minute * 30,
+	DbPostgresReplicas:       []string{},
+	DbPostgresReplicaMaxLag:  5 * time.Second,
 
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceFederationMode:         InstanceFederationModeDefault,
-	InstanceFederationSpamFilter:   false,
-	InstanceExposePeers:            false,
-	InstanceExposeSuspended:        false,
-	InstanceExposeSuspendedWeb:     false,
-	InstanceDeliverToSharedInboxes: true,
-	InstanceLanguages:              make(language.Languages, 0),
+	InstanceFederationMode:                   InstanceFederationModeDefault,
+	InstanceAuthorizedFetchMode:              InstanceAuthorizedFetchModeDefault,
+	InstanceFederationSpamFilter:             false,
+	InstanceFederationSpamScoreThreshold:     0,
+	InstanceFederationSpamScoreAction:        InstanceFederationSpamScoreActionDefault,
+	InstanceFederationSpamScoreMaxMentions:   3,
+	InstanceFederationSpamScoreMaxHashtags:   5,
+	InstanceFederationSpamScoreNewAccountAge: 72 * time.Hour,
+	InstanceIntegrityProofs:                  false,
+	InstanceExposePeers:                      false,
+	InstanceExposeSuspended:                  false,
+	InstanceExposeSuspendedWeb:               false,
+	InstanceDeliverToSharedInboxes:           true,
+	InstanceLanguages:                        make(language.Languages, 0),
+	InstanceWebfingerAliasHosts:              []string{},
+	InstanceOutboxPageSize:                   40,
+	InstanceBackfillReplies:                  false,
+	InstanceBackfillRepliesMaxDepth:          3,
+	InstanceBackfillRepliesMaxStatuses:       100,
+	InstanceBackfillFollowStatuses:           20,
+	InstanceDirectoryIncludeRemote:           true,
+	InstanceNodeInfoMetadata: []string{
+		InstanceNodeInfoMetadataNodeName,
+		InstanceNodeInfoMetadataNodeDescription,
+		InstanceNodeInfoMetadataMaintainer,
+		InstanceNodeInfoMetadataFederation,
+	},
 
 	AccountsRegistrationOpen: false,
 	AccountsReasonRequired:   true,
 	AccountsAllowCustomCSS:   false,
+	AccountsAllowUserInvites: false,
 	AccountsCustomCSSLength:  10000,
+	AccountsArchiveInterval:  7,
+	AccountsDeletionGrace:    7,
+	AccountsCaptchaProvider:  "",
+	AccountsCaptchaSiteKey:   "",
+	AccountsCaptchaSecretKey: "",
+
+	AccountsFieldVerificationInterval: 24 * time.Hour,
 
-	MediaDescriptionMinChars: 0,
-	MediaDescriptionMaxChars: 1500,
-	MediaRemoteCacheDays:     7,
-	MediaLocalMaxSize:        40 * bytesize.MiB,
-	MediaRemoteMaxSize:       40 * bytesize.MiB,
-	MediaEmojiLocalMaxSize:   50 * bytesize.KiB,
-	MediaEmojiRemoteMaxSize:  100 * bytesize.KiB,
-	MediaCleanupFrom:         "00:00",        // Midnight.
-	MediaCleanupEvery:        24 * time.Hour, // 1/day.
-	MediaFfmpegPoolSize:      1,
+	MediaDescriptionMinChars:   0,
+	MediaDescriptionRequired:   false,
+	MediaDescriptionMaxChars:   1500,
+	MediaRemoteCacheDays:       7,
+	MediaLocalMaxSize:          40 * bytesize.MiB,
+	MediaRemoteMaxSize:         40 * bytesize.MiB,
+	MediaEmojiLocalMaxSize:     50 * bytesize.KiB,
+	MediaEmojiRemoteMaxSize:    100 * bytesize.KiB,
+	MediaCleanupFrom:           "00:00",        // Midnight.
+	MediaCleanupEvery:          24 * time.Hour, // 1/day.
+	MediaFfmpegPoolSize:        1,
+	MediaThumbnailFormat:       MediaThumbnailFormatJPEG,
+	MediaVideoTranscode:        false,
+	MediaVideoMaxBitrate:       4000, // 4Mbps.
+	MediaVideoMaxHeight:        1080, // 1080p.
+	MediaVideoTranscodeWorkers: 1,
 
 	StorageBackend:       "local",
 	StorageLocalBasePath: "/gotosocial/storage",
 	StorageS3UseSSL:      true,
 	StorageS3Proxy:       false,
 	StorageS3RedirectURL: "",
+	StorageAzureProxy:    false,
+	StorageGCSProxy:      false,
+
+	SearchBackend:          SearchBackendDB,
+	SearchMeilisearchIndex: "gotosocial-statuses",
 
 	StatusesMaxChars:           5000,
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
 
+	TrendsEnabled:       false,
+	TrendsRequireReview: true,
+	TrendsMinAccounts:   3,
+
+	TranslationProvider: "",
+	TranslationEndpoint: "",
+	TranslationAPIKey:   "",
+
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
 	LetsEncryptCertDir:      "/gotosocial/storage/certs",
@@ -109,6 +160,14 @@
 	OIDCClientSecret:     "",
 	OIDCScopes:           []string{oidc.ScopeOpenID, "profile", "email", "groups"},
 	OIDCLinkExisting:     false,
+	OIDCGroupsClaim:      "groups",
+	OIDCSyncRoles:        false,
+
+	LDAPEnabled:           false,
+	LDAPUserFilter:        "(|(uid={username})(mail={username}))",
+	LDAPUsernameAttribute: "uid",
+	LDAPEmailAttribute:    "mail",
+	LDAPAutoProvision:     false,
 
 	SMTPHost:               "",
 	SMTPPort:               0,
@@ -116,6 +175,12 @@
 	SMTPPassword:           "",
 	SMTPFrom:               "",
 	SMTPDiscloseRecipients: false,
+	SMTPAuthMethod:         SMTPAuthMethodPlain,
+	SMTPOAuth2TokenURL:     "",
+	SMTPOAuth2ClientID:     "",
+	SMTPOAuth2ClientSecret: "",
+	SMTPOAuth2RefreshToken: "",
+	SMTPOAuth2Scopes:       []string{},
 
 	TracingEnabled:           false,
 	TracingTransport:         "grpc",
@@ -125,18 +190,42 @@
 	MetricsEnabled:     false,
 	MetricsAuthEnabled: false,
 
+	DebugEndpointsEnabled: false,
+	DebugEndpointsToken:   "",
+
 	SyslogEnabled:  false,
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
-	AdvancedCookiesSamesite:      "lax",
-	AdvancedRateLimitRequests:    300, // 1 per second per 5 minutes
-	AdvancedRateLimitExceptions:  []string{},
-	AdvancedThrottlingMultiplier: 8, // 8 open requests per CPU
-	AdvancedThrottlingRetryAfter: time.Second * 30,
-	AdvancedSenderMultiplier:     2, // 2 senders per CPU
-	AdvancedCSPExtraURIs:         []string{},
-	AdvancedHeaderFilterMode:     RequestHeaderFilterModeDisabled,
+	LogFile:           "",
+	LogFileMaxSize:    100 * bytesize.MiB,
+	LogFileMaxAge:     0,
+	LogFileMaxBackups: 5,
+
+	DeliveryMaxRetries:           5,
+	DeliveryBackoff:              2 * time.Second,
+	DeliveryUnreachableThreshold: 5,
+	DeliveryUnreachableDuration:  time.Hour,
+	DeliveryDeadLetterRetention:  7 * 24 * time.Hour,
+	DeliveryRateLimit:            0,
+	DeliveryDereferenceRateLimit: 0,
+
+	ClusterEnabled: false,
+	ClusterNodeID:  "",
+
+	AdvancedCookiesSamesite:            "lax",
+	AdvancedRateLimitRequests:          300, // 1 per second per 5 minutes
+	AdvancedRateLimitExceptions:        []string{},
+	AdvancedRateLimitKey:               RateLimitKeyIP,
+	AdvancedRateLimitSearchRequests:    100, // stricter, searches are expensive
+	AdvancedRateLimitStreamingRequests: 600, // looser, clients reconnect often
+	AdvancedThrottlingMultiplier:       8,   // 8 open requests per CPU
+	AdvancedThrottlingRetryAfter:       time.Second * 30,
+	AdvancedSenderMultiplier:           2, // 2 senders per CPU
+	AdvancedCSPExtraURIs:               []string{},
+	AdvancedHeaderFilterMode:           RequestHeaderFilterModeDisabled,
+	AdvancedAIScraperMode:              AIScraperModeDisabled,
+	AdvancedAIScraperTarpitDelay:       time.Second * 30,
 
 	Cache: CacheConfiguration{
 		// Rough memory target that the total
@@ -195,6 +284,7 @@
 		PollVoteMemRatio:                  2,
 		PollVoteIDsMemRatio:               2,
 		ReportMemRatio:                    1,
+		RoleMemRatio:                      0.1,
 		SinBinStatusMemRatio:              0.5,
 		StatusMemRatio:                    5,
 		StatusBookmarkMemRatio:            0.5,
@@ -210,6 +300,12 @@
 		UserMuteIDsMemRatio:               3,
 		WebfingerMemRatio:                 0.1,
 		VisibilityMemRatio:                2,
+
+		// By default, all caches are
+		// kept only in process memory.
+		Backend:        CacheBackendMemory,
+		RedisKeyPrefix: "gotosocial:",
+		RedisTTL:       time.Hour,
 	},
 
 	HTTPClient: HTTPClientConfiguration{
@@ -217,6 +313,11 @@
 		BlockIPs:              make([]string, 0),
 		Timeout:               30 * time.Second,
 		TLSInsecureSkipVerify: false,
+		SOCKSProxy:            "",
+		SOCKSProxyOnionOnly:   false,
+		Proxy:                 "",
+		ProxyIncludeHosts:     []string{},
+		ProxyExcludeHosts:     []string{},
 	},
 
 	AdminMediaPruneDryRun: true,
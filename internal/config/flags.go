@@ -36,10 +36,16 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(LandingPageUserFlag(), cfg.LandingPageUser, fieldtag("LandingPageUser", "usage"))
 		cmd.PersistentFlags().String(HostFlag(), cfg.Host, fieldtag("Host", "usage"))
 		cmd.PersistentFlags().String(AccountDomainFlag(), cfg.AccountDomain, fieldtag("AccountDomain", "usage"))
+		cmd.PersistentFlags().StringSlice(ExtraAccountDomainsFlag(), cfg.ExtraAccountDomains, fieldtag("ExtraAccountDomains", "usage"))
 		cmd.PersistentFlags().String(ProtocolFlag(), cfg.Protocol, fieldtag("Protocol", "usage"))
 		cmd.PersistentFlags().String(LogLevelFlag(), cfg.LogLevel, fieldtag("LogLevel", "usage"))
 		cmd.PersistentFlags().String(LogTimestampFormatFlag(), cfg.LogTimestampFormat, fieldtag("LogTimestampFormat", "usage"))
 		cmd.PersistentFlags().Bool(LogDbQueriesFlag(), cfg.LogDbQueries, fieldtag("LogDbQueries", "usage"))
+		cmd.PersistentFlags().String(LogFormatFlag(), cfg.LogFormat, fieldtag("LogFormat", "usage"))
+		cmd.PersistentFlags().String(LogFileFlag(), cfg.LogFile, fieldtag("LogFile", "usage"))
+		cmd.PersistentFlags().Uint64(LogFileMaxSizeFlag(), uint64(cfg.LogFileMaxSize), fieldtag("LogFileMaxSize", "usage"))
+		cmd.PersistentFlags().Duration(LogFileMaxAgeFlag(), cfg.LogFileMaxAge, fieldtag("LogFileMaxAge", "usage"))
+		cmd.PersistentFlags().Int(LogFileMaxBackupsFlag(), cfg.LogFileMaxBackups, fieldtag("LogFileMaxBackups", "usage"))
 		cmd.PersistentFlags().String(ConfigPathFlag(), cfg.ConfigPath, fieldtag("ConfigPath", "usage"))
 
 		// Database
@@ -56,12 +62,19 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(DbSqliteSynchronousFlag(), cfg.DbSqliteSynchronous, fieldtag("DbSqliteSynchronous", "usage"))
 		cmd.PersistentFlags().Uint64(DbSqliteCacheSizeFlag(), uint64(cfg.DbSqliteCacheSize), fieldtag("DbSqliteCacheSize", "usage"))
 		cmd.PersistentFlags().Duration(DbSqliteBusyTimeoutFlag(), cfg.DbSqliteBusyTimeout, fieldtag("DbSqliteBusyTimeout", "usage"))
+		cmd.PersistentFlags().StringSlice(DbPostgresReplicasFlag(), cfg.DbPostgresReplicas, fieldtag("DbPostgresReplicas", "usage"))
+		cmd.PersistentFlags().Duration(DbPostgresReplicaMaxLagFlag(), cfg.DbPostgresReplicaMaxLag, fieldtag("DbPostgresReplicaMaxLag", "usage"))
 
 		// HTTPClient
 		cmd.PersistentFlags().StringSlice(HTTPClientAllowIPsFlag(), cfg.HTTPClient.AllowIPs, "no usage string")
 		cmd.PersistentFlags().StringSlice(HTTPClientBlockIPsFlag(), cfg.HTTPClient.BlockIPs, "no usage string")
 		cmd.PersistentFlags().Duration(HTTPClientTimeoutFlag(), cfg.HTTPClient.Timeout, "no usage string")
 		cmd.PersistentFlags().Bool(HTTPClientTLSInsecureSkipVerifyFlag(), cfg.HTTPClient.TLSInsecureSkipVerify, "no usage string")
+		cmd.PersistentFlags().String(HTTPClientSOCKSProxyFlag(), cfg.HTTPClient.SOCKSProxy, "no usage string")
+		cmd.PersistentFlags().Bool(HTTPClientSOCKSProxyOnionOnlyFlag(), cfg.HTTPClient.SOCKSProxyOnionOnly, "no usage string")
+		cmd.PersistentFlags().String(HTTPClientProxyFlag(), cfg.HTTPClient.Proxy, "no usage string")
+		cmd.PersistentFlags().StringSlice(HTTPClientProxyIncludeHostsFlag(), cfg.HTTPClient.ProxyIncludeHosts, "no usage string")
+		cmd.PersistentFlags().StringSlice(HTTPClientProxyExcludeHostsFlag(), cfg.HTTPClient.ProxyExcludeHosts, "no usage string")
 	})
 }
 
@@ -84,20 +97,45 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 
 		// Instance
 		cmd.Flags().String(InstanceFederationModeFlag(), cfg.InstanceFederationMode, fieldtag("InstanceFederationMode", "usage"))
+		cmd.Flags().String(InstanceAuthorizedFetchModeFlag(), cfg.InstanceAuthorizedFetchMode, fieldtag("InstanceAuthorizedFetchMode", "usage"))
 		cmd.Flags().Bool(InstanceFederationSpamFilterFlag(), cfg.InstanceFederationSpamFilter, fieldtag("InstanceFederationSpamFilter", "usage"))
+		cmd.Flags().Int(InstanceFederationSpamScoreThresholdFlag(), cfg.InstanceFederationSpamScoreThreshold, fieldtag("InstanceFederationSpamScoreThreshold", "usage"))
+		cmd.Flags().String(InstanceFederationSpamScoreActionFlag(), cfg.InstanceFederationSpamScoreAction, fieldtag("InstanceFederationSpamScoreAction", "usage"))
+		cmd.Flags().Int(InstanceFederationSpamScoreMaxMentionsFlag(), cfg.InstanceFederationSpamScoreMaxMentions, fieldtag("InstanceFederationSpamScoreMaxMentions", "usage"))
+		cmd.Flags().Int(InstanceFederationSpamScoreMaxHashtagsFlag(), cfg.InstanceFederationSpamScoreMaxHashtags, fieldtag("InstanceFederationSpamScoreMaxHashtags", "usage"))
+		cmd.Flags().Duration(InstanceFederationSpamScoreNewAccountAgeFlag(), cfg.InstanceFederationSpamScoreNewAccountAge, fieldtag("InstanceFederationSpamScoreNewAccountAge", "usage"))
+		cmd.Flags().Bool(InstanceIntegrityProofsFlag(), cfg.InstanceIntegrityProofs, fieldtag("InstanceIntegrityProofs", "usage"))
 		cmd.Flags().Bool(InstanceExposePeersFlag(), cfg.InstanceExposePeers, fieldtag("InstanceExposePeers", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
+		cmd.Flags().Bool(InstanceExposeTagRSSFlag(), cfg.InstanceExposeTagRSS, fieldtag("InstanceExposeTagRSS", "usage"))
+		cmd.Flags().String(InstanceWebSubHubURLFlag(), cfg.InstanceWebSubHubURL, fieldtag("InstanceWebSubHubURL", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
 		cmd.Flags().StringSlice(InstanceLanguagesFlag(), cfg.InstanceLanguages.TagStrs(), fieldtag("InstanceLanguages", "usage"))
+		cmd.Flags().StringSlice(InstanceWebfingerAliasHostsFlag(), cfg.InstanceWebfingerAliasHosts, fieldtag("InstanceWebfingerAliasHosts", "usage"))
+		cmd.Flags().Int(InstanceOutboxPageSizeFlag(), cfg.InstanceOutboxPageSize, fieldtag("InstanceOutboxPageSize", "usage"))
+		cmd.Flags().Bool(InstanceBackfillRepliesFlag(), cfg.InstanceBackfillReplies, fieldtag("InstanceBackfillReplies", "usage"))
+		cmd.Flags().Int(InstanceBackfillRepliesMaxDepthFlag(), cfg.InstanceBackfillRepliesMaxDepth, fieldtag("InstanceBackfillRepliesMaxDepth", "usage"))
+		cmd.Flags().Int(InstanceBackfillRepliesMaxStatusesFlag(), cfg.InstanceBackfillRepliesMaxStatuses, fieldtag("InstanceBackfillRepliesMaxStatuses", "usage"))
+		cmd.Flags().Int(InstanceBackfillFollowStatusesFlag(), cfg.InstanceBackfillFollowStatuses, fieldtag("InstanceBackfillFollowStatuses", "usage"))
+		cmd.Flags().Bool(InstanceDirectoryIncludeRemoteFlag(), cfg.InstanceDirectoryIncludeRemote, fieldtag("InstanceDirectoryIncludeRemote", "usage"))
+		cmd.Flags().StringSlice(InstanceNodeInfoMetadataFlag(), cfg.InstanceNodeInfoMetadata, fieldtag("InstanceNodeInfoMetadata", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Bool(AccountsAllowUserInvitesFlag(), cfg.AccountsAllowUserInvites, fieldtag("AccountsAllowUserInvites", "usage"))
+		cmd.Flags().Int(AccountsArchiveIntervalFlag(), cfg.AccountsArchiveInterval, fieldtag("AccountsArchiveInterval", "usage"))
+		cmd.Flags().Int(AccountsDeletionGraceFlag(), cfg.AccountsDeletionGrace, fieldtag("AccountsDeletionGrace", "usage"))
+		cmd.Flags().String(AccountsCaptchaProviderFlag(), cfg.AccountsCaptchaProvider, fieldtag("AccountsCaptchaProvider", "usage"))
+		cmd.Flags().String(AccountsCaptchaSiteKeyFlag(), cfg.AccountsCaptchaSiteKey, fieldtag("AccountsCaptchaSiteKey", "usage"))
+		cmd.Flags().String(AccountsCaptchaSecretKeyFlag(), cfg.AccountsCaptchaSecretKey, fieldtag("AccountsCaptchaSecretKey", "usage"))
+		cmd.Flags().Duration(AccountsFieldVerificationIntervalFlag(), cfg.AccountsFieldVerificationInterval, fieldtag("AccountsFieldVerificationInterval", "usage"))
 
 		// Media
 		cmd.Flags().Int(MediaDescriptionMinCharsFlag(), cfg.MediaDescriptionMinChars, fieldtag("MediaDescriptionMinChars", "usage"))
+		cmd.Flags().Bool(MediaDescriptionRequiredFlag(), cfg.MediaDescriptionRequired, fieldtag("MediaDescriptionRequired", "usage"))
 		cmd.Flags().Int(MediaDescriptionMaxCharsFlag(), cfg.MediaDescriptionMaxChars, fieldtag("MediaDescriptionMaxChars", "usage"))
 		cmd.Flags().Int(MediaRemoteCacheDaysFlag(), cfg.MediaRemoteCacheDays, fieldtag("MediaRemoteCacheDays", "usage"))
 		cmd.Flags().Uint64(MediaLocalMaxSizeFlag(), uint64(cfg.MediaLocalMaxSize), fieldtag("MediaLocalMaxSize", "usage"))
@@ -106,17 +144,33 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Uint64(MediaEmojiRemoteMaxSizeFlag(), uint64(cfg.MediaEmojiRemoteMaxSize), fieldtag("MediaEmojiRemoteMaxSize", "usage"))
 		cmd.Flags().String(MediaCleanupFromFlag(), cfg.MediaCleanupFrom, fieldtag("MediaCleanupFrom", "usage"))
 		cmd.Flags().Duration(MediaCleanupEveryFlag(), cfg.MediaCleanupEvery, fieldtag("MediaCleanupEvery", "usage"))
+		cmd.Flags().String(MediaThumbnailFormatFlag(), cfg.MediaThumbnailFormat, fieldtag("MediaThumbnailFormat", "usage"))
+		cmd.Flags().Bool(MediaVideoTranscodeFlag(), cfg.MediaVideoTranscode, fieldtag("MediaVideoTranscode", "usage"))
+		cmd.Flags().Int(MediaVideoMaxBitrateFlag(), cfg.MediaVideoMaxBitrate, fieldtag("MediaVideoMaxBitrate", "usage"))
+		cmd.Flags().Int(MediaVideoMaxHeightFlag(), cfg.MediaVideoMaxHeight, fieldtag("MediaVideoMaxHeight", "usage"))
+		cmd.Flags().Int(MediaVideoTranscodeWorkersFlag(), cfg.MediaVideoTranscodeWorkers, fieldtag("MediaVideoTranscodeWorkers", "usage"))
 
 		// Storage
 		cmd.Flags().String(StorageBackendFlag(), cfg.StorageBackend, fieldtag("StorageBackend", "usage"))
 		cmd.Flags().String(StorageLocalBasePathFlag(), cfg.StorageLocalBasePath, fieldtag("StorageLocalBasePath", "usage"))
 
+		// Search
+		cmd.Flags().String(SearchBackendFlag(), cfg.SearchBackend, fieldtag("SearchBackend", "usage"))
+
 		// Statuses
 		cmd.Flags().Int(StatusesMaxCharsFlag(), cfg.StatusesMaxChars, fieldtag("StatusesMaxChars", "usage"))
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
 
+		// Trends
+		cmd.Flags().Bool(TrendsEnabledFlag(), cfg.TrendsEnabled, fieldtag("TrendsEnabled", "usage"))
+
+		// Translation
+		cmd.Flags().String(TranslationProviderFlag(), cfg.TranslationProvider, fieldtag("TranslationProvider", "usage"))
+		cmd.Flags().String(TranslationEndpointFlag(), cfg.TranslationEndpoint, fieldtag("TranslationEndpoint", "usage"))
+		cmd.Flags().String(TranslationAPIKeyFlag(), cfg.TranslationAPIKey, fieldtag("TranslationAPIKey", "usage"))
+
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
 		cmd.Flags().Int(LetsEncryptPortFlag(), cfg.LetsEncryptPort, fieldtag("LetsEncryptPort", "usage"))
@@ -135,6 +189,21 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(OIDCClientIDFlag(), cfg.OIDCClientID, fieldtag("OIDCClientID", "usage"))
 		cmd.Flags().String(OIDCClientSecretFlag(), cfg.OIDCClientSecret, fieldtag("OIDCClientSecret", "usage"))
 		cmd.Flags().StringSlice(OIDCScopesFlag(), cfg.OIDCScopes, fieldtag("OIDCScopes", "usage"))
+		cmd.Flags().StringSlice(OIDCModeratorGroupsFlag(), cfg.OIDCModeratorGroups, fieldtag("OIDCModeratorGroups", "usage"))
+		cmd.Flags().String(OIDCGroupsClaimFlag(), cfg.OIDCGroupsClaim, fieldtag("OIDCGroupsClaim", "usage"))
+		cmd.Flags().Bool(OIDCSyncRolesFlag(), cfg.OIDCSyncRoles, fieldtag("OIDCSyncRoles", "usage"))
+
+		// LDAP
+		cmd.Flags().Bool(LDAPEnabledFlag(), cfg.LDAPEnabled, fieldtag("LDAPEnabled", "usage"))
+		cmd.Flags().String(LDAPURLFlag(), cfg.LDAPURL, fieldtag("LDAPURL", "usage"))
+		cmd.Flags().Bool(LDAPStartTLSFlag(), cfg.LDAPStartTLS, fieldtag("LDAPStartTLS", "usage"))
+		cmd.Flags().String(LDAPBindDNFlag(), cfg.LDAPBindDN, fieldtag("LDAPBindDN", "usage"))
+		cmd.Flags().String(LDAPBindPasswordFlag(), cfg.LDAPBindPassword, fieldtag("LDAPBindPassword", "usage"))
+		cmd.Flags().String(LDAPBaseDNFlag(), cfg.LDAPBaseDN, fieldtag("LDAPBaseDN", "usage"))
+		cmd.Flags().String(LDAPUserFilterFlag(), cfg.LDAPUserFilter, fieldtag("LDAPUserFilter", "usage"))
+		cmd.Flags().String(LDAPUsernameAttributeFlag(), cfg.LDAPUsernameAttribute, fieldtag("LDAPUsernameAttribute", "usage"))
+		cmd.Flags().String(LDAPEmailAttributeFlag(), cfg.LDAPEmailAttribute, fieldtag("LDAPEmailAttribute", "usage"))
+		cmd.Flags().Bool(LDAPAutoProvisionFlag(), cfg.LDAPAutoProvision, fieldtag("LDAPAutoProvision", "usage"))
 
 		// SMTP
 		cmd.Flags().String(SMTPHostFlag(), cfg.SMTPHost, fieldtag("SMTPHost", "usage"))
@@ -143,21 +212,45 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SMTPPasswordFlag(), cfg.SMTPPassword, fieldtag("SMTPPassword", "usage"))
 		cmd.Flags().String(SMTPFromFlag(), cfg.SMTPFrom, fieldtag("SMTPFrom", "usage"))
 		cmd.Flags().Bool(SMTPDiscloseRecipientsFlag(), cfg.SMTPDiscloseRecipients, fieldtag("SMTPDiscloseRecipients", "usage"))
+		cmd.Flags().String(SMTPAuthMethodFlag(), cfg.SMTPAuthMethod, fieldtag("SMTPAuthMethod", "usage"))
+		cmd.Flags().String(SMTPOAuth2TokenURLFlag(), cfg.SMTPOAuth2TokenURL, fieldtag("SMTPOAuth2TokenURL", "usage"))
+		cmd.Flags().String(SMTPOAuth2ClientIDFlag(), cfg.SMTPOAuth2ClientID, fieldtag("SMTPOAuth2ClientID", "usage"))
+		cmd.Flags().String(SMTPOAuth2ClientSecretFlag(), cfg.SMTPOAuth2ClientSecret, fieldtag("SMTPOAuth2ClientSecret", "usage"))
+		cmd.Flags().String(SMTPOAuth2RefreshTokenFlag(), cfg.SMTPOAuth2RefreshToken, fieldtag("SMTPOAuth2RefreshToken", "usage"))
+		cmd.Flags().StringSlice(SMTPOAuth2ScopesFlag(), cfg.SMTPOAuth2Scopes, fieldtag("SMTPOAuth2Scopes", "usage"))
 
 		// Syslog
 		cmd.Flags().Bool(SyslogEnabledFlag(), cfg.SyslogEnabled, fieldtag("SyslogEnabled", "usage"))
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
 		cmd.Flags().String(SyslogAddressFlag(), cfg.SyslogAddress, fieldtag("SyslogAddress", "usage"))
 
+		// Delivery
+		cmd.Flags().Int(DeliveryMaxRetriesFlag(), cfg.DeliveryMaxRetries, fieldtag("DeliveryMaxRetries", "usage"))
+		cmd.Flags().Duration(DeliveryBackoffFlag(), cfg.DeliveryBackoff, fieldtag("DeliveryBackoff", "usage"))
+		cmd.Flags().Int(DeliveryUnreachableThresholdFlag(), cfg.DeliveryUnreachableThreshold, fieldtag("DeliveryUnreachableThreshold", "usage"))
+		cmd.Flags().Duration(DeliveryUnreachableDurationFlag(), cfg.DeliveryUnreachableDuration, fieldtag("DeliveryUnreachableDuration", "usage"))
+		cmd.Flags().Duration(DeliveryDeadLetterRetentionFlag(), cfg.DeliveryDeadLetterRetention, fieldtag("DeliveryDeadLetterRetention", "usage"))
+		cmd.Flags().Int(DeliveryRateLimitFlag(), cfg.DeliveryRateLimit, fieldtag("DeliveryRateLimit", "usage"))
+		cmd.Flags().Int(DeliveryDereferenceRateLimitFlag(), cfg.DeliveryDereferenceRateLimit, fieldtag("DeliveryDereferenceRateLimit", "usage"))
+
+		// Cluster
+		cmd.Flags().Bool(ClusterEnabledFlag(), cfg.ClusterEnabled, fieldtag("ClusterEnabled", "usage"))
+		cmd.Flags().String(ClusterNodeIDFlag(), cfg.ClusterNodeID, fieldtag("ClusterNodeID", "usage"))
+
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
 		cmd.Flags().StringSlice(AdvancedRateLimitExceptionsFlag(), cfg.AdvancedRateLimitExceptions, fieldtag("AdvancedRateLimitExceptions", "usage"))
+		cmd.Flags().String(AdvancedRateLimitKeyFlag(), cfg.AdvancedRateLimitKey, fieldtag("AdvancedRateLimitKey", "usage"))
+		cmd.Flags().Int(AdvancedRateLimitSearchRequestsFlag(), cfg.AdvancedRateLimitSearchRequests, fieldtag("AdvancedRateLimitSearchRequests", "usage"))
+		cmd.Flags().Int(AdvancedRateLimitStreamingRequestsFlag(), cfg.AdvancedRateLimitStreamingRequests, fieldtag("AdvancedRateLimitStreamingRequests", "usage"))
 		cmd.Flags().Int(AdvancedThrottlingMultiplierFlag(), cfg.AdvancedThrottlingMultiplier, fieldtag("AdvancedThrottlingMultiplier", "usage"))
 		cmd.Flags().Duration(AdvancedThrottlingRetryAfterFlag(), cfg.AdvancedThrottlingRetryAfter, fieldtag("AdvancedThrottlingRetryAfter", "usage"))
 		cmd.Flags().Int(AdvancedSenderMultiplierFlag(), cfg.AdvancedSenderMultiplier, fieldtag("AdvancedSenderMultiplier", "usage"))
 		cmd.Flags().StringSlice(AdvancedCSPExtraURIsFlag(), cfg.AdvancedCSPExtraURIs, fieldtag("AdvancedCSPExtraURIs", "usage"))
 		cmd.Flags().String(AdvancedHeaderFilterModeFlag(), cfg.AdvancedHeaderFilterMode, fieldtag("AdvancedHeaderFilterMode", "usage"))
+		cmd.Flags().String(AdvancedAIScraperModeFlag(), cfg.AdvancedAIScraperMode, fieldtag("AdvancedAIScraperMode", "usage"))
+		cmd.Flags().Duration(AdvancedAIScraperTarpitDelayFlag(), cfg.AdvancedAIScraperTarpitDelay, fieldtag("AdvancedAIScraperTarpitDelay", "usage"))
 
 		cmd.Flags().String(RequestIDHeaderFlag(), cfg.RequestIDHeader, fieldtag("RequestIDHeader", "usage"))
 	})
@@ -197,6 +290,15 @@ func AddAdminAccountCreate(cmd *cobra.Command) {
 	}
 }
 
+// AddAdminAccountDomain attaches flags pertaining to setting an account's domain.
+func AddAdminAccountDomain(cmd *cobra.Command) {
+	AddAdminAccount(cmd)
+
+	name := AdminAccountDomainFlag()
+	usage := fieldtag("AdminAccountDomain", "usage")
+	cmd.Flags().String(name, "", usage)
+}
+
 // AddAdminTrans attaches flags pertaining to import/export commands.
 func AddAdminTrans(cmd *cobra.Command) {
 	name := AdminTransPathFlag()
@@ -224,3 +326,18 @@ func AddAdminMediaPrune(cmd *cobra.Command) {
 	usage := fieldtag("AdminMediaPruneDryRun", "usage")
 	cmd.Flags().Bool(name, true, usage)
 }
+
+// AddAdminMediaRegenerate attaches flags pertaining to media regenerate commands.
+func AddAdminMediaRegenerate(cmd *cobra.Command) {
+	account := AdminMediaRegenerateAccountFlag()
+	accountUsage := fieldtag("AdminMediaRegenerateAccount", "usage")
+	cmd.Flags().String(account, "", accountUsage)
+
+	since := AdminMediaRegenerateSinceFlag()
+	sinceUsage := fieldtag("AdminMediaRegenerateSince", "usage")
+	cmd.Flags().String(since, "", sinceUsage)
+
+	until := AdminMediaRegenerateUntilFlag()
+	untilUsage := fieldtag("AdminMediaRegenerateUntil", "usage")
+	cmd.Flags().String(until, "", untilUsage)
+}
@@ -2,7 +2,7 @@
 // GoToSocial
 // Copyright (C) GoToSocial Authors admin@gotosocial.org
 // SPDX-License-Identifier: AGPL-3.0-or-later
-//
+// 
 // This program is free software: you can redistribute it and/or modify
 // it under the terms of the GNU Affero General Public License as published by
 // the Free Software Foundation, either version 3 of the License, or
@@ -125,6 +125,31 @@ func GetLogClientIP() bool { return global.GetLogClientIP() }
 // SetLogClientIP safely sets the value for global configuration 'LogClientIP' field
 func SetLogClientIP(v bool) { global.SetLogClientIP(v) }
 
+// GetLogFormat safely fetches the Configuration value for state's 'LogFormat' field
+func (st *ConfigState) GetLogFormat() (v string) {
+	st.mutex.RLock()
+	v = st.config.LogFormat
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLogFormat safely sets the Configuration value for state's 'LogFormat' field
+func (st *ConfigState) SetLogFormat(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LogFormat = v
+	st.reloadToViper()
+}
+
+// LogFormatFlag returns the flag name for the 'LogFormat' field
+func LogFormatFlag() string { return "log-format" }
+
+// GetLogFormat safely fetches the value for global configuration 'LogFormat' field
+func GetLogFormat() string { return global.GetLogFormat() }
+
+// SetLogFormat safely sets the value for global configuration 'LogFormat' field
+func SetLogFormat(v string) { global.SetLogFormat(v) }
+
 // GetApplicationName safely fetches the Configuration value for state's 'ApplicationName' field
 func (st *ConfigState) GetApplicationName() (v string) {
 	st.mutex.RLock()
@@ -250,6 +275,31 @@ func GetAccountDomain() string { return global.GetAccountDomain() }
 // SetAccountDomain safely sets the value for global configuration 'AccountDomain' field
 func SetAccountDomain(v string) { global.SetAccountDomain(v) }
 
+// GetExtraAccountDomains safely fetches the Configuration value for state's 'ExtraAccountDomains' field
+func (st *ConfigState) GetExtraAccountDomains() (v []string) {
+	st.mutex.RLock()
+	v = st.config.ExtraAccountDomains
+	st.mutex.RUnlock()
+	return
+}
+
+// SetExtraAccountDomains safely sets the Configuration value for state's 'ExtraAccountDomains' field
+func (st *ConfigState) SetExtraAccountDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.ExtraAccountDomains = v
+	st.reloadToViper()
+}
+
+// ExtraAccountDomainsFlag returns the flag name for the 'ExtraAccountDomains' field
+func ExtraAccountDomainsFlag() string { return "extra-account-domains" }
+
+// GetExtraAccountDomains safely fetches the value for global configuration 'ExtraAccountDomains' field
+func GetExtraAccountDomains() []string { return global.GetExtraAccountDomains() }
+
+// SetExtraAccountDomains safely sets the value for global configuration 'ExtraAccountDomains' field
+func SetExtraAccountDomains(v []string) { global.SetExtraAccountDomains(v) }
+
 // GetProtocol safely fetches the Configuration value for state's 'Protocol' field
 func (st *ConfigState) GetProtocol() (v string) {
 	st.mutex.RLock()
@@ -725,6 +775,56 @@ func GetDbPostgresConnectionString() string { return global.GetDbPostgresConnect
 // SetDbPostgresConnectionString safely sets the value for global configuration 'DbPostgresConnectionString' field
 func SetDbPostgresConnectionString(v string) { global.SetDbPostgresConnectionString(v) }
 
+// GetDbPostgresReplicas safely fetches the Configuration value for state's 'DbPostgresReplicas' field
+func (st *ConfigState) GetDbPostgresReplicas() (v []string) {
+	st.mutex.RLock()
+	v = st.config.DbPostgresReplicas
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbPostgresReplicas safely sets the Configuration value for state's 'DbPostgresReplicas' field
+func (st *ConfigState) SetDbPostgresReplicas(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbPostgresReplicas = v
+	st.reloadToViper()
+}
+
+// DbPostgresReplicasFlag returns the flag name for the 'DbPostgresReplicas' field
+func DbPostgresReplicasFlag() string { return "db-postgres-replicas" }
+
+// GetDbPostgresReplicas safely fetches the value for global configuration 'DbPostgresReplicas' field
+func GetDbPostgresReplicas() []string { return global.GetDbPostgresReplicas() }
+
+// SetDbPostgresReplicas safely sets the value for global configuration 'DbPostgresReplicas' field
+func SetDbPostgresReplicas(v []string) { global.SetDbPostgresReplicas(v) }
+
+// GetDbPostgresReplicaMaxLag safely fetches the Configuration value for state's 'DbPostgresReplicaMaxLag' field
+func (st *ConfigState) GetDbPostgresReplicaMaxLag() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.DbPostgresReplicaMaxLag
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbPostgresReplicaMaxLag safely sets the Configuration value for state's 'DbPostgresReplicaMaxLag' field
+func (st *ConfigState) SetDbPostgresReplicaMaxLag(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbPostgresReplicaMaxLag = v
+	st.reloadToViper()
+}
+
+// DbPostgresReplicaMaxLagFlag returns the flag name for the 'DbPostgresReplicaMaxLag' field
+func DbPostgresReplicaMaxLagFlag() string { return "db-postgres-replica-max-lag" }
+
+// GetDbPostgresReplicaMaxLag safely fetches the value for global configuration 'DbPostgresReplicaMaxLag' field
+func GetDbPostgresReplicaMaxLag() time.Duration { return global.GetDbPostgresReplicaMaxLag() }
+
+// SetDbPostgresReplicaMaxLag safely sets the value for global configuration 'DbPostgresReplicaMaxLag' field
+func SetDbPostgresReplicaMaxLag(v time.Duration) { global.SetDbPostgresReplicaMaxLag(v) }
+
 // GetWebTemplateBaseDir safely fetches the Configuration value for state's 'WebTemplateBaseDir' field
 func (st *ConfigState) GetWebTemplateBaseDir() (v string) {
 	st.mutex.RLock()
@@ -800,6 +900,31 @@ func GetInstanceFederationMode() string { return global.GetInstanceFederationMod
 // SetInstanceFederationMode safely sets the value for global configuration 'InstanceFederationMode' field
 func SetInstanceFederationMode(v string) { global.SetInstanceFederationMode(v) }
 
+// GetInstanceAuthorizedFetchMode safely fetches the Configuration value for state's 'InstanceAuthorizedFetchMode' field
+func (st *ConfigState) GetInstanceAuthorizedFetchMode() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstanceAuthorizedFetchMode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceAuthorizedFetchMode safely sets the Configuration value for state's 'InstanceAuthorizedFetchMode' field
+func (st *ConfigState) SetInstanceAuthorizedFetchMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceAuthorizedFetchMode = v
+	st.reloadToViper()
+}
+
+// InstanceAuthorizedFetchModeFlag returns the flag name for the 'InstanceAuthorizedFetchMode' field
+func InstanceAuthorizedFetchModeFlag() string { return "instance-authorized-fetch-mode" }
+
+// GetInstanceAuthorizedFetchMode safely fetches the value for global configuration 'InstanceAuthorizedFetchMode' field
+func GetInstanceAuthorizedFetchMode() string { return global.GetInstanceAuthorizedFetchMode() }
+
+// SetInstanceAuthorizedFetchMode safely sets the value for global configuration 'InstanceAuthorizedFetchMode' field
+func SetInstanceAuthorizedFetchMode(v string) { global.SetInstanceAuthorizedFetchMode(v) }
+
 // GetInstanceFederationSpamFilter safely fetches the Configuration value for state's 'InstanceFederationSpamFilter' field
 func (st *ConfigState) GetInstanceFederationSpamFilter() (v bool) {
 	st.mutex.RLock()
@@ -825,6 +950,156 @@ func GetInstanceFederationSpamFilter() bool { return global.GetInstanceFederatio
 // SetInstanceFederationSpamFilter safely sets the value for global configuration 'InstanceFederationSpamFilter' field
 func SetInstanceFederationSpamFilter(v bool) { global.SetInstanceFederationSpamFilter(v) }
 
+// GetInstanceFederationSpamScoreThreshold safely fetches the Configuration value for state's 'InstanceFederationSpamScoreThreshold' field
+func (st *ConfigState) GetInstanceFederationSpamScoreThreshold() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationSpamScoreThreshold
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationSpamScoreThreshold safely sets the Configuration value for state's 'InstanceFederationSpamScoreThreshold' field
+func (st *ConfigState) SetInstanceFederationSpamScoreThreshold(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationSpamScoreThreshold = v
+	st.reloadToViper()
+}
+
+// InstanceFederationSpamScoreThresholdFlag returns the flag name for the 'InstanceFederationSpamScoreThreshold' field
+func InstanceFederationSpamScoreThresholdFlag() string { return "instance-federation-spam-score-threshold" }
+
+// GetInstanceFederationSpamScoreThreshold safely fetches the value for global configuration 'InstanceFederationSpamScoreThreshold' field
+func GetInstanceFederationSpamScoreThreshold() int { return global.GetInstanceFederationSpamScoreThreshold() }
+
+// SetInstanceFederationSpamScoreThreshold safely sets the value for global configuration 'InstanceFederationSpamScoreThreshold' field
+func SetInstanceFederationSpamScoreThreshold(v int) { global.SetInstanceFederationSpamScoreThreshold(v) }
+
+// GetInstanceFederationSpamScoreAction safely fetches the Configuration value for state's 'InstanceFederationSpamScoreAction' field
+func (st *ConfigState) GetInstanceFederationSpamScoreAction() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationSpamScoreAction
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationSpamScoreAction safely sets the Configuration value for state's 'InstanceFederationSpamScoreAction' field
+func (st *ConfigState) SetInstanceFederationSpamScoreAction(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationSpamScoreAction = v
+	st.reloadToViper()
+}
+
+// InstanceFederationSpamScoreActionFlag returns the flag name for the 'InstanceFederationSpamScoreAction' field
+func InstanceFederationSpamScoreActionFlag() string { return "instance-federation-spam-score-action" }
+
+// GetInstanceFederationSpamScoreAction safely fetches the value for global configuration 'InstanceFederationSpamScoreAction' field
+func GetInstanceFederationSpamScoreAction() string { return global.GetInstanceFederationSpamScoreAction() }
+
+// SetInstanceFederationSpamScoreAction safely sets the value for global configuration 'InstanceFederationSpamScoreAction' field
+func SetInstanceFederationSpamScoreAction(v string) { global.SetInstanceFederationSpamScoreAction(v) }
+
+// GetInstanceFederationSpamScoreMaxMentions safely fetches the Configuration value for state's 'InstanceFederationSpamScoreMaxMentions' field
+func (st *ConfigState) GetInstanceFederationSpamScoreMaxMentions() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationSpamScoreMaxMentions
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationSpamScoreMaxMentions safely sets the Configuration value for state's 'InstanceFederationSpamScoreMaxMentions' field
+func (st *ConfigState) SetInstanceFederationSpamScoreMaxMentions(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationSpamScoreMaxMentions = v
+	st.reloadToViper()
+}
+
+// InstanceFederationSpamScoreMaxMentionsFlag returns the flag name for the 'InstanceFederationSpamScoreMaxMentions' field
+func InstanceFederationSpamScoreMaxMentionsFlag() string { return "instance-federation-spam-score-max-mentions" }
+
+// GetInstanceFederationSpamScoreMaxMentions safely fetches the value for global configuration 'InstanceFederationSpamScoreMaxMentions' field
+func GetInstanceFederationSpamScoreMaxMentions() int { return global.GetInstanceFederationSpamScoreMaxMentions() }
+
+// SetInstanceFederationSpamScoreMaxMentions safely sets the value for global configuration 'InstanceFederationSpamScoreMaxMentions' field
+func SetInstanceFederationSpamScoreMaxMentions(v int) { global.SetInstanceFederationSpamScoreMaxMentions(v) }
+
+// GetInstanceFederationSpamScoreMaxHashtags safely fetches the Configuration value for state's 'InstanceFederationSpamScoreMaxHashtags' field
+func (st *ConfigState) GetInstanceFederationSpamScoreMaxHashtags() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationSpamScoreMaxHashtags
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationSpamScoreMaxHashtags safely sets the Configuration value for state's 'InstanceFederationSpamScoreMaxHashtags' field
+func (st *ConfigState) SetInstanceFederationSpamScoreMaxHashtags(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationSpamScoreMaxHashtags = v
+	st.reloadToViper()
+}
+
+// InstanceFederationSpamScoreMaxHashtagsFlag returns the flag name for the 'InstanceFederationSpamScoreMaxHashtags' field
+func InstanceFederationSpamScoreMaxHashtagsFlag() string { return "instance-federation-spam-score-max-hashtags" }
+
+// GetInstanceFederationSpamScoreMaxHashtags safely fetches the value for global configuration 'InstanceFederationSpamScoreMaxHashtags' field
+func GetInstanceFederationSpamScoreMaxHashtags() int { return global.GetInstanceFederationSpamScoreMaxHashtags() }
+
+// SetInstanceFederationSpamScoreMaxHashtags safely sets the value for global configuration 'InstanceFederationSpamScoreMaxHashtags' field
+func SetInstanceFederationSpamScoreMaxHashtags(v int) { global.SetInstanceFederationSpamScoreMaxHashtags(v) }
+
+// GetInstanceFederationSpamScoreNewAccountAge safely fetches the Configuration value for state's 'InstanceFederationSpamScoreNewAccountAge' field
+func (st *ConfigState) GetInstanceFederationSpamScoreNewAccountAge() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationSpamScoreNewAccountAge
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationSpamScoreNewAccountAge safely sets the Configuration value for state's 'InstanceFederationSpamScoreNewAccountAge' field
+func (st *ConfigState) SetInstanceFederationSpamScoreNewAccountAge(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationSpamScoreNewAccountAge = v
+	st.reloadToViper()
+}
+
+// InstanceFederationSpamScoreNewAccountAgeFlag returns the flag name for the 'InstanceFederationSpamScoreNewAccountAge' field
+func InstanceFederationSpamScoreNewAccountAgeFlag() string { return "instance-federation-spam-score-new-account-age" }
+
+// GetInstanceFederationSpamScoreNewAccountAge safely fetches the value for global configuration 'InstanceFederationSpamScoreNewAccountAge' field
+func GetInstanceFederationSpamScoreNewAccountAge() time.Duration { return global.GetInstanceFederationSpamScoreNewAccountAge() }
+
+// SetInstanceFederationSpamScoreNewAccountAge safely sets the value for global configuration 'InstanceFederationSpamScoreNewAccountAge' field
+func SetInstanceFederationSpamScoreNewAccountAge(v time.Duration) { global.SetInstanceFederationSpamScoreNewAccountAge(v) }
+
+// GetInstanceIntegrityProofs safely fetches the Configuration value for state's 'InstanceIntegrityProofs' field
+func (st *ConfigState) GetInstanceIntegrityProofs() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceIntegrityProofs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceIntegrityProofs safely sets the Configuration value for state's 'InstanceIntegrityProofs' field
+func (st *ConfigState) SetInstanceIntegrityProofs(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceIntegrityProofs = v
+	st.reloadToViper()
+}
+
+// InstanceIntegrityProofsFlag returns the flag name for the 'InstanceIntegrityProofs' field
+func InstanceIntegrityProofsFlag() string { return "instance-integrity-proofs" }
+
+// GetInstanceIntegrityProofs safely fetches the value for global configuration 'InstanceIntegrityProofs' field
+func GetInstanceIntegrityProofs() bool { return global.GetInstanceIntegrityProofs() }
+
+// SetInstanceIntegrityProofs safely sets the value for global configuration 'InstanceIntegrityProofs' field
+func SetInstanceIntegrityProofs(v bool) { global.SetInstanceIntegrityProofs(v) }
+
 // GetInstanceExposePeers safely fetches the Configuration value for state's 'InstanceExposePeers' field
 func (st *ConfigState) GetInstanceExposePeers() (v bool) {
 	st.mutex.RLock()
@@ -925,6 +1200,56 @@ func GetInstanceExposePublicTimeline() bool { return global.GetInstanceExposePub
 // SetInstanceExposePublicTimeline safely sets the value for global configuration 'InstanceExposePublicTimeline' field
 func SetInstanceExposePublicTimeline(v bool) { global.SetInstanceExposePublicTimeline(v) }
 
+// GetInstanceExposeTagRSS safely fetches the Configuration value for state's 'InstanceExposeTagRSS' field
+func (st *ConfigState) GetInstanceExposeTagRSS() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceExposeTagRSS
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceExposeTagRSS safely sets the Configuration value for state's 'InstanceExposeTagRSS' field
+func (st *ConfigState) SetInstanceExposeTagRSS(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceExposeTagRSS = v
+	st.reloadToViper()
+}
+
+// InstanceExposeTagRSSFlag returns the flag name for the 'InstanceExposeTagRSS' field
+func InstanceExposeTagRSSFlag() string { return "instance-expose-tag-rss" }
+
+// GetInstanceExposeTagRSS safely fetches the value for global configuration 'InstanceExposeTagRSS' field
+func GetInstanceExposeTagRSS() bool { return global.GetInstanceExposeTagRSS() }
+
+// SetInstanceExposeTagRSS safely sets the value for global configuration 'InstanceExposeTagRSS' field
+func SetInstanceExposeTagRSS(v bool) { global.SetInstanceExposeTagRSS(v) }
+
+// GetInstanceWebSubHubURL safely fetches the Configuration value for state's 'InstanceWebSubHubURL' field
+func (st *ConfigState) GetInstanceWebSubHubURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstanceWebSubHubURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceWebSubHubURL safely sets the Configuration value for state's 'InstanceWebSubHubURL' field
+func (st *ConfigState) SetInstanceWebSubHubURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceWebSubHubURL = v
+	st.reloadToViper()
+}
+
+// InstanceWebSubHubURLFlag returns the flag name for the 'InstanceWebSubHubURL' field
+func InstanceWebSubHubURLFlag() string { return "instance-websub-hub-url" }
+
+// GetInstanceWebSubHubURL safely fetches the value for global configuration 'InstanceWebSubHubURL' field
+func GetInstanceWebSubHubURL() string { return global.GetInstanceWebSubHubURL() }
+
+// SetInstanceWebSubHubURL safely sets the value for global configuration 'InstanceWebSubHubURL' field
+func SetInstanceWebSubHubURL(v string) { global.SetInstanceWebSubHubURL(v) }
+
 // GetInstanceDeliverToSharedInboxes safely fetches the Configuration value for state's 'InstanceDeliverToSharedInboxes' field
 func (st *ConfigState) GetInstanceDeliverToSharedInboxes() (v bool) {
 	st.mutex.RLock()
@@ -1000,560 +1325,1085 @@ func GetInstanceLanguages() language.Languages { return global.GetInstanceLangua
 // SetInstanceLanguages safely sets the value for global configuration 'InstanceLanguages' field
 func SetInstanceLanguages(v language.Languages) { global.SetInstanceLanguages(v) }
 
-// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
+// GetInstanceWebfingerAliasHosts safely fetches the Configuration value for state's 'InstanceWebfingerAliasHosts' field
+func (st *ConfigState) GetInstanceWebfingerAliasHosts() (v []string) {
 	st.mutex.RLock()
-	v = st.config.AccountsRegistrationOpen
+	v = st.config.InstanceWebfingerAliasHosts
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
+// SetInstanceWebfingerAliasHosts safely sets the Configuration value for state's 'InstanceWebfingerAliasHosts' field
+func (st *ConfigState) SetInstanceWebfingerAliasHosts(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsRegistrationOpen = v
+	st.config.InstanceWebfingerAliasHosts = v
 	st.reloadToViper()
 }
 
-// AccountsRegistrationOpenFlag returns the flag name for the 'AccountsRegistrationOpen' field
-func AccountsRegistrationOpenFlag() string { return "accounts-registration-open" }
+// InstanceWebfingerAliasHostsFlag returns the flag name for the 'InstanceWebfingerAliasHosts' field
+func InstanceWebfingerAliasHostsFlag() string { return "instance-webfinger-alias-hosts" }
 
-// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
-func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
+// GetInstanceWebfingerAliasHosts safely fetches the value for global configuration 'InstanceWebfingerAliasHosts' field
+func GetInstanceWebfingerAliasHosts() []string { return global.GetInstanceWebfingerAliasHosts() }
 
-// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
-func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
+// SetInstanceWebfingerAliasHosts safely sets the value for global configuration 'InstanceWebfingerAliasHosts' field
+func SetInstanceWebfingerAliasHosts(v []string) { global.SetInstanceWebfingerAliasHosts(v) }
 
-// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
+// GetInstanceOutboxPageSize safely fetches the Configuration value for state's 'InstanceOutboxPageSize' field
+func (st *ConfigState) GetInstanceOutboxPageSize() (v int) {
 	st.mutex.RLock()
-	v = st.config.AccountsReasonRequired
+	v = st.config.InstanceOutboxPageSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) SetAccountsReasonRequired(v bool) {
+// SetInstanceOutboxPageSize safely sets the Configuration value for state's 'InstanceOutboxPageSize' field
+func (st *ConfigState) SetInstanceOutboxPageSize(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsReasonRequired = v
+	st.config.InstanceOutboxPageSize = v
 	st.reloadToViper()
 }
 
-// AccountsReasonRequiredFlag returns the flag name for the 'AccountsReasonRequired' field
-func AccountsReasonRequiredFlag() string { return "accounts-reason-required" }
+// InstanceOutboxPageSizeFlag returns the flag name for the 'InstanceOutboxPageSize' field
+func InstanceOutboxPageSizeFlag() string { return "instance-outbox-page-size" }
 
-// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
-func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
+// GetInstanceOutboxPageSize safely fetches the value for global configuration 'InstanceOutboxPageSize' field
+func GetInstanceOutboxPageSize() int { return global.GetInstanceOutboxPageSize() }
 
-// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
-func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
+// SetInstanceOutboxPageSize safely sets the value for global configuration 'InstanceOutboxPageSize' field
+func SetInstanceOutboxPageSize(v int) { global.SetInstanceOutboxPageSize(v) }
 
-// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
+// GetInstanceBackfillReplies safely fetches the Configuration value for state's 'InstanceBackfillReplies' field
+func (st *ConfigState) GetInstanceBackfillReplies() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsAllowCustomCSS
+	v = st.config.InstanceBackfillReplies
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
+// SetInstanceBackfillReplies safely sets the Configuration value for state's 'InstanceBackfillReplies' field
+func (st *ConfigState) SetInstanceBackfillReplies(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsAllowCustomCSS = v
+	st.config.InstanceBackfillReplies = v
 	st.reloadToViper()
 }
 
-// AccountsAllowCustomCSSFlag returns the flag name for the 'AccountsAllowCustomCSS' field
-func AccountsAllowCustomCSSFlag() string { return "accounts-allow-custom-css" }
+// InstanceBackfillRepliesFlag returns the flag name for the 'InstanceBackfillReplies' field
+func InstanceBackfillRepliesFlag() string { return "instance-backfill-replies" }
 
-// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
-func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
+// GetInstanceBackfillReplies safely fetches the value for global configuration 'InstanceBackfillReplies' field
+func GetInstanceBackfillReplies() bool { return global.GetInstanceBackfillReplies() }
 
-// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
-func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
+// SetInstanceBackfillReplies safely sets the value for global configuration 'InstanceBackfillReplies' field
+func SetInstanceBackfillReplies(v bool) { global.SetInstanceBackfillReplies(v) }
 
-// GetAccountsCustomCSSLength safely fetches the Configuration value for state's 'AccountsCustomCSSLength' field
-func (st *ConfigState) GetAccountsCustomCSSLength() (v int) {
+// GetInstanceBackfillRepliesMaxDepth safely fetches the Configuration value for state's 'InstanceBackfillRepliesMaxDepth' field
+func (st *ConfigState) GetInstanceBackfillRepliesMaxDepth() (v int) {
 	st.mutex.RLock()
-	v = st.config.AccountsCustomCSSLength
+	v = st.config.InstanceBackfillRepliesMaxDepth
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsCustomCSSLength safely sets the Configuration value for state's 'AccountsCustomCSSLength' field
-func (st *ConfigState) SetAccountsCustomCSSLength(v int) {
+// SetInstanceBackfillRepliesMaxDepth safely sets the Configuration value for state's 'InstanceBackfillRepliesMaxDepth' field
+func (st *ConfigState) SetInstanceBackfillRepliesMaxDepth(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsCustomCSSLength = v
+	st.config.InstanceBackfillRepliesMaxDepth = v
 	st.reloadToViper()
 }
 
-// AccountsCustomCSSLengthFlag returns the flag name for the 'AccountsCustomCSSLength' field
-func AccountsCustomCSSLengthFlag() string { return "accounts-custom-css-length" }
+// InstanceBackfillRepliesMaxDepthFlag returns the flag name for the 'InstanceBackfillRepliesMaxDepth' field
+func InstanceBackfillRepliesMaxDepthFlag() string { return "instance-backfill-replies-max-depth" }
 
-// GetAccountsCustomCSSLength safely fetches the value for global configuration 'AccountsCustomCSSLength' field
-func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength() }
+// GetInstanceBackfillRepliesMaxDepth safely fetches the value for global configuration 'InstanceBackfillRepliesMaxDepth' field
+func GetInstanceBackfillRepliesMaxDepth() int { return global.GetInstanceBackfillRepliesMaxDepth() }
 
-// SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
-func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
+// SetInstanceBackfillRepliesMaxDepth safely sets the value for global configuration 'InstanceBackfillRepliesMaxDepth' field
+func SetInstanceBackfillRepliesMaxDepth(v int) { global.SetInstanceBackfillRepliesMaxDepth(v) }
 
-// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
-func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+// GetInstanceBackfillRepliesMaxStatuses safely fetches the Configuration value for state's 'InstanceBackfillRepliesMaxStatuses' field
+func (st *ConfigState) GetInstanceBackfillRepliesMaxStatuses() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaDescriptionMinChars
+	v = st.config.InstanceBackfillRepliesMaxStatuses
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'MediaDescriptionMinChars' field
-func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+// SetInstanceBackfillRepliesMaxStatuses safely sets the Configuration value for state's 'InstanceBackfillRepliesMaxStatuses' field
+func (st *ConfigState) SetInstanceBackfillRepliesMaxStatuses(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaDescriptionMinChars = v
+	st.config.InstanceBackfillRepliesMaxStatuses = v
 	st.reloadToViper()
 }
 
-// MediaDescriptionMinCharsFlag returns the flag name for the 'MediaDescriptionMinChars' field
-func MediaDescriptionMinCharsFlag() string { return "media-description-min-chars" }
+// InstanceBackfillRepliesMaxStatusesFlag returns the flag name for the 'InstanceBackfillRepliesMaxStatuses' field
+func InstanceBackfillRepliesMaxStatusesFlag() string { return "instance-backfill-replies-max-statuses" }
 
-// GetMediaDescriptionMinChars safely fetches the value for global configuration 'MediaDescriptionMinChars' field
-func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+// GetInstanceBackfillRepliesMaxStatuses safely fetches the value for global configuration 'InstanceBackfillRepliesMaxStatuses' field
+func GetInstanceBackfillRepliesMaxStatuses() int { return global.GetInstanceBackfillRepliesMaxStatuses() }
 
-// SetMediaDescriptionMinChars safely sets the value for global configuration 'MediaDescriptionMinChars' field
-func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+// SetInstanceBackfillRepliesMaxStatuses safely sets the value for global configuration 'InstanceBackfillRepliesMaxStatuses' field
+func SetInstanceBackfillRepliesMaxStatuses(v int) { global.SetInstanceBackfillRepliesMaxStatuses(v) }
 
-// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'MediaDescriptionMaxChars' field
-func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+// GetInstanceBackfillFollowStatuses safely fetches the Configuration value for state's 'InstanceBackfillFollowStatuses' field
+func (st *ConfigState) GetInstanceBackfillFollowStatuses() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaDescriptionMaxChars
+	v = st.config.InstanceBackfillFollowStatuses
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'MediaDescriptionMaxChars' field
-func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+// SetInstanceBackfillFollowStatuses safely sets the Configuration value for state's 'InstanceBackfillFollowStatuses' field
+func (st *ConfigState) SetInstanceBackfillFollowStatuses(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaDescriptionMaxChars = v
+	st.config.InstanceBackfillFollowStatuses = v
 	st.reloadToViper()
 }
 
-// MediaDescriptionMaxCharsFlag returns the flag name for the 'MediaDescriptionMaxChars' field
-func MediaDescriptionMaxCharsFlag() string { return "media-description-max-chars" }
+// InstanceBackfillFollowStatusesFlag returns the flag name for the 'InstanceBackfillFollowStatuses' field
+func InstanceBackfillFollowStatusesFlag() string { return "instance-backfill-follow-statuses" }
 
-// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'MediaDescriptionMaxChars' field
-func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+// GetInstanceBackfillFollowStatuses safely fetches the value for global configuration 'InstanceBackfillFollowStatuses' field
+func GetInstanceBackfillFollowStatuses() int { return global.GetInstanceBackfillFollowStatuses() }
 
-// SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
-func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+// SetInstanceBackfillFollowStatuses safely sets the value for global configuration 'InstanceBackfillFollowStatuses' field
+func SetInstanceBackfillFollowStatuses(v int) { global.SetInstanceBackfillFollowStatuses(v) }
 
-// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
-func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+// GetInstanceDirectoryIncludeRemote safely fetches the Configuration value for state's 'InstanceDirectoryIncludeRemote' field
+func (st *ConfigState) GetInstanceDirectoryIncludeRemote() (v bool) {
 	st.mutex.RLock()
-	v = st.config.MediaRemoteCacheDays
+	v = st.config.InstanceDirectoryIncludeRemote
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'MediaRemoteCacheDays' field
-func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+// SetInstanceDirectoryIncludeRemote safely sets the Configuration value for state's 'InstanceDirectoryIncludeRemote' field
+func (st *ConfigState) SetInstanceDirectoryIncludeRemote(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaRemoteCacheDays = v
+	st.config.InstanceDirectoryIncludeRemote = v
 	st.reloadToViper()
 }
 
-// MediaRemoteCacheDaysFlag returns the flag name for the 'MediaRemoteCacheDays' field
-func MediaRemoteCacheDaysFlag() string { return "media-remote-cache-days" }
+// InstanceDirectoryIncludeRemoteFlag returns the flag name for the 'InstanceDirectoryIncludeRemote' field
+func InstanceDirectoryIncludeRemoteFlag() string { return "instance-directory-include-remote" }
 
-// GetMediaRemoteCacheDays safely fetches the value for global configuration 'MediaRemoteCacheDays' field
-func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+// GetInstanceDirectoryIncludeRemote safely fetches the value for global configuration 'InstanceDirectoryIncludeRemote' field
+func GetInstanceDirectoryIncludeRemote() bool { return global.GetInstanceDirectoryIncludeRemote() }
 
-// SetMediaRemoteCacheDays safely sets the value for global configuration 'MediaRemoteCacheDays' field
-func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+// SetInstanceDirectoryIncludeRemote safely sets the value for global configuration 'InstanceDirectoryIncludeRemote' field
+func SetInstanceDirectoryIncludeRemote(v bool) { global.SetInstanceDirectoryIncludeRemote(v) }
 
-// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'MediaEmojiLocalMaxSize' field
-func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+// GetInstanceNodeInfoMetadata safely fetches the Configuration value for state's 'InstanceNodeInfoMetadata' field
+func (st *ConfigState) GetInstanceNodeInfoMetadata() (v []string) {
 	st.mutex.RLock()
-	v = st.config.MediaEmojiLocalMaxSize
+	v = st.config.InstanceNodeInfoMetadata
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'MediaEmojiLocalMaxSize' field
-func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+// SetInstanceNodeInfoMetadata safely sets the Configuration value for state's 'InstanceNodeInfoMetadata' field
+func (st *ConfigState) SetInstanceNodeInfoMetadata(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaEmojiLocalMaxSize = v
+	st.config.InstanceNodeInfoMetadata = v
 	st.reloadToViper()
 }
 
-// MediaEmojiLocalMaxSizeFlag returns the flag name for the 'MediaEmojiLocalMaxSize' field
-func MediaEmojiLocalMaxSizeFlag() string { return "media-emoji-local-max-size" }
+// InstanceNodeInfoMetadataFlag returns the flag name for the 'InstanceNodeInfoMetadata' field
+func InstanceNodeInfoMetadataFlag() string { return "instance-nodeinfo-metadata" }
 
-// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'MediaEmojiLocalMaxSize' field
-func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+// GetInstanceNodeInfoMetadata safely fetches the value for global configuration 'InstanceNodeInfoMetadata' field
+func GetInstanceNodeInfoMetadata() []string { return global.GetInstanceNodeInfoMetadata() }
 
-// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'MediaEmojiLocalMaxSize' field
-func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+// SetInstanceNodeInfoMetadata safely sets the value for global configuration 'InstanceNodeInfoMetadata' field
+func SetInstanceNodeInfoMetadata(v []string) { global.SetInstanceNodeInfoMetadata(v) }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
-func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.RLock()
-	v = st.config.MediaEmojiRemoteMaxSize
+	v = st.config.AccountsRegistrationOpen
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
-func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaEmojiRemoteMaxSize = v
+	st.config.AccountsRegistrationOpen = v
 	st.reloadToViper()
 }
 
-// MediaEmojiRemoteMaxSizeFlag returns the flag name for the 'MediaEmojiRemoteMaxSize' field
-func MediaEmojiRemoteMaxSizeFlag() string { return "media-emoji-remote-max-size" }
+// AccountsRegistrationOpenFlag returns the flag name for the 'AccountsRegistrationOpen' field
+func AccountsRegistrationOpenFlag() string { return "accounts-registration-open" }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'MediaEmojiRemoteMaxSize' field
-func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
+func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
 
-// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
-func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
+func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
 
-// GetMediaImageSizeHint safely fetches the Configuration value for state's 'MediaImageSizeHint' field
-func (st *ConfigState) GetMediaImageSizeHint() (v bytesize.Size) {
+// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
 	st.mutex.RLock()
-	v = st.config.MediaImageSizeHint
+	v = st.config.AccountsReasonRequired
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaImageSizeHint safely sets the Configuration value for state's 'MediaImageSizeHint' field
-func (st *ConfigState) SetMediaImageSizeHint(v bytesize.Size) {
+// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) SetAccountsReasonRequired(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaImageSizeHint = v
+	st.config.AccountsReasonRequired = v
 	st.reloadToViper()
 }
 
-// MediaImageSizeHintFlag returns the flag name for the 'MediaImageSizeHint' field
-func MediaImageSizeHintFlag() string { return "media-image-size-hint" }
+// AccountsReasonRequiredFlag returns the flag name for the 'AccountsReasonRequired' field
+func AccountsReasonRequiredFlag() string { return "accounts-reason-required" }
 
-// GetMediaImageSizeHint safely fetches the value for global configuration 'MediaImageSizeHint' field
-func GetMediaImageSizeHint() bytesize.Size { return global.GetMediaImageSizeHint() }
+// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
+func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
 
-// SetMediaImageSizeHint safely sets the value for global configuration 'MediaImageSizeHint' field
-func SetMediaImageSizeHint(v bytesize.Size) { global.SetMediaImageSizeHint(v) }
+// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
+func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
 
-// GetMediaVideoSizeHint safely fetches the Configuration value for state's 'MediaVideoSizeHint' field
-func (st *ConfigState) GetMediaVideoSizeHint() (v bytesize.Size) {
+// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
 	st.mutex.RLock()
-	v = st.config.MediaVideoSizeHint
+	v = st.config.AccountsAllowCustomCSS
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaVideoSizeHint safely sets the Configuration value for state's 'MediaVideoSizeHint' field
-func (st *ConfigState) SetMediaVideoSizeHint(v bytesize.Size) {
+// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaVideoSizeHint = v
+	st.config.AccountsAllowCustomCSS = v
 	st.reloadToViper()
 }
 
-// MediaVideoSizeHintFlag returns the flag name for the 'MediaVideoSizeHint' field
-func MediaVideoSizeHintFlag() string { return "media-video-size-hint" }
+// AccountsAllowCustomCSSFlag returns the flag name for the 'AccountsAllowCustomCSS' field
+func AccountsAllowCustomCSSFlag() string { return "accounts-allow-custom-css" }
 
-// GetMediaVideoSizeHint safely fetches the value for global configuration 'MediaVideoSizeHint' field
-func GetMediaVideoSizeHint() bytesize.Size { return global.GetMediaVideoSizeHint() }
+// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
+func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
 
-// SetMediaVideoSizeHint safely sets the value for global configuration 'MediaVideoSizeHint' field
-func SetMediaVideoSizeHint(v bytesize.Size) { global.SetMediaVideoSizeHint(v) }
+// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
+func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
 
-// GetMediaLocalMaxSize safely fetches the Configuration value for state's 'MediaLocalMaxSize' field
-func (st *ConfigState) GetMediaLocalMaxSize() (v bytesize.Size) {
+// GetAccountsAllowUserInvites safely fetches the Configuration value for state's 'AccountsAllowUserInvites' field
+func (st *ConfigState) GetAccountsAllowUserInvites() (v bool) {
 	st.mutex.RLock()
-	v = st.config.MediaLocalMaxSize
+	v = st.config.AccountsAllowUserInvites
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaLocalMaxSize safely sets the Configuration value for state's 'MediaLocalMaxSize' field
-func (st *ConfigState) SetMediaLocalMaxSize(v bytesize.Size) {
+// SetAccountsAllowUserInvites safely sets the Configuration value for state's 'AccountsAllowUserInvites' field
+func (st *ConfigState) SetAccountsAllowUserInvites(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaLocalMaxSize = v
+	st.config.AccountsAllowUserInvites = v
 	st.reloadToViper()
 }
 
-// MediaLocalMaxSizeFlag returns the flag name for the 'MediaLocalMaxSize' field
-func MediaLocalMaxSizeFlag() string { return "media-local-max-size" }
+// AccountsAllowUserInvitesFlag returns the flag name for the 'AccountsAllowUserInvites' field
+func AccountsAllowUserInvitesFlag() string { return "accounts-allow-user-invites" }
 
-// GetMediaLocalMaxSize safely fetches the value for global configuration 'MediaLocalMaxSize' field
-func GetMediaLocalMaxSize() bytesize.Size { return global.GetMediaLocalMaxSize() }
+// GetAccountsAllowUserInvites safely fetches the value for global configuration 'AccountsAllowUserInvites' field
+func GetAccountsAllowUserInvites() bool { return global.GetAccountsAllowUserInvites() }
 
-// SetMediaLocalMaxSize safely sets the value for global configuration 'MediaLocalMaxSize' field
-func SetMediaLocalMaxSize(v bytesize.Size) { global.SetMediaLocalMaxSize(v) }
+// SetAccountsAllowUserInvites safely sets the value for global configuration 'AccountsAllowUserInvites' field
+func SetAccountsAllowUserInvites(v bool) { global.SetAccountsAllowUserInvites(v) }
 
-// GetMediaRemoteMaxSize safely fetches the Configuration value for state's 'MediaRemoteMaxSize' field
-func (st *ConfigState) GetMediaRemoteMaxSize() (v bytesize.Size) {
+// GetAccountsCustomCSSLength safely fetches the Configuration value for state's 'AccountsCustomCSSLength' field
+func (st *ConfigState) GetAccountsCustomCSSLength() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaRemoteMaxSize
+	v = st.config.AccountsCustomCSSLength
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteMaxSize safely sets the Configuration value for state's 'MediaRemoteMaxSize' field
-func (st *ConfigState) SetMediaRemoteMaxSize(v bytesize.Size) {
+// SetAccountsCustomCSSLength safely sets the Configuration value for state's 'AccountsCustomCSSLength' field
+func (st *ConfigState) SetAccountsCustomCSSLength(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaRemoteMaxSize = v
+	st.config.AccountsCustomCSSLength = v
 	st.reloadToViper()
 }
 
-// MediaRemoteMaxSizeFlag returns the flag name for the 'MediaRemoteMaxSize' field
-func MediaRemoteMaxSizeFlag() string { return "media-remote-max-size" }
+// AccountsCustomCSSLengthFlag returns the flag name for the 'AccountsCustomCSSLength' field
+func AccountsCustomCSSLengthFlag() string { return "accounts-custom-css-length" }
 
-// GetMediaRemoteMaxSize safely fetches the value for global configuration 'MediaRemoteMaxSize' field
-func GetMediaRemoteMaxSize() bytesize.Size { return global.GetMediaRemoteMaxSize() }
+// GetAccountsCustomCSSLength safely fetches the value for global configuration 'AccountsCustomCSSLength' field
+func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength() }
 
-// SetMediaRemoteMaxSize safely sets the value for global configuration 'MediaRemoteMaxSize' field
-func SetMediaRemoteMaxSize(v bytesize.Size) { global.SetMediaRemoteMaxSize(v) }
+// SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
+func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
-// GetMediaCleanupFrom safely fetches the Configuration value for state's 'MediaCleanupFrom' field
-func (st *ConfigState) GetMediaCleanupFrom() (v string) {
+// GetAccountsArchiveInterval safely fetches the Configuration value for state's 'AccountsArchiveInterval' field
+func (st *ConfigState) GetAccountsArchiveInterval() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaCleanupFrom
+	v = st.config.AccountsArchiveInterval
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaCleanupFrom safely sets the Configuration value for state's 'MediaCleanupFrom' field
-func (st *ConfigState) SetMediaCleanupFrom(v string) {
+// SetAccountsArchiveInterval safely sets the Configuration value for state's 'AccountsArchiveInterval' field
+func (st *ConfigState) SetAccountsArchiveInterval(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaCleanupFrom = v
+	st.config.AccountsArchiveInterval = v
 	st.reloadToViper()
 }
 
-// MediaCleanupFromFlag returns the flag name for the 'MediaCleanupFrom' field
-func MediaCleanupFromFlag() string { return "media-cleanup-from" }
+// AccountsArchiveIntervalFlag returns the flag name for the 'AccountsArchiveInterval' field
+func AccountsArchiveIntervalFlag() string { return "accounts-archive-interval-days" }
 
-// GetMediaCleanupFrom safely fetches the value for global configuration 'MediaCleanupFrom' field
-func GetMediaCleanupFrom() string { return global.GetMediaCleanupFrom() }
+// GetAccountsArchiveInterval safely fetches the value for global configuration 'AccountsArchiveInterval' field
+func GetAccountsArchiveInterval() int { return global.GetAccountsArchiveInterval() }
 
-// SetMediaCleanupFrom safely sets the value for global configuration 'MediaCleanupFrom' field
-func SetMediaCleanupFrom(v string) { global.SetMediaCleanupFrom(v) }
+// SetAccountsArchiveInterval safely sets the value for global configuration 'AccountsArchiveInterval' field
+func SetAccountsArchiveInterval(v int) { global.SetAccountsArchiveInterval(v) }
 
-// GetMediaCleanupEvery safely fetches the Configuration value for state's 'MediaCleanupEvery' field
-func (st *ConfigState) GetMediaCleanupEvery() (v time.Duration) {
+// GetAccountsDeletionGrace safely fetches the Configuration value for state's 'AccountsDeletionGrace' field
+func (st *ConfigState) GetAccountsDeletionGrace() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaCleanupEvery
+	v = st.config.AccountsDeletionGrace
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaCleanupEvery safely sets the Configuration value for state's 'MediaCleanupEvery' field
-func (st *ConfigState) SetMediaCleanupEvery(v time.Duration) {
+// SetAccountsDeletionGrace safely sets the Configuration value for state's 'AccountsDeletionGrace' field
+func (st *ConfigState) SetAccountsDeletionGrace(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaCleanupEvery = v
+	st.config.AccountsDeletionGrace = v
 	st.reloadToViper()
 }
 
-// MediaCleanupEveryFlag returns the flag name for the 'MediaCleanupEvery' field
-func MediaCleanupEveryFlag() string { return "media-cleanup-every" }
+// AccountsDeletionGraceFlag returns the flag name for the 'AccountsDeletionGrace' field
+func AccountsDeletionGraceFlag() string { return "accounts-deletion-grace-days" }
 
-// GetMediaCleanupEvery safely fetches the value for global configuration 'MediaCleanupEvery' field
-func GetMediaCleanupEvery() time.Duration { return global.GetMediaCleanupEvery() }
+// GetAccountsDeletionGrace safely fetches the value for global configuration 'AccountsDeletionGrace' field
+func GetAccountsDeletionGrace() int { return global.GetAccountsDeletionGrace() }
 
-// SetMediaCleanupEvery safely sets the value for global configuration 'MediaCleanupEvery' field
-func SetMediaCleanupEvery(v time.Duration) { global.SetMediaCleanupEvery(v) }
+// SetAccountsDeletionGrace safely sets the value for global configuration 'AccountsDeletionGrace' field
+func SetAccountsDeletionGrace(v int) { global.SetAccountsDeletionGrace(v) }
 
-// GetMediaFfmpegPoolSize safely fetches the Configuration value for state's 'MediaFfmpegPoolSize' field
-func (st *ConfigState) GetMediaFfmpegPoolSize() (v int) {
+// GetAccountsCaptchaProvider safely fetches the Configuration value for state's 'AccountsCaptchaProvider' field
+func (st *ConfigState) GetAccountsCaptchaProvider() (v string) {
 	st.mutex.RLock()
-	v = st.config.MediaFfmpegPoolSize
+	v = st.config.AccountsCaptchaProvider
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaFfmpegPoolSize safely sets the Configuration value for state's 'MediaFfmpegPoolSize' field
-func (st *ConfigState) SetMediaFfmpegPoolSize(v int) {
+// SetAccountsCaptchaProvider safely sets the Configuration value for state's 'AccountsCaptchaProvider' field
+func (st *ConfigState) SetAccountsCaptchaProvider(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaFfmpegPoolSize = v
+	st.config.AccountsCaptchaProvider = v
 	st.reloadToViper()
 }
 
-// MediaFfmpegPoolSizeFlag returns the flag name for the 'MediaFfmpegPoolSize' field
-func MediaFfmpegPoolSizeFlag() string { return "media-ffmpeg-pool-size" }
+// AccountsCaptchaProviderFlag returns the flag name for the 'AccountsCaptchaProvider' field
+func AccountsCaptchaProviderFlag() string { return "accounts-captcha-provider" }
 
-// GetMediaFfmpegPoolSize safely fetches the value for global configuration 'MediaFfmpegPoolSize' field
-func GetMediaFfmpegPoolSize() int { return global.GetMediaFfmpegPoolSize() }
+// GetAccountsCaptchaProvider safely fetches the value for global configuration 'AccountsCaptchaProvider' field
+func GetAccountsCaptchaProvider() string { return global.GetAccountsCaptchaProvider() }
 
-// SetMediaFfmpegPoolSize safely sets the value for global configuration 'MediaFfmpegPoolSize' field
-func SetMediaFfmpegPoolSize(v int) { global.SetMediaFfmpegPoolSize(v) }
+// SetAccountsCaptchaProvider safely sets the value for global configuration 'AccountsCaptchaProvider' field
+func SetAccountsCaptchaProvider(v string) { global.SetAccountsCaptchaProvider(v) }
 
-// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) GetStorageBackend() (v string) {
+// GetAccountsCaptchaSiteKey safely fetches the Configuration value for state's 'AccountsCaptchaSiteKey' field
+func (st *ConfigState) GetAccountsCaptchaSiteKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.StorageBackend
+	v = st.config.AccountsCaptchaSiteKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) SetStorageBackend(v string) {
+// SetAccountsCaptchaSiteKey safely sets the Configuration value for state's 'AccountsCaptchaSiteKey' field
+func (st *ConfigState) SetAccountsCaptchaSiteKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageBackend = v
+	st.config.AccountsCaptchaSiteKey = v
 	st.reloadToViper()
 }
 
-// StorageBackendFlag returns the flag name for the 'StorageBackend' field
-func StorageBackendFlag() string { return "storage-backend" }
+// AccountsCaptchaSiteKeyFlag returns the flag name for the 'AccountsCaptchaSiteKey' field
+func AccountsCaptchaSiteKeyFlag() string { return "accounts-captcha-site-key" }
 
-// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
-func GetStorageBackend() string { return global.GetStorageBackend() }
+// GetAccountsCaptchaSiteKey safely fetches the value for global configuration 'AccountsCaptchaSiteKey' field
+func GetAccountsCaptchaSiteKey() string { return global.GetAccountsCaptchaSiteKey() }
 
-// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
-func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+// SetAccountsCaptchaSiteKey safely sets the value for global configuration 'AccountsCaptchaSiteKey' field
+func SetAccountsCaptchaSiteKey(v string) { global.SetAccountsCaptchaSiteKey(v) }
 
-// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+// GetAccountsCaptchaSecretKey safely fetches the Configuration value for state's 'AccountsCaptchaSecretKey' field
+func (st *ConfigState) GetAccountsCaptchaSecretKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.StorageLocalBasePath
+	v = st.config.AccountsCaptchaSecretKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) SetStorageLocalBasePath(v string) {
+// SetAccountsCaptchaSecretKey safely sets the Configuration value for state's 'AccountsCaptchaSecretKey' field
+func (st *ConfigState) SetAccountsCaptchaSecretKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageLocalBasePath = v
+	st.config.AccountsCaptchaSecretKey = v
 	st.reloadToViper()
 }
 
-// StorageLocalBasePathFlag returns the flag name for the 'StorageLocalBasePath' field
-func StorageLocalBasePathFlag() string { return "storage-local-base-path" }
+// AccountsCaptchaSecretKeyFlag returns the flag name for the 'AccountsCaptchaSecretKey' field
+func AccountsCaptchaSecretKeyFlag() string { return "accounts-captcha-secret-key" }
 
-// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
-func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+// GetAccountsCaptchaSecretKey safely fetches the value for global configuration 'AccountsCaptchaSecretKey' field
+func GetAccountsCaptchaSecretKey() string { return global.GetAccountsCaptchaSecretKey() }
 
-// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
-func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+// SetAccountsCaptchaSecretKey safely sets the value for global configuration 'AccountsCaptchaSecretKey' field
+func SetAccountsCaptchaSecretKey(v string) { global.SetAccountsCaptchaSecretKey(v) }
 
-// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+// GetAccountsFieldVerificationInterval safely fetches the Configuration value for state's 'AccountsFieldVerificationInterval' field
+func (st *ConfigState) GetAccountsFieldVerificationInterval() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.StorageS3Endpoint
+	v = st.config.AccountsFieldVerificationInterval
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) SetStorageS3Endpoint(v string) {
+// SetAccountsFieldVerificationInterval safely sets the Configuration value for state's 'AccountsFieldVerificationInterval' field
+func (st *ConfigState) SetAccountsFieldVerificationInterval(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Endpoint = v
+	st.config.AccountsFieldVerificationInterval = v
 	st.reloadToViper()
 }
 
-// StorageS3EndpointFlag returns the flag name for the 'StorageS3Endpoint' field
-func StorageS3EndpointFlag() string { return "storage-s3-endpoint" }
+// AccountsFieldVerificationIntervalFlag returns the flag name for the 'AccountsFieldVerificationInterval' field
+func AccountsFieldVerificationIntervalFlag() string { return "accounts-field-verification-interval" }
 
-// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
-func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+// GetAccountsFieldVerificationInterval safely fetches the value for global configuration 'AccountsFieldVerificationInterval' field
+func GetAccountsFieldVerificationInterval() time.Duration { return global.GetAccountsFieldVerificationInterval() }
 
-// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
-func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+// SetAccountsFieldVerificationInterval safely sets the value for global configuration 'AccountsFieldVerificationInterval' field
+func SetAccountsFieldVerificationInterval(v time.Duration) { global.SetAccountsFieldVerificationInterval(v) }
 
-// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
+func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
 	st.mutex.RLock()
-	v = st.config.StorageS3AccessKey
+	v = st.config.MediaDescriptionMinChars
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) SetStorageS3AccessKey(v string) {
+// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'MediaDescriptionMinChars' field
+func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3AccessKey = v
+	st.config.MediaDescriptionMinChars = v
 	st.reloadToViper()
 }
 
-// StorageS3AccessKeyFlag returns the flag name for the 'StorageS3AccessKey' field
-func StorageS3AccessKeyFlag() string { return "storage-s3-access-key" }
+// MediaDescriptionMinCharsFlag returns the flag name for the 'MediaDescriptionMinChars' field
+func MediaDescriptionMinCharsFlag() string { return "media-description-min-chars" }
 
-// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
-func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+// GetMediaDescriptionMinChars safely fetches the value for global configuration 'MediaDescriptionMinChars' field
+func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
 
-// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
-func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+// SetMediaDescriptionMinChars safely sets the value for global configuration 'MediaDescriptionMinChars' field
+func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
 
-// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+// GetMediaDescriptionRequired safely fetches the Configuration value for state's 'MediaDescriptionRequired' field
+func (st *ConfigState) GetMediaDescriptionRequired() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3SecretKey
+	v = st.config.MediaDescriptionRequired
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) SetStorageS3SecretKey(v string) {
+// SetMediaDescriptionRequired safely sets the Configuration value for state's 'MediaDescriptionRequired' field
+func (st *ConfigState) SetMediaDescriptionRequired(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3SecretKey = v
+	st.config.MediaDescriptionRequired = v
 	st.reloadToViper()
 }
 
-// StorageS3SecretKeyFlag returns the flag name for the 'StorageS3SecretKey' field
-func StorageS3SecretKeyFlag() string { return "storage-s3-secret-key" }
+// MediaDescriptionRequiredFlag returns the flag name for the 'MediaDescriptionRequired' field
+func MediaDescriptionRequiredFlag() string { return "media-description-required" }
 
-// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
-func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+// GetMediaDescriptionRequired safely fetches the value for global configuration 'MediaDescriptionRequired' field
+func GetMediaDescriptionRequired() bool { return global.GetMediaDescriptionRequired() }
 
-// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
-func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
+// SetMediaDescriptionRequired safely sets the value for global configuration 'MediaDescriptionRequired' field
+func SetMediaDescriptionRequired(v bool) { global.SetMediaDescriptionRequired(v) }
 
-// GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
-func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
+// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'MediaDescriptionMaxChars' field
+func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
 	st.mutex.RLock()
-	v = st.config.StorageS3UseSSL
+	v = st.config.MediaDescriptionMaxChars
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3UseSSL safely sets the Configuration value for state's 'StorageS3UseSSL' field
-func (st *ConfigState) SetStorageS3UseSSL(v bool) {
+// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'MediaDescriptionMaxChars' field
+func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3UseSSL = v
+	st.config.MediaDescriptionMaxChars = v
 	st.reloadToViper()
 }
 
-// StorageS3UseSSLFlag returns the flag name for the 'StorageS3UseSSL' field
-func StorageS3UseSSLFlag() string { return "storage-s3-use-ssl" }
+// MediaDescriptionMaxCharsFlag returns the flag name for the 'MediaDescriptionMaxChars' field
+func MediaDescriptionMaxCharsFlag() string { return "media-description-max-chars" }
 
-// GetStorageS3UseSSL safely fetches the value for global configuration 'StorageS3UseSSL' field
-func GetStorageS3UseSSL() bool { return global.GetStorageS3UseSSL() }
+// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'MediaDescriptionMaxChars' field
+func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
 
-// SetStorageS3UseSSL safely sets the value for global configuration 'StorageS3UseSSL' field
-func SetStorageS3UseSSL(v bool) { global.SetStorageS3UseSSL(v) }
+// SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
+func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
 
-// GetStorageS3BucketName safely fetches the Configuration value for state's 'StorageS3BucketName' field
-func (st *ConfigState) GetStorageS3BucketName() (v string) {
+// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
+func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
 	st.mutex.RLock()
-	v = st.config.StorageS3BucketName
+	v = st.config.MediaRemoteCacheDays
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'MediaRemoteCacheDays' field
+func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaRemoteCacheDays = v
+	st.reloadToViper()
+}
+
+// MediaRemoteCacheDaysFlag returns the flag name for the 'MediaRemoteCacheDays' field
+func MediaRemoteCacheDaysFlag() string { return "media-remote-cache-days" }
+
+// GetMediaRemoteCacheDays safely fetches the value for global configuration 'MediaRemoteCacheDays' field
+func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+
+// SetMediaRemoteCacheDays safely sets the value for global configuration 'MediaRemoteCacheDays' field
+func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+
+// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'MediaEmojiLocalMaxSize' field
+func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaEmojiLocalMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'MediaEmojiLocalMaxSize' field
+func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaEmojiLocalMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaEmojiLocalMaxSizeFlag returns the flag name for the 'MediaEmojiLocalMaxSize' field
+func MediaEmojiLocalMaxSizeFlag() string { return "media-emoji-local-max-size" }
+
+// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'MediaEmojiLocalMaxSize' field
+func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+
+// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'MediaEmojiLocalMaxSize' field
+func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
+func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaEmojiRemoteMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
+func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaEmojiRemoteMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaEmojiRemoteMaxSizeFlag returns the flag name for the 'MediaEmojiRemoteMaxSize' field
+func MediaEmojiRemoteMaxSizeFlag() string { return "media-emoji-remote-max-size" }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'MediaEmojiRemoteMaxSize' field
+func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+
+// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
+func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+
+// GetMediaImageSizeHint safely fetches the Configuration value for state's 'MediaImageSizeHint' field
+func (st *ConfigState) GetMediaImageSizeHint() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaImageSizeHint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaImageSizeHint safely sets the Configuration value for state's 'MediaImageSizeHint' field
+func (st *ConfigState) SetMediaImageSizeHint(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaImageSizeHint = v
+	st.reloadToViper()
+}
+
+// MediaImageSizeHintFlag returns the flag name for the 'MediaImageSizeHint' field
+func MediaImageSizeHintFlag() string { return "media-image-size-hint" }
+
+// GetMediaImageSizeHint safely fetches the value for global configuration 'MediaImageSizeHint' field
+func GetMediaImageSizeHint() bytesize.Size { return global.GetMediaImageSizeHint() }
+
+// SetMediaImageSizeHint safely sets the value for global configuration 'MediaImageSizeHint' field
+func SetMediaImageSizeHint(v bytesize.Size) { global.SetMediaImageSizeHint(v) }
+
+// GetMediaVideoSizeHint safely fetches the Configuration value for state's 'MediaVideoSizeHint' field
+func (st *ConfigState) GetMediaVideoSizeHint() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaVideoSizeHint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoSizeHint safely sets the Configuration value for state's 'MediaVideoSizeHint' field
+func (st *ConfigState) SetMediaVideoSizeHint(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoSizeHint = v
+	st.reloadToViper()
+}
+
+// MediaVideoSizeHintFlag returns the flag name for the 'MediaVideoSizeHint' field
+func MediaVideoSizeHintFlag() string { return "media-video-size-hint" }
+
+// GetMediaVideoSizeHint safely fetches the value for global configuration 'MediaVideoSizeHint' field
+func GetMediaVideoSizeHint() bytesize.Size { return global.GetMediaVideoSizeHint() }
+
+// SetMediaVideoSizeHint safely sets the value for global configuration 'MediaVideoSizeHint' field
+func SetMediaVideoSizeHint(v bytesize.Size) { global.SetMediaVideoSizeHint(v) }
+
+// GetMediaLocalMaxSize safely fetches the Configuration value for state's 'MediaLocalMaxSize' field
+func (st *ConfigState) GetMediaLocalMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaLocalMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaLocalMaxSize safely sets the Configuration value for state's 'MediaLocalMaxSize' field
+func (st *ConfigState) SetMediaLocalMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaLocalMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaLocalMaxSizeFlag returns the flag name for the 'MediaLocalMaxSize' field
+func MediaLocalMaxSizeFlag() string { return "media-local-max-size" }
+
+// GetMediaLocalMaxSize safely fetches the value for global configuration 'MediaLocalMaxSize' field
+func GetMediaLocalMaxSize() bytesize.Size { return global.GetMediaLocalMaxSize() }
+
+// SetMediaLocalMaxSize safely sets the value for global configuration 'MediaLocalMaxSize' field
+func SetMediaLocalMaxSize(v bytesize.Size) { global.SetMediaLocalMaxSize(v) }
+
+// GetMediaRemoteMaxSize safely fetches the Configuration value for state's 'MediaRemoteMaxSize' field
+func (st *ConfigState) GetMediaRemoteMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaRemoteMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaRemoteMaxSize safely sets the Configuration value for state's 'MediaRemoteMaxSize' field
+func (st *ConfigState) SetMediaRemoteMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaRemoteMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaRemoteMaxSizeFlag returns the flag name for the 'MediaRemoteMaxSize' field
+func MediaRemoteMaxSizeFlag() string { return "media-remote-max-size" }
+
+// GetMediaRemoteMaxSize safely fetches the value for global configuration 'MediaRemoteMaxSize' field
+func GetMediaRemoteMaxSize() bytesize.Size { return global.GetMediaRemoteMaxSize() }
+
+// SetMediaRemoteMaxSize safely sets the value for global configuration 'MediaRemoteMaxSize' field
+func SetMediaRemoteMaxSize(v bytesize.Size) { global.SetMediaRemoteMaxSize(v) }
+
+// GetMediaCleanupFrom safely fetches the Configuration value for state's 'MediaCleanupFrom' field
+func (st *ConfigState) GetMediaCleanupFrom() (v string) {
+	st.mutex.RLock()
+	v = st.config.MediaCleanupFrom
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaCleanupFrom safely sets the Configuration value for state's 'MediaCleanupFrom' field
+func (st *ConfigState) SetMediaCleanupFrom(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaCleanupFrom = v
+	st.reloadToViper()
+}
+
+// MediaCleanupFromFlag returns the flag name for the 'MediaCleanupFrom' field
+func MediaCleanupFromFlag() string { return "media-cleanup-from" }
+
+// GetMediaCleanupFrom safely fetches the value for global configuration 'MediaCleanupFrom' field
+func GetMediaCleanupFrom() string { return global.GetMediaCleanupFrom() }
+
+// SetMediaCleanupFrom safely sets the value for global configuration 'MediaCleanupFrom' field
+func SetMediaCleanupFrom(v string) { global.SetMediaCleanupFrom(v) }
+
+// GetMediaCleanupEvery safely fetches the Configuration value for state's 'MediaCleanupEvery' field
+func (st *ConfigState) GetMediaCleanupEvery() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.MediaCleanupEvery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaCleanupEvery safely sets the Configuration value for state's 'MediaCleanupEvery' field
+func (st *ConfigState) SetMediaCleanupEvery(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaCleanupEvery = v
+	st.reloadToViper()
+}
+
+// MediaCleanupEveryFlag returns the flag name for the 'MediaCleanupEvery' field
+func MediaCleanupEveryFlag() string { return "media-cleanup-every" }
+
+// GetMediaCleanupEvery safely fetches the value for global configuration 'MediaCleanupEvery' field
+func GetMediaCleanupEvery() time.Duration { return global.GetMediaCleanupEvery() }
+
+// SetMediaCleanupEvery safely sets the value for global configuration 'MediaCleanupEvery' field
+func SetMediaCleanupEvery(v time.Duration) { global.SetMediaCleanupEvery(v) }
+
+// GetMediaFfmpegPoolSize safely fetches the Configuration value for state's 'MediaFfmpegPoolSize' field
+func (st *ConfigState) GetMediaFfmpegPoolSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaFfmpegPoolSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaFfmpegPoolSize safely sets the Configuration value for state's 'MediaFfmpegPoolSize' field
+func (st *ConfigState) SetMediaFfmpegPoolSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaFfmpegPoolSize = v
+	st.reloadToViper()
+}
+
+// MediaFfmpegPoolSizeFlag returns the flag name for the 'MediaFfmpegPoolSize' field
+func MediaFfmpegPoolSizeFlag() string { return "media-ffmpeg-pool-size" }
+
+// GetMediaFfmpegPoolSize safely fetches the value for global configuration 'MediaFfmpegPoolSize' field
+func GetMediaFfmpegPoolSize() int { return global.GetMediaFfmpegPoolSize() }
+
+// SetMediaFfmpegPoolSize safely sets the value for global configuration 'MediaFfmpegPoolSize' field
+func SetMediaFfmpegPoolSize(v int) { global.SetMediaFfmpegPoolSize(v) }
+
+// GetMediaThumbnailFormat safely fetches the Configuration value for state's 'MediaThumbnailFormat' field
+func (st *ConfigState) GetMediaThumbnailFormat() (v string) {
+	st.mutex.RLock()
+	v = st.config.MediaThumbnailFormat
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaThumbnailFormat safely sets the Configuration value for state's 'MediaThumbnailFormat' field
+func (st *ConfigState) SetMediaThumbnailFormat(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaThumbnailFormat = v
+	st.reloadToViper()
+}
+
+// MediaThumbnailFormatFlag returns the flag name for the 'MediaThumbnailFormat' field
+func MediaThumbnailFormatFlag() string { return "media-thumbnail-format" }
+
+// GetMediaThumbnailFormat safely fetches the value for global configuration 'MediaThumbnailFormat' field
+func GetMediaThumbnailFormat() string { return global.GetMediaThumbnailFormat() }
+
+// SetMediaThumbnailFormat safely sets the value for global configuration 'MediaThumbnailFormat' field
+func SetMediaThumbnailFormat(v string) { global.SetMediaThumbnailFormat(v) }
+
+// GetMediaVideoTranscode safely fetches the Configuration value for state's 'MediaVideoTranscode' field
+func (st *ConfigState) GetMediaVideoTranscode() (v bool) {
+	st.mutex.RLock()
+	v = st.config.MediaVideoTranscode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoTranscode safely sets the Configuration value for state's 'MediaVideoTranscode' field
+func (st *ConfigState) SetMediaVideoTranscode(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoTranscode = v
+	st.reloadToViper()
+}
+
+// MediaVideoTranscodeFlag returns the flag name for the 'MediaVideoTranscode' field
+func MediaVideoTranscodeFlag() string { return "media-video-transcode" }
+
+// GetMediaVideoTranscode safely fetches the value for global configuration 'MediaVideoTranscode' field
+func GetMediaVideoTranscode() bool { return global.GetMediaVideoTranscode() }
+
+// SetMediaVideoTranscode safely sets the value for global configuration 'MediaVideoTranscode' field
+func SetMediaVideoTranscode(v bool) { global.SetMediaVideoTranscode(v) }
+
+// GetMediaVideoMaxBitrate safely fetches the Configuration value for state's 'MediaVideoMaxBitrate' field
+func (st *ConfigState) GetMediaVideoMaxBitrate() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaVideoMaxBitrate
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoMaxBitrate safely sets the Configuration value for state's 'MediaVideoMaxBitrate' field
+func (st *ConfigState) SetMediaVideoMaxBitrate(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoMaxBitrate = v
+	st.reloadToViper()
+}
+
+// MediaVideoMaxBitrateFlag returns the flag name for the 'MediaVideoMaxBitrate' field
+func MediaVideoMaxBitrateFlag() string { return "media-video-max-bitrate" }
+
+// GetMediaVideoMaxBitrate safely fetches the value for global configuration 'MediaVideoMaxBitrate' field
+func GetMediaVideoMaxBitrate() int { return global.GetMediaVideoMaxBitrate() }
+
+// SetMediaVideoMaxBitrate safely sets the value for global configuration 'MediaVideoMaxBitrate' field
+func SetMediaVideoMaxBitrate(v int) { global.SetMediaVideoMaxBitrate(v) }
+
+// GetMediaVideoMaxHeight safely fetches the Configuration value for state's 'MediaVideoMaxHeight' field
+func (st *ConfigState) GetMediaVideoMaxHeight() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaVideoMaxHeight
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoMaxHeight safely sets the Configuration value for state's 'MediaVideoMaxHeight' field
+func (st *ConfigState) SetMediaVideoMaxHeight(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoMaxHeight = v
+	st.reloadToViper()
+}
+
+// MediaVideoMaxHeightFlag returns the flag name for the 'MediaVideoMaxHeight' field
+func MediaVideoMaxHeightFlag() string { return "media-video-max-height" }
+
+// GetMediaVideoMaxHeight safely fetches the value for global configuration 'MediaVideoMaxHeight' field
+func GetMediaVideoMaxHeight() int { return global.GetMediaVideoMaxHeight() }
+
+// SetMediaVideoMaxHeight safely sets the value for global configuration 'MediaVideoMaxHeight' field
+func SetMediaVideoMaxHeight(v int) { global.SetMediaVideoMaxHeight(v) }
+
+// GetMediaVideoTranscodeWorkers safely fetches the Configuration value for state's 'MediaVideoTranscodeWorkers' field
+func (st *ConfigState) GetMediaVideoTranscodeWorkers() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaVideoTranscodeWorkers
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoTranscodeWorkers safely sets the Configuration value for state's 'MediaVideoTranscodeWorkers' field
+func (st *ConfigState) SetMediaVideoTranscodeWorkers(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoTranscodeWorkers = v
+	st.reloadToViper()
+}
+
+// MediaVideoTranscodeWorkersFlag returns the flag name for the 'MediaVideoTranscodeWorkers' field
+func MediaVideoTranscodeWorkersFlag() string { return "media-video-transcode-workers" }
+
+// GetMediaVideoTranscodeWorkers safely fetches the value for global configuration 'MediaVideoTranscodeWorkers' field
+func GetMediaVideoTranscodeWorkers() int { return global.GetMediaVideoTranscodeWorkers() }
+
+// SetMediaVideoTranscodeWorkers safely sets the value for global configuration 'MediaVideoTranscodeWorkers' field
+func SetMediaVideoTranscodeWorkers(v int) { global.SetMediaVideoTranscodeWorkers(v) }
+
+// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) GetStorageBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) SetStorageBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageBackend = v
+	st.reloadToViper()
+}
+
+// StorageBackendFlag returns the flag name for the 'StorageBackend' field
+func StorageBackendFlag() string { return "storage-backend" }
+
+// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
+func GetStorageBackend() string { return global.GetStorageBackend() }
+
+// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
+func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+
+// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageLocalBasePath
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) SetStorageLocalBasePath(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageLocalBasePath = v
+	st.reloadToViper()
+}
+
+// StorageLocalBasePathFlag returns the flag name for the 'StorageLocalBasePath' field
+func StorageLocalBasePathFlag() string { return "storage-local-base-path" }
+
+// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
+func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+
+// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
+func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+
+// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3Endpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) SetStorageS3Endpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3Endpoint = v
+	st.reloadToViper()
+}
+
+// StorageS3EndpointFlag returns the flag name for the 'StorageS3Endpoint' field
+func StorageS3EndpointFlag() string { return "storage-s3-endpoint" }
+
+// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
+func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+
+// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
+func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+
+// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3AccessKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) SetStorageS3AccessKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3AccessKey = v
+	st.reloadToViper()
+}
+
+// StorageS3AccessKeyFlag returns the flag name for the 'StorageS3AccessKey' field
+func StorageS3AccessKeyFlag() string { return "storage-s3-access-key" }
+
+// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
+func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+
+// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
+func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+
+// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3SecretKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) SetStorageS3SecretKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3SecretKey = v
+	st.reloadToViper()
+}
+
+// StorageS3SecretKeyFlag returns the flag name for the 'StorageS3SecretKey' field
+func StorageS3SecretKeyFlag() string { return "storage-s3-secret-key" }
+
+// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
+func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+
+// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
+func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
+
+// GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
+func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StorageS3UseSSL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3UseSSL safely sets the Configuration value for state's 'StorageS3UseSSL' field
+func (st *ConfigState) SetStorageS3UseSSL(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3UseSSL = v
+	st.reloadToViper()
+}
+
+// StorageS3UseSSLFlag returns the flag name for the 'StorageS3UseSSL' field
+func StorageS3UseSSLFlag() string { return "storage-s3-use-ssl" }
+
+// GetStorageS3UseSSL safely fetches the value for global configuration 'StorageS3UseSSL' field
+func GetStorageS3UseSSL() bool { return global.GetStorageS3UseSSL() }
+
+// SetStorageS3UseSSL safely sets the value for global configuration 'StorageS3UseSSL' field
+func SetStorageS3UseSSL(v bool) { global.SetStorageS3UseSSL(v) }
+
+// GetStorageS3BucketName safely fetches the Configuration value for state's 'StorageS3BucketName' field
+func (st *ConfigState) GetStorageS3BucketName() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3BucketName
 	st.mutex.RUnlock()
 	return
 }
@@ -1562,993 +2412,2318 @@ func (st *ConfigState) GetStorageS3BucketName() (v string) {
 func (st *ConfigState) SetStorageS3BucketName(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3BucketName = v
+	st.config.StorageS3BucketName = v
+	st.reloadToViper()
+}
+
+// StorageS3BucketNameFlag returns the flag name for the 'StorageS3BucketName' field
+func StorageS3BucketNameFlag() string { return "storage-s3-bucket" }
+
+// GetStorageS3BucketName safely fetches the value for global configuration 'StorageS3BucketName' field
+func GetStorageS3BucketName() string { return global.GetStorageS3BucketName() }
+
+// SetStorageS3BucketName safely sets the value for global configuration 'StorageS3BucketName' field
+func SetStorageS3BucketName(v string) { global.SetStorageS3BucketName(v) }
+
+// GetStorageS3Proxy safely fetches the Configuration value for state's 'StorageS3Proxy' field
+func (st *ConfigState) GetStorageS3Proxy() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StorageS3Proxy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3Proxy safely sets the Configuration value for state's 'StorageS3Proxy' field
+func (st *ConfigState) SetStorageS3Proxy(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3Proxy = v
+	st.reloadToViper()
+}
+
+// StorageS3ProxyFlag returns the flag name for the 'StorageS3Proxy' field
+func StorageS3ProxyFlag() string { return "storage-s3-proxy" }
+
+// GetStorageS3Proxy safely fetches the value for global configuration 'StorageS3Proxy' field
+func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
+
+// SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
+func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
+
+// GetStorageS3RedirectURL safely fetches the Configuration value for state's 'StorageS3RedirectURL' field
+func (st *ConfigState) GetStorageS3RedirectURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3RedirectURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3RedirectURL safely sets the Configuration value for state's 'StorageS3RedirectURL' field
+func (st *ConfigState) SetStorageS3RedirectURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3RedirectURL = v
+	st.reloadToViper()
+}
+
+// StorageS3RedirectURLFlag returns the flag name for the 'StorageS3RedirectURL' field
+func StorageS3RedirectURLFlag() string { return "storage-s3-redirect-url" }
+
+// GetStorageS3RedirectURL safely fetches the value for global configuration 'StorageS3RedirectURL' field
+func GetStorageS3RedirectURL() string { return global.GetStorageS3RedirectURL() }
+
+// SetStorageS3RedirectURL safely sets the value for global configuration 'StorageS3RedirectURL' field
+func SetStorageS3RedirectURL(v string) { global.SetStorageS3RedirectURL(v) }
+
+// GetStorageAzureAccountName safely fetches the Configuration value for state's 'StorageAzureAccountName' field
+func (st *ConfigState) GetStorageAzureAccountName() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageAzureAccountName
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageAzureAccountName safely sets the Configuration value for state's 'StorageAzureAccountName' field
+func (st *ConfigState) SetStorageAzureAccountName(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageAzureAccountName = v
+	st.reloadToViper()
+}
+
+// StorageAzureAccountNameFlag returns the flag name for the 'StorageAzureAccountName' field
+func StorageAzureAccountNameFlag() string { return "storage-azure-account-name" }
+
+// GetStorageAzureAccountName safely fetches the value for global configuration 'StorageAzureAccountName' field
+func GetStorageAzureAccountName() string { return global.GetStorageAzureAccountName() }
+
+// SetStorageAzureAccountName safely sets the value for global configuration 'StorageAzureAccountName' field
+func SetStorageAzureAccountName(v string) { global.SetStorageAzureAccountName(v) }
+
+// GetStorageAzureAccountKey safely fetches the Configuration value for state's 'StorageAzureAccountKey' field
+func (st *ConfigState) GetStorageAzureAccountKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageAzureAccountKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageAzureAccountKey safely sets the Configuration value for state's 'StorageAzureAccountKey' field
+func (st *ConfigState) SetStorageAzureAccountKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageAzureAccountKey = v
+	st.reloadToViper()
+}
+
+// StorageAzureAccountKeyFlag returns the flag name for the 'StorageAzureAccountKey' field
+func StorageAzureAccountKeyFlag() string { return "storage-azure-account-key" }
+
+// GetStorageAzureAccountKey safely fetches the value for global configuration 'StorageAzureAccountKey' field
+func GetStorageAzureAccountKey() string { return global.GetStorageAzureAccountKey() }
+
+// SetStorageAzureAccountKey safely sets the value for global configuration 'StorageAzureAccountKey' field
+func SetStorageAzureAccountKey(v string) { global.SetStorageAzureAccountKey(v) }
+
+// GetStorageAzureContainer safely fetches the Configuration value for state's 'StorageAzureContainer' field
+func (st *ConfigState) GetStorageAzureContainer() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageAzureContainer
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageAzureContainer safely sets the Configuration value for state's 'StorageAzureContainer' field
+func (st *ConfigState) SetStorageAzureContainer(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageAzureContainer = v
+	st.reloadToViper()
+}
+
+// StorageAzureContainerFlag returns the flag name for the 'StorageAzureContainer' field
+func StorageAzureContainerFlag() string { return "storage-azure-container" }
+
+// GetStorageAzureContainer safely fetches the value for global configuration 'StorageAzureContainer' field
+func GetStorageAzureContainer() string { return global.GetStorageAzureContainer() }
+
+// SetStorageAzureContainer safely sets the value for global configuration 'StorageAzureContainer' field
+func SetStorageAzureContainer(v string) { global.SetStorageAzureContainer(v) }
+
+// GetStorageAzureEndpoint safely fetches the Configuration value for state's 'StorageAzureEndpoint' field
+func (st *ConfigState) GetStorageAzureEndpoint() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageAzureEndpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageAzureEndpoint safely sets the Configuration value for state's 'StorageAzureEndpoint' field
+func (st *ConfigState) SetStorageAzureEndpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageAzureEndpoint = v
+	st.reloadToViper()
+}
+
+// StorageAzureEndpointFlag returns the flag name for the 'StorageAzureEndpoint' field
+func StorageAzureEndpointFlag() string { return "storage-azure-endpoint" }
+
+// GetStorageAzureEndpoint safely fetches the value for global configuration 'StorageAzureEndpoint' field
+func GetStorageAzureEndpoint() string { return global.GetStorageAzureEndpoint() }
+
+// SetStorageAzureEndpoint safely sets the value for global configuration 'StorageAzureEndpoint' field
+func SetStorageAzureEndpoint(v string) { global.SetStorageAzureEndpoint(v) }
+
+// GetStorageAzureProxy safely fetches the Configuration value for state's 'StorageAzureProxy' field
+func (st *ConfigState) GetStorageAzureProxy() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StorageAzureProxy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageAzureProxy safely sets the Configuration value for state's 'StorageAzureProxy' field
+func (st *ConfigState) SetStorageAzureProxy(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageAzureProxy = v
+	st.reloadToViper()
+}
+
+// StorageAzureProxyFlag returns the flag name for the 'StorageAzureProxy' field
+func StorageAzureProxyFlag() string { return "storage-azure-proxy" }
+
+// GetStorageAzureProxy safely fetches the value for global configuration 'StorageAzureProxy' field
+func GetStorageAzureProxy() bool { return global.GetStorageAzureProxy() }
+
+// SetStorageAzureProxy safely sets the value for global configuration 'StorageAzureProxy' field
+func SetStorageAzureProxy(v bool) { global.SetStorageAzureProxy(v) }
+
+// GetStorageGCSBucket safely fetches the Configuration value for state's 'StorageGCSBucket' field
+func (st *ConfigState) GetStorageGCSBucket() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageGCSBucket
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageGCSBucket safely sets the Configuration value for state's 'StorageGCSBucket' field
+func (st *ConfigState) SetStorageGCSBucket(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageGCSBucket = v
+	st.reloadToViper()
+}
+
+// StorageGCSBucketFlag returns the flag name for the 'StorageGCSBucket' field
+func StorageGCSBucketFlag() string { return "storage-gcs-bucket" }
+
+// GetStorageGCSBucket safely fetches the value for global configuration 'StorageGCSBucket' field
+func GetStorageGCSBucket() string { return global.GetStorageGCSBucket() }
+
+// SetStorageGCSBucket safely sets the value for global configuration 'StorageGCSBucket' field
+func SetStorageGCSBucket(v string) { global.SetStorageGCSBucket(v) }
+
+// GetStorageGCSCredentialsFile safely fetches the Configuration value for state's 'StorageGCSCredentialsFile' field
+func (st *ConfigState) GetStorageGCSCredentialsFile() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageGCSCredentialsFile
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageGCSCredentialsFile safely sets the Configuration value for state's 'StorageGCSCredentialsFile' field
+func (st *ConfigState) SetStorageGCSCredentialsFile(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageGCSCredentialsFile = v
+	st.reloadToViper()
+}
+
+// StorageGCSCredentialsFileFlag returns the flag name for the 'StorageGCSCredentialsFile' field
+func StorageGCSCredentialsFileFlag() string { return "storage-gcs-credentials-file" }
+
+// GetStorageGCSCredentialsFile safely fetches the value for global configuration 'StorageGCSCredentialsFile' field
+func GetStorageGCSCredentialsFile() string { return global.GetStorageGCSCredentialsFile() }
+
+// SetStorageGCSCredentialsFile safely sets the value for global configuration 'StorageGCSCredentialsFile' field
+func SetStorageGCSCredentialsFile(v string) { global.SetStorageGCSCredentialsFile(v) }
+
+// GetStorageGCSEndpoint safely fetches the Configuration value for state's 'StorageGCSEndpoint' field
+func (st *ConfigState) GetStorageGCSEndpoint() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageGCSEndpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageGCSEndpoint safely sets the Configuration value for state's 'StorageGCSEndpoint' field
+func (st *ConfigState) SetStorageGCSEndpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageGCSEndpoint = v
+	st.reloadToViper()
+}
+
+// StorageGCSEndpointFlag returns the flag name for the 'StorageGCSEndpoint' field
+func StorageGCSEndpointFlag() string { return "storage-gcs-endpoint" }
+
+// GetStorageGCSEndpoint safely fetches the value for global configuration 'StorageGCSEndpoint' field
+func GetStorageGCSEndpoint() string { return global.GetStorageGCSEndpoint() }
+
+// SetStorageGCSEndpoint safely sets the value for global configuration 'StorageGCSEndpoint' field
+func SetStorageGCSEndpoint(v string) { global.SetStorageGCSEndpoint(v) }
+
+// GetStorageGCSProxy safely fetches the Configuration value for state's 'StorageGCSProxy' field
+func (st *ConfigState) GetStorageGCSProxy() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StorageGCSProxy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageGCSProxy safely sets the Configuration value for state's 'StorageGCSProxy' field
+func (st *ConfigState) SetStorageGCSProxy(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageGCSProxy = v
+	st.reloadToViper()
+}
+
+// StorageGCSProxyFlag returns the flag name for the 'StorageGCSProxy' field
+func StorageGCSProxyFlag() string { return "storage-gcs-proxy" }
+
+// GetStorageGCSProxy safely fetches the value for global configuration 'StorageGCSProxy' field
+func GetStorageGCSProxy() bool { return global.GetStorageGCSProxy() }
+
+// SetStorageGCSProxy safely sets the value for global configuration 'StorageGCSProxy' field
+func SetStorageGCSProxy(v bool) { global.SetStorageGCSProxy(v) }
+
+// GetSearchBackend safely fetches the Configuration value for state's 'SearchBackend' field
+func (st *ConfigState) GetSearchBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.SearchBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSearchBackend safely sets the Configuration value for state's 'SearchBackend' field
+func (st *ConfigState) SetSearchBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SearchBackend = v
+	st.reloadToViper()
+}
+
+// SearchBackendFlag returns the flag name for the 'SearchBackend' field
+func SearchBackendFlag() string { return "search-backend" }
+
+// GetSearchBackend safely fetches the value for global configuration 'SearchBackend' field
+func GetSearchBackend() string { return global.GetSearchBackend() }
+
+// SetSearchBackend safely sets the value for global configuration 'SearchBackend' field
+func SetSearchBackend(v string) { global.SetSearchBackend(v) }
+
+// GetSearchMeilisearchURL safely fetches the Configuration value for state's 'SearchMeilisearchURL' field
+func (st *ConfigState) GetSearchMeilisearchURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.SearchMeilisearchURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSearchMeilisearchURL safely sets the Configuration value for state's 'SearchMeilisearchURL' field
+func (st *ConfigState) SetSearchMeilisearchURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SearchMeilisearchURL = v
+	st.reloadToViper()
+}
+
+// SearchMeilisearchURLFlag returns the flag name for the 'SearchMeilisearchURL' field
+func SearchMeilisearchURLFlag() string { return "search-meilisearch-url" }
+
+// GetSearchMeilisearchURL safely fetches the value for global configuration 'SearchMeilisearchURL' field
+func GetSearchMeilisearchURL() string { return global.GetSearchMeilisearchURL() }
+
+// SetSearchMeilisearchURL safely sets the value for global configuration 'SearchMeilisearchURL' field
+func SetSearchMeilisearchURL(v string) { global.SetSearchMeilisearchURL(v) }
+
+// GetSearchMeilisearchAPIKey safely fetches the Configuration value for state's 'SearchMeilisearchAPIKey' field
+func (st *ConfigState) GetSearchMeilisearchAPIKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.SearchMeilisearchAPIKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSearchMeilisearchAPIKey safely sets the Configuration value for state's 'SearchMeilisearchAPIKey' field
+func (st *ConfigState) SetSearchMeilisearchAPIKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SearchMeilisearchAPIKey = v
+	st.reloadToViper()
+}
+
+// SearchMeilisearchAPIKeyFlag returns the flag name for the 'SearchMeilisearchAPIKey' field
+func SearchMeilisearchAPIKeyFlag() string { return "search-meilisearch-api-key" }
+
+// GetSearchMeilisearchAPIKey safely fetches the value for global configuration 'SearchMeilisearchAPIKey' field
+func GetSearchMeilisearchAPIKey() string { return global.GetSearchMeilisearchAPIKey() }
+
+// SetSearchMeilisearchAPIKey safely sets the value for global configuration 'SearchMeilisearchAPIKey' field
+func SetSearchMeilisearchAPIKey(v string) { global.SetSearchMeilisearchAPIKey(v) }
+
+// GetSearchMeilisearchIndex safely fetches the Configuration value for state's 'SearchMeilisearchIndex' field
+func (st *ConfigState) GetSearchMeilisearchIndex() (v string) {
+	st.mutex.RLock()
+	v = st.config.SearchMeilisearchIndex
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSearchMeilisearchIndex safely sets the Configuration value for state's 'SearchMeilisearchIndex' field
+func (st *ConfigState) SetSearchMeilisearchIndex(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SearchMeilisearchIndex = v
+	st.reloadToViper()
+}
+
+// SearchMeilisearchIndexFlag returns the flag name for the 'SearchMeilisearchIndex' field
+func SearchMeilisearchIndexFlag() string { return "search-meilisearch-index" }
+
+// GetSearchMeilisearchIndex safely fetches the value for global configuration 'SearchMeilisearchIndex' field
+func GetSearchMeilisearchIndex() string { return global.GetSearchMeilisearchIndex() }
+
+// SetSearchMeilisearchIndex safely sets the value for global configuration 'SearchMeilisearchIndex' field
+func SetSearchMeilisearchIndex(v string) { global.SetSearchMeilisearchIndex(v) }
+
+// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) GetStatusesMaxChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesMaxChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
+func (st *ConfigState) SetStatusesMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesMaxCharsFlag returns the flag name for the 'StatusesMaxChars' field
+func StatusesMaxCharsFlag() string { return "statuses-max-chars" }
+
+// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
+func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+
+// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
+func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+
+// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesPollMaxOptions
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
+func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollMaxOptions = v
+	st.reloadToViper()
+}
+
+// StatusesPollMaxOptionsFlag returns the flag name for the 'StatusesPollMaxOptions' field
+func StatusesPollMaxOptionsFlag() string { return "statuses-poll-max-options" }
+
+// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
+func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+
+// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
+func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+
+// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesPollOptionMaxChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
+func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollOptionMaxChars = v
+	st.reloadToViper()
+}
+
+// StatusesPollOptionMaxCharsFlag returns the flag name for the 'StatusesPollOptionMaxChars' field
+func StatusesPollOptionMaxCharsFlag() string { return "statuses-poll-option-max-chars" }
+
+// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
+func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+
+// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
+func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+
+// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesMediaMaxFiles
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
+func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMediaMaxFiles = v
+	st.reloadToViper()
+}
+
+// StatusesMediaMaxFilesFlag returns the flag name for the 'StatusesMediaMaxFiles' field
+func StatusesMediaMaxFilesFlag() string { return "statuses-media-max-files" }
+
+// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
+func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+
+// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
+func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+
+// GetTrendsEnabled safely fetches the Configuration value for state's 'TrendsEnabled' field
+func (st *ConfigState) GetTrendsEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.TrendsEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTrendsEnabled safely sets the Configuration value for state's 'TrendsEnabled' field
+func (st *ConfigState) SetTrendsEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TrendsEnabled = v
+	st.reloadToViper()
+}
+
+// TrendsEnabledFlag returns the flag name for the 'TrendsEnabled' field
+func TrendsEnabledFlag() string { return "trends-enabled" }
+
+// GetTrendsEnabled safely fetches the value for global configuration 'TrendsEnabled' field
+func GetTrendsEnabled() bool { return global.GetTrendsEnabled() }
+
+// SetTrendsEnabled safely sets the value for global configuration 'TrendsEnabled' field
+func SetTrendsEnabled(v bool) { global.SetTrendsEnabled(v) }
+
+// GetTrendsRequireReview safely fetches the Configuration value for state's 'TrendsRequireReview' field
+func (st *ConfigState) GetTrendsRequireReview() (v bool) {
+	st.mutex.RLock()
+	v = st.config.TrendsRequireReview
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTrendsRequireReview safely sets the Configuration value for state's 'TrendsRequireReview' field
+func (st *ConfigState) SetTrendsRequireReview(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TrendsRequireReview = v
+	st.reloadToViper()
+}
+
+// TrendsRequireReviewFlag returns the flag name for the 'TrendsRequireReview' field
+func TrendsRequireReviewFlag() string { return "trends-require-review" }
+
+// GetTrendsRequireReview safely fetches the value for global configuration 'TrendsRequireReview' field
+func GetTrendsRequireReview() bool { return global.GetTrendsRequireReview() }
+
+// SetTrendsRequireReview safely sets the value for global configuration 'TrendsRequireReview' field
+func SetTrendsRequireReview(v bool) { global.SetTrendsRequireReview(v) }
+
+// GetTrendsMinAccounts safely fetches the Configuration value for state's 'TrendsMinAccounts' field
+func (st *ConfigState) GetTrendsMinAccounts() (v int) {
+	st.mutex.RLock()
+	v = st.config.TrendsMinAccounts
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTrendsMinAccounts safely sets the Configuration value for state's 'TrendsMinAccounts' field
+func (st *ConfigState) SetTrendsMinAccounts(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TrendsMinAccounts = v
+	st.reloadToViper()
+}
+
+// TrendsMinAccountsFlag returns the flag name for the 'TrendsMinAccounts' field
+func TrendsMinAccountsFlag() string { return "trends-min-accounts" }
+
+// GetTrendsMinAccounts safely fetches the value for global configuration 'TrendsMinAccounts' field
+func GetTrendsMinAccounts() int { return global.GetTrendsMinAccounts() }
+
+// SetTrendsMinAccounts safely sets the value for global configuration 'TrendsMinAccounts' field
+func SetTrendsMinAccounts(v int) { global.SetTrendsMinAccounts(v) }
+
+// GetTranslationProvider safely fetches the Configuration value for state's 'TranslationProvider' field
+func (st *ConfigState) GetTranslationProvider() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationProvider
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationProvider safely sets the Configuration value for state's 'TranslationProvider' field
+func (st *ConfigState) SetTranslationProvider(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationProvider = v
+	st.reloadToViper()
+}
+
+// TranslationProviderFlag returns the flag name for the 'TranslationProvider' field
+func TranslationProviderFlag() string { return "translation-provider" }
+
+// GetTranslationProvider safely fetches the value for global configuration 'TranslationProvider' field
+func GetTranslationProvider() string { return global.GetTranslationProvider() }
+
+// SetTranslationProvider safely sets the value for global configuration 'TranslationProvider' field
+func SetTranslationProvider(v string) { global.SetTranslationProvider(v) }
+
+// GetTranslationEndpoint safely fetches the Configuration value for state's 'TranslationEndpoint' field
+func (st *ConfigState) GetTranslationEndpoint() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationEndpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationEndpoint safely sets the Configuration value for state's 'TranslationEndpoint' field
+func (st *ConfigState) SetTranslationEndpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationEndpoint = v
+	st.reloadToViper()
+}
+
+// TranslationEndpointFlag returns the flag name for the 'TranslationEndpoint' field
+func TranslationEndpointFlag() string { return "translation-endpoint" }
+
+// GetTranslationEndpoint safely fetches the value for global configuration 'TranslationEndpoint' field
+func GetTranslationEndpoint() string { return global.GetTranslationEndpoint() }
+
+// SetTranslationEndpoint safely sets the value for global configuration 'TranslationEndpoint' field
+func SetTranslationEndpoint(v string) { global.SetTranslationEndpoint(v) }
+
+// GetTranslationAPIKey safely fetches the Configuration value for state's 'TranslationAPIKey' field
+func (st *ConfigState) GetTranslationAPIKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationAPIKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationAPIKey safely sets the Configuration value for state's 'TranslationAPIKey' field
+func (st *ConfigState) SetTranslationAPIKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationAPIKey = v
+	st.reloadToViper()
+}
+
+// TranslationAPIKeyFlag returns the flag name for the 'TranslationAPIKey' field
+func TranslationAPIKeyFlag() string { return "translation-api-key" }
+
+// GetTranslationAPIKey safely fetches the value for global configuration 'TranslationAPIKey' field
+func GetTranslationAPIKey() string { return global.GetTranslationAPIKey() }
+
+// SetTranslationAPIKey safely sets the value for global configuration 'TranslationAPIKey' field
+func SetTranslationAPIKey(v string) { global.SetTranslationAPIKey(v) }
+
+// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEnabled = v
+	st.reloadToViper()
+}
+
+// LetsEncryptEnabledFlag returns the flag name for the 'LetsEncryptEnabled' field
+func LetsEncryptEnabledFlag() string { return "letsencrypt-enabled" }
+
+// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
+func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+
+// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
+func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+
+// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) GetLetsEncryptPort() (v int) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptPort
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) SetLetsEncryptPort(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptPort = v
+	st.reloadToViper()
+}
+
+// LetsEncryptPortFlag returns the flag name for the 'LetsEncryptPort' field
+func LetsEncryptPortFlag() string { return "letsencrypt-port" }
+
+// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
+func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+
+// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
+func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+
+// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptCertDir
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptCertDir = v
+	st.reloadToViper()
+}
+
+// LetsEncryptCertDirFlag returns the flag name for the 'LetsEncryptCertDir' field
+func LetsEncryptCertDirFlag() string { return "letsencrypt-cert-dir" }
+
+// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
+func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+
+// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
+func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+
+// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptEmailAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEmailAddress = v
+	st.reloadToViper()
+}
+
+// LetsEncryptEmailAddressFlag returns the flag name for the 'LetsEncryptEmailAddress' field
+func LetsEncryptEmailAddressFlag() string { return "letsencrypt-email-address" }
+
+// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
+func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+
+// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
+func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+
+// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) GetTLSCertificateChain() (v string) {
+	st.mutex.RLock()
+	v = st.config.TLSCertificateChain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) SetTLSCertificateChain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TLSCertificateChain = v
+	st.reloadToViper()
+}
+
+// TLSCertificateChainFlag returns the flag name for the 'TLSCertificateChain' field
+func TLSCertificateChainFlag() string { return "tls-certificate-chain" }
+
+// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
+func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+
+// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
+func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+
+// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) GetTLSCertificateKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.TLSCertificateKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) SetTLSCertificateKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TLSCertificateKey = v
+	st.reloadToViper()
+}
+
+// TLSCertificateKeyFlag returns the flag name for the 'TLSCertificateKey' field
+func TLSCertificateKeyFlag() string { return "tls-certificate-key" }
+
+// GetTLSCertificateKey safely fetches the value for global configuration 'TLSCertificateKey' field
+func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
+
+// SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
+func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
+
+// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) GetOIDCEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.OIDCEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCEnabled safely sets the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) SetOIDCEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCEnabled = v
+	st.reloadToViper()
+}
+
+// OIDCEnabledFlag returns the flag name for the 'OIDCEnabled' field
+func OIDCEnabledFlag() string { return "oidc-enabled" }
+
+// GetOIDCEnabled safely fetches the value for global configuration 'OIDCEnabled' field
+func GetOIDCEnabled() bool { return global.GetOIDCEnabled() }
+
+// SetOIDCEnabled safely sets the value for global configuration 'OIDCEnabled' field
+func SetOIDCEnabled(v bool) { global.SetOIDCEnabled(v) }
+
+// GetOIDCIdpName safely fetches the Configuration value for state's 'OIDCIdpName' field
+func (st *ConfigState) GetOIDCIdpName() (v string) {
+	st.mutex.RLock()
+	v = st.config.OIDCIdpName
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCIdpName safely sets the Configuration value for state's 'OIDCIdpName' field
+func (st *ConfigState) SetOIDCIdpName(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCIdpName = v
+	st.reloadToViper()
+}
+
+// OIDCIdpNameFlag returns the flag name for the 'OIDCIdpName' field
+func OIDCIdpNameFlag() string { return "oidc-idp-name" }
+
+// GetOIDCIdpName safely fetches the value for global configuration 'OIDCIdpName' field
+func GetOIDCIdpName() string { return global.GetOIDCIdpName() }
+
+// SetOIDCIdpName safely sets the value for global configuration 'OIDCIdpName' field
+func SetOIDCIdpName(v string) { global.SetOIDCIdpName(v) }
+
+// GetOIDCSkipVerification safely fetches the Configuration value for state's 'OIDCSkipVerification' field
+func (st *ConfigState) GetOIDCSkipVerification() (v bool) {
+	st.mutex.RLock()
+	v = st.config.OIDCSkipVerification
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCSkipVerification safely sets the Configuration value for state's 'OIDCSkipVerification' field
+func (st *ConfigState) SetOIDCSkipVerification(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCSkipVerification = v
+	st.reloadToViper()
+}
+
+// OIDCSkipVerificationFlag returns the flag name for the 'OIDCSkipVerification' field
+func OIDCSkipVerificationFlag() string { return "oidc-skip-verification" }
+
+// GetOIDCSkipVerification safely fetches the value for global configuration 'OIDCSkipVerification' field
+func GetOIDCSkipVerification() bool { return global.GetOIDCSkipVerification() }
+
+// SetOIDCSkipVerification safely sets the value for global configuration 'OIDCSkipVerification' field
+func SetOIDCSkipVerification(v bool) { global.SetOIDCSkipVerification(v) }
+
+// GetOIDCIssuer safely fetches the Configuration value for state's 'OIDCIssuer' field
+func (st *ConfigState) GetOIDCIssuer() (v string) {
+	st.mutex.RLock()
+	v = st.config.OIDCIssuer
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCIssuer safely sets the Configuration value for state's 'OIDCIssuer' field
+func (st *ConfigState) SetOIDCIssuer(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCIssuer = v
+	st.reloadToViper()
+}
+
+// OIDCIssuerFlag returns the flag name for the 'OIDCIssuer' field
+func OIDCIssuerFlag() string { return "oidc-issuer" }
+
+// GetOIDCIssuer safely fetches the value for global configuration 'OIDCIssuer' field
+func GetOIDCIssuer() string { return global.GetOIDCIssuer() }
+
+// SetOIDCIssuer safely sets the value for global configuration 'OIDCIssuer' field
+func SetOIDCIssuer(v string) { global.SetOIDCIssuer(v) }
+
+// GetOIDCClientID safely fetches the Configuration value for state's 'OIDCClientID' field
+func (st *ConfigState) GetOIDCClientID() (v string) {
+	st.mutex.RLock()
+	v = st.config.OIDCClientID
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCClientID safely sets the Configuration value for state's 'OIDCClientID' field
+func (st *ConfigState) SetOIDCClientID(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCClientID = v
+	st.reloadToViper()
+}
+
+// OIDCClientIDFlag returns the flag name for the 'OIDCClientID' field
+func OIDCClientIDFlag() string { return "oidc-client-id" }
+
+// GetOIDCClientID safely fetches the value for global configuration 'OIDCClientID' field
+func GetOIDCClientID() string { return global.GetOIDCClientID() }
+
+// SetOIDCClientID safely sets the value for global configuration 'OIDCClientID' field
+func SetOIDCClientID(v string) { global.SetOIDCClientID(v) }
+
+// GetOIDCClientSecret safely fetches the Configuration value for state's 'OIDCClientSecret' field
+func (st *ConfigState) GetOIDCClientSecret() (v string) {
+	st.mutex.RLock()
+	v = st.config.OIDCClientSecret
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCClientSecret safely sets the Configuration value for state's 'OIDCClientSecret' field
+func (st *ConfigState) SetOIDCClientSecret(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCClientSecret = v
+	st.reloadToViper()
+}
+
+// OIDCClientSecretFlag returns the flag name for the 'OIDCClientSecret' field
+func OIDCClientSecretFlag() string { return "oidc-client-secret" }
+
+// GetOIDCClientSecret safely fetches the value for global configuration 'OIDCClientSecret' field
+func GetOIDCClientSecret() string { return global.GetOIDCClientSecret() }
+
+// SetOIDCClientSecret safely sets the value for global configuration 'OIDCClientSecret' field
+func SetOIDCClientSecret(v string) { global.SetOIDCClientSecret(v) }
+
+// GetOIDCScopes safely fetches the Configuration value for state's 'OIDCScopes' field
+func (st *ConfigState) GetOIDCScopes() (v []string) {
+	st.mutex.RLock()
+	v = st.config.OIDCScopes
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCScopes safely sets the Configuration value for state's 'OIDCScopes' field
+func (st *ConfigState) SetOIDCScopes(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCScopes = v
+	st.reloadToViper()
+}
+
+// OIDCScopesFlag returns the flag name for the 'OIDCScopes' field
+func OIDCScopesFlag() string { return "oidc-scopes" }
+
+// GetOIDCScopes safely fetches the value for global configuration 'OIDCScopes' field
+func GetOIDCScopes() []string { return global.GetOIDCScopes() }
+
+// SetOIDCScopes safely sets the value for global configuration 'OIDCScopes' field
+func SetOIDCScopes(v []string) { global.SetOIDCScopes(v) }
+
+// GetOIDCLinkExisting safely fetches the Configuration value for state's 'OIDCLinkExisting' field
+func (st *ConfigState) GetOIDCLinkExisting() (v bool) {
+	st.mutex.RLock()
+	v = st.config.OIDCLinkExisting
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCLinkExisting safely sets the Configuration value for state's 'OIDCLinkExisting' field
+func (st *ConfigState) SetOIDCLinkExisting(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCLinkExisting = v
+	st.reloadToViper()
+}
+
+// OIDCLinkExistingFlag returns the flag name for the 'OIDCLinkExisting' field
+func OIDCLinkExistingFlag() string { return "oidc-link-existing" }
+
+// GetOIDCLinkExisting safely fetches the value for global configuration 'OIDCLinkExisting' field
+func GetOIDCLinkExisting() bool { return global.GetOIDCLinkExisting() }
+
+// SetOIDCLinkExisting safely sets the value for global configuration 'OIDCLinkExisting' field
+func SetOIDCLinkExisting(v bool) { global.SetOIDCLinkExisting(v) }
+
+// GetOIDCAllowedGroups safely fetches the Configuration value for state's 'OIDCAllowedGroups' field
+func (st *ConfigState) GetOIDCAllowedGroups() (v []string) {
+	st.mutex.RLock()
+	v = st.config.OIDCAllowedGroups
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCAllowedGroups safely sets the Configuration value for state's 'OIDCAllowedGroups' field
+func (st *ConfigState) SetOIDCAllowedGroups(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCAllowedGroups = v
+	st.reloadToViper()
+}
+
+// OIDCAllowedGroupsFlag returns the flag name for the 'OIDCAllowedGroups' field
+func OIDCAllowedGroupsFlag() string { return "oidc-allowed-groups" }
+
+// GetOIDCAllowedGroups safely fetches the value for global configuration 'OIDCAllowedGroups' field
+func GetOIDCAllowedGroups() []string { return global.GetOIDCAllowedGroups() }
+
+// SetOIDCAllowedGroups safely sets the value for global configuration 'OIDCAllowedGroups' field
+func SetOIDCAllowedGroups(v []string) { global.SetOIDCAllowedGroups(v) }
+
+// GetOIDCAdminGroups safely fetches the Configuration value for state's 'OIDCAdminGroups' field
+func (st *ConfigState) GetOIDCAdminGroups() (v []string) {
+	st.mutex.RLock()
+	v = st.config.OIDCAdminGroups
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCAdminGroups safely sets the Configuration value for state's 'OIDCAdminGroups' field
+func (st *ConfigState) SetOIDCAdminGroups(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCAdminGroups = v
+	st.reloadToViper()
+}
+
+// OIDCAdminGroupsFlag returns the flag name for the 'OIDCAdminGroups' field
+func OIDCAdminGroupsFlag() string { return "oidc-admin-groups" }
+
+// GetOIDCAdminGroups safely fetches the value for global configuration 'OIDCAdminGroups' field
+func GetOIDCAdminGroups() []string { return global.GetOIDCAdminGroups() }
+
+// SetOIDCAdminGroups safely sets the value for global configuration 'OIDCAdminGroups' field
+func SetOIDCAdminGroups(v []string) { global.SetOIDCAdminGroups(v) }
+
+// GetOIDCModeratorGroups safely fetches the Configuration value for state's 'OIDCModeratorGroups' field
+func (st *ConfigState) GetOIDCModeratorGroups() (v []string) {
+	st.mutex.RLock()
+	v = st.config.OIDCModeratorGroups
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCModeratorGroups safely sets the Configuration value for state's 'OIDCModeratorGroups' field
+func (st *ConfigState) SetOIDCModeratorGroups(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCModeratorGroups = v
+	st.reloadToViper()
+}
+
+// OIDCModeratorGroupsFlag returns the flag name for the 'OIDCModeratorGroups' field
+func OIDCModeratorGroupsFlag() string { return "oidc-moderator-groups" }
+
+// GetOIDCModeratorGroups safely fetches the value for global configuration 'OIDCModeratorGroups' field
+func GetOIDCModeratorGroups() []string { return global.GetOIDCModeratorGroups() }
+
+// SetOIDCModeratorGroups safely sets the value for global configuration 'OIDCModeratorGroups' field
+func SetOIDCModeratorGroups(v []string) { global.SetOIDCModeratorGroups(v) }
+
+// GetOIDCGroupsClaim safely fetches the Configuration value for state's 'OIDCGroupsClaim' field
+func (st *ConfigState) GetOIDCGroupsClaim() (v string) {
+	st.mutex.RLock()
+	v = st.config.OIDCGroupsClaim
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCGroupsClaim safely sets the Configuration value for state's 'OIDCGroupsClaim' field
+func (st *ConfigState) SetOIDCGroupsClaim(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCGroupsClaim = v
+	st.reloadToViper()
+}
+
+// OIDCGroupsClaimFlag returns the flag name for the 'OIDCGroupsClaim' field
+func OIDCGroupsClaimFlag() string { return "oidc-groups-claim" }
+
+// GetOIDCGroupsClaim safely fetches the value for global configuration 'OIDCGroupsClaim' field
+func GetOIDCGroupsClaim() string { return global.GetOIDCGroupsClaim() }
+
+// SetOIDCGroupsClaim safely sets the value for global configuration 'OIDCGroupsClaim' field
+func SetOIDCGroupsClaim(v string) { global.SetOIDCGroupsClaim(v) }
+
+// GetOIDCSyncRoles safely fetches the Configuration value for state's 'OIDCSyncRoles' field
+func (st *ConfigState) GetOIDCSyncRoles() (v bool) {
+	st.mutex.RLock()
+	v = st.config.OIDCSyncRoles
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOIDCSyncRoles safely sets the Configuration value for state's 'OIDCSyncRoles' field
+func (st *ConfigState) SetOIDCSyncRoles(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OIDCSyncRoles = v
+	st.reloadToViper()
+}
+
+// OIDCSyncRolesFlag returns the flag name for the 'OIDCSyncRoles' field
+func OIDCSyncRolesFlag() string { return "oidc-sync-roles" }
+
+// GetOIDCSyncRoles safely fetches the value for global configuration 'OIDCSyncRoles' field
+func GetOIDCSyncRoles() bool { return global.GetOIDCSyncRoles() }
+
+// SetOIDCSyncRoles safely sets the value for global configuration 'OIDCSyncRoles' field
+func SetOIDCSyncRoles(v bool) { global.SetOIDCSyncRoles(v) }
+
+// GetLDAPEnabled safely fetches the Configuration value for state's 'LDAPEnabled' field
+func (st *ConfigState) GetLDAPEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.LDAPEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPEnabled safely sets the Configuration value for state's 'LDAPEnabled' field
+func (st *ConfigState) SetLDAPEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPEnabled = v
+	st.reloadToViper()
+}
+
+// LDAPEnabledFlag returns the flag name for the 'LDAPEnabled' field
+func LDAPEnabledFlag() string { return "ldap-enabled" }
+
+// GetLDAPEnabled safely fetches the value for global configuration 'LDAPEnabled' field
+func GetLDAPEnabled() bool { return global.GetLDAPEnabled() }
+
+// SetLDAPEnabled safely sets the value for global configuration 'LDAPEnabled' field
+func SetLDAPEnabled(v bool) { global.SetLDAPEnabled(v) }
+
+// GetLDAPURL safely fetches the Configuration value for state's 'LDAPURL' field
+func (st *ConfigState) GetLDAPURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPURL safely sets the Configuration value for state's 'LDAPURL' field
+func (st *ConfigState) SetLDAPURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPURL = v
+	st.reloadToViper()
+}
+
+// LDAPURLFlag returns the flag name for the 'LDAPURL' field
+func LDAPURLFlag() string { return "ldap-url" }
+
+// GetLDAPURL safely fetches the value for global configuration 'LDAPURL' field
+func GetLDAPURL() string { return global.GetLDAPURL() }
+
+// SetLDAPURL safely sets the value for global configuration 'LDAPURL' field
+func SetLDAPURL(v string) { global.SetLDAPURL(v) }
+
+// GetLDAPStartTLS safely fetches the Configuration value for state's 'LDAPStartTLS' field
+func (st *ConfigState) GetLDAPStartTLS() (v bool) {
+	st.mutex.RLock()
+	v = st.config.LDAPStartTLS
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPStartTLS safely sets the Configuration value for state's 'LDAPStartTLS' field
+func (st *ConfigState) SetLDAPStartTLS(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPStartTLS = v
+	st.reloadToViper()
+}
+
+// LDAPStartTLSFlag returns the flag name for the 'LDAPStartTLS' field
+func LDAPStartTLSFlag() string { return "ldap-start-tls" }
+
+// GetLDAPStartTLS safely fetches the value for global configuration 'LDAPStartTLS' field
+func GetLDAPStartTLS() bool { return global.GetLDAPStartTLS() }
+
+// SetLDAPStartTLS safely sets the value for global configuration 'LDAPStartTLS' field
+func SetLDAPStartTLS(v bool) { global.SetLDAPStartTLS(v) }
+
+// GetLDAPBindDN safely fetches the Configuration value for state's 'LDAPBindDN' field
+func (st *ConfigState) GetLDAPBindDN() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPBindDN
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPBindDN safely sets the Configuration value for state's 'LDAPBindDN' field
+func (st *ConfigState) SetLDAPBindDN(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPBindDN = v
+	st.reloadToViper()
+}
+
+// LDAPBindDNFlag returns the flag name for the 'LDAPBindDN' field
+func LDAPBindDNFlag() string { return "ldap-bind-dn" }
+
+// GetLDAPBindDN safely fetches the value for global configuration 'LDAPBindDN' field
+func GetLDAPBindDN() string { return global.GetLDAPBindDN() }
+
+// SetLDAPBindDN safely sets the value for global configuration 'LDAPBindDN' field
+func SetLDAPBindDN(v string) { global.SetLDAPBindDN(v) }
+
+// GetLDAPBindPassword safely fetches the Configuration value for state's 'LDAPBindPassword' field
+func (st *ConfigState) GetLDAPBindPassword() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPBindPassword
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPBindPassword safely sets the Configuration value for state's 'LDAPBindPassword' field
+func (st *ConfigState) SetLDAPBindPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPBindPassword = v
+	st.reloadToViper()
+}
+
+// LDAPBindPasswordFlag returns the flag name for the 'LDAPBindPassword' field
+func LDAPBindPasswordFlag() string { return "ldap-bind-password" }
+
+// GetLDAPBindPassword safely fetches the value for global configuration 'LDAPBindPassword' field
+func GetLDAPBindPassword() string { return global.GetLDAPBindPassword() }
+
+// SetLDAPBindPassword safely sets the value for global configuration 'LDAPBindPassword' field
+func SetLDAPBindPassword(v string) { global.SetLDAPBindPassword(v) }
+
+// GetLDAPBaseDN safely fetches the Configuration value for state's 'LDAPBaseDN' field
+func (st *ConfigState) GetLDAPBaseDN() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPBaseDN
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPBaseDN safely sets the Configuration value for state's 'LDAPBaseDN' field
+func (st *ConfigState) SetLDAPBaseDN(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPBaseDN = v
+	st.reloadToViper()
+}
+
+// LDAPBaseDNFlag returns the flag name for the 'LDAPBaseDN' field
+func LDAPBaseDNFlag() string { return "ldap-base-dn" }
+
+// GetLDAPBaseDN safely fetches the value for global configuration 'LDAPBaseDN' field
+func GetLDAPBaseDN() string { return global.GetLDAPBaseDN() }
+
+// SetLDAPBaseDN safely sets the value for global configuration 'LDAPBaseDN' field
+func SetLDAPBaseDN(v string) { global.SetLDAPBaseDN(v) }
+
+// GetLDAPUserFilter safely fetches the Configuration value for state's 'LDAPUserFilter' field
+func (st *ConfigState) GetLDAPUserFilter() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPUserFilter
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPUserFilter safely sets the Configuration value for state's 'LDAPUserFilter' field
+func (st *ConfigState) SetLDAPUserFilter(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPUserFilter = v
+	st.reloadToViper()
+}
+
+// LDAPUserFilterFlag returns the flag name for the 'LDAPUserFilter' field
+func LDAPUserFilterFlag() string { return "ldap-user-filter" }
+
+// GetLDAPUserFilter safely fetches the value for global configuration 'LDAPUserFilter' field
+func GetLDAPUserFilter() string { return global.GetLDAPUserFilter() }
+
+// SetLDAPUserFilter safely sets the value for global configuration 'LDAPUserFilter' field
+func SetLDAPUserFilter(v string) { global.SetLDAPUserFilter(v) }
+
+// GetLDAPUsernameAttribute safely fetches the Configuration value for state's 'LDAPUsernameAttribute' field
+func (st *ConfigState) GetLDAPUsernameAttribute() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPUsernameAttribute
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPUsernameAttribute safely sets the Configuration value for state's 'LDAPUsernameAttribute' field
+func (st *ConfigState) SetLDAPUsernameAttribute(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPUsernameAttribute = v
+	st.reloadToViper()
+}
+
+// LDAPUsernameAttributeFlag returns the flag name for the 'LDAPUsernameAttribute' field
+func LDAPUsernameAttributeFlag() string { return "ldap-username-attribute" }
+
+// GetLDAPUsernameAttribute safely fetches the value for global configuration 'LDAPUsernameAttribute' field
+func GetLDAPUsernameAttribute() string { return global.GetLDAPUsernameAttribute() }
+
+// SetLDAPUsernameAttribute safely sets the value for global configuration 'LDAPUsernameAttribute' field
+func SetLDAPUsernameAttribute(v string) { global.SetLDAPUsernameAttribute(v) }
+
+// GetLDAPEmailAttribute safely fetches the Configuration value for state's 'LDAPEmailAttribute' field
+func (st *ConfigState) GetLDAPEmailAttribute() (v string) {
+	st.mutex.RLock()
+	v = st.config.LDAPEmailAttribute
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLDAPEmailAttribute safely sets the Configuration value for state's 'LDAPEmailAttribute' field
+func (st *ConfigState) SetLDAPEmailAttribute(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LDAPEmailAttribute = v
 	st.reloadToViper()
 }
 
-// StorageS3BucketNameFlag returns the flag name for the 'StorageS3BucketName' field
-func StorageS3BucketNameFlag() string { return "storage-s3-bucket" }
+// LDAPEmailAttributeFlag returns the flag name for the 'LDAPEmailAttribute' field
+func LDAPEmailAttributeFlag() string { return "ldap-email-attribute" }
 
-// GetStorageS3BucketName safely fetches the value for global configuration 'StorageS3BucketName' field
-func GetStorageS3BucketName() string { return global.GetStorageS3BucketName() }
+// GetLDAPEmailAttribute safely fetches the value for global configuration 'LDAPEmailAttribute' field
+func GetLDAPEmailAttribute() string { return global.GetLDAPEmailAttribute() }
 
-// SetStorageS3BucketName safely sets the value for global configuration 'StorageS3BucketName' field
-func SetStorageS3BucketName(v string) { global.SetStorageS3BucketName(v) }
+// SetLDAPEmailAttribute safely sets the value for global configuration 'LDAPEmailAttribute' field
+func SetLDAPEmailAttribute(v string) { global.SetLDAPEmailAttribute(v) }
 
-// GetStorageS3Proxy safely fetches the Configuration value for state's 'StorageS3Proxy' field
-func (st *ConfigState) GetStorageS3Proxy() (v bool) {
+// GetLDAPAutoProvision safely fetches the Configuration value for state's 'LDAPAutoProvision' field
+func (st *ConfigState) GetLDAPAutoProvision() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3Proxy
+	v = st.config.LDAPAutoProvision
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3Proxy safely sets the Configuration value for state's 'StorageS3Proxy' field
-func (st *ConfigState) SetStorageS3Proxy(v bool) {
+// SetLDAPAutoProvision safely sets the Configuration value for state's 'LDAPAutoProvision' field
+func (st *ConfigState) SetLDAPAutoProvision(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Proxy = v
+	st.config.LDAPAutoProvision = v
 	st.reloadToViper()
 }
 
-// StorageS3ProxyFlag returns the flag name for the 'StorageS3Proxy' field
-func StorageS3ProxyFlag() string { return "storage-s3-proxy" }
+// LDAPAutoProvisionFlag returns the flag name for the 'LDAPAutoProvision' field
+func LDAPAutoProvisionFlag() string { return "ldap-auto-provision" }
 
-// GetStorageS3Proxy safely fetches the value for global configuration 'StorageS3Proxy' field
-func GetStorageS3Proxy() bool { return global.GetStorageS3Proxy() }
+// GetLDAPAutoProvision safely fetches the value for global configuration 'LDAPAutoProvision' field
+func GetLDAPAutoProvision() bool { return global.GetLDAPAutoProvision() }
 
-// SetStorageS3Proxy safely sets the value for global configuration 'StorageS3Proxy' field
-func SetStorageS3Proxy(v bool) { global.SetStorageS3Proxy(v) }
+// SetLDAPAutoProvision safely sets the value for global configuration 'LDAPAutoProvision' field
+func SetLDAPAutoProvision(v bool) { global.SetLDAPAutoProvision(v) }
 
-// GetStorageS3RedirectURL safely fetches the Configuration value for state's 'StorageS3RedirectURL' field
-func (st *ConfigState) GetStorageS3RedirectURL() (v string) {
+// GetTracingEnabled safely fetches the Configuration value for state's 'TracingEnabled' field
+func (st *ConfigState) GetTracingEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3RedirectURL
+	v = st.config.TracingEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3RedirectURL safely sets the Configuration value for state's 'StorageS3RedirectURL' field
-func (st *ConfigState) SetStorageS3RedirectURL(v string) {
+// SetTracingEnabled safely sets the Configuration value for state's 'TracingEnabled' field
+func (st *ConfigState) SetTracingEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3RedirectURL = v
+	st.config.TracingEnabled = v
 	st.reloadToViper()
 }
 
-// StorageS3RedirectURLFlag returns the flag name for the 'StorageS3RedirectURL' field
-func StorageS3RedirectURLFlag() string { return "storage-s3-redirect-url" }
+// TracingEnabledFlag returns the flag name for the 'TracingEnabled' field
+func TracingEnabledFlag() string { return "tracing-enabled" }
 
-// GetStorageS3RedirectURL safely fetches the value for global configuration 'StorageS3RedirectURL' field
-func GetStorageS3RedirectURL() string { return global.GetStorageS3RedirectURL() }
+// GetTracingEnabled safely fetches the value for global configuration 'TracingEnabled' field
+func GetTracingEnabled() bool { return global.GetTracingEnabled() }
 
-// SetStorageS3RedirectURL safely sets the value for global configuration 'StorageS3RedirectURL' field
-func SetStorageS3RedirectURL(v string) { global.SetStorageS3RedirectURL(v) }
+// SetTracingEnabled safely sets the value for global configuration 'TracingEnabled' field
+func SetTracingEnabled(v bool) { global.SetTracingEnabled(v) }
 
-// GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) GetStatusesMaxChars() (v int) {
+// GetTracingTransport safely fetches the Configuration value for state's 'TracingTransport' field
+func (st *ConfigState) GetTracingTransport() (v string) {
 	st.mutex.RLock()
-	v = st.config.StatusesMaxChars
+	v = st.config.TracingTransport
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesMaxChars safely sets the Configuration value for state's 'StatusesMaxChars' field
-func (st *ConfigState) SetStatusesMaxChars(v int) {
+// SetTracingTransport safely sets the Configuration value for state's 'TracingTransport' field
+func (st *ConfigState) SetTracingTransport(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMaxChars = v
+	st.config.TracingTransport = v
 	st.reloadToViper()
 }
 
-// StatusesMaxCharsFlag returns the flag name for the 'StatusesMaxChars' field
-func StatusesMaxCharsFlag() string { return "statuses-max-chars" }
+// TracingTransportFlag returns the flag name for the 'TracingTransport' field
+func TracingTransportFlag() string { return "tracing-transport" }
 
-// GetStatusesMaxChars safely fetches the value for global configuration 'StatusesMaxChars' field
-func GetStatusesMaxChars() int { return global.GetStatusesMaxChars() }
+// GetTracingTransport safely fetches the value for global configuration 'TracingTransport' field
+func GetTracingTransport() string { return global.GetTracingTransport() }
 
-// SetStatusesMaxChars safely sets the value for global configuration 'StatusesMaxChars' field
-func SetStatusesMaxChars(v int) { global.SetStatusesMaxChars(v) }
+// SetTracingTransport safely sets the value for global configuration 'TracingTransport' field
+func SetTracingTransport(v string) { global.SetTracingTransport(v) }
 
-// GetStatusesPollMaxOptions safely fetches the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) GetStatusesPollMaxOptions() (v int) {
+// GetTracingEndpoint safely fetches the Configuration value for state's 'TracingEndpoint' field
+func (st *ConfigState) GetTracingEndpoint() (v string) {
 	st.mutex.RLock()
-	v = st.config.StatusesPollMaxOptions
+	v = st.config.TracingEndpoint
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesPollMaxOptions safely sets the Configuration value for state's 'StatusesPollMaxOptions' field
-func (st *ConfigState) SetStatusesPollMaxOptions(v int) {
+// SetTracingEndpoint safely sets the Configuration value for state's 'TracingEndpoint' field
+func (st *ConfigState) SetTracingEndpoint(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollMaxOptions = v
+	st.config.TracingEndpoint = v
 	st.reloadToViper()
 }
 
-// StatusesPollMaxOptionsFlag returns the flag name for the 'StatusesPollMaxOptions' field
-func StatusesPollMaxOptionsFlag() string { return "statuses-poll-max-options" }
+// TracingEndpointFlag returns the flag name for the 'TracingEndpoint' field
+func TracingEndpointFlag() string { return "tracing-endpoint" }
 
-// GetStatusesPollMaxOptions safely fetches the value for global configuration 'StatusesPollMaxOptions' field
-func GetStatusesPollMaxOptions() int { return global.GetStatusesPollMaxOptions() }
+// GetTracingEndpoint safely fetches the value for global configuration 'TracingEndpoint' field
+func GetTracingEndpoint() string { return global.GetTracingEndpoint() }
 
-// SetStatusesPollMaxOptions safely sets the value for global configuration 'StatusesPollMaxOptions' field
-func SetStatusesPollMaxOptions(v int) { global.SetStatusesPollMaxOptions(v) }
+// SetTracingEndpoint safely sets the value for global configuration 'TracingEndpoint' field
+func SetTracingEndpoint(v string) { global.SetTracingEndpoint(v) }
 
-// GetStatusesPollOptionMaxChars safely fetches the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) GetStatusesPollOptionMaxChars() (v int) {
+// GetTracingInsecureTransport safely fetches the Configuration value for state's 'TracingInsecureTransport' field
+func (st *ConfigState) GetTracingInsecureTransport() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StatusesPollOptionMaxChars
+	v = st.config.TracingInsecureTransport
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesPollOptionMaxChars safely sets the Configuration value for state's 'StatusesPollOptionMaxChars' field
-func (st *ConfigState) SetStatusesPollOptionMaxChars(v int) {
+// SetTracingInsecureTransport safely sets the Configuration value for state's 'TracingInsecureTransport' field
+func (st *ConfigState) SetTracingInsecureTransport(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesPollOptionMaxChars = v
+	st.config.TracingInsecureTransport = v
 	st.reloadToViper()
 }
 
-// StatusesPollOptionMaxCharsFlag returns the flag name for the 'StatusesPollOptionMaxChars' field
-func StatusesPollOptionMaxCharsFlag() string { return "statuses-poll-option-max-chars" }
+// TracingInsecureTransportFlag returns the flag name for the 'TracingInsecureTransport' field
+func TracingInsecureTransportFlag() string { return "tracing-insecure-transport" }
 
-// GetStatusesPollOptionMaxChars safely fetches the value for global configuration 'StatusesPollOptionMaxChars' field
-func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMaxChars() }
+// GetTracingInsecureTransport safely fetches the value for global configuration 'TracingInsecureTransport' field
+func GetTracingInsecureTransport() bool { return global.GetTracingInsecureTransport() }
 
-// SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
-func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
+// SetTracingInsecureTransport safely sets the value for global configuration 'TracingInsecureTransport' field
+func SetTracingInsecureTransport(v bool) { global.SetTracingInsecureTransport(v) }
 
-// GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
+// GetMetricsEnabled safely fetches the Configuration value for state's 'MetricsEnabled' field
+func (st *ConfigState) GetMetricsEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StatusesMediaMaxFiles
+	v = st.config.MetricsEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStatusesMediaMaxFiles safely sets the Configuration value for state's 'StatusesMediaMaxFiles' field
-func (st *ConfigState) SetStatusesMediaMaxFiles(v int) {
+// SetMetricsEnabled safely sets the Configuration value for state's 'MetricsEnabled' field
+func (st *ConfigState) SetMetricsEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StatusesMediaMaxFiles = v
+	st.config.MetricsEnabled = v
 	st.reloadToViper()
 }
 
-// StatusesMediaMaxFilesFlag returns the flag name for the 'StatusesMediaMaxFiles' field
-func StatusesMediaMaxFilesFlag() string { return "statuses-media-max-files" }
+// MetricsEnabledFlag returns the flag name for the 'MetricsEnabled' field
+func MetricsEnabledFlag() string { return "metrics-enabled" }
 
-// GetStatusesMediaMaxFiles safely fetches the value for global configuration 'StatusesMediaMaxFiles' field
-func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
+// GetMetricsEnabled safely fetches the value for global configuration 'MetricsEnabled' field
+func GetMetricsEnabled() bool { return global.GetMetricsEnabled() }
 
-// SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
-func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
+// SetMetricsEnabled safely sets the value for global configuration 'MetricsEnabled' field
+func SetMetricsEnabled(v bool) { global.SetMetricsEnabled(v) }
 
-// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+// GetMetricsAuthEnabled safely fetches the Configuration value for state's 'MetricsAuthEnabled' field
+func (st *ConfigState) GetMetricsAuthEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEnabled
+	v = st.config.MetricsAuthEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+// SetMetricsAuthEnabled safely sets the Configuration value for state's 'MetricsAuthEnabled' field
+func (st *ConfigState) SetMetricsAuthEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEnabled = v
+	st.config.MetricsAuthEnabled = v
 	st.reloadToViper()
 }
 
-// LetsEncryptEnabledFlag returns the flag name for the 'LetsEncryptEnabled' field
-func LetsEncryptEnabledFlag() string { return "letsencrypt-enabled" }
+// MetricsAuthEnabledFlag returns the flag name for the 'MetricsAuthEnabled' field
+func MetricsAuthEnabledFlag() string { return "metrics-auth-enabled" }
 
-// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
-func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+// GetMetricsAuthEnabled safely fetches the value for global configuration 'MetricsAuthEnabled' field
+func GetMetricsAuthEnabled() bool { return global.GetMetricsAuthEnabled() }
 
-// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
-func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+// SetMetricsAuthEnabled safely sets the value for global configuration 'MetricsAuthEnabled' field
+func SetMetricsAuthEnabled(v bool) { global.SetMetricsAuthEnabled(v) }
 
-// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) GetLetsEncryptPort() (v int) {
+// GetMetricsAuthUsername safely fetches the Configuration value for state's 'MetricsAuthUsername' field
+func (st *ConfigState) GetMetricsAuthUsername() (v string) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptPort
+	v = st.config.MetricsAuthUsername
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) SetLetsEncryptPort(v int) {
+// SetMetricsAuthUsername safely sets the Configuration value for state's 'MetricsAuthUsername' field
+func (st *ConfigState) SetMetricsAuthUsername(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptPort = v
+	st.config.MetricsAuthUsername = v
 	st.reloadToViper()
 }
 
-// LetsEncryptPortFlag returns the flag name for the 'LetsEncryptPort' field
-func LetsEncryptPortFlag() string { return "letsencrypt-port" }
+// MetricsAuthUsernameFlag returns the flag name for the 'MetricsAuthUsername' field
+func MetricsAuthUsernameFlag() string { return "metrics-auth-username" }
 
-// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
-func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+// GetMetricsAuthUsername safely fetches the value for global configuration 'MetricsAuthUsername' field
+func GetMetricsAuthUsername() string { return global.GetMetricsAuthUsername() }
 
-// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
-func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+// SetMetricsAuthUsername safely sets the value for global configuration 'MetricsAuthUsername' field
+func SetMetricsAuthUsername(v string) { global.SetMetricsAuthUsername(v) }
 
-// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+// GetMetricsAuthPassword safely fetches the Configuration value for state's 'MetricsAuthPassword' field
+func (st *ConfigState) GetMetricsAuthPassword() (v string) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptCertDir
+	v = st.config.MetricsAuthPassword
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+// SetMetricsAuthPassword safely sets the Configuration value for state's 'MetricsAuthPassword' field
+func (st *ConfigState) SetMetricsAuthPassword(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptCertDir = v
+	st.config.MetricsAuthPassword = v
 	st.reloadToViper()
 }
 
-// LetsEncryptCertDirFlag returns the flag name for the 'LetsEncryptCertDir' field
-func LetsEncryptCertDirFlag() string { return "letsencrypt-cert-dir" }
+// MetricsAuthPasswordFlag returns the flag name for the 'MetricsAuthPassword' field
+func MetricsAuthPasswordFlag() string { return "metrics-auth-password" }
 
-// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
-func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+// GetMetricsAuthPassword safely fetches the value for global configuration 'MetricsAuthPassword' field
+func GetMetricsAuthPassword() string { return global.GetMetricsAuthPassword() }
 
-// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
-func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+// SetMetricsAuthPassword safely sets the value for global configuration 'MetricsAuthPassword' field
+func SetMetricsAuthPassword(v string) { global.SetMetricsAuthPassword(v) }
 
-// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+// GetDebugEndpointsEnabled safely fetches the Configuration value for state's 'DebugEndpointsEnabled' field
+func (st *ConfigState) GetDebugEndpointsEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEmailAddress
+	v = st.config.DebugEndpointsEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+// SetDebugEndpointsEnabled safely sets the Configuration value for state's 'DebugEndpointsEnabled' field
+func (st *ConfigState) SetDebugEndpointsEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEmailAddress = v
+	st.config.DebugEndpointsEnabled = v
 	st.reloadToViper()
 }
 
-// LetsEncryptEmailAddressFlag returns the flag name for the 'LetsEncryptEmailAddress' field
-func LetsEncryptEmailAddressFlag() string { return "letsencrypt-email-address" }
+// DebugEndpointsEnabledFlag returns the flag name for the 'DebugEndpointsEnabled' field
+func DebugEndpointsEnabledFlag() string { return "debug-endpoints-enabled" }
 
-// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
-func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+// GetDebugEndpointsEnabled safely fetches the value for global configuration 'DebugEndpointsEnabled' field
+func GetDebugEndpointsEnabled() bool { return global.GetDebugEndpointsEnabled() }
 
-// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
-func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+// SetDebugEndpointsEnabled safely sets the value for global configuration 'DebugEndpointsEnabled' field
+func SetDebugEndpointsEnabled(v bool) { global.SetDebugEndpointsEnabled(v) }
 
-// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) GetTLSCertificateChain() (v string) {
+// GetDebugEndpointsToken safely fetches the Configuration value for state's 'DebugEndpointsToken' field
+func (st *ConfigState) GetDebugEndpointsToken() (v string) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateChain
+	v = st.config.DebugEndpointsToken
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) SetTLSCertificateChain(v string) {
+// SetDebugEndpointsToken safely sets the Configuration value for state's 'DebugEndpointsToken' field
+func (st *ConfigState) SetDebugEndpointsToken(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateChain = v
+	st.config.DebugEndpointsToken = v
 	st.reloadToViper()
 }
 
-// TLSCertificateChainFlag returns the flag name for the 'TLSCertificateChain' field
-func TLSCertificateChainFlag() string { return "tls-certificate-chain" }
+// DebugEndpointsTokenFlag returns the flag name for the 'DebugEndpointsToken' field
+func DebugEndpointsTokenFlag() string { return "debug-endpoints-token" }
 
-// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
-func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+// GetDebugEndpointsToken safely fetches the value for global configuration 'DebugEndpointsToken' field
+func GetDebugEndpointsToken() string { return global.GetDebugEndpointsToken() }
 
-// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
-func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+// SetDebugEndpointsToken safely sets the value for global configuration 'DebugEndpointsToken' field
+func SetDebugEndpointsToken(v string) { global.SetDebugEndpointsToken(v) }
 
-// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) GetTLSCertificateKey() (v string) {
+// GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
+func (st *ConfigState) GetSMTPHost() (v string) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateKey
+	v = st.config.SMTPHost
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) SetTLSCertificateKey(v string) {
+// SetSMTPHost safely sets the Configuration value for state's 'SMTPHost' field
+func (st *ConfigState) SetSMTPHost(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateKey = v
+	st.config.SMTPHost = v
 	st.reloadToViper()
 }
 
-// TLSCertificateKeyFlag returns the flag name for the 'TLSCertificateKey' field
-func TLSCertificateKeyFlag() string { return "tls-certificate-key" }
+// SMTPHostFlag returns the flag name for the 'SMTPHost' field
+func SMTPHostFlag() string { return "smtp-host" }
 
-// GetTLSCertificateKey safely fetches the value for global configuration 'TLSCertificateKey' field
-func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
+// GetSMTPHost safely fetches the value for global configuration 'SMTPHost' field
+func GetSMTPHost() string { return global.GetSMTPHost() }
 
-// SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
-func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
+// SetSMTPHost safely sets the value for global configuration 'SMTPHost' field
+func SetSMTPHost(v string) { global.SetSMTPHost(v) }
 
-// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) GetOIDCEnabled() (v bool) {
+// GetSMTPPort safely fetches the Configuration value for state's 'SMTPPort' field
+func (st *ConfigState) GetSMTPPort() (v int) {
 	st.mutex.RLock()
-	v = st.config.OIDCEnabled
+	v = st.config.SMTPPort
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCEnabled safely sets the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) SetOIDCEnabled(v bool) {
+// SetSMTPPort safely sets the Configuration value for state's 'SMTPPort' field
+func (st *ConfigState) SetSMTPPort(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCEnabled = v
+	st.config.SMTPPort = v
 	st.reloadToViper()
 }
 
-// OIDCEnabledFlag returns the flag name for the 'OIDCEnabled' field
-func OIDCEnabledFlag() string { return "oidc-enabled" }
+// SMTPPortFlag returns the flag name for the 'SMTPPort' field
+func SMTPPortFlag() string { return "smtp-port" }
 
-// GetOIDCEnabled safely fetches the value for global configuration 'OIDCEnabled' field
-func GetOIDCEnabled() bool { return global.GetOIDCEnabled() }
+// GetSMTPPort safely fetches the value for global configuration 'SMTPPort' field
+func GetSMTPPort() int { return global.GetSMTPPort() }
 
-// SetOIDCEnabled safely sets the value for global configuration 'OIDCEnabled' field
-func SetOIDCEnabled(v bool) { global.SetOIDCEnabled(v) }
+// SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
+func SetSMTPPort(v int) { global.SetSMTPPort(v) }
 
-// GetOIDCIdpName safely fetches the Configuration value for state's 'OIDCIdpName' field
-func (st *ConfigState) GetOIDCIdpName() (v string) {
+// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) GetSMTPUsername() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCIdpName
+	v = st.config.SMTPUsername
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCIdpName safely sets the Configuration value for state's 'OIDCIdpName' field
-func (st *ConfigState) SetOIDCIdpName(v string) {
+// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
+func (st *ConfigState) SetSMTPUsername(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCIdpName = v
+	st.config.SMTPUsername = v
 	st.reloadToViper()
 }
 
-// OIDCIdpNameFlag returns the flag name for the 'OIDCIdpName' field
-func OIDCIdpNameFlag() string { return "oidc-idp-name" }
+// SMTPUsernameFlag returns the flag name for the 'SMTPUsername' field
+func SMTPUsernameFlag() string { return "smtp-username" }
 
-// GetOIDCIdpName safely fetches the value for global configuration 'OIDCIdpName' field
-func GetOIDCIdpName() string { return global.GetOIDCIdpName() }
+// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
+func GetSMTPUsername() string { return global.GetSMTPUsername() }
 
-// SetOIDCIdpName safely sets the value for global configuration 'OIDCIdpName' field
-func SetOIDCIdpName(v string) { global.SetOIDCIdpName(v) }
+// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
+func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
 
-// GetOIDCSkipVerification safely fetches the Configuration value for state's 'OIDCSkipVerification' field
-func (st *ConfigState) GetOIDCSkipVerification() (v bool) {
+// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) GetSMTPPassword() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCSkipVerification
+	v = st.config.SMTPPassword
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCSkipVerification safely sets the Configuration value for state's 'OIDCSkipVerification' field
-func (st *ConfigState) SetOIDCSkipVerification(v bool) {
+// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
+func (st *ConfigState) SetSMTPPassword(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCSkipVerification = v
+	st.config.SMTPPassword = v
 	st.reloadToViper()
 }
 
-// OIDCSkipVerificationFlag returns the flag name for the 'OIDCSkipVerification' field
-func OIDCSkipVerificationFlag() string { return "oidc-skip-verification" }
+// SMTPPasswordFlag returns the flag name for the 'SMTPPassword' field
+func SMTPPasswordFlag() string { return "smtp-password" }
 
-// GetOIDCSkipVerification safely fetches the value for global configuration 'OIDCSkipVerification' field
-func GetOIDCSkipVerification() bool { return global.GetOIDCSkipVerification() }
+// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
+func GetSMTPPassword() string { return global.GetSMTPPassword() }
 
-// SetOIDCSkipVerification safely sets the value for global configuration 'OIDCSkipVerification' field
-func SetOIDCSkipVerification(v bool) { global.SetOIDCSkipVerification(v) }
+// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
+func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
 
-// GetOIDCIssuer safely fetches the Configuration value for state's 'OIDCIssuer' field
-func (st *ConfigState) GetOIDCIssuer() (v string) {
+// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) GetSMTPFrom() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCIssuer
+	v = st.config.SMTPFrom
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCIssuer safely sets the Configuration value for state's 'OIDCIssuer' field
-func (st *ConfigState) SetOIDCIssuer(v string) {
+// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
+func (st *ConfigState) SetSMTPFrom(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCIssuer = v
+	st.config.SMTPFrom = v
 	st.reloadToViper()
 }
 
-// OIDCIssuerFlag returns the flag name for the 'OIDCIssuer' field
-func OIDCIssuerFlag() string { return "oidc-issuer" }
+// SMTPFromFlag returns the flag name for the 'SMTPFrom' field
+func SMTPFromFlag() string { return "smtp-from" }
 
-// GetOIDCIssuer safely fetches the value for global configuration 'OIDCIssuer' field
-func GetOIDCIssuer() string { return global.GetOIDCIssuer() }
+// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
+func GetSMTPFrom() string { return global.GetSMTPFrom() }
 
-// SetOIDCIssuer safely sets the value for global configuration 'OIDCIssuer' field
-func SetOIDCIssuer(v string) { global.SetOIDCIssuer(v) }
+// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
+func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
 
-// GetOIDCClientID safely fetches the Configuration value for state's 'OIDCClientID' field
-func (st *ConfigState) GetOIDCClientID() (v string) {
+// GetSMTPDiscloseRecipients safely fetches the Configuration value for state's 'SMTPDiscloseRecipients' field
+func (st *ConfigState) GetSMTPDiscloseRecipients() (v bool) {
 	st.mutex.RLock()
-	v = st.config.OIDCClientID
+	v = st.config.SMTPDiscloseRecipients
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCClientID safely sets the Configuration value for state's 'OIDCClientID' field
-func (st *ConfigState) SetOIDCClientID(v string) {
+// SetSMTPDiscloseRecipients safely sets the Configuration value for state's 'SMTPDiscloseRecipients' field
+func (st *ConfigState) SetSMTPDiscloseRecipients(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCClientID = v
+	st.config.SMTPDiscloseRecipients = v
 	st.reloadToViper()
 }
 
-// OIDCClientIDFlag returns the flag name for the 'OIDCClientID' field
-func OIDCClientIDFlag() string { return "oidc-client-id" }
+// SMTPDiscloseRecipientsFlag returns the flag name for the 'SMTPDiscloseRecipients' field
+func SMTPDiscloseRecipientsFlag() string { return "smtp-disclose-recipients" }
 
-// GetOIDCClientID safely fetches the value for global configuration 'OIDCClientID' field
-func GetOIDCClientID() string { return global.GetOIDCClientID() }
+// GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
+func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
 
-// SetOIDCClientID safely sets the value for global configuration 'OIDCClientID' field
-func SetOIDCClientID(v string) { global.SetOIDCClientID(v) }
+// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
+func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
 
-// GetOIDCClientSecret safely fetches the Configuration value for state's 'OIDCClientSecret' field
-func (st *ConfigState) GetOIDCClientSecret() (v string) {
+// GetSMTPAuthMethod safely fetches the Configuration value for state's 'SMTPAuthMethod' field
+func (st *ConfigState) GetSMTPAuthMethod() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCClientSecret
+	v = st.config.SMTPAuthMethod
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCClientSecret safely sets the Configuration value for state's 'OIDCClientSecret' field
-func (st *ConfigState) SetOIDCClientSecret(v string) {
+// SetSMTPAuthMethod safely sets the Configuration value for state's 'SMTPAuthMethod' field
+func (st *ConfigState) SetSMTPAuthMethod(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCClientSecret = v
+	st.config.SMTPAuthMethod = v
 	st.reloadToViper()
 }
 
-// OIDCClientSecretFlag returns the flag name for the 'OIDCClientSecret' field
-func OIDCClientSecretFlag() string { return "oidc-client-secret" }
+// SMTPAuthMethodFlag returns the flag name for the 'SMTPAuthMethod' field
+func SMTPAuthMethodFlag() string { return "smtp-auth-method" }
 
-// GetOIDCClientSecret safely fetches the value for global configuration 'OIDCClientSecret' field
-func GetOIDCClientSecret() string { return global.GetOIDCClientSecret() }
+// GetSMTPAuthMethod safely fetches the value for global configuration 'SMTPAuthMethod' field
+func GetSMTPAuthMethod() string { return global.GetSMTPAuthMethod() }
 
-// SetOIDCClientSecret safely sets the value for global configuration 'OIDCClientSecret' field
-func SetOIDCClientSecret(v string) { global.SetOIDCClientSecret(v) }
+// SetSMTPAuthMethod safely sets the value for global configuration 'SMTPAuthMethod' field
+func SetSMTPAuthMethod(v string) { global.SetSMTPAuthMethod(v) }
 
-// GetOIDCScopes safely fetches the Configuration value for state's 'OIDCScopes' field
-func (st *ConfigState) GetOIDCScopes() (v []string) {
+// GetSMTPOAuth2TokenURL safely fetches the Configuration value for state's 'SMTPOAuth2TokenURL' field
+func (st *ConfigState) GetSMTPOAuth2TokenURL() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCScopes
+	v = st.config.SMTPOAuth2TokenURL
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCScopes safely sets the Configuration value for state's 'OIDCScopes' field
-func (st *ConfigState) SetOIDCScopes(v []string) {
+// SetSMTPOAuth2TokenURL safely sets the Configuration value for state's 'SMTPOAuth2TokenURL' field
+func (st *ConfigState) SetSMTPOAuth2TokenURL(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCScopes = v
+	st.config.SMTPOAuth2TokenURL = v
 	st.reloadToViper()
 }
 
-// OIDCScopesFlag returns the flag name for the 'OIDCScopes' field
-func OIDCScopesFlag() string { return "oidc-scopes" }
+// SMTPOAuth2TokenURLFlag returns the flag name for the 'SMTPOAuth2TokenURL' field
+func SMTPOAuth2TokenURLFlag() string { return "smtp-oauth2-token-url" }
 
-// GetOIDCScopes safely fetches the value for global configuration 'OIDCScopes' field
-func GetOIDCScopes() []string { return global.GetOIDCScopes() }
+// GetSMTPOAuth2TokenURL safely fetches the value for global configuration 'SMTPOAuth2TokenURL' field
+func GetSMTPOAuth2TokenURL() string { return global.GetSMTPOAuth2TokenURL() }
 
-// SetOIDCScopes safely sets the value for global configuration 'OIDCScopes' field
-func SetOIDCScopes(v []string) { global.SetOIDCScopes(v) }
+// SetSMTPOAuth2TokenURL safely sets the value for global configuration 'SMTPOAuth2TokenURL' field
+func SetSMTPOAuth2TokenURL(v string) { global.SetSMTPOAuth2TokenURL(v) }
 
-// GetOIDCLinkExisting safely fetches the Configuration value for state's 'OIDCLinkExisting' field
-func (st *ConfigState) GetOIDCLinkExisting() (v bool) {
+// GetSMTPOAuth2ClientID safely fetches the Configuration value for state's 'SMTPOAuth2ClientID' field
+func (st *ConfigState) GetSMTPOAuth2ClientID() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCLinkExisting
+	v = st.config.SMTPOAuth2ClientID
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCLinkExisting safely sets the Configuration value for state's 'OIDCLinkExisting' field
-func (st *ConfigState) SetOIDCLinkExisting(v bool) {
+// SetSMTPOAuth2ClientID safely sets the Configuration value for state's 'SMTPOAuth2ClientID' field
+func (st *ConfigState) SetSMTPOAuth2ClientID(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCLinkExisting = v
+	st.config.SMTPOAuth2ClientID = v
 	st.reloadToViper()
 }
 
-// OIDCLinkExistingFlag returns the flag name for the 'OIDCLinkExisting' field
-func OIDCLinkExistingFlag() string { return "oidc-link-existing" }
+// SMTPOAuth2ClientIDFlag returns the flag name for the 'SMTPOAuth2ClientID' field
+func SMTPOAuth2ClientIDFlag() string { return "smtp-oauth2-client-id" }
 
-// GetOIDCLinkExisting safely fetches the value for global configuration 'OIDCLinkExisting' field
-func GetOIDCLinkExisting() bool { return global.GetOIDCLinkExisting() }
+// GetSMTPOAuth2ClientID safely fetches the value for global configuration 'SMTPOAuth2ClientID' field
+func GetSMTPOAuth2ClientID() string { return global.GetSMTPOAuth2ClientID() }
 
-// SetOIDCLinkExisting safely sets the value for global configuration 'OIDCLinkExisting' field
-func SetOIDCLinkExisting(v bool) { global.SetOIDCLinkExisting(v) }
+// SetSMTPOAuth2ClientID safely sets the value for global configuration 'SMTPOAuth2ClientID' field
+func SetSMTPOAuth2ClientID(v string) { global.SetSMTPOAuth2ClientID(v) }
 
-// GetOIDCAllowedGroups safely fetches the Configuration value for state's 'OIDCAllowedGroups' field
-func (st *ConfigState) GetOIDCAllowedGroups() (v []string) {
+// GetSMTPOAuth2ClientSecret safely fetches the Configuration value for state's 'SMTPOAuth2ClientSecret' field
+func (st *ConfigState) GetSMTPOAuth2ClientSecret() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCAllowedGroups
+	v = st.config.SMTPOAuth2ClientSecret
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCAllowedGroups safely sets the Configuration value for state's 'OIDCAllowedGroups' field
-func (st *ConfigState) SetOIDCAllowedGroups(v []string) {
+// SetSMTPOAuth2ClientSecret safely sets the Configuration value for state's 'SMTPOAuth2ClientSecret' field
+func (st *ConfigState) SetSMTPOAuth2ClientSecret(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCAllowedGroups = v
+	st.config.SMTPOAuth2ClientSecret = v
 	st.reloadToViper()
 }
 
-// OIDCAllowedGroupsFlag returns the flag name for the 'OIDCAllowedGroups' field
-func OIDCAllowedGroupsFlag() string { return "oidc-allowed-groups" }
+// SMTPOAuth2ClientSecretFlag returns the flag name for the 'SMTPOAuth2ClientSecret' field
+func SMTPOAuth2ClientSecretFlag() string { return "smtp-oauth2-client-secret" }
 
-// GetOIDCAllowedGroups safely fetches the value for global configuration 'OIDCAllowedGroups' field
-func GetOIDCAllowedGroups() []string { return global.GetOIDCAllowedGroups() }
+// GetSMTPOAuth2ClientSecret safely fetches the value for global configuration 'SMTPOAuth2ClientSecret' field
+func GetSMTPOAuth2ClientSecret() string { return global.GetSMTPOAuth2ClientSecret() }
 
-// SetOIDCAllowedGroups safely sets the value for global configuration 'OIDCAllowedGroups' field
-func SetOIDCAllowedGroups(v []string) { global.SetOIDCAllowedGroups(v) }
+// SetSMTPOAuth2ClientSecret safely sets the value for global configuration 'SMTPOAuth2ClientSecret' field
+func SetSMTPOAuth2ClientSecret(v string) { global.SetSMTPOAuth2ClientSecret(v) }
 
-// GetOIDCAdminGroups safely fetches the Configuration value for state's 'OIDCAdminGroups' field
-func (st *ConfigState) GetOIDCAdminGroups() (v []string) {
+// GetSMTPOAuth2RefreshToken safely fetches the Configuration value for state's 'SMTPOAuth2RefreshToken' field
+func (st *ConfigState) GetSMTPOAuth2RefreshToken() (v string) {
 	st.mutex.RLock()
-	v = st.config.OIDCAdminGroups
+	v = st.config.SMTPOAuth2RefreshToken
 	st.mutex.RUnlock()
 	return
 }
 
-// SetOIDCAdminGroups safely sets the Configuration value for state's 'OIDCAdminGroups' field
-func (st *ConfigState) SetOIDCAdminGroups(v []string) {
+// SetSMTPOAuth2RefreshToken safely sets the Configuration value for state's 'SMTPOAuth2RefreshToken' field
+func (st *ConfigState) SetSMTPOAuth2RefreshToken(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.OIDCAdminGroups = v
+	st.config.SMTPOAuth2RefreshToken = v
 	st.reloadToViper()
 }
 
-// OIDCAdminGroupsFlag returns the flag name for the 'OIDCAdminGroups' field
-func OIDCAdminGroupsFlag() string { return "oidc-admin-groups" }
+// SMTPOAuth2RefreshTokenFlag returns the flag name for the 'SMTPOAuth2RefreshToken' field
+func SMTPOAuth2RefreshTokenFlag() string { return "smtp-oauth2-refresh-token" }
 
-// GetOIDCAdminGroups safely fetches the value for global configuration 'OIDCAdminGroups' field
-func GetOIDCAdminGroups() []string { return global.GetOIDCAdminGroups() }
+// GetSMTPOAuth2RefreshToken safely fetches the value for global configuration 'SMTPOAuth2RefreshToken' field
+func GetSMTPOAuth2RefreshToken() string { return global.GetSMTPOAuth2RefreshToken() }
 
-// SetOIDCAdminGroups safely sets the value for global configuration 'OIDCAdminGroups' field
-func SetOIDCAdminGroups(v []string) { global.SetOIDCAdminGroups(v) }
+// SetSMTPOAuth2RefreshToken safely sets the value for global configuration 'SMTPOAuth2RefreshToken' field
+func SetSMTPOAuth2RefreshToken(v string) { global.SetSMTPOAuth2RefreshToken(v) }
 
-// GetTracingEnabled safely fetches the Configuration value for state's 'TracingEnabled' field
-func (st *ConfigState) GetTracingEnabled() (v bool) {
+// GetSMTPOAuth2Scopes safely fetches the Configuration value for state's 'SMTPOAuth2Scopes' field
+func (st *ConfigState) GetSMTPOAuth2Scopes() (v []string) {
 	st.mutex.RLock()
-	v = st.config.TracingEnabled
+	v = st.config.SMTPOAuth2Scopes
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTracingEnabled safely sets the Configuration value for state's 'TracingEnabled' field
-func (st *ConfigState) SetTracingEnabled(v bool) {
+// SetSMTPOAuth2Scopes safely sets the Configuration value for state's 'SMTPOAuth2Scopes' field
+func (st *ConfigState) SetSMTPOAuth2Scopes(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TracingEnabled = v
+	st.config.SMTPOAuth2Scopes = v
 	st.reloadToViper()
 }
 
-// TracingEnabledFlag returns the flag name for the 'TracingEnabled' field
-func TracingEnabledFlag() string { return "tracing-enabled" }
+// SMTPOAuth2ScopesFlag returns the flag name for the 'SMTPOAuth2Scopes' field
+func SMTPOAuth2ScopesFlag() string { return "smtp-oauth2-scopes" }
 
-// GetTracingEnabled safely fetches the value for global configuration 'TracingEnabled' field
-func GetTracingEnabled() bool { return global.GetTracingEnabled() }
+// GetSMTPOAuth2Scopes safely fetches the value for global configuration 'SMTPOAuth2Scopes' field
+func GetSMTPOAuth2Scopes() []string { return global.GetSMTPOAuth2Scopes() }
 
-// SetTracingEnabled safely sets the value for global configuration 'TracingEnabled' field
-func SetTracingEnabled(v bool) { global.SetTracingEnabled(v) }
+// SetSMTPOAuth2Scopes safely sets the value for global configuration 'SMTPOAuth2Scopes' field
+func SetSMTPOAuth2Scopes(v []string) { global.SetSMTPOAuth2Scopes(v) }
 
-// GetTracingTransport safely fetches the Configuration value for state's 'TracingTransport' field
-func (st *ConfigState) GetTracingTransport() (v string) {
+// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) GetSyslogEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.TracingTransport
+	v = st.config.SyslogEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTracingTransport safely sets the Configuration value for state's 'TracingTransport' field
-func (st *ConfigState) SetTracingTransport(v string) {
+// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
+func (st *ConfigState) SetSyslogEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TracingTransport = v
+	st.config.SyslogEnabled = v
 	st.reloadToViper()
 }
 
-// TracingTransportFlag returns the flag name for the 'TracingTransport' field
-func TracingTransportFlag() string { return "tracing-transport" }
+// SyslogEnabledFlag returns the flag name for the 'SyslogEnabled' field
+func SyslogEnabledFlag() string { return "syslog-enabled" }
 
-// GetTracingTransport safely fetches the value for global configuration 'TracingTransport' field
-func GetTracingTransport() string { return global.GetTracingTransport() }
+// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
+func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
 
-// SetTracingTransport safely sets the value for global configuration 'TracingTransport' field
-func SetTracingTransport(v string) { global.SetTracingTransport(v) }
+// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
+func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
 
-// GetTracingEndpoint safely fetches the Configuration value for state's 'TracingEndpoint' field
-func (st *ConfigState) GetTracingEndpoint() (v string) {
+// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) GetSyslogProtocol() (v string) {
 	st.mutex.RLock()
-	v = st.config.TracingEndpoint
+	v = st.config.SyslogProtocol
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTracingEndpoint safely sets the Configuration value for state's 'TracingEndpoint' field
-func (st *ConfigState) SetTracingEndpoint(v string) {
+// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
+func (st *ConfigState) SetSyslogProtocol(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TracingEndpoint = v
+	st.config.SyslogProtocol = v
 	st.reloadToViper()
 }
 
-// TracingEndpointFlag returns the flag name for the 'TracingEndpoint' field
-func TracingEndpointFlag() string { return "tracing-endpoint" }
+// SyslogProtocolFlag returns the flag name for the 'SyslogProtocol' field
+func SyslogProtocolFlag() string { return "syslog-protocol" }
 
-// GetTracingEndpoint safely fetches the value for global configuration 'TracingEndpoint' field
-func GetTracingEndpoint() string { return global.GetTracingEndpoint() }
+// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
+func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
 
-// SetTracingEndpoint safely sets the value for global configuration 'TracingEndpoint' field
-func SetTracingEndpoint(v string) { global.SetTracingEndpoint(v) }
+// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
+func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
 
-// GetTracingInsecureTransport safely fetches the Configuration value for state's 'TracingInsecureTransport' field
-func (st *ConfigState) GetTracingInsecureTransport() (v bool) {
+// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) GetSyslogAddress() (v string) {
 	st.mutex.RLock()
-	v = st.config.TracingInsecureTransport
+	v = st.config.SyslogAddress
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTracingInsecureTransport safely sets the Configuration value for state's 'TracingInsecureTransport' field
-func (st *ConfigState) SetTracingInsecureTransport(v bool) {
+// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
+func (st *ConfigState) SetSyslogAddress(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TracingInsecureTransport = v
+	st.config.SyslogAddress = v
 	st.reloadToViper()
 }
 
-// TracingInsecureTransportFlag returns the flag name for the 'TracingInsecureTransport' field
-func TracingInsecureTransportFlag() string { return "tracing-insecure-transport" }
+// SyslogAddressFlag returns the flag name for the 'SyslogAddress' field
+func SyslogAddressFlag() string { return "syslog-address" }
 
-// GetTracingInsecureTransport safely fetches the value for global configuration 'TracingInsecureTransport' field
-func GetTracingInsecureTransport() bool { return global.GetTracingInsecureTransport() }
+// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
+func GetSyslogAddress() string { return global.GetSyslogAddress() }
 
-// SetTracingInsecureTransport safely sets the value for global configuration 'TracingInsecureTransport' field
-func SetTracingInsecureTransport(v bool) { global.SetTracingInsecureTransport(v) }
+// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
+func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
-// GetMetricsEnabled safely fetches the Configuration value for state's 'MetricsEnabled' field
-func (st *ConfigState) GetMetricsEnabled() (v bool) {
+// GetLogFile safely fetches the Configuration value for state's 'LogFile' field
+func (st *ConfigState) GetLogFile() (v string) {
 	st.mutex.RLock()
-	v = st.config.MetricsEnabled
+	v = st.config.LogFile
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMetricsEnabled safely sets the Configuration value for state's 'MetricsEnabled' field
-func (st *ConfigState) SetMetricsEnabled(v bool) {
+// SetLogFile safely sets the Configuration value for state's 'LogFile' field
+func (st *ConfigState) SetLogFile(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MetricsEnabled = v
+	st.config.LogFile = v
 	st.reloadToViper()
 }
 
-// MetricsEnabledFlag returns the flag name for the 'MetricsEnabled' field
-func MetricsEnabledFlag() string { return "metrics-enabled" }
+// LogFileFlag returns the flag name for the 'LogFile' field
+func LogFileFlag() string { return "log-file" }
 
-// GetMetricsEnabled safely fetches the value for global configuration 'MetricsEnabled' field
-func GetMetricsEnabled() bool { return global.GetMetricsEnabled() }
+// GetLogFile safely fetches the value for global configuration 'LogFile' field
+func GetLogFile() string { return global.GetLogFile() }
 
-// SetMetricsEnabled safely sets the value for global configuration 'MetricsEnabled' field
-func SetMetricsEnabled(v bool) { global.SetMetricsEnabled(v) }
+// SetLogFile safely sets the value for global configuration 'LogFile' field
+func SetLogFile(v string) { global.SetLogFile(v) }
 
-// GetMetricsAuthEnabled safely fetches the Configuration value for state's 'MetricsAuthEnabled' field
-func (st *ConfigState) GetMetricsAuthEnabled() (v bool) {
+// GetLogFileMaxSize safely fetches the Configuration value for state's 'LogFileMaxSize' field
+func (st *ConfigState) GetLogFileMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.MetricsAuthEnabled
+	v = st.config.LogFileMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMetricsAuthEnabled safely sets the Configuration value for state's 'MetricsAuthEnabled' field
-func (st *ConfigState) SetMetricsAuthEnabled(v bool) {
+// SetLogFileMaxSize safely sets the Configuration value for state's 'LogFileMaxSize' field
+func (st *ConfigState) SetLogFileMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MetricsAuthEnabled = v
+	st.config.LogFileMaxSize = v
 	st.reloadToViper()
 }
 
-// MetricsAuthEnabledFlag returns the flag name for the 'MetricsAuthEnabled' field
-func MetricsAuthEnabledFlag() string { return "metrics-auth-enabled" }
+// LogFileMaxSizeFlag returns the flag name for the 'LogFileMaxSize' field
+func LogFileMaxSizeFlag() string { return "log-file-max-size" }
 
-// GetMetricsAuthEnabled safely fetches the value for global configuration 'MetricsAuthEnabled' field
-func GetMetricsAuthEnabled() bool { return global.GetMetricsAuthEnabled() }
+// GetLogFileMaxSize safely fetches the value for global configuration 'LogFileMaxSize' field
+func GetLogFileMaxSize() bytesize.Size { return global.GetLogFileMaxSize() }
 
-// SetMetricsAuthEnabled safely sets the value for global configuration 'MetricsAuthEnabled' field
-func SetMetricsAuthEnabled(v bool) { global.SetMetricsAuthEnabled(v) }
+// SetLogFileMaxSize safely sets the value for global configuration 'LogFileMaxSize' field
+func SetLogFileMaxSize(v bytesize.Size) { global.SetLogFileMaxSize(v) }
 
-// GetMetricsAuthUsername safely fetches the Configuration value for state's 'MetricsAuthUsername' field
-func (st *ConfigState) GetMetricsAuthUsername() (v string) {
+// GetLogFileMaxAge safely fetches the Configuration value for state's 'LogFileMaxAge' field
+func (st *ConfigState) GetLogFileMaxAge() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.MetricsAuthUsername
+	v = st.config.LogFileMaxAge
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMetricsAuthUsername safely sets the Configuration value for state's 'MetricsAuthUsername' field
-func (st *ConfigState) SetMetricsAuthUsername(v string) {
+// SetLogFileMaxAge safely sets the Configuration value for state's 'LogFileMaxAge' field
+func (st *ConfigState) SetLogFileMaxAge(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MetricsAuthUsername = v
+	st.config.LogFileMaxAge = v
 	st.reloadToViper()
 }
 
-// MetricsAuthUsernameFlag returns the flag name for the 'MetricsAuthUsername' field
-func MetricsAuthUsernameFlag() string { return "metrics-auth-username" }
+// LogFileMaxAgeFlag returns the flag name for the 'LogFileMaxAge' field
+func LogFileMaxAgeFlag() string { return "log-file-max-age" }
 
-// GetMetricsAuthUsername safely fetches the value for global configuration 'MetricsAuthUsername' field
-func GetMetricsAuthUsername() string { return global.GetMetricsAuthUsername() }
+// GetLogFileMaxAge safely fetches the value for global configuration 'LogFileMaxAge' field
+func GetLogFileMaxAge() time.Duration { return global.GetLogFileMaxAge() }
 
-// SetMetricsAuthUsername safely sets the value for global configuration 'MetricsAuthUsername' field
-func SetMetricsAuthUsername(v string) { global.SetMetricsAuthUsername(v) }
+// SetLogFileMaxAge safely sets the value for global configuration 'LogFileMaxAge' field
+func SetLogFileMaxAge(v time.Duration) { global.SetLogFileMaxAge(v) }
 
-// GetMetricsAuthPassword safely fetches the Configuration value for state's 'MetricsAuthPassword' field
-func (st *ConfigState) GetMetricsAuthPassword() (v string) {
+// GetLogFileMaxBackups safely fetches the Configuration value for state's 'LogFileMaxBackups' field
+func (st *ConfigState) GetLogFileMaxBackups() (v int) {
 	st.mutex.RLock()
-	v = st.config.MetricsAuthPassword
+	v = st.config.LogFileMaxBackups
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMetricsAuthPassword safely sets the Configuration value for state's 'MetricsAuthPassword' field
-func (st *ConfigState) SetMetricsAuthPassword(v string) {
+// SetLogFileMaxBackups safely sets the Configuration value for state's 'LogFileMaxBackups' field
+func (st *ConfigState) SetLogFileMaxBackups(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MetricsAuthPassword = v
+	st.config.LogFileMaxBackups = v
 	st.reloadToViper()
 }
 
-// MetricsAuthPasswordFlag returns the flag name for the 'MetricsAuthPassword' field
-func MetricsAuthPasswordFlag() string { return "metrics-auth-password" }
+// LogFileMaxBackupsFlag returns the flag name for the 'LogFileMaxBackups' field
+func LogFileMaxBackupsFlag() string { return "log-file-max-backups" }
 
-// GetMetricsAuthPassword safely fetches the value for global configuration 'MetricsAuthPassword' field
-func GetMetricsAuthPassword() string { return global.GetMetricsAuthPassword() }
+// GetLogFileMaxBackups safely fetches the value for global configuration 'LogFileMaxBackups' field
+func GetLogFileMaxBackups() int { return global.GetLogFileMaxBackups() }
 
-// SetMetricsAuthPassword safely sets the value for global configuration 'MetricsAuthPassword' field
-func SetMetricsAuthPassword(v string) { global.SetMetricsAuthPassword(v) }
+// SetLogFileMaxBackups safely sets the value for global configuration 'LogFileMaxBackups' field
+func SetLogFileMaxBackups(v int) { global.SetLogFileMaxBackups(v) }
 
-// GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
-func (st *ConfigState) GetSMTPHost() (v string) {
+// GetDeliveryMaxRetries safely fetches the Configuration value for state's 'DeliveryMaxRetries' field
+func (st *ConfigState) GetDeliveryMaxRetries() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPHost
+	v = st.config.DeliveryMaxRetries
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPHost safely sets the Configuration value for state's 'SMTPHost' field
-func (st *ConfigState) SetSMTPHost(v string) {
+// SetDeliveryMaxRetries safely sets the Configuration value for state's 'DeliveryMaxRetries' field
+func (st *ConfigState) SetDeliveryMaxRetries(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPHost = v
+	st.config.DeliveryMaxRetries = v
 	st.reloadToViper()
 }
 
-// SMTPHostFlag returns the flag name for the 'SMTPHost' field
-func SMTPHostFlag() string { return "smtp-host" }
+// DeliveryMaxRetriesFlag returns the flag name for the 'DeliveryMaxRetries' field
+func DeliveryMaxRetriesFlag() string { return "delivery-max-retries" }
 
-// GetSMTPHost safely fetches the value for global configuration 'SMTPHost' field
-func GetSMTPHost() string { return global.GetSMTPHost() }
+// GetDeliveryMaxRetries safely fetches the value for global configuration 'DeliveryMaxRetries' field
+func GetDeliveryMaxRetries() int { return global.GetDeliveryMaxRetries() }
 
-// SetSMTPHost safely sets the value for global configuration 'SMTPHost' field
-func SetSMTPHost(v string) { global.SetSMTPHost(v) }
+// SetDeliveryMaxRetries safely sets the value for global configuration 'DeliveryMaxRetries' field
+func SetDeliveryMaxRetries(v int) { global.SetDeliveryMaxRetries(v) }
 
-// GetSMTPPort safely fetches the Configuration value for state's 'SMTPPort' field
-func (st *ConfigState) GetSMTPPort() (v int) {
+// GetDeliveryBackoff safely fetches the Configuration value for state's 'DeliveryBackoff' field
+func (st *ConfigState) GetDeliveryBackoff() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.SMTPPort
+	v = st.config.DeliveryBackoff
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPPort safely sets the Configuration value for state's 'SMTPPort' field
-func (st *ConfigState) SetSMTPPort(v int) {
+// SetDeliveryBackoff safely sets the Configuration value for state's 'DeliveryBackoff' field
+func (st *ConfigState) SetDeliveryBackoff(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPort = v
+	st.config.DeliveryBackoff = v
 	st.reloadToViper()
 }
 
-// SMTPPortFlag returns the flag name for the 'SMTPPort' field
-func SMTPPortFlag() string { return "smtp-port" }
+// DeliveryBackoffFlag returns the flag name for the 'DeliveryBackoff' field
+func DeliveryBackoffFlag() string { return "delivery-backoff" }
 
-// GetSMTPPort safely fetches the value for global configuration 'SMTPPort' field
-func GetSMTPPort() int { return global.GetSMTPPort() }
+// GetDeliveryBackoff safely fetches the value for global configuration 'DeliveryBackoff' field
+func GetDeliveryBackoff() time.Duration { return global.GetDeliveryBackoff() }
 
-// SetSMTPPort safely sets the value for global configuration 'SMTPPort' field
-func SetSMTPPort(v int) { global.SetSMTPPort(v) }
+// SetDeliveryBackoff safely sets the value for global configuration 'DeliveryBackoff' field
+func SetDeliveryBackoff(v time.Duration) { global.SetDeliveryBackoff(v) }
 
-// GetSMTPUsername safely fetches the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) GetSMTPUsername() (v string) {
+// GetDeliveryUnreachableThreshold safely fetches the Configuration value for state's 'DeliveryUnreachableThreshold' field
+func (st *ConfigState) GetDeliveryUnreachableThreshold() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPUsername
+	v = st.config.DeliveryUnreachableThreshold
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPUsername safely sets the Configuration value for state's 'SMTPUsername' field
-func (st *ConfigState) SetSMTPUsername(v string) {
+// SetDeliveryUnreachableThreshold safely sets the Configuration value for state's 'DeliveryUnreachableThreshold' field
+func (st *ConfigState) SetDeliveryUnreachableThreshold(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPUsername = v
+	st.config.DeliveryUnreachableThreshold = v
 	st.reloadToViper()
 }
 
-// SMTPUsernameFlag returns the flag name for the 'SMTPUsername' field
-func SMTPUsernameFlag() string { return "smtp-username" }
+// DeliveryUnreachableThresholdFlag returns the flag name for the 'DeliveryUnreachableThreshold' field
+func DeliveryUnreachableThresholdFlag() string { return "delivery-unreachable-threshold" }
 
-// GetSMTPUsername safely fetches the value for global configuration 'SMTPUsername' field
-func GetSMTPUsername() string { return global.GetSMTPUsername() }
+// GetDeliveryUnreachableThreshold safely fetches the value for global configuration 'DeliveryUnreachableThreshold' field
+func GetDeliveryUnreachableThreshold() int { return global.GetDeliveryUnreachableThreshold() }
 
-// SetSMTPUsername safely sets the value for global configuration 'SMTPUsername' field
-func SetSMTPUsername(v string) { global.SetSMTPUsername(v) }
+// SetDeliveryUnreachableThreshold safely sets the value for global configuration 'DeliveryUnreachableThreshold' field
+func SetDeliveryUnreachableThreshold(v int) { global.SetDeliveryUnreachableThreshold(v) }
 
-// GetSMTPPassword safely fetches the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) GetSMTPPassword() (v string) {
+// GetDeliveryUnreachableDuration safely fetches the Configuration value for state's 'DeliveryUnreachableDuration' field
+func (st *ConfigState) GetDeliveryUnreachableDuration() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.SMTPPassword
+	v = st.config.DeliveryUnreachableDuration
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPPassword safely sets the Configuration value for state's 'SMTPPassword' field
-func (st *ConfigState) SetSMTPPassword(v string) {
+// SetDeliveryUnreachableDuration safely sets the Configuration value for state's 'DeliveryUnreachableDuration' field
+func (st *ConfigState) SetDeliveryUnreachableDuration(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPPassword = v
+	st.config.DeliveryUnreachableDuration = v
 	st.reloadToViper()
 }
 
-// SMTPPasswordFlag returns the flag name for the 'SMTPPassword' field
-func SMTPPasswordFlag() string { return "smtp-password" }
+// DeliveryUnreachableDurationFlag returns the flag name for the 'DeliveryUnreachableDuration' field
+func DeliveryUnreachableDurationFlag() string { return "delivery-unreachable-duration" }
 
-// GetSMTPPassword safely fetches the value for global configuration 'SMTPPassword' field
-func GetSMTPPassword() string { return global.GetSMTPPassword() }
+// GetDeliveryUnreachableDuration safely fetches the value for global configuration 'DeliveryUnreachableDuration' field
+func GetDeliveryUnreachableDuration() time.Duration { return global.GetDeliveryUnreachableDuration() }
 
-// SetSMTPPassword safely sets the value for global configuration 'SMTPPassword' field
-func SetSMTPPassword(v string) { global.SetSMTPPassword(v) }
+// SetDeliveryUnreachableDuration safely sets the value for global configuration 'DeliveryUnreachableDuration' field
+func SetDeliveryUnreachableDuration(v time.Duration) { global.SetDeliveryUnreachableDuration(v) }
 
-// GetSMTPFrom safely fetches the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) GetSMTPFrom() (v string) {
+// GetDeliveryDeadLetterRetention safely fetches the Configuration value for state's 'DeliveryDeadLetterRetention' field
+func (st *ConfigState) GetDeliveryDeadLetterRetention() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.SMTPFrom
+	v = st.config.DeliveryDeadLetterRetention
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPFrom safely sets the Configuration value for state's 'SMTPFrom' field
-func (st *ConfigState) SetSMTPFrom(v string) {
+// SetDeliveryDeadLetterRetention safely sets the Configuration value for state's 'DeliveryDeadLetterRetention' field
+func (st *ConfigState) SetDeliveryDeadLetterRetention(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPFrom = v
+	st.config.DeliveryDeadLetterRetention = v
 	st.reloadToViper()
 }
 
-// SMTPFromFlag returns the flag name for the 'SMTPFrom' field
-func SMTPFromFlag() string { return "smtp-from" }
+// DeliveryDeadLetterRetentionFlag returns the flag name for the 'DeliveryDeadLetterRetention' field
+func DeliveryDeadLetterRetentionFlag() string { return "delivery-dead-letter-retention" }
 
-// GetSMTPFrom safely fetches the value for global configuration 'SMTPFrom' field
-func GetSMTPFrom() string { return global.GetSMTPFrom() }
+// GetDeliveryDeadLetterRetention safely fetches the value for global configuration 'DeliveryDeadLetterRetention' field
+func GetDeliveryDeadLetterRetention() time.Duration { return global.GetDeliveryDeadLetterRetention() }
 
-// SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
-func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
+// SetDeliveryDeadLetterRetention safely sets the value for global configuration 'DeliveryDeadLetterRetention' field
+func SetDeliveryDeadLetterRetention(v time.Duration) { global.SetDeliveryDeadLetterRetention(v) }
 
-// GetSMTPDiscloseRecipients safely fetches the Configuration value for state's 'SMTPDiscloseRecipients' field
-func (st *ConfigState) GetSMTPDiscloseRecipients() (v bool) {
+// GetDeliveryRateLimit safely fetches the Configuration value for state's 'DeliveryRateLimit' field
+func (st *ConfigState) GetDeliveryRateLimit() (v int) {
 	st.mutex.RLock()
-	v = st.config.SMTPDiscloseRecipients
+	v = st.config.DeliveryRateLimit
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSMTPDiscloseRecipients safely sets the Configuration value for state's 'SMTPDiscloseRecipients' field
-func (st *ConfigState) SetSMTPDiscloseRecipients(v bool) {
+// SetDeliveryRateLimit safely sets the Configuration value for state's 'DeliveryRateLimit' field
+func (st *ConfigState) SetDeliveryRateLimit(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SMTPDiscloseRecipients = v
+	st.config.DeliveryRateLimit = v
 	st.reloadToViper()
 }
 
-// SMTPDiscloseRecipientsFlag returns the flag name for the 'SMTPDiscloseRecipients' field
-func SMTPDiscloseRecipientsFlag() string { return "smtp-disclose-recipients" }
+// DeliveryRateLimitFlag returns the flag name for the 'DeliveryRateLimit' field
+func DeliveryRateLimitFlag() string { return "delivery-rate-limit" }
 
-// GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
-func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
+// GetDeliveryRateLimit safely fetches the value for global configuration 'DeliveryRateLimit' field
+func GetDeliveryRateLimit() int { return global.GetDeliveryRateLimit() }
 
-// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
-func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
+// SetDeliveryRateLimit safely sets the value for global configuration 'DeliveryRateLimit' field
+func SetDeliveryRateLimit(v int) { global.SetDeliveryRateLimit(v) }
 
-// GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) GetSyslogEnabled() (v bool) {
+// GetDeliveryDereferenceRateLimit safely fetches the Configuration value for state's 'DeliveryDereferenceRateLimit' field
+func (st *ConfigState) GetDeliveryDereferenceRateLimit() (v int) {
 	st.mutex.RLock()
-	v = st.config.SyslogEnabled
+	v = st.config.DeliveryDereferenceRateLimit
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogEnabled safely sets the Configuration value for state's 'SyslogEnabled' field
-func (st *ConfigState) SetSyslogEnabled(v bool) {
+// SetDeliveryDereferenceRateLimit safely sets the Configuration value for state's 'DeliveryDereferenceRateLimit' field
+func (st *ConfigState) SetDeliveryDereferenceRateLimit(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogEnabled = v
+	st.config.DeliveryDereferenceRateLimit = v
 	st.reloadToViper()
 }
 
-// SyslogEnabledFlag returns the flag name for the 'SyslogEnabled' field
-func SyslogEnabledFlag() string { return "syslog-enabled" }
+// DeliveryDereferenceRateLimitFlag returns the flag name for the 'DeliveryDereferenceRateLimit' field
+func DeliveryDereferenceRateLimitFlag() string { return "delivery-dereference-rate-limit" }
 
-// GetSyslogEnabled safely fetches the value for global configuration 'SyslogEnabled' field
-func GetSyslogEnabled() bool { return global.GetSyslogEnabled() }
+// GetDeliveryDereferenceRateLimit safely fetches the value for global configuration 'DeliveryDereferenceRateLimit' field
+func GetDeliveryDereferenceRateLimit() int { return global.GetDeliveryDereferenceRateLimit() }
 
-// SetSyslogEnabled safely sets the value for global configuration 'SyslogEnabled' field
-func SetSyslogEnabled(v bool) { global.SetSyslogEnabled(v) }
+// SetDeliveryDereferenceRateLimit safely sets the value for global configuration 'DeliveryDereferenceRateLimit' field
+func SetDeliveryDereferenceRateLimit(v int) { global.SetDeliveryDereferenceRateLimit(v) }
 
-// GetSyslogProtocol safely fetches the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) GetSyslogProtocol() (v string) {
+// GetClusterEnabled safely fetches the Configuration value for state's 'ClusterEnabled' field
+func (st *ConfigState) GetClusterEnabled() (v bool) {
 	st.mutex.RLock()
-	v = st.config.SyslogProtocol
+	v = st.config.ClusterEnabled
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogProtocol safely sets the Configuration value for state's 'SyslogProtocol' field
-func (st *ConfigState) SetSyslogProtocol(v string) {
+// SetClusterEnabled safely sets the Configuration value for state's 'ClusterEnabled' field
+func (st *ConfigState) SetClusterEnabled(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogProtocol = v
+	st.config.ClusterEnabled = v
 	st.reloadToViper()
 }
 
-// SyslogProtocolFlag returns the flag name for the 'SyslogProtocol' field
-func SyslogProtocolFlag() string { return "syslog-protocol" }
+// ClusterEnabledFlag returns the flag name for the 'ClusterEnabled' field
+func ClusterEnabledFlag() string { return "cluster-enabled" }
 
-// GetSyslogProtocol safely fetches the value for global configuration 'SyslogProtocol' field
-func GetSyslogProtocol() string { return global.GetSyslogProtocol() }
+// GetClusterEnabled safely fetches the value for global configuration 'ClusterEnabled' field
+func GetClusterEnabled() bool { return global.GetClusterEnabled() }
 
-// SetSyslogProtocol safely sets the value for global configuration 'SyslogProtocol' field
-func SetSyslogProtocol(v string) { global.SetSyslogProtocol(v) }
+// SetClusterEnabled safely sets the value for global configuration 'ClusterEnabled' field
+func SetClusterEnabled(v bool) { global.SetClusterEnabled(v) }
 
-// GetSyslogAddress safely fetches the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) GetSyslogAddress() (v string) {
+// GetClusterNodeID safely fetches the Configuration value for state's 'ClusterNodeID' field
+func (st *ConfigState) GetClusterNodeID() (v string) {
 	st.mutex.RLock()
-	v = st.config.SyslogAddress
+	v = st.config.ClusterNodeID
 	st.mutex.RUnlock()
 	return
 }
 
-// SetSyslogAddress safely sets the Configuration value for state's 'SyslogAddress' field
-func (st *ConfigState) SetSyslogAddress(v string) {
+// SetClusterNodeID safely sets the Configuration value for state's 'ClusterNodeID' field
+func (st *ConfigState) SetClusterNodeID(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.SyslogAddress = v
+	st.config.ClusterNodeID = v
 	st.reloadToViper()
 }
 
-// SyslogAddressFlag returns the flag name for the 'SyslogAddress' field
-func SyslogAddressFlag() string { return "syslog-address" }
+// ClusterNodeIDFlag returns the flag name for the 'ClusterNodeID' field
+func ClusterNodeIDFlag() string { return "cluster-node-id" }
 
-// GetSyslogAddress safely fetches the value for global configuration 'SyslogAddress' field
-func GetSyslogAddress() string { return global.GetSyslogAddress() }
+// GetClusterNodeID safely fetches the value for global configuration 'ClusterNodeID' field
+func GetClusterNodeID() string { return global.GetClusterNodeID() }
 
-// SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
-func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
+// SetClusterNodeID safely sets the value for global configuration 'ClusterNodeID' field
+func SetClusterNodeID(v string) { global.SetClusterNodeID(v) }
 
 // GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
 func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
@@ -2625,6 +4800,81 @@ func GetAdvancedRateLimitExceptions() []string { return global.GetAdvancedRateLi
 // SetAdvancedRateLimitExceptions safely sets the value for global configuration 'AdvancedRateLimitExceptions' field
 func SetAdvancedRateLimitExceptions(v []string) { global.SetAdvancedRateLimitExceptions(v) }
 
+// GetAdvancedRateLimitKey safely fetches the Configuration value for state's 'AdvancedRateLimitKey' field
+func (st *ConfigState) GetAdvancedRateLimitKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedRateLimitKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedRateLimitKey safely sets the Configuration value for state's 'AdvancedRateLimitKey' field
+func (st *ConfigState) SetAdvancedRateLimitKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedRateLimitKey = v
+	st.reloadToViper()
+}
+
+// AdvancedRateLimitKeyFlag returns the flag name for the 'AdvancedRateLimitKey' field
+func AdvancedRateLimitKeyFlag() string { return "advanced-rate-limit-key" }
+
+// GetAdvancedRateLimitKey safely fetches the value for global configuration 'AdvancedRateLimitKey' field
+func GetAdvancedRateLimitKey() string { return global.GetAdvancedRateLimitKey() }
+
+// SetAdvancedRateLimitKey safely sets the value for global configuration 'AdvancedRateLimitKey' field
+func SetAdvancedRateLimitKey(v string) { global.SetAdvancedRateLimitKey(v) }
+
+// GetAdvancedRateLimitSearchRequests safely fetches the Configuration value for state's 'AdvancedRateLimitSearchRequests' field
+func (st *ConfigState) GetAdvancedRateLimitSearchRequests() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdvancedRateLimitSearchRequests
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedRateLimitSearchRequests safely sets the Configuration value for state's 'AdvancedRateLimitSearchRequests' field
+func (st *ConfigState) SetAdvancedRateLimitSearchRequests(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedRateLimitSearchRequests = v
+	st.reloadToViper()
+}
+
+// AdvancedRateLimitSearchRequestsFlag returns the flag name for the 'AdvancedRateLimitSearchRequests' field
+func AdvancedRateLimitSearchRequestsFlag() string { return "advanced-rate-limit-search-requests" }
+
+// GetAdvancedRateLimitSearchRequests safely fetches the value for global configuration 'AdvancedRateLimitSearchRequests' field
+func GetAdvancedRateLimitSearchRequests() int { return global.GetAdvancedRateLimitSearchRequests() }
+
+// SetAdvancedRateLimitSearchRequests safely sets the value for global configuration 'AdvancedRateLimitSearchRequests' field
+func SetAdvancedRateLimitSearchRequests(v int) { global.SetAdvancedRateLimitSearchRequests(v) }
+
+// GetAdvancedRateLimitStreamingRequests safely fetches the Configuration value for state's 'AdvancedRateLimitStreamingRequests' field
+func (st *ConfigState) GetAdvancedRateLimitStreamingRequests() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdvancedRateLimitStreamingRequests
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedRateLimitStreamingRequests safely sets the Configuration value for state's 'AdvancedRateLimitStreamingRequests' field
+func (st *ConfigState) SetAdvancedRateLimitStreamingRequests(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedRateLimitStreamingRequests = v
+	st.reloadToViper()
+}
+
+// AdvancedRateLimitStreamingRequestsFlag returns the flag name for the 'AdvancedRateLimitStreamingRequests' field
+func AdvancedRateLimitStreamingRequestsFlag() string { return "advanced-rate-limit-streaming-requests" }
+
+// GetAdvancedRateLimitStreamingRequests safely fetches the value for global configuration 'AdvancedRateLimitStreamingRequests' field
+func GetAdvancedRateLimitStreamingRequests() int { return global.GetAdvancedRateLimitStreamingRequests() }
+
+// SetAdvancedRateLimitStreamingRequests safely sets the value for global configuration 'AdvancedRateLimitStreamingRequests' field
+func SetAdvancedRateLimitStreamingRequests(v int) { global.SetAdvancedRateLimitStreamingRequests(v) }
+
 // GetAdvancedThrottlingMultiplier safely fetches the Configuration value for state's 'AdvancedThrottlingMultiplier' field
 func (st *ConfigState) GetAdvancedThrottlingMultiplier() (v int) {
 	st.mutex.RLock()
@@ -2722,33 +4972,83 @@ func AdvancedCSPExtraURIsFlag() string { return "advanced-csp-extra-uris" }
 // GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'AdvancedCSPExtraURIs' field
 func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
 
-// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'AdvancedCSPExtraURIs' field
-func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'AdvancedCSPExtraURIs' field
+func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+
+// GetAdvancedHeaderFilterMode safely fetches the Configuration value for state's 'AdvancedHeaderFilterMode' field
+func (st *ConfigState) GetAdvancedHeaderFilterMode() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedHeaderFilterMode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedHeaderFilterMode safely sets the Configuration value for state's 'AdvancedHeaderFilterMode' field
+func (st *ConfigState) SetAdvancedHeaderFilterMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedHeaderFilterMode = v
+	st.reloadToViper()
+}
+
+// AdvancedHeaderFilterModeFlag returns the flag name for the 'AdvancedHeaderFilterMode' field
+func AdvancedHeaderFilterModeFlag() string { return "advanced-header-filter-mode" }
+
+// GetAdvancedHeaderFilterMode safely fetches the value for global configuration 'AdvancedHeaderFilterMode' field
+func GetAdvancedHeaderFilterMode() string { return global.GetAdvancedHeaderFilterMode() }
+
+// SetAdvancedHeaderFilterMode safely sets the value for global configuration 'AdvancedHeaderFilterMode' field
+func SetAdvancedHeaderFilterMode(v string) { global.SetAdvancedHeaderFilterMode(v) }
+
+// GetAdvancedAIScraperMode safely fetches the Configuration value for state's 'AdvancedAIScraperMode' field
+func (st *ConfigState) GetAdvancedAIScraperMode() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedAIScraperMode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedAIScraperMode safely sets the Configuration value for state's 'AdvancedAIScraperMode' field
+func (st *ConfigState) SetAdvancedAIScraperMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedAIScraperMode = v
+	st.reloadToViper()
+}
+
+// AdvancedAIScraperModeFlag returns the flag name for the 'AdvancedAIScraperMode' field
+func AdvancedAIScraperModeFlag() string { return "advanced-ai-scraper-mode" }
+
+// GetAdvancedAIScraperMode safely fetches the value for global configuration 'AdvancedAIScraperMode' field
+func GetAdvancedAIScraperMode() string { return global.GetAdvancedAIScraperMode() }
 
-// GetAdvancedHeaderFilterMode safely fetches the Configuration value for state's 'AdvancedHeaderFilterMode' field
-func (st *ConfigState) GetAdvancedHeaderFilterMode() (v string) {
+// SetAdvancedAIScraperMode safely sets the value for global configuration 'AdvancedAIScraperMode' field
+func SetAdvancedAIScraperMode(v string) { global.SetAdvancedAIScraperMode(v) }
+
+// GetAdvancedAIScraperTarpitDelay safely fetches the Configuration value for state's 'AdvancedAIScraperTarpitDelay' field
+func (st *ConfigState) GetAdvancedAIScraperTarpitDelay() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.AdvancedHeaderFilterMode
+	v = st.config.AdvancedAIScraperTarpitDelay
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedHeaderFilterMode safely sets the Configuration value for state's 'AdvancedHeaderFilterMode' field
-func (st *ConfigState) SetAdvancedHeaderFilterMode(v string) {
+// SetAdvancedAIScraperTarpitDelay safely sets the Configuration value for state's 'AdvancedAIScraperTarpitDelay' field
+func (st *ConfigState) SetAdvancedAIScraperTarpitDelay(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdvancedHeaderFilterMode = v
+	st.config.AdvancedAIScraperTarpitDelay = v
 	st.reloadToViper()
 }
 
-// AdvancedHeaderFilterModeFlag returns the flag name for the 'AdvancedHeaderFilterMode' field
-func AdvancedHeaderFilterModeFlag() string { return "advanced-header-filter-mode" }
+// AdvancedAIScraperTarpitDelayFlag returns the flag name for the 'AdvancedAIScraperTarpitDelay' field
+func AdvancedAIScraperTarpitDelayFlag() string { return "advanced-ai-scraper-tarpit-delay" }
 
-// GetAdvancedHeaderFilterMode safely fetches the value for global configuration 'AdvancedHeaderFilterMode' field
-func GetAdvancedHeaderFilterMode() string { return global.GetAdvancedHeaderFilterMode() }
+// GetAdvancedAIScraperTarpitDelay safely fetches the value for global configuration 'AdvancedAIScraperTarpitDelay' field
+func GetAdvancedAIScraperTarpitDelay() time.Duration { return global.GetAdvancedAIScraperTarpitDelay() }
 
-// SetAdvancedHeaderFilterMode safely sets the value for global configuration 'AdvancedHeaderFilterMode' field
-func SetAdvancedHeaderFilterMode(v string) { global.SetAdvancedHeaderFilterMode(v) }
+// SetAdvancedAIScraperTarpitDelay safely sets the value for global configuration 'AdvancedAIScraperTarpitDelay' field
+func SetAdvancedAIScraperTarpitDelay(v time.Duration) { global.SetAdvancedAIScraperTarpitDelay(v) }
 
 // GetHTTPClientAllowIPs safely fetches the Configuration value for state's 'HTTPClient.AllowIPs' field
 func (st *ConfigState) GetHTTPClientAllowIPs() (v []string) {
@@ -2850,6 +5150,131 @@ func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSI
 // SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
 func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
 
+// GetHTTPClientSOCKSProxy safely fetches the Configuration value for state's 'HTTPClient.SOCKSProxy' field
+func (st *ConfigState) GetHTTPClientSOCKSProxy() (v string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.SOCKSProxy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientSOCKSProxy safely sets the Configuration value for state's 'HTTPClient.SOCKSProxy' field
+func (st *ConfigState) SetHTTPClientSOCKSProxy(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.SOCKSProxy = v
+	st.reloadToViper()
+}
+
+// HTTPClientSOCKSProxyFlag returns the flag name for the 'HTTPClient.SOCKSProxy' field
+func HTTPClientSOCKSProxyFlag() string { return "httpclient-socks-proxy" }
+
+// GetHTTPClientSOCKSProxy safely fetches the value for global configuration 'HTTPClient.SOCKSProxy' field
+func GetHTTPClientSOCKSProxy() string { return global.GetHTTPClientSOCKSProxy() }
+
+// SetHTTPClientSOCKSProxy safely sets the value for global configuration 'HTTPClient.SOCKSProxy' field
+func SetHTTPClientSOCKSProxy(v string) { global.SetHTTPClientSOCKSProxy(v) }
+
+// GetHTTPClientSOCKSProxyOnionOnly safely fetches the Configuration value for state's 'HTTPClient.SOCKSProxyOnionOnly' field
+func (st *ConfigState) GetHTTPClientSOCKSProxyOnionOnly() (v bool) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.SOCKSProxyOnionOnly
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientSOCKSProxyOnionOnly safely sets the Configuration value for state's 'HTTPClient.SOCKSProxyOnionOnly' field
+func (st *ConfigState) SetHTTPClientSOCKSProxyOnionOnly(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.SOCKSProxyOnionOnly = v
+	st.reloadToViper()
+}
+
+// HTTPClientSOCKSProxyOnionOnlyFlag returns the flag name for the 'HTTPClient.SOCKSProxyOnionOnly' field
+func HTTPClientSOCKSProxyOnionOnlyFlag() string { return "httpclient-socks-proxy-onion-only" }
+
+// GetHTTPClientSOCKSProxyOnionOnly safely fetches the value for global configuration 'HTTPClient.SOCKSProxyOnionOnly' field
+func GetHTTPClientSOCKSProxyOnionOnly() bool { return global.GetHTTPClientSOCKSProxyOnionOnly() }
+
+// SetHTTPClientSOCKSProxyOnionOnly safely sets the value for global configuration 'HTTPClient.SOCKSProxyOnionOnly' field
+func SetHTTPClientSOCKSProxyOnionOnly(v bool) { global.SetHTTPClientSOCKSProxyOnionOnly(v) }
+
+// GetHTTPClientProxy safely fetches the Configuration value for state's 'HTTPClient.Proxy' field
+func (st *ConfigState) GetHTTPClientProxy() (v string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.Proxy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientProxy safely sets the Configuration value for state's 'HTTPClient.Proxy' field
+func (st *ConfigState) SetHTTPClientProxy(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.Proxy = v
+	st.reloadToViper()
+}
+
+// HTTPClientProxyFlag returns the flag name for the 'HTTPClient.Proxy' field
+func HTTPClientProxyFlag() string { return "httpclient-proxy" }
+
+// GetHTTPClientProxy safely fetches the value for global configuration 'HTTPClient.Proxy' field
+func GetHTTPClientProxy() string { return global.GetHTTPClientProxy() }
+
+// SetHTTPClientProxy safely sets the value for global configuration 'HTTPClient.Proxy' field
+func SetHTTPClientProxy(v string) { global.SetHTTPClientProxy(v) }
+
+// GetHTTPClientProxyIncludeHosts safely fetches the Configuration value for state's 'HTTPClient.ProxyIncludeHosts' field
+func (st *ConfigState) GetHTTPClientProxyIncludeHosts() (v []string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.ProxyIncludeHosts
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientProxyIncludeHosts safely sets the Configuration value for state's 'HTTPClient.ProxyIncludeHosts' field
+func (st *ConfigState) SetHTTPClientProxyIncludeHosts(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.ProxyIncludeHosts = v
+	st.reloadToViper()
+}
+
+// HTTPClientProxyIncludeHostsFlag returns the flag name for the 'HTTPClient.ProxyIncludeHosts' field
+func HTTPClientProxyIncludeHostsFlag() string { return "httpclient-proxy-include-hosts" }
+
+// GetHTTPClientProxyIncludeHosts safely fetches the value for global configuration 'HTTPClient.ProxyIncludeHosts' field
+func GetHTTPClientProxyIncludeHosts() []string { return global.GetHTTPClientProxyIncludeHosts() }
+
+// SetHTTPClientProxyIncludeHosts safely sets the value for global configuration 'HTTPClient.ProxyIncludeHosts' field
+func SetHTTPClientProxyIncludeHosts(v []string) { global.SetHTTPClientProxyIncludeHosts(v) }
+
+// GetHTTPClientProxyExcludeHosts safely fetches the Configuration value for state's 'HTTPClient.ProxyExcludeHosts' field
+func (st *ConfigState) GetHTTPClientProxyExcludeHosts() (v []string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.ProxyExcludeHosts
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientProxyExcludeHosts safely sets the Configuration value for state's 'HTTPClient.ProxyExcludeHosts' field
+func (st *ConfigState) SetHTTPClientProxyExcludeHosts(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.ProxyExcludeHosts = v
+	st.reloadToViper()
+}
+
+// HTTPClientProxyExcludeHostsFlag returns the flag name for the 'HTTPClient.ProxyExcludeHosts' field
+func HTTPClientProxyExcludeHostsFlag() string { return "httpclient-proxy-exclude-hosts" }
+
+// GetHTTPClientProxyExcludeHosts safely fetches the value for global configuration 'HTTPClient.ProxyExcludeHosts' field
+func GetHTTPClientProxyExcludeHosts() []string { return global.GetHTTPClientProxyExcludeHosts() }
+
+// SetHTTPClientProxyExcludeHosts safely sets the value for global configuration 'HTTPClient.ProxyExcludeHosts' field
+func SetHTTPClientProxyExcludeHosts(v []string) { global.SetHTTPClientProxyExcludeHosts(v) }
+
 // GetCacheMemoryTarget safely fetches the Configuration value for state's 'Cache.MemoryTarget' field
 func (st *ConfigState) GetCacheMemoryTarget() (v bytesize.Size) {
 	st.mutex.RLock()
@@ -3142,19 +5567,13 @@ func (st *ConfigState) SetCacheConversationLastStatusIDsMemRatio(v float64) {
 }
 
 // CacheConversationLastStatusIDsMemRatioFlag returns the flag name for the 'Cache.ConversationLastStatusIDsMemRatio' field
-func CacheConversationLastStatusIDsMemRatioFlag() string {
-	return "cache-conversation-last-status-ids-mem-ratio"
-}
+func CacheConversationLastStatusIDsMemRatioFlag() string { return "cache-conversation-last-status-ids-mem-ratio" }
 
 // GetCacheConversationLastStatusIDsMemRatio safely fetches the value for global configuration 'Cache.ConversationLastStatusIDsMemRatio' field
-func GetCacheConversationLastStatusIDsMemRatio() float64 {
-	return global.GetCacheConversationLastStatusIDsMemRatio()
-}
+func GetCacheConversationLastStatusIDsMemRatio() float64 { return global.GetCacheConversationLastStatusIDsMemRatio() }
 
 // SetCacheConversationLastStatusIDsMemRatio safely sets the value for global configuration 'Cache.ConversationLastStatusIDsMemRatio' field
-func SetCacheConversationLastStatusIDsMemRatio(v float64) {
-	global.SetCacheConversationLastStatusIDsMemRatio(v)
-}
+func SetCacheConversationLastStatusIDsMemRatio(v float64) { global.SetCacheConversationLastStatusIDsMemRatio(v) }
 
 // GetCacheDomainPermissionDraftMemRation safely fetches the Configuration value for state's 'Cache.DomainPermissionDraftMemRation' field
 func (st *ConfigState) GetCacheDomainPermissionDraftMemRation() (v float64) {
@@ -3173,19 +5592,13 @@ func (st *ConfigState) SetCacheDomainPermissionDraftMemRation(v float64) {
 }
 
 // CacheDomainPermissionDraftMemRationFlag returns the flag name for the 'Cache.DomainPermissionDraftMemRation' field
-func CacheDomainPermissionDraftMemRationFlag() string {
-	return "cache-domain-permission-draft-mem-ratio"
-}
+func CacheDomainPermissionDraftMemRationFlag() string { return "cache-domain-permission-draft-mem-ratio" }
 
 // GetCacheDomainPermissionDraftMemRation safely fetches the value for global configuration 'Cache.DomainPermissionDraftMemRation' field
-func GetCacheDomainPermissionDraftMemRation() float64 {
-	return global.GetCacheDomainPermissionDraftMemRation()
-}
+func GetCacheDomainPermissionDraftMemRation() float64 { return global.GetCacheDomainPermissionDraftMemRation() }
 
 // SetCacheDomainPermissionDraftMemRation safely sets the value for global configuration 'Cache.DomainPermissionDraftMemRation' field
-func SetCacheDomainPermissionDraftMemRation(v float64) {
-	global.SetCacheDomainPermissionDraftMemRation(v)
-}
+func SetCacheDomainPermissionDraftMemRation(v float64) { global.SetCacheDomainPermissionDraftMemRation(v) }
 
 // GetCacheEmojiMemRatio safely fetches the Configuration value for state's 'Cache.EmojiMemRatio' field
 func (st *ConfigState) GetCacheEmojiMemRatio() (v float64) {
@@ -3812,6 +6225,31 @@ func GetCacheReportMemRatio() float64 { return global.GetCacheReportMemRatio() }
 // SetCacheReportMemRatio safely sets the value for global configuration 'Cache.ReportMemRatio' field
 func SetCacheReportMemRatio(v float64) { global.SetCacheReportMemRatio(v) }
 
+// GetCacheRoleMemRatio safely fetches the Configuration value for state's 'Cache.RoleMemRatio' field
+func (st *ConfigState) GetCacheRoleMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.RoleMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRoleMemRatio safely sets the Configuration value for state's 'Cache.RoleMemRatio' field
+func (st *ConfigState) SetCacheRoleMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RoleMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheRoleMemRatioFlag returns the flag name for the 'Cache.RoleMemRatio' field
+func CacheRoleMemRatioFlag() string { return "cache-role-mem-ratio" }
+
+// GetCacheRoleMemRatio safely fetches the value for global configuration 'Cache.RoleMemRatio' field
+func GetCacheRoleMemRatio() float64 { return global.GetCacheRoleMemRatio() }
+
+// SetCacheRoleMemRatio safely sets the value for global configuration 'Cache.RoleMemRatio' field
+func SetCacheRoleMemRatio(v float64) { global.SetCacheRoleMemRatio(v) }
+
 // GetCacheSinBinStatusMemRatio safely fetches the Configuration value for state's 'Cache.SinBinStatusMemRatio' field
 func (st *ConfigState) GetCacheSinBinStatusMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -4187,6 +6625,206 @@ func GetCacheVisibilityMemRatio() float64 { return global.GetCacheVisibilityMemR
 // SetCacheVisibilityMemRatio safely sets the value for global configuration 'Cache.VisibilityMemRatio' field
 func SetCacheVisibilityMemRatio(v float64) { global.SetCacheVisibilityMemRatio(v) }
 
+// GetCacheBackend safely fetches the Configuration value for state's 'Cache.Backend' field
+func (st *ConfigState) GetCacheBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.Backend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheBackend safely sets the Configuration value for state's 'Cache.Backend' field
+func (st *ConfigState) SetCacheBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.Backend = v
+	st.reloadToViper()
+}
+
+// CacheBackendFlag returns the flag name for the 'Cache.Backend' field
+func CacheBackendFlag() string { return "cache-backend" }
+
+// GetCacheBackend safely fetches the value for global configuration 'Cache.Backend' field
+func GetCacheBackend() string { return global.GetCacheBackend() }
+
+// SetCacheBackend safely sets the value for global configuration 'Cache.Backend' field
+func SetCacheBackend(v string) { global.SetCacheBackend(v) }
+
+// GetCacheInvalidationBus safely fetches the Configuration value for state's 'Cache.InvalidationBus' field
+func (st *ConfigState) GetCacheInvalidationBus() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.InvalidationBus
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheInvalidationBus safely sets the Configuration value for state's 'Cache.InvalidationBus' field
+func (st *ConfigState) SetCacheInvalidationBus(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.InvalidationBus = v
+	st.reloadToViper()
+}
+
+// CacheInvalidationBusFlag returns the flag name for the 'Cache.InvalidationBus' field
+func CacheInvalidationBusFlag() string { return "cache-invalidation-bus" }
+
+// GetCacheInvalidationBus safely fetches the value for global configuration 'Cache.InvalidationBus' field
+func GetCacheInvalidationBus() string { return global.GetCacheInvalidationBus() }
+
+// SetCacheInvalidationBus safely sets the value for global configuration 'Cache.InvalidationBus' field
+func SetCacheInvalidationBus(v string) { global.SetCacheInvalidationBus(v) }
+
+// GetCacheRedisAddress safely fetches the Configuration value for state's 'Cache.RedisAddress' field
+func (st *ConfigState) GetCacheRedisAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.RedisAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRedisAddress safely sets the Configuration value for state's 'Cache.RedisAddress' field
+func (st *ConfigState) SetCacheRedisAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RedisAddress = v
+	st.reloadToViper()
+}
+
+// CacheRedisAddressFlag returns the flag name for the 'Cache.RedisAddress' field
+func CacheRedisAddressFlag() string { return "cache-redis-address" }
+
+// GetCacheRedisAddress safely fetches the value for global configuration 'Cache.RedisAddress' field
+func GetCacheRedisAddress() string { return global.GetCacheRedisAddress() }
+
+// SetCacheRedisAddress safely sets the value for global configuration 'Cache.RedisAddress' field
+func SetCacheRedisAddress(v string) { global.SetCacheRedisAddress(v) }
+
+// GetCacheRedisUsername safely fetches the Configuration value for state's 'Cache.RedisUsername' field
+func (st *ConfigState) GetCacheRedisUsername() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.RedisUsername
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRedisUsername safely sets the Configuration value for state's 'Cache.RedisUsername' field
+func (st *ConfigState) SetCacheRedisUsername(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RedisUsername = v
+	st.reloadToViper()
+}
+
+// CacheRedisUsernameFlag returns the flag name for the 'Cache.RedisUsername' field
+func CacheRedisUsernameFlag() string { return "cache-redis-username" }
+
+// GetCacheRedisUsername safely fetches the value for global configuration 'Cache.RedisUsername' field
+func GetCacheRedisUsername() string { return global.GetCacheRedisUsername() }
+
+// SetCacheRedisUsername safely sets the value for global configuration 'Cache.RedisUsername' field
+func SetCacheRedisUsername(v string) { global.SetCacheRedisUsername(v) }
+
+// GetCacheRedisPassword safely fetches the Configuration value for state's 'Cache.RedisPassword' field
+func (st *ConfigState) GetCacheRedisPassword() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.RedisPassword
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRedisPassword safely sets the Configuration value for state's 'Cache.RedisPassword' field
+func (st *ConfigState) SetCacheRedisPassword(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RedisPassword = v
+	st.reloadToViper()
+}
+
+// CacheRedisPasswordFlag returns the flag name for the 'Cache.RedisPassword' field
+func CacheRedisPasswordFlag() string { return "cache-redis-password" }
+
+// GetCacheRedisPassword safely fetches the value for global configuration 'Cache.RedisPassword' field
+func GetCacheRedisPassword() string { return global.GetCacheRedisPassword() }
+
+// SetCacheRedisPassword safely sets the value for global configuration 'Cache.RedisPassword' field
+func SetCacheRedisPassword(v string) { global.SetCacheRedisPassword(v) }
+
+// GetCacheRedisDB safely fetches the Configuration value for state's 'Cache.RedisDB' field
+func (st *ConfigState) GetCacheRedisDB() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.RedisDB
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRedisDB safely sets the Configuration value for state's 'Cache.RedisDB' field
+func (st *ConfigState) SetCacheRedisDB(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RedisDB = v
+	st.reloadToViper()
+}
+
+// CacheRedisDBFlag returns the flag name for the 'Cache.RedisDB' field
+func CacheRedisDBFlag() string { return "cache-redis-db" }
+
+// GetCacheRedisDB safely fetches the value for global configuration 'Cache.RedisDB' field
+func GetCacheRedisDB() int { return global.GetCacheRedisDB() }
+
+// SetCacheRedisDB safely sets the value for global configuration 'Cache.RedisDB' field
+func SetCacheRedisDB(v int) { global.SetCacheRedisDB(v) }
+
+// GetCacheRedisKeyPrefix safely fetches the Configuration value for state's 'Cache.RedisKeyPrefix' field
+func (st *ConfigState) GetCacheRedisKeyPrefix() (v string) {
+	st.mutex.RLock()
+	v = st.config.Cache.RedisKeyPrefix
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRedisKeyPrefix safely sets the Configuration value for state's 'Cache.RedisKeyPrefix' field
+func (st *ConfigState) SetCacheRedisKeyPrefix(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RedisKeyPrefix = v
+	st.reloadToViper()
+}
+
+// CacheRedisKeyPrefixFlag returns the flag name for the 'Cache.RedisKeyPrefix' field
+func CacheRedisKeyPrefixFlag() string { return "cache-redis-key-prefix" }
+
+// GetCacheRedisKeyPrefix safely fetches the value for global configuration 'Cache.RedisKeyPrefix' field
+func GetCacheRedisKeyPrefix() string { return global.GetCacheRedisKeyPrefix() }
+
+// SetCacheRedisKeyPrefix safely sets the value for global configuration 'Cache.RedisKeyPrefix' field
+func SetCacheRedisKeyPrefix(v string) { global.SetCacheRedisKeyPrefix(v) }
+
+// GetCacheRedisTTL safely fetches the Configuration value for state's 'Cache.RedisTTL' field
+func (st *ConfigState) GetCacheRedisTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.RedisTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheRedisTTL safely sets the Configuration value for state's 'Cache.RedisTTL' field
+func (st *ConfigState) SetCacheRedisTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.RedisTTL = v
+	st.reloadToViper()
+}
+
+// CacheRedisTTLFlag returns the flag name for the 'Cache.RedisTTL' field
+func CacheRedisTTLFlag() string { return "cache-redis-ttl" }
+
+// GetCacheRedisTTL safely fetches the value for global configuration 'Cache.RedisTTL' field
+func GetCacheRedisTTL() time.Duration { return global.GetCacheRedisTTL() }
+
+// SetCacheRedisTTL safely sets the value for global configuration 'Cache.RedisTTL' field
+func SetCacheRedisTTL(v time.Duration) { global.SetCacheRedisTTL(v) }
+
 // GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
 func (st *ConfigState) GetAdminAccountUsername() (v string) {
 	st.mutex.RLock()
@@ -4262,6 +6900,31 @@ func GetAdminAccountPassword() string { return global.GetAdminAccountPassword()
 // SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
 func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
 
+// GetAdminAccountDomain safely fetches the Configuration value for state's 'AdminAccountDomain' field
+func (st *ConfigState) GetAdminAccountDomain() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountDomain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountDomain safely sets the Configuration value for state's 'AdminAccountDomain' field
+func (st *ConfigState) SetAdminAccountDomain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountDomain = v
+	st.reloadToViper()
+}
+
+// AdminAccountDomainFlag returns the flag name for the 'AdminAccountDomain' field
+func AdminAccountDomainFlag() string { return "domain" }
+
+// GetAdminAccountDomain safely fetches the value for global configuration 'AdminAccountDomain' field
+func GetAdminAccountDomain() string { return global.GetAdminAccountDomain() }
+
+// SetAdminAccountDomain safely sets the value for global configuration 'AdminAccountDomain' field
+func SetAdminAccountDomain(v string) { global.SetAdminAccountDomain(v) }
+
 // GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
 func (st *ConfigState) GetAdminTransPath() (v string) {
 	st.mutex.RLock()
@@ -4362,6 +7025,81 @@ func GetAdminMediaListRemoteOnly() bool { return global.GetAdminMediaListRemoteO
 // SetAdminMediaListRemoteOnly safely sets the value for global configuration 'AdminMediaListRemoteOnly' field
 func SetAdminMediaListRemoteOnly(v bool) { global.SetAdminMediaListRemoteOnly(v) }
 
+// GetAdminMediaRegenerateAccount safely fetches the Configuration value for state's 'AdminMediaRegenerateAccount' field
+func (st *ConfigState) GetAdminMediaRegenerateAccount() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaRegenerateAccount
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaRegenerateAccount safely sets the Configuration value for state's 'AdminMediaRegenerateAccount' field
+func (st *ConfigState) SetAdminMediaRegenerateAccount(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaRegenerateAccount = v
+	st.reloadToViper()
+}
+
+// AdminMediaRegenerateAccountFlag returns the flag name for the 'AdminMediaRegenerateAccount' field
+func AdminMediaRegenerateAccountFlag() string { return "account" }
+
+// GetAdminMediaRegenerateAccount safely fetches the value for global configuration 'AdminMediaRegenerateAccount' field
+func GetAdminMediaRegenerateAccount() string { return global.GetAdminMediaRegenerateAccount() }
+
+// SetAdminMediaRegenerateAccount safely sets the value for global configuration 'AdminMediaRegenerateAccount' field
+func SetAdminMediaRegenerateAccount(v string) { global.SetAdminMediaRegenerateAccount(v) }
+
+// GetAdminMediaRegenerateSince safely fetches the Configuration value for state's 'AdminMediaRegenerateSince' field
+func (st *ConfigState) GetAdminMediaRegenerateSince() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaRegenerateSince
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaRegenerateSince safely sets the Configuration value for state's 'AdminMediaRegenerateSince' field
+func (st *ConfigState) SetAdminMediaRegenerateSince(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaRegenerateSince = v
+	st.reloadToViper()
+}
+
+// AdminMediaRegenerateSinceFlag returns the flag name for the 'AdminMediaRegenerateSince' field
+func AdminMediaRegenerateSinceFlag() string { return "since" }
+
+// GetAdminMediaRegenerateSince safely fetches the value for global configuration 'AdminMediaRegenerateSince' field
+func GetAdminMediaRegenerateSince() string { return global.GetAdminMediaRegenerateSince() }
+
+// SetAdminMediaRegenerateSince safely sets the value for global configuration 'AdminMediaRegenerateSince' field
+func SetAdminMediaRegenerateSince(v string) { global.SetAdminMediaRegenerateSince(v) }
+
+// GetAdminMediaRegenerateUntil safely fetches the Configuration value for state's 'AdminMediaRegenerateUntil' field
+func (st *ConfigState) GetAdminMediaRegenerateUntil() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaRegenerateUntil
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaRegenerateUntil safely sets the Configuration value for state's 'AdminMediaRegenerateUntil' field
+func (st *ConfigState) SetAdminMediaRegenerateUntil(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaRegenerateUntil = v
+	st.reloadToViper()
+}
+
+// AdminMediaRegenerateUntilFlag returns the flag name for the 'AdminMediaRegenerateUntil' field
+func AdminMediaRegenerateUntilFlag() string { return "until" }
+
+// GetAdminMediaRegenerateUntil safely fetches the value for global configuration 'AdminMediaRegenerateUntil' field
+func GetAdminMediaRegenerateUntil() string { return global.GetAdminMediaRegenerateUntil() }
+
+// SetAdminMediaRegenerateUntil safely sets the value for global configuration 'AdminMediaRegenerateUntil' field
+func SetAdminMediaRegenerateUntil(v string) { global.SetAdminMediaRegenerateUntil(v) }
+
 // GetRequestIDHeader safely fetches the Configuration value for state's 'RequestIDHeader' field
 func (st *ConfigState) GetRequestIDHeader() (v string) {
 	st.mutex.RLock()
@@ -4386,3 +7124,4 @@ func GetRequestIDHeader() string { return global.GetRequestIDHeader() }
 
 // SetRequestIDHeader safely sets the value for global configuration 'RequestIDHeader' field
 func SetRequestIDHeader(v string) { global.SetRequestIDHeader(v) }
+
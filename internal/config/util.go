@@ -19,6 +19,7 @@
 
 import (
 	"net/netip"
+	"slices"
 
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
@@ -37,3 +38,11 @@ func MustParseIPPrefixes(in []string) []netip.Prefix {
 
 	return prefs
 }
+
+// IsLocalDomain returns whether domain is this instance's
+// host, account domain, or one of its extra account domains.
+func IsLocalDomain(domain string) bool {
+	return domain == GetHost() ||
+		domain == GetAccountDomain() ||
+		slices.Contains(GetExtraAccountDomains(), domain)
+}
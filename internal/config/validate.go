@@ -19,6 +19,8 @@
 
 import (
 	"fmt"
+	"net"
+	"net/netip"
 	"net/url"
 	"strings"
 
@@ -62,6 +64,32 @@ func Validate() error {
 		}
 	}
 
+	// `extra-account-domains` should
+	// contain only valid, lowercase hostnames
+	// other than our own host / account domain.
+	for _, domain := range GetExtraAccountDomains() {
+		_, ok := dns.IsDomainName(domain)
+		switch {
+		case domain == "" || !ok || strings.ContainsAny(domain, "/@ "):
+			errf(
+				"%s entries must be valid hostnames, provided value was %s",
+				ExtraAccountDomainsFlag(), domain,
+			)
+
+		case domain != strings.ToLower(domain):
+			errf(
+				"%s entries must be lowercase, provided value was %s",
+				ExtraAccountDomainsFlag(), domain,
+			)
+
+		case domain == host || domain == GetAccountDomain():
+			errf(
+				"%s entries must not be the same as %s or %s, provided value was %s",
+				ExtraAccountDomainsFlag(), HostFlag(), AccountDomainFlag(), domain,
+			)
+		}
+	}
+
 	// Ensure `protocol` sensibly set.
 	switch proto := GetProtocol(); proto {
 	case "https":
@@ -84,6 +112,28 @@ func Validate() error {
 		)
 	}
 
+	// `log-format` should be
+	// "logfmt" or "json".
+	switch logFormat := GetLogFormat(); logFormat {
+	case "logfmt", "json":
+		// No problem.
+
+	default:
+		errf(
+			"%s must be set to either logfmt or json, provided value was %s",
+			LogFormatFlag(), logFormat,
+		)
+	}
+
+	// `log-file` rotation limits can't be negative.
+	if GetLogFileMaxAge() < 0 {
+		errf("%s must be 0 or greater", LogFileMaxAgeFlag())
+	}
+
+	if GetLogFileMaxBackups() < 0 {
+		errf("%s must be 0 or greater", LogFileMaxBackupsFlag())
+	}
+
 	// `federation-mode` should be
 	// "blocklist" or "allowlist".
 	switch fediMode := GetInstanceFederationMode(); fediMode {
@@ -100,6 +150,174 @@ func Validate() error {
 		)
 	}
 
+	// `instance-authorized-fetch-mode` should be
+	// "require" or "optional".
+	switch afMode := GetInstanceAuthorizedFetchMode(); afMode {
+	case InstanceAuthorizedFetchModeRequire, InstanceAuthorizedFetchModeOptional:
+		// No problem.
+
+	default:
+		errf(
+			"%s must be set to either require or optional, provided value was %s",
+			InstanceAuthorizedFetchModeFlag(), afMode,
+		)
+	}
+
+	// `instance-federation-spam-score-action`
+	// should be "drop" or "sin_bin".
+	switch spamAction := GetInstanceFederationSpamScoreAction(); spamAction {
+	case InstanceFederationSpamScoreActionDrop, InstanceFederationSpamScoreActionSinBin:
+		// No problem.
+
+	default:
+		errf(
+			"%s must be set to either drop or sin_bin, provided value was %s",
+			InstanceFederationSpamScoreActionFlag(), spamAction,
+		)
+	}
+
+	// `accounts-captcha-provider` should be empty,
+	// or a supported provider with both keys set.
+	switch captchaProvider := GetAccountsCaptchaProvider(); captchaProvider {
+	case "":
+		// No problem.
+
+	case AccountsCaptchaProviderHCaptcha,
+		AccountsCaptchaProviderTurnstile,
+		AccountsCaptchaProviderFriendlyCaptcha:
+		if GetAccountsCaptchaSiteKey() == "" || GetAccountsCaptchaSecretKey() == "" {
+			errf(
+				"%s and %s must be set when %s is set",
+				AccountsCaptchaSiteKeyFlag(), AccountsCaptchaSecretKeyFlag(), AccountsCaptchaProviderFlag(),
+			)
+		}
+
+	default:
+		errf(
+			"%s must be set to one of hcaptcha, turnstile, or friendlycaptcha, provided value was %s",
+			AccountsCaptchaProviderFlag(), captchaProvider,
+		)
+	}
+
+	// `smtp-auth-method` should be plain, or
+	// xoauth2 with the oauth2 client details set.
+	switch smtpAuthMethod := GetSMTPAuthMethod(); smtpAuthMethod {
+	case SMTPAuthMethodPlain:
+		// No problem.
+
+	case SMTPAuthMethodXOAuth2:
+		if GetSMTPUsername() == "" ||
+			GetSMTPOAuth2TokenURL() == "" ||
+			GetSMTPOAuth2ClientID() == "" ||
+			GetSMTPOAuth2RefreshToken() == "" {
+			errf(
+				"%s, %s, %s, and %s must be set when %s is %s",
+				SMTPUsernameFlag(), SMTPOAuth2TokenURLFlag(), SMTPOAuth2ClientIDFlag(), SMTPOAuth2RefreshTokenFlag(),
+				SMTPAuthMethodFlag(), SMTPAuthMethodXOAuth2,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be set to either plain or xoauth2, provided value was %s",
+			SMTPAuthMethodFlag(), smtpAuthMethod,
+		)
+	}
+
+	// `instance-webfinger-alias-hosts` should
+	// contain only valid, lowercase hostnames
+	// other than our own host / account domain.
+	for _, aliasHost := range GetInstanceWebfingerAliasHosts() {
+		_, ok := dns.IsDomainName(aliasHost)
+		switch {
+		case aliasHost == "" || !ok || strings.ContainsAny(aliasHost, "/@ "):
+			errf(
+				"%s entries must be valid hostnames, provided value was %s",
+				InstanceWebfingerAliasHostsFlag(), aliasHost,
+			)
+
+		case aliasHost != strings.ToLower(aliasHost):
+			errf(
+				"%s entries must be lowercase, provided value was %s",
+				InstanceWebfingerAliasHostsFlag(), aliasHost,
+			)
+
+		case aliasHost == host || aliasHost == GetAccountDomain():
+			errf(
+				"%s entries must not be the same as %s or %s, provided value was %s",
+				InstanceWebfingerAliasHostsFlag(), HostFlag(), AccountDomainFlag(), aliasHost,
+			)
+		}
+	}
+
+	// `instance-nodeinfo-metadata` should only
+	// contain recognized metadata field names.
+	for _, field := range GetInstanceNodeInfoMetadata() {
+		switch field {
+		case InstanceNodeInfoMetadataNodeName,
+			InstanceNodeInfoMetadataNodeDescription,
+			InstanceNodeInfoMetadataMaintainer,
+			InstanceNodeInfoMetadataFederation:
+			// No problem.
+
+		default:
+			errf(
+				"%s entries must be one of node-name, node-description, maintainer or federation, provided value was %s",
+				InstanceNodeInfoMetadataFlag(), field,
+			)
+		}
+	}
+
+	// `instance-outbox-page-size` must be positive.
+	if size := GetInstanceOutboxPageSize(); size <= 0 {
+		errf(
+			"%s must be greater than 0, provided value was %d",
+			InstanceOutboxPageSizeFlag(), size,
+		)
+	}
+
+	// Replies backfill must be limited
+	// in both depth and size when enabled.
+	if GetInstanceBackfillReplies() {
+		if depth := GetInstanceBackfillRepliesMaxDepth(); depth <= 0 {
+			errf(
+				"%s must be greater than 0 when %s is true, provided value was %d",
+				InstanceBackfillRepliesMaxDepthFlag(), InstanceBackfillRepliesFlag(), depth,
+			)
+		}
+
+		if statuses := GetInstanceBackfillRepliesMaxStatuses(); statuses <= 0 {
+			errf(
+				"%s must be greater than 0 when %s is true, provided value was %d",
+				InstanceBackfillRepliesMaxStatusesFlag(), InstanceBackfillRepliesFlag(), statuses,
+			)
+		}
+	}
+
+	// `instance-backfill-follow-statuses` may be 0 (disabled), but not negative.
+	if statuses := GetInstanceBackfillFollowStatuses(); statuses < 0 {
+		errf(
+			"%s must be 0 or greater, provided value was %d",
+			InstanceBackfillFollowStatusesFlag(), statuses,
+		)
+	}
+
+	// `instance-websub-hub-url` is optional,
+	// but must be a valid http(s) URL if set.
+	if hubURL := GetInstanceWebSubHubURL(); hubURL != "" {
+		if url, err := url.Parse(hubURL); err != nil {
+			errf(
+				"%s invalid: %w",
+				InstanceWebSubHubURLFlag(), err,
+			)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf(
+				"%s scheme must be https or http",
+				InstanceWebSubHubURLFlag(),
+			)
+		}
+	}
+
 	// Parse `instance-languages`, and
 	// set enriched version into config.
 	parsedLangs, err := language.InitLangs(GetInstanceLanguages().TagStrs())
@@ -120,6 +338,331 @@ func Validate() error {
 		errf("%s must be set", WebAssetBaseDirFlag())
 	}
 
+	// `search-backend` should be "db" or "meilisearch",
+	// and meilisearch needs a valid URL to connect to.
+	switch searchBackend := GetSearchBackend(); searchBackend {
+	case SearchBackendDB:
+		// No problem.
+
+	case SearchBackendMeilisearch:
+		meiliURL := GetSearchMeilisearchURL()
+		if meiliURL == "" {
+			errf(
+				"%s must be set when %s is %s",
+				SearchMeilisearchURLFlag(), SearchBackendFlag(), searchBackend,
+			)
+		} else if url, err := url.Parse(meiliURL); err != nil {
+			errf(
+				"%s invalid: %w",
+				SearchMeilisearchURLFlag(), err,
+			)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf(
+				"%s scheme must be https or http",
+				SearchMeilisearchURLFlag(),
+			)
+		}
+
+		if GetSearchMeilisearchIndex() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				SearchMeilisearchIndexFlag(), SearchBackendFlag(), searchBackend,
+			)
+		}
+
+	case "":
+		errf("%s must be set", SearchBackendFlag())
+
+	default:
+		errf(
+			"%s must be set to either %s or %s, provided value was %s",
+			SearchBackendFlag(), SearchBackendDB, SearchBackendMeilisearch, searchBackend,
+		)
+	}
+
+	// `media-thumbnail-format` must be a supported format.
+	switch thumbFormat := GetMediaThumbnailFormat(); thumbFormat {
+	case MediaThumbnailFormatJPEG, MediaThumbnailFormatWebP, MediaThumbnailFormatAVIF:
+		// No problem.
+
+	default:
+		errf(
+			"%s must be set to one of %s, %s or %s, provided value was %s",
+			MediaThumbnailFormatFlag(), MediaThumbnailFormatJPEG,
+			MediaThumbnailFormatWebP, MediaThumbnailFormatAVIF, thumbFormat,
+		)
+	}
+
+	// `advanced-rate-limit-key` must be a supported key.
+	switch rlKey := GetAdvancedRateLimitKey(); rlKey {
+	case RateLimitKeyIP, RateLimitKeyToken, RateLimitKeyAccount:
+		// No problem.
+
+	default:
+		errf(
+			"%s must be set to one of %s, %s or %s, provided value was %s",
+			AdvancedRateLimitKeyFlag(), RateLimitKeyIP,
+			RateLimitKeyToken, RateLimitKeyAccount, rlKey,
+		)
+	}
+
+	switch aiMode := GetAdvancedAIScraperMode(); aiMode {
+	case AIScraperModeDisabled, AIScraperModeBlock:
+		// No problem.
+
+	case AIScraperModeTarpit:
+		if GetAdvancedAIScraperTarpitDelay() <= 0 {
+			errf(
+				"%s must be greater than 0 when %s is %s",
+				AdvancedAIScraperTarpitDelayFlag(),
+				AdvancedAIScraperModeFlag(), AIScraperModeTarpit,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be set to empty string, %s or %s, provided value was %s",
+			AdvancedAIScraperModeFlag(), AIScraperModeBlock,
+			AIScraperModeTarpit, aiMode,
+		)
+	}
+
+	// `cache-backend` must be a supported backend.
+	switch cacheBackend := GetCacheBackend(); cacheBackend {
+	case CacheBackendMemory:
+		// No problem.
+
+	case CacheBackendRedis:
+		if GetCacheRedisAddress() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				CacheRedisAddressFlag(), CacheBackendFlag(), cacheBackend,
+			)
+		}
+
+		if GetCacheRedisTTL() <= 0 {
+			errf("%s must be greater than 0", CacheRedisTTLFlag())
+		}
+
+	default:
+		errf(
+			"%s must be set to either %s or %s, provided value was %s",
+			CacheBackendFlag(), CacheBackendMemory, CacheBackendRedis, cacheBackend,
+		)
+	}
+
+	// `cache-invalidation-bus` must be a supported
+	// bus, with the necessary connection configured.
+	switch cacheBus := GetCacheInvalidationBus(); cacheBus {
+	case CacheInvalidationBusNone:
+		// No problem.
+
+	case CacheInvalidationBusPostgres:
+		if !strings.EqualFold(GetDbType(), "postgres") {
+			errf(
+				"%s can only be %s when %s is postgres",
+				CacheInvalidationBusFlag(), cacheBus, DbTypeFlag(),
+			)
+		}
+
+	case CacheInvalidationBusRedis:
+		if GetCacheRedisAddress() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				CacheRedisAddressFlag(), CacheInvalidationBusFlag(), cacheBus,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be set to either %s or %s (or left empty), provided value was %s",
+			CacheInvalidationBusFlag(), CacheInvalidationBusPostgres,
+			CacheInvalidationBusRedis, cacheBus,
+		)
+	}
+
+	// `db-postgres-replicas` are only
+	// supported for postgres databases.
+	if len(GetDbPostgresReplicas()) > 0 {
+		if !strings.EqualFold(GetDbType(), "postgres") {
+			errf("%s can only be set when %s is postgres", DbPostgresReplicasFlag(), DbTypeFlag())
+		}
+
+		if GetDbPostgresReplicaMaxLag() <= 0 {
+			errf("%s must be greater than 0", DbPostgresReplicaMaxLagFlag())
+		}
+	}
+
+	// `accounts-archive-interval-days` can be 0, but not negative.
+	if GetAccountsArchiveInterval() < 0 {
+		errf("%s must be 0 or greater", AccountsArchiveIntervalFlag())
+	}
+
+	// `accounts-deletion-grace-days` can be 0, but not negative.
+	if GetAccountsDeletionGrace() < 0 {
+		errf("%s must be 0 or greater", AccountsDeletionGraceFlag())
+	}
+
+	// `accounts-field-verification-interval` can be 0, but not negative.
+	if GetAccountsFieldVerificationInterval() < 0 {
+		errf("%s must be 0 or greater", AccountsFieldVerificationIntervalFlag())
+	}
+
+	// `media-video-*` transcode limits must be usable
+	// values when video transcoding has been enabled.
+	if GetMediaVideoTranscode() {
+		if GetMediaVideoMaxBitrate() < 1 {
+			errf("%s must be at least 1", MediaVideoMaxBitrateFlag())
+		}
+
+		// Anything smaller than this
+		// is unwatchable anyway, and
+		// keeps scaling well-behaved.
+		if GetMediaVideoMaxHeight() < 144 {
+			errf("%s must be at least 144", MediaVideoMaxHeightFlag())
+		}
+	}
+
+	// `trends-min-accounts` should be at least 1 when trends are
+	// enabled, otherwise anything ever posted would be trending.
+	if GetTrendsEnabled() && GetTrendsMinAccounts() < 1 {
+		errf("%s must be at least 1", TrendsMinAccountsFlag())
+	}
+
+	// `translation-provider` should be empty, or a supported
+	// provider with whatever else that provider needs set.
+	switch translationProvider := GetTranslationProvider(); translationProvider {
+	case "":
+		// No problem.
+
+	case TranslationProviderDeepL:
+		if GetTranslationAPIKey() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				TranslationAPIKeyFlag(), TranslationProviderFlag(), translationProvider,
+			)
+		}
+
+	case TranslationProviderLibreTranslate:
+		if GetTranslationEndpoint() == "" {
+			errf(
+				"%s must be set when %s is %s",
+				TranslationEndpointFlag(), TranslationProviderFlag(), translationProvider,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be set to one of deepl or libretranslate, provided value was %s",
+			TranslationProviderFlag(), translationProvider,
+		)
+	}
+
+	// `delivery-*` settings must allow at least one
+	// attempt, and domains can only be marked as
+	// unreachable after at least one failure.
+	if GetDeliveryMaxRetries() < 1 {
+		errf("%s must be at least 1", DeliveryMaxRetriesFlag())
+	}
+
+	if GetDeliveryBackoff() <= 0 {
+		errf("%s must be greater than 0", DeliveryBackoffFlag())
+	}
+
+	if GetDeliveryUnreachableThreshold() < 1 {
+		errf("%s must be at least 1", DeliveryUnreachableThresholdFlag())
+	}
+
+	if GetDeliveryDeadLetterRetention() < 0 {
+		errf("%s must be 0 or greater", DeliveryDeadLetterRetentionFlag())
+	}
+
+	if GetDeliveryRateLimit() < 0 {
+		errf("%s must be 0 or greater", DeliveryRateLimitFlag())
+	}
+
+	if GetDeliveryDereferenceRateLimit() < 0 {
+		errf("%s must be 0 or greater", DeliveryDereferenceRateLimitFlag())
+	}
+
+	// `http-client.socks-proxy` should be a
+	// host:port address when set, and only then
+	// can `socks-proxy-onion-only` be enabled.
+	if proxy := GetHTTPClientSOCKSProxy(); proxy != "" {
+		_, port, err := net.SplitHostPort(proxy)
+		if err != nil || port == "" || strings.Contains(proxy, "/") {
+			errf(
+				"%s must be a host:port address, provided value was %s",
+				HTTPClientSOCKSProxyFlag(), proxy,
+			)
+		}
+	} else if GetHTTPClientSOCKSProxyOnionOnly() {
+		errf(
+			"%s requires %s to be set",
+			HTTPClientSOCKSProxyOnionOnlyFlag(), HTTPClientSOCKSProxyFlag(),
+		)
+	}
+
+	// `http-client.proxy` should be an http(s) URL
+	// when set, and can't be combined with `socks-proxy`.
+	if proxy := GetHTTPClientProxy(); proxy != "" {
+		u, err := url.Parse(proxy)
+		switch {
+		case err != nil || u.Host == "" ||
+			(u.Scheme != "http" && u.Scheme != "https"):
+			errf(
+				"%s must be an http or https URL, provided value was %s",
+				HTTPClientProxyFlag(), proxy,
+			)
+
+		case GetHTTPClientSOCKSProxy() != "":
+			errf(
+				"%s and %s cannot both be set",
+				HTTPClientProxyFlag(), HTTPClientSOCKSProxyFlag(),
+			)
+		}
+	}
+
+	// `http-client.proxy-include-hosts` and
+	// `proxy-exclude-hosts` should contain only
+	// lowercase hostnames, optionally prefixed
+	// with "*.", IP addresses, or CIDR ranges.
+	for _, hosts := range []struct {
+		flag     string
+		patterns []string
+	}{
+		{HTTPClientProxyIncludeHostsFlag(), GetHTTPClientProxyIncludeHosts()},
+		{HTTPClientProxyExcludeHostsFlag(), GetHTTPClientProxyExcludeHosts()},
+	} {
+		for _, pattern := range hosts.patterns {
+			if !validProxyHostPattern(pattern) {
+				errf(
+					"%s entries must be hostnames (optionally starting with *.), IP addresses, or CIDR ranges, provided value was %s",
+					hosts.flag, pattern,
+				)
+			}
+		}
+	}
+
+	// `cluster-enabled` requires a database that can
+	// be shared between nodes, and an invalidation bus
+	// so nodes don't serve each other's stale data.
+	if GetClusterEnabled() {
+		if strings.EqualFold(GetDbType(), "sqlite") {
+			errf("%s cannot be set when %s is sqlite", ClusterEnabledFlag(), DbTypeFlag())
+		}
+
+		if GetCacheInvalidationBus() == CacheInvalidationBusNone {
+			errf("%s must be set when %s is set", CacheInvalidationBusFlag(), ClusterEnabledFlag())
+		}
+	}
+
+	// `debug-endpoints-enabled` exposes profiling data
+	// and runtime internals, so must be token protected.
+	if GetDebugEndpointsEnabled() && GetDebugEndpointsToken() == "" {
+		errf("%s must be set when %s is set", DebugEndpointsTokenFlag(), DebugEndpointsEnabledFlag())
+	}
+
 	// `storage-s3-redirect-url`
 	if s3RedirectURL := GetStorageS3RedirectURL(); s3RedirectURL != "" {
 		if strings.HasSuffix(s3RedirectURL, "/") {
@@ -170,3 +713,21 @@ func Validate() error {
 
 	return errs.Combine()
 }
+
+// validProxyHostPattern returns whether pattern is
+// a valid http-client proxy include / exclude entry.
+func validProxyHostPattern(pattern string) bool {
+	if _, err := netip.ParsePrefix(pattern); err == nil {
+		return true
+	}
+
+	if _, err := netip.ParseAddr(pattern); err == nil {
+		return true
+	}
+
+	host := strings.TrimPrefix(pattern, "*.")
+	_, ok := dns.IsDomainName(host)
+	return ok && host != "" &&
+		host == strings.ToLower(host) &&
+		!strings.ContainsAny(host, "/@:* ")
+}
@@ -19,6 +19,7 @@
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -140,6 +141,362 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set\nprotocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadRateLimitKey() {
+	testrig.InitTestConfig()
+
+	config.SetAdvancedRateLimitKey("user")
+
+	err := config.Validate()
+	suite.EqualError(err, "advanced-rate-limit-key must be set to one of ip, token or account, provided value was user")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadAIScraperMode() {
+	testrig.InitTestConfig()
+
+	config.SetAdvancedAIScraperMode("teapot")
+
+	err := config.Validate()
+	suite.EqualError(err, "advanced-ai-scraper-mode must be set to empty string, block or tarpit, provided value was teapot")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigAIScraperTarpitNoDelay() {
+	testrig.InitTestConfig()
+
+	config.SetAdvancedAIScraperMode("tarpit")
+	config.SetAdvancedAIScraperTarpitDelay(0)
+
+	err := config.Validate()
+	suite.EqualError(err, "advanced-ai-scraper-tarpit-delay must be greater than 0 when advanced-ai-scraper-mode is tarpit")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadOutboxPageSize() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceOutboxPageSize(0)
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-outbox-page-size must be greater than 0, provided value was 0")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBackfillRepliesNoLimits() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceBackfillReplies(true)
+	config.SetInstanceBackfillRepliesMaxDepth(0)
+	config.SetInstanceBackfillRepliesMaxStatuses(-1)
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-backfill-replies-max-depth must be greater than 0 when instance-backfill-replies is true, provided value was 0\ninstance-backfill-replies-max-statuses must be greater than 0 when instance-backfill-replies is true, provided value was -1")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadBackfillFollowStatuses() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceBackfillFollowStatuses(-1)
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-backfill-follow-statuses must be 0 or greater, provided value was -1")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadFieldVerificationInterval() {
+	testrig.InitTestConfig()
+
+	config.SetAccountsFieldVerificationInterval(-time.Hour)
+
+	err := config.Validate()
+	suite.EqualError(err, "accounts-field-verification-interval must be 0 or greater")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBackfillRepliesDisabledNoLimits() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceBackfillReplies(false)
+	config.SetInstanceBackfillRepliesMaxDepth(0)
+	config.SetInstanceBackfillRepliesMaxStatuses(0)
+
+	err := config.Validate()
+	suite.NoError(err)
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadAuthorizedFetchMode() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceAuthorizedFetchMode("sometimes")
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-authorized-fetch-mode must be set to either require or optional, provided value was sometimes")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadCaptchaProvider() {
+	testrig.InitTestConfig()
+
+	config.SetAccountsCaptchaProvider("recaptcha")
+
+	err := config.Validate()
+	suite.EqualError(err, "accounts-captcha-provider must be set to one of hcaptcha, turnstile, or friendlycaptcha, provided value was recaptcha")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigCaptchaNoKeys() {
+	testrig.InitTestConfig()
+
+	config.SetAccountsCaptchaProvider("turnstile")
+	config.SetAccountsCaptchaSiteKey("0x4AAAAAAA-site")
+
+	err := config.Validate()
+	suite.EqualError(err, "accounts-captcha-site-key and accounts-captcha-secret-key must be set when accounts-captcha-provider is set")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadTranslationProvider() {
+	testrig.InitTestConfig()
+
+	config.SetTranslationProvider("google")
+
+	err := config.Validate()
+	suite.EqualError(err, "translation-provider must be set to one of deepl or libretranslate, provided value was google")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigTranslationDeepLNoAPIKey() {
+	testrig.InitTestConfig()
+
+	config.SetTranslationProvider("deepl")
+
+	err := config.Validate()
+	suite.EqualError(err, "translation-api-key must be set when translation-provider is deepl")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigTranslationLibreTranslateNoEndpoint() {
+	testrig.InitTestConfig()
+
+	config.SetTranslationProvider("libretranslate")
+	config.SetTranslationAPIKey("some-key")
+
+	err := config.Validate()
+	suite.EqualError(err, "translation-endpoint must be set when translation-provider is libretranslate")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadSMTPAuthMethod() {
+	testrig.InitTestConfig()
+
+	config.SetSMTPAuthMethod("login")
+
+	err := config.Validate()
+	suite.EqualError(err, "smtp-auth-method must be set to either plain or xoauth2, provided value was login")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigSMTPXOAuth2NoClient() {
+	testrig.InitTestConfig()
+
+	config.SetSMTPAuthMethod("xoauth2")
+	config.SetSMTPUsername("gotosocial@example.org")
+	config.SetSMTPOAuth2TokenURL("https://oauth2.googleapis.com/token")
+
+	err := config.Validate()
+	suite.EqualError(err, "smtp-username, smtp-oauth2-token-url, smtp-oauth2-client-id, and smtp-oauth2-refresh-token must be set when smtp-auth-method is xoauth2")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadNodeInfoMetadata() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceNodeInfoMetadata([]string{"node-name", "user-count"})
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-nodeinfo-metadata entries must be one of node-name, node-description, maintainer or federation, provided value was user-count")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadWebSubHubURL() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceWebSubHubURL("hub.example.org")
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-websub-hub-url scheme must be https or http")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigWebfingerAliasHosts() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceWebfingerAliasHosts([]string{"old.example.org", "https://old.example.org/", "Old.Example.Org", "localhost:8080"})
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-webfinger-alias-hosts entries must be valid hostnames, provided value was https://old.example.org/\n"+
+		"instance-webfinger-alias-hosts entries must be lowercase, provided value was Old.Example.Org\n"+
+		"instance-webfinger-alias-hosts entries must not be the same as host or account-domain, provided value was localhost:8080")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigExtraAccountDomains() {
+	testrig.InitTestConfig()
+
+	config.SetExtraAccountDomains([]string{"family.example.org", "family.example.org/@", "Friends.Example.Org", "localhost:8080"})
+
+	err := config.Validate()
+	suite.EqualError(err, "extra-account-domains entries must be valid hostnames, provided value was family.example.org/@\n"+
+		"extra-account-domains entries must be lowercase, provided value was Friends.Example.Org\n"+
+		"extra-account-domains entries must not be the same as host or account-domain, provided value was localhost:8080")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadDelivery() {
+	testrig.InitTestConfig()
+
+	config.SetDeliveryMaxRetries(0)
+	config.SetDeliveryBackoff(0)
+	config.SetDeliveryUnreachableThreshold(0)
+	config.SetDeliveryDeadLetterRetention(-time.Hour)
+	config.SetDeliveryRateLimit(-1)
+	config.SetDeliveryDereferenceRateLimit(-1)
+
+	err := config.Validate()
+	suite.EqualError(err, "delivery-max-retries must be at least 1\n"+
+		"delivery-backoff must be greater than 0\n"+
+		"delivery-unreachable-threshold must be at least 1\n"+
+		"delivery-dead-letter-retention must be 0 or greater\n"+
+		"delivery-rate-limit must be 0 or greater\n"+
+		"delivery-dereference-rate-limit must be 0 or greater")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadSOCKSProxy() {
+	testrig.InitTestConfig()
+
+	config.SetHTTPClientSOCKSProxy("socks5://127.0.0.1")
+
+	err := config.Validate()
+	suite.EqualError(err, "httpclient-socks-proxy must be a host:port address, provided value was socks5://127.0.0.1")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigSOCKSProxyOnionOnlyWithoutProxy() {
+	testrig.InitTestConfig()
+
+	config.SetHTTPClientSOCKSProxyOnionOnly(true)
+
+	err := config.Validate()
+	suite.EqualError(err, "httpclient-socks-proxy-onion-only requires httpclient-socks-proxy to be set")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadHTTPProxy() {
+	testrig.InitTestConfig()
+
+	config.SetHTTPClientProxy("ftp://proxy.internal:21")
+	config.SetHTTPClientProxyIncludeHosts([]string{"*.example.org", "10.0.0.0/8", "https://example.org"})
+	config.SetHTTPClientProxyExcludeHosts([]string{"Internal.Example.Org"})
+
+	err := config.Validate()
+	suite.EqualError(err, "httpclient-proxy must be an http or https URL, provided value was ftp://proxy.internal:21\n"+
+		"httpclient-proxy-include-hosts entries must be hostnames (optionally starting with *.), IP addresses, or CIDR ranges, provided value was https://example.org\n"+
+		"httpclient-proxy-exclude-hosts entries must be hostnames (optionally starting with *.), IP addresses, or CIDR ranges, provided value was Internal.Example.Org")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigHTTPAndSOCKSProxy() {
+	testrig.InitTestConfig()
+
+	config.SetHTTPClientProxy("http://proxy.internal:3128")
+	config.SetHTTPClientSOCKSProxy("127.0.0.1:9050")
+
+	err := config.Validate()
+	suite.EqualError(err, "httpclient-proxy and httpclient-socks-proxy cannot both be set")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadCacheBackend() {
+	testrig.InitTestConfig()
+
+	config.SetCacheBackend("memcached")
+
+	err := config.Validate()
+	suite.EqualError(err, "cache-backend must be set to either memory or redis, provided value was memcached")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigRedisCacheNoAddress() {
+	testrig.InitTestConfig()
+
+	config.SetCacheBackend("redis")
+	config.SetCacheRedisTTL(0)
+
+	err := config.Validate()
+	suite.EqualError(err, "cache-redis-address must be set when cache-backend is redis\n"+
+		"cache-redis-ttl must be greater than 0")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadInvalidationBus() {
+	testrig.InitTestConfig()
+
+	config.SetDbType("sqlite")
+	config.SetCacheInvalidationBus("postgres")
+
+	err := config.Validate()
+	suite.EqualError(err, "cache-invalidation-bus can only be postgres when db-type is postgres")
+
+	config.SetCacheInvalidationBus("redis")
+
+	err = config.Validate()
+	suite.EqualError(err, "cache-redis-address must be set when cache-invalidation-bus is redis")
+
+	config.SetCacheInvalidationBus("kafka")
+
+	err = config.Validate()
+	suite.EqualError(err, "cache-invalidation-bus must be set to either postgres or redis (or left empty), provided value was kafka")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigPostgresReplicasNotPostgres() {
+	testrig.InitTestConfig()
+
+	config.SetDbType("sqlite")
+	config.SetDbPostgresReplicas([]string{"postgres://gotosocial@replica.example.org/gotosocial"})
+	config.SetDbPostgresReplicaMaxLag(0)
+
+	err := config.Validate()
+	suite.EqualError(err, "db-postgres-replicas can only be set when db-type is postgres\n"+
+		"db-postgres-replica-max-lag must be greater than 0")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadCluster() {
+	testrig.InitTestConfig()
+
+	config.SetDbType("sqlite")
+	config.SetClusterEnabled(true)
+
+	err := config.Validate()
+	suite.EqualError(err, "cluster-enabled cannot be set when db-type is sqlite\n"+
+		"cache-invalidation-bus must be set when cluster-enabled is set")
+
+	config.SetDbType("postgres")
+	config.SetCacheInvalidationBus("postgres")
+
+	err = config.Validate()
+	suite.NoError(err)
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigDebugEndpointsNoToken() {
+	testrig.InitTestConfig()
+
+	config.SetDebugEndpointsEnabled(true)
+
+	err := config.Validate()
+	suite.EqualError(err, "debug-endpoints-token must be set when debug-endpoints-enabled is set")
+
+	config.SetDebugEndpointsToken("some-long-random-token")
+
+	err = config.Validate()
+	suite.NoError(err)
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadLogSettings() {
+	testrig.InitTestConfig()
+
+	config.SetLogFormat("xml")
+	config.SetLogFileMaxAge(-time.Hour)
+	config.SetLogFileMaxBackups(-1)
+
+	err := config.Validate()
+	suite.EqualError(err, "log-format must be set to either logfmt or json, provided value was xml\n"+
+		"log-file-max-age must be 0 or greater\n"+
+		"log-file-max-backups must be 0 or greater")
+
+	config.SetLogFormat("json")
+	config.SetLogFileMaxAge(24 * time.Hour)
+	config.SetLogFileMaxBackups(0)
+
+	err = config.Validate()
+	suite.NoError(err)
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
@@ -79,6 +79,21 @@ type Account interface {
 		error,
 	)
 
+	// GetDirectoryAccounts returns accounts that have opted in
+	// to being shown in the profile directory, using offset paging.
+	// Order can be "active", to order by most recent status first,
+	// or "new", to order by most recently created first.
+	GetDirectoryAccounts(
+		ctx context.Context,
+		localOnly bool,
+		order string,
+		limit int,
+		offset int,
+	) (
+		[]*gtsmodel.Account,
+		error,
+	)
+
 	// PopulateAccount ensures that all sub-models of an account are populated (e.g. avatar, header etc).
 	PopulateAccount(ctx context.Context, account *gtsmodel.Account) error
 
@@ -123,6 +138,14 @@ type Account interface {
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountWebStatuses(ctx context.Context, account *gtsmodel.Account, limit int, maxID string) ([]*gtsmodel.Status, error)
 
+	// GetAccountOutboxStatuses returns public and unlisted statuses and boosts
+	// created by the given account, including replies, for serving in the
+	// ActivityPub outbox of a *LOCAL* account. Local-only statuses and
+	// statuses pending approval are excluded.
+	//
+	// In the case of no statuses, this function will return db.ErrNoEntries.
+	GetAccountOutboxStatuses(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Status, error)
+
 	// GetInstanceAccount returns the instance account for the given domain.
 	// If domain is empty, this instance account will be returned.
 	GetInstanceAccount(ctx context.Context, domain string) (*gtsmodel.Account, error)
@@ -136,6 +159,18 @@ type Account interface {
 	// Update local account settings.
 	UpdateAccountSettings(ctx context.Context, settings *gtsmodel.AccountSettings, columns ...string) error
 
+	// GetAccountIDsWithStatusExpiry returns the IDs of local
+	// accounts that have automatic status expiry enabled.
+	GetAccountIDsWithStatusExpiry(ctx context.Context) ([]string, error)
+
+	// GetAccountIDsWithEmailDigest returns the IDs of local
+	// accounts that have periodic email digests enabled.
+	GetAccountIDsWithEmailDigest(ctx context.Context) ([]string, error)
+
+	// GetLocalAccountIDs returns the IDs of all local,
+	// unsuspended accounts, excluding the instance account.
+	GetLocalAccountIDs(ctx context.Context) ([]string, error)
+
 	// PopulateAccountStats either creates account stats for the given
 	// account by performing COUNT(*) database queries, or retrieves
 	// existing stats from the database, and attaches stats to account.
@@ -168,4 +203,40 @@ type Account interface {
 
 	// DeleteAccountStats deletes the accountStats entry for the given accountID.
 	DeleteAccountStats(ctx context.Context, accountID string) error
+
+	// GetAccountArchiveByID gets one AccountArchive with the given ID.
+	GetAccountArchiveByID(ctx context.Context, id string) (*gtsmodel.AccountArchive, error)
+
+	// GetLatestAccountArchive gets the most recently
+	// requested AccountArchive for the given accountID.
+	GetLatestAccountArchive(ctx context.Context, accountID string) (*gtsmodel.AccountArchive, error)
+
+	// GetAccountArchives gets all AccountArchives
+	// for the given accountID, newest first.
+	GetAccountArchives(ctx context.Context, accountID string) ([]*gtsmodel.AccountArchive, error)
+
+	// PutAccountArchive stores one AccountArchive.
+	PutAccountArchive(ctx context.Context, archive *gtsmodel.AccountArchive) error
+
+	// UpdateAccountArchive updates one AccountArchive.
+	UpdateAccountArchive(ctx context.Context, archive *gtsmodel.AccountArchive, columns ...string) error
+
+	// DeleteAccountArchiveByID deletes one AccountArchive with the given id.
+	DeleteAccountArchiveByID(ctx context.Context, id string) error
+
+	// GetAccountImportByID gets one AccountImport with the given ID.
+	GetAccountImportByID(ctx context.Context, id string) (*gtsmodel.AccountImport, error)
+
+	// GetAccountImports gets a page of AccountImports
+	// uploaded by the given accountID, newest first.
+	GetAccountImports(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.AccountImport, error)
+
+	// PutAccountImport stores one AccountImport.
+	PutAccountImport(ctx context.Context, imp *gtsmodel.AccountImport) error
+
+	// UpdateAccountImport updates one AccountImport.
+	UpdateAccountImport(ctx context.Context, imp *gtsmodel.AccountImport, columns ...string) error
+
+	// DeleteAccountImports deletes all AccountImports uploaded by the given accountID.
+	DeleteAccountImports(ctx context.Context, accountID string) error
 }
@@ -68,6 +68,10 @@ type Admin interface {
 	// the number of pending sign-ups sitting in the backlog.
 	CountUnhandledSignups(ctx context.Context) (int, error)
 
+	// GetUnhandledSignups returns the users of all account sign-ups
+	// that have not yet been approved or denied, oldest first.
+	GetUnhandledSignups(ctx context.Context) ([]*gtsmodel.User, error)
+
 	/*
 		ACTION FUNCS
 	*/
@@ -78,6 +82,18 @@ type Admin interface {
 	// GetAdminActions gets all admin actions from the database.
 	GetAdminActions(ctx context.Context) ([]*gtsmodel.AdminAction, error)
 
+	// GetAdminActionsByTargetID gets all admin actions
+	// targeting the given ID from the database, newest first.
+	GetAdminActionsByTargetID(ctx context.Context, targetID string) ([]*gtsmodel.AdminAction, error)
+
+	// GetScheduledAdminActions gets all admin actions scheduled
+	// to be run at or before now, which have not yet completed.
+	GetScheduledAdminActions(ctx context.Context, now time.Time) ([]*gtsmodel.AdminAction, error)
+
+	// GetExpiredAdminActions gets all admin actions that completed without errors,
+	// due to expire at or before now, which have not yet been lifted.
+	GetExpiredAdminActions(ctx context.Context, now time.Time) ([]*gtsmodel.AdminAction, error)
+
 	// PutAdminAction puts one admin action in the database.
 	PutAdminAction(ctx context.Context, action *gtsmodel.AdminAction) error
 
@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Announcement interface {
+	// GetAnnouncementByID fetches the announcement with ID from the database.
+	GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error)
+
+	// GetAnnouncements fetches all announcements from the database, newest first.
+	GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// GetVisibleAnnouncements fetches published announcements
+	// that haven't ended yet from the database, oldest first.
+	GetVisibleAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// PopulateAnnouncement ensures that the emojis of the given announcement are populated.
+	PopulateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// PutAnnouncement inserts the given announcement into the database.
+	PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// UpdateAnnouncement updates the given announcement in the database, only updating given columns if provided.
+	UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error
+
+	// DeleteAnnouncementByID deletes the announcement with ID from
+	// the database, along with any reads of and reactions to it.
+	DeleteAnnouncementByID(ctx context.Context, id string) error
+
+	// IsAnnouncementRead returns true if the given account has dismissed the given announcement.
+	IsAnnouncementRead(ctx context.Context, announcementID string, accountID string) (bool, error)
+
+	// PutAnnouncementRead inserts the given announcement read into the database.
+	PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error
+
+	// GetAnnouncementReaction gets the reaction to the given announcement
+	// created by the given account, with the given name.
+	GetAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) (*gtsmodel.AnnouncementReaction, error)
+
+	// GetAnnouncementReactions returns all reactions to the given announcement, oldest first.
+	GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error)
+
+	// PutAnnouncementReaction inserts the given reaction into the database.
+	PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error
+
+	// DeleteAnnouncementReactionByID deletes the reaction with ID from the database.
+	DeleteAnnouncementReactionByID(ctx context.Context, id string) error
+
+	// DeleteAnnouncementReadsAndReactions deletes all reads of, and reactions
+	// to, announcements by the given account. Useful when deleting an account.
+	DeleteAnnouncementReadsAndReactions(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// Appeal handles getting/creation/updating of appeals against admin actions.
+type Appeal interface {
+	// GetAppealByID gets one appeal by its db id.
+	GetAppealByID(ctx context.Context, id string) (*gtsmodel.Appeal, error)
+
+	// GetAppealByAdminActionID gets the appeal
+	// against the admin action with the given id.
+	GetAppealByAdminActionID(ctx context.Context, adminActionID string) (*gtsmodel.Appeal, error)
+
+	// GetAppeals gets a page of appeals, newest first, optionally
+	// only those by the given account ID, and/or in the given state.
+	GetAppeals(ctx context.Context, accountID string, state gtsmodel.AppealState, page *paging.Page) ([]*gtsmodel.Appeal, error)
+
+	// PopulateAppeal populates the struct pointers on the given appeal.
+	PopulateAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error
+
+	// PutAppeal puts the given appeal in the database.
+	PutAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error
+
+	// UpdateAppeal updates one appeal by its db id.
+	// If any columns are set, only they will be updated.
+	UpdateAppeal(ctx context.Context, appeal *gtsmodel.Appeal, columns ...string) error
+}
@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// AuditLog handles getting/creation of audit log
+// entries recording the actions taken by admins.
+type AuditLog interface {
+	// GetAuditLogEntryByID gets one audit log entry by its db id.
+	GetAuditLogEntryByID(ctx context.Context, id string) (*gtsmodel.AuditLogEntry, error)
+
+	// GetAuditLogEntries gets a page of audit log entries, newest first,
+	// optionally only those for actions taken by the given account ID,
+	// on targets of the given type, and/or on the given target ID.
+	GetAuditLogEntries(
+		ctx context.Context,
+		accountID string,
+		targetType string,
+		targetID string,
+		page *paging.Page,
+	) ([]*gtsmodel.AuditLogEntry, error)
+
+	// PopulateAuditLogEntry populates the struct pointers on the given audit log entry.
+	PopulateAuditLogEntry(ctx context.Context, entry *gtsmodel.AuditLogEntry) error
+
+	// PutAuditLogEntry puts the given audit log entry in the database.
+	PutAuditLogEntry(ctx context.Context, entry *gtsmodel.AuditLogEntry) error
+}
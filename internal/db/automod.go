@@ -0,0 +1,43 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Automod interface {
+	// AutomodMatch returns the automod rule with the most severe
+	// action that matches the given status, or nil if none match.
+	// (Note: the actual matching code can be found under ./internal/automod/ ).
+	AutomodMatch(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.AutomodRule, error)
+
+	// GetAutomodRule fetches the automod rule with ID from the database.
+	GetAutomodRule(ctx context.Context, id string) (*gtsmodel.AutomodRule, error)
+
+	// GetAutomodRules fetches all automod rules from the database.
+	GetAutomodRules(ctx context.Context) ([]*gtsmodel.AutomodRule, error)
+
+	// PutAutomodRule inserts the given automod rule into the database.
+	PutAutomodRule(ctx context.Context, rule *gtsmodel.AutomodRule) error
+
+	// DeleteAutomodRule deletes the automod rule with ID from the database.
+	DeleteAutomodRule(ctx context.Context, id string) error
+}
@@ -370,6 +370,17 @@ func (a *accountDB) GetAccounts(
 			bun.Ident("username"),
 			bun.Ident("domain_username"),
 		)
+	} else if dbDialect == dialect.MySQL {
+		// Create a subquery for MySQL to
+		// reuse. The database already uses
+		// a binary collation, so there's no
+		// need to specify a collation here.
+		subQ = a.db.NewRaw(
+			"(COALESCE(?, ?) || ? || ?)",
+			bun.Ident("domain"), "",
+			"/@",
+			bun.Ident("username"),
+		)
 	} else {
 		// Create a subquery for
 		// Postgres to reuse.
@@ -464,7 +475,29 @@ func (a *accountDB) GetAccounts(
 		useAccountIDIn = true
 	}
 
-	// TODO: invitedBy
+	if invitedBy != "" {
+		// Get only accounts that signed up
+		// with an invite from given account.
+		invites, err := a.state.DB.GetInvites(ctx, invitedBy)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("error getting invites: %w", err)
+		}
+
+		inviteIDs := make(map[string]struct{}, len(invites))
+		for _, invite := range invites {
+			inviteIDs[invite.ID] = struct{}{}
+		}
+
+		if err := lazyLoadUsers(); err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			if _, ok := inviteIDs[user.InviteID]; ok {
+				accountIDIn = append(accountIDIn, user.AccountID)
+			}
+		}
+		useAccountIDIn = true
+	}
 
 	if username != "" {
 		q = q.Where("? = ?", bun.Ident("account.username"), username)
@@ -586,6 +619,78 @@ func (a *accountDB) GetAccounts(
 	return a.state.DB.GetAccountsByIDs(ctx, accountIDs)
 }
 
+func (a *accountDB) GetDirectoryAccounts(
+	ctx context.Context,
+	localOnly bool,
+	order string,
+	limit int,
+	offset int,
+) (
+	[]*gtsmodel.Account,
+	error,
+) {
+	var accountIDs []string
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		// Only accounts that opted in.
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		// Skip suspended and moved accounts.
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? IS NULL", bun.Ident("account.moved_to_uri")).
+		// Skip our instance account.
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		// Skip remote instance accounts.
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("account.domain")).
+				WhereOr("? != ?", bun.Ident("account.username"), bun.Ident("account.domain"))
+		})
+
+	if localOnly {
+		q = q.Where("? IS NULL", bun.Ident("account.domain"))
+	}
+
+	switch order {
+	case "active":
+		// Order by most recent status,
+		// putting accounts without any
+		// statuses (or stats) last.
+		q = q.
+			Join(
+				"LEFT JOIN ? AS ? ON ? = ?",
+				bun.Ident("account_stats"), bun.Ident("stats"),
+				bun.Ident("stats.account_id"), bun.Ident("account.id"),
+			).
+			OrderExpr("? DESC NULLS LAST", bun.Ident("stats.last_status_at"))
+
+	case "new":
+		q = q.OrderExpr("? DESC", bun.Ident("account.created_at"))
+
+	default:
+		return nil, gtserror.Newf("unrecognized directory order %s", order)
+	}
+
+	// Tie-break on ID to keep
+	// offset paging stable.
+	q = q.
+		OrderExpr("? DESC", bun.Ident("account.id")).
+		Limit(limit).
+		Offset(offset)
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
 func (a *accountDB) getAccount(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Account) error, keyParts ...any) (*gtsmodel.Account, error) {
 	// Fetch account from database cache with loader callback
 	account, err := a.state.Caches.DB.Account.LoadOne(lookup, func() (*gtsmodel.Account, error) {
@@ -722,6 +827,10 @@ func (a *accountDB) PopulateAccount(ctx context.Context, account *gtsmodel.Accou
 }
 
 func (a *accountDB) PutAccount(ctx context.Context, account *gtsmodel.Account) error {
+	// Tell other processes to drop any cached
+	// lookups of this account, e.g. "not found".
+	defer a.state.Caches.PublishInvalidateAccount(ctx, account)
+
 	return a.state.Caches.DB.Account.Store(account, func() error {
 		// It is safe to run this database transaction within cache.Store
 		// as the cache does not attempt a mutex lock until AFTER hook.
@@ -751,6 +860,10 @@ func (a *accountDB) UpdateAccount(ctx context.Context, account *gtsmodel.Account
 		columns = append(columns, "updated_at")
 	}
 
+	// Tell other processes to drop
+	// their cached copies of account.
+	defer a.state.Caches.PublishInvalidateAccount(ctx, account)
+
 	return a.state.Caches.DB.Account.Store(account, func() error {
 		// It is safe to run this database transaction within cache.Store
 		// as the cache does not attempt a mutex lock until AFTER hook.
@@ -823,6 +936,7 @@ func (a *accountDB) DeleteAccount(ctx context.Context, id string) error {
 	// call invalidate hook in case not cached.
 	a.state.Caches.DB.Account.Invalidate("ID", id)
 	a.state.Caches.OnInvalidateAccount(&deleted)
+	a.state.Caches.PublishInvalidateAccount(ctx, &deleted)
 
 	return nil
 }
@@ -915,7 +1029,7 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 
 	if mediaOnly {
 		// Attachments are stored as a json object; this
-		// implementation differs between SQLite and Postgres,
+		// implementation differs between SQLite/MySQL and Postgres,
 		// so we have to be thorough to cover all eventualities
 		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
 			switch a.db.Dialect().Name() {
@@ -923,7 +1037,7 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 				return q.
 					Where("? IS NOT NULL", bun.Ident("status.attachments")).
 					Where("? != '{}'", bun.Ident("status.attachments"))
-			case dialect.SQLite:
+			case dialect.SQLite, dialect.MySQL:
 				return q.
 					Where("? IS NOT NULL", bun.Ident("status.attachments")).
 					Where("? != ''", bun.Ident("status.attachments")).
@@ -931,7 +1045,7 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 					Where("? != '{}'", bun.Ident("status.attachments")).
 					Where("? != '[]'", bun.Ident("status.attachments"))
 			default:
-				log.Panic(ctx, "db dialect was neither pg nor sqlite")
+				log.Panic(ctx, "db dialect was neither pg, sqlite nor mysql")
 				return q
 			}
 		})
@@ -1095,6 +1209,82 @@ func (a *accountDB) GetAccountWebStatuses(
 	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
 }
 
+func (a *accountDB) GetAccountOutboxStatuses(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.Status, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		statusIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		// Select only IDs from table
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		// Only Public or Unlocked statuses.
+		Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+			gtsmodel.VisibilityPublic,
+			gtsmodel.VisibilityUnlocked,
+		})).
+		// Don't include local-only statuses,
+		// or those not yet approved for sending.
+		Where("? = ?", bun.Ident("status.federated"), true).
+		Where("NOT ? = ?", bun.Ident("status.pending_approval"), true)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("status.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("status.id"))
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(statusIDs)
+	}
+
+	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (a *accountDB) GetAccountSettings(
 	ctx context.Context,
 	accountID string,
@@ -1180,6 +1370,68 @@ func (a *accountDB) UpdateAccountSettings(
 	})
 }
 
+func (a *accountDB) GetAccountIDsWithStatusExpiry(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_settings"), bun.Ident("account_settings")).
+		Column("account_settings.account_id").
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("account_settings.account_id"),
+		).
+		Where("? > 0", bun.Ident("account_settings.status_expiry_days")).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Order("account_settings.account_id").
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (a *accountDB) GetAccountIDsWithEmailDigest(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_settings"), bun.Ident("account_settings")).
+		Column("account_settings.account_id").
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("account_settings.account_id"),
+		).
+		Where("? > 0", bun.Ident("account_settings.email_digest_days")).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Order("account_settings.account_id").
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (a *accountDB) GetLocalAccountIDs(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		Order("account.id").
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
 func (a *accountDB) PopulateAccountStats(ctx context.Context, account *gtsmodel.Account) error {
 	if account.Stats != nil {
 		// Already populated!
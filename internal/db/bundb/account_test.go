@@ -89,6 +89,48 @@ func (suite *AccountTestSuite) TestGetAccountStatusesExcludeRepliesAndReblogsPub
 	suite.Len(statuses, 3)
 }
 
+func (suite *AccountTestSuite) TestGetAccountOutboxStatuses() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+
+	// Get the first page: public statuses, including
+	// replies, but excluding the local-only status.
+	statuses, err := suite.db.GetAccountOutboxStatuses(ctx, accountID, &paging.Page{
+		Max:   paging.MaxID(""),
+		Limit: 2,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, 2)
+	suite.Equal("01J2M1HPFSS54S60Y0KYV23KJE", statuses[0].ID)
+	suite.Equal("01HH9KYNQPA416TNJ53NSATP40", statuses[1].ID)
+
+	// Get the next page.
+	statuses, err = suite.db.GetAccountOutboxStatuses(ctx, accountID, &paging.Page{
+		Max:   paging.MaxID(statuses[1].ID),
+		Limit: 2,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, 1)
+	suite.Equal("01F8MHAMCHF6Y650WCRSCP4WMY", statuses[0].ID)
+
+	// Page back up from the oldest,
+	// should still be sorted newest first.
+	statuses, err = suite.db.GetAccountOutboxStatuses(ctx, accountID, &paging.Page{
+		Min:   paging.MinID(statuses[0].ID),
+		Limit: 2,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, 2)
+	suite.Equal("01J2M1HPFSS54S60Y0KYV23KJE", statuses[0].ID)
+	suite.Equal("01HH9KYNQPA416TNJ53NSATP40", statuses[1].ID)
+}
+
 // populateTestStatus adds mandatory fields to a partially populated status.
 func (suite *AccountTestSuite) populateTestStatus(testAccountKey string, status *gtsmodel.Status, inReplyTo *gtsmodel.Status) *gtsmodel.Status {
 	testAccount := suite.testAccounts[testAccountKey]
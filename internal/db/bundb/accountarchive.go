@@ -0,0 +1,182 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func (a *accountDB) GetAccountArchiveByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.AccountArchive, error) {
+	archive := new(gtsmodel.AccountArchive)
+
+	if err := a.db.
+		NewSelect().
+		Model(archive).
+		Where("? = ?", bun.Ident("account_archive.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.populateAccountArchive(ctx, archive); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func (a *accountDB) GetLatestAccountArchive(
+	ctx context.Context,
+	accountID string,
+) (*gtsmodel.AccountArchive, error) {
+	archive := new(gtsmodel.AccountArchive)
+
+	if err := a.db.
+		NewSelect().
+		Model(archive).
+		Where("? = ?", bun.Ident("account_archive.account_id"), accountID).
+		Order("account_archive.id DESC").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.populateAccountArchive(ctx, archive); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func (a *accountDB) GetAccountArchives(
+	ctx context.Context,
+	accountID string,
+) ([]*gtsmodel.AccountArchive, error) {
+	var archives []*gtsmodel.AccountArchive
+
+	if err := a.db.
+		NewSelect().
+		Model(&archives).
+		Where("? = ?", bun.Ident("account_archive.account_id"), accountID).
+		Order("account_archive.id DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(archives) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	for _, archive := range archives {
+		if err := a.populateAccountArchive(ctx, archive); err != nil {
+			return nil, err
+		}
+	}
+
+	return archives, nil
+}
+
+func (a *accountDB) populateAccountArchive(
+	ctx context.Context,
+	archive *gtsmodel.AccountArchive,
+) error {
+	if gtscontext.Barebones(ctx) {
+		// No need to fully populate.
+		return nil
+	}
+
+	if archive.Account == nil {
+		// Not set, fetch from database.
+		var err error
+		archive.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			archive.AccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error populating account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (a *accountDB) PutAccountArchive(
+	ctx context.Context,
+	archive *gtsmodel.AccountArchive,
+) error {
+	_, err := a.db.
+		NewInsert().
+		Model(archive).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountDB) UpdateAccountArchive(
+	ctx context.Context,
+	archive *gtsmodel.AccountArchive,
+	columns ...string,
+) error {
+	archive.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(archive).
+		Column(columns...).
+		Where("? = ?", bun.Ident("account_archive.id"), archive.ID).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountDB) DeleteAccountArchiveByID(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := a.db.
+		NewDelete().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("account_archives"),
+			bun.Ident("account_archive"),
+		).
+		Where(
+			"? = ?",
+			bun.Ident("account_archive.id"),
+			id,
+		).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	return nil
+}
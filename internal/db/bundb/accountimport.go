@@ -0,0 +1,218 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/uptrace/bun"
+)
+
+func (a *accountDB) GetAccountImportByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.AccountImport, error) {
+	imp := new(gtsmodel.AccountImport)
+
+	if err := a.db.
+		NewSelect().
+		Model(imp).
+		Where("? = ?", bun.Ident("account_import.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.populateAccountImport(ctx, imp); err != nil {
+		return nil, err
+	}
+
+	return imp, nil
+}
+
+func (a *accountDB) GetAccountImports(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.AccountImport, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		imports = make([]*gtsmodel.AccountImport, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		Model(&imports).
+		Where("? = ?", bun.Ident("account_import.account_id"), accountID)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("account_import.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("account_import.id"),
+			minID,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("account_import.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("account_import.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(imports) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(imports)
+	}
+
+	for _, imp := range imports {
+		if err := a.populateAccountImport(ctx, imp); err != nil {
+			return nil, err
+		}
+	}
+
+	return imports, nil
+}
+
+func (a *accountDB) populateAccountImport(
+	ctx context.Context,
+	imp *gtsmodel.AccountImport,
+) error {
+	if gtscontext.Barebones(ctx) {
+		// No need to fully populate.
+		return nil
+	}
+
+	if imp.Account == nil {
+		// Not set, fetch from database.
+		var err error
+		imp.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			imp.AccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error populating account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (a *accountDB) PutAccountImport(
+	ctx context.Context,
+	imp *gtsmodel.AccountImport,
+) error {
+	_, err := a.db.
+		NewInsert().
+		Model(imp).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountDB) UpdateAccountImport(
+	ctx context.Context,
+	imp *gtsmodel.AccountImport,
+	columns ...string,
+) error {
+	imp.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(imp).
+		Column(columns...).
+		Where("? = ?", bun.Ident("account_import.id"), imp.ID).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountDB) DeleteAccountImports(
+	ctx context.Context,
+	accountID string,
+) error {
+	_, err := a.db.
+		NewDelete().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("account_imports"),
+			bun.Ident("account_import"),
+		).
+		Where(
+			"? = ?",
+			bun.Ident("account_import.account_id"),
+			accountID,
+		).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	return nil
+}
@@ -19,11 +19,13 @@
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"errors"
 	"fmt"
 	"net/mail"
+	"slices"
 	"strings"
 	"time"
 
@@ -82,6 +84,21 @@ func (a *adminDB) IsEmailAvailable(ctx context.Context, email string) (bool, err
 		return false, fmt.Errorf("email domain %s is blocked", domain)
 	}
 
+	// check if the canonical form of the email is blocked,
+	// eg., because it belonged to a suspended account
+	canonicalEmailBlockedQ := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("canonical_email_blocks"), bun.Ident("canonical_email_block")).
+		Column("canonical_email_block.id").
+		Where("? = ?", bun.Ident("canonical_email_block.hash"), util.CanonicalEmailHash(m.Address))
+	canonicalEmailBlocked, err := exists(ctx, canonicalEmailBlockedQ)
+	if err != nil {
+		return false, err
+	}
+	if canonicalEmailBlocked {
+		return false, nil
+	}
+
 	// check if this email is associated with a user already
 	q := a.db.
 		NewSelect().
@@ -120,6 +137,12 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 			return nil, err
 		}
 
+		edPubKey, edPrivKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			err := gtserror.Newf("error creating new ed25519 private key: %w", err)
+			return nil, err
+		}
+
 		account = &gtsmodel.Account{
 			ID:                    accountID,
 			Username:              newSignup.Username,
@@ -135,6 +158,9 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 			PrivateKey:            privKey,
 			PublicKey:             &privKey.PublicKey,
 			PublicKeyURI:          uris.PublicKeyURI,
+			Ed25519PrivateKey:     edPrivKey,
+			Ed25519PublicKey:      edPubKey,
+			Ed25519PublicKeyURI:   uris.Ed25519PublicKeyURI,
 		}
 
 		// Insert the new account!
@@ -147,6 +173,13 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 			AccountID: accountID,
 			Privacy:   gtsmodel.VisibilityDefault,
 		}
+
+		// Seed default interaction policies
+		// from the instance defaults, if set.
+		if err := a.seedInteractionPolicies(ctx, account.Settings); err != nil {
+			return nil, err
+		}
+
 		if err := a.state.DB.PutAccountSettings(ctx, account.Settings); err != nil {
 			return nil, err
 		}
@@ -205,6 +238,8 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 		UnconfirmedEmail:       newSignup.Email,
 		CreatedByApplicationID: newSignup.AppID,
 		ExternalID:             newSignup.ExternalID,
+		InviteID:               newSignup.InviteID,
+		TermsOfServiceID:       newSignup.TermsID,
 	}
 
 	if newSignup.EmailVerified {
@@ -217,6 +252,9 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 		// Make new user mod + admin.
 		user.Moderator = util.Ptr(true)
 		user.Admin = util.Ptr(true)
+	} else if newSignup.Moderator {
+		// Make new user mod only.
+		user.Moderator = util.Ptr(true)
 	}
 
 	if newSignup.PreApproved {
@@ -230,6 +268,22 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 		return nil, err
 	}
 
+	if len(newSignup.Answers) != 0 {
+		// Store answers to sign-up
+		// questions with the user.
+		for _, answer := range newSignup.Answers {
+			answer.UserID = user.ID
+		}
+
+		if _, err := a.db.
+			NewInsert().
+			Model(&newSignup.Answers).
+			Exec(ctx); err != nil {
+			err := gtserror.Newf("db error inserting sign-up answers: %w", err)
+			return nil, err
+		}
+	}
+
 	return user, nil
 }
 
@@ -258,6 +312,12 @@ func (a *adminDB) CreateInstanceAccount(ctx context.Context) error {
 		return err
 	}
 
+	edPubKey, edPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Errorf(ctx, "error creating new ed25519 key: %s", err)
+		return err
+	}
+
 	aID, err := id.NewRandomULID()
 	if err != nil {
 		return err
@@ -272,6 +332,9 @@ func (a *adminDB) CreateInstanceAccount(ctx context.Context) error {
 		PrivateKey:            key,
 		PublicKey:             &key.PublicKey,
 		PublicKeyURI:          newAccountURIs.PublicKeyURI,
+		Ed25519PrivateKey:     edPrivKey,
+		Ed25519PublicKey:      edPubKey,
+		Ed25519PublicKeyURI:   newAccountURIs.Ed25519PublicKeyURI,
 		ActorType:             ap.ActorPerson,
 		URI:                   newAccountURIs.UserURI,
 		InboxURI:              newAccountURIs.InboxURI,
@@ -403,6 +466,30 @@ func (a *adminDB) CreateInstanceApplication(ctx context.Context) error {
 	return a.state.DB.PutClient(ctx, oc)
 }
 
+// seedInteractionPolicies copies any default interaction
+// policies set on the local instance into the given settings.
+func (a *adminDB) seedInteractionPolicies(
+	ctx context.Context,
+	settings *gtsmodel.AccountSettings,
+) error {
+	instance, err := a.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	if instance == nil {
+		// Instance not created
+		// yet, nothing to seed.
+		return nil
+	}
+
+	settings.InteractionPolicyDirect = instance.InteractionPolicyDirect
+	settings.InteractionPolicyFollowersOnly = instance.InteractionPolicyFollowersOnly
+	settings.InteractionPolicyUnlocked = instance.InteractionPolicyUnlocked
+	settings.InteractionPolicyPublic = instance.InteractionPolicyPublic
+	return nil
+}
+
 func (a *adminDB) GetInstanceApplication(ctx context.Context) (*gtsmodel.Application, error) {
 	// Instance app clientID == instanceAcct.ID,
 	// so get the instance account first.
@@ -442,6 +529,22 @@ func (a *adminDB) CountUnhandledSignups(ctx context.Context) (int, error) {
 		Count(ctx)
 }
 
+func (a *adminDB) GetUnhandledSignups(ctx context.Context) ([]*gtsmodel.User, error) {
+	var userIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.id").
+		Where("? = ?", bun.Ident("user.approved"), false).
+		Order("user.created_at ASC").
+		Scan(ctx, &userIDs); err != nil {
+		return nil, err
+	}
+
+	return a.state.DB.GetUsersByIDs(ctx, userIDs)
+}
+
 /*
 	ACTION FUNCS
 */
@@ -452,6 +555,7 @@ func (a *adminDB) GetAdminAction(ctx context.Context, id string) (*gtsmodel.Admi
 	if err := a.db.
 		NewSelect().
 		Model(action).
+		Where("? = ?", bun.Ident("admin_action.id"), id).
 		Scan(ctx); err != nil {
 		return nil, err
 	}
@@ -472,6 +576,46 @@ func (a *adminDB) GetAdminActions(ctx context.Context) ([]*gtsmodel.AdminAction,
 	return actions, nil
 }
 
+func (a *adminDB) GetScheduledAdminActions(ctx context.Context, now time.Time) ([]*gtsmodel.AdminAction, error) {
+	actions := make([]*gtsmodel.AdminAction, 0)
+
+	if err := a.db.
+		NewSelect().
+		Model(&actions).
+		Where("? <= ?", bun.Ident("admin_action.scheduled_at"), now).
+		Where("? IS NULL", bun.Ident("admin_action.completed_at")).
+		Order("admin_action.scheduled_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+func (a *adminDB) GetExpiredAdminActions(ctx context.Context, now time.Time) ([]*gtsmodel.AdminAction, error) {
+	actions := make([]*gtsmodel.AdminAction, 0)
+
+	if err := a.db.
+		NewSelect().
+		Model(&actions).
+		Where("? <= ?", bun.Ident("admin_action.expires_at"), now).
+		Where("? IS NOT NULL", bun.Ident("admin_action.completed_at")).
+		Where("? IS NULL", bun.Ident("admin_action.lifted_at")).
+		Order("admin_action.expires_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Actions that completed with errors may
+	// not have fully taken effect, so leave
+	// those for admins to lift by hand.
+	actions = slices.DeleteFunc(actions, func(action *gtsmodel.AdminAction) bool {
+		return len(action.Errors) != 0
+	})
+
+	return actions, nil
+}
+
 func (a *adminDB) PutAdminAction(ctx context.Context, action *gtsmodel.AdminAction) error {
 	_, err := a.db.
 		NewInsert().
@@ -481,6 +625,21 @@ func (a *adminDB) PutAdminAction(ctx context.Context, action *gtsmodel.AdminActi
 	return err
 }
 
+func (a *adminDB) GetAdminActionsByTargetID(ctx context.Context, targetID string) ([]*gtsmodel.AdminAction, error) {
+	actions := make([]*gtsmodel.AdminAction, 0)
+
+	if err := a.db.
+		NewSelect().
+		Model(&actions).
+		Where("? = ?", bun.Ident("admin_action.target_id"), targetID).
+		OrderExpr("? DESC", bun.Ident("admin_action.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
 func (a *adminDB) UpdateAdminAction(ctx context.Context, action *gtsmodel.AdminAction, columns ...string) error {
 	// Update the action's last-updated
 	action.UpdatedAt = time.Now()
@@ -502,7 +661,7 @@ func (a *adminDB) DeleteAdminAction(ctx context.Context, id string) error {
 	_, err := a.db.
 		NewDelete().
 		TableExpr("? AS ?", bun.Ident("admin_actions"), bun.Ident("admin_action")).
-		Where("? = ?", bun.Ident("admin_action"), id).
+		Where("? = ?", bun.Ident("admin_action.id"), id).
 		Exec(ctx)
 
 	return err
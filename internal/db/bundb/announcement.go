@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	db *bun.DB
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error) {
+	announcement := new(gtsmodel.Announcement)
+	if err := a.db.NewSelect().
+		Model(announcement).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.PopulateAnnouncement(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	return announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	return a.getAnnouncements(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Order("id DESC")
+	})
+}
+
+func (a *announcementDB) GetVisibleAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	return a.getAnnouncements(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("published"), true).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("? IS NULL", bun.Ident("ends_at")).
+					WhereOr("? > ?", bun.Ident("ends_at"), time.Now())
+			}).
+			Order("id ASC")
+	})
+}
+
+func (a *announcementDB) getAnnouncements(
+	ctx context.Context,
+	query func(*bun.SelectQuery) *bun.SelectQuery,
+) ([]*gtsmodel.Announcement, error) {
+	var announcements []*gtsmodel.Announcement
+	if err := query(a.db.NewSelect().
+		Model(&announcements)).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, announcement := range announcements {
+		if err := a.PopulateAnnouncement(ctx, announcement); err != nil {
+			return nil, err
+		}
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) PopulateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	if len(announcement.EmojiIDs) == 0 || len(announcement.Emojis) != 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	emojis := make([]*gtsmodel.Emoji, 0, len(announcement.EmojiIDs))
+	if err := a.db.NewSelect().
+		Model(&emojis).
+		Where("? IN (?)", bun.Ident("id"), bun.In(announcement.EmojiIDs)).
+		Scan(ctx); err != nil {
+		return gtserror.Newf("error populating announcement emojis: %w", err)
+	}
+
+	announcement.Emojis = emojis
+	return nil
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	_, err := a.db.NewInsert().
+		Model(announcement).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error {
+	announcement.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.NewUpdate().
+		Model(announcement).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), announcement.ID).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete reads of and reactions to the
+		// announcement along with the announcement.
+		for _, table := range []string{
+			"announcement_reads",
+			"announcement_reactions",
+		} {
+			if _, err := tx.NewDelete().
+				Table(table).
+				Where("? = ?", bun.Ident("announcement_id"), id).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.NewDelete().
+			Table("announcements").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (a *announcementDB) IsAnnouncementRead(ctx context.Context, announcementID string, accountID string) (bool, error) {
+	q := a.db.NewSelect().
+		Table("announcement_reads").
+		Column("id").
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("account_id"), accountID)
+	return exists(ctx, q)
+}
+
+func (a *announcementDB) PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error {
+	_, err := a.db.NewInsert().
+		Model(read).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) GetAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) (*gtsmodel.AnnouncementReaction, error) {
+	reaction := new(gtsmodel.AnnouncementReaction)
+	if err := a.db.NewSelect().
+		Model(reaction).
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? = ?", bun.Ident("name"), name).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return reaction, nil
+}
+
+func (a *announcementDB) GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error) {
+	var reactions []*gtsmodel.AnnouncementReaction
+	err := a.db.NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Order("id ASC").
+		Scan(ctx)
+	return reactions, err
+}
+
+func (a *announcementDB) PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	_, err := a.db.NewInsert().
+		Model(reaction).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReactionByID(ctx context.Context, id string) error {
+	_, err := a.db.NewDelete().
+		Table("announcement_reactions").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReadsAndReactions(ctx context.Context, accountID string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, table := range []string{
+			"announcement_reads",
+			"announcement_reactions",
+		} {
+			if _, err := tx.NewDelete().
+				Table(table).
+				Where("? = ?", bun.Ident("account_id"), accountID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,181 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementGetPut() {
+	t := suite.T()
+
+	// Create an example published announcement, an
+	// unpublished one, and one that's already ended.
+	published := &gtsmodel.Announcement{
+		ID:                 "01JKE4D6T6X3CFW2TMM4Q7Y8VB",
+		Text:               "Maintenance on Sunday :rainbow:",
+		Content:            "<p>Maintenance on Sunday :rainbow:</p>",
+		EmojiIDs:           []string{suite.testEmojis["rainbow"].ID},
+		Published:          util.Ptr(true),
+		PublishedAt:        time.Now(),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	draft := &gtsmodel.Announcement{
+		ID:                 "01JKE4DNSZ8N9Z3DM0F5ZJ3G4R",
+		Text:               "Maintenance on Monday",
+		Content:            "<p>Maintenance on Monday</p>",
+		Published:          util.Ptr(false),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	ended := &gtsmodel.Announcement{
+		ID:                 "01JKE4E3KBQ6NJ0R5WZ9H6C1XP",
+		Text:               "Maintenance last week",
+		Content:            "<p>Maintenance last week</p>",
+		EndsAt:             time.Now().Add(-24 * time.Hour),
+		Published:          util.Ptr(true),
+		PublishedAt:        time.Now().Add(-48 * time.Hour),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	for _, announcement := range []*gtsmodel.Announcement{published, draft, ended} {
+		if err := suite.db.PutAnnouncement(ctx, announcement); err != nil {
+			t.Fatalf("error inserting announcement: %v", err)
+		}
+	}
+
+	// Fetch all announcements, newest should be first.
+	all, err := suite.db.GetAnnouncements(ctx)
+	if err != nil {
+		t.Fatalf("error fetching announcements: %v", err)
+	}
+	suite.Len(all, 3)
+	suite.Equal(ended.ID, all[0].ID)
+	suite.Equal(published.ID, all[2].ID)
+
+	// Only the published announcement should be visible.
+	visible, err := suite.db.GetVisibleAnnouncements(ctx)
+	if err != nil {
+		t.Fatalf("error fetching visible announcements: %v", err)
+	}
+	suite.Len(visible, 1)
+	suite.Equal(published.ID, visible[0].ID)
+	suite.Len(visible[0].Emojis, 1)
+	suite.Equal("rainbow", visible[0].Emojis[0].Shortcode)
+
+	// Publish the draft.
+	draft.Published = util.Ptr(true)
+	if err := suite.db.UpdateAnnouncement(ctx, draft, "published"); err != nil {
+		t.Fatalf("error updating announcement: %v", err)
+	}
+
+	visible, err = suite.db.GetVisibleAnnouncements(ctx)
+	if err != nil {
+		t.Fatalf("error fetching visible announcements: %v", err)
+	}
+	suite.Len(visible, 2)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementReadsAndReactions() {
+	t := suite.T()
+
+	var (
+		announcementID = "01JKE4D6T6X3CFW2TMM4Q7Y8VB"
+		account        = suite.testAccounts["local_account_1"]
+	)
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	if err := suite.db.PutAnnouncement(ctx, &gtsmodel.Announcement{
+		ID:                 announcementID,
+		Text:               "Maintenance on Sunday",
+		Content:            "<p>Maintenance on Sunday</p>",
+		Published:          util.Ptr(true),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		t.Fatalf("error inserting announcement: %v", err)
+	}
+
+	if err := suite.db.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+		ID:             "01JKE4F1B7M2Y5Q8T0W3Z6C9DF",
+		AnnouncementID: announcementID,
+		AccountID:      account.ID,
+	}); err != nil {
+		t.Fatalf("error inserting announcement read: %v", err)
+	}
+
+	if err := suite.db.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             "01JKE4F9N3R6V9X2A5D8G1J4MQ",
+		AnnouncementID: announcementID,
+		AccountID:      account.ID,
+		Name:           "🐢",
+	}); err != nil {
+		t.Fatalf("error inserting announcement reaction: %v", err)
+	}
+
+	read, err := suite.db.IsAnnouncementRead(ctx, announcementID, account.ID)
+	if err != nil {
+		t.Fatalf("error checking announcement read: %v", err)
+	}
+	suite.True(read)
+
+	reaction, err := suite.db.GetAnnouncementReaction(ctx, announcementID, account.ID, "🐢")
+	if err != nil {
+		t.Fatalf("error fetching announcement reaction: %v", err)
+	}
+	suite.False(reaction.IsCustom())
+
+	// Clear the account's reads and reactions,
+	// as is done when the account is deleted.
+	if err := suite.db.DeleteAnnouncementReadsAndReactions(ctx, account.ID); err != nil {
+		t.Fatalf("error deleting announcement reads and reactions: %v", err)
+	}
+
+	read, err = suite.db.IsAnnouncementRead(ctx, announcementID, account.ID)
+	if err != nil {
+		t.Fatalf("error checking announcement read: %v", err)
+	}
+	suite.False(read)
+
+	_, err = suite.db.GetAnnouncementReaction(ctx, announcementID, account.ID, "🐢")
+	if err != db.ErrNoEntries {
+		t.Fatalf("deleted announcement reaction returned unexpected error: %v", err)
+	}
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
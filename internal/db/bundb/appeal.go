@@ -0,0 +1,244 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type appealDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *appealDB) GetAppealByID(ctx context.Context, id string) (*gtsmodel.Appeal, error) {
+	return a.getAppeal(ctx, "id", id)
+}
+
+func (a *appealDB) GetAppealByAdminActionID(ctx context.Context, adminActionID string) (*gtsmodel.Appeal, error) {
+	return a.getAppeal(ctx, "admin_action_id", adminActionID)
+}
+
+func (a *appealDB) getAppeal(ctx context.Context, column string, value string) (*gtsmodel.Appeal, error) {
+	appeal := new(gtsmodel.Appeal)
+
+	if err := a.db.
+		NewSelect().
+		Model(appeal).
+		Where("? = ?", bun.Ident("appeal."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return appeal, nil
+	}
+
+	if err := a.PopulateAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	return appeal, nil
+}
+
+func (a *appealDB) GetAppeals(
+	ctx context.Context,
+	accountID string,
+	state gtsmodel.AppealState,
+	page *paging.Page,
+) ([]*gtsmodel.Appeal, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		appeals = make([]*gtsmodel.Appeal, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		Model(&appeals)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("appeal.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("appeal.id"),
+			minID,
+		)
+	}
+
+	// Return only items
+	// by given account.
+	if accountID != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("appeal.account_id"),
+			accountID,
+		)
+	}
+
+	// Return only items
+	// in given state.
+	if state != 0 {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("appeal.state"),
+			state,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("appeal.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("appeal.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(appeals) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(appeals)
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return appeals, nil
+	}
+
+	for _, appeal := range appeals {
+		if err := a.PopulateAppeal(ctx, appeal); err != nil {
+			return nil, err
+		}
+	}
+
+	return appeals, nil
+}
+
+func (a *appealDB) PopulateAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(3)
+	)
+
+	if appeal.Account == nil {
+		// Appeal account is not set, fetch from the database.
+		appeal.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			appeal.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating appeal account: %w", err)
+		}
+	}
+
+	if appeal.AdminAction == nil {
+		// Appealed action is not set, fetch from the database.
+		appeal.AdminAction, err = a.state.DB.GetAdminAction(
+			ctx,
+			appeal.AdminActionID,
+		)
+		if err != nil {
+			errs.Appendf("error populating appeal admin action: %w", err)
+		}
+	}
+
+	if appeal.ReviewedByAccountID != "" &&
+		appeal.ReviewedByAccount == nil {
+		// Appeal reviewer is not set, fetch from the database.
+		appeal.ReviewedByAccount, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			appeal.ReviewedByAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating appeal reviewed by account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *appealDB) PutAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error {
+	_, err := a.db.
+		NewInsert().
+		Model(appeal).
+		Exec(ctx)
+	return err
+}
+
+func (a *appealDB) UpdateAppeal(ctx context.Context, appeal *gtsmodel.Appeal, columns ...string) error {
+	// Update the appeal's last-updated
+	appeal.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(appeal).
+		Where("? = ?", bun.Ident("appeal.id"), appeal.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,206 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type auditLogDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *auditLogDB) GetAuditLogEntryByID(ctx context.Context, id string) (*gtsmodel.AuditLogEntry, error) {
+	entry := new(gtsmodel.AuditLogEntry)
+
+	if err := a.db.
+		NewSelect().
+		Model(entry).
+		Where("? = ?", bun.Ident("audit_log_entry.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return entry, nil
+	}
+
+	if err := a.PopulateAuditLogEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (a *auditLogDB) GetAuditLogEntries(
+	ctx context.Context,
+	accountID string,
+	targetType string,
+	targetID string,
+	page *paging.Page,
+) ([]*gtsmodel.AuditLogEntry, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		entries = make([]*gtsmodel.AuditLogEntry, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		Model(&entries)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("audit_log_entry.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("audit_log_entry.id"),
+			minID,
+		)
+	}
+
+	// Return only items for
+	// actions by given account.
+	if accountID != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("audit_log_entry.account_id"),
+			accountID,
+		)
+	}
+
+	// Return only items for
+	// given type of target.
+	if targetType != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("audit_log_entry.target_type"),
+			targetType,
+		)
+	}
+
+	// Return only items
+	// for given target.
+	if targetID != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("audit_log_entry.target_id"),
+			targetID,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("audit_log_entry.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("audit_log_entry.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(entries)
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return entries, nil
+	}
+
+	for _, entry := range entries {
+		if err := a.PopulateAuditLogEntry(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (a *auditLogDB) PopulateAuditLogEntry(ctx context.Context, entry *gtsmodel.AuditLogEntry) error {
+	if entry.Account != nil {
+		// Already populated.
+		return nil
+	}
+
+	// Admin account is not set, fetch from the database.
+	account, err := a.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		entry.AccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating audit log entry account: %w", err)
+	}
+	entry.Account = account
+
+	return nil
+}
+
+func (a *auditLogDB) PutAuditLogEntry(ctx context.Context, entry *gtsmodel.AuditLogEntry) error {
+	_, err := a.db.
+		NewInsert().
+		Model(entry).
+		Exec(ctx)
+	return err
+}
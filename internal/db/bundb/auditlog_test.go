@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type AuditLogTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AuditLogTestSuite) TestPutGetAuditLogEntries() {
+	ctx := context.Background()
+
+	var (
+		admin     = suite.testAccounts["admin_account"]
+		moderator = suite.testAccounts["local_account_1"]
+	)
+
+	// Give each entry a creation time a second apart, since
+	// ULIDs created within the same millisecond don't sort
+	// in the order they were created.
+	createdAt := time.Now().Add(-time.Minute)
+
+	for _, entry := range []*gtsmodel.AuditLogEntry{
+		{
+			AccountID:  admin.ID,
+			Action:     gtsmodel.AuditActionCreate,
+			TargetType: gtsmodel.AuditTargetDomainBlock,
+			TargetID:   "example.org",
+			Text:       "Spam instance.",
+		},
+		{
+			AccountID:  admin.ID,
+			Action:     gtsmodel.AuditActionDelete,
+			TargetType: gtsmodel.AuditTargetDomainBlock,
+			TargetID:   "example.org",
+		},
+		{
+			AccountID:  moderator.ID,
+			Action:     gtsmodel.AuditActionResolve,
+			TargetType: gtsmodel.AuditTargetReport,
+			TargetID:   suite.testReports["local_account_2_report_remote_account_1"].ID,
+		},
+	} {
+		createdAt = createdAt.Add(time.Second)
+		entryID, err := id.NewULIDFromTime(createdAt)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		entry.ID = entryID
+		entry.CreatedAt = createdAt
+		if err := suite.state.DB.PutAuditLogEntry(ctx, entry); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Get all entries, newest first.
+	entries, err := suite.state.DB.GetAuditLogEntries(ctx, "", "", "", &paging.Page{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(entries, 3)
+	suite.Equal(gtsmodel.AuditActionResolve, entries[0].Action)
+	suite.Equal(moderator.ID, entries[0].Account.ID)
+
+	// Get entries for one admin.
+	entries, err = suite.state.DB.GetAuditLogEntries(ctx, admin.ID, "", "", &paging.Page{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(entries, 2)
+
+	// Get entries for one target.
+	entries, err = suite.state.DB.GetAuditLogEntries(ctx, "", gtsmodel.AuditTargetDomainBlock, "example.org", &paging.Page{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(entries, 2)
+
+	// Get a single entry.
+	entry, err := suite.state.DB.GetAuditLogEntryByID(ctx, entries[1].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AuditActionCreate, entry.Action)
+	suite.Equal("Spam instance.", entry.Text)
+	suite.Equal(admin.ID, entry.Account.ID)
+
+	// No entries for other targets.
+	_, err = suite.state.DB.GetAuditLogEntries(ctx, "", gtsmodel.AuditTargetIPBlock, "", &paging.Page{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestAuditLogTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogTestSuite))
+}
@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type automodDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *automodDB) AutomodMatch(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.AutomodRule, error) {
+	return a.state.Caches.AutomodRules.Match(status, func() ([]*gtsmodel.AutomodRule, error) {
+		return a.GetAutomodRules(ctx)
+	})
+}
+
+func (a *automodDB) GetAutomodRule(ctx context.Context, id string) (*gtsmodel.AutomodRule, error) {
+	rule := new(gtsmodel.AutomodRule)
+	if err := a.db.NewSelect().
+		Model(rule).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (a *automodDB) GetAutomodRules(ctx context.Context) ([]*gtsmodel.AutomodRule, error) {
+	var rules []*gtsmodel.AutomodRule
+	err := a.db.NewSelect().
+		Model(&rules).
+		Order("id DESC").
+		Scan(ctx)
+	return rules, err
+}
+
+func (a *automodDB) PutAutomodRule(ctx context.Context, rule *gtsmodel.AutomodRule) error {
+	if _, err := a.db.NewInsert().
+		Model(rule).
+		Exec(ctx); err != nil {
+		return err
+	}
+	a.state.Caches.AutomodRules.Clear()
+	return nil
+}
+
+func (a *automodDB) DeleteAutomodRule(ctx context.Context, id string) error {
+	if _, err := a.db.NewDelete().
+		Table("automod_rules").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return err
+	}
+	a.state.Caches.AutomodRules.Clear()
+	return nil
+}
@@ -22,23 +22,30 @@
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql"
+	"database/sql/driver"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"math"
+	"net"
 	"net/url"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"codeberg.org/gruf/go-bytesize"
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	"github.com/superseriousbusiness/gotosocial/internal/db/mysql"
+	"github.com/superseriousbusiness/gotosocial/internal/db/postgres"
+	"github.com/superseriousbusiness/gotosocial/internal/db/sqlite"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/metrics"
@@ -57,36 +64,57 @@ type DBService struct {
 	db.Account
 	db.Admin
 	db.AdvancedMigration
+	db.Announcement
+	db.Appeal
 	db.Application
+	db.AuditLog
+	db.Automod
 	db.Basic
+	db.CanonicalEmailBlock
+	db.Circle
 	db.Conversation
+	db.DeadLetter
 	db.Domain
 	db.Emoji
 	db.HeaderFilter
 	db.Instance
 	db.Interaction
+	db.Invite
+	db.IPBlock
 	db.Filter
+	db.Lease
 	db.List
 	db.Marker
+	db.Measure
 	db.Media
 	db.Mention
 	db.Move
 	db.Notification
+	db.NotificationRequest
 	db.Poll
 	db.Relationship
+	db.Relay
 	db.Report
+	db.Role
 	db.Rule
 	db.Search
 	db.Session
+	db.SeveredRelationship
+	db.SignupQuestion
 	db.SinBinStatus
+	db.SpamFlag
 	db.Status
 	db.StatusBookmark
 	db.StatusFave
+	db.StatusReaction
 	db.Tag
+	db.TermsOfService
 	db.Thread
 	db.Timeline
+	db.Trend
 	db.User
 	db.Tombstone
+	db.Webhook
 	db.WorkerTask
 	db *bun.DB
 }
@@ -97,6 +125,37 @@ func (dbService *DBService) DB() *bun.DB {
 	return dbService.db
 }
 
+// lockMigrations acquires the migrations lock, waiting
+// for any other node currently running migrations.
+func lockMigrations(ctx context.Context, migrator *migrate.Migrator) error {
+	const (
+		retry   = 5 * time.Second
+		timeout = 30 * time.Minute
+	)
+
+	start := time.Now()
+	for {
+		err := migrator.Lock(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if time.Since(start) > timeout {
+			return fmt.Errorf("timed out waiting for migrations lock, if no other "+
+				"node is running migrations this lock may be stale, and can be "+
+				"removed from the bun_migration_locks table: %w", err)
+		}
+
+		log.Infof(ctx, "waiting for another node to finish running migrations")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retry):
+		}
+	}
+}
+
 func doMigration(ctx context.Context, db *bun.DB) error {
 	migrator := migrate.NewMigrator(db, migrations.Migrations)
 
@@ -104,6 +163,19 @@ func doMigration(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if config.GetClusterEnabled() {
+		// Other nodes in the cluster may be starting
+		// at the same time, ensure only one migrates.
+		if err := lockMigrations(ctx, migrator); err != nil {
+			return err
+		}
+		defer func() {
+			if err := migrator.Unlock(ctx); err != nil {
+				log.Errorf(ctx, "error unlocking migrations: %v", err)
+			}
+		}()
+	}
+
 	group, err := migrator.Migrate(ctx)
 	if err != nil && !strings.Contains(err.Error(), "no migrations") {
 		return err
@@ -134,6 +206,7 @@ func doMigration(ctx context.Context, db *bun.DB) error {
 func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 	var sqldb *sql.DB
 	var dialect func() schema.Dialect
+	var retries func() uint64
 	var err error
 
 	switch t := strings.ToLower(config.GetDbType()); t {
@@ -147,6 +220,15 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		// Only sqlite re-attempts
+		// queries when it's busy.
+		retries = sqlite.BusyRetries
+	case "mysql":
+		sqldb, dialect, err = mysqlConn(ctx)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("database type %s not supported for bundb", t)
 	}
@@ -161,10 +243,26 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		return nil, fmt.Errorf("db migration error: %s", err)
 	}
 
+	// Only route queries to any postgres read-only
+	// replicas once migrations are done with, as they
+	// need to be able to read back their own writes.
+	if len(config.GetDbPostgresReplicas()) > 0 {
+		_ = sqldb.Close()
+		sqldb, err = pgReplicaConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Wrap sql.DB as bun.DB type,
 	// adding any connection hooks.
 	db := bunDB(sqldb, dialect)
 
+	// Register connection pool metrics.
+	if err := metrics.InstrumentDB(sqldb, retries); err != nil {
+		return nil, fmt.Errorf("error instrumenting database: %w", err)
+	}
+
 	ps := &DBService{
 		Account: &accountDB{
 			db:    db,
@@ -178,17 +276,43 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			db: db,
+		},
+		Appeal: &appealDB{
+			db:    db,
+			state: state,
+		},
 		Application: &applicationDB{
 			db:    db,
 			state: state,
 		},
+		AuditLog: &auditLogDB{
+			db:    db,
+			state: state,
+		},
+		Automod: &automodDB{
+			db:    db,
+			state: state,
+		},
 		Basic: &basicDB{
 			db: db,
 		},
+		CanonicalEmailBlock: &canonicalEmailBlockDB{
+			db: db,
+		},
+		Circle: &circleDB{
+			db:    db,
+			state: state,
+		},
 		Conversation: &conversationDB{
 			db:    db,
 			state: state,
 		},
+		DeadLetter: &deadLetterDB{
+			db:    db,
+			state: state,
+		},
 		Domain: &domainDB{
 			db:    db,
 			state: state,
@@ -209,10 +333,20 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Invite: &inviteDB{
+			db: db,
+		},
+		IPBlock: &ipBlockDB{
+			db:    db,
+			state: state,
+		},
 		Filter: &filterDB{
 			db:    db,
 			state: state,
 		},
+		Lease: &leaseDB{
+			db: db,
+		},
 		List: &listDB{
 			db:    db,
 			state: state,
@@ -221,6 +355,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Measure: &measureDB{
+			db:    db,
+			state: state,
+		},
 		Media: &mediaDB{
 			db:    db,
 			state: state,
@@ -237,6 +375,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		NotificationRequest: &notificationRequestDB{
+			db:    db,
+			state: state,
+		},
 		Poll: &pollDB{
 			db:    db,
 			state: state,
@@ -245,10 +387,18 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Relay: &relayDB{
+			db:    db,
+			state: state,
+		},
 		Report: &reportDB{
 			db:    db,
 			state: state,
 		},
+		Role: &roleDB{
+			db:    db,
+			state: state,
+		},
 		Rule: &ruleDB{
 			db:    db,
 			state: state,
@@ -260,10 +410,21 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		Session: &sessionDB{
 			db: db,
 		},
+		SeveredRelationship: &severedRelationshipDB{
+			db:    db,
+			state: state,
+		},
+		SignupQuestion: &signupQuestionDB{
+			db: db,
+		},
 		SinBinStatus: &sinBinStatusDB{
 			db:    db,
 			state: state,
 		},
+		SpamFlag: &spamFlagDB{
+			db:    db,
+			state: state,
+		},
 		Status: &statusDB{
 			db:    db,
 			state: state,
@@ -276,10 +437,17 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusReaction: &statusReactionDB{
+			db:    db,
+			state: state,
+		},
 		Tag: &tagDB{
 			db:    db,
 			state: state,
 		},
+		TermsOfService: &termsOfServiceDB{
+			db: db,
+		},
 		Thread: &threadDB{
 			db:    db,
 			state: state,
@@ -288,6 +456,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Trend: &trendDB{
+			db:    db,
+			state: state,
+		},
 		User: &userDB{
 			db:    db,
 			state: state,
@@ -296,6 +468,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Webhook: &webhookDB{
+			db: db,
+		},
 		WorkerTask: &workerTaskDB{
 			db: db,
 		},
@@ -365,6 +540,117 @@ func pgConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 	return sqldb, func() schema.Dialect { return pgdialect.New() }, nil
 }
 
+// NewPostgresBus returns a new pubsub.Bus{} using LISTEN / NOTIFY
+// on the configured postgres database, over the named channel.
+func NewPostgresBus(channel string) (*postgres.Bus, error) {
+	opts, err := deriveBunDBPGOptions()
+	if err != nil {
+		return nil, fmt.Errorf("could not create bundb postgres options: %w", err)
+	}
+
+	return postgres.NewBus(opts, "gotosocial_"+channel), nil
+}
+
+// pgReplicaConn returns a postgres connection pool routing
+// read-only queries to configured read-only replicas.
+func pgReplicaConn(ctx context.Context) (*sql.DB, error) {
+	opts, err := deriveBunDBPGOptions() //nolint:contextcheck
+	if err != nil {
+		return nil, fmt.Errorf("could not create bundb postgres options: %w", err)
+	}
+
+	primary, err := (&postgres.Driver{}).OpenConnector(stdlib.RegisterConnConfig(opts))
+	if err != nil {
+		return nil, fmt.Errorf("could not open postgres db: %w", err)
+	}
+
+	replicas := make(map[string]driver.Connector)
+	for _, url := range config.GetDbPostgresReplicas() {
+		ropts, err := pgx.ParseConfig(url)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse postgres replica connection string: %w", err)
+		}
+
+		if _, ok := ropts.RuntimeParams["application_name"]; !ok {
+			ropts.RuntimeParams["application_name"] = config.GetApplicationName()
+		}
+
+		connector, err := (&postgres.Driver{}).OpenConnector(stdlib.RegisterConnConfig(ropts))
+		if err != nil {
+			return nil, fmt.Errorf("could not open postgres replica %s: %w", ropts.Host, err)
+		}
+
+		// Replicas are keyed by host(:port) for logging.
+		replicas[fmt.Sprintf("%s:%d", ropts.Host, ropts.Port)] = connector
+	}
+
+	sqldb := sql.OpenDB(postgres.NewReplicaConnector(
+		primary,
+		replicas,
+		config.GetDbPostgresReplicaMaxLag(),
+	))
+
+	// Tune db connections as for the primary.
+	sqldb.SetMaxOpenConns(maxOpenConns())
+	sqldb.SetMaxIdleConns(2)
+	sqldb.SetConnMaxLifetime(5 * time.Minute)
+
+	// ping to check the db is there and listening
+	if err := sqldb.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("postgres ping: %w", err)
+	}
+
+	log.Infof(ctx, "routing read-only queries to %d POSTGRES replicas", len(replicas))
+	return sqldb, nil
+}
+
+func mysqlConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
+	cfg, err := deriveBunDBMySQLOptions() //nolint:contextcheck
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create bundb mysql options: %w", err)
+	}
+
+	sqldb, err := sql.Open("mysql-gts", cfg.FormatDSN())
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open mysql db: %w", err)
+	}
+
+	// Tune db connections for mysql, see:
+	// - https://bun.uptrace.dev/guide/running-bun-in-production.html#database-sql
+	// - https://www.alexedwards.net/blog/configuring-sqldb
+	sqldb.SetMaxOpenConns(maxOpenConns())     // x number of conns per CPU
+	sqldb.SetMaxIdleConns(2)                  // assume default 2; if max idle is less than max open, it will be automatically adjusted
+	sqldb.SetConnMaxLifetime(5 * time.Minute) // fine to kill old connections
+
+	// ping to check the db is there and listening
+	if err := sqldb.PingContext(ctx); err != nil {
+		return nil, nil, fmt.Errorf("mysql ping: %w", err)
+	}
+
+	// We rely on DELETE ... RETURNING and long
+	// unique keys, both of which MySQL itself lacks.
+	var version string
+	if err := sqldb.QueryRowContext(ctx, "SELECT VERSION()").Scan(&version); err != nil {
+		return nil, nil, fmt.Errorf("mysql version: %w", err)
+	} else if !strings.Contains(version, "MariaDB") {
+		return nil, nil, fmt.Errorf("mysql version %s not supported, MariaDB 10.6 or later is required", version)
+	}
+
+	// Without a binary collation string comparisons
+	// are case insensitive, and unique keys would
+	// clash for eg. URIs differing only in case.
+	var collation string
+	if err := sqldb.QueryRowContext(ctx, "SELECT @@collation_database").Scan(&collation); err != nil {
+		return nil, nil, fmt.Errorf("mysql collation: %w", err)
+	} else if collation != "utf8mb4_bin" {
+		log.Warnf(ctx, "mysql database %s has collation %s; it should be created with utf8mb4_bin collation, "+
+			"see the database configuration documentation", cfg.DBName, collation)
+	}
+
+	log.Infof(ctx, "connected to MYSQL database (%s)", version)
+	return sqldb, func() schema.Dialect { return mysql.NewDialect() }, nil
+}
+
 func sqliteConn(ctx context.Context) (*sql.DB, func() schema.Dialect, error) {
 	// validate db address has actually been set
 	address := config.GetDbAddress()
@@ -448,6 +734,40 @@ func deriveBunDBPGOptions() (*pgx.ConnConfig, error) {
 		return nil, errors.New("no database set")
 	}
 
+	tlsConfig, err := deriveTLSConfig(address)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _ := pgx.ParseConfig("")
+	if address != "" {
+		cfg.Host = address
+	}
+	if port := config.GetDbPort(); port > 0 {
+		if port > math.MaxUint16 {
+			return nil, errors.New("invalid port, must be in range 1-65535")
+		}
+		cfg.Port = uint16(port) // #nosec G115 -- Just validated above.
+	}
+	if u := config.GetDbUser(); u != "" {
+		cfg.User = u
+	}
+	if p := config.GetDbPassword(); p != "" {
+		cfg.Password = p
+	}
+	if tlsConfig != nil {
+		cfg.TLSConfig = tlsConfig
+	}
+	cfg.Database = database
+	cfg.RuntimeParams["application_name"] = config.GetApplicationName()
+
+	return cfg, nil
+}
+
+// deriveTLSConfig returns a TLS configuration for connecting to
+// a database at address according to the configured TLS mode and
+// CA certificate, or nil if TLS should not be used.
+func deriveTLSConfig(address string) (*tls.Config, error) {
 	var tlsConfig *tls.Config
 	switch config.GetDbTLSMode() {
 	case "", "disable":
@@ -497,27 +817,77 @@ func deriveBunDBPGOptions() (*pgx.ConnConfig, error) {
 		tlsConfig.RootCAs = certPool
 	}
 
-	cfg, _ := pgx.ParseConfig("")
-	if address != "" {
-		cfg.Host = address
-	}
-	if port := config.GetDbPort(); port > 0 {
-		if port > math.MaxUint16 {
-			return nil, errors.New("invalid port, must be in range 1-65535")
-		}
-		cfg.Port = uint16(port) // #nosec G115 -- Just validated above.
+	return tlsConfig, nil
+}
+
+// deriveBunDBMySQLOptions takes an application config and returns either a ready-to-use set of options
+// with sensible defaults, or an error if it's not satisfied by the provided config.
+func deriveBunDBMySQLOptions() (*mysqldriver.Config, error) {
+	// these are all optional, the driver figures out defaults
+	address := config.GetDbAddress()
+
+	// validate database
+	database := config.GetDbDatabase()
+	if database == "" {
+		return nil, errors.New("no database set")
 	}
-	if u := config.GetDbUser(); u != "" {
-		cfg.User = u
+
+	tlsConfig, err := deriveTLSConfig(address)
+	if err != nil {
+		return nil, err
 	}
-	if p := config.GetDbPassword(); p != "" {
-		cfg.Password = p
+
+	cfg := mysqldriver.NewConfig()
+	if strings.HasPrefix(address, "/") {
+		// Connect via unix socket.
+		cfg.Net = "unix"
+		cfg.Addr = address
+	} else {
+		cfg.Net = "tcp"
+		cfg.Addr = address
+		if cfg.Addr == "" {
+			cfg.Addr = "localhost"
+		}
+		if port := config.GetDbPort(); port > 0 {
+			if port > math.MaxUint16 {
+				return nil, errors.New("invalid port, must be in range 1-65535")
+			}
+			cfg.Addr = net.JoinHostPort(cfg.Addr, strconv.Itoa(port))
+		}
 	}
+	cfg.User = config.GetDbUser()
+	cfg.Passwd = config.GetDbPassword()
+	cfg.DBName = database
 	if tlsConfig != nil {
-		cfg.TLSConfig = tlsConfig
+		// Register TLS config under a
+		// name for inclusion in the DSN.
+		const name = "gotosocial"
+		if err := mysqldriver.RegisterTLSConfig(name, tlsConfig); err != nil {
+			return nil, err
+		}
+		cfg.TLSConfig = name
 	}
-	cfg.Database = database
-	cfg.RuntimeParams["application_name"] = config.GetApplicationName()
+
+	// Store times in UTC, and have
+	// them parsed back as time.Time{}.
+	cfg.Loc = time.UTC
+	cfg.ParseTime = true
+
+	// Use 4-byte UTF-8 with
+	// binary string comparisons.
+	cfg.Collation = "utf8mb4_bin"
+
+	cfg.Params = map[string]string{
+		// Ensure NOW() etc return UTC.
+		"time_zone": "'+00:00'",
+
+		// Error instead of silently truncating
+		// data, and support || for concatenation
+		// as we use that in queries for SQLite.
+		"sql_mode": "'STRICT_ALL_TABLES,PIPES_AS_CONCAT,NO_ENGINE_SUBSTITUTION'",
+	}
+
+	cfg.ConnectionAttributes = "program_name:" + config.GetApplicationName()
 
 	return cfg, nil
 }
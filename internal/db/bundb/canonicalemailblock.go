@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type canonicalEmailBlockDB struct {
+	db *bun.DB
+}
+
+func (c *canonicalEmailBlockDB) GetCanonicalEmailBlock(ctx context.Context, id string) (*gtsmodel.CanonicalEmailBlock, error) {
+	return c.getCanonicalEmailBlock(ctx, "id", id)
+}
+
+func (c *canonicalEmailBlockDB) GetCanonicalEmailBlockByHash(ctx context.Context, hash string) (*gtsmodel.CanonicalEmailBlock, error) {
+	return c.getCanonicalEmailBlock(ctx, "hash", hash)
+}
+
+func (c *canonicalEmailBlockDB) getCanonicalEmailBlock(ctx context.Context, column string, value string) (*gtsmodel.CanonicalEmailBlock, error) {
+	block := new(gtsmodel.CanonicalEmailBlock)
+	if err := c.db.NewSelect().
+		Model(block).
+		Where("? = ?", bun.Ident(column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (c *canonicalEmailBlockDB) GetCanonicalEmailBlocks(ctx context.Context) ([]*gtsmodel.CanonicalEmailBlock, error) {
+	var blocks []*gtsmodel.CanonicalEmailBlock
+	err := c.db.NewSelect().
+		Model(&blocks).
+		Order("id DESC").
+		Scan(ctx)
+	return blocks, err
+}
+
+func (c *canonicalEmailBlockDB) PutCanonicalEmailBlock(ctx context.Context, block *gtsmodel.CanonicalEmailBlock) error {
+	_, err := c.db.NewInsert().
+		Model(block).
+		Exec(ctx)
+	return err
+}
+
+func (c *canonicalEmailBlockDB) DeleteCanonicalEmailBlock(ctx context.Context, id string) error {
+	_, err := c.db.NewDelete().
+		Table("canonical_email_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
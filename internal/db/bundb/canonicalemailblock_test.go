@@ -0,0 +1,95 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type CanonicalEmailBlockTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *CanonicalEmailBlockTestSuite) TestCanonicalEmailBlockGetPutDelete() {
+	ctx := context.Background()
+
+	block := &gtsmodel.CanonicalEmailBlock{
+		ID:                 "01JJAZ7QW2N1F7PXGJ5B6G3D0V",
+		Hash:               util.CanonicalEmailHash("someone@somewhere.com"),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.db.PutCanonicalEmailBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// A second block with the same hash is refused.
+	err := suite.db.PutCanonicalEmailBlock(ctx, &gtsmodel.CanonicalEmailBlock{
+		ID:                 "01JJAZ8C4W9W3VQ7Y6C1XJ0N2M",
+		Hash:               block.Hash,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	// Fetch by ID and by hash.
+	byID, err := suite.db.GetCanonicalEmailBlock(ctx, block.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(block.Hash, byID.Hash)
+
+	byHash, err := suite.db.GetCanonicalEmailBlockByHash(ctx, block.Hash)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(block.ID, byHash.ID)
+
+	all, err := suite.db.GetCanonicalEmailBlocks(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(all, 1)
+
+	// Variations of the blocked
+	// address are not available.
+	available, err := suite.db.IsEmailAvailable(ctx, "some.one+again@somewhere.com")
+	suite.NoError(err)
+	suite.False(available)
+
+	if err := suite.db.DeleteCanonicalEmailBlock(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetCanonicalEmailBlock(ctx, block.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Address is available again.
+	available, err = suite.db.IsEmailAvailable(ctx, "some.one+again@somewhere.com")
+	suite.NoError(err)
+	suite.True(available)
+}
+
+func TestCanonicalEmailBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(CanonicalEmailBlockTestSuite))
+}
@@ -0,0 +1,390 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type circleDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+/*
+	CIRCLE FUNCTIONS
+*/
+
+func (c *circleDB) GetCircleByID(ctx context.Context, id string) (*gtsmodel.Circle, error) {
+	circle := new(gtsmodel.Circle)
+
+	if err := c.db.
+		NewSelect().
+		Model(circle).
+		Where("? = ?", bun.Ident("circle.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return circle, nil
+	}
+
+	if err := c.PopulateCircle(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	return circle, nil
+}
+
+func (c *circleDB) GetCirclesByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Circle, error) {
+	var circles []*gtsmodel.Circle
+
+	if err := c.db.
+		NewSelect().
+		Model(&circles).
+		Where("? = ?", bun.Ident("circle.account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("circle.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return circles, nil
+	}
+
+	// Populate all loaded circles, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	circles = slices.DeleteFunc(circles, func(circle *gtsmodel.Circle) bool {
+		if err := c.PopulateCircle(ctx, circle); err != nil {
+			log.Errorf(ctx, "error populating circle %s: %v", circle.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return circles, nil
+}
+
+func (c *circleDB) PopulateCircle(ctx context.Context, circle *gtsmodel.Circle) error {
+	var err error
+
+	if circle.Account == nil {
+		// Circle account is not set, fetch from the database.
+		circle.Account, err = c.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			circle.AccountID,
+		)
+		if err != nil {
+			return gtserror.Newf("error populating circle account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *circleDB) PutCircle(ctx context.Context, circle *gtsmodel.Circle) error {
+	_, err := c.db.
+		NewInsert().
+		Model(circle).
+		Exec(ctx)
+	return err
+}
+
+func (c *circleDB) UpdateCircle(ctx context.Context, circle *gtsmodel.Circle, columns ...string) error {
+	circle.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := c.db.
+		NewUpdate().
+		Model(circle).
+		Where("? = ?", bun.Ident("circle.id"), circle.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (c *circleDB) DeleteCircleByID(ctx context.Context, id string) error {
+	return c.deleteCircles(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("circle.id"), id)
+	})
+}
+
+func (c *circleDB) DeleteCirclesByAccountID(ctx context.Context, accountID string) error {
+	return c.deleteCircles(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("circle.account_id"), accountID)
+	})
+}
+
+// deleteCircles deletes all circles selected by the given
+// where function, along with all members of those circles.
+func (c *circleDB) deleteCircles(
+	ctx context.Context,
+	where func(*bun.SelectQuery) *bun.SelectQuery,
+) error {
+	var circleIDs []string
+
+	q := c.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("circles"), bun.Ident("circle")).
+		ColumnExpr("?", bun.Ident("circle.id"))
+
+	if err := where(q).Scan(ctx, &circleIDs); err != nil {
+		return err
+	}
+
+	if len(circleIDs) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	// Member account IDs, gathered
+	// so we can invalidate visibility.
+	var accountIDs []string
+
+	if err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("circle_members"), bun.Ident("circle_member")).
+			Where("? IN (?)", bun.Ident("circle_member.circle_id"), bun.In(circleIDs)).
+			Returning("?", bun.Ident("account_id")).
+			Exec(ctx, &accountIDs); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("circles"), bun.Ident("circle")).
+			Where("? IN (?)", bun.Ident("circle.id"), bun.In(circleIDs)).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	c.invalidateVisibility(accountIDs...)
+	return nil
+}
+
+/*
+	CIRCLE MEMBER FUNCTIONS
+*/
+
+func (c *circleDB) GetCircleMembers(
+	ctx context.Context,
+	circleID string,
+	page *paging.Page,
+) ([]*gtsmodel.CircleMember, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		members = make([]*gtsmodel.CircleMember, 0, limit)
+	)
+
+	q := c.db.
+		NewSelect().
+		Model(&members).
+		Where("? = ?", bun.Ident("circle_member.circle_id"), circleID)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("circle_member.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("circle_member.id"),
+			minID,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("circle_member.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("circle_member.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(members)
+	}
+
+	// Populate all loaded member accounts, removing those
+	// we fail to populate, eg., if they've since been deleted.
+	members = slices.DeleteFunc(members, func(member *gtsmodel.CircleMember) bool {
+		var err error
+		member.Account, err = c.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			member.AccountID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error populating circle member %s: %v", member.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return members, nil
+}
+
+func (c *circleDB) GetAccountIDsInCircle(ctx context.Context, circleID string) ([]string, error) {
+	var accountIDs []string
+
+	if _, err := c.db.
+		NewSelect().
+		Table("circle_members").
+		Column("account_id").
+		Where("? = ?", bun.Ident("circle_id"), circleID).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Exec(ctx, &accountIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (c *circleDB) IsAccountInCircle(ctx context.Context, circleID string, accountID string) (bool, error) {
+	return c.db.
+		NewSelect().
+		Table("circle_members").
+		Where("? = ?", bun.Ident("circle_id"), circleID).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exists(ctx)
+}
+
+func (c *circleDB) PutCircleMembers(ctx context.Context, members []*gtsmodel.CircleMember) error {
+	// Insert all members into the database in a single transaction (all or nothing!).
+	if err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, member := range members {
+			if _, err := tx.
+				NewInsert().
+				Model(member).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		c.invalidateVisibility(member.AccountID)
+	}
+
+	return nil
+}
+
+func (c *circleDB) DeleteCircleMember(ctx context.Context, circleID string, accountID string) error {
+	if _, err := c.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("circle_members"), bun.Ident("circle_member")).
+		Where("? = ?", bun.Ident("circle_member.circle_id"), circleID).
+		Where("? = ?", bun.Ident("circle_member.account_id"), accountID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	c.invalidateVisibility(accountID)
+	return nil
+}
+
+func (c *circleDB) DeleteCircleMembersByFollows(ctx context.Context, followIDs ...string) error {
+	var accountIDs []string
+
+	// Check for empty list.
+	if len(followIDs) == 0 {
+		return nil
+	}
+
+	// Delete all members with follow
+	// ID, returning their account IDs.
+	if _, err := c.db.
+		NewDelete().
+		Table("circle_members").
+		Where("? IN (?)", bun.Ident("follow_id"), bun.In(followIDs)).
+		Returning("?", bun.Ident("account_id")).
+		Exec(ctx, &accountIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	c.invalidateVisibility(accountIDs...)
+	return nil
+}
+
+// invalidateVisibility invalidates cached visibility results
+// for the given (former) circle member accounts, as membership
+// of a circle changes which statuses are visible to them.
+func (c *circleDB) invalidateVisibility(accountIDs ...string) {
+	for _, accountID := range accountIDs {
+		c.state.Caches.Visibility.Invalidate("RequesterID", accountID)
+	}
+}
@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type CircleTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// putTestCircle puts a new circle owned by local_account_1 in the
+// db, containing local_account_2 (who follows local_account_1).
+func (suite *CircleTestSuite) putTestCircle(ctx context.Context) (*gtsmodel.Circle, *gtsmodel.CircleMember) {
+	circle := &gtsmodel.Circle{
+		ID:        id.NewULID(),
+		Title:     "Close friends",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+	}
+
+	if err := suite.db.PutCircle(ctx, circle); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	follow := suite.testFollows["local_account_2_local_account_1"]
+	member := &gtsmodel.CircleMember{
+		ID:        id.NewULID(),
+		CircleID:  circle.ID,
+		FollowID:  follow.ID,
+		AccountID: follow.AccountID,
+	}
+
+	if err := suite.db.PutCircleMembers(ctx, []*gtsmodel.CircleMember{member}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return circle, member
+}
+
+func (suite *CircleTestSuite) TestGetCircleByID() {
+	ctx := context.Background()
+	circle, _ := suite.putTestCircle(ctx)
+
+	dbCircle, err := suite.db.GetCircleByID(ctx, circle.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(circle.Title, dbCircle.Title)
+	suite.Equal(circle.AccountID, dbCircle.AccountID)
+	suite.NotNil(dbCircle.Account)
+}
+
+func (suite *CircleTestSuite) TestPutCircleDuplicateTitle() {
+	ctx := context.Background()
+	circle, _ := suite.putTestCircle(ctx)
+
+	err := suite.db.PutCircle(ctx, &gtsmodel.Circle{
+		ID:        id.NewULID(),
+		Title:     circle.Title,
+		AccountID: circle.AccountID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *CircleTestSuite) TestGetCircleMembers() {
+	ctx := context.Background()
+	circle, member := suite.putTestCircle(ctx)
+
+	members, err := suite.db.GetCircleMembers(ctx, circle.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(members, 1) {
+		suite.Equal(member.ID, members[0].ID)
+		suite.Equal(member.AccountID, members[0].Account.ID)
+	}
+
+	in, err := suite.db.IsAccountInCircle(ctx, circle.ID, member.AccountID)
+	suite.NoError(err)
+	suite.True(in)
+
+	in, err = suite.db.IsAccountInCircle(ctx, circle.ID, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+	suite.False(in)
+}
+
+func (suite *CircleTestSuite) TestDeleteCircleMembersByFollows() {
+	ctx := context.Background()
+	circle, member := suite.putTestCircle(ctx)
+
+	if err := suite.db.DeleteCircleMembersByFollows(ctx, member.FollowID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accountIDs, err := suite.db.GetAccountIDsInCircle(ctx, circle.ID)
+	suite.NoError(err)
+	suite.Empty(accountIDs)
+}
+
+func (suite *CircleTestSuite) TestDeleteCircle() {
+	ctx := context.Background()
+	circle, _ := suite.putTestCircle(ctx)
+
+	if err := suite.db.DeleteCircleByID(ctx, circle.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetCircleByID(ctx, circle.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	// Members should be gone too.
+	accountIDs, err := suite.db.GetAccountIDsInCircle(ctx, circle.ID)
+	suite.NoError(err)
+	suite.Empty(accountIDs)
+}
+
+func TestCircleTestSuite(t *testing.T) {
+	suite.Run(t, new(CircleTestSuite))
+}
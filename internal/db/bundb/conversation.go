@@ -350,6 +350,9 @@ func (c *conversationDB) DeleteStatusFromConversations(ctx context.Context, stat
 		// So we leave the temp tables alone, in the
 		// knowledge they'll be cleaned up when this
 		// connection gets recycled (in max 5min).
+		//
+		// MySQL has no ON COMMIT DROP either, so
+		// we do the same there as with SQLite.
 		tmpQ = "CREATE TEMPORARY TABLE ? AS ?"
 	}
 
@@ -520,7 +523,24 @@ func (c *conversationDB) DeleteStatusFromConversations(ctx context.Context, stat
 			Where("? = ?", bun.Ident("conversations.id"), bun.Ident("latest_conversation_statuses.conversation_id")).
 			Where("? IS NOT NULL", bun.Ident("latest_conversation_statuses.id")).
 			Returning("?", bun.Ident("conversations.id"))
-		_, err = updateQ.Exec(ctx, &updatedConversationIDs)
+		if tx.Dialect().Name() == dialect.MySQL {
+			// MySQL doesn't support UPDATE ... RETURNING,
+			// so select the IDs of conversations to update
+			// beforehand, from the same temporary table.
+			if err := tx.NewSelect().
+				Table(latestConversationStatusesTmp).
+				Column("conversation_id").
+				Where("? IS NOT NULL", bun.Ident("id")).
+				Scan(ctx, &updatedConversationIDs); err != nil {
+				return gtserror.Newf(
+					"error selecting conversations to update while deleting status %s: %w",
+					statusID, err,
+				)
+			}
+			_, err = updateQ.Exec(ctx)
+		} else {
+			_, err = updateQ.Exec(ctx, &updatedConversationIDs)
+		}
 		if err != nil {
 			return gtserror.Newf(
 				"error rolling back last status for conversation while deleting status %s: %w",
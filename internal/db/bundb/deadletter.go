@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type deadLetterDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (d *deadLetterDB) GetDeadLetterByID(ctx context.Context, id string) (*gtsmodel.DeadLetter, error) {
+	deadLetter := new(gtsmodel.DeadLetter)
+
+	if err := d.db.
+		NewSelect().
+		Model(deadLetter).
+		Where("? = ?", bun.Ident("dead_letter.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deadLetter, nil
+}
+
+func (d *deadLetterDB) GetDeadLetters(
+	ctx context.Context,
+	domain string,
+	page *paging.Page,
+) ([]*gtsmodel.DeadLetter, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		deadLetters = make([]*gtsmodel.DeadLetter, 0, limit)
+	)
+
+	q := d.db.
+		NewSelect().
+		Model(&deadLetters)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("dead_letter.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("dead_letter.id"),
+			minID,
+		)
+	}
+
+	// Return only items
+	// with given domain.
+	if domain != "" {
+		var err error
+
+		// Normalize domain as punycode.
+		domain, err = util.Punify(domain)
+		if err != nil {
+			return nil, gtserror.Newf("error punifying domain %s: %w", domain, err)
+		}
+
+		q = q.Where(
+			"? = ?",
+			bun.Ident("dead_letter.domain"),
+			domain,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("dead_letter.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("dead_letter.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(deadLetters) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(deadLetters)
+	}
+
+	return deadLetters, nil
+}
+
+func (d *deadLetterDB) PutDeadLetter(ctx context.Context, deadLetter *gtsmodel.DeadLetter) error {
+	_, err := d.db.
+		NewInsert().
+		Model(deadLetter).
+		Exec(ctx)
+	return err
+}
+
+func (d *deadLetterDB) DeleteDeadLetterByID(ctx context.Context, id string) error {
+	_, err := d.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("dead_letters"), bun.Ident("dead_letter")).
+		Where("? = ?", bun.Ident("dead_letter.id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	return nil
+}
+
+func (d *deadLetterDB) DeleteDeadLettersOlderThan(ctx context.Context, olderThan time.Time) (int, error) {
+	res, err := d.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("dead_letters"), bun.Ident("dead_letter")).
+		Where("? < ?", bun.Ident("dead_letter.created_at"), olderThan).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
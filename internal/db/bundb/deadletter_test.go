@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DeadLetterTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *DeadLetterTestSuite) TestPutGetDeleteDeadLetters() {
+	ctx := context.Background()
+
+	for _, domain := range []string{
+		"example.org",
+		"example.org",
+		"fossbros-anonymous.io",
+	} {
+		if err := suite.state.DB.PutDeadLetter(ctx, &gtsmodel.DeadLetter{
+			ID:       id.NewULID(),
+			Domain:   domain,
+			InboxURI: "https://" + domain + "/inbox",
+			ActorID:  suite.testAccounts["local_account_1"].URI,
+			Error:    "http response: 503 Service Unavailable",
+			Data:     []byte(`{}`),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Get all dead letters.
+	deadLetters, err := suite.state.DB.GetDeadLetters(ctx, "", &paging.Page{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(deadLetters, 3)
+
+	// Get dead letters for one domain.
+	deadLetters, err = suite.state.DB.GetDeadLetters(ctx, "example.org", &paging.Page{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(deadLetters, 2)
+
+	// Get + delete a single dead letter.
+	deadLetter, err := suite.state.DB.GetDeadLetterByID(ctx, deadLetters[0].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("https://example.org/inbox", deadLetter.InboxURI)
+
+	if err := suite.state.DB.DeleteDeadLetterByID(ctx, deadLetter.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetDeadLetterByID(ctx, deadLetter.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Prune all remaining dead letters.
+	count, err := suite.state.DB.DeleteDeadLettersOlderThan(ctx, time.Now().Add(time.Minute))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, count)
+
+	_, err = suite.state.DB.GetDeadLetters(ctx, "", &paging.Page{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestDeadLetterTestSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterTestSuite))
+}
@@ -19,7 +19,9 @@
 
 import (
 	"context"
+	"errors"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -51,8 +53,9 @@ func (d *domainDB) CreateDomainAllow(ctx context.Context, allow *gtsmodel.Domain
 		return err
 	}
 
-	// Clear the domain allow cache (for later reload)
+	// Clear the domain allow caches (for later reload)
 	d.state.Caches.DB.DomainAllow.Clear()
+	d.state.Caches.DB.DomainLimited.Clear()
 
 	return nil
 }
@@ -135,8 +138,9 @@ func (d *domainDB) UpdateDomainAllow(ctx context.Context, allow *gtsmodel.Domain
 		return err
 	}
 
-	// Clear the domain allow cache (for later reload)
+	// Clear the domain allow caches (for later reload)
 	d.state.Caches.DB.DomainAllow.Clear()
+	d.state.Caches.DB.DomainLimited.Clear()
 
 	return nil
 }
@@ -156,8 +160,9 @@ func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) error {
 		return err
 	}
 
-	// Clear the domain allow cache (for later reload)
+	// Clear the domain allow caches (for later reload)
 	d.state.Caches.DB.DomainAllow.Clear()
+	d.state.Caches.DB.DomainLimited.Clear()
 
 	return nil
 }
@@ -301,20 +306,8 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, er
 		return false, nil
 	}
 
-	// Check the cache for an explicit domain allow (hydrating the cache with callback if necessary).
-	explicitAllow, err := d.state.Caches.DB.DomainAllow.Matches(domain, func() ([]string, error) {
-		var domains []string
-
-		// Scan list of all explicitly allowed domains from DB
-		q := d.db.NewSelect().
-			Table("domain_allows").
-			Column("domain")
-		if err := q.Scan(ctx, &domains); err != nil {
-			return nil, err
-		}
-
-		return domains, nil
-	})
+	// Check the cache for an explicit domain allow.
+	explicitAllow, err := d.isDomainAllowed(ctx, domain)
 	if err != nil {
 		return false, err
 	}
@@ -364,6 +357,204 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, er
 	}
 }
 
+// isDomainAllowed checks the cache for an explicit domain
+// allow matching domain (or one of its parent domains),
+// hydrating the cache with callback if necessary.
+func (d *domainDB) isDomainAllowed(ctx context.Context, domain string) (bool, error) {
+	return d.state.Caches.DB.DomainAllow.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all explicitly allowed domains from DB
+		q := d.db.NewSelect().
+			Table("domain_allows").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+}
+
+func (d *domainDB) IsSignatureRequired(ctx context.Context, domain string) (bool, error) {
+	mode := config.GetInstanceAuthorizedFetchMode()
+
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// If domain unknown or there's no allow
+	// covering it at all, then there's nothing
+	// that could override the instance mode.
+	allowed := false
+	if domain != "" {
+		allowed, err = d.isDomainAllowed(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// Walk up from domain through its parent domains
+	// until we find an allow with a fetch mode set,
+	// so that the most specific entry takes priority.
+	for name := domain; allowed && name != ""; {
+		allow, err := d.GetDomainAllow(ctx, name)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return false, err
+		}
+
+		if allow != nil && allow.AuthorizedFetchMode != "" {
+			mode = allow.AuthorizedFetchMode
+			break
+		}
+
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	return mode != config.InstanceAuthorizedFetchModeOptional, nil
+}
+
+func (d *domainDB) GetAuthorizedFetchDomains(ctx context.Context) ([]string, error) {
+	var domains []string
+
+	if err := d.db.NewSelect().
+		Table("domain_allows").
+		Column("domain").
+		Where("? IS NOT NULL", bun.Ident("authorized_fetch_mode")).
+		Scan(ctx, &domains); err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+func (d *domainDB) GetDomainRateLimits(ctx context.Context, domain string) (int, int, error) {
+	delivery := config.GetDeliveryRateLimit()
+	dereference := config.GetDeliveryDereferenceRateLimit()
+
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// If domain unknown or there's no allow
+	// covering it at all, then there's nothing
+	// that could override the instance limits.
+	allowed := false
+	if domain != "" {
+		allowed, err = d.isDomainAllowed(ctx, domain)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	// Walk up from domain through its parent domains
+	// taking the first limit of each type that we find,
+	// so that the most specific entry takes priority.
+	var deliverySet, dereferenceSet bool
+	for name := domain; allowed && name != ""; {
+		allow, err := d.GetDomainAllow(ctx, name)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return 0, 0, err
+		}
+
+		if allow != nil {
+			if !deliverySet && allow.DeliveryRateLimit != 0 {
+				delivery = allow.DeliveryRateLimit
+				deliverySet = true
+			}
+
+			if !dereferenceSet && allow.DereferenceRateLimit != 0 {
+				dereference = allow.DereferenceRateLimit
+				dereferenceSet = true
+			}
+		}
+
+		if deliverySet && dereferenceSet {
+			break
+		}
+
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	// Negative limits on allows
+	// lift the limit entirely.
+	return max(delivery, 0), max(dereference, 0), nil
+}
+
+func (d *domainDB) GetDomainMediaPolicy(ctx context.Context, domain string) (string, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return "", err
+	}
+
+	// If domain unknown or there's no allow
+	// covering it at all, there's no policy.
+	if domain == "" {
+		return "", nil
+	}
+
+	allowed, err := d.isDomainAllowed(ctx, domain)
+	if err != nil {
+		return "", err
+	}
+
+	// Walk up from domain through its parent domains
+	// until we find an allow with a media policy set,
+	// so that the most specific entry takes priority.
+	for name := domain; allowed && name != ""; {
+		allow, err := d.GetDomainAllow(ctx, name)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return "", err
+		}
+
+		if allow != nil && allow.MediaPolicy != "" {
+			return allow.MediaPolicy, nil
+		}
+
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	return "", nil
+}
+
+func (d *domainDB) IsDomainLimited(ctx context.Context, domain string) (bool, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// If domain unknown,
+	// it can't be limited.
+	if domain == "" {
+		return false, nil
+	}
+
+	// Check the cache for a limited domain allow matching
+	// domain (or one of its parent domains), as a limit on
+	// a domain applies to its subdomains too, hydrating the
+	// cache with callback if necessary.
+	return d.state.Caches.DB.DomainLimited.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all limited domains from DB
+		q := d.db.NewSelect().
+			Table("domain_allows").
+			Column("domain").
+			Where("? = ?", bun.Ident("limited"), true)
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+}
+
 func (d *domainDB) AreDomainsBlocked(ctx context.Context, domains []string) (bool, error) {
 	for _, domain := range domains {
 		if blocked, err := d.IsDomainBlocked(ctx, domain); err != nil {
@@ -24,7 +24,9 @@
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type DomainTestSuite struct {
@@ -274,6 +276,245 @@ func(dbBlock *gtsmodel.DomainBlock) bool {
 	}
 }
 
+func (suite *DomainTestSuite) TestIsSignatureRequired() {
+	ctx := context.Background()
+
+	// Allow a domain with signatures
+	// optional, and one subdomain of
+	// it with signatures required again.
+	for _, allow := range []*gtsmodel.DomainAllow{
+		{
+			ID:                  "01JKFRD1ZKQXHJW5QSF5BA1VZ0",
+			Domain:              "lenient.example",
+			CreatedByAccountID:  suite.testAccounts["admin_account"].ID,
+			AuthorizedFetchMode: config.InstanceAuthorizedFetchModeOptional,
+		},
+		{
+			ID:                  "01JKFRD1ZM9M8CV2TMAZ3DY8SS",
+			Domain:              "strict.lenient.example",
+			CreatedByAccountID:  suite.testAccounts["admin_account"].ID,
+			AuthorizedFetchMode: config.InstanceAuthorizedFetchModeRequire,
+		},
+		{
+			ID:                 "01JKFRD1ZMD0ZEC5P7PNHK3WXT",
+			Domain:             "nomode.example",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		},
+	} {
+		if err := suite.db.CreateDomainAllow(ctx, allow); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Only allows with an override
+	// should be returned for resolving.
+	domains, err := suite.db.GetAuthorizedFetchDomains(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.ElementsMatch([]string{"lenient.example", "strict.lenient.example"}, domains)
+
+	for _, test := range []struct {
+		instanceMode string
+		domain       string
+		required     bool
+	}{
+		// Instance requires signatures.
+		{config.InstanceAuthorizedFetchModeRequire, "", true},
+		{config.InstanceAuthorizedFetchModeRequire, "unknown.example", true},
+		{config.InstanceAuthorizedFetchModeRequire, "nomode.example", true},
+		{config.InstanceAuthorizedFetchModeRequire, "lenient.example", false},
+		{config.InstanceAuthorizedFetchModeRequire, "sub.lenient.example", false},
+		{config.InstanceAuthorizedFetchModeRequire, "LENIENT.example", false},
+		{config.InstanceAuthorizedFetchModeRequire, "strict.lenient.example", true},
+		{config.InstanceAuthorizedFetchModeRequire, "sub.strict.lenient.example", true},
+
+		// Instance doesn't require signatures.
+		{config.InstanceAuthorizedFetchModeOptional, "", false},
+		{config.InstanceAuthorizedFetchModeOptional, "unknown.example", false},
+		{config.InstanceAuthorizedFetchModeOptional, "nomode.example", false},
+		{config.InstanceAuthorizedFetchModeOptional, "lenient.example", false},
+		{config.InstanceAuthorizedFetchModeOptional, "strict.lenient.example", true},
+	} {
+		config.SetInstanceAuthorizedFetchMode(test.instanceMode)
+
+		required, err := suite.db.IsSignatureRequired(ctx, test.domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.required, required, test.instanceMode+" "+test.domain)
+	}
+}
+
+func (suite *DomainTestSuite) TestGetDomainRateLimits() {
+	ctx := context.Background()
+
+	config.SetDeliveryRateLimit(30)
+	config.SetDeliveryDereferenceRateLimit(60)
+
+	allows := []*gtsmodel.DomainAllow{
+		{
+			ID:                 "01JHT2V3WJ3QX3C1YVZ4CS7B1T",
+			Domain:             "big.instance",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			DeliveryRateLimit:  -1,
+		},
+		{
+			ID:                   "01JHT2VBQ1XVVF8KJH7MKG3QQ6",
+			Domain:               "slow.big.instance",
+			CreatedByAccountID:   suite.testAccounts["admin_account"].ID,
+			DereferenceRateLimit: 5,
+		},
+	}
+
+	for _, allow := range allows {
+		if err := suite.db.CreateDomainAllow(ctx, allow); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	for _, test := range []struct {
+		domain      string
+		delivery    int
+		dereference int
+	}{
+		// No allow, instance limits.
+		{"some.other.instance", 30, 60},
+
+		// Delivery limit lifted.
+		{"big.instance", 0, 60},
+		{"sub.big.instance", 0, 60},
+
+		// Dereference limit lowered, delivery
+		// limit still lifted by parent domain.
+		{"slow.big.instance", 0, 5},
+	} {
+		delivery, dereference, err := suite.db.GetDomainRateLimits(ctx, test.domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.delivery, delivery, test.domain)
+		suite.Equal(test.dereference, dereference, test.domain)
+	}
+}
+
+func (suite *DomainTestSuite) TestGetDomainMediaPolicy() {
+	ctx := context.Background()
+
+	allows := []*gtsmodel.DomainAllow{
+		{
+			ID:                 "01JHWD0X5GQ1V8R6WZ0T3FJ2KA",
+			Domain:             "media.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			MediaPolicy:        gtsmodel.DomainMediaPolicySensitive,
+		},
+		{
+			ID:                 "01JHWD17RB8X2E7S5ZGQ8CYW4N",
+			Domain:             "nasty.media.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			MediaPolicy:        gtsmodel.DomainMediaPolicyReject,
+		},
+		{
+			ID:                 "01JHWD1G3T9M6YVJ0C2HN5QKPD",
+			Domain:             "nice.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		},
+	}
+
+	for _, allow := range allows {
+		if err := suite.db.CreateDomainAllow(ctx, allow); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	for _, test := range []struct {
+		domain string
+		policy string
+	}{
+		// No allow, no policy.
+		{"some.other.instance", ""},
+
+		// Allow without policy.
+		{"nice.example.org", ""},
+
+		// Policy inherited from parent domain.
+		{"media.example.org", gtsmodel.DomainMediaPolicySensitive},
+		{"sub.media.example.org", gtsmodel.DomainMediaPolicySensitive},
+
+		// Most specific policy wins.
+		{"nasty.media.example.org", gtsmodel.DomainMediaPolicyReject},
+		{"sub.nasty.media.example.org", gtsmodel.DomainMediaPolicyReject},
+	} {
+		policy, err := suite.db.GetDomainMediaPolicy(ctx, test.domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.policy, policy, test.domain)
+	}
+}
+
+func (suite *DomainTestSuite) TestIsDomainLimited() {
+	ctx := context.Background()
+
+	allows := []*gtsmodel.DomainAllow{
+		{
+			ID:                 "01JHZ4M1V6Q3PK0FYB2W8TRN5C",
+			Domain:             "noisy.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			Limited:            util.Ptr(true),
+		},
+		{
+			ID:                 "01JHZ4MAG8ES5J1XK7R3VD0H2B",
+			Domain:             "quiet.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		},
+	}
+
+	for _, allow := range allows {
+		if err := suite.db.CreateDomainAllow(ctx, allow); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	for _, test := range []struct {
+		domain  string
+		limited bool
+	}{
+		// No allow, not limited.
+		{"some.other.instance", false},
+
+		// Allow without limit.
+		{"quiet.example.org", false},
+
+		// Limited, including subdomains.
+		{"noisy.example.org", true},
+		{"sub.noisy.example.org", true},
+	} {
+		limited, err := suite.db.IsDomainLimited(ctx, test.domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.limited, limited, test.domain)
+	}
+
+	// Lift the limit, cached
+	// result should be cleared.
+	allows[0].Limited = util.Ptr(false)
+	if err := suite.db.UpdateDomainAllow(ctx, allows[0], "limited"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	limited, err := suite.db.IsDomainLimited(ctx, "sub.noisy.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(limited)
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
@@ -20,6 +20,7 @@
 import (
 	"database/sql"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db/mysql"
 	"github.com/superseriousbusiness/gotosocial/internal/db/postgres"
 	"github.com/superseriousbusiness/gotosocial/internal/db/sqlite"
 )
@@ -27,5 +28,6 @@
 func init() {
 	// register our SQL driver implementations.
 	sql.Register("pgx-gts", &postgres.Driver{})
+	sql.Register("mysql-gts", &mysql.Driver{})
 	sql.Register("sqlite-gts", &sqlite.Driver{})
 }
@@ -183,6 +183,8 @@ func(emojiID string) bool {
 	e.state.Caches.DB.Emoji.Invalidate("ID", id)
 	e.state.Caches.DB.Account.InvalidateIDs("ID", accountIDs)
 	e.state.Caches.DB.Status.InvalidateIDs("ID", statusIDs)
+	e.state.Caches.PublishInvalidateAccountIDs(ctx, accountIDs)
+	e.state.Caches.PublishInvalidateStatusIDs(ctx, statusIDs)
 
 	return nil
 }
@@ -207,7 +209,7 @@ func (e *emojiDB) GetEmojisBy(ctx context.Context, domain string, includeDisable
 	//	ORDER BY
 	//		"shortcode_domain" ASC
 	//
-	// Or like this (postgres):
+	// Or like this (postgres, mysql):
 	//
 	//	SELECT
 	//		"emoji"."id" AS "emoji_ids",
@@ -219,10 +221,10 @@ func (e *emojiDB) GetEmojisBy(ctx context.Context, domain string, includeDisable
 	switch e.db.Dialect().Name() {
 	case dialect.SQLite:
 		subQuery = subQuery.ColumnExpr("LOWER(? || ? || COALESCE(?, ?)) AS ?", bun.Ident("emoji.shortcode"), "@", bun.Ident("emoji.domain"), "", bun.Ident("shortcode_domain"))
-	case dialect.PG:
+	case dialect.PG, dialect.MySQL:
 		subQuery = subQuery.ColumnExpr("LOWER(CONCAT(?, ?, COALESCE(?, ?))) AS ?", bun.Ident("emoji.shortcode"), "@", bun.Ident("emoji.domain"), "", bun.Ident("shortcode_domain"))
 	default:
-		panic("db conn was neither pg not sqlite")
+		panic("db conn was neither pg, sqlite nor mysql")
 	}
 
 	subQuery = subQuery.TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji"))
@@ -472,6 +474,75 @@ func (e *emojiDB) PutEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.
 	})
 }
 
+func (e *emojiDB) UpdateEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory, columns ...string) error {
+	emojiCategory.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update the emoji category model in the database.
+	if err := e.state.Caches.DB.EmojiCategory.Store(emojiCategory, func() error {
+		_, err := e.db.
+			NewUpdate().
+			Model(emojiCategory).
+			Where("? = ?", bun.Ident("emoji_category.id"), emojiCategory.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate any emoji in this category,
+	// as they'll have the old model populated.
+	e.state.Caches.DB.Emoji.Invalidate("CategoryID", emojiCategory.ID)
+	return nil
+}
+
+func (e *emojiDB) DeleteEmojiCategoryByID(ctx context.Context, id string) error {
+	// Uncategorize any emojis in this category,
+	// and delete the category, in one transaction.
+	if err := e.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewUpdate().
+			Table("emojis").
+			Set("? = NULL", bun.Ident("category_id")).
+			Where("? = ?", bun.Ident("category_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Table("emoji_categories").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate the category, which
+	// also invalidates emoji within it.
+	e.state.Caches.DB.EmojiCategory.Invalidate("ID", id)
+	return nil
+}
+
+func (e *emojiDB) GetEmojisByCategoryID(ctx context.Context, categoryID string) ([]*gtsmodel.Emoji, error) {
+	var emojiIDs []string
+
+	if err := e.db.
+		NewSelect().
+		Table("emojis").
+		Column("id").
+		Where("? = ?", bun.Ident("category_id"), categoryID).
+		Order("id ASC").
+		Scan(ctx, &emojiIDs); err != nil {
+		return nil, err
+	}
+
+	return e.GetEmojisByIDs(ctx, emojiIDs)
+}
+
 func (e *emojiDB) GetEmojiCategories(ctx context.Context) ([]*gtsmodel.EmojiCategory, error) {
 	emojiCategoryIDs := []string{}
 
@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type inviteDB struct {
+	db *bun.DB
+}
+
+func (i *inviteDB) GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error) {
+	return i.getInvite(ctx, "id", id)
+}
+
+func (i *inviteDB) GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error) {
+	return i.getInvite(ctx, "code", code)
+}
+
+func (i *inviteDB) getInvite(ctx context.Context, column string, value string) (*gtsmodel.Invite, error) {
+	invite := new(gtsmodel.Invite)
+	if err := i.db.NewSelect().
+		Model(invite).
+		Where("? = ?", bun.Ident(column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (i *inviteDB) GetInvites(ctx context.Context, createdByAccountID string) ([]*gtsmodel.Invite, error) {
+	var invites []*gtsmodel.Invite
+	q := i.db.NewSelect().
+		Model(&invites).
+		Order("id DESC")
+
+	if createdByAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("created_by_account_id"), createdByAccountID)
+	}
+
+	err := q.Scan(ctx)
+	return invites, err
+}
+
+func (i *inviteDB) PutInvite(ctx context.Context, invite *gtsmodel.Invite) error {
+	_, err := i.db.NewInsert().
+		Model(invite).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error {
+	invite.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.db.NewUpdate().
+		Model(invite).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), invite.ID).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) UseInvite(ctx context.Context, id string) error {
+	// Increment uses in the same query that checks
+	// max uses and expiry, so that concurrent sign-ups
+	// can't take an invite past its limit, and an
+	// invite can't be used after it expires.
+	now := time.Now()
+	res, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ? + 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("id"), id).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? = 0", bun.Ident("max_uses")).
+				WhereOr("? < ?", bun.Ident("uses"), bun.Ident("max_uses"))
+		}).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("expires_at")).
+				WhereOr("? > ?", bun.Ident("expires_at"), now)
+		}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		// Invite doesn't exist, it's
+		// expired, or it's all used up.
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
+
+func (i *inviteDB) ReleaseInvite(ctx context.Context, id string) error {
+	_, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ? - 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("id"), id).
+		Where("? > 0", bun.Ident("uses")).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InviteTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InviteTestSuite) TestInviteGetPutUse() {
+	t := suite.T()
+
+	// Create new example invite
+	// that can only be used once.
+	invite := gtsmodel.Invite{
+		ID:                 "01JJE2T4SX4KJ0AQ1SS9FTS9QW",
+		Code:               "6e0d4cdd1c0aa35a4b0ab0a5b7b1f6be",
+		CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		MaxUses:            1,
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	// Insert the example invite into db.
+	if err := suite.db.PutInvite(ctx, &invite); err != nil {
+		t.Fatalf("error inserting invite: %v", err)
+	}
+
+	// Now fetch newly created invite by code.
+	check, err := suite.db.GetInviteByCode(ctx, invite.Code)
+	if err != nil {
+		t.Fatalf("error fetching invite: %v", err)
+	}
+
+	// Check all expected fields match.
+	suite.Equal(invite.ID, check.ID)
+	suite.Equal(invite.CreatedByAccountID, check.CreatedByAccountID)
+	suite.Equal(1, check.MaxUses)
+	suite.Equal(0, check.Uses)
+
+	// Invites by creator should include example,
+	// invites by someone else should not.
+	invites, err := suite.db.GetInvites(ctx, invite.CreatedByAccountID)
+	if err != nil {
+		t.Fatalf("error fetching invites: %v", err)
+	}
+	suite.Len(invites, 1)
+
+	invites, err = suite.db.GetInvites(ctx, "01F8MH1H7YV1Z7D2C8K2730QBF")
+	if err != nil {
+		t.Fatalf("error fetching invites: %v", err)
+	}
+	suite.Empty(invites)
+
+	// First use should succeed.
+	if err := suite.db.UseInvite(ctx, invite.ID); err != nil {
+		t.Fatalf("error using invite: %v", err)
+	}
+
+	// Second use should fail, as invite is used up.
+	if err := suite.db.UseInvite(ctx, invite.ID); err != db.ErrNoEntries {
+		t.Fatalf("using used-up invite returned unexpected error: %v", err)
+	}
+
+	check, err = suite.db.GetInviteByID(ctx, invite.ID)
+	if err != nil {
+		t.Fatalf("error fetching invite: %v", err)
+	}
+	suite.Equal(1, check.Uses)
+
+	// Releasing the use should
+	// make the invite usable again.
+	if err := suite.db.ReleaseInvite(ctx, invite.ID); err != nil {
+		t.Fatalf("error releasing invite: %v", err)
+	}
+
+	if err := suite.db.UseInvite(ctx, invite.ID); err != nil {
+		t.Fatalf("error using released invite: %v", err)
+	}
+}
+
+func (suite *InviteTestSuite) TestUseExpiredInvite() {
+	t := suite.T()
+	ctx := context.Background()
+
+	invite := &gtsmodel.Invite{
+		ID:                 "01JJE2VQ0N2Y8X3K5W7TRB6HMA",
+		Code:               "0f5c2d1b9e8a7c6d5e4f3a2b1c0d9e8f",
+		CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		ExpiresAt:          time.Now().Add(-time.Hour),
+	}
+
+	if err := suite.db.PutInvite(ctx, invite); err != nil {
+		t.Fatalf("error putting invite: %v", err)
+	}
+
+	// Use should fail, as invite has expired.
+	if err := suite.db.UseInvite(ctx, invite.ID); err != db.ErrNoEntries {
+		t.Fatalf("using expired invite returned unexpected error: %v", err)
+	}
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type ipBlockDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (i *ipBlockDB) IPBlockMatch(ctx context.Context, addr netip.Addr) (*gtsmodel.IPBlock, error) {
+	return i.state.Caches.IPBlocks.Match(addr, func() ([]*gtsmodel.IPBlock, error) {
+		return i.GetIPBlocks(ctx)
+	})
+}
+
+func (i *ipBlockDB) GetIPBlock(ctx context.Context, id string) (*gtsmodel.IPBlock, error) {
+	block := new(gtsmodel.IPBlock)
+	if err := i.db.NewSelect().
+		Model(block).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (i *ipBlockDB) GetIPBlocks(ctx context.Context) ([]*gtsmodel.IPBlock, error) {
+	var blocks []*gtsmodel.IPBlock
+	err := i.db.NewSelect().
+		Model(&blocks).
+		Order("id DESC").
+		Scan(ctx)
+	return blocks, err
+}
+
+func (i *ipBlockDB) PutIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error {
+	if _, err := i.db.NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return err
+	}
+	i.state.Caches.IPBlocks.Clear()
+	return nil
+}
+
+func (i *ipBlockDB) UpdateIPBlock(ctx context.Context, block *gtsmodel.IPBlock, columns ...string) error {
+	block.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := i.db.NewUpdate().
+		Model(block).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), block.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	i.state.Caches.IPBlocks.Clear()
+	return nil
+}
+
+func (i *ipBlockDB) DeleteIPBlock(ctx context.Context, id string) error {
+	if _, err := i.db.NewDelete().
+		Table("ip_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return err
+	}
+	i.state.Caches.IPBlocks.Clear()
+	return nil
+}
@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type IPBlockTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *IPBlockTestSuite) TestIPBlockGetPutUpdateMatchDelete() {
+	t := suite.T()
+
+	// Create new example IP block.
+	block := gtsmodel.IPBlock{
+		ID:                 "01JJAQ6TX6GHXBX63QRXWNEH4A",
+		IP:                 "192.0.2.0/24",
+		Severity:           gtsmodel.IPBlockSeveritySignUpBlock,
+		CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	// Insert the example IP block into db.
+	if err := suite.db.PutIPBlock(ctx, &block); err != nil {
+		t.Fatalf("error inserting ip block: %v", err)
+	}
+
+	// Now fetch newly created block.
+	check, err := suite.db.GetIPBlock(ctx, block.ID)
+	if err != nil {
+		t.Fatalf("error fetching ip block: %v", err)
+	}
+
+	// Check all expected fields match.
+	suite.Equal(block.ID, check.ID)
+	suite.Equal(block.IP, check.IP)
+	suite.Equal(block.Severity, check.Severity)
+	suite.Equal(block.CreatedByAccountID, check.CreatedByAccountID)
+
+	// Fetch all IP blocks.
+	all, err := suite.db.GetIPBlocks(ctx)
+	if err != nil {
+		t.Fatalf("error fetching ip blocks: %v", err)
+	}
+
+	// Ensure contains example.
+	suite.Len(all, 1)
+	suite.Equal(block.ID, all[0].ID)
+
+	// Address in the range should match.
+	match, err := suite.db.IPBlockMatch(ctx, netip.MustParseAddr("192.0.2.10"))
+	if err != nil {
+		t.Fatalf("error matching ip block: %v", err)
+	}
+	suite.NotNil(match)
+
+	// Update the IP block severity.
+	check.Severity = gtsmodel.IPBlockSeverityNoAccess
+	if err := suite.db.UpdateIPBlock(ctx, check, "severity"); err != nil {
+		t.Fatalf("error updating ip block: %v", err)
+	}
+
+	// Ensure 'updated_at' was updated on check model.
+	suite.True(check.UpdatedAt.After(block.UpdatedAt))
+
+	// Ensure the match reflects the update.
+	match, err = suite.db.IPBlockMatch(ctx, netip.MustParseAddr("192.0.2.10"))
+	if err != nil {
+		t.Fatalf("error matching ip block: %v", err)
+	}
+	suite.Equal(gtsmodel.IPBlockSeverityNoAccess, match.Severity)
+
+	// Now delete the IP block from db.
+	if err := suite.db.DeleteIPBlock(ctx, block.ID); err != nil {
+		t.Fatalf("error deleting ip block: %v", err)
+	}
+
+	// Ensure we can't refetch it.
+	_, err = suite.db.GetIPBlock(ctx, block.ID)
+	if err != db.ErrNoEntries {
+		t.Fatalf("deleted ip block returned unexpected error: %v", err)
+	}
+
+	// Ensure address no longer matches.
+	match, err = suite.db.IPBlockMatch(ctx, netip.MustParseAddr("192.0.2.10"))
+	if err != nil {
+		t.Fatalf("error matching ip block: %v", err)
+	}
+	suite.Nil(match)
+}
+
+func TestIPBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(IPBlockTestSuite))
+}
@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type leaseDB struct {
+	db *bun.DB
+}
+
+func (l *leaseDB) AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	lease := &gtsmodel.Lease{
+		Name:      name,
+		Holder:    holder,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	// Try take over the lease if it already exists,
+	// but only if it's ours or it has expired. This is
+	// atomic on all supported databases, so only one
+	// node can succeed in taking over an expired lease.
+	res, err := l.db.
+		NewUpdate().
+		Model(lease).
+		Column("holder", "expires_at").
+		WherePK().
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? = ?", bun.Ident("holder"), holder).
+				WhereOr("? < ?", bun.Ident("expires_at"), now)
+		}).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return false, err
+	} else if n > 0 {
+		// Lease acquired.
+		return true, nil
+	}
+
+	// Either the lease doesn't exist yet, or is
+	// held by someone else. Try to create it, if
+	// this conflicts the lease is held elsewhere.
+	if _, err := l.db.
+		NewInsert().
+		Model(lease).
+		Exec(ctx); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
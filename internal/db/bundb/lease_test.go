@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LeaseTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *LeaseTestSuite) TestAcquireLease() {
+	ctx := context.Background()
+
+	// First node acquires new lease.
+	ok, err := suite.state.DB.AcquireLease(ctx, "@trends", "node-1", time.Hour)
+	suite.NoError(err)
+	suite.True(ok)
+
+	// Second node can't take it over.
+	ok, err = suite.state.DB.AcquireLease(ctx, "@trends", "node-2", time.Hour)
+	suite.NoError(err)
+	suite.False(ok)
+
+	// But first node can renew it,
+	// so it expires immediately.
+	ok, err = suite.state.DB.AcquireLease(ctx, "@trends", "node-1", -time.Second)
+	suite.NoError(err)
+	suite.True(ok)
+
+	// Now it's expired, second node can take over.
+	ok, err = suite.state.DB.AcquireLease(ctx, "@trends", "node-2", time.Hour)
+	suite.NoError(err)
+	suite.True(ok)
+
+	// Leases are independent by name.
+	ok, err = suite.state.DB.AcquireLease(ctx, "@statusexpiry", "node-1", time.Hour)
+	suite.NoError(err)
+	suite.True(ok)
+}
+
+func TestLeaseTestSuite(t *testing.T) {
+	suite.Run(t, new(LeaseTestSuite))
+}
@@ -0,0 +1,384 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+type measureDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+// whereBetween restricts the given query to rows
+// with given column between start (inclusive)
+// and end (exclusive).
+func whereBetween(q *bun.SelectQuery, column string, start time.Time, end time.Time) *bun.SelectQuery {
+	return q.
+		Where("? >= ?", bun.Ident(column), start).
+		Where("? < ?", bun.Ident(column), end)
+}
+
+// whereAccountDomain restricts the given query to rows
+// where the account joined as "account" is on the given
+// domain, or is any remote account if domain is empty.
+func whereAccountDomain(q *bun.SelectQuery, domain string) *bun.SelectQuery {
+	if domain == "" {
+		return q.Where("? IS NOT NULL", bun.Ident("account.domain"))
+	}
+	return q.Where("? = ?", bun.Ident("account.domain"), domain)
+}
+
+func (m *measureDB) GetLoginActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("tokens"), bun.Ident("token")).
+		ColumnExpr("? AS ?", bun.Ident("user.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("token.access_create_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("users"), bun.Ident("user"),
+			bun.Ident("user.id"), bun.Ident("token.user_id"),
+		)
+	q = whereBetween(q, "token.access_create_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetSignupActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		ColumnExpr("? AS ?", bun.Ident("user.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("user.created_at"), bun.Ident("created_at"))
+	q = whereBetween(q, "user.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetLocalStatusActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("status.language"), bun.Ident("language")).
+		ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+		Where("? = ?", bun.Ident("status.local"), true).
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
+	q = whereBetween(q, "status.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetRemoteStatusActivity(ctx context.Context, domain string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("status.language"), bun.Ident("language")).
+		ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("status.account_id"),
+		).
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
+	q = whereAccountDomain(q, domain)
+	q = whereBetween(q, "status.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetInteractionActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	// Gather faves of local statuses.
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("fave")).
+		ColumnExpr("? AS ?", bun.Ident("fave.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("fave.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("target"),
+			bun.Ident("target.id"), bun.Ident("fave.status_id"),
+		).
+		Where("? = ?", bun.Ident("target.local"), true)
+	q = whereBetween(q, "fave.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	// Gather boosts of, and replies to, local statuses.
+	for _, column := range []string{
+		"status.boost_of_id",
+		"status.in_reply_to_id",
+	} {
+		var statusActivity []*gtsmodel.MeasureActivity
+
+		q := m.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+			ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+			Join(
+				"JOIN ? AS ? ON ? = ?",
+				bun.Ident("statuses"), bun.Ident("target"),
+				bun.Ident("target.id"), bun.Ident(column),
+			).
+			Where("? = ?", bun.Ident("target.local"), true)
+		q = whereBetween(q, "status.created_at", start, end)
+
+		if err := q.Scan(ctx, &statusActivity); err != nil {
+			return nil, err
+		}
+
+		activity = append(activity, statusActivity...)
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetReportActivity(ctx context.Context, domain string, resolved bool, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	column := "report.created_at"
+	if resolved {
+		column = "report.action_taken_at"
+	}
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("reports"), bun.Ident("report")).
+		ColumnExpr("? AS ?", bun.Ident("report.target_account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident(column), bun.Ident("created_at"))
+
+	if domain != "" {
+		q = q.
+			Join(
+				"JOIN ? AS ? ON ? = ?",
+				bun.Ident("accounts"), bun.Ident("account"),
+				bun.Ident("account.id"), bun.Ident("report.target_account_id"),
+			).
+			Where("? = ?", bun.Ident("account.domain"), domain)
+	}
+
+	q = whereBetween(q, column, start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetTagActivity(ctx context.Context, tagID string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("status.language"), bun.Ident("language")).
+		ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("status.account_id"),
+		).
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID)
+	q = whereBetween(q, "status.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetAccountActivity(ctx context.Context, domain string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		ColumnExpr("? AS ?", bun.Ident("account.id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("account.created_at"), bun.Ident("created_at"))
+	q = whereAccountDomain(q, domain)
+	q = whereBetween(q, "account.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetMediaActivity(ctx context.Context, domain string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media")).
+		ColumnExpr("? AS ?", bun.Ident("media.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? + ? AS ?",
+			bun.Ident("media.file_file_size"),
+			bun.Ident("media.thumbnail_file_size"),
+			bun.Ident("value"),
+		).
+		ColumnExpr("? AS ?", bun.Ident("media.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("media.account_id"),
+		)
+	q = whereAccountDomain(q, domain)
+	q = whereBetween(q, "media.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetFollowActivity(ctx context.Context, domain string, followers bool, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error) {
+	var activity []*gtsmodel.MeasureActivity
+
+	// The remote account is the follower if we're
+	// looking for followers of local accounts, else
+	// it's the account being followed.
+	remoteColumn := "follow.target_account_id"
+	if followers {
+		remoteColumn = "follow.account_id"
+	}
+
+	q := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		ColumnExpr("? AS ?", bun.Ident(remoteColumn), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("account.domain"), bun.Ident("domain")).
+		ColumnExpr("? AS ?", bun.Ident("follow.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident(remoteColumn),
+		).
+		Where("? = ?", bun.Ident("account.domain"), domain)
+	q = whereBetween(q, "follow.created_at", start, end)
+
+	if err := q.Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (m *measureDB) GetMediaSpaceUsage(ctx context.Context) (int64, error) {
+	var attachments, emojis int64
+
+	if err := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media")).
+		ColumnExpr("COALESCE(SUM(? + ?), 0)",
+			bun.Ident("media.file_file_size"),
+			bun.Ident("media.thumbnail_file_size"),
+		).
+		Where("? = ?", bun.Ident("media.cached"), true).
+		Scan(ctx, &attachments); err != nil {
+		return 0, err
+	}
+
+	if err := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("emojis"), bun.Ident("emoji")).
+		ColumnExpr("COALESCE(SUM(? + ?), 0)",
+			bun.Ident("emoji.image_file_size"),
+			bun.Ident("emoji.image_static_file_size"),
+		).
+		Where("? = ?", bun.Ident("emoji.cached"), true).
+		Scan(ctx, &emojis); err != nil {
+		return 0, err
+	}
+
+	return attachments + emojis, nil
+}
+
+func (m *measureDB) GetDatabaseSpaceUsage(ctx context.Context) (int64, error) {
+	var size int64
+
+	var q *bun.RawQuery
+	switch m.db.Dialect().Name() {
+	case dialect.PG:
+		q = m.db.NewRaw("SELECT pg_database_size(current_database())")
+	case dialect.SQLite:
+		q = m.db.NewRaw("SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()")
+	case dialect.MySQL:
+		q = m.db.NewRaw("SELECT COALESCE(SUM(data_length + index_length), 0) FROM information_schema.tables WHERE table_schema = DATABASE()")
+	default:
+		log.Panic(ctx, "db dialect was neither pg, sqlite nor mysql")
+	}
+
+	if err := q.Scan(ctx, &size); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
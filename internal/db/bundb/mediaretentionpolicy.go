@@ -0,0 +1,249 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+func (m *mediaDB) GetMediaRetentionPolicyByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.MediaRetentionPolicy, error) {
+	policy := new(gtsmodel.MediaRetentionPolicy)
+
+	if err := m.db.
+		NewSelect().
+		Model(policy).
+		Where("? = ?", bun.Ident("media_retention_policy.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// No need to fully populate.
+		return policy, nil
+	}
+
+	if policy.CreatedByAccount == nil {
+		// Not set, fetch from database.
+		var err error
+		policy.CreatedByAccount, err = m.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			policy.CreatedByAccountID,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error populating created by account: %w", err)
+		}
+	}
+
+	return policy, nil
+}
+
+func (m *mediaDB) GetMediaRetentionPolicies(
+	ctx context.Context,
+	domain string,
+	page *paging.Page,
+) (
+	[]*gtsmodel.MediaRetentionPolicy,
+	error,
+) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		policyIDs = make([]string, 0, limit)
+	)
+
+	q := m.db.
+		NewSelect().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("media_retention_policies"),
+			bun.Ident("media_retention_policy"),
+		).
+		// Select only IDs from table
+		Column("media_retention_policy.id")
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("media_retention_policy.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("media_retention_policy.id"),
+			minID,
+		)
+	}
+
+	// Return only items
+	// with given domain.
+	if domain != "" {
+		var err error
+
+		// Normalize domain as punycode.
+		domain, err = util.Punify(domain)
+		if err != nil {
+			return nil, gtserror.Newf("error punifying domain %s: %w", domain, err)
+		}
+
+		q = q.Where(
+			"? = ?",
+			bun.Ident("media_retention_policy.domain"),
+			domain,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("media_retention_policy.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("media_retention_policy.id"),
+		)
+	}
+
+	if err := q.Scan(ctx, &policyIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(policyIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(policyIDs)
+	}
+
+	// Allocate return slice (will be at most len policyIDs).
+	policies := make([]*gtsmodel.MediaRetentionPolicy, 0, len(policyIDs))
+	for _, id := range policyIDs {
+		policy, err := m.GetMediaRetentionPolicyByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting media retention policy %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (m *mediaDB) PutMediaRetentionPolicy(
+	ctx context.Context,
+	policy *gtsmodel.MediaRetentionPolicy,
+) error {
+	// Normalize the domain as punycode
+	var err error
+	policy.Domain, err = util.Punify(policy.Domain)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.db.
+		NewInsert().
+		Model(policy).
+		Exec(ctx)
+	return err
+}
+
+func (m *mediaDB) UpdateMediaRetentionPolicy(
+	ctx context.Context,
+	policy *gtsmodel.MediaRetentionPolicy,
+	columns ...string,
+) error {
+	policy.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := m.db.
+		NewUpdate().
+		Model(policy).
+		Column(columns...).
+		Where("? = ?", bun.Ident("media_retention_policy.id"), policy.ID).
+		Exec(ctx)
+	return err
+}
+
+func (m *mediaDB) DeleteMediaRetentionPolicy(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := m.db.
+		NewDelete().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("media_retention_policies"),
+			bun.Ident("media_retention_policy"),
+		).
+		Where(
+			"? = ?",
+			bun.Ident("media_retention_policy.id"),
+			id,
+		).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	return nil
+}
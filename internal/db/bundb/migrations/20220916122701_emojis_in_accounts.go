@@ -34,10 +34,10 @@ func init() {
 			switch tx.Dialect().Name() {
 			case dialect.PG:
 				q = q.ColumnExpr("? VARCHAR[]", bun.Ident("emojis"))
-			case dialect.SQLite:
+			case dialect.SQLite, dialect.MySQL:
 				q = q.ColumnExpr("? VARCHAR", bun.Ident("emojis"))
 			default:
-				log.Panic(ctx, "db dialect was neither pg nor sqlite")
+				log.Panic(ctx, "db dialect was neither pg, sqlite nor mysql")
 			}
 
 			if _, err := q.Exec(ctx); err != nil {
@@ -43,17 +43,17 @@ func init() {
 				Table("accounts").
 				Where("? IS NOT NULL", bun.Ident("status_format"))
 
-			// We need to switch here because Postgres and SQLite
-			// have different syntaxes for concatenation.
+			// We need to switch here because Postgres / MySQL and
+			// SQLite have different syntaxes for concatenation.
 			switch tx.Dialect().Name() {
 			case dialect.SQLite:
 				q = q.
 					Set("? = ? || ?", bun.Ident("status_content_type"), "text/", bun.Ident("status_format"))
-			case dialect.PG:
+			case dialect.PG, dialect.MySQL:
 				q = q.
 					Set("? = CONCAT(?, ?)", bun.Ident("status_content_type"), "text/", bun.Ident("status_format"))
 			default:
-				panic("db conn was neither pg not sqlite")
+				panic("db conn was neither pg, sqlite nor mysql")
 			}
 
 			if _, err := q.Exec(ctx); err != nil {
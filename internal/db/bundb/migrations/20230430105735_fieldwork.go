@@ -29,12 +29,12 @@ func init() {
 	up := func(ctx context.Context, db *bun.DB) error {
 		var err error
 		switch db.Dialect().Name() {
-		case dialect.SQLite:
+		case dialect.SQLite, dialect.MySQL:
 			_, err = db.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN ? VARCHAR", bun.Ident("accounts"), bun.Ident("fields_raw"))
 		case dialect.PG:
 			_, err = db.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN ? JSONB", bun.Ident("accounts"), bun.Ident("fields_raw"))
 		default:
-			panic("db conn was neither pg not sqlite")
+			panic("db conn was neither pg, sqlite nor mysql")
 		}
 
 		if err != nil && !(strings.Contains(err.Error(), "already exists") || strings.Contains(err.Error(), "duplicate column name") || strings.Contains(err.Error(), "SQLSTATE 42701")) {
@@ -27,7 +27,7 @@
 
 func init() {
 	up := func(ctx context.Context, db *bun.DB) error {
-		if db.Dialect().Name() == dialect.SQLite { // sqlite does not have an array type
+		if d := db.Dialect().Name(); d == dialect.SQLite || d == dialect.MySQL { // sqlite and mysql do not have an array type
 			_, err := db.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN ? VARCHAR", bun.Ident("reports"), bun.Ident("rules"))
 			if err != nil && !(strings.Contains(err.Error(), "already exists") || strings.Contains(err.Error(), "duplicate column name") || strings.Contains(err.Error(), "SQLSTATE 42701")) {
 				return err
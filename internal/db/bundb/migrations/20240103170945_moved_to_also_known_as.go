@@ -52,7 +52,7 @@ func init() {
 			}
 
 			switch tx.Dialect().Name() {
-			case dialect.SQLite:
+			case dialect.SQLite, dialect.MySQL:
 				if _, err := tx.
 					NewAddColumn().
 					Table("accounts").
@@ -69,7 +69,7 @@ func init() {
 					return err
 				}
 			default:
-				panic("db conn was neither pg not sqlite")
+				panic("db conn was neither pg, sqlite nor mysql")
 			}
 
 			return nil
@@ -34,8 +34,11 @@ func init() {
 			expression = "(mentions IS NULL OR CARDINALITY(mentions) = 0)"
 		case dialect.SQLite:
 			expression = "(mentions IS NULL OR json_array_length(mentions) = 0)"
+		case dialect.MySQL:
+			// MariaDB doesn't support indexes on
+			// expressions, so just leave it out.
 		default:
-			panic("db conn was neither pg not sqlite")
+			panic("db conn was neither pg, sqlite nor mysql")
 		}
 
 		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
@@ -43,7 +46,7 @@ func init() {
 
 			// Add new index for viewing statuses created
 			// by account, which includes mentions in the index.
-			if _, err := tx.
+			q := tx.
 				NewCreateIndex().
 				Model((*gtsmodel.Status)(nil)).
 				Index("statuses_account_view_idx").
@@ -51,8 +54,11 @@ func init() {
 					"account_id",
 					"in_reply_to_account_id",
 					"in_reply_to_uri",
-				).
-				ColumnExpr(expression).
+				)
+			if expression != "" {
+				q = q.ColumnExpr(expression)
+			}
+			if _, err := q.
 				ColumnExpr("id DESC").
 				IfNotExists().
 				Exec(ctx); err != nil {
@@ -27,6 +27,12 @@
 
 func init() {
 	up := func(ctx context.Context, db *bun.DB) error {
+		if db.Dialect().Name() == dialect.MySQL {
+			// MariaDB doesn't support indexes on
+			// expressions, so there's nothing to do.
+			return nil
+		}
+
 		log.Info(ctx, "reindexing accounts (accounts_paging_idx); this may take a few minutes, please don't interrupt this migration!")
 
 		q := db.NewCreateIndex().
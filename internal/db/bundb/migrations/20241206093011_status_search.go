@@ -0,0 +1,172 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// statusSearchBackfillTags matches HTML tags in status content.
+var statusSearchBackfillTags = regexp.MustCompile(`<[^>]*>`)
+
+// statusSearchBackfillText returns the given content warning and
+// HTML content as plaintext for the search index. This is a frozen,
+// simplified copy of text.SanitizeToSearchText, so that this
+// migration doesn't change if the sanitizer does. Tags are replaced
+// with spaces so words either side of them don't get run together.
+func statusSearchBackfillText(contentWarning string, content string) string {
+	content = statusSearchBackfillTags.ReplaceAllString(content, " ")
+	content = html.UnescapeString(content)
+	return strings.TrimSpace(contentWarning + "\n" + content)
+}
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Create the status search index table.
+			switch tx.Dialect().Name() {
+
+			case dialect.SQLite:
+				if _, err := tx.ExecContext(ctx,
+					"CREATE VIRTUAL TABLE IF NOT EXISTS ? USING fts5(? UNINDEXED, ?, tokenize = 'unicode61 remove_diacritics 2')",
+					bun.Ident("status_search"), bun.Ident("status_id"), bun.Ident("text"),
+				); err != nil {
+					return err
+				}
+
+			case dialect.PG:
+				if _, err := tx.ExecContext(ctx,
+					"CREATE TABLE IF NOT EXISTS ? (? CHAR(26) PRIMARY KEY, ? TEXT NOT NULL)",
+					bun.Ident("status_search"), bun.Ident("status_id"), bun.Ident("text"),
+				); err != nil {
+					return err
+				}
+
+				if _, err := tx.ExecContext(ctx,
+					"CREATE INDEX IF NOT EXISTS ? ON ? USING GIN (to_tsvector('simple', ?))",
+					bun.Ident("status_search_text_idx"), bun.Ident("status_search"), bun.Ident("text"),
+				); err != nil {
+					return err
+				}
+
+			case dialect.MySQL:
+				if _, err := tx.ExecContext(ctx,
+					"CREATE TABLE IF NOT EXISTS ? (? CHAR(26) PRIMARY KEY, ? TEXT NOT NULL, FULLTEXT INDEX ? (?))",
+					bun.Ident("status_search"), bun.Ident("status_id"), bun.Ident("text"),
+					bun.Ident("status_search_text_idx"), bun.Ident("text"),
+				); err != nil {
+					return err
+				}
+			}
+
+			// Backfill the index from existing statuses. Search
+			// only ever returns statuses that are local, or that a
+			// local account has faved, bookmarked or been mentioned
+			// in, so there's no point indexing anything else here.
+			// Other statuses are indexed as they're put or updated.
+			type status struct {
+				bun.BaseModel `bun:"table:statuses"`
+
+				ID             string `bun:"id"`
+				Content        string `bun:"content,nullzero"`
+				ContentWarning string `bun:"content_warning,nullzero"`
+			}
+
+			type statusSearchEntry struct {
+				bun.BaseModel `bun:"table:status_search"`
+
+				StatusID string `bun:"status_id"`
+				Text     string `bun:"text"`
+			}
+
+			var (
+				maxID string
+				total int
+			)
+
+			for {
+				var statuses []*status
+				q := tx.
+					NewSelect().
+					Model(&statuses).
+					Column("id", "content", "content_warning").
+					Where("? IS NULL", bun.Ident("boost_of_id")).
+					WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+						return q.
+							Where("? = ?", bun.Ident("local"), true).
+							WhereOr("? IN (SELECT ? FROM ?)", bun.Ident("id"), bun.Ident("status_id"), bun.Ident("status_faves")).
+							WhereOr("? IN (SELECT ? FROM ?)", bun.Ident("id"), bun.Ident("status_id"), bun.Ident("status_bookmarks")).
+							WhereOr("? IN (SELECT ? FROM ?)", bun.Ident("id"), bun.Ident("status_id"), bun.Ident("mentions"))
+					}).
+					Order("id DESC").
+					Limit(500)
+
+				if maxID != "" {
+					q = q.Where("? < ?", bun.Ident("id"), maxID)
+				}
+
+				if err := q.Scan(ctx); err != nil {
+					return err
+				}
+
+				if len(statuses) == 0 {
+					break
+				}
+
+				entries := make([]*statusSearchEntry, len(statuses))
+				for i, s := range statuses {
+					entries[i] = &statusSearchEntry{
+						StatusID: s.ID,
+						Text:     statusSearchBackfillText(s.ContentWarning, s.Content),
+					}
+				}
+
+				// Insert the whole page at once.
+				if _, err := tx.
+					NewInsert().
+					Model(&entries).
+					Exec(ctx); err != nil {
+					return err
+				}
+
+				total += len(statuses)
+				maxID = statuses[len(statuses)-1].ID
+				log.Infof(ctx, "indexed %d statuses for search...", total)
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
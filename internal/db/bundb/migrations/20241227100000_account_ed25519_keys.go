@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Add ed25519 key columns to
+			// accounts, if they don't exist.
+			for _, column := range []struct {
+				name  string
+				bytes bool
+			}{
+				{"ed25519_private_key", true},
+				{"ed25519_public_key", true},
+				{"ed25519_public_key_uri", false},
+			} {
+				exists, err := doesColumnExist(ctx, tx,
+					"accounts", column.name,
+				)
+				if err != nil {
+					return err
+				} else if exists {
+					continue
+				}
+
+				q := tx.NewAddColumn().Table("accounts")
+
+				switch {
+				case !column.bytes:
+					q = q.ColumnExpr("? VARCHAR", bun.Ident(column.name))
+				case tx.Dialect().Name() == dialect.PG:
+					q = q.ColumnExpr("? BYTEA", bun.Ident(column.name))
+				case tx.Dialect().Name() == dialect.SQLite,
+					tx.Dialect().Name() == dialect.MySQL:
+					q = q.ColumnExpr("? BLOB", bun.Ident(column.name))
+				default:
+					log.Panic(ctx, "db dialect was neither pg, sqlite nor mysql")
+				}
+
+				if _, err := q.Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Select all local accounts
+			// that don't have a key yet.
+			var accounts []struct {
+				ID  string `bun:"id"`
+				URI string `bun:"uri"`
+			}
+			if err := tx.NewSelect().
+				Table("accounts").
+				Column("id", "uri").
+				Where("? IS NULL", bun.Ident("domain")).
+				Where("? IS NULL", bun.Ident("ed25519_private_key")).
+				Scan(ctx, &accounts); err != nil {
+				return err
+			}
+
+			if len(accounts) != 0 {
+				log.Infof(ctx, "generating ed25519 keys for %d local accounts", len(accounts))
+			}
+
+			for _, account := range accounts {
+				pub, priv, err := ed25519.GenerateKey(rand.Reader)
+				if err != nil {
+					return err
+				}
+
+				if _, err := tx.NewUpdate().
+					Table("accounts").
+					Set("? = ?", bun.Ident("ed25519_private_key"), []byte(priv)).
+					Set("? = ?", bun.Ident("ed25519_public_key"), []byte(pub)).
+					Set("? = ?", bun.Ident("ed25519_public_key_uri"), account.URI+"#ed25519-key").
+					Where("? = ?", bun.Ident("id"), account.ID).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
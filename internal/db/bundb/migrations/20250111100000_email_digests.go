@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, column := range []struct {
+				name string
+				expr string
+			}{
+				{name: "email_digest_days", expr: "? INTEGER NOT NULL DEFAULT 0"},
+				{name: "email_digest_follows", expr: "? BOOLEAN NOT NULL DEFAULT true"},
+				{name: "email_digest_mentions", expr: "? BOOLEAN NOT NULL DEFAULT true"},
+				{name: "email_digest_follow_requests", expr: "? BOOLEAN NOT NULL DEFAULT true"},
+				{name: "email_digest_sent_at", expr: "? TIMESTAMPTZ"},
+			} {
+				// If column already exists we don't need to do anything.
+				exists, err := doesColumnExist(ctx, tx,
+					"account_settings", column.name,
+				)
+
+				if err != nil {
+					// Real error.
+					return err
+				} else if exists {
+					// Nothing to do.
+					continue
+				}
+
+				// Create the new column.
+				if _, err := tx.NewAddColumn().
+					Table("account_settings").
+					ColumnExpr(column.expr, bun.Ident(column.name)).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Index used when looking for
+			// accounts with digests enabled.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("account_settings").
+				Index("account_settings_email_digest_days_idx").
+				Column("email_digest_days").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.
+				NewCreateTable().
+				Model((*gtsmodel.NotificationRequest)(nil)).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index used when listing
+			// an account's requests.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("notification_requests").
+				Index("notification_requests_account_id_idx").
+				Column("account_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			for _, column := range []struct {
+				table string
+				name  string
+				expr  string
+			}{
+				{table: "account_settings", name: "notification_policy_not_following", expr: "? SMALLINT NOT NULL DEFAULT 1"},
+				{table: "account_settings", name: "notification_policy_not_followers", expr: "? SMALLINT NOT NULL DEFAULT 1"},
+				{table: "account_settings", name: "notification_policy_new_accounts", expr: "? SMALLINT NOT NULL DEFAULT 1"},
+				{table: "account_settings", name: "notification_policy_private_mentions", expr: "? SMALLINT NOT NULL DEFAULT 1"},
+				{table: "account_settings", name: "notification_policy_limited_accounts", expr: "? SMALLINT NOT NULL DEFAULT 1"},
+				{table: "notifications", name: "filtered", expr: "? BOOLEAN NOT NULL DEFAULT false"},
+			} {
+				// If column already exists we don't need to do anything.
+				exists, err := doesColumnExist(ctx, tx,
+					column.table, column.name,
+				)
+
+				if err != nil {
+					// Real error.
+					return err
+				} else if exists {
+					// Nothing to do.
+					continue
+				}
+
+				// Create the new column.
+				if _, err := tx.NewAddColumn().
+					Table(column.table).
+					ColumnExpr(column.expr, bun.Ident(column.name)).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
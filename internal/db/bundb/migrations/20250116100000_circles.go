@@ -0,0 +1,95 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Create new tables.
+			for _, model := range []interface{}{
+				&gtsmodel.Circle{},
+				&gtsmodel.CircleMember{},
+			} {
+				if _, err := tx.
+					NewCreateTable().
+					Model(model).
+					IfNotExists().
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Index used when listing
+			// the members of a circle.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("circle_members").
+				Index("circle_members_circle_id_idx").
+				Column("circle_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index used when removing
+			// members by their follow ID.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("circle_members").
+				Index("circle_members_follow_id_idx").
+				Column("follow_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Add circle ID column to statuses,
+			// if it doesn't exist already.
+			exists, err := doesColumnExist(ctx, tx, "statuses", "circle_id")
+			if err != nil {
+				return err
+			} else if exists {
+				return nil
+			}
+
+			_, err = tx.
+				NewAddColumn().
+				Table("statuses").
+				ColumnExpr("? CHAR(26)", bun.Ident("circle_id")).
+				Exec(ctx)
+			return err
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
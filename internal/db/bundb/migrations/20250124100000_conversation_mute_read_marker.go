@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, column := range []struct {
+				name string
+				expr string
+			}{
+				{name: "last_read_status_id", expr: "? CHAR(26)"},
+				{name: "muted", expr: "? BOOLEAN DEFAULT false"},
+			} {
+				// If column already exists we don't need to do anything.
+				exists, err := doesColumnExist(ctx, tx,
+					"conversations", column.name,
+				)
+
+				if err != nil {
+					// Real error.
+					return err
+				} else if exists {
+					// Nothing to do.
+					continue
+				}
+
+				// Create the new column.
+				if _, err := tx.NewAddColumn().
+					Table("conversations").
+					ColumnExpr(column.expr, bun.Ident(column.name)).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Conversations that are already read have
+			// been read up to and including their last status.
+			if _, err := tx.NewUpdate().
+				Table("conversations").
+				Set("? = ?", bun.Ident("last_read_status_id"), bun.Ident("last_status_id")).
+				Where("? = ?", bun.Ident("read"), true).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
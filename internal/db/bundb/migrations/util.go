@@ -24,7 +24,7 @@
 	"github.com/uptrace/bun/dialect"
 )
 
-// doesColumnExist safely checks whether given column exists on table, handling both SQLite and PostgreSQL appropriately.
+// doesColumnExist safely checks whether given column exists on table, handling SQLite, PostgreSQL and MySQL appropriately.
 func doesColumnExist(ctx context.Context, tx bun.Tx, table, col string) (bool, error) {
 	var n int
 	var err error
@@ -33,6 +33,8 @@ func doesColumnExist(ctx context.Context, tx bun.Tx, table, col string) (bool, e
 		err = tx.NewRaw("SELECT COUNT(*) FROM pragma_table_info(?) WHERE name=?", table, col).Scan(ctx, &n)
 	case dialect.PG:
 		err = tx.NewRaw("SELECT COUNT(*) FROM information_schema.columns WHERE table_name=? and column_name=?", table, col).Scan(ctx, &n)
+	case dialect.MySQL:
+		err = tx.NewRaw("SELECT COUNT(*) FROM information_schema.columns WHERE table_schema=DATABASE() and table_name=? and column_name=?", table, col).Scan(ctx, &n)
 	default:
 		panic("unexpected dialect")
 	}
@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"slices"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -235,6 +236,10 @@ func (n *notificationDB) GetAccountNotifications(
 	// Return only notifs for this account.
 	q = q.Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
 
+	// Don't return notifs held back
+	// by the account's notification policy.
+	q = q.Where("? = ?", bun.Ident("notification.filtered"), false)
+
 	if limit > 0 {
 		q = q.Limit(limit)
 	}
@@ -272,6 +277,47 @@ func (n *notificationDB) PutNotification(ctx context.Context, notif *gtsmodel.No
 	})
 }
 
+func (n *notificationDB) UpdateNotification(ctx context.Context, notif *gtsmodel.Notification, columns ...string) error {
+	notif.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return n.state.Caches.DB.Notification.Store(notif, func() error {
+		_, err := n.db.
+			NewUpdate().
+			Model(notif).
+			Where("? = ?", bun.Ident("notification.id"), notif.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (n *notificationDB) GetFilteredNotificationIDs(ctx context.Context, targetAccountID string, originAccountID string) ([]string, error) {
+	var notifIDs []string
+
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Column("notification.id").
+		Where("? = ?", bun.Ident("notification.target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("notification.filtered"), true).
+		OrderExpr("? DESC", bun.Ident("notification.id"))
+
+	if originAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("notification.origin_account_id"), originAccountID)
+	}
+
+	if err := q.Scan(ctx, &notifIDs); err != nil {
+		return nil, err
+	}
+
+	return notifIDs, nil
+}
+
 func (n *notificationDB) DeleteNotificationByID(ctx context.Context, id string) error {
 	// Delete notif from DB.
 	if _, err := n.db.
@@ -0,0 +1,266 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type notificationRequestDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (n *notificationRequestDB) GetNotificationRequestByID(ctx context.Context, id string) (*gtsmodel.NotificationRequest, error) {
+	return n.getNotificationRequest(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("notification_request.id"), id)
+	})
+}
+
+func (n *notificationRequestDB) GetNotificationRequest(ctx context.Context, accountID string, originAccountID string) (*gtsmodel.NotificationRequest, error) {
+	return n.getNotificationRequest(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("notification_request.account_id"), accountID).
+			Where("? = ?", bun.Ident("notification_request.origin_account_id"), originAccountID)
+	})
+}
+
+func (n *notificationRequestDB) getNotificationRequest(
+	ctx context.Context,
+	where func(*bun.SelectQuery) *bun.SelectQuery,
+) (*gtsmodel.NotificationRequest, error) {
+	req := new(gtsmodel.NotificationRequest)
+
+	q := n.db.
+		NewSelect().
+		Model(req)
+
+	if err := where(q).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return req, nil
+	}
+
+	if err := n.PopulateNotificationRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (n *notificationRequestDB) GetAccountNotificationRequests(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.NotificationRequest, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		reqs = make([]*gtsmodel.NotificationRequest, 0, limit)
+	)
+
+	q := n.db.
+		NewSelect().
+		Model(&reqs).
+		Where("? = ?", bun.Ident("notification_request.account_id"), accountID).
+		Where("? IS NULL", bun.Ident("notification_request.accepted_at"))
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("notification_request.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("notification_request.id"),
+			minID,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("notification_request.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("notification_request.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(reqs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(reqs)
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return reqs, nil
+	}
+
+	for _, req := range reqs {
+		if err := n.PopulateNotificationRequest(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return reqs, nil
+}
+
+func (n *notificationRequestDB) CountAccountNotificationRequests(ctx context.Context, accountID string) (int, error) {
+	return n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notification_requests"), bun.Ident("notification_request")).
+		Where("? = ?", bun.Ident("notification_request.account_id"), accountID).
+		Where("? IS NULL", bun.Ident("notification_request.accepted_at")).
+		Count(ctx)
+}
+
+func (n *notificationRequestDB) PopulateNotificationRequest(ctx context.Context, req *gtsmodel.NotificationRequest) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(3)
+	)
+
+	if req.Account == nil {
+		// Account is not set, fetch from the database.
+		req.Account, err = n.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			req.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating notification request account: %w", err)
+		}
+	}
+
+	if req.OriginAccount == nil {
+		// Origin account is not set, fetch from the database.
+		req.OriginAccount, err = n.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			req.OriginAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating notification request origin account: %w", err)
+		}
+	}
+
+	if req.LastStatusID != "" && req.LastStatus == nil {
+		// Last status is not set, fetch from the database.
+		req.LastStatus, err = n.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			req.LastStatusID,
+		)
+
+		// The status may since have been deleted,
+		// which is fine, it's just not shown then.
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating notification request last status: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (n *notificationRequestDB) PutNotificationRequest(ctx context.Context, req *gtsmodel.NotificationRequest) error {
+	_, err := n.db.
+		NewInsert().
+		Model(req).
+		Exec(ctx)
+	return err
+}
+
+func (n *notificationRequestDB) UpdateNotificationRequest(ctx context.Context, req *gtsmodel.NotificationRequest, columns ...string) error {
+	req.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := n.db.
+		NewUpdate().
+		Model(req).
+		Where("? = ?", bun.Ident("notification_request.id"), req.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (n *notificationRequestDB) DeleteNotificationRequestByID(ctx context.Context, id string) error {
+	_, err := n.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("notification_requests"), bun.Ident("notification_request")).
+		Where("? = ?", bun.Ident("notification_request.id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (n *notificationRequestDB) DeleteNotificationRequests(ctx context.Context, accountID string) error {
+	_, err := n.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("notification_requests"), bun.Ident("notification_request")).
+		WhereOr("? = ?", bun.Ident("notification_request.account_id"), accountID).
+		WhereOr("? = ?", bun.Ident("notification_request.origin_account_id"), accountID).
+		Exec(ctx)
+	return err
+}
@@ -76,14 +76,22 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	}
 
 	// check if target has follow requested requesting
-	rel.RequestedBy, err = r.IsFollowRequested(ctx,
+	followRequest, err := r.GetFollowRequest(
+		gtscontext.SetBarebones(ctx),
 		targetAccount,
 		requestingAccount,
 	)
-	if err != nil {
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return nil, gtserror.Newf("error checking requestedBy: %w", err)
 	}
 
+	if followRequest != nil {
+		// follow request exists, so
+		// include any note attached.
+		rel.RequestedBy = true
+		rel.RequestedByNote = followRequest.Note
+	}
+
 	// check if the requesting account is blocking the target account
 	rel.Blocking, err = r.IsBlocked(ctx, requestingAccount, targetAccount)
 	if err != nil {
@@ -154,6 +162,34 @@ func (r *relationshipDB) GetAccountLocalFollowers(ctx context.Context, accountID
 	return r.GetFollowsByIDs(ctx, followerIDs)
 }
 
+func (r *relationshipDB) GetFamiliarFollowers(ctx context.Context, accountID string, targetAccountIDs []string) ([]*gtsmodel.Follow, error) {
+	if len(targetAccountIDs) == 0 {
+		return nil, nil
+	}
+
+	var followIDs []string
+
+	// Select follows of the target accounts,
+	// where the follower is followed by accountID.
+	if err := r.db.NewSelect().
+		Table("follows").
+		Column("id").
+		Where("? IN (?)", bun.Ident("target_account_id"), bun.In(targetAccountIDs)).
+		Where("? IN (?)",
+			bun.Ident("account_id"),
+			r.db.NewSelect().
+				Table("follows").
+				Column("target_account_id").
+				Where("? = ?", bun.Ident("account_id"), accountID),
+		).
+		OrderExpr("? DESC", bun.Ident("created_at")).
+		Scan(ctx, &followIDs); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	return r.GetFollowsByIDs(ctx, followIDs)
+}
+
 func (r *relationshipDB) GetAccountFollowRequests(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.FollowRequest, error) {
 	followReqIDs, err := r.GetAccountFollowRequestIDs(ctx, accountID, page)
 	if err != nil {
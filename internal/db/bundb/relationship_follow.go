@@ -280,6 +280,11 @@ func (r *relationshipDB) DeleteFollow(
 		return gtserror.Newf("error deleting list entries: %w", err)
 	}
 
+	// Delete every circle member that was created from this follow ID.
+	if err := r.state.DB.DeleteCircleMembersByFollows(ctx, deleted.ID); err != nil {
+		return gtserror.Newf("error deleting circle members: %w", err)
+	}
+
 	return nil
 }
 
@@ -313,6 +318,11 @@ func (r *relationshipDB) DeleteFollowByID(ctx context.Context, id string) error
 		return gtserror.Newf("error deleting list entries: %w", err)
 	}
 
+	// Delete every circle member that was created from this follow ID.
+	if err := r.state.DB.DeleteCircleMembersByFollows(ctx, id); err != nil {
+		return gtserror.Newf("error deleting circle members: %w", err)
+	}
+
 	return nil
 }
 
@@ -346,6 +356,11 @@ func (r *relationshipDB) DeleteFollowByURI(ctx context.Context, uri string) erro
 		return gtserror.Newf("error deleting list entries: %w", err)
 	}
 
+	// Delete every circle member that was created from this follow ID.
+	if err := r.state.DB.DeleteCircleMembersByFollows(ctx, deleted.ID); err != nil {
+		return gtserror.Newf("error deleting circle members: %w", err)
+	}
+
 	return nil
 }
 
@@ -385,6 +400,11 @@ func (r *relationshipDB) DeleteAccountFollows(ctx context.Context, accountID str
 		return gtserror.Newf("error deleting list entries: %w", err)
 	}
 
+	// Delete every circle member that was created from any of these follow IDs.
+	if err := r.state.DB.DeleteCircleMembersByFollows(ctx, followIDs...); err != nil {
+		return gtserror.Newf("error deleting circle members: %w", err)
+	}
+
 	// Invalidate all account's incoming / outoing follows.
 	r.state.Caches.DB.Follow.Invalidate("AccountID", accountID)
 	r.state.Caches.DB.Follow.Invalidate("TargetAccountID", accountID)
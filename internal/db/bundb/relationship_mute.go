@@ -289,10 +289,10 @@ func (r *relationshipDB) getAccountMuteIDs(ctx context.Context, accountID string
 				switch r.db.Dialect().Name() {
 				case dialect.SQLite:
 					notYetExpiredSQL = "? > DATE('now')"
-				case dialect.PG:
+				case dialect.PG, dialect.MySQL:
 					notYetExpiredSQL = "? > NOW()"
 				default:
-					log.Panicf(nil, "db conn %s was neither pg nor sqlite", r.db)
+					log.Panicf(nil, "db conn %s was neither pg, sqlite nor mysql", r.db)
 				}
 				return q.
 					Where("? IS NULL", bun.Ident("expires_at")).
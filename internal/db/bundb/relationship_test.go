@@ -817,6 +817,26 @@ func (suite *RelationshipTestSuite) TestGetAccountFollowers() {
 	suite.Len(follows, 2)
 }
 
+func (suite *RelationshipTestSuite) TestGetFamiliarFollowers() {
+	requester := suite.testAccounts["admin_account"]
+
+	// Admin follows zork, who follows local_account_2,
+	// so zork is a familiar follower of local_account_2.
+	// Zork's other follower is local_account_2, whom
+	// admin doesn't follow, so there's nothing there.
+	follows, err := suite.db.GetFamiliarFollowers(
+		context.Background(),
+		requester.ID,
+		[]string{
+			suite.testAccounts["local_account_2"].ID,
+			suite.testAccounts["local_account_1"].ID,
+		},
+	)
+	suite.NoError(err)
+	suite.Len(follows, 1)
+	suite.Equal(suite.testFollows["local_account_1_local_account_2"].ID, follows[0].ID)
+}
+
 func (suite *RelationshipTestSuite) TestUnfollowExisting() {
 	originAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
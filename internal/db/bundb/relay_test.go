@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type RelayTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *RelayTestSuite) TestPutGetUpdateDeleteRelay() {
+	ctx := context.Background()
+
+	relay := &gtsmodel.Relay{
+		ID:                 id.NewULID(),
+		ActorURI:           "https://relay.example.org/actor",
+		InboxURI:           "https://relay.example.org/inbox",
+		FollowURI:          "http://localhost:8080/users/localhost:8080/follow/" + id.NewULID(),
+		State:              gtsmodel.RelayStatePending,
+		Enabled:            util.Ptr(true),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.state.DB.PutRelay(ctx, relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Fetch relay by ID.
+	dbRelay, err := suite.state.DB.GetRelayByID(ctx, relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(relay.ID, dbRelay.ID)
+	suite.False(dbRelay.Active())
+
+	// Mark relay accepted.
+	dbRelay.State = gtsmodel.RelayStateAccepted
+	if err := suite.state.DB.UpdateRelay(ctx, dbRelay, "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Fetch relay by actor URI.
+	dbRelay, err = suite.state.DB.GetRelayByActorURI(ctx, "https://relay.example.org/actor")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(relay.ID, dbRelay.ID)
+	suite.True(dbRelay.Active())
+
+	relays, err := suite.state.DB.GetRelays(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(relays, 1)
+
+	if err := suite.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetRelayByFollowURI(ctx, relay.FollowURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
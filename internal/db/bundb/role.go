@@ -0,0 +1,119 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type roleDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (r *roleDB) GetRoleByID(ctx context.Context, id string) (*gtsmodel.Role, error) {
+	return r.state.Caches.DB.Role.LoadOne("ID", func() (*gtsmodel.Role, error) {
+		role := new(gtsmodel.Role)
+		if err := r.db.NewSelect().
+			Model(role).
+			Where("? = ?", bun.Ident("id"), id).
+			Scan(ctx); err != nil {
+			return nil, err
+		}
+		return role, nil
+	}, id)
+}
+
+func (r *roleDB) GetRoles(ctx context.Context) ([]*gtsmodel.Role, error) {
+	var roles []*gtsmodel.Role
+	err := r.db.NewSelect().
+		Model(&roles).
+		Order("name ASC").
+		Scan(ctx)
+	return roles, err
+}
+
+func (r *roleDB) PutRole(ctx context.Context, role *gtsmodel.Role) error {
+	return r.state.Caches.DB.Role.Store(role, func() error {
+		_, err := r.db.NewInsert().
+			Model(role).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *roleDB) UpdateRole(ctx context.Context, role *gtsmodel.Role, columns ...string) error {
+	role.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return r.state.Caches.DB.Role.Store(role, func() error {
+		_, err := r.db.NewUpdate().
+			Model(role).
+			Column(columns...).
+			Where("? = ?", bun.Ident("id"), role.ID).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *roleDB) DeleteRoleByID(ctx context.Context, id string) error {
+	// Gather IDs of users with this
+	// role, to invalidate them after.
+	var userIDs []string
+	if err := r.db.NewSelect().
+		Table("users").
+		Column("id").
+		Where("? = ?", bun.Ident("role_id"), id).
+		Scan(ctx, &userIDs); err != nil {
+		return err
+	}
+
+	if err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Take the role away from its users.
+		if _, err := tx.NewUpdate().
+			Table("users").
+			Set("? = NULL", bun.Ident("role_id")).
+			Where("? = ?", bun.Ident("role_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Table("roles").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate the cached role, and
+	// any cached users that had the role.
+	r.state.Caches.DB.Role.Invalidate("ID", id)
+	r.state.Caches.DB.User.InvalidateIDs("ID", userIDs)
+	return nil
+}
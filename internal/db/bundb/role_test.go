@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type RoleTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *RoleTestSuite) TestRoleGetPutUpdateDelete() {
+	t := suite.T()
+
+	// Create new example role.
+	role := &gtsmodel.Role{
+		ID:          "01JK8QF3XQ0W8HWM9NM2QZ6BK0",
+		Name:        "Report Wrangler",
+		Permissions: gtsmodel.RolePermissionManageReports,
+		Highlighted: util.Ptr(true),
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	// Insert the example role into db.
+	if err := suite.db.PutRole(ctx, role); err != nil {
+		t.Fatalf("error inserting role: %v", err)
+	}
+
+	// Role names must be unique.
+	err := suite.db.PutRole(ctx, &gtsmodel.Role{
+		ID:          "01JK8QGB6W8Z3V2Y1F4D9E5TCA",
+		Name:        "Report Wrangler",
+		Highlighted: util.Ptr(false),
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	// Give the role to a user.
+	user := suite.testUsers["local_account_1"]
+	user.RoleID = role.ID
+	if err := suite.db.UpdateUser(ctx, user, "role_id"); err != nil {
+		t.Fatalf("error updating user: %v", err)
+	}
+
+	// Refetch the user, ensure role is populated.
+	user, err = suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("error fetching user: %v", err)
+	}
+	suite.NotNil(user.Role)
+	suite.True(user.HasPermission(gtsmodel.RolePermissionManageReports))
+	suite.False(user.HasPermission(gtsmodel.RolePermissionManageUsers))
+
+	// Update the permissions of the role.
+	role.Permissions |= gtsmodel.RolePermissionManageUsers
+	if err := suite.db.UpdateRole(ctx, role, "permissions"); err != nil {
+		t.Fatalf("error updating role: %v", err)
+	}
+
+	// Change should be visible on the user.
+	user, err = suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("error fetching user: %v", err)
+	}
+	suite.True(user.HasPermission(gtsmodel.RolePermissionManageUsers))
+
+	// Now delete the role from db.
+	if err := suite.db.DeleteRoleByID(ctx, role.ID); err != nil {
+		t.Fatalf("error deleting role: %v", err)
+	}
+
+	// Ensure we can't refetch it.
+	_, err = suite.db.GetRoleByID(ctx, role.ID)
+	if err != db.ErrNoEntries {
+		t.Fatalf("deleted role returned unexpected error: %v", err)
+	}
+
+	// The user should no longer have the role.
+	user, err = suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("error fetching user: %v", err)
+	}
+	suite.Empty(user.RoleID)
+	suite.Nil(user.Role)
+	suite.False(user.HasPermission(gtsmodel.RolePermissionManageReports))
+}
+
+func TestRoleTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleTestSuite))
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -228,8 +229,8 @@ func (s *searchDB) accountText(following bool) *bun.SelectQuery {
 		}
 	}
 
-	// SQLite and Postgres use different syntaxes for
-	// concatenation, and we also need to use a
+	// SQLite and Postgres / MySQL use different syntaxes
+	// for concatenation, and we also need to use a
 	// different number of placeholders depending on
 	// following/not following. COALESCE calls ensure
 	// that we're not trying to concatenate null values.
@@ -242,14 +243,14 @@ func (s *searchDB) accountText(following bool) *bun.SelectQuery {
 	case d == dialect.SQLite && !following:
 		query = "? || COALESCE(?, ?) AS ?"
 
-	case d == dialect.PG && following:
+	case (d == dialect.PG || d == dialect.MySQL) && following:
 		query = "CONCAT(?, COALESCE(?, ?), COALESCE(?, ?)) AS ?"
 
-	case d == dialect.PG && !following:
+	case (d == dialect.PG || d == dialect.MySQL) && !following:
 		query = "CONCAT(?, COALESCE(?, ?)) AS ?"
 
 	default:
-		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+		log.Panicf(nil, "db conn %s was neither pg, sqlite nor mysql", d)
 	}
 
 	return accountText.ColumnExpr(query, args...)
@@ -260,9 +261,12 @@ func (s *searchDB) accountText(following bool) *bun.SelectQuery {
 //	SELECT "status"."id"
 //	FROM "statuses" AS "status"
 //	WHERE ("status"."boost_of_id" IS NULL)
-//	AND (("status"."account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF') OR ("status"."in_reply_to_account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF'))
+//	AND (("status"."account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF') OR ("status"."in_reply_to_account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF')
+//	  OR ("status"."id" IN (SELECT "status_id" FROM "status_faves" WHERE ("account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF')))
+//	  OR ("status"."id" IN (SELECT "status_id" FROM "status_bookmarks" WHERE ("account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF')))
+//	  OR ("status"."id" IN (SELECT "status_id" FROM "mentions" WHERE ("target_account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF'))))
 //	AND ("status"."id" < 'ZZZZZZZZZZZZZZZZZZZZZZZZZZ')
-//	AND ((SELECT "status"."content" || COALESCE("status"."content_warning", '') AS "status_text") LIKE '%hello%' ESCAPE '\')
+//	AND ("status"."id" IN (SELECT "status_id" FROM "status_search" WHERE ("status_search" MATCH '"hello"*')))
 //	ORDER BY "status"."id" DESC LIMIT 10
 func (s *searchDB) SearchForStatuses(
 	ctx context.Context,
@@ -279,6 +283,13 @@ func (s *searchDB) SearchForStatuses(
 		limit = 0
 	}
 
+	// Split query into terms
+	// we can match on.
+	terms := statusSearchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
 	// Make educated guess for slice size
 	var (
 		statusIDs   = make([]string, 0, limit)
@@ -291,14 +302,11 @@ func (s *searchDB) SearchForStatuses(
 		// Select only IDs from table
 		Column("status.id").
 		// Ignore boosts.
-		Where("? IS NULL", bun.Ident("status.boost_of_id")).
-		// Select only statuses created by
-		// accountID or replying to accountID.
-		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
-			return q.
-				Where("? = ?", bun.Ident("status.account_id"), requestingAccountID).
-				WhereOr("? = ?", bun.Ident("status.in_reply_to_account_id"), requestingAccountID)
-		})
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
+
+	// Select only statuses searchable by requester.
+	q = s.whereStatusSearchableBy(q, requestingAccountID)
+
 	if fromAccountID != "" {
 		q = q.Where("? = ?", bun.Ident("status.account_id"), fromAccountID)
 	}
@@ -317,12 +325,9 @@ func (s *searchDB) SearchForStatuses(
 		frontToBack = false
 	}
 
-	// Select status text as subquery.
-	statusTextSubq := s.statusText()
-
-	// Search using LIKE for matches of query
-	// string within statusText subquery.
-	q = whereLike(q, statusTextSubq, query)
+	// Search the full-text index
+	// for matches of query terms.
+	q = q.Where("? IN (?)", bun.Ident("status.id"), s.statusSearchMatch(terms))
 
 	if limit > 0 {
 		// Limit amount of statuses returned.
@@ -370,32 +375,87 @@ func (s *searchDB) SearchForStatuses(
 	return statuses, nil
 }
 
-// statusText returns a subquery that selects a concatenation
-// of status content and content warning as "status_text".
-func (s *searchDB) statusText() *bun.SelectQuery {
-	statusText := s.db.NewSelect()
+func (s *searchDB) GetSearchableStatusIDs(
+	ctx context.Context,
+	requestingAccountID string,
+	statusIDs []string,
+) ([]string, error) {
+	if len(statusIDs) == 0 {
+		return nil, nil
+	}
 
-	// SQLite and Postgres use different
-	// syntaxes for concatenation.
-	switch d := s.db.Dialect().Name(); d {
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? IN (?)", bun.Ident("status.id"), bun.In(statusIDs)).
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
 
-	case dialect.SQLite:
-		statusText = statusText.ColumnExpr(
-			"? || COALESCE(?, ?) AS ?",
-			bun.Ident("status.content"), bun.Ident("status.content_warning"), "",
-			bun.Ident("status_text"))
+	q = s.whereStatusSearchableBy(q, requestingAccountID)
 
-	case dialect.PG:
-		statusText = statusText.ColumnExpr(
-			"CONCAT(?, COALESCE(?, ?)) AS ?",
-			bun.Ident("status.content"), bun.Ident("status.content_warning"), "",
-			bun.Ident("status_text"))
+	searchableIDs := make([]string, 0, len(statusIDs))
+	if err := q.Scan(ctx, &searchableIDs); err != nil {
+		return nil, err
+	}
 
-	default:
-		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+	// Return in the order given by the caller.
+	ordered := make([]string, 0, len(searchableIDs))
+	for _, id := range statusIDs {
+		if slices.Contains(searchableIDs, id) {
+			ordered = append(ordered, id)
+		}
+	}
+
+	return ordered, nil
+}
+
+func (s *searchDB) GetSearchIndexableStatusIDs(
+	ctx context.Context,
+	maxID string,
+	limit int,
+) ([]string, error) {
+	if maxID == "" {
+		maxID = id.Highest
 	}
 
-	return statusText
+	statusIDs := make([]string, 0, limit)
+	if err := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? < ?", bun.Ident("status.id"), maxID).
+		Order("status.id DESC").
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	return statusIDs, nil
+}
+
+// whereStatusSearchableBy restricts the given statuses query to
+// statuses created by accountID, replying to or mentioning
+// accountID, or faved or bookmarked by accountID.
+func (s *searchDB) whereStatusSearchableBy(q *bun.SelectQuery, accountID string) *bun.SelectQuery {
+	return q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("status.account_id"), accountID).
+			WhereOr("? = ?", bun.Ident("status.in_reply_to_account_id"), accountID).
+			WhereOr("? IN (?)", bun.Ident("status.id"), s.interactedStatusIDs("status_faves", "account_id", accountID)).
+			WhereOr("? IN (?)", bun.Ident("status.id"), s.interactedStatusIDs("status_bookmarks", "account_id", accountID)).
+			WhereOr("? IN (?)", bun.Ident("status.id"), s.interactedStatusIDs("mentions", "target_account_id", accountID))
+	})
+}
+
+// interactedStatusIDs returns a subquery that selects
+// status IDs from the given table where column = accountID.
+func (s *searchDB) interactedStatusIDs(table string, column string, accountID string) *bun.SelectQuery {
+	return s.db.
+		NewSelect().
+		Table(table).
+		Column("status_id").
+		Where("? = ?", bun.Ident(column), accountID)
 }
 
 // Query example (SQLite):
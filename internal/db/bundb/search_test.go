@@ -23,6 +23,7 @@
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type SearchTestSuite struct {
@@ -107,9 +108,134 @@ func (suite *SearchTestSuite) TestSearchAccountsFossAny() {
 func (suite *SearchTestSuite) TestSearchStatuses() {
 	testAccount := suite.testAccounts["local_account_1"]
 
+	// Should get zork's own "hello everyone!",
+	// and admin's "hello world!" which zork faved.
 	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hello", "", "", "", 10, 0)
 	suite.NoError(err)
+	suite.Len(statuses, 2)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesPrefixAndCase() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "HELL EVERY", "", "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal("01F8MHAMCHF6Y650WCRSCP4WMY", statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesNoTerms() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Only punctuation / query syntax, nothing to match on.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, `"*:& !`, "", "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesIndexUpdated() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		status      = new(gtsmodel.Status)
+	)
+
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.ContentWarning = "spoilers about pufferfish"
+
+	// Not yet in the index.
+	statuses, err := suite.db.SearchForStatuses(ctx, testAccount.ID, "pufferfish", "", "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+
+	// Update should add new content warning to index.
+	err = suite.db.UpdateStatus(ctx, status, "content_warning")
+	suite.NoError(err)
+
+	statuses, err = suite.db.SearchForStatuses(ctx, testAccount.ID, "pufferfish", "", "", "", 10, 0)
+	suite.NoError(err)
 	suite.Len(statuses, 1)
+
+	// Delete should remove it again.
+	err = suite.db.DeleteStatusByID(ctx, status.ID)
+	suite.NoError(err)
+
+	statuses, err = suite.db.SearchForStatuses(ctx, testAccount.ID, "pufferfish", "", "", "", 10, 0)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+// fakeSearchBackend records statuses
+// indexed into / deleted from it.
+type fakeSearchBackend struct {
+	indexed []string
+	deleted []string
+}
+
+func (f *fakeSearchBackend) Init(context.Context) error  { return nil }
+func (f *fakeSearchBackend) Clear(context.Context) error { return nil }
+
+func (f *fakeSearchBackend) IndexStatuses(_ context.Context, statuses []*gtsmodel.Status) error {
+	for _, status := range statuses {
+		f.indexed = append(f.indexed, status.ID)
+	}
+	return nil
+}
+
+func (f *fakeSearchBackend) DeleteStatuses(_ context.Context, statusIDs []string) error {
+	f.deleted = append(f.deleted, statusIDs...)
+	return nil
+}
+
+func (f *fakeSearchBackend) SearchStatuses(context.Context, string, string, string, string, int) ([]string, error) {
+	return nil, nil
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesExternalIndexUpdated() {
+	var (
+		ctx     = context.Background()
+		backend = new(fakeSearchBackend)
+		status  = new(gtsmodel.Status)
+	)
+
+	suite.state.Search = backend
+	defer func() { suite.state.Search = nil }()
+
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.ContentWarning = "spoilers about pufferfish"
+
+	// Update of text should reindex the status.
+	err := suite.db.UpdateStatus(ctx, status, "content_warning")
+	suite.NoError(err)
+	suite.Equal([]string{status.ID}, backend.indexed)
+
+	// Update of other columns shouldn't.
+	err = suite.db.UpdateStatus(ctx, status, "sensitive")
+	suite.NoError(err)
+	suite.Len(backend.indexed, 1)
+
+	// Delete should remove it from the index.
+	err = suite.db.DeleteStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal([]string{status.ID}, backend.deleted)
+
+	// Put of a boost shouldn't index it,
+	// as boosts have no text of their own.
+	boost := new(gtsmodel.Status)
+	*boost = *suite.testStatuses["local_account_1_status_1"]
+	boost.ID = "01JKFJ6ZCPRW7VQVJB29EJ5NRX"
+	boost.URI = "http://localhost:8080/users/the_mighty_zork/statuses/" + boost.ID
+	boost.URL = ""
+	boost.BoostOfID = suite.testStatuses["admin_account_status_1"].ID
+	boost.BoostOfAccountID = suite.testStatuses["admin_account_status_1"].AccountID
+	boost.AttachmentIDs, boost.Attachments = nil, nil
+	boost.EmojiIDs, boost.TagIDs, boost.MentionIDs = nil, nil, nil
+	boost.ThreadID = ""
+	err = suite.db.PutStatus(ctx, boost)
+	suite.NoError(err)
+	suite.Len(backend.indexed, 1)
+	suite.Equal([]string{status.ID, boost.ID}, backend.deleted)
 }
 
 func (suite *SearchTestSuite) TestSearchStatusesFromAccount() {
@@ -118,8 +244,10 @@ func (suite *SearchTestSuite) TestSearchStatusesFromAccount() {
 
 	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hi", fromAccount.ID, "", "", 10, 0)
 	suite.NoError(err)
-	if suite.Len(statuses, 1) {
-		suite.Equal(fromAccount.ID, statuses[0].AccountID)
+	if suite.Len(statuses, 3) {
+		for _, status := range statuses {
+			suite.Equal(fromAccount.ID, status.AccountID)
+		}
 	}
 }
 
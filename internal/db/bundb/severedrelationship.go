@@ -0,0 +1,244 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type severedRelationshipDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *severedRelationshipDB) GetSeveranceEventByID(ctx context.Context, id string) (*gtsmodel.RelationshipSeveranceEvent, error) {
+	return s.getSeveranceEvent(ctx, "id", id)
+}
+
+func (s *severedRelationshipDB) GetSeveranceEventByOriginID(ctx context.Context, originID string) (*gtsmodel.RelationshipSeveranceEvent, error) {
+	return s.getSeveranceEvent(ctx, "origin_id", originID)
+}
+
+func (s *severedRelationshipDB) getSeveranceEvent(ctx context.Context, column string, value string) (*gtsmodel.RelationshipSeveranceEvent, error) {
+	event := new(gtsmodel.RelationshipSeveranceEvent)
+
+	if err := s.db.
+		NewSelect().
+		Model(event).
+		Where("? = ?", bun.Ident("relationship_severance_event."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (s *severedRelationshipDB) GetAccountSeveranceEvents(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.RelationshipSeveranceEvent, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		events = make([]*gtsmodel.RelationshipSeveranceEvent, 0, limit)
+	)
+
+	// Select only events in which
+	// the given account lost relationships.
+	eventIDs := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("severed_relationships"), bun.Ident("severed_relationship")).
+		Column("severed_relationship.event_id").
+		Where("? = ?", bun.Ident("severed_relationship.account_id"), accountID)
+
+	q := s.db.
+		NewSelect().
+		Model(&events).
+		Where("? IN (?)", bun.Ident("relationship_severance_event.id"), eventIDs)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("relationship_severance_event.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("relationship_severance_event.id"),
+			minID,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("relationship_severance_event.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("relationship_severance_event.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(events)
+	}
+
+	return events, nil
+}
+
+func (s *severedRelationshipDB) PutSeveranceEvent(ctx context.Context, event *gtsmodel.RelationshipSeveranceEvent) error {
+	_, err := s.db.
+		NewInsert().
+		Model(event).
+		Exec(ctx)
+	return err
+}
+
+func (s *severedRelationshipDB) GetSeveredRelationships(
+	ctx context.Context,
+	eventID string,
+	accountID string,
+	direction gtsmodel.SeveredDirection,
+) ([]*gtsmodel.SeveredRelationship, error) {
+	var rels []*gtsmodel.SeveredRelationship
+
+	q := s.db.
+		NewSelect().
+		Model(&rels).
+		Where("? = ?", bun.Ident("severed_relationship.event_id"), eventID).
+		Where("? = ?", bun.Ident("severed_relationship.account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("severed_relationship.id"))
+
+	// Return only items
+	// in given direction.
+	if direction != 0 {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("severed_relationship.direction"),
+			direction,
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(rels) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return rels, nil
+	}
+
+	for _, rel := range rels {
+		if err := s.PopulateSeveredRelationship(ctx, rel); err != nil {
+			return nil, err
+		}
+	}
+
+	return rels, nil
+}
+
+func (s *severedRelationshipDB) PopulateSeveredRelationship(ctx context.Context, rel *gtsmodel.SeveredRelationship) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if rel.Event == nil {
+		// Severance event is not set, fetch from the database.
+		rel.Event, err = s.GetSeveranceEventByID(ctx, rel.EventID)
+		if err != nil {
+			errs.Appendf("error populating severed relationship event: %w", err)
+		}
+	}
+
+	if rel.TargetAccount == nil {
+		// Target account is not set, fetch from the database.
+		rel.TargetAccount, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			rel.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating severed relationship target account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *severedRelationshipDB) PutSeveredRelationship(ctx context.Context, rel *gtsmodel.SeveredRelationship) error {
+	_, err := s.db.
+		NewInsert().
+		Model(rel).
+		Exec(ctx)
+	return err
+}
+
+func (s *severedRelationshipDB) DeleteSeveredRelationshipsByAccountID(ctx context.Context, accountID string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("severed_relationships"), bun.Ident("severed_relationship")).
+		Where("? = ?", bun.Ident("severed_relationship.account_id"), accountID).
+		Exec(ctx)
+	return err
+}
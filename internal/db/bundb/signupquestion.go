@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type signupQuestionDB struct {
+	db *bun.DB
+}
+
+func (s *signupQuestionDB) GetSignupQuestion(ctx context.Context, id string) (*gtsmodel.SignupQuestion, error) {
+	question := new(gtsmodel.SignupQuestion)
+	if err := s.db.NewSelect().
+		Model(question).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+func (s *signupQuestionDB) GetSignupQuestions(ctx context.Context) ([]*gtsmodel.SignupQuestion, error) {
+	var questions []*gtsmodel.SignupQuestion
+	err := s.db.NewSelect().
+		Model(&questions).
+		Order("id ASC").
+		Scan(ctx)
+	return questions, err
+}
+
+func (s *signupQuestionDB) PutSignupQuestion(ctx context.Context, question *gtsmodel.SignupQuestion) error {
+	_, err := s.db.NewInsert().
+		Model(question).
+		Exec(ctx)
+	return err
+}
+
+func (s *signupQuestionDB) UpdateSignupQuestion(ctx context.Context, question *gtsmodel.SignupQuestion, columns ...string) error {
+	question.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := s.db.NewUpdate().
+		Model(question).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), question.ID).
+		Exec(ctx)
+	return err
+}
+
+func (s *signupQuestionDB) DeleteSignupQuestion(ctx context.Context, id string) error {
+	_, err := s.db.NewDelete().
+		Table("signup_questions").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (s *signupQuestionDB) GetSignupAnswersByUserID(ctx context.Context, userID string) ([]*gtsmodel.SignupAnswer, error) {
+	var answers []*gtsmodel.SignupAnswer
+	err := s.db.NewSelect().
+		Model(&answers).
+		Where("? = ?", bun.Ident("user_id"), userID).
+		Order("question_id ASC").
+		Scan(ctx)
+	return answers, err
+}
@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type SignupQuestionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *SignupQuestionTestSuite) TestSignupQuestionGetPutUpdateDelete() {
+	t := suite.T()
+
+	// Create new example questions.
+	questions := []*gtsmodel.SignupQuestion{
+		{
+			ID:   "01JJGB6Y2S8Y5MNE8V37W1XZ9T",
+			Text: "How did you hear about this instance?",
+		},
+		{
+			ID:   "01JJGB7FWHQ7E0CJ8P2Z9XFTM1",
+			Text: "What's your favourite fish?",
+		},
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	// Insert the example questions into db.
+	for _, question := range questions {
+		if err := suite.db.PutSignupQuestion(ctx, question); err != nil {
+			t.Fatalf("error inserting sign-up question: %v", err)
+		}
+	}
+
+	// Fetch all questions, ensure they're in order.
+	all, err := suite.db.GetSignupQuestions(ctx)
+	if err != nil {
+		t.Fatalf("error fetching sign-up questions: %v", err)
+	}
+	suite.Len(all, 2)
+	suite.Equal(questions[0].ID, all[0].ID)
+	suite.Equal(questions[1].ID, all[1].ID)
+
+	// Update the text of the second question.
+	check := all[1]
+	check.Text = "What's your favourite fish, and why?"
+	if err := suite.db.UpdateSignupQuestion(ctx, check, "text"); err != nil {
+		t.Fatalf("error updating sign-up question: %v", err)
+	}
+
+	check, err = suite.db.GetSignupQuestion(ctx, check.ID)
+	if err != nil {
+		t.Fatalf("error fetching sign-up question: %v", err)
+	}
+	suite.Equal("What's your favourite fish, and why?", check.Text)
+
+	// Now delete the first question from db.
+	if err := suite.db.DeleteSignupQuestion(ctx, questions[0].ID); err != nil {
+		t.Fatalf("error deleting sign-up question: %v", err)
+	}
+
+	// Ensure we can't refetch it.
+	_, err = suite.db.GetSignupQuestion(ctx, questions[0].ID)
+	if err != db.ErrNoEntries {
+		t.Fatalf("deleted sign-up question returned unexpected error: %v", err)
+	}
+}
+
+func (suite *SignupQuestionTestSuite) TestNewSignupWithAnswers() {
+	ctx := context.Background()
+
+	user, err := suite.db.NewSignup(ctx, gtsmodel.NewSignup{
+		Username: "curious_applicant",
+		Email:    "curious_applicant@example.org",
+		Password: "a very good password indeed",
+		Answers: []*gtsmodel.SignupAnswer{
+			{
+				ID:         "01JJGBFJ6D0Q5A2FZ1S9KJ4W8N",
+				QuestionID: "01JJGB6Y2S8Y5MNE8V37W1XZ9T",
+				Question:   "How did you hear about this instance?",
+				Answer:     "A friend of mine is on here already!",
+			},
+		},
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	answers, err := suite.db.GetSignupAnswersByUserID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(answers, 1)
+	suite.Equal("A friend of mine is on here already!", answers[0].Answer)
+
+	// Answers should go when the user does.
+	if err := suite.db.DeleteUserByID(ctx, user.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	answers, err = suite.db.GetSignupAnswersByUserID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(answers)
+}
+
+func TestSignupQuestionTestSuite(t *testing.T) {
+	suite.Run(t, new(SignupQuestionTestSuite))
+}
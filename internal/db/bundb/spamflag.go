@@ -0,0 +1,234 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type spamFlagDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *spamFlagDB) GetSpamFlagByID(ctx context.Context, id string) (*gtsmodel.SpamFlag, error) {
+	flag := new(gtsmodel.SpamFlag)
+
+	if err := s.db.
+		NewSelect().
+		Model(flag).
+		Where("? = ?", bun.Ident("spam_flag.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return flag, nil
+	}
+
+	if err := s.PopulateSpamFlag(ctx, flag); err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
+
+func (s *spamFlagDB) GetSpamFlags(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) ([]*gtsmodel.SpamFlag, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		flags = make([]*gtsmodel.SpamFlag, 0, limit)
+	)
+
+	q := s.db.
+		NewSelect().
+		Model(&flags)
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("spam_flag.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("spam_flag.id"),
+			minID,
+		)
+	}
+
+	// Return only items for
+	// statuses by given account.
+	if accountID != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("spam_flag.account_id"),
+			accountID,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("spam_flag.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("spam_flag.id"),
+		)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(flags) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(flags)
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return flags, nil
+	}
+
+	for _, flag := range flags {
+		if err := s.PopulateSpamFlag(ctx, flag); err != nil {
+			return nil, err
+		}
+	}
+
+	return flags, nil
+}
+
+func (s *spamFlagDB) CountSpamFalsePositives(ctx context.Context, accountID string) (int, error) {
+	return s.db.
+		NewSelect().
+		Table("spam_flags").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? IS NOT NULL", bun.Ident("false_positive_at")).
+		Count(ctx)
+}
+
+func (s *spamFlagDB) PopulateSpamFlag(ctx context.Context, flag *gtsmodel.SpamFlag) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(3)
+	)
+
+	if flag.Account == nil {
+		// Flagged account is not set, fetch from the database.
+		flag.Account, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			flag.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating spam flag account: %w", err)
+		}
+	}
+
+	if flag.TargetAccount == nil {
+		// Target account is not set, fetch from the database.
+		flag.TargetAccount, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			flag.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating spam flag target account: %w", err)
+		}
+	}
+
+	if flag.FalsePositiveByAccountID != "" &&
+		flag.FalsePositiveByAccount == nil {
+		// Reviewing admin is not set, fetch from the database.
+		flag.FalsePositiveByAccount, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			flag.FalsePositiveByAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating spam flag false positive by account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *spamFlagDB) PutSpamFlag(ctx context.Context, flag *gtsmodel.SpamFlag) error {
+	_, err := s.db.
+		NewInsert().
+		Model(flag).
+		Exec(ctx)
+	return err
+}
+
+func (s *spamFlagDB) UpdateSpamFlag(ctx context.Context, flag *gtsmodel.SpamFlag, columns ...string) error {
+	// Update the flag's last-updated
+	flag.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := s.db.
+		NewUpdate().
+		Model(flag).
+		Where("? = ?", bun.Ident("spam_flag.id"), flag.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
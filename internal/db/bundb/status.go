@@ -29,6 +29,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/util/xslices"
 	"github.com/uptrace/bun"
 )
@@ -181,7 +182,7 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status) error {
 	var (
 		err  error
-		errs = gtserror.NewMultiError(9)
+		errs = gtserror.NewMultiError(10)
 	)
 
 	if status.Account == nil {
@@ -243,6 +244,17 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 		}
 	}
 
+	if status.QuoteID != "" && status.Quote == nil {
+		// Quoted status is not set, fetch from database.
+		status.Quote, err = s.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			status.QuoteID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating quoted status: %w", err)
+		}
+	}
+
 	if status.PollID != "" && status.Poll == nil {
 		// Status poll is not set, fetch from database.
 		status.Poll, err = s.state.DB.GetPollByID(
@@ -313,7 +325,11 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 }
 
 func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error {
-	return s.state.Caches.DB.Status.Store(status, func() error {
+	// Tell other processes to drop any cached
+	// lookups of this status, e.g. "not found".
+	defer s.state.Caches.PublishInvalidateStatus(ctx, status)
+
+	if err := s.state.Caches.DB.Status.Store(status, func() error {
 		// It is safe to run this database transaction within cache.Store
 		// as the cache does not attempt a mutex lock until AFTER hook.
 		//
@@ -383,11 +399,21 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error
 				}
 			}
 
-			// Finally, insert the status
-			_, err := tx.NewInsert().Model(status).Exec(ctx)
-			return err
+			// Insert the status
+			if _, err := tx.NewInsert().Model(status).Exec(ctx); err != nil {
+				return err
+			}
+
+			// Finally, add status text to the search index.
+			return indexStatus(ctx, tx, status)
 		})
-	})
+	}); err != nil {
+		return err
+	}
+
+	// Add status to external search index.
+	s.indexStatusExternal(ctx, status)
+	return nil
 }
 
 func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, columns ...string) error {
@@ -397,7 +423,17 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 		columns = append(columns, "updated_at")
 	}
 
-	return s.state.Caches.DB.Status.Store(status, func() error {
+	// Only update the status text in search
+	// indexes if it might have changed.
+	reindex := len(columns) == 0 ||
+		slices.Contains(columns, "content") ||
+		slices.Contains(columns, "content_warning")
+
+	// Tell other processes to drop
+	// their cached copies of status.
+	defer s.state.Caches.PublishInvalidateStatus(ctx, status)
+
+	if err := s.state.Caches.DB.Status.Store(status, func() error {
 		// It is safe to run this database transaction within cache.Store
 		// as the cache does not attempt a mutex lock until AFTER hook.
 		//
@@ -466,16 +502,34 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 				}
 			}
 
-			// Finally, update the status
-			_, err := tx.
+			// Update the status
+			if _, err := tx.
 				NewUpdate().
 				Model(status).
 				Column(columns...).
 				Where("? = ?", bun.Ident("status.id"), status.ID).
-				Exec(ctx)
-			return err
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Finally, update the status
+			// text in the search index.
+			if reindex {
+				return indexStatus(ctx, tx, status)
+			}
+
+			return nil
 		})
-	})
+	}); err != nil {
+		return err
+	}
+
+	if reindex {
+		// Update status in external search index.
+		s.indexStatusExternal(ctx, status)
+	}
+
+	return nil
 }
 
 func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
@@ -514,6 +568,25 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 			return err
 		}
 
+		// Remove the status from the search index.
+		if err := unindexStatus(ctx, tx, id); err != nil {
+			return err
+		}
+
+		// Remove the status from trends.
+		if err := deleteTrendByTarget(ctx, tx, gtsmodel.TrendTypeStatus, id); err != nil {
+			return err
+		}
+
+		// Delete edit history of the status.
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("status_edits"), bun.Ident("status_edit")).
+			Where("? = ?", bun.Ident("status_edit.status_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// delete the status itself
 		if _, err := tx.
 			NewDelete().
@@ -540,6 +613,10 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 	// call the invalidate hook in case not cached.
 	s.state.Caches.DB.Status.Invalidate("ID", id)
 	s.state.Caches.OnInvalidateStatus(&deleted)
+	s.state.Caches.PublishInvalidateStatus(ctx, &deleted)
+
+	// Remove status from external search index.
+	s.unindexStatusExternal(ctx, id)
 
 	return nil
 }
@@ -719,3 +796,70 @@ func (s *statusDB) GetDirectStatusIDsBatch(ctx context.Context, minID string, ma
 	}
 	return statusIDs, nil
 }
+
+func (s *statusDB) GetStatusEdits(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, error) {
+	var edits []*gtsmodel.StatusEdit
+
+	if err := s.db.
+		NewSelect().
+		Model(&edits).
+		Where("? = ?", bun.Ident("status_edit.status_id"), statusID).
+		OrderExpr("? ASC", bun.Ident("status_edit.created_at")).
+		OrderExpr("? ASC", bun.Ident("status_edit.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return edits, nil
+}
+
+func (s *statusDB) GetExpiredStatuses(
+	ctx context.Context,
+	settings *gtsmodel.AccountSettings,
+	olderThan time.Time,
+	limit int,
+) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.account_id"), settings.AccountID).
+		Where("? < ?", bun.Ident("status.created_at"), olderThan)
+
+	if util.PtrOrValue(settings.StatusExpiryKeepPinned, true) {
+		q = q.Where("? IS NULL", bun.Ident("status.pinned_at"))
+	}
+
+	if util.PtrOrValue(settings.StatusExpiryKeepSelfFaved, true) {
+		q = q.Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+			Column("status_fave.id").
+			Where("? = ?", bun.Ident("status_fave.status_id"), bun.Ident("status.id")).
+			Where("? = ?", bun.Ident("status_fave.account_id"), settings.AccountID),
+		)
+	}
+
+	if err := q.
+		OrderExpr("? ASC", bun.Ident("status.created_at")).
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *statusDB) PutStatusEdit(ctx context.Context, edit *gtsmodel.StatusEdit) error {
+	_, err := s.db.
+		NewInsert().
+		Model(edit).
+		Exec(ctx)
+	return err
+}
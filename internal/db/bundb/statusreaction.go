@@ -0,0 +1,230 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type statusReactionDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *statusReactionDB) GetStatusReaction(ctx context.Context, accountID string, statusID string, name string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("status_reaction.account_id"), accountID).
+			Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+			Where("? = ?", bun.Ident("status_reaction.name"), name)
+	})
+}
+
+func (s *statusReactionDB) GetStatusReactionByURI(ctx context.Context, uri string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("status_reaction.uri"), uri)
+	})
+}
+
+func (s *statusReactionDB) getStatusReaction(
+	ctx context.Context,
+	where func(*bun.SelectQuery) *bun.SelectQuery,
+) (*gtsmodel.StatusReaction, error) {
+	reaction := new(gtsmodel.StatusReaction)
+
+	q := s.db.
+		NewSelect().
+		Model(reaction)
+
+	if err := where(q).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return reaction, nil
+	}
+
+	if err := s.PopulateStatusReaction(ctx, reaction); err != nil {
+		return nil, err
+	}
+
+	return reaction, nil
+}
+
+func (s *statusReactionDB) GetStatusReactions(ctx context.Context, statusID string) ([]*gtsmodel.StatusReaction, error) {
+	var reactions []*gtsmodel.StatusReaction
+
+	if err := s.db.
+		NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+		OrderExpr("? ASC", bun.Ident("status_reaction.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return reactions, nil
+	}
+
+	// Populate all loaded reactions, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	reactions = slices.DeleteFunc(reactions, func(reaction *gtsmodel.StatusReaction) bool {
+		if err := s.PopulateStatusReaction(ctx, reaction); err != nil {
+			log.Errorf(ctx, "error populating reaction %s: %v", reaction.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return reactions, nil
+}
+
+func (s *statusReactionDB) PopulateStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(4)
+	)
+
+	if reaction.Account == nil {
+		// Reaction author is not set, fetch from database.
+		reaction.Account, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction author: %w", err)
+		}
+	}
+
+	if reaction.TargetAccount == nil {
+		// Reaction target account is not set, fetch from database.
+		reaction.TargetAccount, err = s.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction target account: %w", err)
+		}
+	}
+
+	if reaction.Status == nil {
+		// Reaction status is not set, fetch from database.
+		reaction.Status, err = s.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.StatusID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status reaction status: %w", err)
+		}
+	}
+
+	if reaction.EmojiID != "" && reaction.Emoji == nil {
+		// Reaction emoji is not set, fetch from database.
+		reaction.Emoji, err = s.state.DB.GetEmojiByID(
+			gtscontext.SetBarebones(ctx),
+			reaction.EmojiID,
+		)
+
+		// The emoji may since have been deleted
+		// by an admin, which is fine, the reaction
+		// just won't be shown then.
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating status reaction emoji: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *statusReactionDB) PutStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	_, err := s.db.
+		NewInsert().
+		Model(reaction).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusReactionDB) UpdateStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction, columns ...string) error {
+	reaction.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := s.db.
+		NewUpdate().
+		Model(reaction).
+		Where("? = ?", bun.Ident("status_reaction.id"), reaction.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusReactionDB) DeleteStatusReactionByID(ctx context.Context, id string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_reactions"), bun.Ident("status_reaction")).
+		Where("? = ?", bun.Ident("status_reaction.id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusReactionDB) DeleteStatusReactions(ctx context.Context, targetAccountID string, originAccountID string) error {
+	if targetAccountID == "" && originAccountID == "" {
+		return errors.New("DeleteStatusReactions: one of targetAccountID or originAccountID must be set")
+	}
+
+	q := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_reactions"), bun.Ident("status_reaction"))
+
+	if targetAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status_reaction.target_account_id"), targetAccountID)
+	}
+
+	if originAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status_reaction.account_id"), originAccountID)
+	}
+
+	_, err := q.Exec(ctx)
+	return err
+}
+
+func (s *statusReactionDB) DeleteStatusReactionsForStatus(ctx context.Context, statusID string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_reactions"), bun.Ident("status_reaction")).
+		Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+		Exec(ctx)
+	return err
+}
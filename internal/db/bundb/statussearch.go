@@ -0,0 +1,265 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// statusSearchTable is the full-text search index of status
+// text. On SQLite this is an FTS5 virtual table, on Postgres
+// a regular table with a GIN index over to_tsvector('simple', text),
+// and on MySQL a regular table with a FULLTEXT index over text.
+//
+// It is kept up to date by PutStatus, UpdateStatus and
+// DeleteStatusByID, so must never be written to directly.
+// The same functions also keep the index of any configured
+// external search backend up to date, see state.Search.
+const statusSearchTable = "status_search"
+
+// maxStatusSearchTerms is the maximum number of terms
+// from a search query that will be used in a match.
+const maxStatusSearchTerms = 16
+
+// statusSearchEntry models one
+// row of the status search index.
+type statusSearchEntry struct {
+	bun.BaseModel `bun:"table:status_search"`
+
+	StatusID string `bun:"status_id"`
+	Text     string `bun:"text"`
+}
+
+// statusSearchText returns the text of
+// the given status that should be indexed.
+func statusSearchText(status *gtsmodel.Status) string {
+	return text.SanitizeToSearchText(status.ContentWarning, status.Content)
+}
+
+// statusSearchable returns whether the given
+// status should be included in the search index.
+func statusSearchable(status *gtsmodel.Status) bool {
+	// Boosts have no text of their own.
+	return status.BoostOfID == ""
+}
+
+// indexStatus (re)inserts the given status into the search index.
+func indexStatus(ctx context.Context, tx bun.IDB, status *gtsmodel.Status) error {
+	if err := unindexStatus(ctx, tx, status.ID); err != nil {
+		return err
+	}
+
+	if !statusSearchable(status) {
+		return nil
+	}
+
+	if _, err := tx.
+		NewInsert().
+		Model(&statusSearchEntry{
+			StatusID: status.ID,
+			Text:     statusSearchText(status),
+		}).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error indexing status %s: %w", status.ID, err)
+	}
+
+	return nil
+}
+
+// unindexStatus removes the given status ID from the search index.
+func unindexStatus(ctx context.Context, tx bun.IDB, statusID string) error {
+	if _, err := tx.
+		NewDelete().
+		Table(statusSearchTable).
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error unindexing status %s: %w", statusID, err)
+	}
+	return nil
+}
+
+// indexStatusExternal adds the given status to the
+// index of the configured external search backend,
+// if any, logging any errors. It should be called
+// after the status has been committed to the db.
+func (s *statusDB) indexStatusExternal(ctx context.Context, status *gtsmodel.Status) {
+	if s.state.Search == nil {
+		return
+	}
+
+	var err error
+	if statusSearchable(status) {
+		err = s.state.Search.IndexStatuses(ctx, []*gtsmodel.Status{status})
+	} else {
+		err = s.state.Search.DeleteStatuses(ctx, []string{status.ID})
+	}
+
+	if err != nil {
+		log.Errorf(ctx, "error indexing status %s in search backend: %v", status.ID, err)
+	}
+}
+
+// unindexStatusExternal removes the status with the
+// given ID from the index of the configured external
+// search backend, if any, logging any errors.
+func (s *statusDB) unindexStatusExternal(ctx context.Context, statusID string) {
+	if s.state.Search == nil {
+		return
+	}
+
+	if err := s.state.Search.DeleteStatuses(ctx, []string{statusID}); err != nil {
+		log.Errorf(ctx, "error unindexing status %s in search backend: %v", statusID, err)
+	}
+}
+
+// statusSearchTerms splits the given query into lowercase terms
+// made up of only letters and numbers, dropping everything else.
+//
+// This matches (closely enough) how SQLite's unicode61 tokenizer
+// and Postgres' simple text search config split text, and means
+// terms can be safely placed in a MATCH / to_tsquery / AGAINST expression
+// without having to worry about their respective query syntaxes.
+func statusSearchTerms(query string) []string {
+	terms := strings.FieldsFunc(
+		strings.ToLower(query),
+		func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		},
+	)
+
+	if len(terms) > maxStatusSearchTerms {
+		terms = terms[:maxStatusSearchTerms]
+	}
+
+	return terms
+}
+
+// statusSearchMatch returns a subquery selecting
+// the IDs of statuses whose indexed text contains
+// all of the given terms, or prefixes thereof.
+func (s *searchDB) statusSearchMatch(terms []string) *bun.SelectQuery {
+	q := s.db.
+		NewSelect().
+		Table(statusSearchTable).
+		Column("status_id")
+
+	switch d := s.db.Dialect().Name(); d {
+
+	case dialect.SQLite:
+		// FTS5 query syntax, eg: "foo"* "bar"*
+		match := make([]string, len(terms))
+		for i, term := range terms {
+			match[i] = `"` + term + `"*`
+		}
+		q = q.Where("? MATCH ?",
+			bun.Ident(statusSearchTable),
+			strings.Join(match, " "),
+		)
+
+	case dialect.PG:
+		// tsquery syntax, eg: foo:* & bar:*
+		match := make([]string, len(terms))
+		for i, term := range terms {
+			match[i] = term + ":*"
+		}
+		q = q.Where("to_tsvector('simple', ?) @@ to_tsquery('simple', ?)",
+			bun.Ident("text"),
+			strings.Join(match, " & "),
+		)
+
+	case dialect.MySQL:
+		// Boolean mode syntax, eg: +foo* +bar*
+		match := make([]string, len(terms))
+		for i, term := range terms {
+			match[i] = "+" + term + "*"
+		}
+		q = q.Where("MATCH (?) AGAINST (? IN BOOLEAN MODE)",
+			bun.Ident("text"),
+			strings.Join(match, " "),
+		)
+
+	default:
+		log.Panicf(nil, "db conn %s was neither pg, sqlite nor mysql", d)
+	}
+
+	return q
+}
+
+func (s *searchDB) ReindexStatuses(ctx context.Context) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Clear the existing index.
+		if _, err := tx.ExecContext(ctx,
+			"DELETE FROM ?",
+			bun.Ident(statusSearchTable),
+		); err != nil {
+			return gtserror.Newf("error clearing index: %w", err)
+		}
+
+		// Page through all statuses
+		// by ID, indexing each one.
+		var maxID string
+		for {
+			var statuses []*gtsmodel.Status
+			q := tx.
+				NewSelect().
+				Model(&statuses).
+				Column("id", "content", "content_warning", "boost_of_id").
+				Order("status.id DESC").
+				Limit(500)
+
+			if maxID != "" {
+				q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+			}
+
+			if err := q.Scan(ctx); err != nil {
+				return gtserror.Newf("error selecting statuses: %w", err)
+			}
+
+			if len(statuses) == 0 {
+				return nil
+			}
+
+			for _, status := range statuses {
+				if !statusSearchable(status) {
+					continue
+				}
+
+				if _, err := tx.
+					NewInsert().
+					Model(&statusSearchEntry{
+						StatusID: status.ID,
+						Text:     statusSearchText(status),
+					}).
+					Exec(ctx); err != nil {
+					return gtserror.Newf("error indexing status %s: %w", status.ID, err)
+				}
+			}
+
+			maxID = statuses[len(statuses)-1].ID
+		}
+	})
+}
@@ -303,3 +303,61 @@ func (t *tagDB) GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []strin
 	// but we only want to return each account once.
 	return xslices.Deduplicate(accountIDs), nil
 }
+
+func (t *tagDB) GetTagWebStatuses(ctx context.Context, tagID string, limit int) ([]*gtsmodel.Status, error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	statusIDs := make([]string, 0, limit)
+
+	q := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status_to_tag.status_id").
+		// Join with statuses for filtering.
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		// Join with account settings to check web visibility.
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("account_settings"), bun.Ident("account_settings"),
+			bun.Ident("account_settings.account_id"), bun.Ident("status.account_id"),
+		).
+		// This tag only.
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+		// Local only.
+		Where("? = ?", bun.Ident("status.local"), true).
+		// Public only.
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		// Don't show local-only statuses on the web.
+		Where("? = ?", bun.Ident("status.federated"), true).
+		// Don't show boosts.
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		// Only include statuses that aren't pending approval.
+		Where("NOT ? = ?", bun.Ident("status.pending_approval"), true).
+		// Only include statuses from accounts that show statuses on the web.
+		Where("? != ?", bun.Ident("account_settings.web_visibility"), gtsmodel.VisibilityNone).
+		Order("status_to_tag.status_id DESC")
+
+	if limit > 0 {
+		// limit amount of statuses returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, nil
+	}
+
+	// Return status IDs loaded from cache + db.
+	return t.state.DB.GetStatusesByIDs(ctx, statusIDs)
+}
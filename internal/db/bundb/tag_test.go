@@ -60,6 +60,15 @@ func (suite *TagTestSuite) TestGetTagByName() {
 	}
 }
 
+func (suite *TagTestSuite) TestGetTagWebStatuses() {
+	testTag := suite.testTags["welcome"]
+
+	statuses, err := suite.db.GetTagWebStatuses(context.Background(), testTag.ID, 20)
+	suite.NoError(err)
+	suite.Len(statuses, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, statuses[0].ID)
+}
+
 func (suite *TagTestSuite) TestPutTag() {
 	// Name is normalized when doing
 	// inserts to the db, so these
@@ -0,0 +1,56 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type termsOfServiceDB struct {
+	db *bun.DB
+}
+
+func (t *termsOfServiceDB) GetTermsOfServiceByID(ctx context.Context, id string) (*gtsmodel.TermsOfService, error) {
+	tos := new(gtsmodel.TermsOfService)
+	if err := t.db.NewSelect().
+		Model(tos).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return tos, nil
+}
+
+func (t *termsOfServiceDB) GetAllTermsOfService(ctx context.Context) ([]*gtsmodel.TermsOfService, error) {
+	var versions []*gtsmodel.TermsOfService
+	err := t.db.NewSelect().
+		Model(&versions).
+		Order("id DESC").
+		Scan(ctx)
+	return versions, err
+}
+
+func (t *termsOfServiceDB) PutTermsOfService(ctx context.Context, tos *gtsmodel.TermsOfService) error {
+	_, err := t.db.NewInsert().
+		Model(tos).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type TermsOfServiceTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TermsOfServiceTestSuite) TestTermsOfServiceGetPut() {
+	t := suite.T()
+
+	// Create two example versions.
+	first := &gtsmodel.TermsOfService{
+		ID:                 "01JKC0D3R7C3X9Q1F6M0V2T8HA",
+		Text:               "Be nice.",
+		Content:            "<p>Be nice.</p>",
+		RequireAcceptance:  util.Ptr(false),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	second := &gtsmodel.TermsOfService{
+		ID:                 "01JKC0E5W2M8B4N7K1Q9S3D6PZ",
+		Text:               "Be very nice.",
+		Content:            "<p>Be very nice.</p>",
+		RequireAcceptance:  util.Ptr(true),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	// Insert the example versions into db.
+	for _, tos := range []*gtsmodel.TermsOfService{first, second} {
+		if err := suite.db.PutTermsOfService(ctx, tos); err != nil {
+			t.Fatalf("error inserting terms of service: %v", err)
+		}
+	}
+
+	// Fetch all versions, newest should be first.
+	all, err := suite.db.GetAllTermsOfService(ctx)
+	if err != nil {
+		t.Fatalf("error fetching terms of service: %v", err)
+	}
+	suite.Len(all, 2)
+	suite.Equal(second.ID, all[0].ID)
+	suite.Equal(first.ID, all[1].ID)
+	suite.True(*all[0].RequireAcceptance)
+	suite.False(all[0].CreatedAt.IsZero())
+
+	// Fetch the first version by ID.
+	check, err := suite.db.GetTermsOfServiceByID(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("error fetching terms of service: %v", err)
+	}
+	suite.Equal(first.Text, check.Text)
+	suite.Equal(first.Content, check.Content)
+
+	// Ensure an unknown version isn't found.
+	_, err = suite.db.GetTermsOfServiceByID(ctx, "01JKC0F0000000000000000000")
+	if err != db.ErrNoEntries {
+		t.Fatalf("unknown terms of service returned unexpected error: %v", err)
+	}
+}
+
+func TestTermsOfServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TermsOfServiceTestSuite))
+}
@@ -0,0 +1,393 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type trendDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (t *trendDB) GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error) {
+	var trend gtsmodel.Trend
+
+	if err := t.db.
+		NewSelect().
+		Model(&trend).
+		Where("? = ?", bun.Ident("trend.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &trend, nil
+}
+
+func (t *trendDB) GetTrendByTarget(ctx context.Context, trendType gtsmodel.TrendType, target string) (*gtsmodel.Trend, error) {
+	var trend gtsmodel.Trend
+
+	if err := t.db.
+		NewSelect().
+		Model(&trend).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? = ?", bun.Ident("trend.target"), target).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &trend, nil
+}
+
+func (t *trendDB) PopulateTrend(ctx context.Context, trend *gtsmodel.Trend) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(3)
+	)
+
+	if trend.Type == gtsmodel.TrendTypeTag && trend.Tag == nil {
+		// Trend tag is not set, fetch from database.
+		trend.Tag, err = t.state.DB.GetTag(ctx, trend.Target)
+		if err != nil {
+			errs.Appendf("error populating trend tag: %w", err)
+		}
+	}
+
+	if trend.Type == gtsmodel.TrendTypeStatus && trend.Status == nil {
+		// Trend status is not set, fetch from database.
+		trend.Status, err = t.state.DB.GetStatusByID(ctx, trend.Target)
+		if err != nil {
+			errs.Appendf("error populating trend status: %w", err)
+		}
+	}
+
+	if trend.History == nil {
+		// Trend history is not set, fetch from database.
+		trend.History, err = t.GetTrendHistory(ctx, trend.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating trend history: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (t *trendDB) GetTrends(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	reviewStates []gtsmodel.TrendReviewState,
+	trending bool,
+	limit int,
+	offset int,
+) ([]*gtsmodel.Trend, error) {
+	trends := make([]*gtsmodel.Trend, 0, limit)
+
+	q := t.db.
+		NewSelect().
+		Model(&trends).
+		Where("? = ?", bun.Ident("trend.type"), trendType)
+
+	if len(reviewStates) != 0 {
+		q = q.Where("? IN (?)", bun.Ident("trend.review_state"), bun.In(reviewStates))
+	}
+
+	if trending {
+		q = q.Where("? > 0", bun.Ident("trend.score"))
+	}
+
+	q = q.
+		Order("trend.score DESC").
+		Order("trend.id DESC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return trends, nil
+}
+
+func (t *trendDB) PutTrend(ctx context.Context, trend *gtsmodel.Trend) error {
+	_, err := t.db.
+		NewInsert().
+		Model(trend).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error {
+	trend.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := t.db.
+		NewUpdate().
+		Model(trend).
+		Column(columns...).
+		Where("? = ?", bun.Ident("trend.id"), trend.ID).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) DeleteTrendByID(ctx context.Context, id string) error {
+	return t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return deleteTrends(ctx, tx, []string{id})
+	})
+}
+
+func (t *trendDB) DeleteTrendByTarget(ctx context.Context, trendType gtsmodel.TrendType, target string) error {
+	return t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return deleteTrendByTarget(ctx, tx, trendType, target)
+	})
+}
+
+// deleteTrendByTarget deletes the trend (if any) of
+// the given type and target, along with its history,
+// using the given db handle. This is used to also remove
+// trends when deleting their target in a transaction.
+func deleteTrendByTarget(ctx context.Context, tx bun.IDB, trendType gtsmodel.TrendType, target string) error {
+	var trendIDs []string
+	if err := tx.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("trends"), bun.Ident("trend")).
+		Column("trend.id").
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? = ?", bun.Ident("trend.target"), target).
+		Scan(ctx, &trendIDs); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error selecting trend: %w", err)
+	}
+
+	return deleteTrends(ctx, tx, trendIDs)
+}
+
+// deleteTrends deletes trends with the given
+// IDs, and their history, using the given db handle.
+func deleteTrends(ctx context.Context, tx bun.IDB, trendIDs []string) error {
+	if len(trendIDs) == 0 {
+		return nil
+	}
+
+	if _, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("trend_histories"), bun.Ident("trend_history")).
+		Where("? IN (?)", bun.Ident("trend_history.trend_id"), bun.In(trendIDs)).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error deleting trend history: %w", err)
+	}
+
+	if _, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("trends"), bun.Ident("trend")).
+		Where("? IN (?)", bun.Ident("trend.id"), bun.In(trendIDs)).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error deleting trends: %w", err)
+	}
+
+	return nil
+}
+
+func (t *trendDB) GetTrendHistory(ctx context.Context, trendID string) ([]*gtsmodel.TrendHistory, error) {
+	var history []*gtsmodel.TrendHistory
+
+	if err := t.db.
+		NewSelect().
+		Model(&history).
+		Where("? = ?", bun.Ident("trend_history.trend_id"), trendID).
+		Order("trend_history.day DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (t *trendDB) ReplaceTrendHistory(ctx context.Context, trendID string, history []*gtsmodel.TrendHistory) error {
+	return t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("trend_histories"), bun.Ident("trend_history")).
+			Where("? = ?", bun.Ident("trend_history.trend_id"), trendID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if len(history) == 0 {
+			return nil
+		}
+
+		for _, h := range history {
+			h.TrendID = trendID
+		}
+
+		_, err := tx.
+			NewInsert().
+			Model(&history).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (t *trendDB) GetTagTrendActivity(ctx context.Context, since time.Time) ([]*gtsmodel.TrendActivity, error) {
+	sinceID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return nil, err
+	}
+
+	var activity []*gtsmodel.TrendActivity
+
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		ColumnExpr("? AS ?", bun.Ident("status_to_tag.tag_id"), bun.Ident("target")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("status.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("tags"), bun.Ident("tag"),
+			bun.Ident("tag.id"), bun.Ident("status_to_tag.tag_id"),
+		).
+		Where("? > ?", bun.Ident("status.id"), sinceID).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? = ?", bun.Ident("tag.listable"), true).
+		Where("? = ?", bun.Ident("tag.useable"), true).
+		Scan(ctx, &activity); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+func (t *trendDB) GetStatusTrendActivity(ctx context.Context, since time.Time) ([]*gtsmodel.TrendActivity, error) {
+	sinceID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restrict the interacted-with
+	// statuses to trendable ones.
+	trendable := func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Join(
+				"JOIN ? AS ? ON ? = ?",
+				bun.Ident("accounts"), bun.Ident("account"),
+				bun.Ident("account.id"), bun.Ident("target.account_id"),
+			).
+			Where("? = ?", bun.Ident("target.visibility"), gtsmodel.VisibilityPublic).
+			Where("? = ?", bun.Ident("target.sensitive"), false).
+			Where("? = ?", bun.Ident("account.discoverable"), true).
+			Where("? IS NULL", bun.Ident("account.suspended_at"))
+	}
+
+	var faves []*gtsmodel.TrendActivity
+	if err := trendable(t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("fave")).
+		ColumnExpr("? AS ?", bun.Ident("fave.status_id"), bun.Ident("target")).
+		ColumnExpr("? AS ?", bun.Ident("fave.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("fave.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("target"),
+			bun.Ident("target.id"), bun.Ident("fave.status_id"),
+		).
+		Where("? > ?", bun.Ident("fave.id"), sinceID)).
+		Scan(ctx, &faves); err != nil {
+		return nil, gtserror.Newf("error selecting faves: %w", err)
+	}
+
+	var boosts []*gtsmodel.TrendActivity
+	if err := trendable(t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("boost")).
+		ColumnExpr("? AS ?", bun.Ident("boost.boost_of_id"), bun.Ident("target")).
+		ColumnExpr("? AS ?", bun.Ident("boost.account_id"), bun.Ident("account_id")).
+		ColumnExpr("? AS ?", bun.Ident("boost.created_at"), bun.Ident("created_at")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("target"),
+			bun.Ident("target.id"), bun.Ident("boost.boost_of_id"),
+		).
+		Where("? > ?", bun.Ident("boost.id"), sinceID)).
+		Scan(ctx, &boosts); err != nil {
+		return nil, gtserror.Newf("error selecting boosts: %w", err)
+	}
+
+	return append(faves, boosts...), nil
+}
+
+func (t *trendDB) GetLinkTrendStatuses(ctx context.Context, since time.Time, maxID string, limit int) ([]*gtsmodel.Status, error) {
+	sinceID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxID == "" {
+		maxID = id.Highest
+	}
+
+	var statuses []*gtsmodel.Status
+
+	q := t.db.
+		NewSelect().
+		Model(&statuses).
+		Column("status.id", "status.account_id", "status.created_at", "status.content").
+		Where("? > ?", bun.Ident("status.id"), sinceID).
+		Where("? < ?", bun.Ident("status.id"), maxID).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		// Links are always anchors, so
+		// we can skip statuses without.
+		Where("? LIKE ?", bun.Ident("status.content"), "%href=%").
+		Order("status.id DESC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
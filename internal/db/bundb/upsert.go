@@ -69,10 +69,9 @@ func (u *UpsertQuery) Column(columns ...string) *UpsertQuery {
 func (u *UpsertQuery) insertDialect() error {
 	dialectName := u.db.Dialect().Name()
 	switch dialectName {
-	case dialect.PG, dialect.SQLite:
+	case dialect.PG, dialect.SQLite, dialect.MySQL:
 		return nil
 	default:
-		// FUTURE: MySQL has its own variation on upserts, but the syntax is different.
 		return gtserror.Newf("UpsertQuery: upsert not supported by SQL dialect: %s", dialectName)
 	}
 }
@@ -191,9 +190,23 @@ func (u *UpsertQuery) insertQuery() (*bun.InsertQuery, error) {
 	}
 	onSQL := "CONFLICT (" + strings.Join(constraintIDPlaceholders, ", ") + ") DO UPDATE"
 
+	// MySQL doesn't let us specify which constraint
+	// conflicts, instead updating on any duplicate key.
+	onDuplicateKey := u.db.Dialect().Name() == dialect.MySQL
+	if onDuplicateKey {
+		onSQL = "DUPLICATE KEY UPDATE"
+		constraintIDs = nil
+	}
+
 	setClauses := make([]string, 0, len(columns))
 	setIDs := make([]interface{}, 0, 2*len(columns))
 	for _, column := range columns {
+		if onDuplicateKey {
+			// VALUES() refers to the value that would have been inserted.
+			setClauses = append(setClauses, "? = VALUES(?)")
+			setIDs = append(setIDs, bun.Ident(column), bun.Ident(column))
+			continue
+		}
 		setClauses = append(setClauses, "? = ?")
 		// "excluded" is a special table that contains only the row involved in a conflict.
 		setIDs = append(setIDs, bun.Ident(column), bun.Ident("excluded."+column))
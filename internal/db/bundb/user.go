@@ -143,7 +143,7 @@ func (u *userDB) getUser(ctx context.Context, lookup string, dbQuery func(*gtsmo
 // PopulateUser ensures that the user's struct fields are populated.
 func (u *userDB) PopulateUser(ctx context.Context, user *gtsmodel.User) error {
 	var (
-		errs = gtserror.NewMultiError(1)
+		errs = gtserror.NewMultiError(2)
 		err  error
 	)
 
@@ -158,6 +158,14 @@ func (u *userDB) PopulateUser(ctx context.Context, user *gtsmodel.User) error {
 		}
 	}
 
+	if user.Role == nil && user.RoleID != "" {
+		// Fetch the custom role given to this user.
+		user.Role, err = u.state.DB.GetRoleByID(ctx, user.RoleID)
+		if err != nil {
+			errs.Appendf("error populating user role: %w", err)
+		}
+	}
+
 	return errs.Combine()
 }
 
@@ -176,6 +184,24 @@ func (u *userDB) GetAllUsers(ctx context.Context) ([]*gtsmodel.User, error) {
 	return u.GetUsersByIDs(ctx, userIDs)
 }
 
+func (u *userDB) GetUsersPendingDeletion(ctx context.Context, requestedBefore time.Time) ([]*gtsmodel.User, error) {
+	var userIDs []string
+
+	// Scan IDs of users whose deletion is due into slice.
+	if err := u.db.NewSelect().
+		Table("users").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("deletion_requested_at")).
+		Where("? <= ?", bun.Ident("deletion_requested_at"), requestedBefore).
+		Order("id").
+		Scan(ctx, &userIDs); err != nil {
+		return nil, err
+	}
+
+	// Transform user IDs into user slice.
+	return u.GetUsersByIDs(ctx, userIDs)
+}
+
 func (u *userDB) PutUser(ctx context.Context, user *gtsmodel.User) error {
 	return u.state.Caches.DB.User.Store(user, func() error {
 		_, err := u.db.
@@ -221,6 +247,15 @@ func (u *userDB) DeleteUserByID(ctx context.Context, userID string) error {
 		return err
 	}
 
+	// Delete any answers given to
+	// sign-up questions by the user.
+	if _, err := u.db.NewDelete().
+		Table("signup_answers").
+		Where("? = ?", bun.Ident("user_id"), userID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
 	// Invalidate cached user by ID, manually
 	// call invalidate hook in case not cached.
 	u.state.Caches.DB.User.Invalidate("ID", userID)
@@ -47,6 +47,11 @@ func likeOperator(query *bun.SelectQuery) string {
 	const (
 		like  = "LIKE"
 		ilike = "ILIKE"
+
+		// MySQL databases use a binary
+		// collation, so explicitly specify
+		// a case-insensitive one for LIKE.
+		mysqlLike = "COLLATE utf8mb4_unicode_ci LIKE"
 	)
 
 	d := query.Dialect().Name()
@@ -54,16 +59,18 @@ func likeOperator(query *bun.SelectQuery) string {
 		return like
 	} else if d == dialect.PG {
 		return ilike
+	} else if d == dialect.MySQL {
+		return mysqlLike
 	}
 
-	log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+	log.Panicf(nil, "db conn %s was neither pg, sqlite nor mysql", d)
 	return ""
 }
 
 // whereLike appends a WHERE clause to the
 // given SelectQuery, which searches for
 // matches of `search` in the given subQuery
-// using LIKE (SQLite) or ILIKE (Postgres).
+// using LIKE (SQLite, MySQL) or ILIKE (Postgres).
 func whereLike(
 	query *bun.SelectQuery,
 	subject interface{},
@@ -216,8 +223,10 @@ func whereArrayIsNullOrEmpty(query *bun.SelectQuery, subject interface{}) *bun.S
 		arrayEmptySQL = "json_array_length(?) = 0"
 	case dialect.PG:
 		arrayEmptySQL = "CARDINALITY(?) = 0"
+	case dialect.MySQL:
+		arrayEmptySQL = "JSON_LENGTH(?) = 0"
 	default:
-		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+		log.Panicf(nil, "db conn %s was neither pg, sqlite nor mysql", d)
 	}
 
 	return query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
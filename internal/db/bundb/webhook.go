@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type webhookDB struct {
+	db *bun.DB
+}
+
+func (w *webhookDB) GetWebhookByID(ctx context.Context, id string) (*gtsmodel.Webhook, error) {
+	webhook := new(gtsmodel.Webhook)
+	if err := w.db.NewSelect().
+		Model(webhook).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (w *webhookDB) GetWebhooks(ctx context.Context) ([]*gtsmodel.Webhook, error) {
+	var webhooks []*gtsmodel.Webhook
+	err := w.db.NewSelect().
+		Model(&webhooks).
+		Order("id ASC").
+		Scan(ctx)
+	return webhooks, err
+}
+
+func (w *webhookDB) PutWebhook(ctx context.Context, webhook *gtsmodel.Webhook) error {
+	_, err := w.db.NewInsert().
+		Model(webhook).
+		Exec(ctx)
+	return err
+}
+
+func (w *webhookDB) UpdateWebhook(ctx context.Context, webhook *gtsmodel.Webhook, columns ...string) error {
+	webhook.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := w.db.NewUpdate().
+		Model(webhook).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), webhook.ID).
+		Exec(ctx)
+	return err
+}
+
+func (w *webhookDB) DeleteWebhookByID(ctx context.Context, id string) error {
+	_, err := w.db.NewDelete().
+		Table("webhooks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
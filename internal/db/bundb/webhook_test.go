@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type WebhookTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *WebhookTestSuite) TestWebhookGetPutUpdateDelete() {
+	t := suite.T()
+
+	// Create new example webhook.
+	webhook := &gtsmodel.Webhook{
+		ID:  "01JKAB1T6X6J3YVZ6N2Q1F2M8S",
+		URL: "https://chat.example.org/hooks/reports",
+		Events: []string{
+			gtsmodel.WebhookEventReportCreated,
+			gtsmodel.WebhookEventStatusReported,
+		},
+		Secret:             "super secret",
+		Enabled:            util.Ptr(true),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	// Create new cancellable test context.
+	ctx := context.Background()
+	ctx, cncl := context.WithCancel(ctx)
+	defer cncl()
+
+	// Insert the example webhook into db.
+	if err := suite.db.PutWebhook(ctx, webhook); err != nil {
+		t.Fatalf("error inserting webhook: %v", err)
+	}
+
+	// Fetch all webhooks.
+	all, err := suite.db.GetWebhooks(ctx)
+	if err != nil {
+		t.Fatalf("error fetching webhooks: %v", err)
+	}
+	suite.Len(all, 1)
+	suite.Equal(webhook.Events, all[0].Events)
+	suite.True(all[0].Subscribed(gtsmodel.WebhookEventReportCreated))
+	suite.False(all[0].Subscribed(gtsmodel.WebhookEventAccountCreated))
+
+	// Disable the webhook.
+	check := all[0]
+	check.Enabled = util.Ptr(false)
+	if err := suite.db.UpdateWebhook(ctx, check, "enabled"); err != nil {
+		t.Fatalf("error updating webhook: %v", err)
+	}
+
+	check, err = suite.db.GetWebhookByID(ctx, check.ID)
+	if err != nil {
+		t.Fatalf("error fetching webhook: %v", err)
+	}
+	suite.False(*check.Enabled)
+	suite.False(check.Subscribed(gtsmodel.WebhookEventReportCreated))
+
+	// Now delete the webhook from db.
+	if err := suite.db.DeleteWebhookByID(ctx, webhook.ID); err != nil {
+		t.Fatalf("error deleting webhook: %v", err)
+	}
+
+	// Ensure we can't refetch it.
+	_, err = suite.db.GetWebhookByID(ctx, webhook.ID)
+	if err != db.ErrNoEntries {
+		t.Fatalf("deleted webhook returned unexpected error: %v", err)
+	}
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
@@ -38,6 +38,38 @@ func (w *workerTaskDB) GetWorkerTasks(ctx context.Context) ([]*gtsmodel.WorkerTa
 	return tasks, nil
 }
 
+func (w *workerTaskDB) ClaimWorkerTasks(ctx context.Context, limit int) ([]*gtsmodel.WorkerTask, error) {
+	var tasks []*gtsmodel.WorkerTask
+	if err := w.db.NewSelect().
+		Model(&tasks).
+		OrderExpr("? ASC", bun.Ident("created_at")).
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	claimed := tasks[:0]
+	for _, task := range tasks {
+		res, err := w.db.NewDelete().
+			Table("worker_tasks").
+			Where("? = ?", bun.Ident("id"), task.ID).
+			Exec(ctx)
+		if err != nil {
+			return claimed, err
+		}
+
+		// Only if we deleted the task did we claim it,
+		// otherwise another process got there first.
+		if n, err := res.RowsAffected(); err != nil {
+			return claimed, err
+		} else if n == 1 {
+			claimed = append(claimed, task)
+		}
+	}
+
+	return claimed, nil
+}
+
 func (w *workerTaskDB) PutWorkerTasks(ctx context.Context, tasks []*gtsmodel.WorkerTask) error {
 	var errs []error
 	for _, task := range tasks {
@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type Circle interface {
+	// GetCircleByID gets one circle with the given id.
+	GetCircleByID(ctx context.Context, id string) (*gtsmodel.Circle, error)
+
+	// GetCirclesByAccountID gets all circles owned by the given accountID.
+	GetCirclesByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Circle, error)
+
+	// PopulateCircle ensures that the circle's struct fields are populated.
+	PopulateCircle(ctx context.Context, circle *gtsmodel.Circle) error
+
+	// PutCircle puts a new circle in the database.
+	PutCircle(ctx context.Context, circle *gtsmodel.Circle) error
+
+	// UpdateCircle updates the given circle.
+	// Columns is optional, if not specified all will be updated.
+	UpdateCircle(ctx context.Context, circle *gtsmodel.Circle, columns ...string) error
+
+	// DeleteCircleByID deletes one circle with the given ID, and all its members.
+	DeleteCircleByID(ctx context.Context, id string) error
+
+	// DeleteCirclesByAccountID deletes all circles owned by the given accountID, and all their members.
+	DeleteCirclesByAccountID(ctx context.Context, accountID string) error
+
+	// GetCircleMembers returns a page of members of the circle with the given ID,
+	// newest first, with each member's account populated.
+	GetCircleMembers(ctx context.Context, circleID string, page *paging.Page) ([]*gtsmodel.CircleMember, error)
+
+	// GetAccountIDsInCircle returns the account IDs of all members of the circle with the given ID.
+	GetAccountIDsInCircle(ctx context.Context, circleID string) ([]string, error)
+
+	// IsAccountInCircle returns whether given account with ID is a member of the circle with ID.
+	IsAccountInCircle(ctx context.Context, circleID string, accountID string) (bool, error)
+
+	// PutCircleMembers inserts a slice of circle members into the database.
+	// It uses a transaction to ensure no partial updates.
+	PutCircleMembers(ctx context.Context, members []*gtsmodel.CircleMember) error
+
+	// DeleteCircleMember deletes the member with given account ID from the circle with given ID.
+	DeleteCircleMember(ctx context.Context, circleID string, accountID string) error
+
+	// DeleteCircleMembersByFollows deletes all circle members with the given followIDs.
+	DeleteCircleMembersByFollows(ctx context.Context, followIDs ...string) error
+}
@@ -27,35 +27,56 @@ type DB interface {
 	Account
 	Admin
 	AdvancedMigration
+	Announcement
+	Appeal
 	Application
+	AuditLog
+	Automod
 	Basic
+	CanonicalEmailBlock
+	Circle
 	Conversation
+	DeadLetter
 	Domain
 	Emoji
 	HeaderFilter
 	Instance
 	Interaction
+	Invite
+	IPBlock
 	Filter
+	Lease
 	List
 	Marker
+	Measure
 	Media
 	Mention
 	Move
 	Notification
+	NotificationRequest
 	Poll
 	Relationship
+	Relay
 	Report
+	Role
 	Rule
 	Search
 	Session
+	SeveredRelationship
+	SignupQuestion
 	SinBinStatus
+	SpamFlag
 	Status
 	StatusBookmark
 	StatusFave
+	StatusReaction
 	Tag
+	TermsOfService
 	Thread
 	Timeline
+	Trend
 	User
 	Tombstone
+	Webhook
 	WorkerTask
 }
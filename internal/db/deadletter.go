@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// DeadLetter handles getting/creation/deletion of dead letters, ie., failed deliveries.
+type DeadLetter interface {
+	// GetDeadLetterByID gets one dead letter by its db id.
+	GetDeadLetterByID(ctx context.Context, id string) (*gtsmodel.DeadLetter, error)
+
+	// GetDeadLetters gets a page of dead letters, optionally
+	// only those for inboxes on the given domain, newest first.
+	GetDeadLetters(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.DeadLetter, error)
+
+	// PutDeadLetter puts the given dead letter in the database.
+	PutDeadLetter(ctx context.Context, deadLetter *gtsmodel.DeadLetter) error
+
+	// DeleteDeadLetterByID deletes one dead letter by its db id.
+	DeleteDeadLetterByID(ctx context.Context, id string) error
+
+	// DeleteDeadLettersOlderThan deletes all dead letters
+	// created before the given time, returning the count.
+	DeleteDeadLettersOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+}
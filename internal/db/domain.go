@@ -86,6 +86,31 @@ type Domain interface {
 	// Will return true if even one of the given URIs is blocked.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, error)
 
+	// IsSignatureRequired checks whether unsigned federation GET requests from domain should be
+	// denied, according to the instance authorized fetch mode, and the authorized fetch mode of
+	// any domain allow for domain (or its closest parent domain with one set), which takes precedence.
+	// Callers must only pass a domain that the request has been verified to come from.
+	IsSignatureRequired(ctx context.Context, domain string) (bool, error)
+
+	// GetAuthorizedFetchDomains returns the domains of all domain
+	// allows that have an authorized fetch mode override set.
+	GetAuthorizedFetchDomains(ctx context.Context) ([]string, error)
+
+	// GetDomainRateLimits returns the maximum number of outgoing deliveries, and dereferences,
+	// per minute permitted to domain, according to the instance-wide delivery rate limits, and
+	// the rate limits of any domain allow for domain (or its closest parent domain with one set),
+	// which take precedence. A limit of 0 means no limit.
+	GetDomainRateLimits(ctx context.Context, domain string) (delivery int, dereference int, err error)
+
+	// GetDomainMediaPolicy returns the media policy of any domain allow for domain
+	// (or its closest parent domain with one set), or an empty string if there's none.
+	GetDomainMediaPolicy(ctx context.Context, domain string) (string, error)
+
+	// IsDomainLimited checks whether domain is limited by any domain allow for domain
+	// (or its closest parent domain with one set). Statuses from limited domains are
+	// still federated with, but are kept off of public and tag timelines.
+	IsDomainLimited(ctx context.Context, domain string) (bool, error)
+
 	/*
 		Domain permission draft stuff.
 	*/
@@ -78,6 +78,17 @@ type Emoji interface {
 	// PutEmojiCategory puts one new emoji category in the database.
 	PutEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory) error
 
+	// UpdateEmojiCategory updates the given columns of one emoji category.
+	// If no columns are specified, every column is updated.
+	UpdateEmojiCategory(ctx context.Context, emojiCategory *gtsmodel.EmojiCategory, columns ...string) error
+
+	// DeleteEmojiCategoryByID deletes one emoji category by its database ID,
+	// leaving any emojis that were in the category uncategorized.
+	DeleteEmojiCategoryByID(ctx context.Context, id string) error
+
+	// GetEmojisByCategoryID gets all emojis in the category with the given ID.
+	GetEmojisByCategoryID(ctx context.Context, categoryID string) ([]*gtsmodel.Emoji, error)
+
 	// GetEmojiCategoriesByIDs gets emoji categories for given IDs.
 	GetEmojiCategoriesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.EmojiCategory, error)
 
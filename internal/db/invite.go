@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Invite interface {
+	// GetInviteByID fetches the invite with ID from the database.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error)
+
+	// GetInviteByCode fetches the invite with the given invite link code from the database.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error)
+
+	// GetInvites fetches invites from the database, newest first, including expired
+	// ones. If createdByAccountID is set, only invites created by that account are returned.
+	GetInvites(ctx context.Context, createdByAccountID string) ([]*gtsmodel.Invite, error)
+
+	// PutInvite inserts the given invite into the database.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) error
+
+	// UpdateInvite updates the given invite in the database, only updating given columns if provided.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error
+
+	// UseInvite increments the uses count of the invite with ID, provided it hasn't
+	// yet reached its max uses or expired. Returns ErrNoEntries if it has.
+	UseInvite(ctx context.Context, id string) error
+
+	// ReleaseInvite decrements the uses count of the invite with ID, giving
+	// back a use claimed with UseInvite, eg., when the sign-up then failed.
+	ReleaseInvite(ctx context.Context, id string) error
+}
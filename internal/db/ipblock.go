@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type IPBlock interface {
+	// IPBlockMatch returns the unexpired IP block with the most
+	// severe severity containing the given address, or nil if none.
+	IPBlockMatch(ctx context.Context, addr netip.Addr) (*gtsmodel.IPBlock, error)
+
+	// GetIPBlock fetches the IP block with ID from the database.
+	GetIPBlock(ctx context.Context, id string) (*gtsmodel.IPBlock, error)
+
+	// GetIPBlocks fetches all IP blocks from the database, including expired ones.
+	GetIPBlocks(ctx context.Context) ([]*gtsmodel.IPBlock, error)
+
+	// PutIPBlock inserts the given IP block into the database.
+	PutIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error
+
+	// UpdateIPBlock updates the given IP block in the database, only updating given columns if provided.
+	UpdateIPBlock(ctx context.Context, block *gtsmodel.IPBlock, columns ...string) error
+
+	// DeleteIPBlock deletes the IP block with ID from the database.
+	DeleteIPBlock(ctx context.Context, id string) error
+}
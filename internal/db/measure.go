@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Measure contains functions for gathering the activity
+// that admin measures, dimensions and retention metrics
+// are computed from. Each function returns activity that
+// happened between start (inclusive) and end (exclusive).
+type Measure interface {
+	// GetLoginActivity gets local user logins (ie., creation
+	// of oauth access tokens), with AccountID set.
+	GetLoginActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetSignupActivity gets local user sign-ups, with AccountID set.
+	GetSignupActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetLocalStatusActivity gets statuses (not boosts) created
+	// by local accounts, with AccountID and Language set.
+	GetLocalStatusActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetRemoteStatusActivity gets statuses (not boosts) created by accounts
+	// on the given domain, or any remote domain if domain is empty, with
+	// AccountID, Domain and Language set.
+	GetRemoteStatusActivity(ctx context.Context, domain string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetInteractionActivity gets faves, boosts and replies
+	// of local statuses, with AccountID set to the account
+	// doing the interaction.
+	GetInteractionActivity(ctx context.Context, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetReportActivity gets reports targeting accounts on the given domain, or
+	// any account if domain is empty, with AccountID set to the target account.
+	// If resolved is true, reports are gathered by when they were resolved,
+	// rather than by when they were created.
+	GetReportActivity(ctx context.Context, domain string, resolved bool, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetTagActivity gets uses of the tag with the given ID in
+	// statuses, with AccountID, Domain and Language set.
+	GetTagActivity(ctx context.Context, tagID string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetAccountActivity gets accounts on the given
+	// domain created (ie., first seen) in the period.
+	GetAccountActivity(ctx context.Context, domain string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetMediaActivity gets media attachments of accounts on the
+	// given domain, with Value set to the stored size in bytes.
+	GetMediaActivity(ctx context.Context, domain string, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetFollowActivity gets follows between local accounts and accounts
+	// on the given domain. If followers is true, follows of local accounts
+	// by accounts on the domain are returned with AccountID set to the
+	// follower, else follows of accounts on the domain by local accounts
+	// are returned with AccountID set to the followed account.
+	GetFollowActivity(ctx context.Context, domain string, followers bool, start time.Time, end time.Time) ([]*gtsmodel.MeasureActivity, error)
+
+	// GetMediaSpaceUsage gets the total size in bytes
+	// of all media attachments and emojis stored locally.
+	GetMediaSpaceUsage(ctx context.Context) (int64, error)
+
+	// GetDatabaseSpaceUsage gets the size
+	// in bytes of the database on disk.
+	GetDatabaseSpaceUsage(ctx context.Context) (int64, error)
+}
@@ -51,4 +51,21 @@ type Media interface {
 	// GetCachedAttachmentsOlderThan gets limit n remote attachments (including avatars and headers) older than
 	// the given time. These will be returned in order of attachment.created_at descending (i.e. newest to oldest).
 	GetCachedAttachmentsOlderThan(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.MediaAttachment, error)
+
+	// GetMediaRetentionPolicyByID gets one MediaRetentionPolicy with the given ID.
+	GetMediaRetentionPolicyByID(ctx context.Context, id string) (*gtsmodel.MediaRetentionPolicy, error)
+
+	// GetMediaRetentionPolicies returns a page of MediaRetentionPolicies
+	// using the given parameters, optionally filtered by domain.
+	// A nil page returns all policies.
+	GetMediaRetentionPolicies(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.MediaRetentionPolicy, error)
+
+	// PutMediaRetentionPolicy stores one MediaRetentionPolicy.
+	PutMediaRetentionPolicy(ctx context.Context, policy *gtsmodel.MediaRetentionPolicy) error
+
+	// UpdateMediaRetentionPolicy updates one MediaRetentionPolicy.
+	UpdateMediaRetentionPolicy(ctx context.Context, policy *gtsmodel.MediaRetentionPolicy, columns ...string) error
+
+	// DeleteMediaRetentionPolicy deletes one MediaRetentionPolicy with the given id.
+	DeleteMediaRetentionPolicy(ctx context.Context, id string) error
 }
@@ -0,0 +1,332 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mysql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+// indexPrefixLen is the prefix length used when
+// indexing long string columns, this is the longest
+// utf8mb4 prefix that fits in a 767 byte index key.
+const indexPrefixLen = 191
+
+var (
+	// tableDDLRegexp matches statements that define column types.
+	tableDDLRegexp = regexp.MustCompile(`(?is)^\s*(CREATE\s+(TEMPORARY\s+)?TABLE|ALTER\s+TABLE)\s`)
+
+	// columnTypeRegexp matches Postgres / SQLite column types that need
+	// translating to their MySQL equivalent, along with any type arguments.
+	columnTypeRegexp = regexp.MustCompile(`(?i)\b(TIMESTAMPTZ|TIMESTAMP|DATETIME|BYTEA|BLOB|JSONB|VARCHAR)\b(\s*\()?`)
+
+	// currentTimestampRegexp matches the CURRENT_TIMESTAMP function,
+	// which needs a precision to be used as a DATETIME(6) default.
+	currentTimestampRegexp = regexp.MustCompile(`(?i)\bCURRENT_TIMESTAMP\b(\s*\()?`)
+
+	// createIndexRegexp matches a CREATE INDEX statement, capturing the statement
+	// prefix, whether the index is unique, index name, table name and index parts.
+	createIndexRegexp = regexp.MustCompile(`(?is)^(\s*CREATE\s+(UNIQUE\s+)?INDEX\s+(?:IF\s+NOT\s+EXISTS\s+)?)(\S+)\s+ON\s+(\S+)\s*\((.*)\)\s*$`)
+
+	// dropIndexRegexp matches a Postgres / SQLite style DROP INDEX
+	// statement without a table name, capturing the index name.
+	dropIndexRegexp = regexp.MustCompile(`(?is)^\s*DROP\s+INDEX\s+(IF\s+EXISTS\s+)?(\S+?)(?:\s+(?:CASCADE|RESTRICT))?\s*$`)
+
+	// indexColumnRegexp matches a plain column index part,
+	// capturing the column name and any sort order.
+	indexColumnRegexp = regexp.MustCompile("(?i)^[`\"]?(\\w+)[`\"]?(\\s+(?:ASC|DESC))?$")
+)
+
+// isDDL returns whether query is (likely) a DDL statement
+// that may need rewriting before being passed to MySQL.
+func isDDL(query string) bool {
+	query = strings.TrimSpace(query)
+	if len(query) > 6 {
+		query = query[:6]
+	}
+	query = strings.ToUpper(query)
+	return strings.HasPrefix(query, "CREATE") ||
+		strings.HasPrefix(query, "ALTER") ||
+		strings.HasPrefix(query, "DROP")
+}
+
+// rewriteDDL rewrites DDL statements generated by bun, or written in
+// our migrations with Postgres and SQLite in mind, into their MySQL
+// equivalent. Where it isn't possible to do so from the query text
+// alone, conn is used to look up details of the existing schema.
+// The returned bool indicates whether the statement can be skipped.
+func rewriteDDL(ctx context.Context, conn connIface, query string) (string, bool, error) {
+	if tableDDLRegexp.MatchString(query) {
+		return rewriteColumnTypes(query), false, nil
+	}
+
+	if m := createIndexRegexp.FindStringSubmatch(query); m != nil {
+		var (
+			prefix = m[1]
+			unique = (m[2] != "")
+			index  = m[3]
+			table  = m[4]
+			parts  = splitIndexParts(m[5])
+		)
+
+		// MariaDB indexes unique TEXT columns,
+		// and those too long for a regular index
+		// key, by hash. Otherwise we need to limit
+		// long string columns to a prefix length.
+		if !unique {
+			for i, part := range parts {
+				cm := indexColumnRegexp.FindStringSubmatch(part)
+				if cm == nil {
+					// Not a plain column.
+					continue
+				}
+
+				long, err := isLongColumn(ctx, conn, unquote(table), cm[1], len(parts) > 1)
+				if err != nil {
+					return "", false, err
+				}
+
+				if long {
+					parts[i] = "`" + cm[1] + "`(" + strconv.Itoa(indexPrefixLen) + ")" + cm[2]
+				}
+			}
+		}
+
+		query = prefix + index + " ON " + table + " (" + strings.Join(parts, ", ") + ")"
+		return query, false, nil
+	}
+
+	if m := dropIndexRegexp.FindStringSubmatch(query); m != nil {
+		var (
+			ifExists = (m[1] != "")
+			index    = m[2]
+		)
+
+		// MySQL requires the table name
+		// to drop an index, so look it up.
+		table, err := indexTable(ctx, conn, unquote(index))
+		if err != nil {
+			return "", false, err
+		}
+
+		if table == "" {
+			if ifExists {
+				// Nothing to drop.
+				return "", true, nil
+			}
+			return "", false, errors.New("index " + index + " does not exist")
+		}
+
+		query = "DROP INDEX " + index + " ON `" + table + "`"
+		return query, false, nil
+	}
+
+	return query, false, nil
+}
+
+// rewriteColumnTypes replaces Postgres and SQLite column
+// types in query with the equivalent MySQL column types.
+func rewriteColumnTypes(query string) string {
+	query = replaceUnquoted(query, columnTypeRegexp, func(m []string) string {
+		if m[2] != "" {
+			// Type arguments were given,
+			// eg. VARCHAR(255), leave as-is.
+			return m[0]
+		}
+
+		switch strings.ToUpper(m[1]) {
+		case "TIMESTAMPTZ", "TIMESTAMP", "DATETIME":
+			// Always store microseconds, and don't
+			// use TIMESTAMP as it can't store dates
+			// beyond 2038, nor does MySQL store zone
+			// info; we always store times in UTC.
+			return "DATETIME(6)"
+		case "BYTEA", "BLOB":
+			return "LONGBLOB"
+		case "JSONB":
+			return "JSON"
+		case "VARCHAR":
+			// Postgres / SQLite allow VARCHAR
+			// without a length, which is to
+			// all intents and purposes TEXT.
+			return "TEXT"
+		}
+
+		return m[0]
+	})
+
+	return replaceUnquoted(query, currentTimestampRegexp, func(m []string) string {
+		if m[1] != "" {
+			// Precision given.
+			return m[0]
+		}
+		return "CURRENT_TIMESTAMP(6)"
+	})
+}
+
+// replaceUnquoted is like regexp.ReplaceAllStringFunc(), but skips
+// matches that are part of a quoted identifier, or table qualified
+// column name, and passes the submatches to the replacement func.
+func replaceUnquoted(s string, re *regexp.Regexp, repl func([]string) string) string {
+	var b strings.Builder
+	var last int
+
+	for _, idx := range re.FindAllStringSubmatchIndex(s, -1) {
+		start, end := idx[0], idx[1]
+
+		if start > 0 {
+			switch s[start-1] {
+			case '`', '"', '\'', '.':
+				continue
+			}
+		}
+
+		// Gather submatches.
+		m := make([]string, len(idx)/2)
+		for i := range m {
+			if idx[2*i] >= 0 {
+				m[i] = s[idx[2*i]:idx[2*i+1]]
+			}
+		}
+
+		b.WriteString(s[last:start])
+		b.WriteString(repl(m))
+		last = end
+	}
+
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// splitIndexParts splits the parts of an index
+// definition on commas outside of any parentheses.
+func splitIndexParts(s string) []string {
+	var parts []string
+	var depth, last int
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[last:i]))
+				last = i + 1
+			}
+		}
+	}
+
+	return append(parts, strings.TrimSpace(s[last:]))
+}
+
+// unquote removes identifier quotes from s.
+func unquote(s string) string {
+	return strings.Trim(s, "`\"")
+}
+
+// isLongColumn returns whether given column of table is a TEXT or BLOB
+// column, or when multi is set, a string column which together with other
+// columns would likely exceed the maximum length of an index key.
+func isLongColumn(ctx context.Context, conn connIface, table, column string, multi bool) (bool, error) {
+	row, err := queryRow(ctx, conn,
+		"SELECT DATA_TYPE, COALESCE(CHARACTER_MAXIMUM_LENGTH, 0) FROM information_schema.columns "+
+			"WHERE table_schema = DATABASE() AND table_name = ? AND column_name = ?",
+		table, column,
+	)
+	if err != nil || row == nil {
+		return false, err
+	}
+
+	switch dataType := strings.ToLower(asString(row[0])); {
+	case strings.HasSuffix(dataType, "text"),
+		strings.HasSuffix(dataType, "blob"):
+		return true, nil
+	case multi && strings.HasSuffix(dataType, "char"):
+		n, _ := strconv.Atoi(asString(row[1]))
+		return n > indexPrefixLen, nil
+	}
+
+	return false, nil
+}
+
+// indexTable returns the name of the table that
+// has an index with given name, if any, in the
+// currently selected database.
+func indexTable(ctx context.Context, conn connIface, index string) (string, error) {
+	row, err := queryRow(ctx, conn,
+		"SELECT table_name FROM information_schema.statistics "+
+			"WHERE table_schema = DATABASE() AND index_name = ? LIMIT 1",
+		index,
+	)
+	if err != nil || row == nil {
+		return "", err
+	}
+	return asString(row[0]), nil
+}
+
+// queryRow performs given query with args on conn, returning
+// the first row of the results, or nil if there were none.
+func queryRow(ctx context.Context, conn connIface, query string, args ...driver.Value) ([]driver.Value, error) {
+	st, err := conn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, processMySQLError(err)
+	}
+	defer st.Close()
+
+	rows, err := st.(stmtIface).QueryContext(ctx, db.ToNamedValues(args))
+	if err != nil {
+		return nil, processMySQLError(err)
+	}
+	defer rows.Close()
+
+	row := make([]driver.Value, len(rows.Columns()))
+	if err := rows.Next(row); err != nil {
+		if err == io.EOF {
+			err = nil
+		}
+		return nil, processMySQLError(err)
+	}
+
+	return row, nil
+}
+
+// asString returns the string
+// form of a driver.Value.
+func asString(v driver.Value) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mysql
+
+import (
+	"testing"
+)
+
+func TestRewriteColumnTypes(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "ALTER TABLE `accounts` ADD COLUMN `fetched_at` TIMESTAMPTZ",
+			out: "ALTER TABLE `accounts` ADD COLUMN `fetched_at` DATETIME(6)",
+		},
+		{
+			in:  "ALTER TABLE `accounts` ADD COLUMN `fields_raw` VARCHAR",
+			out: "ALTER TABLE `accounts` ADD COLUMN `fields_raw` TEXT",
+		},
+		{
+			in:  "ALTER TABLE `media_attachments` ADD COLUMN `waveform` BLOB",
+			out: "ALTER TABLE `media_attachments` ADD COLUMN `waveform` LONGBLOB",
+		},
+		{
+			in:  "CREATE TABLE `x` (`id` CHAR(26) NOT NULL, `uri` VARCHAR(768), `created_at` timestamptz NOT NULL DEFAULT current_timestamp)",
+			out: "CREATE TABLE `x` (`id` CHAR(26) NOT NULL, `uri` VARCHAR(768), `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6))",
+		},
+		{
+			// Quoted identifiers + literals are left alone.
+			in:  "CREATE TABLE `x` (`blob` BLOB, `text` VARCHAR DEFAULT 'varchar')",
+			out: "CREATE TABLE `x` (`blob` LONGBLOB, `text` TEXT DEFAULT 'varchar')",
+		},
+	} {
+		if out := rewriteColumnTypes(test.in); out != test.out {
+			t.Errorf("unexpected rewrite of %q: got %q, expected %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestSplitIndexParts(t *testing.T) {
+	parts := splitIndexParts("`account_id`, COALESCE(`domain`, ''), id DESC")
+	if len(parts) != 3 ||
+		parts[0] != "`account_id`" ||
+		parts[1] != "COALESCE(`domain`, '')" ||
+		parts[2] != "id DESC" {
+		t.Errorf("unexpected index parts: %q", parts)
+	}
+}
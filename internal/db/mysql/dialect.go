@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mysql
+
+import (
+	"github.com/uptrace/bun/dialect/mysqldialect"
+	"github.com/uptrace/bun/dialect/sqltype"
+	"github.com/uptrace/bun/schema"
+)
+
+// Dialect is our own wrapper around the mysqldialect.Dialect{}
+// type in order to choose column types for untyped string fields.
+// By default bun creates these as VARCHAR(255), which is too short
+// for most of our string fields, but we can't use TEXT everywhere
+// either as MySQL can only create regular indexes on a prefix of
+// TEXT columns, which we don't want for unique keys like URIs.
+type Dialect struct {
+	*mysqldialect.Dialect
+	tables *schema.Tables
+}
+
+// NewDialect returns a new MySQL
+// dialect storing times in UTC.
+func NewDialect() *Dialect {
+	d := &Dialect{Dialect: mysqldialect.New(
+		mysqldialect.WithTimeLocation("UTC"),
+	)}
+	d.tables = schema.NewTables(d)
+	return d
+}
+
+// Tables implements schema.Dialect, returning
+// tables built with our own OnTable() hook.
+func (d *Dialect) Tables() *schema.Tables {
+	return d.tables
+}
+
+// OnTable implements schema.Dialect.
+func (d *Dialect) OnTable(table *schema.Table) {
+	d.Dialect.OnTable(table)
+
+	for _, field := range table.FieldMap {
+		if field.UserSQLType != "" ||
+			field.DiscoveredSQLType != sqltype.VarChar {
+			// Explicitly typed,
+			// or not a string.
+			continue
+		}
+
+		switch {
+		case isUnique(table, field, false):
+			// Longest that fits in an index key.
+			field.CreateTableSQLType = "VARCHAR(768)"
+
+		case isUnique(table, field, true):
+			// Leave room in the index key
+			// for the other columns in key.
+			field.CreateTableSQLType = "VARCHAR(191)"
+
+		default:
+			field.CreateTableSQLType = "TEXT"
+		}
+	}
+}
+
+// isUnique returns whether field is a primary key, or
+// has a unique constraint on it, either alone or as
+// part of a group of columns depending on 'group'.
+func isUnique(table *schema.Table, field *schema.Field, group bool) bool {
+	if field.IsPK {
+		return group == (len(table.PKs) > 1)
+	}
+
+	for name, fields := range table.Unique {
+		if group == (name != "" && len(fields) > 1) {
+			for _, f := range fields {
+				if f == field {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
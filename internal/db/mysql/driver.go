@@ -0,0 +1,213 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mysql
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+// global MySQL driver instance.
+var mysqlDriver = mysql.MySQLDriver{}
+
+// Driver is our own wrapper around the
+// mysql.MySQLDriver{} type in order to wrap further
+// SQL driver types with our own err processing, and
+// to translate DDL statements to MySQL's flavour.
+type Driver struct{}
+
+func (d *Driver) Open(name string) (driver.Conn, error) {
+	conn, err := mysqlDriver.Open(name)
+	if err != nil {
+		err = processMySQLError(err)
+		return nil, err
+	}
+	return &mysqlConn{conn.(connIface)}, nil
+}
+
+func (d *Driver) OpenConnector(name string) (driver.Connector, error) {
+	cc, err := mysqlDriver.OpenConnector(name)
+	if err != nil {
+		err = processMySQLError(err)
+		return nil, err
+	}
+	return &mysqlConnector{driver: d, Connector: cc}, nil
+}
+
+type mysqlConnector struct {
+	driver *Driver
+	driver.Connector
+}
+
+func (c *mysqlConnector) Driver() driver.Driver { return c.driver }
+
+func (c *mysqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		err = processMySQLError(err)
+		return nil, err
+	}
+	return &mysqlConn{conn.(connIface)}, nil
+}
+
+type mysqlConn struct{ connIface }
+
+func (c *mysqlConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *mysqlConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.connIface.BeginTx(ctx, opts)
+	err = processMySQLError(err)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlTx{tx}, nil
+}
+
+func (c *mysqlConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *mysqlConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	st, err := c.connIface.PrepareContext(ctx, query)
+	err = processMySQLError(err)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlStmt{st.(stmtIface)}, nil
+}
+
+func (c *mysqlConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return c.ExecContext(context.Background(), query, db.ToNamedValues(args))
+}
+
+func (c *mysqlConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if isDDL(query) {
+		var (
+			skip bool
+			err  error
+		)
+
+		// Translate DDL to MySQL's flavour.
+		query, skip, err = rewriteDDL(ctx, c.connIface, query)
+		if err != nil {
+			return nil, err
+		} else if skip {
+			return driver.ResultNoRows, nil
+		}
+	}
+
+	result, err := c.connIface.ExecContext(ctx, query, args)
+	err = processMySQLError(err)
+	return result, err
+}
+
+func (c *mysqlConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return c.QueryContext(context.Background(), query, db.ToNamedValues(args))
+}
+
+func (c *mysqlConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.connIface.QueryContext(ctx, query, args)
+	err = processMySQLError(err)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlRows{rows.(rowsIface)}, nil
+}
+
+func (c *mysqlConn) Close() error {
+	err := c.connIface.Close()
+	return processMySQLError(err)
+}
+
+type mysqlTx struct{ driver.Tx }
+
+func (tx *mysqlTx) Commit() error {
+	err := tx.Tx.Commit()
+	return processMySQLError(err)
+}
+
+func (tx *mysqlTx) Rollback() error {
+	err := tx.Tx.Rollback()
+	return processMySQLError(err)
+}
+
+type mysqlStmt struct{ stmtIface }
+
+func (stmt *mysqlStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return stmt.ExecContext(context.Background(), db.ToNamedValues(args))
+}
+
+func (stmt *mysqlStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	res, err := stmt.stmtIface.ExecContext(ctx, args)
+	err = processMySQLError(err)
+	return res, err
+}
+
+func (stmt *mysqlStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return stmt.QueryContext(context.Background(), db.ToNamedValues(args))
+}
+
+func (stmt *mysqlStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := stmt.stmtIface.QueryContext(ctx, args)
+	err = processMySQLError(err)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlRows{rows.(rowsIface)}, nil
+}
+
+type mysqlRows struct{ rowsIface }
+
+func (r *mysqlRows) Next(dest []driver.Value) error {
+	err := r.rowsIface.Next(dest)
+	err = processMySQLError(err)
+	return err
+}
+
+func (r *mysqlRows) Close() error {
+	err := r.rowsIface.Close()
+	err = processMySQLError(err)
+	return err
+}
+
+type connIface interface {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.ConnBeginTx
+}
+
+type stmtIface interface {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+}
+
+type rowsIface interface {
+	driver.Rows
+	driver.RowsColumnTypeDatabaseTypeName
+	driver.RowsColumnTypeNullable
+	driver.RowsColumnTypePrecisionScale
+	driver.RowsColumnTypeScanType
+}
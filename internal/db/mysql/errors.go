@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mysql
+
+import (
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+// processMySQLError processes an error, replacing any
+// mysql specific errors with our own error type
+func processMySQLError(err error) error {
+	// Attempt to cast as mysql error.
+	myErr, ok := err.(*mysql.MySQLError)
+	if !ok {
+		return err
+	}
+
+	// Handle supplied error code:
+	// (https://mariadb.com/kb/en/mariadb-error-code-reference/)
+	switch myErr.Number {
+	case 1062 /* ER_DUP_ENTRY */ :
+		return db.ErrAlreadyExists
+
+	// Our migrations check for idempotent DDL
+	// failures by matching against the wording
+	// of SQLite and Postgres errors, so ensure
+	// these get included in the error message.
+	case 1050, /* ER_TABLE_EXISTS_ERROR */
+		1060, /* ER_DUP_FIELDNAME */
+		1061 /* ER_DUP_KEYNAME */ :
+		return fmt.Errorf("%w: already exists (code=%d)", err, myErr.Number)
+	case 1091 /* ER_CANT_DROP_FIELD_OR_KEY */ :
+		return fmt.Errorf("%w: does not exist (code=%d)", err, myErr.Number)
+	}
+
+	// Wrap the returned error with the code
+	// for easier debugging later.
+	return fmt.Errorf("%w (code=%d)", err, myErr.Number)
+}
@@ -30,6 +30,8 @@ type Notification interface {
 	//
 	// Returned notifications will be ordered ID descending (ie., highest/newest to lowest/oldest).
 	// If types is empty, *all* notification types will be included.
+	// Notifications filtered by the account's notification policy are
+	// never included; see GetFilteredNotificationIDs for those.
 	GetAccountNotifications(ctx context.Context, accountID string, page *paging.Page, types []gtsmodel.NotificationType, excludeTypes []gtsmodel.NotificationType) ([]*gtsmodel.Notification, error)
 
 	// GetNotificationByID returns one notification according to its id.
@@ -48,6 +50,16 @@ type Notification interface {
 	// PutNotification will insert the given notification into the database.
 	PutNotification(ctx context.Context, notif *gtsmodel.Notification) error
 
+	// UpdateNotification updates the given notification in the database,
+	// only updating the given columns, or all if none are given.
+	UpdateNotification(ctx context.Context, notif *gtsmodel.Notification, columns ...string) error
+
+	// GetFilteredNotificationIDs returns the IDs of notifications targeting
+	// targetAccountID that were held back by its notification policy,
+	// ordered ID descending. If originAccountID is set, only notifications
+	// originating from that account will be included.
+	GetFilteredNotificationIDs(ctx context.Context, targetAccountID string, originAccountID string) ([]string, error)
+
 	// DeleteNotificationByID deletes one notification according to its id,
 	// and removes that notification from the in-memory cache.
 	DeleteNotificationByID(ctx context.Context, id string) error
@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// NotificationRequest handles getting/creation/updating of notification
+// requests, ie., bundles of notifications filtered by an account's policy.
+type NotificationRequest interface {
+	// GetNotificationRequestByID gets one notification request by its db id.
+	GetNotificationRequestByID(ctx context.Context, id string) (*gtsmodel.NotificationRequest, error)
+
+	// GetNotificationRequest gets the notification request
+	// held for accountID about originAccountID, if it exists.
+	GetNotificationRequest(ctx context.Context, accountID string, originAccountID string) (*gtsmodel.NotificationRequest, error)
+
+	// GetAccountNotificationRequests gets a page of pending (ie., not
+	// accepted) notification requests held for the given account,
+	// ordered ID descending (ie., highest/newest to lowest/oldest).
+	GetAccountNotificationRequests(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.NotificationRequest, error)
+
+	// CountAccountNotificationRequests counts the
+	// pending notification requests held for the given account.
+	CountAccountNotificationRequests(ctx context.Context, accountID string) (int, error)
+
+	// PopulateNotificationRequest populates the struct pointers on the given request.
+	PopulateNotificationRequest(ctx context.Context, req *gtsmodel.NotificationRequest) error
+
+	// PutNotificationRequest puts the given notification request in the database.
+	PutNotificationRequest(ctx context.Context, req *gtsmodel.NotificationRequest) error
+
+	// UpdateNotificationRequest updates one notification request by its db id.
+	// If any columns are set, only they will be updated.
+	UpdateNotificationRequest(ctx context.Context, req *gtsmodel.NotificationRequest, columns ...string) error
+
+	// DeleteNotificationRequestByID deletes one notification request by its db id.
+	DeleteNotificationRequestByID(ctx context.Context, id string) error
+
+	// DeleteNotificationRequests deletes all notification
+	// requests held for, or about, the given account.
+	DeleteNotificationRequests(ctx context.Context, accountID string) error
+}
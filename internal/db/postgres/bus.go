@@ -0,0 +1,224 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+const (
+	// maxPayload is the maximum NOTIFY
+	// payload size accepted by Postgres.
+	maxPayload = 7999
+
+	// maxChunkData is the maximum payload data
+	// sent in any one chunk, leaving room for
+	// the chunk header within maxPayload.
+	maxChunkData = maxPayload - 64
+
+	// chunkPrefix marks a NOTIFY payload as
+	// one chunk of a larger published payload.
+	chunkPrefix = "#"
+
+	// chunkTimeout is how long to wait for the
+	// remaining chunks of a payload to arrive.
+	chunkTimeout = time.Minute
+)
+
+// Bus implements pubsub.Bus{} on top
+// of PostgreSQL LISTEN / NOTIFY. Messages
+// are published over a small connection pool,
+// with each subscription using its own conn.
+//
+// Payloads larger than Postgres' NOTIFY limit
+// are split into chunks, reassembled on receipt.
+type Bus struct {
+	config  *pgx.ConnConfig
+	channel string
+	pub     *sql.DB
+}
+
+// NewBus returns a new Bus connecting to the database
+// with given config, publishing to the named channel.
+func NewBus(config *pgx.ConnConfig, channel string) *Bus {
+	pub := stdlib.OpenDB(*config.Copy())
+
+	// Publishing is infrequent
+	// and fast, one conn suffices.
+	pub.SetMaxOpenConns(1)
+
+	return &Bus{
+		config:  config,
+		channel: channel,
+		pub:     pub,
+	}
+}
+
+// Publish implements pubsub.Bus{}.
+func (b *Bus) Publish(ctx context.Context, payload string) error {
+	if len(payload) <= maxPayload &&
+		!strings.HasPrefix(payload, chunkPrefix) {
+		// Small enough to send as-is.
+		return b.notify(ctx, payload)
+	}
+
+	chunks := splitPayload(payload)
+	msgID := id.NewULID()
+
+	for i, chunk := range chunks {
+		header := chunkPrefix + msgID +
+			":" + strconv.Itoa(i) +
+			":" + strconv.Itoa(len(chunks)) +
+			":"
+		if err := b.notify(ctx, header+chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *Bus) notify(ctx context.Context, payload string) error {
+	_, err := b.pub.ExecContext(ctx, "SELECT pg_notify($1, $2)", b.channel, payload)
+	return processPostgresError(err)
+}
+
+// Subscribe implements pubsub.Bus{}.
+func (b *Bus) Subscribe(ctx context.Context, recv func(payload string)) error {
+	conn, err := pgx.ConnectConfig(ctx, b.config.Copy())
+	if err != nil {
+		return processPostgresError(err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	// Channel names are identifiers, not
+	// values, so can't be a query argument.
+	listen := "LISTEN " + pgx.Identifier{b.channel}.Sanitize()
+	if _, err := conn.Exec(ctx, listen); err != nil {
+		return processPostgresError(err)
+	}
+
+	// Partially received chunked payloads.
+	partials := make(map[string]*partial)
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return processPostgresError(err)
+		}
+
+		if !strings.HasPrefix(n.Payload, chunkPrefix) {
+			// Unchunked payload.
+			recv(n.Payload)
+			continue
+		}
+
+		if payload, ok := joinChunk(partials, n.Payload); ok {
+			recv(payload)
+		}
+	}
+}
+
+// Close implements pubsub.Bus{}.
+func (b *Bus) Close() error {
+	return b.pub.Close()
+}
+
+// partial is a partially received chunked payload.
+type partial struct {
+	chunks []string
+	count  int
+	first  time.Time
+}
+
+// splitPayload splits payload into chunks of at
+// most maxChunkData bytes, on UTF-8 rune boundaries
+// as NOTIFY payloads must be valid text.
+func splitPayload(payload string) []string {
+	var chunks []string
+	for len(payload) > maxChunkData {
+		n := maxChunkData
+		for n > 0 && !utf8.RuneStart(payload[n]) {
+			n--
+		}
+		chunks = append(chunks, payload[:n])
+		payload = payload[n:]
+	}
+	return append(chunks, payload)
+}
+
+// joinChunk adds chunk to partials, returning
+// the full payload once all chunks are received.
+func joinChunk(partials map[string]*partial, chunk string) (string, bool) {
+	now := time.Now()
+
+	// Drop any partials which have timed out,
+	// ie. whose publisher must have gone away.
+	for msgID, p := range partials {
+		if now.Sub(p.first) > chunkTimeout {
+			delete(partials, msgID)
+		}
+	}
+
+	// Parse the "#{msgID}:{idx}:{total}:" header.
+	parts := strings.SplitN(chunk[len(chunkPrefix):], ":", 4)
+	if len(parts) != 4 {
+		return "", false
+	}
+	msgID := parts[0]
+	idx, err1 := strconv.Atoi(parts[1])
+	total, err2 := strconv.Atoi(parts[2])
+	if err1 != nil || err2 != nil ||
+		total < 1 || idx < 0 || idx >= total {
+		return "", false
+	}
+
+	p := partials[msgID]
+	if p == nil {
+		p = &partial{
+			chunks: make([]string, total),
+			first:  now,
+		}
+		partials[msgID] = p
+	}
+
+	if len(p.chunks) != total || p.chunks[idx] != "" {
+		// Mismatched or duplicate chunk.
+		return "", false
+	}
+
+	p.chunks[idx] = parts[3]
+	p.count++
+
+	if p.count < total {
+		// Still waiting.
+		return "", false
+	}
+
+	delete(partials, msgID)
+	return strings.Join(p.chunks, ""), true
+}
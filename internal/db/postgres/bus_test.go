@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package postgres
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestChunkedPayload(t *testing.T) {
+	// Multi-byte runes ensure chunks
+	// are split on rune boundaries.
+	payload := strings.Repeat("héllo wörld ", 2000)
+
+	chunks := splitPayload(payload)
+	if len(chunks) < 2 {
+		t.Fatalf("expected payload to be split, got %d chunks", len(chunks))
+	}
+
+	partials := make(map[string]*partial)
+
+	// Deliver chunks in reverse order.
+	for i := len(chunks) - 1; i >= 0; i-- {
+		if len(chunks[i]) > maxChunkData || !utf8.ValidString(chunks[i]) {
+			t.Fatalf("invalid chunk %d", i)
+		}
+
+		chunk := chunkPrefix + "msg:" +
+			strconv.Itoa(i) + ":" +
+			strconv.Itoa(len(chunks)) + ":" +
+			chunks[i]
+
+		joined, ok := joinChunk(partials, chunk)
+		if ok != (i == 0) {
+			t.Fatalf("unexpected join result at chunk %d: %v", i, ok)
+		}
+		if ok && joined != payload {
+			t.Fatal("joined payload does not match")
+		}
+	}
+
+	if len(partials) != 0 {
+		t.Fatalf("expected no partials left, got %d", len(partials))
+	}
+}
@@ -0,0 +1,304 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// lagCheckEvery is how often
+// replica lag is checked for.
+const lagCheckEvery = 5 * time.Second
+
+// lagQuery returns the replication lag of a replica in seconds,
+// or NULL if it can't be determined. A replica that has replayed
+// all the WAL it has received is considered to have no lag, as
+// the last replay timestamp is stale when the primary is idle.
+const lagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() THEN 0
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+END::float8`
+
+// ReplicaConnector wraps a driver.Connector{} to a
+// primary PostgreSQL database, along with connectors
+// to read-only replicas of it. Connections opened by
+// it send read-only queries made outside transactions
+// to a replica, and everything else to the primary.
+//
+// Replicas are checked periodically for replication
+// lag, and any replica that is unreachable or lagging
+// behind the primary by more than the maximum lag is
+// skipped in favour of the primary until it catches up.
+type ReplicaConnector struct {
+	primary  driver.Connector
+	replicas []*replica
+	next     atomic.Uint32
+	cancel   context.CancelFunc
+}
+
+// replica wraps a driver.Connector{}
+// to a read-only replica, with its
+// health as determined by lag checks.
+type replica struct {
+	name      string
+	connector driver.Connector
+	db        *sql.DB
+	ok        atomic.Bool
+}
+
+// NewReplicaConnector returns a new ReplicaConnector{} for given
+// primary and replica connectors, starting replica lag checks.
+// Replicas are only used once their first lag check has passed.
+func NewReplicaConnector(
+	primary driver.Connector,
+	replicas map[string]driver.Connector,
+	maxLag time.Duration,
+) *ReplicaConnector {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &ReplicaConnector{
+		primary:  primary,
+		replicas: make([]*replica, 0, len(replicas)),
+		cancel:   cancel,
+	}
+
+	for name, connector := range replicas {
+		// Lag checks get their own
+		// small connection pool.
+		sqldb := sql.OpenDB(connector)
+		sqldb.SetMaxOpenConns(1)
+
+		r := &replica{
+			name:      name,
+			connector: connector,
+			db:        sqldb,
+		}
+
+		c.replicas = append(c.replicas, r)
+		go r.checkLag(ctx, maxLag)
+	}
+
+	return c
+}
+
+func (c *ReplicaConnector) Driver() driver.Driver { return c.primary.Driver() }
+
+func (c *ReplicaConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.primary.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &replicaConn{connIface: conn.(connIface), connector: c}, nil
+}
+
+// Close stops replica lag checks, closing their
+// connection pools. This is called on sql.DB{}.Close().
+func (c *ReplicaConnector) Close() error {
+	c.cancel()
+	var errs []error
+	for _, r := range c.replicas {
+		errs = append(errs, r.db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// pick returns the next healthy replica
+// in round-robin order, or nil if none are.
+func (c *ReplicaConnector) pick() *replica {
+	for range c.replicas {
+		i := c.next.Add(1) % uint32(len(c.replicas)) // #nosec G115 -- Won't overflow.
+		if r := c.replicas[i]; r.ok.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+// checkLag periodically checks replication lag of replica
+// until context is cancelled, marking it as (un)healthy.
+func (r *replica) checkLag(ctx context.Context, maxLag time.Duration) {
+	ticker := time.NewTicker(lagCheckEvery)
+	defer ticker.Stop()
+
+	for {
+		var lag sql.NullFloat64
+
+		err := r.db.QueryRowContext(ctx, lagQuery).Scan(&lag)
+		switch {
+		case ctx.Err() != nil:
+			return
+
+		case err != nil:
+			if r.ok.Swap(false) {
+				log.Warnf(ctx, "postgres replica %s unavailable: %v", r.name, err)
+			}
+
+		case !lag.Valid || time.Duration(lag.Float64*float64(time.Second)) > maxLag:
+			if r.ok.Swap(false) {
+				log.Warnf(ctx, "postgres replica %s lagging by more than %s", r.name, maxLag)
+			}
+
+		default:
+			if !r.ok.Swap(true) {
+				log.Infof(ctx, "postgres replica %s available", r.name)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicaConn wraps a connection to the primary
+// database, routing read-only queries made outside
+// of transactions to a replica where one is healthy.
+type replicaConn struct {
+	connIface
+	connector *ReplicaConnector
+
+	// replica connection,
+	// opened on first use.
+	replica *replica
+	rconn   connIface
+
+	// whether a transaction
+	// is currently ongoing.
+	tx bool
+}
+
+func (c *replicaConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *replicaConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.connIface.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.tx = true
+	return &replicaTx{Tx: tx, conn: c}, nil
+}
+
+func (c *replicaConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return c.QueryContext(context.Background(), query, db.ToNamedValues(args))
+}
+
+func (c *replicaConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if !c.tx && isReadOnly(query) {
+		if rconn := c.replicaConn(ctx); rconn != nil {
+			rows, err := rconn.QueryContext(ctx, query, args)
+			if err == nil || ctx.Err() != nil {
+				return rows, err
+			}
+
+			// Drop the replica connection on
+			// error, and fall back to primary.
+			log.Debugf(ctx, "falling back to primary after replica %s error: %v", c.replica.name, err)
+			c.closeReplica()
+		}
+	}
+	return c.connIface.QueryContext(ctx, query, args)
+}
+
+func (c *replicaConn) Close() error {
+	c.closeReplica()
+	return c.connIface.Close()
+}
+
+// replicaConn returns a connection to a healthy
+// replica, opening one if necessary, else nil.
+func (c *replicaConn) replicaConn(ctx context.Context) connIface {
+	if c.rconn != nil {
+		if c.replica.ok.Load() {
+			return c.rconn
+		}
+
+		// Replica became unhealthy, close
+		// connection so another is picked.
+		c.closeReplica()
+	}
+
+	r := c.connector.pick()
+	if r == nil {
+		return nil
+	}
+
+	conn, err := r.connector.Connect(ctx)
+	if err != nil {
+		log.Debugf(ctx, "error connecting to replica %s: %v", r.name, err)
+		return nil
+	}
+
+	c.replica = r
+	c.rconn = conn.(connIface)
+	return c.rconn
+}
+
+// closeReplica closes any open replica connection.
+func (c *replicaConn) closeReplica() {
+	if c.rconn != nil {
+		_ = c.rconn.Close()
+		c.rconn = nil
+		c.replica = nil
+	}
+}
+
+// replicaTx wraps a transaction on the
+// primary database, to mark the end of
+// the transaction on its connection.
+type replicaTx struct {
+	driver.Tx
+	conn *replicaConn
+}
+
+func (tx *replicaTx) Commit() error {
+	tx.conn.tx = false
+	return tx.Tx.Commit()
+}
+
+func (tx *replicaTx) Rollback() error {
+	tx.conn.tx = false
+	return tx.Tx.Rollback()
+}
+
+// isReadOnly returns whether query is a plain
+// SELECT query, that doesn't take any row locks.
+func isReadOnly(query string) bool {
+	query = strings.TrimSpace(query)
+	if len(query) < 6 || !strings.EqualFold(query[:6], "SELECT") {
+		return false
+	}
+	query = strings.ToUpper(query)
+	return !strings.Contains(query, " FOR UPDATE") &&
+		!strings.Contains(query, " FOR NO KEY UPDATE") &&
+		!strings.Contains(query, " FOR SHARE") &&
+		!strings.Contains(query, " FOR KEY SHARE")
+}
@@ -164,6 +164,11 @@ type Relationship interface {
 	// GetAccountLocalFollowerIDs is like GetAccountLocalFollowers, but returns just IDs.
 	GetAccountLocalFollowerIDs(ctx context.Context, accountID string) ([]string, error)
 
+	// GetFamiliarFollowers returns follows targeting any of the given target account IDs,
+	// whose origin account is itself followed by the given account ID, ie., the followers
+	// of each target account that the given account also follows. Uses a single query.
+	GetFamiliarFollowers(ctx context.Context, accountID string, targetAccountIDs []string) ([]*gtsmodel.Follow, error)
+
 	// GetAccountFollowRequests returns all follow requests targeting the given account.
 	GetAccountFollowRequests(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.FollowRequest, error)
 
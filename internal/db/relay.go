@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Relay handles getting/creation/deletion/updating of relay subscriptions.
+type Relay interface {
+	// GetRelayByID gets one relay by its db id.
+	GetRelayByID(ctx context.Context, id string) (*gtsmodel.Relay, error)
+
+	// GetRelayByFollowURI gets one relay by the URI
+	// of the Follow sent to it by the instance account.
+	GetRelayByFollowURI(ctx context.Context, followURI string) (*gtsmodel.Relay, error)
+
+	// GetRelayByActorURI gets one relay by the URI of the relay actor.
+	GetRelayByActorURI(ctx context.Context, actorURI string) (*gtsmodel.Relay, error)
+
+	// GetRelays gets all relays, newest first.
+	GetRelays(ctx context.Context) ([]*gtsmodel.Relay, error)
+
+	// PutRelay puts the given relay in the database.
+	PutRelay(ctx context.Context, relay *gtsmodel.Relay) error
+
+	// UpdateRelay updates one relay by its db id.
+	// If no columns are specified, every column is updated.
+	UpdateRelay(ctx context.Context, relay *gtsmodel.Relay, columns ...string) error
+
+	// DeleteRelayByID deletes one relay by its db id.
+	DeleteRelayByID(ctx context.Context, id string) error
+}
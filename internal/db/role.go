@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Role interface {
+	// GetRoleByID fetches the custom role with ID from the database.
+	GetRoleByID(ctx context.Context, id string) (*gtsmodel.Role, error)
+
+	// GetRoles fetches all custom roles from the database, sorted by name.
+	GetRoles(ctx context.Context) ([]*gtsmodel.Role, error)
+
+	// PutRole inserts the given custom role into the database.
+	PutRole(ctx context.Context, role *gtsmodel.Role) error
+
+	// UpdateRole updates the given custom role in the database, only updating given columns if provided.
+	UpdateRole(ctx context.Context, role *gtsmodel.Role, columns ...string) error
+
+	// DeleteRoleByID deletes the custom role with ID from
+	// the database, taking it away from any users that had it.
+	DeleteRoleByID(ctx context.Context, id string) error
+}
@@ -27,10 +27,23 @@ type Search interface {
 	// SearchForAccounts uses the given query text to search for accounts that accountID follows.
 	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, offset int) ([]*gtsmodel.Account, error)
 
-	// SearchForStatuses uses the given query text to search for statuses created by requestingAccountID, or in reply to requestingAccountID.
+	// SearchForStatuses uses the given query text to search the full-text index for statuses created by requestingAccountID,
+	// in reply to or mentioning requestingAccountID, or faved or bookmarked by requestingAccountID.
 	// If fromAccountID is used, the results are restricted to statuses created by fromAccountID.
 	SearchForStatuses(ctx context.Context, requestingAccountID string, query string, fromAccountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
 
+	// ReindexStatuses rebuilds the full-text search index of statuses from scratch.
+	ReindexStatuses(ctx context.Context) error
+
+	// GetSearchableStatusIDs returns those of the given status IDs that would be in scope
+	// for a SearchForStatuses call by requestingAccountID, in the order they were given.
+	// This is used to restrict results from an external search backend.
+	GetSearchableStatusIDs(ctx context.Context, requestingAccountID string, statusIDs []string) ([]string, error)
+
+	// GetSearchIndexableStatusIDs returns up to limit IDs of statuses (excluding boosts) lower
+	// than maxID, newest first. This is used to page through statuses when reindexing.
+	GetSearchIndexableStatusIDs(ctx context.Context, maxID string, limit int) ([]string, error)
+
 	// SearchForTags searches for tags that start with the given query text (case insensitive).
 	SearchForTags(ctx context.Context, query string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Tag, error)
 }
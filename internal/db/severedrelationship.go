@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// SeveredRelationship handles getting/creation of relationship
+// severance events, and the relationships severed by them.
+type SeveredRelationship interface {
+	// GetSeveranceEventByID gets one relationship severance event by its db id.
+	GetSeveranceEventByID(ctx context.Context, id string) (*gtsmodel.RelationshipSeveranceEvent, error)
+
+	// GetSeveranceEventByOriginID gets the relationship severance event
+	// caused by the domain block or account suspension with the given id.
+	GetSeveranceEventByOriginID(ctx context.Context, originID string) (*gtsmodel.RelationshipSeveranceEvent, error)
+
+	// GetAccountSeveranceEvents gets a page of relationship severance
+	// events, newest first, in which the given account lost relationships.
+	GetAccountSeveranceEvents(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.RelationshipSeveranceEvent, error)
+
+	// PutSeveranceEvent puts the given relationship severance event in the database.
+	PutSeveranceEvent(ctx context.Context, event *gtsmodel.RelationshipSeveranceEvent) error
+
+	// GetSeveredRelationships gets the relationships of the given account
+	// that were severed by the given event. If direction is set, only
+	// relationships in that direction will be returned.
+	GetSeveredRelationships(ctx context.Context, eventID string, accountID string, direction gtsmodel.SeveredDirection) ([]*gtsmodel.SeveredRelationship, error)
+
+	// PopulateSeveredRelationship populates the struct pointers on the given severed relationship.
+	PopulateSeveredRelationship(ctx context.Context, rel *gtsmodel.SeveredRelationship) error
+
+	// PutSeveredRelationship puts the given severed relationship in the database.
+	PutSeveredRelationship(ctx context.Context, rel *gtsmodel.SeveredRelationship) error
+
+	// DeleteSeveredRelationshipsByAccountID deletes all
+	// severed relationships belonging to the given account.
+	DeleteSeveredRelationshipsByAccountID(ctx context.Context, accountID string) error
+}
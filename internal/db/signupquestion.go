@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type SignupQuestion interface {
+	// GetSignupQuestion fetches the sign-up question with ID from the database.
+	GetSignupQuestion(ctx context.Context, id string) (*gtsmodel.SignupQuestion, error)
+
+	// GetSignupQuestions fetches all sign-up questions from the database, oldest first.
+	GetSignupQuestions(ctx context.Context) ([]*gtsmodel.SignupQuestion, error)
+
+	// PutSignupQuestion inserts the given sign-up question into the database.
+	PutSignupQuestion(ctx context.Context, question *gtsmodel.SignupQuestion) error
+
+	// UpdateSignupQuestion updates the given sign-up question in the database, only updating given columns if provided.
+	UpdateSignupQuestion(ctx context.Context, question *gtsmodel.SignupQuestion, columns ...string) error
+
+	// DeleteSignupQuestion deletes the sign-up question with ID from the database.
+	// Answers already given to the question are kept.
+	DeleteSignupQuestion(ctx context.Context, id string) error
+
+	// GetSignupAnswersByUserID fetches the answers given to
+	// sign-up questions by the user with ID, in question order.
+	GetSignupAnswersByUserID(ctx context.Context, userID string) ([]*gtsmodel.SignupAnswer, error)
+}
@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// SpamFlag handles getting/creation/updating of spam flags
+// recorded against incoming statuses by the spam scorer.
+type SpamFlag interface {
+	// GetSpamFlagByID gets one spam flag by its db id.
+	GetSpamFlagByID(ctx context.Context, id string) (*gtsmodel.SpamFlag, error)
+
+	// GetSpamFlags gets a page of spam flags, newest first,
+	// optionally only those for statuses by the given account ID.
+	GetSpamFlags(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.SpamFlag, error)
+
+	// CountSpamFalsePositives returns the number of spam flags against
+	// statuses by the given account that were marked as false positives.
+	CountSpamFalsePositives(ctx context.Context, accountID string) (int, error)
+
+	// PopulateSpamFlag populates the struct pointers on the given spam flag.
+	PopulateSpamFlag(ctx context.Context, flag *gtsmodel.SpamFlag) error
+
+	// PutSpamFlag puts the given spam flag in the database.
+	PutSpamFlag(ctx context.Context, flag *gtsmodel.SpamFlag) error
+
+	// UpdateSpamFlag updates one spam flag by its db id.
+	// If any columns are set, only they will be updated.
+	UpdateSpamFlag(ctx context.Context, flag *gtsmodel.SpamFlag, columns ...string) error
+}
@@ -48,6 +48,7 @@ func processSQLiteError(err error) error {
 	// which should give necessary timeout.
 	case sqlite3.BUSY_RECOVERY,
 		sqlite3.BUSY_SNAPSHOT:
+		busyRetries.Add(1)
 		return driver.ErrBadConn
 	}
 
@@ -51,6 +51,7 @@ func processSQLiteError(err error) error {
 	case sqlite3.SQLITE_BUSY,
 		sqlite3.SQLITE_BUSY_RECOVERY,
 		sqlite3.SQLITE_BUSY_SNAPSHOT:
+		busyRetries.Add(1)
 		return driver.ErrBadConn
 	}
 
@@ -0,0 +1,32 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlite
+
+import "sync/atomic"
+
+// busyRetries counts the number of times a busy
+// error has been handed back to database/sql as
+// driver.ErrBadConn, causing it to re-attempt.
+var busyRetries atomic.Uint64
+
+// BusyRetries returns the number of queries and
+// transactions that have been re-attempted by
+// database/sql on account of the database being busy.
+func BusyRetries() uint64 {
+	return busyRetries.Load()
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -79,6 +80,18 @@ type Status interface {
 	// GetStatusChildren gets the child statuses of a given status.
 	GetStatusChildren(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
 
+	// GetStatusEdits returns snapshots of the previous
+	// revisions of the given status ID, oldest first.
+	GetStatusEdits(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, error)
+
+	// PutStatusEdit stores a snapshot of a previous revision of a status.
+	PutStatusEdit(ctx context.Context, edit *gtsmodel.StatusEdit) error
+
+	// GetExpiredStatuses returns up to limit statuses, oldest first,
+	// created before olderThan by the account owning given settings.
+	// Statuses exempt from expiry by the settings are not included.
+	GetExpiredStatuses(ctx context.Context, settings *gtsmodel.AccountSettings, olderThan time.Time, limit int) ([]*gtsmodel.Status, error)
+
 	// MaxDirectStatusID returns the newest ID across all DM statuses.
 	// Returns the empty string with no error if there are no DM statuses yet.
 	// It is used only by the conversation advanced migration.
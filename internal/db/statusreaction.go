@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusReaction interface {
+	// GetStatusReaction gets one status reaction created by the given
+	// accountID, targeting the given statusID, with the given name.
+	GetStatusReaction(ctx context.Context, accountID string, statusID string, name string) (*gtsmodel.StatusReaction, error)
+
+	// GetStatusReactionByURI returns one status reaction with the given uri.
+	GetStatusReactionByURI(ctx context.Context, uri string) (*gtsmodel.StatusReaction, error)
+
+	// GetStatusReactions returns a slice of reactions to the status with given ID, oldest first.
+	// This slice will be unfiltered, not taking account of blocks and whatnot, so filter it before serving it back to a user.
+	GetStatusReactions(ctx context.Context, statusID string) ([]*gtsmodel.StatusReaction, error)
+
+	// PopulateStatusReaction ensures that all sub-models of a reaction are populated (account, status, emoji etc).
+	PopulateStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error
+
+	// PutStatusReaction inserts the given reaction into the database.
+	PutStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error
+
+	// UpdateStatusReaction updates one reaction in the database.
+	UpdateStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction, columns ...string) error
+
+	// DeleteStatusReactionByID deletes one status reaction with the given id.
+	DeleteStatusReactionByID(ctx context.Context, id string) error
+
+	// DeleteStatusReactions mass deletes status reactions targeting targetAccountID
+	// and/or originating from originAccountID, as with DeleteStatusFaves.
+	//
+	// At least one parameter must not be an empty string.
+	DeleteStatusReactions(ctx context.Context, targetAccountID string, originAccountID string) error
+
+	// DeleteStatusReactionsForStatus deletes all status reactions that target the given status ID.
+	// This is useful when a status has been deleted, and you need to clean up after it.
+	DeleteStatusReactionsForStatus(ctx context.Context, statusID string) error
+}
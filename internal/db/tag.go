@@ -57,4 +57,8 @@ type Tag interface {
 
 	// GetAccountIDsFollowingTagIDs returns the account IDs of any followers of the given tag IDs.
 	GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, error)
+
+	// GetTagWebStatuses returns up to limit of the most recent public, federated statuses
+	// with the given tag ID, created by local accounts that expose statuses via the web.
+	GetTagWebStatuses(ctx context.Context, tagID string, limit int) ([]*gtsmodel.Status, error)
 }
@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type TermsOfService interface {
+	// GetTermsOfServiceByID fetches the terms of service version with ID from the database.
+	GetTermsOfServiceByID(ctx context.Context, id string) (*gtsmodel.TermsOfService, error)
+
+	// GetAllTermsOfService fetches every published terms of service version from the database, newest first.
+	GetAllTermsOfService(ctx context.Context) ([]*gtsmodel.TermsOfService, error)
+
+	// PutTermsOfService inserts the given terms of service version into the database.
+	PutTermsOfService(ctx context.Context, tos *gtsmodel.TermsOfService) error
+}
@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Trend handles getting/creation/deletion/updating of trending
+// tags, statuses and links, and gathering of the recent activity
+// that trends are computed from.
+type Trend interface {
+	// GetTrendByID gets one trend by its db id.
+	GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error)
+
+	// GetTrendByTarget gets one trend of the given type by the ID
+	// of the trending tag or status, or the URL of the trending link.
+	GetTrendByTarget(ctx context.Context, trendType gtsmodel.TrendType, target string) (*gtsmodel.Trend, error)
+
+	// PopulateTrend ensures that the trend's Tag or Status
+	// (depending on its type) and History are populated.
+	PopulateTrend(ctx context.Context, trend *gtsmodel.Trend) error
+
+	// GetTrends gets trends of the given type, highest score first. If reviewStates
+	// is not empty, only trends in one of the given review states are returned. If
+	// trending is true, only trends that are currently trending (ie., score > 0) are
+	// returned. Limit 0 means no limit.
+	GetTrends(
+		ctx context.Context,
+		trendType gtsmodel.TrendType,
+		reviewStates []gtsmodel.TrendReviewState,
+		trending bool,
+		limit int,
+		offset int,
+	) ([]*gtsmodel.Trend, error)
+
+	// PutTrend puts the given trend in the database.
+	PutTrend(ctx context.Context, trend *gtsmodel.Trend) error
+
+	// UpdateTrend updates the given trend by its db id. If columns
+	// is empty, all columns will be updated. UpdatedAt is always set.
+	UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error
+
+	// DeleteTrendByID deletes one trend, and its history, by its db id.
+	DeleteTrendByID(ctx context.Context, id string) error
+
+	// DeleteTrendByTarget deletes the trend (if any) of the given type with
+	// the given target, and its history. Used when the target is deleted.
+	DeleteTrendByTarget(ctx context.Context, trendType gtsmodel.TrendType, target string) error
+
+	// GetTrendHistory gets the daily usage history of the trend with the given ID, newest first.
+	GetTrendHistory(ctx context.Context, trendID string) ([]*gtsmodel.TrendHistory, error)
+
+	// ReplaceTrendHistory replaces the daily usage history of the trend with the given ID.
+	ReplaceTrendHistory(ctx context.Context, trendID string, history []*gtsmodel.TrendHistory) error
+
+	// GetTagTrendActivity gets uses of listable hashtags in public statuses
+	// created since the given time, with Target set to the ID of the tag.
+	GetTagTrendActivity(ctx context.Context, since time.Time) ([]*gtsmodel.TrendActivity, error)
+
+	// GetStatusTrendActivity gets faves and boosts created since the given time
+	// of public, non-sensitive statuses by discoverable accounts, with Target set
+	// to the ID of the faved / boosted status.
+	GetStatusTrendActivity(ctx context.Context, since time.Time) ([]*gtsmodel.TrendActivity, error)
+
+	// GetLinkTrendStatuses gets barebones models (ID, AccountID, CreatedAt and
+	// Content only) of public statuses created since the given time which may
+	// contain links, with ID lower than maxID, newest first, for computing
+	// link trends from. Limit 0 means no limit.
+	GetLinkTrendStatuses(ctx context.Context, since time.Time, maxID string, limit int) ([]*gtsmodel.Status, error)
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -28,9 +29,16 @@ type User interface {
 	// GetAllUsers returns all local user accounts, or an error if something goes wrong.
 	GetAllUsers(ctx context.Context) ([]*gtsmodel.User, error)
 
+	// GetUsersPendingDeletion returns all local users who asked
+	// for their account to be deleted at or before the given time.
+	GetUsersPendingDeletion(ctx context.Context, requestedBefore time.Time) ([]*gtsmodel.User, error)
+
 	// GetUserByID returns one user with the given ID, or an error if something goes wrong.
 	GetUserByID(ctx context.Context, id string) (*gtsmodel.User, error)
 
+	// GetUsersByIDs returns the users with the given IDs, in the same order.
+	GetUsersByIDs(ctx context.Context, ids []string) ([]*gtsmodel.User, error)
+
 	// GetUserByAccountID returns one user by its account ID, or an error if something goes wrong.
 	GetUserByAccountID(ctx context.Context, accountID string) (*gtsmodel.User, error)
 
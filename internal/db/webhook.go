@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Webhook interface {
+	// GetWebhookByID fetches the webhook with ID from the database.
+	GetWebhookByID(ctx context.Context, id string) (*gtsmodel.Webhook, error)
+
+	// GetWebhooks fetches all webhooks from the database, oldest first.
+	GetWebhooks(ctx context.Context) ([]*gtsmodel.Webhook, error)
+
+	// PutWebhook inserts the given webhook into the database.
+	PutWebhook(ctx context.Context, webhook *gtsmodel.Webhook) error
+
+	// UpdateWebhook updates the given webhook in the database, only updating given columns if provided.
+	UpdateWebhook(ctx context.Context, webhook *gtsmodel.Webhook, columns ...string) error
+
+	// DeleteWebhookByID deletes the webhook with ID from the database.
+	DeleteWebhookByID(ctx context.Context, id string) error
+}
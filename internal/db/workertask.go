@@ -27,6 +27,11 @@ type WorkerTask interface {
 	// GetWorkerTasks fetches all persisted worker tasks from the database.
 	GetWorkerTasks(ctx context.Context) ([]*gtsmodel.WorkerTask, error)
 
+	// ClaimWorkerTasks fetches up to limit persisted worker tasks from
+	// the database (oldest first), deleting them as they're fetched so
+	// that no other process sharing the database can also claim them.
+	ClaimWorkerTasks(ctx context.Context, limit int) ([]*gtsmodel.WorkerTask, error)
+
 	// PutWorkerTasks persists the given worker tasks to the database.
 	PutWorkerTasks(ctx context.Context, tasks []*gtsmodel.WorkerTask) error
 
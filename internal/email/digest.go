@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+const (
+	digestTemplate = "email_digest.tmpl"
+	digestSubject  = "GoToSocial Activity Digest"
+)
+
+type DigestData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// Number of days covered by this digest.
+	Days int
+	// Accounts (@username@domain) that
+	// have followed the receiver recently.
+	NewFollowers []string
+	// Statuses that have recently
+	// mentioned the receiver.
+	Mentions []DigestMention
+	// Number of currently pending follow
+	// requests targeting the receiver.
+	FollowRequestsCount int
+	// URL to change email digest
+	// settings in the settings panel.
+	SettingsURL string
+}
+
+// DigestMention models one mention of
+// the receiver for inclusion in a digest.
+type DigestMention struct {
+	// Account (@username@domain) that
+	// created the mentioning status.
+	Account string
+	// Web URL of the mentioning status.
+	URL string
+}
+
+func (s *sender) SendDigestEmail(toAddress string, data DigestData) error {
+	return s.sendTemplate(digestTemplate, digestSubject, data, toAddress)
+}
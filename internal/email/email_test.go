@@ -220,6 +220,52 @@ func (suite *EmailTestSuite) TestTemplateReportClosedLocalAccountNoComment() {
 	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Report Closed\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello !\r\n\r\nYou recently reported the account @1happyturtle to the moderator(s) of Test Instance (https://example.org).\r\n\r\nThe report you submitted has now been closed.\r\n\r\nThe moderator who closed the report did not leave a comment.\r\n\r\n---\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *EmailTestSuite) TestTemplateDigest() {
+	digestData := email.DigestData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Days:         7,
+		NewFollowers: []string{
+			"@1happyturtle@example.org",
+			"@foss_satan@fossbros-anonymous.io",
+		},
+		Mentions: []email.DigestMention{
+			{
+				Account: "@admin@example.org",
+				URL:     "https://example.org/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+			},
+		},
+		FollowRequestsCount: 1,
+		SettingsURL:         "https://example.org/settings/user/emailpassword",
+	}
+
+	if err := suite.sender.SendDigestEmail("user@example.org", digestData); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.stripHeaders()
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Activity Digest\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello test!\r\n\r\nHere's what happened on your account on Test Instance (https://example.org) in the last 7 days.\r\n\r\nNew followers:\r\n- @1happyturtle@example.org\r\n- @foss_satan@fossbros-anonymous.io\r\n\r\nMentions:\r\n- @admin@example.org: https://example.org/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R\r\n\r\nYou have 1 pending follow request waiting for your approval.\r\n\r\n---\r\n\r\nYou are receiving this mail because you enabled email digests for your account. To change how often you receive digests, or to turn them off, visit: https://example.org/settings/user/emailpassword\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestTemplateDigestOnlyFollowRequests() {
+	digestData := email.DigestData{
+		Username:            "test",
+		InstanceURL:         "https://example.org",
+		InstanceName:        "Test Instance",
+		Days:                1,
+		FollowRequestsCount: 2,
+		SettingsURL:         "https://example.org/settings/user/emailpassword",
+	}
+
+	if err := suite.sender.SendDigestEmail("user@example.org", digestData); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.stripHeaders()
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Activity Digest\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello test!\r\n\r\nHere's what happened on your account on Test Instance (https://example.org) in the last day.\r\n\r\nYou have 2 pending follow requests waiting for your approval.\r\n\r\n---\r\n\r\nYou are receiving this mail because you enabled email digests for your account. To change how often you receive digests, or to turn them off, visit: https://example.org/settings/user/emailpassword\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
 func TestEmailTestSuite(t *testing.T) {
 	suite.Run(t, new(EmailTestSuite))
 }
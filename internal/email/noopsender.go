@@ -83,6 +83,10 @@ func (s *noopSender) SendSignupRejectedEmail(toAddress string, data SignupReject
 	return s.sendTemplate(signupRejectedTemplate, signupRejectedSubject, data, toAddress)
 }
 
+func (s *noopSender) SendDigestEmail(toAddress string, data DigestData) error {
+	return s.sendTemplate(digestTemplate, digestSubject, data, toAddress)
+}
+
 func (s *noopSender) sendTemplate(template string, subject string, data any, toAddresses ...string) error {
 	buf := &bytes.Buffer{}
 	if err := s.template.ExecuteTemplate(buf, template, data); err != nil {
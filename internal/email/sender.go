@@ -61,6 +61,10 @@ type Sender interface {
 	// SendSignupRejectedEmail sends an email to the given address
 	// that their sign-up request has been rejected by a moderator.
 	SendSignupRejectedEmail(toAddress string, data SignupRejectedData) error
+
+	// SendDigestEmail sends a periodic digest of new followers,
+	// mentions, and pending follow requests to the given address.
+	SendDigestEmail(toAddress string, data DigestData) error
 }
 
 // NewSender returns a new email Sender interface with the given configuration, or an error if something goes wrong.
@@ -81,9 +85,20 @@ func NewSender() (Sender, error) {
 		smtpAuth  smtp.Auth
 	)
 
-	if username == "" || password == "" {
+	switch {
+	case config.GetSMTPAuthMethod() == config.SMTPAuthMethodXOAuth2:
+		smtpAuth = newXOAuth2Auth(
+			username,
+			host,
+			config.GetSMTPOAuth2TokenURL(),
+			config.GetSMTPOAuth2ClientID(),
+			config.GetSMTPOAuth2ClientSecret(),
+			config.GetSMTPOAuth2RefreshToken(),
+			config.GetSMTPOAuth2Scopes(),
+		)
+	case username == "" || password == "":
 		smtpAuth = nil
-	} else {
+	default:
 		smtpAuth = smtp.PlainAuth("", username, password, host)
 	}
 
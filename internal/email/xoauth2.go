@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"golang.org/x/oauth2"
+)
+
+// xoauth2Auth implements smtp.Auth using the
+// XOAUTH2 mechanism supported by (eg.) Gmail and
+// Microsoft 365, for relays with basic auth disabled.
+//
+// See: https://developers.google.com/gmail/imap/xoauth2-protocol
+type xoauth2Auth struct {
+	username string
+	host     string
+	tokens   oauth2.TokenSource
+}
+
+// newXOAuth2Auth returns an XOAUTH2 smtp.Auth for the given
+// username and host, which gets access tokens from the given
+// token URL using the given client details and refresh token.
+// Access tokens are cached, and refreshed once they expire.
+func newXOAuth2Auth(
+	username string,
+	host string,
+	tokenURL string,
+	clientID string,
+	clientSecret string,
+	refreshToken string,
+	scopes []string,
+) smtp.Auth {
+	cfg := &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: tokenURL},
+		Scopes:       scopes,
+	}
+
+	return &xoauth2Auth{
+		username: username,
+		host:     host,
+		tokens: cfg.TokenSource(
+			context.Background(),
+			&oauth2.Token{RefreshToken: refreshToken},
+		),
+	}
+}
+
+func (a *xoauth2Auth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	// Like smtp.PlainAuth, never send
+	// credentials over an unencrypted
+	// connection, except to localhost.
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+
+	token, err := a.tokens.Token()
+	if err != nil {
+		return "", nil, gtserror.Newf("error getting oauth2 access token: %w", err)
+	}
+
+	resp := "user=" + a.username + "\x01auth=Bearer " + token.AccessToken + "\x01\x01"
+	return "XOAUTH2", []byte(resp), nil
+}
+
+func (a *xoauth2Auth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if more {
+		// On failure, the server sends a
+		// JSON error challenge, which must
+		// be answered with an empty response
+		// to get the final error reply.
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
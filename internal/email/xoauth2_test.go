@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+)
+
+func TestXOAuth2Auth(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("grant_type") != "refresh_token" ||
+			r.PostForm.Get("refresh_token") != "some-refresh-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"some-access-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer srv.Close()
+
+	auth := newXOAuth2Auth(
+		"gotosocial@example.org",
+		"smtp.example.org",
+		srv.URL,
+		"some-client",
+		"some-secret",
+		"some-refresh-token",
+		[]string{"https://mail.google.com/"},
+	)
+
+	server := &smtp.ServerInfo{Name: "smtp.example.org", TLS: true}
+	for i := 0; i < 2; i++ {
+		mech, resp, err := auth.Start(server)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mech != "XOAUTH2" {
+			t.Fatalf("unexpected mechanism %s", mech)
+		}
+		if expect := "user=gotosocial@example.org\x01auth=Bearer some-access-token\x01\x01"; string(resp) != expect {
+			t.Fatalf("unexpected response %q", resp)
+		}
+	}
+
+	// Access token should have been reused.
+	if requests != 1 {
+		t.Fatalf("expected 1 token request, got %d", requests)
+	}
+
+	// Credentials shouldn't be sent unencrypted.
+	if _, _, err := auth.Start(&smtp.ServerInfo{Name: "smtp.example.org"}); err == nil {
+		t.Fatal("expected error for unencrypted connection")
+	}
+
+	// Or to the wrong host.
+	if _, _, err := auth.Start(&smtp.ServerInfo{Name: "smtp.example.com", TLS: true}); err == nil {
+		t.Fatal("expected error for wrong host")
+	}
+}
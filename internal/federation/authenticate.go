@@ -38,6 +38,8 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/httpsig"
 )
 
@@ -76,6 +78,13 @@ type PubKeyAuth struct {
 	// account was NOT dereferenced due to an ongoing
 	// handshake with another instance.
 	Handshaking bool
+
+	// Unsigned indicates that the request was not
+	// signed at all, but authorized fetch is not
+	// required for the requester, so it may still
+	// be served public content. OwnerURI and Owner
+	// will both be nil when this field is set.
+	Unsigned bool
 }
 
 // AuthenticateFederatedRequest authenticates any kind of incoming federated
@@ -103,13 +112,43 @@ type PubKeyAuth struct {
 //
 // Note that it is also valid to pass in an empty string here, in which case the
 // keys of the instance account will be used.
+//
+// If the request was not signed, but the signature check middleware determined
+// that authorized fetch is optional for the requester, the returned PubKeyAuth
+// will have only the Unsigned field set; callers must then treat the request as
+// coming from an anonymous requester.
 func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedUsername string) (*PubKeyAuth, gtserror.WithCode) {
+	var host string
+	if pubKeyID := gtscontext.HTTPSignaturePubKeyID(ctx); pubKeyID != nil {
+		host = pubKeyID.Host
+	}
+
+	ctx, end := tracing.StartSpan(ctx, "federation.verify_signature", host,
+		kv.Field{K: "requestedUsername", V: requestedUsername},
+	)
+	pubKeyAuth, errWithCode := f.authenticateFederatedRequest(ctx, requestedUsername)
+	if errWithCode != nil {
+		end(errWithCode)
+	} else {
+		end(nil)
+	}
+	return pubKeyAuth, errWithCode
+}
+
+func (f *Federator) authenticateFederatedRequest(ctx context.Context, requestedUsername string) (*PubKeyAuth, gtserror.WithCode) {
 	// Thanks to the signature check middleware,
 	// we should already have an http signature
 	// verifier set on the context. If we don't,
 	// this is an unsigned request.
 	verifier := gtscontext.HTTPSignatureVerifier(ctx)
 	if verifier == nil {
+		if gtscontext.UnsignedFetchPermitted(ctx) {
+			// Authorized fetch is optional
+			// for this requester, let it
+			// through as anonymous.
+			return &PubKeyAuth{Unsigned: true}, nil
+		}
+
 		err := gtserror.Newf("%w", errUnsigned)
 		errWithCode := gtserror.NewErrorUnauthorized(err, errUnsigned.Error(), "(verifier)")
 		return nil, errWithCode
@@ -178,6 +217,13 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
 	}
 
+	if _, ok := verifier.(*rfc9421.Verifier); ok && !isLocal {
+		// Remote signed this request with an RFC 9421
+		// http message signature, so it must support
+		// them. Use these when signing requests to it.
+		f.transportController.SetRFC9421Support(pubKeyID.Host, true)
+	}
+
 	if pubKeyAuth.Owner == nil {
 		// Ensure we have instance stored in
 		// database for the account at URI.
@@ -237,7 +237,7 @@ func (d *Dereferencer) getAccountByUsernameDomain(
 	username string,
 	domain string,
 ) (*gtsmodel.Account, ap.Accountable, error) {
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if config.IsLocalDomain(domain) {
 		// We do local lookups using an empty domain,
 		// else it will fail the db search below.
 		domain = ""
@@ -767,6 +767,11 @@ func (d *Dereferencer) enrichAccount(
 	latestAcc.FetchedAt = now
 	latestAcc.UpdatedAt = now
 
+	// Carry over any Ed25519 key cached
+	// from verifying an integrity proof.
+	latestAcc.Ed25519PublicKey = account.Ed25519PublicKey
+	latestAcc.Ed25519PublicKeyURI = account.Ed25519PublicKeyURI
+
 	// Ensure the account's avatar media is populated, passing in existing to check for chages.
 	if err := d.fetchAccountAvatar(ctx, requestUser, account, latestAcc); err != nil {
 		log.Errorf(ctx, "error fetching remote avatar for account %s: %v", uri, err)
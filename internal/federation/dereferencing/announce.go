@@ -22,7 +22,10 @@
 	"errors"
 	"net/url"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -56,9 +59,8 @@ func (d *Dereferencer) EnrichAnnounce(
 		)
 	}
 
-	// Fetch and dereference status being boosted, noting that
-	// d.GetStatusByURI handles domain blocks and local statuses.
-	target, _, err := d.GetStatusByURI(ctx, requestUser, targetURIObj)
+	// Fetch and dereference status being boosted.
+	target, err := d.getAnnounceTarget(ctx, requestUser, targetURIObj)
 	if err != nil {
 		return nil, gtserror.Newf("error fetching boost target %s: %w", targetURI, err)
 	}
@@ -128,3 +130,100 @@ func (d *Dereferencer) EnrichAnnounce(
 
 	return boost, err
 }
+
+// getAnnounceTarget returns the status at the given URI, as
+// announced by a boost, dereferencing it if necessary. The URI
+// may instead point to an activity wrapping the status, as sent
+// by Group actors forwarding it (FEP-1b12). In that case, the
+// activity is only fetched once, and the wrapped status returned.
+// Only Create activities are unwrapped this way.
+func (d *Dereferencer) getAnnounceTarget(
+	ctx context.Context,
+	requestUser string,
+	uri *url.URL,
+) (*gtsmodel.Status, error) {
+	uriStr := uri.String()
+
+	// Check whether we already have this status stored.
+	known, err := d.state.DB.GetStatusByURI(gtscontext.SetBarebones(ctx), uriStr)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting status %s: %w", uriStr, err)
+	}
+
+	if known == nil {
+		known, err = d.state.DB.GetStatusByURL(gtscontext.SetBarebones(ctx), uriStr)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("db error getting status %s: %w", uriStr, err)
+		}
+	}
+
+	if known != nil ||
+		uri.Host == config.GetHost() ||
+		uri.Host == config.GetAccountDomain() {
+		// Known or local status, this
+		// can't be a wrapping activity. Note
+		// d.GetStatusByURI handles refreshing.
+		status, _, err := d.GetStatusByURI(ctx, requestUser, uri)
+		return status, err
+	}
+
+	// Check whether this status URI is a blocked domain / subdomain.
+	if blocked, err := d.state.DB.IsDomainBlocked(ctx, uri.Host); err != nil {
+		return nil, gtserror.Newf("error checking blocked domain: %w", err)
+	} else if blocked {
+		err := gtserror.Newf("%s is blocked", uri.Host)
+		return nil, gtserror.SetUnretrievable(err)
+	}
+
+	tsport, err := d.transportController.NewTransportForUsername(ctx, requestUser)
+	if err != nil {
+		return nil, gtserror.Newf("couldn't create transport: %w", err)
+	}
+
+	rsp, err := tsport.Dereference(ctx, uri)
+	if err != nil {
+		err := gtserror.Newf("error dereferencing %s: %w", uri, err)
+		return nil, gtserror.SetUnretrievable(err)
+	}
+
+	// Attempt to resolve either a status, or an activity wrapping one.
+	statusable, activityable, err := ap.ResolveStatusableOrActivityable(ctx, rsp.Body)
+
+	// Tidy up now done.
+	_ = rsp.Body.Close()
+
+	if err != nil {
+		return nil, gtserror.Newf("error resolving %s: %w", uri, err)
+	}
+
+	if statusable != nil {
+		// This is the status itself, so pass it
+		// through for enrichment without refetching,
+		// under its final URI after any redirects.
+		status, _, err := d.RefreshStatus(ctx,
+			requestUser,
+			&gtsmodel.Status{
+				Local: util.Ptr(false),
+				URI:   rsp.Request.URL.String(),
+			},
+			statusable,
+			nil,
+		)
+		return status, err
+	}
+
+	if name := activityable.GetTypeName(); name != ap.ActivityCreate {
+		err := gtserror.Newf("wrapped %s activity %s can't be announced", name, uri)
+		return nil, gtserror.SetNotRelevant(err)
+	}
+
+	objectIRIs := ap.GetObjectIRIs(activityable)
+	if len(objectIRIs) == 0 {
+		return nil, gtserror.Newf("no object iri in wrapped activity %s", uri)
+	}
+
+	// Fetch the wrapped status by its own URI, rather than
+	// trusting any copy of it embedded in the activity.
+	status, _, err := d.GetStatusByURI(ctx, requestUser, objectIRIs[0])
+	return status, err
+}
@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	announceAuthor = "https://unknown-instance.com/users/brand_new_person"
+	announceStatus = announceAuthor + "/statuses/grouped"
+	announceCreate = announceStatus + "/activity"
+)
+
+type AnnounceTestSuite struct {
+	DereferencerStandardTestSuite
+}
+
+// boost returns a new boost wrapper of the given
+// URI, as though announced by a remote Group.
+func (suite *AnnounceTestSuite) boost(boostOfURI string) *gtsmodel.Status {
+	booster := suite.testAccounts["remote_account_1"]
+	return &gtsmodel.Status{
+		URI:           booster.URI + "/announces/01JKJ2W3C3QX4B5D6E7F8G9H0J",
+		BoostOfURI:    boostOfURI,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		Local:         util.Ptr(false),
+		AccountURI:    booster.URI,
+		AccountID:     booster.ID,
+		Account:       booster,
+		Visibility:    gtsmodel.VisibilityPublic,
+		AttachmentIDs: []string{},
+		TagIDs:        []string{},
+	}
+}
+
+func (suite *AnnounceTestSuite) TestEnrichAnnounceStatus() {
+	suite.testRemoteDocuments[announceStatus] = noteJSON(announceStatus, announceAuthor, "")
+
+	boost, err := suite.dereferencer.EnrichAnnounce(context.Background(),
+		suite.boost(announceStatus),
+		suite.testAccounts["local_account_1"].Username,
+	)
+	suite.NoError(err)
+
+	suite.Equal(announceStatus, boost.BoostOfURI)
+	suite.Equal(announceStatus, boost.BoostOf.URI)
+	suite.Equal(1, suite.testRemoteFetches[announceStatus])
+}
+
+func (suite *AnnounceTestSuite) TestEnrichAnnounceWrappedCreateIRI() {
+	// Group Announce with only the IRI
+	// of the Create wrapping the status.
+	suite.testRemoteDocuments[announceStatus] = noteJSON(announceStatus, announceAuthor, "")
+	suite.testRemoteDocuments[announceCreate] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + announceCreate + `",
+  "type": "Create",
+  "actor": "` + announceAuthor + `",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "object": "` + announceStatus + `"
+}`
+
+	boost, err := suite.dereferencer.EnrichAnnounce(context.Background(),
+		suite.boost(announceCreate),
+		suite.testAccounts["local_account_1"].Username,
+	)
+	suite.NoError(err)
+
+	// Boost should be of the wrapped status,
+	// with each document fetched only once.
+	suite.Equal(announceStatus, boost.BoostOfURI)
+	suite.Equal(announceStatus, boost.BoostOf.URI)
+	suite.Equal(1, suite.testRemoteFetches[announceCreate])
+	suite.Equal(1, suite.testRemoteFetches[announceStatus])
+}
+
+func (suite *AnnounceTestSuite) TestEnrichAnnounceWrappedOther() {
+	// Only Creates should be unwrapped.
+	suite.testRemoteDocuments[announceStatus] = noteJSON(announceStatus, announceAuthor, "")
+	suite.testRemoteDocuments[announceCreate] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + announceCreate + `",
+  "type": "Update",
+  "actor": "` + announceAuthor + `",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "object": "` + announceStatus + `"
+}`
+
+	_, err := suite.dereferencer.EnrichAnnounce(context.Background(),
+		suite.boost(announceCreate),
+		suite.testAccounts["local_account_1"].Username,
+	)
+	suite.True(gtserror.IsNotRelevant(err))
+	suite.Equal(1, suite.testRemoteFetches[announceCreate])
+	suite.Zero(suite.testRemoteFetches[announceStatus])
+}
+
+func TestAnnounceTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnounceTestSuite))
+}
@@ -18,6 +18,11 @@
 package dereferencing_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -46,6 +51,15 @@ type DereferencerStandardTestSuite struct {
 	testAccounts          map[string]*gtsmodel.Account
 	testEmojis            map[string]*gtsmodel.Emoji
 
+	// testRemoteDocuments are extra JSON documents,
+	// eg. collections, served by URL to the dereferencer
+	// before falling back to the standard mock client.
+	testRemoteDocuments map[string]string
+
+	// testRemoteFetches counts the times each
+	// of testRemoteDocuments has been fetched.
+	testRemoteFetches map[string]int
+
 	dereferencer dereferencing.Dereferencer
 }
 
@@ -60,6 +74,8 @@ func (suite *DereferencerStandardTestSuite) SetupTest() {
 	suite.testRemoteServices = testrig.NewTestFediServices()
 	suite.testRemoteAttachments = testrig.NewTestFediAttachments("../../../testrig/media")
 	suite.testEmojis = testrig.NewTestEmojis()
+	suite.testRemoteDocuments = make(map[string]string)
+	suite.testRemoteFetches = make(map[string]int)
 
 	suite.state.Caches.Init()
 	testrig.StartNoopWorkers(&suite.state)
@@ -87,7 +103,7 @@ func (suite *DereferencerStandardTestSuite) SetupTest() {
 		converter,
 		testrig.NewTestTransportController(
 			&suite.state,
-			suite.client,
+			testrig.NewMockHTTPClient(suite.serveRemoteDocument, ""),
 		),
 		visFilter,
 		intFilter,
@@ -96,6 +112,61 @@ func (suite *DereferencerStandardTestSuite) SetupTest() {
 	testrig.StandardDBSetup(suite.db, nil)
 }
 
+// serveRemoteDocument serves the requested document from
+// testRemoteDocuments if it's there, else from suite.client.
+func (suite *DereferencerStandardTestSuite) serveRemoteDocument(req *http.Request) (*http.Response, error) {
+	doc, ok := suite.testRemoteDocuments[req.URL.String()]
+	if !ok || req.Method != http.MethodGet {
+		return suite.client.Do(req)
+	}
+
+	suite.testRemoteFetches[req.URL.String()]++
+
+	return &http.Response{
+		Request:       req,
+		StatusCode:    http.StatusOK,
+		Body:          io.NopCloser(bytes.NewReader([]byte(doc))),
+		ContentLength: int64(len(doc)),
+		Header:        http.Header{"Content-Type": {"application/activity+json"}},
+	}, nil
+}
+
+// noteJSON returns the JSON of a public Note with the given
+// id, author and parent (if any), with a replies collection
+// listing the given reply IRIs on its first page.
+func noteJSON(id string, attributedTo string, inReplyTo string, replies ...string) string {
+	note := map[string]any{
+		"@context":     "https://www.w3.org/ns/activitystreams",
+		"id":           id,
+		"type":         "Note",
+		"attributedTo": attributedTo,
+		"content":      "<p>hello from " + id + "</p>",
+		"published":    "2024-01-01T00:00:00Z",
+		"to":           []string{"https://www.w3.org/ns/activitystreams#Public"},
+		"replies": map[string]any{
+			"id":   id + "/replies",
+			"type": "Collection",
+			"first": map[string]any{
+				"id":     id + "/replies?page=true",
+				"type":   "CollectionPage",
+				"partOf": id + "/replies",
+				"items":  append([]string{}, replies...),
+			},
+		},
+	}
+
+	if inReplyTo != "" {
+		note["inReplyTo"] = inReplyTo
+	}
+
+	b, err := json.Marshal(note)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
 func (suite *DereferencerStandardTestSuite) TearDownTest() {
 	testrig.StandardDBTeardown(suite.db)
 	testrig.StopWorkers(&suite.state)
@@ -23,8 +23,10 @@
 	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -64,6 +66,15 @@ func (d *Dereferencer) GetMedia(
 		return nil, err
 	}
 
+	if d.mediaPolicy(ctx, accountID) == gtsmodel.DomainMediaPolicyReject {
+		// Media from this domain is rejected, store
+		// a placeholder only linking to remote URL.
+		return d.mediaManager.CreatePlaceholderMedia(ctx,
+			accountID,
+			info,
+		)
+	}
+
 	return d.processMediaSafeley(ctx,
 		remoteURL,
 		func() (*media.ProcessingMedia, error) {
@@ -147,6 +158,12 @@ func (d *Dereferencer) RefreshMedia(
 		return attach, nil
 	}
 
+	// Never (re)cache media from domains with
+	// a media policy of reject, leave it as is.
+	if d.mediaPolicy(ctx, attach.AccountID) == gtsmodel.DomainMediaPolicyReject {
+		return attach, nil
+	}
+
 	// Ensure we have a valid remote URL.
 	url, err := url.Parse(attach.RemoteURL)
 	if err != nil {
@@ -269,3 +286,31 @@ func (d *Dereferencer) processMediaSafeley(
 
 	return
 }
+
+// mediaPolicy returns the media policy in place for the domain of
+// the account with given ID, if any. Errors are logged and treated
+// as there being no policy, so as not to drop media unnecessarily.
+func (d *Dereferencer) mediaPolicy(ctx context.Context, accountID string) string {
+	account, err := d.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	if err != nil {
+		log.Errorf(ctx, "error getting media owner account %s: %v", accountID, err)
+		return ""
+	}
+
+	if account.IsLocal() {
+		// No policy
+		// for local.
+		return ""
+	}
+
+	policy, err := d.state.DB.GetDomainMediaPolicy(ctx, account.Domain)
+	if err != nil {
+		log.Errorf(ctx, "error getting media policy for %s: %v", account.Domain, err)
+		return ""
+	}
+
+	return policy
+}
@@ -0,0 +1,183 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// maxOutboxPages defines how many pages of
+// a remote account's outbox we are willing
+// to follow when backfilling its statuses.
+const maxOutboxPages = 5
+
+// DereferenceAccountOutbox dereferences the outbox of the given remote
+// account, and fetches up to limit of the most recent statuses created
+// by the account that we don't have stored yet. Only Create activities
+// are considered; boosts (Announce activities) are skipped. Returns the
+// number of statuses that were newly stored.
+func (d *Dereferencer) DereferenceAccountOutbox(
+	ctx context.Context,
+	requestUser string,
+	account *gtsmodel.Account,
+	limit int,
+) (int, error) {
+	if account.IsLocal() {
+		return 0, gtserror.Newf("account %s is local", account.URI)
+	}
+
+	if account.OutboxURI == "" {
+		// Nothing to do.
+		return 0, nil
+	}
+
+	outboxIRI, err := url.Parse(account.OutboxURI)
+	if err != nil {
+		return 0, gtserror.Newf("invalid outbox uri %q: %w", account.OutboxURI, err)
+	}
+
+	collect, err := d.dereferenceCollection(ctx, requestUser, outboxIRI)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		// fetched is the number of statuses
+		// we've stored from the outbox so far.
+		fetched int
+
+		// items yields the next
+		// item in the outbox,
+		// or nil when exhausted.
+		items func() ap.TypeOrIRI = collect.NextItem
+
+		// page is the current outbox page,
+		// nil while items are iterated
+		// from the outbox itself.
+		page ap.CollectionPageIterator
+
+		// pages is the number of
+		// outbox pages followed.
+		pages int
+	)
+
+	for fetched < limit {
+		item := items()
+		if item == nil {
+			// Out of items, move on to the next page.
+			var next ap.WithIRI
+			if page == nil {
+				next = getCollectionFirst(collect)
+			} else {
+				next = page.NextPage()
+			}
+
+			if next == nil || !next.IsIRI() || pages >= maxOutboxPages {
+				break
+			}
+
+			// Dereference the next outbox page by its IRI.
+			page, err = d.dereferenceCollectionPage(ctx, requestUser, next.GetIRI())
+			if err != nil {
+				return fetched, err
+			}
+
+			items = page.NextItem
+			pages++
+			continue
+		}
+
+		// Get the status IRI from
+		// any Create activity item.
+		statusIRI := getCreateObjectIRI(item)
+		if statusIRI == nil {
+			continue
+		}
+
+		if statusIRI.Host != outboxIRI.Host {
+			// If this status doesn't share a host with
+			// the outbox, we shouldn't trust it. Move on.
+			continue
+		}
+
+		// Search for status by URI. Note this may return an existing model
+		// we have stored with an error from attempted update, so check both.
+		status, _, isNew, err := d.getStatusByURI(ctx, requestUser, statusIRI)
+		if err != nil {
+			log.Errorf(ctx, "error getting status from outbox %s: %v", statusIRI, err)
+			continue
+		}
+
+		if !isNew || status.AccountURI != account.URI {
+			// Already had it, or it's someone
+			// else's status; doesn't count.
+			continue
+		}
+
+		fetched++
+	}
+
+	return fetched, nil
+}
+
+// getCollectionFirst returns the "first" page property of the
+// given collection, if it's set, or nil otherwise.
+func getCollectionFirst(collect ap.CollectionIterator) ap.WithIRI {
+	withFirst, ok := collect.(interface {
+		GetActivityStreamsFirst() vocab.ActivityStreamsFirstProperty
+	})
+	if !ok {
+		return nil
+	}
+
+	first := withFirst.GetActivityStreamsFirst()
+	if first == nil {
+		return nil
+	}
+
+	return first
+}
+
+// getCreateObjectIRI returns the object IRI of the given
+// collection item if it's an embedded Create activity,
+// or nil otherwise.
+func getCreateObjectIRI(item ap.TypeOrIRI) *url.URL {
+	t := item.GetType()
+	if t == nil || t.GetTypeName() != ap.ActivityCreate {
+		return nil
+	}
+
+	create, ok := t.(vocab.ActivityStreamsCreate)
+	if !ok {
+		return nil
+	}
+
+	objectIRI, err := ap.ExtractObjectURI(create)
+	if err != nil {
+		return nil
+	}
+
+	return objectIRI
+}
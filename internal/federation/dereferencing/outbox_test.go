@@ -0,0 +1,207 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const (
+	outboxAuthor = "https://unknown-instance.com/users/brand_new_person"
+	outboxURI    = outboxAuthor + "/outbox"
+)
+
+type OutboxTestSuite struct {
+	DereferencerStandardTestSuite
+}
+
+// account fetches and returns the
+// account whose outbox is dereferenced.
+func (suite *OutboxTestSuite) account() *gtsmodel.Account {
+	account, _, err := suite.dereferencer.GetAccountByURI(context.Background(),
+		suite.testAccounts["local_account_1"].Username,
+		testrig.URLMustParse(outboxAuthor),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return account
+}
+
+// outbox sets the outbox to have the given pages
+// of items, each page linking to the next one.
+func (suite *OutboxTestSuite) outbox(pages ...[]string) {
+	suite.testRemoteDocuments[outboxURI] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + outboxURI + `",
+  "type": "OrderedCollection",
+  "first": "` + outboxURI + `?page=1"
+}`
+
+	for i, items := range pages {
+		var next string
+		if i+1 < len(pages) {
+			next = fmt.Sprintf(`"next": "%s?page=%d",`, outboxURI, i+2)
+		}
+
+		var itemsJSON string
+		for j, item := range items {
+			if j > 0 {
+				itemsJSON += ",\n"
+			}
+			itemsJSON += item
+		}
+
+		suite.testRemoteDocuments[fmt.Sprintf("%s?page=%d", outboxURI, i+1)] = fmt.Sprintf(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "%[1]s?page=%[2]d",
+  "type": "OrderedCollectionPage",
+  "partOf": "%[1]s",
+  %[3]s
+  "orderedItems": [
+    %[4]s
+  ]
+}`, outboxURI, i+1, next, itemsJSON)
+	}
+}
+
+// create returns an outbox item creating a new status
+// by the given author, serving the status itself too.
+func (suite *OutboxTestSuite) create(author string, id string) string {
+	uri := author + "/statuses/" + id
+	suite.testRemoteDocuments[uri] = noteJSON(uri, author, "")
+	return `{"id": "` + uri + `/activity", "type": "Create", "actor": "` + author + `", "object": "` + uri + `"}`
+}
+
+// announce returns an outbox item boosting
+// a status by the author, serving the status.
+func (suite *OutboxTestSuite) announce(id string) string {
+	uri := outboxAuthor + "/statuses/" + id
+	suite.testRemoteDocuments[uri] = noteJSON(uri, outboxAuthor, "")
+	return `{"id": "` + uri + `/boost", "type": "Announce", "actor": "` + outboxAuthor + `", "object": "` + uri + `"}`
+}
+
+// stored returns whether a status with the
+// given author and id has been stored.
+func (suite *OutboxTestSuite) stored(author string, id string) bool {
+	_, err := suite.db.GetStatusByURI(context.Background(), author+"/statuses/"+id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	return err == nil
+}
+
+func (suite *OutboxTestSuite) TestDereferenceAccountOutbox() {
+	const otherAuthor = "http://fossbros-anonymous.io/users/foss_satan"
+
+	suite.outbox(
+		[]string{
+			suite.create(outboxAuthor, "one"),
+			suite.announce("boosted"),
+			suite.create(otherAuthor, "elsewhere"),
+			suite.create(outboxAuthor, "two"),
+		},
+		[]string{
+			suite.create(outboxAuthor, "three"),
+		},
+	)
+
+	fetched, err := suite.dereferencer.DereferenceAccountOutbox(context.Background(),
+		suite.testAccounts["local_account_1"].Username,
+		suite.account(),
+		20,
+	)
+	suite.NoError(err)
+	suite.Equal(3, fetched)
+
+	// Only Creates of the
+	// account's own statuses.
+	suite.True(suite.stored(outboxAuthor, "one"))
+	suite.True(suite.stored(outboxAuthor, "two"))
+	suite.True(suite.stored(outboxAuthor, "three"))
+	suite.False(suite.stored(outboxAuthor, "boosted"))
+	suite.False(suite.stored(otherAuthor, "elsewhere"))
+}
+
+func (suite *OutboxTestSuite) TestDereferenceAccountOutboxLimit() {
+	suite.outbox(
+		[]string{
+			suite.create(outboxAuthor, "one"),
+			suite.create(outboxAuthor, "two"),
+		},
+		[]string{
+			suite.create(outboxAuthor, "three"),
+		},
+	)
+
+	fetched, err := suite.dereferencer.DereferenceAccountOutbox(context.Background(),
+		suite.testAccounts["local_account_1"].Username,
+		suite.account(),
+		2,
+	)
+	suite.NoError(err)
+	suite.Equal(2, fetched)
+
+	// Should stop at the limit,
+	// before the second page.
+	suite.True(suite.stored(outboxAuthor, "one"))
+	suite.True(suite.stored(outboxAuthor, "two"))
+	suite.False(suite.stored(outboxAuthor, "three"))
+}
+
+func (suite *OutboxTestSuite) TestDereferenceAccountOutboxMaxPages() {
+	// More pages than we're willing
+	// to follow, one status on each.
+	pages := make([][]string, 8)
+	for i := range pages {
+		pages[i] = []string{suite.create(outboxAuthor, fmt.Sprint(i+1))}
+	}
+	suite.outbox(pages...)
+
+	fetched, err := suite.dereferencer.DereferenceAccountOutbox(context.Background(),
+		suite.testAccounts["local_account_1"].Username,
+		suite.account(),
+		20,
+	)
+	suite.NoError(err)
+	suite.Equal(5, fetched)
+
+	suite.True(suite.stored(outboxAuthor, "5"))
+	suite.False(suite.stored(outboxAuthor, "6"))
+}
+
+func (suite *OutboxTestSuite) TestDereferenceAccountOutboxLocal() {
+	_, err := suite.dereferencer.DereferenceAccountOutbox(context.Background(),
+		suite.testAccounts["local_account_1"].Username,
+		suite.testAccounts["local_account_2"],
+		20,
+	)
+	suite.Error(err)
+}
+
+func TestOutboxTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
@@ -523,6 +523,14 @@ func (d *Dereferencer) enrichStatus(
 	latestStatus.Local = status.Local
 	latestStatus.PinnedAt = status.PinnedAt
 
+	// Carry-over remote interaction counts,
+	// updating them from the statusable
+	// where it embeds counted collections.
+	latestStatus.RemoteRepliesCount = status.RemoteRepliesCount
+	latestStatus.RemoteReblogsCount = status.RemoteReblogsCount
+	latestStatus.RemoteFavesCount = status.RemoteFavesCount
+	setEmbeddedStatusCounts(latestStatus, statusable)
+
 	// Carry-over approvals. Remote instances might not yet
 	// serve statuses with the `approved_by` field, but we
 	// might have marked a status as pre-approved on our side
@@ -550,6 +558,14 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.SetNotPermitted(err)
 	}
 
+	var previous *gtsmodel.StatusEdit
+	if !isNew {
+		// Snapshot the existing revision of the status
+		// before it's modified by populating the latest,
+		// so we can tell whether the status was edited.
+		previous = gtsmodel.SnapshotStatus(status)
+	}
+
 	// Ensure the status' mentions are populated, and pass in existing to check for changes.
 	if err := d.fetchStatusMentions(ctx, requestUser, status, latestStatus); err != nil {
 		return nil, nil, gtserror.Newf("error populating mentions for status %s: %w", uri, err)
@@ -566,6 +582,9 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.Newf("error checking / creating threadID for status %s: %w", uri, err)
 	}
 
+	// Ensure the status' quoted status is populated, if set and possible.
+	d.fetchStatusQuote(ctx, requestUser, status, latestStatus)
+
 	// Ensure the status' tags are populated, (changes are expected / okay).
 	if err := d.fetchStatusTags(ctx, status, latestStatus); err != nil {
 		return nil, nil, gtserror.Newf("error populating tags for status %s: %w", uri, err)
@@ -576,6 +595,13 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.Newf("error populating attachments for status %s: %w", uri, err)
 	}
 
+	// If the status has media, check whether the author's
+	// domain has a policy in place to mark it as sensitive.
+	if len(latestStatus.Attachments) > 0 &&
+		d.mediaPolicy(ctx, latestStatus.AccountID) == gtsmodel.DomainMediaPolicySensitive {
+		latestStatus.Sensitive = util.Ptr(true)
+	}
+
 	// Ensure the status' emoji attachments are populated, passing in existing to check for changes.
 	if err := d.fetchStatusEmojis(ctx, status, latestStatus); err != nil {
 		return nil, nil, gtserror.Newf("error populating emojis for status %s: %w", uri, err)
@@ -588,6 +614,12 @@ func (d *Dereferencer) enrichStatus(
 			return nil, nil, gtserror.Newf("error putting in database: %w", err)
 		}
 	} else {
+		// Keep the previous revision of
+		// the status if it's been edited.
+		if err := d.storeStatusEdit(ctx, previous, latestStatus); err != nil {
+			return nil, nil, gtserror.Newf("error storing edit for status %s: %w", uri, err)
+		}
+
 		// This is an existing status, update the model in the database.
 		if err := d.state.DB.UpdateStatus(ctx, latestStatus); err != nil {
 			return nil, nil, gtserror.Newf("error updating database: %w", err)
@@ -597,6 +629,29 @@ func (d *Dereferencer) enrichStatus(
 	return latestStatus, statusable, nil
 }
 
+// storeStatusEdit stores the given snapshot of the previous
+// revision of the status, if the latest revision differs from
+// it. The status' UpdatedAt is set to the time of the edit.
+func (d *Dereferencer) storeStatusEdit(
+	ctx context.Context,
+	previous *gtsmodel.StatusEdit,
+	status *gtsmodel.Status,
+) error {
+	if !previous.Differs(gtsmodel.SnapshotStatus(status)) {
+		// Not edited.
+		return nil
+	}
+
+	previous.ID = id.NewULID()
+	previous.StatusID = status.ID
+	if err := d.state.DB.PutStatusEdit(ctx, previous); err != nil {
+		return err
+	}
+
+	status.UpdatedAt = time.Now()
+	return nil
+}
+
 func (d *Dereferencer) fetchStatusMentions(
 	ctx context.Context,
 	requestUser string,
@@ -732,6 +787,72 @@ func(m *gtsmodel.Mention) bool {
 	return nil
 }
 
+// quoteDerefKey is the context key used to mark
+// that a quoted status is already being dereferenced,
+// preventing unbounded recursion through quote chains.
+type quoteDerefKey struct{}
+
+// fetchStatusQuote ensures that the quoted status of the given
+// status is populated, dereferencing it if necessary. Failure to
+// dereference the quoted status is not fatal: the quote URI is
+// kept on the status, so the quote can be fetched again later.
+func (d *Dereferencer) fetchStatusQuote(
+	ctx context.Context,
+	requestUser string,
+	existing *gtsmodel.Status,
+	status *gtsmodel.Status,
+) {
+	if status.QuoteURI == "" {
+		// Not a quote.
+		return
+	}
+
+	if status.QuoteID != "" {
+		// Quote was already
+		// found in the database.
+		return
+	}
+
+	if existing.QuoteURI == status.QuoteURI &&
+		existing.QuoteID != "" {
+		// Quote unchanged since last
+		// deref, carry it over as-is.
+		status.QuoteID = existing.QuoteID
+		status.Quote = existing.Quote
+		return
+	}
+
+	if status.QuoteURI == status.URI {
+		// Status claims to quote itself,
+		// there's nothing to fetch here.
+		return
+	}
+
+	if ctx.Value(quoteDerefKey{}) != nil {
+		// We're already dereferencing a quote
+		// further up the call stack; don't go
+		// any deeper, the quote URI is enough.
+		return
+	}
+
+	quoteURI, err := url.Parse(status.QuoteURI)
+	if err != nil {
+		log.Warnf(ctx, "invalid quote uri %s: %v", status.QuoteURI, err)
+		return
+	}
+
+	// Mark the context as dereferencing a quote, and fetch it.
+	ctx = context.WithValue(ctx, quoteDerefKey{}, struct{}{})
+	quote, _, _, err := d.getStatusByURI(ctx, requestUser, quoteURI)
+	if err != nil && quote == nil {
+		log.Warnf(ctx, "error dereferencing quote %s: %v", status.QuoteURI, err)
+		return
+	}
+
+	status.QuoteID = quote.ID
+	status.Quote = quote
+}
+
 func (d *Dereferencer) fetchStatusTags(
 	ctx context.Context,
 	existing *gtsmodel.Status,
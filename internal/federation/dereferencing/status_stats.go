@@ -0,0 +1,200 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// statusCollectionProperty represents the common interface
+// of the replies, likes and shares properties of a status,
+// which may each hold an (ordered) collection or its IRI.
+type statusCollectionProperty interface {
+	IsIRI() bool
+	GetIRI() *url.URL
+	GetActivityStreamsCollection() vocab.ActivityStreamsCollection
+	GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection
+}
+
+// statusCollectionProperties returns the replies, likes
+// and shares properties of the given statusable, where set.
+func statusCollectionProperties(statusable ap.Statusable) (replies, likes, shares statusCollectionProperty) {
+	if prop := statusable.GetActivityStreamsReplies(); prop != nil {
+		replies = prop
+	}
+
+	if withLikes, ok := statusable.(ap.WithLikes); ok {
+		if prop := withLikes.GetActivityStreamsLikes(); prop != nil {
+			likes = prop
+		}
+	}
+
+	if withShares, ok := statusable.(ap.WithShares); ok {
+		if prop := withShares.GetActivityStreamsShares(); prop != nil {
+			shares = prop
+		}
+	}
+
+	return
+}
+
+// setEmbeddedStatusCounts sets the remote interaction counts on
+// the given status from the totalItems of any collections that
+// are embedded in the statusable. Counts that aren't embedded
+// are left as they were.
+func setEmbeddedStatusCounts(status *gtsmodel.Status, statusable ap.Statusable) {
+	replies, likes, shares := statusCollectionProperties(statusable)
+
+	for _, c := range []struct {
+		prop  statusCollectionProperty
+		count **int
+	}{
+		{replies, &status.RemoteRepliesCount},
+		{likes, &status.RemoteFavesCount},
+		{shares, &status.RemoteReblogsCount},
+	} {
+		if n := embeddedTotalItems(c.prop); n >= 0 {
+			*c.count = &n
+		}
+	}
+}
+
+// embeddedTotalItems returns the totalItems of the collection
+// embedded in the given property, or -1 if not available.
+func embeddedTotalItems(prop statusCollectionProperty) int {
+	if prop == nil {
+		return -1
+	}
+
+	if collection := prop.GetActivityStreamsCollection(); collection != nil {
+		return ap.WrapCollection(collection).TotalItems()
+	}
+
+	if collection := prop.GetActivityStreamsOrderedCollection(); collection != nil {
+		return ap.WrapOrderedCollection(collection).TotalItems()
+	}
+
+	return -1
+}
+
+// collectionIRI returns the IRI of the collection
+// in the given property, embedded or not, if any.
+func collectionIRI(prop statusCollectionProperty) string {
+	switch {
+	case prop == nil:
+		return ""
+
+	case prop.IsIRI():
+		return prop.GetIRI().String()
+
+	case prop.GetActivityStreamsCollection() != nil:
+		return getIDString(prop.GetActivityStreamsCollection())
+
+	case prop.GetActivityStreamsOrderedCollection() != nil:
+		return getIDString(prop.GetActivityStreamsOrderedCollection())
+
+	default:
+		return ""
+	}
+}
+
+// RefreshStatusStats forces a refresh of the given remote status (unless
+// it was fetched in the last few seconds), which also updates any poll
+// tallies, and then updates its replies / boosts / faves counts from the
+// collections served by its origin server, dereferencing any collections
+// that aren't embedded in the status or that lack a totalItems count.
+func (d *Dereferencer) RefreshStatusStats(
+	ctx context.Context,
+	requestUser string,
+	status *gtsmodel.Status,
+) (*gtsmodel.Status, error) {
+	if status.IsLocal() {
+		// Local status counts
+		// are always accurate.
+		return status, nil
+	}
+
+	// Force a refresh of the status.
+	latest, statusable, err := d.RefreshStatus(ctx,
+		requestUser,
+		status,
+		nil,
+		Freshest,
+	)
+	if err != nil {
+		return latest, err
+	}
+
+	if statusable == nil {
+		// Status was refreshed only
+		// seconds ago; nothing to do.
+		return latest, nil
+	}
+
+	replies, likes, shares := statusCollectionProperties(statusable)
+
+	for _, c := range []struct {
+		prop  statusCollectionProperty
+		count **int
+	}{
+		{replies, &latest.RemoteRepliesCount},
+		{likes, &latest.RemoteFavesCount},
+		{shares, &latest.RemoteReblogsCount},
+	} {
+		if embeddedTotalItems(c.prop) >= 0 {
+			// Already set from embedded
+			// collection during refresh.
+			continue
+		}
+
+		n, err := d.countCollection(ctx,
+			collectionIRI(c.prop),
+			requestUser,
+		)
+		if err != nil {
+			// Log this but don't bail.
+			log.Warnf(ctx,
+				"couldn't count collection for %s: %v",
+				latest.URI, err,
+			)
+			continue
+		}
+
+		if n >= 0 {
+			*c.count = &n
+		}
+	}
+
+	if err := d.state.DB.UpdateStatus(ctx,
+		latest,
+		"remote_replies_count",
+		"remote_reblogs_count",
+		"remote_faves_count",
+	); err != nil {
+		return latest, gtserror.Newf("db error updating status counts: %w", err)
+	}
+
+	return latest, nil
+}
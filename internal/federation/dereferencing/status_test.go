@@ -21,8 +21,10 @@
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -229,6 +231,53 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithNonMatchingURI() {
 	suite.Nil(fetchedStatus)
 }
 
+func (suite *StatusTestSuite) TestDereferenceStatusEdited() {
+	var (
+		ctx             = context.Background()
+		fetchingAccount = suite.testAccounts["local_account_1"]
+		statusURI       = "https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839"
+	)
+
+	status, _, err := suite.dereferencer.GetStatusByURI(ctx, fetchingAccount.Username, testrig.URLMustParse(statusURI))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Refreshing unchanged status
+	// shouldn't store an edit.
+	note := suite.client.TestRemoteStatuses[statusURI]
+	status, _, err = suite.dereferencer.RefreshStatus(ctx, fetchingAccount.Username, status, note, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	edits, err := suite.db.GetStatusEdits(ctx, status.ID)
+	suite.NoError(err)
+	suite.Empty(edits)
+
+	// Edit the content of the remote status.
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("Hello edited world!")
+	note.SetActivityStreamsContent(contentProp)
+
+	createdAt := status.UpdatedAt
+	status, _, err = suite.dereferencer.RefreshStatus(ctx, fetchingAccount.Username, status, note, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Hello edited world!", status.Content)
+	suite.True(status.UpdatedAt.After(createdAt))
+
+	// Previous revision should be stored.
+	edits, err = suite.db.GetStatusEdits(ctx, status.ID)
+	suite.NoError(err)
+	if suite.Len(edits, 1) {
+		suite.Equal(status.ID, edits[0].StatusID)
+		suite.Equal("Hello world!", edits[0].Content)
+		suite.WithinDuration(createdAt, edits[0].CreatedAt, time.Millisecond)
+	}
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
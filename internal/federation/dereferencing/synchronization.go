@@ -0,0 +1,354 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// SynchronizeFollowers checks the given Collection-Synchronization
+// header value (FEP-8fcf), sent by the requesting remote account
+// along with a delivery, against the local accounts that follow
+// the requester according to our database.
+//
+// If the digests don't match, the requester's partial followers
+// collection is dereferenced asynchronously, and used to prune
+// drifted follows: local follows of the requester that it doesn't
+// list are removed, and listed local accounts that don't follow
+// the requester have an Undo Follow sent on their behalf.
+//
+// Followers of each requester are resynchronized at most
+// once every 6 hours.
+func (d *Dereferencer) SynchronizeFollowers(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	header string,
+) {
+	if header == "" {
+		return
+	}
+
+	sync, err := ap.ParseCollectionSynchronization(header)
+	if err != nil {
+		log.Debugf(ctx, "invalid collection synchronization header: %v", err)
+		return
+	}
+
+	if sync.CollectionID != requester.FollowersURI {
+		// We only synchronize the
+		// requester's own followers.
+		return
+	}
+
+	syncIRI, err := url.Parse(sync.URL)
+	if err != nil {
+		log.Debugf(ctx, "invalid collection synchronization url: %v", err)
+		return
+	}
+
+	requesterIRI, err := url.Parse(requester.URI)
+	if err != nil || syncIRI.Host != requesterIRI.Host {
+		// Partial collection must
+		// be hosted by requester.
+		return
+	}
+
+	follows, err := d.state.DB.GetAccountLocalFollowers(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting local followers of %s: %v", requester.URI, err)
+		return
+	}
+
+	followerURIs := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		if follow.Account != nil {
+			followerURIs = append(followerURIs, follow.Account.URI)
+		}
+	}
+
+	if ap.FollowersDigest(followerURIs) == sync.Digest {
+		// In sync, nothing to do.
+		return
+	}
+
+	// Resync at most once every few hours per requester, so
+	// that one whose followers we can't fully see (or which
+	// keeps sending a stale digest) can't have us fetch its
+	// collection on every delivery. This also dedupes resyncs
+	// queued or running for the same requester.
+	if !d.state.Caches.FollowerSyncs.Add(requester.ID, struct{}{}) {
+		return
+	}
+
+	// Follows have drifted, resync in the background.
+	d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		if err := d.resyncFollowers(ctx, requester, syncIRI, follows); err != nil {
+			log.Errorf(ctx, "error synchronizing followers of %s: %v", requester.URI, err)
+		}
+	})
+}
+
+// maxFollowersPages defines how many pages of
+// a partial followers collection we are willing
+// to follow when synchronizing followers.
+const maxFollowersPages = 20
+
+func (d *Dereferencer) resyncFollowers(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	syncIRI *url.URL,
+	follows []*gtsmodel.Follow,
+) error {
+	// Fetch the partial followers collection
+	// on behalf of the instance account.
+	collect, err := d.dereferenceCollection(ctx, "", syncIRI)
+	if err != nil {
+		return err
+	}
+
+	var (
+		// listed contains all listed local account URIs.
+		listed []string
+
+		// items yields the next
+		// item in the collection,
+		// or nil when exhausted.
+		items func() ap.TypeOrIRI = collect.NextItem
+
+		// page is the current collection
+		// page, nil while items are iterated
+		// from the collection itself.
+		page ap.CollectionPageIterator
+
+		// pages is the number of
+		// collection pages followed.
+		pages int
+
+		// inline is set when the
+		// collection itself had items.
+		inline bool
+	)
+
+	for {
+		item := items()
+		if item == nil {
+			// Out of items, move on to the next page.
+			var next ap.WithIRI
+			if page == nil {
+				next = getCollectionFirst(collect)
+			} else {
+				next = page.NextPage()
+			}
+
+			if next == nil {
+				break
+			}
+
+			if pages >= maxFollowersPages {
+				// We can't see the whole collection, so
+				// we can't tell which follows are stale.
+				return gtserror.Newf("%s has more than %d pages", syncIRI, maxFollowersPages)
+			}
+
+			page, err = d.getFollowersPage(ctx, syncIRI.Host, next)
+			if err != nil {
+				return err
+			}
+
+			items = page.NextItem
+			pages++
+			continue
+		}
+
+		if page == nil {
+			inline = true
+		}
+
+		itemIRI, _ := pub.ToId(item)
+		if itemIRI == nil || !config.IsLocalDomain(itemIRI.Host) {
+			continue
+		}
+
+		listed = append(listed, itemIRI.String())
+	}
+
+	if !inline && pages == 0 {
+		// Neither items nor pages, this is more likely
+		// a collection we can't read than one confirming
+		// none of our accounts follow the requester.
+		return gtserror.Newf("%s has no items or pages", syncIRI)
+	}
+
+	// Remove local follows of
+	// the requester it doesn't list.
+	for _, follow := range follows {
+		if follow.Account == nil ||
+			slices.Contains(listed, follow.Account.URI) {
+			continue
+		}
+
+		if err := d.state.DB.DeleteFollowByID(ctx, follow.ID); err != nil {
+			log.Errorf(ctx, "db error deleting stale follow %s: %v", follow.URI, err)
+			continue
+		}
+
+		// Process side effects as
+		// for any other unfollow.
+		d.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityUndo,
+			GTSModel: &gtsmodel.Follow{
+				AccountID:       follow.AccountID,
+				TargetAccountID: requester.ID,
+				URI:             follow.URI,
+			},
+			Origin: follow.Account,
+			Target: requester,
+		})
+	}
+
+	// Send Undo Follow on behalf of listed
+	// local accounts that don't follow it.
+	for _, uri := range listed {
+		if slices.ContainsFunc(follows, func(follow *gtsmodel.Follow) bool {
+			return follow.Account != nil && follow.Account.URI == uri
+		}) {
+			continue
+		}
+
+		account, err := d.state.DB.GetAccountByURI(gtscontext.SetBarebones(ctx), uri)
+		if err != nil {
+			log.Debugf(ctx, "error getting listed local account %s: %v", uri, err)
+			continue
+		}
+
+		if err := d.undoStaleFollow(ctx, account, requester); err != nil {
+			log.Errorf(ctx, "error undoing stale follow of %s by %s: %v", requester.URI, uri, err)
+		}
+	}
+
+	return nil
+}
+
+// getFollowersPage returns the partial followers collection
+// page referenced by the given first / next property, either
+// embedded in it or dereferenced from its IRI on given host.
+func (d *Dereferencer) getFollowersPage(
+	ctx context.Context,
+	host string,
+	next ap.WithIRI,
+) (ap.CollectionPageIterator, error) {
+	if next.IsIRI() {
+		pageIRI := next.GetIRI()
+		if pageIRI.Host != host {
+			return nil, gtserror.Newf("page %s not hosted on %s", pageIRI, host)
+		}
+
+		return d.dereferenceCollectionPage(ctx, "", pageIRI)
+	}
+
+	embedded, ok := next.(interface {
+		GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage
+		GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage
+	})
+	if ok {
+		if page := embedded.GetActivityStreamsCollectionPage(); page != nil {
+			return ap.WrapCollectionPage(page), nil
+		}
+
+		if page := embedded.GetActivityStreamsOrderedCollectionPage(); page != nil {
+			return ap.WrapOrderedCollectionPage(page), nil
+		}
+	}
+
+	return nil, gtserror.New("unusable collection page")
+}
+
+// undoStaleFollow delivers an Undo of a Follow of target by
+// the local account, for a follow the target believes exists,
+// but which we have no record of.
+func (d *Dereferencer) undoStaleFollow(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	target *gtsmodel.Account,
+) error {
+	follow, err := d.converter.FollowToAS(ctx, &gtsmodel.Follow{
+		ID:              id.NewULID(),
+		URI:             uris.GenerateURIForFollow(account.Username, id.NewULID()),
+		AccountID:       account.ID,
+		Account:         account,
+		TargetAccountID: target.ID,
+		TargetAccount:   target,
+	})
+	if err != nil {
+		return gtserror.Newf("error converting follow: %w", err)
+	}
+
+	actorIRI, err := url.Parse(account.URI)
+	if err != nil {
+		return gtserror.Newf("invalid account uri: %w", err)
+	}
+
+	targetIRI, err := url.Parse(target.URI)
+	if err != nil {
+		return gtserror.Newf("invalid target uri: %w", err)
+	}
+
+	inboxIRI, err := url.Parse(target.InboxURI)
+	if err != nil {
+		return gtserror.Newf("invalid target inbox uri: %w", err)
+	}
+
+	undo := streams.NewActivityStreamsUndo()
+	ap.SetJSONLDId(undo, ap.GetJSONLDId(follow).JoinPath("undo"))
+	ap.AppendActorIRIs(undo, actorIRI)
+	ap.AppendTo(undo, targetIRI)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendActivityStreamsFollow(follow)
+	undo.SetActivityStreamsObject(objectProp)
+
+	m, err := ap.Serialize(undo)
+	if err != nil {
+		return gtserror.Newf("error serializing undo: %w", err)
+	}
+
+	tsport, err := d.transportController.NewTransportForUsername(ctx, account.Username)
+	if err != nil {
+		return gtserror.Newf("error getting transport: %w", err)
+	}
+
+	return tsport.Deliver(ctx, m, inboxIRI)
+}
@@ -0,0 +1,284 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+const (
+	syncFollowersURI = "http://fossbros-anonymous.io/users/foss_satan/followers"
+	syncURL          = "http://fossbros-anonymous.io/users/foss_satan/followers_synchronization"
+	syncInboxURI     = "http://fossbros-anonymous.io/users/foss_satan/inbox"
+)
+
+type SynchronizationTestSuite struct {
+	DereferencerStandardTestSuite
+}
+
+// follow stores a follow of the
+// remote requester by given local account.
+func (suite *SynchronizationTestSuite) follow(id string, account string) *gtsmodel.Follow {
+	follow := &gtsmodel.Follow{
+		ID:              id,
+		URI:             "http://localhost:8080/users/" + suite.testAccounts[account].Username + "/follow/" + id,
+		AccountID:       suite.testAccounts[account].ID,
+		TargetAccountID: suite.testAccounts["remote_account_1"].ID,
+	}
+
+	if err := suite.db.PutFollow(context.Background(), follow); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return follow
+}
+
+// synchronize calls SynchronizeFollowers with a digest that
+// won't match, and runs any resync queued as a result. It
+// returns whether a resync was queued.
+func (suite *SynchronizationTestSuite) synchronize() bool {
+	ctx := context.Background()
+
+	header := ap.CollectionSynchronization{
+		CollectionID: syncFollowersURI,
+		URL:          syncURL,
+		Digest:       strings.Repeat("a", 64),
+	}.String()
+
+	suite.dereferencer.SynchronizeFollowers(ctx,
+		suite.testAccounts["remote_account_1"],
+		header,
+	)
+
+	var queued bool
+	for {
+		fn, ok := suite.state.Workers.Dereference.Queue.Pop()
+		if !ok {
+			return queued
+		}
+
+		fn(ctx)
+		queued = true
+	}
+}
+
+// followExists returns whether the given follow is still in the db.
+func (suite *SynchronizationTestSuite) followExists(follow *gtsmodel.Follow) bool {
+	_, err := suite.db.GetFollowByID(context.Background(), follow.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	return err == nil
+}
+
+// unfollowed returns the URIs of all follows
+// queued to be processed as unfollows.
+func (suite *SynchronizationTestSuite) unfollowed() []string {
+	var uris []string
+	for {
+		msg, ok := suite.state.Workers.Client.Queue.Pop()
+		if !ok {
+			return uris
+		}
+
+		suite.Equal(ap.ActivityFollow, msg.APObjectType)
+		suite.Equal(ap.ActivityUndo, msg.APActivityType)
+		uris = append(uris, msg.GTSModel.(*gtsmodel.Follow).URI)
+	}
+}
+
+// undoneBy returns the actor URIs of
+// all Undos delivered to the requester.
+func (suite *SynchronizationTestSuite) undoneBy() []string {
+	sent, ok := suite.client.SentMessages.Load(syncInboxURI)
+	if !ok {
+		return nil
+	}
+
+	var actors []string
+	for _, b := range sent.([][]byte) {
+		suite.Contains(string(b), `"type":"Undo"`)
+		for _, account := range suite.testAccounts {
+			if account.IsLocal() && strings.Contains(string(b), `"actor":"`+account.URI+`"`) {
+				actors = append(actors, account.URI)
+			}
+		}
+	}
+	return actors
+}
+
+func (suite *SynchronizationTestSuite) TestSynchronizeFollowersPrune() {
+	zorkFollow := suite.follow("01JKH0N1M2ZKQ2T0X4V7C3QG8S", "local_account_1")
+	adminFollow := suite.follow("01JKH0N1M3AX4JXW8R3S9Y2B5D", "admin_account")
+
+	// Collection only lists admin.
+	suite.testRemoteDocuments[syncURL] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    "http://localhost:8080/users/admin",
+    "http://example.org/users/someone_else"
+  ]
+}`
+
+	suite.True(suite.synchronize())
+
+	// Zork's follow should be pruned, and
+	// processed like any other unfollow.
+	suite.False(suite.followExists(zorkFollow))
+	suite.True(suite.followExists(adminFollow))
+	suite.Equal([]string{zorkFollow.URI}, suite.unfollowed())
+	suite.Empty(suite.undoneBy())
+}
+
+func (suite *SynchronizationTestSuite) TestSynchronizeFollowersUndo() {
+	zorkFollow := suite.follow("01JKH0N1M2ZKQ2T0X4V7C3QG8S", "local_account_1")
+
+	// Collection lists zork, and
+	// turtle, who doesn't follow.
+	suite.testRemoteDocuments[syncURL] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/users/1happyturtle"
+  ]
+}`
+
+	suite.True(suite.synchronize())
+
+	// Zork's follow should be kept, and
+	// an Undo sent on turtle's behalf.
+	suite.True(suite.followExists(zorkFollow))
+	suite.Empty(suite.unfollowed())
+	suite.Equal([]string{"http://localhost:8080/users/1happyturtle"}, suite.undoneBy())
+}
+
+func (suite *SynchronizationTestSuite) TestSynchronizeFollowersMultiPage() {
+	zorkFollow := suite.follow("01JKH0N1M2ZKQ2T0X4V7C3QG8S", "local_account_1")
+	adminFollow := suite.follow("01JKH0N1M3AX4JXW8R3S9Y2B5D", "admin_account")
+
+	// Collection lists zork on
+	// the first page, and turtle
+	// on the second page.
+	suite.testRemoteDocuments[syncURL] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `",
+  "type": "OrderedCollection",
+  "first": "` + syncURL + `?page=1"
+}`
+	suite.testRemoteDocuments[syncURL+"?page=1"] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `?page=1",
+  "type": "OrderedCollectionPage",
+  "partOf": "` + syncURL + `",
+  "next": "` + syncURL + `?page=2",
+  "orderedItems": [
+    "http://localhost:8080/users/the_mighty_zork"
+  ]
+}`
+	suite.testRemoteDocuments[syncURL+"?page=2"] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `?page=2",
+  "type": "OrderedCollectionPage",
+  "partOf": "` + syncURL + `",
+  "orderedItems": [
+    "http://localhost:8080/users/1happyturtle"
+  ]
+}`
+
+	suite.True(suite.synchronize())
+
+	// Both pages should have been seen:
+	// zork kept, admin pruned, turtle undone.
+	suite.True(suite.followExists(zorkFollow))
+	suite.False(suite.followExists(adminFollow))
+	suite.Equal([]string{adminFollow.URI}, suite.unfollowed())
+	suite.Equal([]string{"http://localhost:8080/users/1happyturtle"}, suite.undoneBy())
+}
+
+func (suite *SynchronizationTestSuite) TestSynchronizeFollowersTooManyPages() {
+	zorkFollow := suite.follow("01JKH0N1M2ZKQ2T0X4V7C3QG8S", "local_account_1")
+
+	// Collection has more pages than we're
+	// willing to follow, each listing turtle.
+	suite.testRemoteDocuments[syncURL] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `",
+  "type": "OrderedCollection",
+  "first": "` + syncURL + `?page=1"
+}`
+	for i := 1; i <= 25; i++ {
+		suite.testRemoteDocuments[fmt.Sprintf("%s?page=%d", syncURL, i)] = fmt.Sprintf(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "%[1]s?page=%[2]d",
+  "type": "OrderedCollectionPage",
+  "partOf": "%[1]s",
+  "next": "%[1]s?page=%[3]d",
+  "orderedItems": [
+    "http://localhost:8080/users/1happyturtle"
+  ]
+}`, syncURL, i, i+1)
+	}
+
+	suite.True(suite.synchronize())
+
+	// We couldn't see the whole
+	// collection, so nothing should
+	// be pruned or undone.
+	suite.True(suite.followExists(zorkFollow))
+	suite.Empty(suite.unfollowed())
+	suite.Empty(suite.undoneBy())
+}
+
+func (suite *SynchronizationTestSuite) TestSynchronizeFollowersRateLimited() {
+	zorkFollow := suite.follow("01JKH0N1M2ZKQ2T0X4V7C3QG8S", "local_account_1")
+
+	suite.testRemoteDocuments[syncURL] = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + syncURL + `",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    "http://localhost:8080/users/the_mighty_zork"
+  ]
+}`
+
+	// First resync should go ahead, but
+	// not the next one, though the digest
+	// still doesn't match.
+	suite.True(suite.synchronize())
+	suite.False(suite.synchronize())
+
+	suite.True(suite.followExists(zorkFollow))
+	suite.Empty(suite.unfollowed())
+}
+
+func TestSynchronizationTestSuite(t *testing.T) {
+	suite.Run(t, new(SynchronizationTestSuite))
+}
@@ -218,8 +218,77 @@ func (d *Dereferencer) DereferenceStatusAncestors(ctx context.Context, username
 	return gtserror.Newf("reached %d ancestor iterations for %q", maxIter, status.URI)
 }
 
+// BackfillStatusReplies enqueues asynchronous dereferencing of the replies
+// collection of the given remote status, to pull in replies we don't have yet.
+// Unlike regular thread dereferencing, this is limited in depth and number of
+// statuses according to configuration. It is a no-op if replies backfill is
+// disabled, or if the status is local or was fetched very recently.
+func (d *Dereferencer) BackfillStatusReplies(ctx context.Context, requestUser string, status *gtsmodel.Status) {
+	if !config.GetInstanceBackfillReplies() {
+		// Backfill disabled.
+		return
+	}
+
+	if status.IsLocal() || statusFresh(status, Fresh) {
+		// Local replies are already known, and a recent
+		// fetch will have already dereferenced the thread.
+		return
+	}
+
+	// Parse the URI from status.
+	uri, err := url.Parse(status.URI)
+	if err != nil {
+		log.Errorf(ctx, "invalid status uri %q: %v", status.URI, err)
+		return
+	}
+
+	// Enqueue a worker function to re-fetch the status (for its
+	// latest replies collection) and then walk the replies.
+	d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		_, statusable, _, err := d.enrichStatusSafely(ctx,
+			requestUser,
+			uri,
+			status,
+			nil,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error enriching remote status: %v", err)
+			return
+		}
+
+		if statusable == nil {
+			// Nothing to walk.
+			return
+		}
+
+		if err := d.dereferenceStatusDescendants(ctx,
+			requestUser,
+			uri,
+			statusable,
+			config.GetInstanceBackfillRepliesMaxDepth(),
+			config.GetInstanceBackfillRepliesMaxStatuses(),
+		); err != nil {
+			log.Error(ctx, err)
+		}
+	})
+}
+
 // DereferenceStatusDescendents iterates downwards from the given status, using its replies, to ensure that as many children statuses as possible are dereferenced.
 func (d *Dereferencer) DereferenceStatusDescendants(ctx context.Context, username string, statusIRI *url.URL, parent ap.Statusable) error {
+	return d.dereferenceStatusDescendants(ctx, username, statusIRI, parent, 0, 0)
+}
+
+// dereferenceStatusDescendants is the package internal form of DereferenceStatusDescendants(),
+// which stops descending past maxDepth levels of replies, and stops entirely after maxStatuses
+// remote statuses have been dereferenced. Zero values for either mean no limit.
+func (d *Dereferencer) dereferenceStatusDescendants(
+	ctx context.Context,
+	username string,
+	statusIRI *url.URL,
+	parent ap.Statusable,
+	maxDepth int,
+	maxStatuses int,
+) error {
 	statusIRIStr := statusIRI.String()
 
 	// Start log entry with fields
@@ -251,12 +320,21 @@ type frame struct {
 		// the frame's collection page
 		// (is useful for logging).
 		pageURI string
+
+		// depth is how many levels of replies
+		// below the parent status this frame is,
+		// starting at 1 for the parent's replies.
+		depth int
 	}
 
 	var (
 		// current stack frame
 		current *frame
 
+		// number of remote statuses
+		// freshly dereferenced so far.
+		derefd int
+
 		// stack is a list of "shelved" descendand iterator
 		// frames. this is pushed to when a child status frame
 		// is found that we need to further iterate down, and
@@ -269,7 +347,7 @@ func() *frame {
 				if page == nil {
 					return nil
 				}
-				return &frame{page: page, pageURI: pageURI}
+				return &frame{page: page, pageURI: pageURI, depth: 1}
 			}(),
 		}
 
@@ -341,6 +419,17 @@ func() *frame {
 					continue itemLoop
 				}
 
+				if derefd++; maxStatuses > 0 && derefd >= maxStatuses {
+					l.Debugf("reached %d dereferenced statuses", maxStatuses)
+					return nil
+				}
+
+				if maxDepth > 0 && current.depth >= maxDepth {
+					// Don't go any deeper
+					// than allowed depth.
+					continue itemLoop
+				}
+
 				// Extract any attached collection + ID URI from status.
 				page, pageURI := getAttachedStatusCollectionPage(statusable)
 				if page == nil {
@@ -351,6 +440,7 @@ func() *frame {
 				stack = append(stack, current, &frame{
 					pageURI: pageURI,
 					page:    page,
+					depth:   current.depth + 1,
 				})
 
 				// Now start at top of loop
@@ -0,0 +1,204 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const (
+	threadAuthor = "https://unknown-instance.com/users/brand_new_person"
+	threadParent = threadAuthor + "/statuses/parent"
+	threadA      = threadAuthor + "/statuses/a"
+	threadA1     = threadAuthor + "/statuses/a1"
+	threadA2     = threadAuthor + "/statuses/a2"
+	threadB      = threadAuthor + "/statuses/b"
+	threadC      = threadAuthor + "/statuses/c"
+)
+
+type ThreadTestSuite struct {
+	DereferencerStandardTestSuite
+}
+
+func (suite *ThreadTestSuite) SetupTest() {
+	suite.DereferencerStandardTestSuite.SetupTest()
+
+	// Thread looks like:
+	//
+	//	parent
+	//	├── a
+	//	│   └── a1
+	//	│       └── a2
+	//	├── b
+	//	└── c
+	for id, doc := range map[string]string{
+		threadParent: noteJSON(threadParent, threadAuthor, "", threadA, threadB, threadC),
+		threadA:      noteJSON(threadA, threadAuthor, threadParent, threadA1),
+		threadA1:     noteJSON(threadA1, threadAuthor, threadA, threadA2),
+		threadA2:     noteJSON(threadA2, threadAuthor, threadA1),
+		threadB:      noteJSON(threadB, threadAuthor, threadParent),
+		threadC:      noteJSON(threadC, threadAuthor, threadParent),
+	} {
+		suite.testRemoteDocuments[id] = doc
+	}
+}
+
+// parent fetches and returns the parent status of the
+// thread, dropping any thread dereferencing this queues
+// and marking it as not recently fetched, as though it
+// had been stored a while ago.
+func (suite *ThreadTestSuite) parent() *gtsmodel.Status {
+	ctx := context.Background()
+
+	status, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		suite.testAccounts["local_account_1"].Username,
+		testrig.URLMustParse(threadParent),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for {
+		if _, ok := suite.state.Workers.Dereference.Queue.Pop(); !ok {
+			break
+		}
+	}
+
+	status.FetchedAt = time.Now().Add(-time.Hour)
+	if err := suite.db.UpdateStatus(ctx, status, "fetched_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return status
+}
+
+// backfill calls BackfillStatusReplies for the given status, and
+// runs any backfill queued as a result. It returns whether a
+// backfill was queued, checking nothing was fetched before it ran.
+func (suite *ThreadTestSuite) backfill(status *gtsmodel.Status) bool {
+	ctx := context.Background()
+
+	suite.dereferencer.BackfillStatusReplies(ctx,
+		suite.testAccounts["local_account_1"].Username,
+		status,
+	)
+
+	var queued bool
+	for {
+		fn, ok := suite.state.Workers.Dereference.Queue.Pop()
+		if !ok {
+			return queued
+		}
+
+		// Backfill should be async.
+		suite.Empty(suite.stored())
+
+		fn(ctx)
+		queued = true
+	}
+}
+
+// stored returns which of the replies in the thread are stored.
+func (suite *ThreadTestSuite) stored() []string {
+	var uris []string
+	for _, uri := range []string{
+		threadA,
+		threadA1,
+		threadA2,
+		threadB,
+		threadC,
+	} {
+		_, err := suite.db.GetStatusByURI(context.Background(), uri)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+		if err == nil {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+func (suite *ThreadTestSuite) TestBackfillStatusRepliesDisabled() {
+	config.SetInstanceBackfillReplies(false)
+
+	suite.False(suite.backfill(suite.parent()))
+	suite.Empty(suite.stored())
+}
+
+func (suite *ThreadTestSuite) TestBackfillStatusRepliesLocal() {
+	config.SetInstanceBackfillReplies(true)
+
+	// Local statuses never need backfilling.
+	suite.False(suite.backfill(testrig.NewTestStatuses()["local_account_1_status_1"]))
+}
+
+func (suite *ThreadTestSuite) TestBackfillStatusRepliesFresh() {
+	config.SetInstanceBackfillReplies(true)
+
+	// A status fetched just now
+	// doesn't need backfilling.
+	status := suite.parent()
+	status.FetchedAt = time.Now()
+
+	suite.False(suite.backfill(status))
+	suite.Empty(suite.stored())
+}
+
+func (suite *ThreadTestSuite) TestBackfillStatusRepliesMaxDepth() {
+	config.SetInstanceBackfillReplies(true)
+	config.SetInstanceBackfillRepliesMaxDepth(2)
+	config.SetInstanceBackfillRepliesMaxStatuses(100)
+
+	// Replies of replies should be fetched,
+	// but not their replies in turn.
+	suite.True(suite.backfill(suite.parent()))
+	suite.Equal([]string{
+		threadA,
+		threadA1,
+		threadB,
+		threadC,
+	}, suite.stored())
+}
+
+func (suite *ThreadTestSuite) TestBackfillStatusRepliesMaxStatuses() {
+	config.SetInstanceBackfillReplies(true)
+	config.SetInstanceBackfillRepliesMaxDepth(3)
+	config.SetInstanceBackfillRepliesMaxStatuses(2)
+
+	// Thread is walked depth first,
+	// stopping after the first two.
+	suite.True(suite.backfill(suite.parent()))
+	suite.Equal([]string{
+		threadA,
+		threadA1,
+	}, suite.stored())
+}
+
+func TestThreadTestSuite(t *testing.T) {
+	suite.Run(t, new(ThreadTestSuite))
+}
@@ -33,6 +33,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 )
 
 // federatingActor wraps the pub.FederatingActor
@@ -119,6 +120,13 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		return false, nil
 	}
 
+	// Count this activity now it's been
+	// resolved, before any authorization.
+	metrics.RecordActivityReceived(ctx,
+		activity.GetTypeName(),
+		objectTypeName(activity),
+	)
+
 	// Set additional context data. Primarily this means
 	// looking at the Activity and seeing which IRIs are
 	// involved in it tangentially.
@@ -262,3 +270,20 @@ func (f *federatingActor) PostOutboxScheme(c context.Context, w http.ResponseWri
 func (f *federatingActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
 	return f.wrapped.GetOutbox(c, w, r)
 }
+
+// objectTypeName returns the type name of the first
+// object of activity, or empty if the object has no
+// type (ie., is only an IRI) or there is no object.
+func objectTypeName(activity pub.Activity) string {
+	objectProp := activity.GetActivityStreamsObject()
+	if objectProp == nil || objectProp.Len() == 0 {
+		return ""
+	}
+
+	t := objectProp.At(0).GetType()
+	if t == nil {
+		return ""
+	}
+
+	return t.GetTypeName()
+}
@@ -86,6 +86,21 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 	// Iterate all provided objects in the activity,
 	// handling the ones we know how to handle.
 	for _, object := range ap.ExtractObjects(accept) {
+		// Check if this is a response
+		// to one of our relay Follows.
+		isRelay, err := f.relayRespond(ctx,
+			object,
+			requestingAcct,
+			gtsmodel.RelayStateAccepted,
+		)
+		if err != nil {
+			return err
+		}
+
+		if isRelay {
+			continue
+		}
+
 		if asType := object.GetType(); asType != nil {
 
 			// Check and handle any vocab.Type objects.
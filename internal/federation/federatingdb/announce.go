@@ -60,7 +60,31 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		)
 	}
 
+	// If this Announce is from a relay we're
+	// subscribed to, it's relaying content into
+	// the federated timeline rather than boosting.
+	relay, err := f.activeRelay(ctx, requestingAcct)
+	if err != nil {
+		return err
+	}
+
+	if relay != nil {
+		return f.relayAnnounce(ctx,
+			announce,
+			receivingAcct,
+			requestingAcct,
+		)
+	}
+
 	boost, isNew, err := f.converter.ASAnnounceToStatus(ctx, announce)
+	if gtserror.IsNotRelevant(err) {
+		// A Group actor forwarding an activity
+		// we don't convert to a boost (e.g. a
+		// wrapped Like), nothing to do here.
+		log.Debugf(ctx, "ignoring announce: %v", err)
+		return nil
+	}
+
 	if err != nil {
 		return gtserror.Newf("error converting announce to boost: %w", err)
 	}
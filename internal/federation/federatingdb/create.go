@@ -457,6 +457,16 @@ func (f *federatingDB) activityLike(
 		return gtserror.SetMalformed(err)
 	}
 
+	if ap.ExtractReaction(like) != "" {
+		// This Like carries an emoji
+		// reaction, handle it as such.
+		return f.activityReaction(ctx,
+			like,
+			receivingAcct,
+			requestingAcct,
+		)
+	}
+
 	fave, err := f.converter.ASLikeToFave(ctx, like)
 	if err != nil {
 		return gtserror.Newf("could not convert Like to fave: %w", err)
@@ -550,6 +560,71 @@ func (f *federatingDB) activityLike(
 	return nil
 }
 
+/*
+	REACTION HANDLERS
+*/
+
+func (f *federatingDB) activityReaction(
+	ctx context.Context,
+	like vocab.ActivityStreamsLike,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) error {
+	reaction, err := f.converter.ASLikeToReaction(ctx, like)
+	if err != nil {
+		return gtserror.Newf("could not convert Like to reaction: %w", err)
+	}
+
+	// Ensure requester not trying to
+	// React on someone else's behalf.
+	if reaction.AccountID != requestingAcct.ID {
+		text := fmt.Sprintf(
+			"requestingAcct %s is not Like actor account %s",
+			requestingAcct.URI, reaction.Account.URI,
+		)
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if !*reaction.Status.Local {
+		// Only process reactions to local statuses.
+		return nil
+	}
+
+	// Reactions are subject to the
+	// same policy as Likes of a status.
+	policyResult, err := f.intFilter.StatusLikeable(ctx,
+		requestingAcct,
+		reaction.Status,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is likeable: %w", reaction.Status.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// There's no way to approve a reaction after
+	// the fact, so only accept reactions where a
+	// Like would be permitted without approval.
+	if !policyResult.Permitted() {
+		const errText = "requester does not have permission to react to this status"
+		err := gtserror.New(errText)
+		return gtserror.NewErrorForbidden(err, errText)
+	}
+
+	// Side effects (including dereferencing any
+	// custom emoji, and storing the reaction)
+	// are handled asynchronously by the processor.
+	reaction.ID = id.NewULID()
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
+
 /*
 	FLAG HANDLERS
 */
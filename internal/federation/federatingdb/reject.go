@@ -52,6 +52,21 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 	}
 
 	for _, object := range ap.ExtractObjects(reject) {
+		// Check if this is a response
+		// to one of our relay Follows.
+		isRelay, err := f.relayRespond(ctx,
+			object,
+			requestingAcct,
+			gtsmodel.RelayStateRejected,
+		)
+		if err != nil {
+			return err
+		}
+
+		if isRelay {
+			continue
+		}
+
 		if asType := object.GetType(); asType != nil {
 			// Check and handle any vocab.Type objects.
 			switch name := asType.GetTypeName(); name {
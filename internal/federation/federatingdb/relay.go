@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// relayRespond checks whether the given Accept or Reject
+// object is the Follow sent by the instance account to a
+// relay, and if so updates the relay to the given state.
+//
+// Returns true if the object was a relay Follow, in which
+// case the caller should not process the object further.
+func (f *federatingDB) relayRespond(
+	ctx context.Context,
+	object ap.TypeOrIRI,
+	requestingAcct *gtsmodel.Account,
+	state gtsmodel.RelayState,
+) (bool, error) {
+	var followIRI *url.URL
+	if asType := object.GetType(); asType != nil {
+		followIRI = ap.GetJSONLDId(asType)
+	} else if object.IsIRI() {
+		followIRI = object.GetIRI()
+	}
+
+	if followIRI == nil {
+		return false, nil
+	}
+
+	relay, err := f.state.DB.GetRelayByFollowURI(ctx, followIRI.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting relay: %w", err)
+	}
+
+	if relay == nil {
+		// Not a relay Follow.
+		return false, nil
+	}
+
+	if relay.ActorURI != requestingAcct.URI {
+		// Only the relay itself can
+		// respond to our relay Follow.
+		log.Debugf(ctx,
+			"requester %s cannot respond to relay follow %s",
+			requestingAcct.URI, relay.FollowURI,
+		)
+		return true, nil
+	}
+
+	if relay.State == state {
+		// Nothing to change.
+		return true, nil
+	}
+
+	relay.State = state
+	if err := f.state.DB.UpdateRelay(ctx, relay, "state"); err != nil {
+		return true, gtserror.Newf("db error updating relay: %w", err)
+	}
+
+	return true, nil
+}
+
+// activeRelay returns the relay subscription for the given
+// requesting account, if the requesting account is a relay
+// that has accepted our subscription, and is enabled.
+func (f *federatingDB) activeRelay(
+	ctx context.Context,
+	requestingAcct *gtsmodel.Account,
+) (*gtsmodel.Relay, error) {
+	relay, err := f.state.DB.GetRelayByActorURI(ctx, requestingAcct.URI)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting relay: %w", err)
+	}
+
+	if relay == nil || !relay.Active() {
+		return nil, nil
+	}
+
+	return relay, nil
+}
+
+// relayAnnounce handles an Announce from a subscribed
+// relay, by dereferencing and storing the announced status
+// (without creating a boost by the relay actor), so that
+// it's shown in the federated timeline.
+func (f *federatingDB) relayAnnounce(
+	ctx context.Context,
+	announce vocab.ActivityStreamsAnnounce,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) error {
+	objectIRI, err := ap.ExtractAnnounceObjectURI(announce)
+	if err != nil {
+		return gtserror.Newf("error extracting relayed object: %w", err)
+	}
+
+	// Process the relayed status asynchronously;
+	// it will be dereferenced (from its origin) and
+	// stored, if we don't already have it.
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		APIRI:          objectIRI,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RelayTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *RelayTestSuite) putRelay(relayAccount *gtsmodel.Account, state gtsmodel.RelayState) *gtsmodel.Relay {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relay := &gtsmodel.Relay{
+		ID:                 id.NewULID(),
+		ActorURI:           relayAccount.URI,
+		InboxURI:           relayAccount.InboxURI,
+		FollowURI:          uris.GenerateURIForFollow(instanceAccount.Username, id.NewULID()),
+		State:              state,
+		Enabled:            util.Ptr(true),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.db.PutRelay(context.Background(), relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return relay
+}
+
+func (suite *RelayTestSuite) TestAcceptRelayFollow() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	relay := suite.putRelay(relayAccount, gtsmodel.RelayStatePending)
+
+	// Relay Accepts the Follow by IRI.
+	accept := streams.NewActivityStreamsAccept()
+	ap.SetJSONLDId(accept, testrig.URLMustParse("http://example.org/some/accept/id"))
+	ap.AppendActorIRIs(accept, testrig.URLMustParse(relayAccount.URI))
+	ap.AppendObjectIRIs(accept, testrig.URLMustParse(relay.FollowURI))
+	ap.AppendTo(accept, testrig.URLMustParse(instanceAccount.URI))
+
+	if err := suite.federatingDB.Accept(ctx, accept); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Relay should now be accepted.
+	dbRelay, err := suite.db.GetRelayByID(context.Background(), relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.RelayStateAccepted, dbRelay.State)
+
+	// Nothing else should have been processed.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+}
+
+func (suite *RelayTestSuite) TestRelayAnnounce() {
+	receivingAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(receivingAccount, relayAccount)
+
+	suite.putRelay(relayAccount, gtsmodel.RelayStateAccepted)
+
+	announce := suite.testActivities["announce_forwarded_1_zork"]
+	if err := suite.federatingDB.Announce(ctx, announce.Activity.(vocab.ActivityStreamsAnnounce)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Relayed status should be created
+	// by IRI, rather than as a boost.
+	msg, _ := suite.getFederatorMsg(5 * time.Second)
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+	suite.Nil(msg.GTSModel)
+	suite.Equal("http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1", msg.APIRI.String())
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
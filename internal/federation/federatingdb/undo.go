@@ -182,6 +182,17 @@ func (f *federatingDB) undoLike(
 		return gtserror.SetMalformed(err)
 	}
 
+	if ap.ExtractReaction(asLike) != "" {
+		// This Like carries an emoji
+		// reaction, undo it as such.
+		return f.undoReaction(ctx,
+			receivingAcct,
+			requestingAcct,
+			undo,
+			asLike,
+		)
+	}
+
 	// Make sure the Undo
 	// actor owns the target.
 	if !sameActor(
@@ -260,6 +271,71 @@ func (f *federatingDB) undoLike(
 	return nil
 }
 
+func (f *federatingDB) undoReaction(
+	ctx context.Context,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+	undo vocab.ActivityStreamsUndo,
+	asLike vocab.ActivityStreamsLike,
+) error {
+	// Make sure the Undo
+	// actor owns the target.
+	if !sameActor(
+		undo.GetActivityStreamsActor(),
+		asLike.GetActivityStreamsActor(),
+	) {
+		// Ignore this Activity.
+		return nil
+	}
+
+	likeIRI := ap.GetJSONLDId(asLike)
+	if likeIRI == nil {
+		const text = "Like had no id"
+		return gtserror.SetMalformed(errors.New(text))
+	}
+
+	// Unlike with faves, reactions are
+	// always looked up by their URI, since
+	// the emoji itself may not even be
+	// resolvable anymore by this point.
+	reaction, err := f.state.DB.GetStatusReactionByURI(
+		gtscontext.SetBarebones(ctx),
+		likeIRI.String(),
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting reaction %s: %w", likeIRI, err)
+		return err
+	}
+
+	if reaction == nil {
+		// We didn't have this reaction
+		// stored anyway, so we can't
+		// Undo it, just ignore.
+		return nil
+	}
+
+	// Ensure addressee is reaction target.
+	if reaction.TargetAccountID != receivingAcct.ID {
+		const text = "receivingAcct was not reaction target"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Ensure requester is reaction origin.
+	if reaction.AccountID != requestingAcct.ID {
+		const text = "requestingAcct was not reaction origin"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Delete the reaction.
+	if err := f.state.DB.DeleteStatusReactionByID(ctx, reaction.ID); err != nil {
+		err := gtserror.Newf("db error deleting reaction %s: %w", reaction.ID, err)
+		return err
+	}
+
+	log.Debug(ctx, "reaction undone")
+	return nil
+}
+
 func (f *federatingDB) undoBlock(
 	ctx context.Context,
 	receivingAcct *gtsmodel.Account,
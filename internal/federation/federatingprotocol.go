@@ -233,6 +233,14 @@ func (f *Federator) AuthenticatePostInbox(ctx context.Context, w http.ResponseWr
 		return ctx, false, errWithCode
 	}
 
+	if pubKeyAuth.Unsigned {
+		// Authorized fetch mode only ever applies
+		// to GETs, but make sure we never accept
+		// an unsigned delivery to an inbox.
+		w.WriteHeader(http.StatusUnauthorized)
+		return ctx, false, gtserror.NewErrorUnauthorized(errUnsigned)
+	}
+
 	if pubKeyAuth.Handshaking {
 		// There is a mutal handshake occurring between us and
 		// the owner URI. Return 202 and leave as we can't do
@@ -241,10 +249,29 @@ func (f *Federator) AuthenticatePostInbox(ctx context.Context, w http.ResponseWr
 		return ctx, false, nil
 	}
 
+	// Verify integrity proof (FEP-8b32) on the
+	// activity if present, rejecting invalid ones.
+	if errWithCode := f.verifyIntegrityProof(ctx,
+		r,
+		receivingAccount.Username,
+		pubKeyAuth.Owner,
+	); errWithCode != nil {
+		w.WriteHeader(errWithCode.Code())
+		return ctx, false, errWithCode
+	}
+
 	// We have everything we need now, set the requesting
 	// and receiving accounts on the context for later use.
 	ctx = gtscontext.SetRequestingAccount(ctx, pubKeyAuth.Owner)
 	ctx = gtscontext.SetReceivingAccount(ctx, receivingAccount)
+
+	// Check whether our view of the requester's followers
+	// has drifted from theirs, if they've told us (FEP-8fcf).
+	f.SynchronizeFollowers(ctx,
+		pubKeyAuth.Owner,
+		r.Header.Get(ap.CollectionSynchronizationHeader),
+	)
+
 	return ctx, true, nil
 }
 
@@ -0,0 +1,213 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// verifyIntegrityProof verifies the integrity proof (FEP-8b32)
+// attached to the activity in the given inbox POST request body,
+// if any, returning an error with code if the proof is invalid.
+// The request body is replaced so that it can be read again.
+func (f *Federator) verifyIntegrityProof(
+	ctx context.Context,
+	r *http.Request,
+	requestedUsername string,
+	requester *gtsmodel.Account,
+) gtserror.WithCode {
+	// Read the request body so we
+	// can check it for a proof, then
+	// replace it for later resolution.
+	body := http.MaxBytesReader(nil, r.Body, ap.MaxIncomingBodySize)
+	b, err := io.ReadAll(body)
+	_ = body.Close()
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			const text = "body exceeds maximum size"
+			return gtserror.NewErrorRequestEntityTooLarge(err, text)
+		}
+
+		err := gtserror.Newf("error reading request body: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b))
+
+	if !bytes.Contains(b, []byte(`"proof"`)) {
+		// Cheap check for the
+		// (common) no proof case.
+		return nil
+	}
+
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal(b, &raw); err != nil {
+		// Leave malformed json to be
+		// rejected on activity resolution.
+		return nil
+	}
+
+	keyID, ok, err := ap.ExtractIntegrityProofKeyID(raw)
+	if !ok {
+		// No proof after all.
+		return nil
+	} else if err != nil {
+		const text = "malformed integrity proof"
+		return gtserror.NewErrorBadRequest(gtserror.Newf("%s: %w", text, err), text)
+	}
+
+	// Proof must be made by the actor
+	// that is performing the activity.
+	actorID := activityActorID(raw)
+	if actorID == "" {
+		const text = "integrity proof on activity without actor"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if key := cachedIntegrityKey(requester, keyID, actorID); key != nil {
+		if ap.VerifyIntegrityProof(raw, key) == nil {
+			// Verified with cached key.
+			return nil
+		}
+
+		// The key may have been rotated,
+		// fall through to fetch it again.
+	}
+
+	key, errWithCode := f.fetchIntegrityKey(ctx,
+		requestedUsername,
+		requester,
+		keyID,
+		actorID,
+	)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := ap.VerifyIntegrityProof(raw, key); err != nil {
+		const text = "integrity proof verification failed"
+		return gtserror.NewErrorUnauthorized(gtserror.Newf("%s: %w", text, err), text)
+	}
+
+	return nil
+}
+
+// cachedIntegrityKey returns the Ed25519 key cached
+// on requester, if it matches the given key and actor.
+func cachedIntegrityKey(requester *gtsmodel.Account, keyID string, actorID string) ed25519.PublicKey {
+	if requester.URI != actorID ||
+		requester.Ed25519PublicKeyURI != keyID ||
+		len(requester.Ed25519PublicKey) != ed25519.PublicKeySize {
+		return nil
+	}
+	return ed25519.PublicKey(requester.Ed25519PublicKey)
+}
+
+// fetchIntegrityKey dereferences the Ed25519 key with given ID,
+// checking that it is controlled by the given actor. If the actor
+// is the requester, the key is also cached on the requester.
+func (f *Federator) fetchIntegrityKey(
+	ctx context.Context,
+	requestedUsername string,
+	requester *gtsmodel.Account,
+	keyID string,
+	actorID string,
+) (ed25519.PublicKey, gtserror.WithCode) {
+	keyIRI, err := url.Parse(keyID)
+	if err != nil {
+		const text = "invalid integrity proof verificationMethod"
+		return nil, gtserror.NewErrorBadRequest(gtserror.Newf("%s: %w", text, err), text)
+	}
+
+	actorIRI, err := url.Parse(actorID)
+	if err != nil {
+		const text = "invalid activity actor"
+		return nil, gtserror.NewErrorBadRequest(gtserror.Newf("%s: %w", text, err), text)
+	}
+
+	// The key must be hosted alongside its actor,
+	// else anyone could claim control of the key.
+	if keyIRI.Host != actorIRI.Host {
+		const text = "integrity proof verificationMethod not on actor's host"
+		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+
+	b, errWithCode := f.callForPubKey(ctx, requestedUsername, keyIRI)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(b, &doc); err != nil {
+		const text = "integrity proof verificationMethod not valid json"
+		return nil, gtserror.NewErrorUnauthorized(gtserror.Newf("%s: %w", text, err), text)
+	}
+
+	key, controller, err := ap.ExtractAssertionMethod(doc, keyID)
+	if err != nil {
+		const text = "integrity proof verificationMethod not recognizable"
+		return nil, gtserror.NewErrorUnauthorized(gtserror.Newf("%s: %w", text, err), text)
+	}
+
+	if controller != actorID {
+		const text = "integrity proof verificationMethod not controlled by actor"
+		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+
+	if requester.URI == actorID {
+		// Cache key on requester so we
+		// needn't fetch it again next time.
+		requester.Ed25519PublicKey = key
+		requester.Ed25519PublicKeyURI = keyID
+		if err := f.db.UpdateAccount(ctx,
+			requester,
+			"ed25519_public_key",
+			"ed25519_public_key_uri",
+		); err != nil {
+			log.Errorf(ctx, "error caching ed25519 key for %s: %v", actorID, err)
+		}
+	}
+
+	return key, nil
+}
+
+// activityActorID returns the ID of the
+// actor of the given serialized activity.
+func activityActorID(raw map[string]interface{}) string {
+	switch actor := raw["actor"].(type) {
+	case string:
+		return actor
+	case map[string]interface{}:
+		id, _ := actor["id"].(string)
+		return id
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,184 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package spam
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// StatusScore returns a heuristic spam score for the given
+// incoming remote status, delivered to the receiver, along
+// with human-readable reasons for each part of the score.
+//
+// Unlike StatusableOK, this should be called only once the
+// status has been dereferenced, as it uses the status model
+// for visibility and author, and the statusable for content.
+//
+// A score of 0 is returned without further checks if any local
+// account follows the status author, or if an admin previously
+// marked a spam flag against the author as a false positive.
+//
+// Otherwise, the score is the sum of:
+//
+//   - 1 per mention above instance-federation-spam-score-max-mentions.
+//   - 1 per hashtag above instance-federation-spam-score-max-hashtags.
+//   - 2 per non-mention, non-hashtag link.
+//   - 2 if the author account is younger than instance-federation-spam-score-new-account-age.
+//   - 3 if the status is a direct message, and is first contact between the author and receiver.
+func (f *Filter) StatusScore(
+	ctx context.Context,
+	receiver *gtsmodel.Account,
+	status *gtsmodel.Status,
+	statusable ap.Statusable,
+) (int, []string, error) {
+	author := status.Account
+	if author == nil {
+		var err error
+		author, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			status.AccountID,
+		)
+		if err != nil {
+			return 0, nil, gtserror.Newf("db error getting status author: %w", err)
+		}
+	}
+
+	// If anyone here follows the author, they're
+	// presumably not a spammer. This also covers
+	// the receiver following the author themself.
+	followerIDs, err := f.state.DB.GetAccountLocalFollowerIDs(ctx, author.ID)
+	if err != nil {
+		return 0, nil, gtserror.Newf("db error getting local followers: %w", err)
+	}
+
+	if len(followerIDs) != 0 {
+		return 0, nil, nil
+	}
+
+	// If an admin has previously told us that
+	// we got this author wrong, trust them.
+	falsePositives, err := f.state.DB.CountSpamFalsePositives(ctx, author.ID)
+	if err != nil {
+		return 0, nil, gtserror.Newf("db error counting false positives: %w", err)
+	}
+
+	if falsePositives != 0 {
+		return 0, nil, nil
+	}
+
+	var (
+		score   int
+		reasons []string
+	)
+
+	// Mentions above the allowed count.
+	rawMentions, _ := ap.ExtractMentions(statusable)
+	mentions := prepMentions(ctx, rawMentions)
+	if excess := len(mentions) - config.GetInstanceFederationSpamScoreMaxMentions(); excess > 0 {
+		score += excess
+		reasons = append(reasons, fmt.Sprintf("%d mentions", len(mentions)))
+	}
+
+	// Hashtags above the allowed count.
+	hashtags, _ := ap.ExtractHashtags(statusable)
+	if excess := len(hashtags) - config.GetInstanceFederationSpamScoreMaxHashtags(); excess > 0 {
+		score += excess
+		reasons = append(reasons, fmt.Sprintf("%d hashtags", len(hashtags)))
+	}
+
+	// Links that don't belong to a mention or hashtag.
+	if links := f.errantLinks(ctx, statusable, mentions, hashtags); links > 0 {
+		score += 2 * links
+		reasons = append(reasons, fmt.Sprintf("%d non-mention, non-hashtag links", links))
+	}
+
+	// Recently created author account. For remote
+	// accounts CreatedAt is taken from the published
+	// property where provided, else it's the time at
+	// which we first saw the account.
+	if maxAge := config.GetInstanceFederationSpamScoreNewAccountAge(); maxAge > 0 {
+		if age := time.Since(author.CreatedAt); age < maxAge {
+			score += 2
+			reasons = append(reasons, "new account")
+		}
+	}
+
+	// Unsolicited direct message.
+	if status.Visibility == gtsmodel.VisibilityDirect {
+		firstContact, err := f.firstContact(ctx, receiver, author, status)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if firstContact {
+			score += 3
+			reasons = append(reasons, "first contact direct message")
+		}
+	}
+
+	return score, reasons, nil
+}
+
+// firstContact returns true if the given status from
+// author is not a reply to receiver, and neither account
+// follows, or has requested to follow, the other one.
+func (f *Filter) firstContact(
+	ctx context.Context,
+	receiver *gtsmodel.Account,
+	author *gtsmodel.Account,
+	status *gtsmodel.Status,
+) (bool, error) {
+	if status.InReplyToAccountID == receiver.ID {
+		// Replying to something
+		// the receiver sent them.
+		return false, nil
+	}
+
+	for _, pair := range [][2]string{
+		{receiver.ID, author.ID},
+		{author.ID, receiver.ID},
+	} {
+		follows, err := f.state.DB.IsFollowing(ctx, pair[0], pair[1])
+		if err != nil {
+			return false, gtserror.Newf("db error checking follow status: %w", err)
+		}
+
+		if follows {
+			return false, nil
+		}
+
+		followRequested, err := f.state.DB.IsFollowRequested(ctx, pair[0], pair[1])
+		if err != nil {
+			return false, gtserror.Newf("db error checking follow req status: %w", err)
+		}
+
+		if followRequested {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,190 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package spam_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ScoreTestSuite struct {
+	FilterStandardTestSuite
+}
+
+func (suite *ScoreTestSuite) TestStatusScore() {
+	var (
+		ctx      = context.Background()
+		receiver = suite.testAccounts["local_account_1"]
+		author   = suite.testAccounts["remote_account_1"]
+	)
+
+	// spam1 mentions 5 people, and
+	// contains one errant link.
+	rc := io.NopCloser(bytes.NewReader([]byte(spam1)))
+	statusable, err := ap.ResolveStatusable(ctx, rc)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Brand new account
+	// with the same ID.
+	newAuthor := new(gtsmodel.Account)
+	*newAuthor = *author
+	newAuthor.CreatedAt = time.Now()
+
+	for _, test := range []struct {
+		author     *gtsmodel.Account
+		visibility gtsmodel.Visibility
+		score      int
+		reasons    []string
+	}{
+		{
+			author:     author,
+			visibility: gtsmodel.VisibilityPublic,
+			score:      4,
+			reasons: []string{
+				"5 mentions",
+				"1 non-mention, non-hashtag links",
+			},
+		},
+		{
+			author:     newAuthor,
+			visibility: gtsmodel.VisibilityPublic,
+			score:      6,
+			reasons: []string{
+				"5 mentions",
+				"1 non-mention, non-hashtag links",
+				"new account",
+			},
+		},
+		{
+			author:     author,
+			visibility: gtsmodel.VisibilityDirect,
+			score:      7,
+			reasons: []string{
+				"5 mentions",
+				"1 non-mention, non-hashtag links",
+				"first contact direct message",
+			},
+		},
+	} {
+		status := &gtsmodel.Status{
+			AccountID:  test.author.ID,
+			Account:    test.author,
+			Visibility: test.visibility,
+		}
+
+		score, reasons, err := suite.filter.StatusScore(ctx, receiver, status, statusable)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(test.score, score)
+		suite.Equal(test.reasons, reasons)
+	}
+
+	// Mark a spam flag against the
+	// author as a false positive.
+	if err := suite.state.DB.PutSpamFlag(ctx, &gtsmodel.SpamFlag{
+		ID:                       id.NewULID(),
+		StatusURI:                "http://fossbros-anonymous.io/users/foss_satan/statuses/01J9SBYB47R3NGH3C4PKPQX1AP",
+		AccountID:                author.ID,
+		TargetAccountID:          receiver.ID,
+		Score:                    4,
+		SinBinned:                util.Ptr(false),
+		FalsePositiveAt:          time.Now(),
+		FalsePositiveByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Author should now be exempt.
+	score, reasons, err := suite.filter.StatusScore(ctx,
+		receiver,
+		&gtsmodel.Status{
+			AccountID:  author.ID,
+			Account:    author,
+			Visibility: gtsmodel.VisibilityDirect,
+		},
+		statusable,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Zero(score)
+	suite.Empty(reasons)
+}
+
+func (suite *ScoreTestSuite) TestStatusScoreLocalFollower() {
+	var (
+		ctx      = context.Background()
+		receiver = suite.testAccounts["local_account_1"]
+		author   = suite.testAccounts["remote_account_1"]
+		follower = suite.testAccounts["local_account_2"]
+	)
+
+	rc := io.NopCloser(bytes.NewReader([]byte(spam1)))
+	statusable, err := ap.ResolveStatusable(ctx, rc)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a follow in place from another
+	// local account (not receiver) to author.
+	fID := id.NewULID()
+	if err := suite.state.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              fID,
+		URI:             "http://localhost:8080/users/1happyturtle/follows/" + fID,
+		AccountID:       follower.ID,
+		TargetAccountID: author.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Someone here follows the
+	// author so it shouldn't score.
+	score, reasons, err := suite.filter.StatusScore(ctx,
+		receiver,
+		&gtsmodel.Status{
+			AccountID:  author.ID,
+			Account:    author,
+			Visibility: gtsmodel.VisibilityDirect,
+		},
+		statusable,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Zero(score)
+	suite.Empty(reasons)
+}
+
+func TestScoreTestSuite(t *testing.T) {
+	suite.Run(t, &ScoreTestSuite{})
+}
@@ -153,7 +153,7 @@ func (f *Filter) StatusableOK(
 	// aside from mentions and hashtags? Include the
 	// summary/content warning when checking.
 	hashtags, _ := ap.ExtractHashtags(statusable)
-	hasErrantLinks := f.errantLinks(ctx, statusable, mentions, hashtags)
+	hasErrantLinks := f.errantLinks(ctx, statusable, mentions, hashtags) != 0
 	if hasErrantLinks {
 		err := errors.New("status has one or more non-mention, non-hashtag links")
 		return gtserror.SetSpam(err)
@@ -352,15 +352,15 @@ func(mention preppedMention) bool {
 	)
 }
 
-// errantLinks returns true if any http/https
-// link discovered in the statusable content + cw
-// is not either a mention link, or a hashtag link.
+// errantLinks returns the number of http/https
+// links discovered in the statusable content + cw
+// that are not either a mention link, or a hashtag link.
 func (f *Filter) errantLinks(
 	ctx context.Context,
 	statusable ap.Statusable,
 	mentions []preppedMention,
 	hashtags []*gtsmodel.Tag,
-) bool {
+) int {
 	// Concatenate the cw with the
 	// content to check for links in both.
 	cw := ap.ExtractSummary(statusable)
@@ -398,6 +398,7 @@ type preppedLink struct {
 	// For each link in the status, try to
 	// match it to a hashtag or a mention.
 	// If we can't, we have an errant link.
+	var errant int
 	for _, link := range links {
 		hashtagLink := slices.ContainsFunc(
 			hashtags,
@@ -463,10 +464,8 @@ func(mention preppedMention) bool {
 		// Not a hashtag link
 		// or a mention link,
 		// so it's errant.
-		return true
+		errant++
 	}
 
-	// All links OK, or
-	// no links found.
-	return false
+	return errant
 }
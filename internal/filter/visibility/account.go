@@ -125,8 +125,8 @@ func (f *Filter) isAccountVisible(ctx context.Context, account *gtsmodel.Account
 			return false, err
 		}
 
-		// Make sure that user is active (i.e. not disabled, not approved etc).
-		if *user.Disabled || !*user.Approved || user.ConfirmedAt.IsZero() {
+		// Make sure that user is active (i.e. not disabled, not approved, not pending deletion etc).
+		if *user.Disabled || !*user.Approved || user.ConfirmedAt.IsZero() || user.PendingDeletion() {
 			log.Trace(ctx, "local account not active")
 			return false, nil
 		}
@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AccountVisibleTestSuite struct {
+	FilterStandardTestSuite
+}
+
+func (suite *AccountVisibleTestSuite) TestPendingDeletionAccountNotVisible() {
+	ctx := context.Background()
+
+	targetAccount := suite.testAccounts["local_account_1"]
+	requester := suite.testAccounts["local_account_2"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+
+	// Mark the user as having asked for
+	// their account to be deleted.
+	user.DeletionRequestedAt = time.Now()
+	if err := suite.db.UpdateUser(ctx, user, "deletion_requested_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Account should be hidden from
+	// everyone, and so should its statuses.
+	for _, requester := range []*gtsmodel.Account{nil, requester} {
+		visible, err := suite.filter.AccountVisible(ctx, requester, targetAccount)
+		suite.NoError(err)
+		suite.False(visible)
+
+		visible, err = suite.filter.StatusVisible(ctx, requester, targetStatus)
+		suite.NoError(err)
+		suite.False(visible)
+	}
+
+	// Cancel the deletion, account
+	// should be visible again.
+	user.DeletionRequestedAt = time.Time{}
+	if err := suite.db.UpdateUser(ctx, user, "deletion_requested_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	visible, err := suite.filter.AccountVisible(ctx, requester, targetAccount)
+	suite.NoError(err)
+	suite.True(visible)
+}
+
+func TestAccountVisibleTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountVisibleTestSuite))
+}
@@ -40,6 +40,19 @@ func (f *Filter) StatusPublicTimelineable(ctx context.Context, requester *gtsmod
 		requesterID = requester.ID
 	}
 
+	// Statuses from silenced accounts (or limited
+	// domains) are kept off the public timeline for
+	// everyone but the author. This is checked outside
+	// of the cached lookup, as silences can be lifted.
+	silenced, err := f.silencedFor(ctx, requesterID, status)
+	if err != nil {
+		return false, err
+	}
+
+	if silenced {
+		return false, nil
+	}
+
 	visibility, err := f.state.Caches.Visibility.LoadOne("Type,RequesterID,ItemID", func() (*cache.CachedVisibility, error) {
 		// Visibility not yet cached, perform timeline visibility lookup.
 		visible, err := f.isStatusPublicTimelineable(ctx, requester, status)
@@ -121,3 +134,30 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 	// level status. Show on public timeline.
 	return true, nil
 }
+
+// silencedFor returns whether status was authored by a
+// silenced account, or an account on a limited domain,
+// and the requester isn't the author.
+func (f *Filter) silencedFor(ctx context.Context, requesterID string, status *gtsmodel.Status) (bool, error) {
+	if status.Account == nil ||
+		status.AccountID == requesterID {
+		return false, nil
+	}
+
+	if status.Account.IsSilenced() {
+		return true, nil
+	}
+
+	if status.Account.IsLocal() {
+		// Local accounts can't
+		// be domain limited.
+		return false, nil
+	}
+
+	limited, err := f.state.DB.IsDomainLimited(ctx, status.Account.Domain)
+	if err != nil {
+		return false, gtserror.Newf("error checking domain limit: %w", err)
+	}
+
+	return limited, nil
+}
@@ -183,6 +183,23 @@ func (f *Filter) isStatusVisible(
 			return false, nil
 		}
 
+		if status.CircleID != "" {
+			// Status is addressed to a circle,
+			// check requester is a member of it.
+			member, err := f.state.DB.IsAccountInCircle(ctx,
+				status.CircleID,
+				requester.ID,
+			)
+			if err != nil {
+				return false, gtserror.Newf("error checking circle %s membership of %s: %w", status.CircleID, requester.ID, err)
+			}
+
+			if !member {
+				log.Trace(ctx, "circle status not visible to requester")
+				return false, nil
+			}
+		}
+
 		return true, nil
 
 	case gtsmodel.VisibilityMutualsOnly:
@@ -23,6 +23,7 @@
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -277,6 +278,70 @@ func (suite *StatusVisibleTestSuite) TestVisibleLocalOnly() {
 	}
 }
 
+func (suite *StatusVisibleTestSuite) TestVisibleCircle() {
+	ctx := context.Background()
+
+	// Put a circle owned by zork,
+	// containing only the turtle.
+	circle := &gtsmodel.Circle{
+		ID:        id.NewULID(),
+		Title:     "Close friends",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+	}
+	if err := suite.state.DB.PutCircle(ctx, circle); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	follow := suite.testFollows["local_account_2_local_account_1"]
+	if err := suite.state.DB.PutCircleMembers(ctx, []*gtsmodel.CircleMember{{
+		ID:        id.NewULID(),
+		CircleID:  circle.ID,
+		FollowID:  follow.ID,
+		AccountID: follow.AccountID,
+	}}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Copy a followers-only status
+	// from zork, addressed to the circle.
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["local_account_1_status_5"]
+	testStatus.CircleID = circle.ID
+	if err := suite.state.DB.UpdateStatus(ctx, testStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, testCase := range []struct {
+		acct    *gtsmodel.Account
+		visible bool
+	}{
+		{
+			acct:    suite.testAccounts["local_account_1"],
+			visible: true, // Own status, always visible.
+		},
+		{
+			acct:    suite.testAccounts["local_account_2"],
+			visible: true, // Follower in circle, should be visible.
+		},
+		{
+			acct:    suite.testAccounts["admin_account"],
+			visible: false, // Follower not in circle, should not be visible.
+		},
+		{
+			acct:    suite.testAccounts["remote_account_1"],
+			visible: false, // Not a follower, should not be visible.
+		},
+		{
+			acct:    nil,
+			visible: false, // No auth, should not be visible.
+		},
+	} {
+		visible, err := suite.filter.StatusVisible(ctx, testCase.acct, testStatus)
+		suite.NoError(err)
+		suite.Equal(testCase.visible, visible)
+	}
+}
+
 func TestStatusVisibleTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusVisibleTestSuite))
 }
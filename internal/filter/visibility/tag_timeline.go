@@ -44,6 +44,19 @@ func (f *Filter) StatusTagTimelineable(
 		return false, nil
 	}
 
+	// Don't show statuses from silenced accounts.
+	var requesterID string
+	if requester != nil {
+		requesterID = requester.ID
+	}
+	silenced, err := f.silencedFor(ctx, requesterID, status)
+	if err != nil {
+		return false, err
+	}
+	if silenced {
+		return false, nil
+	}
+
 	// Check whether status is visible to requesting account.
 	visible, err := f.StatusVisible(ctx, requester, status)
 	if err != nil {
@@ -44,6 +44,7 @@
 	httpSigPubKeyIDKey
 	dryRunKey
 	httpClientSignFnKey
+	unsignedFetchKey
 )
 
 // DryRun returns whether the "dryrun" context key has been set. This can be
@@ -181,6 +182,20 @@ func SetHTTPSignaturePubKeyID(ctx context.Context, pubKeyID *url.URL) context.Co
 	return context.WithValue(ctx, httpSigPubKeyIDKey, pubKeyID)
 }
 
+// UnsignedFetchPermitted returns whether the "unsignedfetch" context key has been set.
+// This indicates that the current ActivityPub GET request chain was not http-signed,
+// but that it may still be served public content according to authorized fetch mode.
+func UnsignedFetchPermitted(ctx context.Context) bool {
+	_, ok := ctx.Value(unsignedFetchKey).(struct{})
+	return ok
+}
+
+// SetUnsignedFetchPermitted sets the "unsignedfetch" context flag and returns this wrapped
+// context. See UnsignedFetchPermitted() for further information on the "unsignedfetch" flag.
+func SetUnsignedFetchPermitted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unsignedFetchKey, struct{}{})
+}
+
 // IsFastFail returns whether the "fastfail" context key has been set. This
 // can be used to indicate to an http client, for example, that the result
 // of an outgoing request is time sensitive and so not to bother with retries.
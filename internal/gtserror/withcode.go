@@ -152,6 +152,32 @@ func NewErrorConflict(original error, helpText ...string) WithCode {
 	}
 }
 
+// NewErrorTooManyRequests returns an ErrorWithCode 429 with the given original error and optional help text.
+func NewErrorTooManyRequests(original error, helpText ...string) WithCode {
+	safe := http.StatusText(http.StatusTooManyRequests)
+	if helpText != nil {
+		safe = safe + ": " + strings.Join(helpText, ": ")
+	}
+	return withCode{
+		original: original,
+		safe:     errors.New(safe),
+		code:     http.StatusTooManyRequests,
+	}
+}
+
+// NewErrorRequestEntityTooLarge returns an ErrorWithCode 413 with the given original error and optional help text.
+func NewErrorRequestEntityTooLarge(original error, helpText ...string) WithCode {
+	safe := http.StatusText(http.StatusRequestEntityTooLarge)
+	if helpText != nil {
+		safe = safe + ": " + strings.Join(helpText, ": ")
+	}
+	return withCode{
+		original: original,
+		safe:     errors.New(safe),
+		code:     http.StatusRequestEntityTooLarge,
+	}
+}
+
 // NewErrorNotAcceptable returns an ErrorWithCode 406 with the given original error and optional help text.
 func NewErrorNotAcceptable(original error, helpText ...string) WithCode {
 	safe := http.StatusText(http.StatusNotAcceptable)
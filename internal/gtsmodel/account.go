@@ -75,6 +75,9 @@ type Account struct {
 	PublicKey               *rsa.PublicKey   `bun:",notnull"`                                                    // Publickey for authorizing signed activitypub requests, will be defined for both local and remote accounts
 	PublicKeyURI            string           `bun:",nullzero,notnull,unique"`                                    // Web-reachable location of this account's public key
 	PublicKeyExpiresAt      time.Time        `bun:"type:timestamptz,nullzero"`                                   // PublicKey will expire/has expired at given time, and should be fetched again as appropriate. Only ever set for remote accounts.
+	Ed25519PrivateKey       []byte           `bun:",nullzero"`                                                   // Ed25519 private key for signing object integrity proofs, will only be defined for local accounts
+	Ed25519PublicKey        []byte           `bun:",nullzero"`                                                   // Ed25519 public key for verifying object integrity proofs. For remote accounts, cached from their last verified proof.
+	Ed25519PublicKeyURI     string           `bun:",nullzero"`                                                   // Web-reachable location (ie., assertionMethod id) of this account's Ed25519 public key
 	SensitizedAt            time.Time        `bun:"type:timestamptz,nullzero"`                                   // When was this account set to have all its media shown as sensitive?
 	SilencedAt              time.Time        `bun:"type:timestamptz,nullzero"`                                   // When was this account silenced (eg., statuses only visible to followers, not public)?
 	SuspendedAt             time.Time        `bun:"type:timestamptz,nullzero"`                                   // When was this account suspended (eg., don't allow it to log in/post, don't accept media/posts from this account)
@@ -88,6 +91,23 @@ func (a *Account) IsLocal() bool {
 	return a.Domain == "" || a.Domain == config.GetHost() || a.Domain == config.GetAccountDomain()
 }
 
+// HandleDomain returns the domain part of this account's
+// handle. For remote accounts this is simply their domain,
+// for local accounts it's either the extra account domain
+// they're hosted on, or otherwise the instance account domain.
+func (a *Account) HandleDomain() string {
+	if !a.IsLocal() {
+		return a.Domain
+	}
+
+	if a.Settings != nil && a.Settings.AccountDomain != "" &&
+		slices.Contains(config.GetExtraAccountDomains(), a.Settings.AccountDomain) {
+		return a.Settings.AccountDomain
+	}
+
+	return config.GetAccountDomain()
+}
+
 // IsRemote returns whether account is a remote user account.
 func (a *Account) IsRemote() bool {
 	return !a.IsLocal()
@@ -181,6 +201,12 @@ func (a *Account) IsSuspended() bool {
 	return !a.SuspendedAt.IsZero()
 }
 
+// IsSilenced returns true if account
+// has been silenced by an admin.
+func (a *Account) IsSilenced() bool {
+	return !a.SilencedAt.IsZero()
+}
+
 // IsMoving returns true if
 // account is Moving or has Moved.
 func (a *Account) IsMoving() bool {
@@ -217,6 +243,7 @@ type Relationship struct {
 	MutingNotifications bool   // Are you muting notifications from this user?
 	Requested           bool   // Do you have a pending follow request targeting this user?
 	RequestedBy         bool   // Does the user have a pending follow request targeting you?
+	RequestedByNote     string // Note attached to the user's pending follow request targeting you, if any.
 	DomainBlocking      bool   // Are you blocking this user's domain?
 	Endorsed            bool   // Are you featuring this user on your profile?
 	Note                string // Your note on this account.
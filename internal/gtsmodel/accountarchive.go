@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AccountArchive represents one full archive
+// export (posts, media, likes, bookmarks etc)
+// requested by a local account.
+type AccountArchive struct {
+	ID        string              `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	AccountID string              `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account this archive belongs to.
+	Account   *Account            `bun:"-"`                                                           // Account corresponding to AccountID.
+	State     AccountArchiveState `bun:",nullzero,notnull,default:0"`                                 // Current state of the archive.
+	Progress  int                 `bun:",notnull,default:0"`                                          // Progress of archive generation, in percent.
+	Path      string              `bun:",nullzero"`                                                   // Storage path of the generated archive.
+	FileSize  int64               `bun:",notnull,default:0"`                                          // Size of the generated archive in bytes.
+}
+
+// NextRequestAt returns the earliest time at
+// which the owning account may request a new
+// archive, given the configured interval.
+// Failed archives don't count towards this.
+func (a *AccountArchive) NextRequestAt(interval time.Duration) time.Time {
+	if a.State == AccountArchiveStateFailed {
+		return a.UpdatedAt
+	}
+	return a.CreatedAt.Add(interval)
+}
+
+// AccountArchiveState denotes how far
+// along generation of an archive is.
+type AccountArchiveState int16
+
+// AccountArchive states.
+const (
+	AccountArchiveStatePending    AccountArchiveState = 1 // AccountArchiveStatePending indicates the archive is queued but not yet started.
+	AccountArchiveStateProcessing AccountArchiveState = 2 // AccountArchiveStateProcessing indicates the archive is currently being generated.
+	AccountArchiveStateDone       AccountArchiveState = 3 // AccountArchiveStateDone indicates the archive is generated and ready to download.
+	AccountArchiveStateFailed     AccountArchiveState = 4 // AccountArchiveStateFailed indicates something went wrong generating the archive.
+)
+
+// String returns a stringified,
+// frontend API compatible form
+// of this AccountArchiveState.
+func (s AccountArchiveState) String() string {
+	switch s {
+	case AccountArchiveStatePending:
+		return "pending"
+	case AccountArchiveStateProcessing:
+		return "processing"
+	case AccountArchiveStateDone:
+		return "done"
+	case AccountArchiveStateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
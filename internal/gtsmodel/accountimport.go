@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AccountImport represents one CSV data import
+// (follows, blocks, mutes, bookmarks) uploaded by a
+// local account, and the results of processing it.
+type AccountImport struct {
+	ID        string                  `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt time.Time               `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt time.Time               `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	AccountID string                  `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account that uploaded this import.
+	Account   *Account                `bun:"-"`                                                           // Account corresponding to AccountID.
+	Type      string                  `bun:",nullzero,notnull"`                                           // Type of entries in the import, eg., "following", "mutes".
+	Overwrite *bool                   `bun:",nullzero,notnull,default:false"`                             // Whether existing entries not in the import are removed.
+	State     AccountImportState      `bun:",nullzero,notnull,default:0"`                                 // Current state of the import.
+	Total     int                     `bun:",notnull,default:0"`                                          // Total number of entries parsed from the uploaded file.
+	Processed int                     `bun:",notnull,default:0"`                                          // Number of entries processed so far, including failed ones.
+	Failures  []*AccountImportFailure `bun:""`                                                            // Entries that could not be imported, and why.
+}
+
+// AccountImportFailure records one
+// entry of an AccountImport that
+// could not be imported.
+type AccountImportFailure struct {
+	Entry string // The entry itself, eg., an account address or status URL.
+	Error string // Description of why the entry failed.
+}
+
+// AccountImportState denotes how
+// far along processing an import is.
+type AccountImportState int16
+
+// AccountImport states.
+const (
+	AccountImportStateProcessing AccountImportState = 1 // AccountImportStateProcessing indicates the import is queued or being processed.
+	AccountImportStateDone       AccountImportState = 2 // AccountImportStateDone indicates all entries of the import have been processed.
+	AccountImportStateFailed     AccountImportState = 3 // AccountImportStateFailed indicates the import as a whole could not be processed.
+)
+
+// String returns a stringified,
+// frontend API compatible form
+// of this AccountImportState.
+func (s AccountImportState) String() string {
+	switch s {
+	case AccountImportStateProcessing:
+		return "processing"
+	case AccountImportStateDone:
+		return "done"
+	case AccountImportStateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
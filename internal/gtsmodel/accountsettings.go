@@ -23,21 +23,36 @@
 
 // AccountSettings models settings / preferences for a local, non-instance account.
 type AccountSettings struct {
-	AccountID                      string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // AccountID that owns this settings.
-	CreatedAt                      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created.
-	UpdatedAt                      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item was last updated.
-	Privacy                        Visibility         `bun:",nullzero,default:3"`                                         // Default post privacy for this account
-	Sensitive                      *bool              `bun:",nullzero,notnull,default:false"`                             // Set posts from this account to sensitive by default?
-	Language                       string             `bun:",nullzero,notnull,default:'en'"`                              // What language does this account post in?
-	StatusContentType              string             `bun:",nullzero"`                                                   // What is the default format for statuses posted by this account (only for local accounts).
-	Theme                          string             `bun:",nullzero"`                                                   // Preset CSS theme filename selected by this Account (empty string if nothing set).
-	CustomCSS                      string             `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
-	EnableRSS                      *bool              `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
-	HideCollections                *bool              `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
-	WebVisibility                  Visibility         `bun:",nullzero,notnull,default:3"`                                 // Visibility level of statuses that visitors can view via the web profile.
-	InteractionPolicyDirect        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new direct visibility statuses by this account. If null, assume default policy.
-	InteractionPolicyMutualsOnly   *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new mutuals only visibility statuses. If null, assume default policy.
-	InteractionPolicyFollowersOnly *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new followers only visibility statuses. If null, assume default policy.
-	InteractionPolicyUnlocked      *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new unlocked visibility statuses. If null, assume default policy.
-	InteractionPolicyPublic        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new public visibility statuses. If null, assume default policy.
+	AccountID                         string                  `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // AccountID that owns this settings.
+	CreatedAt                         time.Time               `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created.
+	UpdatedAt                         time.Time               `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item was last updated.
+	Privacy                           Visibility              `bun:",nullzero,default:3"`                                         // Default post privacy for this account
+	Sensitive                         *bool                   `bun:",nullzero,notnull,default:false"`                             // Set posts from this account to sensitive by default?
+	Language                          string                  `bun:",nullzero,notnull,default:'en'"`                              // What language does this account post in?
+	StatusContentType                 string                  `bun:",nullzero"`                                                   // What is the default format for statuses posted by this account (only for local accounts).
+	Theme                             string                  `bun:",nullzero"`                                                   // Preset CSS theme filename selected by this Account (empty string if nothing set).
+	CustomCSS                         string                  `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
+	EnableRSS                         *bool                   `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
+	HideCollections                   *bool                   `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
+	WebVisibility                     Visibility              `bun:",nullzero,notnull,default:3"`                                 // Visibility level of statuses that visitors can view via the web profile.
+	InteractionPolicyDirect           *InteractionPolicy      `bun:""`                                                            // Interaction policy to use for new direct visibility statuses by this account. If null, assume default policy.
+	InteractionPolicyMutualsOnly      *InteractionPolicy      `bun:""`                                                            // Interaction policy to use for new mutuals only visibility statuses. If null, assume default policy.
+	InteractionPolicyFollowersOnly    *InteractionPolicy      `bun:""`                                                            // Interaction policy to use for new followers only visibility statuses. If null, assume default policy.
+	InteractionPolicyUnlocked         *InteractionPolicy      `bun:""`                                                            // Interaction policy to use for new unlocked visibility statuses. If null, assume default policy.
+	InteractionPolicyPublic           *InteractionPolicy      `bun:""`                                                            // Interaction policy to use for new public visibility statuses. If null, assume default policy.
+	StatusExpiryDays                  int                     `bun:",nullzero,notnull,default:0"`                                 // Delete statuses by this account once they're older than this many days. 0 = never.
+	StatusExpiryKeepPinned            *bool                   `bun:",nullzero,notnull,default:true"`                              // Exempt pinned statuses from expiry.
+	StatusExpiryKeepSelfFaved         *bool                   `bun:",nullzero,notnull,default:true"`                              // Exempt statuses faved by this account from expiry.
+	RequireMediaDescriptions          *bool                   `bun:",nullzero,notnull,default:false"`                             // Reject new statuses by this account with media attachments that have no description.
+	EmailDigestDays                   int                     `bun:",nullzero,notnull,default:0"`                                 // Send an email digest of activity to this account every this many days. 0 = never.
+	EmailDigestFollows                *bool                   `bun:",nullzero,notnull,default:true"`                              // Include new followers in email digests.
+	EmailDigestMentions               *bool                   `bun:",nullzero,notnull,default:true"`                              // Include mentions in email digests.
+	EmailDigestFollowRequests         *bool                   `bun:",nullzero,notnull,default:true"`                              // Include pending follow requests in email digests.
+	EmailDigestSentAt                 time.Time               `bun:"type:timestamptz,nullzero"`                                   // When was the last email digest sent to this account?
+	NotificationPolicyNotFollowing    NotificationPolicyValue `bun:",nullzero,notnull,default:1"`                                 // What to do with notifications from accounts this account doesn't follow.
+	NotificationPolicyNotFollowers    NotificationPolicyValue `bun:",nullzero,notnull,default:1"`                                 // What to do with notifications from accounts that don't follow this account.
+	NotificationPolicyNewAccounts     NotificationPolicyValue `bun:",nullzero,notnull,default:1"`                                 // What to do with notifications from accounts created in the past 30 days.
+	NotificationPolicyPrivateMentions NotificationPolicyValue `bun:",nullzero,notnull,default:1"`                                 // What to do with unsolicited private mentions from accounts this account doesn't follow.
+	NotificationPolicyLimitedAccounts NotificationPolicyValue `bun:",nullzero,notnull,default:1"`                                 // What to do with notifications from silenced accounts this account doesn't follow.
+	AccountDomain                     string                  `bun:",nullzero"`                                                   // Extra account domain this account is hosted on, if any, instead of the instance account domain.
 }
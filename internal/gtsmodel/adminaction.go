@@ -76,6 +76,27 @@ func ParseAdminActionCategory(in string) AdminActionCategory {
 	AdminActionExpireKeys
 )
 
+// Reverse returns the action type that reverses t,
+// eg., unsilence for silence, or unknown if none.
+func (t AdminActionType) Reverse() AdminActionType {
+	switch t {
+	case AdminActionDisable:
+		return AdminActionReenable
+	case AdminActionReenable:
+		return AdminActionDisable
+	case AdminActionSilence:
+		return AdminActionUnsilence
+	case AdminActionUnsilence:
+		return AdminActionSilence
+	case AdminActionSuspend:
+		return AdminActionUnsuspend
+	case AdminActionUnsuspend:
+		return AdminActionSuspend
+	default:
+		return AdminActionUnknown
+	}
+}
+
 func (t AdminActionType) String() string {
 	switch t {
 	case AdminActionDisable:
@@ -99,7 +120,7 @@ func (t AdminActionType) String() string {
 
 func ParseAdminActionType(in string) AdminActionType {
 	switch strings.ToLower(in) {
-	case "disable":
+	case "disable", "freeze":
 		return AdminActionDisable
 	case "reenable":
 		return AdminActionReenable
@@ -135,6 +156,23 @@ type AdminAction struct {
 	ReportIDs      []string            `bun:"reports,array"`                                               // IDs of any reports cited when creating this action.
 	Reports        []*Report           `bun:"-"`                                                           // Reports corresponding to ReportIDs.
 	Errors         []string            `bun:",array"`                                                      // String value of any error(s) encountered while processing. May be helpful for admins to debug.
+	ScheduledAt    time.Time           `bun:"type:timestamptz,nullzero"`                                   // Time at which this action is scheduled to be run, if not run immediately.
+	ExpiresAt      time.Time           `bun:"type:timestamptz,nullzero"`                                   // Time at which this action expires and is automatically lifted, if ever.
+	LiftedAt       time.Time           `bun:"type:timestamptz,nullzero"`                                   // Time at which this action was lifted, after expiry.
+}
+
+// IsPending returns true if this action
+// is scheduled but has not yet been run.
+func (a *AdminAction) IsPending() bool {
+	return !a.ScheduledAt.IsZero() &&
+		a.CompletedAt.IsZero() &&
+		time.Now().Before(a.ScheduledAt)
+}
+
+// IsLifted returns true if this
+// action expired and was lifted.
+func (a *AdminAction) IsLifted() bool {
+	return !a.LiftedAt.IsZero()
 }
 
 // Key returns a key for the AdminAction which is
@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Announcement represents an announcement made by an
+// admin to all users of this instance, eg., a notice
+// of upcoming maintenance, shown natively by clients.
+type Announcement struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	Text               string    `bun:",nullzero,notnull"`                                           // Raw Markdown text of the announcement (before parsing).
+	Content            string    `bun:",nullzero,notnull"`                                           // HTML content of the announcement, parsed from Text.
+	EmojiIDs           []string  `bun:"emojis,array"`                                                // Database IDs of any custom emojis used in the announcement.
+	Emojis             []*Emoji  `bun:"-"`                                                           // Custom emojis corresponding to EmojiIDs.
+	StartsAt           time.Time `bun:"type:timestamptz,nullzero"`                                   // Start of the event the announcement is about, if any.
+	EndsAt             time.Time `bun:"type:timestamptz,nullzero"`                                   // End of the event the announcement is about, if any. The announcement is no longer shown to users after this.
+	AllDay             *bool     `bun:",nullzero,notnull,default:false"`                             // Whether only the dates of StartsAt and EndsAt are relevant.
+	Published          *bool     `bun:",nullzero,notnull,default:false"`                             // Whether the announcement is shown to users.
+	PublishedAt        time.Time `bun:"type:timestamptz,nullzero"`                                   // Time when the announcement was first published.
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the admin who created the announcement.
+}
+
+// Visible returns true if the announcement is
+// published, and its end time (if any) hasn't passed.
+func (a *Announcement) Visible() bool {
+	if !*a.Published {
+		return false
+	}
+
+	return a.EndsAt.IsZero() || a.EndsAt.After(time.Now())
+}
+
+// AnnouncementRead represents an account
+// having dismissed (ie., read) an announcement.
+type AnnouncementRead struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                  // ID of this item in the database.
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`               // Time when the announcement was dismissed.
+	AnnouncementID string    `bun:"type:CHAR(26),unique:announcementreadannouncementaccount,nullzero,notnull"` // ID of the announcement that was dismissed.
+	AccountID      string    `bun:"type:CHAR(26),unique:announcementreadannouncementaccount,nullzero,notnull"` // ID of the account that dismissed the announcement.
+}
+
+// AnnouncementReaction represents an emoji
+// reaction to an announcement, from one account.
+type AnnouncementReaction struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                          // ID of this item in the database.
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                       // Time when this item was created.
+	AnnouncementID string    `bun:"type:CHAR(26),unique:announcementreactionannouncementaccountname,nullzero,notnull"` // ID of the announcement that has been reacted to.
+	AccountID      string    `bun:"type:CHAR(26),unique:announcementreactionannouncementaccountname,nullzero,notnull"` // ID of the account that created the reaction.
+	Name           string    `bun:",unique:announcementreactionannouncementaccountname,nullzero,notnull"`              // Unicode emoji, or shortcode of local custom emoji.
+	EmojiID        string    `bun:"type:CHAR(26),nullzero"`                                                            // ID of the custom emoji used, if any.
+	Emoji          *Emoji    `bun:"-"`                                                                                 // Custom emoji used, if any.
+}
+
+// IsCustom returns whether this
+// reaction uses a custom emoji.
+func (r *AnnouncementReaction) IsCustom() bool {
+	return r.EmojiID != ""
+}
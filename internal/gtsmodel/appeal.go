@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Appeal represents an appeal by a local user against
+// an admin action taken against their account, such
+// as a silence or disable, to be reviewed by admins.
+type Appeal struct {
+	ID                  string       `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt           time.Time    `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt           time.Time    `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	AccountID           string       `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account that made the appeal.
+	Account             *Account     `bun:"-"`                                                           // Account corresponding to AccountID.
+	AdminActionID       string       `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // ID of the admin action being appealed.
+	AdminAction         *AdminAction `bun:"-"`                                                           // Admin action corresponding to AdminActionID.
+	Text                string       `bun:",nullzero,notnull"`                                           // Text of the appeal, by the appealing user.
+	State               AppealState  `bun:",nullzero,notnull,default:1"`                                 // State of the appeal.
+	ReviewedAt          time.Time    `bun:"type:timestamptz,nullzero"`                                   // Time at which the appeal was approved or rejected, if at all.
+	ReviewedByAccountID string       `bun:"type:CHAR(26),nullzero"`                                      // ID of the admin account that approved or rejected the appeal, if any.
+	ReviewedByAccount   *Account     `bun:"-"`                                                           // Account corresponding to ReviewedByAccountID.
+	ReviewComment       string       `bun:",nullzero"`                                                   // Comment by the reviewing admin, shown to the appealing user.
+}
+
+// IsPending returns true if
+// appeal is not yet reviewed.
+func (a *Appeal) IsPending() bool {
+	return a.State == AppealPending
+}
+
+// AppealState describes the
+// review state of an appeal.
+type AppealState enumType
+
+const (
+	AppealPending  AppealState = 1 // AppealPending -- not yet approved or rejected by an admin.
+	AppealApproved AppealState = 2 // AppealApproved -- approved by an admin, and the appealed action reversed.
+	AppealRejected AppealState = 3 // AppealRejected -- rejected by an admin, the appealed action stands.
+)
+
+// String returns a stringified, frontend API compatible form of AppealState.
+func (s AppealState) String() string {
+	switch s {
+	case AppealPending:
+		return "pending"
+	case AppealApproved:
+		return "approved"
+	case AppealRejected:
+		return "rejected"
+	default:
+		panic("invalid appeal state")
+	}
+}
+
+// ParseAppealState returns the AppealState corresponding
+// to the given string, or 0 if not recognized.
+func ParseAppealState(in string) AppealState {
+	switch in {
+	case "pending":
+		return AppealPending
+	case "approved":
+		return AppealApproved
+	case "rejected":
+		return AppealRejected
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,87 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AuditLogEntry records one moderation or admin action
+// taken by an admin of this instance, so that the other
+// admins and moderators can review it later on.
+type AuditLogEntry struct {
+	ID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created, ie., when was the action taken
+	AccountID  string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that took the action
+	Account    *Account  `bun:"-"`                                                           // admin account corresponding to AccountID
+	Action     string    `bun:",nullzero,notnull"`                                           // what kind of action was taken, eg., "create" or "suspend"
+	TargetType string    `bun:",nullzero,notnull"`                                           // what kind of thing the action was taken on, eg., "domain_block"
+	TargetID   string    `bun:",nullzero"`                                                   // id of the target of the action, if any, eg., a ULID or a domain name
+	Text       string    `bun:",nullzero"`                                                   // reason or comment given for the action, if any
+}
+
+// Audit log actions. Actions taken against
+// accounts and domains are also recorded using
+// the string value of their AdminActionType.
+const (
+	AuditActionCreate        = "create"
+	AuditActionUpdate        = "update"
+	AuditActionDelete        = "delete"
+	AuditActionImport        = "import"
+	AuditActionApprove       = "approve"
+	AuditActionReject        = "reject"
+	AuditActionResolve       = "resolve"
+	AuditActionExpire        = "expire"
+	AuditActionRedrive       = "redrive"
+	AuditActionRefetch       = "refetch"
+	AuditActionPrune         = "prune"
+	AuditActionMove          = "move"
+	AuditActionFalsePositive = "false_positive"
+	AuditActionAssignRole    = "assign_role"
+)
+
+// Audit log target types.
+const (
+	AuditTargetAccount                 = "account"
+	AuditTargetAnnouncement            = "announcement"
+	AuditTargetAppeal                  = "appeal"
+	AuditTargetAutomodRule             = "automod_rule"
+	AuditTargetCanonicalEmailBlock     = "canonical_email_block"
+	AuditTargetDeadLetter              = "dead_letter"
+	AuditTargetDomain                  = "domain"
+	AuditTargetDomainAllow             = "domain_allow"
+	AuditTargetDomainBlock             = "domain_block"
+	AuditTargetDomainPermissionDraft   = "domain_permission_draft"
+	AuditTargetDomainPermissionExclude = "domain_permission_exclude"
+	AuditTargetEmoji                   = "emoji"
+	AuditTargetEmojiCategory           = "emoji_category"
+	AuditTargetHeaderAllow             = "header_allow"
+	AuditTargetHeaderBlock             = "header_block"
+	AuditTargetInstance                = "instance"
+	AuditTargetInvite                  = "invite"
+	AuditTargetIPBlock                 = "ip_block"
+	AuditTargetMedia                   = "media"
+	AuditTargetMediaRetentionPolicy    = "media_retention_policy"
+	AuditTargetRelay                   = "relay"
+	AuditTargetReport                  = "report"
+	AuditTargetRole                    = "role"
+	AuditTargetRule                    = "rule"
+	AuditTargetSignupQuestion          = "signup_question"
+	AuditTargetSpamFlag                = "spam_flag"
+	AuditTargetTermsOfService          = "terms_of_service"
+	AuditTargetTrend                   = "trend"
+	AuditTargetWebhook                 = "webhook"
+)
@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AutomodRule represents an admin-configured rule that's
+// checked against incoming federated statuses, with an
+// action to take automatically on statuses that match it.
+type AutomodRule struct {
+	ID        string            `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt time.Time         `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
+	UpdatedAt time.Time         `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Last-updated time of this item.
+	MatchType AutomodMatchType  `bun:",nullzero,notnull"`                                           // How Pattern is matched against statuses.
+	Pattern   string            `bun:",nullzero,notnull"`                                           // Keyword, regular expression, or link domain to match.
+	Action    AutomodRuleAction `bun:",nullzero,notnull"`                                           // Action to take on statuses that match.
+	Comment   string            `bun:",nullzero"`                                                   // Optional admin comment on why this rule exists.
+	AuthorID  string            `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this rule.
+	Author    *Account          `bun:"-"`                                                           // Account corresponding to AuthorID.
+}
+
+// AutomodMatchType describes how the
+// pattern of an automod rule is matched.
+type AutomodMatchType enumType
+
+const (
+	AutomodMatchUnknown    AutomodMatchType = 0
+	AutomodMatchKeyword    AutomodMatchType = 1 // Case-insensitive whole word or phrase in the status text.
+	AutomodMatchRegex      AutomodMatchType = 2 // Regular expression matching the status text.
+	AutomodMatchLinkDomain AutomodMatchType = 3 // Link in the status to the domain, or a subdomain of it.
+)
+
+// String returns a stringified, frontend API compatible form of AutomodMatchType.
+func (t AutomodMatchType) String() string {
+	switch t {
+	case AutomodMatchKeyword:
+		return "keyword"
+	case AutomodMatchRegex:
+		return "regex"
+	case AutomodMatchLinkDomain:
+		return "link_domain"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAutomodMatchType returns an automod match type from the given value.
+func ParseAutomodMatchType(in string) AutomodMatchType {
+	switch in {
+	case "keyword":
+		return AutomodMatchKeyword
+	case "regex":
+		return AutomodMatchRegex
+	case "link_domain":
+		return AutomodMatchLinkDomain
+	default:
+		return AutomodMatchUnknown
+	}
+}
+
+// AutomodRuleAction describes the action taken
+// on statuses that match an automod rule. Actions
+// are ordered by severity, so when a status matches
+// multiple rules, the highest value action wins.
+type AutomodRuleAction enumType
+
+const (
+	AutomodActionUnknown   AutomodRuleAction = 0
+	AutomodActionSensitive AutomodRuleAction = 1 // Mark the status as sensitive.
+	AutomodActionSinBin    AutomodRuleAction = 2 // Remove the status, keeping a copy in the sin bin for review.
+	AutomodActionDrop      AutomodRuleAction = 3 // Remove the status entirely.
+)
+
+// String returns a stringified, frontend API compatible form of AutomodRuleAction.
+func (a AutomodRuleAction) String() string {
+	switch a {
+	case AutomodActionSensitive:
+		return "sensitive"
+	case AutomodActionSinBin:
+		return "sin_bin"
+	case AutomodActionDrop:
+		return "drop"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAutomodRuleAction returns an automod rule action from the given value.
+func ParseAutomodRuleAction(in string) AutomodRuleAction {
+	switch in {
+	case "sensitive":
+		return AutomodActionSensitive
+	case "sin_bin":
+		return AutomodActionSinBin
+	case "drop":
+		return AutomodActionDrop
+	default:
+		return AutomodActionUnknown
+	}
+}
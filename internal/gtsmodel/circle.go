@@ -0,0 +1,43 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Circle refers to a subset of followers that the
+// owning account can address posts to directly.
+type Circle struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Title     string    `bun:",nullzero,notnull,unique:circleaccounttitle"`                 // Title of this circle.
+	AccountID string    `bun:"type:CHAR(26),notnull,nullzero,unique:circleaccounttitle"`    // Account that created/owns the circle
+	Account   *Account  `bun:"-"`                                                           // Account corresponding to accountID
+}
+
+// CircleMember refers to a single follower in a circle.
+type CircleMember struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                       // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`    // when was item created
+	CircleID  string    `bun:"type:CHAR(26),notnull,nullzero,unique:circlemembercirclefollow"` // ID of the circle that this member belongs to.
+	Circle    *Circle   `bun:"-"`                                                              // Circle corresponding to circleID.
+	FollowID  string    `bun:"type:CHAR(26),notnull,nullzero,unique:circlemembercirclefollow"` // Follow of the circle owner by this member.
+	Follow    *Follow   `bun:"-"`                                                              // Follow corresponding to followID.
+	AccountID string    `bun:"type:CHAR(26),notnull,nullzero"`                                 // ID of the member account (ie., origin of the follow).
+	Account   *Account  `bun:"-"`                                                              // Account corresponding to accountID.
+}
@@ -58,6 +58,14 @@ type Conversation struct {
 
 	// Has the owner read all statuses in this conversation?
 	Read *bool `bun:",default:false"`
+
+	// ID of the most recent status in this conversation that the owner has read.
+	// Empty if the owner hasn't read any statuses in the conversation yet.
+	LastReadStatusID string `bun:"type:CHAR(26),nullzero"`
+
+	// Has the owner muted this conversation?
+	// New statuses in a muted conversation don't mark it as unread or generate notifications.
+	Muted *bool `bun:",default:false"`
 }
 
 // ConversationOtherAccountsKey creates an OtherAccountsKey from a list of OtherAccountIDs.
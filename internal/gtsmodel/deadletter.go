@@ -0,0 +1,37 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DeadLetter represents an outgoing ActivityPub delivery
+// that failed permanently, ie., it reached the maximum
+// number of retries, encountered a non-retryable error,
+// or its recipient's domain was marked unreachable. It
+// is kept so that admins can inspect and re-drive it.
+type DeadLetter struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	Domain    string    `bun:",nullzero,notnull"`                                           // Domain of the inbox to which delivery failed.
+	InboxURI  string    `bun:",nullzero,notnull"`                                           // URI of the inbox to which delivery failed.
+	ActorID   string    `bun:",nullzero"`                                                   // ActivityPub ID of the actor of the activity that failed delivery, if any.
+	ObjectID  string    `bun:",nullzero"`                                                   // ActivityPub ID of the object of the activity that failed delivery, if any.
+	TargetID  string    `bun:",nullzero"`                                                   // ActivityPub ID of the target of the activity that failed delivery, if any.
+	Error     string    `bun:",nullzero"`                                                   // Error message of the last delivery attempt.
+	Data      []byte    `bun:",nullzero,notnull"`                                           // Serialized delivery, from which it can be re-driven.
+}
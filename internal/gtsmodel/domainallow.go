@@ -21,17 +21,29 @@
 
 // DomainAllow represents a federation allow towards a particular domain.
 type DomainAllow struct {
-	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Domain             string    `bun:",nullzero,notnull"`                                           // domain to allow. Eg. 'whatever.com'
-	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this allow
-	CreatedByAccount   *Account  `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
-	PrivateComment     string    `bun:""`                                                            // Private comment on this allow, viewable to admins
-	PublicComment      string    `bun:""`                                                            // Public comment on this allow, viewable (optionally) by everyone
-	Obfuscate          *bool     `bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
-	SubscriptionID     string    `bun:"type:CHAR(26),nullzero"`                                      // if this allow was created through a subscription, what's the subscription ID?
-}
+	ID                   string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt            time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt            time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain               string    `bun:",nullzero,notnull"`                                           // domain to allow. Eg. 'whatever.com'
+	CreatedByAccountID   string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this allow
+	CreatedByAccount     *Account  `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	PrivateComment       string    `bun:""`                                                            // Private comment on this allow, viewable to admins
+	PublicComment        string    `bun:""`                                                            // Public comment on this allow, viewable (optionally) by everyone
+	Obfuscate            *bool     `bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
+	SubscriptionID       string    `bun:"type:CHAR(26),nullzero"`                                      // if this allow was created through a subscription, what's the subscription ID?
+	AuthorizedFetchMode  string    `bun:",nullzero"`                                                   // overrides instance-authorized-fetch-mode for requests from this domain, if set
+	DeliveryRateLimit    int       `bun:",nullzero"`                                                   // overrides delivery-rate-limit for this domain + subdomains, if set; negative means no limit
+	DereferenceRateLimit int       `bun:",nullzero"`                                                   // overrides delivery-dereference-rate-limit for this domain + subdomains, if set; negative means no limit
+	MediaPolicy          string    `bun:",nullzero"`                                                   // policy to apply to media from this domain + subdomains, if set
+	Limited              *bool     `bun:",nullzero,notnull,default:false"`                             // whether this domain + subdomains are limited, ie., federated with but kept off public timelines
+}
+
+// Policies that can be applied to
+// media from a domain on its allow.
+const (
+	DomainMediaPolicyReject    = "reject"    // don't fetch media from domain at all
+	DomainMediaPolicySensitive = "sensitive" // mark statuses with media from domain as sensitive
+)
 
 func (d *DomainAllow) GetID() string {
 	return d.ID
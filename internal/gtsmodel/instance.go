@@ -44,4 +44,74 @@ type Instance struct {
 	Reputation             int64        `bun:",notnull,default:0"`                                          // Reputation score of this instance
 	Version                string       `bun:",nullzero"`                                                   // Version of the software used on this instance
 	Rules                  []Rule       `bun:"-"`                                                           // List of instance rules
+
+	// Default interaction policies used to seed the account
+	// settings of new local accounts. Only set for the local
+	// instance. If null, new accounts get the global default.
+
+	InteractionPolicyDirect        *InteractionPolicy `bun:""` // Default interaction policy for new direct visibility statuses.
+	InteractionPolicyFollowersOnly *InteractionPolicy `bun:""` // Default interaction policy for new followers only visibility statuses.
+	InteractionPolicyUnlocked      *InteractionPolicy `bun:""` // Default interaction policy for new unlocked visibility statuses.
+	InteractionPolicyPublic        *InteractionPolicy `bun:""` // Default interaction policy for new public visibility statuses.
+
+	// User-Agent patterns used to recognize AI scrapers,
+	// for robots.txt and request blocking. Only set for
+	// the local instance. If empty, the defaults are used.
+
+	AIScraperUserAgents []string `bun:"ai_scraper_user_agents,array"`
+}
+
+// AIScrapers returns the User-Agent patterns used to recognize
+// AI scrapers on this instance, falling back to the defaults.
+func (i *Instance) AIScrapers() []string {
+	if len(i.AIScraperUserAgents) == 0 {
+		return DefaultAIScraperUserAgents()
+	}
+	return i.AIScraperUserAgents
+}
+
+// DefaultAIScraperUserAgents returns the default list of
+// User-Agent patterns used to recognize AI scrapers.
+//
+// See: https://github.com/ai-robots-txt/ai.robots.txt/
+func DefaultAIScraperUserAgents() []string {
+	return []string{
+		"AI2Bot",
+		"Ai2Bot-Dolma",
+		"AdsBot-Google",
+		"Amazonbot",
+		"anthropic-ai",
+		"Applebot-Extended",
+		"Bytespider",
+		"CCBot",
+		"ChatGPT-User",
+		"ClaudeBot",
+		"Claude-Web",
+		"cohere-ai",
+		"Diffbot",
+		"FacebookBot",
+		"facebookexternalhit",
+		"FriendlyCrawler",
+		"Google-Extended",
+		"GoogleOther",
+		"GoogleOther-Image",
+		"GoogleOther-Video",
+		"GPTBot",
+		"iaskspider/2.0",
+		"ICC-Crawler",
+		"ImagesiftBot",
+		"img2dataset",
+		"Meta-ExternalAgent",
+		"Meta-ExternalFetcher",
+		"OAI-SearchBot",
+		"omgili",
+		"omgilibot",
+		"PerplexityBot",
+		"PetalBot",
+		"Scrapy",
+		"Timpibot",
+		"VelenPublicWebCrawler",
+		"Webzio-Extended",
+		"YouBot",
+	}
 }
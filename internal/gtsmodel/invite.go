@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Invite represents an invite link created by a
+// local account, which allows whoever holds the
+// link to sign up to the instance without needing
+// their sign-up to be approved by an admin, even
+// when registration is otherwise closed.
+type Invite struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Last-updated time of this item.
+	Code               string    `bun:",nullzero,notnull,unique"`                                    // Random code used in the invite link.
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this invite.
+	CreatedByAccount   *Account  `bun:"-"`                                                           // Account corresponding to CreatedByAccountID.
+	MaxUses            int       `bun:",notnull,default:0"`                                          // Maximum number of sign-ups allowed with this invite, 0 for unlimited.
+	Uses               int       `bun:",notnull,default:0"`                                          // Number of sign-ups made with this invite so far.
+	ExpiresAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // Time at which this invite expires, zero for never.
+}
+
+// Expired returns whether the invite has expired at a given time.
+// Invites without an expiration timestamp never expire.
+func (i *Invite) Expired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !i.ExpiresAt.After(now)
+}
+
+// Usable returns whether the invite can still
+// be used to sign up at the given time, ie., it
+// hasn't expired, and hasn't run out of uses.
+func (i *Invite) Usable(now time.Time) bool {
+	if i.Expired(now) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// IPBlock represents an admin-configured block
+// on a range of IP addresses, in CIDR notation.
+type IPBlock struct {
+	ID                 string          `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt          time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
+	UpdatedAt          time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Last-updated time of this item.
+	IP                 string          `bun:",nullzero,notnull"`                                           // Blocked IP range in normalized CIDR notation, eg., 192.0.2.0/24.
+	Severity           IPBlockSeverity `bun:",nullzero,notnull"`                                           // What is blocked for addresses in the range.
+	Comment            string          `bun:",nullzero"`                                                   // Optional admin comment on why this block exists.
+	ExpiresAt          time.Time       `bun:"type:timestamptz,nullzero"`                                   // Time at which this block expires, zero for never.
+	CreatedByAccountID string          `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this block.
+}
+
+// Expired returns whether the block has expired at a given time.
+// Blocks without an expiration timestamp never expire.
+func (b *IPBlock) Expired(now time.Time) bool {
+	return !b.ExpiresAt.IsZero() && !b.ExpiresAt.After(now)
+}
+
+// IPBlockSeverity describes what is blocked for
+// addresses in the range of an IP block. Severities
+// are ordered, so when an address matches multiple
+// blocks, the highest value severity wins.
+type IPBlockSeverity enumType
+
+const (
+	IPBlockSeverityUnknown     IPBlockSeverity = 0
+	IPBlockSeveritySignUpBlock IPBlockSeverity = 1 // Reject sign-ups from the range.
+	IPBlockSeverityNoAccess    IPBlockSeverity = 2 // Reject all requests from the range.
+)
+
+// String returns a stringified, frontend API compatible form of IPBlockSeverity.
+func (s IPBlockSeverity) String() string {
+	switch s {
+	case IPBlockSeveritySignUpBlock:
+		return "sign_up_block"
+	case IPBlockSeverityNoAccess:
+		return "no_access"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseIPBlockSeverity returns an IP block severity from the given value.
+func ParseIPBlockSeverity(in string) IPBlockSeverity {
+	switch in {
+	case "sign_up_block":
+		return IPBlockSeveritySignUpBlock
+	case "no_access":
+		return IPBlockSeverityNoAccess
+	default:
+		return IPBlockSeverityUnknown
+	}
+}
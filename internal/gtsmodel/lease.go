@@ -0,0 +1,29 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Lease represents a named lease held by one GoToSocial
+// node in a cluster until it expires, used to ensure that
+// scheduled jobs only run on one node of the cluster at once.
+type Lease struct {
+	Name      string    `bun:",pk,nullzero,notnull,unique"`       // Name of the lease, eg., a scheduled job ID.
+	Holder    string    `bun:",nullzero,notnull"`                 // Node ID of the current holder of the lease.
+	ExpiresAt time.Time `bun:"type:timestamptz,nullzero,notnull"` // Time at which the lease expires, and may be acquired by another node.
+}
@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// MeasureActivity is a single occurrence of something
+// counted for admin measures, dimensions and retention,
+// eg., one login, one status, or one new account. Not
+// stored in the database, just scanned from aggregate
+// queries. Fields not relevant to the activity are empty.
+type MeasureActivity struct {
+	AccountID string    // ID of the account the activity relates to
+	Domain    string    // domain of the account the activity relates to, empty for local
+	Language  string    // language of the status the activity relates to
+	Value     int64     // value to sum when counting, eg., file size in bytes
+	CreatedAt time.Time // when the activity happened
+}
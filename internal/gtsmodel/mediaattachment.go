@@ -68,6 +68,12 @@ type Thumbnail struct {
 	FileSize    int    `bun:",notnull"`  // File size in bytes
 	URL         string `bun:",nullzero"` // What is the URL of the thumbnail on the local server
 	RemoteURL   string `bun:",nullzero"` // What is the remote URL of the thumbnail (empty for local media)
+
+	// Path and size of a JPEG fallback of the thumbnail
+	// in storage, if it was generated in a format that not
+	// all clients support (e.g. AVIF). Empty if none.
+	FallbackPath     string `bun:",nullzero"`
+	FallbackFileSize int    `bun:",nullzero"`
 }
 
 // ProcessingStatus refers to how far along in the processing stage the attachment is.
@@ -117,6 +123,7 @@ type FileMeta struct {
 	Original Original `bun:"embed:original_"`
 	Small    Small    `bun:"embed:small_"`
 	Focus    Focus    `bun:"embed:focus_"`
+	Waveform []byte   `bun:",nullzero"` // audio-specific: downsampled waveform peaks, loudest scaled to 255
 }
 
 // Small can be used for a thumbnail of any media type
@@ -35,6 +35,8 @@ type Notification struct {
 	StatusID         string           `bun:"type:CHAR(26),nullzero"`                                      // If the notification pertains to a status, what is the database ID of that status?
 	Status           *Status          `bun:"-"`                                                           // Status corresponding to StatusID. Can be nil, always check first + select using ID if necessary.
 	Read             *bool            `bun:",nullzero,notnull,default:false"`                             // Notification has been seen/read
+	SeveranceEventID string           `bun:"type:CHAR(26),nullzero"`                                      // If the notification pertains to severed relationships, what is the database ID of the severance event?
+	Filtered         *bool            `bun:",nullzero,notnull,default:false"`                             // Notification was held back by the target account's notification policy, pending a notification request.
 }
 
 // NotificationType describes the
@@ -55,6 +57,9 @@ type Notification struct {
 	NotificationPendingFave   NotificationType = 9  // Someone has faved a status of yours, which requires approval by you.
 	NotificationPendingReply  NotificationType = 10 // Someone has replied to a status of yours, which requires approval by you.
 	NotificationPendingReblog NotificationType = 11 // Someone has boosted a status of yours, which requires approval by you.
+	NotificationAdminAppeal   NotificationType = 12 // Someone has appealed an admin action taken against their account.
+	NotificationSevered       NotificationType = 13 // Some of your follow relationships were severed by a moderation action.
+	NotificationEmojiReaction NotificationType = 14 // Someone has reacted to a status of yours with an emoji.
 )
 
 // String returns a stringified, frontend API compatible form of NotificationType.
@@ -82,6 +87,12 @@ func (t NotificationType) String() string {
 		return "pending.reply"
 	case NotificationPendingReblog:
 		return "pending.reblog"
+	case NotificationAdminAppeal:
+		return "admin.appeal"
+	case NotificationSevered:
+		return "severed_relationships"
+	case NotificationEmojiReaction:
+		return "pleroma:emoji_reaction"
 	default:
 		panic("invalid notification type")
 	}
@@ -112,6 +123,12 @@ func ParseNotificationType(in string) NotificationType {
 		return NotificationPendingReply
 	case "pending.reblog":
 		return NotificationPendingReblog
+	case "admin.appeal":
+		return NotificationAdminAppeal
+	case "severed_relationships":
+		return NotificationSevered
+	case "pleroma:emoji_reaction":
+		return NotificationEmojiReaction
 	default:
 		return NotificationUnknown
 	}
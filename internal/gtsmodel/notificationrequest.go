@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// NotificationRequest models a bundle of filtered notifications
+// from one origin account, held back from the target account's
+// notifications timeline until the target accepts or dismisses it.
+type NotificationRequest struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                        // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                     // when was item created
+	UpdatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                     // when was item last updated
+	AccountID       string    `bun:"type:CHAR(26),nullzero,notnull,unique:notification_requests_account_origin_uniq"` // ID of the local account whose notifications were filtered.
+	Account         *Account  `bun:"-"`                                                                               // Account corresponding to AccountID.
+	OriginAccountID string    `bun:"type:CHAR(26),nullzero,notnull,unique:notification_requests_account_origin_uniq"` // ID of the account that caused the filtered notifications.
+	OriginAccount   *Account  `bun:"-"`                                                                               // Account corresponding to OriginAccountID.
+	LastStatusID    string    `bun:"type:CHAR(26),nullzero"`                                                          // ID of the most recent status attached to a filtered notification, if any.
+	LastStatus      *Status   `bun:"-"`                                                                               // Status corresponding to LastStatusID.
+	AcceptedAt      time.Time `bun:"type:timestamptz,nullzero"`                                                       // When was this request accepted by the account? Zero if still pending.
+}
+
+// IsAccepted returns true if the account has
+// accepted notifications from the origin account.
+func (r *NotificationRequest) IsAccepted() bool {
+	return !r.AcceptedAt.IsZero()
+}
+
+// NotificationPolicyValue describes what to do with a notification
+// that matches one of the criteria of an account's notification policy.
+type NotificationPolicyValue enumType
+
+const (
+	NotificationPolicyUnknown NotificationPolicyValue = 0
+	NotificationPolicyAccept  NotificationPolicyValue = 1 // Show notification as normal.
+	NotificationPolicyFilter  NotificationPolicyValue = 2 // Hold notification in a notification request.
+	NotificationPolicyDrop    NotificationPolicyValue = 3 // Don't create notification at all.
+)
+
+// String returns a stringified, frontend API compatible form of NotificationPolicyValue.
+func (v NotificationPolicyValue) String() string {
+	switch v {
+	case NotificationPolicyAccept:
+		return "accept"
+	case NotificationPolicyFilter:
+		return "filter"
+	case NotificationPolicyDrop:
+		return "drop"
+	default:
+		panic("invalid notification policy value")
+	}
+}
+
+// ParseNotificationPolicyValue returns a notification policy value from the given value.
+func ParseNotificationPolicyValue(in string) NotificationPolicyValue {
+	switch in {
+	case "accept":
+		return NotificationPolicyAccept
+	case "filter":
+		return NotificationPolicyFilter
+	case "drop":
+		return NotificationPolicyDrop
+	default:
+		return NotificationPolicyUnknown
+	}
+}
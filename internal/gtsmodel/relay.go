@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Relay represents a subscription of this instance
+// to an ActivityPub relay. The instance account sends
+// a Follow of the relay actor, and once that Follow
+// is accepted, public posts Announced by the relay are
+// accepted into the federated timeline, and local public
+// posts are delivered to the relay's inbox.
+type Relay struct {
+	ID                 string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt          time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt          time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	ActorURI           string     `bun:",nullzero,notnull,unique"`                                    // URI of the relay actor, eg., 'https://relay.example.org/actor'.
+	InboxURI           string     `bun:",nullzero,notnull"`                                           // Inbox URI of the relay actor, to which Follows and local posts are delivered.
+	FollowURI          string     `bun:",nullzero,notnull,unique"`                                    // URI of the Follow sent by the instance account to the relay.
+	State              RelayState `bun:",nullzero,notnull,default:1"`                                 // State of the subscription.
+	Enabled            *bool      `bun:",nullzero,notnull,default:true"`                              // Whether content is currently accepted from and delivered to this relay.
+	CreatedByAccountID string     `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the admin who subscribed to this relay.
+}
+
+// Active returns true if the relay
+// has accepted our subscription, and
+// the relay is enabled by an admin.
+func (r *Relay) Active() bool {
+	return r.State == RelayStateAccepted &&
+		r.Enabled != nil && *r.Enabled
+}
+
+// RelayState describes the state
+// of a subscription to a relay.
+type RelayState enumType
+
+const (
+	RelayStatePending  RelayState = 1 // RelayStatePending -- Follow sent, no response from relay yet.
+	RelayStateAccepted RelayState = 2 // RelayStateAccepted -- relay Accepted our Follow.
+	RelayStateRejected RelayState = 3 // RelayStateRejected -- relay Rejected our Follow.
+)
+
+// String returns a stringified, frontend API compatible form of RelayState.
+func (s RelayState) String() string {
+	switch s {
+	case RelayStatePending:
+		return "pending"
+	case RelayStateAccepted:
+		return "accepted"
+	case RelayStateRejected:
+		return "rejected"
+	default:
+		panic("invalid relay state")
+	}
+}
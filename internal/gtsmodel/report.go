@@ -17,7 +17,10 @@
 
 package gtsmodel
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Report models a user-created reported about an account, which should be reviewed
 // and acted upon by instance admins.
@@ -26,22 +29,64 @@
 // or another instance, OR a report that was created remotely (on another instance)
 // about a user on this instance, and received via the federated (s2s) API.
 type Report struct {
-	ID                     string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	URI                    string    `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this report
-	AccountID              string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this report
-	Account                *Account  `bun:"-"`                                                           // account corresponding to AccountID
-	TargetAccountID        string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account is targeted by this report
-	TargetAccount          *Account  `bun:"-"`                                                           // account corresponding to TargetAccountID
-	Comment                string    `bun:",nullzero"`                                                   // comment / explanation for this report, by the reporter
-	StatusIDs              []string  `bun:"statuses,array"`                                              // database IDs of any statuses referenced by this report
-	Statuses               []*Status `bun:"-"`                                                           // statuses corresponding to StatusIDs
-	RuleIDs                []string  `bun:"rules,array"`                                                 // database IDs of any rules referenced by this report
-	Rules                  []*Rule   `bun:"-"`                                                           // rules corresponding to RuleIDs
-	Forwarded              *bool     `bun:",nullzero,notnull,default:false"`                             // flag to indicate report should be forwarded to remote instance
-	ActionTaken            string    `bun:",nullzero"`                                                   // string description of what action was taken in response to this report
-	ActionTakenAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // time at which action was taken, if any
-	ActionTakenByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // database ID of account which took action, if any
-	ActionTakenByAccount   *Account  `bun:"-"`                                                           // account corresponding to ActionTakenByID, if any
+	ID                     string         `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt              time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt              time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	URI                    string         `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this report
+	AccountID              string         `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this report
+	Account                *Account       `bun:"-"`                                                           // account corresponding to AccountID
+	TargetAccountID        string         `bun:"type:CHAR(26),nullzero,notnull"`                              // which account is targeted by this report
+	TargetAccount          *Account       `bun:"-"`                                                           // account corresponding to TargetAccountID
+	Comment                string         `bun:",nullzero"`                                                   // comment / explanation for this report, by the reporter
+	Category               ReportCategory `bun:",nullzero,notnull,default:1"`                                 // category of this report, eg., spam
+	StatusIDs              []string       `bun:"statuses,array"`                                              // database IDs of any statuses referenced by this report
+	Statuses               []*Status      `bun:"-"`                                                           // statuses corresponding to StatusIDs
+	RuleIDs                []string       `bun:"rules,array"`                                                 // database IDs of any rules referenced by this report
+	Rules                  []*Rule        `bun:"-"`                                                           // rules corresponding to RuleIDs
+	Forwarded              *bool          `bun:",nullzero,notnull,default:false"`                             // flag to indicate report should be forwarded to remote instance
+	ActionTaken            string         `bun:",nullzero"`                                                   // string description of what action was taken in response to this report
+	ActionTakenAt          time.Time      `bun:"type:timestamptz,nullzero"`                                   // time at which action was taken, if any
+	ActionTakenByAccountID string         `bun:"type:CHAR(26),nullzero"`                                      // database ID of account which took action, if any
+	ActionTakenByAccount   *Account       `bun:"-"`                                                           // account corresponding to ActionTakenByID, if any
+}
+
+// ReportCategory describes the
+// reason for which a report was made.
+type ReportCategory enumType
+
+const (
+	ReportCategoryUnknown   ReportCategory = 0 // ReportCategoryUnknown -- unrecognized category.
+	ReportCategoryOther     ReportCategory = 1 // ReportCategoryOther -- some other reason, see the comment.
+	ReportCategorySpam      ReportCategory = 2 // ReportCategorySpam -- unwanted or repetitive content.
+	ReportCategoryViolation ReportCategory = 3 // ReportCategoryViolation -- violates one or more instance rules.
+)
+
+// String returns a stringified, frontend API compatible form of ReportCategory.
+func (c ReportCategory) String() string {
+	switch c {
+	case ReportCategoryOther:
+		return "other"
+	case ReportCategorySpam:
+		return "spam"
+	case ReportCategoryViolation:
+		return "violation"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseReportCategory returns the ReportCategory
+// corresponding to the given string, or
+// ReportCategoryUnknown if not recognized.
+func ParseReportCategory(in string) ReportCategory {
+	switch strings.ToLower(in) {
+	case "other":
+		return ReportCategoryOther
+	case "spam":
+		return ReportCategorySpam
+	case "violation":
+		return ReportCategoryViolation
+	default:
+		return ReportCategoryUnknown
+	}
 }
@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"strings"
+	"time"
+)
+
+// Role models a custom role defined by the admins of this
+// instance, which can be given to users to grant them
+// permission to take some moderation / admin actions,
+// without making them a full admin.
+type Role struct {
+	ID          string          `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt   time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt   time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Name        string          `bun:",nullzero,notnull,unique"`                                    // name of this role, eg., "Report Wrangler"
+	Permissions RolePermissions `bun:",notnull,default:0"`                                          // permissions granted to users with this role
+	Highlighted *bool           `bun:",nullzero,notnull,default:false"`                             // show this role publicly on the profiles of users with it
+}
+
+// RolePermissions is a bitmap of
+// permissions granted by a Role.
+type RolePermissions int
+
+const (
+	RolePermissionViewAuditLog     RolePermissions = 1 << iota // RolePermissionViewAuditLog -- view the admin action audit log.
+	RolePermissionManageReports                                // RolePermissionManageReports -- view and resolve reports, appeals and spam flags.
+	RolePermissionManageFederation                             // RolePermissionManageFederation -- manage domain permissions, header filters, relays and dead letters.
+	RolePermissionManageUsers                                  // RolePermissionManageUsers -- manage accounts, sign-ups, IP blocks and canonical email blocks.
+
+	// RolePermissionsNone represents an empty set of permissions.
+	RolePermissionsNone RolePermissions = 0
+
+	// RolePermissionsAll includes every permission a Role may grant.
+	RolePermissionsAll = RolePermissionViewAuditLog |
+		RolePermissionManageReports |
+		RolePermissionManageFederation |
+		RolePermissionManageUsers
+)
+
+// rolePermissionNames maps each
+// permission to its API name.
+var rolePermissionNames = []struct {
+	perm RolePermissions
+	name string
+}{
+	{RolePermissionViewAuditLog, "view_audit_log"},
+	{RolePermissionManageReports, "manage_reports"},
+	{RolePermissionManageFederation, "manage_federation"},
+	{RolePermissionManageUsers, "manage_users"},
+}
+
+// Has returns true if p includes every permission in perms.
+func (p RolePermissions) Has(perms RolePermissions) bool {
+	return p&perms == perms
+}
+
+// Names returns the API names of the permissions in p.
+func (p RolePermissions) Names() []string {
+	names := make([]string, 0, len(rolePermissionNames))
+	for _, n := range rolePermissionNames {
+		if p.Has(n.perm) {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+// String returns a stringified, frontend API compatible
+// form of RolePermissions, as a comma-separated list.
+func (p RolePermissions) String() string {
+	return strings.Join(p.Names(), ",")
+}
+
+// ParseRolePermission returns the permission
+// with the given API name, or false if unknown.
+func ParseRolePermission(name string) (RolePermissions, bool) {
+	for _, n := range rolePermissionNames {
+		if strings.EqualFold(n.name, name) {
+			return n.perm, true
+		}
+	}
+	return RolePermissionsNone, false
+}
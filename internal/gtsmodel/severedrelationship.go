@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// RelationshipSeveranceEvent represents a moderation event,
+// such as a domain block or an account suspension, which
+// caused follow relationships between accounts to be removed.
+type RelationshipSeveranceEvent struct {
+	ID         string        `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt  time.Time     `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	Type       SeveranceType `bun:",nullzero,notnull"`                                           // Type of moderation event that caused the severance.
+	TargetName string        `bun:",nullzero,notnull"`                                           // Name of the target of the event, ie., blocked domain or suspended account namestring.
+	OriginID   string        `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // ID of the domain block or suspended account that caused the event.
+}
+
+// SeveranceType describes the type of moderation
+// event that caused relationships to be severed.
+type SeveranceType enumType
+
+const (
+	SeveranceDomainBlock       SeveranceType = 1 // SeveranceDomainBlock -- an admin blocked the domain of the other accounts.
+	SeveranceAccountSuspension SeveranceType = 2 // SeveranceAccountSuspension -- an admin suspended the other account.
+)
+
+// String returns a stringified, frontend API compatible form of SeveranceType.
+func (t SeveranceType) String() string {
+	switch t {
+	case SeveranceDomainBlock:
+		return "domain_block"
+	case SeveranceAccountSuspension:
+		return "account_suspension"
+	default:
+		panic("invalid severance type")
+	}
+}
+
+// SeveredRelationship represents a single follow between
+// a local account and another account that was removed
+// by a RelationshipSeveranceEvent.
+type SeveredRelationship struct {
+	ID              string                      `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt       time.Time                   `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	EventID         string                      `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the event that severed this relationship.
+	Event           *RelationshipSeveranceEvent `bun:"-"`                                                           // Event corresponding to EventID.
+	AccountID       string                      `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the local account that lost this relationship.
+	TargetAccountID string                      `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account at the other end of the relationship.
+	TargetAccount   *Account                    `bun:"-"`                                                           // Account corresponding to TargetAccountID.
+	Direction       SeveredDirection            `bun:",nullzero,notnull"`                                           // Direction of the severed follow.
+}
+
+// SeveredDirection describes the direction
+// of a severed follow, from the point of
+// view of the local account that lost it.
+type SeveredDirection enumType
+
+const (
+	SeveredFollowing SeveredDirection = 1 // SeveredFollowing -- the local account followed the target account.
+	SeveredFollower  SeveredDirection = 2 // SeveredFollower -- the target account followed the local account.
+)
+
+// String returns a stringified, frontend API compatible form of SeveredDirection.
+func (d SeveredDirection) String() string {
+	switch d {
+	case SeveredFollowing:
+		return "following"
+	case SeveredFollower:
+		return "follower"
+	default:
+		panic("invalid severed direction")
+	}
+}
+
+// ParseSeveredDirection returns the SeveredDirection
+// corresponding to the given string, or 0 if not recognized.
+func ParseSeveredDirection(in string) SeveredDirection {
+	switch in {
+	case "following":
+		return SeveredFollowing
+	case "follower":
+		return SeveredFollower
+	default:
+		return 0
+	}
+}
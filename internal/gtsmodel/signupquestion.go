@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// SignupQuestion models an extra question set by
+// the admin, which applicants must answer on the
+// sign-up form, in addition to giving a reason.
+type SignupQuestion struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Text      string    `bun:",nullzero,notnull"`                                           // text content of the question
+}
+
+// SignupAnswer models an applicant's answer to one
+// sign-up question, stored with their pending sign-up.
+type SignupAnswer struct {
+	ID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UserID     string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the user who gave this answer
+	QuestionID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the question being answered
+	Question   string    `bun:",nullzero,notnull"`                                           // text of the question at the time it was answered, in case it changes later
+	Answer     string    `bun:",nullzero,notnull"`                                           // text of the answer
+}
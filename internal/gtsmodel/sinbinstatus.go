@@ -22,7 +22,8 @@
 // SinBinStatus represents a status that's been rejected and/or reported + quarantined.
 //
 // Automatically rejected statuses are not put in the sin bin, only statuses that were
-// stored on the instance and which someone (local or remote) has subsequently rejected.
+// stored on the instance and which someone (local or remote) has subsequently rejected,
+// or which matched an automod rule with the sin_bin action.
 type SinBinStatus struct {
 	ID                  string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
 	CreatedAt           time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Creation time of this item.
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// SpamFlag represents an incoming federated status
+// which was removed because its heuristic spam score
+// met the configured threshold, kept as a record so
+// that admins can review it and mark false positives.
+type SpamFlag struct {
+	ID                       string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt                time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt                time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	StatusURI                string    `bun:",nullzero,notnull"`                                           // ActivityPub URI of the flagged status.
+	AccountID                string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the remote account that authored the flagged status.
+	Account                  *Account  `bun:"-"`                                                           // Account corresponding to AccountID.
+	TargetAccountID          string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the local account the flagged status was delivered to.
+	TargetAccount            *Account  `bun:"-"`                                                           // Account corresponding to TargetAccountID.
+	Score                    int       `bun:",notnull,default:0"`                                          // Spam score given to the status.
+	Reasons                  []string  `bun:"reasons,array"`                                               // Human-readable reasons contributing to the score.
+	SinBinned                *bool     `bun:",nullzero,notnull,default:false"`                             // Whether a copy of the status was kept in the sin bin, rather than just dropped.
+	FalsePositiveAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // Time at which an admin marked this flag as a false positive, if at all.
+	FalsePositiveByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // ID of the admin account that marked this flag as a false positive, if any.
+	FalsePositiveByAccount   *Account  `bun:"-"`                                                           // Account corresponding to FalsePositiveByAccountID.
+}
+
+// IsFalsePositive returns true if an admin
+// has marked this flag as a false positive.
+func (s *SpamFlag) IsFalsePositive() bool {
+	return !s.FalsePositiveAt.IsZero()
+}
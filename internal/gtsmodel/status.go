@@ -28,7 +28,7 @@ type Status struct {
 	CreatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
 	UpdatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // when was item (remote) last fetched.
-	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time.
+	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time. Pinned statuses are shown newest pinned_at first, so this is rewritten when pins are reordered.
 	URI                      string             `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                   // web url for viewing this status
 	Content                  string             `bun:""`                                                            // content of this status; likely html-formatted but not guaranteed
@@ -54,22 +54,30 @@ type Status struct {
 	BoostOfAccountID         string             `bun:"type:CHAR(26),nullzero"`                                      // id of the account that owns the boosted status
 	BoostOf                  *Status            `bun:"-"`                                                           // status that corresponds to boostOfID
 	BoostOfAccount           *Account           `bun:"rel:belongs-to"`                                              // account that corresponds to boostOfAccountID
+	QuoteID                  string             `bun:"type:CHAR(26),nullzero"`                                      // id of the status this status quotes, if any
+	QuoteURI                 string             `bun:",nullzero"`                                                   // activitypub uri of the status this status quotes; may be set even if quoteID is not, in which case the quoted status can be dereferenced later
+	Quote                    *Status            `bun:"-"`                                                           // status corresponding to quoteID
 	ThreadID                 string             `bun:"type:CHAR(26),nullzero"`                                      // id of the thread to which this status belongs; only set for remote statuses if a local account is involved at some point in the thread, otherwise null
 	PollID                   string             `bun:"type:CHAR(26),nullzero"`                                      //
 	Poll                     *Poll              `bun:"-"`                                                           //
 	ContentWarning           string             `bun:",nullzero"`                                                   // cw string for this status
 	Visibility               Visibility         `bun:",nullzero,notnull"`                                           // visibility entry for this status
+	CircleID                 string             `bun:"type:CHAR(26),nullzero"`                                      // id of the circle this status is addressed to, if any; only set for local followers-only statuses
 	Sensitive                *bool              `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive?
 	Language                 string             `bun:",nullzero"`                                                   // what language is this status written in?
 	CreatedWithApplicationID string             `bun:"type:CHAR(26),nullzero"`                                      // Which application was used to create this status?
 	CreatedWithApplication   *Application       `bun:"rel:belongs-to"`                                              // application corresponding to createdWithApplicationID
 	ActivityStreamsType      string             `bun:",nullzero,notnull"`                                           // What is the activitystreams type of this status? See: https://www.w3.org/TR/activitystreams-vocabulary/#object-types. Will probably almost always be Note but who knows!.
 	Text                     string             `bun:""`                                                            // Original text of the status without formatting
+	ContentType              StatusContentType  `bun:",nullzero"`                                                   // Content type used to format Text into Content. Only set for local statuses, may be unset for statuses created before content types were stored.
 	Federated                *bool              `bun:",notnull"`                                                    // This status will be federated beyond the local timeline(s)
 	InteractionPolicy        *InteractionPolicy `bun:""`                                                            // InteractionPolicy for this status. If null then the default InteractionPolicy should be assumed for this status's Visibility. Always null for boost wrappers.
 	PendingApproval          *bool              `bun:",nullzero,notnull,default:false"`                             // If true then status is a reply or boost wrapper that must be Approved by the reply-ee or boost-ee before being fully distributed.
 	PreApproved              bool               `bun:"-"`                                                           // If true, then status is a reply to or boost wrapper of a status on our instance, has permission to do the interaction, and an Accept should be sent out for it immediately. Field not stored in the DB.
 	ApprovedByURI            string             `bun:",nullzero"`                                                   // URI of an Accept Activity that approves the Announce or Create Activity that this status was/will be attached to.
+	RemoteRepliesCount       *int               `bun:",nullzero"`                                                   // Number of replies to this remote status as reported by its origin server, if known. Always null for local statuses.
+	RemoteReblogsCount       *int               `bun:",nullzero"`                                                   // Number of boosts of this remote status as reported by its origin server, if known. Always null for local statuses.
+	RemoteFavesCount         *int               `bun:",nullzero"`                                                   // Number of faves of this remote status as reported by its origin server, if known. Always null for local statuses.
 }
 
 // GetID implements timeline.Timelineable{}.
@@ -315,6 +323,15 @@ func (v Visibility) String() string {
 	}
 }
 
+// StatusContentType is the content type with
+// which the text of a local status was formatted.
+type StatusContentType enumType
+
+const (
+	StatusContentTypePlain    StatusContentType = 1 // StatusContentTypePlain -- text/plain.
+	StatusContentTypeMarkdown StatusContentType = 2 // StatusContentTypeMarkdown -- text/markdown.
+)
+
 // Content models the simple string content
 // of a status along with its ContentMap,
 // which contains content entries keyed by
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"slices"
+	"time"
+)
+
+// StatusEdit represents a snapshot of a previous
+// revision of a status, taken when the status was
+// edited. The current revision of a status is the
+// status itself, so it does not get a StatusEdit.
+type StatusEdit struct {
+	ID                     string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was this revision of the status posted
+	StatusID               string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the status this is a revision of
+	Content                string    `bun:""`                                                            // content of the status at this revision
+	ContentWarning         string    `bun:",nullzero"`                                                   // cw string of the status at this revision
+	Text                   string    `bun:""`                                                            // original text of the status at this revision, if local
+	Language               string    `bun:",nullzero"`                                                   // language of the status at this revision
+	Sensitive              *bool     `bun:",nullzero,notnull,default:false"`                             // was the status marked sensitive at this revision
+	AttachmentIDs          []string  `bun:"attachments,array"`                                           // ids of media attachments at this revision, in order
+	AttachmentDescriptions []string  `bun:"attachment_descriptions,array"`                               // descriptions of media attachments at this revision, same order as AttachmentIDs
+	PollOptions            []string  `bun:",array"`                                                      // poll options at this revision, if the status had a poll
+	PollMultiple           *bool     `bun:",nullzero,notnull,default:false"`                             // was the poll at this revision multiple choice
+}
+
+// Differs returns whether the user-visible contents
+// of the given revision differ from this revision.
+// Attachment descriptions are only compared if
+// they're set on both revisions.
+func (e *StatusEdit) Differs(other *StatusEdit) bool {
+	return e.Content != other.Content ||
+		e.ContentWarning != other.ContentWarning ||
+		*e.Sensitive != *other.Sensitive ||
+		!slices.Equal(e.AttachmentIDs, other.AttachmentIDs) ||
+		(e.AttachmentDescriptions != nil && other.AttachmentDescriptions != nil &&
+			!slices.Equal(e.AttachmentDescriptions, other.AttachmentDescriptions)) ||
+		!slices.Equal(e.PollOptions, other.PollOptions) ||
+		*e.PollMultiple != *other.PollMultiple
+}
+
+// SnapshotStatus returns a StatusEdit containing the current
+// revision of the given status. Poll should be populated on
+// the status, and attachments should be too if descriptions
+// are to be kept. ID and StatusID are not set.
+func SnapshotStatus(status *Status) *StatusEdit {
+	edit := &StatusEdit{
+		CreatedAt:      status.UpdatedAt,
+		Content:        status.Content,
+		ContentWarning: status.ContentWarning,
+		Text:           status.Text,
+		Language:       status.Language,
+		Sensitive:      new(bool),
+		PollMultiple:   new(bool),
+	}
+
+	if status.Sensitive != nil {
+		*edit.Sensitive = *status.Sensitive
+	}
+
+	if len(status.AttachmentIDs) > 0 {
+		edit.AttachmentIDs = slices.Clone(status.AttachmentIDs)
+	}
+
+	if len(status.AttachmentIDs) > 0 && status.AttachmentsPopulated() {
+		edit.AttachmentDescriptions = make([]string, len(status.Attachments))
+		for i, attachment := range status.Attachments {
+			edit.AttachmentDescriptions[i] = attachment.Description
+		}
+	}
+
+	if status.Poll != nil {
+		edit.PollOptions = slices.Clone(status.Poll.Options)
+		if status.Poll.Multiple != nil {
+			*edit.PollMultiple = *status.Poll.Multiple
+		}
+	}
+
+	return edit
+}
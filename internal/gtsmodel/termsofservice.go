@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// TermsOfService represents one published version of this
+// instance's terms of service. Versions are never changed
+// once published: to change the terms, an admin publishes
+// a new version, which comes into effect immediately.
+type TermsOfService struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this version was published, and came into effect.
+	Text               string    `bun:""`                                                            // Raw Markdown text of the terms (before parsing).
+	Content            string    `bun:""`                                                            // HTML content of the terms, parsed from Text.
+	RequireAcceptance  *bool     `bun:",nullzero,notnull,default:false"`                             // Whether users must accept this version the next time they sign in.
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // Account ID of the admin who published this version, if known.
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Trend represents a hashtag, status, or link that's
+// currently popular among accounts seen by this instance,
+// along with its moderation state. Trends are recomputed
+// periodically from recent activity; see processing/trends.
+type Trend struct {
+	ID                  string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt           time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Type                TrendType        `bun:",nullzero,notnull,unique:trends_type_target_uniq"`            // type of the trending item
+	Target              string           `bun:",nullzero,notnull,unique:trends_type_target_uniq"`            // ID of the trending tag or status, or URL of the trending link
+	Tag                 *Tag             `bun:"-"`                                                           // Not stored in DB. Trending tag, if Type is TrendTypeTag.
+	Status              *Status          `bun:"-"`                                                           // Not stored in DB. Trending status, if Type is TrendTypeStatus.
+	Score               float64          `bun:",notnull,default:0"`                                          // how popular the item currently is; zero if no longer trending
+	Uses                int              `bun:",notnull,default:0"`                                          // number of times item was used (posted or interacted with) recently
+	Accounts            int              `bun:",notnull,default:0"`                                          // number of distinct accounts that used the item recently
+	ReviewState         TrendReviewState `bun:",nullzero,notnull,default:1"`                                 // moderation state of the trending item
+	ReviewedAt          time.Time        `bun:"type:timestamptz,nullzero"`                                   // when was the item approved or rejected
+	ReviewedByAccountID string           `bun:"type:CHAR(26),nullzero"`                                      // ID of the admin account that approved or rejected the item
+	History             []*TrendHistory  `bun:"-"`                                                           // Not stored in DB. Daily usage history of this item, newest first.
+}
+
+// TrendType describes the
+// type of a trending item.
+type TrendType enumType
+
+const (
+	TrendTypeTag    TrendType = 1 // TrendTypeTag -- a hashtag.
+	TrendTypeStatus TrendType = 2 // TrendTypeStatus -- a status.
+	TrendTypeLink   TrendType = 3 // TrendTypeLink -- a link to a web page.
+)
+
+// String returns a stringified, frontend API compatible form of TrendType.
+func (t TrendType) String() string {
+	switch t {
+	case TrendTypeTag:
+		return "tag"
+	case TrendTypeStatus:
+		return "status"
+	case TrendTypeLink:
+		return "link"
+	default:
+		panic("invalid trend type")
+	}
+}
+
+// TrendReviewState describes the
+// moderation state of a trending item.
+type TrendReviewState enumType
+
+const (
+	TrendReviewPending  TrendReviewState = 1 // TrendReviewPending -- not yet approved or rejected by an admin.
+	TrendReviewApproved TrendReviewState = 2 // TrendReviewApproved -- approved by an admin, may be shown.
+	TrendReviewRejected TrendReviewState = 3 // TrendReviewRejected -- rejected by an admin, never shown.
+)
+
+// String returns a stringified, frontend API compatible form of TrendReviewState.
+func (s TrendReviewState) String() string {
+	switch s {
+	case TrendReviewPending:
+		return "pending"
+	case TrendReviewApproved:
+		return "approved"
+	case TrendReviewRejected:
+		return "rejected"
+	default:
+		panic("invalid trend review state")
+	}
+}
+
+// TrendHistory represents the usage of
+// a trending item on one particular day.
+type TrendHistory struct {
+	TrendID  string    `bun:"type:CHAR(26),pk,nullzero,notnull"`    // ID of the trend this history belongs to
+	Day      time.Time `bun:"type:timestamptz,pk,nullzero,notnull"` // midnight (UTC) at the start of the day
+	Uses     int       `bun:",notnull,default:0"`                   // number of times item was used on this day
+	Accounts int       `bun:",notnull,default:0"`                   // number of distinct accounts that used the item on this day
+}
+
+// TrendActivity represents one use of a potentially
+// trending item by an account, such as posting a
+// hashtag or link, or faving or boosting a status.
+// It is not stored in the database, but aggregated
+// from other tables in order to compute trends.
+type TrendActivity struct {
+	Target    string    // ID of tag or status, or URL of link
+	AccountID string    // ID of the account using the item
+	CreatedAt time.Time // when the item was used
+}
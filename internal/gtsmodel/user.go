@@ -39,7 +39,7 @@ type User struct {
 	Account                *Account     `bun:"rel:belongs-to"`                                              // Pointer to the account of this user that corresponds to AccountID.
 	EncryptedPassword      string       `bun:",nullzero,notnull"`                                           // The encrypted password of this user, generated using https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword. A salt is included so we're safe against 🌈 tables.
 	SignUpIP               net.IP       `bun:",nullzero"`                                                   // IP this user used to sign up. Only stored for pending sign-ups.
-	InviteID               string       `bun:"type:CHAR(26),nullzero"`                                      // id of the user who invited this user (who let this joker in?)
+	InviteID               string       `bun:"type:CHAR(26),nullzero"`                                      // id of the invite this user signed up with (who let this joker in?)
 	Reason                 string       `bun:",nullzero"`                                                   // What reason was given for signing up when this user was created?
 	Locale                 string       `bun:",nullzero"`                                                   // In what timezone/locale is this user located?
 	CreatedByApplicationID string       `bun:"type:CHAR(26),nullzero"`                                      // Which application id created this user? See gtsmodel.Application
@@ -51,11 +51,43 @@ type User struct {
 	UnconfirmedEmail       string       `bun:",nullzero"`                                                   // Email address that hasn't yet been confirmed
 	Moderator              *bool        `bun:",nullzero,notnull,default:false"`                             // Is this user a moderator?
 	Admin                  *bool        `bun:",nullzero,notnull,default:false"`                             // Is this user an admin?
+	RoleID                 string       `bun:"type:CHAR(26),nullzero"`                                      // id of the custom role given to this user, if any
+	Role                   *Role        `bun:"-"`                                                           // custom role corresponding to RoleID
 	Disabled               *bool        `bun:",nullzero,notnull,default:false"`                             // Is this user disabled from posting?
 	Approved               *bool        `bun:",nullzero,notnull,default:false"`                             // Has this user been approved by a moderator?
 	ResetPasswordToken     string       `bun:",nullzero"`                                                   // The generated token that the user can use to reset their password
 	ResetPasswordSentAt    time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did we email the user their reset-password email?
 	ExternalID             string       `bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	TwoFactorSecret        string       `bun:",nullzero"`                                                   // Base32-encoded TOTP secret for this user. May be set before TwoFactorEnabledAt, while enrollment is pending.
+	TwoFactorBackups       []string     `bun:",array"`                                                      // Bcrypt hashes of not-yet-used two factor backup codes.
+	TwoFactorEnabledAt     time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did this user enable two factor authentication? Zero if not enabled.
+	TwoFactorLastCounter   int64        `bun:",notnull,default:0"`                                          // TOTP time step counter of the last accepted code, codes at or before this counter are rejected to prevent replays.
+	DeletionRequestedAt    time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did this user ask for their account to be deleted? Zero if not pending deletion.
+	TermsOfServiceID       string       `bun:"type:CHAR(26),nullzero"`                                      // id of the most recent terms of service version this user accepted
+}
+
+// TwoFactorEnabled returns true if this user
+// has completed two factor auth enrollment, and
+// must therefore provide a code when signing in.
+func (u *User) TwoFactorEnabled() bool {
+	return !u.TwoFactorEnabledAt.IsZero() && u.TwoFactorSecret != ""
+}
+
+// HasPermission returns true if this user has been given
+// all of the given permissions by their custom role. Admins
+// implicitly have every permission.
+func (u *User) HasPermission(perms RolePermissions) bool {
+	if *u.Admin {
+		return true
+	}
+	return u.Role != nil && u.Role.Permissions.Has(perms)
+}
+
+// PendingDeletion returns true if this user has asked
+// for their account to be deleted, and is waiting for
+// the deletion grace period to pass.
+func (u *User) PendingDeletion() bool {
+	return !u.DeletionRequestedAt.IsZero()
 }
 
 // DeniedUser represents one user sign-up that
@@ -96,5 +128,10 @@ type NewSignup struct {
 	AppID         string // ID of the application used to create this account (optional).
 	EmailVerified bool   // Mark submitted email address as already verified (optional).
 	ExternalID    string // ID of this user in external OIDC system (optional).
+	InviteID      string // ID of the invite used to sign up (optional).
+	TermsID       string // ID of the terms of service version agreed to on sign up (optional).
 	Admin         bool   // Mark new user as an admin user (optional).
+	Moderator     bool   // Mark new user as a moderator user (optional).
+
+	Answers []*SignupAnswer // Answers to sign-up questions; user ID will be set on insert (optional).
 }
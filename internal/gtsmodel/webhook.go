@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"slices"
+	"time"
+)
+
+// Webhook represents a URL configured by an admin,
+// to which signed JSON payloads are POSTed when
+// moderation events of interest occur, so that
+// external tooling or chat bots can react to them.
+type Webhook struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was created.
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // Time when this item was last updated.
+	URL                string    `bun:",nullzero,notnull"`                                           // URL to POST event payloads to.
+	Events             []string  `bun:"events,array"`                                                // Events this webhook is subscribed to, eg., 'report.created'.
+	Secret             string    `bun:",nullzero,notnull"`                                           // Secret used to sign event payloads.
+	Enabled            *bool     `bun:",nullzero,notnull,default:true"`                              // Whether events are currently delivered to this webhook.
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the admin who created this webhook.
+}
+
+// Subscribed returns true if the webhook is
+// enabled, and subscribed to the given event.
+func (w *Webhook) Subscribed(event string) bool {
+	return w.Enabled != nil && *w.Enabled &&
+		slices.Contains(w.Events, event)
+}
+
+// Webhook event names.
+const (
+	WebhookEventAccountCreated     = "account.created"      // WebhookEventAccountCreated -- a new local account signed up.
+	WebhookEventDomainBlockCreated = "domain_block.created" // WebhookEventDomainBlockCreated -- a domain block was created.
+	WebhookEventReportCreated      = "report.created"       // WebhookEventReportCreated -- a new report was created, locally or by a remote instance.
+	WebhookEventStatusReported     = "status.reported"      // WebhookEventStatusReported -- a status was included in a new report.
+)
+
+// WebhookEvents contains all
+// supported webhook event names.
+var WebhookEvents = []string{
+	WebhookEventAccountCreated,
+	WebhookEventDomainBlockCreated,
+	WebhookEventReportCreated,
+	WebhookEventStatusReported,
+}
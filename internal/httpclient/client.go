@@ -26,6 +26,7 @@
 	"net"
 	"net/http"
 	"net/netip"
+	"net/url"
 	"runtime"
 	"strconv"
 	"strings"
@@ -37,6 +38,8 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
 )
 
 var (
@@ -48,6 +51,14 @@
 
 	// ErrReservedAddr is returned if a dialed address resolves to an IP within a blocked or reserved net.
 	ErrReservedAddr = errors.New("dial within blocked / reserved IP range")
+
+	// ErrHostUnreachable is returned if the request host has been marked as
+	// temporarily unreachable, after repeatedly failing outgoing requests.
+	ErrHostUnreachable = errors.New("host marked as unreachable")
+
+	// ErrRateLimited is returned if the request host has reached its
+	// outgoing request rate limit, and the request should be retried later.
+	ErrRateLimited = errors.New("host rate limit reached")
 )
 
 // Config provides configuration details for setting up a new
@@ -90,6 +101,52 @@ type Config struct {
 
 	// DisableCompression: see http.Transport{}.DisableCompression.
 	DisableCompression bool
+
+	// MaxRetries is the maximum number of
+	// attempts made for a single request.
+	MaxRetries int
+
+	// Backoff is the starting backoff duration
+	// between attempts, doubled with each attempt.
+	Backoff time.Duration
+
+	// UnreachableThreshold is the number of consecutive
+	// requests to a host that must fail (after retries)
+	// before the host is marked as unreachable.
+	UnreachableThreshold int
+
+	// UnreachableDuration is the duration for
+	// which a host is marked as unreachable.
+	UnreachableDuration time.Duration
+
+	// RateLimit optionally returns the maximum number of
+	// requests per minute permitted to the given host, for
+	// either deliveries (POST requests) or dereferences
+	// (all other requests). <= 0 means no limit.
+	RateLimit func(ctx context.Context, host string, delivery bool) int
+
+	// SOCKSProxy optionally sets the host:port address
+	// of a SOCKS5 proxy to dial outgoing connections
+	// through, eg., the SOCKS port of a Tor daemon. When
+	// set, proxy environment variables are ignored.
+	SOCKSProxy string
+
+	// SOCKSProxyOnionOnly limits use of SOCKSProxy to
+	// .onion hosts, dialing all other hosts directly.
+	SOCKSProxyOnionOnly bool
+
+	// Proxy optionally sets the URL of an HTTP(S) proxy
+	// to send outgoing requests through. When set, proxy
+	// environment variables are ignored.
+	Proxy string
+
+	// ProxyIncludeHosts optionally limits use of Proxy
+	// to hosts matching these patterns (see hostPatterns).
+	ProxyIncludeHosts []string
+
+	// ProxyExcludeHosts excludes hosts matching these
+	// patterns from use of Proxy, dialing them directly.
+	ProxyExcludeHosts []string
 }
 
 // Client wraps an underlying http.Client{} to provide the following:
@@ -99,18 +156,53 @@ type Config struct {
 //   - protection from server side request forgery (SSRF) by only dialing
 //     out to known public IP prefixes, configurable with allows/blocks
 //   - retry-backoff logic for error temporary HTTP error responses
+//   - marking of repeatedly erroring hosts as temporarily unreachable
+//   - optional per-host rate limiting of outgoing requests
+//   - optional dialing through a SOCKS5 proxy, eg., for Tor
+//   - optional HTTP(S) proxying, with per-host include / exclude rules
 //   - optional request signing
 //   - request logging
 type Client struct {
-	client   http.Client
-	badHosts cache.TTLCache[string, struct{}]
-	retries  uint
+	client      http.Client
+	badHosts    cache.TTLCache[string, int]
+	unreachable cache.TTLCache[string, struct{}]
+	retries     uint
+	backoff     time.Duration
+	threshold   int
+	rateLimit   func(context.Context, string, bool) int
+	limiter     limiter.Store
 }
 
 // New returns a new instance of Client initialized using configuration.
 func New(cfg Config) *Client {
 	var c Client
-	c.retries = 5
+
+	if cfg.MaxRetries <= 0 {
+		// By default allow 5 attempts.
+		cfg.MaxRetries = 5
+	}
+
+	if cfg.Backoff <= 0 {
+		// By default use our base backoff.
+		cfg.Backoff = baseBackoff
+	}
+
+	if cfg.UnreachableThreshold <= 0 {
+		// By default mark as unreachable
+		// after 5 consecutive failures.
+		cfg.UnreachableThreshold = 5
+	}
+
+	if cfg.UnreachableDuration <= 0 {
+		// By default mark as
+		// unreachable for 1 hour.
+		cfg.UnreachableDuration = time.Hour
+	}
+
+	c.retries = uint(cfg.MaxRetries) // #nosec G115 -- Checked above.
+	c.backoff = cfg.Backoff
+	c.threshold = cfg.UnreachableThreshold
+	c.rateLimit = cfg.RateLimit
 
 	d := &net.Dialer{
 		Timeout:   15 * time.Second,
@@ -131,10 +223,51 @@ func New(cfg Config) *Client {
 
 	// Protect the dialer
 	// with IP range sanitizer.
-	d.Control = (&Sanitizer{
+	sanitizer := &Sanitizer{
 		Allow: cfg.AllowRanges,
 		Block: cfg.BlockRanges,
-	}).Sanitize
+	}
+	d.Control = sanitizer.Sanitize
+
+	// By default dial directly, using
+	// any proxy from environment vars.
+	dial := d.DialContext
+	proxyFunc := http.ProxyFromEnvironment
+	tlsHandshakeTimeout := 10 * time.Second
+
+	if cfg.SOCKSProxy != "" {
+		// Dial through given SOCKS proxy instead,
+		// with more lenient timeouts to account
+		// for slow connections through Tor.
+		socks, err := newSOCKSDialer(
+			cfg.SOCKSProxy,
+			d,
+			sanitizer,
+			cfg.SOCKSProxyOnionOnly,
+		)
+		if err != nil {
+			log.Panicf(nil, "error setting up socks proxy: %v", err)
+		}
+
+		dial = socks.DialContext
+		proxyFunc = nil
+		tlsHandshakeTimeout = proxyTLSHandshakeTimeout
+	} else if cfg.Proxy != "" {
+		// Send through given HTTP(S) proxy
+		// instead, according to host rules.
+		proxyURL, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			log.Panicf(nil, "error parsing proxy url: %v", err)
+		}
+
+		proxyFunc, dial = newHTTPProxy(
+			proxyURL,
+			cfg.ProxyIncludeHosts,
+			cfg.ProxyExcludeHosts,
+			d,
+			sanitizer,
+		)
+	}
 
 	// Prepare client fields.
 	c.client.Timeout = cfg.Timeout
@@ -155,26 +288,42 @@ func New(cfg Config) *Client {
 
 	// Set underlying HTTP client roundtripper.
 	c.client.Transport = &signingtransport{http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
+		Proxy:                 proxyFunc,
 		ForceAttemptHTTP2:     true,
-		DialContext:           d.DialContext,
+		DialContext:           dial,
 		TLSClientConfig:       tlsClientConfig,
 		MaxIdleConns:          cfg.MaxIdleConns,
 		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
 		ExpectContinueTimeout: 1 * time.Second,
 		ReadBufferSize:        cfg.ReadBufferSize,
 		WriteBufferSize:       cfg.WriteBufferSize,
 		DisableCompression:    cfg.DisableCompression,
 	}}
 
-	// Initiate outgoing bad hosts lookup cache.
-	c.badHosts = cache.NewTTL[string, struct{}](0, 512, 0)
-	c.badHosts.SetTTL(time.Hour, false)
+	// Initiate outgoing bad hosts lookup cache,
+	// mapping hosts to no. consecutive failures.
+	c.badHosts = cache.NewTTL[string, int](0, 512, 0)
+	c.badHosts.SetTTL(cfg.UnreachableDuration, false)
 	if !c.badHosts.Start(time.Minute) {
 		log.Panic(nil, "failed to start transport controller cache")
 	}
 
+	// Initiate unreachable hosts lookup cache.
+	c.unreachable = cache.NewTTL[string, struct{}](0, 512, 0)
+	c.unreachable.SetTTL(cfg.UnreachableDuration, false)
+	if !c.unreachable.Start(time.Minute) {
+		log.Panic(nil, "failed to start transport controller cache")
+	}
+
+	if c.rateLimit != nil {
+		// Initiate per-host rate limit counter store.
+		c.limiter = memory.NewStoreWithOptions(limiter.StoreOptions{
+			Prefix:          "httpclient",
+			CleanUpInterval: time.Minute,
+		})
+	}
+
 	return &c
 }
 
@@ -243,6 +392,24 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 		return
 	}
 
+	if c.unreachable.Has(r.Host) {
+		// Don't bother trying requests to hosts
+		// currently marked as being unreachable.
+		err = fmt.Errorf("httpclient: %w: %s", ErrHostUnreachable, r.Host)
+		r.attempts = c.retries + 1
+		return
+	}
+
+	if wait := c.rateLimited(r); wait > 0 {
+		// Host has reached its rate limit, so ask for
+		// a retry once the limit window has reset. This
+		// doesn't count towards the no. attempts made.
+		err = fmt.Errorf("httpclient: %w: %s", ErrRateLimited, r.Host)
+		r.backoff = wait
+		retry = true
+		return
+	}
+
 	// Update no.
 	// attempts.
 	r.attempts++
@@ -250,12 +417,20 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 	// Reset backoff.
 	r.backoff = 0
 
+	// Set configured
+	// base backoff.
+	r.base = c.backoff
+
 	// Perform main routine.
 	rsp, retry, err = c.do(r)
 
 	if rsp != nil {
 		// Log successful rsp.
 		r.Entry.Info(rsp.Status)
+
+		// Host is reachable, reset
+		// any consecutive failures.
+		c.badHosts.Invalidate(r.Host)
 		return
 	}
 
@@ -272,7 +447,7 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 	case r.attempts > c.retries:
 		// On max retries, mark this as
 		// a "badhost", i.e. is erroring.
-		c.badHosts.Set(r.Host, struct{}{})
+		c.markBadHost(r.Host)
 
 		// Ensure retry flag is unset
 		// when reached max attempts.
@@ -282,6 +457,9 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 		// When retry is still permitted,
 		// check host hasn't been marked
 		// as a "badhost", i.e. erroring.
+		// If so, count this as a further
+		// consecutive failure for host.
+		c.markBadHost(r.Host)
 		r.attempts = c.retries + 1
 		retry = false
 	}
@@ -289,6 +467,64 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 	return
 }
 
+// rateLimited counts the given request against the rate limit for its
+// host, returning the duration to wait before retrying if the limit has
+// been reached, or 0 if the request may go ahead (or there's no limit).
+func (c *Client) rateLimited(r *Request) time.Duration {
+	if c.rateLimit == nil {
+		// No rate limits.
+		return 0
+	}
+
+	// Get limit for this host and request type.
+	delivery := (r.Method == http.MethodPost)
+	limit := c.rateLimit(r.Context(), r.URL.Hostname(), delivery)
+	if limit <= 0 {
+		return 0
+	}
+
+	key := "dereference:" + r.Host
+	if delivery {
+		key = "delivery:" + r.Host
+	}
+
+	lctx, err := c.limiter.Get(r.Context(), key, limiter.Rate{
+		Period: time.Minute,
+		Limit:  int64(limit),
+	})
+	if err != nil {
+		// Memory store shouldn't error, but
+		// if it does, don't block requests.
+		log.Errorf(r.Context(), "error checking rate limit: %v", err)
+		return 0
+	}
+
+	if !lctx.Reached {
+		return 0
+	}
+
+	// Wait until the current window resets,
+	// with a small minimum to avoid spinning.
+	wait := time.Until(time.Unix(lctx.Reset, 0))
+	return max(wait, time.Second)
+}
+
+// markBadHost increments the no. consecutive failures for
+// host, marking it as unreachable on reaching threshold.
+func (c *Client) markBadHost(host string) {
+	failures, _ := c.badHosts.Get(host)
+	failures++
+
+	if failures >= c.threshold {
+		log.Warnf(nil, "marking %s as unreachable after %d failures", host, failures)
+		c.unreachable.Set(host, struct{}{})
+		c.badHosts.Invalidate(host)
+		return
+	}
+
+	c.badHosts.Set(host, failures)
+}
+
 // do performs the "meat" of DoOnce(), but it's separated out to allow
 // easier wrapping of the response, retry, error returns with further logic.
 func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
@@ -347,7 +583,7 @@ func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
 			}
 
 			// Don't let their provided backoff exceed our max.
-			if max := baseBackoff * time.Duration(c.retries); // #nosec G115 -- We control c.retries.
+			if max := c.backoff * time.Duration(c.retries); // #nosec G115 -- We control c.retries.
 			r.backoff > max {
 				r.backoff = max
 			}
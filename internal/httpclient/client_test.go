@@ -19,12 +19,14 @@
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 )
@@ -116,3 +118,112 @@ func TestHTTPClientPrivateIP(t *testing.T) {
 		}
 	}
 }
+
+func TestHTTPClientUnreachable(t *testing.T) {
+	var requests int
+
+	// Create new HTTP client marking hosts as
+	// unreachable after 2 consecutive failures.
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+		MaxRetries:           1,
+		Backoff:              time.Millisecond,
+		UnreachableThreshold: 2,
+	})
+
+	// Start the test server, always erroring.
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", srv.URL, nil)
+
+		// Perform the failing request.
+		_, err := client.Do(req)
+		if err == nil || errors.Is(err, httpclient.ErrHostUnreachable) {
+			t.Fatalf("expected http response error, got: %v", err)
+		}
+	}
+
+	// Host should now be marked as
+	// unreachable, so no further
+	// requests should be attempted.
+	before := requests
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	_, err := client.Do(req)
+	if !errors.Is(err, httpclient.ErrHostUnreachable) {
+		t.Fatalf("expected unreachable host error, got: %v", err)
+	}
+	if requests != before {
+		t.Errorf("expected no further requests, got %d", requests-before)
+	}
+}
+
+func TestHTTPClientRateLimit(t *testing.T) {
+	var requests int
+
+	// Create new HTTP client limiting deliveries
+	// to 2 per minute, with no dereference limit.
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+		RateLimit: func(_ context.Context, _ string, delivery bool) int {
+			if delivery {
+				return 2
+			}
+			return 0
+		},
+	})
+
+	// Start the test server, always succeeding.
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		rw.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	doOnce := func(method string) (bool, time.Duration, error) {
+		r, _ := http.NewRequest(method, srv.URL, nil)
+		req := httpclient.WrapRequest(r)
+		rsp, retry, err := client.DoOnce(req)
+		if rsp != nil {
+			_ = rsp.Body.Close()
+		}
+		return retry, req.BackOff(), err
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := doOnce(http.MethodPost); err != nil {
+			t.Fatalf("unexpected error on delivery %d: %v", i, err)
+		}
+	}
+
+	// Third delivery within the minute
+	// should be held back for a retry.
+	retry, backoff, err := doOnce(http.MethodPost)
+	if !errors.Is(err, httpclient.ErrRateLimited) {
+		t.Fatalf("expected rate limited error, got: %v", err)
+	}
+	if !retry {
+		t.Error("expected rate limited delivery to be retried")
+	}
+	if backoff <= 0 || backoff > time.Minute {
+		t.Errorf("expected backoff until limit reset, got %s", backoff)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+
+	// Dereferences aren't limited.
+	if _, _, err := doOnce(http.MethodGet); err != nil {
+		t.Fatalf("unexpected error on dereference: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+const (
+	// proxyDialTimeout is the timeout used for dialing through a SOCKS
+	// proxy, which is much longer than for direct dialing, as building
+	// a Tor circuit (esp. to an onion service) can take a while.
+	proxyDialTimeout = time.Minute
+
+	// proxyTLSHandshakeTimeout is the TLS handshake
+	// timeout used when dialing through a SOCKS proxy.
+	proxyTLSHandshakeTimeout = 30 * time.Second
+)
+
+// socksDialer dials outgoing connections through a SOCKS5
+// proxy, optionally only for .onion hosts, dialing any
+// others directly.
+//
+// Note that hostnames are passed on to the proxy to resolve,
+// (so as not to leak DNS lookups when using Tor), so only IP
+// literal addresses can be checked against the sanitizer.
+type socksDialer struct {
+	direct    func(ctx context.Context, network, addr string) (net.Conn, error)
+	proxy     proxy.ContextDialer
+	sanitizer *Sanitizer
+	onionOnly bool
+}
+
+// newSOCKSDialer returns a new socksDialer for the SOCKS5 proxy at
+// address, wrapping the given direct dialer and IP sanitizer.
+func newSOCKSDialer(
+	address string,
+	direct *net.Dialer,
+	sanitizer *Sanitizer,
+	onionOnly bool,
+) (*socksDialer, error) {
+	// Dial the proxy itself without the sanitizer,
+	// as it will usually be running on loopback.
+	forward := &net.Dialer{
+		Timeout:   proxyDialTimeout,
+		KeepAlive: direct.KeepAlive,
+	}
+
+	dialer, err := proxy.SOCKS5("tcp", address, nil, forward)
+	if err != nil {
+		return nil, err
+	}
+
+	return &socksDialer{
+		direct:    direct.DialContext,
+		proxy:     dialer.(proxy.ContextDialer),
+		sanitizer: sanitizer,
+		onionOnly: onionOnly,
+	}, nil
+}
+
+// DialContext implements the http.Transport{}.DialContext signature.
+func (d *socksDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.onionOnly && !isOnion(host) {
+		// Only onions are proxied.
+		return d.direct(ctx, network, addr)
+	}
+
+	// The proxy won't have to resolve IP literals,
+	// so we can still check these before dialing.
+	if err := d.sanitizer.sanitizeHost(host); err != nil {
+		return nil, err
+	}
+
+	return d.proxy.DialContext(ctx, network, addr)
+}
+
+// newHTTPProxy returns an http.Transport{}.Proxy function
+// sending requests through the HTTP(S) proxy at proxyURL,
+// if the request host matches include (or include is empty),
+// and doesn't match exclude. It also returns a dial function
+// wrapping direct, which dials the proxy itself unsanitized.
+//
+// As with socksDialer, hostnames are resolved by the proxy,
+// so only IP literal hosts can be checked against the sanitizer.
+func newHTTPProxy(
+	proxyURL *url.URL,
+	include []string,
+	exclude []string,
+	direct *net.Dialer,
+	sanitizer *Sanitizer,
+) (
+	func(*http.Request) (*url.URL, error),
+	func(context.Context, string, string) (net.Conn, error),
+) {
+	includes := newHostPatterns(include)
+	excludes := newHostPatterns(exclude)
+
+	proxyFunc := func(r *http.Request) (*url.URL, error) {
+		host := r.URL.Hostname()
+
+		if excludes.Match(host) ||
+			(!includes.Empty() && !includes.Match(host)) {
+			// Dial directly.
+			return nil, nil
+		}
+
+		if err := sanitizer.sanitizeHost(host); err != nil {
+			return nil, err
+		}
+
+		return proxyURL, nil
+	}
+
+	// Dial the proxy itself without the sanitizer,
+	// as egress proxies will usually be running
+	// on a private network (or on loopback).
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		port := "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+
+	forward := &net.Dialer{
+		Timeout:   direct.Timeout,
+		KeepAlive: direct.KeepAlive,
+	}
+
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if addr == proxyAddr {
+			return forward.DialContext(ctx, network, addr)
+		}
+		return direct.DialContext(ctx, network, addr)
+	}
+
+	return proxyFunc, dial
+}
+
+// hostPatterns matches hosts against a list of
+// exact hostnames, "*.example.org" style wildcards
+// matching any subdomain, IP addresses and CIDR ranges.
+type hostPatterns struct {
+	hosts     []string
+	wildcards []string
+	prefixes  []netip.Prefix
+}
+
+func newHostPatterns(patterns []string) hostPatterns {
+	var p hostPatterns
+
+	for _, pattern := range patterns {
+		if prefix, err := netip.ParsePrefix(pattern); err == nil {
+			p.prefixes = append(p.prefixes, prefix)
+		} else if ip, err := netip.ParseAddr(pattern); err == nil {
+			p.prefixes = append(p.prefixes, netip.PrefixFrom(ip, ip.BitLen()))
+		} else if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+			p.wildcards = append(p.wildcards, suffix)
+		} else {
+			p.hosts = append(p.hosts, pattern)
+		}
+	}
+
+	return p
+}
+
+// Empty returns whether there are no patterns to match.
+func (p hostPatterns) Empty() bool {
+	return len(p.hosts) == 0 &&
+		len(p.wildcards) == 0 &&
+		len(p.prefixes) == 0
+}
+
+// Match returns whether host matches any of the patterns.
+func (p hostPatterns) Match(host string) bool {
+	if ip, err := netip.ParseAddr(host); err == nil {
+		ip = ip.Unmap()
+		for _, prefix := range p.prefixes {
+			if prefix.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if slices.Contains(p.hosts, host) {
+		return true
+	}
+
+	for _, suffix := range p.wildcards {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sanitizeHost checks host against the sanitizer
+// if it's an IP literal, else does nothing. This
+// is used where the dialer will not see final IP.
+func (s *Sanitizer) sanitizeHost(host string) error {
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return nil
+	}
+
+	ntwrk := "tcp6"
+	if ip = ip.Unmap(); ip.Is4() {
+		ntwrk = "tcp4"
+	}
+
+	return s.Sanitize(ntwrk, netip.AddrPortFrom(ip, 0).String(), nil)
+}
+
+// isOnion returns whether host is a Tor onion service address.
+func isOnion(host string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(host, "."), ".onion")
+}
@@ -0,0 +1,339 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package httpclient_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+)
+
+// socksServer is a bare-bones SOCKS5 proxy for
+// testing, which records requested addresses and
+// forwards all connections to the given target.
+type socksServer struct {
+	ln     net.Listener
+	target string
+
+	mu        sync.Mutex
+	requested []string
+}
+
+func newSOCKSServer(t *testing.T, target string) *socksServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &socksServer{ln: ln, target: target}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	t.Cleanup(func() { _ = ln.Close() })
+	return s
+}
+
+func (s *socksServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	// Greeting: version, no. methods, methods.
+	// Reply with version, no authentication.
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(conn, hdr); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(conn, make([]byte, hdr[1])); err != nil {
+		return
+	}
+	if _, err := conn.Write([]byte{5, 0}); err != nil {
+		return
+	}
+
+	// Request: version, cmd, reserved, address type.
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(conn, req); err != nil {
+		return
+	}
+
+	var host string
+	switch req[3] {
+	case 1: // IPv4
+		ip := make([]byte, 4)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return
+		}
+		host = net.IP(ip).String()
+	case 3: // Domain name
+		l := make([]byte, 1)
+		if _, err := io.ReadFull(conn, l); err != nil {
+			return
+		}
+		name := make([]byte, l[0])
+		if _, err := io.ReadFull(conn, name); err != nil {
+			return
+		}
+		host = string(name)
+	default:
+		return
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.requested = append(s.requested, net.JoinHostPort(host,
+		strconv.Itoa(int(binary.BigEndian.Uint16(port))),
+	))
+	s.mu.Unlock()
+
+	target, err := net.Dial("tcp", s.target)
+	if err != nil {
+		_, _ = conn.Write([]byte{5, 1, 0, 1, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	defer target.Close()
+
+	// Reply success, then pipe.
+	if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+		return
+	}
+	go func() { _, _ = io.Copy(target, conn) }()
+	_, _ = io.Copy(conn, target)
+}
+
+func (s *socksServer) Requested() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requested...)
+}
+
+func TestHTTPClientSOCKSProxy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	proxy := newSOCKSServer(t, srv.Listener.Addr().String())
+
+	client := httpclient.New(httpclient.Config{
+		SOCKSProxy: proxy.ln.Addr().String(),
+	})
+
+	// Requests to onions (and any other
+	// hostnames) are passed to the proxy
+	// unresolved, which dials the server.
+	for _, url := range []string{
+		"http://someonionserviceaddress.onion/",
+		"http://example.org/",
+	} {
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+		rsp, _, err := client.DoOnce(httpclient.WrapRequest(r))
+		if err != nil {
+			t.Fatalf("unexpected error requesting %s: %v", url, err)
+		}
+		_ = rsp.Body.Close()
+
+		if rsp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status requesting %s: %s", url, rsp.Status)
+		}
+	}
+
+	expect := []string{
+		"someonionserviceaddress.onion:80",
+		"example.org:80",
+	}
+	requested := proxy.Requested()
+	if len(requested) != len(expect) {
+		t.Fatalf("expected proxy requests %v, got %v", expect, requested)
+	}
+	for i := range expect {
+		if requested[i] != expect[i] {
+			t.Errorf("expected proxy request %s, got %s", expect[i], requested[i])
+		}
+	}
+
+	// IP literals are still checked
+	// before being passed to the proxy.
+	for _, url := range privateIPs {
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+		_, _, err := client.DoOnce(httpclient.WrapRequest(r))
+		if !errors.Is(err, httpclient.ErrReservedAddr) {
+			t.Errorf("expected reserved address error requesting %s, got: %v", url, err)
+		}
+	}
+}
+
+func TestHTTPClientSOCKSProxyOnionOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	proxy := newSOCKSServer(t, srv.Listener.Addr().String())
+
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+		SOCKSProxy:          proxy.ln.Addr().String(),
+		SOCKSProxyOnionOnly: true,
+	})
+
+	for _, url := range []string{
+		"http://someonionserviceaddress.onion/",
+		srv.URL,
+	} {
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+		rsp, _, err := client.DoOnce(httpclient.WrapRequest(r))
+		if err != nil {
+			t.Fatalf("unexpected error requesting %s: %v", url, err)
+		}
+		_ = rsp.Body.Close()
+	}
+
+	// Only the onion should have
+	// been dialed through the proxy.
+	requested := proxy.Requested()
+	if len(requested) != 1 || requested[0] != "someonionserviceaddress.onion:80" {
+		t.Errorf("expected only onion to be proxied, got %v", requested)
+	}
+}
+
+func TestHTTPClientHTTPProxy(t *testing.T) {
+	// Start a direct test server, identifying itself.
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Served-By", "direct")
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// Start a test proxy server, recording requested hosts.
+	var (
+		mu        sync.Mutex
+		requested []string
+	)
+	proxySrv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested = append(requested, r.URL.Host)
+		mu.Unlock()
+		rw.Header().Set("X-Served-By", "proxy")
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer proxySrv.Close()
+
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+		Proxy:             proxySrv.URL,
+		ProxyIncludeHosts: []string{"*.example.org", "127.0.0.2"},
+		ProxyExcludeHosts: []string{"private.example.org"},
+	})
+
+	for _, test := range []struct {
+		url      string
+		servedBy string
+	}{
+		// Included, proxied.
+		{"http://social.example.org/", "proxy"},
+		{"http://127.0.0.2:" + port + "/", "proxy"},
+
+		// Not included, direct.
+		{srv.URL, "direct"},
+
+		// Included but excluded, direct (so won't resolve).
+		{"http://private.example.org/", ""},
+	} {
+		r, _ := http.NewRequest(http.MethodGet, test.url, nil)
+		rsp, _, err := client.DoOnce(httpclient.WrapRequest(r))
+		if test.servedBy == "" {
+			if err == nil {
+				_ = rsp.Body.Close()
+				t.Errorf("expected error requesting %s directly", test.url)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error requesting %s: %v", test.url, err)
+		}
+		_ = rsp.Body.Close()
+
+		if servedBy := rsp.Header.Get("X-Served-By"); servedBy != test.servedBy {
+			t.Errorf("expected %s to be served by %s, got %s", test.url, test.servedBy, servedBy)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requested) != 2 ||
+		requested[0] != "social.example.org" ||
+		requested[1] != "127.0.0.2:"+port {
+		t.Errorf("unexpected proxied hosts: %v", requested)
+	}
+}
+
+func TestHTTPClientHTTPProxyOnPrivateAddress(t *testing.T) {
+	proxySrv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer proxySrv.Close()
+
+	// Without any allowed ranges, the proxy (on loopback)
+	// should still be dialed, though loopback IP literal
+	// hosts should still be blocked from being proxied.
+	client := httpclient.New(httpclient.Config{
+		Proxy: proxySrv.URL,
+	})
+
+	r, _ := http.NewRequest(http.MethodGet, "http://social.example.org/", nil)
+	rsp, _, err := client.DoOnce(httpclient.WrapRequest(r))
+	if err != nil {
+		t.Fatalf("unexpected error requesting through proxy: %v", err)
+	}
+	_ = rsp.Body.Close()
+
+	for _, url := range privateIPs {
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+		_, _, err := client.DoOnce(httpclient.WrapRequest(r))
+		if !errors.Is(err, httpclient.ErrReservedAddr) {
+			t.Errorf("expected reserved address error requesting %s, got: %v", url, err)
+		}
+	}
+}
@@ -37,6 +37,9 @@ type Request struct {
 	// Current backoff dur.
 	backoff time.Duration
 
+	// Starting backoff dur.
+	base time.Duration
+
 	// Delivery attempts.
 	attempts uint
 
@@ -70,7 +73,11 @@ func (r *Request) BackOff() time.Duration {
 	if r.backoff <= 0 {
 		// No backoff dur found, set our predefined
 		// backoff according to a multiplier of 2^n.
-		r.backoff = baseBackoff * 1 << (r.attempts + 1)
+		base := r.base
+		if base <= 0 {
+			base = baseBackoff
+		}
+		r.backoff = base * 1 << (r.attempts + 1)
 	}
 	return r.backoff
 }
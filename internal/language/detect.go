@@ -0,0 +1,267 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package language
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Detect makes a best guess at the language of the given
+// plaintext, returning an ISO 639-1 language code, or an
+// empty string if it can't tell with reasonable confidence.
+//
+// Mentions, hashtags, emoji shortcodes and URLs are ignored.
+//
+// This is deliberately lightweight: languages with their
+// own script are recognized by script alone, and the most
+// common languages written in Latin or Cyrillic script are
+// told apart by counting common words. Anything else, or
+// text too short to tell, gives an empty string, so callers
+// should fall back to some sensible default.
+func Detect(text string) string {
+	words := detectWords(text)
+	if len(words) == 0 {
+		return ""
+	}
+
+	// Count letters in each script.
+	var (
+		counts = make(map[*unicode.RangeTable]int, len(detectScripts))
+		total  int
+	)
+
+	for _, word := range words {
+		for _, r := range word {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+
+			total++
+			for _, s := range detectScripts {
+				if unicode.Is(s, r) {
+					counts[s]++
+					break
+				}
+			}
+		}
+	}
+
+	// Japanese mixes kanji with kana,
+	// and often contains more kanji,
+	// so consider it as one script.
+	counts[unicode.Han] += counts[unicode.Hiragana] + counts[unicode.Katakana]
+
+	// Find which script most
+	// letters are written in.
+	var (
+		script *unicode.RangeTable
+		most   int
+	)
+
+	for _, s := range detectScripts {
+		if counts[s] > most {
+			script, most = s, counts[s]
+		}
+	}
+
+	// Be careful with mixed
+	// script texts: only go
+	// with a clear majority.
+	if most*2 <= total {
+		return ""
+	}
+
+	switch script {
+	case unicode.Han:
+		// Any meaningful amount of
+		// kana means it's Japanese,
+		// otherwise it's Chinese.
+		kana := counts[unicode.Hiragana] + counts[unicode.Katakana]
+		if kana*10 >= most {
+			return "ja"
+		}
+		return "zh"
+
+	case unicode.Arabic:
+		// Persian and Urdu add letters
+		// to the Arabic alphabet, so
+		// those give them away.
+		switch {
+		case strings.ContainsAny(text, "ٹڈڑںے"):
+			return "ur"
+		case strings.ContainsAny(text, "پچژگ"):
+			return "fa"
+		default:
+			return "ar"
+		}
+
+	case unicode.Latin:
+		return detectByWords(words, latinWords)
+
+	case unicode.Cyrillic:
+		return detectByWords(words, cyrillicWords)
+
+	default:
+		return scriptLangs[script]
+	}
+}
+
+// detectWords splits the given text into lower-cased
+// words, skipping mentions, hashtags, emoji shortcodes
+// and URLs, which don't tell us anything about language.
+func detectWords(text string) []string {
+	var words []string
+
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "@") ||
+			strings.HasPrefix(field, "#") ||
+			strings.HasPrefix(field, ":") ||
+			strings.HasPrefix(field, "www.") ||
+			strings.Contains(field, "://") {
+			continue
+		}
+
+		// Split further on anything that isn't a
+		// letter, eg., punctuation and apostrophes.
+		for _, word := range strings.FieldsFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r)
+		}) {
+			words = append(words, strings.ToLower(word))
+		}
+	}
+
+	return words
+}
+
+// detectByWords returns the language whose common words
+// make up the largest part of the given words, as long
+// as they make up a reasonable part of the text, and
+// clearly more than those of any other language.
+func detectByWords(words []string, langWords map[string]map[string]struct{}) string {
+	var (
+		best       string
+		bestScore  int
+		secondBest int
+	)
+
+	for lang, common := range langWords {
+		var score int
+		for _, word := range words {
+			if _, ok := common[word]; ok {
+				score++
+			}
+		}
+
+		switch {
+		case score > bestScore:
+			best, bestScore, secondBest = lang, score, bestScore
+		case score > secondBest:
+			secondBest = score
+		}
+	}
+
+	if bestScore < 2 || // too few to tell
+		bestScore*5 < len(words) || // not a good fit
+		bestScore*2 < secondBest*3 { // too close to call
+		return ""
+	}
+
+	return best
+}
+
+// detectScripts are the scripts
+// recognized by Detect, checked
+// against each letter in order.
+var detectScripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Cyrillic,
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Katakana,
+	unicode.Hangul,
+	unicode.Arabic,
+	unicode.Hebrew,
+	unicode.Greek,
+	unicode.Thai,
+	unicode.Devanagari,
+	unicode.Bengali,
+	unicode.Tamil,
+	unicode.Armenian,
+	unicode.Georgian,
+}
+
+// scriptLangs maps scripts used (mostly)
+// by one language to that language.
+var scriptLangs = map[*unicode.RangeTable]string{
+	unicode.Hangul:     "ko",
+	unicode.Hebrew:     "he",
+	unicode.Greek:      "el",
+	unicode.Thai:       "th",
+	unicode.Devanagari: "hi",
+	unicode.Bengali:    "bn",
+	unicode.Tamil:      "ta",
+	unicode.Armenian:   "hy",
+	unicode.Georgian:   "ka",
+}
+
+// latinWords contains some of the most common
+// words of languages written in Latin script,
+// preferring words that aren't common in the
+// other languages too.
+var latinWords = wordSets(map[string]string{
+	"en": "the and is are was were of to that it this with for you not have be on what but they just my from about will would can there",
+	"de": "der die das und ist nicht ich du es ein eine mit auf für den dem sich auch wie aber noch wir sie war wenn nur bei oder mir mich schon",
+	"fr": "le la les et est un une des du que qui pas pour dans ce cette sur avec je tu il nous vous mais au aux ne très être",
+	"es": "el los las y es una del por con para pero como más muy está este esta yo lo se su también hay qué sí porque cuando todo",
+	"it": "il lo gli e è un una che di del della per con non sono ma anche questo questa mi ti ci perché più molto come io hai ho",
+	"pt": "o os as e é um uma que do da dos das em no na não para com por mas muito você eu ele ela isso está também são mais",
+	"nl": "de het een en is van niet dat die op te ik je zijn met voor maar ook wel er dit nog naar heb wat als kan bij om geen",
+	"sv": "och är att det som en ett på av för med inte jag du har till den om men så vi var kan här eller från sig mycket också vad",
+	"da": "og er at det som en et på af for med ikke jeg du har til den om men så vi var kan her eller fra sig meget også hvad",
+	"nb": "og er at det som en et på av for med ikke jeg du har til den om men så vi var kan her eller fra seg veldig også hva",
+	"pl": "i w z na się nie to jest że do jak ale co tak już mnie jestem czy tylko jego dla przez po od są bardzo",
+	"cs": "a je se na že to v s z do jak ale co tak už jsem jsou není by pro jako když také mě být velmi",
+	"fi": "ja on ei se että oli ovat mutta kun niin kuin minä sinä hän me te he tämä joka myös vain nyt olen jos mitä",
+	"tr": "ve bir bu da de için ile çok ne ama gibi daha var yok ben sen o mi mı değil olarak kadar şey her",
+	"id": "dan yang di ini itu dengan untuk tidak ada dari saya aku kamu akan juga ke sudah bisa atau karena tapi kita mereka",
+})
+
+// cyrillicWords contains some of the most
+// common words of languages written in
+// Cyrillic script, as for latinWords.
+var cyrillicWords = wordSets(map[string]string{
+	"ru": "и в не на я что он с как это но а по так все она его только мне было вот от меня ещё нет о из ему когда даже ну вы ты мы они бы уже если очень",
+	"uk": "і в не на я що він з як це але а по так все вона його тільки мені було від мене ще ні й та ми ви ти вони б вже якщо дуже",
+	"bg": "и в не на аз че той с като това но а по така всички тя само ми беше от ме още няма за да се ще ли много сме сте си",
+})
+
+// wordSets converts the given space-separated
+// word lists into sets of words per language.
+func wordSets(lists map[string]string) map[string]map[string]struct{} {
+	sets := make(map[string]map[string]struct{}, len(lists))
+	for lang, list := range lists {
+		words := strings.Fields(list)
+		set := make(map[string]struct{}, len(words))
+		for _, word := range words {
+			set[word] = struct{}{}
+		}
+		sets[lang] = set
+	}
+	return sets
+}
@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package language_test
+
+import (
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/language"
+)
+
+func TestDetect(t *testing.T) {
+	for _, test := range []struct {
+		text     string
+		expected string
+	}{
+		// Too short or nothing to go on.
+		{text: "", expected: ""},
+		{text: "hello everyone!", expected: ""},
+		{text: "@someone@example.org #hashtag https://example.org/the/and/is :blobcat:", expected: ""},
+
+		// Latin script.
+		{text: "This is a post about the weather, and it was nice today.", expected: "en"},
+		{text: "Das ist ein Beitrag über das Wetter, und es war heute schön.", expected: "de"},
+		{text: "C'est un message sur la météo, et il faisait beau aujourd'hui.", expected: "fr"},
+		{text: "Esta es una publicación sobre el tiempo, y hoy hizo muy bueno.", expected: "es"},
+		{text: "Questo è un post sul tempo, e oggi era molto bello.", expected: "it"},
+		{text: "Dit is een bericht over het weer, en het was vandaag mooi.", expected: "nl"},
+		{text: "To jest post o pogodzie i dzisiaj było bardzo ładnie, ale już nie jest.", expected: "pl"},
+		{text: "Det här är ett inlägg om vädret, och det var fint idag.", expected: "sv"},
+
+		// Mentions, hashtags and links don't count.
+		{text: "@zork@example.org das ist nicht the #weather https://example.org und es war schön", expected: "de"},
+
+		// Other scripts.
+		{text: "Это пост о погоде, и сегодня было очень хорошо.", expected: "ru"},
+		{text: "Це допис про погоду, і сьогодні було дуже добре.", expected: "uk"},
+		{text: "今日はとても良い天気でした。", expected: "ja"},
+		{text: "今天天气很好。", expected: "zh"},
+		{text: "오늘 날씨가 정말 좋았어요.", expected: "ko"},
+		{text: "كان الطقس جميلا اليوم", expected: "ar"},
+		{text: "امروز هوا خیلی خوب بود و ما به پارک رفتیم", expected: "fa"},
+		{text: "Σήμερα ο καιρός ήταν πολύ ωραίος.", expected: "el"},
+		{text: "היום מזג האוויר היה יפה מאוד.", expected: "he"},
+	} {
+		if got := language.Detect(test.text); got != test.expected {
+			t.Errorf("detecting %q: got %q, wanted %q", test.text, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// timeout is the maximum time to wait
+// for any single operation against the
+// directory server, including dialing.
+const timeout = 10 * time.Second
+
+var (
+	// ErrUserNotFound is returned by Authenticate when
+	// no directory entry matched the given login.
+	ErrUserNotFound = errors.New("user not found in directory")
+
+	// ErrInvalidCredentials is returned by Authenticate
+	// when a directory entry matched the given login,
+	// but the password given for it was incorrect.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Entry contains the attributes
+// of a directory user relevant
+// to logging in to GoToSocial.
+type Entry struct {
+	DN       string
+	Username string
+	Email    string
+}
+
+// Authenticator contains logic for checking a
+// login + password against an LDAP directory.
+type Authenticator interface {
+	// Authenticate searches the directory for an entry matching
+	// the given login (username or email address, depending on the
+	// configured user filter), and then attempts to bind as that
+	// entry using the given password. On success, the mapped
+	// attributes of the entry are returned.
+	//
+	// Returns ErrUserNotFound if no single entry matched the login,
+	// or ErrInvalidCredentials if the password was incorrect.
+	Authenticate(ctx context.Context, login string, password string) (*Entry, error)
+}
+
+type authenticator struct {
+	url          string
+	tlsConfig    *tls.Config
+	startTLS     bool
+	bindDN       string
+	bindPassword string
+	baseDN       string
+	userFilter   string
+	usernameAttr string
+	emailAttr    string
+}
+
+// NewAuthenticator returns a new
+// Authenticator configured with
+// the given config.
+func NewAuthenticator() (Authenticator, error) {
+	// validate config fields
+	rawURL := config.GetLDAPURL()
+	if rawURL == "" {
+		return nil, fmt.Errorf("not set: URL")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %s: %w", rawURL, err)
+	}
+
+	if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		return nil, fmt.Errorf("invalid URL %s: scheme must be ldap or ldaps", rawURL)
+	}
+
+	startTLS := config.GetLDAPStartTLS()
+	if startTLS && u.Scheme == "ldaps" {
+		return nil, fmt.Errorf("StartTLS cannot be used with an ldaps URL")
+	}
+
+	baseDN := config.GetLDAPBaseDN()
+	if baseDN == "" {
+		return nil, fmt.Errorf("not set: BaseDN")
+	}
+
+	userFilter := config.GetLDAPUserFilter()
+	if !strings.Contains(userFilter, "{username}") {
+		return nil, fmt.Errorf("UserFilter %s does not contain {username} placeholder", userFilter)
+	}
+
+	usernameAttr := config.GetLDAPUsernameAttribute()
+	if usernameAttr == "" {
+		return nil, fmt.Errorf("not set: UsernameAttribute")
+	}
+
+	emailAttr := config.GetLDAPEmailAttribute()
+	if emailAttr == "" {
+		return nil, fmt.Errorf("not set: EmailAttribute")
+	}
+
+	return &authenticator{
+		url:          rawURL,
+		tlsConfig:    &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12},
+		startTLS:     startTLS,
+		bindDN:       config.GetLDAPBindDN(),
+		bindPassword: config.GetLDAPBindPassword(),
+		baseDN:       baseDN,
+		userFilter:   userFilter,
+		usernameAttr: usernameAttr,
+		emailAttr:    emailAttr,
+	}, nil
+}
+
+func (a *authenticator) Authenticate(ctx context.Context, login string, password string) (*Entry, error) {
+	if login == "" || password == "" {
+		// An empty password would result
+		// in an "unauthenticated" bind, which
+		// many servers treat as a success.
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := a.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Bind as the service account (if
+	// configured) in order to search
+	// for the entry of the logging-in user.
+	if a.bindDN != "" {
+		if err := conn.Bind(a.bindDN, a.bindPassword); err != nil {
+			return nil, gtserror.Newf("error binding as %s: %w", a.bindDN, err)
+		}
+	}
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.baseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2, // We only care whether there's exactly one.
+		int(timeout/time.Second),
+		false,
+		userFilter(a.userFilter, login),
+		[]string{"dn", a.usernameAttr, a.emailAttr},
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, gtserror.Newf("error searching for %s: %w", login, err)
+	}
+
+	if res == nil || len(res.Entries) != 1 {
+		// Either no matches, or
+		// ambiguous login, treat
+		// both as not found.
+		return nil, ErrUserNotFound
+	}
+
+	dirEntry := res.Entries[0]
+
+	// Now bind as the user to check their password.
+	if err := conn.Bind(dirEntry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, gtserror.Newf("error binding as %s: %w", dirEntry.DN, err)
+	}
+
+	return &Entry{
+		DN:       dirEntry.DN,
+		Username: dirEntry.GetAttributeValue(a.usernameAttr),
+		Email:    dirEntry.GetAttributeValue(a.emailAttr),
+	}, nil
+}
+
+// connect dials the directory
+// server, upgrading the connection
+// with StartTLS if configured.
+func (a *authenticator) connect() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(a.url,
+		ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldap.DialWithTLSConfig(a.tlsConfig),
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error dialing %s: %w", a.url, err)
+	}
+
+	conn.SetTimeout(timeout)
+
+	if a.startTLS {
+		if err := conn.StartTLS(a.tlsConfig); err != nil {
+			conn.Close()
+			return nil, gtserror.Newf("error doing StartTLS with %s: %w", a.url, err)
+		}
+	}
+
+	return conn, nil
+}
+
+// userFilter returns the given filter template
+// with each {username} placeholder replaced with
+// the given login, escaped for use in a filter.
+func userFilter(template string, login string) string {
+	return strings.ReplaceAll(template, "{username}", ldap.EscapeFilter(login))
+}
@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ldap
+
+import (
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func TestUserFilter(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		template string
+		login    string
+		expected string
+	}{
+		{name: "username", template: "(uid={username})", login: "zork", expected: "(uid=zork)"},
+		{name: "multiple placeholders", template: "(|(uid={username})(mail={username}))", login: "zork@example.org", expected: "(|(uid=zork@example.org)(mail=zork@example.org))"},
+		{name: "wildcard", template: "(uid={username})", login: "*", expected: `(uid=\2a)`},
+		{name: "injection", template: "(uid={username})", login: "zork)(uid=*", expected: `(uid=zork\29\28uid=\2a)`},
+	} {
+		test := test // loopvar capture
+		t.Run(test.name, func(t *testing.T) {
+			if got := userFilter(test.template, test.login); got != test.expected {
+				t.Fatalf("got: %s, wanted: %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		url         string
+		startTLS    bool
+		baseDN      string
+		userFilter  string
+		expectedErr string
+	}{
+		{name: "ok", url: "ldaps://ldap.example.org", baseDN: "dc=example,dc=org", userFilter: "(uid={username})"},
+		{name: "ok starttls", url: "ldap://ldap.example.org", startTLS: true, baseDN: "dc=example,dc=org", userFilter: "(uid={username})"},
+		{name: "no url", baseDN: "dc=example,dc=org", userFilter: "(uid={username})", expectedErr: "not set: URL"},
+		{name: "bad scheme", url: "https://ldap.example.org", baseDN: "dc=example,dc=org", userFilter: "(uid={username})", expectedErr: "invalid URL https://ldap.example.org: scheme must be ldap or ldaps"},
+		{name: "starttls with ldaps", url: "ldaps://ldap.example.org", startTLS: true, baseDN: "dc=example,dc=org", userFilter: "(uid={username})", expectedErr: "StartTLS cannot be used with an ldaps URL"},
+		{name: "no base dn", url: "ldaps://ldap.example.org", userFilter: "(uid={username})", expectedErr: "not set: BaseDN"},
+		{name: "no placeholder", url: "ldaps://ldap.example.org", baseDN: "dc=example,dc=org", userFilter: "(uid=zork)", expectedErr: "UserFilter (uid=zork) does not contain {username} placeholder"},
+	} {
+		test := test // loopvar capture
+		t.Run(test.name, func(t *testing.T) {
+			testrig.InitTestConfig()
+			config.SetLDAPURL(test.url)
+			config.SetLDAPStartTLS(test.startTLS)
+			config.SetLDAPBaseDN(test.baseDN)
+			config.SetLDAPUserFilter(test.userFilter)
+
+			_, err := NewAuthenticator()
+			switch {
+			case test.expectedErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case test.expectedErr != "" && err == nil:
+				t.Fatalf("expected error %s, got nil", test.expectedErr)
+			case test.expectedErr != "" && err.Error() != test.expectedErr:
+				t.Fatalf("got error: %s, wanted: %s", err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// rotatedTimeFormat is the time format appended
+// to the path of rotated log files, chosen so that
+// rotated files sort lexically in order of age.
+const rotatedTimeFormat = "2006-01-02T15-04-05.000"
+
+// fileWriter is an io.Writer to a log file, rotating
+// the file once it has reached a maximum size or age,
+// and removing the oldest rotated files on rotation.
+type fileWriter struct {
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	file   *os.File
+	size   int64
+	opened time.Time
+	mutex  sync.Mutex
+}
+
+// openFileWriter opens the log file at path for appending,
+// rotating it once larger than maxSize bytes or older than
+// maxAge, keeping at most maxBackups rotated files. Zero values
+// for any of maxSize, maxAge, maxBackups disable that limit.
+func openFileWriter(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*fileWriter, error) {
+	w := &fileWriter{
+		path:       path,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		maxBackups: maxBackups,
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write implements io.Writer, rotating
+// the log file before writing if required.
+func (w *fileWriter) Write(b []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.needsRotate(len(b)) {
+		if err := w.rotate(); err != nil {
+			// Nowhere else to report
+			// this, so note on stderr.
+			_, _ = os.Stderr.WriteString(
+				"error rotating log file: " +
+					err.Error() + "\n",
+			)
+		}
+	}
+
+	if w.file == nil {
+		// Failed
+		// reopening.
+		return 0, os.ErrClosed
+	}
+
+	n, err := w.file.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes the underlying log file.
+func (w *fileWriter) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// needsRotate returns whether the log file should be
+// rotated before writing another n bytes to it. Note
+// that an empty file is never rotated for size, as
+// otherwise a single large entry could rotate forever.
+func (w *fileWriter) needsRotate(n int) bool {
+	if w.maxSize > 0 && w.size > 0 &&
+		w.size+int64(n) > w.maxSize {
+		return true
+	}
+	if w.maxAge > 0 && w.size > 0 &&
+		time.Since(w.opened) > w.maxAge {
+		return true
+	}
+	return false
+}
+
+// open opens (creating if necessary) the log
+// file for appending, and records its size.
+func (w *fileWriter) open() error {
+	file, err := os.OpenFile(w.path,
+		os.O_CREATE|os.O_WRONLY|os.O_APPEND,
+		0o640,
+	)
+	if err != nil {
+		return err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	w.file = file
+	w.size = stat.Size()
+	w.opened = time.Now()
+	return nil
+}
+
+// rotate closes and renames the current log file
+// with a timestamp suffix, opens a new log file in
+// its place, then prunes any excess rotated files.
+func (w *fileWriter) rotate() error {
+	if w.file != nil {
+		_ = w.file.Close()
+		w.file = nil
+	}
+
+	rotated := w.path + "." + time.Now().Format(rotatedTimeFormat)
+	if err := os.Rename(w.path, rotated); err != nil {
+		return err
+	}
+
+	if err := w.open(); err != nil {
+		return err
+	}
+
+	return w.prune()
+}
+
+// prune removes the oldest rotated log
+// files in excess of maxBackups, if set.
+func (w *fileWriter) prune() error {
+	if w.maxBackups <= 0 {
+		return nil
+	}
+
+	rotated, err := filepath.Glob(w.path + ".????-??-??T??-??-??.???")
+	if err != nil {
+		return err
+	}
+
+	if len(rotated) <= w.maxBackups {
+		return nil
+	}
+
+	// Timestamp suffixes sort
+	// oldest -> newest.
+	slices.Sort(rotated)
+
+	for _, path := range rotated[:len(rotated)-w.maxBackups] {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
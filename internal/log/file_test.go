@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileWriterRotateSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gotosocial.log")
+
+	w, err := openFileWriter(path, 16, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// Each line is 10 bytes so every write after
+	// the first should rotate the current file.
+	for _, line := range []string{
+		"line one.\n",
+		"line two.\n",
+		"line 333.\n",
+		"line 444.\n",
+	} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+
+		// Ensure distinct rotated names.
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "line 444.\n" {
+		t.Fatalf("unexpected log file contents: %q", b)
+	}
+
+	rotated, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rotated) != 2 {
+		t.Fatalf("expected 2 rotated files, got %v", rotated)
+	}
+
+	// Oldest rotated file should have been pruned.
+	for _, path := range rotated {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "line one.") {
+			t.Fatalf("expected oldest rotated file %s to be pruned", path)
+		}
+	}
+}
+
+func TestFileWriterRotateAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gotosocial.log")
+
+	w, err := openFileWriter(path, 0, time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	if _, err := w.Write([]byte("old\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pretend file was opened long ago.
+	w.opened = time.Now().Add(-2 * time.Hour)
+
+	if _, err := w.Write([]byte("new\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "new\n" {
+		t.Fatalf("unexpected log file contents: %q", b)
+	}
+
+	rotated, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rotated) != 1 {
+		t.Fatalf("expected 1 rotated file, got %v", rotated)
+	}
+}
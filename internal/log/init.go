@@ -21,6 +21,7 @@
 	"fmt"
 	"log/syslog"
 	"strings"
+	"time"
 )
 
 // ParseLevel will parse the log level from given string and set to appropriate LEVEL.
@@ -44,6 +45,19 @@ func ParseLevel(str string) error {
 	return nil
 }
 
+// ParseFormat will parse the log format from given string and set it.
+func ParseFormat(str string) error {
+	switch strings.ToLower(str) {
+	case "", "logfmt":
+		jsonfmt = false
+	case "json":
+		jsonfmt = true
+	default:
+		return fmt.Errorf("unknown log format: %q", str)
+	}
+	return nil
+}
+
 // EnableSyslog will enabling logging to the syslog at given address.
 func EnableSyslog(proto, addr string) error {
 	// Dial a connection to the syslog daemon
@@ -57,3 +71,19 @@ func EnableSyslog(proto, addr string) error {
 
 	return nil
 }
+
+// EnableFile will enable logging to the file at given path, rotating it once
+// larger than maxSize bytes or older than maxAge, keeping at most maxBackups
+// rotated files. Zero values for maxSize, maxAge, maxBackups disable that limit.
+func EnableFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) error {
+	// Open the log file for appending
+	writer, err := openFileWriter(path, maxSize, maxAge, maxBackups)
+	if err != nil {
+		return err
+	}
+
+	// Set the file writer
+	fileout = writer
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"codeberg.org/gruf/go-byteutil"
+	"codeberg.org/gruf/go-kv"
+)
+
+// appendJSON appends a log entry as a single-line JSON object
+// to buf, with the timestamp (if enabled), caller, level (if
+// non-empty) and fields as keys, in that order.
+func appendJSON(buf *byteutil.Buffer, now time.Time, caller string, lvl string, fields []kv.Field) {
+	buf.B = append(buf.B, '{')
+
+	if jsontimefmt != "" {
+		buf.B = append(buf.B, `"timestamp":"`...)
+		buf.B = now.AppendFormat(buf.B, jsontimefmt)
+		buf.B = append(buf.B, `",`...)
+	}
+
+	buf.B = append(buf.B, `"func":`...)
+	buf.B = appendJSONString(buf.B, caller)
+
+	if lvl != "" {
+		buf.B = append(buf.B, `,"level":`...)
+		buf.B = appendJSONString(buf.B, lvl)
+	}
+
+	for _, field := range fields {
+		buf.B = append(buf.B, ',')
+		buf.B = appendJSONString(buf.B, field.K)
+		buf.B = append(buf.B, ':')
+		buf.B = appendJSONValue(buf.B, field.V)
+	}
+
+	buf.B = append(buf.B, '}', '\n')
+}
+
+// appendJSONValue appends v to b as a JSON value, keeping
+// booleans and numbers as-is and formatting all else as
+// strings, as done for logfmt field values.
+func appendJSONValue(b []byte, v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(b, `null`...)
+	case string:
+		return appendJSONString(b, v)
+	case error:
+		return appendJSONString(b, v.Error())
+	case bool:
+		return strconv.AppendBool(b, v)
+	case int:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int8:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int16:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int32:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int64:
+		return strconv.AppendInt(b, v, 10)
+	case uint:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint8:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint16:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint32:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint64:
+		return strconv.AppendUint(b, v, 10)
+	case float32:
+		return appendJSONFloat(b, float64(v), 32)
+	case float64:
+		return appendJSONFloat(b, v, 64)
+	default:
+		return appendJSONString(b, VarDump(v))
+	}
+}
+
+// appendJSONFloat appends f to b, as a string
+// if not representable as a JSON number.
+func appendJSONFloat(b []byte, f float64, bitSize int) []byte {
+	s := strconv.FormatFloat(f, 'g', -1, bitSize)
+	switch s {
+	case "NaN", "+Inf", "-Inf":
+		return appendJSONString(b, s)
+	}
+	return append(b, s...)
+}
+
+// appendJSONString appends s to b as a quoted JSON string,
+// replacing any invalid UTF-8 with the replacement character.
+func appendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				b = append(b, '\\', c)
+			case c == '\n':
+				b = append(b, '\\', 'n')
+			case c == '\r':
+				b = append(b, '\\', 'r')
+			case c == '\t':
+				b = append(b, '\\', 't')
+			case c < 0x20:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			default:
+				b = append(b, c)
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, `\ufffd`...)
+		} else {
+			b = append(b, s[i:i+size]...)
+		}
+		i += size
+	}
+	return append(b, '"')
+}
@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-kv"
+)
+
+func TestAppendJSON(t *testing.T) {
+	buf := getBuf()
+	defer putBuf(buf)
+
+	appendJSON(buf, time.Now(), "log.TestAppendJSON", "INFO", []kv.Field{
+		{K: "count", V: 42},
+		{K: "ok", V: true},
+		{K: "error", V: errors.New("oh no")},
+		{K: "msg", V: "quote \" newline \n invalid \xff"},
+	})
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.B, &entry); err != nil {
+		t.Fatalf("invalid json %q: %v", buf.B, err)
+	}
+
+	for k, v := range map[string]any{
+		"func":  "log.TestAppendJSON",
+		"level": "INFO",
+		"count": float64(42),
+		"ok":    true,
+		"error": "oh no",
+		"msg":   "quote \" newline \n invalid �",
+	} {
+		if entry[k] != v {
+			t.Errorf("expected %s=%v, got %v", k, v, entry[k])
+		}
+	}
+
+	if _, ok := entry["timestamp"]; !ok {
+		t.Error("expected timestamp")
+	}
+}
@@ -25,6 +25,7 @@
 	"strings"
 	"time"
 
+	"codeberg.org/gruf/go-byteutil"
 	"codeberg.org/gruf/go-kv"
 	"github.com/superseriousbusiness/gotosocial/internal/util/xslices"
 )
@@ -48,10 +49,21 @@
 	// syslog output, only set if enabled.
 	sysout *syslog.Writer
 
+	// log file output, only set if enabled.
+	fileout *fileWriter
+
+	// jsonfmt indicates whether to format
+	// log entries as JSON instead of logfmt.
+	jsonfmt bool
+
 	// timefmt is the logging time format used, which includes
 	// the full field and required quoting
 	timefmt = `timestamp="02/01/2006 15:04:05.000" `
 
+	// jsontimefmt is the logging time format
+	// used (without field / quoting) for JSON.
+	jsontimefmt = `02/01/2006 15:04:05.000`
+
 	// ctxhooks allows modifying log content based on context.
 	ctxhooks []func(context.Context, []kv.Field) []kv.Field
 )
@@ -78,6 +90,7 @@ func TimeFormat() string {
 
 // SetTimeFormat sets the timestamp format to the given string.
 func SetTimeFormat(format string) {
+	jsontimefmt = format
 	if format == "" {
 		timefmt = format
 		return
@@ -347,6 +360,20 @@ func printf(depth int, fields []kv.Field, s string, a ...interface{}) {
 	// Acquire buffer
 	buf := getBuf()
 
+	if jsonfmt {
+		if s != "" {
+			// Append args as final 'msg' field.
+			fields = xslices.AppendJust(fields, kv.Field{
+				K: "msg", V: fmt.Sprintf(s, a...),
+			})
+		}
+
+		// Append JSON formatted entry, without level.
+		appendJSON(buf, time.Now(), Caller(depth+1), "", fields)
+		write(INFO, os.Stdout, buf)
+		return
+	}
+
 	// Append formatted timestamp according to `timefmt`
 	buf.B = time.Now().AppendFormat(buf.B, timefmt)
 
@@ -369,14 +396,7 @@ func printf(depth int, fields []kv.Field, s string, a ...interface{}) {
 		buf.B = append(buf.B, '\n')
 	}
 
-	if sysout != nil {
-		// Write log entry to syslog
-		logsys(INFO, buf.String())
-	}
-
-	// Write to log and release
-	_, _ = os.Stdout.Write(buf.B)
-	putBuf(buf)
+	write(INFO, os.Stdout, buf)
 }
 
 //go:noinline
@@ -399,18 +419,24 @@ func logf(ctx context.Context, depth int, lvl LEVEL, fields []kv.Field, s string
 	// Acquire buffer
 	buf := getBuf()
 
-	// Append formatted timestamp according to `timefmt`
-	buf.B = time.Now().AppendFormat(buf.B, timefmt)
+	// Get time + caller before hooks.
+	now := time.Now()
+	caller := Caller(depth + 1)
 
-	// Append formatted caller func
-	buf.B = append(buf.B, `func=`...)
-	buf.B = append(buf.B, Caller(depth+1)...)
-	buf.B = append(buf.B, ' ')
+	if !jsonfmt {
+		// Append formatted timestamp according to `timefmt`
+		buf.B = now.AppendFormat(buf.B, timefmt)
 
-	// Append formatted level string
-	buf.B = append(buf.B, `level=`...)
-	buf.B = append(buf.B, lvlstrs[lvl]...)
-	buf.B = append(buf.B, ' ')
+		// Append formatted caller func
+		buf.B = append(buf.B, `func=`...)
+		buf.B = append(buf.B, caller...)
+		buf.B = append(buf.B, ' ')
+
+		// Append formatted level string
+		buf.B = append(buf.B, `level=`...)
+		buf.B = append(buf.B, lvlstrs[lvl]...)
+		buf.B = append(buf.B, ' ')
+	}
 
 	if ctx != nil && len(ctxhooks) > 0 {
 		// Ensure fields have space for hooks (+1 for below).
@@ -429,6 +455,13 @@ func logf(ctx context.Context, depth int, lvl LEVEL, fields []kv.Field, s string
 		})
 	}
 
+	if jsonfmt {
+		// Append JSON formatted entry to log buffer.
+		appendJSON(buf, now, caller, lvlstrs[lvl], fields)
+		write(lvl, out, buf)
+		return
+	}
+
 	// Append formatted fields to log buffer.
 	kv.Fields(fields).AppendFormat(buf, false)
 
@@ -437,11 +470,22 @@ func logf(ctx context.Context, depth int, lvl LEVEL, fields []kv.Field, s string
 		buf.B = append(buf.B, '\n')
 	}
 
+	write(lvl, out, buf)
+}
+
+// write writes the formatted log entry in buf to out, and to
+// the syslog and log file if enabled, then releases buf.
+func write(lvl LEVEL, out *os.File, buf *byteutil.Buffer) {
 	if sysout != nil {
 		// Write log entry to syslog
 		logsys(lvl, buf.String())
 	}
 
+	if fileout != nil {
+		// Write log entry to file
+		_, _ = fileout.Write(buf.B)
+	}
+
 	// Write to log and release
 	_, _ = out.Write(buf.B)
 	putBuf(buf)
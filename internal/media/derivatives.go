@@ -0,0 +1,220 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// setOriginalMeta sets the original file
+// metadata of media from its probe result.
+func setOriginalMeta(media *gtsmodel.MediaAttachment, result *result) {
+	width, height, framerate := result.ImageMeta()
+	aspect := util.Div(float32(width), float32(height))
+	media.FileMeta.Original.Width = width
+	media.FileMeta.Original.Height = height
+	media.FileMeta.Original.Size = (width * height)
+	media.FileMeta.Original.Aspect = aspect
+	media.FileMeta.Original.Framerate = util.PtrIf(framerate)
+	media.FileMeta.Original.Duration = util.PtrIf(float32(result.duration))
+	media.FileMeta.Original.Bitrate = util.PtrIf(result.bitrate)
+}
+
+// setWaveform generates and sets the waveform of
+// audio media from its file at path. This is purely
+// cosmetic, so on failure it just logs and continues.
+func setWaveform(ctx context.Context, media *gtsmodel.MediaAttachment, filepath string) {
+	waveform, err := generateWaveform(ctx, filepath)
+	if err != nil {
+		log.Warnf(ctx, "error generating waveform: %v", err)
+	}
+	media.FileMeta.Waveform = waveform
+}
+
+// generateMediaThumb generates a thumbnail (with jpeg fallback
+// if needed) for media with given probe result from its file at
+// path, updating the media's small file metadata, thumbnail type
+// and blurhash (if needed). It returns paths of all generated tmp
+// files, which are to be removed by the caller, even on error.
+func generateMediaThumb(
+	ctx context.Context,
+	media *gtsmodel.MediaAttachment,
+	filepath string,
+	result *result,
+	needBlurhash bool,
+) (
+	posterpath string,
+	thumbpath string,
+	fallbackpath string,
+	err error,
+) {
+	// Source media to generate
+	// thumbnail from, by default
+	// the media file itself.
+	thumbsrc := filepath
+	orientation := result.orientation
+
+	if config.GetMediaVideoTranscode() &&
+		(media.Type == gtsmodel.FileTypeVideo ||
+			media.Type == gtsmodel.FileTypeGifv) {
+		// Generate a poster frame from a short way
+		// into video to use as thumbnail source.
+		posterpath, err = generatePoster(ctx,
+			filepath,
+			result.duration,
+		)
+		if err != nil {
+			err = gtserror.Newf("error generating poster frame: %w", err)
+			return
+		}
+
+		// ffmpeg applies any rotation
+		// when decoding the poster frame.
+		thumbsrc = posterpath
+		orientation = orientationUnspecified
+	}
+
+	width := media.FileMeta.Original.Width
+	height := media.FileMeta.Original.Height
+	aspect := media.FileMeta.Original.Aspect
+
+	if width <= 0 || height <= 0 {
+		// Nothing to
+		// thumbnail.
+		return
+	}
+
+	// Determine thumbnail dimens to use.
+	thumbWidth, thumbHeight := thumbSize(
+		width,
+		height,
+		aspect,
+	)
+	media.FileMeta.Small.Width = thumbWidth
+	media.FileMeta.Small.Height = thumbHeight
+	media.FileMeta.Small.Size = (thumbWidth * thumbHeight)
+	media.FileMeta.Small.Aspect = aspect
+
+	var newBlurhash, mimeType string
+
+	// Generate thumbnail, and new blurhash if needed from source media.
+	thumbpath, mimeType, fallbackpath, newBlurhash, err = generateThumb(ctx, thumbsrc,
+		thumbWidth,
+		thumbHeight,
+		orientation,
+		result.PixFmt(),
+		config.GetMediaThumbnailFormat(),
+		needBlurhash,
+	)
+	if err != nil {
+		err = gtserror.Newf("error generating image thumb: %w", err)
+		return
+	}
+
+	// Set generated thumbnail's mimetype.
+	media.Thumbnail.ContentType = mimeType
+
+	if needBlurhash {
+		// Set newly determined blurhash.
+		media.Blurhash = newBlurhash
+	}
+
+	return
+}
+
+// storeMediaThumb copies the generated thumbnail (and fallback, if
+// any) tmp files of media into storage, updating the media's thumbnail
+// path, size and URL details, and fallback details, accordingly.
+func (m *Manager) storeMediaThumb(
+	ctx context.Context,
+	media *gtsmodel.MediaAttachment,
+	thumbpath string,
+	fallbackpath string,
+) error {
+	// Determine final thumbnail ext.
+	thumbExt := getExtension(thumbpath)
+
+	// Calculate final media attachment thumbnail path.
+	media.Thumbnail.Path = uris.StoragePathForAttachment(
+		media.AccountID,
+		string(TypeAttachment),
+		string(SizeSmall),
+		media.ID,
+		thumbExt,
+	)
+
+	// Copy thumbnail file into storage at path.
+	thumbsz, err := m.state.Storage.PutFile(ctx,
+		media.Thumbnail.Path,
+		thumbpath,
+		media.Thumbnail.ContentType,
+	)
+	if err != nil {
+		return gtserror.Newf("error writing thumb to storage: %w", err)
+	}
+
+	// Set final determined thumbnail size.
+	media.Thumbnail.FileSize = int(thumbsz)
+
+	if fallbackpath != "" {
+		// Calculate final media attachment thumbnail fallback path.
+		media.Thumbnail.FallbackPath = uris.StoragePathForAttachment(
+			media.AccountID,
+			string(TypeAttachment),
+			string(SizeSmall),
+			media.ID,
+			getExtension(fallbackpath),
+		)
+
+		// Copy thumbnail fallback file into storage at path.
+		fallbacksz, err := m.state.Storage.PutFile(ctx,
+			media.Thumbnail.FallbackPath,
+			fallbackpath,
+			"image/jpeg",
+		)
+		if err != nil {
+			return gtserror.Newf("error writing thumb fallback to storage: %w", err)
+		}
+
+		// Set final determined thumbnail fallback size.
+		media.Thumbnail.FallbackFileSize = int(fallbacksz)
+	} else {
+		// Ensure no fallback set from
+		// previous processing (recache).
+		media.Thumbnail.FallbackPath = ""
+		media.Thumbnail.FallbackFileSize = 0
+	}
+
+	// Generate a media attachment thumbnail URL.
+	media.Thumbnail.URL = uris.URIForAttachment(
+		media.AccountID,
+		string(TypeAttachment),
+		string(SizeSmall),
+		media.ID,
+		thumbExt,
+	)
+
+	return nil
+}
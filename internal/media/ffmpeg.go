@@ -30,6 +30,7 @@
 
 	_ffmpeg "github.com/superseriousbusiness/gotosocial/internal/media/ffmpeg"
 
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/tetratelabs/wazero"
@@ -63,31 +64,21 @@ func ffmpegClearMetadata(ctx context.Context, outpath, inpath string) error {
 	)
 }
 
-// ffmpegGenerateWebpThumb generates a thumbnail webp from input media of any type, useful for any media.
-func ffmpegGenerateWebpThumb(ctx context.Context, inpath, outpath string, width, height int, pixfmt string) error {
-	// Generate thumb with ffmpeg.
-	return ffmpeg(ctx, inpath, outpath,
-
-		// Only log errors.
-		"-loglevel", "error",
+// ffmpegGenerateThumb generates a thumbnail from input media of any type, useful for any media,
+// encoded in the given thumbnail format (i.e. webp, avif or jpeg). If pixfmt is set, webp thumbs
+// attempt to retain it, avif and jpeg thumbs always use yuv 4:2:0 (i.e. dropping any alpha).
+func ffmpegGenerateThumb(ctx context.Context, inpath, outpath string, width, height int, pixfmt string, format string) error {
+	// Scale to dimensions
+	// (scale filter: https://ffmpeg.org/ffmpeg-filters.html#scale)
+	filter := "scale=" + strconv.Itoa(width) + ":" + strconv.Itoa(height)
 
-		// Input file.
-		"-i", inpath,
+	var codec []string
 
+	switch format {
+	case config.MediaThumbnailFormatWebP:
 		// Encode using libwebp.
 		// (NOT as libwebp_anim).
-		"-codec:v", "libwebp",
-
-		// Only one frame
-		"-frames:v", "1",
-
-		// Scale to dimensions
-		// (scale filter: https://ffmpeg.org/ffmpeg-filters.html#scale)
-		"-filter:v", "scale="+strconv.Itoa(width)+":"+strconv.Itoa(height)+","+
-
-			// Attempt to use original pixel format
-			// (format filter: https://ffmpeg.org/ffmpeg-filters.html#format)
-			"format=pix_fmts="+pixfmt,
+		codec = []string{"-codec:v", "libwebp"}
 
 		// Quality not specified,
 		// i.e. use default which
@@ -96,12 +87,66 @@ func ffmpegGenerateWebpThumb(ctx context.Context, inpath, outpath string, width,
 		// (libwebp codec: https://ffmpeg.org/ffmpeg-codecs.html#Options-36)
 		// "-qscale:v", "75",
 
+		if pixfmt != "" {
+			// Attempt to use original pixel format
+			// (format filter: https://ffmpeg.org/ffmpeg-filters.html#format)
+			filter += ",format=pix_fmts=" + pixfmt
+		}
+
+	case config.MediaThumbnailFormatAVIF:
+		// Encode using libaom as a single still
+		// picture, in constant quality mode, at
+		// a speed suitable for on-the-fly use.
+		// (libaom codec: https://ffmpeg.org/ffmpeg-codecs.html#libaom_002dav1)
+		codec = []string{
+			"-codec:v", "libaom-av1",
+			"-still-picture", "1",
+			"-crf", "32",
+			"-b:v", "0",
+			"-cpu-used", "6",
+		}
+		filter += ",format=pix_fmts=yuv420p"
+
+	case config.MediaThumbnailFormatJPEG:
+		// Encode using mjpeg, at roughly
+		// the same quality as our natively
+		// encoded (i.e. 75% quality) jpegs.
+		codec = []string{
+			"-codec:v", "mjpeg",
+			"-qscale:v", "4",
+		}
+		filter += ",format=pix_fmts=yuvj420p"
+
+	default:
+		return gtserror.Newf("unsupported thumbnail format: %s", format)
+	}
+
+	args := []string{
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Input file.
+		"-i", inpath,
+	}
+
+	// Append encoding args.
+	args = append(args, codec...)
+	args = append(args,
+		// Only one frame
+		"-frames:v", "1",
+
+		// Scale (+ format).
+		"-filter:v", filter,
+
 		// Overwrite.
 		"-y",
 
 		// Output.
 		outpath,
 	)
+
+	// Generate thumb with ffmpeg.
+	return ffmpeg(ctx, inpath, outpath, args...)
 }
 
 // ffmpegGenerateStatic generates a static png from input image of any type, useful for emoji.
@@ -143,8 +188,147 @@ func ffmpegGenerateStatic(ctx context.Context, inpath string) (string, error) {
 	return outpath, nil
 }
 
+// ffmpegGeneratePoster generates a png poster frame from input video, taken from the given
+// offset in seconds into the video rather than the (often black / title card) first frame.
+func ffmpegGeneratePoster(ctx context.Context, inpath, outpath string, offset float64) error {
+	return ffmpeg(ctx, inpath, outpath,
+
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Seek to offset. Placed before
+		// input this performs a fast seek
+		// to nearest keyframe before offset,
+		// then decodes up to exact offset.
+		"-ss", strconv.FormatFloat(offset, 'f', 3, 64),
+
+		// Input file.
+		"-i", inpath,
+
+		// Only one frame.
+		"-frames:v", "1",
+
+		// Encode using png.
+		"-codec:v", "png",
+
+		// Overwrite.
+		"-y",
+
+		// Output.
+		outpath,
+	)
+}
+
+// ffmpegTranscodeVideo transcodes input video to a web-safe mp4 rendition, i.e. H.264 video
+// in yuv 4:2:0 with AAC audio, with the moov atom at the start of file for progressive playback.
+// The video stream bitrate is capped at maxBitrate (kbit/s), and video is scaled down so that its
+// shorter side is at most maxHeight pixels, preserving aspect ratio.
+func ffmpegTranscodeVideo(ctx context.Context, inpath, outpath string, maxBitrate, maxHeight int) error {
+	h := strconv.Itoa(maxHeight)
+
+	// Scale shorter side down to max height, never
+	// scaling up, and keeping both dimensions even
+	// as required by yuv420p chroma subsampling.
+	// (scale filter: https://ffmpeg.org/ffmpeg-filters.html#scale)
+	filter := "scale=" +
+		"'if(gt(iw,ih),-2,trunc(min(iw," + h + ")/2)*2)':" +
+		"'if(gt(iw,ih),trunc(min(ih," + h + ")/2)*2,-2)'," +
+		"format=pix_fmts=yuv420p"
+
+	// Bitrate cap, with buffer size of 2s at max
+	// bitrate, so short complex scenes can burst.
+	maxrate := strconv.Itoa(maxBitrate) + "k"
+	bufsize := strconv.Itoa(2*maxBitrate) + "k"
+
+	return ffmpeg(ctx, inpath, outpath,
+
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Input file.
+		"-i", inpath,
+
+		// Only keep first video and (if
+		// any) first audio stream, drop
+		// subtitles, data, metadata etc.
+		"-map", "0:v:0",
+		"-map", "0:a:0?",
+		"-map_metadata", "-1",
+
+		// Encode using libx264 in constant quality
+		// mode, capped by VBV to the max bitrate.
+		// (libx264 codec: https://ffmpeg.org/ffmpeg-codecs.html#libx264_002c-libx264rgb)
+		"-codec:v", "libx264",
+		"-preset", "veryfast",
+		"-profile:v", "high",
+		"-crf", "23",
+		"-maxrate", maxrate,
+		"-bufsize", bufsize,
+
+		// Scale + format.
+		"-filter:v", filter,
+
+		// Encode audio using the native
+		// aac encoder at a sensible bitrate.
+		"-codec:a", "aac",
+		"-b:a", "128k",
+
+		// Move the moov atom to the
+		// start of the file, so playback
+		// can start before fully loaded.
+		"-movflags", "+faststart",
+
+		// Output as mp4.
+		"-f", "mp4",
+
+		// Overwrite.
+		"-y",
+
+		// Output.
+		outpath,
+	)
+}
+
+// ffmpegDecodeAudio decodes the first audio stream of input media to raw
+// mono, signed 16-bit little-endian PCM at given sample rate, for analysis.
+func ffmpegDecodeAudio(ctx context.Context, inpath, outpath string, rate int) error {
+	return ffmpeg(ctx, inpath, outpath,
+
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Input file.
+		"-i", inpath,
+
+		// Only first audio stream.
+		"-map", "0:a:0",
+
+		// Downmix to mono, and
+		// resample to given rate.
+		"-ac", "1",
+		"-ar", strconv.Itoa(rate),
+
+		// Output raw s16le PCM.
+		"-codec:a", "pcm_s16le",
+		"-f", "s16le",
+
+		// Overwrite.
+		"-y",
+
+		// Output.
+		outpath,
+	)
+}
+
 // ffmpeg calls `ffmpeg [args...]` (WASM) with in + out paths mounted in runtime.
 func ffmpeg(ctx context.Context, inpath string, outpath string, args ...string) error {
+	// Ensure an empty output file exists, as below it
+	// is mounted without O_TRUNC so it can be reopened
+	// mid-run without data loss, e.g. by mp4 faststart.
+	if err := os.WriteFile(outpath, nil, 0666); err != nil {
+		return gtserror.Newf("error creating output: %w", err)
+	}
+
 	var stderr byteutil.Buffer
 	rc, err := _ffmpeg.Ffmpeg(ctx, _ffmpeg.Args{
 		Stderr: &stderr,
@@ -175,7 +359,7 @@ func ffmpeg(ctx context.Context, inpath string, outpath string, args ...string)
 				},
 				{
 					abs:  outpath,
-					flag: os.O_RDWR | os.O_CREATE | os.O_TRUNC,
+					flag: os.O_RDWR,
 					perm: 0666,
 				},
 			}
@@ -504,6 +688,37 @@ func (res *result) PixFmt() string {
 	return ""
 }
 
+// WebSafe returns whether the result is a video that
+// can already be played back by practically all browsers
+// and clients, i.e. an mp4 of H.264 (yuv420p) video, with
+// optional AAC audio, within the given bitrate (kbit/s)
+// and shorter side pixel size limits.
+func (res *result) WebSafe(maxBitrate, maxHeight int) bool {
+	if res.format != "mov,mp4,m4a,3gp,3g2,mj2" ||
+		len(res.video) != 1 || len(res.audio) > 1 {
+		return false
+	}
+
+	if res.video[0].codec != "h264" ||
+		res.video[0].pixfmt != "yuv420p" {
+		return false
+	}
+
+	if len(res.audio) > 0 &&
+		res.audio[0].codec != "aac" {
+		return false
+	}
+
+	// Check size of shorter side.
+	width, height, _ := res.ImageMeta()
+	if min(width, height) > maxHeight {
+		return false
+	}
+
+	// #nosec G115 -- Won't overflow.
+	return res.bitrate <= uint64(maxBitrate)*1000
+}
+
 // Process converts raw ffprobe result data into our more usable result{} type.
 func (res *ffprobeResult) Process() (*result, error) {
 	if res.Error != nil {
@@ -104,6 +104,44 @@ func (m *Manager) CreateMedia(
 	*ProcessingMedia,
 	error,
 ) {
+	attachment := newAttachment(accountID, info)
+
+	// Store attachment in database in initial form.
+	err := m.state.DB.PutAttachment(ctx, attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pass prepared media as ready to be cached.
+	return m.CacheMedia(attachment, data), nil
+}
+
+// CreatePlaceholderMedia creates a new media attachment
+// entry in the database for given owning account ID and
+// extra information, without ever dereferencing it. The
+// returned media is left uncached, of unknown type, such
+// that it can only be linked to at its remote URL.
+func (m *Manager) CreatePlaceholderMedia(
+	ctx context.Context,
+	accountID string,
+	info AdditionalMediaInfo,
+) (
+	*gtsmodel.MediaAttachment,
+	error,
+) {
+	attachment := newAttachment(accountID, info)
+	attachment.Processing = gtsmodel.ProcessingStatusProcessed
+
+	if err := m.state.DB.PutAttachment(ctx, attachment); err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// newAttachment prepares a new media attachment
+// model for given owning account ID and extra info.
+func newAttachment(accountID string, info AdditionalMediaInfo) *gtsmodel.MediaAttachment {
 	now := time.Now()
 
 	// Populate initial fields on the new media,
@@ -155,14 +193,7 @@ func (m *Manager) CreateMedia(
 		attachment.FileMeta.Focus.Y = *info.FocusY
 	}
 
-	// Store attachment in database in initial form.
-	err := m.state.DB.PutAttachment(ctx, attachment)
-	if err != nil {
-		return nil, err
-	}
-
-	// Pass prepared media as ready to be cached.
-	return m.CacheMedia(attachment, data), nil
+	return attachment
 }
 
 // CacheMedia wraps a media model (assumed already
@@ -24,12 +24,14 @@
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"codeberg.org/gruf/go-iotools"
 	"codeberg.org/gruf/go-storage/disk"
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
@@ -288,6 +290,111 @@ func (suite *ManagerTestSuite) TestSimpleJpegProcess() {
 	equalFiles(suite.T(), suite.state.Storage, dbAttachment.Thumbnail.Path, "./test/test-jpeg-thumbnail.jpeg")
 }
 
+func (suite *ManagerTestSuite) TestSimpleJpegProcessAVIFThumb() {
+	ctx := context.Background()
+
+	config.SetMediaThumbnailFormat(config.MediaThumbnailFormatAVIF)
+	defer config.SetMediaThumbnailFormat(config.MediaThumbnailFormatJPEG)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test image
+		b, err := os.ReadFile("./test/test-jpeg.jpg")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+	suite.NotNil(processing)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.NotNil(attachment)
+
+	// thumbnail should be avif, with a jpeg fallback
+	suite.Equal("image/jpeg", attachment.File.ContentType)
+	suite.Equal("image/avif", attachment.Thumbnail.ContentType)
+	suite.True(strings.HasSuffix(attachment.Thumbnail.Path, ".avif"))
+	suite.True(strings.HasSuffix(attachment.Thumbnail.URL, ".avif"))
+	suite.True(strings.HasSuffix(attachment.Thumbnail.FallbackPath, ".jpeg"))
+	suite.Equal(22858, attachment.Thumbnail.FallbackFileSize)
+	suite.Equal("LiB|W-#6RQR.~qvzRjWF_3rqV@a$", attachment.Blurhash)
+
+	// now make sure the attachment is in the database
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.NotNil(dbAttachment)
+	suite.Equal(attachment.Thumbnail, dbAttachment.Thumbnail)
+
+	// the avif thumbnail should be smaller than the jpeg fallback
+	thumb, err := suite.state.Storage.Get(ctx, dbAttachment.Thumbnail.Path)
+	suite.NoError(err)
+	suite.Equal(attachment.Thumbnail.FileSize, len(thumb))
+	suite.Less(len(thumb), attachment.Thumbnail.FallbackFileSize)
+	suite.Equal("ftypavif", string(thumb[4:12]))
+
+	// the natively generated fallback is the usual jpeg thumbnail
+	equalFiles(suite.T(), suite.state.Storage, dbAttachment.Thumbnail.FallbackPath, "./test/test-jpeg-thumbnail.jpeg")
+}
+
+func (suite *ManagerTestSuite) TestPngAlphaChannelProcessAVIFThumb() {
+	ctx := context.Background()
+
+	config.SetMediaThumbnailFormat(config.MediaThumbnailFormatAVIF)
+	defer config.SetMediaThumbnailFormat(config.MediaThumbnailFormatJPEG)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test image
+		b, err := os.ReadFile("./test/test-png-alphachannel.png")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+	suite.NotNil(processing)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.NotNil(attachment)
+
+	// avif can't do transparency, so thumbnail
+	// should be webp instead, with jpeg fallback
+	suite.Equal("image/png", attachment.File.ContentType)
+	suite.Equal("image/webp", attachment.Thumbnail.ContentType)
+	suite.True(strings.HasSuffix(attachment.Thumbnail.Path, ".webp"))
+	suite.True(strings.HasSuffix(attachment.Thumbnail.FallbackPath, ".jpeg"))
+	suite.NotZero(attachment.Thumbnail.FallbackFileSize)
+	suite.NotEmpty(attachment.Blurhash)
+
+	// ensure the thumbnails contain the expected data.
+	equalFiles(suite.T(), suite.state.Storage, attachment.Thumbnail.Path, "./test/test-png-alphachannel-thumbnail.jpeg")
+	fallback, err := suite.state.Storage.Get(ctx, attachment.Thumbnail.FallbackPath)
+	suite.NoError(err)
+	suite.Equal(attachment.Thumbnail.FallbackFileSize, len(fallback))
+	suite.Equal([]byte{0xff, 0xd8, 0xff}, fallback[:3])
+}
+
 func (suite *ManagerTestSuite) TestSimpleJpegProcessTooLarge() {
 	ctx := context.Background()
 
@@ -566,6 +673,125 @@ func (suite *ManagerTestSuite) TestLongerMp4Process() {
 	equalFiles(suite.T(), suite.state.Storage, dbAttachment.Thumbnail.Path, "./test/longer-mp4-thumbnail.webp")
 }
 
+func (suite *ManagerTestSuite) TestLongerMp4ProcessTranscode() {
+	ctx := context.Background()
+
+	// Enable transcoding, with a max height
+	// below the video's to force a transcode.
+	config.SetMediaVideoTranscode(true)
+	config.SetMediaVideoMaxHeight(240)
+	defer func() {
+		config.SetMediaVideoTranscode(false)
+		config.SetMediaVideoMaxHeight(1080)
+	}()
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test video
+		b, err := os.ReadFile("./test/longer-mp4-original.mp4")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+	suite.NotNil(processing)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.NotNil(attachment)
+
+	// the original should be stored and servable,
+	// with thumbnail generated from a poster frame,
+	// but the transcode itself is still to happen.
+	suite.Equal(gtsmodel.ProcessingStatusProcessing, attachment.Processing)
+	suite.Equal("video/mp4", attachment.File.ContentType)
+	suite.Equal(109569, attachment.File.FileSize)
+	suite.Equal(330, attachment.FileMeta.Original.Height)
+	suite.Equal("image/jpeg", attachment.Thumbnail.ContentType)
+	suite.NotEmpty(attachment.URL)
+	suite.NotEmpty(attachment.Blurhash)
+	equalFiles(suite.T(), suite.state.Storage, attachment.File.Path, "./test/longer-mp4-processed.mp4")
+
+	// a transcode job should have been queued, run it.
+	transcode, ok := suite.state.Workers.Transcode.Queue.Pop()
+	suite.True(ok)
+	transcode(ctx)
+
+	// the attachment should now be updated in
+	// the database with the web-safe rendition.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.NotNil(dbAttachment)
+	suite.Equal(gtsmodel.ProcessingStatusProcessed, dbAttachment.Processing)
+	suite.Equal("video/mp4", dbAttachment.File.ContentType)
+	suite.Equal(attachment.File.Path, dbAttachment.File.Path)
+	suite.Equal(attachment.URL, dbAttachment.URL)
+	suite.Equal(436, dbAttachment.FileMeta.Original.Width)
+	suite.Equal(240, dbAttachment.FileMeta.Original.Height)
+	suite.LessOrEqual(*dbAttachment.FileMeta.Original.Bitrate, uint64(4000*1000))
+	suite.Equal(attachment.Thumbnail, dbAttachment.Thumbnail)
+
+	// stored file should be the (faststart) h264 rendition.
+	b, err := suite.state.Storage.Get(ctx, dbAttachment.File.Path)
+	suite.NoError(err)
+	suite.Equal(dbAttachment.File.FileSize, len(b))
+	suite.Equal("ftyp", string(b[4:8]))
+	suite.Less(bytes.Index(b, []byte("moov")), bytes.Index(b, []byte("mdat")))
+	suite.Contains(string(b), "avc1")
+}
+
+func (suite *ManagerTestSuite) TestBirdnestMp4ProcessWebSafe() {
+	ctx := context.Background()
+
+	// Enable transcoding, with the default limits.
+	config.SetMediaVideoTranscode(true)
+	defer config.SetMediaVideoTranscode(false)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test video
+		b, err := os.ReadFile("./test/birdnest-original.mp4")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+	suite.NotNil(processing)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.NotNil(attachment)
+
+	// already web-safe, so no transcode needed.
+	suite.Equal(gtsmodel.ProcessingStatusProcessed, attachment.Processing)
+	suite.Equal("image/jpeg", attachment.Thumbnail.ContentType)
+	_, ok := suite.state.Workers.Transcode.Queue.Pop()
+	suite.False(ok)
+
+	// ensure the original is stored as usual.
+	equalFiles(suite.T(), suite.state.Storage, attachment.File.Path, "./test/birdnest-processed.mp4")
+}
+
 func (suite *ManagerTestSuite) TestBirdnestMp4Process() {
 	ctx := context.Background()
 
@@ -668,10 +894,16 @@ func (suite *ManagerTestSuite) TestOpusProcess() {
 	suite.Equal(1776956, attachment.File.FileSize)
 	suite.Empty(attachment.Blurhash)
 
+	// a waveform should have been generated,
+	// scaled such that loudest peak is 255.
+	suite.Len(attachment.FileMeta.Waveform, 100)
+	suite.Contains(attachment.FileMeta.Waveform, byte(255))
+
 	// now make sure the attachment is in the database
 	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
 	suite.NoError(err)
 	suite.NotNil(dbAttachment)
+	suite.Equal(attachment.FileMeta.Waveform, dbAttachment.FileMeta.Waveform)
 
 	// ensure the files contain the expected data.
 	equalFiles(suite.T(), suite.state.Storage, dbAttachment.File.Path, "./test/test-opus-processed.opus")
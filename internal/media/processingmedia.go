@@ -108,6 +108,10 @@ func (p *ProcessingMedia) load(ctx context.Context) (
 				e := p.mgr.state.DB.UpdateAttachment(ctx, p.media)
 				if e != nil {
 					log.Errorf(ctx, "error updating media in db: %v", e)
+				} else if err == nil && p.media.Processing == gtsmodel.ProcessingStatusProcessing {
+					// Media awaiting transcode,
+					// queue this for background.
+					p.mgr.enqueueTranscode(p.media.ID)
 				}
 
 				// Store values.
@@ -144,12 +148,14 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		// predfine temporary media
 		// file path variables so we
 		// can remove them on error.
-		temppath  string
-		thumbpath string
+		temppath     string
+		posterpath   string
+		thumbpath    string
+		fallbackpath string
 	)
 
 	defer func() {
-		if err := remove(temppath, thumbpath); err != nil {
+		if err := remove(temppath, posterpath, thumbpath, fallbackpath); err != nil {
 			log.Errorf(ctx, "error(s) cleaning up files: %v", err)
 		}
 	}()
@@ -176,15 +182,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	// Extract any video stream metadata from media.
 	// This will always be used regardless of type,
 	// as even audio files may contain embedded album art.
-	width, height, framerate := result.ImageMeta()
-	aspect := util.Div(float32(width), float32(height))
-	p.media.FileMeta.Original.Width = width
-	p.media.FileMeta.Original.Height = height
-	p.media.FileMeta.Original.Size = (width * height)
-	p.media.FileMeta.Original.Aspect = aspect
-	p.media.FileMeta.Original.Framerate = util.PtrIf(framerate)
-	p.media.FileMeta.Original.Duration = util.PtrIf(float32(result.duration))
-	p.media.FileMeta.Original.Bitrate = util.PtrIf(result.bitrate)
+	setOriginalMeta(p.media, result)
 
 	// Set generic media type and mimetype from ffprobe format data.
 	p.media.Type, p.media.File.ContentType, ext = result.GetFileType()
@@ -213,6 +211,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	case gtsmodel.FileTypeAudio:
 		// NOTE: we do not clean audio file
 		// metadata, in order to keep tags.
+		setWaveform(ctx, p.media, temppath)
 
 	default:
 		log.WarnKVs(ctx, kv.Fields{
@@ -222,41 +221,15 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		return nil
 	}
 
-	if width > 0 && height > 0 {
-		// Determine thumbnail dimens to use.
-		thumbWidth, thumbHeight := thumbSize(
-			width,
-			height,
-			aspect,
-		)
-		p.media.FileMeta.Small.Width = thumbWidth
-		p.media.FileMeta.Small.Height = thumbHeight
-		p.media.FileMeta.Small.Size = (thumbWidth * thumbHeight)
-		p.media.FileMeta.Small.Aspect = aspect
-
-		// Determine if blurhash needs generating.
-		needBlurhash := (p.media.Blurhash == "")
-		var newBlurhash, mimeType string
-
-		// Generate thumbnail, and new blurhash if needed from temp media.
-		thumbpath, mimeType, newBlurhash, err = generateThumb(ctx, temppath,
-			thumbWidth,
-			thumbHeight,
-			result.orientation,
-			result.PixFmt(),
-			needBlurhash,
-		)
-		if err != nil {
-			return gtserror.Newf("error generating image thumb: %w", err)
-		}
-
-		// Set generated thumbnail's mimetype.
-		p.media.Thumbnail.ContentType = mimeType
-
-		if needBlurhash {
-			// Set newly determined blurhash.
-			p.media.Blurhash = newBlurhash
-		}
+	// Generate thumbnail, and new blurhash if needed from temp media.
+	posterpath, thumbpath, fallbackpath, err = generateMediaThumb(ctx,
+		p.media,
+		temppath,
+		result,
+		p.media.Blurhash == "",
+	)
+	if err != nil {
+		return err
 	}
 
 	// Calculate final media attachment file path.
@@ -282,39 +255,15 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	p.media.File.FileSize = int(filesz)
 
 	if thumbpath != "" {
-		// Determine final thumbnail ext.
-		thumbExt := getExtension(thumbpath)
-
-		// Calculate final media attachment thumbnail path.
-		p.media.Thumbnail.Path = uris.StoragePathForAttachment(
-			p.media.AccountID,
-			string(TypeAttachment),
-			string(SizeSmall),
-			p.media.ID,
-			thumbExt,
-		)
-
-		// Copy thumbnail file into storage at path.
-		thumbsz, err := p.mgr.state.Storage.PutFile(ctx,
-			p.media.Thumbnail.Path,
+		// Copy thumbnail file(s) into storage.
+		err := p.mgr.storeMediaThumb(ctx,
+			p.media,
 			thumbpath,
-			p.media.Thumbnail.ContentType,
+			fallbackpath,
 		)
 		if err != nil {
-			return gtserror.Newf("error writing thumb to storage: %w", err)
+			return err
 		}
-
-		// Set final determined thumbnail size.
-		p.media.Thumbnail.FileSize = int(thumbsz)
-
-		// Generate a media attachment thumbnail URL.
-		p.media.Thumbnail.URL = uris.URIForAttachment(
-			p.media.AccountID,
-			string(TypeAttachment),
-			string(SizeSmall),
-			p.media.ID,
-			thumbExt,
-		)
 	}
 
 	// Generate a media attachment URL.
@@ -329,6 +278,14 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	// We can now consider this cached.
 	p.media.Cached = util.Ptr(true)
 
+	if needsTranscode(p.media.Type, result) {
+		// Serve the original until a web-safe rendition
+		// has been transcoded in the background, which
+		// then marks the attachment finished processing.
+		p.media.Processing = gtsmodel.ProcessingStatusProcessing
+		return nil
+	}
+
 	// Finally set the attachment as finished processing.
 	p.media.Processing = gtsmodel.ProcessingStatusProcessed
 
@@ -354,9 +311,18 @@ func (p *ProcessingMedia) cleanup(ctx context.Context) {
 		}
 	}
 
+	if p.media.Thumbnail.FallbackPath != "" {
+		// Ensure media thumbnail fallback at path is deleted from storage.
+		err := p.mgr.state.Storage.Delete(ctx, p.media.Thumbnail.FallbackPath)
+		if err != nil && !storage.IsNotFound(err) {
+			log.Errorf(ctx, "error deleting %s: %v", p.media.Thumbnail.FallbackPath, err)
+		}
+	}
+
 	// Unset all processor-calculated media fields.
 	p.media.FileMeta.Original = gtsmodel.Original{}
 	p.media.FileMeta.Small = gtsmodel.Small{}
+	p.media.FileMeta.Waveform = nil
 	p.media.File.ContentType = ""
 	p.media.File.FileSize = 0
 	p.media.File.Path = ""
@@ -364,6 +330,8 @@ func (p *ProcessingMedia) cleanup(ctx context.Context) {
 	p.media.Thumbnail.ContentType = ""
 	p.media.Thumbnail.Path = ""
 	p.media.Thumbnail.URL = ""
+	p.media.Thumbnail.FallbackPath = ""
+	p.media.Thumbnail.FallbackFileSize = 0
 	p.media.URL = ""
 
 	// Also ensure marked as unknown and finished
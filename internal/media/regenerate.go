@@ -0,0 +1,159 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"os"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// RegenerateMedia recomputes the derivatives of the given
+// cached media attachment from its stored original file,
+// i.e. its thumbnail (and any fallback), blurhash, waveform
+// and file metadata, updating it in the database. The stored
+// original itself is left untouched. Thumbnails at paths no
+// longer in use (e.g. after changing thumbnail format) are
+// deleted from storage once the database has been updated.
+func (m *Manager) RegenerateMedia(ctx context.Context, media *gtsmodel.MediaAttachment) error {
+	if media.File.Path == "" || !util.PtrOrZero(media.Cached) {
+		return gtserror.Newf("media %s not cached", media.ID)
+	}
+
+	var (
+		// predfine temporary media
+		// file path variables so we
+		// can remove them on error.
+		temppath     string
+		posterpath   string
+		thumbpath    string
+		fallbackpath string
+	)
+
+	defer func() {
+		if err := remove(temppath, posterpath, thumbpath, fallbackpath); err != nil {
+			log.Errorf(ctx, "error(s) cleaning up files: %v", err)
+		}
+	}()
+
+	// Open stream to the stored original.
+	rc, err := m.state.Storage.GetStream(ctx,
+		media.File.Path,
+	)
+	if err != nil {
+		return gtserror.Newf("error opening original: %w", err)
+	}
+
+	// Drain original to tmp file
+	// (this reader handles close).
+	temppath, err = drainToTmp(rc)
+	if err != nil {
+		return gtserror.Newf("error draining data to tmp: %w", err)
+	}
+
+	// Pass input file through ffprobe to
+	// parse further metadata information.
+	result, err := probe(ctx, temppath)
+	if err != nil {
+		return gtserror.Newf("ffprobe error: %w", err)
+	}
+
+	// Rename to set file ext as determined by ffprobe,
+	// (not from stored path, which may be eg. 'jpg').
+	_, _, ext := result.GetFileType()
+	newpath := temppath + "." + ext
+	if err := os.Rename(temppath, newpath); err != nil {
+		return gtserror.Newf("error renaming to %s - >%s: %w", temppath, newpath, err)
+	}
+	temppath = newpath
+
+	// Take copies of existing thumbnail paths,
+	// so we can delete them later if replaced.
+	oldThumbPath := media.Thumbnail.Path
+	oldFallbackPath := media.Thumbnail.FallbackPath
+
+	// Recompute file metadata. We don't touch
+	// the media type or file content-type, as
+	// these are tied to the stored original.
+	setOriginalMeta(media, result)
+	media.FileMeta.Small = gtsmodel.Small{}
+
+	if media.Type == gtsmodel.FileTypeAudio {
+		// Regenerate waveform.
+		setWaveform(ctx, media, temppath)
+	}
+
+	// Generate new thumbnail, always with new blurhash.
+	posterpath, thumbpath, fallbackpath, err = generateMediaThumb(ctx,
+		media,
+		temppath,
+		result,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+
+	if thumbpath != "" {
+		// Copy thumbnail file(s) into storage.
+		err := m.storeMediaThumb(ctx,
+			media,
+			thumbpath,
+			fallbackpath,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		// No thumbnail could be generated,
+		// ensure none are set from previous.
+		media.Thumbnail.Path = ""
+		media.Thumbnail.ContentType = ""
+		media.Thumbnail.FileSize = 0
+		media.Thumbnail.URL = ""
+		media.Thumbnail.FallbackPath = ""
+		media.Thumbnail.FallbackFileSize = 0
+	}
+
+	// Update media with regenerated details.
+	err = m.state.DB.UpdateAttachment(ctx, media)
+	if err != nil {
+		return gtserror.Newf("error updating media: %w", err)
+	}
+
+	// Delete any thumbnails at paths no longer in use.
+	for _, path := range []string{oldThumbPath, oldFallbackPath} {
+		if path == "" ||
+			path == media.Thumbnail.Path ||
+			path == media.Thumbnail.FallbackPath {
+			continue
+		}
+
+		err := m.state.Storage.Delete(ctx, path)
+		if err != nil && !storage.IsNotFound(err) {
+			log.Errorf(ctx, "error deleting %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
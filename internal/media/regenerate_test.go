@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type RegenerateTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *RegenerateTestSuite) TestRegenerateMediaThumbnailFormat() {
+	ctx := context.Background()
+
+	config.SetMediaThumbnailFormat(config.MediaThumbnailFormatWebP)
+	defer config.SetMediaThumbnailFormat(config.MediaThumbnailFormatJPEG)
+
+	attachment, err := suite.db.GetAttachmentByID(ctx, suite.testAttachments["local_account_1_unattached_1"].ID)
+	suite.NoError(err)
+
+	oldThumbPath := attachment.Thumbnail.Path
+	oldOriginal, err := suite.storage.Get(ctx, attachment.File.Path)
+	suite.NoError(err)
+
+	// Mess up the blurhash + metadata
+	// to check these get recomputed.
+	attachment.Blurhash = "not a blurhash"
+	attachment.FileMeta.Original.Width = 1
+
+	err = suite.manager.RegenerateMedia(ctx, attachment)
+	suite.NoError(err)
+
+	// Fetch regenerated media from database.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+
+	// Thumbnail should be regenerated as webp,
+	// with a jpeg fallback at the old thumb path.
+	suite.Equal("image/webp", dbAttachment.Thumbnail.ContentType)
+	suite.Equal("01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH8RMYQ6MSNY3JM2XT1CQ5.webp", dbAttachment.Thumbnail.Path)
+	suite.Equal("http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH8RMYQ6MSNY3JM2XT1CQ5.webp", dbAttachment.Thumbnail.URL)
+	suite.Equal(oldThumbPath, dbAttachment.Thumbnail.FallbackPath)
+	suite.NotZero(dbAttachment.Thumbnail.FileSize)
+	suite.NotZero(dbAttachment.Thumbnail.FallbackFileSize)
+
+	// Blurhash + metadata should be recomputed.
+	suite.Equal("LN8}[ntSH;embxM~WA$xI^afs*j?", dbAttachment.Blurhash)
+	suite.Equal(800, dbAttachment.FileMeta.Original.Width)
+	suite.Equal(450, dbAttachment.FileMeta.Original.Height)
+	suite.Equal(512, dbAttachment.FileMeta.Small.Width)
+	suite.Equal(288, dbAttachment.FileMeta.Small.Height)
+
+	// Both thumbnail files should exist in storage.
+	thumb, err := suite.storage.Get(ctx, dbAttachment.Thumbnail.Path)
+	suite.NoError(err)
+	suite.Equal(dbAttachment.Thumbnail.FileSize, len(thumb))
+	fallback, err := suite.storage.Get(ctx, dbAttachment.Thumbnail.FallbackPath)
+	suite.NoError(err)
+	suite.Equal(dbAttachment.Thumbnail.FallbackFileSize, len(fallback))
+
+	// Original should be untouched.
+	newOriginal, err := suite.storage.Get(ctx, dbAttachment.File.Path)
+	suite.NoError(err)
+	suite.Equal(oldOriginal, newOriginal)
+	suite.Equal("image/jpeg", dbAttachment.File.ContentType)
+
+	// Regenerating back to jpeg should remove the webp thumb.
+	config.SetMediaThumbnailFormat(config.MediaThumbnailFormatJPEG)
+	err = suite.manager.RegenerateMedia(ctx, dbAttachment)
+	suite.NoError(err)
+	suite.Equal(oldThumbPath, dbAttachment.Thumbnail.Path)
+	suite.Empty(dbAttachment.Thumbnail.FallbackPath)
+	ok, err := suite.storage.Has(ctx, "01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH8RMYQ6MSNY3JM2XT1CQ5.webp")
+	suite.NoError(err)
+	suite.False(ok)
+}
+
+func (suite *RegenerateTestSuite) TestRegenerateMediaUncached() {
+	ctx := context.Background()
+
+	attachment, err := suite.db.GetAttachmentByID(ctx, suite.testAttachments["remote_account_2_status_1_attachment_2"].ID)
+	suite.NoError(err)
+	suite.False(*attachment.Cached)
+
+	err = suite.manager.RegenerateMedia(ctx, attachment)
+	suite.ErrorContains(err, "not cached")
+}
+
+func TestRegenerateTestSuite(t *testing.T) {
+	suite.Run(t, &RegenerateTestSuite{})
+}
@@ -28,6 +28,7 @@
 	"strings"
 
 	"github.com/buckket/go-blurhash"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"golang.org/x/image/webp"
@@ -75,147 +76,230 @@ func thumbSize(width, height int, aspect float32) (int, int) {
 // Go libraries for generating thumbnails, else
 // always falling back to slower but much more
 // widely supportive ffmpeg.
+//
+// For the webp and avif thumbnail formats, a jpeg
+// fallback thumbnail is also generated, for serving
+// to clients that don't support the chosen format.
 func generateThumb(
 	ctx context.Context,
 	filepath string,
 	width, height int,
 	orientation int,
 	pixfmt string,
+	format string,
 	needBlurhash bool,
 ) (
 	outpath string,
 	mimeType string,
+	fallbackpath string,
 	blurhash string,
 	err error,
 ) {
-	var ext string
-
-	// Default type is webp.
-	mimeType = "image/webp"
+	var base, ext string
 
-	// Generate thumb output path REPLACING extension.
+	// Split input path into base and extension,
+	// used when generating thumb output paths.
 	if i := strings.IndexByte(filepath, '.'); i != -1 {
-		outpath = filepath[:i] + "_thumb.webp"
+		base = filepath[:i]
 		ext = filepath[i+1:] // old extension
 	} else {
-		return "", "", "", gtserror.New("input file missing extension")
+		return "", "", "", "", gtserror.New("input file missing extension")
+	}
+
+	// AVIF transparency requires encoding a
+	// separate alpha stream, which is more
+	// hassle than it's worth for thumbnails,
+	// so we use webp for these images instead.
+	if format == config.MediaThumbnailFormatAVIF &&
+		containsAlpha(pixfmt) {
+		format = config.MediaThumbnailFormatWebP
 	}
 
 	// Check for the few media types we
 	// have native Go decoding that allow
 	// us to generate thumbs natively.
+	decode := nativeDecoder(ext, pixfmt)
+
 	switch {
 
-	case ext == "jpeg":
-		// Replace the "webp" with "jpeg", as we'll
-		// use our native Go thumbnailing generation.
-		outpath = outpath[:len(outpath)-4] + "jpeg"
-		mimeType = "image/jpeg"
+	case format != config.MediaThumbnailFormatJPEG:
+		// Generate thumb in the configured format,
+		// alongside a jpeg fallback thumb for clients
+		// that don't accept the configured format.
+		outpath = base + "_thumb." + format
+		fallbackpath = base + "_thumb.jpeg"
+		mimeType = "image/" + format
 
-		log.Debug(ctx, "generating thumb from jpeg")
-		blurhash, err := generateNativeThumb(
+		blurhash, err := generateFormatThumb(ctx,
 			filepath,
 			outpath,
+			fallbackpath,
 			width,
 			height,
 			orientation,
-			jpeg.Decode,
+			pixfmt,
+			format,
+			decode,
 			needBlurhash,
 		)
-		return outpath, mimeType, blurhash, err
-
-	// We specifically only allow generating native
-	// thumbnails from gif IF it doesn't contain an
-	// alpha channel. We'll ultimately be encoding to
-	// jpeg which doesn't support transparency layers.
-	case ext == "gif" && !containsAlpha(pixfmt):
+		return outpath, mimeType, fallbackpath, blurhash, err
 
-		// Replace the "webp" with "jpeg", as we'll
-		// use our native Go thumbnailing generation.
-		outpath = outpath[:len(outpath)-4] + "jpeg"
+	case decode != nil:
+		// We can use our native Go
+		// thumbnailing generation.
+		outpath = base + "_thumb.jpeg"
 		mimeType = "image/jpeg"
 
-		log.Debug(ctx, "generating thumb from gif")
+		log.Debugf(ctx, "generating thumb from %s", ext)
 		blurhash, err := generateNativeThumb(
 			filepath,
 			outpath,
 			width,
 			height,
 			orientation,
-			gif.Decode,
+			decode,
 			needBlurhash,
 		)
-		return outpath, mimeType, blurhash, err
+		return outpath, mimeType, "", blurhash, err
+	}
 
-	// We specifically only allow generating native
-	// thumbnails from png IF it doesn't contain an
-	// alpha channel. We'll ultimately be encoding to
-	// jpeg which doesn't support transparency layers.
-	case ext == "png" && !containsAlpha(pixfmt):
+	// Default type is webp.
+	outpath = base + "_thumb.webp"
+	mimeType = "image/webp"
 
-		// Replace the "webp" with "jpeg", as we'll
-		// use our native Go thumbnailing generation.
-		outpath = outpath[:len(outpath)-4] + "jpeg"
-		mimeType = "image/jpeg"
+	// The fallback for thumbnail generation, which
+	// encompasses most media types is with ffmpeg.
+	log.Debug(ctx, "generating thumb with ffmpeg")
+	if err := ffmpegGenerateThumb(ctx,
+		filepath,
+		outpath,
+		width,
+		height,
+		pixfmt,
+		config.MediaThumbnailFormatWebP,
+	); err != nil {
+		return outpath, "", "", "", err
+	}
 
-		log.Debug(ctx, "generating thumb from png")
-		blurhash, err := generateNativeThumb(
-			filepath,
-			outpath,
+	if needBlurhash {
+		// Generate new blurhash from webp output thumb.
+		blurhash, err = generateBlurhash(outpath, webp.Decode)
+		if err != nil {
+			return outpath, "", "", "", gtserror.Newf("error generating blurhash: %w", err)
+		}
+	}
+
+	return outpath, mimeType, "", blurhash, nil
+}
+
+// nativeDecoder returns the native Go image decoder
+// to use when generating a thumbnail for media of the
+// given extension and pixel format, or nil if ffmpeg
+// must be used instead.
+//
+// We specifically only allow generating native
+// thumbnails from gif, png and webp IF they don't
+// contain an alpha channel. We'll ultimately be
+// encoding to jpeg which doesn't support transparency.
+func nativeDecoder(ext string, pixfmt string) func(io.Reader) (image.Image, error) {
+	switch {
+	case ext == "jpeg":
+		return jpeg.Decode
+	case ext == "gif" && !containsAlpha(pixfmt):
+		return gif.Decode
+	case ext == "png" && !containsAlpha(pixfmt):
+		return png.Decode
+	case ext == "webp" && !containsAlpha(pixfmt):
+		return webp.Decode
+	default:
+		return nil
+	}
+}
+
+// generateFormatThumb generates a thumbnail in given
+// format (i.e. webp or avif) at outpath, and a jpeg
+// fallback thumbnail at fallbackpath. If a native
+// decoder is given, the jpeg fallback is generated
+// natively first, and the formatted thumbnail is
+// then encoded from it, else both use ffmpeg.
+func generateFormatThumb(
+	ctx context.Context,
+	inpath, outpath, fallbackpath string,
+	width, height int,
+	orientation int,
+	pixfmt string,
+	format string,
+	decode func(io.Reader) (image.Image, error),
+	needBlurhash bool,
+) (
+	blurhash string,
+	err error,
+) {
+	if decode != nil {
+		// Native generation handles orientation for us, so
+		// encode formatted thumb from the resized fallback.
+		log.Debugf(ctx, "generating %s thumb from native jpeg thumb", format)
+		blurhash, err = generateNativeThumb(
+			inpath,
+			fallbackpath,
 			width,
 			height,
 			orientation,
-			png.Decode,
+			decode,
 			needBlurhash,
 		)
-		return outpath, mimeType, blurhash, err
-
-	// We specifically only allow generating native
-	// thumbnails from webp IF it doesn't contain an
-	// alpha channel. We'll ultimately be encoding to
-	// jpeg which doesn't support transparency layers.
-	case ext == "webp" && !containsAlpha(pixfmt):
-
-		// Replace the "webp" with "jpeg", as we'll
-		// use our native Go thumbnailing generation.
-		outpath = outpath[:len(outpath)-4] + "jpeg"
-		mimeType = "image/jpeg"
+		if err != nil {
+			return "", err
+		}
 
-		log.Debug(ctx, "generating thumb from webp")
-		blurhash, err := generateNativeThumb(
-			filepath,
+		if err := ffmpegGenerateThumb(ctx,
+			fallbackpath,
 			outpath,
 			width,
 			height,
-			orientation,
-			webp.Decode,
-			needBlurhash,
-		)
-		return outpath, mimeType, blurhash, err
+			"",
+			format,
+		); err != nil {
+			return "", err
+		}
+
+		return blurhash, nil
 	}
 
-	// The fallback for thumbnail generation, which
-	// encompasses most media types is with ffmpeg.
-	log.Debug(ctx, "generating thumb with ffmpeg")
-	if err := ffmpegGenerateWebpThumb(ctx,
-		filepath,
+	log.Debugf(ctx, "generating %s thumb with ffmpeg", format)
+	if err := ffmpegGenerateThumb(ctx,
+		inpath,
 		outpath,
 		width,
 		height,
 		pixfmt,
+		format,
+	); err != nil {
+		return "", err
+	}
+
+	// Also generate jpeg fallback with ffmpeg.
+	if err := ffmpegGenerateThumb(ctx,
+		inpath,
+		fallbackpath,
+		width,
+		height,
+		pixfmt,
+		config.MediaThumbnailFormatJPEG,
 	); err != nil {
-		return outpath, "", "", err
+		return "", err
 	}
 
 	if needBlurhash {
-		// Generate new blurhash from webp output thumb.
-		blurhash, err = generateWebpBlurhash(outpath)
+		// Generate new blurhash from jpeg fallback thumb,
+		// as we can't natively decode all formats (avif).
+		blurhash, err = generateBlurhash(fallbackpath, jpeg.Decode)
 		if err != nil {
-			return outpath, "", "", gtserror.Newf("error generating blurhash: %w", err)
+			return "", gtserror.Newf("error generating blurhash: %w", err)
 		}
 	}
 
-	return outpath, mimeType, blurhash, nil
+	return blurhash, nil
 }
 
 // generateNativeThumb generates a thumbnail
@@ -315,8 +399,9 @@ func generateNativeThumb(
 	return "", nil
 }
 
-// generateWebpBlurhash generates a blurhash for Webp at filepath.
-func generateWebpBlurhash(filepath string) (string, error) {
+// generateBlurhash generates a blurhash for the
+// image at filepath, decoded with given function.
+func generateBlurhash(filepath string, decode func(io.Reader) (image.Image, error)) (string, error) {
 	// Open the file at given path.
 	file, err := os.Open(filepath)
 	if err != nil {
@@ -324,7 +409,7 @@ func generateWebpBlurhash(filepath string) (string, error) {
 	}
 
 	// Decode image from file.
-	img, err := webp.Decode(file)
+	img, err := decode(file)
 
 	// Done with file.
 	_ = file.Close()
@@ -0,0 +1,258 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// posterOffset is the offset in seconds into
+// a video at which to take its poster frame.
+const posterOffset = 1.0
+
+// needsTranscode returns whether video media
+// with the given probe result should be queued
+// for transcoding to a web-safe rendition.
+func needsTranscode(typ gtsmodel.FileType, result *result) bool {
+	if !config.GetMediaVideoTranscode() {
+		return false
+	}
+
+	switch typ {
+	case gtsmodel.FileTypeVideo,
+		gtsmodel.FileTypeGifv:
+		return !result.WebSafe(
+			config.GetMediaVideoMaxBitrate(),
+			config.GetMediaVideoMaxHeight(),
+		)
+
+	default:
+		return false
+	}
+}
+
+// generatePoster generates a png poster frame for
+// the video at path, taken from a short way into the
+// video (or halfway through very short videos), for
+// use as the source of the video's thumbnail.
+func generatePoster(ctx context.Context, filepath string, duration float64) (string, error) {
+	var outpath string
+
+	// Generate poster output path REPLACING extension.
+	if i := len(filepath) - len(getExtension(filepath)); i > 1 {
+		outpath = filepath[:i-1] + "_poster.png"
+	} else {
+		return "", gtserror.New("input file missing extension")
+	}
+
+	// Take from halfway
+	// through very short
+	// videos, if need be.
+	offset := posterOffset
+	if duration < 2*offset {
+		offset = duration / 2
+	}
+
+	if err := ffmpegGeneratePoster(ctx,
+		filepath,
+		outpath,
+		offset,
+	); err != nil {
+		return outpath, err
+	}
+
+	return outpath, nil
+}
+
+// enqueueTranscode queues a job on the transcode
+// worker pool to transcode the media attachment
+// with given ID into a web-safe video rendition.
+func (m *Manager) enqueueTranscode(mediaID string) {
+	m.state.Workers.Transcode.Queue.Push(func(ctx context.Context) {
+		if err := m.transcode(ctx, mediaID); err != nil {
+			log.Errorf(ctx, "error transcoding media %s: %v", mediaID, err)
+		}
+	})
+}
+
+// transcode transcodes the stored original file of media
+// attachment with given ID into a web-safe video rendition,
+// replacing the original in storage and marking the media
+// as processed. On failure, the original is left in place.
+func (m *Manager) transcode(ctx context.Context, mediaID string) error {
+	// Fetch latest version of media from the database,
+	// as it may have changed (or been deleted) in queue.
+	media, err := m.state.DB.GetAttachmentByID(ctx, mediaID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Media deleted
+			// in meantime.
+			return nil
+		}
+		return gtserror.Newf("error getting media: %w", err)
+	}
+
+	if media.Processing != gtsmodel.ProcessingStatusProcessing ||
+		!util.PtrOrZero(media.Cached) {
+		// Nothing to do.
+		return nil
+	}
+
+	// Perform transcode, falling back to
+	// the original on any error, as that
+	// is still perfectly servable media.
+	origPath := media.File.Path
+	if err := m.transcodeFile(ctx, media); err != nil {
+		log.Errorf(ctx, "error transcoding %s, keeping original: %v", origPath, err)
+	}
+
+	// Whatever happened, media processing is now finished.
+	media.Processing = gtsmodel.ProcessingStatusProcessed
+	if err := m.state.DB.UpdateAttachment(ctx, media,
+		"url",
+		"file_path",
+		"file_content_type",
+		"file_file_size",
+		"original_width",
+		"original_height",
+		"original_size",
+		"original_aspect",
+		"original_duration",
+		"original_framerate",
+		"original_bitrate",
+		"processing",
+	); err != nil {
+		return gtserror.Newf("error updating media: %w", err)
+	}
+
+	if media.File.Path != origPath {
+		// Original was replaced at a new path, delete it.
+		err := m.state.Storage.Delete(ctx, origPath)
+		if err != nil && !storage.IsNotFound(err) {
+			log.Errorf(ctx, "error deleting %s: %v", origPath, err)
+		}
+	}
+
+	return nil
+}
+
+// transcodeFile performs the actual transcode of media's
+// stored original file into a web-safe rendition, storing
+// it and updating media's file details (not in database).
+func (m *Manager) transcodeFile(ctx context.Context, media *gtsmodel.MediaAttachment) error {
+	var (
+		// predfine temporary media
+		// file path variables so we
+		// can remove them on error.
+		temppath string
+		outpath  string
+	)
+
+	defer func() {
+		if err := remove(temppath, outpath); err != nil {
+			log.Errorf(ctx, "error(s) cleaning up files: %v", err)
+		}
+	}()
+
+	// Open stream to the stored original.
+	rc, err := m.state.Storage.GetStream(ctx,
+		media.File.Path,
+	)
+	if err != nil {
+		return gtserror.Newf("error opening original: %w", err)
+	}
+
+	// Drain original to tmp file
+	// (this reader handles close).
+	temppath, err = drainToTmp(rc)
+	if err != nil {
+		return gtserror.Newf("error draining data to tmp: %w", err)
+	}
+
+	// Rename to set original file ext.
+	ext := getExtension(media.File.Path)
+	newpath := temppath + "." + ext
+	if err := os.Rename(temppath, newpath); err != nil {
+		return gtserror.Newf("error renaming to %s - >%s: %w", temppath, newpath, err)
+	}
+	temppath = newpath
+
+	// Transcode original to web-safe mp4 rendition.
+	outpath = temppath[:len(temppath)-len(ext)-1] + "_web.mp4"
+	if err := ffmpegTranscodeVideo(ctx,
+		temppath,
+		outpath,
+		config.GetMediaVideoMaxBitrate(),
+		config.GetMediaVideoMaxHeight(),
+	); err != nil {
+		return gtserror.Newf("ffmpeg error: %w", err)
+	}
+
+	// Probe the rendition for final metadata.
+	result, err := probe(ctx, outpath)
+	if err != nil {
+		return gtserror.Newf("ffprobe error: %w", err)
+	}
+
+	// Calculate final rendition path, this
+	// may be the same as original if it was
+	// already an mp4, in which case replaced.
+	path := uris.StoragePathForAttachment(
+		media.AccountID,
+		string(TypeAttachment),
+		string(SizeOriginal),
+		media.ID,
+		"mp4",
+	)
+
+	// Copy rendition into storage at path.
+	filesz, err := m.state.Storage.PutFile(ctx,
+		path,
+		outpath,
+		"video/mp4",
+	)
+	if err != nil {
+		return gtserror.Newf("error writing rendition to storage: %w", err)
+	}
+
+	// Update media with rendition details.
+	setOriginalMeta(media, result)
+	media.File.Path = path
+	media.File.ContentType = "video/mp4"
+	media.File.FileSize = int(filesz)
+	media.URL = uris.URIForAttachment(
+		media.AccountID,
+		string(TypeAttachment),
+		string(SizeOriginal),
+		media.ID,
+		"mp4",
+	)
+
+	return nil
+}
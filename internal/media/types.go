@@ -38,6 +38,7 @@
 	TypeHeader     Type = "header"     // TypeHeader is the key for profile header requests
 	TypeAvatar     Type = "avatar"     // TypeAvatar is the key for profile avatar requests
 	TypeEmoji      Type = "emoji"      // TypeEmoji is the key for emoji type requests
+	TypeArchive    Type = "archive"    // TypeArchive is the key for account archive exports (never served by the fileserver)
 )
 
 // AdditionalMediaInfo represents additional information that
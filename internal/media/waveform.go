@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// number of peaks in a
+	// generated audio waveform.
+	waveformPeaks = 100
+
+	// sample rate to decode audio
+	// at for waveform generation,
+	// plenty for peak detection.
+	waveformSampleRate = 4000
+)
+
+// generateWaveform generates a downsampled waveform
+// for the audio media at filepath, as a slice of peak
+// amplitudes scaled so that the loudest peak is 255.
+// Returns nil if the audio contains no samples.
+func generateWaveform(ctx context.Context, filepath string) ([]byte, error) {
+	var outpath string
+
+	// Generate pcm output path REPLACING extension.
+	if i := len(filepath) - len(getExtension(filepath)); i > 1 {
+		outpath = filepath[:i-1] + "_waveform.pcm"
+	} else {
+		return nil, gtserror.New("input file missing extension")
+	}
+
+	defer func() {
+		if err := remove(outpath); err != nil {
+			log.Errorf(ctx, "error(s) cleaning up files: %v", err)
+		}
+	}()
+
+	// Decode audio to raw mono PCM with ffmpeg.
+	if err := ffmpegDecodeAudio(ctx,
+		filepath,
+		outpath,
+		waveformSampleRate,
+	); err != nil {
+		return nil, gtserror.Newf("ffmpeg error: %w", err)
+	}
+
+	file, err := os.Open(outpath)
+	if err != nil {
+		return nil, gtserror.Newf("error opening pcm: %w", err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, gtserror.Newf("error statting pcm: %w", err)
+	}
+
+	// Calculate peaks from PCM.
+	peaks, err := waveformFromPCM(
+		bufio.NewReader(file),
+		int(stat.Size()/2),
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error reading pcm: %w", err)
+	}
+
+	return peaks, nil
+}
+
+// waveformFromPCM reads n mono s16le PCM samples from r, splitting
+// them into waveformPeaks buckets and taking the peak amplitude of
+// each, then scaling these so the loudest peak is 255. Returns nil
+// if there are no samples, and fewer peaks if fewer samples.
+func waveformFromPCM(r io.Reader, n int) ([]byte, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	// Number of peaks to generate.
+	count := min(n, waveformPeaks)
+	peaks := make([]int, count)
+
+	var (
+		buf [2]byte
+		max int
+	)
+
+	for i := 0; i < n; i++ {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, err
+		}
+
+		// Get absolute sample amplitude.
+		// #nosec G115 -- Reinterpreting bits.
+		v := int(int16(binary.LittleEndian.Uint16(buf[:])))
+		if v < 0 {
+			v = -v
+		}
+
+		// Update bucket peak.
+		b := i * count / n
+		if v > peaks[b] {
+			peaks[b] = v
+			if v > max {
+				max = v
+			}
+		}
+	}
+
+	waveform := make([]byte, count)
+
+	if max > 0 {
+		// Scale peaks relative to max.
+		for i, peak := range peaks {
+			// #nosec G115 -- Within byte range.
+			waveform[i] = byte(peak * 255 / max)
+		}
+	}
+
+	return waveform, nil
+}
@@ -21,7 +21,9 @@
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -30,6 +32,7 @@
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/extra/bunotel"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
@@ -127,12 +130,232 @@ func Initialize(db db.DB) error {
 	return nil
 }
 
+var (
+	// meter is obtained from the global meter provider,
+	// which delegates to the provider configured in
+	// Initialize() once set. This allows the instruments
+	// below to be safely used before (or without) metrics
+	// being initialized, in which case they are no-ops.
+	meter = otel.Meter(serviceName)
+
+	// Ignore the errors here, on failure
+	// no-op instruments are still returned.
+	activitiesReceived, _ = meter.Int64Counter(
+		"gotosocial.federation.activities.received",
+		metric.WithDescription("Number of ActivityPub activities received from remote instances, by activity and object type"),
+	)
+	deliveries, _ = meter.Int64Counter(
+		"gotosocial.federation.deliveries",
+		metric.WithDescription("Number of outgoing ActivityPub delivery attempts, by remote domain and outcome"),
+	)
+)
+
+// Delivery outcomes for RecordDelivery().
+const (
+	DeliverySuccess = "success"
+	DeliveryRetry   = "retry"
+	DeliveryFailed  = "failed"
+)
+
+// RecordActivityReceived increments the count of
+// inbound activities of the given activity + object type.
+func RecordActivityReceived(ctx context.Context, activityType string, objectType string) {
+	activitiesReceived.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("activity_type", activityType),
+		attribute.String("object_type", objectType),
+	))
+}
+
+// RecordDelivery increments the count of outbound
+// delivery attempts to domain with the given outcome.
+func RecordDelivery(ctx context.Context, domain string, outcome string) {
+	deliveries.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("domain", domain),
+		attribute.String("outcome", outcome),
+	))
+}
+
 func InstrumentGin() gin.HandlerFunc {
-	return otelginmetrics.Middleware(serviceName)
+	// Ignore the error here, on failure
+	// a no-op histogram is still returned.
+	duration, _ := otel.GetMeterProvider().Meter(serviceName).Float64Histogram(
+		"gotosocial.http.server.request.duration",
+		metric.WithDescription("Duration of HTTP requests by route, method and status"),
+		metric.WithUnit("s"),
+	)
+
+	middleware := otelginmetrics.Middleware(serviceName)
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		// Calls through to
+		// the next handlers.
+		middleware(c)
+
+		// Use the matched route rather than the
+		// request path, to keep cardinality low.
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		duration.Record(c.Request.Context(),
+			time.Since(start).Seconds(),
+			metric.WithAttributes(
+				attribute.String("route", route),
+				attribute.String("method", c.Request.Method),
+				attribute.Int("status", c.Writer.Status()),
+			),
+		)
+	}
 }
 
 func InstrumentBun() bun.QueryHook {
-	return bunotel.NewQueryHook(
-		bunotel.WithMeterProvider(otel.GetMeterProvider()),
+	meter := otel.GetMeterProvider().Meter(serviceName)
+
+	// Ignore the error here, on failure
+	// a no-op histogram is still returned.
+	duration, _ := meter.Float64Histogram(
+		"gotosocial.database.query.duration",
+		metric.WithDescription("Duration of database queries by operation and table"),
+		metric.WithUnit("s"),
+	)
+
+	return &queryHook{
+		QueryHook: bunotel.NewQueryHook(
+			bunotel.WithMeterProvider(otel.GetMeterProvider()),
+		),
+		duration: duration,
+	}
+}
+
+// queryHook wraps bunotel.QueryHook to additionally
+// record query latencies grouped by query family,
+// i.e. the operation and table being queried.
+type queryHook struct {
+	*bunotel.QueryHook
+	duration metric.Float64Histogram
+}
+
+func (h *queryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	h.QueryHook.AfterQuery(ctx, event)
+
+	var table string
+	if event.IQuery != nil {
+		table = event.IQuery.GetTableName()
+	}
+
+	h.duration.Record(ctx,
+		time.Since(event.StartTime).Seconds(),
+		metric.WithAttributes(
+			attribute.String("operation", event.Operation()),
+			attribute.String("table", table),
+			attribute.Bool("error", isQueryError(event.Err)),
+		),
+	)
+}
+
+// isQueryError returns whether err is an actual
+// query error, i.e. not nil or an expected no rows.
+func isQueryError(err error) bool {
+	return err != nil && !errors.Is(err, sql.ErrNoRows)
+}
+
+// InstrumentDB registers metrics for the given database
+// connection pool, and for the number of transactions
+// re-attempted on busy, as returned by the retries func.
+func InstrumentDB(sqldb *sql.DB, retries func() uint64) error {
+	if !config.GetMetricsEnabled() {
+		return nil
+	}
+
+	meter := otel.GetMeterProvider().Meter(serviceName)
+
+	maxOpen, err := meter.Int64ObservableGauge(
+		"gotosocial.database.connections.max_open",
+		metric.WithDescription("Maximum number of open connections to the database"),
+	)
+	if err != nil {
+		return err
+	}
+
+	open, err := meter.Int64ObservableGauge(
+		"gotosocial.database.connections.open",
+		metric.WithDescription("Number of established connections to the database, both in use and idle"),
+	)
+	if err != nil {
+		return err
+	}
+
+	inUse, err := meter.Int64ObservableGauge(
+		"gotosocial.database.connections.in_use",
+		metric.WithDescription("Number of database connections currently in use"),
+	)
+	if err != nil {
+		return err
+	}
+
+	idle, err := meter.Int64ObservableGauge(
+		"gotosocial.database.connections.idle",
+		metric.WithDescription("Number of idle database connections"),
+	)
+	if err != nil {
+		return err
+	}
+
+	waitCount, err := meter.Int64ObservableCounter(
+		"gotosocial.database.connections.wait_count",
+		metric.WithDescription("Total number of times a query waited for a free database connection"),
+	)
+	if err != nil {
+		return err
+	}
+
+	waitDuration, err := meter.Float64ObservableCounter(
+		"gotosocial.database.connections.wait_duration",
+		metric.WithDescription("Total time spent waiting for a free database connection"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	closed, err := meter.Int64ObservableCounter(
+		"gotosocial.database.connections.closed",
+		metric.WithDescription("Total number of database connections closed, by reason"),
+	)
+	if err != nil {
+		return err
+	}
+
+	txRetries, err := meter.Int64ObservableCounter(
+		"gotosocial.database.transaction.retries",
+		metric.WithDescription("Total number of queries and transactions re-attempted due to a busy database"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(
+		func(_ context.Context, o metric.Observer) error {
+			stats := sqldb.Stats()
+			o.ObserveInt64(maxOpen, int64(stats.MaxOpenConnections))
+			o.ObserveInt64(open, int64(stats.OpenConnections))
+			o.ObserveInt64(inUse, int64(stats.InUse))
+			o.ObserveInt64(idle, int64(stats.Idle))
+			o.ObserveInt64(waitCount, stats.WaitCount)
+			o.ObserveFloat64(waitDuration, stats.WaitDuration.Seconds())
+			o.ObserveInt64(closed, stats.MaxIdleClosed, metric.WithAttributes(attribute.String("reason", "max_idle")))
+			o.ObserveInt64(closed, stats.MaxIdleTimeClosed, metric.WithAttributes(attribute.String("reason", "max_idle_time")))
+			o.ObserveInt64(closed, stats.MaxLifetimeClosed, metric.WithAttributes(attribute.String("reason", "max_lifetime")))
+			if retries != nil {
+				o.ObserveInt64(txRetries, int64(retries())) // #nosec G115 -- won't overflow
+			}
+			return nil
+		},
+		maxOpen, open, inUse, idle,
+		waitCount, waitDuration, closed,
+		txRetries,
 	)
+	return err
 }
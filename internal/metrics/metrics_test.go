@@ -0,0 +1,273 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !nometrics
+
+package metrics_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// setupReader sets a meter provider with a manual
+// reader, restoring the previous global meter
+// provider on cleanup.
+func setupReader(t *testing.T) *sdk.ManualReader {
+	reader := sdk.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdk.NewMeterProvider(sdk.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(prev) })
+	return reader
+}
+
+// collect returns the metric with the given
+// name from reader, failing the test if absent.
+func collect(t *testing.T, reader *sdk.ManualReader, name string) metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+
+	t.Fatalf("metric %s not recorded", name)
+	return metricdata.Metrics{}
+}
+
+// nopConnector is a database connector
+// that never successfully connects.
+type nopConnector struct{}
+
+func (nopConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("no connections here")
+}
+
+func (nopConnector) Driver() driver.Driver { return nil }
+
+// Minimal models to build queries with.
+type status struct {
+	bun.BaseModel `bun:"table:statuses"`
+	ID            string
+}
+
+type account struct {
+	bun.BaseModel `bun:"table:accounts"`
+	ID            string
+}
+
+func TestInstrumentBun(t *testing.T) {
+	reader := setupReader(t)
+
+	var (
+		ctx   = context.Background()
+		sqldb = sql.OpenDB(nopConnector{})
+		bunDB = bun.NewDB(sqldb, sqlitedialect.New())
+		hook  = metrics.InstrumentBun()
+	)
+	defer sqldb.Close()
+
+	for _, event := range []*bun.QueryEvent{
+		{
+			// Successful select.
+			IQuery: bunDB.NewSelect().Model(new(status)),
+		},
+		{
+			// Select with no rows isn't an error.
+			IQuery: bunDB.NewSelect().Model(new(status)),
+			Err:    sql.ErrNoRows,
+		},
+		{
+			// Failed insert.
+			IQuery: bunDB.NewInsert().Model(new(account)),
+			Err:    errors.New("constraint violation"),
+		},
+	} {
+		event.DB = bunDB
+		event.StartTime = time.Now().Add(-10 * time.Millisecond)
+		ctx := hook.BeforeQuery(ctx, event)
+		hook.AfterQuery(ctx, event)
+	}
+
+	m := collect(t, reader, "gotosocial.database.query.duration")
+	hist, ok := m.Data.(metricdata.Histogram[float64])
+	if !ok {
+		t.Fatalf("unexpected metric data type %T", m.Data)
+	}
+
+	counts := make(map[attribute.Distinct]uint64)
+	for _, dp := range hist.DataPoints {
+		counts[dp.Attributes.Equivalent()] = dp.Count
+
+		if dp.Sum < 0.01*float64(dp.Count) {
+			t.Errorf("expected recorded durations of at least 10ms, got sum %f", dp.Sum)
+		}
+	}
+
+	for _, expect := range []struct {
+		attrs attribute.Set
+		count uint64
+	}{
+		{
+			attrs: attribute.NewSet(
+				attribute.String("operation", "SELECT"),
+				attribute.String("table", "statuses"),
+				attribute.Bool("error", false),
+			),
+			count: 2,
+		},
+		{
+			attrs: attribute.NewSet(
+				attribute.String("operation", "INSERT"),
+				attribute.String("table", "accounts"),
+				attribute.Bool("error", true),
+			),
+			count: 1,
+		},
+	} {
+		if count := counts[expect.attrs.Equivalent()]; count != expect.count {
+			t.Errorf("expected %d queries with %v, got %d", expect.count, expect.attrs.ToSlice(), count)
+		}
+	}
+
+	if len(hist.DataPoints) != 2 {
+		t.Errorf("expected 2 query families, got %d", len(hist.DataPoints))
+	}
+}
+
+func TestInstrumentDB(t *testing.T) {
+	reader := setupReader(t)
+
+	config.SetMetricsEnabled(true)
+	defer config.SetMetricsEnabled(false)
+
+	sqldb := sql.OpenDB(nopConnector{})
+	sqldb.SetMaxOpenConns(8)
+	defer sqldb.Close()
+
+	if err := metrics.InstrumentDB(sqldb, func() uint64 { return 3 }); err != nil {
+		t.Fatal(err)
+	}
+
+	maxOpen := collect(t, reader, "gotosocial.database.connections.max_open")
+	if gauge, ok := maxOpen.Data.(metricdata.Gauge[int64]); !ok {
+		t.Errorf("unexpected max open data type %T", maxOpen.Data)
+	} else if len(gauge.DataPoints) != 1 || gauge.DataPoints[0].Value != 8 {
+		t.Errorf("unexpected max open data points %+v", gauge.DataPoints)
+	}
+
+	retries := collect(t, reader, "gotosocial.database.transaction.retries")
+	if sum, ok := retries.Data.(metricdata.Sum[int64]); !ok {
+		t.Errorf("unexpected retries data type %T", retries.Data)
+	} else if len(sum.DataPoints) != 1 || sum.DataPoints[0].Value != 3 {
+		t.Errorf("unexpected retries data points %+v", sum.DataPoints)
+	}
+
+	closed := collect(t, reader, "gotosocial.database.connections.closed")
+	if sum, ok := closed.Data.(metricdata.Sum[int64]); !ok {
+		t.Errorf("unexpected closed data type %T", closed.Data)
+	} else if len(sum.DataPoints) != 3 {
+		t.Errorf("expected closed connections by 3 reasons, got %d", len(sum.DataPoints))
+	}
+}
+
+func TestInstrumentGin(t *testing.T) {
+	reader := setupReader(t)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(metrics.InstrumentGin())
+	engine.GET("/users/:username/statuses/:status", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{
+		"/users/the_mighty_zork/statuses/01F8MHAMCHF6Y0QNT9NMJ1FSE3",
+		"/users/1happyturtle/statuses/01F8MHC8VWDRBQR0N1BATDDEM5",
+		"/nothing/here",
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		engine.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	m := collect(t, reader, "gotosocial.http.server.request.duration")
+	hist, ok := m.Data.(metricdata.Histogram[float64])
+	if !ok {
+		t.Fatalf("unexpected metric data type %T", m.Data)
+	}
+
+	counts := make(map[attribute.Distinct]uint64)
+	for _, dp := range hist.DataPoints {
+		counts[dp.Attributes.Equivalent()] = dp.Count
+	}
+
+	for _, expect := range []struct {
+		attrs attribute.Set
+		count uint64
+	}{
+		{
+			// Both status requests should be
+			// counted under the route template.
+			attrs: attribute.NewSet(
+				attribute.String("route", "/users/:username/statuses/:status"),
+				attribute.String("method", http.MethodGet),
+				attribute.Int("status", http.StatusOK),
+			),
+			count: 2,
+		},
+		{
+			// And anything not routed under
+			// one label, not the raw path.
+			attrs: attribute.NewSet(
+				attribute.String("route", "unmatched"),
+				attribute.String("method", http.MethodGet),
+				attribute.Int("status", http.StatusNotFound),
+			),
+			count: 1,
+		},
+	} {
+		if count := counts[expect.attrs.Equivalent()]; count != expect.count {
+			t.Errorf("expected %d requests with %v, got %d", expect.count, expect.attrs.ToSlice(), count)
+		}
+	}
+
+	if len(hist.DataPoints) != 2 {
+		t.Errorf("expected 2 routes, got %d", len(hist.DataPoints))
+	}
+}
@@ -20,6 +20,8 @@
 package metrics
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 
 	"github.com/gin-gonic/gin"
@@ -35,6 +37,17 @@ func Initialize(db db.DB) error {
 	return nil
 }
 
+// Delivery outcomes for RecordDelivery().
+const (
+	DeliverySuccess = "success"
+	DeliveryRetry   = "retry"
+	DeliveryFailed  = "failed"
+)
+
+func RecordActivityReceived(ctx context.Context, activityType string, objectType string) {}
+
+func RecordDelivery(ctx context.Context, domain string, outcome string) {}
+
 func InstrumentGin() gin.HandlerFunc {
 	return func(c *gin.Context) {}
 }
@@ -42,3 +55,7 @@ func InstrumentGin() gin.HandlerFunc {
 func InstrumentBun() bun.QueryHook {
 	return nil
 }
+
+func InstrumentDB(sqldb *sql.DB, retries func() uint64) error {
+	return nil
+}
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// error set on gin context by AI scraper middleware.
+var errAIScraper = errors.New("user-agent matched ai scraper pattern")
+
+// AIScraper returns a gin middleware handler that blocks
+// or tarpits requests from known AI scrapers, based on the
+// User-Agent patterns set on the local instance.
+//
+// Requests for robots.txt are always let through, so that
+// any scrapers which do honor it can still find out they
+// are not welcome.
+func AIScraper(state *state.State) gin.HandlerFunc {
+	switch mode := config.GetAdvancedAIScraperMode(); mode {
+	case config.AIScraperModeDisabled:
+		return func(ctx *gin.Context) {}
+
+	case config.AIScraperModeBlock:
+		return aiScraperFilter(state, 0)
+
+	case config.AIScraperModeTarpit:
+		return aiScraperFilter(state, config.GetAdvancedAIScraperTarpitDelay())
+
+	default:
+		panic("unrecognized ai scraper mode: " + mode)
+	}
+}
+
+func aiScraperFilter(state *state.State, delay time.Duration) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == "/robots.txt" {
+			// Always allowed.
+			c.Next()
+			return
+		}
+
+		scraper, err := isAIScraper(c.Request.Context(), state, c.Request.UserAgent())
+		if err != nil {
+			respondInternalServerError(c, err)
+			return
+		}
+
+		if !scraper {
+			// Allowed!
+			c.Next()
+			return
+		}
+
+		if delay > 0 {
+			// Tarpit mode: hold the request open for
+			// delay, or until the client gives up.
+			t := time.NewTimer(delay)
+			select {
+			case <-c.Request.Context().Done():
+				t.Stop()
+			case <-t.C:
+			}
+		}
+
+		_ = c.Error(errAIScraper)
+		respondBlocked(c)
+	}
+}
+
+// isAIScraper returns whether the given User-Agent matches any of
+// the local instance's AI scraper patterns (case-insensitively).
+func isAIScraper(ctx context.Context, state *state.State, userAgent string) (bool, error) {
+	if userAgent == "" {
+		// Handled by
+		// UserAgent().
+		return false, nil
+	}
+
+	instance, err := state.DB.GetInstance(
+		gtscontext.SetBarebones(ctx),
+		config.GetHost(),
+	)
+	if err != nil {
+		return false, gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	userAgent = strings.ToLower(userAgent)
+	for _, pattern := range instance.AIScrapers() {
+		if strings.Contains(userAgent, strings.ToLower(pattern)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,182 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func TestAIScraper(t *testing.T) {
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+
+	var state state.State
+	state.Caches.Init()
+	state.DB = testrig.NewTestDB(&state)
+	testrig.StandardDBSetup(state.DB, nil)
+	defer testrig.StandardDBTeardown(state.DB)
+
+	for _, test := range []struct {
+		name       string
+		mode       string
+		userAgents []string // set on instance, nil = defaults
+		path       string
+		userAgent  string
+		expect     int
+	}{
+		{
+			name:      "disabled",
+			mode:      config.AIScraperModeDisabled,
+			path:      "/",
+			userAgent: "Mozilla/5.0 (compatible; GPTBot/1.2; +https://openai.com/gptbot)",
+			expect:    http.StatusOK,
+		},
+		{
+			name:      "block default pattern",
+			mode:      config.AIScraperModeBlock,
+			path:      "/",
+			userAgent: "Mozilla/5.0 (compatible; GPTBot/1.2; +https://openai.com/gptbot)",
+			expect:    http.StatusForbidden,
+		},
+		{
+			name:      "block default pattern ignoring case",
+			mode:      config.AIScraperModeBlock,
+			path:      "/",
+			userAgent: "ccbot/2.0 (https://commoncrawl.org/faq/)",
+			expect:    http.StatusForbidden,
+		},
+		{
+			name:      "block allows other user agents",
+			mode:      config.AIScraperModeBlock,
+			path:      "/",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:133.0) Gecko/20100101 Firefox/133.0",
+			expect:    http.StatusOK,
+		},
+		{
+			name:      "block allows robots.txt",
+			mode:      config.AIScraperModeBlock,
+			path:      "/robots.txt",
+			userAgent: "Mozilla/5.0 (compatible; GPTBot/1.2; +https://openai.com/gptbot)",
+			expect:    http.StatusOK,
+		},
+		{
+			name:       "block custom pattern",
+			mode:       config.AIScraperModeBlock,
+			userAgents: []string{"SomeNewBot"},
+			path:       "/",
+			userAgent:  "SomeNewBot/0.1",
+			expect:     http.StatusForbidden,
+		},
+		{
+			name:       "block custom pattern replaces defaults",
+			mode:       config.AIScraperModeBlock,
+			userAgents: []string{"SomeNewBot"},
+			path:       "/",
+			userAgent:  "Mozilla/5.0 (compatible; GPTBot/1.2; +https://openai.com/gptbot)",
+			expect:     http.StatusOK,
+		},
+		{
+			name:      "tarpit default pattern",
+			mode:      config.AIScraperModeTarpit,
+			path:      "/",
+			userAgent: "Mozilla/5.0 (compatible; GPTBot/1.2; +https://openai.com/gptbot)",
+			expect:    http.StatusForbidden,
+		},
+	} {
+		config.SetAdvancedAIScraperMode(test.mode)
+		config.SetAdvancedAIScraperTarpitDelay(100 * time.Millisecond)
+
+		ok := t.Run(test.name, func(t *testing.T) {
+			testAIScraper(t,
+				&state,
+				test.mode,
+				test.userAgents,
+				test.path,
+				test.userAgent,
+				test.expect,
+			)
+		})
+
+		if !ok {
+			return
+		}
+	}
+}
+
+func testAIScraper(
+	t *testing.T,
+	state *state.State,
+	mode string,
+	userAgents []string,
+	path string,
+	userAgent string,
+	expect int,
+) {
+	ctx := context.Background()
+
+	// Set AI scraper patterns on the instance.
+	instance, err := state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		t.Fatalf("error getting instance: %v", err)
+	}
+
+	instance.AIScraperUserAgents = userAgents
+	if err := state.DB.UpdateInstance(ctx, instance, "ai_scraper_user_agents"); err != nil {
+		t.Fatalf("error updating instance: %v", err)
+	}
+
+	// Gin test http engine
+	// (used for ctx init).
+	e := gin.New()
+
+	// Create new AI scraper middleware to test against.
+	e.Use(middleware.AIScraper(state))
+
+	// Set the empty gin handler (always returns okay).
+	e.Handle("GET", path, func(ctx *gin.Context) { ctx.Status(200) })
+
+	// Prepare a gin test context.
+	r := httptest.NewRequest("GET", path, nil)
+	r.Header.Set("User-Agent", userAgent)
+	rw := httptest.NewRecorder()
+
+	// Pass req through
+	// engine handler.
+	start := time.Now()
+	e.ServeHTTP(rw, r)
+	took := time.Since(start)
+
+	if code := rw.Result().StatusCode; code != expect {
+		t.Errorf("unexpected response code: expected %d, got %d", expect, code)
+	}
+
+	tarpitted := mode == config.AIScraperModeTarpit && expect == http.StatusForbidden
+	if tarpitted && took < config.GetAdvancedAIScraperTarpitDelay() {
+		t.Errorf("expected tarpitted request to be held for %s, took %s", config.GetAdvancedAIScraperTarpitDelay(), took)
+	}
+}
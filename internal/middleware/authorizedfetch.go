@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// authorizedFetchRefresh is how long the addresses of
+	// domains with an authorized fetch override are kept
+	// before the domains are fetched and resolved again.
+	authorizedFetchRefresh = 10 * time.Minute
+
+	// authorizedFetchLookupTimeout limits
+	// time spent resolving each domain.
+	authorizedFetchLookupTimeout = 5 * time.Second
+)
+
+// AuthorizedFetch returns a function for use with SignatureCheck,
+// which checks whether an unsigned request from the given remote
+// address must be signed, according to the instance authorized
+// fetch mode and any per-domain overrides.
+//
+// Unsigned requests carry no proof of where they come from, so the
+// requesting domain is worked out by resolving each domain returned
+// by domains (ie., those with an override), and checking whether
+// the address is one of theirs. The user-agent is never used for
+// this, as it's trivially spoofed. An address that matches none of
+// the domains gets the instance default, by passing the empty string
+// to sigRequired. An address shared by several domains is only let
+// through unsigned if none of those domains require signatures.
+func AuthorizedFetch(
+	domains func(context.Context) ([]string, error),
+	sigRequired func(context.Context, string) (bool, error),
+) func(context.Context, netip.Addr) (bool, error) {
+	a := &authorizedFetch{
+		domains:     domains,
+		sigRequired: sigRequired,
+		lookup:      net.DefaultResolver.LookupNetIP,
+	}
+	return a.required
+}
+
+// authorizedFetch maps remote addresses to the domains
+// with authorized fetch overrides that resolve to them.
+type authorizedFetch struct {
+	domains     func(context.Context) ([]string, error)
+	sigRequired func(context.Context, string) (bool, error)
+	lookup      func(context.Context, string, string) ([]netip.Addr, error)
+
+	mu      sync.Mutex
+	byAddr  map[netip.Addr][]string
+	expires time.Time
+}
+
+// required checks whether an unsigned
+// request from addr must be signed.
+func (a *authorizedFetch) required(ctx context.Context, addr netip.Addr) (bool, error) {
+	matches, err := a.domainsFor(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+
+	if len(matches) == 0 {
+		// Use instance default.
+		return a.sigRequired(ctx, "")
+	}
+
+	for _, domain := range matches {
+		required, err := a.sigRequired(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+
+		if required {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// domainsFor returns the domains with authorized fetch
+// overrides resolving to addr, refreshing if necessary.
+func (a *authorizedFetch) domainsFor(ctx context.Context, addr netip.Addr) ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if time.Now().After(a.expires) {
+		if err := a.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return a.byAddr[addr.Unmap()], nil
+}
+
+// refresh fetches and resolves the domains with authorized
+// fetch overrides. The caller must hold the lock.
+func (a *authorizedFetch) refresh(ctx context.Context) error {
+	domains, err := a.domains(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Don't let a cancelled request leave
+	// us caching an incomplete address map.
+	ctx = context.WithoutCancel(ctx)
+
+	byAddr := make(map[netip.Addr][]string)
+	for _, domain := range domains {
+		lookupCtx, cncl := context.WithTimeout(ctx, authorizedFetchLookupTimeout)
+		addrs, err := a.lookup(lookupCtx, "ip", domain)
+		cncl()
+
+		if err != nil {
+			// Domain may not resolve (any more),
+			// in which case it matches nothing.
+			log.Debugf(ctx, "error resolving %s: %v", domain, err)
+			continue
+		}
+
+		for _, addr := range addrs {
+			addr = addr.Unmap()
+			byAddr[addr] = append(byAddr[addr], domain)
+		}
+	}
+
+	a.byAddr = byAddr
+	a.expires = time.Now().Add(authorizedFetchRefresh)
+	return nil
+}
@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestAuthorizedFetch(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		// Times domains were fetched.
+		fetched int
+
+		// Override modes by domain, true for require.
+		modes = map[string]bool{
+			"strict.example":  true,
+			"lenient.example": false,
+			"shared.example":  true,
+			"gone.example":    false,
+		}
+
+		// Resolved addresses by domain.
+		addrs = map[string][]netip.Addr{
+			"strict.example":  {netip.MustParseAddr("192.0.2.1")},
+			"lenient.example": {netip.MustParseAddr("192.0.2.2"), netip.MustParseAddr("2001:db8::2")},
+			"shared.example":  {netip.MustParseAddr("192.0.2.2")},
+		}
+	)
+
+	domains := func(context.Context) ([]string, error) {
+		fetched++
+		return []string{"strict.example", "lenient.example", "gone.example"}, nil
+	}
+
+	instanceRequire := true
+	sigRequired := func(_ context.Context, domain string) (bool, error) {
+		if domain == "" {
+			return instanceRequire, nil
+		}
+		return modes[domain], nil
+	}
+
+	a := &authorizedFetch{
+		domains:     domains,
+		sigRequired: sigRequired,
+		lookup: func(_ context.Context, _ string, host string) ([]netip.Addr, error) {
+			if addrs, ok := addrs[host]; ok {
+				return addrs, nil
+			}
+			return nil, errors.New("no such host")
+		},
+	}
+
+	required := func(addr string) bool {
+		required, err := a.required(ctx, netip.MustParseAddr(addr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return required
+	}
+
+	for _, test := range []struct {
+		addr     string
+		required bool
+	}{
+		// Address of a domain always requiring.
+		{"192.0.2.1", true},
+
+		// Addresses of a domain never requiring.
+		{"192.0.2.2", false},
+		{"2001:db8::2", false},
+		{"::ffff:192.0.2.2", false},
+
+		// Unknown address uses instance default.
+		{"192.0.2.3", true},
+	} {
+		if got := required(test.addr); got != test.required {
+			t.Errorf("%s: expected required %v, got %v", test.addr, test.required, got)
+		}
+	}
+
+	if fetched != 1 {
+		t.Errorf("expected domains to be fetched once, got %d", fetched)
+	}
+
+	// Instance default should
+	// be used for unknown address.
+	instanceRequire = false
+	if required("192.0.2.3") {
+		t.Error("expected unknown address to follow instance default")
+	}
+
+	// Once expired, domains are fetched
+	// and resolved again; an address now
+	// shared with a domain that requires
+	// signatures should require them.
+	domains = func(context.Context) ([]string, error) {
+		fetched++
+		return []string{"strict.example", "lenient.example", "shared.example"}, nil
+	}
+	a.domains = domains
+	a.expires = time.Now().Add(-time.Second)
+
+	if !required("192.0.2.2") {
+		t.Error("expected shared address to require signatures")
+	}
+
+	if fetched != 2 {
+		t.Errorf("expected domains to be fetched twice, got %d", fetched)
+	}
+}
@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// error set on gin context by IP block middleware.
+var errIPBlocked = errors.New("client ip matched no_access ip block")
+
+// IPBlock returns a gin middleware handler that blocks
+// requests from client IPs in the range of any unexpired
+// IP block with severity no_access.
+//
+// Sign-up blocks are enforced when processing sign-ups.
+func IPBlock(state *state.State) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		addr, err := netip.ParseAddr(c.ClientIP())
+		if err != nil {
+			// Nothing
+			// to match.
+			c.Next()
+			return
+		}
+
+		block, err := state.DB.IPBlockMatch(c.Request.Context(), addr)
+		if err != nil {
+			err := gtserror.Newf("db error matching ip block: %w", err)
+			respondInternalServerError(c, err)
+			return
+		}
+
+		if block == nil || block.Severity < gtsmodel.IPBlockSeverityNoAccess {
+			// Allowed!
+			c.Next()
+			return
+		}
+
+		_ = c.Error(errIPBlocked)
+		respondBlocked(c)
+	}
+}
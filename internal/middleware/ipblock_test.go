@@ -0,0 +1,126 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func TestIPBlock(t *testing.T) {
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+
+	var state state.State
+	state.Caches.Init()
+	state.DB = testrig.NewTestDB(&state)
+	testrig.StandardDBSetup(state.DB, nil)
+	defer testrig.StandardDBTeardown(state.DB)
+
+	ctx := context.Background()
+	for _, block := range []*gtsmodel.IPBlock{
+		{
+			ID:                 "01JJAS2W7VQ2K0ND3F3QXWJ7DZ",
+			IP:                 "192.0.2.0/24",
+			Severity:           gtsmodel.IPBlockSeverityNoAccess,
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+		{
+			ID:                 "01JJAS36E3W4Q2S8B9J2QZB2XM",
+			IP:                 "198.51.100.0/24",
+			Severity:           gtsmodel.IPBlockSeveritySignUpBlock,
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+		{
+			ID:                 "01JJAS3FDN2C5JQX6CZ1TXWR8S",
+			IP:                 "203.0.113.0/24",
+			Severity:           gtsmodel.IPBlockSeverityNoAccess,
+			ExpiresAt:          time.Now().Add(-time.Hour),
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+	} {
+		if err := state.DB.PutIPBlock(ctx, block); err != nil {
+			t.Fatalf("error inserting ip block: %v", err)
+		}
+	}
+
+	for _, test := range []struct {
+		name       string
+		remoteAddr string
+		expect     int
+	}{
+		{
+			name:       "no access block",
+			remoteAddr: "192.0.2.10:443",
+			expect:     http.StatusForbidden,
+		},
+		{
+			name:       "sign up block allows access",
+			remoteAddr: "198.51.100.10:443",
+			expect:     http.StatusOK,
+		},
+		{
+			name:       "expired block allows access",
+			remoteAddr: "203.0.113.10:443",
+			expect:     http.StatusOK,
+		},
+		{
+			name:       "unblocked address",
+			remoteAddr: "[2001:db8::1]:443",
+			expect:     http.StatusOK,
+		},
+	} {
+		ok := t.Run(test.name, func(t *testing.T) {
+			// Gin test http engine
+			// (used for ctx init).
+			e := gin.New()
+
+			// Create new IP block middleware to test against.
+			e.Use(middleware.IPBlock(&state))
+
+			// Set the empty gin handler (always returns okay).
+			e.Handle("GET", "/", func(ctx *gin.Context) { ctx.Status(200) })
+
+			// Prepare a gin test context.
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = test.remoteAddr
+			rw := httptest.NewRecorder()
+
+			// Pass req through
+			// engine handler.
+			e.ServeHTTP(rw, r)
+
+			if code := rw.Result().StatusCode; code != test.expect {
+				t.Errorf("unexpected response code: expected %d, got %d", test.expect, code)
+			}
+		})
+
+		if !ok {
+			return
+		}
+	}
+}
@@ -22,11 +22,16 @@
 	"net/http"
 	"net/netip"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/oauth2/v4"
 	"github.com/ulule/limiter/v3"
 	"github.com/ulule/limiter/v3/drivers/store/memory"
 
@@ -35,6 +40,51 @@
 
 const rateLimitPeriod = 5 * time.Minute
 
+// RateLimitScope is a group of endpoints, identified
+// by request path prefix, which have a rate limit of
+// their own, separate from the limit for other endpoints.
+type RateLimitScope struct {
+	// Request path prefixes of
+	// endpoints in this scope,
+	// eg., "/api/v2/search".
+	Prefixes []string
+
+	// Max requests allowed per time period for
+	// endpoints in this scope. If <= 0, requests
+	// to these endpoints count toward the main
+	// limit as though the scope wasn't defined.
+	Limit int
+}
+
+// RateLimitConfig contains
+// configuration for RateLimitWithConfig.
+type RateLimitConfig struct {
+	// Max requests allowed per time period.
+	// If <= 0, rate limiting is disabled.
+	Limit int
+
+	// CIDRs of client IPs exempt
+	// from rate limit restrictions.
+	Exceptions []string
+
+	// Key determines how requests are grouped
+	// together for rate limiting; one of
+	// config.RateLimitKeyIP (or empty string),
+	// config.RateLimitKeyToken, or config.RateLimitKeyAccount.
+	//
+	// When keying by token or account, the TokenCheck
+	// middleware must run *before* rate limiting, so
+	// that authenticated requests can be told apart.
+	// Requests that aren't authenticated with a token
+	// bound to a user (including app-only tokens, which
+	// anyone can mint by registering an app) are still
+	// keyed by client IP address.
+	Key string
+
+	// Scopes with rate limits of their own.
+	Scopes []RateLimitScope
+}
+
 // RateLimit returns a gin middleware that will automatically rate
 // limit caller (by IP address), and enrich the response header with
 // the following headers:
@@ -49,23 +99,46 @@
 // If the config AdvancedRateLimitRequests value is <= 0, then a noop
 // handler will be returned, which performs no rate limiting.
 func RateLimit(limit int, exceptions []string) gin.HandlerFunc {
-	if limit <= 0 {
+	return RateLimitWithConfig(RateLimitConfig{
+		Limit:      limit,
+		Exceptions: exceptions,
+	})
+}
+
+// RateLimitWithConfig is like RateLimit, but additionally
+// allows rate limiting authenticated callers by OAuth token
+// or account (so that callers behind one IP address don't
+// all share a limit), and allows setting separate limits
+// for scopes of endpoints.
+func RateLimitWithConfig(cfg RateLimitConfig) gin.HandlerFunc {
+	if cfg.Limit <= 0 {
 		// Rate limiting is disabled.
 		// Return noop middleware.
 		return func(ctx *gin.Context) {}
 	}
 
-	limiter := limiter.New(
-		memory.NewStore(),
-		limiter.Rate{
-			Period: rateLimitPeriod,
-			Limit:  int64(limit),
-		},
-	)
+	mainLimiter := newLimiter(cfg.Limit)
+
+	// Prepare a separate limiter for each
+	// scope that has a limit of its own.
+	type scope struct {
+		prefixes []string
+		limiter  *limiter.Limiter
+	}
+	scopes := make([]scope, 0, len(cfg.Scopes))
+	for _, s := range cfg.Scopes {
+		if s.Limit <= 0 {
+			continue
+		}
+		scopes = append(scopes, scope{
+			prefixes: s.Prefixes,
+			limiter:  newLimiter(s.Limit),
+		})
+	}
 
 	// Convert exceptions IP ranges into prefixes.
-	exceptPrefs := make([]netip.Prefix, len(exceptions))
-	for i, str := range exceptions {
+	exceptPrefs := make([]netip.Prefix, len(cfg.Exceptions))
+	for i, str := range cfg.Exceptions {
 		exceptPrefs[i] = netip.MustParsePrefix(str)
 	}
 
@@ -106,8 +179,19 @@ func RateLimit(limit int, exceptions []string) gin.HandlerFunc {
 			clientIP, _ = netip.AddrFromSlice(asIP)
 		}
 
-		// Fetch rate limit info for this (masked) clientIP.
-		context, err := limiter.Get(c, clientIP.String())
+		// Use the limiter for the scope
+		// this request falls in, if any.
+		limiter := mainLimiter
+		for _, scope := range scopes {
+			if hasPathPrefix(c.Request.URL.Path, scope.prefixes) {
+				limiter = scope.limiter
+				break
+			}
+		}
+
+		// Fetch rate limit info for this caller.
+		key := rateLimitKey(c, cfg.Key, clientIP)
+		context, err := limiter.Get(c, key)
 		if err != nil {
 			// Since we use an in-memory cache now,
 			// it's actually impossible for this to
@@ -157,3 +241,51 @@ func RateLimit(limit int, exceptions []string) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// newLimiter returns a new in-memory limiter
+// allowing limit requests per rateLimitPeriod.
+func newLimiter(limit int) *limiter.Limiter {
+	return limiter.New(
+		memory.NewStore(),
+		limiter.Rate{
+			Period: rateLimitPeriod,
+			Limit:  int64(limit),
+		},
+	)
+}
+
+// rateLimitKey returns the key to group the request
+// under for rate limiting, according to the given key
+// type. When keying by token or account, falls back
+// to the client IP if the request isn't authenticated
+// by a user, as app-only tokens are free to come by.
+func rateLimitKey(c *gin.Context, keyType string, clientIP netip.Addr) string {
+	switch keyType {
+	case config.RateLimitKeyToken:
+		if ti, ok := c.Get(oauth.SessionAuthorizedToken); ok {
+			if ti, ok := ti.(oauth2.TokenInfo); ok && ti.GetUserID() != "" {
+				return "token:" + ti.GetAccess()
+			}
+		}
+
+	case config.RateLimitKeyAccount:
+		if acct, ok := c.Get(oauth.SessionAuthorizedAccount); ok {
+			if acct, ok := acct.(*gtsmodel.Account); ok {
+				return "account:" + acct.ID
+			}
+		}
+	}
+
+	return clientIP.String()
+}
+
+// hasPathPrefix returns true if
+// path has one of the given prefixes.
+func hasPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -26,8 +26,13 @@
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/oauth2/v4"
+	"github.com/superseriousbusiness/oauth2/v4/models"
 )
 
 type RateLimitTestSuite struct {
@@ -187,6 +192,184 @@ type rlTest struct {
 	}
 }
 
+// doRateLimitedRequest calls the given rate limit middleware
+// with a request to path from clientIP, with the given account
+// and token (if not nil) set on the context as though by
+// TokenCheck, and returns the recorded response.
+func doRateLimitedRequest(
+	rlMiddleware gin.HandlerFunc,
+	path string,
+	clientIP string,
+	account *gtsmodel.Account,
+	token oauth2.TokenInfo,
+) *httptest.ResponseRecorder {
+	var (
+		recorder = httptest.NewRecorder()
+		ctx, e   = gin.CreateTestContext(recorder)
+	)
+
+	e.TrustedPlatform = "X-Test-IP"
+	ctx.Request = httptest.NewRequest(http.MethodGet, path, nil)
+	ctx.Request.Header.Add("X-Test-IP", clientIP)
+
+	if account != nil {
+		ctx.Set(oauth.SessionAuthorizedAccount, account)
+	}
+
+	if token != nil {
+		ctx.Set(oauth.SessionAuthorizedToken, token)
+	}
+
+	rlMiddleware(ctx)
+	return recorder
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitKeyAccount() {
+	gin.SetMode(gin.ReleaseMode)
+
+	var (
+		rlMiddleware = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limit: 2,
+			Key:   config.RateLimitKeyAccount,
+		})
+		account1 = &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"}
+		account2 = &gtsmodel.Account{ID: "01F8MH5NBDF2MV7CTC4Q5128HF"}
+	)
+
+	// Account 1 uses up its limit from two different IPs.
+	suite.Equal(http.StatusOK, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", account1, nil).Code)
+	suite.Equal(http.StatusOK, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.2", account1, nil).Code)
+	suite.Equal(http.StatusTooManyRequests, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", account1, nil).Code)
+
+	// Account 2 behind the same
+	// IP should be unaffected.
+	rec := doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", account2, nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("1", rec.Header().Get("X-RateLimit-Remaining"))
+
+	// Unauthenticated requests from the
+	// same IP are limited by IP instead.
+	rec = doRateLimitedRequest(rlMiddleware, "/api/v1/instance", "192.0.2.1", nil, nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("1", rec.Header().Get("X-RateLimit-Remaining"))
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitKeyTokenBehindIP() {
+	gin.SetMode(gin.ReleaseMode)
+
+	var (
+		rlMiddleware = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limit: 3,
+			Key:   config.RateLimitKeyToken,
+		})
+		account1 = &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"}
+		account2 = &gtsmodel.Account{ID: "01F8MH5NBDF2MV7CTC4Q5128HF"}
+		token1   = &models.Token{UserID: "01F8MGVGPHQ2D3P3X0454H54Z5", Access: "NZAZOTC0OWITMDU1NC0ZODG3LWE4NJITMWUXM2M4MTRHZDEX"}
+		token2   = &models.Token{UserID: "01F8MH1VYJAE00TVVGMM5JNJ8X", Access: "PIPINALKNNNFNF98717NAMNAMNFKIJKJ881818KJKJAKJJJA"}
+	)
+
+	// Two tokens used from behind the same
+	// IP (eg., NAT) each get a full budget.
+	for _, test := range []struct {
+		account *gtsmodel.Account
+		token   oauth2.TokenInfo
+	}{
+		{account1, token1},
+		{account2, token2},
+	} {
+		for i := 1; i <= 3; i++ {
+			rec := doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", test.account, test.token)
+			suite.Equal(http.StatusOK, rec.Code)
+			suite.Equal(strconv.Itoa(3-i), rec.Header().Get("X-RateLimit-Remaining"))
+		}
+		suite.Equal(http.StatusTooManyRequests, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", test.account, test.token).Code)
+	}
+
+	// Unauthenticated requests from that
+	// IP still get a budget of their own.
+	for i := 1; i <= 3; i++ {
+		suite.Equal(http.StatusOK, doRateLimitedRequest(rlMiddleware, "/api/v1/instance", "192.0.2.1", nil, nil).Code)
+	}
+	suite.Equal(http.StatusTooManyRequests, doRateLimitedRequest(rlMiddleware, "/api/v1/instance", "192.0.2.1", nil, nil).Code)
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitKeyToken() {
+	gin.SetMode(gin.ReleaseMode)
+
+	var (
+		rlMiddleware = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limit: 1,
+			Key:   config.RateLimitKeyToken,
+		})
+		account = &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"}
+		token1  = &models.Token{UserID: "01F8MGVGPHQ2D3P3X0454H54Z5", Access: "NZAZOTC0OWITMDU1NC0ZODG3LWE4NJITMWUXM2M4MTRHZDEX"}
+		token2  = &models.Token{UserID: "01F8MGVGPHQ2D3P3X0454H54Z5", Access: "PIPINALKNNNFNF98717NAMNAMNFKIJKJ881818KJKJAKJJJA"}
+	)
+
+	// Tokens of the same account
+	// have separate limits.
+	suite.Equal(http.StatusOK, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", account, token1).Code)
+	suite.Equal(http.StatusTooManyRequests, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", account, token1).Code)
+	suite.Equal(http.StatusOK, doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", account, token2).Code)
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitKeyTokenAppOnly() {
+	gin.SetMode(gin.ReleaseMode)
+
+	var (
+		rlMiddleware = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limit: 1,
+			Key:   config.RateLimitKeyToken,
+		})
+		token1 = &models.Token{Access: "NZAZOTC0OWITMDU1NC0ZODG3LWE4NJITMWUXM2M4MTRHZDEX"}
+		token2 = &models.Token{Access: "PIPINALKNNNFNF98717NAMNAMNFKIJKJ881818KJKJAKJJJA"}
+	)
+
+	// App-only tokens (not bound to a user) are
+	// limited by IP, so minting a fresh one
+	// doesn't give a caller a fresh budget.
+	suite.Equal(http.StatusOK, doRateLimitedRequest(rlMiddleware, "/api/v1/instance", "192.0.2.1", nil, token1).Code)
+	suite.Equal(http.StatusTooManyRequests, doRateLimitedRequest(rlMiddleware, "/api/v1/instance", "192.0.2.1", nil, token2).Code)
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitScopes() {
+	gin.SetMode(gin.ReleaseMode)
+
+	rlMiddleware := middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+		Limit: 3,
+		Scopes: []middleware.RateLimitScope{
+			{Prefixes: []string{"/api/v1/search", "/api/v2/search"}, Limit: 1},
+			{Prefixes: []string{"/api/v1/streaming"}, Limit: 5},
+			{Prefixes: []string{"/api/v1/timelines"}, Limit: 0},
+		},
+	})
+
+	// Search has its own, stricter, limit
+	// shared between the prefixes of the scope.
+	rec := doRateLimitedRequest(rlMiddleware, "/api/v2/search?q=zork", "192.0.2.1", nil, nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("1", rec.Header().Get("X-RateLimit-Limit"))
+	suite.Equal(http.StatusTooManyRequests, doRateLimitedRequest(rlMiddleware, "/api/v1/search", "192.0.2.1", nil, nil).Code)
+
+	// Streaming has its own, looser, limit.
+	rec = doRateLimitedRequest(rlMiddleware, "/api/v1/streaming", "192.0.2.1", nil, nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("5", rec.Header().Get("X-RateLimit-Limit"))
+
+	// Scopes without a limit of their own, and
+	// requests not in any scope, count toward
+	// the main limit, which is unaffected by
+	// the search and streaming requests above.
+	rec = doRateLimitedRequest(rlMiddleware, "/api/v1/timelines/home", "192.0.2.1", nil, nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("3", rec.Header().Get("X-RateLimit-Limit"))
+	suite.Equal("2", rec.Header().Get("X-RateLimit-Remaining"))
+
+	rec = doRateLimitedRequest(rlMiddleware, "/api/v1/instance", "192.0.2.1", nil, nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("1", rec.Header().Get("X-RateLimit-Remaining"))
+}
+
 func TestRateLimitTestSuite(t *testing.T) {
 	suite.Run(t, new(RateLimitTestSuite))
 }
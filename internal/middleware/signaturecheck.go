@@ -18,12 +18,19 @@
 package middleware
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"net/http"
+	"net/netip"
 	"net/url"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/httpsig"
@@ -46,14 +53,40 @@
 // blocked, the handler will set the key verifier and the signature in the
 // context for use down the line.
 //
+// Both RFC 9421 (HTTP Message Signatures) and draft-cavage signatures are
+// supported; the former is detected by presence of a Signature-Input header.
+//
+// If a GET or HEAD request is not signed at all, the sigRequired function
+// is used to check whether authorized fetch is required for the client
+// address of the request (see AuthorizedFetch). If it's not, the request
+// context is marked as permitted to receive public content without a
+// signature.
+//
 // In case of an error, the request will be aborted with http code 500.
-func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) func(*gin.Context) {
+func SignatureCheck(
+	uriBlocked func(context.Context, *url.URL) (bool, error),
+	sigRequired func(context.Context, netip.Addr) (bool, error),
+) func(*gin.Context) {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 
+		// Advertise support for RFC 9421 signatures,
+		// so remotes may negotiate their use with us.
+		c.Header(rfc9421.AcceptSignatureHeader, rfc9421.AcceptSignature(c.Request.Method))
+
+		var (
+			verifier httpsig.VerifierWithOptions
+			err      error
+		)
+
 		// Create the signature verifier from the request;
 		// this will error if the request wasn't signed.
-		verifier, err := httpsig.NewVerifier(c.Request)
+		if rfc9421.IsSigned(c.Request.Header) {
+			verifier, err = rfc9421.NewVerifier(c.Request, config.GetProtocol())
+		} else {
+			verifier, err = httpsig.NewVerifier(c.Request)
+		}
+
 		if err != nil {
 			// Only actually *abort* the request with 401
 			// if a signature was present but malformed.
@@ -62,8 +95,12 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 			if err.Error() != noSigError {
 				log.Debugf(ctx, "http signature was present but invalid: %s", err)
 				c.AbortWithStatus(http.StatusUnauthorized)
+				return
 			}
 
+			// Unsigned request, check if we
+			// may serve it public content.
+			unsignedFetch(c, sigRequired)
 			return
 		}
 
@@ -99,6 +136,15 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 			return
 		}
 
+		// RFC 9421 signatures cover a digest of the
+		// body rather than the body itself, so check
+		// that the body actually matches the digest.
+		if v, ok := verifier.(*rfc9421.Verifier); ok && v.CoversDigest() {
+			if !verifyDigest(c, v) {
+				return
+			}
+		}
+
 		// Assume signature was set on Signature header,
 		// but fall back to Authorization header if necessary.
 		signature := c.GetHeader(sigHeader)
@@ -117,3 +163,74 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 		c.Request = c.Request.WithContext(ctx)
 	}
 }
+
+// verifyDigest checks the body of the request in c against
+// the digest covered by the given verifier, replacing the
+// body so it can be read again. If the digest doesn't match,
+// the request is aborted and false is returned.
+func verifyDigest(c *gin.Context, v *rfc9421.Verifier) bool {
+	ctx := c.Request.Context()
+
+	// The signature isn't verified yet, so
+	// don't read more than we'll ever need.
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, ap.MaxIncomingBodySize)
+
+	b, err := io.ReadAll(body)
+	_ = body.Close()
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			log.Debugf(ctx, "request body too large: %v", err)
+			c.AbortWithStatus(http.StatusRequestEntityTooLarge)
+			return false
+		}
+
+		log.Errorf(ctx, "error reading request body: %v", err)
+		c.AbortWithStatus(http.StatusBadRequest)
+		return false
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(b))
+
+	if err := v.VerifyDigest(b); err != nil {
+		log.Debugf(ctx, "http signature digest did not match body: %v", err)
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+// unsignedFetch checks whether the unsigned request in c may be
+// served public content without authorized fetch, according to
+// the authorized fetch mode for the client address, and marks
+// the request context accordingly.
+func unsignedFetch(c *gin.Context, sigRequired func(context.Context, netip.Addr) (bool, error)) {
+	if c.Request.Method != http.MethodGet &&
+		c.Request.Method != http.MethodHead {
+		// Only ever relevant for fetches.
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// An unparseable client IP gives the invalid
+	// address, which won't match any domain, so
+	// the instance default will be used.
+	addr, _ := netip.ParseAddr(c.ClientIP())
+
+	required, err := sigRequired(ctx, addr)
+	if err != nil {
+		log.Errorf(ctx, "error checking authorized fetch mode for %s: %s", addr, err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	if required {
+		// Nothing to do, signature
+		// will be rejected as missing.
+		return
+	}
+
+	ctx = gtscontext.SetUnsignedFetchPermitted(ctx)
+	c.Request = c.Request.WithContext(ctx)
+}
@@ -0,0 +1,223 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func TestSignatureCheckUnsignedFetch(t *testing.T) {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	uriBlocked := func(context.Context, *url.URL) (bool, error) {
+		return false, nil
+	}
+
+	for _, test := range []struct {
+		name string
+
+		// Request.
+		method     string
+		remoteAddr string
+		userAgent  string
+
+		// Address to require signatures from,
+		// all others use instanceRequire.
+		requireAddr     string
+		addrRequire     bool
+		instanceRequire bool
+
+		// Expected address passed to
+		// sigRequired, or nil if
+		// it shouldn't be called.
+		expectAddr      *string
+		expectPermitted bool
+	}{
+		{
+			name:            "instance requires, no override",
+			method:          http.MethodGet,
+			remoteAddr:      "192.0.2.1:1234",
+			instanceRequire: true,
+			expectAddr:      util.Ptr("192.0.2.1"),
+			expectPermitted: false,
+		},
+		{
+			name:            "instance optional, no override",
+			method:          http.MethodGet,
+			remoteAddr:      "192.0.2.1:1234",
+			instanceRequire: false,
+			expectAddr:      util.Ptr("192.0.2.1"),
+			expectPermitted: true,
+		},
+		{
+			name:            "address always requires",
+			method:          http.MethodGet,
+			remoteAddr:      "192.0.2.1:1234",
+			requireAddr:     "192.0.2.1",
+			addrRequire:     true,
+			instanceRequire: false,
+			expectAddr:      util.Ptr("192.0.2.1"),
+			expectPermitted: false,
+		},
+		{
+			name:            "address never requires",
+			method:          http.MethodGet,
+			remoteAddr:      "[2001:db8::1]:1234",
+			requireAddr:     "2001:db8::1",
+			addrRequire:     false,
+			instanceRequire: true,
+			expectAddr:      util.Ptr("2001:db8::1"),
+			expectPermitted: true,
+		},
+		{
+			name:            "head request",
+			method:          http.MethodHead,
+			remoteAddr:      "192.0.2.1:1234",
+			instanceRequire: false,
+			expectAddr:      util.Ptr("192.0.2.1"),
+			expectPermitted: true,
+		},
+		{
+			name:            "user agent doesn't affect address",
+			method:          http.MethodGet,
+			remoteAddr:      "192.0.2.2:1234",
+			userAgent:       "GoToSocial/0.17.0 (+https://example.org)",
+			requireAddr:     "192.0.2.1",
+			addrRequire:     false,
+			instanceRequire: true,
+			expectAddr:      util.Ptr("192.0.2.2"),
+			expectPermitted: false,
+		},
+		{
+			name:            "unsigned post still rejected",
+			method:          http.MethodPost,
+			remoteAddr:      "192.0.2.1:1234",
+			requireAddr:     "192.0.2.1",
+			addrRequire:     false,
+			instanceRequire: false,
+			expectAddr:      nil,
+			expectPermitted: false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var gotAddr *string
+			sigRequired := func(_ context.Context, addr netip.Addr) (bool, error) {
+				str := addr.String()
+				gotAddr = &str
+				if str == test.requireAddr {
+					return test.addrRequire, nil
+				}
+				return test.instanceRequire, nil
+			}
+
+			var (
+				recorder = httptest.NewRecorder()
+				ctx, _   = gin.CreateTestContext(recorder)
+			)
+
+			ctx.Request = httptest.NewRequest(test.method, "https://localhost:8080/users/the_mighty_zork", nil)
+			ctx.Request.RemoteAddr = test.remoteAddr
+			ctx.Request.Header.Set("User-Agent", test.userAgent)
+
+			middleware.SignatureCheck(uriBlocked, sigRequired)(ctx)
+
+			if ctx.IsAborted() {
+				t.Fatalf("request unexpectedly aborted with %d", recorder.Code)
+			}
+
+			switch {
+			case test.expectAddr == nil && gotAddr != nil:
+				t.Errorf("expected sigRequired not to be called, got address %q", *gotAddr)
+			case test.expectAddr != nil && gotAddr == nil:
+				t.Errorf("expected sigRequired to be called with address %q", *test.expectAddr)
+			case test.expectAddr != nil && *gotAddr != *test.expectAddr:
+				t.Errorf("expected address %q, got %q", *test.expectAddr, *gotAddr)
+			}
+
+			permitted := gtscontext.UnsignedFetchPermitted(ctx.Request.Context())
+			if permitted != test.expectPermitted {
+				t.Errorf("expected unsigned fetch permitted %v, got %v", test.expectPermitted, permitted)
+			}
+
+			if gtscontext.HTTPSignatureVerifier(ctx.Request.Context()) != nil {
+				t.Error("expected no verifier to be set for unsigned request")
+			}
+		})
+	}
+}
+
+func TestSignatureCheckDigestBodyTooLarge(t *testing.T) {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sign a small body, but send one larger
+	// than we're willing to read to check it.
+	body := []byte(`{"type":"Create"}`)
+	out := httptest.NewRequest(http.MethodPost, "https://localhost:8080/users/the_mighty_zork/inbox", nil)
+	if err := rfc9421.SignRequest(out, "https://example.org/users/someone#main-key", key, body, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		recorder = httptest.NewRecorder()
+		ctx, _   = gin.CreateTestContext(recorder)
+	)
+
+	large := bytes.Repeat([]byte{' '}, int(ap.MaxIncomingBodySize)+1)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "https://localhost:8080/users/the_mighty_zork/inbox", bytes.NewReader(large))
+	ctx.Request.Header = out.Header.Clone()
+
+	uriBlocked := func(context.Context, *url.URL) (bool, error) {
+		return false, nil
+	}
+	sigRequired := func(context.Context, netip.Addr) (bool, error) {
+		return true, nil
+	}
+
+	middleware.SignatureCheck(uriBlocked, sigRequired)(ctx)
+
+	if !ctx.IsAborted() {
+		t.Fatal("expected request to be aborted")
+	}
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, recorder.Code)
+	}
+}
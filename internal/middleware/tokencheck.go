@@ -18,7 +18,10 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -27,6 +30,28 @@
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
+// tokenLastUsedInterval is the minimum interval
+// between updates of a token's last-used time, to
+// avoid doing a database write for every request.
+const tokenLastUsedInterval = time.Hour
+
+// disabledAllowedPaths are the API path prefixes
+// that a disabled user may still access, so that
+// they can view and appeal actions taken against them.
+var disabledAllowedPaths = []string{
+	"/api/v1/strikes",
+	"/api/v1/appeals",
+}
+
+// pendingDeletionAllowedPaths are the API path
+// prefixes that a user whose account is pending
+// deletion may still access, so that they can
+// see when it will be deleted, or cancel it.
+var pendingDeletionAllowedPaths = []string{
+	"/api/v1/user",
+	"/api/v1/accounts/delete",
+}
+
 // TokenCheck returns a new gin middleware for validating oauth tokens in requests.
 //
 // The middleware checks the request Authorization header for a valid oauth Bearer token.
@@ -37,7 +62,9 @@
 //
 // Then, it will check which *gtsmodel.User the token belongs to. If the user is not confirmed, not approved,
 // or has been disabled, then the middleware will return early. Otherwise, the User will be set on the
-// gin context for further processing by other functions.
+// gin context for further processing by other functions. As an exception, disabled
+// users may still access their strikes and appeals, so they can appeal being disabled.
+// Likewise, users whose account is pending deletion may still cancel the deletion.
 //
 // Next, it will look up the *gtsmodel.Account for the User. If the Account has been suspended, then the
 // middleware will return early. Otherwise, it will set the Account on the gin context too.
@@ -65,6 +92,9 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 		}
 		c.Set(oauth.SessionAuthorizedToken, ti)
 
+		// mark the token as used
+		updateTokenLastUsed(ctx, dbConn, ti.GetAccess())
+
 		// check for user-level token
 		if userID := ti.GetUserID(); userID != "" {
 			log.Tracef(ctx, "authenticated user %s with bearer token, scope is %s", userID, ti.GetScope())
@@ -90,11 +120,16 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 				return
 			}
 
-			if *user.Disabled {
+			if *user.Disabled && !pathAllowed(c.Request.URL.Path, disabledAllowedPaths) {
 				log.Warnf(ctx, "authenticated user %s's account was disabled'", userID)
 				return
 			}
 
+			if user.PendingDeletion() && !pathAllowed(c.Request.URL.Path, pendingDeletionAllowedPaths) {
+				log.Warnf(ctx, "authenticated user %s's account is pending deletion", userID)
+				return
+			}
+
 			c.Set(oauth.SessionAuthorizedUser, user)
 
 			// fetch account for this token
@@ -138,3 +173,41 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 		}
 	}
 }
+
+// updateTokenLastUsed updates the last-used time
+// of the token with the given access code, if it
+// hasn't been updated within tokenLastUsedInterval.
+func updateTokenLastUsed(ctx context.Context, dbConn db.DB, access string) {
+	if access == "" {
+		return
+	}
+
+	token, err := dbConn.GetTokenByAccess(ctx, access)
+	if err != nil {
+		log.Errorf(ctx, "database error looking for token: %s", err)
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(token.LastUsed) < tokenLastUsedInterval {
+		// Recently marked
+		// as used already.
+		return
+	}
+
+	token.LastUsed = now
+	if err := dbConn.UpdateToken(ctx, token, "last_used"); err != nil {
+		log.Errorf(ctx, "database error updating token last used: %s", err)
+	}
+}
+
+// pathAllowed returns whether the given request
+// path starts with one of the allowed prefixes.
+func pathAllowed(path string, allowed []string) bool {
+	for _, prefix := range allowed {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
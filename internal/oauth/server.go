@@ -60,6 +60,13 @@
 	HelpfulAdviceGrant = "If you arrived at this error during a sign in/oauth flow, your client is trying to use an unsupported OAuth grant type. Supported grant types are: authorization_code, client_credentials; please reach out to developer of your client"
 )
 
+// CodeChallengeMethods are the PKCE code challenge
+// methods (RFC 7636) supported by the oauth server.
+var CodeChallengeMethods = []oauth2.CodeChallengeMethod{
+	oauth2.CodeChallengePlain,
+	oauth2.CodeChallengeS256,
+}
+
 // Server wraps some oauth2 server functions in an interface, exposing only what is needed
 type Server interface {
 	HandleTokenRequest(r *http.Request) (map[string]interface{}, gtserror.WithCode)
@@ -99,10 +106,10 @@ func New(ctx context.Context, database db.DB) Server {
 			oauth2.AuthorizationCode,
 			oauth2.ClientCredentials,
 		},
-		AllowedCodeChallengeMethods: []oauth2.CodeChallengeMethod{
-			oauth2.CodeChallengePlain,
-			oauth2.CodeChallengeS256,
-		},
+		// Allow PKCE for the authorization code flow,
+		// but don't require it, since not all clients
+		// support it yet.
+		AllowedCodeChallengeMethods: CodeChallengeMethods,
 	}
 
 	srv := server.NewServer(sc, manager)
@@ -17,7 +17,10 @@
 
 package oidc
 
-import "encoding/gob"
+import (
+	"encoding/gob"
+	"strings"
+)
 
 // Claims represents claims as found in an id_token returned from an OIDC flow.
 type Claims struct {
@@ -32,3 +35,41 @@ type Claims struct {
 func init() {
 	gob.Register(&Claims{})
 }
+
+// extractGroups extracts a list of groups from the given
+// raw claims, using the given claim name. Nested claims
+// can be selected by separating claim names with dots,
+// eg., "realm_access.roles". Values may be either an
+// array of strings, or a single string. If the claim
+// can't be found, or is of another type, nil is returned.
+func extractGroups(raw map[string]any, claim string) []string {
+	var value any = raw
+	for _, name := range strings.Split(claim, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value, ok = m[name]
+		if !ok {
+			return nil
+		}
+	}
+
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+
+	case []any:
+		groups := make([]string, 0, len(value))
+		for _, v := range value {
+			if group, ok := v.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+		return groups
+
+	default:
+		return nil
+	}
+}
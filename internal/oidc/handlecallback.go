@@ -22,6 +22,7 @@
 	"errors"
 	"fmt"
 
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
@@ -63,6 +64,17 @@ func (i *idp) HandleCallback(ctx context.Context, code string) (*Claims, gtserro
 		return nil, gtserror.NewErrorInternalError(err, err.Error())
 	}
 
+	// Groups may be stored under a different
+	// (possibly nested) claim than "groups".
+	if groupsClaim := config.GetOIDCGroupsClaim(); groupsClaim != "" && groupsClaim != "groups" {
+		var raw map[string]any
+		if err := idToken.Claims(&raw); err != nil {
+			err := fmt.Errorf("could not parse raw claims from idToken: %s", err)
+			return nil, gtserror.NewErrorInternalError(err, err.Error())
+		}
+		claims.Groups = extractGroups(raw, groupsClaim)
+	}
+
 	return claims, nil
 }
 
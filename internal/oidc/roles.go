@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oidc
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+// Role models a GoToSocial user role
+// that OIDC group membership maps onto.
+type Role int
+
+const (
+	RoleUser      Role = iota // Regular user.
+	RoleModerator             // Moderator.
+	RoleAdmin                 // Admin (implies moderator).
+)
+
+// String returns a string representation of the role.
+func (r Role) String() string {
+	switch r {
+	case RoleUser:
+		return "user"
+	case RoleModerator:
+		return "moderator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// GroupsRole returns the highest role that membership of
+// the given OIDC groups entitles a user to, based on the
+// configured OIDC admin groups and moderator groups.
+func GroupsRole(groups []string) Role {
+	switch {
+	case InGroups(groups, config.GetOIDCAdminGroups()):
+		return RoleAdmin
+	case InGroups(groups, config.GetOIDCModeratorGroups()):
+		return RoleModerator
+	default:
+		return RoleUser
+	}
+}
+
+// InGroups returns true if one of the given claimed groups
+// is (case-insensitively) equal to one of the given groups.
+func InGroups(claimed []string, groups []string) bool {
+	for _, claimedGroup := range claimed {
+		if slices.ContainsFunc(groups, func(group string) bool {
+			return strings.EqualFold(claimedGroup, group)
+		}) {
+			return true
+		}
+	}
+	return false
+}
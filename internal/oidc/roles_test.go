@@ -0,0 +1,80 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oidc
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func TestGroupsRole(t *testing.T) {
+	testrig.InitTestConfig()
+	for _, test := range []struct {
+		name     string
+		groups   []string
+		expected Role
+	}{
+		{name: "no groups", groups: nil, expected: RoleUser},
+		{name: "no matching groups", groups: []string{"group1", "allowedRole"}, expected: RoleUser},
+		{name: "moderator group", groups: []string{"group1", "moderatorRole"}, expected: RoleModerator},
+		{name: "moderator group different case", groups: []string{"MODERATORROLE"}, expected: RoleModerator},
+		{name: "admin group", groups: []string{"adminRole"}, expected: RoleAdmin},
+		{name: "admin and moderator groups", groups: []string{"moderatorRole", "adminRole"}, expected: RoleAdmin},
+	} {
+		test := test // loopvar capture
+		t.Run(test.name, func(t *testing.T) {
+			if got := GroupsRole(test.groups); got != test.expected {
+				t.Fatalf("got: %s, wanted: %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestExtractGroups(t *testing.T) {
+	raw := map[string]any{
+		"groups": []any{"group1", "group2"},
+		"role":   "admin",
+		"realm_access": map[string]any{
+			"roles": []any{"moderatorRole", 123, "allowedRole"},
+		},
+		"number": 123,
+	}
+
+	for _, test := range []struct {
+		name     string
+		claim    string
+		expected []string
+	}{
+		{name: "top level list", claim: "groups", expected: []string{"group1", "group2"}},
+		{name: "top level string", claim: "role", expected: []string{"admin"}},
+		{name: "nested list", claim: "realm_access.roles", expected: []string{"moderatorRole", "allowedRole"}},
+		{name: "missing claim", claim: "nope", expected: nil},
+		{name: "missing nested claim", claim: "realm_access.nope", expected: nil},
+		{name: "path through non-object", claim: "role.nope", expected: nil},
+		{name: "wrong type", claim: "number", expected: nil},
+	} {
+		test := test // loopvar capture
+		t.Run(test.name, func(t *testing.T) {
+			if got := extractGroups(raw, test.claim); !slices.Equal(got, test.expected) {
+				t.Fatalf("got: %v, wanted: %v", got, test.expected)
+			}
+		})
+	}
+}
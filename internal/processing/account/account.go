@@ -18,6 +18,9 @@
 package account
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -43,6 +46,7 @@ type Processor struct {
 	federator    *federation.Federator
 	parseMention gtsmodel.ParseMentionFunc
 	themes       *Themes
+	websubClient *http.Client
 }
 
 // New returns a new account processor.
@@ -65,5 +69,6 @@ func New(
 		federator:    federator,
 		parseMention: parseMention,
 		themes:       PopulateThemes(),
+		websubClient: &http.Client{Timeout: 30 * time.Second},
 	}
 }
@@ -0,0 +1,741 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+const (
+	// archiveStaleAfter is the duration after which a pending
+	// or processing archive that hasn't been updated is assumed
+	// to have been abandoned (eg., by a restart mid-generation).
+	archiveStaleAfter = time.Hour
+
+	// archivePageSize is the number of items
+	// to select from the database at a time.
+	archivePageSize = 100
+
+	// archiveMediaDir is the directory within the archive
+	// that media files are placed in, as per Mastodon.
+	archiveMediaDir = "media_attachments/files"
+
+	// archiveContext is the JSON-LD context
+	// used for the archive's top-level collections.
+	archiveContext = "https://www.w3.org/ns/activitystreams"
+)
+
+// ArchiveRequest creates a new full archive export for
+// the requester, and queues it for generation in the
+// background. Only one archive may be requested per
+// configured accounts-archive-interval-days.
+func (p *Processor) ArchiveRequest(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.AccountArchive, gtserror.WithCode) {
+	latest, err := p.state.DB.GetLatestAccountArchive(
+		gtscontext.SetBarebones(ctx),
+		requester.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting latest archive: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if latest != nil {
+		if errWithCode := p.checkArchiveAllowed(ctx, latest); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	archive := &gtsmodel.AccountArchive{
+		ID:        id.NewULID(),
+		AccountID: requester.ID,
+		Account:   requester,
+		State:     gtsmodel.AccountArchiveStatePending,
+	}
+
+	if err := p.state.DB.PutAccountArchive(ctx, archive); err != nil {
+		err := gtserror.Newf("db error putting archive: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Do the actual archive generation asynchronously.
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		p.generateArchive(ctx, archive)
+	})
+
+	return p.apiArchive(ctx, archive)
+}
+
+// checkArchiveAllowed checks whether a new archive may be
+// requested, given the requester's latest archive.
+func (p *Processor) checkArchiveAllowed(
+	ctx context.Context,
+	latest *gtsmodel.AccountArchive,
+) gtserror.WithCode {
+	switch latest.State {
+	case gtsmodel.AccountArchiveStatePending,
+		gtsmodel.AccountArchiveStateProcessing:
+		if time.Since(latest.UpdatedAt) < archiveStaleAfter {
+			const text = "an archive is already being generated"
+			return gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		// Archive was abandoned partway through, mark
+		// it as failed so it doesn't count towards the
+		// interval, and allow a new one to be requested.
+		latest.State = gtsmodel.AccountArchiveStateFailed
+		if err := p.state.DB.UpdateAccountArchive(ctx, latest, "state"); err != nil {
+			err := gtserror.Newf("db error updating archive: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	interval := time.Duration(config.GetAccountsArchiveInterval()) * 24 * time.Hour
+	if next := latest.NextRequestAt(interval); time.Now().Before(next) {
+		text := "a new archive can be requested after " + next.UTC().Format(time.RFC3339)
+		return gtserror.NewErrorTooManyRequests(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// ArchiveGet returns the requester's latest archive,
+// including its progress if it's still being generated.
+func (p *Processor) ArchiveGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.AccountArchive, gtserror.WithCode) {
+	archive, err := p.state.DB.GetLatestAccountArchive(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting latest archive: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if archive == nil {
+		const text = "no archive has been requested"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return p.apiArchive(ctx, archive)
+}
+
+// ArchiveFile returns the zip file of the
+// requester's latest archive, if it's done.
+func (p *Processor) ArchiveFile(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.Content, gtserror.WithCode) {
+	archive, err := p.state.DB.GetLatestAccountArchive(
+		gtscontext.SetBarebones(ctx),
+		requester.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting latest archive: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if archive == nil || archive.State != gtsmodel.AccountArchiveStateDone {
+		const text = "no finished archive available"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	rc, err := p.state.Storage.GetStream(ctx, archive.Path)
+	if err != nil {
+		err := gtserror.Newf("error getting archive %s from storage: %w", archive.Path, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.Content{
+		ContentType:    apiutil.AppZip,
+		ContentLength:  archive.FileSize,
+		ContentUpdated: archive.UpdatedAt,
+		Content:        rc,
+	}, nil
+}
+
+func (p *Processor) apiArchive(
+	ctx context.Context,
+	archive *gtsmodel.AccountArchive,
+) (*apimodel.AccountArchive, gtserror.WithCode) {
+	apiArchive, err := p.converter.AccountArchiveToAPIAccountArchive(ctx, archive)
+	if err != nil {
+		err := gtserror.Newf("error converting archive to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiArchive, nil
+}
+
+// deleteAccountArchives deletes all archives (and their
+// stored files) belonging to the given account ID, except
+// for the archive with ID keepID, if set.
+func (p *Processor) deleteAccountArchives(
+	ctx context.Context,
+	accountID string,
+	keepID string,
+) error {
+	archives, err := p.state.DB.GetAccountArchives(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting archives: %w", err)
+	}
+
+	for _, archive := range archives {
+		if archive.ID == keepID {
+			continue
+		}
+
+		if archive.Path != "" {
+			if err := p.state.Storage.Delete(ctx, archive.Path); err != nil &&
+				!errors.Is(err, os.ErrNotExist) {
+				log.Errorf(ctx, "error deleting archive file %s: %v", archive.Path, err)
+			}
+		}
+
+		if err := p.state.DB.DeleteAccountArchiveByID(ctx, archive.ID); err != nil {
+			return gtserror.Newf("db error deleting archive %s: %w", archive.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// generateArchive generates the zip file for the given
+// archive, updating its progress in the database as it
+// goes, then stores the zip and marks the archive done.
+func (p *Processor) generateArchive(ctx context.Context, archive *gtsmodel.AccountArchive) {
+	l := log.WithContext(ctx).WithField("archive", archive.ID)
+
+	archive.State = gtsmodel.AccountArchiveStateProcessing
+	if err := p.state.DB.UpdateAccountArchive(ctx, archive, "state"); err != nil {
+		l.Errorf("db error updating archive: %v", err)
+		return
+	}
+
+	if err := p.writeArchive(ctx, archive); err != nil {
+		l.Errorf("error generating archive: %v", err)
+
+		archive.State = gtsmodel.AccountArchiveStateFailed
+		if err := p.state.DB.UpdateAccountArchive(ctx, archive, "state"); err != nil {
+			l.Errorf("db error updating archive: %v", err)
+		}
+		return
+	}
+
+	// Now this one's done, previous
+	// archives are no longer needed.
+	if err := p.deleteAccountArchives(ctx, archive.AccountID, archive.ID); err != nil {
+		l.Errorf("error deleting previous archives: %v", err)
+	}
+}
+
+func (p *Processor) writeArchive(ctx context.Context, archive *gtsmodel.AccountArchive) error {
+	account := archive.Account
+	if account == nil {
+		var err error
+		account, err = p.state.DB.GetAccountByID(ctx, archive.AccountID)
+		if err != nil {
+			return gtserror.Newf("db error getting account: %w", err)
+		}
+	}
+
+	tmp, err := os.CreateTemp("", "gotosocial-archive-*.zip")
+	if err != nil {
+		return gtserror.Newf("error creating temp file: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		if err := os.Remove(tmp.Name()); err != nil {
+			log.Errorf(ctx, "error removing temp file %s: %v", tmp.Name(), err)
+		}
+	}()
+
+	aw := &archiveWriter{
+		p:       p,
+		archive: archive,
+		account: account,
+		zip:     zip.NewWriter(tmp),
+	}
+
+	for _, step := range []func(context.Context) error{
+		aw.writeActor,
+		aw.writeOutbox,
+		aw.writeLikes,
+		aw.writeBookmarks,
+	} {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := aw.zip.Close(); err != nil {
+		return gtserror.Newf("error closing zip: %w", err)
+	}
+
+	archive.Path = uris.StoragePathForAttachment(
+		account.ID,
+		string(media.TypeArchive),
+		string(media.SizeOriginal),
+		archive.ID,
+		"zip",
+	)
+
+	archive.FileSize, err = p.state.Storage.PutFile(ctx,
+		archive.Path,
+		tmp.Name(),
+		apiutil.AppZip,
+	)
+	if err != nil {
+		return gtserror.Newf("error storing archive: %w", err)
+	}
+
+	archive.State = gtsmodel.AccountArchiveStateDone
+	archive.Progress = 100
+	if err := p.state.DB.UpdateAccountArchive(ctx, archive,
+		"state",
+		"progress",
+		"path",
+		"file_size",
+	); err != nil {
+		return gtserror.Newf("db error updating archive: %w", err)
+	}
+
+	return nil
+}
+
+// archiveWriter wraps state needed
+// while writing one archive zip file.
+type archiveWriter struct {
+	p       *Processor
+	archive *gtsmodel.AccountArchive
+	account *gtsmodel.Account
+	zip     *zip.Writer
+
+	// files contains the archive-relative
+	// paths of media files added to the archive,
+	// keyed by their original (public) URL.
+	files map[string]string
+
+	// media contains media files
+	// queued to be written by writeMedia.
+	media []archiveMedia
+}
+
+// archiveMedia is one media file
+// queued for copying into an archive.
+type archiveMedia struct {
+	path string // storage path
+	name string // path within archive
+}
+
+// setProgress updates the archive's
+// progress in the database, if changed.
+func (aw *archiveWriter) setProgress(ctx context.Context, progress int) {
+	if progress <= aw.archive.Progress {
+		return
+	}
+
+	aw.archive.Progress = progress
+	if err := aw.p.state.DB.UpdateAccountArchive(ctx, aw.archive, "progress"); err != nil {
+		log.Errorf(ctx, "db error updating archive progress: %v", err)
+	}
+}
+
+// writeJSON writes the given value as
+// a JSON file at name within the zip.
+func (aw *archiveWriter) writeJSON(name string, v any) error {
+	w, err := aw.zip.Create(name)
+	if err != nil {
+		return gtserror.Newf("error creating %s: %w", name, err)
+	}
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return gtserror.Newf("error encoding %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// addMedia queues the stored file of the given attachment
+// to be copied into the archive at name, recording it so
+// that references to the attachment URL can be rewritten
+// to point into the archive.
+//
+// Files are only actually copied by writeMedia, as zip
+// entries must be written one at a time, and queued
+// media is usually referenced from within a larger file.
+func (aw *archiveWriter) addMedia(attachment *gtsmodel.MediaAttachment, name string) {
+	if attachment == nil || !*attachment.Cached || attachment.File.Path == "" {
+		// Nothing to copy.
+		return
+	}
+
+	if _, ok := aw.files[attachment.URL]; ok {
+		// Already queued.
+		return
+	}
+
+	if aw.files == nil {
+		aw.files = make(map[string]string)
+	}
+	aw.files[attachment.URL] = name
+	aw.media = append(aw.media, archiveMedia{
+		path: attachment.File.Path,
+		name: name,
+	})
+}
+
+// writeMedia copies all queued media
+// files from storage into the archive.
+func (aw *archiveWriter) writeMedia(ctx context.Context) error {
+	for _, m := range aw.media {
+		if err := aw.copyMedia(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	aw.media = aw.media[:0]
+	return nil
+}
+
+func (aw *archiveWriter) copyMedia(ctx context.Context, m archiveMedia) error {
+	rc, err := aw.p.state.Storage.GetStream(ctx, m.path)
+	if err != nil {
+		// Missing files shouldn't prevent the rest
+		// of the archive from being generated.
+		log.Warnf(ctx, "error getting %s from storage: %v", m.path, err)
+		return nil
+	}
+	defer rc.Close()
+
+	w, err := aw.zip.Create(m.name)
+	if err != nil {
+		return gtserror.Newf("error creating %s: %w", m.name, err)
+	}
+
+	if _, err := io.Copy(w, rc); err != nil {
+		return gtserror.Newf("error writing %s: %w", m.name, err)
+	}
+
+	return nil
+}
+
+// writeActor writes actor.json, along
+// with the account's avatar and header.
+func (aw *archiveWriter) writeActor(ctx context.Context) error {
+	for _, m := range []struct {
+		id   string
+		name string
+	}{
+		{aw.account.AvatarMediaAttachmentID, "avatar"},
+		{aw.account.HeaderMediaAttachmentID, "header"},
+	} {
+		if m.id == "" {
+			continue
+		}
+
+		attachment, err := aw.p.state.DB.GetAttachmentByID(ctx, m.id)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting %s: %w", m.name, err)
+		}
+
+		if attachment == nil {
+			continue
+		}
+
+		aw.addMedia(attachment, m.name+path.Ext(attachment.File.Path))
+	}
+
+	person, err := aw.p.converter.AccountToAS(ctx, aw.account)
+	if err != nil {
+		return gtserror.Newf("error converting account to AS: %w", err)
+	}
+
+	actor, err := ap.Serialize(person)
+	if err != nil {
+		return gtserror.Newf("error serializing account: %w", err)
+	}
+
+	aw.rewriteURLs(actor)
+	if err := aw.writeJSON("actor.json", actor); err != nil {
+		return err
+	}
+
+	if err := aw.writeMedia(ctx); err != nil {
+		return err
+	}
+
+	aw.setProgress(ctx, 5)
+	return nil
+}
+
+// writeOutbox writes outbox.json, containing one Create or
+// Announce activity per status authored by the account,
+// followed by all media attached to those statuses.
+//
+// The outbox is written to the zip as it's generated,
+// to avoid holding every activity in memory at once.
+func (aw *archiveWriter) writeOutbox(ctx context.Context) error {
+	// Used for progress only,
+	// so needn't be exact.
+	var total int
+	if err := aw.p.state.DB.PopulateAccountStats(ctx, aw.account); err != nil {
+		log.Warnf(ctx, "error populating account stats: %v", err)
+	} else {
+		total = *aw.account.Stats.StatusesCount
+	}
+
+	w, err := aw.zip.Create("outbox.json")
+	if err != nil {
+		return gtserror.Newf("error creating outbox.json: %w", err)
+	}
+
+	if _, err := io.WriteString(w,
+		`{"@context":"`+archiveContext+`",`+
+			`"id":"outbox.json","type":"OrderedCollection","orderedItems":[`,
+	); err != nil {
+		return gtserror.Newf("error writing outbox.json: %w", err)
+	}
+
+	var (
+		count int
+		maxID string
+		enc   = json.NewEncoder(w)
+	)
+
+	for {
+		statuses, err := aw.p.state.DB.GetAccountStatuses(ctx,
+			aw.account.ID,
+			archivePageSize,
+			false, // include replies
+			false, // include boosts
+			maxID,
+			"",
+			false, // not media only
+			false, // not public only
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting statuses: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			break
+		}
+
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			activity, err := aw.statusToActivity(ctx, status)
+			if err != nil {
+				// Log and skip; one broken status
+				// shouldn't break the whole archive.
+				log.Warnf(ctx, "error converting status %s: %v", status.ID, err)
+				continue
+			}
+
+			if count > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return gtserror.Newf("error writing outbox.json: %w", err)
+				}
+			}
+
+			if err := enc.Encode(activity); err != nil {
+				return gtserror.Newf("error encoding status %s: %w", status.ID, err)
+			}
+
+			count++
+		}
+
+		if total > 0 {
+			// Outbox accounts for 5%-85% of overall progress.
+			aw.setProgress(ctx, 5+min(80, 80*count/total))
+		}
+	}
+
+	if _, err := io.WriteString(w, `],"totalItems":`); err != nil {
+		return gtserror.Newf("error writing outbox.json: %w", err)
+	}
+
+	if err := enc.Encode(count); err != nil {
+		return gtserror.Newf("error writing outbox.json: %w", err)
+	}
+
+	if _, err := io.WriteString(w, "}"); err != nil {
+		return gtserror.Newf("error writing outbox.json: %w", err)
+	}
+
+	if err := aw.writeMedia(ctx); err != nil {
+		return err
+	}
+
+	aw.setProgress(ctx, 85)
+	return nil
+}
+
+// statusToActivity converts the given status to a serialized
+// Create (or Announce for boosts) activity, writing attached
+// media into the archive and pointing the activity at it.
+func (aw *archiveWriter) statusToActivity(ctx context.Context, status *gtsmodel.Status) (map[string]any, error) {
+	if status.BoostOfID != "" {
+		boostedAccount := status.BoostOfAccount
+		if boostedAccount == nil {
+			var err error
+			boostedAccount, err = aw.p.state.DB.GetAccountByID(
+				gtscontext.SetBarebones(ctx),
+				status.BoostOfAccountID,
+			)
+			if err != nil {
+				return nil, gtserror.Newf("db error getting boosted account: %w", err)
+			}
+		}
+
+		announce, err := aw.p.converter.BoostToAS(ctx, status, aw.account, boostedAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		return ap.Serialize(announce)
+	}
+
+	statusable, err := aw.p.converter.StatusToAS(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range status.Attachments {
+		aw.addMedia(attachment, path.Join(archiveMediaDir, attachment.File.Path))
+	}
+
+	create, err := ap.Serialize(typeutils.WrapStatusableInCreate(statusable, false))
+	if err != nil {
+		return nil, err
+	}
+
+	aw.rewriteURLs(create)
+	return create, nil
+}
+
+// writeLikes writes likes.json, containing
+// the URIs of all statuses faved by the account.
+func (aw *archiveWriter) writeLikes(ctx context.Context) error {
+	var uris []string
+
+	if err := forEachStatusURI(ctx,
+		aw.p.favedURIs(aw.account.ID),
+		func(uri string) error {
+			uris = append(uris, uri)
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := aw.writeJSON("likes.json", archiveCollection("likes.json", uris)); err != nil {
+		return err
+	}
+
+	aw.setProgress(ctx, 90)
+	return nil
+}
+
+// writeBookmarks writes bookmarks.json, containing
+// the URIs of all statuses bookmarked by the account.
+func (aw *archiveWriter) writeBookmarks(ctx context.Context) error {
+	var uris []string
+
+	if err := forEachStatusURI(ctx,
+		aw.p.bookmarkedURIs(aw.account.ID),
+		func(uri string) error {
+			uris = append(uris, uri)
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := aw.writeJSON("bookmarks.json", archiveCollection("bookmarks.json", uris)); err != nil {
+		return err
+	}
+
+	aw.setProgress(ctx, 95)
+	return nil
+}
+
+// rewriteURLs walks the given serialized AS object,
+// replacing any "url" values pointing to media that's
+// been written into the archive with the path of the
+// media within the archive.
+func (aw *archiveWriter) rewriteURLs(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && key == "url" {
+				if name, ok := aw.files[s]; ok {
+					v[key] = name
+				}
+				continue
+			}
+			aw.rewriteURLs(value)
+		}
+	case []any:
+		for _, value := range v {
+			aw.rewriteURLs(value)
+		}
+	}
+}
+
+// archiveCollection returns an OrderedCollection
+// of the given IRIs, as used for likes / bookmarks.
+func archiveCollection(id string, iris []string) map[string]any {
+	if iris == nil {
+		// Ensure JSON array.
+		iris = []string{}
+	}
+
+	return map[string]any{
+		"@context":     archiveContext,
+		"id":           id,
+		"type":         ap.ObjectOrderedCollection,
+		"totalItems":   len(iris),
+		"orderedItems": iris,
+	}
+}
@@ -0,0 +1,265 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ArchiveTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *ArchiveTestSuite) runArchiveJob() {
+	job, ok := suite.state.Workers.Processing.Queue.Pop()
+	if !ok {
+		suite.FailNow("expected archive job to be queued")
+	}
+	job(context.Background())
+}
+
+func (suite *ArchiveTestSuite) TestArchive() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	// Nothing requested yet.
+	_, errWithCode := suite.accountProcessor.ArchiveGet(ctx, account)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	archive, errWithCode := suite.accountProcessor.ArchiveRequest(ctx, account)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("pending", archive.State)
+	suite.Zero(archive.Progress)
+
+	// Can't request another while this one is pending.
+	_, errWithCode = suite.accountProcessor.ArchiveRequest(ctx, account)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Not downloadable yet.
+	_, errWithCode = suite.accountProcessor.ArchiveFile(ctx, account)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	suite.runArchiveJob()
+
+	archive, errWithCode = suite.accountProcessor.ArchiveGet(ctx, account)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("done", archive.State)
+	suite.Equal(100, archive.Progress)
+	suite.NotZero(archive.Size)
+
+	// Rate limited until the interval has passed.
+	_, errWithCode = suite.accountProcessor.ArchiveRequest(ctx, account)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+
+	content, errWithCode := suite.accountProcessor.ArchiveFile(ctx, account)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	defer content.Content.Close()
+
+	b, err := io.ReadAll(content.Content)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.EqualValues(archive.Size, len(b))
+
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		files[f.Name], err = io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	for _, name := range []string{
+		"actor.json",
+		"outbox.json",
+		"likes.json",
+		"bookmarks.json",
+		"avatar.jpeg",
+		"header.jpeg",
+	} {
+		suite.Contains(files, name)
+	}
+
+	var actor map[string]any
+	if err := json.Unmarshal(files["actor.json"], &actor); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(account.URI, actor["id"])
+	suite.Equal("avatar.jpeg", actor["icon"].(map[string]any)["url"])
+
+	var outbox struct {
+		Type         string           `json:"type"`
+		TotalItems   int              `json:"totalItems"`
+		OrderedItems []map[string]any `json:"orderedItems"`
+	}
+	if err := json.Unmarshal(files["outbox.json"], &outbox); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("OrderedCollection", outbox.Type)
+	suite.Equal(len(outbox.OrderedItems), outbox.TotalItems)
+	suite.NotZero(outbox.TotalItems)
+
+	// Every attachment in the outbox should
+	// point to a media file within the archive.
+	var attachments int
+	for _, item := range outbox.OrderedItems {
+		object, ok := item["object"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		as, _ := object["attachment"].([]any)
+		for _, a := range as {
+			url := a.(map[string]any)["url"].(string)
+			suite.True(strings.HasPrefix(url, "media_attachments/files/"), url)
+			suite.Contains(files, url)
+			attachments++
+		}
+	}
+	suite.NotZero(attachments)
+
+	var likes struct {
+		TotalItems   int      `json:"totalItems"`
+		OrderedItems []string `json:"orderedItems"`
+	}
+	if err := json.Unmarshal(files["likes.json"], &likes); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(likes.OrderedItems, likes.TotalItems)
+	suite.Contains(likes.OrderedItems, suite.testStatuses["admin_account_status_1"].URI)
+
+	var bookmarks struct {
+		OrderedItems []string `json:"orderedItems"`
+	}
+	if err := json.Unmarshal(files["bookmarks.json"], &bookmarks); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(bookmarks.OrderedItems, suite.testStatuses["admin_account_status_1"].URI)
+}
+
+func (suite *ArchiveTestSuite) TestArchiveReplacesPrevious() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	if _, errWithCode := suite.accountProcessor.ArchiveRequest(ctx, account); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.runArchiveJob()
+
+	first, err := suite.db.GetLatestAccountArchive(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Pretend the first archive is
+	// old enough to request another.
+	first.CreatedAt = time.Now().Add(-30 * 24 * time.Hour)
+	if err := suite.db.UpdateAccountArchive(ctx, first, "created_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if _, errWithCode := suite.accountProcessor.ArchiveRequest(ctx, account); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.runArchiveJob()
+
+	// Only the new archive should remain.
+	archives, err := suite.db.GetAccountArchives(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(archives, 1)
+	suite.NotEqual(first.ID, archives[0].ID)
+
+	has, err := suite.storage.Has(ctx, first.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(has)
+}
+
+func (suite *ArchiveTestSuite) TestArchiveStale() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	if _, errWithCode := suite.accountProcessor.ArchiveRequest(ctx, account); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Drop the queued job, and backdate the archive
+	// as though it was abandoned by a restart.
+	_, _ = suite.state.Workers.Processing.Queue.Pop()
+
+	archive, err := suite.db.GetLatestAccountArchive(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	archive.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	if err := suite.db.UpdateByID(ctx, archive, archive.ID, "updated_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// New request should now be allowed.
+	if _, errWithCode := suite.accountProcessor.ArchiveRequest(ctx, account); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	archive, err = suite.db.GetAccountArchiveByID(ctx, archive.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AccountArchiveStateFailed, archive.State)
+}
+
+func TestArchiveTestSuite(t *testing.T) {
+	suite.Run(t, &ArchiveTestSuite{})
+}
@@ -430,6 +430,11 @@ func (p *Processor) deleteAccountNotifications(ctx context.Context, account *gts
 		return gtserror.Newf("error deleting notifications by account: %w", err)
 	}
 
+	// Delete all notification requests held for, or about, given account.
+	if err := p.state.DB.DeleteNotificationRequests(ctx, account.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting notification requests: %w", err)
+	}
+
 	return nil
 }
 
@@ -458,6 +463,24 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return gtserror.Newf("error deleting faves targeting account: %w", err)
 	}
 
+	// Delete all reactions owned by given account.
+	if err := p.state.DB.DeleteStatusReactions(ctx, "", account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting reactions by account: %w", err)
+	}
+
+	// Delete all reactions targeting given account.
+	if err := p.state.DB.DeleteStatusReactions(ctx, account.ID, ""); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting reactions targeting account: %w", err)
+	}
+
+	// Delete all announcement reads and reactions by given account.
+	if err := p.state.DB.DeleteAnnouncementReadsAndReactions(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting announcement reads and reactions by account: %w", err)
+	}
+
 	// TODO: add status mutes here when they're implemented.
 
 	// Delete all conversations owned by given account.
@@ -480,6 +503,28 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return gtserror.Newf("error deleting followed tags by account: %w", err)
 	}
 
+	// Delete all circles (and their members) owned by given account.
+	if err := p.state.DB.DeleteCirclesByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting circles by account: %w", err)
+	}
+
+	// Delete all archive exports (and their files) owned by given account.
+	if err := p.deleteAccountArchives(ctx, account.ID, ""); err != nil {
+		return gtserror.Newf("error deleting archives by account: %w", err)
+	}
+
+	// Delete all data imports uploaded by given account.
+	if err := p.state.DB.DeleteAccountImports(ctx, account.ID); err != nil {
+		return gtserror.Newf("error deleting imports by account: %w", err)
+	}
+
+	// Delete records of relationships severed from given account.
+	if err := p.state.DB.DeleteSeveredRelationshipsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting severed relationships by account: %w", err)
+	}
+
 	// Delete account stats model.
 	if err := p.state.DB.DeleteAccountStats(ctx, account.ID); err != nil {
 		return gtserror.Newf("error deleting stats for account: %w", err)
@@ -576,6 +621,7 @@ func stubbifyUser(user *gtsmodel.User) ([]string, error) {
 	user.ConfirmationSentAt = never
 	user.ResetPasswordToken = ""
 	user.ResetPasswordSentAt = never
+	user.DeletionRequestedAt = never
 
 	return []string{
 		"encrypted_password",
@@ -587,5 +633,6 @@ func stubbifyUser(user *gtsmodel.User) ([]string, error) {
 		"confirmation_sent_at",
 		"reset_password_token",
 		"reset_password_sent_at",
+		"deletion_requested_at",
 	}, nil
 }
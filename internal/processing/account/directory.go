@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// DirectoryGet returns a page of accounts that have opted in
+// to being shown in the profile directory, skipping any with
+// a block in place between them and the requester.
+//
+// Remote accounts are only included if the instance is
+// configured to include them, and localOnly is false.
+func (p *Processor) DirectoryGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	localOnly bool,
+	order string,
+	limit int,
+	offset int,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	if !config.GetInstanceDirectoryIncludeRemote() {
+		localOnly = true
+	}
+
+	accounts, err := p.state.DB.GetDirectoryAccounts(ctx, localOnly, order, limit, offset)
+	if err != nil {
+		err := gtserror.Newf("db error getting directory accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccounts := make([]*apimodel.Account, 0, len(accounts))
+	for _, account := range accounts {
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, account.ID)
+		if err != nil {
+			err := gtserror.Newf("db error checking blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", account.ID, err)
+			continue
+		}
+
+		apiAccounts = append(apiAccounts, apiAccount)
+	}
+
+	return apiAccounts, nil
+}
@@ -0,0 +1,282 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// statusURIPager returns one page of status URIs
+// older than maxID, along with the maxID to use for
+// the next page. An empty next maxID means done.
+type statusURIPager func(ctx context.Context, maxID string) ([]string, string, error)
+
+// favedURIs returns a pager over the URIs
+// of statuses faved by the given account.
+func (p *Processor) favedURIs(accountID string) statusURIPager {
+	return func(ctx context.Context, maxID string) ([]string, string, error) {
+		statuses, nextMaxID, _, err := p.state.DB.GetFavedTimeline(ctx,
+			accountID,
+			maxID,
+			"",
+			archivePageSize,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, "", gtserror.Newf("db error getting faves: %w", err)
+		}
+
+		uris := make([]string, 0, len(statuses))
+		for _, status := range statuses {
+			uris = append(uris, status.URI)
+		}
+
+		return uris, nextMaxID, nil
+	}
+}
+
+// bookmarkedURIs returns a pager over the URIs
+// of statuses bookmarked by the given account.
+func (p *Processor) bookmarkedURIs(accountID string) statusURIPager {
+	return func(ctx context.Context, maxID string) ([]string, string, error) {
+		bookmarks, err := p.state.DB.GetStatusBookmarks(ctx,
+			accountID,
+			archivePageSize,
+			maxID,
+			"",
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, "", gtserror.Newf("db error getting bookmarks: %w", err)
+		}
+
+		if len(bookmarks) == 0 {
+			return nil, "", nil
+		}
+
+		uris := make([]string, 0, len(bookmarks))
+		for _, bookmark := range bookmarks {
+			if bookmark.Status != nil {
+				uris = append(uris, bookmark.Status.URI)
+			}
+		}
+
+		return uris, bookmarks[len(bookmarks)-1].ID, nil
+	}
+}
+
+// forEachStatusURI calls fn for every URI
+// returned by the pager, until exhausted.
+func forEachStatusURI(
+	ctx context.Context,
+	pager statusURIPager,
+	fn func(uri string) error,
+) error {
+	var maxID string
+
+	for {
+		uris, nextMaxID, err := pager(ctx, maxID)
+		if err != nil {
+			return err
+		}
+
+		if nextMaxID == "" {
+			// Reached the end.
+			return nil
+		}
+
+		for _, uri := range uris {
+			if err := fn(uri); err != nil {
+				return err
+			}
+		}
+
+		maxID = nextMaxID
+	}
+}
+
+// ExportBookmarks returns an export of the URIs of
+// statuses bookmarked by the requester, encoded
+// either as CSV or JSON depending on contentType.
+func (p *Processor) ExportBookmarks(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	contentType string,
+) (*apimodel.Content, gtserror.WithCode) {
+	return p.exportStatusURIs(ctx,
+		p.bookmarkedURIs(requester.ID),
+		"bookmarks.json",
+		contentType,
+	)
+}
+
+// ExportFavourites returns an export of the URIs of
+// statuses faved by the requester, encoded either
+// as CSV or JSON depending on contentType.
+func (p *Processor) ExportFavourites(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	contentType string,
+) (*apimodel.Content, gtserror.WithCode) {
+	return p.exportStatusURIs(ctx,
+		p.favedURIs(requester.ID),
+		"likes.json",
+		contentType,
+	)
+}
+
+// exportStatusURIs streams the status URIs returned by the
+// given pager as either a CSV file (one URI per row, no header,
+// as per Mastodon's bookmarks.csv), or a JSON OrderedCollection
+// (as per Mastodon's archive likes.json / bookmarks.json).
+//
+// Collections may be arbitrarily large, so rather than
+// loading everything into memory up front, the export
+// is generated page-by-page in the background and piped
+// to the caller as it's written. The caller must close
+// the returned content when done.
+func (p *Processor) exportStatusURIs(
+	ctx context.Context,
+	pager statusURIPager,
+	collectionID string,
+	contentType string,
+) (*apimodel.Content, gtserror.WithCode) {
+	var write func(context.Context, io.Writer) error
+
+	switch contentType {
+	case apiutil.TextCSV:
+		write = func(ctx context.Context, w io.Writer) error {
+			return writeStatusURIsCSV(ctx, w, pager)
+		}
+	case apiutil.AppJSON:
+		write = func(ctx context.Context, w io.Writer) error {
+			return writeStatusURIsJSON(ctx, w, pager, collectionID)
+		}
+	default:
+		err := gtserror.Newf("unsupported export content type %s", contentType)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		// Any error (including the reader having been
+		// closed by the caller) is passed through to the
+		// reader, so a failed export isn't served as if
+		// it were complete.
+		err := write(ctx, pw)
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			log.Errorf(ctx, "error writing %s export: %v", collectionID, err)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return &apimodel.Content{
+		ContentType:    contentType,
+		ContentLength:  -1, // unknown until written
+		ContentUpdated: time.Now(),
+		Content:        pr,
+	}, nil
+}
+
+// writeStatusURIsCSV writes status URIs
+// to w as CSV, one URI per row, no header.
+func writeStatusURIsCSV(
+	ctx context.Context,
+	w io.Writer,
+	pager statusURIPager,
+) error {
+	cw := csv.NewWriter(w)
+
+	if err := forEachStatusURI(ctx, pager, func(uri string) error {
+		return cw.Write([]string{uri})
+	}); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeStatusURIsJSON writes status URIs to w as an
+// OrderedCollection, equivalent to archiveCollection.
+// Items are streamed out as they're paged in, with
+// totalItems written after them once it's known.
+func writeStatusURIsJSON(
+	ctx context.Context,
+	w io.Writer,
+	pager statusURIPager,
+	collectionID string,
+) error {
+	// Prepare opening members.
+	head, err := json.Marshal(map[string]any{
+		"@context": archiveContext,
+		"id":       collectionID,
+		"type":     ap.ObjectOrderedCollection,
+	})
+	if err != nil {
+		return gtserror.Newf("error marshaling collection: %w", err)
+	}
+
+	// Drop the closing brace
+	// and open orderedItems.
+	head = head[:len(head)-1]
+	head = append(head, `,"orderedItems":[`...)
+	if _, err := w.Write(head); err != nil {
+		return err
+	}
+
+	var total int
+	if err := forEachStatusURI(ctx, pager, func(uri string) error {
+		b, err := json.Marshal(uri)
+		if err != nil {
+			return err
+		}
+
+		if total > 0 {
+			b = append([]byte{','}, b...)
+		}
+
+		total++
+		_, err = w.Write(b)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	tail, err := json.Marshal(total)
+	if err != nil {
+		return err
+	}
+
+	tail = append([]byte(`],"totalItems":`), tail...)
+	tail = append(tail, '}')
+	_, err = w.Write(tail)
+	return err
+}
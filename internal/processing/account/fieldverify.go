@@ -0,0 +1,346 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"golang.org/x/net/html"
+)
+
+// fieldPageMaxSize is the maximum number of
+// bytes read from a page linked in a profile
+// field when looking for a rel="me" link.
+const fieldPageMaxSize = 1 << 20 // 1MiB
+
+// ScheduleFieldVerification schedules the profile
+// fields of local accounts to be re-verified at the
+// configured interval, if any, so that verification
+// follows rel="me" links being added or removed.
+func (p *Processor) ScheduleFieldVerification() {
+	every := config.GetAccountsFieldVerificationInterval()
+	if every <= 0 {
+		// Periodic checks disabled.
+		return
+	}
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Debug(ctx, "verifying account fields")
+		if err := p.VerifyAllFields(ctx); err != nil {
+			log.Errorf(ctx, "error verifying account fields: %v", err)
+			return
+		}
+		log.Debugf(ctx, "finished verifying account fields after %s", time.Since(start))
+	}
+
+	log.Infof(nil, "scheduling account field verification to run every %s", every)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@fieldverification",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @fieldverification")
+	}
+}
+
+// VerifyAllFields re-verifies the
+// profile fields of all local accounts.
+func (p *Processor) VerifyAllFields(ctx context.Context) error {
+	accountIDs, err := p.state.DB.GetLocalAccountIDs(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting accounts: %w", err)
+	}
+
+	errs := gtserror.NewMultiError(len(accountIDs))
+	for _, accountID := range accountIDs {
+		account, err := p.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			errs.Appendf("db error getting account %s: %w", accountID, err)
+			continue
+		}
+
+		if err := p.verifyFields(ctx, account); err != nil {
+			errs.Appendf("error verifying fields of account %s: %w", accountID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// VerifyFields re-verifies the profile fields of the
+// given local account immediately, and returns the
+// account with the results of the verification.
+func (p *Processor) VerifyFields(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*apimodel.Account, gtserror.WithCode) {
+	// Get a fresh copy of the account,
+	// the one we were given may be stale.
+	account, err := p.state.DB.GetAccountByID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.verifyFields(ctx, account); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccount, err := p.converter.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		err := gtserror.Newf("error converting account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// verifyFieldsAsync re-verifies the profile
+// fields of the account with the given ID
+// asynchronously, eg., after they've changed.
+func (p *Processor) verifyFieldsAsync(accountID string) {
+	p.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		account, err := p.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting account %s: %v", accountID, err)
+			return
+		}
+
+		if err := p.verifyFields(ctx, account); err != nil {
+			log.Errorf(ctx, "error verifying fields of account %s: %v", accountID, err)
+		}
+	})
+}
+
+// verifyFields checks each profile field of the given
+// account that contains a link, setting the field as
+// verified if the linked page links back to the account
+// with rel="me", or clearing verification if it doesn't.
+// The account is updated in the database if anything
+// changed. Fields whose page couldn't be fetched due to
+// a temporary error keep their previous verification.
+func (p *Processor) verifyFields(ctx context.Context, account *gtsmodel.Account) error {
+	if len(account.FieldsRaw) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	var (
+		tsport  transport.Transport
+		now     = time.Now()
+		changed bool
+	)
+
+	for i, fieldRaw := range account.FieldsRaw {
+		verified := !fieldRaw.VerifiedAt.IsZero()
+
+		if link := fieldLink(fieldRaw.Value); link == nil {
+			// Only links can be verified.
+			verified = false
+		} else {
+			if tsport == nil {
+				var err error
+				tsport, err = p.federator.TransportController().NewTransportForUsername(ctx, "")
+				if err != nil {
+					return gtserror.Newf("error getting instance transport: %w", err)
+				}
+			}
+
+			found, err := linksBackTo(ctx, tsport, link, account)
+			if err != nil {
+				// Couldn't tell either way,
+				// leave the field as it is.
+				log.Debugf(ctx, "error checking %s for rel=me: %v", link, err)
+				continue
+			}
+			verified = found
+		}
+
+		var verifiedAt time.Time
+		switch {
+		case !verified:
+			// Leave zero.
+		case fieldRaw.VerifiedAt.IsZero():
+			// Newly verified.
+			verifiedAt = now
+		default:
+			// Still verified, keep
+			// when it first was.
+			verifiedAt = fieldRaw.VerifiedAt
+		}
+
+		if verifiedAt.Equal(fieldRaw.VerifiedAt) {
+			continue
+		}
+
+		fieldRaw.VerifiedAt = verifiedAt
+		if i < len(account.Fields) {
+			account.Fields[i].VerifiedAt = verifiedAt
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := p.state.DB.UpdateAccount(ctx, account, "fields", "fields_raw"); err != nil {
+		return gtserror.Newf("db error updating account: %w", err)
+	}
+
+	return nil
+}
+
+// fieldLink returns the given raw field value parsed
+// as an http(s) URL, or nil if it's not such a URL.
+func fieldLink(value string) *url.URL {
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, " \t\n") {
+		return nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil
+	}
+
+	return u
+}
+
+// linksBackTo fetches the page at the given link, and returns
+// whether it contains a rel="me" link to the given account. A
+// page that's gone returns false, other failures return error.
+func linksBackTo(
+	ctx context.Context,
+	tsport transport.Transport,
+	link *url.URL,
+	account *gtsmodel.Account,
+) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.String(), nil)
+	if err != nil {
+		return false, gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "text/html")
+
+	rsp, err := tsport.GET(req)
+	if err != nil {
+		return false, gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	switch {
+	case rsp.StatusCode == http.StatusNotFound ||
+		rsp.StatusCode == http.StatusGone:
+		// Page has gone away,
+		// so has the link back.
+		return false, nil
+
+	case rsp.StatusCode < 200 || rsp.StatusCode > 299:
+		return false, gtserror.Newf("page responded with status %s", rsp.Status)
+	}
+
+	body := io.LimitReader(rsp.Body, fieldPageMaxSize)
+	return hasRelMeLink(body, account.URL, account.URI), nil
+}
+
+// hasRelMeLink returns whether the HTML read from
+// r contains an <a> or <link> element marked with
+// rel="me", that points to any of the given URLs.
+func hasRelMeLink(r io.Reader, urls ...string) bool {
+	tokenizer := html.NewTokenizer(r)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// Finished (or
+			// invalid HTML).
+			return false
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data != "a" && token.Data != "link" {
+				continue
+			}
+
+			var (
+				href string
+				me   bool
+			)
+
+			for _, attr := range token.Attr {
+				switch attr.Key {
+				case "href":
+					href = attr.Val
+				case "rel":
+					for _, v := range strings.Fields(attr.Val) {
+						if strings.EqualFold(v, "me") {
+							me = true
+						}
+					}
+				}
+			}
+
+			if !me {
+				continue
+			}
+
+			for _, u := range urls {
+				if sameLink(href, u) {
+					return true
+				}
+			}
+		}
+	}
+}
+
+// sameLink returns whether the two given
+// links point to the same place, ignoring
+// host case and any trailing slash.
+func sameLink(a, b string) bool {
+	ua, err := url.Parse(strings.TrimSpace(a))
+	if err != nil || ua.Host == "" {
+		return false
+	}
+
+	ub, err := url.Parse(b)
+	if err != nil || ub.Host == "" {
+		return false
+	}
+
+	return ua.Scheme == ub.Scheme &&
+		strings.EqualFold(ua.Host, ub.Host) &&
+		strings.TrimSuffix(ua.Path, "/") == strings.TrimSuffix(ub.Path, "/")
+}
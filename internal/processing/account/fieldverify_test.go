@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FieldVerifyTestSuite struct {
+	suite.Suite
+}
+
+func (suite *FieldVerifyTestSuite) TestFieldLink() {
+	for _, test := range []struct {
+		value  string
+		expect string
+	}{
+		{value: "https://example.org/about", expect: "https://example.org/about"},
+		{value: "  http://example.org  ", expect: "http://example.org"},
+		{value: "example.org", expect: ""},
+		{value: "ftp://example.org/file", expect: ""},
+		{value: "she/her", expect: ""},
+		{value: "my site: https://example.org", expect: ""},
+	} {
+		link := fieldLink(test.value)
+		if test.expect == "" {
+			suite.Nil(link, test.value)
+			continue
+		}
+		if suite.NotNil(link, test.value) {
+			suite.Equal(test.expect, link.String())
+		}
+	}
+}
+
+func (suite *FieldVerifyTestSuite) TestHasRelMeLink() {
+	const (
+		accountURL = "http://localhost:8080/@the_mighty_zork"
+		accountURI = "http://localhost:8080/users/the_mighty_zork"
+	)
+
+	for _, test := range []struct {
+		name   string
+		page   string
+		expect bool
+	}{
+		{
+			name:   "anchor",
+			page:   `<html><body><a rel="me" href="http://localhost:8080/@the_mighty_zork">fedi</a></body></html>`,
+			expect: true,
+		},
+		{
+			name:   "link element to uri",
+			page:   `<html><head><link rel="me" href="http://localhost:8080/users/the_mighty_zork"/></head></html>`,
+			expect: true,
+		},
+		{
+			name:   "multiple rel values and trailing slash",
+			page:   `<a rel="nofollow ME noopener" href="http://LOCALHOST:8080/@the_mighty_zork/">fedi</a>`,
+			expect: true,
+		},
+		{
+			name:   "no rel me",
+			page:   `<a href="http://localhost:8080/@the_mighty_zork">fedi</a>`,
+			expect: false,
+		},
+		{
+			name:   "rel me to someone else",
+			page:   `<a rel="me" href="http://localhost:8080/@admin">fedi</a>`,
+			expect: false,
+		},
+		{
+			name:   "rel me on other element",
+			page:   `<img rel="me" src="http://localhost:8080/@the_mighty_zork">`,
+			expect: false,
+		},
+	} {
+		found := hasRelMeLink(strings.NewReader(test.page), accountURL, accountURI)
+		suite.Equal(test.expect, found, test.name)
+	}
+}
+
+func TestFieldVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldVerifyTestSuite))
+}
@@ -29,12 +29,22 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 // FollowCreate handles a follow request to an account, either remote or local.
 func (p *Processor) FollowCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.AccountFollowRequest) (*apimodel.Relationship, gtserror.WithCode) {
+	var note string
+	if form.Note != nil {
+		note = text.SanitizeToPlaintext(*form.Note)
+		if err := validate.FollowNote(note); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
 	targetAccount, errWithCode := p.getFollowTarget(ctx, requestingAccount, form.ID)
 	if errWithCode != nil {
 		return nil, errWithCode
@@ -97,6 +107,7 @@ func(columns ...string) error { return p.state.DB.UpdateFollowRequest(ctx, follo
 		TargetAccount:   targetAccount,
 		ShowReblogs:     form.Reblogs,
 		Notify:          form.Notify,
+		Note:            note,
 	}
 
 	// Insert the new follow request.
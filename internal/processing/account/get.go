@@ -109,6 +109,11 @@ func (p *Processor) GetWeb(ctx context.Context, username string) (*apimodel.WebA
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Hide accounts pending deletion.
+	if errWithCode := p.c.CheckPendingDeletion(ctx, targetAccount); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	webAccount, err := p.converter.AccountToWebAccount(ctx, targetAccount)
 	if err != nil {
 		err := gtserror.Newf("error converting account: %w", err)
@@ -23,87 +23,289 @@
 	"errors"
 	"fmt"
 	"mime/multipart"
+	"net/url"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// ImportData parses the given CSV data file of the
+// given type, and queues its entries to be imported
+// asynchronously. The returned import can be used
+// to track progress, and which entries failed.
 func (p *Processor) ImportData(
 	ctx context.Context,
 	requester *gtsmodel.Account,
 	data *multipart.FileHeader,
 	importType string,
 	overwrite bool,
-) gtserror.WithCode {
+) (*apimodel.AccountImport, gtserror.WithCode) {
+	records, errWithCode := readImportRecords(data, importType)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	imp := &gtsmodel.AccountImport{
+		ID:        id.NewULID(),
+		AccountID: requester.ID,
+		Account:   requester,
+		Type:      importType,
+		Overwrite: &overwrite,
+		State:     gtsmodel.AccountImportStateProcessing,
+	}
+
+	var (
+		f   func(context.Context)
+		err error
+	)
+
 	switch importType {
 
 	case "following":
-		return p.importFollowing(
-			ctx,
-			requester,
-			data,
-			overwrite,
-		)
+		// Only TargetAccount.Username, TargetAccount.Domain,
+		// and ShowReblogs will be set on each Follow.
+		var follows []*gtsmodel.Follow
+		follows, err = p.converter.CSVToFollowing(ctx, records)
+		imp.Total = len(follows)
+		f = importFollowingAsyncF(p, requester, imp, follows)
+
+	case "lists":
+		// Only List.Title, Follow.TargetAccount.Username,
+		// and Follow.TargetAccount.Domain will be set on
+		// each ListEntry.
+		var entries []*gtsmodel.ListEntry
+		entries, err = p.converter.CSVToListEntries(ctx, records)
+		imp.Total = len(entries)
+		f = importListsAsyncF(p, requester, imp, entries)
 
 	case "blocks":
-		return p.importBlocks(
-			ctx,
-			requester,
-			data,
-			overwrite,
-		)
+		// Only TargetAccount.Username and TargetAccount.Domain
+		// will be set on each Block.
+		var blocks []*gtsmodel.Block
+		blocks, err = p.converter.CSVToBlocks(ctx, records)
+		imp.Total = len(blocks)
+		f = importBlocksAsyncF(p, requester, imp, blocks)
+
+	case "mutes":
+		// Only TargetAccount.Username, TargetAccount.Domain,
+		// and Notifications will be set on each UserMute.
+		var mutes []*gtsmodel.UserMute
+		mutes, err = p.converter.CSVToMutes(ctx, records)
+		imp.Total = len(mutes)
+		f = importMutesAsyncF(p, requester, imp, mutes)
+
+	case "bookmarks":
+		// Only Status.URI will be set on each StatusBookmark.
+		var bookmarks []*gtsmodel.StatusBookmark
+		bookmarks, err = p.converter.CSVToBookmarks(ctx, records)
+		imp.Total = len(bookmarks)
+		f = importBookmarksAsyncF(p, requester, imp, bookmarks)
 
 	default:
 		const text = "import type not yet supported"
-		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if err != nil {
+		err := fmt.Errorf("error converting records to %s: %w", importType, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := p.state.DB.PutAccountImport(ctx, imp); err != nil {
+		err := gtserror.Newf("db error putting import: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
 	}
+
+	// Do remaining processing of this import asynchronously.
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		f(ctx)
+		p.importFinished(ctx, imp)
+	})
+
+	return p.apiImport(ctx, imp)
 }
 
-func (p *Processor) importFollowing(
-	ctx context.Context,
-	requester *gtsmodel.Account,
-	followingData *multipart.FileHeader,
-	overwrite bool,
-) gtserror.WithCode {
-	file, err := followingData.Open()
+// readImportRecords reads all CSV records
+// out of the given import data file.
+func readImportRecords(
+	data *multipart.FileHeader,
+	importType string,
+) ([][]string, gtserror.WithCode) {
+	file, err := data.Open()
 	if err != nil {
-		err := fmt.Errorf("error opening following data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		err := fmt.Errorf("error opening %s data file: %w", importType, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 	defer file.Close()
 
 	// Parse records out of the file.
 	records, err := csv.NewReader(file).ReadAll()
 	if err != nil {
-		err := fmt.Errorf("error reading following data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		err := fmt.Errorf("error reading %s data file: %w", importType, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
-	// Convert the records into a slice of barebones follows.
-	//
-	// Only TargetAccount.Username, TargetAccount.Domain,
-	// and ShowReblogs will be set on each Follow.
-	follows, err := p.converter.CSVToFollowing(ctx, records)
+	return records, nil
+}
+
+// ImportGet returns the import with the given
+// ID, if it was uploaded by the requester.
+func (p *Processor) ImportGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	id string,
+) (*apimodel.AccountImport, gtserror.WithCode) {
+	imp, err := p.state.DB.GetAccountImportByID(
+		gtscontext.SetBarebones(ctx),
+		id,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting import: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if imp == nil || imp.AccountID != requester.ID {
+		const text = "import not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return p.apiImport(ctx, imp)
+}
+
+// ImportsGet returns a page of imports
+// uploaded by the requester, newest first.
+func (p *Processor) ImportsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	imports, err := p.state.DB.GetAccountImports(
+		gtscontext.SetBarebones(ctx),
+		requester.ID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting imports: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(imports)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := imports[count-1].ID
+	hi := imports[0].ID
+
+	// Convert each import to API model.
+	items := make([]any, len(imports))
+	for i, imp := range imports {
+		apiImport, errWithCode := p.apiImport(ctx, imp)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiImport
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/import",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+func (p *Processor) apiImport(
+	ctx context.Context,
+	imp *gtsmodel.AccountImport,
+) (*apimodel.AccountImport, gtserror.WithCode) {
+	apiImport, err := p.converter.AccountImportToAPIAccountImport(ctx, imp)
 	if err != nil {
-		err := fmt.Errorf("error converting records to follows: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		err := gtserror.Newf("error converting import to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
 	}
+	return apiImport, nil
+}
 
-	// Do remaining processing of this import asynchronously.
-	f := importFollowingAsyncF(p, requester, follows, overwrite)
-	p.state.Workers.Processing.Queue.Push(f)
+// importEntryDone records that one entry of the given
+// import has been processed, noting the given failure
+// reason for the entry if it couldn't be imported.
+func (p *Processor) importEntryDone(
+	ctx context.Context,
+	imp *gtsmodel.AccountImport,
+	entry string,
+	failure string,
+) {
+	imp.Processed++
+	columns := []string{"processed"}
+
+	if failure != "" {
+		imp.Failures = append(imp.Failures, &gtsmodel.AccountImportFailure{
+			Entry: entry,
+			Error: failure,
+		})
+		columns = append(columns, "failures")
+	}
+
+	if err := p.state.DB.UpdateAccountImport(ctx, imp, columns...); err != nil {
+		log.Errorf(ctx, "db error updating import: %v", err)
+	}
+}
 
-	return nil
+// importFailed marks the given import as having
+// failed as a whole, eg., due to a database error.
+func (p *Processor) importFailed(ctx context.Context, imp *gtsmodel.AccountImport) {
+	imp.State = gtsmodel.AccountImportStateFailed
+	if err := p.state.DB.UpdateAccountImport(ctx, imp, "state"); err != nil {
+		log.Errorf(ctx, "db error updating import: %v", err)
+	}
+}
+
+// importFinished marks the given import as done,
+// unless it was already marked as failed.
+func (p *Processor) importFinished(ctx context.Context, imp *gtsmodel.AccountImport) {
+	if imp.State != gtsmodel.AccountImportStateProcessing {
+		return
+	}
+
+	imp.State = gtsmodel.AccountImportStateDone
+	if err := p.state.DB.UpdateAccountImport(ctx, imp, "state"); err != nil {
+		log.Errorf(ctx, "db error updating import: %v", err)
+	}
+}
+
+// importAddress returns the account address
+// (without leading '@') for the given username
+// and domain, as shown in import failures.
+func importAddress(username string, domain string) string {
+	if domain == "" {
+		// Local account,
+		// use our domain.
+		domain = config.GetAccountDomain()
+		if domain == "" {
+			domain = config.GetHost()
+		}
+	}
+	return username + "@" + domain
 }
 
 func importFollowingAsyncF(
 	p *Processor,
 	requester *gtsmodel.Account,
+	imp *gtsmodel.AccountImport,
 	follows []*gtsmodel.Follow,
-	overwrite bool,
 ) func(context.Context) {
+	overwrite := *imp.Overwrite
 	return func(ctx context.Context) {
 		// Map used to store wanted
 		// follow targets (if overwriting).
@@ -117,12 +319,14 @@ func importFollowingAsyncF(
 			prevFollows, err := p.state.DB.GetAccountFollows(ctx, requester.ID, nil)
 			if err != nil {
 				log.Errorf(ctx, "db error getting following: %v", err)
+				p.importFailed(ctx, imp)
 				return
 			}
 
 			prevFollowReqs, err := p.state.DB.GetAccountFollowRequesting(ctx, requester.ID, nil)
 			if err != nil {
 				log.Errorf(ctx, "db error getting follow requesting: %v", err)
+				p.importFailed(ctx, imp)
 				return
 			}
 
@@ -192,6 +396,9 @@ func importFollowingAsyncF(
 				// Notify when new
 				// follow posts.
 				notify = follow.Notify
+
+				// Address for failures.
+				entry = importAddress(username, domain)
 			)
 
 			if overwrite {
@@ -209,6 +416,7 @@ func importFollowingAsyncF(
 			)
 			if err != nil {
 				log.Errorf(ctx, "could not retrieve account: %v", err)
+				p.importEntryDone(ctx, imp, entry, "account could not be retrieved")
 				continue
 			}
 
@@ -226,55 +434,208 @@ func importFollowingAsyncF(
 				},
 			); errWithCode != nil {
 				log.Errorf(ctx, "could not follow account: %v", errWithCode.Unwrap())
+				p.importEntryDone(ctx, imp, entry, errWithCode.Safe())
 				continue
 			}
+
+			p.importEntryDone(ctx, imp, entry, "")
 		}
 	}
 }
 
-func (p *Processor) importBlocks(
-	ctx context.Context,
+func importListsAsyncF(
+	p *Processor,
 	requester *gtsmodel.Account,
-	blocksData *multipart.FileHeader,
-	overwrite bool,
-) gtserror.WithCode {
-	file, err := blocksData.Open()
-	if err != nil {
-		err := fmt.Errorf("error opening blocks data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
-	}
-	defer file.Close()
+	imp *gtsmodel.AccountImport,
+	entries []*gtsmodel.ListEntry,
+) func(context.Context) {
+	overwrite := *imp.Overwrite
+	return func(ctx context.Context) {
+		// Get current lists owned by requester,
+		// so we can add to existing lists of the
+		// same title rather than creating new ones.
+		prevLists, err := p.state.DB.GetListsByAccountID(ctx, requester.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting lists: %v", err)
+			p.importFailed(ctx, imp)
+			return
+		}
 
-	// Parse records out of the file.
-	records, err := csv.NewReader(file).ReadAll()
-	if err != nil {
-		err := fmt.Errorf("error reading blocks data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
-	}
+		// Map of list titles to lists,
+		// including ones we create below.
+		lists := util.KeyBy(prevLists, func(list *gtsmodel.List) string {
+			return list.Title
+		})
 
-	// Convert the records into a slice of barebones blocks.
-	//
-	// Only TargetAccount.Username and TargetAccount.Domain,
-	// will be set on each Block.
-	blocks, err := p.converter.CSVToBlocks(ctx, records)
-	if err != nil {
-		err := fmt.Errorf("error converting records to blocks: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
-	}
+		// Map used to store wanted follow
+		// IDs, keyed by list title (if overwriting).
+		var wantedEntries map[string]map[string]struct{}
 
-	// Do remaining processing of this import asynchronously.
-	f := importBlocksAsyncF(p, requester, blocks, overwrite)
-	p.state.Workers.Processing.Queue.Push(f)
+		if overwrite {
+			// Initialize new entries map.
+			wantedEntries = make(map[string]map[string]struct{})
+
+			// Once we've created (or tried to create)
+			// the required entries, go through previous
+			// lists and remove unwanted ones, along with
+			// unwanted entries from wanted lists.
+			defer func() {
+				for _, prev := range prevLists {
+					wanted, ok := wantedEntries[prev.Title]
+					if !ok {
+						// List isn't in the file
+						// at all, remove it.
+						if err := p.state.DB.DeleteListByID(ctx, prev.ID); err != nil {
+							log.Errorf(ctx, "could not remove list: %v", err)
+						}
+						continue
+					}
+
+					followIDs, err := p.state.DB.GetFollowIDsInList(ctx, prev.ID, nil)
+					if err != nil {
+						log.Errorf(ctx, "db error getting list follow IDs: %v", err)
+						continue
+					}
+
+					for _, followID := range followIDs {
+						if _, wanted := wanted[followID]; wanted {
+							// Leave this
+							// one alone.
+							continue
+						}
+
+						if err := p.state.DB.DeleteListEntry(ctx, prev.ID, followID); err != nil &&
+							!errors.Is(err, db.ErrNoEntries) {
+							log.Errorf(ctx, "could not remove list entry: %v", err)
+							continue
+						}
+					}
+				}
+			}()
+		}
+
+		// Go through the list entries parsed from
+		// CSV file, and create each one (and its
+		// containing list, if necessary).
+		for _, listEntry := range entries {
+			var (
+				// Title of the containing list.
+				title = listEntry.List.Title
+
+				// Username of the target.
+				username = listEntry.Follow.TargetAccount.Username
+
+				// Domain of the target.
+				// Empty for our domain.
+				domain = listEntry.Follow.TargetAccount.Domain
+
+				// List title + address for failures.
+				entry = title + "," + importAddress(username, domain)
+			)
+
+			if overwrite && wantedEntries[title] == nil {
+				// We'll be overwriting, so mark this list as
+				// wanted, even if none of its entries succeed.
+				wantedEntries[title] = make(map[string]struct{})
+			}
+
+			list := lists[title]
+			if list == nil {
+				// No list with this
+				// title yet, create it.
+				list = &gtsmodel.List{
+					ID:            id.NewULID(),
+					Title:         title,
+					AccountID:     requester.ID,
+					RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+					Exclusive:     util.Ptr(false),
+				}
+
+				if err := p.state.DB.PutList(ctx, list); err != nil {
+					log.Errorf(ctx, "db error putting list: %v", err)
+					p.importEntryDone(ctx, imp, entry, "list could not be created")
+					continue
+				}
+
+				lists[title] = list
+			}
+
+			// Get the target account, dereferencing it if necessary.
+			targetAcct, _, err := p.federator.Dereferencer.GetAccountByUsernameDomain(
+				ctx,
+				requester.Username,
+				username,
+				domain,
+			)
+			if err != nil {
+				log.Errorf(ctx, "could not retrieve account: %v", err)
+				p.importEntryDone(ctx, imp, entry, "account could not be retrieved")
+				continue
+			}
+
+			// List entries are made up of
+			// follows, so we need a follow
+			// of the target to add them.
+			follow, err := p.state.DB.GetFollow(
+				gtscontext.SetBarebones(ctx),
+				requester.ID,
+				targetAcct.ID,
+			)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "db error getting follow: %v", err)
+				p.importEntryDone(ctx, imp, entry, "list entry could not be created")
+				continue
+			}
+
+			if follow == nil {
+				p.importEntryDone(ctx, imp, entry, "account not currently followed")
+				continue
+			}
+
+			if overwrite {
+				// We'll be overwriting, so store
+				// this new entry in our handy map.
+				wantedEntries[title][follow.ID] = struct{}{}
+			}
+
+			inList, err := p.state.DB.IsAccountInList(ctx, list.ID, targetAcct.ID)
+			if err != nil {
+				log.Errorf(ctx, "db error checking list entry: %v", err)
+				p.importEntryDone(ctx, imp, entry, "list entry could not be created")
+				continue
+			}
+
+			if inList {
+				// Already in list,
+				// nothing to do.
+				p.importEntryDone(ctx, imp, entry, "")
+				continue
+			}
+
+			if err := p.state.DB.PutListEntries(ctx, []*gtsmodel.ListEntry{{
+				ID:       id.NewULID(),
+				ListID:   list.ID,
+				List:     list,
+				FollowID: follow.ID,
+				Follow:   follow,
+			}}); err != nil {
+				log.Errorf(ctx, "db error putting list entry: %v", err)
+				p.importEntryDone(ctx, imp, entry, "list entry could not be created")
+				continue
+			}
 
-	return nil
+			p.importEntryDone(ctx, imp, entry, "")
+		}
+	}
 }
 
 func importBlocksAsyncF(
 	p *Processor,
 	requester *gtsmodel.Account,
+	imp *gtsmodel.AccountImport,
 	blocks []*gtsmodel.Block,
-	overwrite bool,
 ) func(context.Context) {
+	overwrite := *imp.Overwrite
 	return func(ctx context.Context) {
 		// Map used to store wanted
 		// block targets (if overwriting).
@@ -293,6 +654,7 @@ func importBlocksAsyncF(
 			prevBlocks, err = p.state.DB.GetAccountBlocks(ctx, requester.ID, nil)
 			if err != nil {
 				log.Errorf(ctx, "db error getting blocks: %v", err)
+				p.importFailed(ctx, imp)
 				return
 			}
 
@@ -336,6 +698,9 @@ func importBlocksAsyncF(
 				// Domain of the target.
 				// Empty for our domain.
 				domain = block.TargetAccount.Domain
+
+				// Address for failures.
+				entry = importAddress(username, domain)
 			)
 
 			if overwrite {
@@ -359,6 +724,7 @@ func importBlocksAsyncF(
 			)
 			if err != nil {
 				log.Errorf(ctx, "could not retrieve account: %v", err)
+				p.importEntryDone(ctx, imp, entry, "account could not be retrieved")
 				continue
 			}
 
@@ -372,8 +738,254 @@ func importBlocksAsyncF(
 				targetAcct.ID,
 			); errWithCode != nil {
 				log.Errorf(ctx, "could not block account: %v", errWithCode.Unwrap())
+				p.importEntryDone(ctx, imp, entry, errWithCode.Safe())
 				continue
 			}
+
+			p.importEntryDone(ctx, imp, entry, "")
+		}
+	}
+}
+
+func importMutesAsyncF(
+	p *Processor,
+	requester *gtsmodel.Account,
+	imp *gtsmodel.AccountImport,
+	mutes []*gtsmodel.UserMute,
+) func(context.Context) {
+	overwrite := *imp.Overwrite
+	return func(ctx context.Context) {
+		// Map used to store wanted
+		// mute targets (if overwriting).
+		var wantedMutes map[string]struct{}
+
+		if overwrite {
+			// If we're overwriting, we need to get current
+			// mutes owned by requester *before* making any
+			// changes, so that we can remove unwanted mutes
+			// after we've created new ones.
+			prevMutes, err := p.state.DB.GetAccountMutes(ctx, requester.ID, nil)
+			if err != nil {
+				log.Errorf(ctx, "db error getting mutes: %v", err)
+				p.importFailed(ctx, imp)
+				return
+			}
+
+			// Initialize new mutes map.
+			wantedMutes = make(map[string]struct{}, len(mutes))
+
+			// Once we've created (or tried to create)
+			// the required mutes, go through previous
+			// mutes and remove unwanted ones.
+			defer func() {
+				for _, prev := range prevMutes {
+					username := prev.TargetAccount.Username
+					domain := prev.TargetAccount.Domain
+
+					_, wanted := wantedMutes[username+"@"+domain]
+					if wanted {
+						// Leave this
+						// one alone.
+						continue
+					}
+
+					if _, errWithCode := p.MuteRemove(
+						ctx,
+						requester,
+						prev.TargetAccountID,
+					); errWithCode != nil {
+						log.Errorf(ctx, "could not unmute account: %v", errWithCode.Unwrap())
+						continue
+					}
+				}
+			}()
+		}
+
+		// Go through the mutes parsed from CSV
+		// file, and create / update each one.
+		for _, mute := range mutes {
+			var (
+				// Username of the target.
+				username = mute.TargetAccount.Username
+
+				// Domain of the target.
+				// Empty for our domain.
+				domain = mute.TargetAccount.Domain
+
+				// Address for failures.
+				entry = importAddress(username, domain)
+			)
+
+			if overwrite {
+				// We'll be overwriting, so store
+				// this new mute in our handy map.
+				wantedMutes[username+"@"+domain] = struct{}{}
+			}
+
+			// Get the target account, dereferencing it if necessary.
+			targetAcct, _, err := p.federator.Dereferencer.GetAccountByUsernameDomain(
+				ctx,
+				// Mutes aren't federated, so use the
+				// instance account to deref the account.
+				"",
+				username,
+				domain,
+			)
+			if err != nil {
+				log.Errorf(ctx, "could not retrieve account: %v", err)
+				p.importEntryDone(ctx, imp, entry, "account could not be retrieved")
+				continue
+			}
+
+			// Use the processor's MuteCreate function
+			// to create or update the mute. This takes
+			// account of existing mutes.
+			if _, errWithCode := p.MuteCreate(
+				ctx,
+				requester,
+				targetAcct.ID,
+				&apimodel.UserMuteCreateUpdateRequest{
+					Notifications: mute.Notifications,
+				},
+			); errWithCode != nil {
+				log.Errorf(ctx, "could not mute account: %v", errWithCode.Unwrap())
+				p.importEntryDone(ctx, imp, entry, errWithCode.Safe())
+				continue
+			}
+
+			p.importEntryDone(ctx, imp, entry, "")
+		}
+	}
+}
+
+func importBookmarksAsyncF(
+	p *Processor,
+	requester *gtsmodel.Account,
+	imp *gtsmodel.AccountImport,
+	bookmarks []*gtsmodel.StatusBookmark,
+) func(context.Context) {
+	overwrite := *imp.Overwrite
+	return func(ctx context.Context) {
+		// Map used to store wanted
+		// bookmark targets (if overwriting).
+		var wantedBookmarks map[string]struct{}
+
+		if overwrite {
+			// If we're overwriting, we need to get current
+			// bookmarks owned by requester *before* making
+			// any changes, so that we can remove unwanted
+			// bookmarks after we've created new ones.
+			prevBookmarks, err := p.state.DB.GetStatusBookmarks(ctx, requester.ID, -1, "", "")
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "db error getting bookmarks: %v", err)
+				p.importFailed(ctx, imp)
+				return
+			}
+
+			// Initialize new bookmarks map.
+			wantedBookmarks = make(map[string]struct{}, len(bookmarks))
+
+			// Once we've created (or tried to create)
+			// the required bookmarks, go through previous
+			// bookmarks and remove unwanted ones.
+			defer func() {
+				for _, prev := range prevBookmarks {
+					if prev.Status != nil {
+						_, wanted := wantedBookmarks[prev.Status.ID]
+						if wanted {
+							// Leave this
+							// one alone.
+							continue
+						}
+					}
+
+					if err := p.state.DB.DeleteStatusBookmarkByID(ctx, prev.ID); err != nil {
+						log.Errorf(ctx, "could not remove bookmark: %v", err)
+						continue
+					}
+
+					if err := p.c.InvalidateTimelinedStatus(ctx, requester.ID, prev.StatusID); err != nil {
+						log.Errorf(ctx, "error invalidating status from timelines: %v", err)
+					}
+				}
+			}()
+		}
+
+		// Go through the bookmarks parsed from
+		// CSV file, and create each one.
+		for _, bookmark := range bookmarks {
+			// URI of the bookmarked status.
+			entry := bookmark.Status.URI
+
+			uri, err := url.Parse(entry)
+			if err != nil {
+				p.importEntryDone(ctx, imp, entry, "invalid status URL")
+				continue
+			}
+
+			// Get the target status, dereferencing it if necessary.
+			status, _, err := p.federator.Dereferencer.GetStatusByURI(
+				ctx,
+				requester.Username,
+				uri,
+			)
+			if err != nil {
+				log.Errorf(ctx, "could not retrieve status: %v", err)
+				p.importEntryDone(ctx, imp, entry, "status could not be retrieved")
+				continue
+			}
+
+			if overwrite {
+				// We'll be overwriting, so store
+				// this new bookmark in our handy map.
+				wantedBookmarks[status.ID] = struct{}{}
+			}
+
+			visible, err := p.visFilter.StatusVisible(ctx, requester, status)
+			if err != nil {
+				log.Errorf(ctx, "error checking status visibility: %v", err)
+				p.importEntryDone(ctx, imp, entry, "status could not be retrieved")
+				continue
+			}
+
+			if !visible {
+				p.importEntryDone(ctx, imp, entry, "status is not visible")
+				continue
+			}
+
+			bookmarked, err := p.state.DB.IsStatusBookmarkedBy(ctx, requester.ID, status.ID)
+			if err != nil {
+				log.Errorf(ctx, "db error checking bookmark: %v", err)
+				p.importEntryDone(ctx, imp, entry, "bookmark could not be created")
+				continue
+			}
+
+			if bookmarked {
+				// Already bookmarked,
+				// nothing to do.
+				p.importEntryDone(ctx, imp, entry, "")
+				continue
+			}
+
+			if err := p.state.DB.PutStatusBookmark(ctx, &gtsmodel.StatusBookmark{
+				ID:              id.NewULID(),
+				AccountID:       requester.ID,
+				Account:         requester,
+				TargetAccountID: status.AccountID,
+				TargetAccount:   status.Account,
+				StatusID:        status.ID,
+				Status:          status,
+			}); err != nil {
+				log.Errorf(ctx, "db error putting bookmark: %v", err)
+				p.importEntryDone(ctx, imp, entry, "bookmark could not be created")
+				continue
+			}
+
+			if err := p.c.InvalidateTimelinedStatus(ctx, requester.ID, status.ID); err != nil {
+				log.Errorf(ctx, "error invalidating status from timelines: %v", err)
+			}
+
+			p.importEntryDone(ctx, imp, entry, "")
 		}
 	}
 }
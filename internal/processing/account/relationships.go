@@ -87,6 +87,89 @@ func (p *Processor) FollowersGet(ctx context.Context, requestingAccount *gtsmode
 	}), nil
 }
 
+// FamiliarFollowersGet returns, for each of the given target
+// account IDs, the accounts followed by the requesting account
+// that also follow the target account. Followers are looked up
+// for all target accounts at once.
+func (p *Processor) FamiliarFollowersGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetAccountIDs []string,
+) ([]*apimodel.FamiliarFollowers, gtserror.WithCode) {
+	var (
+		results  = make([]*apimodel.FamiliarFollowers, 0, len(targetAccountIDs))
+		byID     = make(map[string]*apimodel.FamiliarFollowers, len(targetAccountIDs))
+		queryIDs = make([]string, 0, len(targetAccountIDs))
+	)
+
+	for _, targetAccountID := range targetAccountIDs {
+		if _, ok := byID[targetAccountID]; ok {
+			// Already seen.
+			continue
+		}
+
+		// Fetch target account to check it exists, and visibility of requester->target.
+		targetAccount, errWithCode := p.c.GetVisibleTargetAccount(ctx, requestingAccount, targetAccountID)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		result := &apimodel.FamiliarFollowers{
+			ID:       targetAccountID,
+			Accounts: []*apimodel.Account{},
+		}
+		results = append(results, result)
+		byID[targetAccountID] = result
+
+		// Don't reveal followers of a local account
+		// that has hidden them, as with FollowersGet.
+		if targetAccount.IsInstance() ||
+			(targetAccountID != requestingAccount.ID &&
+				targetAccount.IsLocal() &&
+				*targetAccount.Settings.HideCollections) {
+			continue
+		}
+
+		queryIDs = append(queryIDs, targetAccountID)
+	}
+
+	follows, err := p.state.DB.GetFamiliarFollowers(ctx, requestingAccount.ID, queryIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting familiar followers: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Group the followers by
+	// the account they follow.
+	grouped := make(map[string][]*gtsmodel.Account, len(queryIDs))
+	for _, follow := range follows {
+		follower := follow.Account
+		if follower == nil {
+			continue
+		}
+
+		// Don't reveal that a local account follows
+		// the target if it has hidden who it follows.
+		if follower.IsLocal() &&
+			follower.Settings != nil &&
+			*follower.Settings.HideCollections {
+			continue
+		}
+
+		grouped[follow.TargetAccountID] = append(grouped[follow.TargetAccountID], follower)
+	}
+
+	for targetAccountID, followers := range grouped {
+		byID[targetAccountID].Accounts = p.c.GetVisibleAPIAccounts(ctx,
+			requestingAccount,
+			func(i int) *gtsmodel.Account { return followers[i] },
+			len(followers),
+		)
+	}
+
+	return results, nil
+}
+
 // FollowingGet fetches a list of the accounts that target account is following.
 func (p *Processor) FollowingGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string, page *paging.Page) (*apimodel.PageableResponse, gtserror.WithCode) {
 	// Fetch target account to check it exists, and visibility of requester->target.
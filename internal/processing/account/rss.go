@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"time"
@@ -82,7 +83,7 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string)
 
 	return func() (string, gtserror.WithCode) {
 		// Assemble author namestring once only.
-		author := "@" + account.Username + "@" + config.GetAccountDomain()
+		author := "@" + account.Username + "@" + account.HandleDomain()
 
 		// Derive image/thumbnail for this account (may be nil).
 		image, errWithCode := p.rssImageForAccount(ctx, account, author)
@@ -106,7 +107,7 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string)
 		// since we already know there's no eligible statuses.
 		if lastPostAt.IsZero() {
 			feed.Updated = account.CreatedAt
-			return stringifyFeed(feed)
+			return stringifyFeed(feed, RSSFeedURL(account))
 		}
 
 		// Account has posted at least one status that's
@@ -133,7 +134,7 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string)
 			feed.Add(item)
 		}
 
-		return stringifyFeed(feed)
+		return stringifyFeed(feed, RSSFeedURL(account))
 	}, lastPostAt, nil
 }
 
@@ -166,10 +167,66 @@ func (p *Processor) rssImageForAccount(ctx context.Context, account *gtsmodel.Ac
 	}, nil
 }
 
-func stringifyFeed(feed *feeds.Feed) (string, gtserror.WithCode) {
+// rssFeedXML wraps an RSS feed in the same way as
+// feeds.RssFeedXml, but also declares the atom namespace
+// so that WebSub discovery links can be added to the channel.
+type rssFeedXML struct {
+	XMLName          xml.Name `xml:"rss"`
+	Version          string   `xml:"version,attr"`
+	ContentNamespace string   `xml:"xmlns:content,attr"`
+	AtomNamespace    string   `xml:"xmlns:atom,attr"`
+	Channel          *rssChannel
+}
+
+// rssChannel is an RSS channel
+// with additional atom links.
+type rssChannel struct {
+	*feeds.RssFeed
+	AtomLinks []rssAtomLink `xml:"atom:link"`
+}
+
+// rssAtomLink is an atom:link
+// element within an RSS channel.
+type rssAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// FeedXml implements feeds.XmlFeed.
+func (r *rssFeedXML) FeedXml() interface{} {
+	return r
+}
+
+func stringifyFeed(feed *feeds.Feed, selfURL string) (string, gtserror.WithCode) {
+	var (
+		rss string
+		err error
+	)
+
 	// Stringify the feed. Even with no statuses,
 	// this will still produce valid rss xml.
-	rss, err := feed.ToRss()
+	if hubURL := config.GetInstanceWebSubHubURL(); hubURL == "" {
+		rss, err = feed.ToRss()
+	} else {
+		// WebSub is enabled, so include discovery
+		// links for the hub and this feed's own URL.
+		//
+		// See https://www.w3.org/TR/websub/#discovery
+		rss, err = feeds.ToXML(&rssFeedXML{
+			Version:          "2.0",
+			ContentNamespace: "http://purl.org/rss/1.0/modules/content/",
+			AtomNamespace:    "http://www.w3.org/2005/Atom",
+			Channel: &rssChannel{
+				RssFeed: (&feeds.Rss{Feed: feed}).RssFeed(),
+				AtomLinks: []rssAtomLink{
+					{Href: hubURL, Rel: "hub"},
+					{Href: selfURL, Rel: "self", Type: "application/rss+xml"},
+				},
+			},
+		})
+	}
+
 	if err != nil {
 		err := gtserror.Newf("error converting feed to rss string: %w", err)
 		return "", gtserror.NewErrorInternalError(err)
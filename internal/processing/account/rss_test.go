@@ -22,6 +22,7 @@
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
 
 type GetRSSTestSuite struct {
@@ -183,6 +184,48 @@ func (suite *GetRSSTestSuite) TestGetAccountRSSZorkNoPosts() {
 </rss>`, feed)
 }
 
+func (suite *GetRSSTestSuite) TestGetAccountRSSZorkWebSub() {
+	ctx := context.Background()
+
+	config.SetInstanceWebSubHubURL("https://hub.example.org/")
+	defer config.SetInstanceWebSubHubURL("")
+
+	// Get all of zork's posts.
+	statuses, err := suite.db.GetAccountStatuses(ctx, suite.testAccounts["local_account_1"].ID, 0, false, false, "", "", false, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Delete them so the feed is stable.
+	for _, status := range statuses {
+		if err := suite.db.DeleteStatusByID(ctx, status.ID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	getFeed, _, err := suite.accountProcessor.GetRSSFeedForUsername(ctx, "the_mighty_zork")
+	suite.NoError(err)
+
+	feed, err := getFeed()
+	suite.NoError(err)
+	suite.Equal(`<?xml version="1.0" encoding="UTF-8"?><rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:atom="http://www.w3.org/2005/Atom">
+  <channel>
+    <title>Posts from @the_mighty_zork@localhost:8080</title>
+    <link>http://localhost:8080/@the_mighty_zork</link>
+    <description>Posts from @the_mighty_zork@localhost:8080</description>
+    <pubDate>Fri, 20 May 2022 11:09:18 +0000</pubDate>
+    <lastBuildDate>Fri, 20 May 2022 11:09:18 +0000</lastBuildDate>
+    <image>
+      <url>http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/small/01F8MH58A357CV5K7R7TJMSH6S.webp</url>
+      <title>Avatar for @the_mighty_zork@localhost:8080</title>
+      <link>http://localhost:8080/@the_mighty_zork</link>
+    </image>
+    <atom:link href="https://hub.example.org/" rel="hub"></atom:link>
+    <atom:link href="http://localhost:8080/@the_mighty_zork/feed.rss" rel="self" type="application/rss+xml"></atom:link>
+  </channel>
+</rss>`, feed)
+}
+
 func TestGetRSSTestSuite(t *testing.T) {
 	suite.Run(t, new(GetRSSTestSuite))
 }
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// SeveranceEventsGet returns a page of moderation events,
+// newest first, in which the given account lost follows.
+func (p *Processor) SeveranceEventsGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	events, err := p.state.DB.GetAccountSeveranceEvents(ctx, account.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting severance events: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(events)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	var (
+		items = make([]interface{}, 0, count)
+
+		// Get the lowest and highest
+		// ID values, used for paging.
+		lo = events[count-1].ID
+		hi = events[0].ID
+	)
+
+	for _, event := range events {
+		apiEvent, err := p.converter.SeveranceEventToAPISeveranceEvent(ctx, event, account.ID)
+		if err != nil {
+			log.Errorf(ctx, "error converting severance event %s to api: %v", event.ID, err)
+			continue
+		}
+
+		items = append(items, apiEvent)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/severed_relationships",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// SeveredRelationshipsGet returns the follows of the given account
+// that were removed by the given event, optionally only those
+// in the given direction.
+func (p *Processor) SeveredRelationshipsGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	eventID string,
+	direction gtsmodel.SeveredDirection,
+) ([]*apimodel.SeveredRelationship, gtserror.WithCode) {
+	rels, err := p.state.DB.GetSeveredRelationships(ctx, eventID, account.ID, direction)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting severed relationships: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(rels) == 0 && direction == 0 {
+		// No relationships at all for this account in this
+		// event, so as far as they're concerned it doesn't exist.
+		const text = "severance event not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	apiRels := make([]*apimodel.SeveredRelationship, 0, len(rels))
+	for _, rel := range rels {
+		apiRel, err := p.converter.SeveredRelationshipToAPISeveredRelationship(ctx, rel)
+		if err != nil {
+			log.Errorf(ctx, "error converting severed relationship %s to api: %v", rel.ID, err)
+			continue
+		}
+
+		apiRels = append(apiRels, apiRel)
+	}
+
+	return apiRels, nil
+}
@@ -294,6 +294,56 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		settingsColumns = append(settingsColumns, "web_visibility")
 	}
 
+	if form.StatusExpiryDays != nil {
+		days := *form.StatusExpiryDays
+		if err := validate.StatusExpiryDays(days); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		account.Settings.StatusExpiryDays = days
+		settingsColumns = append(settingsColumns, "status_expiry_days")
+	}
+
+	if form.StatusExpiryKeepPinned != nil {
+		account.Settings.StatusExpiryKeepPinned = form.StatusExpiryKeepPinned
+		settingsColumns = append(settingsColumns, "status_expiry_keep_pinned")
+	}
+
+	if form.StatusExpiryKeepSelfFaved != nil {
+		account.Settings.StatusExpiryKeepSelfFaved = form.StatusExpiryKeepSelfFaved
+		settingsColumns = append(settingsColumns, "status_expiry_keep_self_faved")
+	}
+
+	if form.EmailDigestDays != nil {
+		days := *form.EmailDigestDays
+		if err := validate.EmailDigestDays(days); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		account.Settings.EmailDigestDays = days
+		settingsColumns = append(settingsColumns, "email_digest_days")
+	}
+
+	if form.EmailDigestFollows != nil {
+		account.Settings.EmailDigestFollows = form.EmailDigestFollows
+		settingsColumns = append(settingsColumns, "email_digest_follows")
+	}
+
+	if form.EmailDigestMentions != nil {
+		account.Settings.EmailDigestMentions = form.EmailDigestMentions
+		settingsColumns = append(settingsColumns, "email_digest_mentions")
+	}
+
+	if form.EmailDigestFollowRequests != nil {
+		account.Settings.EmailDigestFollowRequests = form.EmailDigestFollowRequests
+		settingsColumns = append(settingsColumns, "email_digest_follow_requests")
+	}
+
+	if form.RequireMediaDescriptions != nil {
+		account.Settings.RequireMediaDescriptions = form.RequireMediaDescriptions
+		settingsColumns = append(settingsColumns, "require_media_descriptions")
+	}
+
 	// We've parsed + set everything, do
 	// necessary database updates now.
 
@@ -311,6 +361,12 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		}
 	}
 
+	if form.FieldsAttributes != nil {
+		// Check any links in the
+		// new fields for rel="me".
+		p.verifyFieldsAsync(account.ID)
+	}
+
 	// Send out Update message over the s2s (fedi) API.
 	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
 		APObjectType:   ap.ActorPerson,
@@ -359,6 +415,16 @@ func (p *Processor) updateFields(
 			Name:  text.SanitizeToPlaintext(name),
 			Value: text.SanitizeToPlaintext(value),
 		}
+
+		// Keep verification of unchanged
+		// values until they're re-checked.
+		for _, prev := range account.FieldsRaw {
+			if prev.Value == fieldRaw.Value {
+				fieldRaw.VerifiedAt = prev.VerifiedAt
+				break
+			}
+		}
+
 		fieldsRaw = append(fieldsRaw, fieldRaw)
 	}
 
@@ -410,6 +476,10 @@ func (p *Processor) processAccountText(
 	for _, fieldRaw := range account.FieldsRaw {
 		field := &gtsmodel.Field{}
 
+		// Verification is done on the raw
+		// value, so carry it over as-is.
+		field.VerifiedAt = fieldRaw.VerifiedAt
+
 		// Name stays plain, but we still need to
 		// see if there are any emojis set in it.
 		field.Name = fieldRaw.Name
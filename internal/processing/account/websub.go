@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// RSSFeedURL returns the URL of the RSS feed of the given local account.
+func RSSFeedURL(account *gtsmodel.Account) string {
+	return account.URL + "/feed.rss"
+}
+
+// PublishRSSFeed notifies the configured WebSub hub, if any, that the
+// RSS feed of the given status' author has changed because the status
+// was created, edited or deleted. The hub will then fetch the feed and
+// push the changes to subscribers. Errors are logged, not returned, as
+// a failure to reach the hub should not affect processing the status.
+//
+// See https://www.w3.org/TR/websub/#publishing
+func (p *Processor) PublishRSSFeed(ctx context.Context, status *gtsmodel.Status) {
+	hubURL := config.GetInstanceWebSubHubURL()
+	if hubURL == "" {
+		// WebSub not enabled.
+		return
+	}
+
+	if !status.IsLocal() {
+		// Only local accounts have
+		// feeds for us to publish.
+		return
+	}
+
+	account := status.Account
+	if account == nil {
+		var err error
+		account, err = p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting status account: %v", err)
+			return
+		}
+	}
+
+	if account.Settings == nil {
+		var err error
+		account.Settings, err = p.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting account settings: %v", err)
+			return
+		}
+	}
+
+	if !rssEligible(account, status) {
+		// Feed is unaffected.
+		return
+	}
+
+	topic := RSSFeedURL(account)
+	if err := p.publish(ctx, hubURL, topic); err != nil {
+		log.Errorf(ctx, "error publishing %s to websub hub: %v", topic, err)
+	}
+}
+
+// publish sends a publish notification
+// for the given topic URL to the hub.
+func (p *Processor) publish(ctx context.Context, hubURL string, topic string) error {
+	form := url.Values{
+		"hub.mode": {"publish"},
+		"hub.url":  {topic},
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		hubURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return gtserror.Newf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := p.websubClient.Do(req)
+	if err != nil {
+		return gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	// Drain body so the
+	// connection can be reused.
+	_, _ = io.Copy(io.Discard, rsp.Body)
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return gtserror.Newf("hub responded with status %s", rsp.Status)
+	}
+
+	return nil
+}
+
+// rssEligible returns whether the given status by the given
+// account would appear in the account's RSS feed, mirroring
+// the selection done by the database for account web statuses.
+func rssEligible(account *gtsmodel.Account, status *gtsmodel.Status) bool {
+	if !util.PtrOrZero(account.Settings.EnableRSS) {
+		return false
+	}
+
+	if status.BoostOfID != "" ||
+		status.InReplyToURI != "" ||
+		status.IsLocalOnly() ||
+		util.PtrOrZero(status.PendingApproval) {
+		return false
+	}
+
+	switch account.Settings.WebVisibility {
+	case gtsmodel.VisibilityPublic:
+		return status.Visibility == gtsmodel.VisibilityPublic
+	case gtsmodel.VisibilityUnlocked:
+		return status.Visibility == gtsmodel.VisibilityPublic ||
+			status.Visibility == gtsmodel.VisibilityUnlocked
+	default:
+		return false
+	}
+}
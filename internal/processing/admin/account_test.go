@@ -20,10 +20,12 @@
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -75,6 +77,18 @@ func (suite *AccountTestSuite) TestAccountActionSuspend() {
 	}
 
 	suite.NotZero(targetAcct.SuspendedAt)
+
+	// Ensure the suspended account's
+	// email was blocked from sign-ups.
+	block, err := suite.db.GetCanonicalEmailBlockByHash(ctx,
+		util.CanonicalEmailHash(suite.testUsers["local_account_1"].Email),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(targetAcct.ID, block.ReferenceAccountID)
+	suite.Equal(adminAcct.ID, block.CreatedByAccountID)
 }
 
 func (suite *AccountTestSuite) TestAccountActionUnsupported() {
@@ -94,10 +108,243 @@ func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 		adminAcct,
 		request,
 	)
-	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\"]")
+	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"disable\" \"silence\" \"suspend\"]")
 	suite.Empty(actionID)
 }
 
+func (suite *AccountTestSuite) TestAccountActionSilenceExpiring() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category:  gtsmodel.AdminActionCategoryAccount.String(),
+			Type:      gtsmodel.AdminActionSilence.String(),
+			Text:      "too loud",
+			TargetID:  suite.testAccounts["remote_account_1"].ID,
+			ExpiresAt: time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure action completed,
+	// but not yet lifted.
+	adminAction, err := suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotZero(adminAction.CompletedAt)
+	suite.NotZero(adminAction.ExpiresAt)
+	suite.Zero(adminAction.LiftedAt)
+
+	// Ensure target account silenced.
+	targetAcct, err := suite.db.GetAccountByID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(targetAcct.IsSilenced())
+}
+
+func (suite *AccountTestSuite) TestAccountActionScheduled() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category:    gtsmodel.AdminActionCategoryAccount.String(),
+			Type:        "freeze",
+			TargetID:    suite.testAccounts["local_account_1"].ID,
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Ensure action stored as
+	// pending and not yet run.
+	adminAction, err := suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(gtsmodel.AdminActionDisable, adminAction.Type)
+	suite.True(adminAction.IsPending())
+	suite.Zero(adminAction.CompletedAt)
+
+	// Ensure target user not disabled yet.
+	user, err := suite.db.GetUserByAccountID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.False(*user.Disabled)
+}
+
+func (suite *AccountTestSuite) TestAccountActionSuspendExpiring() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category:  gtsmodel.AdminActionCategoryAccount.String(),
+			Type:      gtsmodel.AdminActionSuspend.String(),
+			TargetID:  suite.testAccounts["local_account_1"].ID,
+			ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.EqualError(errWithCode, "suspend actions cannot expire, as suspending an account deletes it")
+	suite.Empty(actionID)
+}
+
+func (suite *AccountTestSuite) TestLiftExpiredActions() {
+	var (
+		ctx        = context.Background()
+		now        = time.Now()
+		adminAcct  = suite.testAccounts["admin_account"]
+		remoteAcct = suite.testAccounts["remote_account_1"]
+		localAcct  = suite.testAccounts["local_account_1"]
+	)
+
+	// Silence the remote account.
+	targetAcct := new(gtsmodel.Account)
+	*targetAcct = *remoteAcct
+	targetAcct.SilencedAt = now.Add(-2 * time.Hour)
+	if err := suite.db.UpdateAccount(ctx, targetAcct, "silenced_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Disable the local account.
+	targetUser := new(gtsmodel.User)
+	*targetUser = *suite.testUsers["local_account_1"]
+	targetUser.Disabled = util.Ptr(true)
+	if err := suite.db.UpdateUser(ctx, targetUser, "disabled"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var (
+		// Expired silence, followed
+		// by a newer silence that
+		// doesn't expire.
+		expiredSilence = &gtsmodel.AdminAction{
+			ID:             "01JKG0W3N7TZ7TA1S4R1X9M7QF",
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       remoteAcct.ID,
+			Type:           gtsmodel.AdminActionSilence,
+			AccountID:      adminAcct.ID,
+			CompletedAt:    now.Add(-2 * time.Hour),
+			ExpiresAt:      now.Add(-time.Minute),
+		}
+		newerSilence = &gtsmodel.AdminAction{
+			ID:             "01JKG0W3N8A6W8M1BXFJ3B0ZJ2",
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       remoteAcct.ID,
+			Type:           gtsmodel.AdminActionSilence,
+			AccountID:      adminAcct.ID,
+			CompletedAt:    now.Add(-time.Hour),
+		}
+
+		// Expired disable that
+		// completed with errors.
+		erroredDisable = &gtsmodel.AdminAction{
+			ID:             "01JKG0W3N8QY3Z7J3DJW6D6K6A",
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       localAcct.ID,
+			Type:           gtsmodel.AdminActionDisable,
+			AccountID:      adminAcct.ID,
+			CompletedAt:    now.Add(-time.Hour),
+			ExpiresAt:      now.Add(-time.Minute),
+			Errors:         []string{"oopsie"},
+		}
+	)
+
+	for _, action := range []*gtsmodel.AdminAction{
+		expiredSilence,
+		newerSilence,
+		erroredDisable,
+	} {
+		if err := suite.db.PutAdminAction(ctx, action); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Errored action shouldn't be up for lifting.
+	expired, err := suite.db.GetExpiredAdminActions(ctx, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(expired, 1)
+	suite.Equal(expiredSilence.ID, expired[0].ID)
+
+	suite.adminProcessor.LiftExpiredActions(ctx, now)
+
+	// Wait for any actions to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Expired silence should be marked as lifted, but
+	// not reversed, since the newer silence still applies.
+	dbAction, err := suite.db.GetAdminAction(ctx, expiredSilence.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(dbAction.LiftedAt)
+
+	dbAcct, err := suite.db.GetAccountByID(ctx, remoteAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbAcct.IsSilenced())
+
+	// Errored disable should be left alone.
+	dbAction, err = suite.db.GetAdminAction(ctx, erroredDisable.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(dbAction.LiftedAt)
+
+	dbUser, err := suite.db.GetUserByAccountID(ctx, localAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbUser.Disabled)
+
+	// Nothing should have been
+	// reversed at all, in fact.
+	actions, err := suite.db.GetAdminActionsByTargetID(ctx, remoteAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(actions, 2)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
@@ -19,16 +19,26 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// runAdminActionsEvery is the frequency at which
+// scheduled admin actions are run, and expired
+// admin actions are lifted.
+const runAdminActionsEvery = time.Minute
+
 func (p *Processor) AccountAction(
 	ctx context.Context,
 	adminAcct *gtsmodel.Account,
@@ -40,14 +50,37 @@ func (p *Processor) AccountAction(
 		return "", gtserror.NewErrorInternalError(err)
 	}
 
-	switch gtsmodel.ParseAdminActionType(request.Type) {
-	case gtsmodel.AdminActionSuspend:
-		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
+	var targetUser *gtsmodel.User
+	if targetAcct.IsLocal() {
+		targetUser, err = p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting target user: %w", err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if errWithCode := p.checkCanModerate(ctx, adminAcct, targetUser); errWithCode != nil {
+		return "", errWithCode
+	}
+
+	actionType := gtsmodel.ParseAdminActionType(request.Type)
+	switch actionType {
+	case gtsmodel.AdminActionSuspend,
+		gtsmodel.AdminActionSilence:
+		// Supported for all accounts.
+
+	case gtsmodel.AdminActionDisable:
+		if !targetAcct.IsLocal() {
+			const text = "disable actions can only be taken on local accounts"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
 
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
+			gtsmodel.AdminActionDisable.String(),
+			gtsmodel.AdminActionSilence.String(),
 			gtsmodel.AdminActionSuspend.String(),
 		}
 
@@ -58,29 +91,120 @@ func (p *Processor) AccountAction(
 
 		return "", gtserror.NewErrorBadRequest(err, err.Error())
 	}
+
+	scheduledAt, expiresAt, errWithCode := parseActionTimes(request)
+	if errWithCode != nil {
+		return "", errWithCode
+	}
+
+	if actionType == gtsmodel.AdminActionSuspend && !expiresAt.IsZero() {
+		const text = "suspend actions cannot expire, as suspending an account deletes it"
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	action := &gtsmodel.AdminAction{
+		ID:             id.NewULID(),
+		TargetCategory: gtsmodel.AdminActionCategoryAccount,
+		TargetID:       targetAcct.ID,
+		Target:         targetAcct,
+		Type:           actionType,
+		AccountID:      adminAcct.ID,
+		Text:           request.Text,
+		ScheduledAt:    scheduledAt,
+		ExpiresAt:      expiresAt,
+	}
+
+	if action.IsPending() {
+		// Store the action to be
+		// run later by the scheduler.
+		if err := p.state.DB.PutAdminAction(ctx, action); err != nil {
+			err := gtserror.Newf("db error putting admin action: %w", err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+		p.LogAction(ctx, adminAcct, actionType.String(), gtsmodel.AuditTargetAccount, targetAcct.ID, request.Text)
+		return action.ID, nil
+	}
+
+	errWithCode = p.actions.Run(
+		ctx,
+		action,
+		p.accountActionF(adminAcct, targetAcct, actionType),
+	)
+	if errWithCode != nil {
+		return action.ID, errWithCode
+	}
+
+	p.LogAction(ctx, adminAcct, actionType.String(), gtsmodel.AuditTargetAccount, targetAcct.ID, request.Text)
+	return action.ID, nil
 }
 
-func (p *Processor) accountActionSuspend(
-	ctx context.Context,
+// parseActionTimes parses and checks the optional
+// scheduled and expiry times of an admin action request.
+func parseActionTimes(request *apimodel.AdminActionRequest) (time.Time, time.Time, gtserror.WithCode) {
+	var (
+		scheduledAt time.Time
+		expiresAt   time.Time
+		err         error
+	)
+
+	if request.ScheduledAt != "" {
+		scheduledAt, err = time.Parse(time.RFC3339, request.ScheduledAt)
+		if err != nil {
+			text := fmt.Sprintf("invalid scheduled_at: %v", err)
+			return time.Time{}, time.Time{}, gtserror.NewErrorBadRequest(err, text)
+		}
+
+		if scheduledAt.Before(time.Now()) {
+			const text = "scheduled_at must be in the future"
+			return time.Time{}, time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	if request.ExpiresAt != "" {
+		expiresAt, err = time.Parse(time.RFC3339, request.ExpiresAt)
+		if err != nil {
+			text := fmt.Sprintf("invalid expires_at: %v", err)
+			return time.Time{}, time.Time{}, gtserror.NewErrorBadRequest(err, text)
+		}
+
+		// Action takes effect either
+		// now or at the scheduled time.
+		start := scheduledAt
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		if !expiresAt.After(start) {
+			const text = "expires_at must be after the action takes effect"
+			return time.Time{}, time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	return scheduledAt, expiresAt, nil
+}
+
+// accountActionF returns the function that performs
+// an account admin action of the given type, to be
+// passed to Actions{}.Run().
+func (p *Processor) accountActionF(
 	adminAcct *gtsmodel.Account,
 	targetAcct *gtsmodel.Account,
-	text string,
-) (string, gtserror.WithCode) {
-	actionID := id.NewULID()
+	actionType gtsmodel.AdminActionType,
+) func(context.Context) gtserror.MultiError {
+	return func(ctx context.Context) gtserror.MultiError {
+		var err error
 
-	errWithCode := p.actions.Run(
-		ctx,
-		&gtsmodel.AdminAction{
-			ID:             actionID,
-			TargetCategory: gtsmodel.AdminActionCategoryAccount,
-			TargetID:       targetAcct.ID,
-			Target:         targetAcct,
-			Type:           gtsmodel.AdminActionSuspend,
-			AccountID:      adminAcct.ID,
-			Text:           text,
-		},
-		func(ctx context.Context) gtserror.MultiError {
-			if err := p.state.Workers.Client.Process(
+		switch actionType {
+		case gtsmodel.AdminActionSuspend:
+			if targetAcct.IsLocal() {
+				// Block the suspended account's email before its
+				// user is stubbified, to prevent easy re-registration.
+				if err := p.blockSuspendedEmail(ctx, adminAcct, targetAcct); err != nil {
+					log.Errorf(ctx, "error blocking suspended account email: %v", err)
+				}
+			}
+
+			err = p.state.Workers.Client.Process(
 				ctx,
 				&messages.FromClientAPI{
 					APObjectType:   ap.ActorPerson,
@@ -88,15 +212,233 @@ func(ctx context.Context) gtserror.MultiError {
 					Origin:         adminAcct,
 					Target:         targetAcct,
 				},
-			); err != nil {
-				errs := gtserror.NewMultiError(1)
-				errs.Append(err)
-				return errs
+			)
+
+		case gtsmodel.AdminActionDisable,
+			gtsmodel.AdminActionReenable:
+			err = p.accountSetDisabled(ctx, targetAcct,
+				actionType == gtsmodel.AdminActionDisable,
+			)
+
+		case gtsmodel.AdminActionSilence,
+			gtsmodel.AdminActionUnsilence:
+			err = p.accountSetSilenced(ctx, targetAcct,
+				actionType == gtsmodel.AdminActionSilence,
+			)
+
+		default:
+			err = gtserror.Newf("unsupported account action type %s", actionType)
+		}
+
+		if err != nil {
+			errs := gtserror.NewMultiError(1)
+			errs.Append(err)
+			return errs
+		}
+
+		return nil
+	}
+}
+
+// accountSetDisabled sets whether the user
+// of the given local account is disabled.
+func (p *Processor) accountSetDisabled(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+	disabled bool,
+) error {
+	user, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		return gtserror.Newf("db error getting user for account %s: %w", targetAcct.ID, err)
+	}
+
+	user.Disabled = util.Ptr(disabled)
+	if err := p.state.DB.UpdateUser(ctx, user, "disabled"); err != nil {
+		return gtserror.Newf("db error updating user %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+// accountSetSilenced sets whether
+// the given account is silenced.
+func (p *Processor) accountSetSilenced(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+	silenced bool,
+) error {
+	if silenced {
+		targetAcct.SilencedAt = time.Now()
+	} else {
+		targetAcct.SilencedAt = time.Time{}
+	}
+
+	if err := p.state.DB.UpdateAccount(ctx, targetAcct, "silenced_at"); err != nil {
+		return gtserror.Newf("db error updating account %s: %w", targetAcct.ID, err)
+	}
+
+	return nil
+}
+
+// ScheduleAdminActions schedules admin actions to be
+// run at their scheduled time, and lifted on expiry.
+func (p *Processor) ScheduleAdminActions() {
+	fn := func(ctx context.Context, start time.Time) {
+		p.runScheduledActions(ctx, start)
+		p.LiftExpiredActions(ctx, start)
+	}
+
+	log.Infof(nil, "scheduling admin actions to run every %s", runAdminActionsEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@adminactions",
+		time.Now(),
+		runAdminActionsEvery,
+		fn,
+	) {
+		panic("failed to schedule @adminactions")
+	}
+}
+
+// runScheduledActions runs all admin actions
+// scheduled to be run at or before now.
+func (p *Processor) runScheduledActions(ctx context.Context, now time.Time) {
+	actions, err := p.state.DB.GetScheduledAdminActions(ctx, now)
+	if err != nil {
+		log.Errorf(ctx, "db error getting scheduled admin actions: %v", err)
+		return
+	}
+
+	for _, action := range actions {
+		adminAcct, targetAcct, err := p.getAccountActionAccounts(ctx, action)
+		if err != nil {
+			log.Errorf(ctx, "error running scheduled admin action %s: %v", action.ID, err)
+			continue
+		}
+
+		action.Target = targetAcct
+		if errWithCode := p.actions.RunScheduled(ctx, action,
+			p.accountActionF(adminAcct, targetAcct, action.Type),
+		); errWithCode != nil {
+			// Likely a conflicting action is
+			// running, we'll try again next time.
+			log.Warnf(ctx, "error running scheduled admin action %s: %v", action.ID, errWithCode)
+		}
+	}
+}
+
+// LiftExpiredActions lifts all admin actions that
+// expired at or before now, by running an action of
+// the reverse type, eg., unsilence for a silence.
+//
+// Actions superseded by a newer action of the same
+// type on the same target are marked as lifted without
+// being reversed, so as not to undo the newer action.
+func (p *Processor) LiftExpiredActions(ctx context.Context, now time.Time) {
+	actions, err := p.state.DB.GetExpiredAdminActions(ctx, now)
+	if err != nil {
+		log.Errorf(ctx, "db error getting expired admin actions: %v", err)
+		return
+	}
+
+	for _, action := range actions {
+		adminAcct, targetAcct, err := p.getAccountActionAccounts(ctx, action)
+		if err != nil {
+			log.Errorf(ctx, "error lifting expired admin action %s: %v", action.ID, err)
+			continue
+		}
+
+		superseded, err := p.isActionSuperseded(ctx, action)
+		if err != nil {
+			log.Errorf(ctx, "error lifting expired admin action %s: %v", action.ID, err)
+			continue
+		}
+
+		if superseded {
+			// Newer action still in effect,
+			// just mark this one as lifted.
+			action.LiftedAt = now
+			if err := p.state.DB.UpdateAdminAction(ctx, action, "lifted_at"); err != nil {
+				log.Errorf(ctx, "db error marking admin action %s as lifted: %v", action.ID, err)
 			}
+			continue
+		}
 
-			return nil
-		},
-	)
+		reverse := &gtsmodel.AdminAction{
+			ID:             id.NewULID(),
+			TargetCategory: action.TargetCategory,
+			TargetID:       action.TargetID,
+			Target:         targetAcct,
+			Type:           action.Type.Reverse(),
+			AccountID:      action.AccountID,
+			Text:           "lifted expired admin action " + action.ID,
+		}
+
+		if errWithCode := p.actions.Run(ctx, reverse,
+			p.accountActionF(adminAcct, targetAcct, reverse.Type),
+		); errWithCode != nil {
+			// Likely a conflicting action is
+			// running, we'll try again next time.
+			log.Warnf(ctx, "error lifting expired admin action %s: %v", action.ID, errWithCode)
+			continue
+		}
+
+		action.LiftedAt = now
+		if err := p.state.DB.UpdateAdminAction(ctx, action, "lifted_at"); err != nil {
+			log.Errorf(ctx, "db error marking admin action %s as lifted: %v", action.ID, err)
+		}
+	}
+}
+
+// isActionSuperseded returns whether a newer action of the
+// same type as the given action, on the same target, has been
+// carried out without errors and hasn't been lifted since.
+func (p *Processor) isActionSuperseded(
+	ctx context.Context,
+	action *gtsmodel.AdminAction,
+) (bool, error) {
+	actions, err := p.state.DB.GetAdminActionsByTargetID(ctx, action.TargetID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting admin actions for target %s: %w", action.TargetID, err)
+	}
+
+	// Actions are ordered newest
+	// first, so stop at this one.
+	for _, other := range actions {
+		if other.ID <= action.ID {
+			break
+		}
+
+		if other.Type == action.Type &&
+			!other.CompletedAt.IsZero() &&
+			len(other.Errors) == 0 &&
+			!other.IsLifted() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getAccountActionAccounts fetches the admin and target
+// accounts of a stored account admin action, to run it.
+func (p *Processor) getAccountActionAccounts(
+	ctx context.Context,
+	action *gtsmodel.AdminAction,
+) (*gtsmodel.Account, *gtsmodel.Account, error) {
+	if action.TargetCategory != gtsmodel.AdminActionCategoryAccount {
+		return nil, nil, gtserror.Newf("unsupported action target category %s", action.TargetCategory)
+	}
+
+	adminAcct, err := p.state.DB.GetAccountByID(ctx, action.AccountID)
+	if err != nil {
+		return nil, nil, gtserror.Newf("db error getting admin account %s: %w", action.AccountID, err)
+	}
+
+	targetAcct, err := p.state.DB.GetAccountByID(ctx, action.TargetID)
+	if err != nil {
+		return nil, nil, gtserror.Newf("db error getting target account %s: %w", action.TargetID, err)
+	}
 
-	return actionID, errWithCode
+	return adminAcct, targetAcct, nil
 }
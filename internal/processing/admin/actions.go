@@ -64,6 +64,27 @@ func (a *Actions) Run(
 	ctx context.Context,
 	action *gtsmodel.AdminAction,
 	f func(context.Context) gtserror.MultiError,
+) gtserror.WithCode {
+	return a.run(ctx, action, f, a.state.DB.PutAdminAction)
+}
+
+// RunScheduled is like Run, but for an action previously
+// stored in the database to be run at its scheduled time.
+func (a *Actions) RunScheduled(
+	ctx context.Context,
+	action *gtsmodel.AdminAction,
+	f func(context.Context) gtserror.MultiError,
+) gtserror.WithCode {
+	return a.run(ctx, action, f, func(context.Context, *gtsmodel.AdminAction) error {
+		return nil // already stored.
+	})
+}
+
+func (a *Actions) run(
+	ctx context.Context,
+	action *gtsmodel.AdminAction,
+	f func(context.Context) gtserror.MultiError,
+	put func(context.Context, *gtsmodel.AdminAction) error,
 ) gtserror.WithCode {
 	actionKey := action.Key()
 
@@ -81,7 +102,7 @@ func (a *Actions) Run(
 
 	// Action with this key not
 	// yet running, create it.
-	if err := a.state.DB.PutAdminAction(ctx, action); err != nil {
+	if err := put(ctx, action); err != nil {
 		err = gtserror.Newf("db error putting admin action %s: %w", actionKey, err)
 
 		// Don't store in map
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// AdminActionGet returns the admin action with the given ID.
+func (p *Processor) AdminActionGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.AdminAction, gtserror.WithCode) {
+	action, err := p.state.DB.GetAdminAction(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("admin action %s not found", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err := gtserror.Newf("db error getting admin action %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAdminAction(ctx, action)
+}
+
+// AdminActionsGet returns all admin actions, newest first,
+// optionally filtered to those targeting the given ID.
+func (p *Processor) AdminActionsGet(
+	ctx context.Context,
+	targetID string,
+) ([]*apimodel.AdminAction, gtserror.WithCode) {
+	actions, err := p.state.DB.GetAdminActions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting admin actions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetID != "" {
+		actions = slices.DeleteFunc(actions, func(action *gtsmodel.AdminAction) bool {
+			return action.TargetID != targetID
+		})
+	}
+
+	// Order by ID descending (creation date).
+	slices.SortFunc(actions, func(a, b *gtsmodel.AdminAction) int {
+		return -strings.Compare(a.ID, b.ID)
+	})
+
+	apiActions := make([]*apimodel.AdminAction, 0, len(actions))
+	for _, action := range actions {
+		apiAction, errWithCode := p.apiAdminAction(ctx, action)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiActions = append(apiActions, apiAction)
+	}
+
+	return apiActions, nil
+}
+
+func (p *Processor) apiAdminAction(
+	ctx context.Context,
+	action *gtsmodel.AdminAction,
+) (*apimodel.AdminAction, gtserror.WithCode) {
+	apiAction, err := p.converter.AdminActionToAPIAdminAction(ctx, action)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting admin action to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAction, nil
+}
@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// AIScrapersGet returns the User-Agent patterns
+// used to recognize AI scrapers on this instance.
+func (p *Processor) AIScrapersGet(
+	ctx context.Context,
+) (*apimodel.AIScrapers, gtserror.WithCode) {
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return aiScrapers(instance), nil
+}
+
+// AIScrapersUpdate replaces the User-Agent patterns used to
+// recognize AI scrapers on this instance. Patterns are used
+// both to generate robots.txt, and to block / tarpit requests
+// when advanced-ai-scraper-mode is set.
+//
+// If no patterns are given, the default list is restored.
+func (p *Processor) AIScrapersUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AIScrapersUpdateRequest,
+) (*apimodel.AIScrapers, gtserror.WithCode) {
+	// Tidy up given patterns, dropping
+	// any blank entries or duplicates.
+	userAgents := make([]string, 0, len(form.UserAgents))
+	for _, userAgent := range form.UserAgents {
+		userAgent = strings.TrimSpace(userAgent)
+		if userAgent == "" {
+			continue
+		}
+
+		if slices.ContainsFunc(userAgents, func(s string) bool {
+			return strings.EqualFold(s, userAgent)
+		}) {
+			continue
+		}
+
+		userAgents = append(userAgents, userAgent)
+	}
+
+	if err := validate.AIScraperUserAgents(userAgents); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(userAgents) == 0 {
+		// Return to defaults.
+		userAgents = nil
+	}
+
+	instance.AIScraperUserAgents = userAgents
+	if err := p.state.DB.UpdateInstance(ctx, instance,
+		"ai_scraper_user_agents",
+	); err != nil {
+		err := gtserror.Newf("db error updating instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetInstance, instance.ID, "ai scrapers")
+
+	return aiScrapers(instance), nil
+}
+
+// aiScrapers converts the AI scraper
+// patterns on instance to their API model.
+func aiScrapers(instance *gtsmodel.Instance) *apimodel.AIScrapers {
+	return &apimodel.AIScrapers{
+		UserAgents: instance.AIScrapers(),
+		Default:    len(instance.AIScraperUserAgents) == 0,
+	}
+}
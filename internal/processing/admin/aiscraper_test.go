@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AIScraperTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AIScraperTestSuite) TestAIScrapersUpdate() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	// Defaults to start with.
+	scrapers, errWithCode := suite.adminProcessor.AIScrapersGet(ctx)
+	suite.NoError(errWithCode)
+	suite.True(scrapers.Default)
+	suite.Equal(gtsmodel.DefaultAIScraperUserAgents(), scrapers.UserAgents)
+
+	// Set some patterns, blanks
+	// and duplicates should be dropped.
+	scrapers, errWithCode = suite.adminProcessor.AIScrapersUpdate(ctx, adminAcct, &apimodel.AIScrapersUpdateRequest{
+		UserAgents: []string{"GPTBot", " ", "SomeNewBot ", "gptbot"},
+	})
+	suite.NoError(errWithCode)
+	suite.False(scrapers.Default)
+	suite.Equal([]string{"GPTBot", "SomeNewBot"}, scrapers.UserAgents)
+
+	// Invalid patterns should be rejected.
+	_, errWithCode = suite.adminProcessor.AIScrapersUpdate(ctx, adminAcct, &apimodel.AIScrapersUpdateRequest{
+		UserAgents: []string{"GPTBot\nDisallow: /"},
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// Setting no patterns should return to defaults.
+	scrapers, errWithCode = suite.adminProcessor.AIScrapersUpdate(ctx, adminAcct, &apimodel.AIScrapersUpdateRequest{})
+	suite.NoError(errWithCode)
+	suite.True(scrapers.Default)
+	suite.Equal(gtsmodel.DefaultAIScraperUserAgents(), scrapers.UserAgents)
+}
+
+func TestAIScraperTestSuite(t *testing.T) {
+	suite.Run(t, &AIScraperTestSuite{})
+}
@@ -0,0 +1,331 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// AnnouncementsGet fetches all announcements,
+// published or not, newest first.
+func (p *Processor) AnnouncementsGet(ctx context.Context) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// AnnouncementGet fetches the announcement with provided ID.
+func (p *Processor) AnnouncementGet(ctx context.Context, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAnnouncement(ctx, announcement)
+}
+
+// AnnouncementCreate creates a new announcement from the
+// given form, streaming it to users if it's published.
+func (p *Processor) AnnouncementCreate(ctx context.Context, adminAcct *gtsmodel.Account, form *apimodel.AdminAnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	if err := validate.AnnouncementText(form.Text); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	startsAt, errWithCode := parseAnnouncementTime(form.StartsAt, "starts_at")
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	endsAt, errWithCode := parseAnnouncementTime(form.EndsAt, "ends_at")
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := validateAnnouncementTimes(startsAt, endsAt); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	now := time.Now()
+	announcement := &gtsmodel.Announcement{
+		ID:                 id.NewULID(),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		StartsAt:           startsAt,
+		EndsAt:             endsAt,
+		AllDay:             util.Ptr(form.AllDay),
+		Published:          util.Ptr(util.PtrOrValue(form.Published, true)),
+		CreatedByAccountID: adminAcct.ID,
+	}
+	p.setAnnouncementText(ctx, adminAcct, announcement, form.Text)
+
+	if *announcement.Published {
+		announcement.PublishedAt = now
+	}
+
+	if err := p.state.DB.PutAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("error inserting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetAnnouncement, announcement.ID, announcement.Text)
+
+	apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if announcement.Visible() {
+		p.stream.Announcement(ctx, apiAnnouncement)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// AnnouncementUpdate updates the announcement with provided ID
+// from the given form, streaming the change to users as needed.
+func (p *Processor) AnnouncementUpdate(ctx context.Context, adminAcct *gtsmodel.Account, id string, form *apimodel.AdminAnnouncementUpdateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		wasVisible = announcement.Visible()
+		columns    = make([]string, 0, 7)
+	)
+
+	if form.Text != nil {
+		if err := validate.AnnouncementText(*form.Text); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		p.setAnnouncementText(ctx, adminAcct, announcement, *form.Text)
+		columns = append(columns, "text", "content", "emojis")
+	}
+
+	if form.StartsAt != nil {
+		startsAt, errWithCode := parseAnnouncementTime(*form.StartsAt, "starts_at")
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		announcement.StartsAt = startsAt
+		columns = append(columns, "starts_at")
+	}
+
+	if form.EndsAt != nil {
+		endsAt, errWithCode := parseAnnouncementTime(*form.EndsAt, "ends_at")
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		announcement.EndsAt = endsAt
+		columns = append(columns, "ends_at")
+	}
+
+	if form.AllDay != nil {
+		announcement.AllDay = util.Ptr(*form.AllDay)
+		columns = append(columns, "all_day")
+	}
+
+	if form.Published != nil {
+		announcement.Published = util.Ptr(*form.Published)
+		columns = append(columns, "published")
+
+		if *announcement.Published && announcement.PublishedAt.IsZero() {
+			// First time being published.
+			announcement.PublishedAt = time.Now()
+			columns = append(columns, "published_at")
+		}
+	}
+
+	if len(columns) == 0 {
+		const text = "empty form submitted"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if errWithCode := validateAnnouncementTimes(announcement.StartsAt, announcement.EndsAt); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.UpdateAnnouncement(ctx, announcement, columns...); err != nil {
+		err := gtserror.Newf("error updating announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetAnnouncement, announcement.ID, announcement.Text)
+
+	apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	switch {
+	// Announcement is (still) shown to
+	// users, stream the latest version.
+	case announcement.Visible():
+		p.stream.Announcement(ctx, apiAnnouncement)
+
+	// Announcement is no longer shown
+	// to users, remove it from clients.
+	case wasVisible:
+		p.stream.AnnouncementDelete(ctx, announcement.ID)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// AnnouncementDelete deletes the announcement with
+// provided ID, along with all reads and reactions of it.
+func (p *Processor) AnnouncementDelete(ctx context.Context, adminAcct *gtsmodel.Account, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deleting,
+	// while reactions still exist.
+	apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		err := gtserror.Newf("error deleting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetAnnouncement, announcement.ID, announcement.Text)
+
+	if announcement.Visible() {
+		p.stream.AnnouncementDelete(ctx, announcement.ID)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// getAnnouncement is a simple wrapper to get an
+// announcement from the database with provided
+// ID, returning appropriate errors.
+func (p *Processor) getAnnouncement(ctx context.Context, id string) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return announcement, nil
+
+	// Announcement does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "announcement not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// apiAnnouncement converts the given announcement to
+// its API model, including its reactions. Since this
+// is not for any particular user, it is never "read".
+func (p *Processor) apiAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) (*apimodel.Announcement, gtserror.WithCode) {
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error getting announcement reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, reactions, nil, false)
+	if err != nil {
+		err := gtserror.Newf("error converting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// setAnnouncementText sets the raw text of the given
+// announcement, parsing it as Markdown into HTML content
+// and picking out any custom emojis that it uses.
+func (p *Processor) setAnnouncementText(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	announcement *gtsmodel.Announcement,
+	text string,
+) {
+	result := p.formatter.FromMarkdown(ctx, p.parseMention, adminAcct.ID, "", text)
+
+	announcement.Text = text
+	announcement.Content = result.HTML
+	announcement.Emojis = result.Emojis
+	announcement.EmojiIDs = make([]string, len(result.Emojis))
+	for i, emoji := range result.Emojis {
+		announcement.EmojiIDs[i] = emoji.ID
+	}
+}
+
+// parseAnnouncementTime parses the given ISO 8601 datetime
+// string for the given field. An empty string gives zero time.
+func parseAnnouncementTime(value string, field string) (time.Time, gtserror.WithCode) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		err := fmt.Errorf("%s must be a valid ISO 8601 datetime", field)
+		return time.Time{}, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return t, nil
+}
+
+// validateAnnouncementTimes checks that the
+// end time of an announcement, if set, is not
+// before its start time, if set.
+func validateAnnouncementTimes(startsAt time.Time, endsAt time.Time) gtserror.WithCode {
+	if !startsAt.IsZero() && !endsAt.IsZero() && endsAt.Before(startsAt) {
+		const text = "ends_at must not be before starts_at"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type AnnouncementTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementCreateUpdateDelete() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	// Create a draft announcement.
+	announcement, errWithCode := suite.adminProcessor.AnnouncementCreate(ctx, adminAcct, &apimodel.AdminAnnouncementCreateRequest{
+		Text:      "Maintenance on **Sunday** :rainbow:",
+		StartsAt:  "2025-02-09T06:00:00Z",
+		EndsAt:    "2099-02-09T08:00:00Z",
+		Published: util.Ptr(false),
+	})
+	suite.NoError(errWithCode)
+	suite.Contains(announcement.Content, "<strong>Sunday</strong>")
+	suite.Len(announcement.Emojis, 1)
+	suite.Equal("2025-02-09T06:00:00.000Z", *announcement.StartsAt)
+	suite.False(announcement.Published)
+	suite.Empty(announcement.PublishedAt)
+
+	// Draft shouldn't be shown to users.
+	apiAnnouncements, errWithCode := suite.processor.Announcements().Get(ctx, requester, true)
+	suite.NoError(errWithCode)
+	suite.Empty(apiAnnouncements)
+
+	// Publish it, and remove the start time.
+	announcement, errWithCode = suite.adminProcessor.AnnouncementUpdate(ctx, adminAcct, announcement.ID, &apimodel.AdminAnnouncementUpdateRequest{
+		StartsAt:  util.Ptr(""),
+		Published: util.Ptr(true),
+	})
+	suite.NoError(errWithCode)
+	suite.Nil(announcement.StartsAt)
+	suite.True(announcement.Published)
+	suite.NotEmpty(announcement.PublishedAt)
+
+	apiAnnouncements, errWithCode = suite.processor.Announcements().Get(ctx, requester, true)
+	suite.NoError(errWithCode)
+	suite.Len(apiAnnouncements, 1)
+
+	// Delete it.
+	_, errWithCode = suite.adminProcessor.AnnouncementDelete(ctx, adminAcct, announcement.ID)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.AnnouncementGet(ctx, announcement.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementCreateInvalid() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.AnnouncementCreate(ctx, adminAcct, &apimodel.AdminAnnouncementCreateRequest{})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: announcement text must be provided", errWithCode.Safe())
+
+	_, errWithCode = suite.adminProcessor.AnnouncementCreate(ctx, adminAcct, &apimodel.AdminAnnouncementCreateRequest{
+		Text:     "Maintenance on Sunday!",
+		StartsAt: "sunday",
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: starts_at must be a valid ISO 8601 datetime", errWithCode.Safe())
+
+	_, errWithCode = suite.adminProcessor.AnnouncementCreate(ctx, adminAcct, &apimodel.AdminAnnouncementCreateRequest{
+		Text:     "Maintenance on Sunday!",
+		StartsAt: "2025-02-09T08:00:00Z",
+		EndsAt:   "2025-02-09T06:00:00Z",
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: ends_at must not be before starts_at", errWithCode.Safe())
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, &AnnouncementTestSuite{})
+}
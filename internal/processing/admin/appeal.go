@@ -0,0 +1,254 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// AppealGet returns the appeal with the given id.
+func (p *Processor) AppealGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	appeal, errWithCode := p.getAppeal(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAppeal(ctx, appeal)
+}
+
+// AppealsGet returns a page of appeals,
+// optionally filtered by appeal state.
+func (p *Processor) AppealsGet(
+	ctx context.Context,
+	state gtsmodel.AppealState,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	appeals, err := p.state.DB.GetAppeals(ctx, "", state, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting appeals: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(appeals)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := appeals[count-1].ID
+	hi := appeals[0].ID
+
+	// Convert each appeal to API model.
+	items := make([]any, len(appeals))
+	for i, appeal := range appeals {
+		apiAppeal, errWithCode := p.apiAppeal(ctx, appeal)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiAppeal
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if state != 0 {
+		query.Set(apiutil.AdminAppealStateKey, state.String())
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/appeals",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// AppealApprove approves the pending appeal with the given
+// id, reversing the admin action that was appealed against,
+// eg., by unsilencing an account that was silenced.
+func (p *Processor) AppealApprove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	comment string,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	appeal, errWithCode := p.getPendingAppeal(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	action := appeal.AdminAction
+	if !action.IsLifted() {
+		if errWithCode := p.liftAppealedAction(ctx, adminAcct, appeal); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	return p.reviewAppeal(ctx, adminAcct, appeal, gtsmodel.AppealApproved, comment)
+}
+
+// AppealReject rejects the pending appeal with
+// the given id, leaving the admin action in place.
+func (p *Processor) AppealReject(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	comment string,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	appeal, errWithCode := p.getPendingAppeal(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.reviewAppeal(ctx, adminAcct, appeal, gtsmodel.AppealRejected, comment)
+}
+
+// liftAppealedAction runs an admin action of
+// the reverse type of the appealed action, and
+// marks the appealed action as lifted.
+func (p *Processor) liftAppealedAction(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	appeal *gtsmodel.Appeal,
+) gtserror.WithCode {
+	action := appeal.AdminAction
+
+	reverse := &gtsmodel.AdminAction{
+		ID:             id.NewULID(),
+		TargetCategory: action.TargetCategory,
+		TargetID:       action.TargetID,
+		Target:         appeal.Account,
+		Type:           action.Type.Reverse(),
+		AccountID:      adminAcct.ID,
+		Text:           "approved appeal " + appeal.ID,
+	}
+
+	if errWithCode := p.actions.Run(ctx, reverse,
+		p.accountActionF(adminAcct, appeal.Account, reverse.Type),
+	); errWithCode != nil {
+		return errWithCode
+	}
+
+	action.LiftedAt = time.Now()
+	if err := p.state.DB.UpdateAdminAction(ctx, action, "lifted_at"); err != nil {
+		err := gtserror.Newf("db error marking admin action %s as lifted: %w", action.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// reviewAppeal marks the given appeal as reviewed by
+// adminAcct with the given state, and returns it.
+func (p *Processor) reviewAppeal(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	appeal *gtsmodel.Appeal,
+	state gtsmodel.AppealState,
+	comment string,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	appeal.State = state
+	appeal.ReviewedAt = time.Now()
+	appeal.ReviewedByAccountID = adminAcct.ID
+	appeal.ReviewedByAccount = adminAcct
+	appeal.ReviewComment = comment
+
+	if err := p.state.DB.UpdateAppeal(ctx, appeal,
+		"state",
+		"reviewed_at",
+		"reviewed_by_account_id",
+		"review_comment",
+	); err != nil {
+		err := gtserror.Newf("db error updating appeal: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	action := gtsmodel.AuditActionReject
+	if state == gtsmodel.AppealApproved {
+		action = gtsmodel.AuditActionApprove
+	}
+	p.LogAction(ctx, adminAcct, action, gtsmodel.AuditTargetAppeal, appeal.ID, comment)
+
+	return p.apiAppeal(ctx, appeal)
+}
+
+// getPendingAppeal gets the appeal with the given
+// id, returning an error if it's already reviewed.
+func (p *Processor) getPendingAppeal(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.Appeal, gtserror.WithCode) {
+	appeal, errWithCode := p.getAppeal(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !appeal.IsPending() {
+		err := fmt.Errorf("appeal %s has already been %s", id, appeal.State)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return appeal, nil
+}
+
+func (p *Processor) getAppeal(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.Appeal, gtserror.WithCode) {
+	appeal, err := p.state.DB.GetAppealByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting appeal %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if appeal == nil {
+		err := fmt.Errorf("appeal %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return appeal, nil
+}
+
+func (p *Processor) apiAppeal(
+	ctx context.Context,
+	appeal *gtsmodel.Appeal,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	apiAppeal, err := p.converter.AppealToAdminAPIAppeal(ctx, appeal)
+	if err != nil {
+		err := gtserror.Newf("error converting appeal to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAppeal, nil
+}
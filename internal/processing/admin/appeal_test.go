@@ -0,0 +1,182 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AppealTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// silence silences local_account_1,
+// returning the ID of the admin action.
+func (suite *AppealTestSuite) silence() string {
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionSilence.String(),
+			Text:     "too loud",
+			TargetID: suite.testAccounts["local_account_1"].ID,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	return actionID
+}
+
+func (suite *AppealTestSuite) TestAppealApprove() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+		actionID   = suite.silence()
+	)
+
+	// Strike should now be visible to the target.
+	strikes, errWithCode := suite.processor.Appeal().StrikesGet(ctx, targetAcct)
+	suite.NoError(errWithCode)
+	suite.Len(strikes, 1)
+	suite.Equal(actionID, strikes[0].ID)
+	suite.True(strikes[0].Active)
+	suite.Nil(strikes[0].Appeal)
+
+	appeal, errWithCode := suite.processor.Appeal().Create(ctx, targetAcct,
+		&apimodel.AppealCreateRequest{
+			StrikeID: actionID,
+			Text:     "i'll keep it down, promise",
+		},
+	)
+	suite.NoError(errWithCode)
+	suite.Equal("pending", appeal.State)
+
+	// Second appeal of
+	// same strike is a conflict.
+	_, errWithCode = suite.processor.Appeal().Create(ctx, targetAcct,
+		&apimodel.AppealCreateRequest{
+			StrikeID: actionID,
+			Text:     "pretty please",
+		},
+	)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	adminAppeal, errWithCode := suite.adminProcessor.AppealApprove(ctx, adminAcct, appeal.ID, "fair enough")
+	suite.NoError(errWithCode)
+	suite.Equal("approved", adminAppeal.State)
+	suite.Equal("fair enough", adminAppeal.ReviewComment)
+	suite.Equal(adminAcct.ID, adminAppeal.ReviewedByAccount.ID)
+
+	// Wait for reverse action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure appealed action lifted.
+	adminAction, err := suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(adminAction.LiftedAt)
+
+	// Ensure target account unsilenced.
+	targetAcct, err = suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(targetAcct.IsSilenced())
+
+	// Appeal can't be reviewed twice.
+	_, errWithCode = suite.adminProcessor.AppealReject(ctx, adminAcct, appeal.ID, "")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *AppealTestSuite) TestAppealReject() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+		actionID   = suite.silence()
+	)
+
+	appeal, errWithCode := suite.processor.Appeal().Create(ctx, targetAcct,
+		&apimodel.AppealCreateRequest{
+			StrikeID: actionID,
+			Text:     "i'll keep it down, promise",
+		},
+	)
+	suite.NoError(errWithCode)
+
+	adminAppeal, errWithCode := suite.adminProcessor.AppealReject(ctx, adminAcct, appeal.ID, "no")
+	suite.NoError(errWithCode)
+	suite.Equal("rejected", adminAppeal.State)
+
+	// Ensure target account still silenced.
+	targetAcct, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(targetAcct.IsSilenced())
+
+	// Appeal state visible on strike.
+	strikes, errWithCode := suite.processor.Appeal().StrikesGet(ctx, targetAcct)
+	suite.NoError(errWithCode)
+	suite.Len(strikes, 1)
+	suite.Equal("rejected", strikes[0].Appeal.State)
+	suite.Equal("no", strikes[0].Appeal.ReviewComment)
+}
+
+func (suite *AppealTestSuite) TestAppealOtherAccountsStrike() {
+	var (
+		ctx      = context.Background()
+		actionID = suite.silence()
+	)
+
+	_, errWithCode := suite.processor.Appeal().Create(ctx,
+		suite.testAccounts["local_account_2"],
+		&apimodel.AppealCreateRequest{
+			StrikeID: actionID,
+			Text:     "let them speak",
+		},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestAppealTestSuite(t *testing.T) {
+	suite.Run(t, &AppealTestSuite{})
+}
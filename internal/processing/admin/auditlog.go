@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// AuditLogGet returns a page of audit log entries, optionally
+// only those for actions taken by the given account ID, on
+// targets of the given type, and/or on the given target ID.
+func (p *Processor) AuditLogGet(
+	ctx context.Context,
+	accountID string,
+	targetType string,
+	targetID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	entries, err := p.state.DB.GetAuditLogEntries(ctx,
+		accountID,
+		targetType,
+		targetID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting audit log entries: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(entries)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := entries[count-1].ID
+	hi := entries[0].ID
+
+	// Convert each entry to API model.
+	items := make([]any, len(entries))
+	for i, entry := range entries {
+		apiEntry, err := p.converter.AuditLogEntryToAdminAPIAuditLogEntry(ctx, entry)
+		if err != nil {
+			err := gtserror.Newf("error converting audit log entry to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items[i] = apiEntry
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 3)
+	if accountID != "" {
+		query.Set(apiutil.AccountIDKey, accountID)
+	}
+	if targetType != "" {
+		query.Set(apiutil.AuditLogTargetTypeKey, targetType)
+	}
+	if targetID != "" {
+		query.Set(apiutil.AuditLogTargetIDKey, targetID)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/audit_log",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// LogAction records an action taken by the given admin account
+// in the audit log. By the time this is called, the action has
+// already been taken, so any error is logged rather than returned.
+func (p *Processor) LogAction(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	action string,
+	targetType string,
+	targetID string,
+	text string,
+) {
+	entry := &gtsmodel.AuditLogEntry{
+		ID:         id.NewULID(),
+		AccountID:  adminAcct.ID,
+		Account:    adminAcct,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Text:       text,
+	}
+
+	if err := p.state.DB.PutAuditLogEntry(ctx, entry); err != nil {
+		log.Errorf(ctx, "db error putting audit log entry for %s %s %s: %v",
+			action, targetType, targetID, err)
+	}
+}
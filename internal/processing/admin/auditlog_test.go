@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type AuditLogTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AuditLogTestSuite) TestRuleActionsLogged() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	rule, errWithCode := suite.adminProcessor.RuleCreate(ctx, adminAcct, &apimodel.InstanceRuleCreateRequest{
+		Text: "Be nice.",
+	})
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.RuleDelete(ctx, adminAcct, rule.ID)
+	suite.NoError(errWithCode)
+
+	resp, errWithCode := suite.adminProcessor.AuditLogGet(ctx,
+		"",
+		gtsmodel.AuditTargetRule,
+		rule.ID,
+		&paging.Page{},
+	)
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, 2)
+
+	// Newest first.
+	deleted := resp.Items[0].(*apimodel.AdminAuditLogEntry)
+	suite.Equal(gtsmodel.AuditActionDelete, deleted.Action)
+	suite.Equal(adminAcct.ID, deleted.Account.ID)
+
+	created := resp.Items[1].(*apimodel.AdminAuditLogEntry)
+	suite.Equal(gtsmodel.AuditActionCreate, created.Action)
+	suite.Equal(gtsmodel.AuditTargetRule, created.TargetType)
+	suite.Equal(rule.ID, created.TargetID)
+	suite.Equal("Be nice.", created.Text)
+}
+
+func TestAuditLogTestSuite(t *testing.T) {
+	suite.Run(t, &AuditLogTestSuite{})
+}
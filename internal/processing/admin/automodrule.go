@@ -0,0 +1,182 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/automod"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// maxAutomodPatternLength is the maximum
+// length in characters of automod patterns.
+const maxAutomodPatternLength = 1000
+
+// GetAutomodRule fetches the automod rule with provided ID from the database.
+func (p *Processor) GetAutomodRule(ctx context.Context, id string) (*apimodel.AutomodRule, gtserror.WithCode) {
+	rule, err := p.state.DB.GetAutomodRule(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return toAPIAutomodRule(rule), nil
+
+	// Rule does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "automod rule not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// GetAutomodRules fetches all automod rules stored in the database.
+func (p *Processor) GetAutomodRules(ctx context.Context) ([]*apimodel.AutomodRule, gtserror.WithCode) {
+	rules, err := p.state.DB.GetAutomodRules(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert rules to apimodel rules.
+	apiRules := make([]*apimodel.AutomodRule, len(rules))
+	for i := range rules {
+		apiRules[i] = toAPIAutomodRule(rules[i])
+	}
+
+	return apiRules, nil
+}
+
+// CreateAutomodRule inserts the incoming automod rule into the database, marking as authored by provided admin account.
+func (p *Processor) CreateAutomodRule(ctx context.Context, admin *gtsmodel.Account, request *apimodel.AutomodRuleRequest) (*apimodel.AutomodRule, gtserror.WithCode) {
+	matchType := gtsmodel.ParseAutomodMatchType(request.MatchType)
+	if matchType == gtsmodel.AutomodMatchUnknown {
+		err := fmt.Errorf("invalid match_type %q, must be one of keyword, regex, link_domain", request.MatchType)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	action := gtsmodel.ParseAutomodRuleAction(request.Action)
+	if action == gtsmodel.AutomodActionUnknown {
+		err := fmt.Errorf("invalid action %q, must be one of sensitive, sin_bin, drop", request.Action)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	pattern, errWithCode := validateAutomodPattern(matchType, request.Pattern)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Create new database model with ID.
+	rule := &gtsmodel.AutomodRule{
+		ID:        id.NewULID(),
+		MatchType: matchType,
+		Pattern:   pattern,
+		Action:    action,
+		Comment:   request.Comment,
+		AuthorID:  admin.ID,
+		Author:    admin,
+	}
+
+	// Insert new automod rule into the database.
+	if err := p.state.DB.PutAutomodRule(ctx, rule); err != nil {
+		err := gtserror.Newf("error inserting into database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, admin, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetAutomodRule, rule.ID, rule.Comment)
+
+	// Finally return API model response.
+	return toAPIAutomodRule(rule), nil
+}
+
+// DeleteAutomodRule deletes the automod rule with provided ID from the database.
+func (p *Processor) DeleteAutomodRule(ctx context.Context, admin *gtsmodel.Account, id string) gtserror.WithCode {
+	if err := p.state.DB.DeleteAutomodRule(ctx, id); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error deleting from database: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	p.LogAction(ctx, admin, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetAutomodRule, id, "")
+	return nil
+}
+
+// toAPIAutomodRule performs a simple conversion of database model AutomodRule to API model.
+func toAPIAutomodRule(rule *gtsmodel.AutomodRule) *apimodel.AutomodRule {
+	return &apimodel.AutomodRule{
+		ID:        rule.ID,
+		MatchType: rule.MatchType.String(),
+		Pattern:   rule.Pattern,
+		Action:    rule.Action.String(),
+		Comment:   rule.Comment,
+		CreatedBy: rule.AuthorID,
+		CreatedAt: util.FormatISO8601(rule.CreatedAt),
+	}
+}
+
+// validateAutomodPattern validates the incoming rule
+// pattern for the given match type, returning the
+// pattern in the form it should be stored.
+func validateAutomodPattern(matchType gtsmodel.AutomodMatchType, pattern string) (string, gtserror.WithCode) {
+	if pattern == "" || len([]rune(pattern)) > maxAutomodPatternLength {
+		text := fmt.Sprintf("invalid pattern (empty or longer than %d chars)", maxAutomodPatternLength)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Ensure the rule compiles, so we
+	// don't fail later when matching.
+	var rules automod.Rules
+
+	switch matchType {
+	case gtsmodel.AutomodMatchLinkDomain:
+		if strings.ContainsAny(pattern, "/:@ ") {
+			const text = "invalid link domain: must be a bare domain, eg., example.org"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		// Store domain as
+		// lowercase punycode.
+		domain, err := util.Punify(pattern)
+		if err != nil {
+			err := fmt.Errorf("invalid link domain: %w", err)
+			return "", gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		pattern = domain
+
+	default:
+		if err := rules.Append(&gtsmodel.AutomodRule{
+			MatchType: matchType,
+			Pattern:   pattern,
+		}); err != nil {
+			return "", gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	return pattern, nil
+}
@@ -0,0 +1,311 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// maxCanonicalEmailBlockCommentLength is the maximum
+// length in characters of canonical email block comments.
+const maxCanonicalEmailBlockCommentLength = 1000
+
+// GetCanonicalEmailBlock fetches the canonical email block with provided ID from the database.
+func (p *Processor) GetCanonicalEmailBlock(ctx context.Context, id string) (*apimodel.CanonicalEmailBlock, gtserror.WithCode) {
+	block, err := p.state.DB.GetCanonicalEmailBlock(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return toAPICanonicalEmailBlock(block), nil
+
+	// Block does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "canonical email block not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// GetCanonicalEmailBlocks fetches all canonical email blocks stored in the database.
+func (p *Processor) GetCanonicalEmailBlocks(ctx context.Context) ([]*apimodel.CanonicalEmailBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetCanonicalEmailBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert blocks to apimodel blocks.
+	apiBlocks := make([]*apimodel.CanonicalEmailBlock, len(blocks))
+	for i := range blocks {
+		apiBlocks[i] = toAPICanonicalEmailBlock(blocks[i])
+	}
+
+	return apiBlocks, nil
+}
+
+// TestCanonicalEmailBlocks returns any canonical email blocks
+// that match the canonical form of the given email address.
+func (p *Processor) TestCanonicalEmailBlocks(ctx context.Context, email string) ([]*apimodel.CanonicalEmailBlock, gtserror.WithCode) {
+	if email == "" {
+		const text = "email must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	block, err := p.state.DB.GetCanonicalEmailBlockByHash(ctx, util.CanonicalEmailHash(email))
+	switch {
+	case err == nil:
+		return []*apimodel.CanonicalEmailBlock{toAPICanonicalEmailBlock(block)}, nil
+
+	case errors.Is(err, db.ErrNoEntries):
+		return []*apimodel.CanonicalEmailBlock{}, nil
+
+	default:
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// CreateCanonicalEmailBlock inserts a canonical email block into the
+// database for either the given email address or canonical email hash,
+// marking it as created by provided admin account.
+func (p *Processor) CreateCanonicalEmailBlock(
+	ctx context.Context,
+	admin *gtsmodel.Account,
+	email string,
+	hash string,
+	comment string,
+) (*apimodel.CanonicalEmailBlock, gtserror.WithCode) {
+	switch {
+	case email != "" && hash != "":
+		const text = "only one of email or canonical_email_hash may be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+
+	case email != "":
+		if _, err := mail.ParseAddress(email); err != nil {
+			err := fmt.Errorf("invalid email: %w", err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		hash = util.CanonicalEmailHash(email)
+
+	case hash != "":
+		var errWithCode gtserror.WithCode
+		hash, errWithCode = validateCanonicalEmailHash(hash)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+	default:
+		const text = "one of email or canonical_email_hash must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if errWithCode := validateCanonicalEmailBlockComment(comment); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	block := &gtsmodel.CanonicalEmailBlock{
+		ID:                 id.NewULID(),
+		Hash:               hash,
+		Comment:            comment,
+		CreatedByAccountID: admin.ID,
+	}
+
+	if err := p.state.DB.PutCanonicalEmailBlock(ctx, block); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "a block already exists for this canonical email hash"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err := gtserror.Newf("error inserting into database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, admin, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetCanonicalEmailBlock, block.ID, block.Comment)
+
+	return toAPICanonicalEmailBlock(block), nil
+}
+
+// ImportCanonicalEmailBlocks imports canonical email blocks from the
+// given JSON-formatted file, eg., one exported from another instance
+// via GetCanonicalEmailBlocks. Hashes that are already blocked are
+// skipped. Returns only the newly created blocks.
+func (p *Processor) ImportCanonicalEmailBlocks(
+	ctx context.Context,
+	admin *gtsmodel.Account,
+	blocksF *multipart.FileHeader,
+) ([]*apimodel.CanonicalEmailBlock, gtserror.WithCode) {
+	// Open the provided file.
+	file, err := blocksF.Open()
+	if err != nil {
+		err = gtserror.Newf("error opening attachment: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	defer file.Close()
+
+	// Parse file as slice of canonical email blocks.
+	imports := make([]*apimodel.CanonicalEmailBlock, 0)
+	if err := json.NewDecoder(file).Decode(&imports); err != nil {
+		err = gtserror.Newf("error parsing attachment as canonical email blocks: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(imports) == 0 {
+		err = gtserror.New("error importing canonical email blocks: 0 entries provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Validate all entries up front, so
+	// that a bad file imports nothing.
+	blocks := make([]*gtsmodel.CanonicalEmailBlock, 0, len(imports))
+	for _, imp := range imports {
+		hash, errWithCode := validateCanonicalEmailHash(imp.CanonicalEmailHash)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if errWithCode := validateCanonicalEmailBlockComment(imp.Comment); errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		blocks = append(blocks, &gtsmodel.CanonicalEmailBlock{
+			ID:                 id.NewULID(),
+			Hash:               hash,
+			Comment:            imp.Comment,
+			CreatedByAccountID: admin.ID,
+		})
+	}
+
+	apiBlocks := make([]*apimodel.CanonicalEmailBlock, 0, len(blocks))
+	for _, block := range blocks {
+		err := p.state.DB.PutCanonicalEmailBlock(ctx, block)
+		switch {
+		case err == nil:
+			apiBlocks = append(apiBlocks, toAPICanonicalEmailBlock(block))
+			p.LogAction(ctx, admin, gtsmodel.AuditActionImport, gtsmodel.AuditTargetCanonicalEmailBlock, block.ID, block.Comment)
+
+		case errors.Is(err, db.ErrAlreadyExists):
+			// Already blocked.
+
+		default:
+			err := gtserror.Newf("error inserting into database: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return apiBlocks, nil
+}
+
+// DeleteCanonicalEmailBlock deletes the canonical email block with provided ID from the database.
+func (p *Processor) DeleteCanonicalEmailBlock(ctx context.Context, admin *gtsmodel.Account, id string) gtserror.WithCode {
+	if err := p.state.DB.DeleteCanonicalEmailBlock(ctx, id); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error deleting from database: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	p.LogAction(ctx, admin, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetCanonicalEmailBlock, id, "")
+	return nil
+}
+
+// blockSuspendedEmail stores a canonical email block for the
+// email address of the given local account, which is being
+// suspended, so that it can't trivially be used to sign up again.
+func (p *Processor) blockSuspendedEmail(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+) error {
+	user, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		return gtserror.Newf("db error getting user: %w", err)
+	}
+
+	email := user.Email
+	if email == "" {
+		// Never confirmed.
+		email = user.UnconfirmedEmail
+	}
+
+	if email == "" {
+		// Nothing
+		// to block.
+		return nil
+	}
+
+	err = p.state.DB.PutCanonicalEmailBlock(ctx, &gtsmodel.CanonicalEmailBlock{
+		ID:                 id.NewULID(),
+		Hash:               util.CanonicalEmailHash(email),
+		ReferenceAccountID: targetAcct.ID,
+		CreatedByAccountID: adminAcct.ID,
+	})
+	if err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return gtserror.Newf("db error putting canonical email block: %w", err)
+	}
+
+	return nil
+}
+
+// toAPICanonicalEmailBlock performs a simple conversion of database model CanonicalEmailBlock to API model.
+func toAPICanonicalEmailBlock(block *gtsmodel.CanonicalEmailBlock) *apimodel.CanonicalEmailBlock {
+	return &apimodel.CanonicalEmailBlock{
+		ID:                 block.ID,
+		CanonicalEmailHash: block.Hash,
+		Comment:            block.Comment,
+		ReferenceAccountID: block.ReferenceAccountID,
+		CreatedBy:          block.CreatedByAccountID,
+		CreatedAt:          util.FormatISO8601(block.CreatedAt),
+	}
+}
+
+// validateCanonicalEmailHash checks the incoming hash is a
+// hex-encoded SHA256 hash, returning it in lowercase form.
+func validateCanonicalEmailHash(hash string) (string, gtserror.WithCode) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	if b, err := hex.DecodeString(hash); err != nil || len(b) != 32 {
+		text := fmt.Sprintf("invalid canonical_email_hash %q, must be a hex-encoded SHA256 hash", hash)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return hash, nil
+}
+
+// validateCanonicalEmailBlockComment checks the incoming canonical email block comment length.
+func validateCanonicalEmailBlockComment(comment string) gtserror.WithCode {
+	if len([]rune(comment)) > maxCanonicalEmailBlockCommentLength {
+		text := fmt.Sprintf("comment must be no longer than %d chars", maxCanonicalEmailBlockCommentLength)
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+// pruneDeadLettersEvery is how often dead
+// letters older than retention are deleted.
+const pruneDeadLettersEvery = time.Hour
+
+// DeadLetterPut stores the given permanently failed delivery
+// as a dead letter, so that it may later be inspected and
+// re-driven by admins. This is intended to be used as the
+// delivery.WorkerPool{}.DeadLetter callback.
+func (p *Processor) DeadLetterPut(ctx context.Context, dlv *delivery.Delivery, deliveryErr error) {
+	if config.GetDeliveryDeadLetterRetention() <= 0 {
+		// Dead letters disabled.
+		return
+	}
+
+	// Serialize the delivery,
+	// so it can be re-driven.
+	data, err := dlv.Serialize()
+	if err != nil {
+		log.Errorf(ctx, "error serializing delivery: %v", err)
+		return
+	}
+
+	deadLetter := &gtsmodel.DeadLetter{
+		ID:       id.NewULID(),
+		Domain:   dlv.Request.URL.Hostname(),
+		InboxURI: dlv.Request.URL.String(),
+		ActorID:  dlv.ActorID,
+		ObjectID: dlv.ObjectID,
+		TargetID: dlv.TargetID,
+		Data:     data,
+	}
+
+	if deliveryErr != nil {
+		deadLetter.Error = deliveryErr.Error()
+	}
+
+	// Use a background context, as the worker
+	// context may be cancelled on shutdown.
+	ctx = gtscontext.WithValues(context.Background(), ctx)
+
+	if err := p.state.DB.PutDeadLetter(ctx, deadLetter); err != nil {
+		log.Errorf(ctx, "db error putting dead letter: %v", err)
+	}
+}
+
+// DeadLetterGet returns the dead letter with the given id.
+func (p *Processor) DeadLetterGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.DeadLetter, gtserror.WithCode) {
+	deadLetter, errWithCode := p.getDeadLetter(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDeadLetter(ctx, deadLetter)
+}
+
+// DeadLettersGet returns a page of dead
+// letters, optionally filtered by domain.
+func (p *Processor) DeadLettersGet(
+	ctx context.Context,
+	domain string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	deadLetters, err := p.state.DB.GetDeadLetters(ctx, domain, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting dead letters: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(deadLetters)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := deadLetters[count-1].ID
+	hi := deadLetters[0].ID
+
+	// Convert each dead letter to API model.
+	items := make([]any, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		apiDeadLetter, errWithCode := p.apiDeadLetter(ctx, deadLetter)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiDeadLetter
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if domain != "" {
+		query.Set(apiutil.DeadLetterDomainKey, domain)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/dead_letters",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// DeadLetterRedrive pushes the delivery of the dead letter
+// with the given id back onto the delivery queue, removing
+// the dead letter. Should the delivery fail again, it will
+// be stored as a new dead letter.
+func (p *Processor) DeadLetterRedrive(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.DeadLetter, gtserror.WithCode) {
+	deadLetter, errWithCode := p.getDeadLetter(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.pushDeliveryData(ctx, deadLetter.Data); err != nil {
+		err := gtserror.Newf("error re-driving dead letter %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteDeadLetterByID(ctx, deadLetter.ID); err != nil {
+		err := gtserror.Newf("db error deleting dead letter: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionRedrive, gtsmodel.AuditTargetDeadLetter, deadLetter.ID, "")
+
+	return p.apiDeadLetter(ctx, deadLetter)
+}
+
+// DeadLetterDelete deletes the dead
+// letter with the given id, without
+// attempting its delivery again.
+func (p *Processor) DeadLetterDelete(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.DeadLetter, gtserror.WithCode) {
+	deadLetter, errWithCode := p.getDeadLetter(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteDeadLetterByID(ctx, deadLetter.ID); err != nil {
+		err := gtserror.Newf("db error deleting dead letter: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetDeadLetter, deadLetter.ID, "")
+
+	return p.apiDeadLetter(ctx, deadLetter)
+}
+
+// ScheduleDeadLetterPrune schedules dead letters
+// older than the configured retention to be
+// deleted periodically, if dead letters are enabled.
+func (p *Processor) ScheduleDeadLetterPrune() {
+	retention := config.GetDeliveryDeadLetterRetention()
+	if retention <= 0 {
+		// Dead letters disabled.
+		return
+	}
+
+	fn := func(ctx context.Context, start time.Time) {
+		log.Debug(ctx, "pruning dead letters")
+		count, err := p.state.DB.DeleteDeadLettersOlderThan(ctx, start.Add(-retention))
+		if err != nil {
+			log.Errorf(ctx, "error pruning dead letters: %v", err)
+			return
+		}
+		log.Debugf(ctx, "pruned %d dead letters after %s", count, time.Since(start))
+	}
+
+	log.Infof(nil, "scheduling dead letter pruning to run every %s", pruneDeadLettersEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@deadletterprune",
+		time.Now(),
+		pruneDeadLettersEvery,
+		fn,
+	) {
+		panic("failed to schedule @deadletterprune")
+	}
+}
+
+func (p *Processor) getDeadLetter(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DeadLetter, gtserror.WithCode) {
+	deadLetter, err := p.state.DB.GetDeadLetterByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting dead letter %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if deadLetter == nil {
+		err := fmt.Errorf("dead letter %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return deadLetter, nil
+}
+
+func (p *Processor) apiDeadLetter(
+	ctx context.Context,
+	deadLetter *gtsmodel.DeadLetter,
+) (*apimodel.DeadLetter, gtserror.WithCode) {
+	apiDeadLetter, err := p.converter.DeadLetterToAPIDeadLetter(ctx, deadLetter)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting dead letter to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDeadLetter, nil
+}
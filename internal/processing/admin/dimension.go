@@ -0,0 +1,282 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"cmp"
+	"context"
+	"runtime"
+	"slices"
+	"strconv"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/language"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DimensionsGet returns the dimensions with given
+// keys for the period from start to end, with at
+// most limit data items per dimension. Unknown
+// dimension keys are ignored. Params for individual
+// dimensions, eg., tag ID or domain, are keyed by
+// dimension key.
+func (p *Processor) DimensionsGet(
+	ctx context.Context,
+	keys []string,
+	start time.Time,
+	end time.Time,
+	limit int,
+	params map[string]map[string]string,
+) ([]*apimodel.AdminDimension, gtserror.WithCode) {
+	dimensions := make([]*apimodel.AdminDimension, 0, len(keys))
+	for _, key := range keys {
+		data, errWithCode := p.getDimensionData(ctx, key, params[key], start, end, limit)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if data == nil {
+			// Unknown key.
+			continue
+		}
+
+		dimensions = append(dimensions, &apimodel.AdminDimension{
+			Key:  key,
+			Data: data,
+		})
+	}
+
+	return dimensions, nil
+}
+
+// getDimensionData gets the biggest data items, up to limit, for
+// the dimension with given key, using given params, between start
+// and end. If key is not a known dimension, nil will be returned.
+func (p *Processor) getDimensionData(
+	ctx context.Context,
+	key string,
+	params map[string]string,
+	start time.Time,
+	end time.Time,
+	limit int,
+) ([]apimodel.AdminDimensionData, gtserror.WithCode) {
+	var (
+		activity []*gtsmodel.MeasureActivity
+		groupBy  func(*gtsmodel.MeasureActivity) string
+		humanKey func(string) string
+		err      error
+	)
+
+	switch key {
+	case "languages":
+		activity, err = p.state.DB.GetLocalStatusActivity(ctx, start, end)
+		groupBy, humanKey = byLanguage, languageName
+
+	case "servers":
+		activity, err = p.state.DB.GetRemoteStatusActivity(ctx, "", start, end)
+		groupBy = byServer
+
+	case "space_usage":
+		data, errWithCode := p.spaceUsageData(ctx)
+		return data[:min(limit, len(data))], errWithCode
+
+	case "software_versions":
+		data := softwareVersionsData()
+		return data[:min(limit, len(data))], nil
+
+	case "tag_servers", "tag_languages":
+		tagID, errWithCode := measureParam(key, params, "id")
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		activity, err = p.state.DB.GetTagActivity(ctx, tagID, start, end)
+		if key == "tag_servers" {
+			groupBy = byServer
+		} else {
+			groupBy, humanKey = byLanguage, languageName
+		}
+
+	case "instance_accounts":
+		domain, errWithCode := measureDomainParam(key, params)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		// Most followed accounts from the domain
+		// (by local accounts), so include all
+		// follows made up until end of period.
+		activity, err = p.state.DB.GetFollowActivity(ctx, domain, false, time.Time{}, end)
+		groupBy, humanKey = byAccount, p.accountUsername(ctx)
+
+	case "instance_languages":
+		domain, errWithCode := measureDomainParam(key, params)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		activity, err = p.state.DB.GetRemoteStatusActivity(ctx, domain, start, end)
+		groupBy, humanKey = byLanguage, languageName
+
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		err := gtserror.Newf("db error getting activity for dimension %s: %w", key, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Count activity per group.
+	counts := make(map[string]int64)
+	for _, a := range activity {
+		counts[groupBy(a)]++
+	}
+
+	groups := make([]string, 0, len(counts))
+	for group := range counts {
+		groups = append(groups, group)
+	}
+
+	// Sort biggest groups first.
+	slices.SortFunc(groups, func(a, b string) int {
+		if c := cmp.Compare(counts[b], counts[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+
+	if len(groups) > limit {
+		groups = groups[:limit]
+	}
+
+	data := make([]apimodel.AdminDimensionData, 0, len(groups))
+	for _, group := range groups {
+		human := group
+		if humanKey != nil {
+			human = humanKey(group)
+		}
+
+		data = append(data, apimodel.AdminDimensionData{
+			Key:      group,
+			HumanKey: human,
+			Value:    strconv.FormatInt(counts[group], 10),
+		})
+	}
+
+	return data, nil
+}
+
+// spaceUsageData returns space used
+// on disk by database and media.
+func (p *Processor) spaceUsageData(ctx context.Context) ([]apimodel.AdminDimensionData, gtserror.WithCode) {
+	dbSize, err := p.state.DB.GetDatabaseSpaceUsage(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting database space usage: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	mediaSize, err := p.state.DB.GetMediaSpaceUsage(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting media space usage: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	const unit = "bytes"
+	return []apimodel.AdminDimensionData{
+		{
+			Key:        config.GetDbType(),
+			HumanKey:   "Database",
+			Value:      strconv.FormatInt(dbSize, 10),
+			Unit:       util.Ptr(unit),
+			HumanValue: util.Ptr(humanValue(unit, dbSize)),
+		},
+		{
+			Key:        "media",
+			HumanKey:   "Media storage",
+			Value:      strconv.FormatInt(mediaSize, 10),
+			Unit:       util.Ptr(unit),
+			HumanValue: util.Ptr(humanValue(unit, mediaSize)),
+		},
+	}, nil
+}
+
+// softwareVersionsData returns versions
+// of the software this instance runs.
+func softwareVersionsData() []apimodel.AdminDimensionData {
+	return []apimodel.AdminDimensionData{
+		{
+			Key:      "gotosocial",
+			HumanKey: "GoToSocial",
+			Value:    config.GetSoftwareVersion(),
+		},
+		{
+			Key:      "go",
+			HumanKey: "Go",
+			Value:    runtime.Version(),
+		},
+		{
+			Key:      "database",
+			HumanKey: "Database",
+			Value:    config.GetDbType(),
+		},
+	}
+}
+
+// languageName returns the human-readable
+// name of the given BCP47 language tag.
+func languageName(tag string) string {
+	if tag == "" {
+		return "Unknown"
+	}
+
+	lang, err := language.Parse(tag)
+	if err != nil {
+		return tag
+	}
+
+	return lang.DisplayStr
+}
+
+// byServer groups activity by the
+// domain of the account, using our
+// host for local accounts.
+func byServer(a *gtsmodel.MeasureActivity) string {
+	if a.Domain == "" {
+		return config.GetHost()
+	}
+	return a.Domain
+}
+
+// accountUsername returns a func that looks up
+// the username of the account with given ID.
+func (p *Processor) accountUsername(ctx context.Context) func(string) string {
+	return func(accountID string) string {
+		account, err := p.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting account %s: %v", accountID, err)
+			return accountID
+		}
+		return account.Username
+	}
+}
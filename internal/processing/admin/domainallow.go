@@ -99,6 +99,8 @@ func(ctx context.Context) gtserror.MultiError {
 		return nil, actionID, errWithCode
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetDomainAllow, domain, domainAllow.PrivateComment)
+
 	apiDomainAllow, errWithCode := p.apiDomainPerm(ctx, domainAllow, false)
 	if errWithCode != nil {
 		return nil, actionID, errWithCode
@@ -208,6 +210,8 @@ func(ctx context.Context) gtserror.MultiError {
 		return nil, actionID, errWithCode
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetDomainAllow, domainAllow.Domain, "")
+
 	return apiDomainAllow, actionID, nil
 }
 
@@ -253,3 +257,106 @@ func (p *Processor) domainUnallowSideEffects(
 	// up their follows/following, media, etc.
 	return p.domainBlockSideEffects(ctx, block)
 }
+
+// DomainAllowUpdate updates the domain allow
+// with the given id, using any provided values.
+func (p *Processor) DomainAllowUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	authorizedFetchMode *string,
+	deliveryRateLimit *int,
+	dereferenceRateLimit *int,
+	mediaPolicy *string,
+	limited *bool,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	domainAllow, err := p.state.DB.GetDomainAllowByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain allow %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if domainAllow == nil {
+		err := fmt.Errorf("no domain allow exists with id %s", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	var columns []string
+
+	if authorizedFetchMode != nil {
+		switch mode := *authorizedFetchMode; mode {
+		case "",
+			config.InstanceAuthorizedFetchModeRequire,
+			config.InstanceAuthorizedFetchModeOptional:
+			domainAllow.AuthorizedFetchMode = mode
+		default:
+			err := fmt.Errorf(
+				"authorized_fetch_mode must be one of %s, %s, or empty string, provided value was %s",
+				config.InstanceAuthorizedFetchModeRequire,
+				config.InstanceAuthorizedFetchModeOptional,
+				mode,
+			)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		columns = append(columns, "authorized_fetch_mode")
+	}
+
+	if deliveryRateLimit != nil {
+		if *deliveryRateLimit < -1 {
+			err := fmt.Errorf("delivery_rate_limit must be -1 (no limit), 0 (no override), or greater, provided value was %d", *deliveryRateLimit)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		domainAllow.DeliveryRateLimit = *deliveryRateLimit
+		columns = append(columns, "delivery_rate_limit")
+	}
+
+	if dereferenceRateLimit != nil {
+		if *dereferenceRateLimit < -1 {
+			err := fmt.Errorf("dereference_rate_limit must be -1 (no limit), 0 (no override), or greater, provided value was %d", *dereferenceRateLimit)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		domainAllow.DereferenceRateLimit = *dereferenceRateLimit
+		columns = append(columns, "dereference_rate_limit")
+	}
+
+	if mediaPolicy != nil {
+		switch policy := *mediaPolicy; policy {
+		case "",
+			gtsmodel.DomainMediaPolicyReject,
+			gtsmodel.DomainMediaPolicySensitive:
+			domainAllow.MediaPolicy = policy
+		default:
+			err := fmt.Errorf(
+				"media_policy must be one of %s, %s, or empty string, provided value was %s",
+				gtsmodel.DomainMediaPolicyReject,
+				gtsmodel.DomainMediaPolicySensitive,
+				policy,
+			)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		columns = append(columns, "media_policy")
+	}
+
+	if limited != nil {
+		domainAllow.Limited = limited
+		columns = append(columns, "limited")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to update.
+		return p.apiDomainPerm(ctx, domainAllow, false)
+	}
+
+	if err := p.state.DB.UpdateDomainAllow(ctx, domainAllow, columns...); err != nil {
+		err := gtserror.Newf("db error updating domain allow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetDomainAllow, domainAllow.Domain, "")
+
+	return p.apiDomainPerm(ctx, domainAllow, false)
+}
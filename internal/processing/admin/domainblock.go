@@ -34,6 +34,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/webhook"
 )
 
 func (p *Processor) createDomainBlock(
@@ -53,7 +54,8 @@ func (p *Processor) createDomainBlock(
 		return nil, "", gtserror.NewErrorInternalError(err)
 	}
 
-	if domainBlock == nil {
+	created := (domainBlock == nil)
+	if created {
 		// No block exists yet, create it.
 		domainBlock = &gtsmodel.DomainBlock{
 			ID:                 id.NewULID(),
@@ -111,11 +113,22 @@ func(ctx context.Context) gtserror.MultiError {
 		return nil, actionID, errWithCode
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetDomainBlock, domain, domainBlock.PrivateComment)
+
 	apiDomainBlock, errWithCode := p.apiDomainPerm(ctx, domainBlock, false)
 	if errWithCode != nil {
 		return nil, actionID, errWithCode
 	}
 
+	if created {
+		if err := webhook.Send(ctx, p.state,
+			gtsmodel.WebhookEventDomainBlockCreated,
+			apiDomainBlock,
+		); err != nil {
+			log.Errorf(ctx, "error sending domain block to webhooks: %v", err)
+		}
+	}
+
 	return apiDomainBlock, actionID, nil
 }
 
@@ -276,6 +289,8 @@ func(ctx context.Context) gtserror.MultiError {
 		return nil, actionID, errWithCode
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetDomainBlock, domainBlock.Domain, "")
+
 	return apiDomainBlock, actionID, nil
 }
 
@@ -58,6 +58,8 @@ func(ctx context.Context) gtserror.MultiError {
 		return actionID, errWithCode
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AdminActionExpireKeys.String(), gtsmodel.AuditTargetDomain, domain, "")
+
 	return actionID, nil
 }
 
@@ -149,6 +149,8 @@ func (p *Processor) DomainPermissionDraftCreate(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, acct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetDomainPermissionDraft, permDraft.ID, permDraft.PrivateComment)
+
 	return p.apiDomainPerm(ctx, permDraft, false)
 }
 
@@ -248,6 +250,10 @@ func (p *Processor) DomainPermissionDraftAccept(
 		// before returning.
 		deleteDraft()
 
+		if errWithCode == nil {
+			p.LogAction(ctx, acct, gtsmodel.AuditActionApprove, gtsmodel.AuditTargetDomainPermissionDraft, permDraft.ID, "")
+		}
+
 		return new, actionID, errWithCode
 	}
 
@@ -278,6 +284,8 @@ func (p *Processor) DomainPermissionDraftAccept(
 	// before returning.
 	deleteDraft()
 
+	p.LogAction(ctx, acct, gtsmodel.AuditActionApprove, gtsmodel.AuditTargetDomainPermissionDraft, permDraft.ID, "")
+
 	apiPerm, errWithCode := p.apiDomainPerm(ctx, existing, false)
 	return apiPerm, "", errWithCode
 }
@@ -305,6 +313,8 @@ func (p *Processor) DomainPermissionDraftRemove(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, acct, gtsmodel.AuditActionReject, gtsmodel.AuditTargetDomainPermissionDraft, permDraft.ID, "")
+
 	if excludeTarget {
 		// Add a domain permission exclude
 		// targeting the permDraft's domain.
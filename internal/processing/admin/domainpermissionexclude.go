@@ -58,6 +58,8 @@ func (p *Processor) DomainPermissionExcludeCreate(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, acct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetDomainPermissionExclude, permExclude.ID, permExclude.PrivateComment)
+
 	return p.apiDomainPerm(ctx, permExclude, false)
 }
 
@@ -155,5 +157,7 @@ func (p *Processor) DomainPermissionExcludeRemove(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, acct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetDomainPermissionExclude, permExclude.ID, "")
+
 	return p.apiDomainPerm(ctx, permExclude, false)
 }
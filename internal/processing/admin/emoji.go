@@ -76,6 +76,8 @@ func (p *Processor) EmojiCreate(
 		return nil, errWithCode
 	}
 
+	p.LogAction(ctx, account, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetEmoji, emoji.ID, emoji.Shortcode)
+
 	apiEmoji, err := p.converter.EmojiToAPIEmoji(ctx, emoji)
 	if err != nil {
 		err := gtserror.Newf("error converting emoji: %w", err)
@@ -177,6 +179,7 @@ func (p *Processor) EmojiGet(
 // from the database, with the given id.
 func (p *Processor) EmojiDelete(
 	ctx context.Context,
+	adminAcct *gtsmodel.Account,
 	id string,
 ) (*apimodel.AdminEmoji, gtserror.WithCode) {
 	emoji, err := p.state.DB.GetEmojiByID(ctx, id)
@@ -208,6 +211,8 @@ func (p *Processor) EmojiDelete(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetEmoji, emoji.ID, emoji.Shortcode)
+
 	return adminEmoji, nil
 }
 
@@ -215,6 +220,7 @@ func (p *Processor) EmojiDelete(
 // given id, using the provided form parameters.
 func (p *Processor) EmojiUpdate(
 	ctx context.Context,
+	adminAcct *gtsmodel.Account,
 	emojiID string,
 	form *apimodel.EmojiUpdateRequest,
 ) (*apimodel.AdminEmoji, gtserror.WithCode) {
@@ -232,21 +238,34 @@ func (p *Processor) EmojiUpdate(
 		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
 	}
 
+	var (
+		adminEmoji  *apimodel.AdminEmoji
+		errWithCode gtserror.WithCode
+	)
+
 	switch form.Type {
 
 	case apimodel.EmojiUpdateCopy:
-		return p.emojiUpdateCopy(ctx, emoji, form.Shortcode, form.CategoryName)
+		adminEmoji, errWithCode = p.emojiUpdateCopy(ctx, emoji, form.Shortcode, form.CategoryName)
 
 	case apimodel.EmojiUpdateDisable:
-		return p.emojiUpdateDisable(ctx, emoji)
+		adminEmoji, errWithCode = p.emojiUpdateDisable(ctx, emoji)
 
 	case apimodel.EmojiUpdateModify:
-		return p.emojiUpdateModify(ctx, emoji, form.Image, form.CategoryName)
+		adminEmoji, errWithCode = p.emojiUpdateModify(ctx, emoji, form.Image, form.CategoryName)
 
 	default:
 		const text = "unrecognized emoji update action type"
 		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 	}
+
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetEmoji, emoji.ID, string(form.Type))
+
+	return adminEmoji, nil
 }
 
 // EmojiCategoriesGet returns all custom emoji
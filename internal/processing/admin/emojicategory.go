@@ -0,0 +1,263 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// EmojiCategoryCreate creates a new, empty
+// custom emoji category with the given name.
+func (p *Processor) EmojiCategoryCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	name string,
+) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	if errWithCode := p.checkEmojiCategoryName(ctx, "", name); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	category := &gtsmodel.EmojiCategory{
+		ID:   id.NewULID(),
+		Name: name,
+	}
+
+	if err := p.state.DB.PutEmojiCategory(ctx, category); err != nil {
+		err := gtserror.Newf("db error inserting emoji category: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetEmojiCategory, category.ID, category.Name)
+
+	return p.apiEmojiCategory(ctx, category)
+}
+
+// EmojiCategoryUpdate renames the custom
+// emoji category with the given id.
+func (p *Processor) EmojiCategoryUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	name string,
+) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	category, errWithCode := p.getEmojiCategory(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.checkEmojiCategoryName(ctx, category.ID, name); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	category.Name = name
+	if err := p.state.DB.UpdateEmojiCategory(ctx, category, "name"); err != nil {
+		err := gtserror.Newf("db error updating emoji category: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetEmojiCategory, category.ID, category.Name)
+
+	return p.apiEmojiCategory(ctx, category)
+}
+
+// EmojiCategoryDelete deletes the custom emoji category
+// with the given id. Emojis in the category are not
+// deleted, they just become uncategorized.
+func (p *Processor) EmojiCategoryDelete(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	category, errWithCode := p.getEmojiCategory(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert to api category before deletion,
+	// so we can return the deleted category.
+	apiCategory, errWithCode := p.apiEmojiCategory(ctx, category)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteEmojiCategoryByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting emoji category %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetEmojiCategory, category.ID, category.Name)
+
+	return apiCategory, nil
+}
+
+// EmojiCategoryEmojisMove moves the given local emojis,
+// and / or all emojis in the given "from" category, into
+// the custom emoji category with the given id, returning
+// the moved emojis.
+func (p *Processor) EmojiCategoryEmojisMove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	form *apimodel.EmojiCategoryEmojisRequest,
+) ([]*apimodel.AdminEmoji, gtserror.WithCode) {
+	if len(form.EmojiIDs) == 0 && form.FromCategoryID == "" {
+		const text = "no emoji_ids or from_category_id provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	category, errWithCode := p.getEmojiCategory(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var emojis []*gtsmodel.Emoji
+
+	if form.FromCategoryID != "" {
+		// Ensure "from" category exists.
+		from, errWithCode := p.getEmojiCategory(ctx, form.FromCategoryID)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		inCategory, err := p.state.DB.GetEmojisByCategoryID(ctx, from.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting emojis in category %s: %w", from.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		emojis = append(emojis, inCategory...)
+	}
+
+	for _, emojiID := range form.EmojiIDs {
+		emoji, err := p.state.DB.GetEmojiByID(ctx, emojiID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting emoji %s: %w", emojiID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if emoji == nil {
+			text := fmt.Sprintf("emoji %s not found", emojiID)
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+
+		if !emoji.IsLocal() {
+			// Only local emojis
+			// can be categorized.
+			text := fmt.Sprintf("emoji %s is not a local emoji", emojiID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		emojis = append(emojis, emoji)
+	}
+
+	// Gather emojis as we move them,
+	// skipping any duplicates given.
+	moved := make(map[string]struct{}, len(emojis))
+	apiEmojis := make([]*apimodel.AdminEmoji, 0, len(emojis))
+
+	for _, emoji := range emojis {
+		if _, ok := moved[emoji.ID]; ok {
+			continue
+		}
+		moved[emoji.ID] = struct{}{}
+
+		if emoji.CategoryID != category.ID {
+			emoji.CategoryID = category.ID
+			emoji.Category = category
+
+			if err := p.state.DB.UpdateEmoji(ctx, emoji, "category_id"); err != nil {
+				err := gtserror.Newf("db error updating emoji %s: %w", emoji.ID, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		apiEmoji, err := p.converter.EmojiToAdminAPIEmoji(ctx, emoji)
+		if err != nil {
+			err := gtserror.Newf("error converting emoji to admin api emoji: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiEmojis = append(apiEmojis, apiEmoji)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionMove, gtsmodel.AuditTargetEmojiCategory, category.ID, category.Name)
+
+	return apiEmojis, nil
+}
+
+// getEmojiCategory gets the emoji category with
+// the given id, returning 404 if it doesn't exist.
+func (p *Processor) getEmojiCategory(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.EmojiCategory, gtserror.WithCode) {
+	category, err := p.state.DB.GetEmojiCategory(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting emoji category %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if category == nil {
+		text := fmt.Sprintf("emoji category %s not found", id)
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return category, nil
+}
+
+// checkEmojiCategoryName checks that no emoji category other
+// than the one with the given id (if set) already uses name.
+// Category names are compared case-insensitively.
+func (p *Processor) checkEmojiCategoryName(
+	ctx context.Context,
+	id string,
+	name string,
+) gtserror.WithCode {
+	existing, err := p.state.DB.GetEmojiCategoryByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting emoji category by name: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil && existing.ID != id {
+		text := fmt.Sprintf("emoji category %s already exists", existing.Name)
+		return gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	return nil
+}
+
+func (p *Processor) apiEmojiCategory(
+	ctx context.Context,
+	category *gtsmodel.EmojiCategory,
+) (*apimodel.EmojiCategory, gtserror.WithCode) {
+	apiCategory, err := p.converter.EmojiCategoryToAPIEmojiCategory(ctx, category)
+	if err != nil {
+		err := gtserror.Newf("error converting emoji category to api emoji category: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiCategory, nil
+}
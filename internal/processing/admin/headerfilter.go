@@ -54,22 +54,22 @@ func (p *Processor) GetBlockHeaderFilters(ctx context.Context) ([]*apimodel.Head
 
 // CreateAllowHeaderFilter inserts the incoming allow HTTP header filter into the database, marking as authored by provided admin account.
 func (p *Processor) CreateAllowHeaderFilter(ctx context.Context, admin *gtsmodel.Account, request *apimodel.HeaderFilterRequest) (*apimodel.HeaderFilter, gtserror.WithCode) {
-	return p.createHeaderFilter(ctx, admin, request, p.state.DB.PutAllowHeaderFilter)
+	return p.createHeaderFilter(ctx, admin, request, gtsmodel.AuditTargetHeaderAllow, p.state.DB.PutAllowHeaderFilter)
 }
 
 // CreateBlockHeaderFilter inserts the incoming block HTTP header filter into the database, marking as authored by provided admin account.
 func (p *Processor) CreateBlockHeaderFilter(ctx context.Context, admin *gtsmodel.Account, request *apimodel.HeaderFilterRequest) (*apimodel.HeaderFilter, gtserror.WithCode) {
-	return p.createHeaderFilter(ctx, admin, request, p.state.DB.PutBlockHeaderFilter)
+	return p.createHeaderFilter(ctx, admin, request, gtsmodel.AuditTargetHeaderBlock, p.state.DB.PutBlockHeaderFilter)
 }
 
 // DeleteAllowHeaderFilter deletes the allowing HTTP header filter with provided ID from the database.
-func (p *Processor) DeleteAllowHeaderFilter(ctx context.Context, id string) gtserror.WithCode {
-	return p.deleteHeaderFilter(ctx, id, p.state.DB.DeleteAllowHeaderFilter)
+func (p *Processor) DeleteAllowHeaderFilter(ctx context.Context, admin *gtsmodel.Account, id string) gtserror.WithCode {
+	return p.deleteHeaderFilter(ctx, admin, id, gtsmodel.AuditTargetHeaderAllow, p.state.DB.DeleteAllowHeaderFilter)
 }
 
 // DeleteBlockHeaderFilter deletes the blocking HTTP header filter with provided ID from the database.
-func (p *Processor) DeleteBlockHeaderFilter(ctx context.Context, id string) gtserror.WithCode {
-	return p.deleteHeaderFilter(ctx, id, p.state.DB.DeleteBlockHeaderFilter)
+func (p *Processor) DeleteBlockHeaderFilter(ctx context.Context, admin *gtsmodel.Account, id string) gtserror.WithCode {
+	return p.deleteHeaderFilter(ctx, admin, id, gtsmodel.AuditTargetHeaderBlock, p.state.DB.DeleteBlockHeaderFilter)
 }
 
 // getHeaderFilter fetches an HTTP header filter with
@@ -138,6 +138,7 @@ func (p *Processor) createHeaderFilter(
 	ctx context.Context,
 	admin *gtsmodel.Account,
 	request *apimodel.HeaderFilterRequest,
+	targetType string,
 	insert func(context.Context, *gtsmodel.HeaderFilter) error,
 ) (
 	*apimodel.HeaderFilter,
@@ -168,6 +169,8 @@ func (p *Processor) createHeaderFilter(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, admin, gtsmodel.AuditActionCreate, targetType, filter.ID, filter.Header+": "+filter.Regex)
+
 	// Finally return API model response.
 	return toAPIHeaderFilter(&filter), nil
 }
@@ -176,10 +179,16 @@ func (p *Processor) createHeaderFilter(
 // with provided ID, using the given delete function.
 func (p *Processor) deleteHeaderFilter(
 	ctx context.Context,
+	admin *gtsmodel.Account,
 	id string,
+	targetType string,
 	delete func(context.Context, string) error,
 ) gtserror.WithCode {
-	if err := delete(ctx, id); err != nil && !errors.Is(err, db.ErrNoEntries) {
+	err := delete(ctx, id)
+	switch {
+	case err == nil:
+		p.LogAction(ctx, admin, gtsmodel.AuditActionDelete, targetType, id, "")
+	case !errors.Is(err, db.ErrNoEntries):
 		err := gtserror.Newf("error deleting from database: %w", err)
 		return gtserror.NewErrorInternalError(err)
 	}
@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"cmp"
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// DefaultInteractionPoliciesGet returns the instance-wide default
+// interaction policies used to seed the settings of new accounts.
+func (p *Processor) DefaultInteractionPoliciesGet(
+	ctx context.Context,
+) (*apimodel.DefaultPolicies, gtserror.WithCode) {
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.defaultInteractionPolicies(ctx, instance)
+}
+
+// DefaultInteractionPoliciesUpdate updates the instance-wide default
+// interaction policies used to seed the settings of new accounts.
+//
+// Visibility levels left unset in the form are returned to the global default.
+// Existing accounts are not affected; policies are only copied on sign-up.
+func (p *Processor) DefaultInteractionPoliciesUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.UpdateInteractionPoliciesRequest,
+) (*apimodel.DefaultPolicies, gtserror.WithCode) {
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, spec := range []struct {
+		form       *apimodel.InteractionPolicy
+		visibility apimodel.Visibility
+		policy     **gtsmodel.InteractionPolicy
+	}{
+		{form.Direct, apimodel.VisibilityDirect, &instance.InteractionPolicyDirect},
+		{form.Private, apimodel.VisibilityPrivate, &instance.InteractionPolicyFollowersOnly},
+		{form.Unlisted, apimodel.VisibilityUnlisted, &instance.InteractionPolicyUnlocked},
+		{form.Public, apimodel.VisibilityPublic, &instance.InteractionPolicyPublic},
+	} {
+		if spec.form == nil {
+			// Unset/return to global default.
+			*spec.policy = nil
+			continue
+		}
+
+		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
+			spec.form,
+			spec.visibility,
+		)
+		if err != nil {
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		// Set new default policy.
+		*spec.policy = policy
+	}
+
+	if err := p.state.DB.UpdateInstance(ctx, instance,
+		"interaction_policy_direct",
+		"interaction_policy_followers_only",
+		"interaction_policy_unlocked",
+		"interaction_policy_public",
+	); err != nil {
+		err := gtserror.Newf("db error updating instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetInstance, instance.ID, "default interaction policies")
+
+	return p.defaultInteractionPolicies(ctx, instance)
+}
+
+// defaultInteractionPolicies converts the default interaction
+// policies set on the given instance to their API model, falling
+// back to the global default for any visibility left unset.
+func (p *Processor) defaultInteractionPolicies(
+	ctx context.Context,
+	instance *gtsmodel.Instance,
+) (*apimodel.DefaultPolicies, gtserror.WithCode) {
+	resp := new(apimodel.DefaultPolicies)
+
+	for _, spec := range []struct {
+		policy   *gtsmodel.InteractionPolicy
+		fallback *gtsmodel.InteractionPolicy
+		apiModel *apimodel.InteractionPolicy
+	}{
+		{instance.InteractionPolicyDirect, gtsmodel.DefaultInteractionPolicyDirect(), &resp.Direct},
+		{instance.InteractionPolicyFollowersOnly, gtsmodel.DefaultInteractionPolicyFollowersOnly(), &resp.Private},
+		{instance.InteractionPolicyUnlocked, gtsmodel.DefaultInteractionPolicyUnlocked(), &resp.Unlisted},
+		{instance.InteractionPolicyPublic, gtsmodel.DefaultInteractionPolicyPublic(), &resp.Public},
+	} {
+		// Take set policy or global default.
+		policy := cmp.Or(spec.policy, spec.fallback)
+
+		apiPolicy, err := p.converter.InteractionPolicyToAPIInteractionPolicy(ctx, policy, nil, nil)
+		if err != nil {
+			err := gtserror.Newf("error converting interaction policy: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		*spec.apiModel = *apiPolicy
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// GetInvites fetches invites stored in the database, newest first.
+// If accountID is set, only invites created by that account are returned.
+func (p *Processor) GetInvites(ctx context.Context, accountID string) ([]*apimodel.Invite, gtserror.WithCode) {
+	invites, err := p.state.DB.GetInvites(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInvites := make([]*apimodel.Invite, len(invites))
+	for i, invite := range invites {
+		apiInvites[i] = p.converter.InviteToAPIInvite(invite)
+	}
+
+	return apiInvites, nil
+}
+
+// ExpireInvite expires the invite with the given ID,
+// regardless of who created it, so that it can no
+// longer be used to sign up.
+func (p *Processor) ExpireInvite(ctx context.Context, adminAcct *gtsmodel.Account, id string) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Real error.
+		err = gtserror.Newf("db error getting invite %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil {
+		// No invite found.
+		err := gtserror.Newf("invite %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if now := time.Now(); !invite.Expired(now) {
+		invite.ExpiresAt = now
+		if err := p.state.DB.UpdateInvite(ctx, invite, "expires_at"); err != nil {
+			err := gtserror.Newf("db error updating invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		p.LogAction(ctx, adminAcct, gtsmodel.AuditActionExpire, gtsmodel.AuditTargetInvite, invite.ID, "")
+	}
+
+	return p.converter.InviteToAPIInvite(invite), nil
+}
@@ -0,0 +1,281 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// maxIPBlockCommentLength is the maximum
+// length in characters of IP block comments.
+const maxIPBlockCommentLength = 1000
+
+// GetIPBlock fetches the IP block with provided ID from the database.
+func (p *Processor) GetIPBlock(ctx context.Context, id string) (*apimodel.IPBlock, gtserror.WithCode) {
+	block, errWithCode := p.getIPBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	return toAPIIPBlock(block), nil
+}
+
+// GetIPBlocks fetches all IP blocks stored in the database.
+func (p *Processor) GetIPBlocks(ctx context.Context) ([]*apimodel.IPBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetIPBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert blocks to apimodel blocks.
+	apiBlocks := make([]*apimodel.IPBlock, len(blocks))
+	for i := range blocks {
+		apiBlocks[i] = toAPIIPBlock(blocks[i])
+	}
+
+	return apiBlocks, nil
+}
+
+// CreateIPBlock inserts the incoming IP block into the database, marking as created by provided admin account.
+func (p *Processor) CreateIPBlock(ctx context.Context, admin *gtsmodel.Account, request *apimodel.IPBlockCreateRequest) (*apimodel.IPBlock, gtserror.WithCode) {
+	ip, errWithCode := validateIPBlockIP(request.IP)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	severity, errWithCode := validateIPBlockSeverity(request.Severity)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := validateIPBlockComment(request.Comment); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Create new database model with ID.
+	block := &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 ip,
+		Severity:           severity,
+		Comment:            request.Comment,
+		CreatedByAccountID: admin.ID,
+	}
+
+	if request.ExpiresIn != nil {
+		if *request.ExpiresIn <= 0 {
+			const text = "expires_in must be a positive number of seconds"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		block.ExpiresAt = time.Now().Add(time.Duration(*request.ExpiresIn) * time.Second)
+	}
+
+	// Insert new IP block into the database.
+	if err := p.state.DB.PutIPBlock(ctx, block); err != nil {
+		err := gtserror.Newf("error inserting into database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, admin, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetIPBlock, block.ID, block.IP+": "+block.Comment)
+
+	// Finally return API model response.
+	return toAPIIPBlock(block), nil
+}
+
+// UpdateIPBlock updates the IP block with provided ID using fields set on the request.
+func (p *Processor) UpdateIPBlock(ctx context.Context, admin *gtsmodel.Account, id string, request *apimodel.IPBlockUpdateRequest) (*apimodel.IPBlock, gtserror.WithCode) {
+	block, errWithCode := p.getIPBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var columns []string
+
+	if request.IP != nil {
+		ip, errWithCode := validateIPBlockIP(*request.IP)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		block.IP = ip
+		columns = append(columns, "ip")
+	}
+
+	if request.Severity != nil {
+		severity, errWithCode := validateIPBlockSeverity(*request.Severity)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		block.Severity = severity
+		columns = append(columns, "severity")
+	}
+
+	if request.Comment != nil {
+		if errWithCode := validateIPBlockComment(*request.Comment); errWithCode != nil {
+			return nil, errWithCode
+		}
+		block.Comment = *request.Comment
+		columns = append(columns, "comment")
+	}
+
+	if request.ExpiresIn != nil {
+		switch expiresIn := *request.ExpiresIn; {
+		case expiresIn < 0:
+			const text = "expires_in must not be negative"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+
+		case expiresIn == 0:
+			// Remove expiry.
+			block.ExpiresAt = time.Time{}
+
+		default:
+			block.ExpiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+		}
+		columns = append(columns, "expires_at")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to update.
+		return toAPIIPBlock(block), nil
+	}
+
+	if err := p.state.DB.UpdateIPBlock(ctx, block, columns...); err != nil {
+		err := gtserror.Newf("error updating database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, admin, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetIPBlock, block.ID, block.IP)
+
+	return toAPIIPBlock(block), nil
+}
+
+// DeleteIPBlock deletes the IP block with provided ID from the database.
+func (p *Processor) DeleteIPBlock(ctx context.Context, admin *gtsmodel.Account, id string) gtserror.WithCode {
+	err := p.state.DB.DeleteIPBlock(ctx, id)
+	switch {
+	case err == nil:
+		p.LogAction(ctx, admin, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetIPBlock, id, "")
+	case !errors.Is(err, db.ErrNoEntries):
+		err := gtserror.Newf("error deleting from database: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+	return nil
+}
+
+// getIPBlock fetches the IP block with provided ID
+// from the database, wrapping any error with code.
+func (p *Processor) getIPBlock(ctx context.Context, id string) (*gtsmodel.IPBlock, gtserror.WithCode) {
+	block, err := p.state.DB.GetIPBlock(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return block, nil
+
+	// Block does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "ip block not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// toAPIIPBlock performs a simple conversion of database model IPBlock to API model.
+func toAPIIPBlock(block *gtsmodel.IPBlock) *apimodel.IPBlock {
+	apiBlock := &apimodel.IPBlock{
+		ID:        block.ID,
+		IP:        block.IP,
+		Severity:  block.Severity.String(),
+		Comment:   block.Comment,
+		CreatedBy: block.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(block.CreatedAt),
+	}
+
+	if !block.ExpiresAt.IsZero() {
+		expiresAt := util.FormatISO8601(block.ExpiresAt)
+		apiBlock.ExpiresAt = &expiresAt
+	}
+
+	return apiBlock
+}
+
+// validateIPBlockIP validates the incoming IP address
+// or CIDR range, returning it in normalized CIDR form.
+func validateIPBlockIP(ip string) (string, gtserror.WithCode) {
+	prefix, err := parseIPRange(ip)
+	if err != nil {
+		err := fmt.Errorf("invalid ip: %w", err)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	return prefix.String(), nil
+}
+
+// parseIPRange parses the given IP address or CIDR range,
+// with host bits zeroed, eg., 192.0.2.1/24 => 192.0.2.0/24.
+// A single address is treated as a range of one.
+func parseIPRange(ip string) (netip.Prefix, error) {
+	ip = strings.TrimSpace(ip)
+
+	if strings.Contains(ip, "/") {
+		prefix, err := netip.ParsePrefix(ip)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// validateIPBlockSeverity parses the incoming IP block severity.
+func validateIPBlockSeverity(severity string) (gtsmodel.IPBlockSeverity, gtserror.WithCode) {
+	s := gtsmodel.ParseIPBlockSeverity(severity)
+	if s == gtsmodel.IPBlockSeverityUnknown {
+		err := fmt.Errorf("invalid severity %q, must be one of sign_up_block, no_access", severity)
+		return 0, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	return s, nil
+}
+
+// validateIPBlockComment checks the incoming IP block comment length.
+func validateIPBlockComment(comment string) gtserror.WithCode {
+	if len([]rune(comment)) > maxIPBlockCommentLength {
+		text := fmt.Sprintf("comment must be no longer than %d chars", maxIPBlockCommentLength)
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return nil
+}
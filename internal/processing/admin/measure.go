@@ -0,0 +1,318 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"codeberg.org/gruf/go-bytesize"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// day is the size of the
+// buckets measures are split into.
+const day = 24 * time.Hour
+
+// measure wraps activity gathered
+// for a measure, along with how to
+// aggregate it into a value.
+type measure struct {
+	activity []*gtsmodel.MeasureActivity
+
+	// distinct, if set, returns
+	// the key to count activity
+	// distinctly by. Else all
+	// activity is counted.
+	distinct func(*gtsmodel.MeasureActivity) string
+
+	// sum indicates activity
+	// values should be summed
+	// rather than counted.
+	sum bool
+
+	// unit of the value, if any.
+	unit string
+}
+
+// value aggregates activity
+// accepted by the given filter.
+func (m *measure) value(filter func(*gtsmodel.MeasureActivity) bool) int64 {
+	var (
+		value int64
+		seen  map[string]struct{}
+	)
+
+	if m.distinct != nil {
+		seen = make(map[string]struct{})
+	}
+
+	for _, a := range m.activity {
+		if !filter(a) {
+			continue
+		}
+
+		switch {
+		case m.distinct != nil:
+			seen[m.distinct(a)] = struct{}{}
+		case m.sum:
+			value += a.Value
+		default:
+			value++
+		}
+	}
+
+	if m.distinct != nil {
+		value = int64(len(seen))
+	}
+
+	return value
+}
+
+// MeasuresGet returns the measures with given
+// keys for the period from start to end. Unknown
+// measure keys are ignored. Params for individual
+// measures, eg., tag ID or domain, are keyed by
+// measure key.
+func (p *Processor) MeasuresGet(
+	ctx context.Context,
+	keys []string,
+	start time.Time,
+	end time.Time,
+	params map[string]map[string]string,
+) ([]*apimodel.AdminMeasure, gtserror.WithCode) {
+	// Previous period of same length
+	// as the requested one, so that
+	// totals can be compared.
+	prevStart := start.Add(-end.Sub(start))
+
+	measures := make([]*apimodel.AdminMeasure, 0, len(keys))
+	for _, key := range keys {
+		// Gather activity from start of previous
+		// period, we can then split it in two.
+		m, errWithCode := p.getMeasure(ctx, key, params[key], prevStart, end)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if m == nil {
+			// Unknown key.
+			continue
+		}
+
+		measures = append(measures, measureToAPIMeasure(key, m, start, end))
+	}
+
+	return measures, nil
+}
+
+// measureToAPIMeasure converts the given measure to
+// its API representation for the period start to end.
+func measureToAPIMeasure(key string, m *measure, start time.Time, end time.Time) *apimodel.AdminMeasure {
+	between := func(start time.Time, end time.Time) func(*gtsmodel.MeasureActivity) bool {
+		return func(a *gtsmodel.MeasureActivity) bool {
+			return !a.CreatedAt.Before(start) && a.CreatedAt.Before(end)
+		}
+	}
+
+	var (
+		total     = m.value(between(start, end))
+		prevTotal = m.value(between(start.Add(-end.Sub(start)), start))
+	)
+
+	apiMeasure := &apimodel.AdminMeasure{
+		Key:           key,
+		Total:         strconv.FormatInt(total, 10),
+		PreviousTotal: util.Ptr(strconv.FormatInt(prevTotal, 10)),
+		Data:          make([]apimodel.AdminMeasureData, 0, int(end.Sub(start)/day)),
+	}
+
+	if m.unit != "" {
+		apiMeasure.Unit = util.Ptr(m.unit)
+		apiMeasure.HumanValue = util.Ptr(humanValue(m.unit, total))
+	}
+
+	for date := start; date.Before(end); date = date.Add(day) {
+		value := m.value(between(date, date.Add(day)))
+		apiMeasure.Data = append(apiMeasure.Data, apimodel.AdminMeasureData{
+			Date:  util.FormatISO8601(date),
+			Value: strconv.FormatInt(value, 10),
+		})
+	}
+
+	return apiMeasure
+}
+
+// getMeasure gathers activity for the measure with given key,
+// using given params, between start and end. If key is not a
+// known measure, nil will be returned.
+func (p *Processor) getMeasure(
+	ctx context.Context,
+	key string,
+	params map[string]string,
+	start time.Time,
+	end time.Time,
+) (*measure, gtserror.WithCode) {
+	var (
+		m   = new(measure)
+		err error
+	)
+
+	switch key {
+	case "active_users":
+		// Active users either logged
+		// in or posted on a given day.
+		m.activity, err = p.activeUserActivity(ctx, start, end)
+		m.distinct = byAccount
+
+	case "new_users":
+		m.activity, err = p.state.DB.GetSignupActivity(ctx, start, end)
+
+	case "interactions":
+		m.activity, err = p.state.DB.GetInteractionActivity(ctx, start, end)
+
+	case "opened_reports":
+		m.activity, err = p.state.DB.GetReportActivity(ctx, "", false, start, end)
+
+	case "resolved_reports":
+		m.activity, err = p.state.DB.GetReportActivity(ctx, "", true, start, end)
+
+	case "tag_accounts", "tag_uses", "tag_servers":
+		tagID, errWithCode := measureParam(key, params, "id")
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		m.activity, err = p.state.DB.GetTagActivity(ctx, tagID, start, end)
+		switch key {
+		case "tag_accounts":
+			m.distinct = byAccount
+		case "tag_servers":
+			m.distinct = byDomain
+		}
+
+	case "instance_accounts",
+		"instance_media_attachments",
+		"instance_reports",
+		"instance_statuses",
+		"instance_follows",
+		"instance_followers":
+		domain, errWithCode := measureDomainParam(key, params)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		switch key {
+		case "instance_accounts":
+			m.activity, err = p.state.DB.GetAccountActivity(ctx, domain, start, end)
+		case "instance_media_attachments":
+			m.activity, err = p.state.DB.GetMediaActivity(ctx, domain, start, end)
+			m.sum = true
+			m.unit = "bytes"
+		case "instance_reports":
+			m.activity, err = p.state.DB.GetReportActivity(ctx, domain, false, start, end)
+		case "instance_statuses":
+			m.activity, err = p.state.DB.GetRemoteStatusActivity(ctx, domain, start, end)
+		case "instance_follows":
+			m.activity, err = p.state.DB.GetFollowActivity(ctx, domain, false, start, end)
+		case "instance_followers":
+			m.activity, err = p.state.DB.GetFollowActivity(ctx, domain, true, start, end)
+		}
+
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		err := gtserror.Newf("db error getting activity for measure %s: %w", key, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return m, nil
+}
+
+// activeUserActivity gathers logins
+// and statuses of local accounts.
+func (p *Processor) activeUserActivity(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) ([]*gtsmodel.MeasureActivity, error) {
+	logins, err := p.state.DB.GetLoginActivity(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := p.state.DB.GetLocalStatusActivity(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(logins, statuses...), nil
+}
+
+func byAccount(a *gtsmodel.MeasureActivity) string { return a.AccountID }
+
+func byDomain(a *gtsmodel.MeasureActivity) string { return a.Domain }
+
+func byLanguage(a *gtsmodel.MeasureActivity) string { return a.Language }
+
+// measureParam returns the required param
+// with given name for the given measure or
+// dimension key, or a bad request error.
+func measureParam(key string, params map[string]string, name string) (string, gtserror.WithCode) {
+	value := params[name]
+	if value == "" {
+		text := fmt.Sprintf("%s[%s] must be provided for %s", key, name, key)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return value, nil
+}
+
+// measureDomainParam returns the required
+// domain param for the given measure or
+// dimension key, punified for db lookups.
+func measureDomainParam(key string, params map[string]string) (string, gtserror.WithCode) {
+	domain, errWithCode := measureParam(key, params, "domain")
+	if errWithCode != nil {
+		return "", errWithCode
+	}
+
+	domain, err := util.Punify(domain)
+	if err != nil {
+		text := fmt.Sprintf("invalid %s[domain]: %v", key, err)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return domain, nil
+}
+
+// humanValue formats the given
+// value with the given unit.
+func humanValue(unit string, value int64) string {
+	if unit == "bytes" {
+		return bytesize.Size(value).StringIEC() // #nosec G115 -- Sizes are never negative.
+	}
+	return strconv.FormatInt(value, 10) + " " + unit
+}
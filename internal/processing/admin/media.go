@@ -46,11 +46,13 @@ func (p *Processor) MediaRefetch(ctx context.Context, requestingAccount *gtsmode
 		}
 	}()
 
+	p.LogAction(ctx, requestingAccount, gtsmodel.AuditActionRefetch, gtsmodel.AuditTargetEmoji, "", domain)
+
 	return nil
 }
 
 // MediaPrune triggers a non-blocking prune of unused media, orphaned, uncaching remote and fixing cache states.
-func (p *Processor) MediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode {
+func (p *Processor) MediaPrune(ctx context.Context, adminAcct *gtsmodel.Account, mediaRemoteCacheDays int) gtserror.WithCode {
 	if mediaRemoteCacheDays < 0 {
 		err := fmt.Errorf("MediaPrune: invalid value for mediaRemoteCacheDays prune: value was %d, cannot be less than 0", mediaRemoteCacheDays)
 		return gtserror.NewErrorBadRequest(err, err.Error())
@@ -63,5 +65,8 @@ func (p *Processor) MediaPrune(ctx context.Context, mediaRemoteCacheDays int) gt
 		p.cleaner.Emoji().All(ctx, mediaRemoteCacheDays)
 	}()
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionPrune, gtsmodel.AuditTargetMedia, "",
+		fmt.Sprintf("remote cache days: %d", mediaRemoteCacheDays))
+
 	return nil
 }
@@ -0,0 +1,247 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// MediaRetentionPolicyCreate creates a media retention
+// policy for the given domain, overriding how long remote
+// media from that domain (and subdomains) is kept cached.
+func (p *Processor) MediaRetentionPolicyCreate(
+	ctx context.Context,
+	acct *gtsmodel.Account,
+	domain string,
+	remoteCacheDays int,
+	privateComment string,
+) (*apimodel.MediaRetentionPolicy, gtserror.WithCode) {
+	if errWithCode := validateRemoteCacheDays(remoteCacheDays); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	policy := &gtsmodel.MediaRetentionPolicy{
+		ID:                 id.NewULID(),
+		Domain:             domain,
+		RemoteCacheDays:    remoteCacheDays,
+		CreatedByAccountID: acct.ID,
+		CreatedByAccount:   acct,
+		PrivateComment:     privateComment,
+	}
+
+	if err := p.state.DB.PutMediaRetentionPolicy(ctx, policy); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "a media retention policy already exists for this domain"
+			err := fmt.Errorf("%w: %s", err, text)
+			return nil, gtserror.NewErrorConflict(err, text)
+		}
+
+		// Real error.
+		err := gtserror.Newf("db error putting media retention policy: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, acct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetMediaRetentionPolicy, policy.ID, policy.PrivateComment)
+
+	return p.apiMediaRetentionPolicy(ctx, policy)
+}
+
+// MediaRetentionPolicyGet returns one
+// media retention policy with the given id.
+func (p *Processor) MediaRetentionPolicyGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.MediaRetentionPolicy, gtserror.WithCode) {
+	policy, errWithCode := p.getMediaRetentionPolicy(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiMediaRetentionPolicy(ctx, policy)
+}
+
+// MediaRetentionPoliciesGet returns a page of
+// MediaRetentionPolicies with the given parameters.
+func (p *Processor) MediaRetentionPoliciesGet(
+	ctx context.Context,
+	domain string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	policies, err := p.state.DB.GetMediaRetentionPolicies(
+		ctx,
+		domain,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(policies)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := policies[count-1].ID
+	hi := policies[0].ID
+
+	// Convert each policy to API model.
+	items := make([]any, len(policies))
+	for i, policy := range policies {
+		apiPolicy, errWithCode := p.apiMediaRetentionPolicy(ctx, policy)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiPolicy
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if domain != "" {
+		query.Set(apiutil.DomainPermissionDomainKey, domain)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/media_retention_policies",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// MediaRetentionPolicyUpdate updates the media retention
+// policy with the given id, using any provided values.
+func (p *Processor) MediaRetentionPolicyUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	remoteCacheDays *int,
+	privateComment *string,
+) (*apimodel.MediaRetentionPolicy, gtserror.WithCode) {
+	policy, errWithCode := p.getMediaRetentionPolicy(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var columns []string
+
+	if remoteCacheDays != nil {
+		if errWithCode := validateRemoteCacheDays(*remoteCacheDays); errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		policy.RemoteCacheDays = *remoteCacheDays
+		columns = append(columns, "remote_cache_days")
+	}
+
+	if privateComment != nil {
+		policy.PrivateComment = *privateComment
+		columns = append(columns, "private_comment")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to update.
+		return p.apiMediaRetentionPolicy(ctx, policy)
+	}
+
+	if err := p.state.DB.UpdateMediaRetentionPolicy(ctx, policy, columns...); err != nil {
+		err := gtserror.Newf("db error updating media retention policy: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetMediaRetentionPolicy, policy.ID, policy.PrivateComment)
+
+	return p.apiMediaRetentionPolicy(ctx, policy)
+}
+
+// MediaRetentionPolicyDelete deletes the media
+// retention policy with the given id, returning
+// remote media from its domain to the default
+// media-remote-cache-days retention period.
+func (p *Processor) MediaRetentionPolicyDelete(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.MediaRetentionPolicy, gtserror.WithCode) {
+	policy, errWithCode := p.getMediaRetentionPolicy(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteMediaRetentionPolicy(ctx, policy.ID); err != nil {
+		err := gtserror.Newf("db error deleting media retention policy: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetMediaRetentionPolicy, policy.ID, "")
+
+	return p.apiMediaRetentionPolicy(ctx, policy)
+}
+
+func (p *Processor) getMediaRetentionPolicy(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.MediaRetentionPolicy, gtserror.WithCode) {
+	policy, err := p.state.DB.GetMediaRetentionPolicyByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting media retention policy %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if policy == nil {
+		err := fmt.Errorf("media retention policy %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return policy, nil
+}
+
+func (p *Processor) apiMediaRetentionPolicy(
+	ctx context.Context,
+	policy *gtsmodel.MediaRetentionPolicy,
+) (*apimodel.MediaRetentionPolicy, gtserror.WithCode) {
+	apiPolicy, err := p.converter.MediaRetentionPolicyToAPIMediaRetentionPolicy(ctx, policy)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting media retention policy to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPolicy, nil
+}
+
+func validateRemoteCacheDays(days int) gtserror.WithCode {
+	if days < 0 {
+		const text = "remote_cache_days must be 0 (keep indefinitely) or greater"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// RelayCreate subscribes this instance to the relay
+// with the given actor URI, by sending a Follow of
+// the relay actor from the instance account.
+func (p *Processor) RelayCreate(
+	ctx context.Context,
+	acct *gtsmodel.Account,
+	actorURI string,
+) (*apimodel.Relay, gtserror.WithCode) {
+	actorIRI, err := url.Parse(actorURI)
+	if err != nil || (actorIRI.Scheme != "https" && actorIRI.Scheme != "http") || actorIRI.Host == "" {
+		const text = "actor_uri must be a valid http or https url"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	existing, err := p.state.DB.GetRelayByActorURI(ctx, actorIRI.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		const text = "already subscribed to this relay"
+		err := fmt.Errorf("%w: %s", db.ErrAlreadyExists, text)
+		return nil, gtserror.NewErrorConflict(err, text)
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Dereference the relay actor,
+	// so we know which inbox to use.
+	relayAcct, _, err := p.federator.GetAccountByURI(ctx,
+		instanceAcct.Username,
+		actorIRI,
+	)
+	if err != nil {
+		err := gtserror.Newf("error dereferencing relay actor %s: %w", actorIRI, err)
+		const text = "could not dereference relay actor"
+		return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+	}
+
+	// Prefer shared inbox where
+	// the relay actor has one.
+	inboxURI := relayAcct.InboxURI
+	if sharedInbox := util.PtrOrZero(relayAcct.SharedInboxURI); sharedInbox != "" {
+		inboxURI = sharedInbox
+	}
+
+	relay := &gtsmodel.Relay{
+		ID:                 id.NewULID(),
+		ActorURI:           relayAcct.URI,
+		InboxURI:           inboxURI,
+		FollowURI:          uris.GenerateURIForFollow(instanceAcct.Username, id.NewULID()),
+		State:              gtsmodel.RelayStatePending,
+		Enabled:            util.Ptr(true),
+		CreatedByAccountID: acct.ID,
+	}
+
+	if err := p.state.DB.PutRelay(ctx, relay); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "already subscribed to this relay"
+			err := fmt.Errorf("%w: %s", err, text)
+			return nil, gtserror.NewErrorConflict(err, text)
+		}
+
+		err := gtserror.Newf("db error putting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, acct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetRelay, relay.ID, relay.ActorURI)
+
+	follow, err := p.converter.RelayToASFollow(ctx, relay, instanceAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting relay to follow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if errWithCode := p.deliverToRelay(ctx, relay, follow); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayGet returns the relay with the given id.
+func (p *Processor) RelayGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.Relay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelaysGet returns all relays
+// this instance is subscribed to.
+func (p *Processor) RelaysGet(
+	ctx context.Context,
+) ([]*apimodel.Relay, gtserror.WithCode) {
+	relays, err := p.state.DB.GetRelays(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relays: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRelays := make([]*apimodel.Relay, 0, len(relays))
+	for _, relay := range relays {
+		apiRelay, errWithCode := p.apiRelay(ctx, relay)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiRelays = append(apiRelays, apiRelay)
+	}
+
+	return apiRelays, nil
+}
+
+// RelayUpdate updates the relay with the given id.
+// A disabled relay remains subscribed, but content is
+// neither accepted from nor delivered to the relay.
+func (p *Processor) RelayUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	enabled *bool,
+) (*apimodel.Relay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if enabled == nil || *enabled == *relay.Enabled {
+		// Nothing to update.
+		return p.apiRelay(ctx, relay)
+	}
+
+	relay.Enabled = enabled
+	if err := p.state.DB.UpdateRelay(ctx, relay, "enabled"); err != nil {
+		err := gtserror.Newf("db error updating relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetRelay, relay.ID, relay.ActorURI)
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayDelete unsubscribes from the relay with the
+// given id, sending an Undo of the relay Follow.
+func (p *Processor) RelayDelete(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.Relay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		err := gtserror.Newf("db error deleting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetRelay, relay.ID, relay.ActorURI)
+
+	if relay.State != gtsmodel.RelayStateRejected {
+		instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+		if err != nil {
+			err := gtserror.Newf("db error getting instance account: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		undo, err := p.converter.RelayToASUndoFollow(ctx, relay, instanceAcct)
+		if err != nil {
+			err := gtserror.Newf("error converting relay to undo: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if errWithCode := p.deliverToRelay(ctx, relay, undo); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// deliverToRelay delivers the given activity
+// to the relay inbox, from the instance account.
+func (p *Processor) deliverToRelay(
+	ctx context.Context,
+	relay *gtsmodel.Relay,
+	t vocab.Type,
+) gtserror.WithCode {
+	inboxIRI, err := url.Parse(relay.InboxURI)
+	if err != nil {
+		err := gtserror.Newf("error parsing relay inbox uri: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	m, err := ap.Serialize(t)
+	if err != nil {
+		err := gtserror.Newf("error serializing %T: %w", t, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Empty username gets
+	// instance account transport.
+	tsport, err := p.transport.NewTransportForUsername(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("error getting instance transport: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := tsport.Deliver(ctx, m, inboxIRI); err != nil {
+		err := gtserror.Newf("error delivering %T to relay inbox %s: %w", t, inboxIRI, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+func (p *Processor) getRelay(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.Relay, gtserror.WithCode) {
+	relay, err := p.state.DB.GetRelayByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relay %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if relay == nil {
+		err := fmt.Errorf("relay %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return relay, nil
+}
+
+func (p *Processor) apiRelay(
+	ctx context.Context,
+	relay *gtsmodel.Relay,
+) (*apimodel.Relay, gtserror.WithCode) {
+	apiRelay, err := p.converter.RelayToAPIRelay(ctx, relay)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting relay to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
@@ -147,6 +147,8 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, account, gtsmodel.AuditActionResolve, gtsmodel.AuditTargetReport, report.ID, report.ActionTaken)
+
 	// Process side effects of closing the report.
 	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
 		APObjectType:   ap.ActivityFlag,
@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// RetentionGet returns retention data for cohorts of
+// local users who signed up between start and end,
+// bucketed by frequency, which must be "day" or "month".
+// A user is active in a bucket if they logged in or
+// posted, or signed up, during it.
+func (p *Processor) RetentionGet(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+	frequency string,
+) ([]*apimodel.AdminCohort, gtserror.WithCode) {
+	// Get the start of
+	// the next bucket.
+	next := func(t time.Time) time.Time {
+		if frequency == "month" {
+			return t.AddDate(0, 1, 0)
+		}
+		return t.AddDate(0, 0, 1)
+	}
+
+	// Align start to
+	// a bucket start.
+	start = start.UTC()
+	if frequency == "month" {
+		start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	} else {
+		start = start.Truncate(day)
+	}
+
+	signups, err := p.state.DB.GetSignupActivity(ctx, start, end)
+	if err != nil {
+		err := gtserror.Newf("db error getting signup activity: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	activity, err := p.activeUserActivity(ctx, start, end)
+	if err != nil {
+		err := gtserror.Newf("db error getting active user activity: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Signing up counts as activity.
+	activity = append(activity, signups...)
+
+	// Get the bucket start
+	// times in the period.
+	var buckets []time.Time
+	for t := start; t.Before(end); t = next(t) {
+		buckets = append(buckets, t)
+	}
+
+	// Gather sets of accounts
+	// active in each bucket.
+	active := make([]map[string]struct{}, len(buckets))
+	for i, bucket := range buckets {
+		active[i] = make(map[string]struct{})
+		for _, a := range activity {
+			if !a.CreatedAt.Before(bucket) && a.CreatedAt.Before(next(bucket)) {
+				active[i][a.AccountID] = struct{}{}
+			}
+		}
+	}
+
+	cohorts := make([]*apimodel.AdminCohort, 0, len(buckets))
+	for i, period := range buckets {
+		// Cohort is users who
+		// signed up in period.
+		var cohort []string
+		for _, s := range signups {
+			if !s.CreatedAt.Before(period) && s.CreatedAt.Before(next(period)) {
+				cohort = append(cohort, s.AccountID)
+			}
+		}
+
+		apiCohort := &apimodel.AdminCohort{
+			Period:    util.FormatISO8601(period),
+			Frequency: frequency,
+			Data:      make([]apimodel.AdminCohortData, 0, len(buckets)-i),
+		}
+
+		// Count how many of the cohort were
+		// active in this and later buckets.
+		for j := i; j < len(buckets); j++ {
+			var value int
+			for _, accountID := range cohort {
+				if _, ok := active[j][accountID]; ok {
+					value++
+				}
+			}
+
+			var rate float64
+			if len(cohort) != 0 {
+				rate = float64(value) / float64(len(cohort))
+			}
+
+			apiCohort.Data = append(apiCohort.Data, apimodel.AdminCohortData{
+				Date:  util.FormatISO8601(buckets[j]),
+				Rate:  rate,
+				Value: strconv.Itoa(value),
+			})
+		}
+
+		cohorts = append(cohorts, apiCohort)
+	}
+
+	return cohorts, nil
+}
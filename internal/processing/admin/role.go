@@ -0,0 +1,293 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// RolesGet fetches all custom roles, sorted by name.
+func (p *Processor) RolesGet(ctx context.Context) ([]*apimodel.AdminRole, gtserror.WithCode) {
+	roles, err := p.state.DB.GetRoles(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRoles := make([]*apimodel.AdminRole, len(roles))
+	for i, role := range roles {
+		apiRoles[i] = p.converter.RoleToAdminAPIRole(role)
+	}
+
+	return apiRoles, nil
+}
+
+// RoleGet fetches the custom role with provided ID.
+func (p *Processor) RoleGet(ctx context.Context, id string) (*apimodel.AdminRole, gtserror.WithCode) {
+	role, errWithCode := p.getRole(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.RoleToAdminAPIRole(role), nil
+}
+
+// RoleCreate creates a new custom role with the given name and permissions.
+func (p *Processor) RoleCreate(ctx context.Context, adminAcct *gtsmodel.Account, form *apimodel.AdminRoleCreateRequest) (*apimodel.AdminRole, gtserror.WithCode) {
+	name := strings.TrimSpace(form.Name)
+	if err := validate.RoleName(name); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	permissions, errWithCode := parseRolePermissions(form.Permissions)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	role := &gtsmodel.Role{
+		ID:          id.NewULID(),
+		Name:        name,
+		Permissions: permissions,
+		Highlighted: util.Ptr(form.Highlighted),
+	}
+
+	if err := p.state.DB.PutRole(ctx, role); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "a role with this name already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err := gtserror.Newf("error inserting role: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetRole, role.ID, role.Name)
+
+	return p.converter.RoleToAdminAPIRole(role), nil
+}
+
+// RoleUpdate updates the name, permissions, and / or
+// highlighted status of the custom role with provided ID.
+func (p *Processor) RoleUpdate(ctx context.Context, adminAcct *gtsmodel.Account, id string, form *apimodel.AdminRoleUpdateRequest) (*apimodel.AdminRole, gtserror.WithCode) {
+	role, errWithCode := p.getRole(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := make([]string, 0, 3)
+
+	if form.Name != nil {
+		name := strings.TrimSpace(*form.Name)
+		if err := validate.RoleName(name); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		role.Name = name
+		columns = append(columns, "name")
+	}
+
+	if form.Permissions != nil {
+		permissions, errWithCode := parseRolePermissions(form.Permissions)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		role.Permissions = permissions
+		columns = append(columns, "permissions")
+	}
+
+	if form.Highlighted != nil {
+		role.Highlighted = util.Ptr(*form.Highlighted)
+		columns = append(columns, "highlighted")
+	}
+
+	if len(columns) == 0 {
+		const text = "empty form submitted"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if err := p.state.DB.UpdateRole(ctx, role, columns...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			const text = "a role with this name already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err := gtserror.Newf("error updating role: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetRole, role.ID, role.Name)
+
+	return p.converter.RoleToAdminAPIRole(role), nil
+}
+
+// RoleDelete deletes the custom role with provided
+// ID, taking it away from any users that had it.
+func (p *Processor) RoleDelete(ctx context.Context, adminAcct *gtsmodel.Account, id string) (*apimodel.AdminRole, gtserror.WithCode) {
+	role, errWithCode := p.getRole(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteRoleByID(ctx, role.ID); err != nil {
+		err := gtserror.Newf("error deleting role: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetRole, role.ID, role.Name)
+
+	return p.converter.RoleToAdminAPIRole(role), nil
+}
+
+// AccountRoleSet gives the custom role with roleID to the
+// local account with accountID. An empty roleID takes any
+// custom role away from the account.
+func (p *Processor) AccountRoleSet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+	roleID string,
+) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	user, err := p.state.DB.GetUserByAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting user for account id %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if user == nil {
+		err := fmt.Errorf("user for account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	var role *gtsmodel.Role
+	if roleID != "" {
+		var errWithCode gtserror.WithCode
+		role, errWithCode = p.getRole(ctx, roleID)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	user.RoleID = roleID
+	user.Role = role
+	if err := p.state.DB.UpdateUser(ctx, user, "role_id"); err != nil {
+		err := gtserror.Newf("db error updating user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var text string
+	if role != nil {
+		text = role.Name
+	}
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionAssignRole, gtsmodel.AuditTargetAccount, accountID, text)
+
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, user.Account)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s to admin api model: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// checkCanModerate checks that modAcct may act on the given
+// target user, if any (remote accounts have no user). Admins
+// may act on anyone. Users acting through a custom role may
+// not act on admins, nor on users whose custom role grants
+// permissions that the moderator's own role doesn't have.
+func (p *Processor) checkCanModerate(
+	ctx context.Context,
+	modAcct *gtsmodel.Account,
+	target *gtsmodel.User,
+) gtserror.WithCode {
+	if target == nil {
+		// Remote account.
+		return nil
+	}
+
+	modUser, err := p.state.DB.GetUserByAccountID(ctx, modAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account id %s: %w", modAcct.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if *modUser.Admin {
+		// Admins can act on anyone.
+		return nil
+	}
+
+	if *target.Admin {
+		const text = "only admins can take actions on admin accounts"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if target.Role != nil && !modUser.HasPermission(target.Role.Permissions) {
+		const text = "cannot take actions on accounts with permissions you don't have"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// getRole is a simple wrapper to get a custom role
+// from the database with provided ID, returning
+// appropriate errors.
+func (p *Processor) getRole(ctx context.Context, id string) (*gtsmodel.Role, gtserror.WithCode) {
+	role, err := p.state.DB.GetRoleByID(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return role, nil
+
+	// Role does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "role not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting role: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// parseRolePermissions parses the given API permission
+// names into a bitmap, erroring on any unknown names.
+func parseRolePermissions(names []string) (gtsmodel.RolePermissions, gtserror.WithCode) {
+	permissions := gtsmodel.RolePermissionsNone
+	for _, name := range names {
+		perm, ok := gtsmodel.ParseRolePermission(name)
+		if !ok {
+			err := fmt.Errorf("unknown role permission %s", name)
+			return 0, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		permissions |= perm
+	}
+	return permissions, nil
+}
@@ -0,0 +1,205 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type RoleTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *RoleTestSuite) TestRoleCreateAssignDelete() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+	)
+
+	role, errWithCode := suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name:        " Report Wrangler ",
+		Permissions: []string{"manage_reports", "VIEW_AUDIT_LOG"},
+		Highlighted: true,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("Report Wrangler", role.Name)
+	suite.Equal([]string{"view_audit_log", "manage_reports"}, role.Permissions)
+	suite.True(role.Highlighted)
+
+	// Give the role to an account.
+	account, errWithCode := suite.adminProcessor.AccountRoleSet(ctx, adminAcct, targetAcct.ID, role.ID)
+	suite.NoError(errWithCode)
+	suite.Equal(role.ID, account.Role.ID)
+	suite.Equal(apimodel.AccountRoleName("Report Wrangler"), account.Role.Name)
+	suite.Equal(
+		apimodel.AccountRolePermissionsViewAuditLog|apimodel.AccountRolePermissionsManageReports,
+		account.Role.Permissions,
+	)
+	suite.True(account.Role.Highlighted)
+
+	user, err := suite.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	suite.NoError(err)
+	suite.True(user.HasPermission(gtsmodel.RolePermissionManageReports))
+	suite.False(user.HasPermission(gtsmodel.RolePermissionManageFederation))
+
+	// Narrow the role's permissions.
+	_, errWithCode = suite.adminProcessor.RoleUpdate(ctx, adminAcct, role.ID, &apimodel.AdminRoleUpdateRequest{
+		Permissions: []string{"view_audit_log"},
+	})
+	suite.NoError(errWithCode)
+
+	user, err = suite.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	suite.NoError(err)
+	suite.False(user.HasPermission(gtsmodel.RolePermissionManageReports))
+
+	// Delete the role, user
+	// should go back to normal.
+	_, errWithCode = suite.adminProcessor.RoleDelete(ctx, adminAcct, role.ID)
+	suite.NoError(errWithCode)
+
+	account, errWithCode = suite.adminProcessor.AccountGet(ctx, targetAcct.ID)
+	suite.NoError(errWithCode)
+	suite.Equal(apimodel.AccountRoleUser, account.Role.Name)
+	suite.Equal(apimodel.AccountRolePermissionsNone, account.Role.Permissions)
+
+	// Everything should be logged.
+	resp, errWithCode := suite.adminProcessor.AuditLogGet(ctx,
+		"",
+		gtsmodel.AuditTargetAccount,
+		targetAcct.ID,
+		&paging.Page{},
+	)
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, 1)
+	assigned := resp.Items[0].(*apimodel.AdminAuditLogEntry)
+	suite.Equal(gtsmodel.AuditActionAssignRole, assigned.Action)
+	suite.Equal("Report Wrangler", assigned.Text)
+
+	resp, errWithCode = suite.adminProcessor.AuditLogGet(ctx,
+		"",
+		gtsmodel.AuditTargetRole,
+		role.ID,
+		&paging.Page{},
+	)
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, 3)
+}
+
+func (suite *RoleTestSuite) TestRoleCannotModerateHigherAccounts() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		modAcct   = suite.testAccounts["local_account_1"]
+		otherAcct = suite.testAccounts["local_account_2"]
+	)
+
+	// Give a user permission to manage users.
+	modRole, errWithCode := suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name:        "User Wrangler",
+		Permissions: []string{"manage_users"},
+	})
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.AccountRoleSet(ctx, adminAcct, modAcct.ID, modRole.ID)
+	suite.NoError(errWithCode)
+
+	// They shouldn't be able to act on an admin.
+	_, errWithCode = suite.adminProcessor.AccountAction(ctx, modAcct, &apimodel.AdminActionRequest{
+		Type:     gtsmodel.AdminActionSuspend.String(),
+		TargetID: adminAcct.ID,
+	})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.Equal("Forbidden: only admins can take actions on admin accounts", errWithCode.Safe())
+
+	// Nor on a user with permissions they lack.
+	otherRole, errWithCode := suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name:        "Report Wrangler",
+		Permissions: []string{"manage_reports", "manage_users"},
+	})
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.AccountRoleSet(ctx, adminAcct, otherAcct.ID, otherRole.ID)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.AccountAction(ctx, modAcct, &apimodel.AdminActionRequest{
+		Type:     gtsmodel.AdminActionDisable.String(),
+		TargetID: otherAcct.ID,
+	})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.Equal("Forbidden: cannot take actions on accounts with permissions you don't have", errWithCode.Safe())
+
+	// With the same permissions as
+	// the moderator, it's allowed.
+	_, errWithCode = suite.adminProcessor.AccountRoleSet(ctx, adminAcct, otherAcct.ID, modRole.ID)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.AccountAction(ctx, modAcct, &apimodel.AdminActionRequest{
+		Type:     gtsmodel.AdminActionSilence.String(),
+		TargetID: otherAcct.ID,
+	})
+	suite.NoError(errWithCode)
+
+	// Admins can act on anyone.
+	_, errWithCode = suite.adminProcessor.AccountAction(ctx, adminAcct, &apimodel.AdminActionRequest{
+		Type:     gtsmodel.AdminActionSilence.String(),
+		TargetID: modAcct.ID,
+	})
+	suite.NoError(errWithCode)
+}
+
+func (suite *RoleTestSuite) TestRoleCreateInvalid() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name: "admin",
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: role name admin is reserved for a built-in role", errWithCode.Safe())
+
+	_, errWithCode = suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name:        "Wizard",
+		Permissions: []string{"manage_everything"},
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: unknown role permission manage_everything", errWithCode.Safe())
+
+	_, errWithCode = suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name: "Wizard",
+	})
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name: "Wizard",
+	})
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+}
+
+func TestRoleTestSuite(t *testing.T) {
+	suite.Run(t, &RoleTestSuite{})
+}
@@ -62,7 +62,7 @@ func (p *Processor) RuleGet(ctx context.Context, id string) (*apimodel.AdminInst
 }
 
 // RuleCreate adds a new rule to the instance.
-func (p *Processor) RuleCreate(ctx context.Context, form *apimodel.InstanceRuleCreateRequest) (*apimodel.AdminInstanceRule, gtserror.WithCode) {
+func (p *Processor) RuleCreate(ctx context.Context, adminAcct *gtsmodel.Account, form *apimodel.InstanceRuleCreateRequest) (*apimodel.AdminInstanceRule, gtserror.WithCode) {
 	ruleID, err := id.NewRandomULID()
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating id for new instance rule: %s", err), "error creating rule ID")
@@ -77,11 +77,13 @@ func (p *Processor) RuleCreate(ctx context.Context, form *apimodel.InstanceRuleC
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetRule, rule.ID, rule.Text)
+
 	return p.converter.InstanceRuleToAdminAPIRule(rule), nil
 }
 
 // RuleUpdate updates text for an existing rule.
-func (p *Processor) RuleUpdate(ctx context.Context, id string, form *apimodel.InstanceRuleCreateRequest) (*apimodel.AdminInstanceRule, gtserror.WithCode) {
+func (p *Processor) RuleUpdate(ctx context.Context, adminAcct *gtsmodel.Account, id string, form *apimodel.InstanceRuleCreateRequest) (*apimodel.AdminInstanceRule, gtserror.WithCode) {
 	rule, err := p.state.DB.GetRuleByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, db.ErrNoEntries) {
@@ -99,11 +101,13 @@ func (p *Processor) RuleUpdate(ctx context.Context, id string, form *apimodel.In
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetRule, rule.ID, rule.Text)
+
 	return p.converter.InstanceRuleToAdminAPIRule(updatedRule), nil
 }
 
 // RuleDelete deletes an existing rule.
-func (p *Processor) RuleDelete(ctx context.Context, id string) (*apimodel.AdminInstanceRule, gtserror.WithCode) {
+func (p *Processor) RuleDelete(ctx context.Context, adminAcct *gtsmodel.Account, id string) (*apimodel.AdminInstanceRule, gtserror.WithCode) {
 	rule, err := p.state.DB.GetRuleByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, db.ErrNoEntries) {
@@ -120,5 +124,7 @@ func (p *Processor) RuleDelete(ctx context.Context, id string) (*apimodel.AdminI
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetRule, rule.ID, "")
+
 	return p.converter.InstanceRuleToAdminAPIRule(deletedRule), nil
 }
@@ -46,6 +46,10 @@ func (p *Processor) SignupApprove(
 		return nil, gtserror.NewErrorNotFound(err, err.Error())
 	}
 
+	if errWithCode := p.checkCanModerate(ctx, adminAcct, user); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Get a lock on the account URI,
 	// to ensure it's not also being
 	// rejected at the same time!
@@ -64,6 +68,8 @@ func (p *Processor) SignupApprove(
 			Origin:         adminAcct,
 			Target:         user.Account,
 		})
+
+		p.LogAction(ctx, adminAcct, gtsmodel.AuditActionApprove, gtsmodel.AuditTargetAccount, accountID, "")
 	}
 
 	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, user.Account)
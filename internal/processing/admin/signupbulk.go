@@ -0,0 +1,245 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// signupsFilter selects pending
+// sign-ups for bulk actions.
+type signupsFilter struct {
+	emailDomain   string
+	ip            netip.Prefix
+	createdAfter  time.Time
+	createdBefore time.Time
+}
+
+// SignupsApprove approves all pending sign-ups
+// matching the filters in the given request,
+// returning the outcome for each of them.
+//
+// A failure to approve one sign-up doesn't stop
+// the others from being approved; it's reported
+// in the result for that sign-up instead.
+func (p *Processor) SignupsApprove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	request *apimodel.AdminSignupsBulkRequest,
+) ([]*apimodel.AdminSignupsBulkResult, gtserror.WithCode) {
+	users, errWithCode := p.filterSignups(ctx, request)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	results := make([]*apimodel.AdminSignupsBulkResult, 0, len(users))
+	for _, user := range users {
+		account, errWithCode := p.SignupApprove(ctx, adminAcct, user.AccountID)
+		if errWithCode != nil {
+			log.Warnf(ctx, "error approving sign-up for account %s: %v", user.AccountID, errWithCode)
+		}
+		results = append(results, signupsBulkResult(user, account, errWithCode))
+	}
+
+	return results, nil
+}
+
+// SignupsReject rejects all pending sign-ups
+// matching the filters in the given request,
+// returning the outcome for each of them.
+//
+// A failure to reject one sign-up doesn't stop
+// the others from being rejected; it's reported
+// in the result for that sign-up instead.
+//
+// If the request's message contains "{username}",
+// it's replaced with each applicant's username.
+func (p *Processor) SignupsReject(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	request *apimodel.AdminSignupsBulkRequest,
+) ([]*apimodel.AdminSignupsBulkResult, gtserror.WithCode) {
+	users, errWithCode := p.filterSignups(ctx, request)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	results := make([]*apimodel.AdminSignupsBulkResult, 0, len(users))
+	for _, user := range users {
+		message := strings.ReplaceAll(
+			request.Message,
+			"{username}",
+			user.Account.Username,
+		)
+
+		account, errWithCode := p.SignupReject(
+			ctx,
+			adminAcct,
+			user.AccountID,
+			request.PrivateComment,
+			request.SendEmail,
+			message,
+		)
+		if errWithCode != nil {
+			log.Warnf(ctx, "error rejecting sign-up for account %s: %v", user.AccountID, errWithCode)
+		}
+		results = append(results, signupsBulkResult(user, account, errWithCode))
+	}
+
+	return results, nil
+}
+
+// signupsBulkResult returns the result of a bulk
+// action for the given pending sign-up user.
+func signupsBulkResult(
+	user *gtsmodel.User,
+	account *apimodel.AdminAccountInfo,
+	errWithCode gtserror.WithCode,
+) *apimodel.AdminSignupsBulkResult {
+	result := &apimodel.AdminSignupsBulkResult{
+		AccountID: user.AccountID,
+		Account:   account,
+	}
+
+	if errWithCode != nil {
+		result.Account = nil
+		result.Error = errWithCode.Safe()
+	}
+
+	return result
+}
+
+// filterSignups returns the users of all pending
+// sign-ups matching the filters in the given request.
+func (p *Processor) filterSignups(
+	ctx context.Context,
+	request *apimodel.AdminSignupsBulkRequest,
+) ([]*gtsmodel.User, gtserror.WithCode) {
+	filter, errWithCode := parseSignupsFilter(request)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	users, err := p.state.DB.GetUnhandledSignups(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting pending sign-ups: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Filter in place.
+	matched := users[:0]
+	for _, user := range users {
+		if filter.matches(user) {
+			matched = append(matched, user)
+		}
+	}
+
+	return matched, nil
+}
+
+// parseSignupsFilter parses and checks the filters
+// of a bulk sign-ups request. At least one is required,
+// to avoid acting on the whole backlog by accident.
+func parseSignupsFilter(request *apimodel.AdminSignupsBulkRequest) (*signupsFilter, gtserror.WithCode) {
+	var (
+		filter = new(signupsFilter)
+		err    error
+	)
+
+	filter.emailDomain = strings.ToLower(strings.TrimPrefix(
+		strings.TrimSpace(request.EmailDomain), "@",
+	))
+
+	if request.IP != "" {
+		filter.ip, err = parseIPRange(request.IP)
+		if err != nil {
+			text := fmt.Sprintf("invalid ip: %v", err)
+			return nil, gtserror.NewErrorBadRequest(err, text)
+		}
+	}
+
+	if request.CreatedAfter != "" {
+		filter.createdAfter, err = time.Parse(time.RFC3339, request.CreatedAfter)
+		if err != nil {
+			text := fmt.Sprintf("invalid created_after: %v", err)
+			return nil, gtserror.NewErrorBadRequest(err, text)
+		}
+	}
+
+	if request.CreatedBefore != "" {
+		filter.createdBefore, err = time.Parse(time.RFC3339, request.CreatedBefore)
+		if err != nil {
+			text := fmt.Sprintf("invalid created_before: %v", err)
+			return nil, gtserror.NewErrorBadRequest(err, text)
+		}
+	}
+
+	if filter.emailDomain == "" &&
+		!filter.ip.IsValid() &&
+		filter.createdAfter.IsZero() &&
+		filter.createdBefore.IsZero() {
+		const text = "at least one of email_domain, ip, created_after, or created_before must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return filter, nil
+}
+
+// matches returns whether the given
+// pending sign-up user matches filter.
+func (f *signupsFilter) matches(user *gtsmodel.User) bool {
+	if f.emailDomain != "" {
+		email := user.Email
+		if email == "" {
+			// Not yet confirmed.
+			email = user.UnconfirmedEmail
+		}
+
+		_, domain, _ := strings.Cut(email, "@")
+		if !strings.EqualFold(domain, f.emailDomain) {
+			return false
+		}
+	}
+
+	if f.ip.IsValid() {
+		addr, ok := netip.AddrFromSlice(user.SignUpIP)
+		if !ok || !f.ip.Contains(addr.Unmap()) {
+			return false
+		}
+	}
+
+	if !f.createdAfter.IsZero() && !user.CreatedAt.After(f.createdAfter) {
+		return false
+	}
+
+	if !f.createdBefore.IsZero() && !user.CreatedAt.Before(f.createdBefore) {
+		return false
+	}
+
+	return true
+}
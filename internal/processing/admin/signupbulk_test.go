@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SignupBulkTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *SignupBulkTestSuite) TestSignupsApproveNoFilter() {
+	_, errWithCode := suite.adminProcessor.SignupsApprove(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminSignupsBulkRequest{},
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *SignupBulkTestSuite) TestSignupsApproveNoMatch() {
+	results, errWithCode := suite.adminProcessor.SignupsApprove(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminSignupsBulkRequest{
+			EmailDomain:  "example.org",
+			CreatedAfter: "2023-01-01T00:00:00Z",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(results)
+}
+
+func (suite *SignupBulkTestSuite) TestSignupsRejectByIP() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["unconfirmed_account"]
+		targetUser = suite.testUsers["unconfirmed_account"]
+	)
+
+	results, errWithCode := suite.adminProcessor.SignupsReject(
+		ctx,
+		adminAcct,
+		&apimodel.AdminSignupsBulkRequest{
+			IP:        "199.222.111.0/24",
+			SendEmail: true,
+			Message:   "Sorry {username}, we're full.",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if suite.Len(results, 1) {
+		suite.Equal(targetAcct.ID, results[0].AccountID)
+		suite.Empty(results[0].Error)
+		if suite.NotNil(results[0].Account) {
+			suite.Equal(targetAcct.ID, results[0].Account.ID)
+			suite.False(results[0].Account.Approved)
+		}
+	}
+
+	// Wait for processor to
+	// handle side effects.
+	var (
+		deniedUser *gtsmodel.DeniedUser
+		err        error
+	)
+	if !testrig.WaitFor(func() bool {
+		deniedUser, err = suite.state.DB.GetDeniedUserByID(ctx, targetUser.ID)
+		return deniedUser != nil && err == nil
+	}) {
+		suite.FailNow("waiting for denied user")
+	}
+
+	// Message should have the
+	// username filled in.
+	suite.Equal("Sorry "+targetAcct.Username+", we're full.", deniedUser.Message)
+}
+
+func (suite *SignupBulkTestSuite) TestSignupsApprovePartialFailure() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		modAcct    = suite.testAccounts["local_account_1"]
+		targetAcct = suite.testAccounts["unconfirmed_account"]
+	)
+
+	// Give a user permission to manage users.
+	modRole, errWithCode := suite.adminProcessor.RoleCreate(ctx, adminAcct, &apimodel.AdminRoleCreateRequest{
+		Name:        "User Wrangler",
+		Permissions: []string{"manage_users"},
+	})
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.AccountRoleSet(ctx, adminAcct, modAcct.ID, modRole.ID)
+	suite.NoError(errWithCode)
+
+	// Add a pending sign-up for an admin from the
+	// same range as the unconfirmed account, which
+	// the moderator won't be allowed to approve.
+	adminUser, err := suite.state.DB.NewSignup(ctx, gtsmodel.NewSignup{
+		Username: "pending_admin",
+		Email:    "pending_admin@example.org",
+		Password: "a very long and very secure password",
+		SignUpIP: net.ParseIP("199.222.111.90"),
+		Admin:    true,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	results, errWithCode := suite.adminProcessor.SignupsApprove(
+		ctx,
+		modAcct,
+		&apimodel.AdminSignupsBulkRequest{
+			IP: "199.222.111.0/24",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Both sign-ups should have a result, and the failure
+	// shouldn't have stopped the other being approved.
+	if !suite.Len(results, 2) {
+		suite.FailNow("")
+	}
+
+	for _, result := range results {
+		switch result.AccountID {
+		case targetAcct.ID:
+			suite.Empty(result.Error)
+			if suite.NotNil(result.Account) {
+				suite.True(result.Account.Approved)
+			}
+
+		case adminUser.AccountID:
+			suite.Equal("Forbidden: only admins can take actions on admin accounts", result.Error)
+			suite.Nil(result.Account)
+
+		default:
+			suite.FailNow("unexpected account " + result.AccountID)
+		}
+	}
+}
+
+func TestSignupBulkTestSuite(t *testing.T) {
+	suite.Run(t, new(SignupBulkTestSuite))
+}
@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// GetSignupQuestions fetches all sign-up questions, in the order they're asked.
+func (p *Processor) GetSignupQuestions(ctx context.Context) ([]apimodel.SignupQuestion, gtserror.WithCode) {
+	questions, err := p.state.DB.GetSignupQuestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiQuestions := make([]apimodel.SignupQuestion, len(questions))
+	for i, question := range questions {
+		apiQuestions[i] = p.converter.SignupQuestionToAPISignupQuestion(question)
+	}
+
+	return apiQuestions, nil
+}
+
+// GetSignupQuestion fetches the sign-up question with provided ID.
+func (p *Processor) GetSignupQuestion(ctx context.Context, id string) (*apimodel.SignupQuestion, gtserror.WithCode) {
+	question, errWithCode := p.getSignupQuestion(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiQuestion := p.converter.SignupQuestionToAPISignupQuestion(question)
+	return &apiQuestion, nil
+}
+
+// CreateSignupQuestion adds a new sign-up question, which
+// will be asked after any existing sign-up questions.
+func (p *Processor) CreateSignupQuestion(ctx context.Context, adminAcct *gtsmodel.Account, form *apimodel.SignupQuestionCreateRequest) (*apimodel.SignupQuestion, gtserror.WithCode) {
+	text := strings.TrimSpace(form.Text)
+	if err := validate.SignupQuestion(text); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	question := &gtsmodel.SignupQuestion{
+		ID:   id.NewULID(),
+		Text: text,
+	}
+
+	if err := p.state.DB.PutSignupQuestion(ctx, question); err != nil {
+		err := gtserror.Newf("error inserting sign-up question: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetSignupQuestion, question.ID, question.Text)
+
+	apiQuestion := p.converter.SignupQuestionToAPISignupQuestion(question)
+	return &apiQuestion, nil
+}
+
+// UpdateSignupQuestion updates the text of the sign-up question with provided ID.
+// Answers already given keep the question text they were given for.
+func (p *Processor) UpdateSignupQuestion(ctx context.Context, adminAcct *gtsmodel.Account, id string, form *apimodel.SignupQuestionUpdateRequest) (*apimodel.SignupQuestion, gtserror.WithCode) {
+	question, errWithCode := p.getSignupQuestion(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	text := strings.TrimSpace(form.Text)
+	if err := validate.SignupQuestion(text); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	question.Text = text
+	if err := p.state.DB.UpdateSignupQuestion(ctx, question, "text"); err != nil {
+		err := gtserror.Newf("error updating sign-up question: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetSignupQuestion, question.ID, question.Text)
+
+	apiQuestion := p.converter.SignupQuestionToAPISignupQuestion(question)
+	return &apiQuestion, nil
+}
+
+// DeleteSignupQuestion deletes the sign-up question with provided ID.
+// Answers already given to the question are kept.
+func (p *Processor) DeleteSignupQuestion(ctx context.Context, adminAcct *gtsmodel.Account, id string) (*apimodel.SignupQuestion, gtserror.WithCode) {
+	question, errWithCode := p.getSignupQuestion(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteSignupQuestion(ctx, question.ID); err != nil {
+		err := gtserror.Newf("error deleting sign-up question: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetSignupQuestion, question.ID, "")
+
+	apiQuestion := p.converter.SignupQuestionToAPISignupQuestion(question)
+	return &apiQuestion, nil
+}
+
+// getSignupQuestion is a simple wrapper to get sign-up question
+// from the database with provided ID, returning appropriate errors.
+func (p *Processor) getSignupQuestion(ctx context.Context, id string) (*gtsmodel.SignupQuestion, gtserror.WithCode) {
+	question, err := p.state.DB.GetSignupQuestion(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return question, nil
+
+	// Question does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "sign-up question not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
@@ -49,6 +49,10 @@ func (p *Processor) SignupReject(
 		return nil, gtserror.NewErrorNotFound(err, err.Error())
 	}
 
+	if errWithCode := p.checkCanModerate(ctx, adminAcct, user); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Get a lock on the account URI,
 	// since we're going to be deleting
 	// it and its associated user.
@@ -112,5 +116,7 @@ func (p *Processor) SignupReject(
 		Target:         user.Account,
 	})
 
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionReject, gtsmodel.AuditTargetAccount, accountID, privateComment)
+
 	return apiAccount, nil
 }
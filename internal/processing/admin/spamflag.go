@@ -0,0 +1,184 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// SpamFlagGet returns the spam flag with the given id.
+func (p *Processor) SpamFlagGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.AdminSpamFlag, gtserror.WithCode) {
+	flag, errWithCode := p.getSpamFlag(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiSpamFlag(ctx, flag)
+}
+
+// SpamFlagsGet returns a page of spam flags, optionally
+// filtered to statuses authored by the given account ID.
+func (p *Processor) SpamFlagsGet(
+	ctx context.Context,
+	accountID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	flags, err := p.state.DB.GetSpamFlags(ctx, accountID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting spam flags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(flags)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := flags[count-1].ID
+	hi := flags[0].ID
+
+	// Convert each flag to API model.
+	items := make([]any, len(flags))
+	for i, flag := range flags {
+		apiFlag, errWithCode := p.apiSpamFlag(ctx, flag)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiFlag
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if accountID != "" {
+		query.Set(apiutil.AccountIDKey, accountID)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/spam_flags",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// SpamFlagFalsePositive marks the spam flag with the given
+// id as a false positive. This exempts the author of the
+// flagged status from spam scoring from now on, and queues
+// the flagged status to be fetched and delivered again.
+func (p *Processor) SpamFlagFalsePositive(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminSpamFlag, gtserror.WithCode) {
+	flag, errWithCode := p.getSpamFlag(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if flag.IsFalsePositive() {
+		err := fmt.Errorf("spam flag %s has already been marked as a false positive", id)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	flag.FalsePositiveAt = time.Now()
+	flag.FalsePositiveByAccountID = adminAcct.ID
+	flag.FalsePositiveByAccount = adminAcct
+
+	if err := p.state.DB.UpdateSpamFlag(ctx, flag,
+		"false_positive_at",
+		"false_positive_by_account_id",
+	); err != nil {
+		err := gtserror.Newf("db error updating spam flag: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionFalsePositive, gtsmodel.AuditTargetSpamFlag, flag.ID, "")
+
+	// Redeliver the status to the account it was
+	// originally meant for, by handling it as though
+	// it was forwarded to them. This will dereference
+	// the status again from its origin, and won't be
+	// caught by the spam score now the author is exempt.
+	statusURI, err := url.Parse(flag.StatusURI)
+	if err != nil {
+		// Not fatal, the flag is
+		// already marked, so just log.
+		log.Errorf(ctx, "error parsing spam flag status uri: %v", err)
+	} else {
+		p.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			APIRI:          statusURI,
+			Receiving:      flag.TargetAccount,
+			Requesting:     flag.Account,
+		})
+	}
+
+	return p.apiSpamFlag(ctx, flag)
+}
+
+func (p *Processor) getSpamFlag(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.SpamFlag, gtserror.WithCode) {
+	flag, err := p.state.DB.GetSpamFlagByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting spam flag %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if flag == nil {
+		err := fmt.Errorf("spam flag %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return flag, nil
+}
+
+func (p *Processor) apiSpamFlag(
+	ctx context.Context,
+	flag *gtsmodel.SpamFlag,
+) (*apimodel.AdminSpamFlag, gtserror.WithCode) {
+	apiFlag, err := p.converter.SpamFlagToAdminAPISpamFlag(ctx, flag)
+	if err != nil {
+		err := gtserror.Newf("error converting spam flag to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFlag, nil
+}
@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type SpamFlagTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *SpamFlagTestSuite) TestSpamFlagFalsePositive() {
+	var (
+		ctx        = context.Background()
+		adminAcct  = suite.testAccounts["admin_account"]
+		authorAcct = suite.testAccounts["remote_account_1"]
+		targetAcct = suite.testAccounts["local_account_1"]
+	)
+
+	flag := &gtsmodel.SpamFlag{
+		ID:              id.NewULID(),
+		StatusURI:       "http://fossbros-anonymous.io/users/foss_satan/statuses/01J9SBYB47R3NGH3C4PKPQX1AP",
+		AccountID:       authorAcct.ID,
+		TargetAccountID: targetAcct.ID,
+		Score:           6,
+		Reasons:         []string{"5 mentions", "new account"},
+		SinBinned:       util.Ptr(true),
+	}
+	if err := suite.state.DB.PutSpamFlag(ctx, flag); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiFlag, errWithCode := suite.adminProcessor.SpamFlagGet(ctx, flag.ID)
+	suite.NoError(errWithCode)
+	suite.Equal("sin_bin", apiFlag.Action)
+	suite.Equal(flag.Reasons, apiFlag.Reasons)
+	suite.Empty(apiFlag.FalsePositiveAt)
+
+	apiFlag, errWithCode = suite.adminProcessor.SpamFlagFalsePositive(ctx, adminAcct, flag.ID)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(apiFlag.FalsePositiveAt)
+	suite.Equal(adminAcct.ID, apiFlag.FalsePositiveByAccount.ID)
+
+	// Author should now be exempt from scoring.
+	count, err := suite.state.DB.CountSpamFalsePositives(ctx, authorAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, count)
+
+	// Can't mark it twice.
+	_, errWithCode = suite.adminProcessor.SpamFlagFalsePositive(ctx, adminAcct, flag.ID)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestSpamFlagTestSuite(t *testing.T) {
+	suite.Run(t, &SpamFlagTestSuite{})
+}
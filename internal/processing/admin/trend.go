@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// TrendsGet returns currently trending items of the
+// given type, most popular first, in any review state.
+func (p *Processor) TrendsGet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	trendType gtsmodel.TrendType,
+	limit int,
+	offset int,
+) ([]*apimodel.AdminTrend, gtserror.WithCode) {
+	trends, err := p.state.DB.GetTrends(ctx, trendType, nil, true, limit, offset)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting trends: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTrends := make([]*apimodel.AdminTrend, 0, len(trends))
+	for _, trend := range trends {
+		if err := p.state.DB.PopulateTrend(ctx, trend); err != nil {
+			log.Errorf(ctx, "error populating trend %s: %v", trend.ID, err)
+			continue
+		}
+
+		apiTrend, err := p.converter.TrendToAdminAPITrend(ctx, trend, adminAcct)
+		if err != nil {
+			log.Errorf(ctx, "error converting trend %s to admin api trend: %v", trend.ID, err)
+			continue
+		}
+
+		apiTrends = append(apiTrends, apiTrend)
+	}
+
+	return apiTrends, nil
+}
+
+// TrendReview approves or rejects the trend of the given
+// type with the given ID, on behalf of the given admin.
+func (p *Processor) TrendReview(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	trendType gtsmodel.TrendType,
+	trendID string,
+	approve bool,
+) (*apimodel.AdminTrend, gtserror.WithCode) {
+	trend, err := p.state.DB.GetTrendByID(ctx, trendID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting trend %s: %w", trendID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if trend == nil || trend.Type != trendType {
+		err := gtserror.Newf("no %s trend found with id %s", trendType, trendID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if approve {
+		trend.ReviewState = gtsmodel.TrendReviewApproved
+	} else {
+		trend.ReviewState = gtsmodel.TrendReviewRejected
+	}
+	trend.ReviewedAt = time.Now()
+	trend.ReviewedByAccountID = adminAcct.ID
+
+	if err := p.state.DB.UpdateTrend(
+		ctx,
+		trend,
+		"review_state",
+		"reviewed_at",
+		"reviewed_by_account_id",
+	); err != nil {
+		err := gtserror.Newf("db error updating trend %s: %w", trendID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	action := gtsmodel.AuditActionReject
+	if approve {
+		action = gtsmodel.AuditActionApprove
+	}
+	p.LogAction(ctx, adminAcct, action, gtsmodel.AuditTargetTrend, trend.ID, trendType.String())
+
+	if err := p.state.DB.PopulateTrend(ctx, trend); err != nil {
+		err := gtserror.Newf("error populating trend %s: %w", trendID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTrend, err := p.converter.TrendToAdminAPITrend(ctx, trend, adminAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting trend %s: %w", trendID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiTrend, nil
+}
@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/webhook"
+)
+
+// WebhooksGet fetches all webhooks, oldest first.
+func (p *Processor) WebhooksGet(ctx context.Context) ([]*apimodel.AdminWebhook, gtserror.WithCode) {
+	webhooks, err := p.state.DB.GetWebhooks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Only handle errors other than not-found types.
+		err := gtserror.Newf("error selecting from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiWebhooks := make([]*apimodel.AdminWebhook, len(webhooks))
+	for i, webhook := range webhooks {
+		apiWebhooks[i] = p.converter.WebhookToAdminAPIWebhook(webhook)
+	}
+
+	return apiWebhooks, nil
+}
+
+// WebhookGet fetches the webhook with provided ID.
+func (p *Processor) WebhookGet(ctx context.Context, id string) (*apimodel.AdminWebhook, gtserror.WithCode) {
+	webhook, errWithCode := p.getWebhook(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.WebhookToAdminAPIWebhook(webhook), nil
+}
+
+// WebhookCreate creates a new, enabled webhook with the
+// given URL and events, and a newly generated secret.
+func (p *Processor) WebhookCreate(ctx context.Context, adminAcct *gtsmodel.Account, form *apimodel.AdminWebhookCreateRequest) (*apimodel.AdminWebhook, gtserror.WithCode) {
+	webhookURL, errWithCode := parseWebhookURL(form.URL)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	events, errWithCode := parseWebhookEvents(form.Events)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		err := gtserror.Newf("error generating webhook secret: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	webhook := &gtsmodel.Webhook{
+		ID:                 id.NewULID(),
+		URL:                webhookURL,
+		Events:             events,
+		Secret:             secret,
+		Enabled:            util.Ptr(true),
+		CreatedByAccountID: adminAcct.ID,
+	}
+
+	if err := p.state.DB.PutWebhook(ctx, webhook); err != nil {
+		err := gtserror.Newf("error inserting webhook: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetWebhook, webhook.ID, webhook.URL)
+
+	return p.converter.WebhookToAdminAPIWebhook(webhook), nil
+}
+
+// WebhookUpdate updates the URL, events, and / or
+// enabled status of the webhook with provided ID.
+func (p *Processor) WebhookUpdate(ctx context.Context, adminAcct *gtsmodel.Account, id string, form *apimodel.AdminWebhookUpdateRequest) (*apimodel.AdminWebhook, gtserror.WithCode) {
+	webhook, errWithCode := p.getWebhook(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := make([]string, 0, 3)
+
+	if form.URL != nil {
+		webhookURL, errWithCode := parseWebhookURL(*form.URL)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		webhook.URL = webhookURL
+		columns = append(columns, "url")
+	}
+
+	if form.Events != nil {
+		events, errWithCode := parseWebhookEvents(form.Events)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		webhook.Events = events
+		columns = append(columns, "events")
+	}
+
+	if form.Enabled != nil {
+		webhook.Enabled = util.Ptr(*form.Enabled)
+		columns = append(columns, "enabled")
+	}
+
+	if len(columns) == 0 {
+		const text = "empty form submitted"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if err := p.state.DB.UpdateWebhook(ctx, webhook, columns...); err != nil {
+		err := gtserror.Newf("error updating webhook: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetWebhook, webhook.ID, webhook.URL)
+
+	return p.converter.WebhookToAdminAPIWebhook(webhook), nil
+}
+
+// WebhookDelete deletes the webhook with provided ID,
+// dropping any of its deliveries still waiting to be sent.
+func (p *Processor) WebhookDelete(ctx context.Context, adminAcct *gtsmodel.Account, id string) (*apimodel.AdminWebhook, gtserror.WithCode) {
+	webhook, errWithCode := p.getWebhook(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteWebhookByID(ctx, webhook.ID); err != nil {
+		err := gtserror.Newf("error deleting webhook: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Drop queued deliveries to this webhook.
+	p.state.Workers.Webhook.Queue.Delete("TargetID", webhook.ID)
+
+	p.LogAction(ctx, adminAcct, gtsmodel.AuditActionDelete, gtsmodel.AuditTargetWebhook, webhook.ID, webhook.URL)
+
+	return p.converter.WebhookToAdminAPIWebhook(webhook), nil
+}
+
+// getWebhook is a simple wrapper to get a webhook
+// from the database with provided ID, returning
+// appropriate errors.
+func (p *Processor) getWebhook(ctx context.Context, id string) (*gtsmodel.Webhook, gtserror.WithCode) {
+	webhook, err := p.state.DB.GetWebhookByID(ctx, id)
+
+	switch {
+	// Successfully found.
+	case err == nil:
+		return webhook, nil
+
+	// Webhook does not exist with ID.
+	case errors.Is(err, db.ErrNoEntries):
+		const text = "webhook not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+
+	// Any other error type.
+	default:
+		err := gtserror.Newf("error selecting webhook: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+}
+
+// parseWebhookURL checks that the given
+// webhook URL is a valid http(s) URL.
+func parseWebhookURL(rawURL string) (string, gtserror.WithCode) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		const text = "url must be a valid http or https url"
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return u.String(), nil
+}
+
+// parseWebhookEvents checks that the given webhook events
+// are all known, returning them sorted and deduplicated.
+func parseWebhookEvents(events []string) ([]string, gtserror.WithCode) {
+	if len(events) == 0 {
+		const text = "at least one event must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	for _, event := range events {
+		if !slices.Contains(gtsmodel.WebhookEvents, event) {
+			err := fmt.Errorf("unknown webhook event %s", event)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	events = slices.Clone(events)
+	slices.Sort(events)
+	return slices.Compact(events), nil
+}
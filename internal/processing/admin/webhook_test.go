@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type WebhookTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *WebhookTestSuite) TestWebhookDomainBlockCreated() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		queue     = &suite.state.Workers.Webhook.Queue
+	)
+
+	webhook, errWithCode := suite.adminProcessor.WebhookCreate(ctx, adminAcct, &apimodel.AdminWebhookCreateRequest{
+		URL: "https://chat.example.org/hooks/federation",
+		Events: []string{
+			gtsmodel.WebhookEventDomainBlockCreated,
+			gtsmodel.WebhookEventDomainBlockCreated,
+		},
+	})
+	suite.NoError(errWithCode)
+	suite.Equal([]string{gtsmodel.WebhookEventDomainBlockCreated}, webhook.Events)
+	suite.NotEmpty(webhook.Secret)
+	suite.True(webhook.Enabled)
+
+	// Block a new domain, this should
+	// queue a delivery to the webhook.
+	_, _, errWithCode = suite.adminProcessor.DomainPermissionCreate(
+		ctx,
+		gtsmodel.DomainPermissionBlock,
+		adminAcct,
+		"baddies.example.org",
+		false,
+		"",
+		"",
+		"",
+	)
+	suite.NoError(errWithCode)
+	suite.Equal(1, queue.Len())
+
+	// Disable the webhook, another
+	// block shouldn't be delivered.
+	disabled := false
+	_, errWithCode = suite.adminProcessor.WebhookUpdate(ctx, adminAcct, webhook.ID, &apimodel.AdminWebhookUpdateRequest{
+		Enabled: &disabled,
+	})
+	suite.NoError(errWithCode)
+
+	_, _, errWithCode = suite.adminProcessor.DomainPermissionCreate(
+		ctx,
+		gtsmodel.DomainPermissionBlock,
+		adminAcct,
+		"more.baddies.example.org",
+		false,
+		"",
+		"",
+		"",
+	)
+	suite.NoError(errWithCode)
+	suite.Equal(1, queue.Len())
+
+	// Deleting the webhook should
+	// drop its queued delivery.
+	_, errWithCode = suite.adminProcessor.WebhookDelete(ctx, adminAcct, webhook.ID)
+	suite.NoError(errWithCode)
+	suite.Equal(0, queue.Len())
+}
+
+func (suite *WebhookTestSuite) TestWebhookCreateInvalid() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.WebhookCreate(ctx, adminAcct, &apimodel.AdminWebhookCreateRequest{
+		URL:    "ftp://chat.example.org/hooks",
+		Events: []string{gtsmodel.WebhookEventReportCreated},
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: url must be a valid http or https url", errWithCode.Safe())
+
+	_, errWithCode = suite.adminProcessor.WebhookCreate(ctx, adminAcct, &apimodel.AdminWebhookCreateRequest{
+		URL: "https://chat.example.org/hooks",
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: at least one event must be provided", errWithCode.Safe())
+
+	_, errWithCode = suite.adminProcessor.WebhookCreate(ctx, adminAcct, &apimodel.AdminWebhookCreateRequest{
+		URL:    "https://chat.example.org/hooks",
+		Events: []string{"status.created"},
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: unknown webhook event status.created", errWithCode.Safe())
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, &WebhookTestSuite{})
+}
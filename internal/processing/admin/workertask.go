@@ -19,8 +19,8 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"slices"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -47,92 +47,212 @@
 func (p *Processor) FillWorkerQueues(ctx context.Context) error {
 	log.Info(ctx, "rehydrate!")
 
-	// Get all persisted worker tasks from db.
-	//
-	// (database returns these as ASCENDING, i.e.
-	// returned in the order they were inserted).
-	tasks, err := p.state.DB.GetWorkerTasks(ctx)
-	if err != nil {
-		return gtserror.Newf("error fetching worker tasks from db: %w", err)
-	}
+	counts, err := p.fillWorkerQueues(ctx, 0)
+
+	// Log recovered tasks.
+	log.WithContext(ctx).
+		WithField("delivery", counts.delivery).
+		WithField("federator", counts.federator).
+		WithField("client", counts.client).
+		WithField("errors", counts.errors).
+		Info("recovered queued tasks")
+
+	return err
+}
+
+// workerTaskCounts counts worker
+// tasks recovered, by worker type.
+type workerTaskCounts struct {
+	delivery  int
+	federator int
+	client    int
+
+	// Failed recoveries.
+	errors int
+}
+
+// total returns the total count
+// of all claimed tasks.
+func (c workerTaskCounts) total() int {
+	return c.delivery + c.federator + c.client + c.errors
+}
+
+// fillWorkerQueues claims persisted worker tasks from the database,
+// up to max tasks (or all if max <= 0), pushing each of them to their
+// relevant worker queues. Tasks are claimed in batches, so that other
+// processes sharing the database may also claim tasks concurrently.
+func (p *Processor) fillWorkerQueues(ctx context.Context, max int) (workerTaskCounts, error) {
+	const batch = 100
 
 	var (
-		// Counts of each task type
-		// successfully recovered.
-		delivery  int
-		federator int
-		client    int
+		counts workerTaskCounts
 
-		// Failed recoveries.
-		errors int
+		// Tasks that we failed to push,
+		// to be persisted again after.
+		failed []*gtsmodel.WorkerTask
 	)
 
-loop:
-
-	// Handle each persisted task, removing
-	// all those we can't handle. Leaving us
-	// with a slice of tasks we can safely
-	// delete from being persisted in the DB.
-	for i := 0; i < len(tasks); {
-		var err error
+	for max <= 0 || counts.total() < max {
+		limit := batch
+		if max > 0 {
+			limit = min(limit, max-counts.total())
+		}
 
-		// Task at index.
-		task := tasks[i]
-
-		// Appropriate task count
-		// pointer to increment.
-		var counter *int
-
-		// Attempt to recovery persisted
-		// task depending on worker type.
-		switch task.WorkerType {
-		case gtsmodel.DeliveryWorker:
-			err = p.pushDelivery(ctx, task)
-			counter = &delivery
-		case gtsmodel.FederatorWorker:
-			err = p.pushFederator(ctx, task)
-			counter = &federator
-		case gtsmodel.ClientWorker:
-			err = p.pushClient(ctx, task)
-			counter = &client
-		default:
-			err = fmt.Errorf("invalid worker type %d", task.WorkerType)
+		// Claim next batch of persisted worker tasks from db.
+		//
+		// (database returns these as ASCENDING, i.e.
+		// returned in the order they were inserted).
+		tasks, err := p.state.DB.ClaimWorkerTasks(ctx, limit)
+
+		// Handle each claimed task,
+		// even in case of error, as
+		// these are now removed from db.
+		for _, task := range tasks {
+			var err error
+
+			// Appropriate task count
+			// pointer to increment.
+			var counter *int
+
+			// Attempt to recovery persisted
+			// task depending on worker type.
+			switch task.WorkerType {
+			case gtsmodel.DeliveryWorker:
+				err = p.pushDelivery(ctx, task)
+				counter = &counts.delivery
+			case gtsmodel.FederatorWorker:
+				err = p.pushFederator(ctx, task)
+				counter = &counts.federator
+			case gtsmodel.ClientWorker:
+				err = p.pushClient(ctx, task)
+				counter = &counts.client
+			default:
+				err = fmt.Errorf("invalid worker type %d", task.WorkerType)
+			}
+
+			if err != nil {
+				log.Errorf(ctx, "error pushing task %d: %v", task.ID, err)
+				failed = append(failed, task)
+				counts.errors++
+				continue
+			}
+
+			(*counter)++
 		}
 
 		if err != nil {
-			log.Errorf(ctx, "error pushing task %d: %v", task.ID, err)
+			err = gtserror.Newf("error claiming worker tasks from db: %w", err)
+			return counts, errors.Join(err, p.putFailedWorkerTasks(ctx, failed))
+		}
+
+		if len(tasks) < limit {
+			// Reached the
+			// end of tasks.
+			break
+		}
+	}
+
+	return counts, p.putFailedWorkerTasks(ctx, failed)
+}
+
+// putFailedWorkerTasks persists again any claimed worker tasks
+// that failed to be pushed, so they may be retried later on.
+func (p *Processor) putFailedWorkerTasks(ctx context.Context, failed []*gtsmodel.WorkerTask) error {
+	if len(failed) == 0 {
+		return nil
+	}
+
+	for _, task := range failed {
+		// Given a new
+		// ID on insert.
+		task.ID = 0
+	}
+
+	if err := p.state.DB.PutWorkerTasks(ctx, failed); err != nil {
+		return gtserror.Newf("error putting failed tasks in db: %w", err)
+	}
 
-			// Drop error'd task from slice.
-			tasks = slices.Delete(tasks, i, i+1)
+	return nil
+}
 
-			// Incr errors.
-			errors++
-			continue loop
+const (
+	// shareQueuesEvery is how often worker
+	// queues are shared between cluster nodes.
+	shareQueuesEvery = 30 * time.Second
+
+	// shareQueueMax is the length of local delivery
+	// queue beyond which deliveries are shared with
+	// other nodes, and shareClaimMax the most tasks
+	// claimed at once by a node with an idle queue.
+	shareQueueMax = 1000
+	shareClaimMax = 500
+)
+
+// ScheduleWorkerQueueSharing schedules this node to periodically
+// share its worker queues with other nodes in a cluster. Deliveries
+// beyond a maximum queue length are persisted to the database, from
+// where they (and tasks persisted by any stopped nodes) are claimed
+// by nodes whose own delivery queue is idle.
+func (p *Processor) ScheduleWorkerQueueSharing() {
+	fn := func(ctx context.Context, start time.Time) {
+		if err := p.shareWorkerQueues(ctx); err != nil {
+			log.Errorf(ctx, "error sharing worker queues: %v", err)
 		}
+	}
 
-		// Increment slice
-		// index & counter.
-		(*counter)++
-		i++
+	log.Infof(nil, "scheduling worker queue sharing to run every %s", shareQueuesEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@workerqueuesharing",
+		time.Now(),
+		shareQueuesEvery,
+		fn,
+	) {
+		panic("failed to schedule @workerqueuesharing")
 	}
+}
 
-	// Tasks that worker successfully pushed
-	// to their appropriate workers, we can
-	// safely now remove from the database.
-	for _, task := range tasks {
-		if err := p.state.DB.DeleteWorkerTaskByID(ctx, task.ID); err != nil {
-			log.Errorf(ctx, "error deleting task from db: %v", err)
+func (p *Processor) shareWorkerQueues(ctx context.Context) error {
+	queued := p.state.Workers.Delivery.Queue.Len()
+
+	if queued == 0 {
+		// Our queue is idle, claim
+		// shared tasks from the db.
+		counts, err := p.fillWorkerQueues(ctx, shareClaimMax)
+		if n := counts.total(); n > 0 {
+			log.Infof(ctx, "claimed %d shared worker tasks", n)
 		}
+		return err
 	}
 
-	// Log recovered tasks.
-	log.WithContext(ctx).
-		WithField("delivery", delivery).
-		WithField("federator", federator).
-		WithField("client", client).
-		WithField("errors", errors).
-		Info("recovered queued tasks")
+	if queued <= shareQueueMax {
+		// Nothing to share.
+		return nil
+	}
 
+	tasks := make([]*gtsmodel.WorkerTask, 0, queued-shareQueueMax)
+	for range queued - shareQueueMax {
+		task, err := p.popDelivery()
+		if err != nil {
+			log.Errorf(ctx, "error popping delivery: %v", err)
+			continue
+		}
+
+		if task == nil {
+			// Queue drained
+			// in the meantime.
+			break
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	// Persist deliveries to the database, for other nodes to claim.
+	if err := p.state.DB.PutWorkerTasks(ctx, tasks); err != nil {
+		return gtserror.Newf("error putting tasks in db: %w", err)
+	}
+
+	log.Infof(ctx, "shared %d queued deliveries", len(tasks))
 	return nil
 }
 
@@ -232,10 +352,15 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 
 // pushDelivery parses a valid delivery.Delivery{} from serialized task data and pushes to queue.
 func (p *Processor) pushDelivery(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	return p.pushDeliveryData(ctx, task.TaskData)
+}
+
+// pushDeliveryData parses a valid delivery.Delivery{} from serialized data, signs and pushes to queue.
+func (p *Processor) pushDeliveryData(ctx context.Context, data []byte) error {
 	dlv := new(delivery.Delivery)
 
-	// Deserialize the raw worker task data into delivery.
-	if err := dlv.Deserialize(task.TaskData); err != nil {
+	// Deserialize the raw serialized data into delivery.
+	if err := dlv.Deserialize(data); err != nil {
 		return gtserror.Newf("error deserializing delivery: %w", err)
 	}
 
@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/announcements"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	gtsstream "github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AnnouncementsTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+
+	testAccounts map[string]*gtsmodel.Account
+
+	stream        stream.Processor
+	announcements announcements.Processor
+}
+
+func (suite *AnnouncementsTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *AnnouncementsTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+
+	suite.stream = stream.New(&suite.state, testrig.NewTestOauthServer(suite.db))
+	suite.announcements = announcements.New(
+		&suite.state,
+		typeutils.NewConverter(&suite.state),
+		&suite.stream,
+	)
+
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *AnnouncementsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StopWorkers(&suite.state)
+}
+
+// putAnnouncement inserts a published
+// announcement, ending at given time.
+func (suite *AnnouncementsTestSuite) putAnnouncement(id string, endsAt time.Time) {
+	if err := suite.db.PutAnnouncement(context.Background(), &gtsmodel.Announcement{
+		ID:                 id,
+		Text:               "Maintenance on Sunday!",
+		Content:            "<p>Maintenance on Sunday!</p>",
+		EndsAt:             endsAt,
+		Published:          util.Ptr(true),
+		PublishedAt:        time.Now(),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *AnnouncementsTestSuite) TestGetAndDismiss() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	suite.putAnnouncement("01JKE4D6T6X3CFW2TMM4Q7Y8VB", time.Time{})
+
+	// Ended announcement shouldn't be shown.
+	suite.putAnnouncement("01JKE4DNSZ8N9Z3DM0F5ZJ3G4R", time.Now().Add(-time.Hour))
+
+	apiAnnouncements, errWithCode := suite.announcements.Get(ctx, requester, false)
+	suite.NoError(errWithCode)
+	suite.Len(apiAnnouncements, 1)
+	suite.Equal("01JKE4D6T6X3CFW2TMM4Q7Y8VB", apiAnnouncements[0].ID)
+	suite.False(apiAnnouncements[0].Read)
+
+	// Dismiss twice, second should be a no-op.
+	for i := 0; i < 2; i++ {
+		errWithCode = suite.announcements.Dismiss(ctx, requester, "01JKE4D6T6X3CFW2TMM4Q7Y8VB")
+		suite.NoError(errWithCode)
+	}
+
+	// Dismissed announcement should now only
+	// be returned when asking for dismissed.
+	apiAnnouncements, errWithCode = suite.announcements.Get(ctx, requester, false)
+	suite.NoError(errWithCode)
+	suite.Empty(apiAnnouncements)
+
+	apiAnnouncements, errWithCode = suite.announcements.Get(ctx, requester, true)
+	suite.NoError(errWithCode)
+	suite.Len(apiAnnouncements, 1)
+	suite.True(apiAnnouncements[0].Read)
+
+	// Ended announcement can't be dismissed.
+	errWithCode = suite.announcements.Dismiss(ctx, requester, "01JKE4DNSZ8N9Z3DM0F5ZJ3G4R")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *AnnouncementsTestSuite) TestReactions() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_1"]
+		other     = suite.testAccounts["local_account_2"]
+	)
+
+	suite.putAnnouncement("01JKE4D6T6X3CFW2TMM4Q7Y8VB", time.Time{})
+
+	openStream, errWithCode := suite.stream.Open(ctx, other, gtsstream.TimelineHome)
+	suite.NoError(errWithCode)
+	defer openStream.Close()
+
+	// Add reactions with a unicode
+	// emoji and a local custom emoji.
+	errWithCode = suite.announcements.ReactionAdd(ctx, requester, "01JKE4D6T6X3CFW2TMM4Q7Y8VB", "🐢")
+	suite.NoError(errWithCode)
+
+	errWithCode = suite.announcements.ReactionAdd(ctx, requester, "01JKE4D6T6X3CFW2TMM4Q7Y8VB", ":rainbow:")
+	suite.NoError(errWithCode)
+
+	errWithCode = suite.announcements.ReactionAdd(ctx, other, "01JKE4D6T6X3CFW2TMM4Q7Y8VB", "rainbow")
+	suite.NoError(errWithCode)
+
+	// Other account should have been
+	// streamed the latest rainbow count.
+	var event apimodel.AnnouncementReactionEvent
+	for i := 0; i < 3; i++ {
+		msg, ok := openStream.Recv(ctx)
+		suite.True(ok)
+		suite.Equal(gtsstream.EventTypeAnnouncementReaction, msg.Event)
+		suite.NoError(json.Unmarshal([]byte(msg.Payload), &event))
+	}
+	suite.Equal(apimodel.AnnouncementReactionEvent{
+		Name:           "rainbow",
+		Count:          2,
+		AnnouncementID: "01JKE4D6T6X3CFW2TMM4Q7Y8VB",
+	}, event)
+
+	// Unknown custom emoji can't be used.
+	errWithCode = suite.announcements.ReactionAdd(ctx, requester, "01JKE4D6T6X3CFW2TMM4Q7Y8VB", ":blobcat:")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: custom emoji blobcat not found", errWithCode.Safe())
+
+	// Remove the turtle.
+	errWithCode = suite.announcements.ReactionRemove(ctx, requester, "01JKE4D6T6X3CFW2TMM4Q7Y8VB", "🐢")
+	suite.NoError(errWithCode)
+
+	apiAnnouncements, errWithCode := suite.announcements.Get(ctx, requester, false)
+	suite.NoError(errWithCode)
+	suite.Len(apiAnnouncements, 1)
+
+	reactions := apiAnnouncements[0].Reactions
+	suite.Len(reactions, 1)
+	suite.Equal("rainbow", reactions[0].Name)
+	suite.Equal(2, reactions[0].Count)
+	suite.True(reactions[0].Me)
+	suite.NotEmpty(reactions[0].URL)
+}
+
+func TestAnnouncementsTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementsTestSuite))
+}
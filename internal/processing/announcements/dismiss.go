@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Dismiss marks the announcement with given ID as read by
+// the requester (no-op if it was already dismissed).
+func (p *Processor) Dismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+) gtserror.WithCode {
+	announcement, errWithCode := p.getVisibleAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	read, err := p.state.DB.IsAnnouncementRead(ctx, announcement.ID, requester.ID)
+	if err != nil {
+		err := gtserror.Newf("db error checking announcement read: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if read {
+		// Already dismissed.
+		return nil
+	}
+
+	if err := p.state.DB.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+		ID:             id.NewULID(),
+		AnnouncementID: announcement.ID,
+		AccountID:      requester.ID,
+	}); err != nil {
+		err := gtserror.Newf("db error putting announcement read: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
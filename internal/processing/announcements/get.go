@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Get returns the announcements currently shown to users, oldest
+// first. Announcements the requester has already dismissed are
+// only included if withDismissed is true.
+func (p *Processor) Get(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	withDismissed bool,
+) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetVisibleAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		read, err := p.state.DB.IsAnnouncementRead(ctx, announcement.ID, requester.ID)
+		if err != nil {
+			err := gtserror.Newf("db error checking announcement read: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if read && !withDismissed {
+			// Already dismissed.
+			continue
+		}
+
+		reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting announcement reactions: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, reactions, requester, read)
+		if err != nil {
+			err := gtserror.Newf("error converting announcement: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// getVisibleAnnouncement returns the announcement with
+// given ID, if it's currently shown to users, else 404.
+func (p *Processor) getVisibleAnnouncement(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement == nil || !announcement.Visible() {
+		const text = "announcement not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return announcement, nil
+}
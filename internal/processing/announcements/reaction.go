@@ -0,0 +1,191 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// parseReactionName returns the reaction name for the given
+// emoji, as provided by a client: either a unicode emoji, which
+// is used as-is, or a custom emoji shortcode, optionally wrapped
+// in colons, which is used without them.
+func parseReactionName(emoji string) (string, bool) {
+	if err := validate.UnicodeEmoji(emoji); err == nil {
+		return emoji, false
+	}
+
+	shortcode := strings.TrimSuffix(strings.TrimPrefix(emoji, ":"), ":")
+	return shortcode, true
+}
+
+// ReactionAdd adds an emoji reaction for the requester to the
+// announcement with given ID (no-op if the requester already
+// reacted with this emoji), streaming the new count to users.
+//
+// The emoji may be either a unicode emoji, or the shortcode of an enabled
+// local custom emoji, optionally wrapped in colons.
+func (p *Processor) ReactionAdd(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	emoji string,
+) gtserror.WithCode {
+	name, custom := parseReactionName(emoji)
+
+	var customEmoji *gtsmodel.Emoji
+	if custom {
+		if err := validate.EmojiShortcode(name); err != nil {
+			err := fmt.Errorf("%s is neither a unicode emoji nor a custom emoji shortcode", emoji)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		var err error
+		customEmoji, err = p.state.DB.GetEmojiByShortcodeDomain(ctx, name, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting emoji %s: %w", name, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if customEmoji == nil || *customEmoji.Disabled {
+			err := fmt.Errorf("custom emoji %s not found", name)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	announcement, existing, errWithCode := p.getReactableAnnouncement(ctx, requester, announcementID, name)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if existing != nil {
+		// Already reacted with this emoji.
+		return nil
+	}
+
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: announcement.ID,
+		AccountID:      requester.ID,
+		Name:           name,
+	}
+
+	if customEmoji != nil {
+		reaction.EmojiID = customEmoji.ID
+		reaction.Emoji = customEmoji
+	}
+
+	if err := p.state.DB.PutAnnouncementReaction(ctx, reaction); err != nil {
+		err := gtserror.Newf("db error putting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return p.streamReactionCount(ctx, announcement.ID, name)
+}
+
+// ReactionRemove removes an emoji reaction of the requester from the
+// announcement with given ID (no-op if the requester hadn't reacted
+// with this emoji), streaming the new count to users.
+func (p *Processor) ReactionRemove(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	emoji string,
+) gtserror.WithCode {
+	name, _ := parseReactionName(emoji)
+
+	announcement, existing, errWithCode := p.getReactableAnnouncement(ctx, requester, announcementID, name)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if existing == nil {
+		// Not reacted with this emoji.
+		return nil
+	}
+
+	if err := p.state.DB.DeleteAnnouncementReactionByID(ctx, existing.ID); err != nil {
+		err := gtserror.Newf("db error removing announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return p.streamReactionCount(ctx, announcement.ID, name)
+}
+
+func (p *Processor) getReactableAnnouncement(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) (
+	*gtsmodel.Announcement,
+	*gtsmodel.AnnouncementReaction,
+	gtserror.WithCode,
+) {
+	announcement, errWithCode := p.getVisibleAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	reaction, err := p.state.DB.GetAnnouncementReaction(ctx, announcement.ID, requester.ID, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error checking existing reaction: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return announcement, reaction, nil
+}
+
+// streamReactionCount streams the current count of reactions
+// with the given name to the given announcement to all users.
+func (p *Processor) streamReactionCount(
+	ctx context.Context,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcementID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	var count int
+	for _, reaction := range reactions {
+		if reaction.Name == name {
+			count++
+		}
+	}
+
+	p.stream.AnnouncementReaction(ctx, &apimodel.AnnouncementReactionEvent{
+		Name:           name,
+		Count:          count,
+		AnnouncementID: announcementID,
+	})
+
+	return nil
+}
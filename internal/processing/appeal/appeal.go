@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package appeal
+
+import (
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor struct {
+	state     *state.State
+	converter *typeutils.Converter
+}
+
+func New(state *state.State, converter *typeutils.Converter) Processor {
+	return Processor{
+		state:     state,
+		converter: converter,
+	}
+}
+
+// isStrike returns whether the given admin action
+// type is shown to users as a strike against them.
+func isStrike(t gtsmodel.AdminActionType) bool {
+	switch t {
+	case gtsmodel.AdminActionDisable,
+		gtsmodel.AdminActionSilence,
+		gtsmodel.AdminActionSuspend:
+		return true
+	default:
+		return false
+	}
+}
+
+// isAppealable returns whether the given admin action type
+// can be appealed. Suspensions can't, as suspending a local
+// account deletes it, so there's nothing left to reverse.
+func isAppealable(t gtsmodel.AdminActionType) bool {
+	switch t {
+	case gtsmodel.AdminActionDisable,
+		gtsmodel.AdminActionSilence:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package appeal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// Create creates an appeal by the given account
+// against one of the strikes on their account,
+// and notifies the instance moderators of it.
+func (p *Processor) Create(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	form *apimodel.AppealCreateRequest,
+) (*apimodel.Appeal, gtserror.WithCode) {
+	action, err := p.state.DB.GetAdminAction(ctx, form.StrikeID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting admin action: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if action == nil ||
+		action.TargetCategory != gtsmodel.AdminActionCategoryAccount ||
+		action.TargetID != account.ID ||
+		!isStrike(action.Type) {
+		// Don't leak the existence of actions
+		// not taken against the requester.
+		err := fmt.Errorf("strike %s not found", form.StrikeID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if !isAppealable(action.Type) {
+		err := fmt.Errorf("%s strikes cannot be appealed", action.Type)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if action.CompletedAt.IsZero() || action.IsLifted() {
+		const text = "strike is not in effect"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	existing, err := p.state.DB.GetAppealByAdminActionID(
+		gtscontext.SetBarebones(ctx),
+		action.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting appeal: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		const text = "strike has already been appealed"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	appeal := &gtsmodel.Appeal{
+		ID:            id.NewULID(),
+		AccountID:     account.ID,
+		Account:       account,
+		AdminActionID: action.ID,
+		AdminAction:   action,
+		Text:          form.Text,
+		State:         gtsmodel.AppealPending,
+	}
+
+	if err := p.state.DB.PutAppeal(ctx, appeal); err != nil {
+		err := gtserror.Newf("db error putting appeal: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process side effects
+	// (ie., notify moderators).
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectAppeal,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       appeal,
+		Origin:         account,
+	})
+
+	return p.converter.AppealToAPIAppeal(appeal), nil
+}
@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package appeal
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// StrikesGet returns the strikes (ie., admin actions) taken
+// against the given account, newest first, along with any
+// appeals that the account has made against them.
+func (p *Processor) StrikesGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) ([]*apimodel.Strike, gtserror.WithCode) {
+	actions, err := p.state.DB.GetAdminActionsByTargetID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting admin actions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	strikes := make([]*apimodel.Strike, 0, len(actions))
+	for _, action := range actions {
+		if action.TargetCategory != gtsmodel.AdminActionCategoryAccount ||
+			!isStrike(action.Type) || action.CompletedAt.IsZero() {
+			// Not a strike, or
+			// not carried out yet.
+			continue
+		}
+
+		appeal, err := p.state.DB.GetAppealByAdminActionID(
+			gtscontext.SetBarebones(ctx),
+			action.ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting appeal: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		strikes = append(strikes, p.converter.AdminActionToAPIStrike(action, appeal))
+	}
+
+	return strikes, nil
+}
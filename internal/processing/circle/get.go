@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circle
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// Get returns the api model of one circle with the given ID.
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Circle, gtserror.WithCode) {
+	circle, errWithCode := p.getCircle(
+		// Use barebones ctx; no embedded
+		// structs necessary for this call.
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		id,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiCircle(ctx, circle)
+}
+
+// GetAll returns all circles created by the given account, sorted by circle ID DESC (newest first).
+func (p *Processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Circle, gtserror.WithCode) {
+	circles, err := p.state.DB.GetCirclesByAccountID(
+		// Use barebones ctx; no embedded
+		// structs necessary for simple GET.
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiCircles := make([]*apimodel.Circle, 0, len(circles))
+	for _, circle := range circles {
+		apiCircle, errWithCode := p.apiCircle(ctx, circle)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiCircles = append(apiCircles, apiCircle)
+	}
+
+	return apiCircles, nil
+}
+
+// GetCircleAccounts returns accounts that are members of the given circle, owned by the given account.
+// The additional parameters can be used for paging. Nil page param returns all accounts.
+func (p *Processor) GetCircleAccounts(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	circleID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Ensure circle exists + is owned by requesting account.
+	_, errWithCode := p.getCircle(
+		// Use barebones ctx; no embedded
+		// structs necessary for this call.
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		circleID,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Get page of circle members.
+	members, err := p.state.DB.GetCircleMembers(ctx,
+		circleID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting circle members: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for any members.
+	count := len(members)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	var (
+		// Preallocate expected frontend items.
+		items = make([]interface{}, 0, count)
+
+		// Set paging low / high IDs. Circles are
+		// paged by member ID, not by account ID.
+		lo = members[count-1].ID
+		hi = members[0].ID
+	)
+
+	// Convert member accounts to frontend.
+	for _, member := range members {
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, member.Account)
+		if err != nil {
+			log.Errorf(ctx, "error converting to api account: %v", err)
+			continue
+		}
+		items = append(items, apiAccount)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/circles/" + circleID + "/accounts",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// AddToCircle adds targetAccountIDs to the given circle, if valid.
+// Each target account must currently follow the circle owner.
+func (p *Processor) AddToCircle(ctx context.Context, account *gtsmodel.Account, circleID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this circle exists + account owns it.
+	_, errWithCode := p.getCircle(ctx, account.ID, circleID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Preallocate a slice of expected circle members, we specifically
+	// gather and add all the target accounts in one go rather than
+	// individually, to ensure we don't end up with partial updates.
+	members := make([]*gtsmodel.CircleMember, 0, len(targetAccountIDs))
+
+	// Iterate all the account IDs in given target list.
+	for _, targetAccountID := range targetAccountIDs {
+
+		// Check whether account is already a member.
+		in, err := p.state.DB.IsAccountInCircle(ctx, circleID, targetAccountID)
+		if err != nil {
+			err := gtserror.Newf("db error checking circle membership: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if in {
+			text := fmt.Sprintf("account %s is already in circle %s", targetAccountID, circleID)
+			return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+
+		// Get the follow from target to circle owner.
+		follow, err := p.state.DB.GetFollow(
+
+			// We don't need any sub-models.
+			gtscontext.SetBarebones(ctx),
+			targetAccountID,
+			account.ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting follow: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if follow == nil {
+			text := fmt.Sprintf("account %s does not currently follow you", targetAccountID)
+			return gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+
+		// Generate new member for this follow in circle.
+		members = append(members, &gtsmodel.CircleMember{
+			ID:        id.NewULID(),
+			CircleID:  circleID,
+			FollowID:  follow.ID,
+			AccountID: targetAccountID,
+		})
+	}
+
+	// Add all of the gathered circle members to the database.
+	switch err := p.state.DB.PutCircleMembers(ctx, members); {
+	case err == nil:
+
+	case errors.Is(err, db.ErrAlreadyExists):
+		err := gtserror.Newf("conflict adding circle member: %w", err)
+		return gtserror.NewErrorUnprocessableEntity(err)
+
+	default:
+		err := gtserror.Newf("db error inserting circle members: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// RemoveFromCircle removes targetAccountIDs from the given circle, if valid.
+func (p *Processor) RemoveFromCircle(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	circleID string,
+	targetAccountIDs []string,
+) gtserror.WithCode {
+	// Ensure this circle exists + account owns it.
+	_, errWithCode := p.getCircle(ctx, account.ID, circleID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	var errs gtserror.MultiError
+
+	// Iterate all the account IDs in given target list.
+	for _, targetAccountID := range targetAccountIDs {
+		// Delete the member with account ID from circle.
+		err := p.state.DB.DeleteCircleMember(ctx, circleID, targetAccountID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error removing circle member: %w", err)
+			continue
+		}
+	}
+
+	// Wrap errors in errWithCode if set.
+	if err := errs.Combine(); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
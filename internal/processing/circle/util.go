@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package circle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// getCircle is a shortcut to get one circle from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *Processor) getCircle(ctx context.Context, accountID string, circleID string) (*gtsmodel.Circle, gtserror.WithCode) {
+	circle, err := p.state.DB.GetCircleByID(ctx, circleID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting circle: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if circle == nil {
+		const text = "circle not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	if circle.AccountID != accountID {
+		const text = "circle not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New("circle does not belong to account"),
+			text,
+		)
+	}
+
+	return circle, nil
+}
+
+// apiCircle is a shortcut to return the API version of the given
+// circle, or return an appropriate error if conversion fails.
+func (p *Processor) apiCircle(ctx context.Context, circle *gtsmodel.Circle) (*apimodel.Circle, gtserror.WithCode) {
+	apiCircle, err := p.converter.CircleToAPICircle(ctx, circle)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting circle to api: %w", err))
+	}
+
+	return apiCircle, nil
+}
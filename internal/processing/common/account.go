@@ -28,6 +28,33 @@
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
+// CheckPendingDeletion returns a not found error if the given local
+// account's user has asked for their account to be deleted, so that
+// the account is hidden while the deletion grace period runs out.
+func (p *Processor) CheckPendingDeletion(ctx context.Context, account *gtsmodel.Account) gtserror.WithCode {
+	if !account.IsLocal() || account.IsInstance() {
+		// Only local user
+		// accounts can be.
+		return nil
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if user.PendingDeletion() {
+		const text = "account not found"
+		return gtserror.NewErrorNotFound(
+			errors.New("account is pending deletion"),
+			text,
+		)
+	}
+
+	return nil
+}
+
 // GetTargetAccountBy fetches the target account with db load function, given the authorized (or, nil) requester's
 // account. This returns an approprate gtserror.WithCode accounting (ha) for not found and visibility to requester.
 func (p *Processor) GetTargetAccountBy(
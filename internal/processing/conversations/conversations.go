@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
@@ -124,3 +125,46 @@ func (p *Processor) getFiltersAndMutes(
 
 	return filters, compiledMutes, nil
 }
+
+// updateConversationOwnedBy gets a conversation by ID and checks that it is owned by the given account,
+// then applies the given update function to it, stores the given columns,
+// and returns the API representation of the updated conversation.
+func (p *Processor) updateConversationOwnedBy(
+	ctx context.Context,
+	id string,
+	requestingAccount *gtsmodel.Account,
+	update func(conversation *gtsmodel.Conversation),
+	columns ...string,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	// Get the conversation, including participating accounts and last status.
+	conversation, errWithCode := p.getConversationOwnedBy(ctx, id, requestingAccount)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Update and store the conversation.
+	update(conversation)
+	if err := p.state.DB.UpsertConversation(ctx, conversation, columns...); err != nil {
+		err = gtserror.Newf("DB error updating conversation %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filters, mutes, errWithCode := p.getFiltersAndMutes(ctx, requestingAccount)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiConversation, err := p.converter.ConversationToAPIConversation(
+		ctx,
+		conversation,
+		requestingAccount,
+		filters,
+		mutes,
+	)
+	if err != nil {
+		err = gtserror.Newf("error converting conversation %s to API representation: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiConversation, nil
+}
@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Mute mutes the conversation with the given ID, so that new
+// statuses in it no longer mark it as unread or generate notifications.
+func (p *Processor) Mute(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	return p.setMuted(ctx, requestingAccount, id, true)
+}
+
+// Unmute unmutes the conversation with the given ID.
+func (p *Processor) Unmute(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	return p.setMuted(ctx, requestingAccount, id, false)
+}
+
+func (p *Processor) setMuted(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+	muted bool,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	return p.updateConversationOwnedBy(
+		ctx,
+		id,
+		requestingAccount,
+		func(conversation *gtsmodel.Conversation) {
+			conversation.Muted = util.Ptr(muted)
+		},
+		"muted",
+	)
+}
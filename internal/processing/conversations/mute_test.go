@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations_test
+
+import (
+	"context"
+	"net/http"
+)
+
+func (suite *ConversationsTestSuite) TestMuteUnmute() {
+	ctx := context.Background()
+	conversation := suite.NewTestConversation(suite.testAccount, 0)
+
+	apiConversation, err := suite.conversationsProcessor.Mute(ctx, suite.testAccount, conversation.ID)
+	if suite.NoError(err) {
+		suite.True(apiConversation.Muted)
+	}
+
+	// The mute should have been stored.
+	dbConversation, dbErr := suite.db.GetConversationByID(ctx, conversation.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.True(*dbConversation.Muted)
+
+	apiConversation, err = suite.conversationsProcessor.Unmute(ctx, suite.testAccount, conversation.ID)
+	if suite.NoError(err) {
+		suite.False(apiConversation.Muted)
+	}
+}
+
+func (suite *ConversationsTestSuite) TestMuteNotOwned() {
+	conversation := suite.NewTestConversation(suite.testAccount, 0)
+
+	_, err := suite.conversationsProcessor.Mute(context.Background(), suite.testAccounts["local_account_2"], conversation.ID)
+	if suite.Error(err) {
+		suite.Equal(http.StatusNotFound, err.Code())
+	}
+}
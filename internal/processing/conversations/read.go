@@ -26,40 +26,41 @@
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// Read marks the conversation with the given ID as read,
+// up to and including its current last status.
 func (p *Processor) Read(
 	ctx context.Context,
 	requestingAccount *gtsmodel.Account,
 	id string,
 ) (*apimodel.Conversation, gtserror.WithCode) {
-	// Get the conversation, including participating accounts and last status.
-	conversation, errWithCode := p.getConversationOwnedBy(ctx, id, requestingAccount)
-	if errWithCode != nil {
-		return nil, errWithCode
-	}
-
-	// Mark the conversation as read.
-	conversation.Read = util.Ptr(true)
-	if err := p.state.DB.UpsertConversation(ctx, conversation, "read"); err != nil {
-		err = gtserror.Newf("DB error updating conversation %s: %w", id, err)
-		return nil, gtserror.NewErrorInternalError(err)
-	}
-
-	filters, mutes, errWithCode := p.getFiltersAndMutes(ctx, requestingAccount)
-	if errWithCode != nil {
-		return nil, errWithCode
-	}
-
-	apiConversation, err := p.converter.ConversationToAPIConversation(
+	return p.updateConversationOwnedBy(
 		ctx,
-		conversation,
+		id,
 		requestingAccount,
-		filters,
-		mutes,
+		func(conversation *gtsmodel.Conversation) {
+			conversation.Read = util.Ptr(true)
+			conversation.LastReadStatusID = conversation.LastStatusID
+		},
+		"read",
+		"last_read_status_id",
 	)
-	if err != nil {
-		err = gtserror.Newf("error converting conversation %s to API representation: %w", id, err)
-		return nil, gtserror.NewErrorInternalError(err)
-	}
+}
 
-	return apiConversation, nil
+// Unread marks the conversation with the given ID as unread.
+// The read marker is left where it was, so that clients
+// can still tell which statuses have actually been read.
+func (p *Processor) Unread(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+) (*apimodel.Conversation, gtserror.WithCode) {
+	return p.updateConversationOwnedBy(
+		ctx,
+		id,
+		requestingAccount,
+		func(conversation *gtsmodel.Conversation) {
+			conversation.Read = util.Ptr(false)
+		},
+		"read",
+	)
 }
@@ -30,5 +30,22 @@ func (suite *ConversationsTestSuite) TestRead() {
 	apiConversation, err := suite.conversationsProcessor.Read(context.Background(), suite.testAccount, conversation.ID)
 	if suite.NoError(err) {
 		suite.False(apiConversation.Unread)
+		suite.Equal(conversation.LastStatusID, apiConversation.LastReadStatusID)
+	}
+}
+
+func (suite *ConversationsTestSuite) TestUnread() {
+	conversation := suite.NewTestConversation(suite.testAccount, 0)
+
+	_, err := suite.conversationsProcessor.Read(context.Background(), suite.testAccount, conversation.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiConversation, err := suite.conversationsProcessor.Unread(context.Background(), suite.testAccount, conversation.ID)
+	if suite.NoError(err) {
+		suite.True(apiConversation.Unread)
+		// The read marker should stay where it was.
+		suite.Equal(conversation.LastStatusID, apiConversation.LastReadStatusID)
 	}
 }
@@ -160,12 +160,16 @@ func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gt
 				OtherAccountsKey: gtsmodel.ConversationOtherAccountsKey(otherAccountIDs),
 				ThreadID:         status.ThreadID,
 				Read:             util.Ptr(true),
+				Muted:            util.Ptr(false),
 			}
 		}
 
 		// Assume that if the conversation owner posted the status, they've already read it.
 		statusAuthoredByConversationOwner := status.AccountID == conversation.AccountID
 
+		// New statuses in a muted conversation still update it, but don't mark it as unread.
+		muted := util.PtrOrValue(conversation.Muted, false)
+
 		// Update the conversation.
 		// If there is no previous last status or this one is more recently created, set it as the last status.
 		if conversation.LastStatus == nil || conversation.LastStatus.CreatedAt.Before(status.CreatedAt) {
@@ -174,7 +178,12 @@ func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gt
 		}
 		// If the conversation is unread, leave it marked as unread.
 		// If the conversation is read but this status might not have been, mark the conversation as unread.
-		if !statusAuthoredByConversationOwner {
+		if statusAuthoredByConversationOwner {
+			// Move the read marker up to this status if it's the latest one.
+			if conversation.LastStatusID == status.ID {
+				conversation.LastReadStatusID = status.ID
+			}
+		} else if !muted {
 			conversation.Read = util.Ptr(false)
 		}
 
@@ -229,8 +238,8 @@ func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gt
 
 		// Generate a notification,
 		// unless the status was authored by the user who would be notified,
-		// in which case they already know.
-		if status.AccountID != localAccount.ID {
+		// in which case they already know, or they muted the conversation.
+		if status.AccountID != localAccount.ID && !muted {
 			notifications = append(notifications, ConversationNotification{
 				AccountID:    localAccount.ID,
 				Conversation: apiConversation,
@@ -22,14 +22,18 @@
 	"errors"
 	"net/http"
 	"net/url"
+	"strconv"
 
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -97,22 +101,22 @@ func (p *Processor) OutboxGet(
 		params.Total = util.Ptr(*receivingAcct.Stats.StatusesCount)
 		params.First = new(paging.Page)
 		params.Query = make(url.Values, 1)
-		params.Query.Set("limit", "40") // enables paging
+		params.Query.Set("limit", strconv.Itoa(config.GetInstanceOutboxPageSize())) // enables paging
 		obj = ap.NewASOrderedCollection(params)
 
 	default:
 		// Paging enabled.
-		// Get page of full public statuses.
-		statuses, err := p.state.DB.GetAccountStatuses(
+		if page.GetLimit() == 0 {
+			// Paging params given without a limit,
+			// ensure we never serve whole history.
+			page.Limit = config.GetInstanceOutboxPageSize()
+		}
+
+		// Get page of public + unlisted statuses and boosts.
+		statuses, err := p.state.DB.GetAccountOutboxStatuses(
 			ctx,
 			receivingAcct.ID,
-			page.GetLimit(), // limit
-			true,            // excludeReplies
-			true,            // excludeReblogs
-			page.GetMax(),   // maxID
-			page.GetMin(),   // minID
-			false,           // mediaOnly
-			true,            // publicOnly
+			page,
 		)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
 			err := gtserror.Newf("error getting statuses: %w", err)
@@ -129,8 +133,13 @@ func (p *Processor) OutboxGet(
 			hi = statuses[0].ID
 		}
 
+		// Check whether we reached the oldest
+		// status, in which case there's no next.
+		last := page.GetOrder() != paging.OrderAscending &&
+			len(statuses) < page.GetLimit()
+
 		// Reslice statuses dropping all those invisible to requester
-		// (eg., local-only statuses, if the requester is remote).
+		// (eg., boosts of statuses the requester can't see).
 		statuses, err = p.visFilter.StatusesVisible(
 			ctx,
 			auth.requestingAcct,
@@ -151,7 +160,9 @@ func (p *Processor) OutboxGet(
 		pageParams.Count = len(statuses)
 
 		// Set linked next/prev parameters.
-		pageParams.Next = page.Next(lo, hi)
+		if !last {
+			pageParams.Next = page.Next(lo, hi)
+		}
 		pageParams.Prev = page.Prev(lo, hi)
 
 		// Set the collection item property builder function.
@@ -159,6 +170,24 @@ func (p *Processor) OutboxGet(
 			// Get status at index.
 			status := statuses[i]
 
+			if status.BoostOfID != "" {
+				// Derive announce from boost.
+				announce, err := p.converter.BoostToAS(
+					ctx,
+					status,
+					receivingAcct,
+					status.BoostOfAccount,
+				)
+				if err != nil {
+					log.Errorf(ctx, "error converting %s to announce: %v", status.URI, err)
+					return
+				}
+
+				// Add to item property.
+				itemsProp.AppendActivityStreamsAnnounce(announce)
+				return
+			}
+
 			// Derive statusable from status.
 			statusable, err := p.converter.StatusToAS(ctx, status)
 			if err != nil {
@@ -303,6 +332,86 @@ func (p *Processor) FollowersGet(
 	return data, nil
 }
 
+// FollowersSyncGet returns the serialized ActivityPub partial
+// followers collection of a local account, used for follower
+// synchronization (FEP-8fcf), which contains links to accounts
+// following this account that are on the requester's domain.
+func (p *Processor) FollowersSyncGet(
+	ctx context.Context,
+	requestedUser string,
+) (interface{}, gtserror.WithCode) {
+	// Authenticate incoming request, getting related accounts.
+	auth, errWithCode := p.authenticate(ctx, requestedUser)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	receivingAcct := auth.receivingAcct
+
+	if auth.requestingAcct == nil {
+		// The partial collection depends
+		// on who's asking, so we need to know.
+		const text = "request must be signed"
+		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+	}
+
+	requesterIRI, err := url.Parse(auth.requestingAcct.URI)
+	if err != nil {
+		err := gtserror.Newf("error parsing requester uri %s: %w", auth.requestingAcct.URI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	collectionID, err := url.Parse(uris.GenerateURIForFollowersSync(receivingAcct.Username))
+	if err != nil {
+		err := gtserror.Newf("error parsing followers sync uri: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Get all followers of account,
+	// to filter by requester domain.
+	followers, err := p.state.DB.GetAccountFollowers(ctx, receivingAcct.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error getting followers: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	collection := streams.NewActivityStreamsOrderedCollection()
+	ap.SetJSONLDId(collection, collectionID)
+
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, follow := range followers {
+		if follow.Account == nil {
+			continue
+		}
+
+		// Parse URL object from URI.
+		iri, err := url.Parse(follow.Account.URI)
+		if err != nil {
+			log.Errorf(ctx, "error parsing account uri %s: %v", follow.Account.URI, err)
+			continue
+		}
+
+		// Only include followers
+		// on requester's domain.
+		if iri.Host == requesterIRI.Host {
+			itemsProp.AppendIRI(iri)
+		}
+	}
+
+	totalItems := streams.NewActivityStreamsTotalItemsProperty()
+	totalItems.Set(itemsProp.Len())
+	collection.SetActivityStreamsTotalItems(totalItems)
+	collection.SetActivityStreamsOrderedItems(itemsProp)
+
+	// Serialize the prepared object.
+	data, err := ap.Serialize(collection)
+	if err != nil {
+		err := gtserror.Newf("error serializing: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return data, nil
+}
+
 // FollowingGet returns the serialized ActivityPub
 // collection of a local account's following collection,
 // which contains links to accounts followed by this account.
@@ -431,6 +540,18 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser str
 		}
 	}
 
+	// Reslice statuses dropping all those invisible to requester
+	// (eg., local-only statuses, if the requester is remote).
+	statuses, err = p.visFilter.StatusesVisible(
+		ctx,
+		auth.requestingAcct,
+		statuses,
+	)
+	if err != nil {
+		err := gtserror.Newf("error filtering statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	collection, err := p.converter.StatusesToASFeaturedCollection(ctx, receivingAcct.FeaturedCollectionURI, statuses)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
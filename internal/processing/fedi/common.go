@@ -29,7 +29,7 @@
 
 type commonAuth struct {
 	handshakingURI *url.URL          // Set to requestingAcct's URI if we're currently handshaking them.
-	requestingAcct *gtsmodel.Account // Remote account making request to this instance.
+	requestingAcct *gtsmodel.Account // Remote account making request to this instance. Nil if request was unsigned.
 	receivingAcct  *gtsmodel.Account // Local account receiving the request.
 }
 
@@ -47,6 +47,11 @@ func (p *Processor) authenticate(ctx context.Context, requestedUser string) (*co
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	// Hide accounts pending deletion.
+	if errWithCode := p.c.CheckPendingDeletion(ctx, receiver); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Ensure request signed, and use signature URI to
 	// get requesting account, dereferencing if necessary.
 	pubKeyAuth, errWithCode := p.federator.AuthenticateFederatedRequest(ctx, requestedUser)
@@ -63,6 +68,15 @@ func (p *Processor) authenticate(ctx context.Context, requestedUser string) (*co
 		}, nil
 	}
 
+	if pubKeyAuth.Unsigned {
+		// Unsigned request permitted by
+		// authorized fetch mode, treat
+		// requester as unauthenticated.
+		return &commonAuth{
+			receivingAcct: receiver,
+		}, nil
+	}
+
 	// Get requester from auth.
 	requester := pubKeyAuth.Owner
 
@@ -19,14 +19,17 @@
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"net/url"
 
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -60,7 +63,14 @@ func (p *Processor) UserGet(ctx context.Context, requestedUsername string, reque
 		}
 
 		// Return early with bare minimum data.
-		return data(minimalPerson)
+		return data(minimalPerson, receiver)
+	}
+
+	// Beyond the public key, which remote instances may
+	// still need to verify deliveries already sent, hide
+	// accounts pending deletion.
+	if errWithCode := p.c.CheckPendingDeletion(ctx, receiver); errWithCode != nil {
+		return nil, errWithCode
 	}
 
 	// If the request is not on a public key path, we want to
@@ -78,6 +88,13 @@ func (p *Processor) UserGet(ctx context.Context, requestedUsername string, reque
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if pubKeyAuth.Unsigned {
+		// Unsigned request permitted by authorized
+		// fetch mode; there's no requester to check
+		// blocks against, so just serve the profile.
+		return data(person, receiver)
+	}
+
 	if pubKeyAuth.Handshaking {
 		// If we are currently handshaking with the remote account
 		// making the request, then don't be coy: just serve the AP
@@ -91,7 +108,7 @@ func (p *Processor) UserGet(ctx context.Context, requestedUsername string, reque
 		// Instead, we end up in an 'I'll show you mine if you show me
 		// yours' situation, where we sort of agree to reveal each
 		// other's profiles at the same time.
-		return data(person)
+		return data(person, receiver)
 	}
 
 	// Get requester from auth.
@@ -107,15 +124,25 @@ func (p *Processor) UserGet(ctx context.Context, requestedUsername string, reque
 		return nil, gtserror.NewErrorForbidden(errors.New(text))
 	}
 
-	return data(person)
+	return data(person, receiver)
 }
 
-func data(requestedPerson vocab.ActivityStreamsPerson) (interface{}, gtserror.WithCode) {
+func data(requestedPerson vocab.ActivityStreamsPerson, receiver *gtsmodel.Account) (interface{}, gtserror.WithCode) {
 	data, err := ap.Serialize(requestedPerson)
 	if err != nil {
 		err := gtserror.Newf("error serializing person: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if config.GetInstanceIntegrityProofs() &&
+		receiver.Ed25519PublicKey != nil {
+		// Publish Ed25519 key for verifying
+		// integrity proofs on our activities.
+		ap.AppendAssertionMethod(data,
+			receiver.Ed25519PublicKeyURI,
+			ed25519.PublicKey(receiver.Ed25519PublicKey),
+		)
+	}
+
 	return data, nil
 }
@@ -20,10 +20,12 @@
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 const (
@@ -31,9 +33,12 @@
 	hostMetaRel                     = "lrdd"
 	hostMetaType                    = "application/xrd+xml"
 	hostMetaTemplate                = ".well-known/webfinger?resource={uri}"
-	nodeInfoVersion                 = "2.0"
+	nodeInfoVersion20               = "2.0"
+	nodeInfoVersion21               = "2.1"
 	nodeInfoSoftwareName            = "gotosocial"
-	nodeInfoRel                     = "http://nodeinfo.diaspora.software/ns/schema/" + nodeInfoVersion
+	nodeInfoSoftwareRepository      = "https://github.com/superseriousbusiness/gotosocial"
+	nodeInfoSoftwareHomepage        = "https://docs.gotosocial.org"
+	nodeInfoRelBase                 = "http://nodeinfo.diaspora.software/ns/schema/"
 	webfingerProfilePage            = "http://webfinger.net/rel/profile-page"
 	webFingerProfilePageContentType = "text/html"
 	webfingerSelf                   = "self"
@@ -45,26 +50,30 @@
 	nodeInfoProtocols = []string{"activitypub"}
 	nodeInfoInbound   = []string{}
 	nodeInfoOutbound  = []string{}
-	nodeInfoMetadata  = make(map[string]interface{})
 )
 
-// NodeInfoRelGet returns a well known response giving the path to node info.
+// NodeInfoRelGet returns a well known response giving the paths to
+// node info, one for each schema version we support, newest first.
 func (p *Processor) NodeInfoRelGet(ctx context.Context) (*apimodel.WellKnownResponse, gtserror.WithCode) {
 	protocol := config.GetProtocol()
 	host := config.GetHost()
 
+	links := make([]apimodel.Link, 0, 2)
+	for _, version := range []string{nodeInfoVersion21, nodeInfoVersion20} {
+		links = append(links, apimodel.Link{
+			Rel:  nodeInfoRelBase + version,
+			Href: fmt.Sprintf("%s://%s/nodeinfo/%s", protocol, host, version),
+		})
+	}
+
 	return &apimodel.WellKnownResponse{
-		Links: []apimodel.Link{
-			{
-				Rel:  nodeInfoRel,
-				Href: fmt.Sprintf("%s://%s/nodeinfo/%s", protocol, host, nodeInfoVersion),
-			},
-		},
+		Links: links,
 	}, nil
 }
 
-// NodeInfoGet returns a node info struct in response to a node info request.
-func (p *Processor) NodeInfoGet(ctx context.Context) (*apimodel.Nodeinfo, gtserror.WithCode) {
+// NodeInfoGet returns a node info struct in response to a node info
+// request, according to the given schema version (2.0 or 2.1).
+func (p *Processor) NodeInfoGet(ctx context.Context, version string) (*apimodel.Nodeinfo, gtserror.WithCode) {
 	host := config.GetHost()
 
 	userCount, err := p.state.DB.CountInstanceUsers(ctx, host)
@@ -77,12 +86,28 @@ func (p *Processor) NodeInfoGet(ctx context.Context) (*apimodel.Nodeinfo, gtserr
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	metadata, err := p.nodeInfoMetadata(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	software := apimodel.NodeInfoSoftware{
+		Name:    nodeInfoSoftwareName,
+		Version: config.GetSoftwareVersion(),
+	}
+
+	if version == nodeInfoVersion21 {
+		// Fields only
+		// added in 2.1.
+		software.Repository = nodeInfoSoftwareRepository
+		software.Homepage = nodeInfoSoftwareHomepage
+	} else {
+		version = nodeInfoVersion20
+	}
+
 	return &apimodel.Nodeinfo{
-		Version: nodeInfoVersion,
-		Software: apimodel.NodeInfoSoftware{
-			Name:    nodeInfoSoftwareName,
-			Version: config.GetSoftwareVersion(),
-		},
+		Version:   version,
+		Software:  software,
 		Protocols: nodeInfoProtocols,
 		Services: apimodel.NodeInfoServices{
 			Inbound:  nodeInfoInbound,
@@ -95,10 +120,58 @@ func (p *Processor) NodeInfoGet(ctx context.Context) (*apimodel.Nodeinfo, gtserr
 			},
 			LocalPosts: postCount,
 		},
-		Metadata: nodeInfoMetadata,
+		Metadata: metadata,
 	}, nil
 }
 
+// nodeInfoMetadata generates the free-form nodeinfo metadata
+// from instance settings, including only those fields set in
+// the instance-nodeinfo-metadata config option.
+func (p *Processor) nodeInfoMetadata(ctx context.Context) (map[string]any, error) {
+	metadata := make(map[string]any)
+
+	fields := config.GetInstanceNodeInfoMetadata()
+	if len(fields) == 0 {
+		// Nothing to do.
+		return metadata, nil
+	}
+
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return nil, gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	for _, field := range fields {
+		switch field {
+		case config.InstanceNodeInfoMetadataNodeName:
+			metadata["nodeName"] = instance.Title
+
+		case config.InstanceNodeInfoMetadataNodeDescription:
+			metadata["nodeDescription"] = instance.ShortDescriptionText
+
+		case config.InstanceNodeInfoMetadataMaintainer:
+			maintainer := map[string]any{
+				"email": instance.ContactEmail,
+			}
+
+			if instance.ContactAccount != nil {
+				maintainer["name"] = instance.ContactAccount.Username
+				maintainer["url"] = instance.ContactAccount.URL
+			}
+
+			metadata["maintainer"] = maintainer
+
+		case config.InstanceNodeInfoMetadataFederation:
+			metadata["federation"] = map[string]any{
+				"mode":            config.GetInstanceFederationMode(),
+				"authorizedFetch": config.GetInstanceAuthorizedFetchMode(),
+			}
+		}
+	}
+
+	return metadata, nil
+}
+
 // HostMetaGet returns a host-meta struct in response to a host-meta request.
 func (p *Processor) HostMetaGet() *apimodel.HostMeta {
 	protocol := config.GetProtocol()
@@ -116,19 +189,31 @@ func (p *Processor) HostMetaGet() *apimodel.HostMeta {
 }
 
 // WebfingerGet handles the GET for a webfinger resource. Most commonly, it will be used for returning account lookups.
-func (p *Processor) WebfingerGet(ctx context.Context, requestedUsername string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
+func (p *Processor) WebfingerGet(ctx context.Context, requestedUsername string, requestedHost string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
 	// Get the local account the request is referring to.
 	requestedAccount, err := p.state.DB.GetAccountByUsernameDomain(ctx, requestedUsername, "")
 	if err != nil {
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("database error getting account with username %s: %s", requestedUsername, err))
 	}
 
+	// Hide accounts pending deletion.
+	if errWithCode := p.c.CheckPendingDeletion(ctx, requestedAccount); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Lookups at one of the extra account domains
+	// should only resolve accounts hosted on it,
+	// while lookups at the host (etc) resolve all.
+	handleDomain := requestedAccount.HandleDomain()
+	if requestedHost != handleDomain &&
+		slices.Contains(config.GetExtraAccountDomains(), requestedHost) {
+		err := fmt.Errorf("account with username %s is not hosted on %s", requestedUsername, requestedHost)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
 	return &apimodel.WellKnownResponse{
-		Subject: webfingerAccount + ":" + requestedAccount.Username + "@" + config.GetAccountDomain(),
-		Aliases: []string{
-			requestedAccount.URI,
-			requestedAccount.URL,
-		},
+		Subject: webfingerAccount + ":" + requestedAccount.Username + "@" + handleDomain,
+		Aliases: webfingerAliases(requestedAccount),
 		Links: []apimodel.Link{
 			{
 				Rel:  webfingerProfilePage,
@@ -143,3 +228,40 @@ func (p *Processor) WebfingerGet(ctx context.Context, requestedUsername string)
 		},
 	}, nil
 }
+
+// webfingerAliases returns the aliases to serve
+// in webfinger responses for the given local account.
+func webfingerAliases(account *gtsmodel.Account) []string {
+	aliasHosts := config.GetInstanceWebfingerAliasHosts()
+	aliases := make([]string, 0,
+		2+len(aliasHosts)+
+			len(account.AlsoKnownAsURIs)+1,
+	)
+
+	// Always include the
+	// account's own URI + URL.
+	aliases = append(aliases,
+		account.URI,
+		account.URL,
+	)
+
+	// Include the account's handle at any of the
+	// hosts this instance was previously known as,
+	// so that the old handle is recognized as an
+	// alias of the current one.
+	for _, host := range aliasHosts {
+		aliases = append(aliases,
+			webfingerAccount+":"+account.Username+"@"+host,
+		)
+	}
+
+	// Include any accounts that this
+	// account is also known as, and the
+	// account it moved to (if it moved).
+	aliases = append(aliases, account.AlsoKnownAsURIs...)
+	if account.MovedToURI != "" {
+		aliases = append(aliases, account.MovedToURI)
+	}
+
+	return aliases
+}
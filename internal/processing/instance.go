@@ -136,7 +136,7 @@ func (p *Processor) InstanceGetRules(ctx context.Context) ([]apimodel.InstanceRu
 	return p.converter.InstanceRulesToAPIRules(i.Rules), nil
 }
 
-func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.InstanceV1, gtserror.WithCode) {
+func (p *Processor) InstancePatch(ctx context.Context, adminAcct *gtsmodel.Account, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.InstanceV1, gtserror.WithCode) {
 	// Fetch this instance from the db for processing.
 	instance, err := p.getThisInstance(ctx)
 	if err != nil {
@@ -155,6 +155,10 @@ func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 	// in the database.
 	var columns []string
 
+	// Set if terms are changed, as
+	// a new version must be stored.
+	var termsChanged bool
+
 	// Validate & update site
 	// title if set on the form.
 	if form.Title != nil {
@@ -248,6 +252,7 @@ func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 
 		// Parse terms as Markdown, keep
 		// the raw version for later editing.
+		termsChanged = (terms != instance.TermsText)
 		instance.TermsText = terms
 		instance.Terms = p.formatter.FromMarkdown(ctx, p.parseMentionFunc, "", "", terms).HTML
 		columns = append(columns, []string{"terms", "terms_text"}...)
@@ -308,6 +313,19 @@ func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSe
 		}
 	}
 
+	if termsChanged {
+		// Keep track of the change in the terms
+		// of service version history. Acceptance
+		// of terms changed here is never required.
+		if _, err := p.putTermsOfService(ctx, adminAcct, instance, false); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if updateInstanceAccount || len(columns) != 0 {
+		p.admin.LogAction(ctx, adminAcct, gtsmodel.AuditActionUpdate, gtsmodel.AuditTargetInstance, instance.ID, "")
+	}
+
 	return p.InstanceGetV1(ctx)
 }
 
@@ -49,6 +49,13 @@ func (p *Processor) Delete(ctx context.Context, mediaAttachmentID string) gtserr
 		}
 	}
 
+	// delete the thumbnail fallback from storage
+	if attachment.Thumbnail.FallbackPath != "" {
+		if err := p.state.Storage.Delete(ctx, attachment.Thumbnail.FallbackPath); err != nil && !storage.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("remove thumbnail fallback at path %s: %s", attachment.Thumbnail.FallbackPath, err))
+		}
+	}
+
 	// delete the file from storage
 	if attachment.File.Path != "" {
 		if err := p.state.Storage.Delete(ctx, attachment.File.Path); err != nil && !storage.IsNotFound(err) {
@@ -22,6 +22,7 @@
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -111,6 +112,7 @@ func (p *Processor) GetFile(
 			acctID,
 			mediaSize,
 			mediaID,
+			form.Accept,
 		)
 
 	default:
@@ -125,6 +127,7 @@ func (p *Processor) getAttachmentContent(
 	acctID string,
 	sizeStr media.Size,
 	mediaID string,
+	accept []string,
 ) (
 	*apimodel.Content,
 	gtserror.WithCode,
@@ -194,6 +197,19 @@ func (p *Processor) getAttachmentContent(
 		)
 
 	case media.SizeSmall:
+		if attach.Thumbnail.FallbackPath != "" &&
+			!slices.Contains(accept, attach.Thumbnail.ContentType) {
+			// Thumbnail is in a format the caller doesn't
+			// explicitly accept (e.g. avif), and wildcards
+			// are no guarantee of support, so serve fallback.
+			apiContent.ContentType = "image/jpeg"
+			apiContent.ContentLength = int64(attach.Thumbnail.FallbackFileSize)
+			return p.getContent(ctx,
+				attach.Thumbnail.FallbackPath,
+				apiContent,
+			)
+		}
+
 		apiContent.ContentType = attach.Thumbnail.ContentType
 		apiContent.ContentLength = int64(attach.Thumbnail.FileSize)
 		return p.getContent(ctx,
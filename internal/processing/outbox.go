@@ -0,0 +1,229 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// OutboxPost handles an activity (or bare object) POSTed by the owner
+// of an outbox, via the ActivityPub client-to-server API. Supported are
+// Create of a Note (or a bare Note), Follow of an account, and Like of
+// a status. Each is processed in the same way as its client API
+// equivalent, including all side effects such as federation.
+//
+// Returns the URI of the resulting activity, to be
+// returned to the client in the Location header.
+func (p *Processor) OutboxPost(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	t vocab.Type,
+) (string, gtserror.WithCode) {
+	// Bare objects are treated as though
+	// they were wrapped in a Create.
+	if statusable, ok := ap.ToStatusable(t); ok {
+		return p.outboxCreateNote(ctx, requester, application, statusable)
+	}
+
+	activity, ok := ap.ToActivityable(t)
+	if !ok {
+		err := fmt.Errorf("unsupported type %s", t.GetTypeName())
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Clients may leave out actor, but if
+	// it's set it must be the outbox owner.
+	for _, actor := range ap.GetActorIRIs(activity) {
+		if actor.String() != requester.URI {
+			const text = "activity actor must be outbox owner"
+			return "", gtserror.NewErrorForbidden(errors.New(text), text)
+		}
+	}
+
+	switch typeName := activity.GetTypeName(); typeName {
+
+	case ap.ActivityCreate:
+		statusables, _ := ap.ExtractStatusables(ap.ExtractObjects(activity))
+		if len(statusables) != 1 {
+			const text = "Create must have exactly one object"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		return p.outboxCreateNote(ctx, requester, application, statusables[0])
+
+	case ap.ActivityFollow:
+		objectIRI, errWithCode := outboxObjectIRI(activity)
+		if errWithCode != nil {
+			return "", errWithCode
+		}
+		return p.outboxFollow(ctx, requester, objectIRI)
+
+	case ap.ActivityLike:
+		objectIRI, errWithCode := outboxObjectIRI(activity)
+		if errWithCode != nil {
+			return "", errWithCode
+		}
+		return p.outboxLike(ctx, requester, objectIRI)
+
+	default:
+		err := fmt.Errorf("unsupported activity type %s", typeName)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+}
+
+// outboxObjectIRI returns the single object
+// IRI of the given Follow or Like activity.
+func outboxObjectIRI(activity ap.Activityable) (*url.URL, gtserror.WithCode) {
+	objectIRIs := ap.GetObjectIRIs(activity)
+	if len(objectIRIs) != 1 {
+		text := activity.GetTypeName() + " must have exactly one object"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+	return objectIRIs[0], nil
+}
+
+func (p *Processor) outboxCreateNote(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	statusable ap.Statusable,
+) (string, gtserror.WithCode) {
+	// Ensure any replied-to status is dereferenced,
+	// so that it can be found during conversion.
+	if inReplyTo := ap.ExtractInReplyToURI(statusable); inReplyTo != nil {
+		if _, _, err := p.federator.GetStatusByURI(ctx, requester.Username, inReplyTo); err != nil {
+			err := gtserror.Newf("error getting inReplyTo status %s: %w", inReplyTo, err)
+			return "", gtserror.NewErrorBadRequest(err, "inReplyTo status could not be retrieved")
+		}
+	}
+
+	form, err := p.converter.ASNoteToStatusCreateRequest(ctx, requester, statusable)
+	if err != nil {
+		if gtserror.IsMalformed(err) || gtserror.IsNotFound(err) {
+			return "", gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		err := gtserror.Newf("error converting note: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	// Apply the same limits as
+	// statuses created via client API.
+	chars := len([]rune(form.Status)) + len([]rune(form.SpoilerText))
+	if chars == 0 {
+		const text = "no content or summary provided"
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if maxChars := config.GetStatusesMaxChars(); chars > maxChars {
+		text := fmt.Sprintf(
+			"note too long, %d characters provided (including summary) but limit is %d",
+			chars, maxChars,
+		)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.Language != "" {
+		lang, err := validate.Language(form.Language)
+		if err != nil {
+			return "", gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		form.Language = lang
+	}
+
+	apiStatus, errWithCode := p.status.Create(ctx, requester, application, form)
+	if errWithCode != nil {
+		return "", errWithCode
+	}
+
+	return apiStatus.URI + "/activity#" + ap.ActivityCreate, nil
+}
+
+func (p *Processor) outboxFollow(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	objectIRI *url.URL,
+) (string, gtserror.WithCode) {
+	target, _, err := p.federator.GetAccountByURI(ctx, requester.Username, objectIRI)
+	if err != nil {
+		err := gtserror.Newf("error getting follow target %s: %w", objectIRI, err)
+		return "", gtserror.NewErrorBadRequest(err, "follow target could not be retrieved")
+	}
+
+	if _, errWithCode := p.account.FollowCreate(ctx, requester,
+		&apimodel.AccountFollowRequest{ID: target.ID},
+	); errWithCode != nil {
+		return "", errWithCode
+	}
+
+	// The follow will either be pending
+	// as a follow request, or accepted.
+	followReq, err := p.state.DB.GetFollowRequest(ctx, requester.ID, target.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting follow request: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	if followReq != nil {
+		return followReq.URI, nil
+	}
+
+	follow, err := p.state.DB.GetFollow(ctx, requester.ID, target.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting follow: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	return follow.URI, nil
+}
+
+func (p *Processor) outboxLike(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	objectIRI *url.URL,
+) (string, gtserror.WithCode) {
+	target, _, err := p.federator.GetStatusByURI(ctx, requester.Username, objectIRI)
+	if err != nil {
+		err := gtserror.Newf("error getting like target %s: %w", objectIRI, err)
+		return "", gtserror.NewErrorBadRequest(err, "like target could not be retrieved")
+	}
+
+	if _, errWithCode := p.status.FaveCreate(ctx, requester, target.ID); errWithCode != nil {
+		return "", errWithCode
+	}
+
+	fave, err := p.state.DB.GetStatusFave(ctx, requester.ID, target.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting fave: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	return fave.URI, nil
+}
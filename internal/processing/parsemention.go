@@ -60,9 +60,9 @@ func GetParseMentionFunc(state *state.State, federator *federation.Federator) gt
 		//   - "@someone" with no host component.
 		//   - "@someone@gts.example.org" and we're host "gts.example.org".
 		//   - "@someone@example.org" and we're account-domain "example.org".
+		//   - "@someone@family.example.org" and that's one of our extra account domains.
 		local := targetHost == "" ||
-			targetHost == config.GetHost() ||
-			targetHost == config.GetAccountDomain()
+			config.IsLocalDomain(targetHost)
 
 		// Either a local or remote
 		// target for the mention.
@@ -55,7 +55,7 @@ func (p *Processor) ScheduleExpiry(ctx context.Context, poll *gtsmodel.Poll) err
 	}
 
 	// Add the given poll to the scheduler.
-	ok := p.state.Workers.Scheduler.AddOnce(
+	ok := p.state.Workers.Scheduler.AddOnceExclusive(
 		poll.ID,
 		poll.ExpiresAt,
 		p.onExpiry(poll.ID),
@@ -71,6 +71,23 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	for _, r := range rules {
+		if *r.Deleted {
+			err = fmt.Errorf("rule with ID %s does not exist", r.ID)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	if len(rules) != len(form.RuleIDs) {
+		err = errors.New("one or more rule_ids do not exist")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	category, errWithCode := parseCategory(form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	reportID := id.NewULID()
 	report := &gtsmodel.Report{
 		ID:              reportID,
@@ -80,6 +97,7 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		TargetAccountID: form.AccountID,
 		TargetAccount:   targetAccount,
 		Comment:         form.Comment,
+		Category:        category,
 		StatusIDs:       form.StatusIDs,
 		Statuses:        statuses,
 		RuleIDs:         form.RuleIDs,
@@ -107,3 +125,29 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 
 	return apiReport, nil
 }
+
+// parseCategory parses the category of the given report form.
+// If no category is set, it defaults to violation when any
+// rules are given (as clients may only send rule IDs), else
+// to other. Rules may only be given for category violation.
+func parseCategory(form *apimodel.ReportCreateRequest) (gtsmodel.ReportCategory, gtserror.WithCode) {
+	if form.Category == "" {
+		if len(form.RuleIDs) != 0 {
+			return gtsmodel.ReportCategoryViolation, nil
+		}
+		return gtsmodel.ReportCategoryOther, nil
+	}
+
+	category := gtsmodel.ParseReportCategory(form.Category)
+	if category == gtsmodel.ReportCategoryUnknown {
+		err := fmt.Errorf("category %s not recognized, valid categories are: spam, violation, other", form.Category)
+		return 0, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(form.RuleIDs) != 0 && category != gtsmodel.ReportCategoryViolation {
+		err := fmt.Errorf("rule_ids can only be set for category violation, not %s", category)
+		return 0, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return category, nil
+}
@@ -410,7 +410,7 @@ func (p *Processor) accountByUsernameDomain(
 	resolve bool,
 ) (*gtsmodel.Account, error) {
 	var usernameDomain string
-	if domain == "" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if domain == "" || config.IsLocalDomain(domain) {
 		// Local lookup, normalize domain.
 		domain = ""
 		usernameDomain = username
@@ -845,16 +845,29 @@ func (p *Processor) statusesByText(
 		fromAccountID = parsed.fromAccountID
 	}
 
-	statuses, err := p.state.DB.SearchForStatuses(
-		ctx,
-		requestingAccountID,
-		query,
-		fromAccountID,
-		maxID,
-		minID,
-		limit,
-		offset,
-	)
+	var statuses []*gtsmodel.Status
+	if p.state.Search != nil {
+		statuses, err = p.statusesByTextFromBackend(
+			ctx,
+			requestingAccountID,
+			query,
+			fromAccountID,
+			maxID,
+			minID,
+			limit,
+		)
+	} else {
+		statuses, err = p.state.DB.SearchForStatuses(
+			ctx,
+			requestingAccountID,
+			query,
+			fromAccountID,
+			maxID,
+			minID,
+			limit,
+			offset,
+		)
+	}
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return gtserror.Newf("error checking database for statuses using text %s: %w", query, err)
 	}
@@ -866,6 +879,82 @@ func (p *Processor) statusesByText(
 	return nil
 }
 
+// maxBackendSearchRounds is the maximum number of times
+// the external search backend will be queried to fill up
+// a page of results that are in scope for the requester.
+const maxBackendSearchRounds = 5
+
+// statusesByTextFromBackend searches for statuses using
+// the configured external search backend, then narrows
+// down the candidates it returns to those the requesting
+// account would be allowed to find via database search.
+//
+// Offset is not supported, paging must be done via IDs.
+func (p *Processor) statusesByTextFromBackend(
+	ctx context.Context,
+	requestingAccountID string,
+	query string,
+	fromAccountID string,
+	maxID string,
+	minID string,
+	limit int,
+) ([]*gtsmodel.Status, error) {
+	// Page up from minID if set,
+	// else down from maxID (or top).
+	frontToBack := minID == ""
+	statusIDs := make([]string, 0, limit)
+
+	for i := 0; i < maxBackendSearchRounds && len(statusIDs) < limit; i++ {
+		candidateIDs, err := p.state.Search.SearchStatuses(
+			ctx,
+			query,
+			fromAccountID,
+			maxID,
+			minID,
+			limit,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error searching backend: %w", err)
+		}
+
+		if len(candidateIDs) == 0 {
+			// Nothing left.
+			break
+		}
+
+		searchableIDs, err := p.state.DB.GetSearchableStatusIDs(
+			ctx,
+			requestingAccountID,
+			candidateIDs,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("db error filtering status ids: %w", err)
+		}
+
+		if frontToBack {
+			// Page down from the oldest
+			// candidate for next round.
+			statusIDs = append(statusIDs, searchableIDs...)
+			maxID = candidateIDs[len(candidateIDs)-1]
+		} else {
+			// Page up from the newest
+			// candidate for next round.
+			statusIDs = append(searchableIDs, statusIDs...)
+			minID = candidateIDs[0]
+		}
+	}
+
+	if len(statusIDs) > limit {
+		if frontToBack {
+			statusIDs = statusIDs[:limit]
+		} else {
+			statusIDs = statusIDs[len(statusIDs)-limit:]
+		}
+	}
+
+	return p.state.DB.GetStatusesByIDs(ctx, statusIDs)
+}
+
 // parsedQuery represents the results of parsing the search operator terms within a query.
 type parsedQuery struct {
 	// query is the original search query text with operator terms removed.
@@ -0,0 +1,95 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// reindexBatchSize is the number of statuses
+// sent to the search backend at once on reindex.
+const reindexBatchSize = 500
+
+// InitBackend initializes the index of the configured
+// external search backend, if any. This should be
+// called once on startup, before serving requests.
+func (p *Processor) InitBackend(ctx context.Context) error {
+	if p.state.Search == nil {
+		return nil
+	}
+
+	return p.state.Search.Init(ctx)
+}
+
+// Reindex rebuilds the search index of statuses from
+// scratch, either in the configured external search
+// backend, or in the database if there isn't one.
+func (p *Processor) Reindex(ctx context.Context) error {
+	if p.state.Search == nil {
+		return p.state.DB.ReindexStatuses(ctx)
+	}
+
+	if err := p.state.Search.Init(ctx); err != nil {
+		return gtserror.Newf("error initializing search backend: %w", err)
+	}
+
+	if err := p.state.Search.Clear(ctx); err != nil {
+		return gtserror.Newf("error clearing search backend: %w", err)
+	}
+
+	var (
+		maxID   string
+		indexed int
+	)
+
+	for {
+		statusIDs, err := p.state.DB.GetSearchIndexableStatusIDs(ctx, maxID, reindexBatchSize)
+		if err != nil {
+			return gtserror.Newf("db error getting status ids: %w", err)
+		}
+
+		if len(statusIDs) == 0 {
+			break
+		}
+
+		// Only the text is needed,
+		// so don't populate statuses.
+		statuses, err := p.state.DB.GetStatusesByIDs(
+			gtscontext.SetBarebones(ctx),
+			statusIDs,
+		)
+		if err != nil {
+			return gtserror.Newf("db error getting statuses: %w", err)
+		}
+
+		if err := p.state.Search.IndexStatuses(ctx, statuses); err != nil {
+			return gtserror.Newf("error indexing statuses: %w", err)
+		}
+
+		indexed += len(statuses)
+		log.Infof(ctx, "indexed %d statuses", indexed)
+
+		maxID = statusIDs[len(statusIDs)-1]
+	}
+
+	return nil
+}
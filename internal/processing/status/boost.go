@@ -66,6 +66,14 @@ func (p *Processor) BoostCreate(
 		return nil, gtserror.NewErrorUnprocessableEntity(err)
 	}
 
+	// Statuses addressed to a circle can't be boosted,
+	// as that would leak them beyond the circle members.
+	if target.CircleID != "" {
+		const errText = "you cannot boost a status addressed to a circle"
+		err := gtserror.New(errText)
+		return nil, gtserror.NewErrorForbidden(err, errText)
+	}
+
 	// Ensure valid boost target for requester.
 	policyResult, err := p.intFilter.StatusBoostable(ctx,
 		requester,
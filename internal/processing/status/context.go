@@ -59,6 +59,18 @@ func (p *Processor) contextGet(
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	if requester != nil {
+		// Pull in replies to a remote target that we may be
+		// missing (if enabled). This happens asynchronously,
+		// so they'll show up the next time thread is loaded.
+		// Only done for an authorized requester, as with
+		// refreshing the target, to prevent a DOS vector.
+		p.federator.BackfillStatusReplies(ctx,
+			requester.Username,
+			targetStatus,
+		)
+	}
+
 	// Fetch up to the top of the thread.
 	ancestors, err := p.state.DB.GetStatusParents(ctx, targetStatus)
 	if err != nil {
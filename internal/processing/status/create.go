@@ -21,15 +21,18 @@
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/language"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
@@ -118,7 +121,22 @@ func (p *Processor) Create(
 		return nil, errWithCode
 	}
 
-	if errWithCode := p.processMediaIDs(ctx, form, requester.ID, status); errWithCode != nil {
+	// Check + attach quoted status.
+	if errWithCode := p.processQuote(ctx,
+		requester,
+		status,
+		form.QuoteID,
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.processMediaIDs(ctx, form, requester, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Check + attach circle BEFORE visibility, as
+	// addressing a circle implies private visibility.
+	if errWithCode := p.processCircle(ctx, requester, form, status); errWithCode != nil {
 		return nil, errWithCode
 	}
 
@@ -272,6 +290,66 @@ func (p *Processor) processInReplyTo(ctx context.Context, requester *gtsmodel.Ac
 	return nil
 }
 
+func (p *Processor) processQuote(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status, quoteID string) gtserror.WithCode {
+	if quoteID == "" {
+		// Not a quote.
+		// Nothing to do.
+		return nil
+	}
+
+	// Fetch target quoted status (checking visibility).
+	quote, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		quoteID,
+		nil,
+	)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// If this is a boost, unwrap it to get source status.
+	quote, errWithCode = p.c.UnwrapIfBoost(ctx,
+		requester,
+		quote,
+	)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Only public or unlisted statuses can be
+	// quoted, to avoid leaking restricted posts.
+	if quote.Visibility != gtsmodel.VisibilityPublic &&
+		quote.Visibility != gtsmodel.VisibilityUnlocked {
+		const errText = "only public or unlisted statuses can be quoted"
+		err := gtserror.New(errText)
+		return gtserror.NewErrorForbidden(err, errText)
+	}
+
+	// Quoting is a form of boosting, so
+	// ensure requester is allowed to boost.
+	policyResult, err := p.intFilter.StatusBoostable(ctx,
+		requester,
+		quote,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is boostable: %w", quote.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if !policyResult.Permitted() {
+		const errText = "you do not have permission to quote this status"
+		err := gtserror.New(errText)
+		return gtserror.NewErrorForbidden(err, errText)
+	}
+
+	// Set status fields from quote.
+	status.QuoteID = quote.ID
+	status.QuoteURI = quote.URI
+	status.Quote = quote
+
+	return nil
+}
+
 func (p *Processor) processThreadID(ctx context.Context, status *gtsmodel.Status) gtserror.WithCode {
 	// Status takes the thread ID of
 	// whatever it replies to, if set.
@@ -312,7 +390,7 @@ func (p *Processor) processThreadID(ctx context.Context, status *gtsmodel.Status
 	return nil
 }
 
-func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCreateRequest, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode {
+func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCreateRequest, requester *gtsmodel.Account, status *gtsmodel.Status) gtserror.WithCode {
 	if form.MediaIDs == nil {
 		return nil
 	}
@@ -320,6 +398,17 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCr
 	// Get minimum allowed char descriptions.
 	minChars := config.GetMediaDescriptionMinChars()
 
+	// Check whether either the instance or
+	// the requester insists on descriptions.
+	var requiredBy string
+	switch {
+	case config.GetMediaDescriptionRequired():
+		requiredBy = "this instance requires"
+	case requester.Settings != nil &&
+		util.PtrOrValue(requester.Settings.RequireMediaDescriptions, false):
+		requiredBy = "your settings require"
+	}
+
 	attachments := []*gtsmodel.MediaAttachment{}
 	attachmentIDs := []string{}
 
@@ -335,7 +424,7 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCr
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
-		if attachment.AccountID != thisAccountID {
+		if attachment.AccountID != requester.ID {
 			text := fmt.Sprintf("media %s does not belong to account", mediaID)
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
@@ -345,6 +434,14 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCr
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
+		if requiredBy != "" && strings.TrimSpace(attachment.Description) == "" {
+			text := fmt.Sprintf(
+				"media %s has no description: %s a description (alt text) on all media attachments",
+				mediaID, requiredBy,
+			)
+			return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+
 		if length := len([]rune(attachment.Description)); length < minChars {
 			text := fmt.Sprintf("media %s description too short, at least %d required", mediaID, minChars)
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
@@ -359,6 +456,51 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCr
 	return nil
 }
 
+func (p *Processor) processCircle(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.StatusCreateRequest,
+	status *gtsmodel.Status,
+) gtserror.WithCode {
+	if form.CircleID == "" {
+		// Not addressed
+		// to a circle.
+		return nil
+	}
+
+	switch form.Visibility {
+	case "":
+		// Default to private, as
+		// circles are only ever a
+		// subset of one's followers.
+		form.Visibility = apimodel.VisibilityPrivate
+
+	case apimodel.VisibilityPrivate:
+		// Fine.
+
+	default:
+		const text = "statuses addressed to a circle must have visibility private"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	circle, err := p.state.DB.GetCircleByID(
+		gtscontext.SetBarebones(ctx),
+		form.CircleID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting circle %s: %w", form.CircleID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if circle == nil || circle.AccountID != requester.ID {
+		const text = "circle not found"
+		return gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	status.CircleID = circle.ID
+	return nil
+}
+
 func (p *Processor) processVisibility(
 	ctx context.Context,
 	form *apimodel.StatusCreateRequest,
@@ -386,6 +528,13 @@ func (p *Processor) processVisibility(
 	// Set federated according to "local_only" field,
 	// assuming federated (ie., not local-only) by default.
 	localOnly := util.PtrOrValue(form.LocalOnly, false)
+
+	// Replies to local-only statuses are always local-only
+	// too, so as not to leak parts of the thread to remotes.
+	if status.InReplyTo != nil && status.InReplyTo.IsLocalOnly() {
+		localOnly = true
+	}
+
 	status.Federated = util.Ptr(!localOnly)
 
 	return nil
@@ -459,7 +608,7 @@ func processLanguage(form *apimodel.StatusCreateRequest, accountDefaultLanguage
 	if form.Language != "" {
 		status.Language = form.Language
 	} else {
-		status.Language = accountDefaultLanguage
+		status.Language = detectLanguage(form, accountDefaultLanguage)
 	}
 	if status.Language == "" {
 		return errors.New("no language given either in status create form or account default")
@@ -467,6 +616,26 @@ func processLanguage(form *apimodel.StatusCreateRequest, accountDefaultLanguage
 	return nil
 }
 
+// detectLanguage guesses the language of a status created
+// without one, falling back to the account default if the
+// status text doesn't give enough to go on.
+func detectLanguage(form *apimodel.StatusCreateRequest, accountDefaultLanguage string) string {
+	detected := language.Detect(form.SpoilerText + "\n" + form.Status)
+	if detected == "" {
+		return accountDefaultLanguage
+	}
+
+	// Prefer the account default if it's a more
+	// specific tag for the same language, eg.,
+	// keep "en-GB" rather than just "en".
+	if defaultBase, err := baseLanguage(accountDefaultLanguage); err == nil &&
+		defaultBase == detected {
+		return accountDefaultLanguage
+	}
+
+	return detected
+}
+
 func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.ParseMentionFunc, form *apimodel.StatusCreateRequest, status *gtsmodel.Status) error {
 	if form.ContentType == "" {
 		// If content type wasn't specified, use the author's preferred content-type.
@@ -493,10 +662,12 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 	// Format status according to text/plain.
 	case apimodel.StatusContentTypePlain:
 		format = p.formatter.FromPlain
+		status.ContentType = gtsmodel.StatusContentTypePlain
 
 	// Format status according to text/markdown.
 	case apimodel.StatusContentTypeMarkdown:
 		format = p.formatter.FromMarkdown
+		status.ContentType = gtsmodel.StatusContentTypeMarkdown
 
 	// Unknown.
 	default:
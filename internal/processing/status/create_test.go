@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -26,6 +27,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -147,6 +149,46 @@ func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownWithSpoilerTextEmoj
 	suite.NotEmpty(apiStatus.Emojis)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownSource() {
+	ctx := context.Background()
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "poopoo **peepee**",
+		MediaIDs:    []string{},
+		Poll:        nil,
+		InReplyToID: "",
+		Sensitive:   false,
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ScheduledAt: "",
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypeMarkdown,
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	suite.Equal("<p>poopoo <strong>peepee</strong></p>", apiStatus.Content)
+
+	// Content type should be stored
+	// and returned with the source.
+	dbStatus, dbErr := suite.state.DB.GetStatusByID(ctx, apiStatus.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.Equal(gtsmodel.StatusContentTypeMarkdown, dbStatus.ContentType)
+
+	source, errWithCode := suite.status.SourceGet(ctx, creatingAccount, apiStatus.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(apimodel.StatusContentTypeMarkdown, source.ContentType)
+	suite.True(strings.HasSuffix(source.Text, "poopoo **peepee**"))
+}
+
 func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	ctx := context.Background()
 
@@ -174,6 +216,58 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	suite.Nil(apiStatus)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionRequired() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Remove the description
+	// from the attachment.
+	attachment := new(gtsmodel.MediaAttachment)
+	*attachment = *suite.testAttachments["local_account_1_unattached_1"]
+	attachment.Description = ""
+	if err := suite.db.UpdateAttachment(ctx, attachment, "description"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	newForm := func() *apimodel.StatusCreateRequest {
+		return &apimodel.StatusCreateRequest{
+			Status:      "poopoo peepee",
+			MediaIDs:    []string{attachment.ID},
+			Visibility:  apimodel.VisibilityPublic,
+			LocalOnly:   util.Ptr(false),
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		}
+	}
+
+	// Required by the account's settings.
+	requiringAccount := new(gtsmodel.Account)
+	*requiringAccount = *creatingAccount
+	requiringAccount.Settings = new(gtsmodel.AccountSettings)
+	*requiringAccount.Settings = *creatingAccount.Settings
+	requiringAccount.Settings.RequireMediaDescriptions = util.Ptr(true)
+
+	apiStatus, err := suite.status.Create(ctx, requiringAccount, creatingApplication, newForm())
+	suite.EqualError(err, "media 01F8MH8RMYQ6MSNY3JM2XT1CQ5 has no description: your settings require a description (alt text) on all media attachments")
+	suite.Nil(apiStatus)
+
+	// Required by the instance.
+	config.SetMediaDescriptionRequired(true)
+
+	apiStatus, err = suite.status.Create(ctx, creatingAccount, creatingApplication, newForm())
+	suite.EqualError(err, "media 01F8MH8RMYQ6MSNY3JM2XT1CQ5 has no description: this instance requires a description (alt text) on all media attachments")
+	suite.Nil(apiStatus)
+
+	// Not required at all.
+	config.SetMediaDescriptionRequired(false)
+
+	apiStatus, err = suite.status.Create(ctx, creatingAccount, creatingApplication, newForm())
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 	ctx := context.Background()
 
@@ -201,6 +295,43 @@ func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 	suite.Equal("zh-Hans", *apiStatus.Language)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessNoLanguageDetected() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	for _, test := range []struct {
+		status   string
+		expected string
+	}{
+		// Enough to go on.
+		{status: "Das ist ein Beitrag über das Wetter, und es war heute schön.", expected: "de"},
+		{status: "今日はとても良い天気でした。", expected: "ja"},
+
+		// Detected English matches account
+		// default, so account default is used.
+		{status: "This is a post about the weather, and it was nice today.", expected: "en"},
+
+		// Too short to tell, account default is used.
+		{status: "boobies", expected: "en"},
+	} {
+		statusCreateForm := &apimodel.StatusCreateRequest{
+			Status:      test.status,
+			MediaIDs:    []string{},
+			Visibility:  apimodel.VisibilityPublic,
+			LocalOnly:   util.Ptr(false),
+			ContentType: apimodel.StatusContentTypePlain,
+		}
+
+		apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+		suite.NoError(err)
+		suite.NotNil(apiStatus)
+
+		suite.Equal(test.expected, *apiStatus.Language)
+	}
+}
+
 func (suite *StatusCreateTestSuite) TestProcessReplyToUnthreadedRemoteStatus() {
 	ctx := context.Background()
 
@@ -238,6 +369,77 @@ func (suite *StatusCreateTestSuite) TestProcessReplyToUnthreadedRemoteStatus() {
 	suite.NotEmpty(dbStatus.ThreadID)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessReplyToLocalOnlyStatus() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_2"]
+	creatingApplication := suite.testApplications["application_1"]
+	inReplyTo := suite.testStatuses["local_account_1_status_2"]
+
+	// Reply to a local-only status,
+	// without setting local_only.
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "hey!",
+		MediaIDs:    []string{},
+		Poll:        nil,
+		InReplyToID: inReplyTo.ID,
+		Sensitive:   false,
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ScheduledAt: "",
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	// Reply should be local-only too.
+	suite.True(apiStatus.LocalOnly)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessStatusToCircle() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	circle := &gtsmodel.Circle{
+		ID:        id.NewULID(),
+		Title:     "Close friends",
+		AccountID: creatingAccount.ID,
+	}
+	if err := suite.db.PutCircle(ctx, circle); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Circle statuses must be private.
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "just between us",
+		Visibility:  apimodel.VisibilityPublic,
+		CircleID:    circle.ID,
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.EqualError(errWithCode, "statuses addressed to a circle must have visibility private")
+	suite.Nil(apiStatus)
+
+	// Visibility defaults to private if unset.
+	statusCreateForm.Visibility = ""
+
+	apiStatus, errWithCode = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(errWithCode)
+	suite.Equal(apimodel.VisibilityPrivate, apiStatus.Visibility)
+	suite.Equal(circle.ID, *apiStatus.CircleID)
+
+	// Someone else's circle can't be used.
+	apiStatus, errWithCode = suite.status.Create(ctx, suite.testAccounts["local_account_2"], creatingApplication, statusCreateForm)
+	suite.EqualError(errWithCode, "circle not found")
+	suite.Nil(apiStatus)
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
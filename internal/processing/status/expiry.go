@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+const (
+	// expireEvery is how often expired
+	// statuses are looked for and deleted.
+	expireEvery = time.Hour
+
+	// expireBatch is the maximum number of statuses
+	// deleted per account each time expiry runs, to
+	// avoid flooding the workers (and remote instances)
+	// when an account first enables expiry.
+	expireBatch = 100
+)
+
+// ScheduleExpiry schedules statuses of accounts
+// that have status expiry enabled to be deleted
+// periodically once they're old enough.
+func (p *Processor) ScheduleExpiry() {
+	fn := func(ctx context.Context, start time.Time) {
+		log.Debug(ctx, "expiring statuses")
+		if err := p.Expire(ctx); err != nil {
+			log.Errorf(ctx, "error expiring statuses: %v", err)
+			return
+		}
+		log.Debugf(ctx, "finished expiring statuses after %s", time.Since(start))
+	}
+
+	log.Infof(nil, "scheduling status expiry to run every %s", expireEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@statusexpiry",
+		time.Now(),
+		expireEvery,
+		fn,
+	) {
+		panic("failed to schedule @statusexpiry")
+	}
+}
+
+// Expire looks for statuses of accounts with status expiry
+// enabled that are older than the account's configured number
+// of days, and queues them for deletion. Deletes are processed
+// (and federated) just as if the account had deleted them.
+func (p *Processor) Expire(ctx context.Context) error {
+	accountIDs, err := p.state.DB.GetAccountIDsWithStatusExpiry(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting accounts: %w", err)
+	}
+
+	errs := gtserror.NewMultiError(len(accountIDs))
+	for _, accountID := range accountIDs {
+		if err := p.expireAccountStatuses(ctx, accountID); err != nil {
+			errs.Appendf("error expiring statuses of account %s: %w", accountID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (p *Processor) expireAccountStatuses(ctx context.Context, accountID string) error {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return gtserror.Newf("db error getting account: %w", err)
+	}
+
+	if account.Settings == nil ||
+		account.Settings.StatusExpiryDays <= 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	olderThan := time.Now().AddDate(0, 0, -account.Settings.StatusExpiryDays)
+	statuses, err := p.state.DB.GetExpiredStatuses(ctx,
+		account.Settings,
+		olderThan,
+		expireBatch,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting expired statuses: %w", err)
+	}
+
+	for _, status := range statuses {
+		if status.BoostOfID != "" {
+			// Expired boosts are undone
+			// rather than being deleted.
+			p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+				APObjectType:   ap.ActivityAnnounce,
+				APActivityType: ap.ActivityUndo,
+				GTSModel:       status,
+				Origin:         account,
+				Target:         status.BoostOfAccount,
+			})
+			continue
+		}
+
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       status,
+			Origin:         account,
+			Target:         account,
+		})
+	}
+
+	if len(statuses) > 0 {
+		log.Debugf(ctx, "expired %d statuses of account %s", len(statuses), account.Username)
+	}
+
+	return nil
+}
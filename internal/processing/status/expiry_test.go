@@ -0,0 +1,163 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusExpiryTestSuite struct {
+	StatusStandardTestSuite
+}
+
+// expiredStatusIDs pops all queued client API messages,
+// returning the IDs of statuses queued for deletion or
+// unboosting, checking the activity type is appropriate.
+func (suite *StatusExpiryTestSuite) expiredStatusIDs() []string {
+	var ids []string
+	for {
+		msg, ok := suite.state.Workers.Client.Queue.Pop()
+		if !ok {
+			break
+		}
+
+		status, ok := msg.GTSModel.(*gtsmodel.Status)
+		if !ok {
+			suite.FailNow("", "unexpected model type %T", msg.GTSModel)
+		}
+
+		if status.BoostOfID != "" {
+			suite.Equal(ap.ActivityUndo, msg.APActivityType)
+		} else {
+			suite.Equal(ap.ActivityDelete, msg.APActivityType)
+		}
+
+		ids = append(ids, status.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (suite *StatusExpiryTestSuite) setExpiry(account *gtsmodel.Account, days int, keepPinned, keepSelfFaved bool) {
+	settings, err := suite.db.GetAccountSettings(context.Background(), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings.StatusExpiryDays = days
+	settings.StatusExpiryKeepPinned = util.Ptr(keepPinned)
+	settings.StatusExpiryKeepSelfFaved = util.Ptr(keepSelfFaved)
+
+	if err := suite.db.UpdateAccountSettings(context.Background(), settings,
+		"status_expiry_days",
+		"status_expiry_keep_pinned",
+		"status_expiry_keep_self_faved",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+// expectedStatusIDs returns IDs of test statuses of the
+// given account, excluding pinned / self-faved if requested.
+func (suite *StatusExpiryTestSuite) expectedStatusIDs(account *gtsmodel.Account, keepPinned, keepSelfFaved bool) []string {
+	var ids []string
+	for _, status := range suite.testStatuses {
+		if status.AccountID != account.ID {
+			continue
+		}
+
+		if keepPinned && !status.PinnedAt.IsZero() {
+			continue
+		}
+
+		if keepSelfFaved && suite.isSelfFaved(account, status) {
+			continue
+		}
+
+		ids = append(ids, status.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (suite *StatusExpiryTestSuite) isSelfFaved(account *gtsmodel.Account, status *gtsmodel.Status) bool {
+	for _, fave := range testrig.NewTestFaves() {
+		if fave.StatusID == status.ID &&
+			fave.AccountID == account.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *StatusExpiryTestSuite) TestExpireDisabled() {
+	if err := suite.status.Expire(context.Background()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(suite.expiredStatusIDs())
+}
+
+func (suite *StatusExpiryTestSuite) TestExpireKeepPinnedAndSelfFaved() {
+	account := suite.testAccounts["admin_account"]
+	suite.setExpiry(account, 7, true, true)
+
+	if err := suite.status.Expire(context.Background()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	expected := suite.expectedStatusIDs(account, true, true)
+	suite.NotEmpty(expected)
+	suite.Equal(expected, suite.expiredStatusIDs())
+}
+
+func (suite *StatusExpiryTestSuite) TestExpireAll() {
+	account := suite.testAccounts["admin_account"]
+	suite.setExpiry(account, 7, false, false)
+
+	if err := suite.status.Expire(context.Background()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	expected := suite.expectedStatusIDs(account, false, false)
+	suite.Equal(expected, suite.expiredStatusIDs())
+	suite.Greater(len(expected), len(suite.expectedStatusIDs(account, true, true)))
+}
+
+func (suite *StatusExpiryTestSuite) TestExpireNotOldEnough() {
+	account := suite.testAccounts["admin_account"]
+	suite.setExpiry(account, 3650, false, false)
+
+	if err := suite.status.Expire(context.Background()); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(suite.expiredStatusIDs())
+}
+
+func TestStatusExpiryTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusExpiryTestSuite))
+}
@@ -0,0 +1,237 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// HistoryGet gets edit history for the target status, taking account of privacy settings and blocks etc.
+// Revisions are returned oldest first, ending with the current revision. Each revision after the first
+// includes a diff describing what changed compared to the revision before it.
+func (p *Processor) HistoryGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) ([]*apimodel.StatusEdit, gtserror.WithCode) {
+	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requestingAccount,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiStatus, errWithCode := p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	edits, err := p.state.DB.GetStatusEdits(ctx, targetStatus.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting edits of status %s: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Current revision of
+	// the status goes last.
+	revisions := append(edits, gtsmodel.SnapshotStatus(targetStatus))
+
+	apiEdits := make([]*apimodel.StatusEdit, len(revisions))
+	for i, revision := range revisions {
+		if i == len(revisions)-1 {
+			// Current revision, we
+			// can use the API status.
+			apiEdits[i] = &apimodel.StatusEdit{
+				Content:          apiStatus.Content,
+				SpoilerText:      apiStatus.SpoilerText,
+				Sensitive:        apiStatus.Sensitive,
+				CreatedAt:        util.FormatISO8601(targetStatus.UpdatedAt),
+				Account:          apiStatus.Account,
+				Poll:             apiStatus.Poll,
+				MediaAttachments: apiStatus.MediaAttachments,
+				Emojis:           apiStatus.Emojis,
+			}
+		} else {
+			apiEdits[i] = p.revisionToAPIStatusEdit(ctx, revision, apiStatus)
+		}
+
+		if i > 0 {
+			apiEdits[i].Diff = diffRevisions(revisions[i-1], revision)
+		}
+	}
+
+	return apiEdits, nil
+}
+
+// revisionToAPIStatusEdit converts the given previous
+// revision of a status to its frontend representation.
+// Account and emojis are taken from the current status.
+func (p *Processor) revisionToAPIStatusEdit(
+	ctx context.Context,
+	revision *gtsmodel.StatusEdit,
+	apiStatus *apimodel.Status,
+) *apimodel.StatusEdit {
+	apiEdit := &apimodel.StatusEdit{
+		Content:          revision.Content,
+		SpoilerText:      revision.ContentWarning,
+		Sensitive:        *revision.Sensitive,
+		CreatedAt:        util.FormatISO8601(revision.CreatedAt),
+		Account:          apiStatus.Account,
+		MediaAttachments: []*apimodel.Attachment{},
+		Emojis:           apiStatus.Emojis,
+	}
+
+	if len(revision.AttachmentIDs) > 0 {
+		// Attachments removed since this revision
+		// may since have been cleaned up, so just
+		// include whichever ones we still have.
+		attachments, err := p.state.DB.GetAttachmentsByIDs(ctx, revision.AttachmentIDs)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting attachments: %v", err)
+		}
+
+		for _, attachment := range attachments {
+			apiAttachment, err := p.converter.AttachmentToAPIAttachment(ctx, attachment)
+			if err != nil {
+				log.Errorf(ctx, "error converting attachment %s: %v", attachment.ID, err)
+				continue
+			}
+
+			// Use the description the
+			// attachment had at the time.
+			i := slices.Index(revision.AttachmentIDs, attachment.ID)
+			if i < len(revision.AttachmentDescriptions) {
+				apiAttachment.Description = util.Ptr(revision.AttachmentDescriptions[i])
+			}
+
+			apiEdit.MediaAttachments = append(apiEdit.MediaAttachments, &apiAttachment)
+		}
+	}
+
+	if len(revision.PollOptions) > 0 {
+		// Votes are reset when poll
+		// options change, so only
+		// include the options.
+		apiEdit.Poll = &apimodel.Poll{
+			Multiple: *revision.PollMultiple,
+			Options:  make([]apimodel.PollOption, len(revision.PollOptions)),
+			Emojis:   []apimodel.Emoji{},
+		}
+		for i, option := range revision.PollOptions {
+			apiEdit.Poll.Options[i].Title = option
+		}
+	}
+
+	return apiEdit
+}
+
+// diffRevisions returns what changed between
+// the given previous and next status revisions.
+func diffRevisions(prev, next *gtsmodel.StatusEdit) *apimodel.StatusEditDiff {
+	diff := &apimodel.StatusEditDiff{
+		Content: diffText(
+			text.SanitizeToSearchText("", prev.Content),
+			text.SanitizeToSearchText("", next.Content),
+		),
+		SpoilerText:      diffText(prev.ContentWarning, next.ContentWarning),
+		SensitiveChanged: *prev.Sensitive != *next.Sensitive,
+		MediaAttachments: apimodel.StatusEditMediaDiff{
+			Added:              []string{},
+			Removed:            []string{},
+			DescriptionChanged: []string{},
+		},
+	}
+
+	// IDs of attachments in both revisions,
+	// in the order of each revision.
+	var keptPrev, keptNext []string
+
+	for i, id := range prev.AttachmentIDs {
+		j := slices.Index(next.AttachmentIDs, id)
+		if j == -1 {
+			diff.MediaAttachments.Removed = append(diff.MediaAttachments.Removed, id)
+			continue
+		}
+
+		keptPrev = append(keptPrev, id)
+
+		if i < len(prev.AttachmentDescriptions) &&
+			j < len(next.AttachmentDescriptions) &&
+			prev.AttachmentDescriptions[i] != next.AttachmentDescriptions[j] {
+			diff.MediaAttachments.DescriptionChanged = append(diff.MediaAttachments.DescriptionChanged, id)
+		}
+	}
+
+	for _, id := range next.AttachmentIDs {
+		if slices.Contains(prev.AttachmentIDs, id) {
+			keptNext = append(keptNext, id)
+		} else {
+			diff.MediaAttachments.Added = append(diff.MediaAttachments.Added, id)
+		}
+	}
+
+	diff.MediaAttachments.Reordered = !slices.Equal(keptPrev, keptNext)
+
+	if len(prev.PollOptions) > 0 || len(next.PollOptions) > 0 {
+		diff.Poll = &apimodel.StatusEditPollDiff{
+			Added:   []string{},
+			Removed: []string{},
+
+			// Only counts as changed if there's a poll before and after,
+			// otherwise the whole poll was just added or removed.
+			MultipleChanged: len(prev.PollOptions) > 0 &&
+				len(next.PollOptions) > 0 &&
+				*prev.PollMultiple != *next.PollMultiple,
+		}
+
+		for _, option := range prev.PollOptions {
+			if !slices.Contains(next.PollOptions, option) {
+				diff.Poll.Removed = append(diff.Poll.Removed, option)
+			}
+		}
+
+		for _, option := range next.PollOptions {
+			if !slices.Contains(prev.PollOptions, option) {
+				diff.Poll.Added = append(diff.Poll.Added, option)
+			}
+		}
+	}
+
+	return diff
+}
+
+// diffText returns a word-level diff of the given texts.
+func diffText(prev, next string) []apimodel.TextDiffChunk {
+	chunks := text.DiffWords(prev, next)
+	apiChunks := make([]apimodel.TextDiffChunk, len(chunks))
+	for i, chunk := range chunks {
+		apiChunks[i] = apimodel.TextDiffChunk{
+			Type: chunk.Op.String(),
+			Text: chunk.Text,
+		}
+	}
+	return apiChunks
+}
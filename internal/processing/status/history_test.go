@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type HistoryTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *HistoryTestSuite) TestHistoryGetNoEdits() {
+	var (
+		ctx             = context.Background()
+		requester       = suite.testAccounts["admin_account"]
+		targetStatus    = suite.testStatuses["admin_account_status_1"]
+		attachmentCount = len(targetStatus.AttachmentIDs)
+	)
+
+	edits, errWithCode := suite.status.HistoryGet(ctx, requester, targetStatus.ID)
+	suite.NoError(errWithCode)
+
+	if suite.Len(edits, 1) {
+		suite.Equal(targetStatus.Content, edits[0].Content)
+		suite.Len(edits[0].MediaAttachments, attachmentCount)
+		suite.Nil(edits[0].Diff)
+	}
+}
+
+func (suite *HistoryTestSuite) TestHistoryGetWithEdits() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["admin_account"]
+		targetStatus = suite.testStatuses["admin_account_status_1"]
+	)
+
+	// Store a previous revision of the status,
+	// with different content, a content warning,
+	// a poll, and no media attachments.
+	if err := suite.db.PutStatusEdit(ctx, &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		CreatedAt:      targetStatus.CreatedAt.Add(-time.Hour),
+		StatusID:       targetStatus.ID,
+		Content:        "hello world! first post :rainbow: !",
+		ContentWarning: "first post",
+		Sensitive:      util.Ptr(false),
+		PollOptions:    []string{"yes", "no"},
+		PollMultiple:   util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	edits, errWithCode := suite.status.HistoryGet(ctx, requester, targetStatus.ID)
+	suite.NoError(errWithCode)
+
+	if !suite.Len(edits, 2) {
+		suite.FailNow("")
+	}
+
+	// Oldest revision first, with no diff.
+	previous := edits[0]
+	suite.Equal("hello world! first post :rainbow: !", previous.Content)
+	suite.Equal("first post", previous.SpoilerText)
+	suite.Empty(previous.MediaAttachments)
+	if suite.NotNil(previous.Poll) {
+		suite.Equal([]apimodel.PollOption{{Title: "yes"}, {Title: "no"}}, previous.Poll.Options)
+	}
+	suite.Nil(previous.Diff)
+
+	// Current revision last, diffed
+	// against the previous revision.
+	current := edits[1]
+	suite.Equal(targetStatus.Content, current.Content)
+	suite.Len(current.MediaAttachments, 1)
+
+	diff := current.Diff
+	if !suite.NotNil(diff) {
+		suite.FailNow("")
+	}
+
+	suite.Equal([]apimodel.TextDiffChunk{
+		{Type: "equal", Text: "hello world! "},
+		{Type: "insert", Text: "#welcome ! "},
+		{Type: "equal", Text: "first post"},
+		{Type: "insert", Text: " on the instance"},
+		{Type: "equal", Text: " :rainbow: !"},
+	}, diff.Content)
+	suite.Equal([]apimodel.TextDiffChunk{
+		{Type: "delete", Text: "first post"},
+	}, diff.SpoilerText)
+	suite.Equal([]string{"01F8MH6NEM8D7527KZAECTCR76"}, diff.MediaAttachments.Added)
+	suite.Empty(diff.MediaAttachments.Removed)
+	suite.False(diff.MediaAttachments.Reordered)
+	if suite.NotNil(diff.Poll) {
+		suite.Equal([]string{"yes", "no"}, diff.Poll.Removed)
+		suite.Empty(diff.Poll.Added)
+		suite.False(diff.Poll.MultipleChanged)
+	}
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HistoryTestSuite))
+}
@@ -24,6 +24,8 @@
 	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -189,3 +191,69 @@ func (p *Processor) PinRemove(ctx context.Context, requestingAccount *gtsmodel.A
 
 	return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 }
+
+// PinsReorder sets the order of requestingAccount's pinned statuses,
+// as shown on their profile and in their Featured ActivityPub collection,
+// to the given order of status IDs, first being shown first.
+//
+// statusIDs must contain each of the account's pinned statuses exactly once,
+// otherwise code 422 Unprocessable Entity will be returned.
+//
+// Pinned statuses are ordered by their pinned_at time, newest first, so
+// the order is persisted by rewriting pinned_at. Statuses pinned later
+// on will therefore still be shown first, as with normal pins.
+func (p *Processor) PinsReorder(ctx context.Context, requestingAccount *gtsmodel.Account, statusIDs []string) ([]apimodel.Status, gtserror.WithCode) {
+	// Get a lock on this account.
+	unlock := p.state.ProcessingLocks.Lock(requestingAccount.URI)
+	defer unlock()
+
+	pinned, err := p.state.DB.GetAccountPinnedStatuses(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting pinned statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Index pinned statuses by ID.
+	pinnedByID := make(map[string]*gtsmodel.Status, len(pinned))
+	for _, status := range pinned {
+		pinnedByID[status.ID] = status
+	}
+
+	// Put statuses in the requested order, making
+	// sure each pinned status is included once.
+	ordered := make([]*gtsmodel.Status, 0, len(statusIDs))
+	for _, statusID := range statusIDs {
+		status, ok := pinnedByID[statusID]
+		if !ok {
+			err := fmt.Errorf("status %s is not pinned, or is included more than once", statusID)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		delete(pinnedByID, statusID)
+		ordered = append(ordered, status)
+	}
+
+	if len(pinnedByID) != 0 {
+		err := fmt.Errorf("%d pinned status(es) missing from new order, include all of your pinned statuses", len(pinnedByID))
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Space pinned_at times a millisecond
+	// apart, with the first status newest.
+	now := time.Now()
+	for i, status := range ordered {
+		status.PinnedAt = now.Add(-time.Duration(i) * time.Millisecond)
+		if err := p.state.DB.UpdateStatus(ctx, status, "pinned_at"); err != nil {
+			err = gtserror.Newf("db error updating pinned status: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.c.GetVisibleAPIStatuses(ctx,
+		requestingAccount,
+		ordered,
+		statusfilter.FilterContextNone,
+		nil, // no filters
+		nil, // no mutes
+	), nil
+}
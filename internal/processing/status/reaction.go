@@ -0,0 +1,274 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// parseReactionName returns the reaction name for the given
+// emoji, as provided by a client: either a unicode emoji, which
+// is used as-is, or a custom emoji shortcode, optionally wrapped
+// in colons, which is used without them.
+func parseReactionName(emoji string) (string, bool) {
+	if err := validate.UnicodeEmoji(emoji); err == nil {
+		return emoji, false
+	}
+
+	shortcode := strings.TrimSuffix(strings.TrimPrefix(emoji, ":"), ":")
+	return shortcode, true
+}
+
+func (p *Processor) getReactableStatus(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetID string,
+	name string,
+) (
+	*gtsmodel.Status,
+	*gtsmodel.StatusReaction,
+	gtserror.WithCode,
+) {
+	// Get target status and ensure it's not a boost.
+	target, errWithCode := p.c.GetVisibleTargetStatus(
+		ctx,
+		requester,
+		targetID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	target, errWithCode = p.c.UnwrapIfBoost(
+		ctx,
+		requester,
+		target,
+	)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	reaction, err := p.state.DB.GetStatusReaction(ctx, requester.ID, target.ID, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error checking existing reaction: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return target, reaction, nil
+}
+
+// ReactionCreate adds an emoji reaction for the requester, targeting the
+// given status (no-op if the requester already reacted with this emoji).
+//
+// The emoji may be either a unicode emoji, or the shortcode of an enabled
+// local custom emoji, optionally wrapped in colons.
+func (p *Processor) ReactionCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	emoji string,
+) (*apimodel.Status, gtserror.WithCode) {
+	name, custom := parseReactionName(emoji)
+
+	var customEmoji *gtsmodel.Emoji
+	if custom {
+		if err := validate.EmojiShortcode(name); err != nil {
+			err := fmt.Errorf("%s is neither a unicode emoji nor a custom emoji shortcode", emoji)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		var err error
+		customEmoji, err = p.state.DB.GetEmojiByShortcodeDomain(ctx, name, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting emoji %s: %w", name, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if customEmoji == nil || *customEmoji.Disabled {
+			err := fmt.Errorf("custom emoji %s not found", name)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	status, existing, errWithCode := p.getReactableStatus(ctx, requester, targetStatusID, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existing != nil {
+		// Already reacted with this emoji.
+		return p.c.GetAPIStatus(ctx, requester, status)
+	}
+
+	// Reactions are subject to the
+	// same policy as Likes of a status.
+	policyResult, err := p.intFilter.StatusLikeable(ctx,
+		requester,
+		status,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is likeable: %w", status.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// There's no way to have a reaction approved
+	// after the fact, so only allow reactions where
+	// a Like would be permitted without approval.
+	if !policyResult.Permitted() {
+		const errText = "you do not have permission to react to this status"
+		err := gtserror.New(errText)
+		return nil, gtserror.NewErrorForbidden(err, errText)
+	}
+
+	reactionID := id.NewULID()
+	reaction := &gtsmodel.StatusReaction{
+		ID:              reactionID,
+		AccountID:       requester.ID,
+		Account:         requester,
+		TargetAccountID: status.AccountID,
+		TargetAccount:   status.Account,
+		StatusID:        status.ID,
+		Status:          status,
+		Name:            name,
+		URI:             uris.GenerateURIForLike(requester.Username, reactionID),
+	}
+
+	if customEmoji != nil {
+		reaction.EmojiID = customEmoji.ID
+		reaction.Emoji = customEmoji
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		err = gtserror.Newf("db error putting reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process new reaction side effects.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		Origin:         requester,
+		Target:         status.Account,
+	})
+
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// ReactionRemove removes an emoji reaction of the requester, targeting the given
+// status (no-op if the requester hadn't reacted to the status with this emoji).
+func (p *Processor) ReactionRemove(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	emoji string,
+) (*apimodel.Status, gtserror.WithCode) {
+	name, _ := parseReactionName(emoji)
+
+	status, existing, errWithCode := p.getReactableStatus(ctx, requester, targetStatusID, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existing == nil {
+		// Not reacted with this emoji.
+		return p.c.GetAPIStatus(ctx, requester, status)
+	}
+
+	// We have a reaction to remove.
+	if err := p.state.DB.DeleteStatusReactionByID(ctx, existing.ID); err != nil {
+		err = gtserror.Newf("db error removing reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process remove reaction side effects.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       existing,
+		Origin:         requester,
+		Target:         status.Account,
+	})
+
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// ReactionsGet returns the emoji reactions to the given status, grouped by
+// emoji, along with the accounts that reacted, filtered according to blocks.
+func (p *Processor) ReactionsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+) ([]apimodel.StatusReaction, gtserror.WithCode) {
+	status, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	reactions, err := p.state.DB.GetStatusReactions(ctx, status.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Only show the requester reactions from
+	// accounts they don't block, and which
+	// don't block them.
+	visible := make([]*gtsmodel.StatusReaction, 0, len(reactions))
+	for _, reaction := range reactions {
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, reaction.AccountID)
+		if err != nil {
+			err := gtserror.Newf("error checking blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if !blocked {
+			visible = append(visible, reaction)
+		}
+	}
+
+	apiReactions, err := p.converter.StatusReactionsToAPIStatusReactions(ctx,
+		visible,
+		requester,
+		true,
+	)
+	if err != nil {
+		err := gtserror.Newf("error converting reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiReactions, nil
+}
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Refresh re-dereferences the given status from its origin server if it's
+// remote, updating its replies / boosts / faves counts and poll tallies,
+// and returns the updated status. For local statuses, this is the same
+// as just getting the status.
+func (p *Processor) Refresh(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+) (*apimodel.Status, gtserror.WithCode) {
+	target, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Redirect to wrapped status if boost.
+	target, errWithCode = p.c.UnwrapIfBoost(
+		ctx,
+		requester,
+		target,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !target.IsLocal() {
+		latest, err := p.federator.RefreshStatusStats(ctx,
+			requester.Username,
+			target,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error refreshing status %s stats: %v", target.URI, err)
+		}
+
+		if latest == nil {
+			// Status was deleted
+			// at its origin server.
+			const text = "target status not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+
+		target = latest
+
+		// Counts may have changed, so uncache
+		// the prepared version from requester's
+		// timelines, where they'll see it next.
+		if err := p.c.InvalidateTimelinedStatus(ctx, requester.ID, target.ID); err != nil {
+			err = gtserror.Newf("error invalidating status from timelines: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.c.GetAPIStatus(ctx, requester, target)
+}
@@ -18,6 +18,8 @@
 package status
 
 import (
+	"codeberg.org/gruf/go-cache/v3"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/interaction"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
@@ -27,6 +29,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
@@ -42,6 +45,11 @@ type Processor struct {
 	formatter    *text.Formatter
 	parseMention gtsmodel.ParseMentionFunc
 
+	// translator is nil if no
+	// translation provider is set.
+	translator   translate.Translator
+	translations cache.TTLCache[string, *apimodel.Translation]
+
 	// other processors
 	polls   *polls.Processor
 	intReqs *interactionrequests.Processor
@@ -68,6 +76,8 @@ func New(
 		intFilter:    intFilter,
 		formatter:    text.NewFormatter(state.DB),
 		parseMention: parseMention,
+		translator:   translate.New(),
+		translations: newTranslationCache(),
 		polls:        polls,
 		intReqs:      intReqs,
 	}
@@ -0,0 +1,227 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"codeberg.org/gruf/go-cache/v3"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+	"golang.org/x/text/language"
+)
+
+func newTranslationCache() cache.TTLCache[string, *apimodel.Translation] {
+	translationCache := cache.NewTTL[string, *apimodel.Translation](0, 1000, 0)
+	translationCache.SetTTL(time.Hour, false)
+	if !translationCache.Start(time.Minute) {
+		log.Panic(nil, "could not start translationCache")
+	}
+	return translationCache
+}
+
+// Translate translates the given status into the requested
+// language (or the requester's preferred language if none is
+// requested), using the configured translation provider.
+//
+// Only public and unlisted statuses can be translated, since
+// translating means sending the status to a third party.
+func (p *Processor) Translate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	lang string,
+) (*apimodel.Translation, gtserror.WithCode) {
+	if p.translator == nil {
+		const text = "translations are not enabled on this instance"
+		return nil, gtserror.NewErrorNotImplemented(errors.New(text), text)
+	}
+
+	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Translate the original if boost.
+	targetStatus, errWithCode = p.c.UnwrapIfBoost(
+		ctx,
+		requester,
+		targetStatus,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if targetStatus.Visibility != gtsmodel.VisibilityPublic &&
+		targetStatus.Visibility != gtsmodel.VisibilityUnlocked {
+		const text = "only public or unlisted statuses can be translated"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Figure out which language to translate
+	// into, falling back to the requester's
+	// preferred language if none was given.
+	if lang == "" && requester.Settings != nil {
+		lang = requester.Settings.Language
+	}
+	if lang == "" {
+		lang = "en"
+	}
+
+	targetLang, err := baseLanguage(lang)
+	if err != nil {
+		text := "invalid language " + lang
+		return nil, gtserror.NewErrorBadRequest(err, text)
+	}
+
+	// Status language may be unset (or invalid, if
+	// it came from a remote), in which case we let
+	// the provider figure it out.
+	sourceLang, _ := baseLanguage(targetStatus.Language)
+	if sourceLang == targetLang {
+		const text = "status is already in the requested language"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Include the time the status was last updated in
+	// the key, so translations of edited statuses
+	// aren't served from the cache.
+	key := targetStatus.ID + "/" + targetLang + "/" +
+		strconv.FormatInt(targetStatus.UpdatedAt.Unix(), 10)
+
+	if translation, ok := p.translations.Get(key); ok {
+		return translation, nil
+	}
+
+	translation, errWithCode := p.translateStatus(ctx, targetStatus, sourceLang, targetLang)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.translations.Set(key, translation)
+	return translation, nil
+}
+
+// translateStatus translates all the translatable parts
+// of the given status in one request to the provider.
+func (p *Processor) translateStatus(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	sourceLang string,
+	targetLang string,
+) (*apimodel.Translation, gtserror.WithCode) {
+	translation := &apimodel.Translation{
+		MediaAttachments: make([]apimodel.TranslationAttachment, len(status.Attachments)),
+		Language:         targetLang,
+		Provider:         p.translator.Provider(),
+	}
+
+	// Gather pointers to each non-empty field of the
+	// translation alongside the text to translate,
+	// so we can fill in results in the same order.
+	//
+	// Results come from a third party, so sanitize
+	// them the same as we would any remote status.
+	var (
+		texts     []string
+		dsts      []*string
+		sanitizes []func(string) string
+	)
+
+	add := func(text string, dst *string, sanitize func(string) string) {
+		if text != "" {
+			texts = append(texts, text)
+			dsts = append(dsts, dst)
+			sanitizes = append(sanitizes, sanitize)
+		}
+	}
+
+	add(status.Content, &translation.Content, text.SanitizeToHTML)
+	add(status.ContentWarning, &translation.SpoilerText, text.SanitizeToPlaintext)
+
+	if status.Poll != nil {
+		translation.Poll = &apimodel.TranslationPoll{
+			ID:      status.Poll.ID,
+			Options: make([]apimodel.TranslationPollOption, len(status.Poll.Options)),
+		}
+
+		for i, option := range status.Poll.Options {
+			add(option, &translation.Poll.Options[i].Title, text.SanitizeToPlaintext)
+		}
+	}
+
+	for i, attachment := range status.Attachments {
+		translation.MediaAttachments[i].ID = attachment.ID
+		add(attachment.Description, &translation.MediaAttachments[i].Description, text.SanitizeToPlaintext)
+	}
+
+	if len(texts) == 0 {
+		const errText = "status has no content to translate"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(errText), errText)
+	}
+
+	result, err := p.translator.Translate(ctx, texts, sourceLang, targetLang)
+	switch {
+	case errors.Is(err, translate.ErrUnsupported):
+		errText := "translating from " + sourceLang + " to " + targetLang + " is not supported"
+		if sourceLang == "" {
+			errText = "translating to " + targetLang + " is not supported"
+		}
+		return nil, gtserror.NewErrorUnprocessableEntity(err, errText)
+
+	case err != nil:
+		err := gtserror.Newf("error translating status %s: %w", status.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for i, translated := range result.Texts {
+		*dsts[i] = sanitizes[i](translated)
+	}
+
+	translation.DetectedSourceLanguage = result.SourceLanguage
+	return translation, nil
+}
+
+// baseLanguage returns the base ISO 639
+// language code of the given BCP47 tag,
+// eg., "en" for "en-GB", which is what
+// translation providers expect.
+func baseLanguage(lang string) (string, error) {
+	if lang == "" {
+		return "", errors.New("no language provided")
+	}
+
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return "", err
+	}
+
+	base, _ := tag.Base()
+	return base.String(), nil
+}
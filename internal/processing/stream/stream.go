@@ -19,6 +19,7 @@
 
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/pubsub"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
@@ -36,3 +37,14 @@ func New(state *state.State, oauthServer oauth.Server) Processor {
 		streams:     stream.Streams{},
 	}
 }
+
+// SetBus sets a bus over which streamed messages are
+// fanned-out to (and from) other GoToSocial processes.
+func (p *Processor) SetBus(bus pubsub.Bus) {
+	p.streams.SetBus(bus)
+}
+
+// Stop stops fanning-out streamed messages, if set.
+func (p *Processor) Stop() {
+	p.streams.Stop()
+}
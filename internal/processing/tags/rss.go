@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gorilla/feeds"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	rssFeedLength = 20
+)
+
+// GetRSSFeed returns a function to return the RSS feed of recent public
+// posts by local accounts using the tag with the given name, and the
+// last-modified time (time that the most recent of those posts was made).
+//
+// To save work, callers to this function should only call the returned
+// func if the last-modified time is newer than the one they have cached.
+//
+// If there are no eligible posts, the returned last-modified time will be
+// zero, and the returned func will return a valid RSS xml with no items.
+func (p *Processor) GetRSSFeed(ctx context.Context, name string) (func() (string, gtserror.WithCode), time.Time, gtserror.WithCode) {
+	var (
+		never = time.Time{}
+	)
+
+	if !config.GetInstanceExposeTagRSS() {
+		err := gtserror.New("tag RSS feeds not enabled")
+		return nil, never, gtserror.NewErrorNotFound(err)
+	}
+
+	// Normalize + validate tag name.
+	normalized, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := gtserror.Newf("string '%s' could not be normalized to a valid hashtag", name)
+		return nil, never, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	name = normalized
+
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting tag %s: %w", name, err)
+		return nil, never, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil || !*tag.Listable {
+		// Tag not known here, or not allowed to be
+		// shown publicly; treat both cases the same.
+		err := gtserror.Newf("tag %s not found", name)
+		return nil, never, gtserror.NewErrorNotFound(err)
+	}
+
+	statuses, err := p.state.DB.GetTagWebStatuses(ctx, tag.ID, rssFeedLength)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting statuses for tag %s: %w", name, err)
+		return nil, never, gtserror.NewErrorInternalError(err)
+	}
+
+	// LastModified time is needed by callers to check freshness
+	// for cacheing. Statuses are sorted newest first; if there
+	// are none then this will be a zero time.Time, that's fine.
+	var lastPostAt time.Time
+	if len(statuses) > 0 {
+		lastPostAt = statuses[0].CreatedAt
+	}
+
+	return func() (string, gtserror.WithCode) {
+		tagURL := config.GetProtocol() + "://" + config.GetHost() + "/tags/" + tag.Name
+
+		feed := &feeds.Feed{
+			Title:       "#" + tag.Name + " on " + config.GetHost(),
+			Description: "Public posts tagged #" + tag.Name + " by accounts on " + config.GetHost(),
+			Link:        &feeds.Link{Href: tagURL},
+		}
+
+		// If there are no posts, use tag creation time
+		// as the Updated value for the feed, since we
+		// want something determinate for cacheing.
+		if lastPostAt.IsZero() {
+			feed.Updated = tag.CreatedAt
+		} else {
+			feed.Updated = lastPostAt
+		}
+
+		// Add each status to the rss feed.
+		for _, status := range statuses {
+			item, err := p.converter.StatusToRSSItem(ctx, status)
+			if err != nil {
+				err = gtserror.Newf("error converting status to feed item: %w", err)
+				return "", gtserror.NewErrorInternalError(err)
+			}
+
+			feed.Add(item)
+		}
+
+		// Stringify the feed. Even with no statuses,
+		// this will still produce valid rss xml.
+		rss, err := feed.ToRss()
+		if err != nil {
+			err := gtserror.Newf("error converting feed to rss string: %w", err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+
+		return rss, nil
+	}, lastPostAt, nil
+}
@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// TermsOfServiceGet returns the terms of service version with
+// the given ID, or the version currently in effect if id is empty.
+func (p *Processor) TermsOfServiceGet(ctx context.Context, id string) (*apimodel.TermsOfService, gtserror.WithCode) {
+	history, errWithCode := p.TermsOfServiceHistoryGet(ctx)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	for _, tos := range history {
+		if id == "" || tos.ID == id {
+			return tos, nil
+		}
+	}
+
+	const text = "terms of service not found"
+	return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+}
+
+// TermsOfServiceHistoryGet returns every
+// published terms of service version, newest
+// first, so the version in effect comes first.
+func (p *Processor) TermsOfServiceHistoryGet(ctx context.Context) ([]*apimodel.TermsOfService, gtserror.WithCode) {
+	versions, err := p.state.DB.GetAllTermsOfService(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting terms of service: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Versions are sorted newest first,
+	// so the version that replaced any
+	// given version is the one before it.
+	history := make([]*apimodel.TermsOfService, 0, len(versions))
+	for i, tos := range versions {
+		var next *gtsmodel.TermsOfService
+		if i > 0 {
+			next = versions[i-1]
+		}
+
+		history = append(history, p.converter.TermsOfServiceToAPITermsOfService(tos, next))
+	}
+
+	return history, nil
+}
+
+// TermsOfServiceVersionsGet returns every published
+// terms of service version, newest first, for admins.
+func (p *Processor) TermsOfServiceVersionsGet(ctx context.Context) ([]*apimodel.AdminTermsOfService, gtserror.WithCode) {
+	versions, err := p.state.DB.GetAllTermsOfService(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting terms of service: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiVersions := make([]*apimodel.AdminTermsOfService, 0, len(versions))
+	for i, tos := range versions {
+		apiVersions = append(apiVersions, p.converter.TermsOfServiceToAdminAPITermsOfService(tos, i == 0))
+	}
+
+	return apiVersions, nil
+}
+
+// TermsOfServiceCreate publishes a new version of the terms of
+// service, which comes into effect immediately. The instance
+// terms shown on the about page are updated to match.
+func (p *Processor) TermsOfServiceCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AdminTermsOfServiceCreateRequest,
+) (*apimodel.AdminTermsOfService, gtserror.WithCode) {
+	if form.Text == "" {
+		const text = "text must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if err := validate.SiteTerms(form.Text); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	instance, err := p.getThisInstance(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error fetching instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Parse terms as Markdown, keep
+	// the raw version for later editing.
+	instance.TermsText = form.Text
+	instance.Terms = p.formatter.FromMarkdown(ctx, p.parseMentionFunc, "", "", form.Text).HTML
+	if err := p.state.DB.UpdateInstance(ctx, instance, "terms", "terms_text"); err != nil {
+		err := gtserror.Newf("db error updating instance: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	tos, err := p.putTermsOfService(ctx, adminAcct, instance, form.RequireAcceptance)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.admin.LogAction(ctx, adminAcct, gtsmodel.AuditActionCreate, gtsmodel.AuditTargetTermsOfService, tos.ID, "")
+
+	return p.converter.TermsOfServiceToAdminAPITermsOfService(tos, true), nil
+}
+
+// putTermsOfService stores the current terms of the given
+// instance as a new terms of service version, so that the
+// history of changes to the terms is kept.
+func (p *Processor) putTermsOfService(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	instance *gtsmodel.Instance,
+	requireAcceptance bool,
+) (*gtsmodel.TermsOfService, error) {
+	tos := &gtsmodel.TermsOfService{
+		ID:                 id.NewULID(),
+		Text:               instance.TermsText,
+		Content:            instance.Terms,
+		RequireAcceptance:  util.Ptr(requireAcceptance),
+		CreatedByAccountID: adminAcct.ID,
+	}
+
+	if err := p.state.DB.PutTermsOfService(ctx, tos); err != nil {
+		return nil, gtserror.Newf("db error putting terms of service: %w", err)
+	}
+
+	return tos, nil
+}
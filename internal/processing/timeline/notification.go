@@ -184,7 +184,11 @@ func (p *Processor) notifVisible(
 		// If this is a new local account sign-up,
 		// skip normal visibility checking because
 		// origin account won't be confirmed yet.
-		if n.NotificationType == gtsmodel.NotificationSignup {
+		//
+		// Likewise for appeals, as the origin
+		// account may be disabled or silenced.
+		if n.NotificationType == gtsmodel.NotificationSignup ||
+			n.NotificationType == gtsmodel.NotificationAdminAppeal {
 			return true, nil
 		}
 
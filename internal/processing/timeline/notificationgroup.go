@@ -0,0 +1,554 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/status"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+const (
+	// notifGroupsBatch is the number of notifications
+	// fetched from the database at a time while
+	// building up a page of notification groups.
+	notifGroupsBatch = 80
+
+	// notifGroupsMaxBatches bounds the number of batches
+	// fetched for one page of notification groups, so
+	// that a single request can't trawl the whole table.
+	notifGroupsMaxBatches = 5
+
+	// notifGroupSampleAccounts is the max number
+	// of sample account IDs set on a group.
+	notifGroupSampleAccounts = 8
+
+	// notifGroupWindowMs is the window of time, in
+	// milliseconds, within which groupable notifications
+	// of the same kind are collapsed into one group.
+	notifGroupWindowMs = 24 * 60 * 60 * 1000
+
+	// ungroupedPrefix prefixes the group
+	// key of notifications that aren't grouped.
+	ungroupedPrefix = "ungrouped-"
+)
+
+// groupableNotifTypes are the notification types
+// that can be collapsed into notification groups.
+// These are also the types grouped by default.
+var groupableNotifTypes = []gtsmodel.NotificationType{
+	gtsmodel.NotificationFave,
+	gtsmodel.NotificationFollow,
+	gtsmodel.NotificationReblog,
+}
+
+// NotificationGroupsGet returns a page of notifications for the authorized
+// account, with notifications of groupedTypes collapsed into groups.
+//
+// The page limit applies to the number of groups returned, not the
+// number of underlying notifications. If groupedTypes is empty, the
+// default groupable types (favourite, follow, reblog) are grouped.
+func (p *Processor) NotificationGroupsGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+	page *paging.Page,
+	types []gtsmodel.NotificationType,
+	excludeTypes []gtsmodel.NotificationType,
+	groupedTypes []gtsmodel.NotificationType,
+) (*apimodel.GroupedNotificationsResults, gtserror.WithCode) {
+	filters, mutes, errWithCode := p.notifFilters(ctx, authed.Account.ID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		grouper = newNotifGrouper(groupedTypes)
+		limit   = page.GetLimit()
+		batch   = &paging.Page{
+			Min:   page.Min,
+			Max:   page.Max,
+			Limit: notifGroupsBatch,
+		}
+
+		// Lowest and highest ID
+		// values, used for paging.
+		lo, hi string
+	)
+
+	for i := 0; i < notifGroupsMaxBatches; i++ {
+		notifs, err := p.state.DB.GetAccountNotifications(
+			ctx,
+			authed.Account.ID,
+			batch,
+			types,
+			excludeTypes,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting notifications: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		full := false
+		for _, n := range notifs {
+			key := grouper.key(n)
+			if limit > 0 && grouper.len() == limit && !grouper.has(key) {
+				// Reached the group limit
+				// for this page, stop here.
+				full = true
+				break
+			}
+
+			// Update paging values, including
+			// for notifs we skip below, so that
+			// the next page starts after them.
+			if hi == "" {
+				hi = n.ID
+			}
+			lo = n.ID
+
+			visible, err := p.notifVisible(ctx, n, authed.Account)
+			if err != nil {
+				log.Debugf(ctx, "skipping notification %s because of an error checking notification visibility: %v", n.ID, err)
+				continue
+			}
+
+			if !visible {
+				continue
+			}
+
+			apiNotif, err := p.converter.NotificationToAPINotification(ctx, n, filters, mutes)
+			if err != nil {
+				if !errors.Is(err, status.ErrHideStatus) {
+					log.Debugf(ctx, "skipping notification %s because it couldn't be converted to its api representation: %s", n.ID, err)
+				}
+				continue
+			}
+
+			grouper.add(key, apiNotif)
+		}
+
+		if full ||
+			len(notifs) < notifGroupsBatch ||
+			page.GetOrder().Ascending() {
+			// Either we have enough groups, there
+			// are no more notifs, or we're paging
+			// upwards from a min ID; in the last
+			// case only a single batch is used to
+			// avoid skipping over notifications.
+			break
+		}
+
+		// Continue on from the oldest fetched notif.
+		batch = &paging.Page{
+			Min:   page.Min,
+			Max:   paging.MaxID(notifs[len(notifs)-1].ID),
+			Limit: notifGroupsBatch,
+		}
+	}
+
+	results := grouper.results()
+	if lo == "" {
+		// Nothing to page.
+		return results, nil
+	}
+
+	// Build type query string.
+	query := make(url.Values)
+	for _, typ := range types {
+		query.Add("types[]", typ.String())
+	}
+	for _, typ := range excludeTypes {
+		query.Add("exclude_types[]", typ.String())
+	}
+	for _, typ := range groupedTypes {
+		query.Add("grouped_types[]", typ.String())
+	}
+
+	results.LinkHeader = paging.PackageResponse(paging.ResponseParams{
+		Path:  "/api/v2/notifications",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}).LinkHeader
+
+	return results, nil
+}
+
+// NotificationGroupGet returns the notification
+// group with the given key, including all
+// notifications currently stored for that group.
+func (p *Processor) NotificationGroupGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+	groupKey string,
+) (*apimodel.GroupedNotificationsResults, gtserror.WithCode) {
+	notifs, errWithCode := p.notifGroup(ctx, authed.Account.ID, groupKey)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	filters, mutes, errWithCode := p.notifFilters(ctx, authed.Account.ID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Group all notifs under the requested key, regardless
+	// of the grouped types that were used to derive it.
+	grouper := newNotifGrouper(groupableNotifTypes)
+	for _, n := range notifs {
+		visible, err := p.notifVisible(ctx, n, authed.Account)
+		if err != nil || !visible {
+			continue
+		}
+
+		apiNotif, err := p.converter.NotificationToAPINotification(ctx, n, filters, mutes)
+		if err != nil {
+			continue
+		}
+
+		grouper.add(groupKey, apiNotif)
+	}
+
+	if grouper.len() == 0 {
+		const text = "notification group not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return grouper.results(), nil
+}
+
+// NotificationGroupAccountsGet returns the accounts that
+// triggered the notifications in the given group, newest first.
+func (p *Processor) NotificationGroupAccountsGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+	groupKey string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	results, errWithCode := p.NotificationGroupGet(ctx, authed, groupKey)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return results.Accounts, nil
+}
+
+// NotificationGroupDismiss deletes all of the
+// notifications belonging to the given group.
+func (p *Processor) NotificationGroupDismiss(
+	ctx context.Context,
+	authed *oauth.Auth,
+	groupKey string,
+) gtserror.WithCode {
+	notifs, errWithCode := p.notifGroup(ctx, authed.Account.ID, groupKey)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	for _, n := range notifs {
+		if err := p.state.DB.DeleteNotificationByID(ctx, n.ID); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error deleting notification %s: %w", n.ID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return nil
+}
+
+// notifFilters returns the filters and compiled
+// user mutes to apply to the given account's notifs.
+func (p *Processor) notifFilters(
+	ctx context.Context,
+	accountID string,
+) ([]*gtsmodel.Filter, *usermute.CompiledUserMuteList, gtserror.WithCode) {
+	filters, err := p.state.DB.GetFiltersForAccountID(ctx, accountID)
+	if err != nil {
+		err = gtserror.Newf("couldn't retrieve filters for account %s: %w", accountID, err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	mutes, err := p.state.DB.GetAccountMutes(gtscontext.SetBarebones(ctx), accountID, nil)
+	if err != nil {
+		err = gtserror.Newf("couldn't retrieve mutes for account %s: %w", accountID, err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return filters, usermute.NewCompiledUserMuteList(mutes), nil
+}
+
+// notifGroup fetches the notifications targeting
+// accountID that belong to the given group, newest first.
+func (p *Processor) notifGroup(
+	ctx context.Context,
+	accountID string,
+	groupKey string,
+) ([]*gtsmodel.Notification, gtserror.WithCode) {
+	const text = "notification group not found"
+
+	// Ungrouped notification, just get by ID.
+	if notifID, ok := strings.CutPrefix(groupKey, ungroupedPrefix); ok {
+		notif, err := p.state.DB.GetNotificationByID(ctx, notifID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting notification %s: %w", notifID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if notif == nil || notif.TargetAccountID != accountID {
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+
+		return []*gtsmodel.Notification{notif}, nil
+	}
+
+	ntype, statusID, window, ok := parseNotifGroupKey(groupKey)
+	if !ok {
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Select all notifs of this type within
+	// the group's window of time. Notification
+	// IDs are ULIDs so we can page by time.
+	start := uint64(window) * notifGroupWindowMs // #nosec G115 -- window is checked to be non-negative.
+	notifs, err := p.state.DB.GetAccountNotifications(
+		ctx,
+		accountID,
+		&paging.Page{
+			Min: paging.SinceID(lowestULIDAt(start)),
+			Max: paging.MaxID(lowestULIDAt(start + notifGroupWindowMs)),
+		},
+		[]gtsmodel.NotificationType{ntype},
+		nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting notifications: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Only keep those about the group's status.
+	notifs = slices.DeleteFunc(notifs, func(n *gtsmodel.Notification) bool {
+		return n.StatusID != statusID
+	})
+
+	if len(notifs) == 0 {
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return notifs, nil
+}
+
+// notifGroupKey returns the key of the group that the given
+// notification belongs in, given the types to be grouped.
+//
+// Favourites and reblogs are grouped per status, and follows
+// are grouped together, within the same window of time.
+// Anything else gets a unique "ungrouped-{id}" key.
+func notifGroupKey(n *gtsmodel.Notification, groupedTypes []gtsmodel.NotificationType) string {
+	if !slices.Contains(groupedTypes, n.NotificationType) {
+		return ungroupedPrefix + n.ID
+	}
+
+	u, err := ulid.Parse(n.ID)
+	if err != nil {
+		// Can't get a time from
+		// this ID, don't group it.
+		return ungroupedPrefix + n.ID
+	}
+	window := strconv.FormatUint(u.Time()/notifGroupWindowMs, 10)
+
+	switch n.NotificationType {
+	case gtsmodel.NotificationFave, gtsmodel.NotificationReblog:
+		return n.NotificationType.String() + "-" + n.StatusID + "-" + window
+	case gtsmodel.NotificationFollow:
+		return n.NotificationType.String() + "-" + window
+	default:
+		return ungroupedPrefix + n.ID
+	}
+}
+
+// parseNotifGroupKey parses the notification type, status ID
+// (if any) and time window from the given grouped notification
+// group key, returning false if the key isn't valid.
+func parseNotifGroupKey(groupKey string) (gtsmodel.NotificationType, string, int64, bool) {
+	parts := strings.Split(groupKey, "-")
+
+	ntype := gtsmodel.ParseNotificationType(parts[0])
+	if !slices.Contains(groupableNotifTypes, ntype) {
+		return 0, "", 0, false
+	}
+
+	var statusID string
+	switch {
+	case ntype == gtsmodel.NotificationFollow && len(parts) == 2:
+		// Follows are not about a status.
+	case ntype != gtsmodel.NotificationFollow && len(parts) == 3:
+		statusID = parts[1]
+	default:
+		return 0, "", 0, false
+	}
+
+	window, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
+	if err != nil || window < 0 {
+		return 0, "", 0, false
+	}
+
+	return ntype, statusID, window, true
+}
+
+// lowestULIDAt returns the lowest possible
+// ULID for the given unix millisecond time.
+func lowestULIDAt(ms uint64) string {
+	var u ulid.ULID
+	if err := u.SetTime(ms); err != nil {
+		// Time too big to
+		// fit in a ULID.
+		return id.Highest
+	}
+	return u.String()
+}
+
+// notifGrouper collapses frontend notifications
+// into notification groups, deduplicating the
+// accounts and statuses that they reference.
+type notifGrouper struct {
+	groupedTypes []gtsmodel.NotificationType
+	groups       []*apimodel.NotificationGroup
+	byKey        map[string]*apimodel.NotificationGroup
+	accounts     []*apimodel.Account
+	accountIDs   map[string]struct{}
+	statuses     []*apimodel.Status
+	statusIDs    map[string]struct{}
+}
+
+func newNotifGrouper(groupedTypes []gtsmodel.NotificationType) *notifGrouper {
+	// Only group the types
+	// that are groupable.
+	if len(groupedTypes) == 0 {
+		groupedTypes = groupableNotifTypes
+	} else {
+		groupedTypes = slices.DeleteFunc(
+			slices.Clone(groupedTypes),
+			func(t gtsmodel.NotificationType) bool {
+				return !slices.Contains(groupableNotifTypes, t)
+			},
+		)
+	}
+
+	return &notifGrouper{
+		groupedTypes: groupedTypes,
+		byKey:        make(map[string]*apimodel.NotificationGroup),
+		accountIDs:   make(map[string]struct{}),
+		statusIDs:    make(map[string]struct{}),
+	}
+}
+
+// key returns the group key for the given notification.
+func (g *notifGrouper) key(n *gtsmodel.Notification) string {
+	return notifGroupKey(n, g.groupedTypes)
+}
+
+// has returns whether a group with key exists yet.
+func (g *notifGrouper) has(key string) bool {
+	_, ok := g.byKey[key]
+	return ok
+}
+
+// len returns the number of groups.
+func (g *notifGrouper) len() int {
+	return len(g.groups)
+}
+
+// add adds the given notification to the group with key.
+// Notifications must be added newest first.
+func (g *notifGrouper) add(key string, n *apimodel.Notification) {
+	group, ok := g.byKey[key]
+	if !ok {
+		group = &apimodel.NotificationGroup{
+			GroupKey:                 key,
+			Type:                     n.Type,
+			MostRecentNotificationID: n.ID,
+			PageMaxID:                n.ID,
+			LatestPageNotificationAt: n.CreatedAt,
+			SampleAccountIDs:         make([]string, 0, 1),
+		}
+		if n.Status != nil {
+			group.StatusID = n.Status.ID
+		}
+		g.byKey[key] = group
+		g.groups = append(g.groups, group)
+	}
+
+	group.NotificationsCount++
+	group.PageMinID = n.ID
+
+	if acct := n.Account; acct != nil {
+		if len(group.SampleAccountIDs) < notifGroupSampleAccounts &&
+			!slices.Contains(group.SampleAccountIDs, acct.ID) {
+			group.SampleAccountIDs = append(group.SampleAccountIDs, acct.ID)
+		}
+
+		if _, ok := g.accountIDs[acct.ID]; !ok {
+			g.accountIDs[acct.ID] = struct{}{}
+			g.accounts = append(g.accounts, acct)
+		}
+	}
+
+	if status := n.Status; status != nil {
+		if _, ok := g.statusIDs[status.ID]; !ok {
+			g.statusIDs[status.ID] = struct{}{}
+			g.statuses = append(g.statuses, status)
+		}
+	}
+}
+
+// results returns the grouped results, with
+// non-nil slices so they serialize as arrays.
+func (g *notifGrouper) results() *apimodel.GroupedNotificationsResults {
+	results := &apimodel.GroupedNotificationsResults{
+		Accounts:           g.accounts,
+		Statuses:           g.statuses,
+		NotificationGroups: g.groups,
+	}
+
+	if results.Accounts == nil {
+		results.Accounts = []*apimodel.Account{}
+	}
+	if results.Statuses == nil {
+		results.Statuses = []*apimodel.Status{}
+	}
+	if results.NotificationGroups == nil {
+		results.NotificationGroups = []*apimodel.NotificationGroup{}
+	}
+
+	return results
+}
@@ -0,0 +1,106 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// NotificationPolicyGet returns the notification
+// policy of the requesting account.
+func (p *Processor) NotificationPolicyGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+) (*apimodel.NotificationPolicy, gtserror.WithCode) {
+	settings, err := p.state.DB.GetAccountSettings(ctx, authed.Account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiPolicy, err := p.converter.NotificationPolicyToAPINotificationPolicy(ctx, settings)
+	if err != nil {
+		err := gtserror.Newf("error converting notification policy to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPolicy, nil
+}
+
+// NotificationPolicyUpdate updates the notification policy
+// of the requesting account with any values set in the form.
+func (p *Processor) NotificationPolicyUpdate(
+	ctx context.Context,
+	authed *oauth.Auth,
+	form *apimodel.NotificationPolicyUpdateRequest,
+) (*apimodel.NotificationPolicy, gtserror.WithCode) {
+	settings, err := p.state.DB.GetAccountSettings(ctx, authed.Account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var columns []string
+	for _, field := range []struct {
+		name   string
+		column string
+		value  *string
+		dst    *gtsmodel.NotificationPolicyValue
+	}{
+		{"for_not_following", "notification_policy_not_following", form.ForNotFollowing, &settings.NotificationPolicyNotFollowing},
+		{"for_not_followers", "notification_policy_not_followers", form.ForNotFollowers, &settings.NotificationPolicyNotFollowers},
+		{"for_new_accounts", "notification_policy_new_accounts", form.ForNewAccounts, &settings.NotificationPolicyNewAccounts},
+		{"for_private_mentions", "notification_policy_private_mentions", form.ForPrivateMentions, &settings.NotificationPolicyPrivateMentions},
+		{"for_limited_accounts", "notification_policy_limited_accounts", form.ForLimitedAccounts, &settings.NotificationPolicyLimitedAccounts},
+	} {
+		if field.value == nil {
+			// Not being changed.
+			continue
+		}
+
+		value := gtsmodel.ParseNotificationPolicyValue(*field.value)
+		if value == gtsmodel.NotificationPolicyUnknown {
+			text := fmt.Sprintf("%s must be one of accept, filter, or drop", field.name)
+			return nil, gtserror.NewErrorBadRequest(gtserror.New(text), text)
+		}
+
+		*field.dst = value
+		columns = append(columns, field.column)
+	}
+
+	if len(columns) != 0 {
+		if err := p.state.DB.UpdateAccountSettings(ctx, settings, columns...); err != nil {
+			err := gtserror.Newf("db error updating account settings: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	apiPolicy, err := p.converter.NotificationPolicyToAPINotificationPolicy(ctx, settings)
+	if err != nil {
+		err := gtserror.Newf("error converting notification policy to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPolicy, nil
+}
@@ -0,0 +1,211 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// NotificationRequestsGet returns a page of pending
+// notification requests held for the requesting account.
+func (p *Processor) NotificationRequestsGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	reqs, err := p.state.DB.GetAccountNotificationRequests(ctx, authed.Account.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting notification requests: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(reqs)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	filters, mutes, errWithCode := p.notifFilters(ctx, authed.Account.ID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		items = make([]interface{}, 0, count)
+
+		// Get the lowest and highest
+		// ID values, used for paging.
+		lo = reqs[count-1].ID
+		hi = reqs[0].ID
+	)
+
+	for _, req := range reqs {
+		apiReq, err := p.converter.NotificationRequestToAPINotificationRequest(ctx, req, filters, mutes)
+		if err != nil {
+			log.Errorf(ctx, "error converting notification request %s to api: %v", req.ID, err)
+			continue
+		}
+
+		items = append(items, apiReq)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/notifications/requests",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// NotificationRequestGet returns one notification
+// request held for the requesting account.
+func (p *Processor) NotificationRequestGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+	id string,
+) (*apimodel.NotificationRequest, gtserror.WithCode) {
+	req, errWithCode := p.getNotificationRequest(ctx, authed.Account, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	filters, mutes, errWithCode := p.notifFilters(ctx, authed.Account.ID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiReq, err := p.converter.NotificationRequestToAPINotificationRequest(ctx, req, filters, mutes)
+	if err != nil {
+		err := gtserror.Newf("error converting notification request to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiReq, nil
+}
+
+// NotificationRequestAccept accepts the given notification
+// request, moving its filtered notifications into the
+// requesting account's notifications, and letting future
+// notifications from the origin account through.
+func (p *Processor) NotificationRequestAccept(
+	ctx context.Context,
+	authed *oauth.Auth,
+	id string,
+) gtserror.WithCode {
+	req, errWithCode := p.getNotificationRequest(ctx, authed.Account, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	notifIDs, err := p.state.DB.GetFilteredNotificationIDs(ctx, req.AccountID, req.OriginAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting filtered notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	for _, notifID := range notifIDs {
+		notif, err := p.state.DB.GetNotificationByID(gtscontext.SetBarebones(ctx), notifID)
+		if err != nil {
+			err := gtserror.Newf("db error getting notification %s: %w", notifID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		notif.Filtered = util.Ptr(false)
+		if err := p.state.DB.UpdateNotification(ctx, notif, "filtered"); err != nil {
+			err := gtserror.Newf("db error updating notification %s: %w", notifID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	req.AcceptedAt = time.Now()
+	if err := p.state.DB.UpdateNotificationRequest(ctx, req, "accepted_at"); err != nil {
+		err := gtserror.Newf("db error updating notification request: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// NotificationRequestDismiss dismisses the given notification
+// request, deleting the filtered notifications it holds.
+// Future notifications from the origin account will be
+// filtered into a new request, as per the account's policy.
+func (p *Processor) NotificationRequestDismiss(
+	ctx context.Context,
+	authed *oauth.Auth,
+	id string,
+) gtserror.WithCode {
+	req, errWithCode := p.getNotificationRequest(ctx, authed.Account, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	notifIDs, err := p.state.DB.GetFilteredNotificationIDs(ctx, req.AccountID, req.OriginAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting filtered notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	for _, notifID := range notifIDs {
+		if err := p.state.DB.DeleteNotificationByID(ctx, notifID); err != nil {
+			err := gtserror.Newf("db error deleting notification %s: %w", notifID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if err := p.state.DB.DeleteNotificationRequestByID(ctx, req.ID); err != nil {
+		err := gtserror.Newf("db error deleting notification request: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// getNotificationRequest fetches the pending notification
+// request with the given ID, ensuring it's held for the
+// given account. Other accounts' requests, and requests
+// that have already been accepted, are treated as not found.
+func (p *Processor) getNotificationRequest(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+) (*gtsmodel.NotificationRequest, gtserror.WithCode) {
+	req, err := p.state.DB.GetNotificationRequestByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting notification request: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if req == nil || req.AccountID != account.ID || req.IsAccepted() {
+		const text = "notification request not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return req, nil
+}
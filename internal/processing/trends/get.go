@@ -0,0 +1,174 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// TagsGet returns currently trending hashtags, most popular first.
+func (p *Processor) TagsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+	offset int,
+) ([]*apimodel.Tag, gtserror.WithCode) {
+	trends, errWithCode := p.getTrends(ctx, gtsmodel.TrendTypeTag, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiTags := make([]*apimodel.Tag, 0, len(trends))
+	for _, trend := range trends {
+		if !util.PtrOrValue(trend.Tag.Listable, true) ||
+			!util.PtrOrValue(trend.Tag.Useable, true) {
+			// Tag was hidden by an
+			// admin since trending.
+			continue
+		}
+
+		following, err := p.state.DB.IsAccountFollowingTag(ctx, requester.ID, trend.Tag.ID)
+		if err != nil {
+			log.Errorf(ctx, "error checking if account follows tag %s: %v", trend.Tag.ID, err)
+			continue
+		}
+
+		apiTag, err := p.converter.TrendToAPITag(ctx, trend, util.Ptr(following))
+		if err != nil {
+			log.Errorf(ctx, "error converting trend %s to api tag: %v", trend.ID, err)
+			continue
+		}
+
+		apiTags = append(apiTags, &apiTag)
+	}
+
+	return apiTags, nil
+}
+
+// StatusesGet returns currently trending statuses, most popular
+// first, excluding any that aren't visible to the requester.
+func (p *Processor) StatusesGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+	offset int,
+) ([]*apimodel.Status, gtserror.WithCode) {
+	trends, errWithCode := p.getTrends(ctx, gtsmodel.TrendTypeStatus, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiStatuses := make([]*apimodel.Status, 0, len(trends))
+	for _, trend := range trends {
+		visible, err := p.visFilter.StatusVisible(ctx, requester, trend.Status)
+		if err != nil {
+			log.Errorf(ctx, "error checking status visibility: %v", err)
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiStatus, err := p.converter.StatusToAPIStatus(ctx, trend.Status, requester, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			log.Errorf(ctx, "error converting to api status: %v", err)
+			continue
+		}
+
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
+
+// LinksGet returns currently trending links, most popular first.
+func (p *Processor) LinksGet(
+	ctx context.Context,
+	limit int,
+	offset int,
+) ([]*apimodel.TrendsLink, gtserror.WithCode) {
+	trends, errWithCode := p.getTrends(ctx, gtsmodel.TrendTypeLink, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiLinks := make([]*apimodel.TrendsLink, 0, len(trends))
+	for _, trend := range trends {
+		apiLink, err := p.converter.TrendToAPITrendsLink(ctx, trend)
+		if err != nil {
+			log.Errorf(ctx, "error converting trend %s to api link: %v", trend.ID, err)
+			continue
+		}
+
+		apiLinks = append(apiLinks, &apiLink)
+	}
+
+	return apiLinks, nil
+}
+
+// getTrends gets populated trends of the given type
+// that may currently be shown to users. If trends
+// are disabled, no trends will be returned.
+func (p *Processor) getTrends(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	limit int,
+	offset int,
+) ([]*gtsmodel.Trend, gtserror.WithCode) {
+	if !config.GetTrendsEnabled() {
+		// Nothing is
+		// trending.
+		return nil, nil
+	}
+
+	trends, err := p.state.DB.GetTrends(
+		ctx,
+		trendType,
+		shownReviewStates(),
+		true,
+		limit,
+		offset,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting trends: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	populated := make([]*gtsmodel.Trend, 0, len(trends))
+	for _, trend := range trends {
+		if err := p.state.DB.PopulateTrend(ctx, trend); err != nil {
+			log.Errorf(ctx, "error populating trend %s: %v", trend.ID, err)
+			continue
+		}
+
+		populated = append(populated, trend)
+	}
+
+	return populated, nil
+}
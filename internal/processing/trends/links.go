@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// extractLinks returns the distinct http(s) links in
+// the given status content HTML, excluding links to
+// mentioned accounts and hashtags, and fragments.
+func extractLinks(content string) []string {
+	var links []string
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// Finished (or
+			// invalid HTML).
+			return links
+
+		case html.StartTagToken:
+			token := tokenizer.Token()
+			if token.Data != "a" {
+				continue
+			}
+
+			link := linkFromAnchor(token)
+			if link != "" && !slices.Contains(links, link) {
+				links = append(links, link)
+			}
+		}
+	}
+}
+
+// linkFromAnchor returns the normalized link of
+// the given anchor token, or an empty string if
+// it's a mention, a hashtag, or not http(s).
+func linkFromAnchor(token html.Token) string {
+	var href string
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "href":
+			href = attr.Val
+
+		case "class", "rel":
+			// Mentions and hashtags are marked with
+			// class="mention" (+ "hashtag") by most
+			// software, or rel="tag" for hashtags.
+			for _, v := range strings.Fields(attr.Val) {
+				if v == "mention" || v == "hashtag" || v == "tag" {
+					return ""
+				}
+			}
+		}
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Host = strings.ToLower(u.Host)
+	return u.String()
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor struct {
+	state     *state.State
+	converter *typeutils.Converter
+	visFilter *visibility.Filter
+}
+
+// New returns a new trends processor.
+func New(state *state.State, converter *typeutils.Converter, visFilter *visibility.Filter) Processor {
+	return Processor{
+		state:     state,
+		converter: converter,
+		visFilter: visFilter,
+	}
+}
+
+// shownReviewStates returns the review states
+// of trends that may be shown to users, which
+// depends on whether trends require review.
+func shownReviewStates() []gtsmodel.TrendReviewState {
+	if config.GetTrendsRequireReview() {
+		return []gtsmodel.TrendReviewState{
+			gtsmodel.TrendReviewApproved,
+		}
+	}
+
+	return []gtsmodel.TrendReviewState{
+		gtsmodel.TrendReviewPending,
+		gtsmodel.TrendReviewApproved,
+	}
+}
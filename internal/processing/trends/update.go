@@ -0,0 +1,353 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// updateEvery is how often
+	// trends are recomputed.
+	updateEvery = 15 * time.Minute
+
+	// historyDays is the number of days of
+	// daily usage history kept for trends.
+	historyDays = 7
+
+	// scoreWindow is how far back activity
+	// counts towards an item's trend score.
+	scoreWindow = 48 * time.Hour
+
+	// scoreHalfLife is the age at which an account's
+	// use of an item counts for half as much towards
+	// its score as a use right now.
+	scoreHalfLife = 12 * time.Hour
+
+	// linkStatusesBatch is the number of statuses
+	// to scan for links in one database query.
+	linkStatusesBatch = 500
+)
+
+// ScheduleUpdates schedules trends
+// to be recomputed periodically.
+func (p *Processor) ScheduleUpdates() {
+	fn := func(ctx context.Context, start time.Time) {
+		log.Debug(ctx, "updating trends")
+		if err := p.Update(ctx); err != nil {
+			log.Errorf(ctx, "error updating trends: %v", err)
+			return
+		}
+		log.Debugf(ctx, "finished updating trends after %s", time.Since(start))
+	}
+
+	log.Infof(nil, "scheduling trends to update every %s", updateEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@trends",
+		time.Now(),
+		updateEvery,
+		fn,
+	) {
+		panic("failed to schedule @trends")
+	}
+}
+
+// Update recomputes trending tags, statuses and
+// links from recent activity, and stores them.
+//
+// Items that are no longer trending are dropped
+// if they were never reviewed, else their score
+// is zeroed, so that review decisions are kept.
+func (p *Processor) Update(ctx context.Context) error {
+	var (
+		now   = time.Now()
+		since = dayStart(now).AddDate(0, 0, -(historyDays - 1))
+		errs  = gtserror.NewMultiError(3)
+	)
+
+	tagActivity, err := p.state.DB.GetTagTrendActivity(ctx, since)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		errs.Appendf("db error getting tag activity: %w", err)
+	} else if err := p.updateTrends(ctx, now, gtsmodel.TrendTypeTag, tagActivity); err != nil {
+		errs.Appendf("error updating tag trends: %w", err)
+	}
+
+	statusActivity, err := p.state.DB.GetStatusTrendActivity(ctx, since)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		errs.Appendf("db error getting status activity: %w", err)
+	} else if err := p.updateTrends(ctx, now, gtsmodel.TrendTypeStatus, statusActivity); err != nil {
+		errs.Appendf("error updating status trends: %w", err)
+	}
+
+	linkActivity, err := p.linkActivity(ctx, since)
+	if err != nil {
+		errs.Appendf("error getting link activity: %w", err)
+	} else if err := p.updateTrends(ctx, now, gtsmodel.TrendTypeLink, linkActivity); err != nil {
+		errs.Appendf("error updating link trends: %w", err)
+	}
+
+	return errs.Combine()
+}
+
+// linkActivity gathers uses of links in
+// public statuses created since given time.
+func (p *Processor) linkActivity(ctx context.Context, since time.Time) ([]*gtsmodel.TrendActivity, error) {
+	var (
+		activity []*gtsmodel.TrendActivity
+		maxID    string
+	)
+
+	for {
+		statuses, err := p.state.DB.GetLinkTrendStatuses(ctx, since, maxID, linkStatusesBatch)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("db error getting statuses: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			return activity, nil
+		}
+
+		for _, status := range statuses {
+			for _, link := range extractLinks(status.Content) {
+				activity = append(activity, &gtsmodel.TrendActivity{
+					Target:    link,
+					AccountID: status.AccountID,
+					CreatedAt: status.CreatedAt,
+				})
+			}
+		}
+
+		maxID = statuses[len(statuses)-1].ID
+	}
+}
+
+// computedTrend contains the
+// computed stats of one trend.
+type computedTrend struct {
+	score    float64
+	uses     int
+	accounts int
+	history  []*gtsmodel.TrendHistory
+}
+
+// computeTrends computes the stats of currently
+// trending items from the given activity, keyed
+// by trend target. Only items used by at least
+// minAccounts accounts within scoreWindow trend.
+func computeTrends(
+	now time.Time,
+	trendType gtsmodel.TrendType,
+	activity []*gtsmodel.TrendActivity,
+	minAccounts int,
+) map[string]*computedTrend {
+	// Group activity by target.
+	byTarget := make(map[string][]*gtsmodel.TrendActivity)
+	for _, a := range activity {
+		byTarget[a.Target] = append(byTarget[a.Target], a)
+	}
+
+	var (
+		scoreSince = now.Add(-scoreWindow)
+		today      = dayStart(now)
+		computed   = make(map[string]*computedTrend)
+	)
+
+	for target, activity := range byTarget {
+		// Find the most recent use of the
+		// target by each account within
+		// the score window.
+		latest := make(map[string]time.Time)
+		uses := 0
+		for _, a := range activity {
+			if a.CreatedAt.Before(scoreSince) {
+				continue
+			}
+
+			uses++
+			if a.CreatedAt.After(latest[a.AccountID]) {
+				latest[a.AccountID] = a.CreatedAt
+			}
+		}
+
+		if len(latest) < minAccounts {
+			// Not enough
+			// accounts.
+			continue
+		}
+
+		// Each account contributes to score once,
+		// decaying with age of their latest use.
+		var score float64
+		for _, at := range latest {
+			age := now.Sub(at)
+			score += math.Pow(0.5, float64(age)/float64(scoreHalfLife))
+		}
+
+		c := &computedTrend{
+			score:    score,
+			uses:     uses,
+			accounts: len(latest),
+		}
+
+		// Statuses don't
+		// have history.
+		if trendType != gtsmodel.TrendTypeStatus {
+			c.history = dailyHistory(today, activity)
+		}
+
+		computed[target] = c
+	}
+
+	return computed
+}
+
+// dailyHistory buckets the given activity into historyDays
+// days of usage history, starting from today, newest first.
+func dailyHistory(today time.Time, activity []*gtsmodel.TrendActivity) []*gtsmodel.TrendHistory {
+	history := make([]*gtsmodel.TrendHistory, historyDays)
+	accounts := make([]map[string]struct{}, historyDays)
+	for i := range history {
+		history[i] = &gtsmodel.TrendHistory{
+			Day: today.AddDate(0, 0, -i),
+		}
+		accounts[i] = make(map[string]struct{})
+	}
+
+	for _, a := range activity {
+		i := int(today.Sub(dayStart(a.CreatedAt)).Hours() / 24)
+		if i < 0 || i >= historyDays {
+			continue
+		}
+
+		history[i].Uses++
+		accounts[i][a.AccountID] = struct{}{}
+	}
+
+	for i := range history {
+		history[i].Accounts = len(accounts[i])
+	}
+
+	return history
+}
+
+// updateTrends stores computed trends of the given type,
+// and drops or zeroes any that are no longer trending.
+func (p *Processor) updateTrends(
+	ctx context.Context,
+	now time.Time,
+	trendType gtsmodel.TrendType,
+	activity []*gtsmodel.TrendActivity,
+) error {
+	computed := computeTrends(now, trendType, activity, config.GetTrendsMinAccounts())
+
+	existing, err := p.state.DB.GetTrends(ctx, trendType, nil, false, 0, 0)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting existing trends: %w", err)
+	}
+
+	for _, trend := range existing {
+		c, ok := computed[trend.Target]
+		if ok {
+			// Still trending, update stats.
+			delete(computed, trend.Target)
+			if err := p.storeTrend(ctx, trend, c, false); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if trend.ReviewState == gtsmodel.TrendReviewPending {
+			// No longer trending and never
+			// reviewed, nothing to keep.
+			if err := p.state.DB.DeleteTrendByID(ctx, trend.ID); err != nil {
+				return gtserror.Newf("db error deleting trend: %w", err)
+			}
+			continue
+		}
+
+		if trend.Score != 0 {
+			// No longer trending, but keep
+			// review state for next time.
+			if err := p.storeTrend(ctx, trend, &computedTrend{}, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Anything left is newly trending.
+	for target, c := range computed {
+		trend := &gtsmodel.Trend{
+			ID:          id.NewULID(),
+			Type:        trendType,
+			Target:      target,
+			ReviewState: gtsmodel.TrendReviewPending,
+		}
+
+		if err := p.storeTrend(ctx, trend, c, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// storeTrend sets the computed stats on the given
+// trend, then puts or updates it, and its history.
+func (p *Processor) storeTrend(
+	ctx context.Context,
+	trend *gtsmodel.Trend,
+	c *computedTrend,
+	isNew bool,
+) error {
+	trend.Score = c.score
+	trend.Uses = c.uses
+	trend.Accounts = c.accounts
+
+	if isNew {
+		if err := p.state.DB.PutTrend(ctx, trend); err != nil {
+			return gtserror.Newf("db error putting trend: %w", err)
+		}
+	} else {
+		if err := p.state.DB.UpdateTrend(ctx, trend, "score", "uses", "accounts"); err != nil {
+			return gtserror.Newf("db error updating trend: %w", err)
+		}
+	}
+
+	if err := p.state.DB.ReplaceTrendHistory(ctx, trend.ID, c.history); err != nil {
+		return gtserror.Newf("db error replacing trend history: %w", err)
+	}
+
+	return nil
+}
+
+// dayStart returns midnight (UTC)
+// at the start of the given time's day.
+func dayStart(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// putTrendingStatus puts a new public status by
+// the given account with the given tags and content.
+func (suite *TrendsTestSuite) putTrendingStatus(
+	account *gtsmodel.Account,
+	content string,
+	tags ...*gtsmodel.Tag,
+) {
+	createdAt := time.Now().Add(-time.Minute)
+	statusID, err := id.NewULIDFromTime(createdAt)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tagIDs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 "http://localhost:8080/users/" + account.Username + "/statuses/" + statusID,
+		URL:                 "http://localhost:8080/@" + account.Username + "/statuses/" + statusID,
+		Content:             content,
+		CreatedAt:           createdAt,
+		Local:               util.Ptr(true),
+		AccountURI:          account.URI,
+		AccountID:           account.ID,
+		TagIDs:              tagIDs,
+		ThreadID:            id.NewULID(),
+		Visibility:          gtsmodel.VisibilityPublic,
+		Sensitive:           util.Ptr(false),
+		Federated:           util.Ptr(true),
+		ActivityStreamsType: "Note",
+	}
+
+	if err := suite.db.PutStatus(context.Background(), status); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *TrendsTestSuite) TestUpdateTags() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_1"]
+		tag       = suite.testTags["Hashtag"]
+	)
+
+	// Only two accounts use the tag,
+	// which isn't enough to trend.
+	suite.putTrendingStatus(suite.testAccounts["local_account_1"], "<p>#hashtag</p>", tag)
+	suite.putTrendingStatus(suite.testAccounts["local_account_2"], "<p>#hashtag</p>", tag)
+
+	if err := suite.trends.Update(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	trend, err := suite.db.GetTrendByTarget(ctx, gtsmodel.TrendTypeTag, tag.ID)
+	suite.Nil(trend)
+	suite.Error(err)
+
+	// Third account pushes it over the edge.
+	suite.putTrendingStatus(suite.testAccounts["admin_account"], "<p>#hashtag</p>", tag)
+
+	if err := suite.trends.Update(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	trend, err = suite.db.GetTrendByTarget(ctx, gtsmodel.TrendTypeTag, tag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(3, trend.Uses)
+	suite.Equal(3, trend.Accounts)
+	suite.Equal(gtsmodel.TrendReviewPending, trend.ReviewState)
+	suite.Greater(trend.Score, 0.0)
+
+	// Trend is pending review,
+	// so it shouldn't be shown.
+	apiTags, errWithCode := suite.trends.TagsGet(ctx, requester, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Empty(apiTags)
+
+	// Approve the trend.
+	trend.ReviewState = gtsmodel.TrendReviewApproved
+	if err := suite.db.UpdateTrend(ctx, trend, "review_state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiTags, errWithCode = suite.trends.TagsGet(ctx, requester, 10, 0)
+	suite.NoError(errWithCode)
+	if suite.Len(apiTags, 1) {
+		suite.Equal("hashtag", apiTags[0].Name)
+		suite.NotNil(apiTags[0].History)
+		suite.Len(*apiTags[0].History, 7)
+
+		// Statuses may straddle midnight,
+		// so just check overall uses.
+		var uses int
+		for _, h := range *apiTags[0].History {
+			n, err := strconv.Atoi(h.Uses)
+			suite.NoError(err)
+			uses += n
+		}
+		suite.Equal(3, uses)
+	}
+}
+
+func (suite *TrendsTestSuite) TestUpdateLinks() {
+	var (
+		ctx     = context.Background()
+		content = `<p>read this: <a href="https://example.org/article" rel="nofollow noreferrer noopener" target="_blank">https://example.org/article</a> ` +
+			`<span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span></p>`
+	)
+
+	// Don't require review for this one.
+	config.SetTrendsRequireReview(false)
+
+	suite.putTrendingStatus(suite.testAccounts["local_account_1"], content)
+	suite.putTrendingStatus(suite.testAccounts["local_account_2"], content)
+	suite.putTrendingStatus(suite.testAccounts["admin_account"], content)
+
+	if err := suite.trends.Update(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiLinks, errWithCode := suite.trends.LinksGet(ctx, 10, 0)
+	suite.NoError(errWithCode)
+
+	// Mention link
+	// shouldn't trend.
+	if suite.Len(apiLinks, 1) {
+		suite.Equal("https://example.org/article", apiLinks[0].URL)
+		suite.Equal("example.org", apiLinks[0].ProviderName)
+		suite.Len(apiLinks[0].History, 7)
+	}
+}
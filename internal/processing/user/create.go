@@ -19,16 +19,24 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/captcha"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -44,34 +52,60 @@ func (p *Processor) Create(
 	app *gtsmodel.Application,
 	form *apimodel.AccountCreateRequest,
 ) (*gtsmodel.User, gtserror.WithCode) {
-	const (
-		usersPerDay = 10
-		regBacklog  = 20
-	)
+	// Check the captcha first (if enabled), so
+	// that bots can't use this endpoint to probe
+	// which emails + usernames are in use here.
+	if p.captcha != nil {
+		err := p.captcha.Verify(ctx, form.CaptchaResponse, form.IP)
+		switch {
+		case errors.Is(err, captcha.ErrFailed):
+			return nil, gtserror.NewErrorBadRequest(err, "captcha verification failed, please try again")
 
-	// Ensure no more than usersPerDay
-	// have registered in the last 24h.
-	newUsersCount, err := p.state.DB.CountApprovedSignupsSince(ctx, time.Now().Add(-24*time.Hour))
-	if err != nil {
-		err := fmt.Errorf("db error counting new users: %w", err)
-		return nil, gtserror.NewErrorInternalError(err)
+		case err != nil:
+			err := gtserror.Newf("error verifying captcha: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
 	}
 
-	if newUsersCount >= usersPerDay {
-		err := fmt.Errorf("this instance has hit its limit of new sign-ups for today; you can try again tomorrow")
-		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	// Reject sign-ups from addresses
+	// covered by an unexpired IP block.
+	if addr, ok := netip.AddrFromSlice(form.IP); ok {
+		block, err := p.state.DB.IPBlockMatch(ctx, addr)
+		if err != nil {
+			err := gtserror.Newf("db error matching ip block: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if block != nil {
+			const text = "sign-ups are not allowed from your IP address"
+			return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+		}
 	}
 
-	// Ensure the new users backlog isn't full.
-	backlogLen, err := p.state.DB.CountUnhandledSignups(ctx)
-	if err != nil {
-		err := fmt.Errorf("db error counting registration backlog length: %w", err)
-		return nil, gtserror.NewErrorInternalError(err)
+	// Check the invite code (if provided). Sign-ups
+	// with a valid invite skip the sign-up limits, as
+	// they don't end up in the backlog for approval.
+	var invite *gtsmodel.Invite
+	if form.InviteCode != "" {
+		var errWithCode gtserror.WithCode
+		invite, errWithCode = p.getUsableInvite(ctx, form.InviteCode)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+	} else if errWithCode := p.checkSignupLimits(ctx); errWithCode != nil {
+		return nil, errWithCode
 	}
 
-	if backlogLen >= regBacklog {
-		err := fmt.Errorf("this instance's sign-up backlog is currently full; you must wait until pending sign-ups are handled by the admin(s)")
-		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	// Check answers to sign-up questions. As with
+	// the reason, these are only needed if the
+	// sign-up will have to be approved.
+	var answers []*gtsmodel.SignupAnswer
+	if invite == nil {
+		var errWithCode gtserror.WithCode
+		answers, errWithCode = p.signupAnswers(ctx, form.Answers)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
 	}
 
 	emailAvailable, err := p.state.DB.IsEmailAvailable(ctx, form.Email)
@@ -96,7 +130,7 @@ func (p *Processor) Create(
 
 	// Only store reason if one is required.
 	var reason string
-	if config.GetAccountsReasonRequired() {
+	if config.GetAccountsReasonRequired() && invite == nil {
 		reason = form.Reason
 	}
 
@@ -109,7 +143,20 @@ func (p *Processor) Create(
 		}
 	}
 
-	user, err := p.state.DB.NewSignup(ctx, gtsmodel.NewSignup{
+	// Agreeing to the terms on the sign-up form
+	// counts as accepting the current version.
+	versions, err := p.state.DB.GetAllTermsOfService(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting terms of service: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var termsID string
+	if len(versions) != 0 {
+		termsID = versions[0].ID
+	}
+
+	newSignup := gtsmodel.NewSignup{
 		Username: form.Username,
 		Email:    form.Email,
 		Password: form.Password,
@@ -117,8 +164,38 @@ func (p *Processor) Create(
 		SignUpIP: form.IP,
 		Locale:   form.Locale,
 		AppID:    app.ID,
-	})
+		Answers:  answers,
+		TermsID:  termsID,
+	}
+
+	if invite != nil {
+		// Claim a use of the invite; this fails
+		// if it was used up in the meantime.
+		err := p.state.DB.UseInvite(ctx, invite.ID)
+		if errors.Is(err, db.ErrNoEntries) {
+			const text = "invite code is invalid or has expired"
+			return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+		} else if err != nil {
+			err := gtserror.Newf("db error using invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Whoever created the invite vouches
+		// for the new user, so skip approval.
+		newSignup.InviteID = invite.ID
+		newSignup.PreApproved = true
+	}
+
+	user, err := p.state.DB.NewSignup(ctx, newSignup)
 	if err != nil {
+		if invite != nil {
+			// Sign-up didn't go through,
+			// so give back the invite use.
+			if err := p.state.DB.ReleaseInvite(ctx, invite.ID); err != nil {
+				log.Errorf(ctx, "db error releasing invite %s: %v", invite.ID, err)
+			}
+		}
+
 		err := fmt.Errorf("db error creating new signup: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -138,6 +215,74 @@ func (p *Processor) Create(
 	return user, nil
 }
 
+// checkSignupLimits returns an error if the
+// instance can't take any more sign-ups for
+// approval at the moment.
+func (p *Processor) checkSignupLimits(ctx context.Context) gtserror.WithCode {
+	const (
+		usersPerDay = 10
+		regBacklog  = 20
+	)
+
+	// Ensure no more than usersPerDay
+	// have registered in the last 24h.
+	newUsersCount, err := p.state.DB.CountApprovedSignupsSince(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		err := fmt.Errorf("db error counting new users: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if newUsersCount >= usersPerDay {
+		err := fmt.Errorf("this instance has hit its limit of new sign-ups for today; you can try again tomorrow")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Ensure the new users backlog isn't full.
+	backlogLen, err := p.state.DB.CountUnhandledSignups(ctx)
+	if err != nil {
+		err := fmt.Errorf("db error counting registration backlog length: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if backlogLen >= regBacklog {
+		err := fmt.Errorf("this instance's sign-up backlog is currently full; you must wait until pending sign-ups are handled by the admin(s)")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return nil
+}
+
+// signupAnswers checks that the given answers, keyed by
+// question ID, cover all of the instance's sign-up questions,
+// and returns them ready to be stored with the new user.
+func (p *Processor) signupAnswers(
+	ctx context.Context,
+	given map[string]string,
+) ([]*gtsmodel.SignupAnswer, gtserror.WithCode) {
+	questions, err := p.state.DB.GetSignupQuestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting sign-up questions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	answers := make([]*gtsmodel.SignupAnswer, 0, len(questions))
+	for _, question := range questions {
+		answer := strings.TrimSpace(given[question.ID])
+		if err := validate.SignupAnswer(question.Text, answer); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		answers = append(answers, &gtsmodel.SignupAnswer{
+			ID:         id.NewULID(),
+			QuestionID: question.ID,
+			Question:   question.Text,
+			Answer:     text.SanitizeToPlaintext(answer),
+		})
+	}
+
+	return answers, nil
+}
+
 // TokenForNewUser generates an OAuth Bearer token
 // for a new user (with account) created by Create().
 func (p *Processor) TokenForNewUser(
@@ -19,21 +19,141 @@
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// deletionsEvery is how often users are
+// checked for account deletions that are due.
+const deletionsEvery = time.Hour
+
 // DeleteSelf is like Account.Delete, but specifically
 // for local user+accounts deleting themselves.
 //
-// Calling DeleteSelf results in a delete message being enqueued in the processor,
-// which causes side effects to occur: delete will be federated out to other instances,
-// and the above Delete function will be called afterwards from the processor, to clear
-// out the account's bits and bobs, and stubbify it.
+// If an account deletion grace period is configured, the user is
+// just marked as pending deletion, and the account will be deleted
+// by DeletePendingAccounts once the grace period has passed. Until
+// then, the user can cancel the deletion with CancelDeleteSelf.
+//
+// Otherwise, calling DeleteSelf results in a delete message being enqueued in
+// the processor, which causes side effects to occur: delete will be federated
+// out to other instances, and the above Delete function will be called afterwards
+// from the processor, to clear out the account's bits and bobs, and stubbify it.
 func (p *Processor) DeleteSelf(ctx context.Context, account *gtsmodel.Account) gtserror.WithCode {
+	if config.GetAccountsDeletionGrace() <= 0 {
+		// No grace period,
+		// delete right away.
+		p.deleteSelf(account)
+		return nil
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if user.PendingDeletion() {
+		// Already pending, don't
+		// restart the grace period.
+		return nil
+	}
+
+	user.DeletionRequestedAt = time.Now()
+	if err := p.state.DB.UpdateUser(ctx, user, "deletion_requested_at"); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// CancelDeleteSelf cancels the pending deletion of
+// the given user's account, and returns the updated user.
+func (p *Processor) CancelDeleteSelf(ctx context.Context, user *gtsmodel.User) (*apimodel.User, gtserror.WithCode) {
+	if !user.PendingDeletion() {
+		err := errors.New("account is not pending deletion")
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	user.DeletionRequestedAt = time.Time{}
+	if err := p.state.DB.UpdateUser(ctx, user, "deletion_requested_at"); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.UserToAPIUser(ctx, user), nil
+}
+
+// ScheduleDeletions schedules accounts pending deletion to be
+// periodically checked, and deleted once their grace period is up.
+func (p *Processor) ScheduleDeletions() {
+	fn := func(ctx context.Context, start time.Time) {
+		log.Debug(ctx, "deleting accounts pending deletion")
+		if err := p.DeletePendingAccounts(ctx); err != nil {
+			log.Errorf(ctx, "error deleting accounts pending deletion: %v", err)
+			return
+		}
+		log.Debugf(ctx, "finished deleting accounts pending deletion after %s", time.Since(start))
+	}
+
+	log.Infof(nil, "scheduling account deletions to run every %s", deletionsEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@accountdeletions",
+		time.Now(),
+		deletionsEvery,
+		fn,
+	) {
+		panic("failed to schedule @accountdeletions")
+	}
+}
+
+// DeletePendingAccounts deletes the accounts of all users
+// who asked for their account to be deleted longer ago
+// than the configured account deletion grace period.
+func (p *Processor) DeletePendingAccounts(ctx context.Context) error {
+	grace := time.Duration(config.GetAccountsDeletionGrace()) * 24 * time.Hour
+
+	users, err := p.state.DB.GetUsersPendingDeletion(ctx, time.Now().Add(-grace))
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting users: %w", err)
+	}
+
+	errs := gtserror.NewMultiError(len(users))
+	for _, user := range users {
+		// Disable the user until deletion is processed,
+		// and clear the pending deletion so that this
+		// user isn't picked up again on the next run.
+		user.Disabled = util.Ptr(true)
+		user.DeletionRequestedAt = time.Time{}
+		if err := p.state.DB.UpdateUser(ctx, user,
+			"disabled",
+			"deletion_requested_at",
+		); err != nil {
+			errs.Appendf("db error updating user %s: %w", user.ID, err)
+			continue
+		}
+
+		p.deleteSelf(user.Account)
+	}
+
+	return errs.Combine()
+}
+
+// deleteSelf enqueues the delete message for the given
+// local account, which deletes it and federates the delete.
+func (p *Processor) deleteSelf(account *gtsmodel.Account) {
 	// Process the delete side effects asynchronously.
 	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
 		// Use ap.ObjectProfile here to
@@ -44,5 +164,4 @@ func (p *Processor) DeleteSelf(ctx context.Context, account *gtsmodel.Account) g
 		Origin:         account,
 		Target:         account,
 	})
-	return nil
 }
@@ -0,0 +1,160 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DeleteTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *DeleteTestSuite) SetupTest() {
+	suite.UserStandardTestSuite.SetupTest()
+	testrig.StartNoopWorkers(&suite.state)
+	config.SetAccountsDeletionGrace(7)
+}
+
+func (suite *DeleteTestSuite) TearDownTest() {
+	testrig.StopWorkers(&suite.state)
+	suite.UserStandardTestSuite.TearDownTest()
+}
+
+// queuedDeletes pops all queued client API messages,
+// returning the IDs of accounts queued for deletion.
+func (suite *DeleteTestSuite) queuedDeletes() []string {
+	var ids []string
+	for {
+		msg, ok := suite.state.Workers.Client.Queue.Pop()
+		if !ok {
+			break
+		}
+
+		suite.Equal(ap.ObjectProfile, msg.APObjectType)
+		suite.Equal(ap.ActivityDelete, msg.APActivityType)
+		ids = append(ids, msg.Target.ID)
+	}
+	return ids
+}
+
+func (suite *DeleteTestSuite) TestDeleteSelfCancel() {
+	var (
+		ctx     = context.Background()
+		user    = suite.testUsers["local_account_1"]
+		account = testrig.NewTestAccounts()["local_account_1"]
+	)
+
+	if errWithCode := suite.user.DeleteSelf(ctx, account); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// User should be pending deletion,
+	// but nothing should be deleted yet.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbUser.PendingDeletion())
+	suite.Empty(suite.queuedDeletes())
+
+	// Grace period hasn't passed,
+	// so this should do nothing.
+	if err := suite.user.DeletePendingAccounts(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suite.queuedDeletes())
+
+	apiUser, errWithCode := suite.user.CancelDeleteSelf(ctx, dbUser)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiUser.DeletionScheduledAt)
+
+	dbUser, err = suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbUser.PendingDeletion())
+
+	// Can't cancel twice.
+	_, errWithCode = suite.user.CancelDeleteSelf(ctx, dbUser)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+}
+
+func (suite *DeleteTestSuite) TestDeletePendingAccounts() {
+	var (
+		ctx  = context.Background()
+		user = new(gtsmodel.User)
+	)
+	*user = *suite.testUsers["local_account_1"]
+
+	// Pretend deletion was
+	// requested 8 days ago.
+	user.DeletionRequestedAt = time.Now().Add(-8 * 24 * time.Hour)
+	if err := suite.db.UpdateUser(ctx, user, "deletion_requested_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.user.DeletePendingAccounts(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{user.AccountID}, suite.queuedDeletes())
+
+	// User should now be disabled,
+	// and no longer pending deletion.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbUser.Disabled)
+	suite.False(dbUser.PendingDeletion())
+
+	// Next run shouldn't pick them up again.
+	if err := suite.user.DeletePendingAccounts(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suite.queuedDeletes())
+}
+
+func (suite *DeleteTestSuite) TestDeleteSelfNoGracePeriod() {
+	var (
+		ctx     = context.Background()
+		account = testrig.NewTestAccounts()["local_account_1"]
+	)
+
+	config.SetAccountsDeletionGrace(0)
+
+	if errWithCode := suite.user.DeleteSelf(ctx, account); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal([]string{account.ID}, suite.queuedDeletes())
+}
+
+func TestDeleteTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteTestSuite))
+}
@@ -0,0 +1,244 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// digestEvery is how often accounts
+	// are checked for email digests due.
+	digestEvery = time.Hour
+
+	// digestLimit is the maximum number of new
+	// followers, and of mentions, in one digest.
+	digestLimit = 50
+)
+
+// ScheduleDigests schedules email digests to be sent
+// periodically to accounts that have them enabled.
+func (p *Processor) ScheduleDigests() {
+	fn := func(ctx context.Context, start time.Time) {
+		log.Debug(ctx, "sending email digests")
+		if err := p.SendDigests(ctx); err != nil {
+			log.Errorf(ctx, "error sending email digests: %v", err)
+			return
+		}
+		log.Debugf(ctx, "finished sending email digests after %s", time.Since(start))
+	}
+
+	log.Infof(nil, "scheduling email digests to run every %s", digestEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurringExclusive(
+		"@emaildigests",
+		time.Now(),
+		digestEvery,
+		fn,
+	) {
+		panic("failed to schedule @emaildigests")
+	}
+}
+
+// SendDigests sends an email digest of new followers, mentions,
+// and pending follow requests to each account that has email
+// digests enabled, and is due a digest according to its settings.
+func (p *Processor) SendDigests(ctx context.Context) error {
+	accountIDs, err := p.state.DB.GetAccountIDsWithEmailDigest(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting accounts: %w", err)
+	}
+
+	now := time.Now()
+	errs := gtserror.NewMultiError(len(accountIDs))
+	for _, accountID := range accountIDs {
+		if err := p.sendDigest(ctx, accountID, now); err != nil {
+			errs.Appendf("error sending digest to account %s: %w", accountID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (p *Processor) sendDigest(ctx context.Context, accountID string, now time.Time) error {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return gtserror.Newf("db error getting account: %w", err)
+	}
+
+	settings := account.Settings
+	if settings == nil || settings.EmailDigestDays <= 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	period := time.Duration(settings.EmailDigestDays) * 24 * time.Hour
+	if now.Sub(settings.EmailDigestSentAt) < period {
+		// Not due yet.
+		return nil
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, accountID)
+	if err != nil {
+		return gtserror.Newf("db error getting user: %w", err)
+	}
+
+	if user.Email == "" ||
+		!util.PtrOrZero(user.Approved) ||
+		util.PtrOrZero(user.Disabled) ||
+		user.PendingDeletion() {
+		// Can't (or shouldn't)
+		// email this user.
+		return nil
+	}
+
+	// Cover the time since the last digest, so nothing
+	// is missed between runs, unless that was so long
+	// ago that digests must have been turned off since.
+	since := now.Add(-period)
+	if sentAt := settings.EmailDigestSentAt; sentAt.Before(since) &&
+		sentAt.After(since.Add(-period)) {
+		since = sentAt
+	}
+
+	data := email.DigestData{
+		Username: account.Username,
+		Days:     settings.EmailDigestDays,
+	}
+
+	if util.PtrOrValue(settings.EmailDigestFollows, true) {
+		notifs, err := p.digestNotifications(ctx, accountID, since, gtsmodel.NotificationFollow)
+		if err != nil {
+			return err
+		}
+
+		for _, notif := range notifs {
+			data.NewFollowers = append(data.NewFollowers, digestAcct(notif.OriginAccount))
+		}
+	}
+
+	if util.PtrOrValue(settings.EmailDigestMentions, true) {
+		notifs, err := p.digestNotifications(ctx, accountID, since, gtsmodel.NotificationMention)
+		if err != nil {
+			return err
+		}
+
+		for _, notif := range notifs {
+			if notif.Status == nil {
+				// Status since deleted.
+				continue
+			}
+
+			data.Mentions = append(data.Mentions, email.DigestMention{
+				Account: digestAcct(notif.OriginAccount),
+				URL:     notif.Status.URL,
+			})
+		}
+	}
+
+	if util.PtrOrValue(settings.EmailDigestFollowRequests, true) {
+		followReqIDs, err := p.state.DB.GetAccountFollowRequestIDs(ctx, accountID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting follow requests: %w", err)
+		}
+		data.FollowRequestsCount = len(followReqIDs)
+	}
+
+	if len(data.NewFollowers) != 0 ||
+		len(data.Mentions) != 0 ||
+		data.FollowRequestsCount != 0 {
+		instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+		if err != nil {
+			return gtserror.Newf("db error getting instance: %w", err)
+		}
+
+		data.InstanceURL = instance.URI
+		data.InstanceName = instance.Title
+		data.SettingsURL = instance.URI + "/settings/user/emailpassword"
+
+		if err := p.emailSender.SendDigestEmail(user.Email, data); err != nil {
+			return gtserror.Newf("error sending email: %w", err)
+		}
+
+		user.LastEmailedAt = now
+		if err := p.state.DB.UpdateUser(ctx, user, "last_emailed_at"); err != nil {
+			return gtserror.Newf("db error updating user: %w", err)
+		}
+	}
+
+	// Mark the digest period done even if there
+	// was nothing to send, so the next digest
+	// covers only the following period.
+	settings.EmailDigestSentAt = now
+	if err := p.state.DB.UpdateAccountSettings(ctx, settings, "email_digest_sent_at"); err != nil {
+		return gtserror.Newf("db error updating account settings: %w", err)
+	}
+
+	return nil
+}
+
+// digestNotifications returns up to digestLimit notifications
+// of the given type targeting accountID, created after since.
+func (p *Processor) digestNotifications(
+	ctx context.Context,
+	accountID string,
+	since time.Time,
+	notifType gtsmodel.NotificationType,
+) ([]*gtsmodel.Notification, error) {
+	minID, err := id.NewULIDFromTime(since)
+	if err != nil {
+		return nil, gtserror.Newf("error generating min id: %w", err)
+	}
+
+	notifs, err := p.state.DB.GetAccountNotifications(ctx,
+		accountID,
+		&paging.Page{
+			Min:   paging.MinID(minID),
+			Limit: digestLimit,
+		},
+		[]gtsmodel.NotificationType{notifType},
+		nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting %s notifications: %w", notifType, err)
+	}
+
+	return notifs, nil
+}
+
+// digestAcct returns the @username@domain
+// form of the given account for digests.
+func digestAcct(account *gtsmodel.Account) string {
+	domain := account.Domain
+	if domain == "" {
+		domain = config.GetAccountDomain()
+	}
+	return "@" + account.Username + "@" + domain
+}
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DigestTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *DigestTestSuite) TestSendDigests() {
+	var (
+		ctx            = context.Background()
+		user           = suite.testUsers["local_account_1"]
+		requestingAcct = testrig.NewTestAccounts()["remote_account_1"]
+	)
+
+	// Enable weekly digests, without mentions.
+	settings, err := suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.EmailDigestDays = 7
+	settings.EmailDigestMentions = util.Ptr(false)
+	if err := suite.db.UpdateAccountSettings(ctx, settings,
+		"email_digest_days",
+		"email_digest_mentions",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Add a recent follow notification
+	// and a pending follow request.
+	if err := suite.db.PutNotification(ctx, &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  user.AccountID,
+		OriginAccountID:  requestingAcct.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+		ID:              id.NewULID(),
+		URI:             "http://fossbros-anonymous.io/users/foss_satan/follow/digest",
+		AccountID:       requestingAcct.ID,
+		TargetAccountID: user.AccountID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.user.SendDigests(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	digest := suite.sentEmails[user.Email]
+	suite.Contains(digest, "Subject: GoToSocial Activity Digest")
+	suite.Contains(digest, "- @foss_satan@fossbros-anonymous.io")
+	suite.Contains(digest, "You have 1 pending follow request")
+	suite.NotContains(digest, "Mentions:")
+
+	// Sent time should be stored.
+	settings, err = suite.db.GetAccountSettings(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(time.Now(), settings.EmailDigestSentAt, time.Minute)
+
+	// Running again immediately shouldn't send
+	// another digest, since it's not due yet.
+	delete(suite.sentEmails, user.Email)
+	if err := suite.user.SendDigests(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suite.sentEmails)
+}
+
+func TestDigestTestSuite(t *testing.T) {
+	suite.Run(t, new(DigestTestSuite))
+}
@@ -0,0 +1,192 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// inviteCodeSize is the number of random
+// bytes in a (hex-encoded) invite code.
+const inviteCodeSize = 16
+
+// InvitesGet returns all invites created by the given account, newest first.
+func (p *Processor) InvitesGet(ctx context.Context, accountID string) ([]*apimodel.Invite, gtserror.WithCode) {
+	invites, err := p.state.DB.GetInvites(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invites: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInvites := make([]*apimodel.Invite, len(invites))
+	for i, invite := range invites {
+		apiInvites[i] = p.converter.InviteToAPIInvite(invite)
+	}
+
+	return apiInvites, nil
+}
+
+// InviteCreate creates a new invite link on behalf of the given user.
+func (p *Processor) InviteCreate(
+	ctx context.Context,
+	user *gtsmodel.User,
+	form *apimodel.InviteCreateRequest,
+) (*apimodel.Invite, gtserror.WithCode) {
+	// Admins and moderators can always create
+	// invites, other users only if allowed to.
+	if !config.GetAccountsAllowUserInvites() &&
+		!*user.Admin && !*user.Moderator {
+		const text = "you are not allowed to create invites on this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if form.MaxUses < 0 {
+		const text = "max_uses must not be negative"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	var expiresAt time.Time
+	if form.ExpiresIn != nil {
+		if *form.ExpiresIn <= 0 {
+			const text = "expires_in must be a positive number of seconds"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		expiresAt = time.Now().Add(time.Duration(*form.ExpiresIn) * time.Second)
+	}
+
+	b := make([]byte, inviteCodeSize)
+	if _, err := rand.Read(b); err != nil {
+		err := gtserror.Newf("error generating invite code: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	invite := &gtsmodel.Invite{
+		ID:                 id.NewULID(),
+		Code:               hex.EncodeToString(b),
+		CreatedByAccountID: user.AccountID,
+		MaxUses:            form.MaxUses,
+		ExpiresAt:          expiresAt,
+	}
+
+	if err := p.state.DB.PutInvite(ctx, invite); err != nil {
+		err := gtserror.Newf("db error putting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Refetch to pick up
+	// db default values.
+	invite, err := p.state.DB.GetInviteByID(ctx, invite.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.InviteToAPIInvite(invite), nil
+}
+
+// InviteExpire expires the invite with the given ID, created by the given
+// account, so that it can no longer be used to sign up. Accounts that
+// already signed up with the invite are unaffected.
+func (p *Processor) InviteExpire(
+	ctx context.Context,
+	accountID string,
+	inviteID string,
+) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByID(ctx, inviteID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || invite.CreatedByAccountID != accountID {
+		// Don't leak existence of
+		// other accounts' invites.
+		err := gtserror.Newf("invite %s not found", inviteID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if now := time.Now(); !invite.Expired(now) {
+		invite.ExpiresAt = now
+		if err := p.state.DB.UpdateInvite(ctx, invite, "expires_at"); err != nil {
+			err := gtserror.Newf("db error updating invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.converter.InviteToAPIInvite(invite), nil
+}
+
+// InviteCheck returns whether the given
+// code can currently be used to sign up.
+func (p *Processor) InviteCheck(ctx context.Context, code string) (bool, gtserror.WithCode) {
+	_, errWithCode := p.getUsableInvite(ctx, code)
+	switch {
+	case errWithCode == nil:
+		return true, nil
+	case errWithCode.Code() == http.StatusForbidden:
+		return false, nil
+	default:
+		return false, errWithCode
+	}
+}
+
+// getUsableInvite returns the invite with the given
+// code, provided it can still be used to sign up.
+func (p *Processor) getUsableInvite(ctx context.Context, code string) (*gtsmodel.Invite, gtserror.WithCode) {
+	const text = "invite code is invalid or has expired"
+
+	invite, err := p.state.DB.GetInviteByCode(ctx, code)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || !invite.Usable(time.Now()) {
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Invites stop working if
+	// their creator is suspended.
+	creator, err := p.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		invite.CreatedByAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite creator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if creator == nil || creator.IsSuspended() {
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	return invite, nil
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type InviteTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *InviteTestSuite) TestInviteCreateNotAllowed() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	// Regular users can't create
+	// invites unless allowed to.
+	_, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	config.SetAccountsAllowUserInvites(true)
+	defer config.SetAccountsAllowUserInvites(false)
+
+	invite, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(user.AccountID, invite.CreatedBy)
+}
+
+func (suite *InviteTestSuite) TestInviteCreateCheckExpire() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["admin_account"]
+	)
+
+	invite, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{
+		MaxUses:   5,
+		ExpiresIn: util.Ptr(86400),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(invite.Code, 32)
+	suite.Equal("http://localhost:8080/signup?invite="+invite.Code, invite.URL)
+	suite.Equal(5, *invite.MaxUses)
+	suite.Zero(invite.Uses)
+	suite.True(invite.Usable)
+	suite.NotNil(invite.ExpiresAt)
+
+	usable, errWithCode := suite.user.InviteCheck(ctx, invite.Code)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(usable)
+
+	// Someone else can't expire the invite.
+	_, errWithCode = suite.user.InviteExpire(ctx, suite.testUsers["local_account_1"].AccountID, invite.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	invite, errWithCode = suite.user.InviteExpire(ctx, user.AccountID, invite.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(invite.Usable)
+
+	usable, errWithCode = suite.user.InviteCheck(ctx, invite.Code)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(usable)
+
+	// Unknown codes aren't usable either.
+	usable, errWithCode = suite.user.InviteCheck(ctx, "not a real code")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(usable)
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
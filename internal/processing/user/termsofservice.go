@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// TermsOfServicePending returns the terms of service version
+// currently in effect, if the given user has to accept it before
+// signing in, or nil if they don't. That's the case when any
+// version published since the last one the user accepted was
+// published with acceptance required.
+func (p *Processor) TermsOfServicePending(
+	ctx context.Context,
+	user *gtsmodel.User,
+) (*gtsmodel.TermsOfService, gtserror.WithCode) {
+	// Versions are sorted newest first.
+	versions, err := p.state.DB.GetAllTermsOfService(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting terms of service: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, tos := range versions {
+		if tos.ID <= user.TermsOfServiceID {
+			// User accepted this version
+			// or a later one, nothing to do.
+			break
+		}
+
+		if *tos.RequireAcceptance {
+			return versions[0], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// TermsOfServiceAccept records that the given user
+// has accepted the terms of service version with ID.
+func (p *Processor) TermsOfServiceAccept(
+	ctx context.Context,
+	user *gtsmodel.User,
+	id string,
+) gtserror.WithCode {
+	tos, err := p.state.DB.GetTermsOfServiceByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting terms of service %s: %w", id, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if tos == nil {
+		const text = "terms of service not found"
+		return gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if tos.ID <= user.TermsOfServiceID {
+		// Already accepted this
+		// version or a later one.
+		return nil
+	}
+
+	user.TermsOfServiceID = tos.ID
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"terms_of_service_id",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type TermsOfServiceTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TermsOfServiceTestSuite) putTermsOfService(id string, requireAcceptance bool) *gtsmodel.TermsOfService {
+	tos := &gtsmodel.TermsOfService{
+		ID:                id,
+		Text:              "Be nice.",
+		Content:           "<p>Be nice.</p>",
+		RequireAcceptance: util.Ptr(requireAcceptance),
+	}
+
+	if err := suite.db.PutTermsOfService(context.Background(), tos); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return tos
+}
+
+func (suite *TermsOfServiceTestSuite) TestPendingAccept() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	// No terms published, nothing to accept.
+	pending, errWithCode := suite.user.TermsOfServicePending(ctx, user)
+	suite.NoError(errWithCode)
+	suite.Nil(pending)
+
+	// Terms not requiring acceptance
+	// don't have to be accepted either.
+	suite.putTermsOfService("01JKC0D3R7C3X9Q1F6M0V2T8HA", false)
+	pending, errWithCode = suite.user.TermsOfServicePending(ctx, user)
+	suite.NoError(errWithCode)
+	suite.Nil(pending)
+
+	// Publish a version requiring acceptance,
+	// followed by a minor change that doesn't:
+	// the latest version must still be accepted.
+	suite.putTermsOfService("01JKC0E5W2M8B4N7K1Q9S3D6PZ", true)
+	latest := suite.putTermsOfService("01JKC0F9A1B2C3D4E5F6G7H8J9", false)
+	pending, errWithCode = suite.user.TermsOfServicePending(ctx, user)
+	suite.NoError(errWithCode)
+	suite.NotNil(pending)
+	suite.Equal(latest.ID, pending.ID)
+
+	// Accept the latest version.
+	errWithCode = suite.user.TermsOfServiceAccept(ctx, user, latest.ID)
+	suite.NoError(errWithCode)
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.Equal(latest.ID, dbUser.TermsOfServiceID)
+
+	// Nothing left to accept.
+	pending, errWithCode = suite.user.TermsOfServicePending(ctx, dbUser)
+	suite.NoError(errWithCode)
+	suite.Nil(pending)
+
+	// Accepting an older version
+	// doesn't move the user back.
+	errWithCode = suite.user.TermsOfServiceAccept(ctx, dbUser, "01JKC0E5W2M8B4N7K1Q9S3D6PZ")
+	suite.NoError(errWithCode)
+	suite.Equal(latest.ID, dbUser.TermsOfServiceID)
+
+	// Unknown versions can't be accepted.
+	errWithCode = suite.user.TermsOfServiceAccept(ctx, dbUser, "01JKC0G0000000000000000000")
+	suite.EqualError(errWithCode, "terms of service not found")
+}
+
+func TestTermsOfServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TermsOfServiceTestSuite))
+}
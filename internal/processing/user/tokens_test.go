@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TokensTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TokensTestSuite) TestTokensGet() {
+	var (
+		ctx    = context.Background()
+		user   = suite.testUsers["local_account_1"]
+		tokens = testrig.NewTestTokens()
+	)
+
+	resp, errWithCode := suite.user.TokensGet(ctx, user.ID, &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Only the access token owned by this user
+	// should be returned, not the authorization
+	// code token, or anyone else's tokens.
+	suite.Len(resp.Items, 1)
+	tokenInfo := resp.Items[0].(*apimodel.TokenInfo)
+	suite.Equal(tokens["local_account_1"].ID, tokenInfo.ID)
+	suite.Equal("read write follow push", tokenInfo.Scope)
+	suite.Equal("really cool gts application", tokenInfo.Application.Name)
+	suite.Empty(tokenInfo.LastUsed)
+}
+
+func (suite *TokensTestSuite) TestTokenGetNotOwned() {
+	var (
+		ctx    = context.Background()
+		user   = suite.testUsers["local_account_1"]
+		tokens = testrig.NewTestTokens()
+	)
+
+	// Someone else's token.
+	_, errWithCode := suite.user.TokenGet(ctx, user.ID, tokens["local_account_2"].ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Authorization code, not an access token.
+	_, errWithCode = suite.user.TokenGet(ctx, user.ID, tokens["local_account_1_user_authorization_token"].ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *TokensTestSuite) TestTokenInvalidate() {
+	var (
+		ctx   = context.Background()
+		user  = suite.testUsers["local_account_1"]
+		token = testrig.NewTestTokens()["local_account_1"]
+	)
+
+	tokenInfo, errWithCode := suite.user.TokenInvalidate(ctx, user.ID, token.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(token.ID, tokenInfo.ID)
+
+	// Token should be gone now.
+	_, err := suite.db.GetTokenByAccess(ctx, token.Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// And no longer listed.
+	resp, errWithCode := suite.user.TokensGet(ctx, user.ID, &paging.Page{Limit: 20})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(resp.Items)
+}
+
+func TestTokensTestSuite(t *testing.T) {
+	suite.Run(t, new(TokensTestSuite))
+}
@@ -0,0 +1,321 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// twoFactorBackupCount is the number of backup
+	// codes generated when two factor auth is enabled.
+	twoFactorBackupCount = 8
+
+	// twoFactorBackupSize is the size in bytes of each
+	// backup code, giving codes of twice this in hex chars.
+	twoFactorBackupSize = 5
+
+	// twoFactorMaxFailures is the number of failed
+	// sign in codes allowed for a user before checks
+	// are refused until the failures cache expires.
+	twoFactorMaxFailures = 5
+)
+
+// TwoFactorEnroll generates and stores a new pending TOTP
+// secret for the given user, returning the secret and a
+// key URI that the user can add to an authenticator app,
+// provided the given password is their current password.
+//
+// Two factor auth is not enabled until TwoFactorEnable
+// is called with a valid code generated from the secret.
+func (p *Processor) TwoFactorEnroll(
+	ctx context.Context,
+	user *gtsmodel.User,
+	password string,
+) (*apimodel.TwoFactorEnrollment, gtserror.WithCode) {
+	if err := bcrypt.CompareHashAndPassword(
+		[]byte(user.EncryptedPassword),
+		[]byte(password),
+	); err != nil {
+		err := gtserror.Newf("%w", err)
+		return nil, gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if user.TwoFactorEnabled() {
+		const text = "two factor authentication is already enabled; disable it first to enroll again"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		err := gtserror.Newf("error generating secret: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user.TwoFactorSecret = secret
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	accountName := user.Email
+	if user.Account != nil {
+		accountName = user.Account.Username
+	}
+
+	return &apimodel.TwoFactorEnrollment{
+		Secret: secret,
+		URI:    totp.URI(secret, config.GetHost(), accountName),
+	}, nil
+}
+
+// TwoFactorEnable completes two factor enrollment for the given
+// user, provided code is valid for their pending TOTP secret.
+// It returns a fresh set of one-time backup codes, which are
+// only stored hashed and so cannot be shown again.
+func (p *Processor) TwoFactorEnable(
+	ctx context.Context,
+	user *gtsmodel.User,
+	code string,
+) (*apimodel.TwoFactorBackupCodes, gtserror.WithCode) {
+	if user.TwoFactorEnabled() {
+		const text = "two factor authentication is already enabled"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	if user.TwoFactorSecret == "" {
+		const text = "no pending two factor enrollment; enroll first"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	counter, ok := totp.Validate(
+		user.TwoFactorSecret,
+		code,
+		time.Now(),
+		uint64(user.TwoFactorLastCounter), // #nosec G115 -- Set from uint64.
+	)
+	if !ok {
+		const text = "two factor code was incorrect"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	codes := make([]string, twoFactorBackupCount)
+	hashes := make([]string, twoFactorBackupCount)
+	for i := range codes {
+		b := make([]byte, twoFactorBackupSize)
+		if _, err := rand.Read(b); err != nil {
+			err := gtserror.Newf("error generating backup code: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		codes[i] = hex.EncodeToString(b)
+
+		hash, err := bcrypt.GenerateFromPassword(
+			[]byte(codes[i]),
+			bcrypt.DefaultCost,
+		)
+		if err != nil {
+			err := gtserror.Newf("error hashing backup code: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		hashes[i] = string(hash)
+	}
+
+	user.TwoFactorBackups = hashes
+	user.TwoFactorEnabledAt = time.Now()
+	user.TwoFactorLastCounter = int64(counter) // #nosec G115 -- Unix time steps fit in int64.
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_backups",
+		"two_factor_enabled_at",
+		"two_factor_last_counter",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.TwoFactorBackupCodes{
+		BackupCodes: codes,
+	}, nil
+}
+
+// TwoFactorDisable disables two factor auth for the given
+// user, and removes their secret and any backup codes,
+// provided the given password is their current password.
+func (p *Processor) TwoFactorDisable(
+	ctx context.Context,
+	user *gtsmodel.User,
+	password string,
+) gtserror.WithCode {
+	if err := bcrypt.CompareHashAndPassword(
+		[]byte(user.EncryptedPassword),
+		[]byte(password),
+	); err != nil {
+		err := gtserror.Newf("%w", err)
+		return gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if user.TwoFactorSecret == "" && !user.TwoFactorEnabled() {
+		// Nothing to do.
+		return nil
+	}
+
+	if err := p.TwoFactorReset(ctx, user); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// TwoFactorReset unconditionally removes two factor auth
+// details from the given user. It is up to the caller to
+// ensure that this is authorized, eg., by checking password.
+func (p *Processor) TwoFactorReset(ctx context.Context, user *gtsmodel.User) error {
+	user.TwoFactorSecret = ""
+	user.TwoFactorBackups = nil
+	user.TwoFactorEnabledAt = time.Time{}
+	user.TwoFactorLastCounter = 0
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+		"two_factor_backups",
+		"two_factor_enabled_at",
+		"two_factor_last_counter",
+	); err != nil {
+		return gtserror.Newf("db error updating user: %w", err)
+	}
+	return nil
+}
+
+// TwoFactorCheck checks the given code for the given user,
+// who is attempting to sign in. The code may either be
+// a current, not yet used TOTP code, or one of the user's
+// backup codes, in which case the backup code is used up.
+//
+// After too many failed attempts, further checks for
+// the user are refused until the failures expire.
+func (p *Processor) TwoFactorCheck(
+	ctx context.Context,
+	user *gtsmodel.User,
+	code string,
+) gtserror.WithCode {
+	if !user.TwoFactorEnabled() {
+		// Nothing to check.
+		return nil
+	}
+
+	failures, _ := p.state.Caches.TwoFactorFailures.Get(user.ID)
+	if failures >= twoFactorMaxFailures {
+		const text = "too many failed two factor attempts, try again later"
+		err := gtserror.Newf("two factor attempts exhausted for user %s", user.ID)
+		return gtserror.NewErrorTooManyRequests(err, text)
+	}
+
+	errWithCode := p.twoFactorCheck(ctx, user, code)
+	if errWithCode != nil {
+		if errWithCode.Code() == http.StatusUnauthorized {
+			// Only count actual wrong codes
+			// towards the failure limit.
+			p.state.Caches.TwoFactorFailures.Set(user.ID, failures+1)
+		}
+		return errWithCode
+	}
+
+	p.state.Caches.TwoFactorFailures.Invalidate(user.ID)
+	return nil
+}
+
+func (p *Processor) twoFactorCheck(
+	ctx context.Context,
+	user *gtsmodel.User,
+	code string,
+) gtserror.WithCode {
+	const text = "two factor code was incorrect"
+
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return gtserror.NewErrorUnauthorized(errors.New("no code provided"), text)
+	}
+
+	counter, ok := totp.Validate(
+		user.TwoFactorSecret,
+		code,
+		time.Now(),
+		uint64(user.TwoFactorLastCounter), // #nosec G115 -- Set from uint64.
+	)
+	if ok {
+		// Store the counter so
+		// this code can't be replayed.
+		user.TwoFactorLastCounter = int64(counter) // #nosec G115 -- Unix time steps fit in int64.
+		if err := p.state.DB.UpdateUser(
+			ctx, user,
+			"two_factor_last_counter",
+		); err != nil {
+			err := gtserror.Newf("db error updating user: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+		return nil
+	}
+
+	// Not a valid TOTP code,
+	// check backup codes.
+	code = strings.ToLower(code)
+	for i, hash := range user.TwoFactorBackups {
+		if bcrypt.CompareHashAndPassword(
+			[]byte(hash),
+			[]byte(code),
+		) != nil {
+			continue
+		}
+
+		// Backup code matched, remove it
+		// from the slice so it can't be reused.
+		backups := make([]string, 0, len(user.TwoFactorBackups)-1)
+		backups = append(backups, user.TwoFactorBackups[:i]...)
+		backups = append(backups, user.TwoFactorBackups[i+1:]...)
+
+		user.TwoFactorBackups = backups
+		if err := p.state.DB.UpdateUser(
+			ctx, user,
+			"two_factor_backups",
+		); err != nil {
+			err := gtserror.Newf("db error updating user: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		return nil
+	}
+
+	err := gtserror.Newf("two factor code didn't match for user %s", user.ID)
+	return gtserror.NewErrorUnauthorized(err, text)
+}
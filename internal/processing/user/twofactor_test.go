@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TwoFactorTestSuite) TestEnrollEnableCheckDisable() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	// Enrolling with the wrong password should fail.
+	_, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "wrong password")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Empty(user.TwoFactorSecret)
+
+	// Enroll.
+	enrollment, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "password")
+	suite.NoError(errWithCode)
+	suite.NotEmpty(enrollment.Secret)
+	suite.Contains(enrollment.URI, "secret="+enrollment.Secret)
+	suite.False(user.TwoFactorEnabled())
+
+	// A wrong code should not enable 2fa.
+	_, errWithCode = suite.user.TwoFactorEnable(ctx, user, "000000x")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.False(user.TwoFactorEnabled())
+
+	// Enable with the right code.
+	code, err := totp.Code(enrollment.Secret, time.Now())
+	suite.NoError(err)
+	backups, errWithCode := suite.user.TwoFactorEnable(ctx, user, code)
+	suite.NoError(errWithCode)
+	suite.Len(backups.BackupCodes, 8)
+
+	// Re-fetch from the db to make sure it was stored.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.True(dbUser.TwoFactorEnabled())
+	suite.Len(dbUser.TwoFactorBackups, 8)
+
+	// Enrolling again should fail.
+	_, errWithCode = suite.user.TwoFactorEnroll(ctx, dbUser, "password")
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Code used to enable shouldn't be
+	// accepted again at sign in.
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Next TOTP code should be accepted at sign in, once.
+	code, err = totp.Code(enrollment.Secret, time.Now().Add(totp.Period))
+	suite.NoError(err)
+	suite.NoError(suite.user.TwoFactorCheck(ctx, dbUser, code))
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Garbage should not.
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, "nope")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Backup code should be accepted once only.
+	backup := backups.BackupCodes[3]
+	suite.NoError(suite.user.TwoFactorCheck(ctx, dbUser, backup))
+	suite.Len(dbUser.TwoFactorBackups, 7)
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, backup)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Disabling with the wrong password should fail.
+	errWithCode = suite.user.TwoFactorDisable(ctx, dbUser, "wrong password")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.True(dbUser.TwoFactorEnabled())
+
+	// Disable with the right password.
+	suite.NoError(suite.user.TwoFactorDisable(ctx, dbUser, "password"))
+
+	dbUser, err = suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.False(dbUser.TwoFactorEnabled())
+	suite.Empty(dbUser.TwoFactorSecret)
+	suite.Empty(dbUser.TwoFactorBackups)
+	suite.Zero(dbUser.TwoFactorLastCounter)
+}
+
+func (suite *TwoFactorTestSuite) TestCheckTooManyFailures() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_2"]
+
+	enrollment, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "password")
+	suite.NoError(errWithCode)
+
+	code, err := totp.Code(enrollment.Secret, time.Now())
+	suite.NoError(err)
+	_, errWithCode = suite.user.TwoFactorEnable(ctx, user, code)
+	suite.NoError(errWithCode)
+
+	for i := 0; i < 5; i++ {
+		errWithCode = suite.user.TwoFactorCheck(ctx, user, "nope")
+		suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	}
+
+	// Even a valid code is now refused.
+	code, err = totp.Code(enrollment.Secret, time.Now().Add(totp.Period))
+	suite.NoError(err)
+	errWithCode = suite.user.TwoFactorCheck(ctx, user, code)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+
+	// Until failures are forgotten.
+	suite.state.Caches.TwoFactorFailures.Invalidate(user.ID)
+	suite.NoError(suite.user.TwoFactorCheck(ctx, user, code))
+}
+
+func (suite *TwoFactorTestSuite) TestEnableNotEnrolled() {
+	user := suite.testUsers["local_account_1"]
+
+	_, errWithCode := suite.user.TwoFactorEnable(context.Background(), user, "123456")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: no pending two factor enrollment; enroll first", errWithCode.Safe())
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, &TwoFactorTestSuite{})
+}
@@ -18,6 +18,7 @@
 package user
 
 import (
+	"github.com/superseriousbusiness/gotosocial/internal/captcha"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
@@ -29,6 +30,7 @@ type Processor struct {
 	converter   *typeutils.Converter
 	oauthServer oauth.Server
 	emailSender email.Sender
+	captcha     *captcha.Captcha
 }
 
 // New returns a new user processor.
@@ -42,5 +44,6 @@ func New(
 		state:       state,
 		converter:   converter,
 		emailSender: emailSender,
+		captcha:     captcha.New(),
 	}
 }
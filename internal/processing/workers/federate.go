@@ -19,16 +19,20 @@
 
 import (
 	"context"
+	"errors"
 	"net/url"
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -192,6 +196,15 @@ func (f *federate) CreateStatus(ctx context.Context, status *gtsmodel.Status) er
 	if _, err := f.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
 		return gtserror.Newf("error sending Create activity via outbox %s: %w", outboxIRI, err)
 	}
+
+	// Public top-level statuses
+	// are also sent to any relays
+	// this instance subscribes to.
+	if status.Visibility == gtsmodel.VisibilityPublic &&
+		status.InReplyToURI == "" {
+		f.deliverToRelays(ctx, status.Account, create)
+	}
+
 	return nil
 }
 
@@ -313,26 +326,27 @@ func (f *federate) UpdateStatus(ctx context.Context, status *gtsmodel.Status) er
 	return nil
 }
 
-func (f *federate) Follow(ctx context.Context, follow *gtsmodel.Follow) error {
+func (f *federate) Follow(ctx context.Context, followRequest *gtsmodel.FollowRequest) error {
 	// Populate model.
-	if err := f.state.DB.PopulateFollow(ctx, follow); err != nil {
-		return gtserror.Newf("error populating follow: %w", err)
+	if err := f.state.DB.PopulateFollowRequest(ctx, followRequest); err != nil {
+		return gtserror.Newf("error populating follow request: %w", err)
 	}
 
 	// Do nothing if both accounts are local.
-	if follow.Account.IsLocal() &&
-		follow.TargetAccount.IsLocal() {
+	if followRequest.Account.IsLocal() &&
+		followRequest.TargetAccount.IsLocal() {
 		return nil
 	}
 
 	// Parse relevant URI(s).
-	outboxIRI, err := parseURI(follow.Account.OutboxURI)
+	outboxIRI, err := parseURI(followRequest.Account.OutboxURI)
 	if err != nil {
 		return err
 	}
 
-	// Convert follow to ActivityStreams Follow.
-	asFollow, err := f.converter.FollowToAS(ctx, follow)
+	// Convert follow request to ActivityStreams Follow
+	// (requests are sent as follows), including any note.
+	asFollow, err := f.converter.FollowRequestToAS(ctx, followRequest)
 	if err != nil {
 		return gtserror.Newf("error converting follow to AS: %s", err)
 	}
@@ -478,6 +492,63 @@ func (f *federate) UndoLike(ctx context.Context, fave *gtsmodel.StatusFave) erro
 	return nil
 }
 
+func (f *federate) UndoReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	// Populate model.
+	if err := f.state.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// Do nothing if both accounts are local.
+	if reaction.Account.IsLocal() &&
+		reaction.TargetAccount.IsLocal() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(reaction.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	targetAccountIRI, err := parseURI(reaction.TargetAccount.URI)
+	if err != nil {
+		return err
+	}
+
+	// Recreate the ActivityStreams Like.
+	like, err := f.converter.ReactionToAS(ctx, reaction)
+	if err != nil {
+		return gtserror.Newf("error converting reaction to AS: %w", err)
+	}
+
+	// Create a new Undo, with
+	// the actor of the Like.
+	undo := streams.NewActivityStreamsUndo()
+	undo.SetActivityStreamsActor(like.GetActivityStreamsActor())
+
+	// Set recreated Like as the 'object' property.
+	undoObject := streams.NewActivityStreamsObjectProperty()
+	undoObject.AppendActivityStreamsLike(like)
+	undo.SetActivityStreamsObject(undoObject)
+
+	// Address the Undo To the target account.
+	undoTo := streams.NewActivityStreamsToProperty()
+	undoTo.AppendIRI(targetAccountIRI)
+	undo.SetActivityStreamsTo(undoTo)
+
+	// Send the Undo via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, undo,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			undo, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 func (f *federate) UndoAnnounce(ctx context.Context, boost *gtsmodel.Status) error {
 	// Populate model.
 	if err := f.state.DB.PopulateStatus(ctx, boost); err != nil {
@@ -490,6 +561,12 @@ func (f *federate) UndoAnnounce(ctx context.Context, boost *gtsmodel.Status) err
 		return nil
 	}
 
+	// Do nothing if the boost
+	// shouldn't be federated.
+	if boost.IsLocalOnly() {
+		return nil
+	}
+
 	// Parse relevant URI(s).
 	outboxIRI, err := parseURI(boost.Account.OutboxURI)
 	if err != nil {
@@ -754,6 +831,46 @@ func (f *federate) Like(ctx context.Context, fave *gtsmodel.StatusFave) error {
 	return nil
 }
 
+// Reaction sends the given emoji reaction out to the
+// owner of the reacted-to status, as a Like with the
+// reaction emoji as its content.
+func (f *federate) Reaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	// Populate model.
+	if err := f.state.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// Do nothing if both accounts are local.
+	if reaction.Account.IsLocal() &&
+		reaction.TargetAccount.IsLocal() {
+		return nil
+	}
+
+	// Create the ActivityStreams Like.
+	like, err := f.converter.ReactionToAS(ctx, reaction)
+	if err != nil {
+		return gtserror.Newf("error converting reaction to AS Like: %w", err)
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(reaction.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	// Send the Like via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, like,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			like, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 // Announce sends the given boost out to relevant
 // recipients with the Outbox of the status creator.
 //
@@ -772,6 +889,12 @@ func (f *federate) Announce(ctx context.Context, boost *gtsmodel.Status) error {
 		return nil
 	}
 
+	// Do nothing if the boost
+	// shouldn't be federated.
+	if boost.IsLocalOnly() {
+		return nil
+	}
+
 	// Create the ActivityStreams Announce.
 	announce, err := f.converter.BoostToAS(
 		ctx,
@@ -865,6 +988,66 @@ func (f *federate) deliverToInboxOnly(
 	return nil
 }
 
+// deliverToRelays delivers the given activity, on behalf
+// of the sending account, to the inbox of each enabled
+// relay that has accepted this instance's subscription.
+//
+// Errors are logged rather than returned, since failure
+// to deliver to a relay shouldn't fail the whole side effect.
+func (f *federate) deliverToRelays(
+	ctx context.Context,
+	sendingAcct *gtsmodel.Account,
+	t vocab.Type,
+) {
+	relays, err := f.state.DB.GetRelays(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting relays: %v", err)
+		return
+	}
+
+	var m map[string]any
+	var tsport transport.Transport
+
+	for _, relay := range relays {
+		if !relay.Active() {
+			continue
+		}
+
+		toInbox, err := url.Parse(relay.InboxURI)
+		if err != nil {
+			log.Errorf(ctx, "error parsing relay inbox uri %s: %v", relay.InboxURI, err)
+			continue
+		}
+
+		if m == nil {
+			// Lazily serialize and get a transport,
+			// only once we know there's a relay to
+			// deliver the activity to.
+			m, err = ap.Serialize(t)
+			if err != nil {
+				log.Errorf(ctx, "error serializing activity %T: %v", t, err)
+				return
+			}
+
+			tsport, err = f.TransportController().NewTransportForUsername(
+				ctx,
+				sendingAcct.Username,
+			)
+			if err != nil {
+				log.Errorf(ctx, "error getting transport for %s: %v", sendingAcct.Username, err)
+				return
+			}
+		}
+
+		if err := tsport.Deliver(ctx, m, toInbox); err != nil {
+			log.Errorf(ctx,
+				"error delivering activity %T to relay inbox %s: %v",
+				t, relay.InboxURI, err,
+			)
+		}
+	}
+}
+
 func (f *federate) UpdateAccount(ctx context.Context, account *gtsmodel.Account) error {
 	// Populate model.
 	if err := f.state.DB.PopulateAccount(ctx, account); err != nil {
@@ -99,6 +99,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		case ap.ActivityLike:
 			return p.clientAPI.CreateLike(ctx, cMsg)
 
+		// CREATE EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.clientAPI.CreateReaction(ctx, cMsg)
+
 		// CREATE ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.CreateAnnounce(ctx, cMsg)
@@ -106,6 +110,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// CREATE BLOCK
 		case ap.ActivityBlock:
 			return p.clientAPI.CreateBlock(ctx, cMsg)
+
+		// CREATE APPEAL (against an admin action)
+		case ap.ObjectAppeal:
+			return p.clientAPI.CreateAppeal(ctx, cMsg)
 		}
 
 	// UPDATE SOMETHING
@@ -195,6 +203,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		case ap.ActivityLike:
 			return p.clientAPI.UndoFave(ctx, cMsg)
 
+		// UNDO EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.clientAPI.UndoReaction(ctx, cMsg)
+
 		// UNDO ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.UndoAnnounce(ctx, cMsg)
@@ -251,6 +263,10 @@ func (p *clientAPI) CreateUser(ctx context.Context, cMsg *messages.FromClientAPI
 		log.Errorf(ctx, "error emailing new signup: %v", err)
 	}
 
+	if err := p.surface.webhookAccountCreated(ctx, newUser); err != nil {
+		log.Errorf(ctx, "error sending new signup to webhooks: %v", err)
+	}
+
 	// Send "please confirm your address" email to the new user.
 	if err := p.surface.emailUserPleaseConfirm(ctx, newUser, true); err != nil {
 		log.Errorf(ctx, "error emailing confirm: %v", err)
@@ -259,6 +275,20 @@ func (p *clientAPI) CreateUser(ctx context.Context, cMsg *messages.FromClientAPI
 	return nil
 }
 
+func (p *clientAPI) CreateAppeal(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	appeal, ok := cMsg.GTSModel.(*gtsmodel.Appeal)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Appeal", cMsg.GTSModel)
+	}
+
+	// Notify mods of the new appeal.
+	if err := p.surface.notifyAppeal(ctx, appeal); err != nil {
+		log.Errorf(ctx, "error notifying mods of new appeal: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -352,6 +382,9 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status: %v", err)
 	}
 
+	// Notify WebSub hub of RSS feed update.
+	p.account.PublishRSSFeed(ctx, status)
+
 	if status.InReplyToID != "" {
 		// Interaction counts changed on the replied status;
 		// uncache the prepared version from all timelines.
@@ -441,12 +474,9 @@ func (p *clientAPI) CreateFollowReq(ctx context.Context, cMsg *messages.FromClie
 		log.Errorf(ctx, "error notifying follow request: %v", err)
 	}
 
-	// Convert the follow request to follow model (requests are sent as follows).
-	follow := p.converter.FollowRequestToFollow(ctx, followRequest)
-
 	if err := p.federate.Follow(
 		ctx,
-		follow,
+		followRequest,
 	); err != nil {
 		log.Errorf(ctx, "error federating follow request: %v", err)
 	}
@@ -554,6 +584,27 @@ func (p *clientAPI) CreateLike(ctx context.Context, cMsg *messages.FromClientAPI
 	return nil
 }
 
+func (p *clientAPI) CreateReaction(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	reaction, ok := cMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", cMsg.GTSModel)
+	}
+
+	if err := p.surface.notifyReaction(ctx, reaction); err != nil {
+		log.Errorf(ctx, "error notifying reaction: %v", err)
+	}
+
+	if err := p.federate.Reaction(ctx, reaction); err != nil {
+		log.Errorf(ctx, "error federating reaction: %v", err)
+	}
+
+	// Reactions changed on the status; uncache
+	// the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	return nil
+}
+
 func (p *clientAPI) CreateAnnounce(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	boost, ok := cMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -706,6 +757,9 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status update: %v", err)
 	}
 
+	// Notify WebSub hub of RSS feed update.
+	p.account.PublishRSSFeed(ctx, status)
+
 	if status.Poll != nil && status.Poll.Closing {
 
 		// If the latest status has a newly closed poll, at least compared
@@ -879,6 +933,23 @@ func (p *clientAPI) UndoFave(ctx context.Context, cMsg *messages.FromClientAPI)
 	return nil
 }
 
+func (p *clientAPI) UndoReaction(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	reaction, ok := cMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", cMsg.GTSModel)
+	}
+
+	if err := p.federate.UndoReaction(ctx, reaction); err != nil {
+		log.Errorf(ctx, "error federating reaction undo: %v", err)
+	}
+
+	// Reactions changed on the status; uncache
+	// the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	return nil
+}
+
 func (p *clientAPI) UndoAnnounce(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -964,6 +1035,9 @@ func (p *clientAPI) DeleteStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status delete: %v", err)
 	}
 
+	// Notify WebSub hub of RSS feed update.
+	p.account.PublishRSSFeed(ctx, status)
+
 	if status.InReplyToID != "" {
 		// Interaction counts changed on the replied status;
 		// uncache the prepared version from all timelines.
@@ -981,7 +1055,8 @@ func (p *clientAPI) DeleteAccountOrUser(ctx context.Context, cMsg *messages.From
 	//   - ID of an admin account (account suspension).
 	var originID string
 
-	if domainBlock, ok := cMsg.GTSModel.(*gtsmodel.DomainBlock); ok {
+	domainBlock, ok := cMsg.GTSModel.(*gtsmodel.DomainBlock)
+	if ok {
 		// Origin is a domain block.
 		originID = domainBlock.ID
 	} else {
@@ -1014,6 +1089,15 @@ func (p *clientAPI) DeleteAccountOrUser(ctx context.Context, cMsg *messages.From
 		log.Errorf(ctx, "error federating account delete: %v", err)
 	}
 
+	if originID != account.ID {
+		// Account is being removed by a moderation
+		// action, so record + notify local accounts
+		// of any follows that are about to be severed.
+		if err := p.utils.severRelationships(ctx, account, domainBlock); err != nil {
+			log.Errorf(ctx, "error severing relationships: %v", err)
+		}
+	}
+
 	if err := p.account.Delete(ctx, cMsg.Target, originID); err != nil {
 		log.Errorf(ctx, "error deleting account: %v", err)
 	}
@@ -1039,6 +1123,10 @@ func (p *clientAPI) ReportAccount(ctx context.Context, cMsg *messages.FromClient
 		log.Errorf(ctx, "error emailing report opened: %v", err)
 	}
 
+	if err := p.surface.webhookReportCreated(ctx, report); err != nil {
+		log.Errorf(ctx, "error sending report opened to webhooks: %v", err)
+	}
+
 	return nil
 }
 
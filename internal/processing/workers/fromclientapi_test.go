@@ -26,6 +26,7 @@
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
@@ -533,6 +534,160 @@ func (suite *FromClientAPITestSuite) TestProcessCreateStatusBoostMuted() {
 	suite.Nil(notif)
 }
 
+// putHomeFilter stores a home timeline filter for account
+// with the given action, matching the keyword "pee pee".
+func (suite *FromClientAPITestSuite) putHomeFilter(
+	ctx context.Context,
+	state *state.State,
+	account *gtsmodel.Account,
+	action gtsmodel.FilterAction,
+) *gtsmodel.Filter {
+	filterID := id.NewULID()
+	filter := &gtsmodel.Filter{
+		ID:        filterID,
+		AccountID: account.ID,
+		Title:     "no potty talk",
+		Action:    action,
+		Keywords: []*gtsmodel.FilterKeyword{
+			{
+				ID:        id.NewULID(),
+				AccountID: account.ID,
+				FilterID:  filterID,
+				Keyword:   "pee pee",
+			},
+		},
+		ContextHome: util.Ptr(true),
+	}
+
+	if err := state.DB.PutFilter(ctx, filter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return filter
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusFilteredHide() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = context.Background()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		streams          = suite.openStreams(ctx,
+			testStructs.Processor,
+			receivingAccount,
+			nil,
+		)
+		homeStream = streams[stream.TimelineHome]
+
+		// Admin account posts a new top-level status,
+		// which matches a hide filter of the receiver.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+	)
+
+	suite.putHomeFilter(ctx, testStructs.State, receivingAccount, gtsmodel.FilterActionHide)
+
+	// Process the new status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Filtered status should
+	// not be streamed at all.
+	suite.checkStreamed(
+		homeStream,
+		false,
+		"",
+		"",
+	)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusFilteredWarn() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = context.Background()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		streams          = suite.openStreams(ctx,
+			testStructs.Processor,
+			receivingAccount,
+			nil,
+		)
+		homeStream = streams[stream.TimelineHome]
+
+		// Admin account posts a new top-level status,
+		// which matches a warn filter of the receiver.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+	)
+
+	filter := suite.putHomeFilter(ctx, testStructs.State, receivingAccount, gtsmodel.FilterActionWarn)
+
+	// Process the new status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Filtered status should be streamed,
+	// annotated with the matching filter.
+	ctx, cncl := context.WithTimeout(ctx, time.Second*5)
+	defer cncl()
+
+	msg, ok := homeStream.Recv(ctx)
+	if !ok {
+		suite.FailNow("expected a message but message was not received")
+	}
+	suite.Equal(stream.EventTypeUpdate, msg.Event)
+
+	apiStatus := new(apimodel.Status)
+	if err := json.Unmarshal([]byte(msg.Payload), apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(status.ID, apiStatus.ID)
+	if suite.Len(apiStatus.Filtered, 1) {
+		suite.Equal(filter.ID, apiStatus.Filtered[0].Filter.ID)
+		suite.Equal([]string{"pee pee"}, apiStatus.Filtered[0].KeywordMatches)
+	}
+}
+
 func (suite *FromClientAPITestSuite) TestProcessCreateStatusListRepliesPolicyListOnlyOK() {
 	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
 	defer testrig.TearDownTestStructs(testStructs)
@@ -1972,6 +2127,75 @@ func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	}
 }
 
+func (suite *FromClientAPITestSuite) TestProcessAccountSuspendSeversRelationships() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = context.Background()
+		adminAccount     = suite.testAccounts["admin_account"]
+		suspendedAccount = suite.testAccounts["local_account_2"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Process the account suspension.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActorPerson,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       suspendedAccount,
+			Origin:         adminAccount,
+			Target:         suspendedAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// There should be a severance
+	// event for the suspension.
+	event, err := testStructs.State.DB.GetSeveranceEventByOriginID(ctx, suspendedAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.SeveranceAccountSuspension, event.Type)
+	suite.Equal(suspendedAccount.Username, event.TargetName)
+
+	// Receiving account followed + was followed
+	// by the suspended account, so it should
+	// have lost a relationship in each direction.
+	rels, err := testStructs.State.DB.GetSeveredRelationships(ctx, event.ID, receivingAccount.ID, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	directions := make([]gtsmodel.SeveredDirection, 0, len(rels))
+	for _, rel := range rels {
+		suite.Equal(suspendedAccount.ID, rel.TargetAccountID)
+		directions = append(directions, rel.Direction)
+	}
+	suite.ElementsMatch([]gtsmodel.SeveredDirection{
+		gtsmodel.SeveredFollowing,
+		gtsmodel.SeveredFollower,
+	}, directions)
+
+	// Receiving account should have been notified.
+	notifs, err := testStructs.State.DB.GetAccountNotifications(
+		ctx,
+		receivingAccount.ID,
+		nil,
+		[]gtsmodel.NotificationType{gtsmodel.NotificationSevered},
+		nil,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(notifs, 1) {
+		suite.Equal(event.ID, notifs[0].SeveranceEventID)
+	}
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
@@ -25,6 +25,7 @@
 
 	"codeberg.org/gruf/go-kv"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -34,10 +35,12 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -54,6 +57,24 @@ type fediAPI struct {
 }
 
 func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	var host string
+	switch {
+	case fMsg.Requesting != nil:
+		host = fMsg.Requesting.Domain
+	case fMsg.APIRI != nil:
+		host = fMsg.APIRI.Host
+	}
+
+	ctx, end := tracing.StartSpan(ctx, "federation.process_inbox", host,
+		kv.Field{K: "activityType", V: fMsg.APActivityType},
+		kv.Field{K: "objectType", V: fMsg.APObjectType},
+	)
+	err := p.processFromFediAPI(ctx, fMsg)
+	end(err)
+	return err
+}
+
+func (p *Processor) processFromFediAPI(ctx context.Context, fMsg *messages.FromFediAPI) error {
 	// Allocate new log fields slice
 	fields := make([]kv.Field, 3, 5)
 	fields[0] = kv.Field{"activityType", fMsg.APActivityType}
@@ -96,6 +117,10 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 		case ap.ActivityLike:
 			return p.fediAPI.CreateLike(ctx, fMsg)
 
+		// CREATE EMOJI REACTION
+		case ap.ActivityEmojiReact:
+			return p.fediAPI.CreateReaction(ctx, fMsg)
+
 		// CREATE ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.fediAPI.CreateAnnounce(ctx, fMsg)
@@ -255,6 +280,36 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		return nil
 	}
 
+	// Check status against automod rules,
+	// before it's timelined or notified.
+	removed, err := p.utils.applyAutomod(ctx, status)
+	if err != nil {
+		log.Errorf(ctx, "error applying automod: %v", err)
+	}
+
+	if removed {
+		// Status removed
+		// by automod rule.
+		return nil
+	}
+
+	// Score status for spam from the
+	// perspective of the receiver.
+	removed, err = p.utils.applySpamScore(ctx,
+		fMsg.Receiving,
+		status,
+		statusable,
+	)
+	if err != nil {
+		log.Errorf(ctx, "error applying spam score: %v", err)
+	}
+
+	if removed {
+		// Status removed
+		// as likely spam.
+		return nil
+	}
+
 	// If pending approval is true then
 	// status must reply to a LOCAL status
 	// that requires approval for the reply.
@@ -530,6 +585,59 @@ func (p *fediAPI) CreateLike(ctx context.Context, fMsg *messages.FromFediAPI) er
 	return nil
 }
 
+func (p *fediAPI) CreateReaction(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	reaction, ok := fMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", fMsg.GTSModel)
+	}
+
+	if placeholder := reaction.Emoji; placeholder != nil && reaction.EmojiID == "" {
+		// Reaction uses a custom emoji,
+		// make sure we have it stored.
+		emoji, err := p.federate.GetEmoji(ctx,
+			placeholder.Shortcode,
+			placeholder.Domain,
+			placeholder.ImageRemoteURL,
+			media.AdditionalEmojiInfo{
+				URI:                  &placeholder.URI,
+				ImageRemoteURL:       &placeholder.ImageRemoteURL,
+				ImageStaticRemoteURL: &placeholder.ImageStaticRemoteURL,
+			},
+			false,
+		)
+		if emoji == nil {
+			return gtserror.Newf("error loading reaction emoji %s: %w", reaction.Name, err)
+		} else if err != nil {
+			// non-fatal error occurred during loading, still use it.
+			log.Warnf(ctx, "partially loaded reaction emoji: %v", err)
+		}
+
+		reaction.EmojiID = emoji.ID
+		reaction.Emoji = emoji
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// The reaction already exists in the
+			// database, which means we've already
+			// handled side effects. We can just
+			// return nil here and be done with it.
+			return nil
+		}
+		return gtserror.Newf("db error inserting reaction: %w", err)
+	}
+
+	if err := p.surface.notifyReaction(ctx, reaction); err != nil {
+		log.Errorf(ctx, "error notifying reaction: %v", err)
+	}
+
+	// Reactions changed on the status; uncache
+	// the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	return nil
+}
+
 func (p *fediAPI) CreateAnnounce(ctx context.Context, fMsg *messages.FromFediAPI) error {
 	boost, ok := fMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -739,6 +847,10 @@ func (p *fediAPI) CreateFlag(ctx context.Context, fMsg *messages.FromFediAPI) er
 		log.Errorf(ctx, "error emailing report opened: %v", err)
 	}
 
+	if err := p.surface.webhookReportCreated(ctx, incomingReport); err != nil {
+		log.Errorf(ctx, "error sending report opened to webhooks: %v", err)
+	}
+
 	return nil
 }
 
@@ -791,9 +903,83 @@ func (p *fediAPI) AcceptFollow(ctx context.Context, fMsg *messages.FromFediAPI)
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
+	// Fetch recent statuses of the
+	// newly followed account, if needed.
+	p.backfillFollowedAccount(ctx,
+		fMsg.Receiving,
+		fMsg.Requesting,
+	)
+
 	return nil
 }
 
+// backfillFollowedAccount enqueues fetching recent statuses from the
+// outbox of the given remote account, newly followed by the given local
+// account, if we don't have any statuses of the remote account yet.
+// On success, the follower's home timeline is dropped, so that it gets
+// rebuilt from the database (including the new statuses) on next view.
+func (p *fediAPI) backfillFollowedAccount(
+	ctx context.Context,
+	follower *gtsmodel.Account,
+	followed *gtsmodel.Account,
+) {
+	limit := config.GetInstanceBackfillFollowStatuses()
+	if limit <= 0 {
+		// Backfill disabled.
+		return
+	}
+
+	if followed.IsLocal() {
+		// Nothing to fetch.
+		return
+	}
+
+	// Check whether we have any statuses
+	// from this account stored already.
+	statuses, err := p.state.DB.GetAccountStatuses(ctx,
+		followed.ID,
+		1,     // limit
+		false, // excludeReplies
+		false, // excludeReblogs
+		"",    // maxID
+		"",    // minID
+		false, // mediaOnly
+		false, // publicOnly
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting statuses of account %s: %v", followed.URI, err)
+		return
+	}
+
+	if len(statuses) > 0 {
+		// Timeline will already
+		// have posts of this account.
+		return
+	}
+
+	p.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		fetched, err := p.federate.DereferenceAccountOutbox(ctx,
+			follower.Username,
+			followed,
+			limit,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error dereferencing outbox of account %s: %v", followed.URI, err)
+		}
+
+		if fetched == 0 {
+			// Nothing changed.
+			return
+		}
+
+		// Drop the follower's home timeline so the
+		// statuses are included when it's rebuilt.
+		if err := p.state.Timelines.Home.RemoveTimeline(ctx, follower.ID); err != nil {
+			log.Errorf(ctx, "error removing home timeline of account %s: %v", follower.ID, err)
+		}
+	})
+}
+
 func (p *fediAPI) AcceptLike(ctx context.Context, fMsg *messages.FromFediAPI) error {
 	// TODO: Add something here if we ever implement sending out Likes to
 	// followers more broadly and not just the owner of the Liked status.
@@ -28,6 +28,7 @@
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
@@ -603,6 +604,74 @@ func (suite *FromFediAPITestSuite) TestCreateStatusFromIRI() {
 	suite.Equal(statusCreator.URI, s.AccountURI)
 }
 
+func (suite *FromFediAPITestSuite) TestCreateStatusAutomod() {
+	for _, testCase := range []struct {
+		action    gtsmodel.AutomodRuleAction
+		stored    bool
+		sensitive bool
+		sinBinned bool
+	}{
+		{action: gtsmodel.AutomodActionSensitive, stored: true, sensitive: true},
+		{action: gtsmodel.AutomodActionSinBin, sinBinned: true},
+		{action: gtsmodel.AutomodActionDrop},
+	} {
+		suite.testCreateStatusAutomod(
+			testCase.action,
+			testCase.stored,
+			testCase.sensitive,
+			testCase.sinBinned,
+		)
+	}
+}
+
+func (suite *FromFediAPITestSuite) testCreateStatusAutomod(
+	action gtsmodel.AutomodRuleAction,
+	stored bool,
+	sensitive bool,
+	sinBinned bool,
+) {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	ctx := context.Background()
+	const statusURI = "http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1"
+
+	// Add a rule matching the incoming status.
+	if err := testStructs.State.DB.PutAutomodRule(ctx, &gtsmodel.AutomodRule{
+		ID:        "01JGE8Y2Q3TQ9D3K7MZ4WZ6V1A",
+		MatchType: gtsmodel.AutomodMatchKeyword,
+		Pattern:   "please forward it",
+		Action:    action,
+		AuthorID:  suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		Receiving:      suite.testAccounts["local_account_1"],
+		Requesting:     suite.testAccounts["remote_account_2"],
+		APIRI:          testrig.URLMustParse(statusURI),
+	})
+	suite.NoError(err, action.String())
+
+	status, err := testStructs.State.DB.GetStatusByURI(ctx, statusURI)
+	if stored {
+		suite.NoError(err, action.String())
+		suite.Equal(sensitive, *status.Sensitive, action.String())
+	} else {
+		suite.ErrorIs(err, db.ErrNoEntries, action.String())
+	}
+
+	_, err = testStructs.State.DB.GetSinBinStatusByURI(ctx, statusURI)
+	if sinBinned {
+		suite.NoError(err, action.String())
+	} else {
+		suite.ErrorIs(err, db.ErrNoEntries, action.String())
+	}
+}
+
 func (suite *FromFediAPITestSuite) TestMoveAccount() {
 	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
 	defer testrig.TearDownTestStructs(testStructs)
@@ -680,6 +749,62 @@ func (suite *FromFediAPITestSuite) TestMoveAccount() {
 	suite.WithinDuration(time.Now(), move.SucceededAt, 1*time.Minute)
 }
 
+func (suite *FromFediAPITestSuite) TestAcceptFollowBackfill() {
+	for _, test := range []struct {
+		name     string
+		limit    int
+		followed string
+		queued   bool
+	}{
+		{
+			// Backfill disabled.
+			name:     "disabled",
+			limit:    0,
+			followed: "remote_account_4",
+			queued:   false,
+		},
+		{
+			// We already have statuses
+			// by this account, so should
+			// be on the timeline already.
+			name:     "has statuses",
+			limit:    20,
+			followed: "remote_account_1",
+			queued:   false,
+		},
+		{
+			// We have no statuses
+			// by this account yet.
+			name:     "no statuses",
+			limit:    20,
+			followed: "remote_account_4",
+			queued:   true,
+		},
+	} {
+		suite.Run(test.name, func() {
+			testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+			defer testrig.TearDownTestStructs(testStructs)
+
+			config.SetInstanceBackfillFollowStatuses(test.limit)
+
+			// Stop the dereference workers, so
+			// we can see whether anything's queued.
+			testStructs.State.Workers.Dereference.Stop()
+
+			err := testStructs.Processor.Workers().ProcessFromFediAPI(context.Background(), &messages.FromFediAPI{
+				APObjectType:   ap.ActivityFollow,
+				APActivityType: ap.ActivityAccept,
+				Receiving:      suite.testAccounts["local_account_1"],
+				Requesting:     suite.testAccounts[test.followed],
+			})
+			suite.NoError(err)
+
+			queued := testStructs.State.Workers.Dereference.Queue.Len() > 0
+			suite.Equal(test.queued, queued)
+		})
+	}
+}
+
 func TestFromFederatorTestSuite(t *testing.T) {
 	suite.Run(t, &FromFediAPITestSuite{})
 }
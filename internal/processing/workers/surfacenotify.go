@@ -335,6 +335,59 @@ func (s *Surface) notifyableFave(
 	return true, nil
 }
 
+// notifyReaction notifies the target of the given
+// reaction that their status has been reacted to.
+func (s *Surface) notifyReaction(
+	ctx context.Context,
+	reaction *gtsmodel.StatusReaction,
+) error {
+	if reaction.TargetAccountID == reaction.AccountID {
+		// Self-reaction, nothing to do.
+		return nil
+	}
+
+	// Beforehand, ensure the passed reaction is fully populated.
+	if err := s.State.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction %s: %w", reaction.ID, err)
+	}
+
+	if reaction.TargetAccount.IsRemote() {
+		// no need to notify
+		// remote accounts.
+		return nil
+	}
+
+	// Ensure reactee hasn't
+	// muted the thread.
+	muted, err := s.State.DB.IsThreadMutedByAccount(
+		ctx,
+		reaction.Status.ThreadID,
+		reaction.TargetAccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error checking status thread mute %s: %w", reaction.StatusID, err)
+	}
+
+	if muted {
+		// Reactee doesn't want
+		// notifs for this thread.
+		return nil
+	}
+
+	// notify status author
+	// of reaction by account.
+	if err := s.Notify(ctx,
+		gtsmodel.NotificationEmojiReaction,
+		reaction.TargetAccount,
+		reaction.Account,
+		reaction.StatusID,
+	); err != nil {
+		return gtserror.Newf("error notifying status author %s: %w", reaction.TargetAccountID, err)
+	}
+
+	return nil
+}
+
 // notifyAnnounce notifies the status boost target
 // account that their status has been boosted.
 func (s *Surface) notifyAnnounce(
@@ -534,6 +587,75 @@ func (s *Surface) notifySignup(ctx context.Context, newUser *gtsmodel.User) erro
 	return errs.Combine()
 }
 
+func (s *Surface) notifyAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error {
+	modAccounts, err := s.State.DB.GetInstanceModerators(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No registered
+			// mod accounts.
+			return nil
+		}
+
+		// Real error.
+		return gtserror.Newf("error getting instance moderator accounts: %w", err)
+	}
+
+	// Ensure appeal populated.
+	if err := s.State.DB.PopulateAppeal(ctx, appeal); err != nil {
+		return gtserror.Newf("db error populating appeal: %w", err)
+	}
+
+	// Notify each moderator.
+	var errs gtserror.MultiError
+	for _, mod := range modAccounts {
+		if err := s.Notify(ctx,
+			gtsmodel.NotificationAdminAppeal,
+			mod,
+			appeal.Account,
+			"",
+		); err != nil {
+			errs.Appendf("error notifying moderator %s: %w", mod.ID, err)
+			continue
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *Surface) notifySeveredRelationships(
+	ctx context.Context,
+	event *gtsmodel.RelationshipSeveranceEvent,
+	account *gtsmodel.Account,
+) error {
+	if account.IsRemote() {
+		// nothing to do.
+		return nil
+	}
+
+	// Notifications for severed relationships
+	// are each about a distinct event, so
+	// we don't use Notify's deduplication.
+	//
+	// The "origin" of the notification is
+	// the notified account itself, as the
+	// other accounts may be many / deleted.
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationSevered,
+		TargetAccountID:  account.ID,
+		TargetAccount:    account,
+		OriginAccountID:  account.ID,
+		OriginAccount:    account,
+		SeveranceEventID: event.ID,
+	}
+
+	if err := s.State.DB.PutNotification(ctx, notif); err != nil {
+		return gtserror.Newf("error putting notification in database: %w", err)
+	}
+
+	return s.streamNotification(ctx, account, notif)
+}
+
 func getNotifyLockURI(
 	notificationType gtsmodel.NotificationType,
 	targetAccount *gtsmodel.Account,
@@ -618,6 +740,32 @@ func (s *Surface) Notify(
 		StatusID:         statusID,
 	}
 
+	// Check what the target account's
+	// notification policy says about this.
+	policy, err := s.notifPolicy(ctx,
+		notificationType,
+		targetAccount,
+		originAccount,
+		statusID,
+	)
+	if err != nil {
+		return gtserror.Newf("error checking notification policy: %w", err)
+	}
+
+	switch policy {
+	case gtsmodel.NotificationPolicyDrop:
+		// Target doesn't
+		// want this at all.
+		return nil
+
+	case gtsmodel.NotificationPolicyFilter:
+		// Hold notif back in
+		// a notification request.
+		if err := s.filterNotification(ctx, notif); err != nil {
+			return err
+		}
+	}
+
 	if err := s.State.DB.PutNotification(ctx, notif); err != nil {
 		return gtserror.Newf("error putting notification in database: %w", err)
 	}
@@ -626,7 +774,23 @@ func (s *Surface) Notify(
 	// with the state-y stuff.
 	unlock()
 
-	// Stream notification to the user.
+	if util.PtrOrZero(notif.Filtered) {
+		// Filtered notifs
+		// aren't streamed.
+		return nil
+	}
+
+	return s.streamNotification(ctx, targetAccount, notif)
+}
+
+// streamNotification converts the given notification
+// to its API representation, and streams it to the
+// (local) target account, applying filters and mutes.
+func (s *Surface) streamNotification(
+	ctx context.Context,
+	targetAccount *gtsmodel.Account,
+	notif *gtsmodel.Notification,
+) error {
 	filters, err := s.State.DB.GetFiltersForAccountID(ctx, targetAccount.ID)
 	if err != nil {
 		return gtserror.Newf("couldn't retrieve filters for account %s: %w", targetAccount.ID, err)
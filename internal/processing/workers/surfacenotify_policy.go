@@ -0,0 +1,207 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// newAccountAge is the age below which an
+// origin account is considered "new" for
+// the purposes of notification policies.
+const newAccountAge = 30 * 24 * time.Hour
+
+// notifPolicyFilterable returns true if notifications
+// of the given type may be filtered or dropped by the
+// target account's notification policy.
+func notifPolicyFilterable(notificationType gtsmodel.NotificationType) bool {
+	switch notificationType {
+	case gtsmodel.NotificationMention,
+		gtsmodel.NotificationReblog,
+		gtsmodel.NotificationFollow,
+		gtsmodel.NotificationFollowRequest,
+		gtsmodel.NotificationFave,
+		gtsmodel.NotificationEmojiReaction:
+		return true
+	default:
+		return false
+	}
+}
+
+// notifPolicy evaluates the notification policy of the
+// given (local) target account against the given notif
+// params, and returns what should be done with the notif.
+//
+// Where several criteria of the policy match, the
+// strictest of the configured values is returned.
+func (s *Surface) notifPolicy(
+	ctx context.Context,
+	notificationType gtsmodel.NotificationType,
+	targetAccount *gtsmodel.Account,
+	originAccount *gtsmodel.Account,
+	statusID string,
+) (gtsmodel.NotificationPolicyValue, error) {
+	if !notifPolicyFilterable(notificationType) ||
+		targetAccount.ID == originAccount.ID {
+		// Never filter these.
+		return gtsmodel.NotificationPolicyAccept, nil
+	}
+
+	settings := targetAccount.Settings
+	if settings == nil {
+		var err error
+		settings, err = s.State.DB.GetAccountSettings(ctx, targetAccount.ID)
+		if err != nil {
+			return 0, gtserror.Newf("error getting settings for account %s: %w", targetAccount.ID, err)
+		}
+	}
+
+	// Check whether target has already
+	// accepted notifs from this origin.
+	req, err := s.State.DB.GetNotificationRequest(
+		gtscontext.SetBarebones(ctx),
+		targetAccount.ID,
+		originAccount.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return 0, gtserror.Newf("error getting notification request: %w", err)
+	}
+
+	if req != nil && req.IsAccepted() {
+		// Explicitly allowed.
+		return gtsmodel.NotificationPolicyAccept, nil
+	}
+
+	following, err := s.State.DB.IsFollowing(ctx, targetAccount.ID, originAccount.ID)
+	if err != nil {
+		return 0, gtserror.Newf("error checking follow: %w", err)
+	}
+
+	followedBy, err := s.State.DB.IsFollowing(ctx, originAccount.ID, targetAccount.ID)
+	if err != nil {
+		return 0, gtserror.Newf("error checking follow: %w", err)
+	}
+
+	policy := gtsmodel.NotificationPolicyAccept
+	apply := func(value gtsmodel.NotificationPolicyValue) {
+		if value > policy {
+			policy = value
+		}
+	}
+
+	if !following {
+		apply(settings.NotificationPolicyNotFollowing)
+	}
+
+	if !followedBy {
+		apply(settings.NotificationPolicyNotFollowers)
+	}
+
+	if time.Since(originAccount.CreatedAt) < newAccountAge {
+		apply(settings.NotificationPolicyNewAccounts)
+	}
+
+	if originAccount.IsSilenced() && !following {
+		apply(settings.NotificationPolicyLimitedAccounts)
+	}
+
+	if notificationType == gtsmodel.NotificationMention && !following {
+		status, err := s.State.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			statusID,
+		)
+		if err != nil {
+			return 0, gtserror.Newf("error getting status %s: %w", statusID, err)
+		}
+
+		// A private mention is unsolicited
+		// if it's not a reply to the target.
+		if status.Visibility == gtsmodel.VisibilityDirect &&
+			status.InReplyToAccountID != targetAccount.ID {
+			apply(settings.NotificationPolicyPrivateMentions)
+		}
+	}
+
+	return policy, nil
+}
+
+// filterNotification marks the given notification as
+// filtered, and creates or updates the notification
+// request for the notification's target and origin.
+func (s *Surface) filterNotification(
+	ctx context.Context,
+	notif *gtsmodel.Notification,
+) error {
+	notif.Filtered = util.Ptr(true)
+
+	// Lock on the target + origin pair so that
+	// concurrent notifs don't race to create
+	// the same notification request.
+	unlock := s.State.ProcessingLocks.Lock(
+		"notification_request:?target=" + notif.TargetAccountID +
+			"&origin=" + notif.OriginAccountID,
+	)
+	defer unlock()
+
+	req, err := s.State.DB.GetNotificationRequest(
+		gtscontext.SetBarebones(ctx),
+		notif.TargetAccountID,
+		notif.OriginAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting notification request: %w", err)
+	}
+
+	if req == nil {
+		// No request yet, create one.
+		req = &gtsmodel.NotificationRequest{
+			ID:              id.NewULID(),
+			AccountID:       notif.TargetAccountID,
+			OriginAccountID: notif.OriginAccountID,
+			LastStatusID:    notif.StatusID,
+		}
+
+		if err := s.State.DB.PutNotificationRequest(ctx, req); err != nil {
+			return gtserror.Newf("error putting notification request: %w", err)
+		}
+
+		return nil
+	}
+
+	// Bump existing request, keeping the
+	// previous last status if this notif
+	// isn't about a status.
+	if notif.StatusID != "" {
+		req.LastStatusID = notif.StatusID
+	}
+
+	if err := s.State.DB.UpdateNotificationRequest(ctx, req, "last_status_id"); err != nil {
+		return gtserror.Newf("error updating notification request: %w", err)
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -112,6 +113,102 @@ func (suite *SurfaceNotifyTestSuite) TestSpamNotifs() {
 	}
 }
 
+func (suite *SurfaceNotifyTestSuite) TestNotifyPolicy() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	surface := &workers.Surface{
+		State:         testStructs.State,
+		Converter:     testStructs.TypeConverter,
+		Stream:        testStructs.Processor.Stream(),
+		VisFilter:     visibility.NewFilter(testStructs.State),
+		EmailSender:   testStructs.EmailSender,
+		Conversations: testStructs.Processor.Conversations(),
+	}
+
+	var (
+		ctx           = context.Background()
+		targetAccount = new(gtsmodel.Account)
+		originAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	// Copy target account, as
+	// we modify its settings.
+	*targetAccount = *suite.testAccounts["local_account_1"]
+
+	// Filter notifs from accounts target doesn't
+	// follow, and drop notifs from non-followers.
+	settings, err := testStructs.State.DB.GetAccountSettings(ctx, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.NotificationPolicyNotFollowing = gtsmodel.NotificationPolicyFilter
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx, settings,
+		"notification_policy_not_following",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+	targetAccount.Settings = settings
+
+	// Notify of a follow from an account
+	// that local_account_1 doesn't follow.
+	if err := surface.Notify(ctx,
+		gtsmodel.NotificationFollow,
+		targetAccount,
+		originAccount,
+		"",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Notif should exist, filtered, in a notif request.
+	notif, err := testStructs.State.DB.GetNotification(ctx,
+		gtsmodel.NotificationFollow,
+		targetAccount.ID,
+		originAccount.ID,
+		"",
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*notif.Filtered)
+
+	req, err := testStructs.State.DB.GetNotificationRequest(ctx,
+		targetAccount.ID,
+		originAccount.ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(req.IsAccepted())
+
+	// Now drop notifs from non-followers,
+	// which is stricter, so should win.
+	settings.NotificationPolicyNotFollowers = gtsmodel.NotificationPolicyDrop
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx, settings,
+		"notification_policy_not_followers",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := surface.Notify(ctx,
+		gtsmodel.NotificationFollowRequest,
+		targetAccount,
+		originAccount,
+		"",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = testStructs.State.DB.GetNotification(ctx,
+		gtsmodel.NotificationFollowRequest,
+		targetAccount.ID,
+		originAccount.ID,
+		"",
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestSurfaceNotifyTestSuite(t *testing.T) {
 	suite.Run(t, new(SurfaceNotifyTestSuite))
 }
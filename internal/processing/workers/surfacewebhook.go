@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/webhook"
+)
+
+// webhookReportCreated sends the report.created event
+// for the given new report to subscribed webhooks, and
+// the status.reported event for each status included in it.
+func (s *Surface) webhookReportCreated(ctx context.Context, report *gtsmodel.Report) error {
+	// Convert with no requesting account,
+	// as no-one in particular is viewing.
+	apiReport, err := s.Converter.ReportToAdminAPIReport(ctx, report, nil)
+	if err != nil {
+		return gtserror.Newf("error converting report: %w", err)
+	}
+
+	if err := webhook.Send(ctx, s.State,
+		gtsmodel.WebhookEventReportCreated,
+		apiReport,
+	); err != nil {
+		return err
+	}
+
+	for _, status := range apiReport.Statuses {
+		if err := webhook.Send(ctx, s.State,
+			gtsmodel.WebhookEventStatusReported,
+			status,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// webhookAccountCreated sends the account.created event
+// for the given new user to subscribed webhooks.
+func (s *Surface) webhookAccountCreated(ctx context.Context, newUser *gtsmodel.User) error {
+	// Ensure user populated.
+	if err := s.State.DB.PopulateUser(ctx, newUser); err != nil {
+		return gtserror.Newf("error populating user: %w", err)
+	}
+
+	apiAccount, err := s.Converter.AccountToAdminAPIAccount(ctx, newUser.Account)
+	if err != nil {
+		return gtserror.Newf("error converting account: %w", err)
+	}
+
+	return webhook.Send(ctx, s.State,
+		gtsmodel.WebhookEventAccountCreated,
+		apiAccount,
+	)
+}
@@ -20,12 +20,17 @@
 import (
 	"context"
 	"errors"
+	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/spam"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
@@ -42,6 +47,7 @@ type utils struct {
 	account   *account.Processor
 	surface   *Surface
 	converter *typeutils.Converter
+	spam      *spam.Filter
 }
 
 // wipeStatus encapsulates common logic used to
@@ -120,6 +126,11 @@ func (u *utils) wipeStatus(
 		errs.Appendf("error deleting status faves: %w", err)
 	}
 
+	// Delete all reactions to this status.
+	if err := u.state.DB.DeleteStatusReactionsForStatus(ctx, status.ID); err != nil {
+		errs.Appendf("error deleting status reactions: %w", err)
+	}
+
 	if pollID := status.PollID; pollID != "" {
 		// Delete this poll by ID from the database.
 		if err := u.state.DB.DeletePollByID(ctx, pollID); err != nil {
@@ -172,6 +183,128 @@ func (u *utils) wipeStatus(
 	return errs.Combine()
 }
 
+// applyAutomod checks the given incoming status
+// against admin-configured automod rules, and takes
+// the action of the most severe matching rule, if any.
+//
+// Returns true if the status was removed by the
+// action, in which case callers should stop any
+// further processing of the status.
+func (u *utils) applyAutomod(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) (bool, error) {
+	rule, err := u.state.DB.AutomodMatch(ctx, status)
+	if err != nil {
+		return false, gtserror.Newf("error matching automod rules: %w", err)
+	}
+
+	if rule == nil {
+		// No match,
+		// nothing to do.
+		return false, nil
+	}
+
+	log.Infof(ctx,
+		"status %s matched automod rule %s, action %s",
+		status.URI, rule.ID, rule.Action,
+	)
+
+	switch rule.Action {
+	case gtsmodel.AutomodActionSensitive:
+		status.Sensitive = util.Ptr(true)
+		if err := u.state.DB.UpdateStatus(ctx, status, "sensitive"); err != nil {
+			return false, gtserror.Newf("db error marking status sensitive: %w", err)
+		}
+		return false, nil
+
+	case gtsmodel.AutomodActionSinBin,
+		gtsmodel.AutomodActionDrop:
+		// Remove the status, only keeping
+		// a copy in the sin bin if requested.
+		const deleteAttachments = true
+		copyToSinBin := (rule.Action == gtsmodel.AutomodActionSinBin)
+		if err := u.wipeStatus(ctx,
+			status,
+			deleteAttachments,
+			copyToSinBin,
+		); err != nil {
+			return true, gtserror.Newf("error wiping status: %w", err)
+		}
+		return true, nil
+
+	default:
+		return false, gtserror.Newf("unknown automod action %d", rule.Action)
+	}
+}
+
+// applySpamScore scores the given incoming status for
+// spam from the perspective of the receiving account,
+// and removes it if the score meets the configured
+// threshold, recording a spam flag for admin review.
+//
+// Returns true if the status was removed, in which
+// case callers should stop any further processing.
+func (u *utils) applySpamScore(
+	ctx context.Context,
+	receiver *gtsmodel.Account,
+	status *gtsmodel.Status,
+	statusable ap.Statusable,
+) (bool, error) {
+	threshold := config.GetInstanceFederationSpamScoreThreshold()
+	if threshold <= 0 {
+		// Spam scoring
+		// not enabled.
+		return false, nil
+	}
+
+	score, reasons, err := u.spam.StatusScore(ctx, receiver, status, statusable)
+	if err != nil {
+		return false, gtserror.Newf("error scoring status: %w", err)
+	}
+
+	if score < threshold {
+		// Looks OK.
+		return false, nil
+	}
+
+	log.Infof(ctx,
+		"status %s scored %d for spam (%s); removing it",
+		status.URI, score, strings.Join(reasons, ", "),
+	)
+
+	// Remove the status, only keeping
+	// a copy in the sin bin if requested.
+	const deleteAttachments = true
+	copyToSinBin := (config.GetInstanceFederationSpamScoreAction() ==
+		config.InstanceFederationSpamScoreActionSinBin)
+	if err := u.wipeStatus(ctx,
+		status,
+		deleteAttachments,
+		copyToSinBin,
+	); err != nil {
+		return true, gtserror.Newf("error wiping status: %w", err)
+	}
+
+	// Keep a record of what we did
+	// so admins can mark mistakes.
+	flag := &gtsmodel.SpamFlag{
+		ID:              id.NewULID(),
+		StatusURI:       status.URI,
+		AccountID:       status.AccountID,
+		TargetAccountID: receiver.ID,
+		Score:           score,
+		Reasons:         reasons,
+		SinBinned:       &copyToSinBin,
+	}
+
+	if err := u.state.DB.PutSpamFlag(ctx, flag); err != nil {
+		return true, gtserror.Newf("db error putting spam flag: %w", err)
+	}
+
+	return true, nil
+}
+
 // redirectFollowers redirects all local
 // followers of originAcct to targetAcct.
 //
@@ -647,3 +780,167 @@ func (u *utils) requestAnnounce(
 
 	return nil
 }
+
+// severRelationships records the follows between local accounts
+// and the given account, which is about to be deleted as a side
+// effect of the given domain block or (if nil) an account
+// suspension, and notifies each affected local account.
+//
+// This should be called *before* deleting the account's follows.
+func (u *utils) severRelationships(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	domainBlock *gtsmodel.DomainBlock,
+) error {
+	// Gather follows between this
+	// account and local accounts.
+	followers, err := u.state.DB.GetAccountLocalFollowers(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting local followers: %w", err)
+	}
+
+	follows, err := u.state.DB.GetAccountFollows(ctx, account.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting follows: %w", err)
+	}
+
+	// Map of local account IDs
+	// to their severed relationships.
+	severed := make(map[string][]*gtsmodel.SeveredRelationship)
+
+	for _, follow := range followers {
+		if follow.AccountID == account.ID {
+			continue
+		}
+
+		// Local account followed deleted account.
+		severed[follow.AccountID] = append(severed[follow.AccountID], &gtsmodel.SeveredRelationship{
+			AccountID:       follow.AccountID,
+			TargetAccountID: account.ID,
+			Direction:       gtsmodel.SeveredFollowing,
+		})
+	}
+
+	for _, follow := range follows {
+		if follow.TargetAccount == nil ||
+			follow.TargetAccount.IsRemote() ||
+			follow.TargetAccountID == account.ID {
+			continue
+		}
+
+		// Deleted account followed local account.
+		severed[follow.TargetAccountID] = append(severed[follow.TargetAccountID], &gtsmodel.SeveredRelationship{
+			AccountID:       follow.TargetAccountID,
+			TargetAccountID: account.ID,
+			Direction:       gtsmodel.SeveredFollower,
+		})
+	}
+
+	if len(severed) == 0 {
+		// Nothing
+		// to record.
+		return nil
+	}
+
+	event, err := u.getSeveranceEvent(ctx, account, domainBlock)
+	if err != nil {
+		return err
+	}
+
+	var errs gtserror.MultiError
+	for accountID, rels := range severed {
+		// Lock on the event + local account, so
+		// we only notify once per event even if
+		// accounts are deleted concurrently.
+		unlock := u.state.ProcessingLocks.Lock("severance:?event=" + event.ID + "&account=" + accountID)
+
+		// Check if account already lost relationships
+		// in this event, in which case it's already
+		// been notified (ie., during a domain block).
+		existing, err := u.state.DB.GetSeveredRelationships(
+			gtscontext.SetBarebones(ctx),
+			event.ID,
+			accountID,
+			0,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			unlock()
+			errs.Appendf("db error getting severed relationships: %w", err)
+			continue
+		}
+
+		for _, rel := range rels {
+			rel.ID = id.NewULID()
+			rel.EventID = event.ID
+			rel.Event = event
+			if err := u.state.DB.PutSeveredRelationship(ctx, rel); err != nil {
+				errs.Appendf("db error putting severed relationship: %w", err)
+			}
+		}
+
+		unlock()
+
+		if len(existing) != 0 {
+			// Already notified.
+			continue
+		}
+
+		localAccount, err := u.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			errs.Appendf("db error getting local account %s: %w", accountID, err)
+			continue
+		}
+
+		if err := u.surface.notifySeveredRelationships(ctx, event, localAccount); err != nil {
+			errs.Appendf("error notifying severed relationships: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// getSeveranceEvent gets or creates the relationship
+// severance event for the given domain block or, if
+// nil, for the suspension of the given account.
+func (u *utils) getSeveranceEvent(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	domainBlock *gtsmodel.DomainBlock,
+) (*gtsmodel.RelationshipSeveranceEvent, error) {
+	event := &gtsmodel.RelationshipSeveranceEvent{
+		Type:       gtsmodel.SeveranceAccountSuspension,
+		TargetName: account.Username,
+		OriginID:   account.ID,
+	}
+
+	if domainBlock != nil {
+		// One event for all
+		// accounts on domain.
+		event.Type = gtsmodel.SeveranceDomainBlock
+		event.TargetName = domainBlock.Domain
+		event.OriginID = domainBlock.ID
+	} else if account.IsRemote() {
+		event.TargetName += "@" + account.Domain
+	}
+
+	// Lock on origin so accounts deleted concurrently
+	// for a domain block all get the same event.
+	unlock := u.state.ProcessingLocks.Lock("severance:?origin=" + event.OriginID)
+	defer unlock()
+
+	existing, err := u.state.DB.GetSeveranceEventByOriginID(ctx, event.OriginID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting severance event: %w", err)
+	}
+
+	if existing != nil {
+		return existing, nil
+	}
+
+	event.ID = id.NewULID()
+	if err := u.state.DB.PutSeveranceEvent(ctx, event); err != nil {
+		return nil, gtserror.Newf("db error putting severance event: %w", err)
+	}
+
+	return event, nil
+}
@@ -20,6 +20,7 @@
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/spam"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
@@ -75,6 +76,7 @@ func New(
 		account:   account,
 		surface:   surface,
 		converter: converter,
+		spam:      spam.NewFilter(state),
 	}
 
 	return Processor{
@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pubsub
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Bus is a simple publish / subscribe message bus,
+// shared between GoToSocial processes when running
+// multiple processes against the same database.
+type Bus interface {
+	// Publish sends payload to all subscribed processes
+	// (possibly including the publishing process itself).
+	Publish(ctx context.Context, payload string) error
+
+	// Subscribe calls recv for each received payload,
+	// blocking until ctx is canceled or an error occurs.
+	Subscribe(ctx context.Context, recv func(payload string)) error
+
+	// Close will close any open
+	// connections to the bus.
+	Close() error
+}
+
+// Subscribe subscribes to bus, passing received payloads
+// to recv, until ctx is canceled. On any subscription error
+// it resubscribes with backoff, calling onError (if set) so
+// the caller may drop any state that could now be stale, as
+// messages published while not subscribed are lost.
+func Subscribe(
+	ctx context.Context,
+	bus Bus,
+	name string,
+	recv func(payload string),
+	onError func(),
+) {
+	const (
+		minBackoff = time.Second
+		maxBackoff = time.Minute
+	)
+
+	backoff := minBackoff
+
+	for {
+		start := time.Now()
+		err := bus.Subscribe(ctx, recv)
+
+		if ctx.Err() != nil {
+			// Bus was stopped.
+			return
+		}
+
+		if time.Since(start) > maxBackoff {
+			// Subscription was healthy
+			// for a while, reset backoff.
+			backoff = minBackoff
+		}
+
+		log.Errorf(ctx, "error subscribed to %s bus, retrying in %s: %v", name, backoff, err)
+		if onError != nil {
+			onError()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
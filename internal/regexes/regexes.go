@@ -55,6 +55,10 @@
 	ulid                     = `[0123456789ABCDEFGHJKMNPQRSTVWXYZ]{26}`                  // Pattern for ULID.
 	ulidValidate             = `^` + ulid + `$`                                          // Validate one ULID.
 
+	quoteFallbackLink      = `<a\s[^>]*?\bhref="([^"]*)"[^>]*>(?:[^<]|<[^/]|</[^a])*</a>`                          // Capture the href of a single html link.
+	quoteFallbackFinder    = `(?is)(?:\s*<br\s*/?>)*\s*RE:\s*` + quoteFallbackLink + `((?:\s*</(?:span|p)>)*)\s*$` // Extract a trailing "RE: [link]" quote fallback from html content.
+	emptyTrailingParagraph = `(?is)<p>\s*(?:<span[^>]*>\s*</span>\s*)?</p>\s*$`                                    // Match an empty paragraph at the end of html content.
+
 	/*
 		Path parts / capture.
 	*/
@@ -112,6 +116,15 @@
 	// See: https://regex101.com/r/EnTOBV/1
 	MisskeyReportNotes = regexp.MustCompile(misskeyReportNotesFinder)
 
+	// QuoteFallback captures the link href and any trailing closing
+	// tags of a "RE: [link]" quote fallback at the end of html content,
+	// as appended to quote posts by Misskey, Mastodon, and ourselves.
+	QuoteFallback = regexp.MustCompile(quoteFallbackFinder)
+
+	// EmptyTrailingParagraph matches an empty paragraph (or paragraph
+	// containing just an empty span) at the end of html content.
+	EmptyTrailingParagraph = regexp.MustCompile(emptyTrailingParagraph)
+
 	// UserPath validates and captures the username part from eg /users/example_username.
 	UserPath = regexp.MustCompile(userPath)
 
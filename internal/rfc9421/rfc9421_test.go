@@ -0,0 +1,269 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
+	"github.com/superseriousbusiness/httpsig"
+)
+
+const keyID = "https://example.org/users/someone#main-key"
+
+// signAndReceive signs an outgoing request to target, then
+// returns it as it would be seen by the receiving server.
+func signAndReceive(t *testing.T, method string, target string, key crypto.PrivateKey, body []byte) *http.Request {
+	out, err := http.NewRequest(method, target, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rfc9421.SignRequest(out, keyID, key, body, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// Incoming requests only have path + query.
+	in, err := http.NewRequest(method, out.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	in.Host = out.URL.Host
+	in.Header = out.Header.Clone()
+	return in
+}
+
+func TestSignVerifyRSA(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := signAndReceive(t, http.MethodGet, "https://example.com/users/target?page=true", key, nil)
+	if !rfc9421.IsSigned(r.Header) {
+		t.Fatal("expected request to be detected as rfc9421 signed")
+	}
+
+	verifier, err := rfc9421.NewVerifier(r, "https")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if verifier.KeyId() != keyID {
+		t.Fatalf("unexpected key id %q", verifier.KeyId())
+	}
+
+	if err := verifier.Verify(&key.PublicKey, httpsig.RSA_SHA256); err != nil {
+		t.Fatalf("expected signature to verify: %v", err)
+	}
+
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	if err := verifier.Verify(&other.PublicKey, httpsig.RSA_SHA256); err == nil {
+		t.Fatal("expected verification with wrong key to fail")
+	}
+}
+
+func TestSignVerifyEd25519POST(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(`{"type":"Create"}`)
+	r := signAndReceive(t, http.MethodPost, "https://example.com/users/target/inbox", key, body)
+
+	if r.Header.Get(rfc9421.ContentDigestHeader) != rfc9421.ContentDigest(body) {
+		t.Fatal("expected content digest to be set")
+	}
+
+	verifier, err := rfc9421.NewVerifier(r, "https")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifier.Verify(pub, httpsig.ED25519); err != nil {
+		t.Fatalf("expected signature to verify: %v", err)
+	}
+
+	if !verifier.CoversDigest() {
+		t.Fatal("expected signature to cover digest")
+	}
+
+	if err := verifier.VerifyDigest(body); err != nil {
+		t.Fatalf("expected digest to match body: %v", err)
+	}
+}
+
+func TestVerifyDigestMismatch(t *testing.T) {
+	_, key, _ := ed25519.GenerateKey(rand.Reader)
+
+	// Sign one body, but send another.
+	body := []byte(`{"type":"Create"}`)
+	r := signAndReceive(t, http.MethodPost, "https://example.com/users/target/inbox", key, body)
+
+	verifier, err := rfc9421.NewVerifier(r, "https")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifier.VerifyDigest([]byte(`{"type":"Delete"}`)); err == nil {
+		t.Fatal("expected digest of different body not to match")
+	}
+}
+
+func TestVerifyDigestAlgorithms(t *testing.T) {
+	body := []byte(`{"type":"Create"}`)
+	sum512 := sha512.Sum512(body)
+	b64512 := base64.StdEncoding.EncodeToString(sum512[:])
+
+	for _, test := range []struct {
+		name    string
+		header  string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:   "content-digest sha-256",
+			header: "Content-Digest",
+			value:  rfc9421.ContentDigest(body),
+		},
+		{
+			name:   "content-digest sha-512",
+			header: "Content-Digest",
+			value:  "sha-512=:" + b64512 + ":",
+		},
+		{
+			name:   "content-digest unsupported ignored",
+			header: "Content-Digest",
+			value:  "md5=:AAAA:, sha-512=:" + b64512 + ":",
+		},
+		{
+			name:    "content-digest unsupported only",
+			header:  "Content-Digest",
+			value:   "md5=:AAAA:",
+			wantErr: true,
+		},
+		{
+			name:    "content-digest one of two wrong",
+			header:  "Content-Digest",
+			value:   rfc9421.ContentDigest(body) + ", sha-512=:AAAA:",
+			wantErr: true,
+		},
+		{
+			name:   "legacy digest",
+			header: "Digest",
+			value:  "SHA-256=" + strings.Trim(strings.TrimPrefix(rfc9421.ContentDigest(body), "sha-256="), ":"),
+		},
+		{
+			name:    "legacy digest wrong",
+			header:  "Digest",
+			value:   "SHA-256=AAAA",
+			wantErr: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodPost, "/inbox", bytes.NewReader(body))
+			r.Host = "example.com"
+			r.Header.Set(rfc9421.SignatureInputHeader, `sig1=("@method" "@target-uri" "`+strings.ToLower(test.header)+`");created=`+
+				strconv.FormatInt(time.Now().Unix(), 10)+`;keyid="`+keyID+`"`)
+			r.Header.Set(rfc9421.SignatureHeader, `sig1=:AAAA:`)
+			r.Header.Set(test.header, test.value)
+
+			verifier, err := rfc9421.NewVerifier(r, "https")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = verifier.VerifyDigest(body)
+			if test.wantErr && err == nil {
+				t.Fatal("expected digest verification to fail")
+			} else if !test.wantErr && err != nil {
+				t.Fatalf("expected digest to verify: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	r := signAndReceive(t, http.MethodGet, "https://example.com/users/target", key, nil)
+	r.URL.Path = "/users/someone_else"
+
+	verifier, err := rfc9421.NewVerifier(r, "https")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifier.Verify(&key.PublicKey, httpsig.RSA_SHA256); err == nil {
+		t.Fatal("expected verification of tampered request to fail")
+	}
+}
+
+func TestVerifyRejectsInsufficientCoverage(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodPost, "/inbox", nil)
+	r.Host = "example.com"
+	r.Header.Set(rfc9421.SignatureInputHeader, `sig1=("@method" "@target-uri");created=`+
+		strconv.FormatInt(time.Now().Unix(), 10)+`;keyid="`+keyID+`"`)
+	r.Header.Set(rfc9421.SignatureHeader, `sig1=:AAAA:`)
+
+	_, err := rfc9421.NewVerifier(r, "https")
+	if err == nil || !strings.Contains(err.Error(), "content digest") {
+		t.Fatalf("expected content digest coverage error, got %v", err)
+	}
+}
+
+func TestVerifyRejectsExpired(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodGet, "/users/target", nil)
+	r.Host = "example.com"
+	created := time.Now().Add(-2 * time.Hour).Unix()
+	r.Header.Set(rfc9421.SignatureInputHeader, `sig1=("@method" "@target-uri");created=`+
+		strconv.FormatInt(created, 10)+`;keyid="`+keyID+`"`)
+	r.Header.Set(rfc9421.SignatureHeader, `sig1=:AAAA:`)
+
+	_, err := rfc9421.NewVerifier(r, "https")
+	if err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Fatalf("expected expiry error, got %v", err)
+	}
+}
+
+func TestVerifyUnsigned(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodGet, "/users/target", nil)
+	if _, err := rfc9421.NewVerifier(r, "https"); !errors.Is(err, rfc9421.ErrNoSignature) {
+		t.Fatalf("expected ErrNoSignature, got %v", err)
+	}
+}
+
+func TestAcceptSignature(t *testing.T) {
+	const expect = `sig1=("@method" "@target-uri" "content-digest");created`
+	if got := rfc9421.AcceptSignature(http.MethodPost); got != expect {
+		t.Fatalf("expected %s, got %s", expect, got)
+	}
+}
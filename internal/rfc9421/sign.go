@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dunglas/httpsfv"
+)
+
+var (
+	// Covered components for outgoing requests.
+	getComponents  = []string{"@method", "@target-uri"}
+	postComponents = []string{"@method", "@target-uri", "content-digest"}
+)
+
+// SignRequest signs the outgoing request with the given private key,
+// setting the Signature-Input and Signature headers. When body is
+// non-nil, a Content-Digest header for it is also set and covered by
+// the signature. The signature will expire after expiresIn.
+func SignRequest(
+	r *http.Request,
+	keyID string,
+	key crypto.PrivateKey,
+	body []byte,
+	expiresIn time.Duration,
+) error {
+	components := getComponents
+	if body != nil {
+		r.Header.Set(ContentDigestHeader, ContentDigest(body))
+		components = postComponents
+	}
+
+	var alg string
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		alg = AlgRSAv15SHA256
+	case ed25519.PrivateKey:
+		alg = AlgEd25519
+	default:
+		return fmt.Errorf("rfc9421: unsupported private key type %T", key)
+	}
+
+	// Prepare covered components and
+	// signature params as inner list.
+	now := time.Now()
+	params := httpsfv.InnerList{
+		Items:  make([]httpsfv.Item, 0, len(components)),
+		Params: httpsfv.NewParams(),
+	}
+	for _, c := range components {
+		params.Items = append(params.Items, httpsfv.NewItem(c))
+	}
+	params.Params.Add("created", now.Unix())
+	params.Params.Add("expires", now.Add(expiresIn).Unix())
+	params.Params.Add("keyid", keyID)
+	params.Params.Add("alg", alg)
+
+	base, err := signatureBase(r, "https", params)
+	if err != nil {
+		return err
+	}
+
+	var sig []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		sum := sha256.Sum256(base)
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+		if err != nil {
+			return fmt.Errorf("rfc9421: error signing: %w", err)
+		}
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, base)
+	}
+
+	// Serialize the signature input.
+	input := httpsfv.NewDictionary()
+	input.Add(Label, params)
+	inputStr, err := httpsfv.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	// Serialize the signature itself.
+	signature := httpsfv.NewDictionary()
+	signature.Add(Label, httpsfv.NewItem(sig))
+	sigStr, err := httpsfv.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set(SignatureInputHeader, inputStr)
+	r.Header.Set(SignatureHeader, sigStr)
+	return nil
+}
+
+// AcceptSignature returns an Accept-Signature header value
+// requesting an RFC 9421 signature suitable for requests
+// of the given method, for advertising our support.
+func AcceptSignature(method string) string {
+	components := getComponents
+	if method == http.MethodPost {
+		components = postComponents
+	}
+
+	params := httpsfv.InnerList{
+		Items:  make([]httpsfv.Item, 0, len(components)),
+		Params: httpsfv.NewParams(),
+	}
+	for _, c := range components {
+		params.Items = append(params.Items, httpsfv.NewItem(c))
+	}
+	params.Params.Add("created", true)
+
+	dict := httpsfv.NewDictionary()
+	dict.Add(Label, params)
+	s, _ := httpsfv.Marshal(dict)
+	return s
+}
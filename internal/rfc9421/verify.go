@@ -0,0 +1,373 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dunglas/httpsfv"
+	"github.com/superseriousbusiness/httpsig"
+)
+
+// maxClockSkew is the allowed leeway when
+// checking created and expires parameters.
+const maxClockSkew = 30 * time.Second
+
+// maxAge is the maximum accepted age of a signature
+// that doesn't provide its own expires parameter.
+const maxAge = time.Hour
+
+// Verifier verifies an RFC 9421 signature on an
+// incoming request. It implements the same interface
+// as draft-cavage verifiers from the httpsig library,
+// so both can be handled in the same way by callers.
+type Verifier struct {
+	keyID string
+	alg   string
+	base  []byte
+	sig   []byte
+
+	// digest is the name of the covered digest
+	// header, if any, and digestValues its values.
+	digest       string
+	digestValues []string
+}
+
+// NewVerifier parses the RFC 9421 signature on the given request, building
+// the signature base to later be verified against the signer's public key.
+// Scheme is used to rebuild the request target URI. Returns ErrNoSignature
+// if the request carries no RFC 9421 signature at all.
+func NewVerifier(r *http.Request, scheme string) (*Verifier, error) {
+	inputs := r.Header.Values(SignatureInputHeader)
+	if len(inputs) == 0 {
+		return nil, ErrNoSignature
+	}
+
+	inputDict, err := httpsfv.UnmarshalDictionary(inputs)
+	if err != nil {
+		return nil, fmt.Errorf("rfc9421: error parsing %s: %w", SignatureInputHeader, err)
+	}
+
+	sigDict, err := httpsfv.UnmarshalDictionary(r.Header.Values(SignatureHeader))
+	if err != nil {
+		return nil, fmt.Errorf("rfc9421: error parsing %s: %w", SignatureHeader, err)
+	}
+
+	// Use the first labelled signature
+	// for which we have both the input
+	// parameters and the signature value.
+	for _, label := range inputDict.Names() {
+		input, _ := inputDict.Get(label)
+		params, ok := input.(httpsfv.InnerList)
+		if !ok {
+			continue
+		}
+
+		sigMember, ok := sigDict.Get(label)
+		if !ok {
+			continue
+		}
+
+		sigItem, ok := sigMember.(httpsfv.Item)
+		if !ok {
+			return nil, errors.New("rfc9421: signature value must be an item")
+		}
+
+		sig, ok := sigItem.Value.([]byte)
+		if !ok {
+			return nil, errors.New("rfc9421: signature value must be a byte sequence")
+		}
+
+		return newVerifier(r, scheme, params, sig)
+	}
+
+	return nil, errors.New("rfc9421: no matching signature for any signature input")
+}
+
+func newVerifier(r *http.Request, scheme string, params httpsfv.InnerList, sig []byte) (*Verifier, error) {
+	v := &Verifier{sig: sig}
+
+	keyID, _ := params.Params.Get("keyid")
+	v.keyID, _ = keyID.(string)
+	if v.keyID == "" {
+		return nil, errors.New("rfc9421: missing keyid parameter")
+	}
+
+	if alg, ok := params.Params.Get("alg"); ok {
+		v.alg, _ = alg.(string)
+		switch v.alg {
+		case AlgRSAv15SHA256, AlgRSAPSSSHA512, AlgEd25519:
+		default:
+			return nil, fmt.Errorf("rfc9421: unsupported alg %v", alg)
+		}
+	}
+
+	now := time.Now()
+
+	created, _ := params.Params.Get("created")
+	createdUnix, ok := created.(int64)
+	if !ok {
+		return nil, errors.New("rfc9421: missing created parameter")
+	}
+
+	createdAt := time.Unix(createdUnix, 0)
+	if createdAt.After(now.Add(maxClockSkew)) {
+		return nil, errors.New("rfc9421: signature created in the future")
+	}
+
+	expiresAt := createdAt.Add(maxAge)
+	if expires, ok := params.Params.Get("expires"); ok {
+		expiresUnix, ok := expires.(int64)
+		if !ok {
+			return nil, errors.New("rfc9421: invalid expires parameter")
+		}
+		expiresAt = time.Unix(expiresUnix, 0)
+	}
+
+	if now.After(expiresAt.Add(maxClockSkew)) {
+		return nil, errors.New("rfc9421: signature expired")
+	}
+
+	// Ensure the signature covers
+	// enough of the request that
+	// it can't be trivially replayed
+	// against a different resource.
+	covered := make([]string, 0, len(params.Items))
+	for _, item := range params.Items {
+		name, _ := item.Value.(string)
+		covered = append(covered, name)
+	}
+
+	if !slices.Contains(covered, "@method") {
+		return nil, errors.New("rfc9421: signature does not cover @method")
+	}
+
+	if !slices.Contains(covered, "@target-uri") &&
+		!slices.Contains(covered, "@request-target") &&
+		!slices.Contains(covered, "@path") {
+		return nil, errors.New("rfc9421: signature does not cover request target")
+	}
+
+	switch {
+	case slices.Contains(covered, "content-digest"):
+		v.digest = "content-digest"
+	case slices.Contains(covered, "digest"):
+		v.digest = "digest"
+	case r.Method == http.MethodPost:
+		return nil, errors.New("rfc9421: signature does not cover content digest")
+	}
+
+	if v.digest != "" {
+		// Keep the signed digest so that the
+		// caller can check it against the body.
+		v.digestValues = r.Header.Values(v.digest)
+	}
+
+	var err error
+	v.base, err = signatureBase(r, scheme, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// KeyId returns the key ID the signature claims to be signed with.
+func (v *Verifier) KeyId() string {
+	return v.keyID
+}
+
+// Verify verifies the signature using the given public key. If the
+// signature declared its own algorithm, that will be used, else the
+// given (draft-cavage) algorithm will be mapped to its closest RFC
+// 9421 equivalent.
+func (v *Verifier) Verify(pKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	alg := v.alg
+	if alg == "" {
+		switch algo {
+		case httpsig.RSA_SHA256:
+			alg = AlgRSAv15SHA256
+		case httpsig.RSA_SHA512:
+			alg = AlgRSAPSSSHA512
+		case httpsig.ED25519:
+			alg = AlgEd25519
+		default:
+			return fmt.Errorf("rfc9421: unsupported algorithm %s", algo)
+		}
+	}
+
+	switch alg {
+	case AlgRSAv15SHA256:
+		pub, ok := pKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("rfc9421: %s requires rsa public key, got %T", alg, pKey)
+		}
+		sum := sha256.Sum256(v.base)
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, sum[:], v.sig)
+
+	case AlgRSAPSSSHA512:
+		pub, ok := pKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("rfc9421: %s requires rsa public key, got %T", alg, pKey)
+		}
+		sum := sha512.Sum512(v.base)
+		return rsa.VerifyPSS(pub, crypto.SHA512, sum[:], v.sig, &rsa.PSSOptions{
+			SaltLength: 64,
+		})
+
+	case AlgEd25519:
+		pub, ok := pKey.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("rfc9421: %s requires ed25519 public key, got %T", alg, pKey)
+		}
+		if !ed25519.Verify(pub, v.base, v.sig) {
+			return errors.New("rfc9421: ed25519 signature verification failed")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("rfc9421: unsupported alg %s", alg)
+}
+
+// CoversDigest returns whether the signature covers a digest
+// of the request body, which must then be checked against
+// the body with VerifyDigest. The signature itself only
+// proves that the digest wasn't tampered with, not the body.
+func (v *Verifier) CoversDigest() bool {
+	return v.digest != ""
+}
+
+// VerifyDigest checks the covered Content-Digest (or legacy
+// Digest) header of the request against the given body. All
+// digests using a supported algorithm must match, and at
+// least one supported algorithm must be present.
+func (v *Verifier) VerifyDigest(body []byte) error {
+	var (
+		digests map[string][]byte
+		err     error
+	)
+
+	switch v.digest {
+	case "content-digest":
+		digests, err = parseContentDigest(v.digestValues)
+	case "digest":
+		digests, err = parseLegacyDigest(v.digestValues)
+	default:
+		return errors.New("rfc9421: signature does not cover content digest")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var checked int
+	for alg, digest := range digests {
+		var sum []byte
+		switch alg {
+		case "sha-256":
+			s := sha256.Sum256(body)
+			sum = s[:]
+		case "sha-512":
+			s := sha512.Sum512(body)
+			sum = s[:]
+		default:
+			// Unsupported, skip.
+			continue
+		}
+
+		if subtle.ConstantTimeCompare(sum, digest) != 1 {
+			return fmt.Errorf("rfc9421: %s digest does not match body", alg)
+		}
+		checked++
+	}
+
+	if checked == 0 {
+		return errors.New("rfc9421: no digest with supported algorithm")
+	}
+
+	return nil
+}
+
+// parseContentDigest parses RFC 9530 Content-Digest header
+// values into a map of lowercase algorithm to digest bytes.
+func parseContentDigest(values []string) (map[string][]byte, error) {
+	dict, err := httpsfv.UnmarshalDictionary(values)
+	if err != nil {
+		return nil, fmt.Errorf("rfc9421: invalid content-digest: %w", err)
+	}
+
+	digests := make(map[string][]byte, len(dict.Names()))
+	for _, alg := range dict.Names() {
+		member, _ := dict.Get(alg)
+		item, ok := member.(httpsfv.Item)
+		if !ok {
+			return nil, fmt.Errorf("rfc9421: invalid content-digest for %s", alg)
+		}
+
+		digest, ok := item.Value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("rfc9421: invalid content-digest for %s", alg)
+		}
+
+		digests[alg] = digest
+	}
+
+	return digests, nil
+}
+
+// parseLegacyDigest parses RFC 3230 Digest header values,
+// eg., "SHA-256=base64", into a map of lowercase algorithm
+// to digest bytes.
+func parseLegacyDigest(values []string) (map[string][]byte, error) {
+	digests := make(map[string][]byte)
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			alg, b64, ok := strings.Cut(strings.TrimSpace(part), "=")
+			if !ok {
+				return nil, errors.New("rfc9421: invalid digest")
+			}
+
+			digest, err := base64.StdEncoding.DecodeString(b64)
+			if err != nil {
+				return nil, fmt.Errorf("rfc9421: invalid digest for %s: %w", alg, err)
+			}
+
+			digests[strings.ToLower(alg)] = digest
+		}
+	}
+
+	return digests, nil
+}
+
+// VerifyWithOptions is provided for compatibility with httpsig.VerifierWithOptions.
+// The draft-cavage signing options have no meaning here, so they are ignored.
+func (v *Verifier) VerifyWithOptions(pKey crypto.PublicKey, algo httpsig.Algorithm, _ httpsig.SignatureOption) error {
+	return v.Verify(pKey, algo)
+}
@@ -24,12 +24,27 @@
 
 	"codeberg.org/gruf/go-runners"
 	"codeberg.org/gruf/go-sched"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
+// onceLeaseTTL is the lease duration for exclusive
+// once-only tasks, long enough that no other node
+// will also run the task once it's been completed.
+const onceLeaseTTL = time.Hour
+
 // Scheduler wraps an underlying scheduler to provide
 // task tracking by unique string identifiers, so jobs
 // may be cancelled with only an identifier.
 type Scheduler struct {
+	// Lease is an optional hook used to ensure that
+	// exclusive tasks only run on one node when running
+	// multiple GoToSocial nodes. It should attempt to
+	// acquire a lease on task ID for given duration,
+	// returning false if it's held by another node.
+	//
+	// This must be set before any tasks are added.
+	Lease func(ctx context.Context, id string, ttl time.Duration) bool
+
 	sch sched.Scheduler
 	ts  map[string]*task
 	mu  sync.Mutex
@@ -64,6 +79,23 @@ func (sch *Scheduler) AddRecurring(id string, start time.Time, freq time.Duratio
 	return sch.schedule(id, fn, &sched.PeriodicAt{Once: sched.Once(start), Period: sched.Periodic(freq)})
 }
 
+// AddOnceExclusive is as AddOnce, but when a Lease hook is set the
+// task will only run on the node which first acquires its lease.
+func (sch *Scheduler) AddOnceExclusive(id string, start time.Time, fn func(context.Context, time.Time)) bool {
+	return sch.AddOnce(id, start, sch.exclusive(id, onceLeaseTTL, fn))
+}
+
+// AddRecurringExclusive is as AddRecurring, but when a Lease hook is set
+// each run of the task will only happen on the node which acquires its lease.
+func (sch *Scheduler) AddRecurringExclusive(id string, start time.Time, freq time.Duration, fn func(context.Context, time.Time)) bool {
+	// Hold the lease for most of the period, so that
+	// the other nodes (running the task at roughly the
+	// same time) skip it, while allowing for some drift
+	// between nodes' clocks before the next run.
+	ttl := freq - freq/10
+	return sch.AddRecurring(id, start, freq, sch.exclusive(id, ttl, fn))
+}
+
 // Cancel attempts to cancel a scheduled task with id, returns false if no task found.
 func (sch *Scheduler) Cancel(id string) bool {
 	// Attempt to acquire and
@@ -121,6 +153,28 @@ func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t
 	return true
 }
 
+// exclusive wraps fn to only run when the
+// Lease hook (if set) acquires a lease on id.
+func (sch *Scheduler) exclusive(id string, ttl time.Duration, fn func(context.Context, time.Time)) func(context.Context, time.Time) {
+	if fn == nil {
+		panic("nil function")
+	}
+
+	if sch.Lease == nil {
+		// Nothing to
+		// coordinate.
+		return fn
+	}
+
+	return func(ctx context.Context, now time.Time) {
+		if !sch.Lease(ctx, id, ttl) {
+			log.Debugf(ctx, "skipping task %s held by another node", id)
+			return
+		}
+		fn(ctx, now)
+	}
+}
+
 // task simply wraps together a scheduled
 // job, and the matching cancel function.
 type task struct {
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Backend represents an external full-text search
+// engine that status text can be indexed into and
+// queried from, instead of the database's own index.
+type Backend interface {
+	// Init creates and configures the search index
+	// if necessary. It is safe to call more than once.
+	Init(ctx context.Context) error
+
+	// IndexStatuses adds the given statuses to the
+	// search index, replacing any existing entries.
+	IndexStatuses(ctx context.Context, statuses []*gtsmodel.Status) error
+
+	// DeleteStatuses removes statuses with
+	// the given IDs from the search index.
+	DeleteStatuses(ctx context.Context, statusIDs []string) error
+
+	// Clear removes all statuses from the search index.
+	Clear(ctx context.Context) error
+
+	// SearchStatuses returns up to limit IDs of statuses matching
+	// query, newest first, optionally restricted to statuses by
+	// accountID and to the (exclusive) range of minID to maxID.
+	// If minID is set, the statuses immediately newer than
+	// minID are returned, as with database paging.
+	//
+	// Results are not filtered for visibility or search scope,
+	// that's the responsibility of the caller.
+	SearchStatuses(
+		ctx context.Context,
+		query string,
+		accountID string,
+		maxID string,
+		minID string,
+		limit int,
+	) ([]string, error)
+}
+
+// NewBackend returns the external search backend
+// configured in search-backend, or nil if statuses
+// are searched using the database's own index.
+func NewBackend() (Backend, error) {
+	switch backend := config.GetSearchBackend(); backend {
+	case config.SearchBackendDB:
+		return nil, nil
+
+	case config.SearchBackendMeilisearch:
+		return newMeilisearch(
+			config.GetSearchMeilisearchURL(),
+			config.GetSearchMeilisearchAPIKey(),
+			config.GetSearchMeilisearchIndex(),
+		), nil
+
+	default:
+		return nil, fmt.Errorf("search backend %s not recognised", backend)
+	}
+}
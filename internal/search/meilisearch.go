@@ -0,0 +1,276 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// meilisearch is a Backend implementation
+// using the HTTP API of a Meilisearch instance.
+//
+// See https://www.meilisearch.com/docs/reference/api/overview
+type meilisearch struct {
+	client *http.Client
+	url    string
+	apiKey string
+	index  string
+}
+
+// meilisearchDocument is the
+// representation of a status
+// stored in the Meilisearch index.
+type meilisearchDocument struct {
+	ID        string `json:"id"`
+	AccountID string `json:"account_id"`
+	Timestamp int64  `json:"timestamp"`
+	Text      string `json:"text"`
+}
+
+func newMeilisearch(baseURL string, apiKey string, index string) *meilisearch {
+	return &meilisearch{
+		client: &http.Client{Timeout: 30 * time.Second},
+		url:    strings.TrimSuffix(baseURL, "/"),
+		apiKey: apiKey,
+		index:  index,
+	}
+}
+
+func (m *meilisearch) Init(ctx context.Context) error {
+	// Create the index. Meilisearch processes this as an
+	// asynchronous task, which will simply fail (without
+	// touching existing documents) if the index exists.
+	if err := m.do(ctx, http.MethodPost, "/indexes", map[string]any{
+		"uid":        m.index,
+		"primaryKey": "id",
+	}, nil); err != nil {
+		return gtserror.Newf("error creating index %s: %w", m.index, err)
+	}
+
+	// Ensure index settings are up to date, so we can
+	// filter on account and timestamp, and sort newest first.
+	if err := m.do(ctx, http.MethodPatch, m.indexPath("/settings"), map[string]any{
+		"searchableAttributes": []string{"text"},
+		"filterableAttributes": []string{"account_id", "timestamp"},
+		"sortableAttributes":   []string{"timestamp"},
+	}, nil); err != nil {
+		return gtserror.Newf("error updating settings of index %s: %w", m.index, err)
+	}
+
+	return nil
+}
+
+func (m *meilisearch) IndexStatuses(ctx context.Context, statuses []*gtsmodel.Status) error {
+	documents := make([]meilisearchDocument, 0, len(statuses))
+	for _, status := range statuses {
+		timestamp, err := ulid.Parse(status.ID)
+		if err != nil {
+			return gtserror.Newf("error parsing status id %s: %w", status.ID, err)
+		}
+
+		documents = append(documents, meilisearchDocument{
+			ID:        status.ID,
+			AccountID: status.AccountID,
+			Timestamp: int64(timestamp.Time()), // #nosec G115 -- ULID timestamps fit in 48 bits.
+			Text:      text.SanitizeToSearchText(status.ContentWarning, status.Content),
+		})
+	}
+
+	if len(documents) == 0 {
+		return nil
+	}
+
+	if err := m.do(ctx, http.MethodPost, m.indexPath("/documents"), documents, nil); err != nil {
+		return gtserror.Newf("error adding documents to index %s: %w", m.index, err)
+	}
+
+	return nil
+}
+
+func (m *meilisearch) DeleteStatuses(ctx context.Context, statusIDs []string) error {
+	if len(statusIDs) == 0 {
+		return nil
+	}
+
+	if err := m.do(ctx, http.MethodPost, m.indexPath("/documents/delete-batch"), statusIDs, nil); err != nil {
+		return gtserror.Newf("error deleting documents from index %s: %w", m.index, err)
+	}
+
+	return nil
+}
+
+func (m *meilisearch) Clear(ctx context.Context) error {
+	if err := m.do(ctx, http.MethodDelete, m.indexPath("/documents"), nil, nil); err != nil {
+		return gtserror.Newf("error clearing index %s: %w", m.index, err)
+	}
+
+	return nil
+}
+
+func (m *meilisearch) SearchStatuses(
+	ctx context.Context,
+	query string,
+	accountID string,
+	maxID string,
+	minID string,
+	limit int,
+) ([]string, error) {
+	// Meilisearch can only do range filters on
+	// numbers, so page using the ULID timestamps,
+	// and trim any IDs outside the range below.
+	filters := make([]string, 0, 3)
+	if accountID != "" {
+		filters = append(filters, "account_id = "+quoteFilterValue(accountID))
+	}
+
+	if maxID != "" {
+		timestamp, err := ulid.Parse(maxID)
+		if err != nil {
+			return nil, gtserror.Newf("error parsing max id %s: %w", maxID, err)
+		}
+		filters = append(filters, fmt.Sprintf("timestamp <= %d", timestamp.Time()))
+	}
+
+	if minID != "" {
+		timestamp, err := ulid.Parse(minID)
+		if err != nil {
+			return nil, gtserror.Newf("error parsing min id %s: %w", minID, err)
+		}
+		filters = append(filters, fmt.Sprintf("timestamp >= %d", timestamp.Time()))
+	}
+
+	// Sort oldest first when paging up from minID,
+	// so we get the statuses immediately above it.
+	sort := "timestamp:desc"
+	if minID != "" {
+		sort = "timestamp:asc"
+	}
+
+	var result struct {
+		Hits []struct {
+			ID string `json:"id"`
+		} `json:"hits"`
+	}
+
+	if err := m.do(ctx, http.MethodPost, m.indexPath("/search"), map[string]any{
+		"q":                    query,
+		"filter":               strings.Join(filters, " AND "),
+		"sort":                 []string{sort},
+		"limit":                limit,
+		"attributesToRetrieve": []string{"id"},
+	}, &result); err != nil {
+		return nil, gtserror.Newf("error searching index %s: %w", m.index, err)
+	}
+
+	statusIDs := make([]string, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		if maxID != "" && hit.ID >= maxID {
+			continue
+		}
+
+		if minID != "" && hit.ID <= minID {
+			continue
+		}
+
+		statusIDs = append(statusIDs, hit.ID)
+	}
+
+	if sort == "timestamp:asc" {
+		// Return newest first.
+		slices.Reverse(statusIDs)
+	}
+
+	return statusIDs, nil
+}
+
+// indexPath returns the API path
+// of subpath within the index.
+func (m *meilisearch) indexPath(subpath string) string {
+	return "/indexes/" + url.PathEscape(m.index) + subpath
+}
+
+// do performs a request against the Meilisearch API, encoding
+// body (if set) as JSON, and decoding the response into out (if set).
+func (m *meilisearch) do(ctx context.Context, method string, path string, body any, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return gtserror.Newf("error encoding request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, m.url+path, reqBody)
+	if err != nil {
+		return gtserror.Newf("error creating request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	rsp, err := m.client.Do(req)
+	if err != nil {
+		return gtserror.Newf("error performing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		// Include (a limited amount of)
+		// the error body Meilisearch returns.
+		msg, _ := io.ReadAll(io.LimitReader(rsp.Body, 512))
+		return gtserror.Newf("%s %s returned %s: %s", method, path, rsp.Status, msg)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(out); err != nil {
+		return gtserror.Newf("error decoding response body: %w", err)
+	}
+
+	return nil
+}
+
+// quoteFilterValue quotes a string
+// for use in a Meilisearch filter.
+func quoteFilterValue(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type MeilisearchTestSuite struct {
+	suite.Suite
+
+	server   *httptest.Server
+	backend  *meilisearch
+	requests []*http.Request
+	bodies   []any
+
+	// response to return from search requests.
+	hits []string
+}
+
+func (suite *MeilisearchTestSuite) SetupTest() {
+	suite.requests = nil
+	suite.bodies = nil
+	suite.hits = nil
+
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body any
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		suite.requests = append(suite.requests, r)
+		suite.bodies = append(suite.bodies, body)
+
+		if r.Header.Get("Authorization") != "Bearer some-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path == "/indexes/statuses/search" {
+			hits := make([]map[string]string, 0, len(suite.hits))
+			for _, id := range suite.hits {
+				hits = append(hits, map[string]string{"id": id})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"hits": hits})
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	}))
+
+	suite.backend = newMeilisearch(suite.server.URL+"/", "some-key", "statuses")
+}
+
+func (suite *MeilisearchTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *MeilisearchTestSuite) TestInit() {
+	err := suite.backend.Init(context.Background())
+	suite.NoError(err)
+
+	suite.Len(suite.requests, 2)
+	suite.Equal(http.MethodPost, suite.requests[0].Method)
+	suite.Equal("/indexes", suite.requests[0].URL.Path)
+	suite.Equal(map[string]any{"uid": "statuses", "primaryKey": "id"}, suite.bodies[0])
+	suite.Equal(http.MethodPatch, suite.requests[1].Method)
+	suite.Equal("/indexes/statuses/settings", suite.requests[1].URL.Path)
+}
+
+func (suite *MeilisearchTestSuite) TestIndexStatuses() {
+	err := suite.backend.IndexStatuses(context.Background(), []*gtsmodel.Status{
+		{
+			ID:             "01F8MH75CBF9JFX4ZAD54N0W0R",
+			AccountID:      "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ContentWarning: "hello",
+			Content:        "<p>this is a <b>test</b></p>",
+		},
+	})
+	suite.NoError(err)
+
+	suite.Len(suite.requests, 1)
+	suite.Equal("/indexes/statuses/documents", suite.requests[0].URL.Path)
+	suite.Equal([]any{
+		map[string]any{
+			"id":         "01F8MH75CBF9JFX4ZAD54N0W0R",
+			"account_id": "01F8MH1H7YV1Z7D2C8K2730QBF",
+			"timestamp":  float64(1624186787211),
+			"text":       "hello\nthis is a  test",
+		},
+	}, suite.bodies[0])
+}
+
+func (suite *MeilisearchTestSuite) TestSearchStatuses() {
+	suite.hits = []string{
+		"01F8MHAMCHF6Y01D6CXDH5F5AY",
+		"01F8MH75CBF9JFX4ZAD54N0W0R",
+		"01F8MH75CBF9JFX4ZAD54N0W0Q",
+	}
+
+	statusIDs, err := suite.backend.SearchStatuses(
+		context.Background(),
+		"test",
+		"01F8MH1H7YV1Z7D2C8K2730QBF",
+		"01F8MH75CBF9JFX4ZAD54N0W0R",
+		"",
+		20,
+	)
+	suite.NoError(err)
+
+	// The boundary ID itself should be trimmed.
+	suite.Equal([]string{"01F8MH75CBF9JFX4ZAD54N0W0Q"}, statusIDs)
+	suite.Equal(map[string]any{
+		"q":                    "test",
+		"filter":               `account_id = "01F8MH1H7YV1Z7D2C8K2730QBF" AND timestamp <= 1624186787211`,
+		"sort":                 []any{"timestamp:desc"},
+		"limit":                float64(20),
+		"attributesToRetrieve": []any{"id"},
+	}, suite.bodies[0])
+}
+
+func (suite *MeilisearchTestSuite) TestError() {
+	suite.backend.apiKey = "wrong-key"
+
+	err := suite.backend.Clear(context.Background())
+	suite.ErrorContains(err, "401 Unauthorized")
+}
+
+func TestMeilisearchTestSuite(t *testing.T) {
+	suite.Run(t, new(MeilisearchTestSuite))
+}
@@ -21,6 +21,7 @@
 	"codeberg.org/gruf/go-mutexes"
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/search"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/workers"
@@ -42,6 +43,12 @@ type State struct {
 	// DB provides access to the database.
 	DB db.DB
 
+	// Search provides access to the configured external
+	// search backend, or nil if statuses are searched
+	// using the database's own index. It is kept up to
+	// date with status changes by the database itself.
+	Search search.Backend
+
 	// FedLocks provides access to this state's mutex
 	// map of per URI federation locks, intended for
 	// use in internal/federation functions.
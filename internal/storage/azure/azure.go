@@ -0,0 +1,602 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package azure provides a storage.Storage implementation
+// backed by an Azure Blob Storage container, talking to the
+// Blob service REST API directly using Shared Key auth.
+package azure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+)
+
+// apiVersion is the Blob service REST API
+// version sent with every request we make.
+const apiVersion = "2021-08-06"
+
+// DefaultConfig returns the default AzureStorage configuration.
+func DefaultConfig() Config {
+	return Config{
+		PutBlockSize: 4 * 1024 * 1024, // 4MiB
+		ListSize:     200,
+	}
+}
+
+// Config defines options to be
+// used when opening an AzureStorage.
+type Config struct {
+
+	// Endpoint is the Blob service endpoint to use,
+	// without container name. If empty, this defaults
+	// to "https://{account}.blob.core.windows.net".
+	// Path-style endpoints (e.g. Azurite emulator,
+	// "http://127.0.0.1:10000/devstoreaccount1")
+	// are also supported.
+	Endpoint string
+
+	// PutBlockSize is the size (in bytes) of the
+	// blocks that streamed uploads are split into.
+	// Streams smaller than this are uploaded in a
+	// single Put Blob request.
+	PutBlockSize int
+
+	// ListSize determines how many blobs
+	// are requested per List Blobs request.
+	ListSize int
+
+	// Client is the HTTP client used to make
+	// requests, defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// AzureStorage is a storage implementation
+// that stores data in an Azure Blob container.
+type AzureStorage struct {
+	account   string
+	key       []byte
+	container string
+	base      *url.URL
+	config    Config
+}
+
+// Open opens a new AzureStorage instance for the given
+// storage account, base64 account key and container.
+func Open(account, key, container string, cfg *Config) (*AzureStorage, error) {
+	if account == "" || container == "" {
+		return nil, errors.New("azure: account and container must be set")
+	}
+
+	// Decode the base64 encoded account key.
+	keyb, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("azure: error decoding account key: %w", err)
+	}
+
+	if cfg == nil {
+		def := DefaultConfig()
+		cfg = &def
+	}
+
+	// Take a copy of
+	// config so we own it.
+	config := *cfg
+
+	if config.Endpoint == "" {
+		config.Endpoint = "https://" + account + ".blob.core.windows.net"
+	}
+
+	if config.PutBlockSize <= 0 {
+		config.PutBlockSize = DefaultConfig().PutBlockSize
+	}
+
+	if config.ListSize <= 0 {
+		config.ListSize = DefaultConfig().ListSize
+	}
+
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+
+	// Parse endpoint and append container to
+	// the path, giving us the base container URL.
+	base, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("azure: invalid endpoint: %w", err)
+	}
+	base.Path += "/" + container
+
+	return &AzureStorage{
+		account:   account,
+		key:       keyb,
+		container: container,
+		base:      base,
+		config:    config,
+	}, nil
+}
+
+// Clean: implements Storage.Clean().
+func (st *AzureStorage) Clean(ctx context.Context) error {
+	return nil // nothing to do for Azure
+}
+
+// ReadBytes: implements Storage.ReadBytes().
+func (st *AzureStorage) ReadBytes(ctx context.Context, key string) ([]byte, error) {
+	rc, err := st.ReadStream(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := io.ReadAll(rc)
+	_ = rc.Close()
+	return b, err
+}
+
+// ReadStream: implements Storage.ReadStream().
+func (st *AzureStorage) ReadStream(ctx context.Context, key string) (io.ReadCloser, error) {
+	rsp, err := st.do(ctx, http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, drainErr(rsp, key)
+	}
+
+	return rsp.Body, nil
+}
+
+// WriteBytes: implements Storage.WriteBytes().
+func (st *AzureStorage) WriteBytes(ctx context.Context, key string, value []byte) (int, error) {
+	n, err := st.PutBlob(ctx, key, bytes.NewReader(value), "")
+	return int(n), err
+}
+
+// WriteStream: implements Storage.WriteStream().
+func (st *AzureStorage) WriteStream(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return st.PutBlob(ctx, key, r, "")
+}
+
+// PutBlob writes the data stream at key in the container,
+// setting the blob's content-type (if given). Streams larger
+// than the configured block size are uploaded block-by-block,
+// so they need never be held in memory in their entirety.
+func (st *AzureStorage) PutBlob(ctx context.Context, key string, r io.Reader, contentType string) (int64, error) {
+	buf := make([]byte, st.config.PutBlockSize)
+
+	// Read first block from the stream.
+	n, err := io.ReadFull(r, buf)
+	switch {
+	case errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF):
+		// The whole stream fit within a single
+		// block, upload it in a single request.
+		return st.putSingle(ctx, key, buf[:n], contentType)
+
+	case err != nil:
+		return 0, err
+	}
+
+	var (
+		blockIDs []string
+		total    int64
+	)
+
+	for n > 0 {
+		// Block IDs must all be the same length
+		// within a blob, so use a padded counter.
+		blockID := base64.StdEncoding.EncodeToString(
+			[]byte(fmt.Sprintf("%08d", len(blockIDs))),
+		)
+
+		if err := st.putBlock(ctx, key, blockID, buf[:n]); err != nil {
+			return total, err
+		}
+
+		blockIDs = append(blockIDs, blockID)
+		total += int64(n)
+
+		// Read next block from the stream.
+		n, err = io.ReadFull(r, buf)
+		if err != nil &&
+			!errors.Is(err, io.EOF) &&
+			!errors.Is(err, io.ErrUnexpectedEOF) {
+			return total, err
+		}
+	}
+
+	// Commit all uploaded blocks as the blob.
+	if err := st.putBlockList(ctx, key, blockIDs, contentType); err != nil {
+		return total, err
+	}
+
+	return total, nil
+}
+
+// putSingle uploads data as a block blob in one Put Blob request.
+func (st *AzureStorage) putSingle(ctx context.Context, key string, data []byte, contentType string) (int64, error) {
+	hdrs := http.Header{"X-Ms-Blob-Type": {"BlockBlob"}}
+	if contentType != "" {
+		hdrs.Set("Content-Type", contentType)
+	}
+
+	rsp, err := st.do(ctx, http.MethodPut, key, nil, hdrs, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if rsp.StatusCode != http.StatusCreated {
+		return 0, drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+	return int64(len(data)), nil
+}
+
+// putBlock uploads one uncommitted block of a block blob.
+func (st *AzureStorage) putBlock(ctx context.Context, key string, blockID string, data []byte) error {
+	query := url.Values{
+		"comp":    {"block"},
+		"blockid": {blockID},
+	}
+
+	rsp, err := st.do(ctx, http.MethodPut, key, query, nil, data)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode != http.StatusCreated {
+		return drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+	return nil
+}
+
+// putBlockList commits the given uploaded blocks as the block blob.
+func (st *AzureStorage) putBlockList(ctx context.Context, key string, blockIDs []string, contentType string) error {
+	var body bytes.Buffer
+	body.WriteString(xml.Header)
+	body.WriteString("<BlockList>")
+	for _, id := range blockIDs {
+		body.WriteString("<Latest>" + id + "</Latest>")
+	}
+	body.WriteString("</BlockList>")
+
+	hdrs := http.Header{"Content-Type": {"application/xml"}}
+	if contentType != "" {
+		hdrs.Set("X-Ms-Blob-Content-Type", contentType)
+	}
+
+	query := url.Values{"comp": {"blocklist"}}
+	rsp, err := st.do(ctx, http.MethodPut, key, query, hdrs, body.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode != http.StatusCreated {
+		return drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+	return nil
+}
+
+// Stat: implements Storage.Stat().
+func (st *AzureStorage) Stat(ctx context.Context, key string) (*storage.Entry, error) {
+	rsp, err := st.do(ctx, http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = rsp.Body.Close()
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		return &storage.Entry{
+			Key:  key,
+			Size: rsp.ContentLength,
+		}, nil
+
+	case http.StatusNotFound:
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("azure: unexpected status %s: %s", rsp.Status, key)
+	}
+}
+
+// Remove: implements Storage.Remove().
+func (st *AzureStorage) Remove(ctx context.Context, key string) error {
+	rsp, err := st.do(ctx, http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode != http.StatusAccepted {
+		return drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+	return nil
+}
+
+// listResult is the (relevant part of)
+// the XML response to a List Blobs request.
+type listResult struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			ContentLength int64 `xml:"Content-Length"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+// WalkKeys: implements Storage.WalkKeys().
+func (st *AzureStorage) WalkKeys(ctx context.Context, opts storage.WalkKeysOpts) error {
+	if opts.Step == nil {
+		panic("nil step fn")
+	}
+
+	var marker string
+
+	for {
+		query := url.Values{
+			"restype":    {"container"},
+			"comp":       {"list"},
+			"maxresults": {strconv.Itoa(st.config.ListSize)},
+		}
+
+		if opts.Prefix != "" {
+			query.Set("prefix", opts.Prefix)
+		}
+
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+
+		// List blobs in container starting at marker.
+		rsp, err := st.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		if rsp.StatusCode != http.StatusOK {
+			return drainErr(rsp, "")
+		}
+
+		var result listResult
+		err = xml.NewDecoder(rsp.Body).Decode(&result)
+		_ = rsp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("azure: error decoding list result: %w", err)
+		}
+
+		// Iterate through list result contents.
+		for _, blob := range result.Blobs {
+
+			// Skip filtered blob keys.
+			if opts.Filter != nil &&
+				opts.Filter(blob.Name) {
+				continue
+			}
+
+			// Pass each blob through step func.
+			if err := opts.Step(storage.Entry{
+				Key:  blob.Name,
+				Size: blob.Properties.ContentLength,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// No marker means we reached end of container.
+		if result.NextMarker == "" {
+			return nil
+		}
+
+		marker = result.NextMarker
+	}
+}
+
+// SignedURL returns a service SAS URL granting read
+// access to the blob at key until expiry. If contentType
+// is set, it will be returned as the response content-type.
+func (st *AzureStorage) SignedURL(key string, expiry time.Duration, contentType string) (*url.URL, error) {
+	const (
+		sasVersion  = "2020-12-06"
+		permissions = "r"
+		resource    = "b"
+	)
+
+	u := st.blobURL(key)
+	expires := time.Now().UTC().Add(expiry).Format(time.RFC3339)
+
+	// Build the string to sign, as described in:
+	// https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+	toSign := strings.Join([]string{
+		permissions,
+		"", // signedStart
+		expires,
+		"/blob/" + st.account + "/" + st.container + "/" + key,
+		"", // signedIdentifier
+		"", // signedIP
+		"", // signedProtocol
+		sasVersion,
+		resource,
+		"", // signedSnapshotTime
+		"", // signedEncryptionScope
+		"", // rscc
+		"", // rscd
+		"", // rsce
+		"", // rscl
+		contentType,
+	}, "\n")
+
+	query := url.Values{
+		"sv":  {sasVersion},
+		"sp":  {permissions},
+		"sr":  {resource},
+		"se":  {expires},
+		"sig": {st.sign(toSign)},
+	}
+
+	if contentType != "" {
+		query.Set("rsct", contentType)
+	}
+
+	u.RawQuery = query.Encode()
+	return u, nil
+}
+
+// blobURL returns the URL of the blob
+// at key (or container if key is empty).
+func (st *AzureStorage) blobURL(key string) *url.URL {
+	u := *st.base
+	if key != "" {
+		u.Path += "/" + key
+	}
+	return &u
+}
+
+// do performs an authorized request against the blob at key (or the container
+// if key is empty), with given method, query parameters, headers and body.
+func (st *AzureStorage) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	hdrs http.Header,
+	body []byte,
+) (*http.Response, error) {
+	u := st.blobURL(key)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range hdrs {
+		req.Header[k] = v
+	}
+
+	req.ContentLength = int64(len(body))
+	if len(body) == 0 {
+		// Ensure no body is sent
+		// for e.g. GET and HEAD.
+		req.Body = http.NoBody
+	}
+
+	req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("X-Ms-Version", apiVersion)
+	req.Header.Set("Authorization", "SharedKey "+st.account+":"+st.sign(st.stringToSign(req)))
+
+	return st.config.Client.Do(req)
+}
+
+// stringToSign builds the Shared Key string to sign for the request, see:
+// https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (st *AzureStorage) stringToSign(req *http.Request) string {
+	var contentLength string
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var buf strings.Builder
+	buf.WriteString(req.Method + "\n")
+	buf.WriteString(req.Header.Get("Content-Encoding") + "\n")
+	buf.WriteString(req.Header.Get("Content-Language") + "\n")
+	buf.WriteString(contentLength + "\n")
+	buf.WriteString(req.Header.Get("Content-MD5") + "\n")
+	buf.WriteString(req.Header.Get("Content-Type") + "\n")
+	buf.WriteString("\n") // Date, we use x-ms-date instead
+	buf.WriteString(req.Header.Get("If-Modified-Since") + "\n")
+	buf.WriteString(req.Header.Get("If-Match") + "\n")
+	buf.WriteString(req.Header.Get("If-None-Match") + "\n")
+	buf.WriteString(req.Header.Get("If-Unmodified-Since") + "\n")
+	buf.WriteString(req.Header.Get("Range") + "\n")
+
+	// Canonicalized headers: all x-ms-*
+	// headers, lowercased and sorted.
+	var names []string
+	for k := range req.Header {
+		if k = strings.ToLower(k); strings.HasPrefix(k, "x-ms-") {
+			names = append(names, k)
+		}
+	}
+	slices.Sort(names)
+	for _, k := range names {
+		buf.WriteString(k + ":" + strings.TrimSpace(req.Header.Get(k)) + "\n")
+	}
+
+	// Canonicalized resource: account,
+	// path and sorted query parameters.
+	buf.WriteString("/" + st.account + req.URL.EscapedPath())
+	query := req.URL.Query()
+	names = names[:0]
+	for k := range query {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+	for _, k := range names {
+		values := query[k]
+		slices.Sort(values)
+		buf.WriteString("\n" + strings.ToLower(k) + ":" + strings.Join(values, ","))
+	}
+
+	return buf.String()
+}
+
+// sign returns the base64 HMAC-SHA256
+// signature of str using account key.
+func (st *AzureStorage) sign(str string) string {
+	mac := hmac.New(sha256.New, st.key)
+	mac.Write([]byte(str))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// drainErr drains and closes the response body, returning
+// an error describing the unexpected response status. Not
+// found responses are wrapped as storage.ErrNotFound.
+func drainErr(rsp *http.Response, key string) error {
+	b, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+	_ = rsp.Body.Close()
+
+	err := fmt.Errorf("azure: unexpected status %s: %s", rsp.Status, bytes.TrimSpace(b))
+	if rsp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s (%w)", storage.ErrNotFound, key, err)
+	}
+
+	return err
+}
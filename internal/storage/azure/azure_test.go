@@ -0,0 +1,282 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package azure_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+	"github.com/superseriousbusiness/gotosocial/internal/storage/azure"
+)
+
+const (
+	testAccount   = "devstoreaccount1"
+	testKey       = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testContainer = "gts"
+)
+
+// fakeBlobService is a minimal in-memory
+// mock of the Azure Blob service REST API.
+type fakeBlobService struct {
+	mu     sync.Mutex
+	blobs  map[string][]byte
+	types  map[string]string
+	blocks map[string][]byte
+}
+
+func (f *fakeBlobService) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+testAccount+":") ||
+		r.Header.Get("X-Ms-Date") == "" || r.Header.Get("X-Ms-Version") == "" {
+		rw.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	prefix := "/" + testAccount + "/" + testContainer
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	switch {
+	case r.Method == http.MethodGet && query.Get("comp") == "list":
+		type blob struct {
+			Name          string `xml:"Name"`
+			ContentLength int64  `xml:"Properties>Content-Length"`
+		}
+		var result struct {
+			XMLName    xml.Name `xml:"EnumerationResults"`
+			Blobs      []blob   `xml:"Blobs>Blob"`
+			NextMarker string   `xml:"NextMarker"`
+		}
+
+		var names []string
+		for name := range f.blobs {
+			if strings.HasPrefix(name, query.Get("prefix")) {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+
+		// Page results using the marker as an offset.
+		start, _ := strconv.Atoi(query.Get("marker"))
+		size, _ := strconv.Atoi(query.Get("maxresults"))
+		end := min(start+size, len(names))
+		for _, name := range names[start:end] {
+			result.Blobs = append(result.Blobs, blob{name, int64(len(f.blobs[name]))})
+		}
+		if end < len(names) {
+			result.NextMarker = strconv.Itoa(end)
+		}
+
+		_ = xml.NewEncoder(rw).Encode(result)
+
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		f.blocks[key+"/"+query.Get("blockid")] = body
+		rw.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.Unmarshal(body, &list); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, id := range list.Latest {
+			data = append(data, f.blocks[key+"/"+id]...)
+		}
+		f.blobs[key] = data
+		f.types[key] = r.Header.Get("X-Ms-Blob-Content-Type")
+		rw.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodPut:
+		if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.blobs[key] = body
+		f.types[key] = r.Header.Get("Content-Type")
+		rw.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		data, ok := f.blobs[key]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = rw.Write(data)
+
+	case r.Method == http.MethodDelete:
+		if _, ok := f.blobs[key]; !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.blobs, key)
+		rw.WriteHeader(http.StatusAccepted)
+
+	default:
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func openTestStorage(t *testing.T) (*azure.AzureStorage, *fakeBlobService) {
+	fake := &fakeBlobService{
+		blobs:  make(map[string][]byte),
+		types:  make(map[string]string),
+		blocks: make(map[string][]byte),
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg := azure.DefaultConfig()
+	cfg.Endpoint = server.URL + "/" + testAccount
+	cfg.PutBlockSize = 4
+	cfg.ListSize = 2
+
+	st, err := azure.Open(testAccount, testKey, testContainer, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return st, fake
+}
+
+func TestAzureStorage(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openTestStorage(t)
+
+	// Small write fits in a single Put Blob.
+	if _, err := st.WriteBytes(ctx, "a/small", []byte("hi")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Larger stream is uploaded block-by-block.
+	data := []byte("hello world, this is a block blob")
+	n, err := st.PutBlob(ctx, "a/large", io.MultiReader(bytes.NewReader(data)), "text/plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != int64(len(data)) {
+		t.Fatalf("expected %d bytes written, got %d", len(data), n)
+	}
+
+	if ct := fake.types["a/large"]; ct != "text/plain" {
+		t.Fatalf("expected text/plain content-type, got %q", ct)
+	}
+
+	b, err := st.ReadBytes(ctx, "a/large")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(b, data) {
+		t.Fatalf("expected %q, got %q", data, b)
+	}
+
+	entry, err := st.Stat(ctx, "a/small")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry == nil || entry.Size != 2 {
+		t.Fatalf("unexpected stat entry: %+v", entry)
+	}
+
+	if _, err := st.WriteBytes(ctx, "b/other", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Walk all keys, paging through results.
+	var keys []string
+	if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+		Step: func(e storage.Entry) error {
+			keys = append(keys, e.Key)
+			return nil
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(keys, []string{"a/large", "a/small", "b/other"}) {
+		t.Fatalf("unexpected walked keys: %v", keys)
+	}
+
+	if err := st.Remove(ctx, "a/small"); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err = st.Stat(ctx, "a/small")
+	if err != nil || entry != nil {
+		t.Fatalf("expected no entry after remove, got %+v (%v)", entry, err)
+	}
+
+	if _, err := st.ReadBytes(ctx, "a/small"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if err := st.Remove(ctx, "a/small"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestAzureSignedURL(t *testing.T) {
+	st, _ := openTestStorage(t)
+
+	u, err := st.SignedURL("a/large", time.Hour, "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasSuffix(u.Path, "/"+testAccount+"/"+testContainer+"/a/large") {
+		t.Fatalf("unexpected signed url path: %s", u.Path)
+	}
+
+	query := u.Query()
+	for k, v := range map[string]string{
+		"sp":   "r",
+		"sr":   "b",
+		"rsct": "image/png",
+	} {
+		if query.Get(k) != v {
+			t.Fatalf("expected %s=%s in signed url, got %q", k, v, query.Get(k))
+		}
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(query.Get("sig")); err != nil {
+		t.Fatalf("invalid signature in signed url: %v", err)
+	}
+}
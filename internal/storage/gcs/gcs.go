@@ -0,0 +1,768 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package gcs provides a storage.Storage implementation
+// backed by a Google Cloud Storage bucket, talking to the
+// JSON API directly using service account credentials.
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+)
+
+const (
+	// defaultEndpoint is the default GCS API endpoint.
+	defaultEndpoint = "https://storage.googleapis.com"
+
+	// defaultTokenURI is the OAuth2 token endpoint
+	// used if not set in the service account key.
+	defaultTokenURI = "https://oauth2.googleapis.com/token"
+
+	// scope is the OAuth2 scope requested for access tokens.
+	scope = "https://www.googleapis.com/auth/devstorage.read_write"
+
+	// chunkAlign is the size that all but the last
+	// chunk of a resumable upload must be a multiple of.
+	chunkAlign = 256 * 1024
+)
+
+// DefaultConfig returns the default GCSStorage configuration.
+func DefaultConfig() Config {
+	return Config{
+		PutChunkSize: 8 * 1024 * 1024, // 8MiB
+		ListSize:     200,
+	}
+}
+
+// Config defines options to be
+// used when opening a GCSStorage.
+type Config struct {
+
+	// Endpoint is the GCS API endpoint to use.
+	// Defaults to "https://storage.googleapis.com",
+	// but may be set to point at e.g. an emulator.
+	Endpoint string
+
+	// Credentials is the JSON service account key used
+	// to authorize requests and sign URLs. If empty,
+	// requests are sent unauthenticated (only useful
+	// for emulators), and URL signing is unsupported.
+	Credentials []byte
+
+	// PutChunkSize is the size (in bytes) of the
+	// chunks that streamed uploads are split into,
+	// rounded up to a multiple of 256KiB. Streams
+	// smaller than this are uploaded in one request.
+	PutChunkSize int
+
+	// ListSize determines how many objects
+	// are requested per objects list request.
+	ListSize int
+
+	// Client is the HTTP client used to make
+	// requests, defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// serviceAccount contains the fields
+// we need from a service account JSON key.
+type serviceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// GCSStorage is a storage implementation
+// that stores data in a Google Cloud Storage bucket.
+type GCSStorage struct {
+	bucket   string
+	endpoint *url.URL
+	config   Config
+
+	// service account details,
+	// nil key if unauthenticated.
+	email    string
+	key      *rsa.PrivateKey
+	tokenURI string
+
+	// cached access token.
+	token   string
+	expires time.Time
+	mutex   sync.Mutex
+}
+
+// Open opens a new GCSStorage instance for the given bucket.
+func Open(bucket string, cfg *Config) (*GCSStorage, error) {
+	if bucket == "" {
+		return nil, errors.New("gcs: bucket must be set")
+	}
+
+	if cfg == nil {
+		def := DefaultConfig()
+		cfg = &def
+	}
+
+	// Take a copy of
+	// config so we own it.
+	config := *cfg
+
+	if config.Endpoint == "" {
+		config.Endpoint = defaultEndpoint
+	}
+
+	if config.PutChunkSize <= 0 {
+		config.PutChunkSize = DefaultConfig().PutChunkSize
+	}
+
+	// Resumable upload chunks must be aligned to 256KiB.
+	config.PutChunkSize = (config.PutChunkSize + chunkAlign - 1) / chunkAlign * chunkAlign
+
+	if config.ListSize <= 0 {
+		config.ListSize = DefaultConfig().ListSize
+	}
+
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("gcs: invalid endpoint: %w", err)
+	}
+
+	st := &GCSStorage{
+		bucket:   bucket,
+		endpoint: endpoint,
+		config:   config,
+	}
+
+	if len(config.Credentials) > 0 {
+		var sa serviceAccount
+
+		// Parse the service account JSON key.
+		if err := json.Unmarshal(config.Credentials, &sa); err != nil {
+			return nil, fmt.Errorf("gcs: error parsing credentials: %w", err)
+		}
+
+		// Parse the contained RSA private key.
+		key, err := parsePrivateKey(sa.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: error parsing credentials private key: %w", err)
+		}
+
+		if sa.TokenURI == "" {
+			sa.TokenURI = defaultTokenURI
+		}
+
+		st.email = sa.ClientEmail
+		st.key = key
+		st.tokenURI = sa.TokenURI
+	}
+
+	return st, nil
+}
+
+// parsePrivateKey parses a PEM encoded
+// PKCS8 (or PKCS1) RSA private key.
+func parsePrivateKey(data string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no pem data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return rsaKey, nil
+}
+
+// Clean: implements Storage.Clean().
+func (st *GCSStorage) Clean(ctx context.Context) error {
+	return nil // nothing to do for GCS
+}
+
+// ReadBytes: implements Storage.ReadBytes().
+func (st *GCSStorage) ReadBytes(ctx context.Context, key string) ([]byte, error) {
+	rc, err := st.ReadStream(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := io.ReadAll(rc)
+	_ = rc.Close()
+	return b, err
+}
+
+// ReadStream: implements Storage.ReadStream().
+func (st *GCSStorage) ReadStream(ctx context.Context, key string) (io.ReadCloser, error) {
+	u := st.objectURL(key)
+	u.RawQuery = url.Values{"alt": {"media"}}.Encode()
+
+	rsp, err := st.do(ctx, http.MethodGet, u.String(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, drainErr(rsp, key)
+	}
+
+	return rsp.Body, nil
+}
+
+// WriteBytes: implements Storage.WriteBytes().
+func (st *GCSStorage) WriteBytes(ctx context.Context, key string, value []byte) (int, error) {
+	n, err := st.PutObject(ctx, key, bytes.NewReader(value), "")
+	return int(n), err
+}
+
+// WriteStream: implements Storage.WriteStream().
+func (st *GCSStorage) WriteStream(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return st.PutObject(ctx, key, r, "")
+}
+
+// PutObject writes the data stream at key in the bucket,
+// setting the object's content-type (if given). Streams
+// larger than the configured chunk size are sent using a
+// resumable upload, so they need never be held in memory
+// in their entirety.
+func (st *GCSStorage) PutObject(ctx context.Context, key string, r io.Reader, contentType string) (int64, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	buf := make([]byte, st.config.PutChunkSize)
+
+	// Read first chunk from the stream.
+	n, err := io.ReadFull(r, buf)
+	switch {
+	case errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF):
+		// The whole stream fit within a single
+		// chunk, upload it in a single request.
+		return st.putSingle(ctx, key, buf[:n], contentType)
+
+	case err != nil:
+		return 0, err
+	}
+
+	// Initiate a new resumable upload session.
+	session, err := st.startResumable(ctx, key, contentType)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		next  = make([]byte, len(buf))
+		total int64
+	)
+
+	for {
+		start := total
+		total += int64(n)
+
+		// Read the following chunk, to
+		// find out if this one is the last.
+		nn, err := io.ReadFull(r, next)
+		last := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !last {
+			return start, err
+		}
+
+		// Only the final chunk includes the total size.
+		size := "*"
+		if last && nn == 0 {
+			size = strconv.FormatInt(total, 10)
+		}
+
+		hdrs := http.Header{"Content-Range": {
+			"bytes " + strconv.FormatInt(start, 10) + "-" +
+				strconv.FormatInt(total-1, 10) + "/" + size,
+		}}
+
+		if err := st.putChunk(ctx, session, hdrs, buf[:n]); err != nil {
+			return start, err
+		}
+
+		if last && nn == 0 {
+			return total, nil
+		}
+
+		if last {
+			// Upload the trailing
+			// partial chunk as final.
+			hdrs := http.Header{"Content-Range": {
+				"bytes " + strconv.FormatInt(total, 10) + "-" +
+					strconv.FormatInt(total+int64(nn)-1, 10) + "/" +
+					strconv.FormatInt(total+int64(nn), 10),
+			}}
+
+			if err := st.putChunk(ctx, session, hdrs, next[:nn]); err != nil {
+				return total, err
+			}
+
+			return total + int64(nn), nil
+		}
+
+		buf, next = next, buf
+		n = nn
+	}
+}
+
+// putSingle uploads data as an object in a single media upload request.
+func (st *GCSStorage) putSingle(ctx context.Context, key string, data []byte, contentType string) (int64, error) {
+	u := st.uploadURL(key, "media")
+	hdrs := http.Header{"Content-Type": {contentType}}
+
+	rsp, err := st.do(ctx, http.MethodPost, u.String(), hdrs, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return 0, drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+	return int64(len(data)), nil
+}
+
+// startResumable initiates a resumable upload
+// for key, returning the upload session URI.
+func (st *GCSStorage) startResumable(ctx context.Context, key string, contentType string) (string, error) {
+	u := st.uploadURL(key, "resumable")
+	hdrs := http.Header{"X-Upload-Content-Type": {contentType}}
+
+	rsp, err := st.do(ctx, http.MethodPost, u.String(), hdrs, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return "", drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+
+	session := rsp.Header.Get("Location")
+	if session == "" {
+		return "", errors.New("gcs: no resumable upload session location")
+	}
+
+	return session, nil
+}
+
+// putChunk uploads one chunk of a resumable upload session.
+func (st *GCSStorage) putChunk(ctx context.Context, session string, hdrs http.Header, data []byte) error {
+	rsp, err := st.do(ctx, http.MethodPut, session, hdrs, data)
+	if err != nil {
+		return err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK, http.StatusCreated,
+		http.StatusPermanentRedirect: // i.e. "resume incomplete"
+		_ = rsp.Body.Close()
+		return nil
+
+	default:
+		return drainErr(rsp, "")
+	}
+}
+
+// object is the (relevant part of)
+// a JSON API object resource.
+type object struct {
+	Name string `json:"name"`
+	Size string `json:"size"`
+}
+
+// Stat: implements Storage.Stat().
+func (st *GCSStorage) Stat(ctx context.Context, key string) (*storage.Entry, error) {
+	rsp, err := st.do(ctx, http.MethodGet, st.objectURL(key).String(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		var obj object
+		err := json.NewDecoder(rsp.Body).Decode(&obj)
+		_ = rsp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("gcs: error decoding object: %w", err)
+		}
+
+		size, _ := strconv.ParseInt(obj.Size, 10, 64)
+		return &storage.Entry{
+			Key:  key,
+			Size: size,
+		}, nil
+
+	case http.StatusNotFound:
+		_ = rsp.Body.Close()
+		return nil, nil
+
+	default:
+		return nil, drainErr(rsp, key)
+	}
+}
+
+// Remove: implements Storage.Remove().
+func (st *GCSStorage) Remove(ctx context.Context, key string) error {
+	rsp, err := st.do(ctx, http.MethodDelete, st.objectURL(key).String(), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode != http.StatusNoContent &&
+		rsp.StatusCode != http.StatusOK {
+		return drainErr(rsp, key)
+	}
+
+	_ = rsp.Body.Close()
+	return nil
+}
+
+// listResult is the (relevant part of) the
+// JSON response to an objects list request.
+type listResult struct {
+	Items         []object `json:"items"`
+	NextPageToken string   `json:"nextPageToken"`
+}
+
+// WalkKeys: implements Storage.WalkKeys().
+func (st *GCSStorage) WalkKeys(ctx context.Context, opts storage.WalkKeysOpts) error {
+	if opts.Step == nil {
+		panic("nil step fn")
+	}
+
+	var token string
+
+	for {
+		query := url.Values{
+			"maxResults": {strconv.Itoa(st.config.ListSize)},
+		}
+
+		if opts.Prefix != "" {
+			query.Set("prefix", opts.Prefix)
+		}
+
+		if token != "" {
+			query.Set("pageToken", token)
+		}
+
+		u := st.endpoint.JoinPath("storage/v1/b", st.bucket, "o")
+		u.RawQuery = query.Encode()
+
+		// List objects in bucket starting at token.
+		rsp, err := st.do(ctx, http.MethodGet, u.String(), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		if rsp.StatusCode != http.StatusOK {
+			return drainErr(rsp, "")
+		}
+
+		var result listResult
+		err = json.NewDecoder(rsp.Body).Decode(&result)
+		_ = rsp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("gcs: error decoding list result: %w", err)
+		}
+
+		// Iterate through list result contents.
+		for _, obj := range result.Items {
+
+			// Skip filtered obj keys.
+			if opts.Filter != nil &&
+				opts.Filter(obj.Name) {
+				continue
+			}
+
+			size, _ := strconv.ParseInt(obj.Size, 10, 64)
+
+			// Pass each obj through step func.
+			if err := opts.Step(storage.Entry{
+				Key:  obj.Name,
+				Size: size,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// No token means we reached end of bucket.
+		if result.NextPageToken == "" {
+			return nil
+		}
+
+		token = result.NextPageToken
+	}
+}
+
+// SignedURL returns a V4 signed URL granting read access
+// to the object at key until expiry. If contentType is
+// set, it will be returned as the response content-type.
+func (st *GCSStorage) SignedURL(key string, expiry time.Duration, contentType string) (*url.URL, error) {
+	if st.key == nil {
+		return nil, errors.New("gcs: signing urls requires credentials")
+	}
+
+	const algorithm = "GOOG4-RSA-SHA256"
+
+	now := time.Now().UTC()
+	datetime := now.Format("20060102T150405Z")
+	credScope := now.Format("20060102") + "/auto/storage/goog4_request"
+
+	// Path-style object URL, note
+	// that "/" is left unescaped.
+	u := *st.endpoint
+	u.Path += "/" + st.bucket + "/" + key
+	u.RawPath = ""
+
+	query := url.Values{
+		"X-Goog-Algorithm":     {algorithm},
+		"X-Goog-Credential":    {st.email + "/" + credScope},
+		"X-Goog-Date":          {datetime},
+		"X-Goog-Expires":       {strconv.Itoa(int(expiry.Seconds()))},
+		"X-Goog-SignedHeaders": {"host"},
+	}
+
+	if contentType != "" {
+		query.Set("response-content-type", contentType)
+	}
+
+	// url.Values{}.Encode() sorts by key, but encodes
+	// spaces as '+', whereas signing expects '%20'.
+	canonicalQuery := strings.ReplaceAll(query.Encode(), "+", "%20")
+
+	// Build the canonical request, as described in:
+	// https://cloud.google.com/storage/docs/authentication/canonical-requests
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	toSign := strings.Join([]string{
+		algorithm,
+		datetime,
+		credScope,
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	sig, err := st.rsaSign([]byte(toSign))
+	if err != nil {
+		return nil, fmt.Errorf("gcs: error signing url: %w", err)
+	}
+
+	u.RawQuery = canonicalQuery + "&X-Goog-Signature=" + hex.EncodeToString(sig)
+	return &u, nil
+}
+
+// objectURL returns the JSON API URL for object at key.
+func (st *GCSStorage) objectURL(key string) *url.URL {
+	u := st.endpoint.JoinPath("storage/v1/b", st.bucket, "o")
+
+	// Object names are a single path
+	// segment, so "/" must be escaped.
+	u.RawPath = u.Path + "/" + url.PathEscape(key)
+	u.Path += "/" + key
+	return u
+}
+
+// uploadURL returns the JSON API upload
+// URL for object at key of upload type.
+func (st *GCSStorage) uploadURL(key string, uploadType string) *url.URL {
+	u := st.endpoint.JoinPath("upload/storage/v1/b", st.bucket, "o")
+	u.RawQuery = url.Values{
+		"uploadType": {uploadType},
+		"name":       {key},
+	}.Encode()
+	return u
+}
+
+// do performs an authorized request to
+// url with given method, headers and body.
+func (st *GCSStorage) do(
+	ctx context.Context,
+	method string,
+	url string,
+	hdrs http.Header,
+	body []byte,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range hdrs {
+		req.Header[k] = v
+	}
+
+	req.ContentLength = int64(len(body))
+	if len(body) == 0 {
+		// Ensure no body is sent
+		// for e.g. GET and DELETE.
+		req.Body = http.NoBody
+	}
+
+	if st.key != nil {
+		token, err := st.accessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return st.config.Client.Do(req)
+}
+
+// accessToken returns a cached OAuth2 access token,
+// fetching a new one with a signed JWT if expired.
+func (st *GCSStorage) accessToken(ctx context.Context) (string, error) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	now := time.Now()
+	if st.token != "" && now.Before(st.expires) {
+		return st.token, nil
+	}
+
+	// Build a JWT asserting our service account identity, see:
+	// https://developers.google.com/identity/protocols/oauth2/service-account#authorizingrequests
+	header, _ := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+
+	claims, _ := json.Marshal(map[string]any{
+		"iss":   st.email,
+		"scope": scope,
+		"aud":   st.tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+
+	jwt := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(claims)
+
+	sig, err := st.rsaSign([]byte(jwt))
+	if err != nil {
+		return "", fmt.Errorf("gcs: error signing jwt: %w", err)
+	}
+
+	jwt += "." + base64.RawURLEncoding.EncodeToString(sig)
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {jwt},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, st.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := st.config.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("gcs: error fetching access token: %w", err)
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return "", drainErr(rsp, "")
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+
+	err = json.NewDecoder(rsp.Body).Decode(&token)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("gcs: error decoding access token: %w", err)
+	}
+
+	// Cache the token, leaving a
+	// minute's leeway before expiry.
+	st.token = token.AccessToken
+	st.expires = now.Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute)
+
+	return st.token, nil
+}
+
+// rsaSign returns the RSASSA-PKCS1-v1_5 SHA256
+// signature of data using service account key.
+func (st *GCSStorage) rsaSign(data []byte) ([]byte, error) {
+	hash := sha256.Sum256(data)
+	return rsa.SignPKCS1v15(rand.Reader, st.key, crypto.SHA256, hash[:])
+}
+
+// drainErr drains and closes the response body, returning
+// an error describing the unexpected response status. Not
+// found responses are wrapped as storage.ErrNotFound.
+func drainErr(rsp *http.Response, key string) error {
+	b, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+	_ = rsp.Body.Close()
+
+	err := fmt.Errorf("gcs: unexpected status %s: %s", rsp.Status, bytes.TrimSpace(b))
+	if rsp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s (%w)", storage.ErrNotFound, key, err)
+	}
+
+	return err
+}
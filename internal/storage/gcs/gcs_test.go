@@ -0,0 +1,366 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gcs_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-storage"
+	"github.com/superseriousbusiness/gotosocial/internal/storage/gcs"
+)
+
+const (
+	testBucket = "gts"
+	testToken  = "test-access-token"
+)
+
+// fakeGCS is a minimal in-memory mock of the
+// Google Cloud Storage JSON API and token endpoint.
+type fakeGCS struct {
+	mu      sync.Mutex
+	key     *rsa.PublicKey
+	objects map[string][]byte
+	types   map[string]string
+	uploads map[string]*bytes.Buffer
+	tokens  int
+}
+
+func (f *fakeGCS) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+
+	if r.URL.Path == "/token" {
+		f.serveToken(rw, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var (
+		objects = "/storage/v1/b/" + testBucket + "/o"
+		uploads = "/upload" + objects
+		query   = r.URL.Query()
+	)
+
+	switch {
+	case r.URL.Path == uploads && query.Get("uploadType") == "media":
+		f.objects[query.Get("name")] = body
+		f.types[query.Get("name")] = r.Header.Get("Content-Type")
+		f.writeObject(rw, query.Get("name"))
+
+	case r.URL.Path == uploads && query.Get("uploadType") == "resumable":
+		id := strconv.Itoa(len(f.uploads))
+		f.uploads[id] = new(bytes.Buffer)
+		f.types[query.Get("name")] = r.Header.Get("X-Upload-Content-Type")
+		rw.Header().Set("Location", "http://"+r.Host+"/session/"+id+"?name="+query.Get("name"))
+
+	case strings.HasPrefix(r.URL.Path, "/session/"):
+		buf := f.uploads[strings.TrimPrefix(r.URL.Path, "/session/")]
+
+		var start, end int64
+		var size string
+		if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%s", &start, &end, &size); err != nil ||
+			start != int64(buf.Len()) || end-start+1 != int64(len(body)) {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		buf.Write(body)
+
+		if size == "*" {
+			// Resume incomplete.
+			rw.WriteHeader(http.StatusPermanentRedirect)
+			return
+		}
+
+		f.objects[query.Get("name")] = buf.Bytes()
+		f.writeObject(rw, query.Get("name"))
+
+	case r.URL.Path == objects:
+		var result struct {
+			Items         []map[string]string `json:"items"`
+			NextPageToken string              `json:"nextPageToken,omitempty"`
+		}
+
+		var names []string
+		for name := range f.objects {
+			if strings.HasPrefix(name, query.Get("prefix")) {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+
+		// Page results using the token as an offset.
+		start, _ := strconv.Atoi(query.Get("pageToken"))
+		size, _ := strconv.Atoi(query.Get("maxResults"))
+		end := min(start+size, len(names))
+		for _, name := range names[start:end] {
+			result.Items = append(result.Items, map[string]string{
+				"name": name,
+				"size": strconv.Itoa(len(f.objects[name])),
+			})
+		}
+		if end < len(names) {
+			result.NextPageToken = strconv.Itoa(end)
+		}
+
+		_ = json.NewEncoder(rw).Encode(result)
+
+	case strings.HasPrefix(r.URL.Path, objects+"/"):
+		name := strings.TrimPrefix(r.URL.Path, objects+"/")
+
+		data, ok := f.objects[name]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodDelete:
+			delete(f.objects, name)
+			rw.WriteHeader(http.StatusNoContent)
+		case query.Get("alt") == "media":
+			_, _ = rw.Write(data)
+		default:
+			f.writeObject(rw, name)
+		}
+
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeGCS) serveToken(rw http.ResponseWriter, r *http.Request) {
+	// Verify the signed JWT assertion
+	// using the service account public key.
+	parts := strings.Split(r.PostForm.Get("assertion"), ".")
+	if r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || len(parts) != 3 {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	sig, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(f.key, crypto.SHA256, hash[:], sig); err != nil {
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	f.tokens++
+	_ = json.NewEncoder(rw).Encode(map[string]any{
+		"access_token": testToken,
+		"expires_in":   3600,
+		"token_type":   "Bearer",
+	})
+}
+
+func (f *fakeGCS) writeObject(rw http.ResponseWriter, name string) {
+	_ = json.NewEncoder(rw).Encode(map[string]string{
+		"name": name,
+		"size": strconv.Itoa(len(f.objects[name])),
+	})
+}
+
+func openTestStorage(t *testing.T) (*gcs.GCSStorage, *fakeGCS) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake := &fakeGCS{
+		key:     &key.PublicKey,
+		objects: make(map[string][]byte),
+		types:   make(map[string]string),
+		uploads: make(map[string]*bytes.Buffer),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		fake.ServeHTTP(rw, r)
+	}))
+	t.Cleanup(server.Close)
+
+	creds, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "gts@example.iam.gserviceaccount.com",
+		"private_key": string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})),
+		"token_uri": server.URL + "/token",
+	})
+
+	cfg := gcs.DefaultConfig()
+	cfg.Endpoint = server.URL
+	cfg.Credentials = creds
+	cfg.PutChunkSize = 1 // rounded up to 256KiB
+	cfg.ListSize = 2
+
+	st, err := gcs.Open(testBucket, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return st, fake
+}
+
+func TestGCSStorage(t *testing.T) {
+	ctx := context.Background()
+	st, fake := openTestStorage(t)
+
+	// Small write fits in a single media upload.
+	if _, err := st.WriteBytes(ctx, "a/small", []byte("hi")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Streams spanning more than one chunk use a resumable upload,
+	// check both with and without a trailing partial chunk.
+	for _, size := range []int{256 * 1024 * 2, 256*1024*2 + 10} {
+		data := bytes.Repeat([]byte{'x'}, size)
+		n, err := st.PutObject(ctx, "a/large", io.MultiReader(bytes.NewReader(data)), "video/mp4")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n != int64(size) {
+			t.Fatalf("expected %d bytes written, got %d", size, n)
+		}
+
+		if ct := fake.types["a/large"]; ct != "video/mp4" {
+			t.Fatalf("expected video/mp4 content-type, got %q", ct)
+		}
+
+		b, err := st.ReadBytes(ctx, "a/large")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(b, data) {
+			t.Fatalf("read data did not match written data of size %d", size)
+		}
+	}
+
+	entry, err := st.Stat(ctx, "a/small")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry == nil || entry.Size != 2 {
+		t.Fatalf("unexpected stat entry: %+v", entry)
+	}
+
+	if _, err := st.WriteBytes(ctx, "b/other", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Walk all keys, paging through results.
+	var keys []string
+	if err := st.WalkKeys(ctx, storage.WalkKeysOpts{
+		Step: func(e storage.Entry) error {
+			keys = append(keys, e.Key)
+			return nil
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(keys, []string{"a/large", "a/small", "b/other"}) {
+		t.Fatalf("unexpected walked keys: %v", keys)
+	}
+
+	if err := st.Remove(ctx, "a/small"); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err = st.Stat(ctx, "a/small")
+	if err != nil || entry != nil {
+		t.Fatalf("expected no entry after remove, got %+v (%v)", entry, err)
+	}
+
+	if _, err := st.ReadBytes(ctx, "a/small"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	// Access token should have been cached.
+	if fake.tokens != 1 {
+		t.Fatalf("expected 1 access token fetch, got %d", fake.tokens)
+	}
+}
+
+func TestGCSSignedURL(t *testing.T) {
+	st, _ := openTestStorage(t)
+
+	u, err := st.SignedURL("a/large", time.Hour, "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Path != "/"+testBucket+"/a/large" {
+		t.Fatalf("unexpected signed url path: %s", u.Path)
+	}
+
+	query := u.Query()
+	for k, v := range map[string]string{
+		"X-Goog-Algorithm":      "GOOG4-RSA-SHA256",
+		"X-Goog-Expires":        "3600",
+		"X-Goog-SignedHeaders":  "host",
+		"response-content-type": "image/png",
+	} {
+		if query.Get(k) != v {
+			t.Fatalf("expected %s=%s in signed url, got %q", k, v, query.Get(k))
+		}
+	}
+
+	if !strings.HasPrefix(query.Get("X-Goog-Credential"), "gts@example.iam.gserviceaccount.com/") {
+		t.Fatalf("unexpected credential in signed url: %s", query.Get("X-Goog-Credential"))
+	}
+
+	// Without credentials, URLs can't be signed.
+	unauthed, err := gcs.Open(testBucket, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := unauthed.SignedURL("a/large", time.Hour, ""); err == nil {
+		t.Fatal("expected error signing url without credentials")
+	}
+}
@@ -38,6 +38,8 @@
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/storage/azure"
+	"github.com/superseriousbusiness/gotosocial/internal/storage/gcs"
 )
 
 const (
@@ -45,8 +47,8 @@
 	urlCacheExpiryFrequency = time.Minute * 5
 )
 
-// PresignedURL represents a pre signed S3 URL with
-// an expiry time.
+// PresignedURL represents a pre signed object storage
+// URL (e.g. S3, Azure SAS, GCS signed URL) with an expiry time.
 type PresignedURL struct {
 	*url.URL
 	Expiry time.Time // link expires at this time
@@ -70,12 +72,21 @@ func IsNotFound(err error) bool {
 	return errors.Is(err, storage.ErrNotFound)
 }
 
-// Driver wraps a kv.KVStore to also provide S3 presigned GET URLs.
+// urlSigner is implemented by object storage
+// backends other than S3 that are able to sign
+// GET URLs for direct access to stored data.
+type urlSigner interface {
+	SignedURL(key string, expiry time.Duration, contentType string) (*url.URL, error)
+}
+
+// Driver wraps a kv.KVStore to also provide presigned GET URLs
+// for object storage backends (i.e. S3, Azure Blob and GCS).
 type Driver struct {
 	// Underlying storage
 	Storage storage.Storage
 
-	// S3-only parameters
+	// Object storage parameters,
+	// Bucket and RedirectURL are S3-only.
 	Proxy          bool
 	Bucket         string
 	PresignedCache *ttl.Cache[string, PresignedURL]
@@ -122,6 +133,16 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string)
 		// uploaded info.
 		sz = info.Size
 
+	case *azure.AzureStorage:
+		// As above, for Azure
+		// set blob content-type.
+		sz, err = d.PutBlob(ctx, key, file, contentType)
+
+	case *gcs.GCSStorage:
+		// As above, for GCS
+		// set object content-type.
+		sz, err = d.PutObject(ctx, key, file, contentType)
+
 	default:
 		// Write the file data to storage under key. Note
 		// that for disk.DiskStorage{} this should end up
@@ -162,11 +183,47 @@ func (d *Driver) WalkKeys(ctx context.Context, walk func(string) error) error {
 	})
 }
 
-// URL will return a presigned GET object URL, but only if running on S3 storage with proxying disabled.
+// signURL returns a presigned GET URL for key from the underlying
+// object storage, with the given expiry and response content-type.
+func (d *Driver) signURL(ctx context.Context, key string, expiry time.Duration, contentType string) (*url.URL, error) {
+	switch st := d.Storage.(type) {
+	case *s3.S3Storage:
+		var params url.Values
+		if contentType != "" {
+			params = url.Values{"response-content-type": []string{contentType}}
+		}
+		return st.Client().PresignedGetObject(ctx, d.Bucket, key, expiry, params)
+
+	case urlSigner:
+		return st.SignedURL(key, expiry, contentType)
+
+	default:
+		return nil, gtserror.Newf("storage %T does not support presigned urls", st)
+	}
+}
+
+// presigns returns whether this driver serves
+// data via presigned URLs, i.e. it is backed by
+// object storage with proxying disabled.
+func (d *Driver) presigns() bool {
+	if d.Proxy {
+		return false
+	}
+
+	switch d.Storage.(type) {
+	case *s3.S3Storage, urlSigner:
+		return true
+	default:
+		return false
+	}
+}
+
+// URL will return a presigned GET object URL, but only if running on object storage with proxying disabled.
 func (d *Driver) URL(ctx context.Context, key string) *PresignedURL {
-	// Check whether S3 *without* proxying is enabled
-	s3, ok := d.Storage.(*s3.S3Storage)
-	if !ok || d.Proxy {
+	// Check whether object
+	// storage *without*
+	// proxying is enabled.
+	if !d.presigns() {
 		return nil
 	}
 
@@ -193,9 +250,7 @@ func (d *Driver) URL(ctx context.Context, key string) *PresignedURL {
 			return nil
 		}
 	} else {
-		u, err = s3.Client().PresignedGetObject(ctx, d.Bucket, key, urlCacheTTL, url.Values{
-			"response-content-type": []string{mime.TypeByExtension(path.Ext(key))},
-		})
+		u, err = d.signURL(ctx, key, urlCacheTTL, mime.TypeByExtension(path.Ext(key)))
 		if err != nil {
 			// If URL request fails, fallback is to
 			// fetch the file. So ignore the error here
@@ -216,23 +271,22 @@ func (d *Driver) URL(ctx context.Context, key string) *PresignedURL {
 // to a content-security-policy to allow requests to
 // endpoints served by this driver.
 //
-// If the driver is not backed by non-proxying S3,
-// this will return an empty string and no error.
+// If the driver is not backed by non-proxying object
+// storage, this will return an empty string and no error.
 //
 // Otherwise, this function probes for a CSP URI by
 // doing the following:
 //
-//  1. Create a temporary file in the S3 bucket.
+//  1. Create a temporary file in the bucket / container.
 //  2. Generate a pre-signed URL for that file.
 //  3. Extract '[scheme]://[host]' from the URL.
 //  4. Remove the temporary file.
 //  5. Return the '[scheme]://[host]' string.
 func (d *Driver) ProbeCSPUri(ctx context.Context) (string, error) {
-	// Check whether S3 without proxying
-	// is enabled. If it's not, there's
+	// Check whether object storage without
+	// proxying is enabled. If it's not, there's
 	// no need to add anything to the CSP.
-	s3, ok := d.Storage.(*s3.S3Storage)
-	if !ok || d.Proxy {
+	if !d.presigns() {
 		return "", nil
 	}
 
@@ -246,7 +300,7 @@ func (d *Driver) ProbeCSPUri(ctx context.Context) (string, error) {
 
 	const cspKey = "gotosocial-csp-probe"
 
-	// Create an empty file in S3 storage.
+	// Create an empty file in object storage.
 	if _, err := d.Put(ctx, cspKey, make([]byte, 0)); err != nil {
 		return "", gtserror.Newf("error putting file in bucket at key %s: %w", cspKey, err)
 	}
@@ -255,12 +309,12 @@ func (d *Driver) ProbeCSPUri(ctx context.Context) (string, error) {
 	defer func() {
 		if err := d.Delete(ctx, cspKey); err != nil {
 			log.Warnf(ctx, "error deleting file from bucket at key %s (%v); "+
-				"you may want to remove this file manually from your S3 bucket", cspKey, err)
+				"you may want to remove this file manually from your bucket", cspKey, err)
 		}
 	}()
 
 	// Get a presigned URL for that empty file.
-	u, err := s3.Client().PresignedGetObject(ctx, d.Bucket, cspKey, 1*time.Second, nil)
+	u, err := d.signURL(ctx, cspKey, 1*time.Second, "")
 	if err != nil {
 		return "", err
 	}
@@ -279,6 +333,10 @@ func AutoConfig() (*Driver, error) {
 	switch backend := config.GetStorageBackend(); backend {
 	case "s3":
 		return NewS3Storage()
+	case "azure":
+		return NewAzureStorage()
+	case "gcs":
+		return NewGCSStorage()
 	case "local":
 		return NewFileStorage()
 	default:
@@ -328,15 +386,72 @@ func NewS3Storage() (*Driver, error) {
 		return nil, fmt.Errorf("error opening s3 storage: %w", err)
 	}
 
-	// ttl should be lower than the expiry used by S3 to avoid serving invalid URLs
-	presignedCache := ttl.New[string, PresignedURL](0, 1000, urlCacheTTL-urlCacheExpiryFrequency)
-	presignedCache.Start(urlCacheExpiryFrequency)
-
 	return &Driver{
 		Proxy:          config.GetStorageS3Proxy(),
 		Bucket:         config.GetStorageS3BucketName(),
 		Storage:        s3,
-		PresignedCache: presignedCache,
+		PresignedCache: newPresignedCache(),
 		RedirectURL:    redirectURL,
 	}, nil
 }
+
+func NewAzureStorage() (*Driver, error) {
+	// Load runtime configuration
+	account := config.GetStorageAzureAccountName()
+	key := config.GetStorageAzureAccountKey()
+	container := config.GetStorageAzureContainer()
+
+	azureCfg := azure.DefaultConfig()
+	azureCfg.Endpoint = config.GetStorageAzureEndpoint()
+
+	// Open the azure storage implementation
+	azure, err := azure.Open(account, key, container, &azureCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error opening azure storage: %w", err)
+	}
+
+	return &Driver{
+		Proxy:          config.GetStorageAzureProxy(),
+		Storage:        azure,
+		PresignedCache: newPresignedCache(),
+	}, nil
+}
+
+func NewGCSStorage() (*Driver, error) {
+	// Load runtime configuration
+	bucket := config.GetStorageGCSBucket()
+	credsFile := config.GetStorageGCSCredentialsFile()
+
+	gcsCfg := gcs.DefaultConfig()
+	gcsCfg.Endpoint = config.GetStorageGCSEndpoint()
+
+	if credsFile != "" {
+		// Read service account key from file.
+		creds, err := os.ReadFile(credsFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading gcs credentials file: %w", err)
+		}
+		gcsCfg.Credentials = creds
+	}
+
+	// Open the gcs storage implementation
+	gcs, err := gcs.Open(bucket, &gcsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error opening gcs storage: %w", err)
+	}
+
+	return &Driver{
+		// Without credentials we can't sign URLs, so always proxy.
+		Proxy:          config.GetStorageGCSProxy() || credsFile == "",
+		Storage:        gcs,
+		PresignedCache: newPresignedCache(),
+	}, nil
+}
+
+// newPresignedCache returns a new, started cache for presigned URLs.
+func newPresignedCache() *ttl.Cache[string, PresignedURL] {
+	// ttl should be lower than the expiry used by the presigned URLs to avoid serving invalid URLs
+	presignedCache := ttl.New[string, PresignedURL](0, 1000, urlCacheTTL-urlCacheExpiryFrequency)
+	presignedCache.Start(urlCacheExpiryFrequency)
+	return presignedCache
+}
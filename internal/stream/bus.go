@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/pubsub"
+)
+
+// streamBus wraps a pubsub.Bus{}
+// with its subscription state.
+type streamBus struct {
+	bus    pubsub.Bus
+	origin string
+	cncl   context.CancelFunc
+}
+
+// busMessage is a single stream message
+// fanned-out over the bus to other nodes.
+type busMessage struct {
+	// Origin is the ID of the publishing
+	// process, to ignore our own messages.
+	Origin string `json:"origin"`
+
+	// AccountID is the account to post the
+	// message to, or empty for all accounts.
+	AccountID string `json:"account_id,omitempty"`
+
+	// Message is the stream message.
+	Message Message `json:"message"`
+}
+
+// SetBus sets a bus over which all posted messages are fanned-out
+// to other GoToSocial processes, so that clients receive them no
+// matter which process their stream is open on. It also starts
+// posting any messages received from other processes over the bus.
+//
+// This must be called before any messages are posted.
+func (s *Streams) SetBus(bus pubsub.Bus) {
+	ctx, cncl := context.WithCancel(context.Background())
+	s.bus = &streamBus{
+		bus:    bus,
+		origin: id.NewULID(),
+		cncl:   cncl,
+	}
+	go pubsub.Subscribe(ctx, bus, "stream",
+		func(payload string) {
+			s.onMessage(ctx, payload)
+		},
+		nil, // nothing to drop
+	)
+}
+
+// Stop stops receiving messages
+// from the bus, and closes it.
+func (s *Streams) Stop() {
+	if s.bus == nil {
+		return
+	}
+	s.bus.cncl()
+	if err := s.bus.bus.Close(); err != nil {
+		log.Errorf(nil, "error closing stream bus: %v", err)
+	}
+}
+
+// publish publishes msg for accountID
+// (or all if empty) on bus, if set.
+func (s *Streams) publish(ctx context.Context, accountID string, msg Message) {
+	if s.bus == nil {
+		return
+	}
+
+	b, err := json.Marshal(busMessage{
+		Origin:    s.bus.origin,
+		AccountID: accountID,
+		Message:   msg,
+	})
+	if err != nil {
+		log.Errorf(ctx, "error marshaling stream message: %v", err)
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	if err := s.bus.bus.Publish(ctx, string(b)); err != nil {
+		log.Errorf(ctx, "error publishing stream message: %v", err)
+	}
+}
+
+// onMessage handles a message received over the bus.
+func (s *Streams) onMessage(ctx context.Context, payload string) {
+	var msg busMessage
+
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		log.Errorf(ctx, "error unmarshaling stream message: %v", err)
+		return
+	}
+
+	if msg.Origin == s.bus.origin {
+		// Our own message,
+		// already posted.
+		return
+	}
+
+	if msg.AccountID == "" {
+		s.postAll(ctx, msg.Message)
+	} else {
+		s.post(ctx, msg.AccountID, msg.Message)
+	}
+}
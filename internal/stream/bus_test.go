@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+// memBus is a simple in-memory pubsub.Bus{},
+// delivering published payloads to subscribers in-line.
+type memBus struct {
+	mu    sync.Mutex
+	subs  []func(string)
+	ready chan struct{}
+}
+
+func (b *memBus) Publish(_ context.Context, payload string) error {
+	b.mu.Lock()
+	subs := b.subs
+	b.mu.Unlock()
+	for _, recv := range subs {
+		recv(payload)
+	}
+	return nil
+}
+
+func (b *memBus) Subscribe(ctx context.Context, recv func(string)) error {
+	b.mu.Lock()
+	b.subs = append(b.subs, recv)
+	b.mu.Unlock()
+	b.ready <- struct{}{}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (b *memBus) Close() error { return nil }
+
+func TestStreamsBus(t *testing.T) {
+	bus := &memBus{ready: make(chan struct{})}
+
+	var s1, s2 stream.Streams
+	s1.SetBus(bus)
+	s2.SetBus(bus)
+	<-bus.ready
+	<-bus.ready
+	defer s1.Stop()
+	defer s2.Stop()
+
+	// Open stream on each "node".
+	str1 := s1.Open("account1", stream.TimelineHome)
+	str2 := s2.Open("account1", stream.TimelineHome)
+	defer str1.Close()
+	defer str2.Close()
+
+	ctx, cncl := context.WithTimeout(context.Background(), time.Second)
+	defer cncl()
+
+	// Post message on first node only.
+	s1.Post(ctx, "account1", stream.Message{
+		Stream:  []string{stream.TimelineHome},
+		Event:   stream.EventTypeUpdate,
+		Payload: "{}",
+	})
+
+	// Both streams should receive it, once.
+	for i, str := range []*stream.Stream{str1, str2} {
+		msg, ok := str.Recv(ctx)
+		if !ok {
+			t.Fatalf("stream %d did not receive message", i+1)
+		}
+		if msg.Event != stream.EventTypeUpdate {
+			t.Fatalf("stream %d received unexpected event %s", i+1, msg.Event)
+		}
+	}
+
+	// Post a delete to all on second node.
+	s2.PostAll(ctx, stream.Message{
+		Stream:  stream.AllStatusTimelines,
+		Event:   stream.EventTypeDelete,
+		Payload: "01F8MH17FWEB39HZJ76B6VXSKF",
+	})
+
+	msg, ok := str1.Recv(ctx)
+	if !ok || msg.Event != stream.EventTypeDelete {
+		t.Fatal("first stream did not receive delete")
+	}
+
+	// No duplicate messages from bus.
+	ctx, cncl = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cncl()
+	if _, ok := str1.Recv(ctx); ok {
+		t.Fatal("first stream received unexpected message")
+	}
+}
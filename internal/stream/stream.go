@@ -50,6 +50,18 @@
 	// EventTypeConversation -- a user
 	// should be shown an updated conversation.
 	EventTypeConversation = "conversation"
+
+	// EventTypeAnnouncement -- an announcement
+	// has been published or updated.
+	EventTypeAnnouncement = "announcement"
+
+	// EventTypeAnnouncementReaction -- a reaction
+	// to an announcement was added or removed.
+	EventTypeAnnouncementReaction = "announcement.reaction"
+
+	// EventTypeAnnouncementDelete -- an announcement
+	// has been deleted or unpublished.
+	EventTypeAnnouncementDelete = "announcement.delete"
 )
 
 const (
@@ -95,6 +107,11 @@
 type Streams struct {
 	streams map[string][]*Stream
 	mutex   sync.Mutex
+
+	// bus is set when messages
+	// are fanned-out to other
+	// GoToSocial processes.
+	bus *streamBus
 }
 
 // Open will open open a new Stream for given account ID and stream types, the given context will be passed to Stream.
@@ -148,6 +165,12 @@ func (s *Streams) Open(accountID string, streamTypes ...string) *Stream {
 
 // Post will post the given message to all streams of given account ID matching type.
 func (s *Streams) Post(ctx context.Context, accountID string, msg Message) bool {
+	s.publish(ctx, accountID, msg)
+	return s.post(ctx, accountID, msg)
+}
+
+// post will post the given message to all local streams of given account ID matching type.
+func (s *Streams) post(ctx context.Context, accountID string, msg Message) bool {
 	var deferred []func() bool
 
 	// Acquire lock.
@@ -198,6 +221,12 @@ func (s *Streams) Post(ctx context.Context, accountID string, msg Message) bool
 
 // PostAll will post the given message to all streams with matching types.
 func (s *Streams) PostAll(ctx context.Context, msg Message) bool {
+	s.publish(ctx, "", msg)
+	return s.postAll(ctx, msg)
+}
+
+// postAll will post the given message to all local streams with matching types.
+func (s *Streams) postAll(ctx context.Context, msg Message) bool {
 	var deferred []func() bool
 
 	// Acquire lock.
@@ -0,0 +1,171 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import "unicode"
+
+// maxDiffCells is the maximum size of the table
+// used to diff two texts, beyond which DiffWords
+// gives up and returns a total replacement.
+const maxDiffCells = 4_000_000
+
+// DiffOp describes how a chunk of
+// text changed between two texts.
+type DiffOp int
+
+const (
+	DiffEqual  DiffOp = iota // DiffEqual -- chunk is in both texts.
+	DiffInsert               // DiffInsert -- chunk is only in the new text.
+	DiffDelete               // DiffDelete -- chunk is only in the old text.
+)
+
+// String returns a stringified, frontend API compatible form of DiffOp.
+func (op DiffOp) String() string {
+	switch op {
+	case DiffEqual:
+		return "equal"
+	case DiffInsert:
+		return "insert"
+	case DiffDelete:
+		return "delete"
+	default:
+		panic("invalid diff op")
+	}
+}
+
+// DiffChunk is one chunk of a diff.
+type DiffChunk struct {
+	Op   DiffOp
+	Text string
+}
+
+// DiffWords returns a word-level diff of the given old and new
+// plaintexts. Adjacent chunks with the same op are merged, so
+// concatenating the equal and delete chunks gives the old text,
+// and concatenating the equal and insert chunks gives the new.
+func DiffWords(old string, new string) []DiffChunk {
+	a, b := splitWords(old), splitWords(new)
+
+	// Trim common prefix and suffix,
+	// which covers most small edits
+	// and keeps the table small.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var chunks []DiffChunk
+	push := func(op DiffOp, word string) {
+		if l := len(chunks); l > 0 && chunks[l-1].Op == op {
+			chunks[l-1].Text += word
+			return
+		}
+		chunks = append(chunks, DiffChunk{Op: op, Text: word})
+	}
+
+	for _, word := range a[:prefix] {
+		push(DiffEqual, word)
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+	n, m := len(midA), len(midB)
+
+	if (n+1)*(m+1) > maxDiffCells {
+		// Too big to diff
+		// sensibly, just
+		// replace it all.
+		for _, word := range midA {
+			push(DiffDelete, word)
+		}
+		for _, word := range midB {
+			push(DiffInsert, word)
+		}
+	} else {
+		// lcs[i][j] is the length of the longest
+		// common subsequence of midA[i:], midB[j:].
+		lcs := make([][]int, n+1)
+		for i := range lcs {
+			lcs[i] = make([]int, m+1)
+		}
+		for i := n - 1; i >= 0; i-- {
+			for j := m - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < n && j < m {
+			switch {
+			case midA[i] == midB[j]:
+				push(DiffEqual, midA[i])
+				i++
+				j++
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				push(DiffDelete, midA[i])
+				i++
+			default:
+				push(DiffInsert, midB[j])
+				j++
+			}
+		}
+		for ; i < n; i++ {
+			push(DiffDelete, midA[i])
+		}
+		for ; j < m; j++ {
+			push(DiffInsert, midB[j])
+		}
+	}
+
+	for _, word := range a[len(a)-suffix:] {
+		push(DiffEqual, word)
+	}
+
+	return chunks
+}
+
+// splitWords splits the given text into words
+// and runs of whitespace, keeping both, so that
+// joining the result gives back the input text.
+func splitWords(in string) []string {
+	var (
+		words []string
+		start int
+	)
+
+	runes := []rune(in)
+	for i := 1; i <= len(runes); i++ {
+		if i == len(runes) ||
+			unicode.IsSpace(runes[i]) != unicode.IsSpace(runes[i-1]) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return words
+}
@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type DiffTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DiffTestSuite) TestDiffWordsUnchanged() {
+	chunks := text.DiffWords("hello world", "hello world")
+	suite.Equal([]text.DiffChunk{
+		{Op: text.DiffEqual, Text: "hello world"},
+	}, chunks)
+}
+
+func (suite *DiffTestSuite) TestDiffWordsReplace() {
+	chunks := text.DiffWords(
+		"the quick brown fox jumps",
+		"the slow brown fox really jumps",
+	)
+	suite.Equal([]text.DiffChunk{
+		{Op: text.DiffEqual, Text: "the "},
+		{Op: text.DiffDelete, Text: "quick"},
+		{Op: text.DiffInsert, Text: "slow"},
+		{Op: text.DiffEqual, Text: " brown fox"},
+		{Op: text.DiffInsert, Text: " really"},
+		{Op: text.DiffEqual, Text: " jumps"},
+	}, chunks)
+}
+
+func (suite *DiffTestSuite) TestDiffWordsFromEmpty() {
+	chunks := text.DiffWords("", "new text")
+	suite.Equal([]text.DiffChunk{
+		{Op: text.DiffInsert, Text: "new text"},
+	}, chunks)
+}
+
+func (suite *DiffTestSuite) TestDiffWordsRoundTrip() {
+	var (
+		old = "some words here, and 😎 emoji\nover two lines"
+		new = "some other words, and 😎 emojis\n\nover three lines!"
+	)
+
+	var gotOld, gotNew string
+	for _, chunk := range text.DiffWords(old, new) {
+		if chunk.Op != text.DiffInsert {
+			gotOld += chunk.Text
+		}
+		if chunk.Op != text.DiffDelete {
+			gotNew += chunk.Text
+		}
+	}
+
+	suite.Equal(old, gotOld)
+	suite.Equal(new, gotNew)
+}
+
+func TestDiffTestSuite(t *testing.T) {
+	suite.Run(t, new(DiffTestSuite))
+}
@@ -189,3 +189,14 @@ func SanitizeToPlaintext(in string) string {
 	content = html.UnescapeString(content)
 	return strings.TrimSpace(content)
 }
+
+// SanitizeToSearchText returns the given content warning
+// and HTML content as plaintext suitable for indexing by
+// a full-text search engine. Unlike SanitizeToPlaintext,
+// it ensures words either side of an HTML tag boundary
+// (eg., "<p>one</p><p>two</p>") don't get run together.
+func SanitizeToSearchText(contentWarning string, content string) string {
+	content = strings.ReplaceAll(content, "<", " <")
+	content = SanitizeToPlaintext(content)
+	return strings.TrimSpace(contentWarning + "\n" + content)
+}
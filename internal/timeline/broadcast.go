@@ -0,0 +1,181 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/pubsub"
+)
+
+// Operations on timelines shared over the bus.
+const (
+	opRemove                        = "remove"
+	opRemoveTimeline                = "remove_timeline"
+	opWipeItemFromAllTimelines      = "wipe_item_from_all_timelines"
+	opWipeItemsFromAccountID        = "wipe_items_from_account_id"
+	opUnprepareItem                 = "unprepare_item"
+	opUnprepareItemFromAllTimelines = "unprepare_item_from_all_timelines"
+)
+
+// broadcastMessage is a single timeline
+// operation shared over the bus.
+type broadcastMessage struct {
+	Origin     string `json:"origin"`
+	Op         string `json:"op"`
+	TimelineID string `json:"timeline_id,omitempty"`
+	ItemID     string `json:"item_id,omitempty"`
+	AccountID  string `json:"account_id,omitempty"`
+}
+
+// Broadcast wraps manager so that changes to its timelines are
+// shared with the timeline managers of other GoToSocial processes
+// over bus, keeping their in-memory timelines consistent.
+//
+// Removals and unprepares are applied as-is by other processes.
+// Ingested items can't be shared, so instead other processes drop
+// the ingesting timeline entirely, to be re-indexed from the db
+// the next time it is fetched.
+func Broadcast(manager Manager, bus pubsub.Bus) Manager {
+	ctx, cncl := context.WithCancel(context.Background())
+	m := &broadcastManager{
+		Manager: manager,
+		bus:     bus,
+		origin:  id.NewULID(),
+		cncl:    cncl,
+	}
+	go pubsub.Subscribe(ctx, bus, "timeline",
+		func(payload string) {
+			m.onMessage(ctx, payload)
+		},
+		nil, // nothing to drop
+	)
+	return m
+}
+
+type broadcastManager struct {
+	Manager
+	bus    pubsub.Bus
+	origin string
+	cncl   context.CancelFunc
+}
+
+func (m *broadcastManager) IngestOne(ctx context.Context, timelineID string, item Timelineable) (bool, error) {
+	ok, err := m.Manager.IngestOne(ctx, timelineID, item)
+	if ok {
+		m.publish(ctx, broadcastMessage{Op: opRemoveTimeline, TimelineID: timelineID})
+	}
+	return ok, err
+}
+
+func (m *broadcastManager) Remove(ctx context.Context, timelineID string, itemID string) (int, error) {
+	m.publish(ctx, broadcastMessage{Op: opRemove, TimelineID: timelineID, ItemID: itemID})
+	return m.Manager.Remove(ctx, timelineID, itemID)
+}
+
+func (m *broadcastManager) RemoveTimeline(ctx context.Context, timelineID string) error {
+	m.publish(ctx, broadcastMessage{Op: opRemoveTimeline, TimelineID: timelineID})
+	return m.Manager.RemoveTimeline(ctx, timelineID)
+}
+
+func (m *broadcastManager) WipeItemFromAllTimelines(ctx context.Context, itemID string) error {
+	m.publish(ctx, broadcastMessage{Op: opWipeItemFromAllTimelines, ItemID: itemID})
+	return m.Manager.WipeItemFromAllTimelines(ctx, itemID)
+}
+
+func (m *broadcastManager) WipeItemsFromAccountID(ctx context.Context, timelineID string, accountID string) error {
+	m.publish(ctx, broadcastMessage{Op: opWipeItemsFromAccountID, TimelineID: timelineID, AccountID: accountID})
+	return m.Manager.WipeItemsFromAccountID(ctx, timelineID, accountID)
+}
+
+func (m *broadcastManager) UnprepareItem(ctx context.Context, timelineID string, itemID string) error {
+	m.publish(ctx, broadcastMessage{Op: opUnprepareItem, TimelineID: timelineID, ItemID: itemID})
+	return m.Manager.UnprepareItem(ctx, timelineID, itemID)
+}
+
+func (m *broadcastManager) UnprepareItemFromAllTimelines(ctx context.Context, itemID string) error {
+	m.publish(ctx, broadcastMessage{Op: opUnprepareItemFromAllTimelines, ItemID: itemID})
+	return m.Manager.UnprepareItemFromAllTimelines(ctx, itemID)
+}
+
+func (m *broadcastManager) Stop() error {
+	m.cncl()
+	if err := m.bus.Close(); err != nil {
+		log.Errorf(nil, "error closing timeline bus: %v", err)
+	}
+	return m.Manager.Stop()
+}
+
+// publish publishes msg on the bus.
+func (m *broadcastManager) publish(ctx context.Context, msg broadcastMessage) {
+	msg.Origin = m.origin
+
+	b, err := json.Marshal(msg)
+	if err != nil {
+		log.Errorf(ctx, "error marshaling timeline message: %v", err)
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	if err := m.bus.Publish(ctx, string(b)); err != nil {
+		log.Errorf(ctx, "error publishing timeline message: %v", err)
+	}
+}
+
+// onMessage applies a timeline operation received
+// over the bus to the wrapped (local) manager only.
+func (m *broadcastManager) onMessage(ctx context.Context, payload string) {
+	var msg broadcastMessage
+
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		log.Errorf(ctx, "error unmarshaling timeline message: %v", err)
+		return
+	}
+
+	if msg.Origin == m.origin {
+		// Our own message,
+		// already applied.
+		return
+	}
+
+	var err error
+	switch msg.Op {
+	case opRemove:
+		_, err = m.Manager.Remove(ctx, msg.TimelineID, msg.ItemID)
+	case opRemoveTimeline:
+		err = m.Manager.RemoveTimeline(ctx, msg.TimelineID)
+	case opWipeItemFromAllTimelines:
+		err = m.Manager.WipeItemFromAllTimelines(ctx, msg.ItemID)
+	case opWipeItemsFromAccountID:
+		err = m.Manager.WipeItemsFromAccountID(ctx, msg.TimelineID, msg.AccountID)
+	case opUnprepareItem:
+		err = m.Manager.UnprepareItem(ctx, msg.TimelineID, msg.ItemID)
+	case opUnprepareItemFromAllTimelines:
+		err = m.Manager.UnprepareItemFromAllTimelines(ctx, msg.ItemID)
+	default:
+		log.Warnf(ctx, "received unknown timeline operation %s", msg.Op)
+		return
+	}
+
+	if err != nil {
+		log.Errorf(ctx, "error applying timeline operation %s: %v", msg.Op, err)
+	}
+}
@@ -0,0 +1,188 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package totp implements time-based one-time
+// passwords as described in RFC 6238, using the
+// parameters that all common authenticator apps
+// understand: HMAC-SHA1, 6 digits, 30 second steps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 -- SHA1 is what RFC 6238 and authenticator apps use.
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the number of digits in a generated code.
+	Digits = 6
+
+	// Period is the lifetime of one code.
+	Period = 30 * time.Second
+
+	// Skew is the number of periods either side
+	// of the current one for which a code is still
+	// accepted, to allow for clock drift.
+	Skew = 1
+
+	// secretSize is the size in bytes of
+	// generated secrets, as recommended
+	// by RFC 4226 for HMAC-SHA1.
+	secretSize = 20
+)
+
+// encoding is the base32 encoding used for secrets,
+// unpadded as expected by authenticator apps.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a new randomly
+// generated, base32-encoded secret.
+func NewSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error reading random bytes: %w", err)
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Code returns the code for the given
+// base32-encoded secret at the given time.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, counter(t)), nil
+}
+
+// Validate checks whether the given code is valid for the
+// given base32-encoded secret at time now, allowing for Skew
+// periods of clock drift. If so, it returns true along with
+// the time step counter that the code was generated for.
+//
+// Codes for counters at or before lastCounter are rejected,
+// so that a code can't be replayed once it has been used:
+// callers should store the returned counter, and pass it
+// as lastCounter the next time they validate a code.
+func Validate(secret string, code string, now time.Time, lastCounter uint64) (uint64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	c := counter(now)
+	for i := -Skew; i <= Skew; i++ {
+		at := offset(c, i)
+		if at <= lastCounter {
+			// Already used (or
+			// older), skip it.
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(hotp(key, at)), []byte(code)) == 1 {
+			return at, true
+		}
+	}
+
+	return 0, false
+}
+
+// URI returns an otpauth:// key URI for the given
+// secret, suitable for encoding into a QR code to
+// be scanned by an authenticator app. See:
+// https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func URI(secret string, issuer string, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	u := &url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+// decodeSecret decodes the given base32 secret,
+// tolerating lowercase, spaces and padding as
+// users may have copied it from somewhere.
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(secret)
+	secret = strings.ReplaceAll(secret, " ", "")
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := encoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding secret: %w", err)
+	}
+
+	if len(key) == 0 {
+		return nil, errors.New("empty secret")
+	}
+
+	return key, nil
+}
+
+// counter returns the RFC 6238
+// time step counter for time t.
+func counter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(Period.Seconds()) // #nosec G115 -- times before 1970 aren't a concern.
+}
+
+// offset returns counter c offset by i steps.
+func offset(c uint64, i int) uint64 {
+	if i < 0 {
+		return c - uint64(-i) // #nosec G115 -- i is bounded by Skew.
+	}
+	return c + uint64(i) // #nosec G115 -- i is bounded by Skew.
+}
+
+// hotp implements the HOTP algorithm from
+// RFC 4226 for the given key and counter.
+func hotp(key []byte, c uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], c)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation.
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, bin%1000000)
+}
@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package totp_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+type TOTPTestSuite struct {
+	suite.Suite
+}
+
+// rfcSecret is the SHA1 test seed from RFC 6238 Appendix B.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func (suite *TOTPTestSuite) TestCodeRFCVectors() {
+	// Test vectors from RFC 6238 Appendix B,
+	// truncated to the last 6 digits.
+	for unix, expect := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		code, err := totp.Code(rfcSecret, time.Unix(unix, 0))
+		suite.NoError(err)
+		suite.Equal(expect, code, "unix time %d", unix)
+	}
+}
+
+func (suite *TOTPTestSuite) TestValidate() {
+	secret, err := totp.NewSecret()
+	suite.NoError(err)
+
+	now := time.Now()
+	code, err := totp.Code(secret, now)
+	suite.NoError(err)
+
+	// Current code is valid now, and within skew.
+	counter, ok := totp.Validate(secret, code, now, 0)
+	suite.True(ok)
+	suite.Equal(uint64(now.Unix())/30, counter)
+
+	skewCounter, ok := totp.Validate(secret, code, now.Add(totp.Period), 0)
+	suite.True(ok)
+	suite.Equal(counter, skewCounter)
+
+	_, ok = totp.Validate(secret, " "+code+" ", now, 0)
+	suite.True(ok)
+
+	// Too far in the future.
+	_, ok = totp.Validate(secret, code, now.Add(3*totp.Period), 0)
+	suite.False(ok)
+
+	// Garbage codes.
+	_, ok = totp.Validate(secret, "", now, 0)
+	suite.False(ok)
+	_, ok = totp.Validate(secret, "12345", now, 0)
+	suite.False(ok)
+	_, ok = totp.Validate("not base32!", code, now, 0)
+	suite.False(ok)
+}
+
+func (suite *TOTPTestSuite) TestValidateReplay() {
+	secret, err := totp.NewSecret()
+	suite.NoError(err)
+
+	now := time.Now()
+	code, err := totp.Code(secret, now)
+	suite.NoError(err)
+
+	counter, ok := totp.Validate(secret, code, now, 0)
+	suite.True(ok)
+
+	// Once used, the code can't be used
+	// again, even later within the skew.
+	_, ok = totp.Validate(secret, code, now, counter)
+	suite.False(ok)
+	_, ok = totp.Validate(secret, code, now.Add(totp.Period), counter)
+	suite.False(ok)
+
+	// Nor can an older code.
+	oldCode, err := totp.Code(secret, now.Add(-totp.Period))
+	suite.NoError(err)
+	_, ok = totp.Validate(secret, oldCode, now, counter)
+	suite.False(ok)
+
+	// But the next one can.
+	nextCode, err := totp.Code(secret, now.Add(totp.Period))
+	suite.NoError(err)
+	nextCounter, ok := totp.Validate(secret, nextCode, now, counter)
+	suite.True(ok)
+	suite.Equal(counter+1, nextCounter)
+}
+
+func (suite *TOTPTestSuite) TestURI() {
+	uri := totp.URI("JBSWY3DPEHPK3PXP", "example.org", "someone")
+	suite.Equal("otpauth://totp/example.org:someone?algorithm=SHA1&digits=6&issuer=example.org&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
+
+func TestTOTPTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPTestSuite))
+}
@@ -20,8 +20,10 @@
 package tracing
 
 import (
+	"context"
 	"errors"
 
+	"codeberg.org/gruf/go-kv"
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/uptrace/bun"
@@ -41,3 +43,11 @@ func InstrumentGin() gin.HandlerFunc {
 func InstrumentBun() bun.QueryHook {
 	return nil
 }
+
+func StartSpan(ctx context.Context, name string, host string, fields ...kv.Field) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
+
+func StartClientSpan(ctx context.Context, name string, host string, fields ...kv.Field) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
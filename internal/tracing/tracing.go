@@ -29,6 +29,7 @@
 	"github.com/uptrace/bun/extra/bunotel"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
@@ -188,3 +189,49 @@ func InstrumentBun() bun.QueryHook {
 		bunotel.WithFormattedQueries(true),
 	)
 }
+
+// StartSpan starts a new internal span with name, as a child of any span
+// in ctx, for work relating to the given remote host. Any given fields are
+// added as span attributes. The returned function must be called with the
+// resulting error (if any) to end the span.
+func StartSpan(ctx context.Context, name string, host string, fields ...kv.Field) (context.Context, func(error)) {
+	return startSpan(ctx, name, host, oteltrace.SpanKindInternal, fields)
+}
+
+// StartClientSpan is as StartSpan, but for
+// an outgoing request to the given remote host.
+func StartClientSpan(ctx context.Context, name string, host string, fields ...kv.Field) (context.Context, func(error)) {
+	return startSpan(ctx, name, host, oteltrace.SpanKindClient, fields)
+}
+
+func startSpan(
+	ctx context.Context,
+	name string,
+	host string,
+	kind oteltrace.SpanKind,
+	fields []kv.Field,
+) (context.Context, func(error)) {
+	tracer := otel.GetTracerProvider().Tracer(
+		tracerName,
+		oteltrace.WithInstrumentationVersion(config.GetSoftwareVersion()),
+	)
+
+	attrs := make([]attribute.KeyValue, 0, 1+len(fields))
+	attrs = append(attrs, attribute.String("remote.host", host))
+	for _, field := range fields {
+		attrs = append(attrs, attribute.String(field.K, fmt.Sprint(field.V)))
+	}
+
+	ctx, span := tracer.Start(ctx, name,
+		oteltrace.WithSpanKind(kind),
+		oteltrace.WithAttributes(attrs...),
+	)
+
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
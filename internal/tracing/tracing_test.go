@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !notracing
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// spanRecorder is a span processor
+// that keeps all ended spans in memory.
+type spanRecorder struct {
+	mu    sync.Mutex
+	ended []sdktrace.ReadOnlySpan
+}
+
+func (r *spanRecorder) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (r *spanRecorder) Shutdown(context.Context) error                  { return nil }
+func (r *spanRecorder) ForceFlush(context.Context) error                { return nil }
+
+func (r *spanRecorder) OnEnd(s sdktrace.ReadOnlySpan) {
+	r.mu.Lock()
+	r.ended = append(r.ended, s)
+	r.mu.Unlock()
+}
+
+func (r *spanRecorder) Ended() []sdktrace.ReadOnlySpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ended
+}
+
+// setupRecorder sets a tracer provider recording
+// spans to the returned recorder, restoring the
+// previous global tracer provider on cleanup.
+func setupRecorder(t *testing.T) *spanRecorder {
+	recorder := new(spanRecorder)
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(recorder),
+	))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return recorder
+}
+
+// attrs returns the attributes of span as a map.
+func attrs(span sdktrace.ReadOnlySpan) map[attribute.Key]string {
+	m := make(map[attribute.Key]string)
+	for _, attr := range span.Attributes() {
+		m[attr.Key] = attr.Value.Emit()
+	}
+	return m
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	// Start parent span, then child
+	// client span that errors.
+	ctx, endParent := tracing.StartSpan(context.Background(),
+		"federation.process_inbox", "example.org",
+		kv.Field{K: "activityType", V: "Create"},
+	)
+	_, endChild := tracing.StartClientSpan(ctx,
+		"federation.dereference", "other.example",
+		kv.Field{K: "url", V: "https://other.example/users/someone"},
+	)
+	endChild(errors.New("remote said no"))
+	endParent(nil)
+
+	ended := recorder.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(ended))
+	}
+	child, parent := ended[0], ended[1]
+
+	// Check parent span.
+	if parent.Name() != "federation.process_inbox" {
+		t.Errorf("unexpected parent span name %q", parent.Name())
+	}
+	if parent.SpanKind() != oteltrace.SpanKindInternal {
+		t.Errorf("unexpected parent span kind %s", parent.SpanKind())
+	}
+	if parent.Status().Code != codes.Unset {
+		t.Errorf("unexpected parent span status %s", parent.Status().Code)
+	}
+	if a := attrs(parent); a["remote.host"] != "example.org" || a["activityType"] != "Create" {
+		t.Errorf("unexpected parent span attributes %v", a)
+	}
+
+	// Check child span.
+	if child.Name() != "federation.dereference" {
+		t.Errorf("unexpected child span name %q", child.Name())
+	}
+	if child.SpanKind() != oteltrace.SpanKindClient {
+		t.Errorf("unexpected child span kind %s", child.SpanKind())
+	}
+	if child.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("expected child span to be a child of parent span")
+	}
+	if child.Status().Code != codes.Error || child.Status().Description != "remote said no" {
+		t.Errorf("unexpected child span status %v", child.Status())
+	}
+	if len(child.Events()) != 1 || child.Events()[0].Name != "exception" {
+		t.Errorf("expected error to be recorded on child span, got events %v", child.Events())
+	}
+	if a := attrs(child); a["remote.host"] != "other.example" || a["url"] != "https://other.example/users/someone" {
+		t.Errorf("unexpected child span attributes %v", a)
+	}
+}
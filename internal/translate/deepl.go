@@ -0,0 +1,119 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+const (
+	deepLFreeEndpoint = "https://api-free.deepl.com"
+	deepLProEndpoint  = "https://api.deepl.com"
+)
+
+// deepL translates texts
+// using the DeepL API.
+//
+// See: https://developers.deepl.com/docs/api-reference/translate
+type deepL struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+func newDeepL(endpoint string, apiKey string, client *http.Client) *deepL {
+	if endpoint == "" {
+		// Keys for the free API always
+		// end with ":fx", and only work
+		// against the free endpoint.
+		if strings.HasSuffix(apiKey, ":fx") {
+			endpoint = deepLFreeEndpoint
+		} else {
+			endpoint = deepLProEndpoint
+		}
+	}
+
+	return &deepL{
+		url:    strings.TrimSuffix(endpoint, "/") + "/v2/translate",
+		apiKey: apiKey,
+		client: client,
+	}
+}
+
+func (d *deepL) Provider() string {
+	return "DeepL.com"
+}
+
+func (d *deepL) Translate(
+	ctx context.Context,
+	texts []string,
+	sourceLang string,
+	targetLang string,
+) (*Result, error) {
+	body := struct {
+		Text        []string `json:"text"`
+		SourceLang  string   `json:"source_lang,omitempty"`
+		TargetLang  string   `json:"target_lang"`
+		TagHandling string   `json:"tag_handling"`
+	}{
+		Text:        texts,
+		SourceLang:  strings.ToUpper(sourceLang),
+		TargetLang:  strings.ToUpper(targetLang),
+		TagHandling: "html",
+	}
+
+	header := http.Header{
+		"Authorization": {"DeepL-Auth-Key " + d.apiKey},
+	}
+
+	var result struct {
+		Translations []struct {
+			DetectedSourceLanguage string `json:"detected_source_language"`
+			Text                   string `json:"text"`
+		} `json:"translations"`
+	}
+
+	if err := postJSON(ctx, d.client, d.url, header, body, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Translations) != len(texts) {
+		return nil, gtserror.Newf(
+			"expected %d translations, got %d",
+			len(texts), len(result.Translations),
+		)
+	}
+
+	res := &Result{
+		Texts:          make([]string, len(texts)),
+		SourceLanguage: sourceLang,
+	}
+
+	for i, t := range result.Translations {
+		res.Texts[i] = t.Text
+		if res.SourceLanguage == "" {
+			res.SourceLanguage = strings.ToLower(t.DetectedSourceLanguage)
+		}
+	}
+
+	return res, nil
+}
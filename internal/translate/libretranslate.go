@@ -0,0 +1,106 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// libreTranslate translates texts
+// using a LibreTranslate instance.
+//
+// See: https://libretranslate.com/docs
+type libreTranslate struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+func newLibreTranslate(endpoint string, apiKey string, client *http.Client) *libreTranslate {
+	return &libreTranslate{
+		url:    strings.TrimSuffix(endpoint, "/") + "/translate",
+		apiKey: apiKey,
+		client: client,
+	}
+}
+
+func (l *libreTranslate) Provider() string {
+	return "LibreTranslate"
+}
+
+func (l *libreTranslate) Translate(
+	ctx context.Context,
+	texts []string,
+	sourceLang string,
+	targetLang string,
+) (*Result, error) {
+	source := sourceLang
+	if source == "" {
+		source = "auto"
+	}
+
+	body := struct {
+		Q      []string `json:"q"`
+		Source string   `json:"source"`
+		Target string   `json:"target"`
+		Format string   `json:"format"`
+		APIKey string   `json:"api_key,omitempty"`
+	}{
+		Q:      texts,
+		Source: source,
+		Target: targetLang,
+		Format: "html",
+		APIKey: l.apiKey,
+	}
+
+	var result struct {
+		TranslatedText   []string `json:"translatedText"`
+		DetectedLanguage []struct {
+			Language string `json:"language"`
+		} `json:"detectedLanguage"`
+	}
+
+	if err := postJSON(ctx, l.client, l.url, nil, body, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.TranslatedText) != len(texts) {
+		return nil, gtserror.Newf(
+			"expected %d translations, got %d",
+			len(texts), len(result.TranslatedText),
+		)
+	}
+
+	res := &Result{
+		Texts:          result.TranslatedText,
+		SourceLanguage: sourceLang,
+	}
+
+	// Detected language is only
+	// included for source "auto".
+	if res.SourceLanguage == "" &&
+		len(result.DetectedLanguage) > 0 {
+		res.SourceLanguage = result.DetectedLanguage[0].Language
+	}
+
+	return res, nil
+}
@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// ErrUnsupported is returned by Translate when the
+// provider doesn't support translating between the
+// given languages (or doesn't know one of them at all).
+var ErrUnsupported = errors.New("translation not supported for given languages")
+
+// Result contains texts translated by a
+// provider, in the order they were given.
+type Result struct {
+	// Translated texts, one per given text.
+	Texts []string
+
+	// Language of the given texts, either as
+	// given by the caller, or as detected by
+	// the provider if none was given.
+	SourceLanguage string
+}
+
+// Translator wraps logic for translating
+// texts using one translation provider.
+type Translator interface {
+	// Provider returns the human-readable
+	// name of the translation provider,
+	// suitable for showing to users.
+	Provider() string
+
+	// Translate translates the given HTML texts from
+	// sourceLang to targetLang, both ISO 639 language
+	// codes. If sourceLang is empty, the provider
+	// will attempt to detect it.
+	//
+	// Returns ErrUnsupported if the provider can't
+	// translate between the given languages, or
+	// another error if the provider couldn't be reached.
+	Translate(ctx context.Context, texts []string, sourceLang string, targetLang string) (*Result, error)
+}
+
+// New returns a new Translator using the
+// translation-* config values, or nil if
+// no translation provider is configured.
+func New() Translator {
+	var (
+		endpoint = config.GetTranslationEndpoint()
+		apiKey   = config.GetTranslationAPIKey()
+		client   = &http.Client{Timeout: 10 * time.Second}
+	)
+
+	switch config.GetTranslationProvider() {
+	case config.TranslationProviderDeepL:
+		return newDeepL(endpoint, apiKey, client)
+
+	case config.TranslationProviderLibreTranslate:
+		return newLibreTranslate(endpoint, apiKey, client)
+
+	default:
+		return nil
+	}
+}
+
+// postJSON posts the given body as JSON to the given
+// url with any extra given headers, and decodes the
+// JSON response into out.
+//
+// 400 Bad Request responses are assumed to be caused
+// by unsupported languages, as every other part of
+// the request is up to us.
+func postJSON(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	header http.Header,
+	body any,
+	out any,
+) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return gtserror.Newf("error encoding request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		url,
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return gtserror.Newf("error creating request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		return gtserror.Newf("error calling translation provider: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		// All good.
+
+	case http.StatusBadRequest:
+		b, _ := io.ReadAll(io.LimitReader(rsp.Body, 256))
+		return gtserror.Newf("%w: %s", ErrUnsupported, b)
+
+	default:
+		b, _ := io.ReadAll(io.LimitReader(rsp.Body, 256))
+		return gtserror.Newf("translation provider responded %s: %s", rsp.Status, b)
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(out); err != nil {
+		return gtserror.Newf("error decoding translation provider response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+func TestNew(t *testing.T) {
+	for _, test := range []struct {
+		provider string
+		apiKey   string
+		name     string
+		url      string
+	}{
+		{provider: ""},
+		{provider: config.TranslationProviderDeepL, apiKey: "some-key:fx", name: "DeepL.com", url: "https://api-free.deepl.com/v2/translate"},
+		{provider: config.TranslationProviderDeepL, apiKey: "some-key", name: "DeepL.com", url: "https://api.deepl.com/v2/translate"},
+		{provider: config.TranslationProviderLibreTranslate, name: "LibreTranslate", url: "https://translate.example.org/translate"},
+	} {
+		test := test // loopvar capture
+		t.Run(test.provider, func(t *testing.T) {
+			config.SetTranslationProvider(test.provider)
+			config.SetTranslationAPIKey(test.apiKey)
+			if test.provider == config.TranslationProviderLibreTranslate {
+				config.SetTranslationEndpoint("https://translate.example.org/")
+			} else {
+				config.SetTranslationEndpoint("")
+			}
+
+			tr := New()
+			switch {
+			case test.provider == "" && tr != nil:
+				t.Fatal("expected nil translator when no provider set")
+			case test.provider == "":
+				return
+			case tr == nil:
+				t.Fatalf("expected translator for provider %s, got nil", test.provider)
+			}
+
+			if tr.Provider() != test.name {
+				t.Fatalf("got provider %s, wanted %s", tr.Provider(), test.name)
+			}
+
+			var url string
+			switch tr := tr.(type) {
+			case *deepL:
+				url = tr.url
+			case *libreTranslate:
+				url = tr.url
+			}
+
+			if url != test.url {
+				t.Fatalf("got url %s, wanted %s", url, test.url)
+			}
+		})
+	}
+}
+
+func TestTranslateDeepL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "DeepL-Auth-Key some-key" {
+			t.Errorf("got authorization %s, wanted DeepL-Auth-Key some-key", got)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding body: %v", err)
+		}
+
+		if body["target_lang"] != "EN" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Value for 'target_lang' not supported."}`))
+			return
+		}
+
+		if _, ok := body["source_lang"]; ok {
+			t.Errorf("expected no source_lang, got %v", body["source_lang"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"translations":[{"detected_source_language":"DE","text":"<p>Hello world</p>"},{"detected_source_language":"DE","text":"Greeting"}]}`))
+	}))
+	defer srv.Close()
+
+	tr := newDeepL(srv.URL, "some-key", srv.Client())
+
+	res, err := tr.Translate(context.Background(), []string{"<p>Hallo Welt</p>", "Gruß"}, "", "en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(res.Texts) != 2 || res.Texts[0] != "<p>Hello world</p>" || res.Texts[1] != "Greeting" {
+		t.Fatalf("unexpected translated texts: %v", res.Texts)
+	}
+
+	if res.SourceLanguage != "de" {
+		t.Fatalf("got source language %s, wanted de", res.SourceLanguage)
+	}
+
+	_, err = tr.Translate(context.Background(), []string{"Hallo"}, "", "tlh")
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+}
+
+func TestTranslateLibreTranslate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/translate" {
+			t.Errorf("got path %s, wanted /translate", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding body: %v", err)
+		}
+
+		if body["source"] != "auto" {
+			t.Errorf("got source %v, wanted auto", body["source"])
+		}
+
+		if body["api_key"] != "some-key" {
+			t.Errorf("got api_key %v, wanted some-key", body["api_key"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch body["target"] {
+		case "en":
+			_, _ = w.Write([]byte(`{"translatedText":["<p>Hello world</p>"],"detectedLanguage":[{"confidence":90,"language":"de"}]}`))
+		case "fr":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"oh no"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"tlh is not supported"}`))
+		}
+	}))
+	defer srv.Close()
+
+	tr := newLibreTranslate(srv.URL, "some-key", srv.Client())
+
+	res, err := tr.Translate(context.Background(), []string{"<p>Hallo Welt</p>"}, "", "en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(res.Texts) != 1 || res.Texts[0] != "<p>Hello world</p>" {
+		t.Fatalf("unexpected translated texts: %v", res.Texts)
+	}
+
+	if res.SourceLanguage != "de" {
+		t.Fatalf("got source language %s, wanted de", res.SourceLanguage)
+	}
+
+	_, err = tr.Translate(context.Background(), []string{"Hallo"}, "", "tlh")
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+
+	_, err = tr.Translate(context.Background(), []string{"Hallo"}, "", "fr")
+	switch {
+	case err == nil:
+		t.Fatal("expected error, got nil")
+	case errors.Is(err, ErrUnsupported):
+		t.Fatalf("expected non-ErrUnsupported error, got %v", err)
+	}
+}
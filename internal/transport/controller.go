@@ -28,6 +28,7 @@
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-byteutil"
 	"codeberg.org/gruf/go-cache/v3"
@@ -36,6 +37,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 )
 
@@ -46,6 +48,10 @@ type Controller interface {
 
 	// NewTransportForUsername searches for account with username, and returns result of .NewTransport().
 	NewTransportForUsername(ctx context.Context, username string) (Transport, error)
+
+	// SetRFC9421Support records whether the given remote host is known to accept RFC 9421
+	// http message signatures, which determines how outgoing requests to it are signed.
+	SetRFC9421Support(host string, supported bool)
 }
 
 type controller struct {
@@ -55,6 +61,10 @@ type controller struct {
 	client    pub.HttpClient
 	trspCache cache.TTLCache[string, *transport]
 	userAgent string
+
+	// rfc9421 caches per-host support for
+	// RFC 9421 http message signatures.
+	rfc9421 cache.TTLCache[string, bool]
 }
 
 // NewController returns an implementation of the Controller interface for creating new transports
@@ -72,6 +82,15 @@ func NewController(state *state.State, federatingDB federatingdb.DB, clock pub.C
 		client:    client,
 		trspCache: cache.NewTTL[string, *transport](0, 100, 0),
 		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)", version, proto, host),
+		rfc9421:   cache.NewTTL[string, bool](0, 1000, 0),
+	}
+
+	// Remember negotiated signature support for
+	// a day, after which we renegotiate, in case
+	// the remote software has since been updated.
+	c.rfc9421.SetTTL(24*time.Hour, false)
+	if !c.rfc9421.Start(time.Minute) {
+		log.Panic(nil, "failed to start transport controller cache")
 	}
 
 	return c
@@ -140,6 +159,20 @@ func (c *controller) NewTransportForUsername(ctx context.Context, username strin
 	return transport, nil
 }
 
+func (c *controller) SetRFC9421Support(host string, supported bool) {
+	c.rfc9421.Set(host, supported)
+}
+
+// useRFC9421 returns whether outgoing requests to host
+// should be signed using RFC 9421 http message signatures.
+// Hosts default to draft-cavage signatures until support
+// has been positively negotiated, as that is still by far
+// the most widely supported scheme in the fediverse.
+func (c *controller) useRFC9421(host string) bool {
+	supported, _ := c.rfc9421.Get(host)
+	return supported
+}
+
 // dereferenceLocalFollowers is a shortcut to dereference followers of an
 // account on this instance, without making any external api/http calls.
 //
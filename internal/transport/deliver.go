@@ -25,6 +25,7 @@
 	"net/http"
 	"net/url"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -46,6 +47,9 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 		host   = config.GetHost()
 	)
 
+	// Attach integrity proof, if enabled.
+	obj = t.withIntegrityProof(ctx, obj)
+
 	// Marshal object as JSON.
 	b, err := json.Marshal(obj)
 	if err != nil {
@@ -57,6 +61,10 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 	objID := getObjectID(obj)
 	tgtID := getTargetID(obj)
 
+	// Get follower synchronization
+	// header func, if applicable.
+	sync := t.followersSync(ctx, obj)
+
 	for _, to := range recipients {
 		// Skip delivery to recipient if it is "us".
 		if to.Host == host || to.Host == domain {
@@ -76,6 +84,11 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 			continue
 		}
 
+		if sync != nil {
+			// Add follower synchronization header for recipient.
+			req.Request.Header.Set(ap.CollectionSynchronizationHeader, sync(to.Host))
+		}
+
 		// Append to request queue.
 		reqs = append(reqs, req)
 	}
@@ -93,6 +106,9 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 		return nil
 	}
 
+	// Attach integrity proof, if enabled.
+	obj = t.withIntegrityProof(ctx, obj)
+
 	// Marshal object as JSON.
 	b, err := json.Marshal(obj)
 	if err != nil {
@@ -111,6 +127,11 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 		return err
 	}
 
+	if sync := t.followersSync(ctx, obj); sync != nil {
+		// Add follower synchronization header for recipient.
+		req.Request.Header.Set(ap.CollectionSynchronizationHeader, sync(to.Host))
+	}
+
 	// Push prepared request to the delivery queue.
 	t.controller.state.Workers.Delivery.Queue.Push(req)
 
@@ -0,0 +1,165 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !nometrics
+
+package delivery_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	// The delivery counter is created against the global
+	// meter provider, which only delegates to the first
+	// provider set, so only ever set one for these tests.
+	reader     = sdk.NewManualReader()
+	readerOnce sync.Once
+)
+
+func TestDeliveryMetrics(t *testing.T) {
+	readerOnce.Do(func() {
+		otel.SetMeterProvider(sdk.NewMeterProvider(sdk.WithReader(reader)))
+	})
+
+	// Start a listener that accepts every delivery.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	srv := new(http.Server)
+	srv.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusAccepted)
+	})
+	go srv.Serve(l)
+	defer srv.Close()
+
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	var (
+		okHost  = "127.0.0.1:" + port
+		badHost = "127.0.0.2:" + port
+	)
+
+	// Counts are cumulative, so expect
+	// these deliveries on top of any
+	// from earlier runs of this test.
+	expect := collectDeliveries(t)
+	expect[deliveryKey(okHost, metrics.DeliverySuccess)] += 2
+	expect[deliveryKey(badHost, metrics.DeliveryFailed)] += 1
+
+	wp := new(delivery.WorkerPool)
+	wp.Init(httpclient.New(httpclient.Config{
+		// Only allow the first loopback address, so
+		// deliveries to the second fail without retry.
+		AllowRanges: config.MustParseIPPrefixes([]string{
+			"127.0.0.1/32",
+		}),
+	}))
+	wp.Start(1)
+	defer wp.Stop()
+
+	for _, host := range []string{okHost, badHost, okHost} {
+		req, err := http.NewRequest(http.MethodPost, "http://"+host+"/inbox", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dlv := new(delivery.Delivery)
+		dlv.Request = httpclient.WrapRequest(req)
+		wp.Queue.Push(dlv)
+	}
+
+	// Deliveries are recorded asynchronously
+	// by the worker, so poll until all are in.
+	var counts map[attribute.Distinct]int64
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+		counts = collectDeliveries(t)
+		if equalCounts(counts, expect) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("expected delivery counts %v, got %v", expect, counts)
+}
+
+// collectDeliveries returns the current delivery
+// counts from reader, by domain and outcome.
+func collectDeliveries(t *testing.T) map[attribute.Distinct]int64 {
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[attribute.Distinct]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "gotosocial.federation.deliveries" {
+				continue
+			}
+
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				t.Fatalf("unexpected metric data type %T", m.Data)
+			}
+
+			for _, dp := range sum.DataPoints {
+				counts[dp.Attributes.Equivalent()] = dp.Value
+			}
+		}
+	}
+
+	return counts
+}
+
+// deliveryKey returns the attribute key
+// for deliveries to domain with outcome.
+func deliveryKey(domain string, outcome string) attribute.Distinct {
+	set := attribute.NewSet(
+		attribute.String("domain", domain),
+		attribute.String("outcome", outcome),
+	)
+	return set.Equivalent()
+}
+
+func equalCounts(a, b map[attribute.Distinct]int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
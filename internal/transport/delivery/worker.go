@@ -23,12 +23,15 @@
 	"slices"
 	"time"
 
+	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-runners"
 	"codeberg.org/gruf/go-structr"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/queue"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -44,6 +47,11 @@ type WorkerPool struct {
 	// passed to each of delivery pool Worker{}s.
 	Queue queue.StructQueue[*Delivery]
 
+	// DeadLetter is an optional callback passed
+	// to each of delivery pool Worker{}s, called
+	// with deliveries that have permanently failed.
+	DeadLetter func(context.Context, *Delivery, error)
+
 	// internal fields.
 	workers []*Worker
 }
@@ -79,6 +87,7 @@ func (p *WorkerPool) Start(n int) {
 		p.workers[i] = new(Worker)
 		p.workers[i].Client = p.Client
 		p.workers[i].Queue = &p.Queue
+		p.workers[i].DeadLetter = p.DeadLetter
 
 		// Attempt to start worker.
 		// Return bool not useful
@@ -124,6 +133,11 @@ type Worker struct {
 	// that delivery worker will feed from.
 	Queue *queue.StructQueue[*Delivery]
 
+	// DeadLetter is an optional callback
+	// that will be passed any deliveries
+	// that have permanently failed.
+	DeadLetter func(context.Context, *Delivery, error)
+
 	// internal fields.
 	backlog []*Delivery
 	service runners.Service
@@ -201,15 +215,31 @@ func (w *Worker) process(ctx context.Context) bool {
 			}
 		}
 
+		// Wrap this delivery attempt in a tracing span,
+		// restoring the base request context afterwards
+		// so that retries do not nest within each other.
+		base := dlv.Request.Context()
+		spanCtx, end := tracing.StartClientSpan(base,
+			"federation.deliver",
+			dlv.Request.URL.Host,
+			kv.Field{K: "actorID", V: dlv.ActorID},
+			kv.Field{K: "objectID", V: dlv.ObjectID},
+		)
+		dlv.Request.Request = dlv.Request.Request.WithContext(spanCtx)
+
 		// Attempt delivery of AP request.
 		rsp, retry, err := w.Client.DoOnce(
 			dlv.Request,
 		)
 
+		end(err)
+		dlv.Request.Request = dlv.Request.Request.WithContext(base)
+
 		switch {
 		case err == nil:
 			// Ensure body closed.
 			_ = rsp.Body.Close()
+			metrics.RecordDelivery(ctx, dlv.Request.URL.Host, metrics.DeliverySuccess)
 			continue loop
 
 		case errors.Is(err, context.Canceled) &&
@@ -226,13 +256,28 @@ func (w *Worker) process(ctx context.Context) bool {
 			w.Queue.Push(dlv)
 			continue loop
 
+		case errors.Is(err, httpclient.ErrRateLimited):
+			// Domain is being throttled, hold the
+			// delivery back in the backlog until
+			// its rate limit window has reset,
+			// leaving worker free for others.
+			dlv.next = time.Now().Add(dlv.Request.BackOff())
+			w.pushBacklog(dlv)
+			continue loop
+
 		case !retry:
 			// Drop deliveries when no
 			// retry requested, or they
 			// reached max (either).
+			metrics.RecordDelivery(ctx, dlv.Request.URL.Host, metrics.DeliveryFailed)
+			if w.DeadLetter != nil {
+				w.DeadLetter(ctx, dlv, err)
+			}
 			continue loop
 		}
 
+		metrics.RecordDelivery(ctx, dlv.Request.URL.Host, metrics.DeliveryRetry)
+
 		// Determine next delivery attempt.
 		backoff := dlv.Request.BackOff()
 		dlv.next = time.Now().Add(backoff)
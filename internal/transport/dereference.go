@@ -22,13 +22,24 @@
 	"net/http"
 	"net/url"
 
+	"codeberg.org/gruf/go-kv"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 func (t *transport) Dereference(ctx context.Context, iri *url.URL) (*http.Response, error) {
+	ctx, end := tracing.StartClientSpan(ctx, "federation.dereference", iri.Host,
+		kv.Field{K: "url", V: iri},
+	)
+	rsp, err := t.dereference(ctx, iri)
+	end(err)
+	return rsp, err
+}
+
+func (t *transport) dereference(ctx context.Context, iri *url.URL) (*http.Response, error) {
 	// If the request is to us, we can shortcut for
 	// certain URIs rather than going through the normal
 	// request flow, thereby saving time and energy.
@@ -33,11 +33,19 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 func (t *transport) DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error) {
+	ctx, end := tracing.StartClientSpan(ctx, "federation.dereference_instance", iri.Host)
+	i, err := t.dereferenceInstance(ctx, iri)
+	end(err)
+	return i, err
+}
+
+func (t *transport) dereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error) {
 	var i *gtsmodel.Instance
 	var err error
 
@@ -320,7 +328,7 @@ func callNodeInfo(ctx context.Context, t *transport, iri *url.URL) (*apimodel.No
 
 	// Ensure that the incoming request content-type is expected.
 	if ct := resp.Header.Get("Content-Type"); !apiutil.NodeInfo2ContentType(ct) {
-		err := gtserror.Newf("non nodeinfo schema 2.0 response: %s", ct)
+		err := gtserror.Newf("non nodeinfo schema 2.x response: %s", ct)
 		return nil, gtserror.SetMalformed(err)
 	}
 
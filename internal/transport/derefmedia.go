@@ -25,10 +25,21 @@
 
 	"codeberg.org/gruf/go-bytesize"
 	"codeberg.org/gruf/go-iotools"
+	"codeberg.org/gruf/go-kv"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 )
 
 func (t *transport) DereferenceMedia(ctx context.Context, iri *url.URL, maxsz int64) (io.ReadCloser, error) {
+	ctx, end := tracing.StartClientSpan(ctx, "federation.dereference_media", iri.Host,
+		kv.Field{K: "url", V: iri},
+	)
+	rc, err := t.dereferenceMedia(ctx, iri, maxsz)
+	end(err)
+	return rc, err
+}
+
+func (t *transport) dereferenceMedia(ctx context.Context, iri *url.URL, maxsz int64) (io.ReadCloser, error) {
 	// Build IRI just once
 	iriStr := iri.String()
 
@@ -25,9 +25,11 @@
 	"net/http"
 	"net/url"
 
+	"codeberg.org/gruf/go-kv"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -37,17 +39,9 @@
 func (t *transport) webfingerURLFor(targetDomain string) (string, bool) {
 	url := "https://" + targetDomain + "/.well-known/webfinger"
 
-	wc := t.controller.state.Caches.Webfinger
-
-	// We're doing the manual locking/unlocking here to be able to
-	// safely call Cache.Get instead of Get, as the latter updates the
-	// item expiry which we don't want to do here
-	wc.Lock()
-	item, ok := wc.Cache.Get(targetDomain)
-	wc.Unlock()
-
+	cached, ok := t.controller.state.Caches.Webfinger.GetURL(targetDomain)
 	if ok {
-		url = item.Value
+		url = cached
 	}
 
 	return url, ok
@@ -74,6 +68,15 @@ func prepWebfingerReq(ctx context.Context, loc, domain, username string) (*http.
 }
 
 func (t *transport) Finger(ctx context.Context, targetUsername string, targetDomain string) ([]byte, error) {
+	ctx, end := tracing.StartClientSpan(ctx, "federation.webfinger", targetDomain,
+		kv.Field{K: "username", V: targetUsername},
+	)
+	b, err := t.finger(ctx, targetUsername, targetDomain)
+	end(err)
+	return b, err
+}
+
+func (t *transport) finger(ctx context.Context, targetUsername string, targetDomain string) ([]byte, error) {
 	// Remotes seem to prefer having their punycode
 	// domain used in webfinger requests, so let's oblige.
 	punyDomain, err := util.Punify(targetDomain)
@@ -24,6 +24,11 @@
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type FingerTestSuite struct {
@@ -88,8 +93,8 @@ func (suite *FingerTestSuite) TestFingerWithHostMetaCacheStrategy() {
 
 	suite.Equal(1, wc.Len(), "expect webfinger cache to hold one entry")
 	wc.Lock()
-	suite.True(wc.Cache.Has("misconfigured-instance.com"), "expect webfinger cache to have entry for misconfigured-instance.com")
-	ent, _ := wc.Cache.Get("misconfigured-instance.com")
+	suite.True(wc.Cache.Cache.Has("misconfigured-instance.com"), "expect webfinger cache to have entry for misconfigured-instance.com")
+	ent, _ := wc.Cache.Cache.Get("misconfigured-instance.com")
 	wc.Unlock()
 
 	initialTime := ent.Expiry
@@ -103,8 +108,8 @@ func (suite *FingerTestSuite) TestFingerWithHostMetaCacheStrategy() {
 	// there should still only be 1 cache entry
 	suite.Equal(1, wc.Len(), "expect webfinger cache to hold one entry")
 	wc.Lock()
-	suite.True(wc.Cache.Has("misconfigured-instance.com"), "expect webfinger cache to have entry for misconfigured-instance.com")
-	rep, _ := wc.Cache.Get("misconfigured-instance.com")
+	suite.True(wc.Cache.Cache.Has("misconfigured-instance.com"), "expect webfinger cache to have entry for misconfigured-instance.com")
+	rep, _ := wc.Cache.Cache.Get("misconfigured-instance.com")
 	wc.Unlock()
 
 	repeatTime := rep.Expiry
@@ -126,8 +131,8 @@ func (suite *FingerTestSuite) TestFingerWithHostMetaCacheStrategy() {
 	// there should still only be 1 cache entry, because we don't evict from cache on failure
 	suite.Equal(1, wc.Len(), "expect webfinger cache to hold one entry")
 	wc.Lock()
-	suite.True(wc.Cache.Has("misconfigured-instance.com"), "expect webfinger cache to have entry for misconfigured-instance.com")
-	last, _ := wc.Cache.Get("misconfigured-instance.com")
+	suite.True(wc.Cache.Cache.Has("misconfigured-instance.com"), "expect webfinger cache to have entry for misconfigured-instance.com")
+	last, _ := wc.Cache.Cache.Get("misconfigured-instance.com")
 	wc.Unlock()
 
 	lastTime := last.Expiry
@@ -137,6 +142,48 @@ func (suite *FingerTestSuite) TestFingerWithHostMetaCacheStrategy() {
 	suite.Equal(repeatTime, lastTime)
 }
 
+// endedSpans is a span processor
+// that keeps all ended spans in memory.
+type endedSpans []sdktrace.ReadOnlySpan
+
+func (e *endedSpans) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (e *endedSpans) OnEnd(s sdktrace.ReadOnlySpan)                   { *e = append(*e, s) }
+func (e *endedSpans) Shutdown(context.Context) error                  { return nil }
+func (e *endedSpans) ForceFlush(context.Context) error                { return nil }
+
+func (suite *FingerTestSuite) TestFingerSpan() {
+	spans := new(endedSpans)
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	defer otel.SetTracerProvider(prev)
+
+	_, err := suite.transport.Finger(context.Background(), "brand_new_person", "unknown-instance.com")
+	suite.NoError(err)
+
+	_, err = suite.transport.Finger(context.Background(), "invalid", "misconfigured-instance.com")
+	suite.Error(err)
+
+	if !suite.Len(*spans, 2) {
+		suite.FailNow("")
+	}
+
+	for i, expect := range []struct {
+		host     string
+		username string
+		status   codes.Code
+	}{
+		{"unknown-instance.com", "brand_new_person", codes.Unset},
+		{"misconfigured-instance.com", "invalid", codes.Error},
+	} {
+		span := (*spans)[i]
+		suite.Equal("federation.webfinger", span.Name())
+		suite.Equal(oteltrace.SpanKindClient, span.SpanKind())
+		suite.Equal(expect.status, span.Status().Code)
+		suite.Contains(span.Attributes(), attribute.String("remote.host", expect.host))
+		suite.Contains(span.Attributes(), attribute.String("username", expect.username))
+	}
+}
+
 func TestFingerTestSuite(t *testing.T) {
 	suite.Run(t, &FingerTestSuite{})
 }
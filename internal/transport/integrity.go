@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"crypto/ed25519"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// withIntegrityProof returns the given serialized activity with
+// an integrity proof (FEP-8b32) attached, signed by the Ed25519 key
+// of the local actor performing it. If integrity proofs are disabled,
+// or the actor has no such key, then the activity is returned as-is.
+func (t *transport) withIntegrityProof(ctx context.Context, obj map[string]interface{}) map[string]interface{} {
+	if !config.GetInstanceIntegrityProofs() {
+		return obj
+	}
+
+	actorID := getActorID(obj)
+	if actorID == "" {
+		return obj
+	}
+
+	actorIRI, err := url.Parse(actorID)
+	if err != nil || actorIRI.Host != config.GetHost() {
+		// Not a local actor.
+		return obj
+	}
+
+	actor, err := t.controller.state.DB.GetAccountByURI(
+		gtscontext.SetBarebones(ctx),
+		actorID,
+	)
+	if err != nil || !actor.IsLocal() ||
+		actor.Ed25519PrivateKey == nil {
+		return obj
+	}
+
+	secured, err := ap.AddIntegrityProof(obj,
+		ed25519.PrivateKey(actor.Ed25519PrivateKey),
+		actor.Ed25519PublicKeyURI,
+		time.Now(),
+	)
+	if err != nil {
+		log.Errorf(ctx, "error adding integrity proof: %v", err)
+		return obj
+	}
+
+	return secured
+}
@@ -29,6 +29,11 @@
 	// TODO: Update these to use `(created)` pseudo-header instead of `Date`.
 	getHeaders  = []string{httpsig.RequestTarget, "host", "date"}
 	postHeaders = []string{httpsig.RequestTarget, "host", "date", "digest"}
+
+	// POST headers when delivering with a
+	// follower synchronization header (FEP-8fcf),
+	// which must be signed for receivers to trust it.
+	postSyncHeaders = []string{httpsig.RequestTarget, "host", "date", "digest", "collection-synchronization"}
 )
 
 // NewGETSigner returns a new httpsig.Signer instance initialized with GTS GET preferences.
@@ -42,3 +47,10 @@ func NewPOSTSigner(expiresIn int64) (httpsig.SignerWithOptions, error) {
 	sig, _, err := httpsig.NewSigner(prefs, digestAlgo, postHeaders, httpsig.Signature, expiresIn)
 	return sig, err
 }
+
+// NewPOSTSyncSigner returns a new httpsig.Signer instance initialized with GTS POST
+// preferences, additionally signing the FEP-8fcf Collection-Synchronization header.
+func NewPOSTSyncSigner(expiresIn int64) (httpsig.SignerWithOptions, error) {
+	sig, _, err := httpsig.NewSigner(prefs, digestAlgo, postSyncHeaders, httpsig.Signature, expiresIn)
+	return sig, err
+}
@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// followersSync returns a function which gives the value of
+// the Collection-Synchronization header (FEP-8fcf) to use when
+// delivering the given serialized activity to an inbox on the
+// given host. Returns nil if the activity isn't addressed to the
+// followers of the local actor performing it, in which case no
+// header should be added.
+func (t *transport) followersSync(ctx context.Context, obj map[string]interface{}) func(host string) string {
+	actorID := getActorID(obj)
+	if actorID == "" {
+		return nil
+	}
+
+	actorIRI, err := url.Parse(actorID)
+	if err != nil || actorIRI.Host != config.GetHost() {
+		// Not a local actor.
+		return nil
+	}
+
+	actor, err := t.controller.state.DB.GetAccountByURI(
+		gtscontext.SetBarebones(ctx),
+		actorID,
+	)
+	if err != nil || !actor.IsLocal() {
+		return nil
+	}
+
+	if !slices.Contains(getAudienceIDs(obj), actor.FollowersURI) {
+		// Not addressed to followers.
+		return nil
+	}
+
+	follows, err := t.controller.state.DB.GetAccountFollowers(ctx, actor.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting followers of %s: %v", actorID, err)
+		return nil
+	}
+
+	// Group follower URIs by host,
+	// as each receiving instance only
+	// gets the digest of its own followers.
+	byHost := make(map[string][]string)
+	for _, follow := range follows {
+		if follow.Account == nil {
+			continue
+		}
+
+		followerIRI, err := url.Parse(follow.Account.URI)
+		if err != nil {
+			continue
+		}
+
+		host := followerIRI.Host
+		byHost[host] = append(byHost[host], follow.Account.URI)
+	}
+
+	syncURL := uris.GenerateURIForFollowersSync(actor.Username)
+	return func(host string) string {
+		return ap.CollectionSynchronization{
+			CollectionID: actor.FollowersURI,
+			URL:          syncURL,
+			Digest:       ap.FollowersDigest(byHost[host]),
+		}.String()
+	}
+}
+
+// getAudienceIDs extracts to and cc IDs from 'serialized' ActivityPub object map.
+func getAudienceIDs(obj map[string]interface{}) []string {
+	var ids []string
+	for _, key := range []string{"to", "cc"} {
+		switch t := obj[key].(type) {
+		case string:
+			ids = append(ids, t)
+		case []interface{}:
+			for _, v := range t {
+				if id, ok := v.(string); ok {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	return ids
+}
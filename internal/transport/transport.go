@@ -27,9 +27,11 @@
 	"sync"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
 	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 	"github.com/superseriousbusiness/httpsig"
 )
@@ -92,6 +94,7 @@ type transport struct {
 	signerExp  time.Time
 	getSigner  httpsig.SignerWithOptions
 	postSigner httpsig.SignerWithOptions
+	syncSigner httpsig.SignerWithOptions
 	signerMu   sync.Mutex
 }
 
@@ -100,27 +103,66 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 		return nil, errors.New("must be GET request")
 	}
 
+	// Set our predefined controller user-agent.
+	r.Header.Set("User-Agent", t.controller.userAgent)
+
+	host := r.URL.Host
+	if t.controller.useRFC9421(host) {
+		// Remote is known to support RFC 9421,
+		// so try with an http message signature.
+		rsp, err := t.do(r, t.signRFC9421(nil))
+		if err != nil || rsp.StatusCode != http.StatusUnauthorized {
+			return rsp, err
+		}
+
+		// Ignore this response.
+		_ = rsp.Body.Close()
+
+		// Remote has stopped accepting these (eg.
+		// software downgrade), fall back to cavage.
+		t.controller.SetRFC9421Support(host, false)
+	}
+
 	// Prepare HTTP GET signing func with opts.
 	sign := t.signGET(httpsig.SignatureOption{
 		ExcludeQueryStringFromPathPseudoHeader: false,
 	})
 
-	ctx := r.Context() // update with signing details.
-	ctx = gtscontext.SetOutgoingPublicKeyID(ctx, t.pubKeyID)
-	ctx = gtscontext.SetHTTPClientSignFunc(ctx, sign)
-	r = r.WithContext(ctx) // replace request ctx.
+	// Pass to underlying HTTP client.
+	rsp, err := t.do(r, sign)
+	if err != nil {
+		return nil, err
+	}
 
-	// Set our predefined controller user-agent.
-	r.Header.Set("User-Agent", t.controller.userAgent)
+	// Check whether remote advertises that it
+	// accepts RFC 9421 signatures, in which case
+	// prefer them for future requests to this host.
+	acceptsRFC9421 := rsp.Header.Get(rfc9421.AcceptSignatureHeader) != ""
 
-	// Pass to underlying HTTP client.
-	resp, err := t.controller.client.Do(r)
-	if err != nil || resp.StatusCode != http.StatusUnauthorized {
-		return resp, err
+	if rsp.StatusCode != http.StatusUnauthorized {
+		if acceptsRFC9421 {
+			t.controller.SetRFC9421Support(host, true)
+		}
+		return rsp, nil
 	}
 
 	// Ignore this response.
-	_ = resp.Body.Close()
+	_ = rsp.Body.Close()
+
+	if acceptsRFC9421 {
+		// Remote indicated it accepts RFC 9421
+		// signatures, so try again using those.
+		rsp, err = t.do(r, t.signRFC9421(nil))
+		if err != nil || rsp.StatusCode != http.StatusUnauthorized {
+			if err == nil {
+				t.controller.SetRFC9421Support(host, true)
+			}
+			return rsp, err
+		}
+
+		// Ignore this response.
+		_ = rsp.Body.Close()
+	}
 
 	// Try again without the path included in
 	// the HTTP signature for better compatibility.
@@ -128,12 +170,8 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 		ExcludeQueryStringFromPathPseudoHeader: true,
 	})
 
-	ctx = r.Context() // update with signing details.
-	ctx = gtscontext.SetHTTPClientSignFunc(ctx, sign)
-	r = r.WithContext(ctx) // replace request ctx.
-
 	// Pass to underlying HTTP client.
-	return t.controller.client.Do(r)
+	return t.do(r, sign)
 }
 
 func (t *transport) POST(r *http.Request, body []byte) (*http.Response, error) {
@@ -141,17 +179,21 @@ func (t *transport) POST(r *http.Request, body []byte) (*http.Response, error) {
 		return nil, errors.New("must be POST request")
 	}
 
-	// Prepare POST signer.
-	sign := t.signPOST(body)
+	// Set our predefined controller user-agent.
+	r.Header.Set("User-Agent", t.controller.userAgent)
+
+	// Pass to underlying HTTP client with POST signer.
+	return t.do(r, t.signPOST(body))
+}
 
+// do performs the given http request using the
+// underlying http client, signing using given func.
+func (t *transport) do(r *http.Request, sign httpclient.SignFunc) (*http.Response, error) {
 	ctx := r.Context() // update with signing details.
 	ctx = gtscontext.SetOutgoingPublicKeyID(ctx, t.pubKeyID)
 	ctx = gtscontext.SetHTTPClientSignFunc(ctx, sign)
 	r = r.WithContext(ctx) // replace request ctx.
 
-	// Set our predefined controller user-agent.
-	r.Header.Set("User-Agent", t.controller.userAgent)
-
 	// Pass to underlying HTTP client.
 	return t.controller.client.Do(r)
 }
@@ -166,16 +208,34 @@ func (t *transport) signGET(opts httpsig.SignatureOption) httpclient.SignFunc {
 	}
 }
 
-// signPOST will safely sign an HTTP POST request for given body.
+// signPOST will safely sign an HTTP POST request for given body,
+// using RFC 9421 signatures for hosts known to support them.
 func (t *transport) signPOST(body []byte) httpclient.SignFunc {
 	return func(r *http.Request) (err error) {
+		if t.controller.useRFC9421(r.URL.Host) {
+			return t.signRFC9421(body)(r)
+		}
 		t.safesign(func() {
-			err = t.postSigner.SignRequest(t.privkey, t.pubKeyID, r, body)
+			signer := t.postSigner
+			if r.Header.Get(ap.CollectionSynchronizationHeader) != "" {
+				// Include follower synchronization header in signature.
+				signer = t.syncSigner
+			}
+			err = signer.SignRequest(t.privkey, t.pubKeyID, r, body)
 		})
 		return
 	}
 }
 
+// signRFC9421 will sign an HTTP request using RFC 9421
+// http message signatures, covering body if non-nil.
+func (t *transport) signRFC9421(body []byte) httpclient.SignFunc {
+	return func(r *http.Request) error {
+		const expiry = 120 * time.Second
+		return rfc9421.SignRequest(r, t.pubKeyID, t.privkey, body, expiry)
+	}
+}
+
 // safesign will perform sign function within mutex protection,
 // and ensured that httpsig.Signers are up-to-date.
 func (t *transport) safesign(sign func()) {
@@ -189,6 +249,7 @@ func (t *transport) safesign(sign func()) {
 		// Signers have expired and require renewal
 		t.getSigner, _ = NewGETSigner(expiry)
 		t.postSigner, _ = NewPOSTSigner(expiry)
+		t.syncSigner, _ = NewPOSTSyncSigner(expiry)
 		t.signerExp = now.Add(time.Second * expiry)
 	}
 
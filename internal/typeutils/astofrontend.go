@@ -0,0 +1,134 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// ASNoteToStatusCreateRequest converts a Note posted to the outbox of
+// the given local account, via the ActivityPub client-to-server API,
+// into a status create request. This can then be processed exactly
+// as though it were submitted via the client API, so that statuses
+// created either way are formatted, stored and federated identically.
+//
+// Any status replied to must already be stored in the database.
+func (c *Converter) ASNoteToStatusCreateRequest(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	statusable ap.Statusable,
+) (*apimodel.StatusCreateRequest, error) {
+	if typeName := statusable.GetTypeName(); typeName != ap.ObjectNote {
+		err := gtserror.Newf("unsupported object type %s", typeName)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	// Clients may leave out attributedTo, but if
+	// it's set it must be the owner of the outbox.
+	for _, attributedTo := range ap.GetAttributedTo(statusable) {
+		if attributedTo.String() != requester.URI {
+			err := gtserror.Newf("attributedTo %s is not outbox owner", attributedTo)
+			return nil, gtserror.SetMalformed(err)
+		}
+	}
+
+	if attachments := statusable.GetActivityStreamsAttachment(); attachments != nil && attachments.Len() > 0 {
+		err := gtserror.New("attachments are not supported")
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	visibility, err := ap.ExtractVisibility(statusable, requester.FollowersURI)
+	if err != nil {
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	form := &apimodel.StatusCreateRequest{
+		SpoilerText: text.SanitizeToPlaintext(ap.ExtractSummary(statusable)),
+		Sensitive:   ap.ExtractSensitive(statusable),
+		Visibility:  c.VisToAPIVis(ctx, visibility),
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	// Content may be given as content
+	// and / or a contentMap of languages.
+	content := ap.ExtractContent(statusable)
+	form.Status = content.Content
+	for _, lang := range slices.Sorted(maps.Keys(content.ContentMap)) {
+		langContent := content.ContentMap[lang]
+		if form.Status == "" {
+			form.Status = langContent
+		}
+
+		if langContent == form.Status {
+			form.Language = lang
+			break
+		}
+	}
+
+	// Content is HTML, whereas
+	// we need the plain text.
+	form.Status = htmlToPlain(form.Status)
+
+	if inReplyTo := ap.ExtractInReplyToURI(statusable); inReplyTo != nil {
+		uri := inReplyTo.String()
+
+		// Replied-to status may be
+		// referred to by URI or URL.
+		inReplyToStatus, err := c.state.DB.GetStatusByURI(ctx, uri)
+		if errors.Is(err, db.ErrNoEntries) {
+			inReplyToStatus, err = c.state.DB.GetStatusByURL(ctx, uri)
+		}
+
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				err := gtserror.Newf("inReplyTo status %s not found", uri)
+				return nil, gtserror.SetNotFound(err)
+			}
+
+			return nil, gtserror.Newf("db error getting inReplyTo status %s: %w", uri, err)
+		}
+
+		form.InReplyToID = inReplyToStatus.ID
+	}
+
+	return form, nil
+}
+
+// htmlToPlain converts the given (sanitized)
+// HTML content to plain text, preserving line
+// breaks between paragraphs and <br> tags.
+func htmlToPlain(content string) string {
+	content = strings.NewReplacer(
+		"</p><p>", "\n\n",
+		"<br>", "\n",
+		"<br/>", "\n",
+		"<br />", "\n",
+	).Replace(content)
+	return text.SanitizeToPlaintext(content)
+}
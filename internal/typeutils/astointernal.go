@@ -22,6 +22,7 @@
 	"context"
 	"errors"
 	"net/url"
+	"strings"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -30,8 +31,10 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 // ASRepresentationToAccount converts a remote account / person
@@ -405,6 +408,31 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		}
 	}
 
+	// status.QuoteURI
+	// status.QuoteID
+	// status.Quote
+	//
+	// Status that this status quotes, if applicable.
+	// If we don't have this status in the database, we
+	// just set the URI and assume we can deref it later.
+	if quoteURI := ap.ExtractQuoteURI(statusable); quoteURI != nil {
+		status.QuoteURI = quoteURI.String()
+
+		// Check if we already have the quoted status.
+		quote, err := c.state.DB.GetStatusByURI(ctx, status.QuoteURI)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error getting quote %s from db: %w", status.QuoteURI, err)
+			return nil, err
+		}
+
+		if quote != nil {
+			// We have it in the DB! Set
+			// appropriate fields here and now.
+			status.QuoteID = quote.ID
+			status.Quote = quote
+		}
+	}
+
 	// Calculate intended visibility of the status.
 	status.Visibility, err = ap.ExtractVisibility(
 		statusable,
@@ -473,6 +501,17 @@ func (c *Converter) ASFollowToFollowRequest(ctx context.Context, followable ap.F
 		TargetAccountID: target.ID,
 	}
 
+	if withContent, ok := followable.(ap.WithContent); ok {
+		// Some software lets the follower attach
+		// a note to the Follow as its content. Keep
+		// it as plaintext, dropping it if too long.
+		note := ap.ExtractContent(withContent).Content
+		note = text.SanitizeToPlaintext(note)
+		if err := validate.FollowNote(note); err == nil {
+			followRequest.Note = note
+		}
+	}
+
 	return followRequest, nil
 }
 
@@ -542,6 +581,98 @@ func (c *Converter) ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gt
 	}, nil
 }
 
+// ASLikeToReaction converts a remote activitystreams 'like' carrying an
+// emoji reaction into a gts model status reaction, without an ID.
+//
+// If the reaction uses a custom emoji, the Emoji field of the returned
+// reaction will be set to a barebones emoji extracted from the Like's
+// tags, which the caller should then dereference to get an EmojiID.
+func (c *Converter) ASLikeToReaction(ctx context.Context, reactable ap.Reactable) (*gtsmodel.StatusReaction, error) {
+	uriObj := ap.GetJSONLDId(reactable)
+	if uriObj == nil {
+		err := gtserror.New("unusable iri property")
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	// Stringify uri obj.
+	uri := uriObj.String()
+
+	content := ap.ExtractReaction(reactable)
+	if content == "" {
+		err := gtserror.Newf("no reaction content on like %s", uri)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	var (
+		name  string
+		emoji *gtsmodel.Emoji
+	)
+
+	if shortcode, ok := strings.CutPrefix(content, ":"); ok {
+		// Custom emoji, find the
+		// matching emoji in tags.
+		shortcode = strings.TrimSuffix(shortcode, ":")
+
+		emojis, err := ap.ExtractEmojis(reactable)
+		if err != nil {
+			log.Warnf(ctx, "error extracting emojis from like %s: %v", uri, err)
+		}
+
+		for _, e := range emojis {
+			if e.Shortcode == shortcode {
+				emoji = e
+				break
+			}
+		}
+
+		if emoji == nil {
+			err := gtserror.Newf("no emoji tag for reaction %s on like %s", content, uri)
+			return nil, gtserror.SetMalformed(err)
+		}
+
+		if emoji.Domain == config.GetHost() {
+			// One of our own
+			// emojis reflected.
+			emoji.Domain = ""
+		}
+
+		name = emoji.Shortcode
+		if emoji.Domain != "" {
+			name += "@" + emoji.Domain
+		}
+	} else {
+		// Plain unicode emoji.
+		if err := validate.UnicodeEmoji(content); err != nil {
+			err := gtserror.Newf("invalid reaction on like %s: %w", uri, err)
+			return nil, gtserror.SetMalformed(err)
+		}
+
+		name = content
+	}
+
+	origin, err := c.getASActorAccount(ctx, uri, reactable)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := c.getASObjectStatus(ctx, uri, reactable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gtsmodel.StatusReaction{
+		AccountID:       origin.ID,
+		Account:         origin,
+		TargetAccountID: target.AccountID,
+		TargetAccount:   target.Account,
+		StatusID:        target.ID,
+		Status:          target,
+		Name:            name,
+		Emoji:           emoji,
+		URI:             uri,
+	}, nil
+}
+
 // ASBlockToBlock converts a remote activity streams 'block' representation into a gts model block.
 func (c *Converter) ASBlockToBlock(ctx context.Context, blockable ap.Blockable) (*gtsmodel.Block, error) {
 	uriObj := ap.GetJSONLDId(blockable)
@@ -613,16 +744,23 @@ func (c *Converter) ASAnnounceToStatus(
 	boost.URI = uri
 	isNew = true
 
-	// Get the URI of the boosted status.
-	boostOf := ap.GetObjectIRIs(announceable)
-	if len(boostOf) == 0 {
-		err := gtserror.Newf("unusable object property iri for %s", uri)
+	// Get the URI of the boosted status,
+	// unwrapping it from a Create if this
+	// was forwarded by a Group actor.
+	boostOf, err := ap.ExtractAnnounceObjectURI(announceable)
+	if err != nil {
+		if gtserror.IsNotRelevant(err) {
+			// Pass through as-is so
+			// callers can ignore it.
+			return nil, isNew, err
+		}
+		err := gtserror.Newf("unusable object property iri for %s: %w", uri, err)
 		return nil, isNew, gtserror.SetMalformed(err)
 	}
 
 	// Set the URI of the boosted status on
 	// the boost, for later dereferencing.
-	boost.BoostOfURI = boostOf[0].String()
+	boost.BoostOfURI = boostOf.String()
 
 	// Extract published time for the boost,
 	// zero-time will fall back to db defaults.
@@ -797,6 +935,14 @@ func (c *Converter) ASFlagToReport(ctx context.Context, flaggable ap.Flaggable)
 		statuses = append(statuses, status)
 	}
 
+	// Get the category of the report, if given.
+	// Only GoToSocial sets this, so fall back
+	// to "other" if it's missing or unrecognized.
+	category := gtsmodel.ParseReportCategory(ap.GetFlagCategory(flaggable))
+	if category == gtsmodel.ReportCategoryUnknown {
+		category = gtsmodel.ReportCategoryOther
+	}
+
 	// id etc should be handled the caller,
 	// so just return what we got
 	return &gtsmodel.Report{
@@ -806,6 +952,7 @@ func (c *Converter) ASFlagToReport(ctx context.Context, flaggable ap.Flaggable)
 		TargetAccountID: targetAcc.ID,
 		TargetAccount:   targetAcc,
 		Comment:         content,
+		Category:        category,
 		StatusIDs:       statusIDs,
 		Statuses:        statuses,
 	}, nil
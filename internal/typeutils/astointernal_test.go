@@ -30,6 +30,7 @@
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -313,6 +314,7 @@ func (suite *ASToInternalTestSuite) TestParseFlag1() {
 	suite.Len(report.Statuses, 1)
 	suite.Equal(report.Statuses[0].ID, reportedStatus.ID)
 	suite.Equal(report.Comment, "Note: "+reportedStatus.URL+"\n-----\nban this sick filth ⛔")
+	suite.Equal(gtsmodel.ReportCategoryOther, report.Category)
 }
 
 func (suite *ASToInternalTestSuite) TestParseFlag2() {
@@ -472,6 +474,36 @@ func (suite *ASToInternalTestSuite) TestParseFlag6() {
 	suite.Equal(report.Comment, "misinformation")
 }
 
+func (suite *ASToInternalTestSuite) TestParseFlag7() {
+	reportedAccount := suite.testAccounts["local_account_1"]
+	reportingAccount := suite.testAccounts["remote_account_1"]
+
+	// flag with a GoToSocial category
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + reportingAccount.URI + `",
+  "category": "spam",
+  "content": "buy my crypto",
+  "id": "http://fossbros-anonymous.io/db22128d-884e-4358-9935-6a7c3940535d",
+  "object": "` + reportedAccount.URI + `",
+  "type": "Flag"
+}`
+
+	t := suite.jsonToType(raw)
+	asFlag, ok := t.(ap.Flaggable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	report, err := suite.typeconverter.ASFlagToReport(context.Background(), asFlag)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(report.TargetAccountID, reportedAccount.ID)
+	suite.Equal(gtsmodel.ReportCategorySpam, report.Category)
+}
+
 func (suite *ASToInternalTestSuite) TestParseAnnounce() {
 	// Boost a status that belongs to a local account
 	boostingAccount := suite.testAccounts["remote_account_1"]
@@ -512,6 +544,80 @@ func (suite *ASToInternalTestSuite) TestParseAnnounce() {
 	suite.Nil(boost.BoostOfAccount)
 }
 
+func (suite *ASToInternalTestSuite) TestParseAnnounceWrappedCreate() {
+	// A Group actor forwarding a Create
+	// wrapped in an Announce (FEP-1b12).
+	boostingAccount := suite.testAccounts["remote_account_1"]
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + boostingAccount.URI + `",
+  "id": "http://fossbros-anonymous.io/activities/announce/01JF0Y5ZQ0F4TR5W1V3M5E8T6K",
+  "object": {
+    "actor": "` + targetStatus.AccountURI + `",
+    "id": "http://fossbros-anonymous.io/activities/create/01JF0Y6J3H5Q7B8X0C2N4R6T8V",
+    "object": {
+      "attributedTo": "` + targetStatus.AccountURI + `",
+      "id": "` + targetStatus.URI + `",
+      "type": "Page"
+    },
+    "type": "Create"
+  },
+  "type": "Announce",
+  "to": "https://www.w3.org/ns/activitystreams#Public"
+  }`
+
+	t := suite.jsonToType(raw)
+	asAnnounce, ok := t.(ap.Announceable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	boost, isNew, err := suite.typeconverter.ASAnnounceToStatus(context.Background(), asAnnounce)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(isNew)
+	suite.Equal(boostingAccount.ID, boost.AccountID)
+
+	// The boost should point to the
+	// created object, not the Create.
+	suite.Equal(targetStatus.URI, boost.BoostOfURI)
+}
+
+func (suite *ASToInternalTestSuite) TestParseAnnounceWrappedLike() {
+	// A Group actor forwarding a Like
+	// wrapped in an Announce (FEP-1b12).
+	boostingAccount := suite.testAccounts["remote_account_1"]
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + boostingAccount.URI + `",
+  "id": "http://fossbros-anonymous.io/activities/announce/01JF0Y7D1W9E3K5M7P9R1T3V5X",
+  "object": {
+    "actor": "` + targetStatus.AccountURI + `",
+    "id": "http://fossbros-anonymous.io/activities/like/01JF0Y7XK2B4D6F8H0J2L4N6Q8",
+    "object": "` + targetStatus.URI + `",
+    "type": "Like"
+  },
+  "type": "Announce",
+  "to": "https://www.w3.org/ns/activitystreams#Public"
+  }`
+
+	t := suite.jsonToType(raw)
+	asAnnounce, ok := t.(ap.Announceable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	boost, _, err := suite.typeconverter.ASAnnounceToStatus(context.Background(), asAnnounce)
+	suite.Nil(boost)
+	suite.True(gtserror.IsNotRelevant(err))
+}
+
 func (suite *ASToInternalTestSuite) TestParseHonkAccount() {
 	// Hopefully comprehensive checks for
 	// https://github.com/superseriousbusiness/gotosocial/issues/2527.
@@ -20,6 +20,7 @@
 import (
 	"cmp"
 	"context"
+	"net/url"
 	"slices"
 	"strconv"
 
@@ -491,6 +492,84 @@ func (c *Converter) CSVToFollowing(
 	return follows, nil
 }
 
+// CSVToListEntries converts a slice of CSV records
+// to a slice of barebones *gtsmodel.ListEntry's,
+// ready for further processing.
+//
+// Only List.Title, Follow.TargetAccount.Username,
+// and Follow.TargetAccount.Domain will be set on
+// each ListEntry.
+func (c *Converter) CSVToListEntries(
+	ctx context.Context,
+	records [][]string,
+) ([]*gtsmodel.ListEntry, error) {
+	// We need to know our own domain for this.
+	// Try account domain, fall back to host.
+	var (
+		thisHost          = config.GetHost()
+		thisAccountDomain = config.GetAccountDomain()
+		entries           = make([]*gtsmodel.ListEntry, 0, len(records))
+	)
+
+	for _, record := range records {
+		if len(record) != 2 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// List title.
+		title := record[0]
+		if title == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// Account address.
+		namestring := record[1]
+		if namestring == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// Prepend with "@"
+		// if not included.
+		if namestring[0] != '@' {
+			namestring = "@" + namestring
+		}
+
+		username, domain, err := util.ExtractNamestringParts(namestring)
+		if err != nil {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if domain == thisHost || domain == thisAccountDomain {
+			// Clear the domain,
+			// since it's ours.
+			domain = ""
+		}
+
+		// Looks good, whack it in the slice.
+		entries = append(entries, &gtsmodel.ListEntry{
+			List: &gtsmodel.List{
+				Title: title,
+			},
+			Follow: &gtsmodel.Follow{
+				TargetAccount: &gtsmodel.Account{
+					Username: username,
+					Domain:   domain,
+				},
+			},
+		})
+	}
+
+	return entries, nil
+}
+
 // CSVToBlocks converts a slice of CSV records
 // to a slice of barebones *gtsmodel.Block's,
 // ready for further processing.
@@ -553,3 +632,131 @@ func (c *Converter) CSVToBlocks(
 
 	return blocks, nil
 }
+
+// CSVToMutes converts a slice of CSV records
+// to a slice of barebones *gtsmodel.UserMute's,
+// ready for further processing.
+//
+// Only TargetAccount.Username, TargetAccount.Domain,
+// and Notifications will be set on each UserMute.
+func (c *Converter) CSVToMutes(
+	ctx context.Context,
+	records [][]string,
+) ([]*gtsmodel.UserMute, error) {
+	// We need to know our own domain for this.
+	// Try account domain, fall back to host.
+	var (
+		thisHost          = config.GetHost()
+		thisAccountDomain = config.GetAccountDomain()
+		mutes             = make([]*gtsmodel.UserMute, 0, len(records))
+	)
+
+	for _, record := range records {
+		recordLen := len(record)
+
+		// Be lenient in case "Hide
+		// notifications" is missing.
+		if recordLen == 0 ||
+			recordLen > 2 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// "Account address"
+		namestring := record[0]
+		if namestring == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if namestring == "Account address" {
+			// CSV header row,
+			// skip this one.
+			continue
+		}
+
+		// Prepend with "@"
+		// if not included.
+		if namestring[0] != '@' {
+			namestring = "@" + namestring
+		}
+
+		username, domain, err := util.ExtractNamestringParts(namestring)
+		if err != nil {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if domain == thisHost || domain == thisAccountDomain {
+			// Clear the domain,
+			// since it's ours.
+			domain = ""
+		}
+
+		// "Hide notifications", defaulting
+		// to true as Mastodon does.
+		notifications := util.Ptr(true)
+		if recordLen > 1 {
+			b, err := strconv.ParseBool(record[1])
+			if err != nil {
+				// Badly formatted,
+				// skip this one.
+				continue
+			}
+			notifications = &b
+		}
+
+		// Looks good, whack it in the slice.
+		mutes = append(mutes, &gtsmodel.UserMute{
+			TargetAccount: &gtsmodel.Account{
+				Username: username,
+				Domain:   domain,
+			},
+			Notifications: notifications,
+		})
+	}
+
+	return mutes, nil
+}
+
+// CSVToBookmarks converts a slice of CSV records
+// to a slice of barebones *gtsmodel.StatusBookmark's,
+// ready for further processing.
+//
+// Only Status.URI will be set on each StatusBookmark.
+func (c *Converter) CSVToBookmarks(
+	ctx context.Context,
+	records [][]string,
+) ([]*gtsmodel.StatusBookmark, error) {
+	bookmarks := make([]*gtsmodel.StatusBookmark, 0, len(records))
+
+	for _, record := range records {
+		if len(record) != 1 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// Status URI / URL.
+		uri, err := url.Parse(record[0])
+		if err != nil ||
+			(uri.Scheme != "https" && uri.Scheme != "http") ||
+			uri.Host == "" {
+			// Badly formatted (or a
+			// header row), skip this one.
+			continue
+		}
+
+		// Looks good, whack it in the slice.
+		bookmarks = append(bookmarks, &gtsmodel.StatusBookmark{
+			Status: &gtsmodel.Status{
+				URI: uri.String(),
+			},
+		})
+	}
+
+	return bookmarks, nil
+}
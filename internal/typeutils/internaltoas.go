@@ -23,6 +23,7 @@
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"html"
 	"net/url"
 	"strings"
 
@@ -32,6 +33,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -562,6 +564,15 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	}
 	status.SetActivityStreamsTag(tagProp)
 
+	// tag -- quote
+	if s.QuoteURI != "" {
+		quoteURI, err := url.Parse(s.QuoteURI)
+		if err != nil {
+			return nil, gtserror.Newf("error parsing url %s: %w", s.QuoteURI, err)
+		}
+		ap.AppendQuoteLink(status, quoteURI)
+	}
+
 	// parse out some URIs we need here
 	authorFollowersURI, err := url.Parse(s.Account.FollowersURI)
 	if err != nil {
@@ -589,8 +600,20 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	case gtsmodel.VisibilityMutualsOnly:
 		// TODO
 	case gtsmodel.VisibilityFollowersOnly:
-		// if FOLLOWERS ONLY then we want to add followers to TO, and mentions to CC
-		toProp.AppendIRI(authorFollowersURI)
+		// if FOLLOWERS ONLY then we want to add followers to TO, and mentions to CC,
+		// unless the status is addressed to a circle, in which case we add only the
+		// members of that circle to TO, so it's delivered to just their inboxes.
+		if s.CircleID != "" {
+			memberIRIs, err := c.circleMemberIRIs(ctx, s.CircleID)
+			if err != nil {
+				return nil, err
+			}
+			for _, iri := range memberIRIs {
+				toProp.AppendIRI(iri)
+			}
+		} else {
+			toProp.AppendIRI(authorFollowersURI)
+		}
 		for _, m := range mentions {
 			iri, err := url.Parse(m.TargetAccount.URI)
 			if err != nil {
@@ -629,12 +652,21 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 
 	// content -- the actual post
 	// itself, plus the language
+	content := s.Content
+	if s.QuoteURI != "" {
+		// Append a fallback link to the quoted
+		// status for implementations that don't
+		// understand quotes. Quote-aware clients
+		// hide anything with the quote-inline class.
+		content += quoteInlineFallback(s)
+	}
+
 	contentProp := streams.NewActivityStreamsContentProperty()
-	contentProp.AppendXMLSchemaString(s.Content)
+	contentProp.AppendXMLSchemaString(content)
 
 	if s.Language != "" {
 		contentProp.AppendRDFLangString(map[string]string{
-			s.Language: s.Content,
+			s.Language: content,
 		})
 	}
 
@@ -779,6 +811,34 @@ func (c *Converter) addPollToAS(ctx context.Context, poll *gtsmodel.Poll, dst ap
 
 // StatusToASDelete converts a gts model status into a Delete of that status, using just the
 // URI of the status as object, and addressing the Delete appropriately.
+// circleMemberIRIs returns the parsed account URIs
+// of all members of the circle with the given ID.
+func (c *Converter) circleMemberIRIs(ctx context.Context, circleID string) ([]*url.URL, error) {
+	accountIDs, err := c.state.DB.GetAccountIDsInCircle(ctx, circleID)
+	if err != nil {
+		return nil, gtserror.Newf("error getting circle %s members: %w", circleID, err)
+	}
+
+	accounts, err := c.state.DB.GetAccountsByIDs(
+		gtscontext.SetBarebones(ctx),
+		accountIDs,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error getting circle %s member accounts: %w", circleID, err)
+	}
+
+	iris := make([]*url.URL, 0, len(accounts))
+	for _, account := range accounts {
+		iri, err := url.Parse(account.URI)
+		if err != nil {
+			return nil, gtserror.Newf("error parsing uri %s: %w", account.URI, err)
+		}
+		iris = append(iris, iri)
+	}
+
+	return iris, nil
+}
+
 func (c *Converter) StatusToASDelete(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsDelete, error) {
 	// Parse / fetch some information
 	// we need to create the Delete.
@@ -965,6 +1025,27 @@ func (c *Converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow) (vocab.A
 	return follow, nil
 }
 
+// FollowRequestToAS converts a gts model follow request into an
+// activity streams Follow, suitable for federation. Any note on
+// the follow request is set as the content of the Follow, so
+// that software which understands it can show it to the target.
+func (c *Converter) FollowRequestToAS(ctx context.Context, fr *gtsmodel.FollowRequest) (vocab.ActivityStreamsFollow, error) {
+	follow, err := c.FollowToAS(ctx, c.FollowRequestToFollow(ctx, fr))
+	if err != nil {
+		return nil, err
+	}
+
+	if fr.Note != "" {
+		// Note is stored as plaintext, so
+		// escape it to include as content.
+		contentProp := streams.NewActivityStreamsContentProperty()
+		contentProp.AppendXMLSchemaString(html.EscapeString(fr.Note))
+		follow.SetActivityStreamsContent(contentProp)
+	}
+
+	return follow, nil
+}
+
 // MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
 func (c *Converter) MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab.ActivityStreamsMention, error) {
 	if m.TargetAccount == nil {
@@ -1217,6 +1298,72 @@ func (c *Converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab
 	return like, nil
 }
 
+// ReactionToAS converts a gts model status reaction into an activityStreams LIKE,
+// with the reaction emoji as its content, suitable for federation. We want to end
+// up with something like this:
+//
+//	{
+//		"@context": "https://www.w3.org/ns/activitystreams",
+//		"actor": "https://example.org/users/someone",
+//		"id": "https://example.org/users/someone/liked/01HVR8CJDDZ8DB1BC0FDPDT8QV",
+//		"object": "https://example.com/users/someone_else/statuses/01HVR8DQ4RJ0Y0MFNB5CJGB1W7",
+//		"type": "Like",
+//		"content": ":blobcat:",
+//		"_misskey_reaction": ":blobcat:",
+//		"tag": [
+//			{
+//				"id": "https://example.org/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ",
+//				"type": "Emoji",
+//				"name": ":blobcat:",
+//				...
+//			}
+//		]
+//	}
+func (c *Converter) ReactionToAS(ctx context.Context, r *gtsmodel.StatusReaction) (vocab.ActivityStreamsLike, error) {
+	if err := c.state.DB.PopulateStatusReaction(ctx, r); err != nil {
+		return nil, gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// A reaction is just a Like with extra
+	// bits, so start from the fave version.
+	like, err := c.FaveToAS(ctx, &gtsmodel.StatusFave{
+		AccountID:       r.AccountID,
+		Account:         r.Account,
+		TargetAccountID: r.TargetAccountID,
+		TargetAccount:   r.TargetAccount,
+		StatusID:        r.StatusID,
+		Status:          r.Status,
+		URI:             r.URI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IsCustom() {
+		// Plain unicode emoji.
+		ap.SetReaction(like, r.Name)
+		return like, nil
+	}
+
+	if r.Emoji == nil {
+		return nil, gtserror.Newf("emoji %s for reaction %s no longer exists", r.EmojiID, r.ID)
+	}
+
+	// Custom emoji, include it
+	// in the tags of the Like.
+	asEmoji, err := c.EmojiToAS(ctx, r.Emoji)
+	if err != nil {
+		return nil, gtserror.Newf("error converting emoji to AS emoji: %w", err)
+	}
+
+	tagProp := streams.NewActivityStreamsTagProperty()
+	tagProp.AppendTootEmoji(asEmoji)
+	like.SetActivityStreamsTag(tagProp)
+
+	ap.SetReaction(like, ":"+r.Emoji.Shortcode+":")
+	return like, nil
+}
+
 // BoostToAS converts a gts model boost into an activityStreams ANNOUNCE, suitable for federation
 func (c *Converter) BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error) {
 	// the boosted status is probably pinned to the boostWrapperStatus but double check to make sure
@@ -1678,6 +1825,10 @@ func (c *Converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (voc
 	contentProp.AppendXMLSchemaString(r.Comment)
 	flag.SetActivityStreamsContent(contentProp)
 
+	// category is not part of the AS vocabulary, but
+	// we include it so that remote GtS admins can see it
+	ap.SetFlagCategory(flag, r.Category.String())
+
 	// set at least the target account uri as the object of the flag
 	objectProp := streams.NewActivityStreamsObjectProperty()
 	targetAccountURI, err := url.Parse(r.TargetAccount.URI)
@@ -2129,3 +2280,76 @@ func (c *Converter) InteractionReqToASReject(
 
 	return reject, nil
 }
+
+// RelayToASFollow converts a gts model relay into the
+// Follow of the relay actor by the given instance account.
+func (c *Converter) RelayToASFollow(
+	ctx context.Context,
+	r *gtsmodel.Relay,
+	instanceAcct *gtsmodel.Account,
+) (vocab.ActivityStreamsFollow, error) {
+	follow := streams.NewActivityStreamsFollow()
+
+	followID, err := url.Parse(r.FollowURI)
+	if err != nil {
+		return nil, gtserror.Newf("invalid follow uri: %w", err)
+	}
+
+	actorIRI, err := url.Parse(instanceAcct.URI)
+	if err != nil {
+		return nil, gtserror.Newf("invalid instance account uri: %w", err)
+	}
+
+	relayIRI, err := url.Parse(r.ActorURI)
+	if err != nil {
+		return nil, gtserror.Newf("invalid relay actor uri: %w", err)
+	}
+
+	// Set id to the URI of
+	// the relay Follow.
+	ap.SetJSONLDId(follow, followID)
+
+	// Actor is the instance account.
+	ap.AppendActorIRIs(follow, actorIRI)
+
+	// Object is the relay actor,
+	// which is also the recipient.
+	ap.AppendObjectIRIs(follow, relayIRI)
+	ap.AppendTo(follow, relayIRI)
+
+	return follow, nil
+}
+
+// RelayToASUndoFollow converts a gts model relay into an
+// Undo of the Follow of the relay actor by the given
+// instance account, for unsubscribing from the relay.
+func (c *Converter) RelayToASUndoFollow(
+	ctx context.Context,
+	r *gtsmodel.Relay,
+	instanceAcct *gtsmodel.Account,
+) (vocab.ActivityStreamsUndo, error) {
+	follow, err := c.RelayToASFollow(ctx, r, instanceAcct)
+	if err != nil {
+		return nil, err
+	}
+
+	undo := streams.NewActivityStreamsUndo()
+
+	undoID, err := url.Parse(r.FollowURI + "/undo")
+	if err != nil {
+		return nil, gtserror.Newf("invalid undo uri: %w", err)
+	}
+
+	// Set id, actor and
+	// recipient as the Follow.
+	ap.SetJSONLDId(undo, undoID)
+	ap.AppendActorIRIs(undo, ap.GetActorIRIs(follow)...)
+	ap.AppendTo(undo, ap.GetTo(follow)...)
+
+	// Object is the Follow itself.
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendActivityStreamsFollow(follow)
+	undo.SetActivityStreamsObject(objectProp)
+
+	return undo, nil
+}
@@ -27,6 +27,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -999,6 +1000,7 @@ func (suite *InternalToASTestSuite) TestReportToAS() {
 	suite.Equal(`{
   "@context": "https://www.w3.org/ns/activitystreams",
   "actor": "http://localhost:8080/users/localhost:8080",
+  "category": "violation",
   "content": "dark souls sucks, please yeet this nerd",
   "id": "http://localhost:8080/reports/01GP3AWY4CRDVRNZKW0TEAMB5R",
   "object": [
@@ -1249,6 +1251,48 @@ func (suite *InternalToASTestSuite) TestInteractionReqToASAcceptLike() {
 }`, string(b))
 }
 
+func (suite *InternalToASTestSuite) TestFollowRequestToASWithNote() {
+	followingAccount := suite.testAccounts["local_account_1"]
+	followedAccount := suite.testAccounts["remote_account_1"]
+
+	fr := &gtsmodel.FollowRequest{
+		ID:              "01J1AKMZ8JE5NW0ZSFTRC1JJNE",
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/01J1AKMZ8JE5NW0ZSFTRC1JJNE",
+		AccountID:       followingAccount.ID,
+		Account:         followingAccount,
+		TargetAccountID: followedAccount.ID,
+		TargetAccount:   followedAccount,
+		ShowReblogs:     util.Ptr(true),
+		Notify:          util.Ptr(false),
+		Note:            "Hi! We met at the conference last week.",
+	}
+
+	follow, err := suite.typeconverter.FollowRequestToAS(context.Background(), fr)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	i, err := ap.Serialize(follow)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "http://localhost:8080/users/the_mighty_zork",
+  "content": "Hi! We met at the conference last week.",
+  "id": "http://localhost:8080/users/the_mighty_zork/follow/01J1AKMZ8JE5NW0ZSFTRC1JJNE",
+  "object": "http://fossbros-anonymous.io/users/foss_satan",
+  "to": "http://fossbros-anonymous.io/users/foss_satan",
+  "type": "Follow"
+}`, string(b))
+}
+
 func TestInternalToASTestSuite(t *testing.T) {
 	suite.Run(t, new(InternalToASTestSuite))
 }
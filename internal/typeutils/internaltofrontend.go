@@ -23,6 +23,7 @@
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
@@ -34,11 +35,13 @@
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/language"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -58,6 +61,14 @@
 	string(apimodel.StatusContentTypeMarkdown),
 }
 
+func instanceOAuthCodeChallengeMethods() []string {
+	methods := make([]string, len(oauth.CodeChallengeMethods))
+	for i, m := range oauth.CodeChallengeMethods {
+		methods[i] = m.String()
+	}
+	return methods
+}
+
 func toMastodonVersion(in string) string {
 	return instanceMastodonVersion + "+" + strings.ReplaceAll(in, " ", "-")
 }
@@ -97,6 +108,15 @@ func (c *Converter) UserToAPIUser(ctx context.Context, u *gtsmodel.User) *apimod
 		user.ResetPasswordSentAt = util.FormatISO8601(u.ResetPasswordSentAt)
 	}
 
+	if u.TwoFactorEnabled() {
+		user.TwoFactorEnabledAt = util.FormatISO8601(u.TwoFactorEnabledAt)
+	}
+
+	if u.PendingDeletion() {
+		grace := time.Duration(config.GetAccountsDeletionGrace()) * 24 * time.Hour
+		user.DeletionScheduledAt = util.FormatISO8601(u.DeletionRequestedAt.Add(grace))
+	}
+
 	return user
 }
 
@@ -120,11 +140,17 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		)
 	}
 
-	// Populate the account's role permissions bitmap and highlightedness from its public role.
-	if len(apiAccount.Roles) > 0 {
-		apiAccount.Role = c.APIAccountDisplayRoleToAPIAccountRoleSensitive(&apiAccount.Roles[0])
-	} else {
+	// Populate the account's role, including permissions bitmap
+	// and highlightedness. Skip for remote and instance accounts,
+	// which have no user, and so only ever have the default role.
+	if a.IsRemote() || a.IsInstance() {
 		apiAccount.Role = c.APIAccountDisplayRoleToAPIAccountRoleSensitive(nil)
+	} else {
+		user, err := c.state.DB.GetUserByAccountID(ctx, a.ID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting user from database for account id %s: %w", a.ID, err)
+		}
+		apiAccount.Role = c.UserToAPIAccountRole(user)
 	}
 
 	statusContentType := string(apimodel.StatusContentTypeDefault)
@@ -142,6 +168,17 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
 		FollowRequestsCount: *a.Stats.FollowRequestsCount,
 		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
+
+		StatusExpiryDays:          a.Settings.StatusExpiryDays,
+		StatusExpiryKeepPinned:    util.PtrOrValue(a.Settings.StatusExpiryKeepPinned, true),
+		StatusExpiryKeepSelfFaved: util.PtrOrValue(a.Settings.StatusExpiryKeepSelfFaved, true),
+
+		EmailDigestDays:           a.Settings.EmailDigestDays,
+		EmailDigestFollows:        util.PtrOrValue(a.Settings.EmailDigestFollows, true),
+		EmailDigestMentions:       util.PtrOrValue(a.Settings.EmailDigestMentions, true),
+		EmailDigestFollowRequests: util.PtrOrValue(a.Settings.EmailDigestFollowRequests, true),
+
+		RequireMediaDescriptions: util.PtrOrValue(a.Settings.RequireMediaDescriptions, false),
 	}
 
 	return apiAccount, nil
@@ -183,7 +220,8 @@ func (c *Converter) AccountToWebAccount(
 	}
 
 	webAccount := &apimodel.WebAccount{
-		Account: apiAccount,
+		Account:       apiAccount,
+		AccountDomain: a.HandleDomain(),
 	}
 
 	// Set additional avatar information for
@@ -399,7 +437,10 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 
 // UserToAPIAccountDisplayRole returns the API representation of a user's display role.
 // This will accept a nil user but does not always return a value:
-// the default "user" role is considered uninteresting and not returned.
+// the default "user" role is considered uninteresting and not returned,
+// and neither are custom roles that aren't highlighted.
+//
+// A custom role given to a user takes precedence over the built-in moderator role.
 func (c *Converter) UserToAPIAccountDisplayRole(user *gtsmodel.User) *apimodel.AccountDisplayRole {
 	switch {
 	case user == nil:
@@ -409,6 +450,14 @@ func (c *Converter) UserToAPIAccountDisplayRole(user *gtsmodel.User) *apimodel.A
 			ID:   string(apimodel.AccountRoleAdmin),
 			Name: apimodel.AccountRoleAdmin,
 		}
+	case user.Role != nil:
+		if !*user.Role.Highlighted {
+			return nil
+		}
+		return &apimodel.AccountDisplayRole{
+			ID:   user.Role.ID,
+			Name: apimodel.AccountRoleName(user.Role.Name),
+		}
 	case *user.Moderator:
 		return &apimodel.AccountDisplayRole{
 			ID:   string(apimodel.AccountRoleModerator),
@@ -447,6 +496,210 @@ func (c *Converter) APIAccountDisplayRoleToAPIAccountRoleSensitive(display *apim
 	return role
 }
 
+// UserToAPIAccountRole returns the API representation of a user's role,
+// with permission bitmap. This will accept a nil user and always returns a value.
+// Unlike the display role, custom roles are returned even if not highlighted.
+func (c *Converter) UserToAPIAccountRole(user *gtsmodel.User) *apimodel.AccountRole {
+	if user == nil || *user.Admin || user.Role == nil {
+		// Built-in role.
+		return c.APIAccountDisplayRoleToAPIAccountRoleSensitive(
+			c.UserToAPIAccountDisplayRole(user),
+		)
+	}
+
+	return &apimodel.AccountRole{
+		AccountDisplayRole: apimodel.AccountDisplayRole{
+			ID:   user.Role.ID,
+			Name: apimodel.AccountRoleName(user.Role.Name),
+		},
+		Permissions: rolePermissionsToAPI(user.Role.Permissions),
+		Highlighted: *user.Role.Highlighted,
+	}
+}
+
+// rolePermissionsToAPI converts the permissions of a custom
+// role to the equivalent Mastodon API permissions bitmap.
+func rolePermissionsToAPI(perms gtsmodel.RolePermissions) apimodel.AccountRolePermissions {
+	apiPerms := apimodel.AccountRolePermissionsNone
+	for perm, apiPerm := range map[gtsmodel.RolePermissions]apimodel.AccountRolePermissions{
+		gtsmodel.RolePermissionViewAuditLog:     apimodel.AccountRolePermissionsViewAuditLog,
+		gtsmodel.RolePermissionManageReports:    apimodel.AccountRolePermissionsManageReports,
+		gtsmodel.RolePermissionManageFederation: apimodel.AccountRolePermissionsManageFederation,
+		gtsmodel.RolePermissionManageUsers:      apimodel.AccountRolePermissionsManageUsers,
+	} {
+		if perms.Has(perm) {
+			apiPerms |= apiPerm
+		}
+	}
+	return apiPerms
+}
+
+// RoleToAdminAPIRole converts a custom role to its admin API representation.
+func (c *Converter) RoleToAdminAPIRole(role *gtsmodel.Role) *apimodel.AdminRole {
+	return &apimodel.AdminRole{
+		ID:          role.ID,
+		CreatedAt:   util.FormatISO8601(role.CreatedAt),
+		UpdatedAt:   util.FormatISO8601(role.UpdatedAt),
+		Name:        role.Name,
+		Permissions: role.Permissions.Names(),
+		Highlighted: *role.Highlighted,
+	}
+}
+
+// WebhookToAdminAPIWebhook converts a webhook to its admin API representation.
+func (c *Converter) WebhookToAdminAPIWebhook(webhook *gtsmodel.Webhook) *apimodel.AdminWebhook {
+	return &apimodel.AdminWebhook{
+		ID:        webhook.ID,
+		CreatedAt: util.FormatISO8601(webhook.CreatedAt),
+		UpdatedAt: util.FormatISO8601(webhook.UpdatedAt),
+		URL:       webhook.URL,
+		Events:    webhook.Events,
+		Secret:    webhook.Secret,
+		Enabled:   *webhook.Enabled,
+	}
+}
+
+// AnnouncementToAPIAnnouncement converts an announcement, and the given reactions
+// to it, to their API representation, as seen by requester. Requester may be nil,
+// eg., when streaming the announcement to everyone, in which case read and me
+// are always false.
+func (c *Converter) AnnouncementToAPIAnnouncement(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	reactions []*gtsmodel.AnnouncementReaction,
+	requester *gtsmodel.Account,
+	read bool,
+) (*apimodel.Announcement, error) {
+	var errs gtserror.MultiError
+
+	apiAnnouncement := &apimodel.Announcement{
+		ID:        announcement.ID,
+		Content:   announcement.Content,
+		AllDay:    *announcement.AllDay,
+		UpdatedAt: util.FormatISO8601(announcement.UpdatedAt),
+		Published: *announcement.Published,
+		Read:      read,
+		Mentions:  []apimodel.Mention{},
+		Statuses:  []apimodel.Status{},
+		Tags:      []apimodel.Tag{},
+		Emojis:    make([]apimodel.Emoji, 0, len(announcement.Emojis)),
+		Reactions: c.announcementReactionsToAPIAnnouncementReactions(ctx, reactions, requester),
+	}
+
+	if !announcement.StartsAt.IsZero() {
+		startsAt := util.FormatISO8601(announcement.StartsAt)
+		apiAnnouncement.StartsAt = &startsAt
+	}
+
+	if !announcement.EndsAt.IsZero() {
+		endsAt := util.FormatISO8601(announcement.EndsAt)
+		apiAnnouncement.EndsAt = &endsAt
+	}
+
+	if !announcement.PublishedAt.IsZero() {
+		apiAnnouncement.PublishedAt = util.FormatISO8601(announcement.PublishedAt)
+	}
+
+	for _, emoji := range announcement.Emojis {
+		apiEmoji, err := c.EmojiToAPIEmoji(ctx, emoji)
+		if err != nil {
+			errs.Appendf("error converting emoji %s: %w", emoji.ID, err)
+			continue
+		}
+		apiAnnouncement.Emojis = append(apiAnnouncement.Emojis, apiEmoji)
+	}
+
+	return apiAnnouncement, errs.Combine()
+}
+
+// announcementReactionsToAPIAnnouncementReactions groups the
+// given announcement reactions by name, counting them, and
+// noting which were made by requester (may be nil).
+func (c *Converter) announcementReactionsToAPIAnnouncementReactions(
+	ctx context.Context,
+	reactions []*gtsmodel.AnnouncementReaction,
+	requester *gtsmodel.Account,
+) []apimodel.AnnouncementReaction {
+	var (
+		apiReactions = make([]apimodel.AnnouncementReaction, 0, len(reactions))
+		byName       = make(map[string]int, len(reactions))
+	)
+
+	for _, reaction := range reactions {
+		i, ok := byName[reaction.Name]
+		if !ok {
+			apiReaction := apimodel.AnnouncementReaction{
+				Name: reaction.Name,
+			}
+
+			if reaction.IsCustom() {
+				if reaction.Emoji == nil {
+					var err error
+					reaction.Emoji, err = c.state.DB.GetEmojiByID(ctx, reaction.EmojiID)
+					if err != nil && !errors.Is(err, db.ErrNoEntries) {
+						log.Errorf(ctx, "error fetching emoji %s: %v", reaction.EmojiID, err)
+					}
+				}
+
+				if reaction.Emoji == nil {
+					// Emoji has since
+					// been deleted.
+					continue
+				}
+
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiReactions)
+			byName[reaction.Name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReaction := &apiReactions[i]
+		apiReaction.Count++
+
+		if requester != nil && reaction.AccountID == requester.ID {
+			apiReaction.Me = true
+		}
+	}
+
+	return apiReactions
+}
+
+// TermsOfServiceToAPITermsOfService converts a terms of service version to
+// its API representation. Next should be the version that replaced tos, or
+// nil if tos is the version currently in effect.
+func (c *Converter) TermsOfServiceToAPITermsOfService(tos *gtsmodel.TermsOfService, next *gtsmodel.TermsOfService) *apimodel.TermsOfService {
+	apiTOS := &apimodel.TermsOfService{
+		ID:            tos.ID,
+		EffectiveDate: util.FormatISO8601Date(tos.CreatedAt),
+		Effective:     next == nil,
+		Content:       tos.Content,
+	}
+
+	if next != nil {
+		succeededBy := util.FormatISO8601Date(next.CreatedAt)
+		apiTOS.SucceededBy = &succeededBy
+	}
+
+	return apiTOS
+}
+
+// TermsOfServiceToAdminAPITermsOfService converts a terms of service version
+// to its admin API representation. Effective should be true if tos is the
+// version currently in effect.
+func (c *Converter) TermsOfServiceToAdminAPITermsOfService(tos *gtsmodel.TermsOfService, effective bool) *apimodel.AdminTermsOfService {
+	return &apimodel.AdminTermsOfService{
+		ID:                tos.ID,
+		CreatedAt:         util.FormatISO8601(tos.CreatedAt),
+		Effective:         effective,
+		Text:              tos.Text,
+		Content:           tos.Content,
+		RequireAcceptance: *tos.RequireAcceptance,
+	}
+}
+
 func (c *Converter) fieldsToAPIFields(f []*gtsmodel.Field) []apimodel.Field {
 	fields := make([]apimodel.Field, len(f))
 
@@ -530,6 +783,7 @@ func (c *Converter) AccountToAPIAccountBlocked(ctx context.Context, a *gtsmodel.
 func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Account) (*apimodel.AdminAccountInfo, error) {
 	var (
 		email                  string
+		unconfirmedEmail       string
 		ip                     *string
 		domain                 *string
 		locale                 string
@@ -539,6 +793,9 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		disabled               bool
 		role                   = *c.APIAccountDisplayRoleToAPIAccountRoleSensitive(nil)
 		createdByApplicationID string
+		invitedByAccountID     string
+		inviteID               string
+		signupAnswers          []apimodel.SignupAnswer
 	)
 
 	if err := c.state.DB.PopulateAccount(ctx, a); err != nil {
@@ -564,6 +821,13 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 
 		if user.Email != "" {
 			email = user.Email
+
+			// User already has a confirmed email
+			// address, so any unconfirmed address
+			// is a pending change of email.
+			if user.UnconfirmedEmail != user.Email {
+				unconfirmedEmail = user.UnconfirmedEmail
+			}
 		} else {
 			email = user.UnconfirmedEmail
 		}
@@ -577,14 +841,37 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 			inviteRequest = &user.Reason
 		}
 
-		role = *c.APIAccountDisplayRoleToAPIAccountRoleSensitive(
-			c.UserToAPIAccountDisplayRole(user),
-		)
+		role = *c.UserToAPIAccountRole(user)
 
 		confirmed = !user.ConfirmedAt.IsZero()
 		approved = *user.Approved
 		disabled = *user.Disabled
 		createdByApplicationID = user.CreatedByApplicationID
+
+		answers, err := c.state.DB.GetSignupAnswersByUserID(ctx, user.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting sign-up answers for user %s: %w", user.ID, err)
+		}
+		for _, answer := range answers {
+			signupAnswers = append(signupAnswers, apimodel.SignupAnswer{
+				QuestionID: answer.QuestionID,
+				Question:   answer.Question,
+				Answer:     answer.Answer,
+			})
+		}
+
+		if user.InviteID != "" {
+			inviteID = user.InviteID
+
+			invite, err := c.state.DB.GetInviteByID(ctx, user.InviteID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting invite %s from database: %w", user.InviteID, err)
+			}
+
+			if invite != nil {
+				invitedByAccountID = invite.CreatedByAccountID
+			}
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -598,6 +885,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Domain:                 domain,
 		CreatedAt:              util.FormatISO8601(a.CreatedAt),
 		Email:                  email,
+		UnconfirmedEmail:       unconfirmedEmail,
 		IP:                     ip,
 		IPs:                    []interface{}{}, // not implemented,
 		Locale:                 locale,
@@ -610,10 +898,45 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Suspended:              !a.SuspendedAt.IsZero(),
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
-		InvitedByAccountID:     "", // not implemented (yet)
+		InvitedByAccountID:     invitedByAccountID,
+		InviteID:               inviteID,
+		SignupAnswers:          signupAnswers,
 	}, nil
 }
 
+// SignupQuestionToAPISignupQuestion converts a gtsmodel
+// sign-up question to its api model representation.
+func (c *Converter) SignupQuestionToAPISignupQuestion(question *gtsmodel.SignupQuestion) apimodel.SignupQuestion {
+	return apimodel.SignupQuestion{
+		ID:   question.ID,
+		Text: question.Text,
+	}
+}
+
+// InviteToAPIInvite converts a gtsmodel invite
+// to its api model representation.
+func (c *Converter) InviteToAPIInvite(invite *gtsmodel.Invite) *apimodel.Invite {
+	apiInvite := &apimodel.Invite{
+		ID:        invite.ID,
+		Code:      invite.Code,
+		URL:       config.GetProtocol() + "://" + config.GetHost() + "/signup?invite=" + invite.Code,
+		Uses:      invite.Uses,
+		Usable:    invite.Usable(time.Now()),
+		CreatedBy: invite.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(invite.CreatedAt),
+	}
+
+	if invite.MaxUses != 0 {
+		apiInvite.MaxUses = util.Ptr(invite.MaxUses)
+	}
+
+	if !invite.ExpiresAt.IsZero() {
+		apiInvite.ExpiresAt = util.Ptr(util.FormatISO8601(invite.ExpiresAt))
+	}
+
+	return apiInvite
+}
+
 func (c *Converter) AppToAPIAppSensitive(ctx context.Context, a *gtsmodel.Application) (*apimodel.Application, error) {
 	return &apimodel.Application{
 		ID:           a.ID,
@@ -625,6 +948,34 @@ func (c *Converter) AppToAPIAppSensitive(ctx context.Context, a *gtsmodel.Applic
 	}, nil
 }
 
+// TokenToAPITokenInfo converts a gtsmodel token to an api model
+// token info, suitable for showing to the owner of the token.
+// The token values themselves are not included.
+func (c *Converter) TokenToAPITokenInfo(ctx context.Context, t *gtsmodel.Token) (*apimodel.TokenInfo, error) {
+	app, err := c.state.DB.GetApplicationByClientID(ctx, t.ClientID)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting application for client %s: %w", t.ClientID, err)
+	}
+
+	apiApp, err := c.AppToAPIAppPublic(ctx, app)
+	if err != nil {
+		return nil, gtserror.Newf("error converting application: %w", err)
+	}
+
+	var lastUsed string
+	if !t.LastUsed.IsZero() {
+		lastUsed = util.FormatISO8601(t.LastUsed)
+	}
+
+	return &apimodel.TokenInfo{
+		ID:          t.ID,
+		CreatedAt:   util.FormatISO8601(t.CreatedAt),
+		LastUsed:    lastUsed,
+		Scope:       t.Scope,
+		Application: apiApp,
+	}, nil
+}
+
 // AppToAPIAppPublic takes a db model application as a param, and returns a populated apitype application, or an error
 // if something goes wrong. The returned application should be ready to serialize on an API level, and has sensitive
 // fields sanitized so that it can be served to non-authorized accounts without revealing any private information.
@@ -667,6 +1018,9 @@ func (c *Converter) AttachmentToAPIAttachment(ctx context.Context, media *gtsmod
 		api.Meta.Focus.X = media.FileMeta.Focus.X
 		api.Meta.Focus.Y = media.FileMeta.Focus.Y
 
+		// Set audio waveform (if any).
+		api.Meta.Waveform = toAPIWaveform(media.FileMeta.Waveform)
+
 		// Only add thumbnail details if
 		// we have thumbnail stored locally.
 		if media.Thumbnail.Path != "" {
@@ -793,18 +1147,131 @@ func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistor
 	return apimodel.Tag{
 		Name: strings.ToLower(t.Name),
 		URL:  uris.URIForTag(t.Name),
-		History: func() *[]any {
+		History: func() *[]apimodel.History {
 			if !stubHistory {
 				return nil
 			}
 
-			h := make([]any, 0)
+			h := make([]apimodel.History, 0)
 			return &h
 		}(),
 		Following: following,
 	}, nil
 }
 
+// TrendToAPITag converts a gts model trend of type tag into its api
+// (frontend) representation, including the tag's daily usage history.
+// Trend Tag and History must be populated. following is as for TagToAPITag.
+func (c *Converter) TrendToAPITag(ctx context.Context, t *gtsmodel.Trend, following *bool) (apimodel.Tag, error) {
+	if t.Tag == nil {
+		return apimodel.Tag{}, gtserror.Newf("trend %s tag not populated", t.ID)
+	}
+
+	apiTag, err := c.TagToAPITag(ctx, t.Tag, false, following)
+	if err != nil {
+		return apimodel.Tag{}, err
+	}
+
+	history := trendHistoryToAPIHistory(t.History)
+	apiTag.History = &history
+
+	return apiTag, nil
+}
+
+// TrendToAPITrendsLink converts a gts model trend of type link into its api
+// (frontend) representation, including the link's daily usage history.
+// Trend History must be populated.
+//
+// Since link previews aren't fetched, the card only contains the URL, with
+// the title and provider derived from it.
+func (c *Converter) TrendToAPITrendsLink(ctx context.Context, t *gtsmodel.Trend) (apimodel.TrendsLink, error) {
+	u, err := url.Parse(t.Target)
+	if err != nil {
+		return apimodel.TrendsLink{}, gtserror.Newf("error parsing trend %s link: %w", t.ID, err)
+	}
+
+	return apimodel.TrendsLink{
+		Card: apimodel.Card{
+			URL:          t.Target,
+			Title:        t.Target,
+			Type:         "link",
+			ProviderName: u.Host,
+			ProviderURL:  u.Scheme + "://" + u.Host,
+		},
+		History: trendHistoryToAPIHistory(t.History),
+	}, nil
+}
+
+// TrendToAdminAPITrend converts a gts model trend into its admin api (frontend)
+// representation. The trend's Tag or Status (depending on type) and History
+// must be populated. requestingAccount is used to convert trending statuses.
+func (c *Converter) TrendToAdminAPITrend(
+	ctx context.Context,
+	t *gtsmodel.Trend,
+	requestingAccount *gtsmodel.Account,
+) (*apimodel.AdminTrend, error) {
+	apiTrend := &apimodel.AdminTrend{
+		ID:          t.ID,
+		Type:        t.Type.String(),
+		Score:       t.Score,
+		Uses:        t.Uses,
+		Accounts:    t.Accounts,
+		ReviewState: t.ReviewState.String(),
+	}
+
+	if !t.ReviewedAt.IsZero() {
+		reviewedAt := util.FormatISO8601(t.ReviewedAt)
+		apiTrend.ReviewedAt = &reviewedAt
+	}
+
+	if t.ReviewedByAccountID != "" {
+		apiTrend.ReviewedByAccountID = &t.ReviewedByAccountID
+	}
+
+	switch t.Type {
+	case gtsmodel.TrendTypeTag:
+		apiTag, err := c.TrendToAPITag(ctx, t, nil)
+		if err != nil {
+			return nil, err
+		}
+		apiTrend.Tag = &apiTag
+
+	case gtsmodel.TrendTypeStatus:
+		if t.Status == nil {
+			return nil, gtserror.Newf("trend %s status not populated", t.ID)
+		}
+
+		apiStatus, err := c.StatusToAPIStatus(ctx, t.Status, requestingAccount, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		apiTrend.Status = apiStatus
+
+	case gtsmodel.TrendTypeLink:
+		apiLink, err := c.TrendToAPITrendsLink(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		apiTrend.Link = &apiLink
+	}
+
+	return apiTrend, nil
+}
+
+// trendHistoryToAPIHistory converts gts model daily
+// trend history into its api (frontend) representation.
+func trendHistoryToAPIHistory(history []*gtsmodel.TrendHistory) []apimodel.History {
+	apiHistory := make([]apimodel.History, 0, len(history))
+	for _, h := range history {
+		apiHistory = append(apiHistory, apimodel.History{
+			Day:      strconv.FormatInt(h.Day.Unix(), 10),
+			Uses:     strconv.Itoa(h.Uses),
+			Accounts: strconv.Itoa(h.Accounts),
+		})
+	}
+	return apiHistory
+}
+
 // StatusToAPIStatus converts a gts model
 // status into its api (frontend) representation
 // for serialization on the API.
@@ -1228,6 +1695,7 @@ func (c *Converter) StatusToAPIStatusSource(ctx context.Context, s *gtsmodel.Sta
 		ID:          s.ID,
 		Text:        text,
 		SpoilerText: s.ContentWarning,
+		ContentType: c.ContentTypeToAPIContentType(s.ContentType),
 	}, nil
 }
 
@@ -1283,11 +1751,91 @@ func (c *Converter) statusToFrontend(
 		apiStatus.Reblogged = apiStatus.Reblog.Reblogged
 		apiStatus.Pinned = apiStatus.Reblog.Pinned
 		apiStatus.Filtered = apiStatus.Reblog.Filtered
+		apiStatus.EmojiReactions = apiStatus.Reblog.EmojiReactions
+
+		// Set quote of boosted status, if any.
+		apiStatus.Reblog.Quote, err = c.quoteToFrontend(ctx,
+			status.BoostOf.Quote,
+			requestingAccount,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error converting boosted status quote: %w", err)
+		}
+
+		apiStatus.Reblog.Content = quoteContent(
+			apiStatus.Reblog.Content,
+			status.BoostOf,
+			apiStatus.Reblog.Quote != nil,
+		)
+	}
+
+	// Set quoted status, if any.
+	apiStatus.Quote, err = c.quoteToFrontend(ctx,
+		status.Quote,
+		requestingAccount,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error converting quote: %w", err)
 	}
 
+	apiStatus.Content = quoteContent(
+		apiStatus.Content,
+		status,
+		apiStatus.Quote != nil,
+	)
+
 	return apiStatus, nil
 }
 
+// quoteToFrontend converts the given quoted status into
+// its frontend representation, including its author.
+//
+// Returns nil, nil if quote is nil, or not
+// visible to the (possibly nil) requester.
+//
+// Quotes of the quoted status are not
+// converted, to prevent recursion issues.
+func (c *Converter) quoteToFrontend(
+	ctx context.Context,
+	quote *gtsmodel.Status,
+	requestingAccount *gtsmodel.Account,
+) (*apimodel.StatusQuoted, error) {
+	if quote == nil {
+		// Nothing
+		// to do.
+		return nil, nil
+	}
+
+	visible, err := c.visFilter.StatusVisible(ctx, requestingAccount, quote)
+	if err != nil {
+		return nil, gtserror.Newf("error checking quote visibility: %w", err)
+	}
+
+	if !visible {
+		// Leave it to
+		// the quote_id.
+		return nil, nil
+	}
+
+	apiQuote, err := c.baseStatusToFrontend(ctx,
+		quote,
+		requestingAccount,
+		statusfilter.FilterContextNone, // Don't filter quotes.
+		nil,                            // No filters.
+		nil,                            // No mutes.
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	apiQuote.Account, err = c.AccountToAPIAccountPublic(ctx, quote.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting quote acct: %w", err)
+	}
+
+	return &apimodel.StatusQuoted{Status: apiQuote}, nil
+}
+
 // baseStatusToFrontend performs the main logic
 // of statusToFrontend() without handling of boost
 // logic, to prevent *possible* recursion issues.
@@ -1336,6 +1884,15 @@ func (c *Converter) baseStatusToFrontend(
 		return nil, gtserror.Newf("error counting faves: %w", err)
 	}
 
+	// We only know about interactions with remote
+	// statuses that reached us, so prefer the counts
+	// reported by the origin server where higher.
+	if !s.IsLocal() {
+		repliesCount = max(repliesCount, util.PtrOrZero(s.RemoteRepliesCount))
+		reblogsCount = max(reblogsCount, util.PtrOrZero(s.RemoteReblogsCount))
+		favesCount = max(favesCount, util.PtrOrZero(s.RemoteFavesCount))
+	}
+
 	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.Attachments, s.AttachmentIDs)
 	if err != nil {
 		log.Errorf(ctx, "error converting status attachments: %v", err)
@@ -1407,6 +1964,10 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.InReplyToAccountID = util.Ptr(s.InReplyToAccountID)
 	}
 
+	if s.QuoteID != "" {
+		apiStatus.QuoteID = util.Ptr(s.QuoteID)
+	}
+
 	if s.Language != "" {
 		apiStatus.Language = util.Ptr(s.Language)
 	}
@@ -1454,6 +2015,28 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.Muted = interacts.Muted
 		apiStatus.Reblogged = interacts.Reblogged
 		apiStatus.Pinned = interacts.Pinned
+
+		reactions, err := c.state.DB.GetStatusReactions(gtscontext.SetBarebones(ctx), s.ID)
+		if err != nil {
+			log.Errorf(ctx, "error getting reactions for status %s: %v", s.ID, err)
+		}
+
+		apiStatus.EmojiReactions, err = c.StatusReactionsToAPIStatusReactions(ctx,
+			reactions,
+			requestingAccount,
+			false,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error converting reactions for status %s: %v", s.ID, err)
+		}
+	}
+
+	// Only show the author
+	// who a status's circle is.
+	if s.CircleID != "" &&
+		requestingAccount != nil &&
+		requestingAccount.ID == s.AccountID {
+		apiStatus.CircleID = util.Ptr(s.CircleID)
 	}
 
 	// If web URL is empty for whatever
@@ -1476,6 +2059,87 @@ func (c *Converter) baseStatusToFrontend(
 	return apiStatus, nil
 }
 
+// StatusReactionsToAPIStatusReactions groups the given reactions to
+// a status by emoji, in order of the first reaction with each emoji,
+// noting whether requester (if any) reacted with it. If withAccounts
+// is set, the accounts that reacted are included for each emoji.
+//
+// Reactions using a custom emoji that no longer exists are skipped.
+func (c *Converter) StatusReactionsToAPIStatusReactions(
+	ctx context.Context,
+	reactions []*gtsmodel.StatusReaction,
+	requester *gtsmodel.Account,
+	withAccounts bool,
+) ([]apimodel.StatusReaction, error) {
+	var (
+		errs         gtserror.MultiError
+		apiReactions = make([]apimodel.StatusReaction, 0, len(reactions))
+		byName       = make(map[string]int, len(reactions))
+	)
+
+	for _, reaction := range reactions {
+		i, ok := byName[reaction.Name]
+		if !ok {
+			apiReaction := apimodel.StatusReaction{
+				Name: reaction.Name,
+			}
+
+			if reaction.IsCustom() {
+				if reaction.Emoji == nil {
+					var err error
+					reaction.Emoji, err = c.state.DB.GetEmojiByID(ctx, reaction.EmojiID)
+					if err != nil && !errors.Is(err, db.ErrNoEntries) {
+						errs.Appendf("error fetching emoji %s: %w", reaction.EmojiID, err)
+					}
+				}
+
+				if reaction.Emoji == nil {
+					// Emoji has since
+					// been deleted.
+					continue
+				}
+
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiReactions)
+			byName[reaction.Name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReaction := &apiReactions[i]
+		apiReaction.Count++
+
+		if requester != nil && reaction.AccountID == requester.ID {
+			apiReaction.Me = true
+		}
+
+		if !withAccounts {
+			continue
+		}
+
+		if reaction.Account == nil {
+			var err error
+			reaction.Account, err = c.state.DB.GetAccountByID(ctx, reaction.AccountID)
+			if err != nil {
+				errs.Appendf("error fetching account %s: %w", reaction.AccountID, err)
+				continue
+			}
+		}
+
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, reaction.Account)
+		if err != nil {
+			errs.Appendf("error converting account %s to api account: %w", reaction.AccountID, err)
+			continue
+		}
+
+		apiReaction.Accounts = append(apiReaction.Accounts, apiAccount)
+	}
+
+	return apiReactions, errs.Combine()
+}
+
 // VisToAPIVis converts a gts visibility into its api equivalent
 func (c *Converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility {
 	switch m {
@@ -1491,6 +2155,18 @@ func (c *Converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apim
 	return ""
 }
 
+// ContentTypeToAPIContentType converts the given status content type
+// into its api equivalent, or an empty string if it's not set.
+func (c *Converter) ContentTypeToAPIContentType(m gtsmodel.StatusContentType) apimodel.StatusContentType {
+	switch m {
+	case gtsmodel.StatusContentTypePlain:
+		return apimodel.StatusContentTypePlain
+	case gtsmodel.StatusContentTypeMarkdown:
+		return apimodel.StatusContentTypeMarkdown
+	}
+	return ""
+}
+
 // InstanceRuleToAdminAPIRule converts a local instance rule into its api equivalent for serving at /api/v1/admin/instance/rules/:id
 func (c *Converter) InstanceRuleToAPIRule(r gtsmodel.Rule) apimodel.InstanceRule {
 	return apimodel.InstanceRule{
@@ -1541,8 +2217,8 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Version:              config.GetSoftwareVersion(),
 		Languages:            config.GetInstanceLanguages().TagStrs(),
 		Registrations:        config.GetAccountsRegistrationOpen(),
-		ApprovalRequired:     true,                               // approval always required
-		InvitesEnabled:       false,                              // todo: not supported yet
+		ApprovalRequired:     true, // approval always required
+		InvitesEnabled:       config.GetAccountsAllowUserInvites(),
 		MaxTootChars:         uint(config.GetStatusesMaxChars()), // #nosec G115 -- Already validated.
 		Rules:                c.InstanceRulesToAPIRules(i.Rules),
 		Terms:                i.Terms,
@@ -1580,6 +2256,7 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.MediaAttachments.ImageMatrixLimit = math.MaxInt32
 	instance.Configuration.MediaAttachments.VideoFrameRateLimit = math.MaxInt32
 	instance.Configuration.MediaAttachments.VideoMatrixLimit = math.MaxInt32
+	instance.Configuration.MediaAttachments.DescriptionRequired = config.GetMediaDescriptionRequired()
 
 	instance.Configuration.Polls.MaxOptions = config.GetStatusesPollMaxOptions()
 	instance.Configuration.Polls.MaxCharactersPerOption = config.GetStatusesPollOptionMaxChars()
@@ -1589,6 +2266,7 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize()) // #nosec G115 -- Already validated.
+	instance.Configuration.OAuth.CodeChallengeMethodsSupported = instanceOAuthCodeChallengeMethods()
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
 
 	// URLs
@@ -1744,6 +2422,7 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.MediaAttachments.ImageMatrixLimit = math.MaxInt32
 	instance.Configuration.MediaAttachments.VideoFrameRateLimit = math.MaxInt32
 	instance.Configuration.MediaAttachments.VideoMatrixLimit = math.MaxInt32
+	instance.Configuration.MediaAttachments.DescriptionRequired = config.GetMediaDescriptionRequired()
 
 	instance.Configuration.Polls.MaxOptions = config.GetStatusesPollMaxOptions()
 	instance.Configuration.Polls.MaxCharactersPerOption = config.GetStatusesPollOptionMaxChars()
@@ -1753,12 +2432,31 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize()) // #nosec G115 -- Already validated.
+	instance.Configuration.OAuth.CodeChallengeMethodsSupported = instanceOAuthCodeChallengeMethods()
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
+	instance.Configuration.Translation.Enabled = config.GetTranslationProvider() != ""
 
 	// registrations
 	instance.Registrations.Enabled = config.GetAccountsRegistrationOpen()
 	instance.Registrations.ApprovalRequired = true // always required
 	instance.Registrations.Message = nil           // todo: not implemented
+	if provider := config.GetAccountsCaptchaProvider(); provider != "" {
+		instance.Registrations.Captcha = &apimodel.InstanceV2RegistrationsCaptcha{
+			Provider: provider,
+			SiteKey:  config.GetAccountsCaptchaSiteKey(),
+		}
+	}
+
+	questions, err := c.state.DB.GetSignupQuestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("InstanceToAPIV2Instance: db error getting sign-up questions: %w", err)
+	}
+	for _, question := range questions {
+		instance.Registrations.Questions = append(
+			instance.Registrations.Questions,
+			c.SignupQuestionToAPISignupQuestion(question),
+		)
+	}
 
 	// contact
 	instance.Contact.Email = i.ContactEmail
@@ -1795,6 +2493,7 @@ func (c *Converter) RelationshipToAPIRelationship(ctx context.Context, r *gtsmod
 		MutingNotifications: r.MutingNotifications,
 		Requested:           r.Requested,
 		RequestedBy:         r.RequestedBy,
+		RequestedByNote:     r.RequestedByNote,
 		DomainBlocking:      r.DomainBlocking,
 		Endorsed:            r.Endorsed,
 		Note:                r.Note,
@@ -1862,12 +2561,214 @@ func (c *Converter) NotificationToAPINotification(
 		apiStatus = apiStatus.Reblog.Status
 	}
 
-	return &apimodel.Notification{
+	var apiEvent *apimodel.RelationshipSeveranceEvent
+	if n.SeveranceEventID != "" {
+		event, err := c.state.DB.GetSeveranceEventByID(ctx, n.SeveranceEventID)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationToapi: error getting severance event with id %s from the db: %s", n.SeveranceEventID, err)
+		}
+
+		apiEvent, err = c.SeveranceEventToAPISeveranceEvent(ctx, event, n.TargetAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationToapi: error converting severance event to api: %s", err)
+		}
+	}
+
+	apiNotif := &apimodel.Notification{
 		ID:        n.ID,
 		Type:      n.NotificationType.String(),
 		CreatedAt: util.FormatISO8601(n.CreatedAt),
 		Account:   apiAccount,
 		Status:    apiStatus,
+		Event:     apiEvent,
+	}
+
+	if n.NotificationType == gtsmodel.NotificationEmojiReaction {
+		// Notifications don't store the reaction itself,
+		// so use the latest reaction by the origin account
+		// to the status (if it hasn't since been undone).
+		reactions, err := c.state.DB.GetStatusReactions(gtscontext.SetBarebones(ctx), n.StatusID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("NotificationToapi: error getting reactions for status %s: %w", n.StatusID, err)
+		}
+
+		for i := len(reactions) - 1; i >= 0; i-- {
+			if reactions[i].AccountID != n.OriginAccountID {
+				continue
+			}
+
+			apiReactions, err := c.StatusReactionsToAPIStatusReactions(ctx,
+				reactions[i:i+1],
+				nil,
+				false,
+			)
+			if err != nil {
+				log.Errorf(ctx, "error converting reaction %s: %v", reactions[i].ID, err)
+			}
+
+			if len(apiReactions) == 1 {
+				apiNotif.Emoji = apiReactions[0].Name
+				apiNotif.EmojiURL = apiReactions[0].URL
+			}
+
+			break
+		}
+	}
+
+	return apiNotif, nil
+}
+
+// SeveranceEventToAPISeveranceEvent converts a relationship severance
+// event into its API representation, counting the relationships
+// that the given account lost in it.
+func (c *Converter) SeveranceEventToAPISeveranceEvent(
+	ctx context.Context,
+	event *gtsmodel.RelationshipSeveranceEvent,
+	accountID string,
+) (*apimodel.RelationshipSeveranceEvent, error) {
+	rels, err := c.state.DB.GetSeveredRelationships(
+		gtscontext.SetBarebones(ctx),
+		event.ID,
+		accountID,
+		0,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting severed relationships: %w", err)
+	}
+
+	apiEvent := &apimodel.RelationshipSeveranceEvent{
+		ID:         event.ID,
+		Type:       event.Type.String(),
+		Purged:     true,
+		TargetName: event.TargetName,
+		CreatedAt:  util.FormatISO8601(event.CreatedAt),
+	}
+
+	for _, rel := range rels {
+		switch rel.Direction {
+		case gtsmodel.SeveredFollower:
+			apiEvent.FollowersCount++
+		case gtsmodel.SeveredFollowing:
+			apiEvent.FollowingCount++
+		}
+	}
+
+	return apiEvent, nil
+}
+
+// SeveredRelationshipToAPISeveredRelationship converts
+// a severed relationship into its API representation.
+func (c *Converter) SeveredRelationshipToAPISeveredRelationship(
+	ctx context.Context,
+	rel *gtsmodel.SeveredRelationship,
+) (*apimodel.SeveredRelationship, error) {
+	if rel.TargetAccount == nil {
+		var err error
+		rel.TargetAccount, err = c.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			rel.TargetAccountID,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting target account %s: %w", rel.TargetAccountID, err)
+		}
+	}
+
+	acct := rel.TargetAccount.Username
+	if rel.TargetAccount.IsRemote() {
+		acct += "@" + rel.TargetAccount.Domain
+	}
+
+	return &apimodel.SeveredRelationship{
+		ID:        rel.ID,
+		Direction: rel.Direction.String(),
+		Acct:      acct,
+		URI:       rel.TargetAccount.URI,
+	}, nil
+}
+
+// NotificationRequestToAPINotificationRequest converts a notification
+// request into its API representation, counting the filtered notifications
+// it holds. The given filters and mutes are applied to the last status.
+func (c *Converter) NotificationRequestToAPINotificationRequest(
+	ctx context.Context,
+	req *gtsmodel.NotificationRequest,
+	filters []*gtsmodel.Filter,
+	mutes *usermute.CompiledUserMuteList,
+) (*apimodel.NotificationRequest, error) {
+	if err := c.state.DB.PopulateNotificationRequest(ctx, req); err != nil {
+		return nil, gtserror.Newf("error populating notification request: %w", err)
+	}
+
+	notifIDs, err := c.state.DB.GetFilteredNotificationIDs(ctx, req.AccountID, req.OriginAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting filtered notifications: %w", err)
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, req.OriginAccount)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account to api: %w", err)
+	}
+
+	var apiStatus *apimodel.Status
+	if req.LastStatus != nil {
+		apiStatus, err = c.StatusToAPIStatus(ctx,
+			req.LastStatus,
+			req.Account,
+			statusfilter.FilterContextNotifications,
+			filters,
+			mutes,
+		)
+		if err != nil && !errors.Is(err, statusfilter.ErrHideStatus) {
+			return nil, gtserror.Newf("error converting status to api: %w", err)
+		}
+	}
+
+	return &apimodel.NotificationRequest{
+		ID:                 req.ID,
+		CreatedAt:          util.FormatISO8601(req.CreatedAt),
+		UpdatedAt:          util.FormatISO8601(req.UpdatedAt),
+		Account:            apiAccount,
+		NotificationsCount: strconv.Itoa(len(notifIDs)),
+		LastStatus:         apiStatus,
+	}, nil
+}
+
+// NotificationPolicyToAPINotificationPolicy converts the notification
+// policy stored in the given account settings into its API representation,
+// summarizing the notifications currently held back by it.
+func (c *Converter) NotificationPolicyToAPINotificationPolicy(
+	ctx context.Context,
+	settings *gtsmodel.AccountSettings,
+) (*apimodel.NotificationPolicy, error) {
+	requestsCount, err := c.state.DB.CountAccountNotificationRequests(ctx, settings.AccountID)
+	if err != nil {
+		return nil, gtserror.Newf("db error counting notification requests: %w", err)
+	}
+
+	notifIDs, err := c.state.DB.GetFilteredNotificationIDs(ctx, settings.AccountID, "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting filtered notifications: %w", err)
+	}
+
+	// Treat unset values as
+	// the default, "accept".
+	policyString := func(value gtsmodel.NotificationPolicyValue) string {
+		if value == gtsmodel.NotificationPolicyUnknown {
+			value = gtsmodel.NotificationPolicyAccept
+		}
+		return value.String()
+	}
+
+	return &apimodel.NotificationPolicy{
+		ForNotFollowing:    policyString(settings.NotificationPolicyNotFollowing),
+		ForNotFollowers:    policyString(settings.NotificationPolicyNotFollowers),
+		ForNewAccounts:     policyString(settings.NotificationPolicyNewAccounts),
+		ForPrivateMentions: policyString(settings.NotificationPolicyPrivateMentions),
+		ForLimitedAccounts: policyString(settings.NotificationPolicyLimitedAccounts),
+		Summary: apimodel.NotificationPolicySummary{
+			PendingRequestsCount:      requestsCount,
+			PendingNotificationsCount: len(notifIDs),
+		},
 	}, nil
 }
 
@@ -1882,8 +2783,10 @@ func (c *Converter) ConversationToAPIConversation(
 	mutes *usermute.CompiledUserMuteList,
 ) (*apimodel.Conversation, error) {
 	apiConversation := &apimodel.Conversation{
-		ID:     conversation.ID,
-		Unread: !*conversation.Read,
+		ID:               conversation.ID,
+		Unread:           !*conversation.Read,
+		Muted:            util.PtrOrValue(conversation.Muted, false),
+		LastReadStatusID: conversation.LastReadStatusID,
 	}
 
 	// Populate most recent status in convo;
@@ -2003,16 +2906,350 @@ func (c *Converter) DomainPermToAPIDomainPerm(
 		domainPerm.PermissionType = d.GetType().String()
 	}
 
+	// If this is an allow, also add any authorized fetch mode
+	// and rate limit overrides, media policy, and limit.
+	if allow, ok := d.(*gtsmodel.DomainAllow); ok {
+		domainPerm.AuthorizedFetchMode = allow.AuthorizedFetchMode
+		domainPerm.DeliveryRateLimit = allow.DeliveryRateLimit
+		domainPerm.DereferenceRateLimit = allow.DereferenceRateLimit
+		domainPerm.MediaPolicy = allow.MediaPolicy
+		domainPerm.Limited = util.PtrOrZero(allow.Limited)
+	}
+
 	return domainPerm, nil
 }
 
+// MediaRetentionPolicyToAPIMediaRetentionPolicy converts a gts
+// model media retention policy into its api representation.
+func (c *Converter) MediaRetentionPolicyToAPIMediaRetentionPolicy(
+	ctx context.Context,
+	p *gtsmodel.MediaRetentionPolicy,
+) (*apimodel.MediaRetentionPolicy, error) {
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	domain, err := util.DePunify(p.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying domain %s: %w", p.Domain, err)
+	}
+
+	return &apimodel.MediaRetentionPolicy{
+		ID:              p.ID,
+		Domain:          domain,
+		RemoteCacheDays: p.RemoteCacheDays,
+		PrivateComment:  p.PrivateComment,
+		CreatedBy:       p.CreatedByAccountID,
+		CreatedAt:       util.FormatISO8601(p.CreatedAt),
+		UpdatedAt:       util.FormatISO8601(p.UpdatedAt),
+	}, nil
+}
+
+// RelayToAPIRelay converts a gts model
+// relay into its api representation.
+func (c *Converter) RelayToAPIRelay(
+	ctx context.Context,
+	r *gtsmodel.Relay,
+) (*apimodel.Relay, error) {
+	return &apimodel.Relay{
+		ID:        r.ID,
+		ActorURI:  r.ActorURI,
+		InboxURI:  r.InboxURI,
+		State:     r.State.String(),
+		Enabled:   util.PtrOrZero(r.Enabled),
+		CreatedBy: r.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(r.CreatedAt),
+		UpdatedAt: util.FormatISO8601(r.UpdatedAt),
+	}, nil
+}
+
+// DeadLetterToAPIDeadLetter converts a gts model
+// dead letter into its api representation.
+func (c *Converter) DeadLetterToAPIDeadLetter(
+	ctx context.Context,
+	d *gtsmodel.DeadLetter,
+) (*apimodel.DeadLetter, error) {
+	return &apimodel.DeadLetter{
+		ID:        d.ID,
+		CreatedAt: util.FormatISO8601(d.CreatedAt),
+		Domain:    d.Domain,
+		InboxURI:  d.InboxURI,
+		ActorID:   d.ActorID,
+		ObjectID:  d.ObjectID,
+		TargetID:  d.TargetID,
+		Error:     d.Error,
+	}, nil
+}
+
+// AdminActionToAPIAdminAction converts a gts
+// model admin action into its api representation.
+func (c *Converter) AdminActionToAPIAdminAction(
+	ctx context.Context,
+	a *gtsmodel.AdminAction,
+) (*apimodel.AdminAction, error) {
+	var state string
+	switch {
+	case a.IsLifted():
+		state = "lifted"
+	case !a.CompletedAt.IsZero():
+		state = "active"
+	case a.IsPending():
+		state = "scheduled"
+	default:
+		state = "running"
+	}
+
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return util.FormatISO8601(t)
+	}
+
+	return &apimodel.AdminAction{
+		ID:             a.ID,
+		CreatedAt:      util.FormatISO8601(a.CreatedAt),
+		TargetCategory: a.TargetCategory.String(),
+		TargetID:       a.TargetID,
+		Type:           a.Type.String(),
+		AccountID:      a.AccountID,
+		Text:           a.Text,
+		State:          state,
+		ScheduledAt:    formatTime(a.ScheduledAt),
+		CompletedAt:    formatTime(a.CompletedAt),
+		ExpiresAt:      formatTime(a.ExpiresAt),
+		LiftedAt:       formatTime(a.LiftedAt),
+		Errors:         a.Errors,
+	}, nil
+}
+
+// AdminActionToAPIStrike converts a gts model admin action against
+// an account, and any appeal against it, into an api model strike,
+// as seen by the account that the action was taken against.
+func (c *Converter) AdminActionToAPIStrike(
+	a *gtsmodel.AdminAction,
+	appeal *gtsmodel.Appeal,
+) *apimodel.Strike {
+	strike := &apimodel.Strike{
+		ID:        a.ID,
+		CreatedAt: util.FormatISO8601(a.CreatedAt),
+		Type:      a.Type.String(),
+		Text:      a.Text,
+		Active:    !a.CompletedAt.IsZero() && !a.IsLifted(),
+	}
+
+	if !a.ExpiresAt.IsZero() {
+		strike.ExpiresAt = util.FormatISO8601(a.ExpiresAt)
+	}
+
+	if appeal != nil {
+		strike.Appeal = c.AppealToAPIAppeal(appeal)
+	}
+
+	return strike
+}
+
+// AppealToAPIAppeal converts a gts model appeal into
+// an api model appeal, as seen by the appealing account.
+func (c *Converter) AppealToAPIAppeal(a *gtsmodel.Appeal) *apimodel.Appeal {
+	appeal := &apimodel.Appeal{
+		ID:            a.ID,
+		CreatedAt:     util.FormatISO8601(a.CreatedAt),
+		StrikeID:      a.AdminActionID,
+		Text:          a.Text,
+		State:         a.State.String(),
+		ReviewComment: a.ReviewComment,
+	}
+
+	if !a.ReviewedAt.IsZero() {
+		appeal.ReviewedAt = util.FormatISO8601(a.ReviewedAt)
+	}
+
+	return appeal
+}
+
+// AppealToAdminAPIAppeal converts a gts model appeal
+// into an api model appeal, as seen by admins.
+func (c *Converter) AppealToAdminAPIAppeal(
+	ctx context.Context,
+	a *gtsmodel.Appeal,
+) (*apimodel.AdminAppeal, error) {
+	if err := c.state.DB.PopulateAppeal(ctx, a); err != nil {
+		return nil, gtserror.Newf("error populating appeal: %w", err)
+	}
+
+	account, err := c.AccountToAdminAPIAccount(ctx, a.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting appeal account: %w", err)
+	}
+
+	action, err := c.AdminActionToAPIAdminAction(ctx, a.AdminAction)
+	if err != nil {
+		return nil, gtserror.Newf("error converting appeal action: %w", err)
+	}
+
+	appeal := &apimodel.AdminAppeal{
+		ID:            a.ID,
+		CreatedAt:     util.FormatISO8601(a.CreatedAt),
+		UpdatedAt:     util.FormatISO8601(a.UpdatedAt),
+		Account:       account,
+		Action:        action,
+		Text:          a.Text,
+		State:         a.State.String(),
+		ReviewComment: a.ReviewComment,
+	}
+
+	if !a.ReviewedAt.IsZero() {
+		appeal.ReviewedAt = util.FormatISO8601(a.ReviewedAt)
+	}
+
+	if a.ReviewedByAccount != nil {
+		appeal.ReviewedByAccount, err = c.AccountToAdminAPIAccount(ctx, a.ReviewedByAccount)
+		if err != nil {
+			return nil, gtserror.Newf("error converting appeal reviewer account: %w", err)
+		}
+	}
+
+	return appeal, nil
+}
+
+// SpamFlagToAdminAPISpamFlag converts a gts model
+// spam flag into an api model spam flag, as seen by admins.
+func (c *Converter) SpamFlagToAdminAPISpamFlag(
+	ctx context.Context,
+	f *gtsmodel.SpamFlag,
+) (*apimodel.AdminSpamFlag, error) {
+	if err := c.state.DB.PopulateSpamFlag(ctx, f); err != nil {
+		return nil, gtserror.Newf("error populating spam flag: %w", err)
+	}
+
+	account, err := c.AccountToAdminAPIAccount(ctx, f.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting spam flag account: %w", err)
+	}
+
+	targetAccount, err := c.AccountToAdminAPIAccount(ctx, f.TargetAccount)
+	if err != nil {
+		return nil, gtserror.Newf("error converting spam flag target account: %w", err)
+	}
+
+	action := config.InstanceFederationSpamScoreActionDrop
+	if *f.SinBinned {
+		action = config.InstanceFederationSpamScoreActionSinBin
+	}
+
+	reasons := f.Reasons
+	if reasons == nil {
+		reasons = make([]string, 0)
+	}
+
+	flag := &apimodel.AdminSpamFlag{
+		ID:            f.ID,
+		CreatedAt:     util.FormatISO8601(f.CreatedAt),
+		UpdatedAt:     util.FormatISO8601(f.UpdatedAt),
+		StatusURI:     f.StatusURI,
+		Account:       account,
+		TargetAccount: targetAccount,
+		Score:         f.Score,
+		Reasons:       reasons,
+		Action:        action,
+	}
+
+	if f.IsFalsePositive() {
+		flag.FalsePositiveAt = util.FormatISO8601(f.FalsePositiveAt)
+	}
+
+	if f.FalsePositiveByAccount != nil {
+		flag.FalsePositiveByAccount, err = c.AccountToAdminAPIAccount(ctx, f.FalsePositiveByAccount)
+		if err != nil {
+			return nil, gtserror.Newf("error converting spam flag false positive by account: %w", err)
+		}
+	}
+
+	return flag, nil
+}
+
+// AuditLogEntryToAdminAPIAuditLogEntry converts a gts
+// model audit log entry into its admin api representation.
+func (c *Converter) AuditLogEntryToAdminAPIAuditLogEntry(
+	ctx context.Context,
+	e *gtsmodel.AuditLogEntry,
+) (*apimodel.AdminAuditLogEntry, error) {
+	if err := c.state.DB.PopulateAuditLogEntry(ctx, e); err != nil {
+		return nil, gtserror.Newf("error populating audit log entry: %w", err)
+	}
+
+	account, err := c.AccountToAdminAPIAccount(ctx, e.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting audit log entry account: %w", err)
+	}
+
+	return &apimodel.AdminAuditLogEntry{
+		ID:         e.ID,
+		CreatedAt:  util.FormatISO8601(e.CreatedAt),
+		Account:    account,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Text:       e.Text,
+	}, nil
+}
+
+// AccountArchiveToAPIAccountArchive converts a gts
+// model account archive into its api representation.
+func (c *Converter) AccountArchiveToAPIAccountArchive(
+	ctx context.Context,
+	a *gtsmodel.AccountArchive,
+) (*apimodel.AccountArchive, error) {
+	interval := time.Duration(config.GetAccountsArchiveInterval()) * 24 * time.Hour
+	return &apimodel.AccountArchive{
+		ID:            a.ID,
+		CreatedAt:     util.FormatISO8601(a.CreatedAt),
+		UpdatedAt:     util.FormatISO8601(a.UpdatedAt),
+		State:         a.State.String(),
+		Progress:      a.Progress,
+		Size:          a.FileSize,
+		NextRequestAt: util.FormatISO8601(a.NextRequestAt(interval)),
+	}, nil
+}
+
+// AccountImportToAPIAccountImport converts a gts
+// model account import into its api representation.
+func (c *Converter) AccountImportToAPIAccountImport(
+	ctx context.Context,
+	i *gtsmodel.AccountImport,
+) (*apimodel.AccountImport, error) {
+	mode := "merge"
+	if *i.Overwrite {
+		mode = "overwrite"
+	}
+
+	failures := make([]apimodel.AccountImportFailure, len(i.Failures))
+	for n, f := range i.Failures {
+		failures[n] = apimodel.AccountImportFailure{
+			Entry: f.Entry,
+			Error: f.Error,
+		}
+	}
+
+	return &apimodel.AccountImport{
+		ID:        i.ID,
+		CreatedAt: util.FormatISO8601(i.CreatedAt),
+		UpdatedAt: util.FormatISO8601(i.UpdatedAt),
+		Type:      i.Type,
+		Mode:      mode,
+		State:     i.State.String(),
+		Total:     i.Total,
+		Processed: i.Processed,
+		Failures:  failures,
+	}, nil
+}
+
 // ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
 		ID:          r.ID,
 		CreatedAt:   util.FormatISO8601(r.CreatedAt),
 		ActionTaken: !r.ActionTakenAt.IsZero(),
-		Category:    "other", // todo: only support default 'other' category right now
+		Category:    r.Category.String(),
 		Comment:     r.Comment,
 		Forwarded:   *r.Forwarded,
 		StatusIDs:   r.StatusIDs,
@@ -2124,6 +3361,7 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 	}
 
 	rules := make([]*apimodel.InstanceRule, 0, len(r.RuleIDs))
+	ruleIDs := make([]string, 0, len(r.RuleIDs))
 	if len(r.RuleIDs) != 0 && len(r.Rules) == 0 {
 		r.Rules, err = c.state.DB.GetRulesByIDs(ctx, r.RuleIDs)
 		if err != nil {
@@ -2135,6 +3373,7 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 			ID:   v.ID,
 			Text: v.Text,
 		})
+		ruleIDs = append(ruleIDs, v.ID)
 	}
 
 	if ac := r.ActionTaken; ac != "" {
@@ -2145,7 +3384,7 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		ID:                   r.ID,
 		ActionTaken:          !r.ActionTakenAt.IsZero(),
 		ActionTakenAt:        actionTakenAt,
-		Category:             "other", // todo: only support default 'other' category right now
+		Category:             r.Category.String(),
 		Comment:              r.Comment,
 		Forwarded:            *r.Forwarded,
 		CreatedAt:            util.FormatISO8601(r.CreatedAt),
@@ -2157,6 +3396,7 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		ActionTakenComment:   actionTakenComment,
 		Statuses:             statuses,
 		Rules:                rules,
+		RuleIDs:              ruleIDs,
 	}, nil
 }
 
@@ -2170,6 +3410,14 @@ func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimo
 	}, nil
 }
 
+// CircleToAPICircle converts one gts model circle into an api model circle, for serving at /api/v1/circles
+func (c *Converter) CircleToAPICircle(ctx context.Context, circle *gtsmodel.Circle) (*apimodel.Circle, error) {
+	return &apimodel.Circle{
+		ID:    circle.ID,
+		Title: circle.Title,
+	}, nil
+}
+
 // MarkersToAPIMarker converts several gts model markers into an api marker, for serving at /api/v1/markers
 func (c *Converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error) {
 	apiMarker := &apimodel.Marker{}
@@ -134,7 +134,15 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved()
     "follow_requests_count": 0,
     "also_known_as_uris": [
       "http://localhost:8080/users/1happyturtle"
-    ]
+    ],
+    "status_expiry_days": 0,
+    "status_expiry_keep_pinned": true,
+    "status_expiry_keep_self_faved": true,
+    "email_digest_days": 0,
+    "email_digest_follows": true,
+    "email_digest_mentions": true,
+    "email_digest_follow_requests": true,
+    "require_media_descriptions": false
   },
   "enable_rss": true,
   "role": {
@@ -323,7 +331,15 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
     "status_content_type": "text/plain",
     "note": "hey yo this is my profile!",
     "fields": [],
-    "follow_requests_count": 0
+    "follow_requests_count": 0,
+    "status_expiry_days": 0,
+    "status_expiry_keep_pinned": true,
+    "status_expiry_keep_self_faved": true,
+    "email_digest_days": 0,
+    "email_digest_follows": true,
+    "email_digest_mentions": true,
+    "email_digest_follow_requests": true,
+    "require_media_descriptions": false
   },
   "enable_rss": true,
   "role": {
@@ -592,6 +608,32 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendRemoteCounts() {
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["remote_account_1_status_1"]
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	// Origin server reports more faves and boosts
+	// than we know of, but fewer replies.
+	testStatus.RemoteFavesCount = util.Ptr(42)
+	testStatus.RemoteReblogsCount = util.Ptr(7)
+	testStatus.RemoteRepliesCount = util.Ptr(0)
+
+	localReplies, err := suite.db.CountStatusReplies(context.Background(), testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(context.Background(), testStatus, requestingAccount, statusfilter.FilterContextNone, nil, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(42, apiStatus.FavouritesCount)
+	suite.Equal(7, apiStatus.ReblogsCount)
+	suite.Equal(localReplies, apiStatus.RepliesCount)
+}
+
 // Modify a fixture status into a status that should be filtered,
 // and then filter it, returning the API status or any error from converting it.
 func (suite *InternalToFrontendTestSuite) filteredStatusToFrontend(action gtsmodel.FilterAction, boost bool) (*apimodel.Status, error) {
@@ -1971,7 +2013,8 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -1986,6 +2029,12 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "urls": {
@@ -2116,7 +2165,8 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
       "image_matrix_limit": 2147483647,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 2147483647,
-      "video_matrix_limit": 2147483647
+      "video_matrix_limit": 2147483647,
+      "description_required": false
     },
     "polls": {
       "max_options": 6,
@@ -2129,6 +2179,12 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
     },
     "emojis": {
       "emoji_size_limit": 51200
+    },
+    "oauth": {
+      "code_challenge_methods_supported": [
+        "plain",
+        "S256"
+      ]
     }
   },
   "registrations": {
@@ -2249,7 +2305,7 @@ func (suite *InternalToFrontendTestSuite) TestReportToFrontend1() {
   "action_taken": false,
   "action_taken_at": null,
   "action_taken_comment": null,
-  "category": "other",
+  "category": "violation",
   "comment": "dark souls sucks, please yeet this nerd",
   "forwarded": true,
   "status_ids": [
@@ -2477,7 +2533,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "546037",
       "highlighted": true
     },
     "confirmed": true,
@@ -2532,7 +2588,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "546037",
       "highlighted": true
     },
     "confirmed": true,
@@ -2575,6 +2631,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
   },
   "statuses": [],
   "rules": [],
+  "rule_ids": [],
   "action_taken_comment": "user was warned not to be a turtle anymore"
 }`, string(b))
 }
@@ -2591,7 +2648,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
   "id": "01GP3AWY4CRDVRNZKW0TEAMB5R",
   "action_taken": false,
   "action_taken_at": null,
-  "category": "other",
+  "category": "violation",
   "comment": "dark souls sucks, please yeet this nerd",
   "forwarded": true,
   "created_at": "2022-05-14T10:20:03.000Z",
@@ -2819,6 +2876,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "text": "Do crime"
     }
   ],
+  "rule_ids": [
+    "01GP3AWY4CRDVRNZKW0TEAMB51",
+    "01GP3DFY9XQ1TJMZT5BGAZPXX3"
+  ],
   "action_taken_comment": null
 }`, string(b))
 }
@@ -2970,7 +3031,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "546037",
       "highlighted": true
     },
     "confirmed": true,
@@ -3025,7 +3086,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "546037",
       "highlighted": true
     },
     "confirmed": true,
@@ -3068,6 +3129,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
   },
   "statuses": [],
   "rules": [],
+  "rule_ids": [],
   "action_taken_comment": "user was warned not to be a turtle anymore"
 }`, string(b))
 }
@@ -3442,6 +3504,7 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPISelfConvo() {
 	suite.Equal(`{
   "id": "01J9C6K86PKZ5GY5WXV94DGH6R",
   "unread": false,
+  "muted": false,
   "accounts": [
     {
       "id": "01F8MH1H7YV1Z7D2C8K2730QBF",
@@ -3600,6 +3663,7 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPI() {
 	suite.Equal(`{
   "id": "01J9C6K86PKZ5GY5WXV94DGH6R",
   "unread": true,
+  "muted": false,
   "accounts": [
     {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -3723,6 +3787,33 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPI() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestAccountToAdminAPIAccountPendingEmailChange() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Request an email change
+	// for the test user.
+	user, err := suite.db.GetUserByAccountID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	user.UnconfirmedEmail = "someone.else@example.org"
+	if err := suite.db.UpdateUser(ctx, user, "unconfirmed_email"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	adminAccount, err := suite.typeconverter.AccountToAdminAPIAccount(ctx, testAccount)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Old email should still be in use,
+	// with the new one shown as pending.
+	suite.Equal(user.Email, adminAccount.Email)
+	suite.Equal("someone.else@example.org", adminAccount.UnconfirmedEmail)
+	suite.True(adminAccount.Confirmed)
+}
+
 func TestInternalToFrontendTestSuite(t *testing.T) {
 	suite.Run(t, new(InternalToFrontendTestSuite))
 }
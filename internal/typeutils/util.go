@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"math"
 	"net/url"
 	"path"
@@ -60,6 +61,21 @@ func toAPIFrameRate(framerate *float32) string {
 	return strconv.Itoa(int(round)) + "/1"
 }
 
+// toAPIWaveform converts stored media waveform
+// peaks (0-255) to API waveform values (0-1),
+// rounded to two decimal places for brevity.
+func toAPIWaveform(waveform []byte) []float32 {
+	if len(waveform) == 0 {
+		return nil
+	}
+	peaks := make([]float32, len(waveform))
+	for i, peak := range waveform {
+		round := math.Round(float64(peak) / 255 * 100)
+		peaks[i] = float32(round / 100)
+	}
+	return peaks
+}
+
 type statusInteractions struct {
 	Favourited bool
 	Muted      bool
@@ -190,6 +206,84 @@ func placeholderAttachments(arr []*apimodel.Attachment) (string, []*apimodel.Att
 	return text.SanitizeToHTML(note.String()), arr
 }
 
+// quoteInlineFallback returns a fallback link to the status
+// quoted by the given status, for appending to the content of
+// outgoing quotes, so that implementations that don't understand
+// quotes still show *something* to their users. Eg.,
+//
+//	<p><span class="quote-inline">RE: <a href="https://example.org/@someone/statuses/01HE7Y659ZWZ02JM4AWYJZ176Q">https://example.org/@someone/statuses/01HE7Y659ZWZ02JM4AWYJZ176Q</a></span></p>
+func quoteInlineFallback(s *gtsmodel.Status) string {
+	// Prefer the web URL
+	// of the quoted status.
+	quoteURL := s.QuoteURI
+	if s.Quote != nil && s.Quote.URL != "" {
+		quoteURL = s.Quote.URL
+	}
+
+	var fallback strings.Builder
+	fallback.WriteString(`<p><span class="quote-inline">RE: `)
+	fallback.WriteString(`<a href="`)
+	fallback.WriteString(quoteURL)
+	fallback.WriteString(`">`)
+	fallback.WriteString(quoteURL)
+	fallback.WriteString(`</a></span></p>`)
+
+	return text.SanitizeToHTML(fallback.String())
+}
+
+// quoteContent prepares the content of the given
+// quote status for display to the frontend.
+//
+// If the quoted status is rendered inline, any "RE: [link]"
+// fallback to the quoted status is stripped from the end of
+// the content, as it would just duplicate the inline quote.
+//
+// Otherwise, a fallback link to the quoted status is appended
+// to the content if it doesn't already have one, so that the
+// quote is at least shown as a clearly-labelled link.
+func quoteContent(content string, s *gtsmodel.Status, inline bool) string {
+	if s.QuoteURI == "" {
+		// Not a quote.
+		return content
+	}
+
+	stripped, ok := stripQuoteFallback(content, s)
+	switch {
+	case inline:
+		return stripped
+	case ok:
+		return content
+	default:
+		return content + quoteInlineFallback(s)
+	}
+}
+
+// stripQuoteFallback strips a trailing "RE: [link]"
+// fallback to the status quoted by s from the given
+// content, returning false if no fallback was found.
+func stripQuoteFallback(content string, s *gtsmodel.Status) (string, bool) {
+	m := regexes.QuoteFallback.FindStringSubmatchIndex(content)
+	if m == nil {
+		return content, false
+	}
+
+	// Only strip the fallback if it
+	// links to the quoted status, by
+	// either its URI or its web URL.
+	href := html.UnescapeString(content[m[2]:m[3]])
+	if href != s.QuoteURI &&
+		(s.Quote == nil || href != s.Quote.URL) {
+		return content, false
+	}
+
+	// Drop the fallback, keeping any closing tags
+	// after it, and tidy up any paragraph that's
+	// now left empty as a result.
+	content = content[:m[0]] + content[m[4]:m[5]]
+	content = regexes.EmptyTrailingParagraph.ReplaceAllString(content, "")
+	return content, true
+}
+
 func (c *Converter) pendingReplyNote(
 	ctx context.Context,
 	s *gtsmodel.Status,
@@ -218,3 +218,89 @@ type testcase struct {
 		assert.Equal(t, testcase.expectedFields, fields)
 	}
 }
+
+func TestToAPIWaveform(t *testing.T) {
+	assert.Nil(t, toAPIWaveform(nil))
+	assert.Equal(t,
+		[]float32{0, 0.5, 0.75, 1},
+		toAPIWaveform([]byte{0, 128, 191, 255}),
+	)
+}
+
+func TestQuoteContent(t *testing.T) {
+	type testcase struct {
+		content  string
+		status   *gtsmodel.Status
+		inline   bool
+		expected string
+	}
+
+	var (
+		misskeyQuote = &gtsmodel.Status{
+			QuoteURI: "https://misskey.example.org/notes/9ukzvf1y7x",
+		}
+		mastodonQuote = &gtsmodel.Status{
+			QuoteURI: "https://mastodon.example.org/users/someone/statuses/113612342086339880",
+			Quote: &gtsmodel.Status{
+				URL: "https://mastodon.example.org/@someone/113612342086339880",
+			},
+		}
+	)
+
+	for i, testcase := range []testcase{
+		{
+			// Not a quote, nothing to do.
+			content:  `<p>hello world</p>`,
+			status:   &gtsmodel.Status{},
+			expected: `<p>hello world</p>`,
+		},
+		{
+			// Misskey-style fallback, quote rendered inline.
+			content:  `<p><span>look at this!<br><br>RE: <a href="https://misskey.example.org/notes/9ukzvf1y7x">https://misskey.example.org/notes/9ukzvf1y7x</a></span></p>`,
+			status:   misskeyQuote,
+			inline:   true,
+			expected: `<p><span>look at this!</span></p>`,
+		},
+		{
+			// Mastodon-style fallback paragraph with web URL, quote rendered inline.
+			content:  `<p>look at this!</p><p>RE: <a href="https://mastodon.example.org/@someone/113612342086339880" rel="nofollow noreferrer noopener" target="_blank"><span class="invisible">https://</span><span class="ellipsis">mastodon.example.org/@someone/1</span><span class="invisible">13612342086339880</span></a></p>`,
+			status:   mastodonQuote,
+			inline:   true,
+			expected: `<p>look at this!</p>`,
+		},
+		{
+			// Our own fallback, quote rendered inline.
+			content:  `<p>look at this!</p>` + quoteInlineFallback(misskeyQuote),
+			status:   misskeyQuote,
+			inline:   true,
+			expected: `<p>look at this!</p>`,
+		},
+		{
+			// Fallback links somewhere else, leave it be.
+			content:  `<p>look at this!<br>RE: <a href="https://example.org/some/page">https://example.org/some/page</a></p>`,
+			status:   misskeyQuote,
+			inline:   true,
+			expected: `<p>look at this!<br>RE: <a href="https://example.org/some/page">https://example.org/some/page</a></p>`,
+		},
+		{
+			// Fallback present, quote not rendered inline, leave it be.
+			content:  `<p><span>look at this!<br><br>RE: <a href="https://misskey.example.org/notes/9ukzvf1y7x">https://misskey.example.org/notes/9ukzvf1y7x</a></span></p>`,
+			status:   misskeyQuote,
+			expected: `<p><span>look at this!<br><br>RE: <a href="https://misskey.example.org/notes/9ukzvf1y7x">https://misskey.example.org/notes/9ukzvf1y7x</a></span></p>`,
+		},
+		{
+			// No fallback, quote not rendered inline, add one.
+			content:  `<p>look at this!</p>`,
+			status:   misskeyQuote,
+			expected: `<p>look at this!</p>` + quoteInlineFallback(misskeyQuote),
+		},
+	} {
+		content := quoteContent(testcase.content, testcase.status, testcase.inline)
+		if content != testcase.expected {
+			t.Errorf(
+				"test %d expected content '%s' got '%s'",
+				i, testcase.expected, content,
+			)
+		}
+	}
+}
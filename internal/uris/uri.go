@@ -27,27 +27,28 @@
 )
 
 const (
-	UsersPath        = "users"         // UsersPath is for serving users info
-	StatusesPath     = "statuses"      // StatusesPath is for serving statuses
-	InboxPath        = "inbox"         // InboxPath represents the activitypub inbox location
-	OutboxPath       = "outbox"        // OutboxPath represents the activitypub outbox location
-	FollowersPath    = "followers"     // FollowersPath represents the activitypub followers location
-	FollowingPath    = "following"     // FollowingPath represents the activitypub following location
-	LikedPath        = "liked"         // LikedPath represents the activitypub liked location
-	CollectionsPath  = "collections"   // CollectionsPath represents the activitypub collections location
-	FeaturedPath     = "featured"      // FeaturedPath represents the activitypub featured location
-	PublicKeyPath    = "main-key"      // PublicKeyPath is for serving an account's public key
-	FollowPath       = "follow"        // FollowPath used to generate the URI for an individual follow or follow request
-	UpdatePath       = "updates"       // UpdatePath is used to generate the URI for an account update
-	BlocksPath       = "blocks"        // BlocksPath is used to generate the URI for a block
-	MovesPath        = "moves"         // MovesPath is used to generate the URI for a move
-	ReportsPath      = "reports"       // ReportsPath is used to generate the URI for a report/flag
-	ConfirmEmailPath = "confirm_email" // ConfirmEmailPath is used to generate the URI for an email confirmation link
-	FileserverPath   = "fileserver"    // FileserverPath is a path component for serving attachments + media
-	EmojiPath        = "emoji"         // EmojiPath represents the activitypub emoji location
-	TagsPath         = "tags"          // TagsPath represents the activitypub tags location
-	AcceptsPath      = "accepts"       // AcceptsPath represents the activitypub Accept's location
-	RejectsPath      = "rejects"       // RejectsPath represents the activitypub Reject's location
+	UsersPath         = "users"                     // UsersPath is for serving users info
+	StatusesPath      = "statuses"                  // StatusesPath is for serving statuses
+	InboxPath         = "inbox"                     // InboxPath represents the activitypub inbox location
+	OutboxPath        = "outbox"                    // OutboxPath represents the activitypub outbox location
+	FollowersPath     = "followers"                 // FollowersPath represents the activitypub followers location
+	FollowersSyncPath = "followers_synchronization" // FollowersSyncPath represents the partial followers collection location used for follower synchronization
+	FollowingPath     = "following"                 // FollowingPath represents the activitypub following location
+	LikedPath         = "liked"                     // LikedPath represents the activitypub liked location
+	CollectionsPath   = "collections"               // CollectionsPath represents the activitypub collections location
+	FeaturedPath      = "featured"                  // FeaturedPath represents the activitypub featured location
+	PublicKeyPath     = "main-key"                  // PublicKeyPath is for serving an account's public key
+	FollowPath        = "follow"                    // FollowPath used to generate the URI for an individual follow or follow request
+	UpdatePath        = "updates"                   // UpdatePath is used to generate the URI for an account update
+	BlocksPath        = "blocks"                    // BlocksPath is used to generate the URI for a block
+	MovesPath         = "moves"                     // MovesPath is used to generate the URI for a move
+	ReportsPath       = "reports"                   // ReportsPath is used to generate the URI for a report/flag
+	ConfirmEmailPath  = "confirm_email"             // ConfirmEmailPath is used to generate the URI for an email confirmation link
+	FileserverPath    = "fileserver"                // FileserverPath is a path component for serving attachments + media
+	EmojiPath         = "emoji"                     // EmojiPath represents the activitypub emoji location
+	TagsPath          = "tags"                      // TagsPath represents the activitypub tags location
+	AcceptsPath       = "accepts"                   // AcceptsPath represents the activitypub Accept's location
+	RejectsPath       = "rejects"                   // RejectsPath represents the activitypub Reject's location
 )
 
 // UserURIs contains a bunch of UserURIs and URLs for a user, host, account, etc.
@@ -77,8 +78,14 @@ type UserURIs struct {
 	FeaturedCollectionURI string
 	// The URI for this user's public key, eg., https://example.org/users/example_user/publickey
 	PublicKeyURI string
+	// The URI for this user's Ed25519 public key, eg., https://example.org/users/example_user#ed25519-key
+	Ed25519PublicKeyURI string
 }
 
+// Ed25519PublicKeyFragment is the URI fragment identifying
+// an account's Ed25519 public key within its actor document.
+const Ed25519PublicKeyFragment = "ed25519-key"
+
 // GenerateURIForFollow returns the AP URI for a new follow -- something like:
 // https://example.org/users/whatever_user/follow/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForFollow(username string, thisFollowID string) string {
@@ -87,6 +94,15 @@ func GenerateURIForFollow(username string, thisFollowID string) string {
 	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, FollowPath, thisFollowID)
 }
 
+// GenerateURIForFollowersSync returns the URI of the partial followers
+// collection used for follower synchronization -- something like:
+// https://example.org/users/whatever_user/followers_synchronization
+func GenerateURIForFollowersSync(username string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s/%s", protocol, host, UsersPath, username, FollowersSyncPath)
+}
+
 // GenerateURIForLike returns the AP URI for a new like/fave -- something like:
 // https://example.org/users/whatever_user/liked/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForLike(username string, thisFavedID string) string {
@@ -174,6 +190,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 	likedURI := fmt.Sprintf("%s/%s", userURI, LikedPath)
 	collectionURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, FeaturedPath)
 	publicKeyURI := fmt.Sprintf("%s/%s", userURI, PublicKeyPath)
+	ed25519PublicKeyURI := fmt.Sprintf("%s#%s", userURI, Ed25519PublicKeyFragment)
 
 	return &UserURIs{
 		HostURL:     hostURL,
@@ -189,6 +206,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 		LikedURI:              likedURI,
 		FeaturedCollectionURI: collectionURI,
 		PublicKeyURI:          publicKeyURI,
+		Ed25519PublicKeyURI:   ed25519PublicKeyURI,
 	}
 }
 
@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// CanonicalizeEmail returns the canonical form of the given email
+// address, used to catch trivial variations of the same address:
+// the address is lowercased, and any dots or "+suffix" are removed
+// from the local part, eg., "Some.One+spam@Example.org" becomes
+// "someone@example.org".
+func CanonicalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domain, _ := strings.Cut(email, "@")
+	local, _, _ = strings.Cut(local, "+")
+	local = strings.ReplaceAll(local, ".", "")
+	return local + "@" + domain
+}
+
+// CanonicalEmailHash returns the hex-encoded SHA256
+// hash of the canonical form of the given email address.
+func CanonicalEmailHash(email string) string {
+	sum := sha256.Sum256([]byte(CanonicalizeEmail(email)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func TestCanonicalizeEmail(t *testing.T) {
+	for _, test := range []struct {
+		email  string
+		expect string
+	}{
+		{email: "someone@example.org", expect: "someone@example.org"},
+		{email: "Some.One@Example.org", expect: "someone@example.org"},
+		{email: "some.one+spam@example.org", expect: "someone@example.org"},
+		{email: " someone+a+b@example.org ", expect: "someone@example.org"},
+		{email: "someone@mail.example.org", expect: "someone@mail.example.org"},
+	} {
+		if got := util.CanonicalizeEmail(test.email); got != test.expect {
+			t.Errorf("unexpected canonical email for %q: expected %q, got %q", test.email, test.expect, got)
+		}
+	}
+}
+
+func TestCanonicalEmailHash(t *testing.T) {
+	// Variations of the same address share a hash.
+	hash := util.CanonicalEmailHash("some.one+spam@example.org")
+	if other := util.CanonicalEmailHash("SomeOne@example.org"); hash != other {
+		t.Errorf("expected matching hashes, got %s and %s", hash, other)
+	}
+
+	// sha256("someone@example.org")
+	const expect = "79a6123c2db3b110c92f2872d217545dfc5ff5147bbdd47e67e72f223747a538"
+	if hash != expect {
+		t.Errorf("unexpected hash: expected %s, got %s", expect, hash)
+	}
+}
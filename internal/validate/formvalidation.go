@@ -21,6 +21,8 @@
 	"errors"
 	"fmt"
 	"net/mail"
+	"strings"
+	"unicode"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -39,13 +41,25 @@
 	maximumShortDescriptionLength = 500
 	maximumDescriptionLength      = 5000
 	maximumSiteTermsLength        = 5000
+	maximumAnnouncementLength     = 5000
 	maximumUsernameLength         = 64
 	maximumEmojiCategoryLength    = 64
+	maximumUnicodeEmojiLength     = 64
 	maximumProfileFieldLength     = 255
 	maximumProfileFields          = 6
 	maximumListTitleLength        = 200
+	maximumFollowNoteLength       = 500
+	maximumCircleTitleLength      = 200
 	maximumFilterKeywordLength    = 40
 	maximumFilterTitleLength      = 200
+	minimumStatusExpiryDays       = 7
+	maximumStatusExpiryDays       = 3650
+	maximumEmailDigestDays        = 30
+	maximumAIScraperUserAgent     = 200
+	maximumAIScraperUserAgents    = 500
+	maximumSignupQuestionLength   = 500
+	maximumSignupAnswerLength     = 500
+	maximumRoleNameLength         = 64
 )
 
 // Password returns a helpful error if the given password
@@ -140,6 +154,56 @@ func SignUpReason(reason string, reasonRequired bool) error {
 	return nil
 }
 
+// SignupQuestion checks that the text of a sign-up question is not empty, and not too long.
+func SignupQuestion(text string) error {
+	if text == "" {
+		return errors.New("question text must not be empty")
+	}
+
+	if length := len([]rune(text)); length > maximumSignupQuestionLength {
+		return fmt.Errorf("question text should be no more than %d chars but was %d", maximumSignupQuestionLength, length)
+	}
+
+	return nil
+}
+
+// SignupAnswer checks that an answer to the given sign-up question is not empty, and not too long.
+func SignupAnswer(question string, answer string) error {
+	if answer == "" {
+		return fmt.Errorf("no answer provided to question '%s'", question)
+	}
+
+	if length := len([]rune(answer)); length > maximumSignupAnswerLength {
+		return fmt.Errorf("answer to question '%s' should be no more than %d chars but was %d", question, maximumSignupAnswerLength, length)
+	}
+
+	return nil
+}
+
+// RoleName checks that the name of a custom role is not empty,
+// not too long, and doesn't clash with a built-in role name.
+func RoleName(name string) error {
+	if name == "" {
+		return errors.New("role name must not be empty")
+	}
+
+	if length := len([]rune(name)); length > maximumRoleNameLength {
+		return fmt.Errorf("role name should be no more than %d chars but was %d", maximumRoleNameLength, length)
+	}
+
+	for _, builtin := range []apimodel.AccountRoleName{
+		apimodel.AccountRoleUser,
+		apimodel.AccountRoleModerator,
+		apimodel.AccountRoleAdmin,
+	} {
+		if strings.EqualFold(name, string(builtin)) {
+			return fmt.Errorf("role name %s is reserved for a built-in role", name)
+		}
+	}
+
+	return nil
+}
+
 // DisplayName checks that a requested display name is valid
 func DisplayName(displayName string) error {
 	// TODO: add some validation logic here -- length, characters, etc
@@ -189,6 +253,32 @@ func CustomCSS(customCSS string) error {
 	return nil
 }
 
+// StatusExpiryDays checks that the given number of days
+// after which to delete an account's statuses is sensible.
+// 0 is allowed, and means statuses should never expire.
+func StatusExpiryDays(days int) error {
+	if days == 0 {
+		return nil
+	}
+
+	if days < minimumStatusExpiryDays || days > maximumStatusExpiryDays {
+		return fmt.Errorf("status_expiry_days must be 0, or between %d and %d (inclusive), but was %d", minimumStatusExpiryDays, maximumStatusExpiryDays, days)
+	}
+
+	return nil
+}
+
+// EmailDigestDays checks that the given number of days
+// between email digests sent to an account is sensible.
+// 0 is allowed, and means digests should never be sent.
+func EmailDigestDays(days int) error {
+	if days < 0 || days > maximumEmailDigestDays {
+		return fmt.Errorf("email_digest_days must be between 0 and %d (inclusive), but was %d", maximumEmailDigestDays, days)
+	}
+
+	return nil
+}
+
 func InstanceCustomCSS(customCSS string) error {
 
 	maximumCustomCSSLength := config.GetAccountsCustomCSSLength()
@@ -217,6 +307,47 @@ func EmojiCategory(category string) error {
 	return nil
 }
 
+// UnicodeEmoji checks that the given string consists only of
+// a unicode emoji, as used for emoji reactions. Compound emojis
+// (skin tones, zero-width joiner sequences, flags, keycaps) are
+// allowed, but anything containing letters, spaces, punctuation
+// or markup is not.
+func UnicodeEmoji(emoji string) error {
+	if emoji == "" {
+		return errors.New("no emoji provided")
+	}
+
+	if length := len(emoji); length > maximumUnicodeEmojiLength {
+		return fmt.Errorf("emoji must be less than %d bytes, but provided value was %d bytes", maximumUnicodeEmojiLength, length)
+	}
+
+	var symbol bool
+	for _, r := range emoji {
+		switch {
+		case unicode.In(r, unicode.So, unicode.Me):
+			// Actual emoji symbol,
+			// or enclosing keycap.
+			symbol = true
+
+		case unicode.In(r, unicode.Sk, unicode.Mn, unicode.Cf):
+			// Modifiers, variation selectors,
+			// joiners and the like.
+
+		case r == '#' || r == '*' || ('0' <= r && r <= '9'):
+			// Keycap bases.
+
+		default:
+			return fmt.Errorf("%s is not a unicode emoji", emoji)
+		}
+	}
+
+	if !symbol {
+		return fmt.Errorf("%s is not a unicode emoji", emoji)
+	}
+
+	return nil
+}
+
 // SiteTitle ensures that the given site title is within spec.
 func SiteTitle(siteTitle string) error {
 	if length := len([]rune(siteTitle)); length > maximumSiteTitleLength {
@@ -253,6 +384,19 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// AnnouncementText ensures that the given announcement text is within spec.
+func AnnouncementText(t string) error {
+	if t == "" {
+		return errors.New("announcement text must be provided")
+	}
+
+	if length := len([]rune(t)); length > maximumAnnouncementLength {
+		return fmt.Errorf("announcement text should be no more than %d chars but given text was %d", maximumAnnouncementLength, length)
+	}
+
+	return nil
+}
+
 // ULID returns an error if the passed string is not a valid ULID.
 // The name param is used to form error messages.
 func ULID(i string, name string) error {
@@ -302,6 +446,29 @@ func ListTitle(title string) error {
 	return nil
 }
 
+// FollowNote validates the optional note
+// attached to a follow request.
+func FollowNote(note string) error {
+	if length := len([]rune(note)); length > maximumFollowNoteLength {
+		return fmt.Errorf("follow note length must be no more than %d chars, provided note was %d chars", maximumFollowNoteLength, length)
+	}
+
+	return nil
+}
+
+// CircleTitle validates the title of a new or updated Circle.
+func CircleTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("circle title must be provided, and must be no more than %d chars", maximumCircleTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumCircleTitleLength {
+		return fmt.Errorf("circle title length must be no more than %d chars, provided title was %d chars", maximumCircleTitleLength, length)
+	}
+
+	return nil
+}
+
 // ListRepliesPolicy validates the replies_policy of a new or updated list.
 func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
 	switch repliesPolicy {
@@ -339,6 +506,33 @@ func FilterKeyword(keyword string) error {
 	return nil
 }
 
+// AIScraperUserAgents validates a list of AI scraper User-Agent
+// patterns. Since patterns are served in robots.txt, they may
+// only contain printable ASCII characters, and no comments.
+func AIScraperUserAgents(userAgents []string) error {
+	if length := len(userAgents); length > maximumAIScraperUserAgents {
+		return fmt.Errorf("no more than %d ai scraper user-agents allowed, provided %d", maximumAIScraperUserAgents, length)
+	}
+
+	for _, userAgent := range userAgents {
+		if userAgent == "" {
+			return errors.New("ai scraper user-agent must not be empty")
+		}
+
+		if length := len(userAgent); length > maximumAIScraperUserAgent {
+			return fmt.Errorf("ai scraper user-agent %q must be no more than %d chars, provided %d", userAgent, maximumAIScraperUserAgent, length)
+		}
+
+		for _, r := range userAgent {
+			if r < ' ' || r > '~' || r == '#' {
+				return fmt.Errorf("ai scraper user-agent %q must only contain printable ascii characters, and no '#'", userAgent)
+			}
+		}
+	}
+
+	return nil
+}
+
 // FilterTitle validates the title of a new or updated filter.
 func FilterTitle(title string) error {
 	if title == "" {
@@ -404,7 +598,12 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 		return errors.New("form was nil")
 	}
 
-	if !config.GetAccountsRegistrationOpen() {
+	// Sign-ups with an invite code are allowed even when
+	// registration is closed; the code itself is checked
+	// by the processor, which has access to the db.
+	invited := form.InviteCode != ""
+
+	if !config.GetAccountsRegistrationOpen() && !invited {
 		return errors.New("registration is not open for this server")
 	}
 
@@ -430,5 +629,7 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 	}
 	form.Locale = locale
 
-	return SignUpReason(form.Reason, config.GetAccountsReasonRequired())
+	// Invited sign-ups don't need approval,
+	// so there's no need to ask for a reason.
+	return SignUpReason(form.Reason, config.GetAccountsReasonRequired() && !invited)
 }
@@ -20,6 +20,7 @@
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -324,6 +325,53 @@ func (suite *ValidationTestSuite) TestValidateCustomCSSTooLongUnicode() {
 	suite.EqualError(err, "custom_css must be less than 5 characters, but submitted custom_css was 10 characters")
 }
 
+func (suite *ValidationTestSuite) TestValidateStatusExpiryDays() {
+	for days, ok := range map[int]bool{
+		0:    true,
+		7:    true,
+		30:   true,
+		3650: true,
+		-1:   false,
+		1:    false,
+		6:    false,
+		3651: false,
+	} {
+		err := validate.StatusExpiryDays(days)
+		if ok {
+			suite.NoError(err, "expected %d to be valid", days)
+		} else {
+			suite.Error(err, "expected %d to be invalid", days)
+		}
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateEmailDigestDays() {
+	for days, ok := range map[int]bool{
+		0:  true,
+		1:  true,
+		7:  true,
+		30: true,
+		-1: false,
+		31: false,
+	} {
+		err := validate.EmailDigestDays(days)
+		if ok {
+			suite.NoError(err, "expected %d to be valid", days)
+		} else {
+			suite.Error(err, "expected %d to be invalid", days)
+		}
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateFollowNote() {
+	suite.NoError(validate.FollowNote(""))
+	suite.NoError(validate.FollowNote("Hi! We met at the conference last week."))
+	suite.NoError(validate.FollowNote(strings.Repeat("👋", 500)))
+
+	err := validate.FollowNote(strings.Repeat("a", 501))
+	suite.EqualError(err, "follow note length must be no more than 500 chars, provided note was 501 chars")
+}
+
 func (suite *ValidationTestSuite) TestValidateEmojiShortcode() {
 	type testStruct struct {
 		shortcode string
@@ -385,6 +433,79 @@ type testStruct struct {
 	}
 }
 
+func (suite *ValidationTestSuite) TestValidateUnicodeEmoji() {
+	for emoji, ok := range map[string]bool{
+		"🐢":         true,
+		"❤️":        true,
+		"👍🏽":        true,
+		"👩‍👩‍👧":     true,
+		"🏳️‍🌈":      true,
+		"🇳🇱":        true,
+		"1️⃣":       true,
+		"":          false,
+		"a":         false,
+		"1":         false,
+		"🐢 🐢":       false,
+		":blobcat:": false,
+		"<b>🐢</b>":  false,
+		"🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢🐢": false,
+	} {
+		err := validate.UnicodeEmoji(emoji)
+		if ok {
+			suite.NoError(err, "expected %q to be valid", emoji)
+		} else {
+			suite.Error(err, "expected %q to be invalid", emoji)
+		}
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateAIScraperUserAgents() {
+	for _, test := range []struct {
+		userAgents []string
+		ok         bool
+	}{
+		{nil, true},
+		{[]string{"GPTBot", "iaskspider/2.0", "Claude Web"}, true},
+		{[]string{""}, false},
+		{[]string{"GPTBot\nUser-agent: *"}, false},
+		{[]string{"GPTBot # comment"}, false},
+		{[]string{"🤖"}, false},
+		{[]string{strings.Repeat("a", 201)}, false},
+	} {
+		err := validate.AIScraperUserAgents(test.userAgents)
+		if test.ok {
+			suite.NoError(err, "expected %q to be valid", test.userAgents)
+		} else {
+			suite.Error(err, "expected %q to be invalid", test.userAgents)
+		}
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateSignupQuestionAndAnswer() {
+	suite.NoError(validate.SignupQuestion("How did you hear about this instance?"))
+	suite.EqualError(validate.SignupQuestion(""), "question text must not be empty")
+	suite.EqualError(validate.SignupQuestion(strings.Repeat("a", 501)), "question text should be no more than 500 chars but was 501")
+
+	const question = "What's your favourite fish?"
+	suite.NoError(validate.SignupAnswer(question, "Pike"))
+	suite.NoError(validate.SignupAnswer(question, strings.Repeat("🐟", 500)))
+	suite.EqualError(validate.SignupAnswer(question, ""), "no answer provided to question 'What's your favourite fish?'")
+	suite.EqualError(validate.SignupAnswer(question, strings.Repeat("a", 501)), "answer to question 'What's your favourite fish?' should be no more than 500 chars but was 501")
+}
+
+func (suite *ValidationTestSuite) TestValidateRoleName() {
+	suite.NoError(validate.RoleName("Report Wrangler"))
+	suite.EqualError(validate.RoleName(""), "role name must not be empty")
+	suite.EqualError(validate.RoleName(strings.Repeat("a", 65)), "role name should be no more than 64 chars but was 65")
+	suite.EqualError(validate.RoleName("Moderator"), "role name Moderator is reserved for a built-in role")
+}
+
+func (suite *ValidationTestSuite) TestValidateAnnouncementText() {
+	suite.NoError(validate.AnnouncementText("Scheduled maintenance tonight!"))
+	suite.EqualError(validate.AnnouncementText(""), "announcement text must be provided")
+	suite.EqualError(validate.AnnouncementText(strings.Repeat("a", 5001)), "announcement text should be no more than 5000 chars but given text was 5001")
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
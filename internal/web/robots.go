@@ -19,56 +19,29 @@
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
 
 const (
 	robotsPath          = "/robots.txt"
 	robotsMetaAllowSome = "nofollow, noarchive, nositelinkssearchbox, max-image-preview:standard" // https://developers.google.com/search/docs/crawling-indexing/robots-meta-tag#robotsmeta
-	robotsTxt           = `# GoToSocial robots.txt -- to edit, see internal/web/robots.go
+	robotsTxtHeader     = `# GoToSocial robots.txt -- to edit, see internal/web/robots.go
+# AI scrapers can be edited via the /api/v1/admin/ai_scrapers endpoint
 # More info @ https://developers.google.com/search/docs/crawling-indexing/robots/intro
 
 # AI scrapers and the like.
 # https://github.com/ai-robots-txt/ai.robots.txt/
-User-agent: AI2Bot
-User-agent: Ai2Bot-Dolma
-User-agent: AdsBot-Google
-User-agent: Amazonbot
-User-agent: anthropic-ai
-User-agent: Applebot-Extended
-User-agent: Bytespider
-User-agent: CCBot
-User-agent: ChatGPT-User
-User-agent: ClaudeBot
-User-agent: Claude-Web
-User-agent: cohere-ai
-User-agent: Diffbot
-User-agent: FacebookBot
-User-agent: facebookexternalhit
-User-agent: FriendlyCrawler
-User-agent: Google-Extended
-User-agent: GoogleOther
-User-agent: GoogleOther-Image
-User-agent: GoogleOther-Video
-User-agent: GPTBot
-User-agent: iaskspider/2.0
-User-agent: ICC-Crawler
-User-agent: ImagesiftBot
-User-agent: img2dataset
-User-agent: Meta-ExternalAgent
-User-agent: Meta-ExternalFetcher
-User-agent: OAI-SearchBot
-User-agent: omgili
-User-agent: omgilibot
-User-agent: PerplexityBot
-User-agent: PetalBot
-User-agent: Scrapy
-User-agent: Timpibot
-User-agent: VelenPublicWebCrawler
-User-agent: Webzio-Extended
-User-agent: YouBot
-Disallow: /
+`
+
+	// robotsTxtRules is served after the generated AI
+	// scrapers section of robots.txt (see robotsTxt).
+	robotsTxtRules = `Disallow: /
 
 # Marketing/SEO "intelligence" data scrapers
 User-agent: AwarioRssBot
@@ -128,5 +101,29 @@
 // More granular robots meta tags are then applied for web pages
 // depending on user preferences (see internal/web).
 func (m *Module) robotsGETHandler(c *gin.Context) {
-	c.String(http.StatusOK, robotsTxt)
+	instance, err := m.getInstance(
+		gtscontext.SetBarebones(c.Request.Context()),
+		config.GetHost(),
+	)
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.String(http.StatusOK, robotsTxt(instance.AIScrapers()))
+}
+
+// robotsTxt generates robots.txt, disallowing
+// everything to the given AI scraper User-Agents.
+func robotsTxt(aiScrapers []string) string {
+	var b strings.Builder
+	b.WriteString(robotsTxtHeader)
+	for _, userAgent := range aiScrapers {
+		b.WriteString("User-agent: ")
+		b.WriteString(userAgent)
+		b.WriteString("\n")
+	}
+	b.WriteString(robotsTxtRules)
+	return b.String()
 }
@@ -25,11 +25,15 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
-const appRSSUTF8 = string(apiutil.AppRSSXML) + "; charset=utf-8"
+const (
+	appRSSUTF8 = string(apiutil.AppRSSXML) + "; charset=utf-8"
+	rssSuffix  = ".rss"
+)
 
 func (m *Module) rssFeedGETHandler(c *gin.Context) {
 	if _, err := apiutil.NegotiateAccept(c, apiutil.AppRSSXML); err != nil {
@@ -58,17 +62,38 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 		return
 	}
 
+	// If WebSub is enabled, advertise the hub
+	// and this feed's canonical URL via headers.
+	//
+	// See https://www.w3.org/TR/websub/#discovery
+	if hubURL := config.GetInstanceWebSubHubURL(); hubURL != "" {
+		selfURL := config.GetProtocol() + "://" + config.GetHost() + c.Request.URL.Path
+		c.Header(linkHeader, "<"+hubURL+`>; rel="hub", <`+selfURL+`>; rel="self"`)
+	}
+
+	m.serveRSSFeed(c, getRSSFeed, lastPostAt)
+}
+
+// serveRSSFeed serves the RSS feed returned by getRSSFeed, using
+// lastPostAt along with the eTag cache to handle cache headers
+// and to avoid generating the feed when the caller is up to date.
+func (m *Module) serveRSSFeed(
+	c *gin.Context,
+	getRSSFeed func() (string, gtserror.WithCode),
+	lastPostAt time.Time,
+) {
 	var (
-		rssFeed string // Stringified rss feed.
+		errWithCode gtserror.WithCode
+		rssFeed     string // Stringified rss feed.
 
 		cacheKey              = c.Request.URL.Path
 		cacheEntry, wasCached = m.eTagCache.Get(cacheKey)
 	)
 
 	if !wasCached || unixAfter(lastPostAt, cacheEntry.lastModified) {
-		// We either have no ETag cache entry for this account's feed,
-		// or we have an expired cache entry (account has posted since
-		// the cache entry was last generated).
+		// We either have no ETag cache entry for this feed, or
+		// we have an expired cache entry (something has been
+		// posted since the cache entry was last generated).
 		//
 		// As such, we need to generate a new ETag, and for that we need
 		// the string representation of the RSS feed.
@@ -84,8 +109,8 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 			return
 		}
 
-		// We never want lastModified to be zero, so if account
-		// has never actually posted anything, just use Now as
+		// We never want lastModified to be zero, so if nothing
+		// has actually been posted to the feed, just use Now as
 		// the lastModified time instead for cache control.
 		var lastModified time.Time
 		if lastPostAt.IsZero() {
@@ -143,7 +168,7 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 	// At this point we know that the client wants the newest
 	// representation of the RSS feed, either because they didn't
 	// submit any 'If-None-Match' / 'If-Modified-Since' cache headers,
-	// or because they did but the account has posted more recently
+	// or because they did but something has been posted more recently
 	// than the values of the submitted headers would suggest.
 	//
 	// If we had a cache hit earlier, we may not have called the
@@ -27,6 +27,7 @@
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
@@ -53,16 +54,70 @@ func (m *Module) signupGETHandler(c *gin.Context) {
 		return
 	}
 
+	var (
+		registrationOpen = config.GetAccountsRegistrationOpen()
+		reasonRequired   = config.GetAccountsReasonRequired()
+		inviteCode       = c.Query("invite")
+		inviteInvalid    bool
+		invited          bool
+		questions        []apimodel.SignupQuestion
+	)
+
+	if inviteCode != "" {
+		// A valid invite lets the visitor sign up even
+		// if registration is closed, and since invited
+		// sign-ups skip approval, no reason is needed.
+		usable, errWithCode := m.processor.User().InviteCheck(ctx, inviteCode)
+		if errWithCode != nil {
+			apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+			return
+		}
+
+		if usable {
+			registrationOpen = true
+			reasonRequired = false
+			invited = true
+		} else {
+			inviteCode = ""
+			inviteInvalid = true
+		}
+	}
+
+	if registrationOpen && !invited {
+		// Applicants who weren't invited must
+		// answer the instance's sign-up questions.
+		instanceV2, errWithCode := m.processor.InstanceGetV2(ctx)
+		if errWithCode != nil {
+			apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+			return
+		}
+		questions = instanceV2.Registrations.Questions
+	}
+
 	page := apiutil.WebPage{
 		Template: "sign-up.tmpl",
 		Instance: instance,
 		OGMeta:   apiutil.OGBase(instance),
 		Extra: map[string]any{
-			"reasonRequired":   config.GetAccountsReasonRequired(),
-			"registrationOpen": config.GetAccountsRegistrationOpen(),
+			"reasonRequired":   reasonRequired,
+			"registrationOpen": registrationOpen,
+			"inviteCode":       inviteCode,
+			"inviteInvalid":    inviteInvalid,
+			"questions":        questions,
 		},
 	}
 
+	if m.captcha != nil && registrationOpen {
+		// Load the captcha widget script, and loosen
+		// the standard CSP just enough for it to run.
+		page.Javascript = []string{m.captcha.Script()}
+		page.Extra["captchaClass"] = m.captcha.Class()
+		page.Extra["captchaSiteKey"] = m.captcha.SiteKey()
+
+		csp := c.Writer.Header().Get("Content-Security-Policy")
+		c.Header("Content-Security-Policy", csp+"; "+m.captcha.CSP())
+	}
+
 	apiutil.TemplateWebPage(c, page)
 }
 
@@ -100,6 +155,12 @@ func (m *Module) signupPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if m.captcha != nil {
+		// The widget submits its response under
+		// a field name of the provider's choosing.
+		form.CaptchaResponse = c.PostForm(m.captcha.FormField())
+	}
+
 	clientIP := c.ClientIP()
 	signUpIP := net.ParseIP(clientIP)
 	if signUpIP == nil {
@@ -133,6 +194,7 @@ func (m *Module) signupPOSTHandler(c *gin.Context) {
 		Extra: map[string]any{
 			"email":    user.UnconfirmedEmail,
 			"username": user.Account.Username,
+			"approved": util.PtrOrZero(user.Approved),
 		},
 	}
 
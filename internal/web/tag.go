@@ -19,14 +19,24 @@
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
 
 func (m *Module) tagGETHandler(c *gin.Context) {
+	// Gin can't route "/tags/:tag_name.rss"
+	// separately from "/tags/:tag_name", so
+	// check for the rss suffix ourselves.
+	if tagName, ok := strings.CutSuffix(c.Param(apiutil.TagNameKey), rssSuffix); ok {
+		m.tagRSSFeedGETHandler(c, tagName)
+		return
+	}
+
 	ctx := c.Request.Context()
 
 	// We'll need the instance later, and we can also use it
@@ -55,13 +65,44 @@ func (m *Module) tagGETHandler(c *gin.Context) {
 		return
 	}
 
+	extra := map[string]any{"tagName": tagName}
+	if config.GetInstanceExposeTagRSS() {
+		// Advertise the tag's
+		// RSS feed if enabled.
+		extra["rssFeed"] = "/tags/" + tagName + rssSuffix
+	}
+
 	page := apiutil.WebPage{
 		Template:    "tag.tmpl",
 		Instance:    instance,
 		OGMeta:      apiutil.OGBase(instance),
 		Stylesheets: []string{cssFA, cssThread, cssTag, instanceCustomCSSPath},
-		Extra:       map[string]any{"tagName": tagName},
+		Extra:       extra,
 	}
 
 	apiutil.TemplateWebPage(c, page)
 }
+
+func (m *Module) tagRSSFeedGETHandler(c *gin.Context, tagName string) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.AppRSSXML); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	tagName, errWithCode := apiutil.ParseTagName(tagName)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Retrieve the getRSSFeed function from the processor.
+	// We'll only call the function if we need to, to save work.
+	// lastPostAt may be a zero time if nothing has been posted.
+	getRSSFeed, lastPostAt, errWithCode := m.processor.Tags().GetRSSFeed(c.Request.Context(), tagName)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	m.serveRSSFeed(c, getRSSFeed, lastPostAt)
+}
@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+const (
+	termsPath       = aboutPath + "/terms"
+	termsPathWithID = termsPath + "/:" + apiutil.IDKey
+)
+
+// termsGETHandler serves the terms of service currently
+// in effect at /about/terms, or a previous version of the
+// terms at /about/terms/:id, along with the version history.
+func (m *Module) termsGETHandler(c *gin.Context) {
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Return instance we already got from the db,
+	// don't try to fetch it again when erroring.
+	instanceGet := func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	// We only serve text/html at this endpoint.
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextHTML); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), instanceGet)
+		return
+	}
+
+	history, errWithCode := m.processor.TermsOfServiceHistoryGet(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Pick the requested version, or
+	// the current version if no ID given.
+	var terms *apimodel.TermsOfService
+	id := c.Param(apiutil.IDKey)
+	for _, tos := range history {
+		if id == "" || tos.ID == id {
+			terms = tos
+			break
+		}
+	}
+
+	if id != "" && terms == nil {
+		const text = "terms of service not found"
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotFound(gtserror.New(text), text), instanceGet)
+		return
+	}
+
+	page := apiutil.WebPage{
+		Template:    "terms.tmpl",
+		Instance:    instance,
+		OGMeta:      apiutil.OGBase(instance),
+		Stylesheets: []string{cssAbout, instanceCustomCSSPath},
+		Extra: map[string]any{
+			"terms":   terms,
+			"history": history,
+		},
+	}
+
+	apiutil.TemplateWebPage(c, page)
+}
@@ -20,14 +20,17 @@
 import (
 	"context"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"path/filepath"
 
 	"codeberg.org/gruf/go-cache/v3"
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/captcha"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
@@ -58,6 +61,7 @@
 	ifNoneMatchHeader     = "If-None-Match"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-None-Match
 	eTagHeader            = "ETag"              // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 	lastModifiedHeader    = "Last-Modified"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Last-Modified
+	linkHeader            = "Link"              // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Link
 
 	cssFA       = assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css"
 	cssAbout    = distPathPrefix + "/about.css"
@@ -73,16 +77,22 @@
 )
 
 type Module struct {
-	processor    *processing.Processor
-	eTagCache    cache.Cache[string, eTagCacheEntry]
-	isURIBlocked func(context.Context, *url.URL) (bool, error)
+	processor           *processing.Processor
+	eTagCache           cache.Cache[string, eTagCacheEntry]
+	isURIBlocked        func(context.Context, *url.URL) (bool, error)
+	isSignatureRequired func(context.Context, netip.Addr) (bool, error)
+	getInstance         func(context.Context, string) (*gtsmodel.Instance, error)
+	captcha             *captcha.Captcha
 }
 
 func New(db db.DB, processor *processing.Processor) *Module {
 	return &Module{
-		processor:    processor,
-		eTagCache:    newETagCache(),
-		isURIBlocked: db.IsURIBlocked,
+		processor:           processor,
+		eTagCache:           newETagCache(),
+		isURIBlocked:        db.IsURIBlocked,
+		isSignatureRequired: middleware.AuthorizedFetch(db.GetAuthorizedFetchDomains, db.IsSignatureRequired),
+		getInstance:         db.GetInstance,
+		captcha:             captcha.New(),
 	}
 }
 
@@ -104,7 +114,7 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	// can still be served
 	profileGroup := r.AttachGroup(profileGroupPath)
 	profileGroup.Use(mi...)
-	profileGroup.Use(middleware.SignatureCheck(m.isURIBlocked), middleware.CacheControl(middleware.CacheControlConfig{
+	profileGroup.Use(middleware.SignatureCheck(m.isURIBlocked, m.isSignatureRequired), middleware.CacheControl(middleware.CacheControlConfig{
 		Directives: []string{"no-store"},
 	}))
 	profileGroup.Handle(http.MethodGet, "", m.profileGETHandler) // use empty path here since it's the base of the group
@@ -121,6 +131,8 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	r.AttachHandler(http.MethodPost, confirmEmailPath, m.confirmEmailPOSTHandler)
 	r.AttachHandler(http.MethodGet, robotsPath, m.robotsGETHandler)
 	r.AttachHandler(http.MethodGet, aboutPath, m.aboutGETHandler)
+	r.AttachHandler(http.MethodGet, termsPath, m.termsGETHandler)
+	r.AttachHandler(http.MethodGet, termsPathWithID, m.termsGETHandler)
 	r.AttachHandler(http.MethodGet, domainBlockListPath, m.domainBlockListGETHandler)
 	r.AttachHandler(http.MethodGet, tagsPath, m.tagGETHandler)
 	r.AttachHandler(http.MethodGet, signupPath, m.signupGETHandler)
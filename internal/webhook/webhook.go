@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package webhook provides delivery of signed
+// moderation event payloads to the webhooks
+// configured by admins of this instance.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// SignatureHeader is the header containing
+// the signature of a webhook event payload,
+// in the form 'sha256=<hex HMAC-SHA256>'.
+const SignatureHeader = "X-Hub-Signature"
+
+// Send queues delivery of the given event, concerning object,
+// to all enabled webhooks that are subscribed to the event.
+// Failed deliveries are retried with backoff by the webhook
+// worker pool, so this returns as soon as they're queued.
+func Send(ctx context.Context, state *state.State, event string, object any) error {
+	webhooks, err := state.DB.GetWebhooks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting webhooks: %w", err)
+	}
+
+	// Drop any webhooks not
+	// interested in this event.
+	webhooks = slices.DeleteFunc(webhooks, func(w *gtsmodel.Webhook) bool {
+		return !w.Subscribed(event)
+	})
+
+	if len(webhooks) == 0 {
+		// Nobody's listening,
+		// don't bother encoding.
+		return nil
+	}
+
+	body, err := json.Marshal(apimodel.WebhookEvent{
+		Event:     event,
+		CreatedAt: util.FormatISO8601(time.Now()),
+		Object:    object,
+	})
+	if err != nil {
+		return gtserror.Newf("error encoding %s event: %w", event, err)
+	}
+
+	dlvs := make([]*delivery.Delivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		dlv, err := newDelivery(ctx, webhook, body)
+		if err != nil {
+			log.Errorf(ctx, "error preparing delivery to webhook %s: %v", webhook.ID, err)
+			continue
+		}
+		dlvs = append(dlvs, dlv)
+	}
+
+	state.Workers.Webhook.Queue.Push(dlvs...)
+	return nil
+}
+
+// newDelivery prepares a signed delivery
+// of the event payload body to webhook.
+func newDelivery(ctx context.Context, webhook *gtsmodel.Webhook, body []byte) (*delivery.Delivery, error) {
+	r, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		webhook.URL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("User-Agent", fmt.Sprintf("gotosocial/%s (+%s://%s)",
+		config.GetSoftwareVersion(),
+		config.GetProtocol(),
+		config.GetHost(),
+	))
+	r.Header.Set(SignatureHeader, "sha256="+Sign(webhook.Secret, body))
+
+	return &delivery.Delivery{
+		// Index by webhook ID, so queued deliveries
+		// can be dropped if the webhook is deleted.
+		TargetID: webhook.ID,
+		Request:  httpclient.WrapRequest(r),
+	}, nil
+}
+
+// Sign returns the hex-encoded HMAC-SHA256
+// signature of body, using secret as the key.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret returns a new random
+// secret for signing event payloads.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// LogFailed can be used as the DeadLetter callback of the
+// webhook worker pool, to log deliveries that have failed
+// permanently, ie., after running out of retries.
+func LogFailed(ctx context.Context, dlv *delivery.Delivery, err error) {
+	log.Warnf(ctx, "delivery to webhook %s at %s failed: %v",
+		dlv.TargetID, dlv.Request.URL, err)
+}
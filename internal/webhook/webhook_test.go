@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/webhook"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type WebhookTestSuite struct {
+	state state.State
+	suite.Suite
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, &WebhookTestSuite{})
+}
+
+func (suite *WebhookTestSuite) SetupSuite() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+}
+
+func (suite *WebhookTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	// Initialize test database.
+	_ = testrig.NewTestDB(&suite.state)
+	testrig.StandardDBSetup(suite.state.DB, nil)
+}
+
+func (suite *WebhookTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.state.DB)
+	testrig.StopWorkers(&suite.state)
+}
+
+func (suite *WebhookTestSuite) TestSign() {
+	// Known HMAC-SHA256 test vector, RFC 4231 test case 2.
+	suite.Equal(
+		"5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		webhook.Sign("Jefe", []byte("what do ya want for nothing?")),
+	)
+}
+
+func (suite *WebhookTestSuite) TestSend() {
+	ctx := context.Background()
+
+	webhooks := []*gtsmodel.Webhook{
+		{
+			ID:                 "01JKAB1T6X6J3YVZ6N2Q1F2M8S",
+			URL:                "https://chat.example.org/hooks/reports",
+			Events:             []string{gtsmodel.WebhookEventReportCreated},
+			Secret:             "reports secret",
+			Enabled:            util.Ptr(true),
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+		{
+			ID:                 "01JKAB2C5N8W0E5JX3B7Q4H9TD",
+			URL:                "https://bot.example.org/webhook",
+			Events:             []string{gtsmodel.WebhookEventAccountCreated},
+			Secret:             "accounts secret",
+			Enabled:            util.Ptr(true),
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+		{
+			ID:                 "01JKAB2R2W4Z0H6M1K8D3C5V7B",
+			URL:                "https://old.example.org/webhook",
+			Events:             []string{gtsmodel.WebhookEventReportCreated},
+			Secret:             "disabled secret",
+			Enabled:            util.Ptr(false),
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+	}
+
+	for _, w := range webhooks {
+		if err := suite.state.DB.PutWebhook(ctx, w); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	object := map[string]string{"id": "01GP3AWY4CRDVRNZKW0TEAMB5R"}
+	if err := webhook.Send(ctx, &suite.state, gtsmodel.WebhookEventReportCreated, object); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the enabled, subscribed
+	// webhook should get a delivery.
+	suite.Equal(1, suite.state.Workers.Webhook.Queue.Len())
+	dlv, ok := suite.state.Workers.Webhook.Queue.Pop()
+	if !ok {
+		suite.FailNow("expected queued delivery")
+	}
+
+	suite.Equal(webhooks[0].ID, dlv.TargetID)
+	suite.Equal(webhooks[0].URL, dlv.Request.URL.String())
+	suite.Equal("application/json", dlv.Request.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(dlv.Request.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(
+		"sha256="+webhook.Sign(webhooks[0].Secret, body),
+		dlv.Request.Header.Get(webhook.SignatureHeader),
+	)
+
+	var event apimodel.WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.WebhookEventReportCreated, event.Event)
+	suite.NotEmpty(event.CreatedAt)
+	suite.Equal(map[string]any{"id": "01GP3AWY4CRDVRNZKW0TEAMB5R"}, event.Object)
+}
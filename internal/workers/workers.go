@@ -37,6 +37,12 @@ type Workers struct {
 	// indexed queue of Delivery{} objects.
 	Delivery delivery.WorkerPool
 
+	// Webhook provides a worker pool that
+	// handles outgoing admin webhook deliveries.
+	// It contains an embedded (but accessible)
+	// indexed queue of Delivery{} objects.
+	Webhook delivery.WorkerPool
+
 	// Client provides a worker pool that handles
 	// incoming processing jobs from the client API.
 	Client MsgWorkerPool[*messages.FromClientAPI]
@@ -54,6 +60,11 @@ type Workers struct {
 	// eg., import tasks, admin tasks.
 	Processing FnWorkerPool
 
+	// Transcode provides a worker pool for
+	// asynchronous media transcoding jobs,
+	// kept separate as these can run long.
+	Transcode FnWorkerPool
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -75,6 +86,10 @@ func (w *Workers) Start() {
 	w.Delivery.Start(n)
 	log.Infof(nil, "started %d delivery workers", n)
 
+	n = maxprocs
+	w.Webhook.Start(n)
+	log.Infof(nil, "started %d webhook workers", n)
+
 	n = 4 * maxprocs
 	w.Client.Start(n)
 	log.Infof(nil, "started %d client workers", n)
@@ -90,6 +105,10 @@ func (w *Workers) Start() {
 	n = maxprocs
 	w.Processing.Start(n)
 	log.Infof(nil, "started %d processing workers", n)
+
+	n = transcodeWorkers()
+	w.Transcode.Start(n)
+	log.Infof(nil, "started %d transcode workers", n)
 }
 
 // Stop will stop all of the contained
@@ -102,6 +121,9 @@ func (w *Workers) Stop() {
 	w.Delivery.Stop()
 	log.Info(nil, "stopped delivery workers")
 
+	w.Webhook.Stop()
+	log.Info(nil, "stopped webhook workers")
+
 	w.Client.Stop()
 	log.Info(nil, "stopped client workers")
 
@@ -113,6 +135,9 @@ func (w *Workers) Stop() {
 
 	w.Processing.Stop()
 	log.Info(nil, "stopped processing workers")
+
+	w.Transcode.Stop()
+	log.Info(nil, "stopped transcode workers")
 }
 
 // nocopy when embedded will signal linter to
@@ -131,3 +156,12 @@ func deliveryWorkers(maxprocs int) int {
 	}
 	return n * maxprocs
 }
+
+func transcodeWorkers() int {
+	n := config.GetMediaVideoTranscodeWorkers()
+	if n < 1 {
+		// clamp to 1
+		return 1
+	}
+	return n
+}
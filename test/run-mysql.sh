@@ -0,0 +1,47 @@
+#!/bin/bash
+
+set -e
+
+# Determine available docker binary
+_docker=$(command -v 'podman') || \
+_docker=$(command -v 'docker') || \
+{ echo 'docker not found'; exit 1; }
+
+# Ensure test args are set.
+ARGS=${@}; [ -z "$ARGS" ] && \
+ARGS='./...'
+
+# Database config.
+DB_NAME='gotosocial'
+DB_USER='gotosocial'
+DB_PASS='gotosocial'
+DB_IP='127.0.0.1'
+DB_PORT=3306
+
+# Start mariadb container
+CID=$($_docker run --detach \
+    --publish "${DB_IP}:${DB_PORT}:${DB_PORT}" \
+    --env "MARIADB_DATABASE=${DB_NAME}" \
+    --env "MARIADB_USER=${DB_USER}" \
+    --env "MARIADB_PASSWORD=${DB_PASS}" \
+    --env "MARIADB_RANDOM_ROOT_PASSWORD=yes" \
+    'docker.io/mariadb:lts' \
+    --character-set-server=utf8mb4 \
+    --collation-server=utf8mb4_bin)
+
+# On exit kill the container
+trap "$_docker kill ${CID}" exit
+
+# Wait for database to come up.
+until $_docker exec "$CID" healthcheck.sh --connect --innodb_initialized >/dev/null 2>&1; do
+    sleep 1
+done
+
+env \
+GTS_DB_TYPE=mysql \
+GTS_DB_ADDRESS=${DB_IP} \
+GTS_DB_PORT=${DB_PORT} \
+GTS_DB_USER=${DB_USER} \
+GTS_DB_PASSWORD=${DB_PASS} \
+GTS_DB_DATABASE=${DB_NAME} \
+go test -p 1 ${ARGS}
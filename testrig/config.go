@@ -59,11 +59,13 @@ func testDefaults() config.Configuration {
 		LogLevel:                 envStr("GTS_LOG_LEVEL", "error"),
 		LogTimestampFormat:       "02/01/2006 15:04:05.000",
 		LogDbQueries:             true,
+		LogFormat:                "logfmt",
 		ApplicationName:          "gotosocial",
 		LandingPageUser:          "",
 		ConfigPath:               "",
 		Host:                     "localhost:8080",
 		AccountDomain:            "localhost:8080",
+		ExtraAccountDomains:      []string{},
 		Protocol:                 "http",
 		BindAddress:              "127.0.0.1",
 		Port:                     8080,
@@ -81,16 +83,38 @@ func testDefaults() config.Configuration {
 		DbSqliteSynchronous:      "NORMAL",
 		DbSqliteCacheSize:        8 * bytesize.MiB,
 		DbSqliteBusyTimeout:      time.Minute * 5,
+		DbPostgresReplicas:       []string{},
+		DbPostgresReplicaMaxLag:  5 * time.Second,
 
 		WebTemplateBaseDir: "./web/template/",
 		WebAssetBaseDir:    "./web/assets/",
 
-		InstanceFederationMode:         config.InstanceFederationModeDefault,
-		InstanceFederationSpamFilter:   true,
-		InstanceExposePeers:            true,
-		InstanceExposeSuspended:        true,
-		InstanceExposeSuspendedWeb:     true,
-		InstanceDeliverToSharedInboxes: true,
+		InstanceFederationMode:                   config.InstanceFederationModeDefault,
+		InstanceAuthorizedFetchMode:              config.InstanceAuthorizedFetchModeDefault,
+		InstanceFederationSpamFilter:             true,
+		InstanceFederationSpamScoreThreshold:     0,
+		InstanceFederationSpamScoreAction:        config.InstanceFederationSpamScoreActionDefault,
+		InstanceFederationSpamScoreMaxMentions:   3,
+		InstanceFederationSpamScoreMaxHashtags:   5,
+		InstanceFederationSpamScoreNewAccountAge: 72 * time.Hour,
+		InstanceIntegrityProofs:                  false,
+		InstanceExposePeers:                      true,
+		InstanceExposeSuspended:                  true,
+		InstanceExposeSuspendedWeb:               true,
+		InstanceDeliverToSharedInboxes:           true,
+		InstanceWebfingerAliasHosts:              []string{},
+		InstanceOutboxPageSize:                   40,
+		InstanceBackfillReplies:                  false,
+		InstanceBackfillRepliesMaxDepth:          3,
+		InstanceBackfillRepliesMaxStatuses:       100,
+		InstanceBackfillFollowStatuses:           0,
+		InstanceDirectoryIncludeRemote:           true,
+		InstanceNodeInfoMetadata: []string{
+			config.InstanceNodeInfoMetadataNodeName,
+			config.InstanceNodeInfoMetadataNodeDescription,
+			config.InstanceNodeInfoMetadataMaintainer,
+			config.InstanceNodeInfoMetadataFederation,
+		},
 		InstanceLanguages: language.Languages{
 			{
 				TagStr: "nl",
@@ -103,17 +127,31 @@ func testDefaults() config.Configuration {
 		AccountsRegistrationOpen: true,
 		AccountsReasonRequired:   true,
 		AccountsAllowCustomCSS:   true,
+		AccountsAllowUserInvites: false,
 		AccountsCustomCSSLength:  10000,
+		AccountsArchiveInterval:  7,
+		AccountsDeletionGrace:    0,
+		AccountsCaptchaProvider:  "",
+		AccountsCaptchaSiteKey:   "",
+		AccountsCaptchaSecretKey: "",
+
+		AccountsFieldVerificationInterval: 24 * time.Hour,
 
-		MediaDescriptionMinChars: 0,
-		MediaDescriptionMaxChars: 500,
-		MediaRemoteCacheDays:     7,
-		MediaLocalMaxSize:        40 * bytesize.MiB,
-		MediaRemoteMaxSize:       40 * bytesize.MiB,
-		MediaEmojiLocalMaxSize:   51200,          // 50KiB
-		MediaEmojiRemoteMaxSize:  102400,         // 100KiB
-		MediaCleanupFrom:         "00:00",        // midnight.
-		MediaCleanupEvery:        24 * time.Hour, // 1/day.
+		MediaDescriptionMinChars:   0,
+		MediaDescriptionRequired:   false,
+		MediaDescriptionMaxChars:   500,
+		MediaRemoteCacheDays:       7,
+		MediaLocalMaxSize:          40 * bytesize.MiB,
+		MediaRemoteMaxSize:         40 * bytesize.MiB,
+		MediaEmojiLocalMaxSize:     51200,          // 50KiB
+		MediaEmojiRemoteMaxSize:    102400,         // 100KiB
+		MediaCleanupFrom:           "00:00",        // midnight.
+		MediaCleanupEvery:          24 * time.Hour, // 1/day.
+		MediaThumbnailFormat:       config.MediaThumbnailFormatJPEG,
+		MediaVideoTranscode:        false,
+		MediaVideoMaxBitrate:       4000,
+		MediaVideoMaxHeight:        1080,
+		MediaVideoTranscodeWorkers: 1,
 
 		// the testrig only uses in-memory storage, so we can
 		// safely set this value to 'test' to avoid running storage
@@ -121,11 +159,21 @@ func testDefaults() config.Configuration {
 		StorageBackend:       "test",
 		StorageLocalBasePath: "",
 
+		SearchBackend: "db",
+
 		StatusesMaxChars:           5000,
 		StatusesPollMaxOptions:     6,
 		StatusesPollOptionMaxChars: 50,
 		StatusesMediaMaxFiles:      6,
 
+		TrendsEnabled:       true,
+		TrendsRequireReview: true,
+		TrendsMinAccounts:   3,
+
+		TranslationProvider: "",
+		TranslationEndpoint: "",
+		TranslationAPIKey:   "",
+
 		LetsEncryptEnabled:      false,
 		LetsEncryptPort:         0,
 		LetsEncryptCertDir:      "",
@@ -141,6 +189,15 @@ func testDefaults() config.Configuration {
 		OIDCLinkExisting:     false,
 		OIDCAdminGroups:      []string{"adminRole"},
 		OIDCAllowedGroups:    []string{"allowedRole"},
+		OIDCModeratorGroups:  []string{"moderatorRole"},
+		OIDCGroupsClaim:      "groups",
+		OIDCSyncRoles:        false,
+
+		LDAPEnabled:           false,
+		LDAPUserFilter:        "(|(uid={username})(mail={username}))",
+		LDAPUsernameAttribute: "uid",
+		LDAPEmailAttribute:    "mail",
+		LDAPAutoProvision:     false,
 
 		SMTPHost:               "",
 		SMTPPort:               0,
@@ -148,6 +205,12 @@ func testDefaults() config.Configuration {
 		SMTPPassword:           "",
 		SMTPFrom:               "GoToSocial",
 		SMTPDiscloseRecipients: false,
+		SMTPAuthMethod:         config.SMTPAuthMethodPlain,
+		SMTPOAuth2TokenURL:     "",
+		SMTPOAuth2ClientID:     "",
+		SMTPOAuth2ClientSecret: "",
+		SMTPOAuth2RefreshToken: "",
+		SMTPOAuth2Scopes:       []string{},
 
 		TracingEnabled:           false,
 		TracingEndpoint:          "localhost:4317",
@@ -157,12 +220,26 @@ func testDefaults() config.Configuration {
 		MetricsEnabled:     true,
 		MetricsAuthEnabled: false,
 
+		DebugEndpointsEnabled: false,
+
 		SyslogEnabled:  false,
 		SyslogProtocol: "udp",
 		SyslogAddress:  "localhost:514",
 
+		DeliveryMaxRetries:           5,
+		DeliveryBackoff:              2 * time.Second,
+		DeliveryUnreachableThreshold: 5,
+		DeliveryUnreachableDuration:  time.Hour,
+		DeliveryDeadLetterRetention:  7 * 24 * time.Hour,
+		DeliveryRateLimit:            0,
+		DeliveryDereferenceRateLimit: 0,
+
+		ClusterEnabled: false,
+		ClusterNodeID:  "",
+
 		AdvancedCookiesSamesite:      "lax",
 		AdvancedRateLimitRequests:    0, // disabled
+		AdvancedRateLimitKey:         config.RateLimitKeyIP,
 		AdvancedThrottlingMultiplier: 0, // disabled
 		AdvancedSenderMultiplier:     0, // 1 sender only, regardless of CPU
 
@@ -34,6 +34,8 @@
 	&gtsmodel.AccountToEmoji{},
 	&gtsmodel.Application{},
 	&gtsmodel.Block{},
+	&gtsmodel.Circle{},
+	&gtsmodel.CircleMember{},
 	&gtsmodel.DomainBlock{},
 	&gtsmodel.EmailDomainBlock{},
 	&gtsmodel.Filter{},
@@ -53,6 +55,7 @@
 	&gtsmodel.StatusToEmoji{},
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusFave{},
+	&gtsmodel.StatusReaction{},
 	&gtsmodel.StatusBookmark{},
 	&gtsmodel.Tag{},
 	&gtsmodel.Thread{},
@@ -63,6 +66,7 @@
 	&gtsmodel.Emoji{},
 	&gtsmodel.Instance{},
 	&gtsmodel.Notification{},
+	&gtsmodel.NotificationRequest{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
 	&gtsmodel.Client{},
@@ -71,6 +75,32 @@
 	&gtsmodel.Report{},
 	&gtsmodel.Rule{},
 	&gtsmodel.WorkerTask{},
+	&gtsmodel.Trend{},
+	&gtsmodel.TrendHistory{},
+	&gtsmodel.MediaRetentionPolicy{},
+	&gtsmodel.Relay{},
+	&gtsmodel.DeadLetter{},
+	&gtsmodel.Appeal{},
+	&gtsmodel.AutomodRule{},
+	&gtsmodel.IPBlock{},
+	&gtsmodel.CanonicalEmailBlock{},
+	&gtsmodel.Invite{},
+	&gtsmodel.SpamFlag{},
+	&gtsmodel.SignupQuestion{},
+	&gtsmodel.SignupAnswer{},
+	&gtsmodel.AuditLogEntry{},
+	&gtsmodel.Role{},
+	&gtsmodel.Webhook{},
+	&gtsmodel.TermsOfService{},
+	&gtsmodel.Announcement{},
+	&gtsmodel.AnnouncementRead{},
+	&gtsmodel.AnnouncementReaction{},
+	&gtsmodel.RelationshipSeveranceEvent{},
+	&gtsmodel.SeveredRelationship{},
+	&gtsmodel.Lease{},
+	&gtsmodel.AccountArchive{},
+	&gtsmodel.AccountImport{},
+	&gtsmodel.StatusEdit{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
@@ -209,6 +239,10 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	if err := db.ReindexStatuses(ctx); err != nil {
+		log.Panic(nil, err)
+	}
+
 	for _, v := range NewTestEmojis() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(nil, err)
@@ -2624,6 +2624,7 @@ func NewTestReports() map[string]*gtsmodel.Report {
 			AccountID:       "01F8MH5NBDF2MV7CTC4Q5128HF",
 			TargetAccountID: "01F8MH5ZK5VRH73AKHQM6Y9VNX",
 			Comment:         "dark souls sucks, please yeet this nerd",
+			Category:        gtsmodel.ReportCategoryViolation,
 			StatusIDs:       []string{"01FVW7JHQFSFK166WWKR8CBA6M"},
 			Forwarded:       util.Ptr(true),
 			RuleIDs:         []string{"01GP3AWY4CRDVRNZKW0TEAMB51", "01GP3DFY9XQ1TJMZT5BGAZPXX3"},
@@ -2636,6 +2637,7 @@ func NewTestReports() map[string]*gtsmodel.Report {
 			AccountID:              "01F8MH5ZK5VRH73AKHQM6Y9VNX",
 			TargetAccountID:        "01F8MH5NBDF2MV7CTC4Q5128HF",
 			Comment:                "this is a turtle, not a person, therefore should not be a poster",
+			Category:               gtsmodel.ReportCategoryOther,
 			StatusIDs:              []string{},
 			RuleIDs:                []string{},
 			Forwarded:              util.Ptr(true),
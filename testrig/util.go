@@ -49,6 +49,7 @@ func StartNoopWorkers(state *state.State) {
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
 	state.Workers.Delivery.Init(nil)
+	state.Workers.Webhook.Init(nil)
 
 	// Specifically do NOT start the workers
 	// as caller may require queue contents.
@@ -78,12 +79,14 @@ func StartWorkers(state *state.State, processor *workers.Processor) {
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
 	state.Workers.Delivery.Init(nil)
+	state.Workers.Webhook.Init(nil)
 
 	_ = state.Workers.Scheduler.Start()
 	state.Workers.Client.Start(1)
 	state.Workers.Federator.Start(1)
 	state.Workers.Dereference.Start(1)
 	state.Workers.Processing.Start(1)
+	state.Workers.Transcode.Start(1)
 }
 
 func StopWorkers(state *state.State) {
@@ -92,6 +95,7 @@ func StopWorkers(state *state.State) {
 	state.Workers.Federator.Stop()
 	state.Workers.Dereference.Stop()
 	state.Workers.Processing.Stop()
+	state.Workers.Transcode.Stop()
 }
 
 func StartTimelines(state *state.State, visFilter *visibility.Filter, converter *typeutils.Converter) {
@@ -26,6 +26,7 @@ export interface AdminAccount {
 	domain: string | null,
 	created_at: string,
 	email: string,
+	unconfirmed_email?: string,
 	ip: string | null,
 	ips: [],
 	locale: string,
@@ -79,6 +80,14 @@ export interface AccountSource {
 	privacy: string;
 	sensitive: boolean;
 	status_content_type: string;
+	status_expiry_days: number;
+	status_expiry_keep_pinned: boolean;
+	status_expiry_keep_self_faved: boolean;
+	email_digest_days: number;
+	email_digest_follows: boolean;
+	email_digest_mentions: boolean;
+	email_digest_follow_requests: boolean;
+	require_media_descriptions: boolean;
 }
 
 export interface SearchAccountParams {
@@ -179,6 +179,12 @@ function LocalAccountDetails({ adminAcct }: { adminAcct: AdminAccount }) {
 					<dt>Email</dt>
 					<dd>{adminAcct.email} {<b>{adminAcct.confirmed ? "(confirmed)" : "(not confirmed)"}</b> }</dd>
 				</div>
+				{ adminAcct.unconfirmed_email &&
+					<div className="info-list-entry">
+						<dt>Pending Email Change</dt>
+						<dd>{adminAcct.unconfirmed_email} <b>(not confirmed)</b></dd>
+					</div>
+				}
 				<div className="info-list-entry">
 					<dt>Disabled</dt>
 					<dd>{yesOrNo(adminAcct.disabled)}</dd>
@@ -18,14 +18,17 @@
 */
 
 import React from "react";
-import { useTextInput } from "../../lib/form";
+import { useBoolInput, useTextInput } from "../../lib/form";
 import useFormSubmit from "../../lib/form/submit";
-import { TextInput } from "../../components/form/inputs";
+import { Checkbox, TextInput } from "../../components/form/inputs";
 import MutationButton from "../../components/form/mutation-button";
-import { useEmailChangeMutation, usePasswordChangeMutation, useUserQuery } from "../../lib/query/user";
+import { useEmailChangeMutation, usePasswordChangeMutation, useUpdateCredentialsMutation, useUserQuery } from "../../lib/query/user";
 import Loading from "../../components/loading";
+import { Error as ErrorC } from "../../components/error";
 import { User } from "../../lib/types/user";
+import { Account } from "../../lib/types/account";
 import { useInstanceV1Query } from "../../lib/query/gts-api";
+import { useVerifyCredentialsQuery } from "../../lib/query/oauth";
 
 export default function EmailPassword() {
 	return (
@@ -33,10 +36,104 @@ export default function EmailPassword() {
 			<h1>Email & Password Settings</h1>
 			<EmailChange />
 			<PasswordChange />
+			<EmailDigest />
 		</>
 	);
 }
 
+function EmailDigest() {
+	const {
+		data: account,
+		isLoading,
+		isFetching,
+		isError,
+		error,
+	} = useVerifyCredentialsQuery();
+
+	if (isLoading || isFetching) {
+		return <Loading />;
+	}
+
+	if (isError) {
+		return <ErrorC error={error} />;
+	}
+
+	if (!account) {
+		return <ErrorC error={new Error("account was undefined")} />;
+	}
+
+	return <EmailDigestForm account={account} />;
+}
+
+function EmailDigestForm({ account }: { account: Account }) {
+	/* form keys
+		- int email_digest_days
+		- bool email_digest_follows
+		- bool email_digest_mentions
+		- bool email_digest_follow_requests
+	 */
+	const form = {
+		days: useTextInput("email_digest_days", {
+			source: account,
+			valueSelector: (s: Account) => (s.source?.email_digest_days ?? 0).toString(),
+		}),
+		follows: useBoolInput("email_digest_follows", {
+			source: account,
+			valueSelector: (s: Account) => s.source?.email_digest_follows ?? true,
+		}),
+		mentions: useBoolInput("email_digest_mentions", {
+			source: account,
+			valueSelector: (s: Account) => s.source?.email_digest_mentions ?? true,
+		}),
+		followRequests: useBoolInput("email_digest_follow_requests", {
+			source: account,
+			valueSelector: (s: Account) => s.source?.email_digest_follow_requests ?? true,
+		}),
+	};
+
+	const [submitForm, result] = useFormSubmit(form, useUpdateCredentialsMutation());
+
+	return (
+		<form className="user-digest" onSubmit={submitForm}>
+			<div className="form-section-docs">
+				<h3>Email Digests</h3>
+				<a
+					href="https://docs.gotosocial.org/en/latest/user_guide/settings#email-digests"
+					target="_blank"
+					className="docslink"
+					rel="noreferrer"
+				>
+				Learn more about this (opens in a new tab)
+				</a>
+			</div>
+			<TextInput
+				field={form.days}
+				label="Send me a digest every this many days (1 to 30, or 0 to never send)"
+				type="number"
+				min="0"
+				max="30"
+			/>
+			<Checkbox
+				field={form.follows}
+				label="Include new followers"
+			/>
+			<Checkbox
+				field={form.mentions}
+				label="Include mentions"
+			/>
+			<Checkbox
+				field={form.followRequests}
+				label="Include pending follow requests"
+			/>
+			<MutationButton
+				disabled={false}
+				label="Save digest settings"
+				result={result}
+			/>
+		</form>
+	);
+}
+
 function PasswordChange() {
 	// Load instance data.
 	const {
@@ -67,6 +67,7 @@ export default function Import() {
 					<>
 						<option value="">- Select import type -</option>
 						<option value="following">Following list</option>
+						<option value="lists">Lists</option>
 						<option value="blocks">Blocked accounts list</option>
 					</>
 				}>
@@ -32,12 +32,17 @@ export default function BasicSettings({ account }: { account: Account }) {
 		- bool source[sensitive]
 		- string source[language]
 		- string source[status_content_type]
+		- bool require_media_descriptions
 	 */
 	const form = {
 		defaultPrivacy: useTextInput("source[privacy]", { source: account, defaultValue: "unlisted" }),
 		isSensitive: useBoolInput("source[sensitive]", { source: account }),
 		language: useTextInput("source[language]", { source: account, valueSelector: (s: Account) => s.source?.language?.toUpperCase() ?? "EN" }),
 		statusContentType: useTextInput("source[status_content_type]", { source: account, defaultValue: "text/plain" }),
+		requireMediaDescriptions: useBoolInput("require_media_descriptions", {
+			source: account,
+			valueSelector: (s: Account) => s.source?.require_media_descriptions ?? false,
+		}),
 	};
 	
 	const [submitForm, result] = useFormSubmit(form, useUpdateCredentialsMutation());
@@ -78,6 +83,10 @@ export default function BasicSettings({ account }: { account: Account }) {
 				field={form.isSensitive}
 				label="Mark my posts as sensitive by default"
 			/>
+			<Checkbox
+				field={form.requireMediaDescriptions}
+				label="Don't let me post media without a description (alt text)"
+			/>
 			<MutationButton
 				disabled={false}
 				label="Save settings"
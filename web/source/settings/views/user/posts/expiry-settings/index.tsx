@@ -0,0 +1,86 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React from "react";
+import { useTextInput, useBoolInput } from "../../../../lib/form";
+import useFormSubmit from "../../../../lib/form/submit";
+import { TextInput, Checkbox } from "../../../../components/form/inputs";
+import MutationButton from "../../../../components/form/mutation-button";
+import { useUpdateCredentialsMutation } from "../../../../lib/query/user";
+import { Account } from "../../../../lib/types/account";
+
+export default function ExpirySettings({ account }: { account: Account }) {
+	/* form keys
+		- int status_expiry_days
+		- bool status_expiry_keep_pinned
+		- bool status_expiry_keep_self_faved
+	 */
+	const form = {
+		days: useTextInput("status_expiry_days", {
+			source: account,
+			valueSelector: (s: Account) => (s.source?.status_expiry_days ?? 0).toString(),
+		}),
+		keepPinned: useBoolInput("status_expiry_keep_pinned", {
+			source: account,
+			valueSelector: (s: Account) => s.source?.status_expiry_keep_pinned ?? true,
+		}),
+		keepSelfFaved: useBoolInput("status_expiry_keep_self_faved", {
+			source: account,
+			valueSelector: (s: Account) => s.source?.status_expiry_keep_self_faved ?? true,
+		}),
+	};
+
+	const [submitForm, result] = useFormSubmit(form, useUpdateCredentialsMutation());
+
+	return (
+		<form className="post-settings" onSubmit={submitForm}>
+			<div className="form-section-docs">
+				<h3>Automatic Post Deletion</h3>
+				<a
+					href="https://docs.gotosocial.org/en/latest/user_guide/settings#automatic-post-deletion"
+					target="_blank"
+					className="docslink"
+					rel="noreferrer"
+				>
+				Learn more about these settings (opens in a new tab)
+				</a>
+			</div>
+			<TextInput
+				field={form.days}
+				label="Delete posts older than this many days (7 to 3650, or 0 to never delete)"
+				type="number"
+				min="0"
+				max="3650"
+			/>
+			<Checkbox
+				field={form.keepPinned}
+				label="Don't delete pinned posts"
+			/>
+			<Checkbox
+				field={form.keepSelfFaved}
+				label="Don't delete posts I've favourited"
+			/>
+			<MutationButton
+				disabled={false}
+				label="Save settings"
+				result={result}
+			/>
+		</form>
+	);
+}
@@ -23,6 +23,7 @@ import Loading from "../../../components/loading";
 import { Error as ErrorC } from "../../../components/error";
 import BasicSettings from "./basic-settings";
 import InteractionPolicySettings from "./interaction-policy-settings";
+import ExpirySettings from "./expiry-settings";
 
 export default function PostSettings() {
 	const {
@@ -50,6 +51,7 @@ export default function PostSettings() {
 			<h1>Post Settings</h1>
 			<BasicSettings account={account} />
 			<InteractionPolicySettings />
+			<ExpirySettings account={account} />
 		</>
 	);
 }
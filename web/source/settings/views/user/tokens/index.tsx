@@ -0,0 +1,147 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React, { ReactNode, useEffect, useMemo } from "react";
+import { useSearch } from "wouter";
+import { PageableList } from "../../../components/pageable-list";
+import MutationButton from "../../../components/form/mutation-button";
+import { useInvalidateTokenMutation, useLazySearchTokenInfoQuery } from "../../../lib/query/user/tokens";
+import { TokenInfo } from "../../../lib/types/tokeninfo";
+
+export default function Tokens() {
+	const search = useSearch();
+	const urlQueryParams = useMemo(() => new URLSearchParams(search), [search]);
+	const [ searchTokens, searchRes ] = useLazySearchTokenInfoQuery();
+
+	// Trigger search on mount, and again
+	// whenever the paging params change.
+	useEffect(() => {
+		searchTokens(Object.fromEntries(urlQueryParams), true);
+	}, [urlQueryParams, searchTokens]);
+
+	// Function to map an item to a list entry.
+	function itemToEntry(tokenInfo: TokenInfo): ReactNode {
+		return (
+			<TokenInfoListEntry
+				key={tokenInfo.id}
+				tokenInfo={tokenInfo}
+			/>
+		);
+	}
+
+	return (
+		<div className="tokens-view">
+			<div className="form-section-docs">
+				<h1>Access Tokens</h1>
+				<p>
+					On this page you can see the access tokens that applications
+					have been granted to act on your behalf, and when they were
+					last used (accurate to within an hour).
+					<br/>If you don't recognize an application, or you don't use
+					it anymore, you can invalidate its token, after which it will
+					no longer be able to access your account.
+				</p>
+			</div>
+			<PageableList
+				isLoading={searchRes.isLoading}
+				isFetching={searchRes.isFetching}
+				isSuccess={searchRes.isSuccess}
+				items={searchRes.data?.tokens}
+				itemToEntry={itemToEntry}
+				isError={searchRes.isError}
+				error={searchRes.error}
+				emptyMessage={<b>No tokens found.</b>}
+				prevNextLinks={searchRes.data?.links}
+			/>
+		</div>
+	);
+}
+
+interface TokenInfoListEntryProps {
+	tokenInfo: TokenInfo;
+}
+
+function TokenInfoListEntry({ tokenInfo }: TokenInfoListEntryProps) {
+	const [ invalidate, invalidateResult ] = useInvalidateTokenMutation();
+
+	const appName = tokenInfo.application.name;
+	const created = new Date(tokenInfo.created_at).toLocaleString();
+	const lastUsed = tokenInfo.last_used
+		? new Date(tokenInfo.last_used).toLocaleString()
+		: "unknown";
+
+	return (
+		<span
+			className="token-info entry"
+			aria-label={`Token for ${appName}`}
+			title={`Token for ${appName}`}
+		>
+			<span className="text-cutoff">
+				<i
+					className="fa fa-fw fa-key"
+					aria-hidden="true"
+				/> <strong>{appName}</strong>
+			</span>
+			<dl className="info-list">
+				{ tokenInfo.application.website &&
+					<div className="info-list-entry">
+						<dt>Website:</dt>
+						<dd className="text-cutoff">
+							<a
+								href={tokenInfo.application.website}
+								target="_blank"
+								rel="noreferrer"
+							>
+								{tokenInfo.application.website}
+							</a>
+						</dd>
+					</div>
+				}
+				<div className="info-list-entry">
+					<dt>Scope:</dt>
+					<dd className="text-cutoff monospace">{tokenInfo.scope}</dd>
+				</div>
+				<div className="info-list-entry">
+					<dt>Created:</dt>
+					<dd>{created}</dd>
+				</div>
+				<div className="info-list-entry">
+					<dt>Last used:</dt>
+					<dd>{lastUsed}</dd>
+				</div>
+			</dl>
+			<div className="action-buttons">
+				<MutationButton
+					label="Invalidate token"
+					title={`Invalidate token for ${appName}`}
+					type="button"
+					className="button danger"
+					onClick={(e) => {
+						e.preventDefault();
+						e.stopPropagation();
+						invalidate(tokenInfo.id);
+					}}
+					disabled={false}
+					showError={true}
+					result={invalidateResult}
+				/>
+			</div>
+		</span>
+	);
+}